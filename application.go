@@ -81,6 +81,20 @@ func NewApp() *App {
 }
 
 func (a *App) Run() (err error) {
+	return a.run(nil)
+}
+
+// Запускает только указанные компоненты и их обязательные зависимости,
+// остальные компоненты остаются зарегистрированными, но не инициализируются и не запускаются
+func (a *App) RunComponents(names ...string) error {
+	if len(names) == 0 {
+		return errors.New("components for run not specified")
+	}
+
+	return a.run(names)
+}
+
+func (a *App) run(names []string) (err error) {
 	if atomic.LoadInt64(&a.running) == 1 {
 		return errors.New("already running")
 	}
@@ -93,6 +107,24 @@ func (a *App) Run() (err error) {
 		return err
 	}
 
+	var running map[string]struct{}
+
+	if names != nil {
+		if running, err = a.components.Required(names...); err != nil {
+			return err
+		}
+
+		filtered := make([]*component, 0, len(running))
+
+		for _, cmp := range components {
+			if _, ok := running[cmp.Name()]; ok {
+				filtered = append(filtered, cmp)
+			}
+		}
+
+		components = filtered
+	}
+
 	total := len(components)
 	if total == 0 {
 		return
@@ -118,6 +150,13 @@ func (a *App) Run() (err error) {
 		fn := func(component *component) func() error {
 			return func() error {
 				for _, dep := range component.ReverseDep() {
+					// незапущенные компоненты никогда не перейдут в статус Shutdown
+					if running != nil {
+						if _, ok := running[dep]; !ok {
+							continue
+						}
+					}
+
 					<-a.ShutdownComponent(dep)
 				}
 
@@ -167,13 +206,13 @@ func (a *App) Run() (err error) {
 						},
 					}, closers...)
 
-					a.shutdown <- sig[0]
+					a.requestShutdown()
 				}
 			}
 
 			if notBlockedRunning >= total {
 				if !shutdownRunning {
-					a.shutdown <- sig[0]
+					a.requestShutdown()
 				}
 			}
 
@@ -193,6 +232,14 @@ func (a *App) Run() (err error) {
 	}
 }
 
+// сигнал о завершении мог уже быть отправлен, поэтому повторная отправка не должна блокировать
+func (a *App) requestShutdown() {
+	select {
+	case a.shutdown <- sig[0]:
+	default:
+	}
+}
+
 func (a *App) SetName(name string) {
 	a.name = name
 }
@@ -345,6 +392,10 @@ func RegisterComponent(c Component) error {
 	return DefaultApplication.RegisterComponent(c)
 }
 
+func RunComponents(names ...string) error {
+	return DefaultApplication.RunComponents(names...)
+}
+
 func Run() error {
 	return DefaultApplication.Run()
 }
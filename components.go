@@ -113,3 +113,35 @@ func (c *components) Resolve() (err error) {
 
 	return nil
 }
+
+// Возвращает указанные компоненты вместе со всеми их обязательными зависимостями
+func (c *components) Required(names ...string) (map[string]struct{}, error) {
+	result := make(map[string]struct{}, len(names))
+	queue := append([]string(nil), names...)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if _, ok := result[name]; ok {
+			continue
+		}
+
+		cmp, exist := c.Get(name)
+		if !exist {
+			return nil, errors.New("component \"" + name + "\" not found")
+		}
+
+		result[name] = struct{}{}
+
+		if dependency, ok := cmp.instance.(ComponentDependency); ok {
+			for _, dep := range dependency.Dependencies() {
+				if dep.Required {
+					queue = append(queue, dep.Name)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
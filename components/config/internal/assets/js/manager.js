@@ -106,6 +106,26 @@ $(document).ready(function () {
         }
     });
 
+    // live updates
+    shadowEvents.subscribe('dashboard.config', function (event) {
+        var row = $('#configs [name="' + event.data.key + '"]').parentsUntil('tbody', 'tr');
+
+        if (!row.length || row.hasClass('has-error')) {
+            return;
+        }
+
+        var escape = function (v) {
+            return $('<div>').text(v).html();
+        };
+
+        new PNotify({
+            title: 'Config changed',
+            text: '<strong>' + escape(event.data.key) + '</strong>' + (typeof event.data.value !== 'undefined' ? ': ' + escape(event.data.value) : '') + '<br />Reload page to view actual values',
+            type: 'info',
+            styling: 'bootstrap3'
+        });
+    });
+
     /*
     $('#configs tbody').on('click', 'tr.group', function () {
         var currentOrder = table.order()[0];
@@ -1 +1 @@
-$(document).ready(function(){$("#configs input[id], #configs select, #configs textarea[id]").change(function(){var e=$(this),row=e.parentsUntil("tbody","tr"),current=e.val(),def=this.defaultValue;"checkbox"==e.prop("type")?(current=""!=e.prop("checked"),def=this.defaultChecked):"SELECT"==this.tagName&&(def=e.find("option").filter(function(){return $(this).prop("defaultSelected")}).val()),current==def?row.removeClass("has-error"):row.addClass("has-error"),$("#configs button[type=submit]").prop("disabled",0==$("#configs tr.has-error").length)}),$("#configs button[type=reset]").click(function(){$("#configs tr.has-error").removeClass("has-error"),$("#configs button[type=submit]").prop("disabled",!0)}),$("#configs button[type=submit]").click(function(){var m=$("#modalConfig"),c="";return $("#configs tr.has-error td input[name][type!=hidden], #configs tr.has-error td select, #configs tr.has-error td textarea").each(function(){var e=$(this);"checkbox"==e.prop("type")?v=e.prop("checked"):v=e.val(),c+="<li><strong>"+e.prop("name")+"</strong>: "+v+"</li>"}),m.find(".modal-body").html("Changes options:<br /><ul>"+c+"</ul>"),m.modal(),!1}),$("#modalConfig button[type=submit]").click(function(){var data={};return $("#configs tr.has-error td input[id][type!=hidden], #configs tr.has-error td select, #configs tr.has-error td textarea").each(function(){var e=$(this);data[e.prop("name")]="checkbox"==e.prop("type")?e.prop("checked"):e.val()}),$.post("#",data,function(){window.location.reload()}),!1}),$("#configs input[type=password].password-show").on("show.bs.password hide.bs.password",function(){var e=$("#"+$(this).prop("id")+"_value");if(e.length){var t=e.text();e.text(e.data("value")),e.data("value",t)}});$("#configs table").DataTable({language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},bPaginate:!1,bInfo:!1,aaSorting:[],drawCallback:function(){var api=this.api(),rows=api.rows({page:"current"}).nodes(),last=null;api.column(0,{page:"current"}).data().each(function(row,i){var name=$(row).data("group");last!==name&&name.length&&$(rows).eq(i).before('<tr class="group"><td colspan="5">'+name+"</td></tr>"),last=name})}})});
\ No newline at end of file
+$(document).ready(function(){$("#configs input[id], #configs select, #configs textarea[id]").change(function(){var e=$(this),n=e.parentsUntil("tbody","tr"),s=e.val(),t=this.defaultValue;e.prop("type")=="checkbox"?(s=e.prop("checked")!="",t=this.defaultChecked):this.tagName=="SELECT"&&(t=e.find("option").filter(function(){return $(this).prop("defaultSelected")}).val()),s==t?n.removeClass("has-error"):n.addClass("has-error"),$("#configs button[type=submit]").prop("disabled",$("#configs tr.has-error").length==0)}),$("#configs button[type=reset]").click(function(){$("#configs tr.has-error").removeClass("has-error"),$("#configs button[type=submit]").prop("disabled",!0)}),$("#configs button[type=submit]").click(function(){var e=$("#modalConfig"),t="";return $("#configs tr.has-error td input[name][type!=hidden], #configs tr.has-error td select, #configs tr.has-error td textarea").each(function(){var e=$(this);e.prop("type")=="checkbox"?v=e.prop("checked"):v=e.val(),t+="<li><strong>"+e.prop("name")+"</strong>: "+v+"</li>"}),e.find(".modal-body").html("Changes options:<br /><ul>"+t+"</ul>"),e.modal(),!1}),$("#modalConfig button[type=submit]").click(function(){var e={};return $("#configs tr.has-error td input[id][type!=hidden], #configs tr.has-error td select, #configs tr.has-error td textarea").each(function(){var t=$(this);e[t.prop("name")]=t.prop("type")=="checkbox"?t.prop("checked"):t.val()}),$.post("#",e,function(){window.location.reload()}),!1}),$("#configs input[type=password].password-show").on("show.bs.password hide.bs.password",function(){var t,e=$("#"+$(this).prop("id")+"_value");e.length&&(t=e.text(),e.text(e.data("value")),e.data("value",t))});var e=$("#configs table").DataTable({language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},bPaginate:!1,bInfo:!1,aaSorting:[],drawCallback:function(){var e=this.api(),n=e.rows({page:"current"}).nodes(),t=null;e.column(0,{page:"current"}).data().each(function(e,s){var o=$(e).data("group");t!==o&&o.length&&$(n).eq(s).before('<tr class="group"><td colspan="5">'+o+"</td></tr>"),t=o})}});shadowEvents.subscribe("dashboard.config",function(e){var t=$('#configs [name="'+e.data.key+'"]').parentsUntil("tbody","tr");if(!t.length||t.hasClass("has-error"))return;var n=function(e){return $("<div>").text(e).html()};new PNotify({title:"Config changed",text:"<strong>"+n(e.data.key)+"</strong>"+(typeof e.data.value!="undefined"?": "+n(e.data.value):"")+"<br />Reload page to view actual values",type:"info",styling:"bootstrap3"})})})
\ No newline at end of file
@@ -1,16 +1,16 @@
 package internal
 
 import (
+	"io/fs"
 	"net/http"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/config/internal/handlers"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -21,7 +21,7 @@ func (c *Component) DashboardMenu() dashboard.Menu {
 
 func (c *Component) DashboardRoutes() []dashboard.Route {
 	return []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
+		dashboard.RouteFromFS(c),
 		dashboard.NewRoute("/"+c.Name()+"/", handlers.NewManagerHandler(c)).
 			WithMethods([]string{http.MethodGet, http.MethodPost}).
 			WithAuth(true),
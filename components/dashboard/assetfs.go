@@ -20,8 +20,9 @@ const (
 type AssetsHandler struct {
 	Handler
 
-	root http.FileSystem
-	path string
+	root    http.FileSystem
+	path    string
+	noCache bool
 }
 
 func NewAssetsHandler(root http.FileSystem) *AssetsHandler {
@@ -37,6 +38,12 @@ func NewAssetsHandlerByPath(root http.FileSystem, path string) *AssetsHandler {
 	}
 }
 
+// отключает кэширование на стороне клиента, используется для режима разработки
+func (h *AssetsHandler) WithoutCache() *AssetsHandler {
+	h.noCache = true
+	return h
+}
+
 func (h *AssetsHandler) ServeHTTP(w http.ResponseWriter, r *Request) {
 	var path string
 	if h.path != "" {
@@ -90,7 +97,12 @@ func (h *AssetsHandler) ServeHTTP(w http.ResponseWriter, r *Request) {
 		w.Header().Set("Content-Type", ctype)
 	}
 
-	w.Header().Set("Cache-Control", "max-age=315360000, public, immutable")
+	if h.noCache {
+		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	} else {
+		w.Header().Set("Cache-Control", "max-age=315360000, public, immutable")
+	}
+
 	w.Header().Set("Last-Modified", d.ModTime().UTC().Format(http.TimeFormat))
 	w.Header().Set("Content-Length", strconv.FormatInt(d.Size(), 10))
 	w.WriteHeader(http.StatusOK)
@@ -101,6 +113,7 @@ func (h *AssetsHandler) ServeHTTP(w http.ResponseWriter, r *Request) {
 	}
 }
 
+// Deprecated: use HasFS
 type HasAssetFS interface {
 	AssetFS() *assetfs.AssetFS
 }
@@ -1,6 +1,8 @@
 package dashboard
 
 import (
+	"io/fs"
+
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow"
 )
@@ -9,5 +11,7 @@ type Component interface {
 	shadow.Component
 
 	Renderer() Renderer
+	Events() Events
+	RegisterFS(name string, fs fs.FS)
 	RegisterAssetFS(name string, fs *assetfs.AssetFS)
 }
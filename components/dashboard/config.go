@@ -38,4 +38,7 @@ const (
 	ConfigFrontendMinifyEnabled  = ComponentName + ".frontend.minify-enabled"
 	ConfigStartURL               = ComponentName + ".start-url"
 	ConfigPanicHandlerCallerSkip = ComponentName + ".panic-handler.caller-skip"
+	ConfigEventsKeepAlive        = ComponentName + ".events.keep-alive"
+	ConfigEventsBufferSize       = ComponentName + ".events.buffer-size"
+	ConfigDevelopmentDirectories = ComponentName + ".development.directories"
 )
@@ -7,4 +7,8 @@ const (
 	SessionUser    = "user"
 	SessionLastURL = "last-url"
 	AuthPath       = "/" + ComponentName + "/auth"
+	EventsPath     = "/" + ComponentName + "/events"
+
+	EventsTopicComponents = ComponentName + ".components"
+	EventsTopicConfig     = ComponentName + ".config"
 )
@@ -0,0 +1,25 @@
+package dashboard
+
+import (
+	"time"
+)
+
+const (
+	EventsTopicAll = "*"
+)
+
+type Event struct {
+	ID        uint64      `json:"id"`
+	Topic     string      `json:"topic"`
+	Data      interface{} `json:"data"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type Events interface {
+	// публикует событие в топик, доставка подписчикам не блокирующая,
+	// если подписчик не успевает вычитывать события, то они для него теряются
+	Publish(topic string, data interface{})
+	// подписка на топики, пустой список или EventsTopicAll означает все топики,
+	// топик с суффиксом .* подписывает на все вложенные топики
+	Subscribe(topics ...string) (<-chan Event, func())
+}
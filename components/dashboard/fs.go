@@ -0,0 +1,31 @@
+package dashboard
+
+import (
+	"io/fs"
+	"net/http"
+
+	"github.com/mrsmtvd/shadow"
+)
+
+type HasFS interface {
+	FS() fs.FS
+}
+
+func RouteFromFS(component HasFS) Route {
+	return NewRoute("/"+component.(shadow.Component).Name()+"/assets/*filepath", NewAssetsHandler(http.FS(SubFS(component.FS(), AssetFSPrefixRoute)))).
+		WithMethods([]string{http.MethodGet})
+}
+
+func TemplatesFromFS(component HasFS) fs.FS {
+	return SubFS(component.FS(), AssetFSPrefixTemplates)
+}
+
+// в отличии от fs.Sub не возвращает ошибку, при невалидной директории отдается исходная файловая система
+func SubFS(f fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(f, dir)
+	if err != nil {
+		return f
+	}
+
+	return sub
+}
@@ -0,0 +1,28 @@
+$(document).ready(function () {
+    shadowEvents.subscribe('dashboard.components', function (event) {
+        var
+            status = event.data.status,
+            cell = $('tr[data-component="' + event.data.name + '"] td.component-status'),
+            label = $('<span class="label"></span>')
+        ;
+
+        if (!cell.length) {
+            return;
+        }
+
+        switch (status) {
+            case 'ready':
+            case 'finished':
+                label.addClass('label-success');
+                break;
+            case 'unknown':
+                label.addClass('label-warning');
+                break;
+            default:
+                label.addClass('label-danger');
+        }
+
+        label.text(componentStatuses[status] || status);
+        cell.empty().append(label);
+    });
+});
@@ -0,0 +1 @@
+$(document).ready(function(){shadowEvents.subscribe("dashboard.components",function(e){var n=e.data.status,s=$('tr[data-component="'+e.data.name+'"] td.component-status'),t=$('<span class="label"></span>');if(!s.length)return;switch(n){case"ready":case"finished":t.addClass("label-success");break;case"unknown":t.addClass("label-warning");break;default:t.addClass("label-danger")}t.text(componentStatuses[n]||n),s.empty().append(t)})})
\ No newline at end of file
@@ -0,0 +1,89 @@
+var shadowEvents = (function () {
+    var path = '/dashboard/events';
+
+    function url(topics, protocol) {
+        var query = $.map(topics || [], function (topic) {
+            return 'topic=' + encodeURIComponent(topic);
+        }).join('&');
+
+        return protocol + path + (query ? '?' + query : '');
+    }
+
+    function dispatch(raw, callback) {
+        var event;
+
+        try {
+            event = JSON.parse(raw);
+        } catch (e) {
+            return;
+        }
+
+        // keep-alive сообщения не содержат топика
+        if (!event || !event.topic) {
+            return;
+        }
+
+        callback(event);
+    }
+
+    function subscribeWebSocket(topics, callback) {
+        var
+            address = url(topics, (window.location.protocol === 'https:' ? 'wss://' : 'ws://') + window.location.host),
+            closed = false,
+            socket
+        ;
+
+        function connect() {
+            socket = new WebSocket(address);
+
+            socket.onmessage = function (e) {
+                dispatch(e.data, callback);
+            };
+
+            socket.onclose = function () {
+                if (!closed) {
+                    setTimeout(connect, 3000);
+                }
+            };
+        }
+
+        connect();
+
+        return {
+            close: function () {
+                closed = true;
+                socket.close();
+            }
+        };
+    }
+
+    return {
+        subscribe: function (topics, callback) {
+            if (typeof topics === 'string') {
+                topics = [topics];
+            }
+
+            if (window.EventSource) {
+                var source = new EventSource(url(topics, ''));
+
+                source.onmessage = function (e) {
+                    dispatch(e.data, callback);
+                };
+
+                return {
+                    close: function () {
+                        source.close();
+                    }
+                };
+            }
+
+            if (window.WebSocket) {
+                return subscribeWebSocket(topics, callback);
+            }
+
+            return {
+                close: function () {}
+            };
+        }
+    };
+})();
@@ -0,0 +1 @@
+var shadowEvents=function(){var n="/dashboard/events";function e(e,t){var s=$.map(e||[],function(e){return"topic="+encodeURIComponent(e)}).join("&");return t+n+(s?"?"+s:"")}function t(e,t){var n;try{n=JSON.parse(e)}catch{return}if(!n||!n.topic)return;t(n)}function s(n,s){var o,r=e(n,(window.location.protocol==="https:"?"wss://":"ws://")+window.location.host),i=!1;function a(){o=new WebSocket(r),o.onmessage=function(e){t(e.data,s)},o.onclose=function(){i||setTimeout(a,3e3)}}return a(),{close:function(){i=!0,o.close()}}}return{subscribe:function(n,o){if(typeof n=="string"&&(n=[n]),window.EventSource){var i=new EventSource(e(n,""));return i.onmessage=function(e){t(e.data,o)},{close:function(){i.close()}}}return window.WebSocket?s(n,o):{close:function(){}}}}}()
\ No newline at end of file
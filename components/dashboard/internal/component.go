@@ -14,6 +14,7 @@ import (
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/i18n"
 	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/misc/listener"
 )
 
 type Component struct {
@@ -24,6 +25,7 @@ type Component struct {
 	config         config.Component
 	logger         logging.Logger
 	renderer       *Renderer
+	events         *Events
 	sessionManager *scs.SessionManager
 	router         *Router
 	server         *http.Server
@@ -58,6 +60,7 @@ func (c *Component) Init(a shadow.Application) (err error) {
 	c.application = a
 	c.config = a.GetComponent(config.ComponentName).(config.Component)
 	c.renderer = NewRenderer()
+	c.events = NewEvents()
 	c.registryAssetFS = new(sync.Map)
 
 	return nil
@@ -75,6 +78,7 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) (err error)
 	c.router = NewRouter(c.logger, c.config.Int(dashboard.ConfigPanicHandlerCallerSkip))
 
 	c.initAssetFS()
+	c.initEvents()
 
 	if err := c.initTemplates(); err != nil {
 		return err
@@ -92,7 +96,8 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) (err error)
 	}
 
 	addr := net.JoinHostPort(c.config.String(dashboard.ConfigHost), c.config.String(dashboard.ConfigPort))
-	lis, err := net.Listen("tcp", addr)
+	// при перезапуске сокет наследуется от родительского процесса, чтобы порт не закрывался
+	lis, err := listener.Listen(c.Name(), "tcp", addr)
 
 	if err != nil {
 		return fmt.Errorf("failed to listen [%d]: %s", os.Getpid(), err.Error())
@@ -119,6 +124,9 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) (err error)
 }
 
 func (c *Component) Shutdown() error {
+	// закрываем подписки, иначе долгоживущие соединения не дадут завершить сервер
+	c.events.Close()
+
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
@@ -178,6 +178,22 @@ func (c *Component) ConfigVariables() []config.Variable {
 			WithUsage("Skip number of callers in panic handler").
 			WithGroup("Develop mode").
 			WithDefault(DefaultCallerSkip),
+		config.NewVariable(dashboard.ConfigEventsKeepAlive, config.ValueTypeDuration).
+			WithUsage("Interval of keep alive messages in live updates connections").
+			WithGroup("Live updates").
+			WithEditable(true).
+			WithDefault(15 * time.Second),
+		config.NewVariable(dashboard.ConfigEventsBufferSize, config.ValueTypeInt).
+			WithUsage("Size of events buffer for each live updates connection").
+			WithGroup("Live updates").
+			WithEditable(true).
+			WithDefault(DefaultEventsBufferSize),
+		config.NewVariable(dashboard.ConfigDevelopmentDirectories, config.ValueTypeString).
+			WithUsage("Directories with templates and assets of components in format component=path, used only in debug mode").
+			WithGroup("Development").
+			WithEditable(true).
+			WithView([]string{config.ViewTags}).
+			WithViewOptions(map[string]interface{}{config.ViewOptionTagsDefaultText: "add a directory"}),
 		config.NewVariable(dashboard.ConfigStartURL, config.ValueTypeString).
 			WithUsage("Start URL").
 			WithDefault("/" + c.Name()),
@@ -207,6 +223,8 @@ func (c *Component) ConfigWatchers() []config.Watcher {
 			dashboard.ConfigOAuth2GplusScopes,
 		}, c.watchAuth),
 		config.NewWatcher([]string{dashboard.ConfigPanicHandlerCallerSkip}, c.watchPanicHandlerCallerSkip),
+		config.NewWatcher([]string{dashboard.ConfigEventsBufferSize}, c.watchEventsBufferSize),
+		config.NewWatcher([]string{config.WatcherForAll}, c.watchEventsConfig),
 	}
 }
 
@@ -217,3 +235,7 @@ func (c *Component) watchAuth(_ string, _ interface{}, _ interface{}) {
 func (c *Component) watchPanicHandlerCallerSkip(_ string, v interface{}, _ interface{}) {
 	c.router.SetPanicHandlerCallerSkip(int(v.(int64)))
 }
+
+func (c *Component) watchEventsBufferSize(_ string, v interface{}, _ interface{}) {
+	c.events.SetBufferSize(v.(int))
+}
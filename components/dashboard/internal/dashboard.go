@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"io/fs"
 	"net/http"
 	"net/url"
 	"path"
@@ -13,7 +14,6 @@ import (
 	"strings"
 
 	"github.com/Masterminds/sprig/v3"
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/dashboard/internal/handlers"
@@ -21,8 +21,8 @@ import (
 	"github.com/mrsmtvd/shadow/misc/time"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -46,8 +46,8 @@ func (c *Component) DashboardMenu() dashboard.Menu {
 
 func (c *Component) DashboardRoutes() []dashboard.Route {
 	routes := []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
-		dashboard.NewRoute("/favicon.ico", dashboard.NewAssetsHandlerByPath(c.AssetFS(), "images/favicon.svg")).
+		dashboard.RouteFromFS(c),
+		dashboard.NewRoute("/favicon.ico", dashboard.NewAssetsHandlerByPath(http.FS(dashboard.SubFS(c.FS(), dashboard.AssetFSPrefixRoute)), "images/favicon.svg")).
 			WithMethods([]string{http.MethodGet}),
 		dashboard.NewRoute("/"+c.Name()+"/assetfs", handlers.NewAssetFSHandler(c.registryAssetFS, c.application.BuildDate())).
 			WithMethods([]string{http.MethodGet}).
@@ -77,6 +77,9 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 		dashboard.NewRoute("/"+c.Name()+"/session", &handlers.SessionHandler{}).
 			WithMethods([]string{http.MethodGet}).
 			WithAuth(true),
+		dashboard.NewRoute(dashboard.EventsPath, handlers.NewEventsHandler(c.events)).
+			WithMethods([]string{http.MethodGet}).
+			WithAuth(true),
 		dashboard.NewRoute("/healthcheck/:healthcheck", handlers.NewHealthCheckHandler(c.components, metricHealthCheckStatus)).
 			WithMethods([]string{http.MethodGet}),
 	}
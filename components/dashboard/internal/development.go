@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/mrsmtvd/shadow/components/config"
+	"github.com/mrsmtvd/shadow/components/dashboard"
+)
+
+type developmentAssetsHandler struct {
+	dashboard.Handler
+
+	component *Component
+	namespace string
+	fallback  *dashboard.AssetsHandler
+}
+
+func (h *developmentAssetsHandler) ServeHTTP(w http.ResponseWriter, r *dashboard.Request) {
+	if f := h.component.developmentFS(h.namespace); f != nil {
+		dashboard.NewAssetsHandler(http.FS(dashboard.SubFS(f, dashboard.AssetFSPrefixRoute))).WithoutCache().ServeHTTP(w, r)
+
+		return
+	}
+
+	h.fallback.ServeHTTP(w, r)
+}
+
+func (c *Component) developmentDirectory(ns string) string {
+	if !c.config.Bool(config.ConfigDebug) {
+		return ""
+	}
+
+	for _, item := range strings.Split(c.config.String(dashboard.ConfigDevelopmentDirectories), ",") {
+		parts := strings.SplitN(strings.TrimSpace(item), "=", 2)
+
+		if len(parts) == 2 && parts[0] == ns && parts[1] != "" {
+			return parts[1]
+		}
+	}
+
+	return ""
+}
+
+// в режиме отладки возвращает файловую систему из директории на диске, если она указана для компонента
+func (c *Component) developmentFS(ns string) fs.FS {
+	if dir := c.developmentDirectory(ns); dir != "" {
+		return os.DirFS(dir)
+	}
+
+	return nil
+}
+
+func (c *Component) developmentTemplatesFS(ns string) fs.FS {
+	if f := c.developmentFS(ns); f != nil {
+		return dashboard.SubFS(f, dashboard.AssetFSPrefixTemplates)
+	}
+
+	return nil
+}
+
+func (c *Component) developmentRoute(route dashboard.Route, ns string) dashboard.Route {
+	handler, ok := route.Handler().(*dashboard.AssetsHandler)
+	if !ok || route.Path() != "/"+ns+"/assets/*filepath" {
+		return route
+	}
+
+	return dashboard.NewRoute(route.Path(), &developmentAssetsHandler{
+		component: c,
+		namespace: ns,
+		fallback:  handler,
+	}).
+		WithHandlerName(route.HandlerName()).
+		WithMethods(route.Methods()).
+		WithAuth(route.Auth())
+}
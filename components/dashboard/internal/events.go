@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mrsmtvd/shadow"
+	"github.com/mrsmtvd/shadow/components/config"
+	"github.com/mrsmtvd/shadow/components/dashboard"
+)
+
+const (
+	DefaultEventsBufferSize = 64
+)
+
+type eventsSubscriber struct {
+	ch     chan dashboard.Event
+	topics []string
+}
+
+func (s *eventsSubscriber) match(topic string) bool {
+	if len(s.topics) == 0 {
+		return true
+	}
+
+	for _, t := range s.topics {
+		switch {
+		case t == dashboard.EventsTopicAll, t == topic:
+			return true
+		case strings.HasSuffix(t, ".*") && strings.HasPrefix(topic, t[:len(t)-1]):
+			return true
+		}
+	}
+
+	return false
+}
+
+type Events struct {
+	// поля для atomic идут первыми, чтобы на 32-битных платформах они были выровнены по 64 битам
+	sequence   uint64
+	bufferSize int64
+
+	mutex       sync.RWMutex
+	subscribers map[*eventsSubscriber]struct{}
+	closed      bool
+}
+
+func NewEvents() *Events {
+	return &Events{
+		bufferSize:  DefaultEventsBufferSize,
+		subscribers: make(map[*eventsSubscriber]struct{}),
+	}
+}
+
+func (e *Events) SetBufferSize(size int) {
+	if size <= 0 {
+		size = DefaultEventsBufferSize
+	}
+
+	atomic.StoreInt64(&e.bufferSize, int64(size))
+}
+
+func (e *Events) Publish(topic string, data interface{}) {
+	event := dashboard.Event{
+		ID:        atomic.AddUint64(&e.sequence, 1),
+		Topic:     topic,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	for s := range e.subscribers {
+		if !s.match(topic) {
+			continue
+		}
+
+		select {
+		case s.ch <- event:
+		default:
+		}
+	}
+}
+
+func (e *Events) Subscribe(topics ...string) (<-chan dashboard.Event, func()) {
+	s := &eventsSubscriber{
+		ch:     make(chan dashboard.Event, atomic.LoadInt64(&e.bufferSize)),
+		topics: topics,
+	}
+
+	e.mutex.Lock()
+	if e.closed {
+		close(s.ch)
+	} else {
+		e.subscribers[s] = struct{}{}
+	}
+	e.mutex.Unlock()
+
+	var once sync.Once
+
+	return s.ch, func() {
+		once.Do(func() {
+			e.mutex.Lock()
+			if _, ok := e.subscribers[s]; ok {
+				delete(e.subscribers, s)
+				close(s.ch)
+			}
+			e.mutex.Unlock()
+		})
+	}
+}
+
+func (e *Events) Close() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.closed = true
+
+	for s := range e.subscribers {
+		delete(e.subscribers, s)
+		close(s.ch)
+	}
+}
+
+func (c *Component) Events() dashboard.Events {
+	return c.events
+}
+
+func (c *Component) initEvents() {
+	c.events.SetBufferSize(c.config.Int(dashboard.ConfigEventsBufferSize))
+
+	for _, cmp := range c.components {
+		name := cmp.Name()
+
+		go c.publishComponentStatus(name, c.application.ReadyComponent(name))
+		go c.publishComponentStatus(name, c.application.WatchComponentStatus(shadow.ComponentStatusRunFailed, name))
+		go c.publishComponentStatus(name, c.application.RunningComponent(name))
+		go c.publishComponentStatus(name, c.application.ShutdownComponent(name))
+	}
+}
+
+func (c *Component) publishComponentStatus(name string, ch <-chan struct{}) {
+	<-ch
+
+	c.events.Publish(dashboard.EventsTopicComponents, map[string]interface{}{
+		"name":   name,
+		"status": c.application.StatusComponent(name).String(),
+	})
+}
+
+func (c *Component) watchEventsConfig(key string, newValue interface{}, _ interface{}) {
+	data := map[string]interface{}{
+		"key": key,
+	}
+
+	for _, v := range c.config.Variables() {
+		if v.Key() != key {
+			continue
+		}
+
+		data["value"] = newValue
+
+		for _, view := range v.View() {
+			if view == config.ViewPassword {
+				delete(data, "value")
+				break
+			}
+		}
+
+		break
+	}
+
+	c.events.Publish(dashboard.EventsTopicConfig, data)
+}
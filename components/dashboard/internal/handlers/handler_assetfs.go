@@ -3,6 +3,7 @@ package handlers
 import (
 	"errors"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -12,7 +13,6 @@ import (
 	"sync"
 	"time"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 )
 
@@ -73,22 +73,27 @@ func (h *AssetFSHandler) getRoot() []assetFSList {
 }
 
 func (h *AssetFSHandler) getComponentByPath(name, path string) ([]assetFSList, error) {
-	var fs *assetfs.AssetFS
+	var f fs.FS
 
 	h.registry.Range(func(key, value interface{}) bool {
 		if key.(string) == name {
-			fs = value.(*assetfs.AssetFS)
+			f = value.(fs.FS)
 			return false
 		}
 
 		return true
 	})
 
-	if fs == nil {
+	if f == nil {
 		return nil, errors.New("directory " + name + " not found")
 	}
 
-	fileRoot, err := fs.Open(path)
+	path = strings.Trim(filepath.ToSlash(path), "/")
+	if path == "" {
+		path = "."
+	}
+
+	fileRoot, err := f.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -110,12 +115,9 @@ func (h *AssetFSHandler) getComponentByPath(name, path string) ([]assetFSList, e
 		}}, nil
 	}
 
-	fsDirectory, ok := statRoot.(*assetfs.AssetDirectory)
-	if !ok {
-		return nil, errors.New("failed cast " + filepath.Join(path, statRoot.Name()) + " to assetfs.AssetDirectory")
-	}
+	fileRoot.Close()
 
-	files, err := fsDirectory.Readdir(0)
+	files, err := fs.ReadDir(f, path)
 	if err != nil {
 		return nil, err
 	}
@@ -123,12 +125,7 @@ func (h *AssetFSHandler) getComponentByPath(name, path string) ([]assetFSList, e
 	ret := make([]assetFSList, 0, len(files))
 
 	for _, file := range files {
-		fileSub, err := fs.Open(filepath.Join(path, file.Name()))
-		if err != nil {
-			return nil, err
-		}
-
-		statSub, err := fileSub.Stat()
+		statSub, err := file.Info()
 		if err != nil {
 			return nil, err
 		}
@@ -140,10 +137,9 @@ func (h *AssetFSHandler) getComponentByPath(name, path string) ([]assetFSList, e
 			Mode:    statSub.Mode(),
 			ModTime: statSub.ModTime(),
 			Path:    filepath.Join("/", name, path, statSub.Name()),
-			Reader:  fileSub,
 		}
 
-		if statSub.IsDir() {
+		if statSub.IsDir() || statSub.ModTime().IsZero() {
 			if h.buildDate != nil {
 				infoSub.ModTime = *h.buildDate
 			}
@@ -194,7 +190,7 @@ func (h *AssetFSHandler) ServeHTTP(w http.ResponseWriter, r *dashboard.Request)
 
 		switch r.URL().Query().Get("mode") {
 		case "raw":
-			if len(files) == 1 {
+			if len(files) == 1 && files[0].Reader != nil {
 				if _, err := io.Copy(w, files[0].Reader); err != nil {
 					h.InternalError(w, r, err)
 				}
@@ -203,7 +199,7 @@ func (h *AssetFSHandler) ServeHTTP(w http.ResponseWriter, r *dashboard.Request)
 			}
 
 		case "file":
-			if len(files) == 1 {
+			if len(files) == 1 && files[0].Reader != nil {
 				w.Header().Set("Content-Length", strconv.FormatInt(files[0].Size, 10))
 				w.Header().Set("Content-Type", "application/x-gzip")
 				w.Header().Set("Content-Disposition", "attachment; filename="+files[0].Name)
@@ -57,7 +57,8 @@ func (h *ComponentsHandler) ServeHTTP(_ *dashboard.Response, r *dashboard.Reques
 			row["dependencies"] = deps.Dependencies()
 		}
 
-		if _, ok := cmp.(dashboard.HasAssetFS); ok {
+		switch cmp.(type) {
+		case dashboard.HasFS, dashboard.HasAssetFS:
 			row["has_assetfs"] = true
 		}
 
@@ -77,10 +78,14 @@ func (h *ComponentsHandler) ServeHTTP(_ *dashboard.Response, r *dashboard.Reques
 			row["has_dashboard_routes"] = true
 		}
 
-		if tpl, ok := cmp.(dashboard.HasTemplates); ok {
-			templates := tpl.DashboardTemplates()
+		switch tpl := cmp.(type) {
+		case dashboard.HasTemplates:
+			if tpl.DashboardTemplates() != nil {
+				row["has_dashboard_templates"] = dashboard.AssetFSPrefixTemplates
+			}
 
-			if templates != nil {
+		case dashboard.HasAssetFSTemplates:
+			if templates := tpl.DashboardTemplates(); templates != nil {
 				row["has_dashboard_templates"] = templates.Prefix
 			}
 		}
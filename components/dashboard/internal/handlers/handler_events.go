@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/logging"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	eventsDefaultKeepAlive = 15 * time.Second
+	eventsRetry            = 3 * time.Second
+)
+
+type EventsHandler struct {
+	dashboard.Handler
+
+	events dashboard.Events
+}
+
+func NewEventsHandler(events dashboard.Events) *EventsHandler {
+	return &EventsHandler{
+		events: events,
+	}
+}
+
+func (h *EventsHandler) keepAlive(r *dashboard.Request) time.Duration {
+	if d := r.Config().Duration(dashboard.ConfigEventsKeepAlive); d > 0 {
+		return d
+	}
+
+	return eventsDefaultKeepAlive
+}
+
+func (h *EventsHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
+	topics := r.URL().Query()["topic"]
+
+	if r.Original().Header.Get("Upgrade") != "" {
+		h.serveWebSocket(w, r, topics)
+		return
+	}
+
+	h.serveEventSource(w, r, topics)
+}
+
+func (h *EventsHandler) serveEventSource(w *dashboard.Response, r *dashboard.Request, topics []string) {
+	events, unsubscribe := h.events.Subscribe(topics...)
+	defer unsubscribe()
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.WriteString(w, "retry: "+strconv.FormatInt(eventsRetry.Milliseconds(), 10)+"\n\n"); err != nil {
+		return
+	}
+
+	w.Flush()
+
+	ticker := time.NewTicker(h.keepAlive(r))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				logging.Log(r.Context()).Error("Failed marshal event", "topic", event.Topic, "error", err.Error())
+				continue
+			}
+
+			if _, err = io.WriteString(w, "id: "+strconv.FormatUint(event.ID, 10)+"\ndata: "+string(data)+"\n\n"); err != nil {
+				return
+			}
+
+			w.Flush()
+
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+			w.Flush()
+		}
+	}
+}
+
+func (h *EventsHandler) serveWebSocket(w *dashboard.Response, r *dashboard.Request, topics []string) {
+	keepAlive := h.keepAlive(r)
+
+	server := websocket.Server{
+		Handshake: func(cfg *websocket.Config, req *http.Request) error {
+			// защита от подключения со сторонних сайтов с cookie пользователя
+			if cfg.Origin != nil && cfg.Origin.Host != req.Host {
+				return errors.New("origin " + cfg.Origin.String() + " not allowed")
+			}
+
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			defer conn.Close()
+
+			events, unsubscribe := h.events.Subscribe(topics...)
+			defer unsubscribe()
+
+			closed := make(chan struct{})
+
+			go func() {
+				defer close(closed)
+
+				var message string
+
+				for {
+					if err := websocket.Message.Receive(conn, &message); err != nil {
+						return
+					}
+				}
+			}()
+
+			ticker := time.NewTicker(keepAlive)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-closed:
+					return
+
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+
+					if err := websocket.JSON.Send(conn, event); err != nil {
+						return
+					}
+
+				case <-ticker.C:
+					if err := websocket.Message.Send(conn, "{}"); err != nil {
+						return
+					}
+				}
+			}
+		},
+	}
+
+	server.ServeHTTP(w, r.Original())
+}
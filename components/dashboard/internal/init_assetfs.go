@@ -1,22 +1,32 @@
 package internal
 
 import (
+	"io/fs"
+
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/misc/bindata"
 )
 
 func (c *Component) initAssetFS() {
 	for _, component := range c.components {
-		if cmp, ok := component.(dashboard.HasAssetFS); ok {
+		switch cmp := component.(type) {
+		case dashboard.HasFS:
+			c.RegisterFS(component.Name(), cmp.FS())
+		case dashboard.HasAssetFS:
 			c.RegisterAssetFS(component.Name(), cmp.AssetFS())
 		}
 	}
 }
 
+func (c *Component) RegisterFS(name string, f fs.FS) {
+	c.registryAssetFS.Store(name, f)
+}
+
 func (c *Component) RegisterAssetFS(name string, fs *assetfs.AssetFS) {
 	if fs.Prefix != "" {
 		fs.Prefix = ""
 	}
 
-	c.registryAssetFS.Store(name, fs)
+	c.RegisterFS(name, bindata.FS(fs))
 }
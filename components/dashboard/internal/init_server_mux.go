@@ -20,7 +20,7 @@ func (c *Component) initServeMux() error {
 	for _, component := range c.components {
 		if componentRoute, ok := component.(dashboard.HasRoutes); ok {
 			for _, route := range componentRoute.DashboardRoutes() {
-				c.router.addRoute(NewRouteItem(route, component))
+				c.router.addRoute(NewRouteItem(c.developmentRoute(route, component.Name()), component))
 			}
 		}
 
@@ -1,7 +1,10 @@
 package internal
 
 import (
+	"io/fs"
+
 	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/misc/bindata"
 )
 
 func (c *Component) initTemplates() error {
@@ -30,16 +33,26 @@ func (c *Component) initTemplates() error {
 		}
 	}
 
+	c.renderer.SetDevelopment(c.developmentTemplatesFS)
+
 	if err := c.renderer.AddRootTemplates(c.DashboardTemplates()); err != nil {
 		return err
 	}
 
 	for _, component := range c.components {
-		if componentTemplate, ok := component.(dashboard.HasTemplates); ok {
-			err := c.renderer.RegisterNamespace(component.Name(), componentTemplate.DashboardTemplates())
-			if err != nil {
-				return err
-			}
+		var templates fs.FS
+
+		switch cmp := component.(type) {
+		case dashboard.HasTemplates:
+			templates = cmp.DashboardTemplates()
+		case dashboard.HasAssetFSTemplates:
+			templates = bindata.FS(cmp.DashboardTemplates())
+		default:
+			continue
+		}
+
+		if err := c.renderer.RegisterNamespace(component.Name(), templates); err != nil {
+			return err
 		}
 	}
 
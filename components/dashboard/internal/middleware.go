@@ -2,6 +2,7 @@ package internal
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/mrsmtvd/shadow/components/dashboard"
@@ -33,17 +34,47 @@ func ContextMiddleware(router dashboard.Router, renderer dashboard.Renderer) fun
 
 func SessionMiddleware(sessionManager *scs.SessionManager) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		return sessionManager.LoadAndSave(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			session := NewSession(sessionManager, r)
 			ctx := dashboard.ContextWithSession(r.Context(), session)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 
 			session.Flush()
-		}))
+		})
+
+		buffered := sessionManager.LoadAndSave(handler)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !IsStreamingRequest(r) {
+				buffered.ServeHTTP(w, r)
+				return
+			}
+
+			// LoadAndSave буферизирует весь ответ, поэтому для потоковых запросов
+			// сессия только загружается и изменения в ней не сохраняются
+			var token string
+
+			if cookie, err := r.Cookie(sessionManager.Cookie.Name); err == nil {
+				token = cookie.Value
+			}
+
+			ctx, err := sessionManager.Load(r.Context(), token)
+			if err != nil {
+				sessionManager.ErrorFunc(w, r, err)
+				return
+			}
+
+			handler.ServeHTTP(w, r.WithContext(ctx))
+		})
 	}
 }
 
+func IsStreamingRequest(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
 func AuthorizationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		route := dashboard.RouteFromContext(r.Context())
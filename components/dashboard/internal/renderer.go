@@ -6,10 +6,11 @@ import (
 	"errors"
 	"html/template"
 	"io"
+	"io/fs"
+	"path"
 	"strings"
 	"sync"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/i18n"
 )
@@ -17,28 +18,29 @@ import (
 const (
 	TemplateRootName      = "_root"
 	TemplatePostfix       = ".html"
-	TemplateLayoutsDir    = "templates/layouts"
-	TemplateViewsDir      = "templates/views"
+	TemplateLayoutsDir    = "layouts"
+	TemplateViewsDir      = "views"
 	TemplateDefaultLayout = "base"
 )
 
 type templatesNamespace struct {
 	mutex     sync.RWMutex
-	fs        *assetfs.AssetFS
+	fs        fs.FS
 	templates map[string]*template.Template
 }
 
 type Renderer struct {
 	rootTemplate *template.Template
 
-	mutex      sync.RWMutex
-	globals    map[string]interface{}
-	namespaces map[string]*templatesNamespace
+	mutex       sync.RWMutex
+	globals     map[string]interface{}
+	namespaces  map[string]*templatesNamespace
+	development func(ns string) fs.FS
 }
 
-func newNamespace(fs *assetfs.AssetFS) *templatesNamespace {
+func newNamespace(f fs.FS) *templatesNamespace {
 	return &templatesNamespace{
-		fs:        fs,
+		fs:        f,
 		templates: make(map[string]*template.Template),
 	}
 }
@@ -76,25 +78,52 @@ func (r *Renderer) AddFunc(name string, f interface{}) {
 	})
 }
 
-func (r *Renderer) AddRootTemplates(fs *assetfs.AssetFS) error {
-	files, err := r.getTemplateFiles(TemplateLayoutsDir, fs)
+func (r *Renderer) AddRootTemplates(f fs.FS) error {
+	tpl, err := r.parseLayouts(r.rootTemplate, f)
 	if err != nil {
 		return err
 	}
 
-	tpl := r.rootTemplate
+	r.rootTemplate = tpl
+
+	return nil
+}
+
+// функция возвращает шаблоны с диска для пространства имен, если для него включен режим разработки,
+// в этом случае шаблоны не кэшируются и перечитываются при каждом рендеринге
+func (r *Renderer) SetDevelopment(f func(ns string) fs.FS) {
+	r.mutex.Lock()
+	r.development = f
+	r.mutex.Unlock()
+}
+
+func (r *Renderer) developmentFS(ns string) fs.FS {
+	r.mutex.RLock()
+	f := r.development
+	r.mutex.RUnlock()
+
+	if f == nil {
+		return nil
+	}
+
+	return f(ns)
+}
+
+func (r *Renderer) parseLayouts(tpl *template.Template, f fs.FS) (*template.Template, error) {
+	files, err := r.getTemplateFiles(TemplateLayoutsDir, f)
+	if err != nil {
+		return nil, err
+	}
 
 	for layout, content := range files {
 		layout = strings.TrimSuffix(layout, TemplatePostfix)
 
 		if tpl, err = tpl.New(layout).Parse(string(content)); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	r.rootTemplate = tpl
-
-	return nil
+	return tpl, nil
 }
 
 func (r *Renderer) AddGlobalVar(key string, value interface{}) {
@@ -111,13 +140,13 @@ func (r *Renderer) IsRegisterNamespace(ns string) bool {
 	return ok
 }
 
-func (r *Renderer) RegisterNamespace(ns string, fs *assetfs.AssetFS) error {
+func (r *Renderer) RegisterNamespace(ns string, f fs.FS) error {
 	if r.IsRegisterNamespace(ns) {
 		return errors.New("namesapce " + ns + " already exists")
 	}
 
 	r.mutex.Lock()
-	r.namespaces[ns] = newNamespace(fs)
+	r.namespaces[ns] = newNamespace(f)
 	r.mutex.Unlock()
 
 	return nil
@@ -179,8 +208,8 @@ func (r *Renderer) getContextVariables(ctx context.Context) map[string]interface
 	return vars
 }
 
-func (r *Renderer) getTemplateFiles(directory string, f *assetfs.AssetFS) (map[string][]byte, error) {
-	files, err := f.AssetDir(directory)
+func (r *Renderer) getTemplateFiles(directory string, f fs.FS) (map[string][]byte, error) {
+	files, err := fs.ReadDir(f, directory)
 	if err != nil {
 		return nil, err
 	}
@@ -188,16 +217,16 @@ func (r *Renderer) getTemplateFiles(directory string, f *assetfs.AssetFS) (map[s
 	templates := make(map[string][]byte)
 
 	for _, file := range files {
-		if !strings.HasSuffix(file, TemplatePostfix) {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), TemplatePostfix) {
 			continue
 		}
 
-		content, err := f.Asset(directory + "/" + file)
+		content, err := fs.ReadFile(f, path.Join(directory, file.Name()))
 		if err != nil {
 			continue
 		}
 
-		templates[file] = content
+		templates[file.Name()] = content
 	}
 
 	return templates, nil
@@ -215,12 +244,21 @@ func (r *Renderer) getLazyViewTemplate(ns, view, layout string) (*template.Templ
 	view += TemplatePostfix
 	cacheID := layout + "/" + view
 
-	tpl, ok := namespace.get(cacheID)
-	if ok {
-		return tpl, nil
+	nsFS := r.developmentFS(ns)
+	rootFS := r.developmentFS(dashboard.ComponentName)
+	development := nsFS != nil || rootFS != nil
+
+	if !development {
+		if tpl, ok := namespace.get(cacheID); ok {
+			return tpl, nil
+		}
+	}
+
+	if nsFS == nil {
+		nsFS = namespace.fs
 	}
 
-	files, err := r.getTemplateFiles(TemplateViewsDir, namespace.fs)
+	files, err := r.getTemplateFiles(TemplateViewsDir, nsFS)
 	if err != nil {
 		return nil, err
 	}
@@ -244,12 +282,18 @@ func (r *Renderer) getLazyViewTemplate(ns, view, layout string) (*template.Templ
 	}
 
 	// layouts
-	tpl, err = r.rootTemplate.Clone()
+	tpl, err := r.rootTemplate.Clone()
 	if err != nil {
 		return nil, err
 	}
 
-	if files, err := r.getTemplateFiles(TemplateLayoutsDir, namespace.fs); err == nil {
+	if rootFS != nil {
+		if tpl, err = r.parseLayouts(tpl, rootFS); err != nil {
+			return nil, err
+		}
+	}
+
+	if files, err := r.getTemplateFiles(TemplateLayoutsDir, nsFS); err == nil {
 		for l, body := range files {
 			l = strings.TrimSuffix(l, TemplatePostfix)
 			if l != layout {
@@ -272,7 +316,9 @@ func (r *Renderer) getLazyViewTemplate(ns, view, layout string) (*template.Templ
 		return nil, err
 	}
 
-	namespace.set(cacheID, tpl)
+	if !development {
+		namespace.set(cacheID, tpl)
+	}
 
 	return tpl, nil
 }
@@ -3,13 +3,14 @@ package dashboard
 import (
 	"context"
 	"io"
+	"io/fs"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 )
 
 type Renderer interface {
 	IsRegisterNamespace(ns string) bool
-	RegisterNamespace(ns string, fs *assetfs.AssetFS) error
+	RegisterNamespace(ns string, fs fs.FS) error
 	Render(ctx context.Context, wr io.Writer, ns, view string, data map[string]interface{}) error
 	RenderAndReturn(ctx context.Context, ns, view string, data map[string]interface{}) (string, error)
 	RenderLayout(ctx context.Context, wr io.Writer, ns, view, layout string, data map[string]interface{}) error
@@ -17,6 +18,11 @@ type Renderer interface {
 }
 
 type HasTemplates interface {
+	DashboardTemplates() fs.FS
+}
+
+// Deprecated: use HasTemplates
+type HasAssetFSTemplates interface {
 	DashboardTemplates() *assetfs.AssetFS
 }
 
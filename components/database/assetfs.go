@@ -2,9 +2,11 @@ package database
 
 import (
 	"bytes"
-	"path/filepath"
+	"io/fs"
+	"path"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
+	"github.com/mrsmtvd/shadow/misc/bindata"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
@@ -12,35 +14,40 @@ const (
 	MigrationFileExt = ".sql"
 )
 
+// Deprecated: use MigrationsFromFS
 func MigrationsFromAsset(fs *assetfs.AssetFS) []Migration {
-	root, err := fs.Open("")
-	if err != nil {
-		return nil
-	}
+	return MigrationsFromFS(bindata.FS(fs), ".")
+}
 
-	files, err := root.Readdir(0)
+func MigrationsFromFS(f fs.FS, dir string) []Migration {
+	files, err := fs.ReadDir(f, dir)
 	if err != nil {
 		return nil
 	}
 
 	migrations := make([]Migration, 0)
 
-	for _, f := range files {
-		if f.IsDir() || filepath.Ext(f.Name()) != MigrationFileExt {
+	for _, file := range files {
+		if file.IsDir() || path.Ext(file.Name()) != MigrationFileExt {
+			continue
+		}
+
+		content, err := fs.ReadFile(f, path.Join(dir, file.Name()))
+		if err != nil {
 			continue
 		}
 
-		content, err := fs.Asset(filepath.Join(fs.Prefix, f.Name()))
+		info, err := file.Info()
 		if err != nil {
 			continue
 		}
 
-		migration, err := migrate.ParseMigration(f.Name(), bytes.NewReader(content))
+		migration, err := migrate.ParseMigration(file.Name(), bytes.NewReader(content))
 		if err != nil {
 			return nil
 		}
 
-		migrations = append(migrations, NewMigration(migration.Id, migration.Up, migration.Down, f.ModTime()))
+		migrations = append(migrations, NewMigration(migration.Id, migration.Up, migration.Down, info.ModTime()))
 	}
 
 	return migrations
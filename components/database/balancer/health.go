@@ -0,0 +1,286 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+)
+
+const (
+	DefaultHealthCheckInterval = time.Second * 10
+	DefaultHealthCheckTimeout  = time.Second * 2
+	DefaultEjectBackoffMin     = time.Second * 5
+	DefaultEjectBackoffMax     = time.Minute * 5
+)
+
+type ReplicaState struct {
+	Executor     database.Executor
+	Healthy      bool
+	Failures     uint64
+	Ejections    uint64
+	LastCheck    time.Time
+	LastError    error
+	EjectedUntil time.Time
+}
+
+// Обертка над балансировщиком, которая периодически пингует исполнителей
+// и исключает недоступных из балансировки с экспоненциально растущей паузой.
+// Если доступных исполнителей нет, Get возвращает nil
+type Health struct {
+	mutex    sync.RWMutex
+	balancer database.Balancer
+	replicas []*ReplicaState
+
+	interval   time.Duration
+	timeout    time.Duration
+	backoffMin time.Duration
+	backoffMax time.Duration
+
+	reset chan struct{}
+	done  chan struct{}
+	once  sync.Once
+}
+
+func NewHealth(balancer database.Balancer) *Health {
+	h := &Health{
+		balancer:   balancer,
+		replicas:   make([]*ReplicaState, 0),
+		interval:   DefaultHealthCheckInterval,
+		timeout:    DefaultHealthCheckTimeout,
+		backoffMin: DefaultEjectBackoffMin,
+		backoffMax: DefaultEjectBackoffMax,
+		reset:      make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+
+	go h.loop()
+
+	return h
+}
+
+func (h *Health) Balancer() database.Balancer {
+	return h.balancer
+}
+
+func (h *Health) SetCheckInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultHealthCheckInterval
+	}
+
+	h.mutex.Lock()
+	h.interval = interval
+	h.mutex.Unlock()
+
+	select {
+	case h.reset <- struct{}{}:
+	default:
+	}
+}
+
+func (h *Health) SetCheckTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultHealthCheckTimeout
+	}
+
+	h.mutex.Lock()
+	h.timeout = timeout
+	h.mutex.Unlock()
+}
+
+func (h *Health) SetEjectBackoff(min, max time.Duration) {
+	if min <= 0 {
+		min = DefaultEjectBackoffMin
+	}
+
+	if max < min {
+		max = min
+	}
+
+	h.mutex.Lock()
+	h.backoffMin = min
+	h.backoffMax = max
+	h.mutex.Unlock()
+}
+
+func (h *Health) Get() database.Executor {
+	return h.balancer.Get()
+}
+
+func (h *Health) Set(executors []database.Executor) {
+	h.mutex.Lock()
+
+	replicas := make([]*ReplicaState, 0, len(executors))
+
+	for _, executor := range executors {
+		var state *ReplicaState
+
+		// состояние уже известных исполнителей сохраняется
+		for _, replica := range h.replicas {
+			if replica.Executor == executor {
+				state = replica
+				break
+			}
+		}
+
+		if state == nil {
+			state = &ReplicaState{
+				Executor: executor,
+				Healthy:  true,
+			}
+		}
+
+		replicas = append(replicas, state)
+	}
+
+	h.replicas = replicas
+	h.mutex.Unlock()
+
+	h.apply()
+}
+
+func (h *Health) Replicas() []ReplicaState {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	list := make([]ReplicaState, 0, len(h.replicas))
+	for _, replica := range h.replicas {
+		list = append(list, *replica)
+	}
+
+	return list
+}
+
+func (h *Health) Close() error {
+	h.once.Do(func() {
+		close(h.done)
+	})
+
+	return nil
+}
+
+func (h *Health) Check() {
+	h.mutex.RLock()
+	timeout := h.timeout
+	replicas := make([]*ReplicaState, 0, len(h.replicas))
+
+	now := time.Now()
+	for _, replica := range h.replicas {
+		if replica.Healthy || !now.Before(replica.EjectedUntil) {
+			replicas = append(replicas, replica)
+		}
+	}
+	h.mutex.RUnlock()
+
+	if len(replicas) == 0 {
+		return
+	}
+
+	errs := make([]error, len(replicas))
+
+	var wg sync.WaitGroup
+
+	for i, replica := range replicas {
+		wg.Add(1)
+
+		go func(i int, executor database.Executor) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			errs[i] = executor.Ping(ctx)
+		}(i, replica.Executor)
+	}
+
+	wg.Wait()
+
+	changed := false
+	now = time.Now()
+
+	h.mutex.Lock()
+	for i, replica := range replicas {
+		replica.LastCheck = now
+		replica.LastError = errs[i]
+
+		if errs[i] == nil {
+			if !replica.Healthy {
+				changed = true
+			}
+
+			replica.Healthy = true
+			replica.Failures = 0
+			replica.EjectedUntil = time.Time{}
+
+			continue
+		}
+
+		if replica.Healthy {
+			changed = true
+			replica.Ejections++
+		}
+
+		replica.Healthy = false
+		replica.Failures++
+		replica.EjectedUntil = now.Add(h.backoff(replica.Failures))
+	}
+	h.mutex.Unlock()
+
+	if changed {
+		h.apply()
+	}
+}
+
+func (h *Health) backoff(failures uint64) time.Duration {
+	backoff := h.backoffMin
+
+	for i := uint64(1); i < failures && backoff < h.backoffMax; i++ {
+		backoff *= 2
+	}
+
+	if backoff > h.backoffMax {
+		backoff = h.backoffMax
+	}
+
+	return backoff
+}
+
+// передает во вложенный балансировщик только доступных исполнителей
+func (h *Health) apply() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	executors := make([]database.Executor, 0, len(h.replicas))
+
+	for _, replica := range h.replicas {
+		if replica.Healthy {
+			executors = append(executors, replica.Executor)
+		}
+	}
+
+	h.balancer.Set(executors)
+}
+
+func (h *Health) loop() {
+	h.mutex.RLock()
+	ticker := time.NewTicker(h.interval)
+	h.mutex.RUnlock()
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.Check()
+
+		case <-h.reset:
+			h.mutex.RLock()
+			ticker.Reset(h.interval)
+			h.mutex.RUnlock()
+
+		case <-h.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package balancer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/stretchr/testify/assert"
+)
+
+type testExecutor struct {
+	database.Executor
+
+	name    string
+	address string
+	db      *sql.DB
+	down    int64
+	pings   int64
+}
+
+func newTestExecutor(name string) *testExecutor {
+	return &testExecutor{
+		name:    name,
+		address: name + ":3306",
+	}
+}
+
+func (e *testExecutor) String() string {
+	return e.name
+}
+
+func (e *testExecutor) ServerAddress() string {
+	return e.address
+}
+
+func (e *testExecutor) DB() *sql.DB {
+	return e.db
+}
+
+func (e *testExecutor) Ping(context.Context) error {
+	atomic.AddInt64(&e.pings, 1)
+
+	if atomic.LoadInt64(&e.down) == 1 {
+		return errors.New("connection refused")
+	}
+
+	return nil
+}
+
+func (e *testExecutor) setDown(down bool) {
+	if down {
+		atomic.StoreInt64(&e.down, 1)
+	} else {
+		atomic.StoreInt64(&e.down, 0)
+	}
+}
+
+func (e *testExecutor) pinged() int64 {
+	return atomic.LoadInt64(&e.pings)
+}
+
+// testBalancer запоминает переданных исполнителей и всегда возвращает первого
+type testBalancer struct {
+	mutex     sync.Mutex
+	executors []database.Executor
+}
+
+func (b *testBalancer) Get() database.Executor {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if len(b.executors) == 0 {
+		return nil
+	}
+
+	return b.executors[0]
+}
+
+func (b *testBalancer) Set(executors []database.Executor) {
+	b.mutex.Lock()
+	b.executors = executors
+	b.mutex.Unlock()
+}
+
+func (b *testBalancer) list() []database.Executor {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.executors
+}
+
+func newTestHealth(t *testing.T, balancer database.Balancer) *Health {
+	h := NewHealth(balancer)
+	t.Cleanup(func() {
+		_ = h.Close()
+	})
+
+	return h
+}
+
+func TestHealth_Check_EjectsFailedReplica(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	first := newTestExecutor("first")
+	second := newTestExecutor("second")
+	balancer := &testBalancer{}
+
+	h := newTestHealth(t, balancer)
+	h.Set([]database.Executor{first, second})
+	a.Equal([]database.Executor{first, second}, balancer.list())
+
+	first.setDown(true)
+	h.Check()
+
+	a.Equal([]database.Executor{second}, balancer.list())
+	a.Equal(second, h.Get())
+
+	replicas := h.Replicas()
+	if a.Len(replicas, 2) {
+		a.False(replicas[0].Healthy)
+		a.Equal(uint64(1), replicas[0].Failures)
+		a.Equal(uint64(1), replicas[0].Ejections)
+		a.Error(replicas[0].LastError)
+		a.True(replicas[0].EjectedUntil.After(time.Now()))
+
+		a.True(replicas[1].Healthy)
+		a.NoError(replicas[1].LastError)
+	}
+
+	second.setDown(true)
+	h.Check()
+
+	a.Empty(balancer.list())
+	a.Nil(h.Get())
+}
+
+func TestHealth_Check_EjectedReplicaNotPingedUntilBackoff(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := newTestExecutor("replica")
+	balancer := &testBalancer{}
+
+	h := newTestHealth(t, balancer)
+	h.SetEjectBackoff(time.Hour, time.Hour)
+	h.Set([]database.Executor{executor})
+
+	executor.setDown(true)
+	h.Check()
+	a.Equal(int64(1), executor.pinged())
+
+	executor.setDown(false)
+	h.Check()
+	a.Equal(int64(1), executor.pinged())
+	a.Empty(balancer.list())
+
+	// пауза истекла, исполнитель снова проверяется и возвращается в балансировку
+	h.mutex.Lock()
+	h.replicas[0].EjectedUntil = time.Now().Add(-time.Second)
+	h.mutex.Unlock()
+
+	h.Check()
+	a.Equal(int64(2), executor.pinged())
+	a.Equal([]database.Executor{executor}, balancer.list())
+
+	replicas := h.Replicas()
+	if a.Len(replicas, 1) {
+		a.True(replicas[0].Healthy)
+		a.Equal(uint64(0), replicas[0].Failures)
+		a.Equal(uint64(1), replicas[0].Ejections)
+		a.True(replicas[0].EjectedUntil.IsZero())
+	}
+}
+
+func TestHealth_Check_BackoffGrowsWithFailures(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := newTestExecutor("replica")
+	executor.setDown(true)
+
+	h := newTestHealth(t, &testBalancer{})
+	h.SetEjectBackoff(time.Minute, time.Minute*5)
+	h.Set([]database.Executor{executor})
+
+	expected := []time.Duration{time.Minute, time.Minute * 2, time.Minute * 4, time.Minute * 5, time.Minute * 5}
+
+	for i, backoff := range expected {
+		h.mutex.Lock()
+		h.replicas[0].EjectedUntil = time.Time{}
+		h.mutex.Unlock()
+
+		startAt := time.Now()
+		h.Check()
+
+		replica := h.Replicas()[0]
+		a.Equal(uint64(i+1), replica.Failures)
+		a.Equal(uint64(1), replica.Ejections)
+		a.WithinDuration(startAt.Add(backoff), replica.EjectedUntil, time.Second, "failure %d", i+1)
+	}
+}
+
+func TestHealth_Set_KeepsStateOfKnownReplicas(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	first := newTestExecutor("first")
+	second := newTestExecutor("second")
+	balancer := &testBalancer{}
+
+	h := newTestHealth(t, balancer)
+	h.Set([]database.Executor{first})
+
+	first.setDown(true)
+	h.Check()
+
+	h.Set([]database.Executor{first, second})
+	a.Equal([]database.Executor{second}, balancer.list())
+
+	replicas := h.Replicas()
+	if a.Len(replicas, 2) {
+		a.False(replicas[0].Healthy)
+		a.True(replicas[1].Healthy)
+	}
+}
+
+func TestHealth_SetEjectBackoff_Defaults(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHealth(t, &testBalancer{})
+
+	h.SetEjectBackoff(0, 0)
+	assert.Equal(t, DefaultEjectBackoffMin, h.backoff(1))
+
+	h.SetEjectBackoff(time.Minute, time.Second)
+	assert.Equal(t, time.Minute, h.backoff(10))
+}
@@ -0,0 +1,62 @@
+package balancer
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/mrsmtvd/shadow/components/database"
+)
+
+type LeastConnections struct {
+	// поле для atomic идет первым, чтобы на 32-битных платформах оно было выровнено по 64 битам
+	index uint64
+
+	mutex     sync.RWMutex
+	executors []database.Executor
+}
+
+func NewLeastConnections() *LeastConnections {
+	return &LeastConnections{}
+}
+
+func (b *LeastConnections) Get() database.Executor {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	l := len(b.executors)
+	if l == 0 {
+		return nil
+	}
+
+	// начинаем обход со смещением, чтобы при равной нагрузке исполнители чередовались
+	offset := int(atomic.AddUint64(&b.index, 1) % uint64(l))
+
+	var (
+		best            database.Executor
+		bestConnections = -1
+	)
+
+	for i := 0; i < l; i++ {
+		executor := b.executors[(offset+i)%l]
+		connections := executorConnections(executor)
+
+		if best == nil || connections < bestConnections {
+			best = executor
+			bestConnections = connections
+		}
+	}
+
+	return best
+}
+
+func (b *LeastConnections) Set(executors []database.Executor) {
+	b.mutex.Lock()
+	b.executors = executors
+	b.mutex.Unlock()
+
+	atomic.StoreUint64(&b.index, 0)
+}
+
+func executorConnections(executor database.Executor) int {
+	return executor.DB().Stats().InUse
+}
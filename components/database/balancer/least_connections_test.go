@@ -0,0 +1,120 @@
+package balancer
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConnDriverName = "shadow-balancer-fake-conn"
+
+type testConnDriver struct{}
+
+func (testConnDriver) Open(string) (driver.Conn, error) {
+	return testConn{}, nil
+}
+
+type testConn struct{}
+
+func (testConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (testConn) Close() error {
+	return nil
+}
+
+func (testConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func init() {
+	sql.Register(testConnDriverName, testConnDriver{})
+}
+
+// newTestExecutorWithConnections исполнитель, у пула которого занято inUse соединений
+func newTestExecutorWithConnections(t *testing.T, name string, inUse int) *testExecutor {
+	db, err := sql.Open(testConnDriverName, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*sql.Conn, 0, inUse)
+
+	for i := 0; i < inUse; i++ {
+		conn, err := db.Conn(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		conns = append(conns, conn)
+	}
+
+	t.Cleanup(func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+
+		_ = db.Close()
+	})
+
+	executor := newTestExecutor(name)
+	executor.db = db
+
+	return executor
+}
+
+func TestLeastConnections_Get_LeastBusy(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	busy := newTestExecutorWithConnections(t, "busy", 3)
+	idle := newTestExecutorWithConnections(t, "idle", 0)
+	loaded := newTestExecutorWithConnections(t, "loaded", 1)
+
+	b := NewLeastConnections()
+	b.Set([]database.Executor{busy, idle, loaded})
+
+	for i := 0; i < 5; i++ {
+		a.Equal(idle, b.Get())
+	}
+
+	conn, err := idle.DB().Conn(context.Background())
+	a.NoError(err)
+
+	conn2, err := idle.DB().Conn(context.Background())
+	a.NoError(err)
+
+	a.Equal(loaded, b.Get())
+
+	a.NoError(conn.Close())
+	a.NoError(conn2.Close())
+}
+
+func TestLeastConnections_Get_EqualLoadAlternates(t *testing.T) {
+	t.Parallel()
+
+	first := newTestExecutorWithConnections(t, "first", 0)
+	second := newTestExecutorWithConnections(t, "second", 0)
+
+	b := NewLeastConnections()
+	b.Set([]database.Executor{first, second})
+
+	counts := make(map[string]int)
+	for i := 0; i < 10; i++ {
+		counts[b.Get().String()]++
+	}
+
+	assert.Equal(t, map[string]int{"first": 5, "second": 5}, counts)
+}
+
+func TestLeastConnections_Get_Empty(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewLeastConnections().Get())
+}
@@ -0,0 +1,89 @@
+package balancer
+
+import (
+	"sync"
+
+	"github.com/mrsmtvd/shadow/components/database"
+)
+
+type weightedExecutor struct {
+	executor database.Executor
+	weight   int
+	current  int
+}
+
+// Smooth weighted round robin, вес ищется по адресу сервера,
+// для исполнителей без веса используется DefaultWeight
+type Weighted struct {
+	mutex     sync.Mutex
+	weights   map[string]int
+	executors []*weightedExecutor
+	total     int
+}
+
+const DefaultWeight = 1
+
+func NewWeighted(weights map[string]int) *Weighted {
+	if weights == nil {
+		weights = make(map[string]int)
+	}
+
+	return &Weighted{
+		weights: weights,
+	}
+}
+
+func (b *Weighted) Get() database.Executor {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var best *weightedExecutor
+
+	for _, e := range b.executors {
+		e.current += e.weight
+
+		if best == nil || e.current > best.current {
+			best = e
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	best.current -= b.total
+
+	return best.executor
+}
+
+func (b *Weighted) Set(executors []database.Executor) {
+	list := make([]*weightedExecutor, 0, len(executors))
+	total := 0
+
+	for _, executor := range executors {
+		weight, ok := b.weights[executorAddress(executor)]
+		if !ok || weight <= 0 {
+			weight = DefaultWeight
+		}
+
+		list = append(list, &weightedExecutor{
+			executor: executor,
+			weight:   weight,
+		})
+
+		total += weight
+	}
+
+	b.mutex.Lock()
+	b.executors = list
+	b.total = total
+	b.mutex.Unlock()
+}
+
+func executorAddress(executor database.Executor) string {
+	if e, ok := executor.(interface{ ServerAddress() string }); ok {
+		return e.ServerAddress()
+	}
+
+	return executor.String()
+}
@@ -0,0 +1,68 @@
+package balancer
+
+import (
+	"testing"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeighted_Get_SmoothDistribution(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	heavy := newTestExecutor("heavy")
+	light := newTestExecutor("light")
+	other := newTestExecutor("other")
+
+	b := NewWeighted(map[string]int{
+		heavy.ServerAddress(): 5,
+		light.ServerAddress(): 1,
+	})
+	b.Set([]database.Executor{heavy, light, other})
+
+	sequence := make([]string, 0, 7)
+	for i := 0; i < 7; i++ {
+		sequence = append(sequence, b.Get().String())
+	}
+
+	// за один цикл каждый исполнитель выбирается по своему весу, тяжелый не подряд
+	a.Equal([]string{"heavy", "heavy", "light", "heavy", "other", "heavy", "heavy"}, sequence)
+
+	counts := make(map[string]int)
+	for i := 0; i < 70; i++ {
+		counts[b.Get().String()]++
+	}
+
+	a.Equal(map[string]int{"heavy": 50, "light": 10, "other": 10}, counts)
+}
+
+func TestWeighted_Get_WrongWeightAsDefault(t *testing.T) {
+	t.Parallel()
+
+	first := newTestExecutor("first")
+	second := newTestExecutor("second")
+
+	b := NewWeighted(map[string]int{
+		first.ServerAddress(): -3,
+	})
+	b.Set([]database.Executor{first, second})
+
+	counts := make(map[string]int)
+	for i := 0; i < 10; i++ {
+		counts[b.Get().String()]++
+	}
+
+	assert.Equal(t, map[string]int{"first": 5, "second": 5}, counts)
+}
+
+func TestWeighted_Get_Empty(t *testing.T) {
+	t.Parallel()
+
+	b := NewWeighted(nil)
+	assert.Nil(t, b.Get())
+
+	b.Set([]database.Executor{newTestExecutor("first")})
+	b.Set(nil)
+	assert.Nil(t, b.Get())
+}
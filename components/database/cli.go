@@ -0,0 +1,65 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mrsmtvd/shadow"
+	"github.com/mrsmtvd/shadow/components/config"
+	"github.com/mrsmtvd/shadow/components/logging"
+)
+
+// Проверяет наличие в аргументах командной строки флага -database.migrate
+func IsMigrateMode() bool {
+	for _, arg := range os.Args[1:] {
+		if arg == "--" {
+			break
+		}
+
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+
+		if i := strings.Index(name, "="); i >= 0 {
+			name = name[:i]
+		}
+
+		if name == ConfigMigrate {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Запускает приложение без сервисных компонентов, только config, logging и database,
+// выполняет команду из -database.migrate и возвращает код завершения процесса
+//
+//	if database.IsMigrateMode() {
+//		os.Exit(database.RunMigrate())
+//	}
+func RunMigrate() int {
+	names := []string{config.ComponentName, ComponentName}
+	if shadow.DefaultApplication.HasComponent(logging.ComponentName) {
+		names = append(names, logging.ComponentName)
+	}
+
+	err := shadow.RunComponents(names...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	code := MigrateExitFailed
+
+	if cmp, ok := shadow.DefaultApplication.GetComponent(ComponentName).(Component); ok {
+		code = cmp.MigrateExitCode()
+	}
+
+	if err != nil && code == MigrateExitOK {
+		code = MigrateExitFailed
+	}
+
+	return code
+}
@@ -10,4 +10,5 @@ type Component interface {
 	Storage() Storage
 	Migration(id, source string) Migration
 	Migrations() []Migration
+	MigrateExitCode() int
 }
@@ -1,17 +1,33 @@
 package database
 
 const (
-	ConfigAllowUseMasterAsSlave = ComponentName + ".allow-use-master-as-slave"
-	ConfigBalancer              = ComponentName + ".balancer"
-	ConfigDriver                = ComponentName + ".driver"
-	ConfigDialectEngine         = ComponentName + ".dialect.engine"
-	ConfigDialectEncoding       = ComponentName + ".dialect.encoding"
-	ConfigDialectVersion        = ComponentName + ".dialect.version"
-	ConfigDsnMaster             = ComponentName + ".dsn.master"
-	ConfigDsnSlaves             = ComponentName + ".dsn.slaves"
-	ConfigMigrationsSchema      = ComponentName + ".migrations.schema"
-	ConfigMigrationsTable       = ComponentName + ".migrations.table"
-	ConfigMaxIdleConns          = ComponentName + ".max_idle_conns"
-	ConfigMaxOpenConns          = ComponentName + ".max_open_conns"
-	ConfigConnMaxLifetime       = ComponentName + ".conn_max_lifetime"
+	ConfigAllowUseMasterAsSlave     = ComponentName + ".allow-use-master-as-slave"
+	ConfigBalancer                  = ComponentName + ".balancer"
+	ConfigBalancerWeights           = ComponentName + ".balancer.weights"
+	ConfigHealthCheckEnabled        = ComponentName + ".health-check.enabled"
+	ConfigHealthCheckInterval       = ComponentName + ".health-check.interval"
+	ConfigHealthCheckTimeout        = ComponentName + ".health-check.timeout"
+	ConfigEjectBackoffMin           = ComponentName + ".health-check.eject-backoff.min"
+	ConfigEjectBackoffMax           = ComponentName + ".health-check.eject-backoff.max"
+	ConfigDriver                    = ComponentName + ".driver"
+	ConfigDialectEngine             = ComponentName + ".dialect.engine"
+	ConfigDialectEncoding           = ComponentName + ".dialect.encoding"
+	ConfigDialectVersion            = ComponentName + ".dialect.version"
+	ConfigDsnMaster                 = ComponentName + ".dsn.master"
+	ConfigDsnSlaves                 = ComponentName + ".dsn.slaves"
+	ConfigMigrationsSchema          = ComponentName + ".migrations.schema"
+	ConfigMigrationsTable           = ComponentName + ".migrations.table"
+	ConfigMigrationsLockTimeout     = ComponentName + ".migrations.lock.timeout"
+	ConfigMigrationsAuto            = ComponentName + ".migrations.auto.enabled"
+	ConfigMigrationsAutoMaxPending  = ComponentName + ".migrations.auto.max-pending"
+	ConfigMigrationsAutoRefuseStart = ComponentName + ".migrations.auto.refuse-start"
+	ConfigMigrate                   = ComponentName + ".migrate"
+	ConfigMigrateSource             = ComponentName + ".migrate.source"
+	ConfigMigrateID                 = ComponentName + ".migrate.id"
+	ConfigMaxIdleConns              = ComponentName + ".max_idle_conns"
+	ConfigMaxOpenConns              = ComponentName + ".max_open_conns"
+	ConfigConnMaxLifetime           = ComponentName + ".conn_max_lifetime"
+	ConfigSlowQueryThreshold        = ComponentName + ".slow-query.threshold"
+	ConfigTxMaxRetries              = ComponentName + ".tx.max-retries"
+	ConfigTxRetryBackoff            = ComponentName + ".tx.retry-backoff"
 )
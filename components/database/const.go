@@ -4,6 +4,22 @@ const (
 	ComponentName    = "database"
 	ComponentVersion = "3.1.0"
 
-	BalancerRandom     = "random"
-	BalancerRoundRobin = "round_robin"
+	BalancerRandom           = "random"
+	BalancerRoundRobin       = "round_robin"
+	BalancerWeighted         = "weighted"
+	BalancerLeastConnections = "least_connections"
+)
+
+const (
+	MigrateUp     = "up"
+	MigrateDown   = "down"
+	MigrateStatus = "status"
+	MigrateRedo   = "redo"
+
+	MigrateExitOK      = 0
+	MigrateExitFailed  = 1
+	MigrateExitUsage   = 2
+	MigrateExitPending = 3
+	MigrateExitDrift   = 4
+	MigrateExitLocked  = 5
 )
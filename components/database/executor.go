@@ -10,14 +10,27 @@ import (
 type Executor interface {
 	String() string
 	Ping(context.Context) error
+	// возвращает копию исполнителя, все запросы которого выполняются с указанным контекстом
+	WithContext(ctx context.Context) Executor
+	Context() context.Context
 	Begin() (Executor, error)
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (Executor, error)
 	Commit() error
 	DB() *sql.DB
 	Rollback() error
 	SelectByQuery(i interface{}, query string, args ...interface{}) ([]interface{}, error)
 	Select(i interface{}, builder *sq.SelectBuilder) ([]interface{}, error)
+	SelectByQueryContext(ctx context.Context, i interface{}, query string, args ...interface{}) ([]interface{}, error)
+	SelectContext(ctx context.Context, i interface{}, builder *sq.SelectBuilder) ([]interface{}, error)
 	SelectOneByQuery(holder interface{}, query string, args ...interface{}) error
 	SelectOne(holder interface{}, builder *sq.SelectBuilder) error
+	SelectOneByQueryContext(ctx context.Context, holder interface{}, query string, args ...interface{}) error
+	SelectOneContext(ctx context.Context, holder interface{}, builder *sq.SelectBuilder) error
+	// возвращает курсор, который вызывающая сторона обязана закрыть
+	QueryByQuery(query string, args ...interface{}) (*sql.Rows, error)
+	Query(builder *sq.SelectBuilder) (*sql.Rows, error)
+	QueryByQueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryContext(ctx context.Context, builder *sq.SelectBuilder) (*sql.Rows, error)
 	SelectIntByQuery(query string, args ...interface{}) (int64, error)
 	SelectInt(builder *sq.SelectBuilder) (int64, error)
 	SelectNullIntByQuery(query string, args ...interface{}) (sql.NullInt64, error)
@@ -31,12 +44,15 @@ type Executor interface {
 	SelectNullStrByQuery(query string, args ...interface{}) (sql.NullString, error)
 	SelectNullStr(builder *sq.SelectBuilder) (sql.NullString, error)
 	Get(i interface{}, keys ...interface{}) (interface{}, error)
+	GetContext(ctx context.Context, i interface{}, keys ...interface{}) (interface{}, error)
 	Insert(list ...interface{}) error
 	Update(list ...interface{}) (int64, error)
 	Delete(list ...interface{}) (int64, error)
 	Prepare(query string) (*sql.Stmt, error)
 	ExecByQuery(query string, args ...interface{}) (sql.Result, error)
 	Exec(query interface{}, args ...interface{}) (sql.Result, error)
+	ExecByQueryContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query interface{}, args ...interface{}) (sql.Result, error)
 	ExecSelect(builder *sq.SelectBuilder) (sql.Result, error)
 	ExecInsert(builder *sq.InsertBuilder) (sql.Result, error)
 	ExecUpdate(builder *sq.UpdateBuilder) (sql.Result, error)
@@ -11,7 +11,16 @@ $(document).ready(function () {
                 dataSrc: 'data'
             },
             columns: [
-                { data: 'id' },
+                {
+                    data: 'id',
+                    render: function (id, type, data) {
+                        if (type === 'display' && data.code) {
+                            return id + ' <span class="label label-info">Go</span>';
+                        }
+
+                        return id;
+                    }
+                },
                 { data: 'source' },
                 {
                     data: 'modified_at',
@@ -21,18 +30,27 @@ $(document).ready(function () {
                 },
                 {
                     data: 'applied_at',
-                    render: function (date) {
-                        return date ? dateToString(date) : '';
+                    render: function (date, type, data) {
+                        var content = date ? dateToString(date) : '';
+
+                        if (type === 'display' && data.drift) {
+                            content += ' <span class="label label-danger" title="' + data.drift + '"><i class="fa fa-exclamation-triangle"></i> ' + data.drift + '</span>';
+                        }
+
+                        return content;
                     }
                 },
                 {
                     orderable: false,
                     data: null,
                     render: function (data) {
-                        var content = '<div class="btn-group btn-group-xs">'
-                            + '<button class="btn btn-success btn-icon show" onclick="showCode(this)"><i class="fas fa-eye" title="Show"></i></button>'
-                            + '<a href="/dashboard/assetfs/?path=/' + data.source + '/migrations/' + data.id + '&mode=raw" class="btn btn-info btn-icon"><i class="fas fa-file" title="Raw"></i></a>'
-                            + '<a href="/dashboard/assetfs/?path=/' + data.source + '/migrations/' + data.id + '&mode=file" class="btn btn-warning btn-icon"><i class="fas fa-file-download" title="Download"></i></a>';
+                        var content = '<div class="btn-group btn-group-xs">';
+
+                        if (!data.code) {
+                            content += '<button class="btn btn-success btn-icon show" onclick="showCode(this)"><i class="fas fa-eye" title="Show"></i></button>'
+                                + '<a href="/dashboard/assetfs/?path=/' + data.source + '/migrations/' + data.id + '&mode=raw" class="btn btn-info btn-icon"><i class="fas fa-file" title="Raw"></i></a>'
+                                + '<a href="/dashboard/assetfs/?path=/' + data.source + '/migrations/' + data.id + '&mode=file" class="btn btn-warning btn-icon"><i class="fas fa-file-download" title="Download"></i></a>';
+                        }
 
                         if (data.applied_at) {
                             content += '<a href="javascript:void(0)" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm rollback ' + data.id + ' for ' + data.source + ' migration" data-modal-callback="migrate(\'down\',\'' + data.id + '\',\'' + data.source + '\');">'
@@ -1 +1,4 @@
-$(document).ready(function(){var table=$("#sql").DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/database/migrations/",dataSrc:"data"},columns:[{data:"id"},{data:"source"},{data:"modified_at",render:function(date){return date?dateToString(date):""}},{data:"applied_at",render:function(date){return date?dateToString(date):""}},{orderable:!1,data:null,render:function(data){var content='<div class="btn-group btn-group-xs"><button class="btn btn-success btn-icon show" onclick="showCode(this)"><i class="fas fa-eye" title="Show"></i></button><a href="/dashboard/assetfs/?path=/'+data.source+"/migrations/"+data.id+'&mode=raw" class="btn btn-info btn-icon"><i class="fas fa-file" title="Raw"></i></a><a href="/dashboard/assetfs/?path=/'+data.source+"/migrations/"+data.id+'&mode=file" class="btn btn-warning btn-icon"><i class="fas fa-file-download" title="Download"></i></a>';return data.applied_at?content+='<a href="javascript:void(0)" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm rollback '+data.id+" for "+data.source+" migration\" data-modal-callback=\"migrate('down','"+data.id+"','"+data.source+'\');"><i class="fa fa-backward"></i></a>':content+='<a href="javascript:void(0)" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm apply '+data.id+" for "+data.source+" migration\" data-modal-callback=\"migrate('up','"+data.id+"','"+data.source+'\');"><i class="fa fa-play"></i></a>',content+"</div>"}},{data:"up",visible:!1},{data:"down",visible:!1}],drawCallback:function(){var api=this.api(),rows=api.rows({page:"current"}).nodes(),last=null;api.column(5,{page:"current"}).data().each(function(group,i){var row=$(rows).eq(i);last!==group&&(row.after('<tr class="no-hover" style="display:none"><td colspan="'+row.children().length+'"><pre><button type="button" class="close" onclick="hideCode(this)">×</button><code class="sql">-- +migrate Up\n'+group+"\n\n-- +migrate Down"+api.column(6,{page:"current"}).data()[i]+"</code></pre></td></tr>"),last=group)})}});window.showCode=function(e){$(e).find("i").toggleClass("fas fa-eye").toggleClass("fas fa-eye-slash");var code=$(e).closest("#sql tbody tr").next();code.find("code").each(function(i,block){hljs.highlightBlock(block)}),code.toggle()},window.hideCode=function(e){$(e).closest("#sql tbody tr").prev().find("button.show").click()},window.migrate=function(action,id,source){var url="/database/migrations/"+action+"/";""!==source&&(url+=source+"/"),""!==id&&(url+=id),$.post(url,function(r){"failed"!==r.result?void 0!==r.message&&(new PNotify({title:"Result operation",text:r.message,type:"success",hide:!1,styling:"bootstrap3"}),table.ajax.reload()):new PNotify({title:"Result operation",text:r.message,type:"error",hide:!1,styling:"bootstrap3"})})}});
\ No newline at end of file
+$(document).ready(function(){var e=$("#sql").DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/database/migrations/",dataSrc:"data"},columns:[{data:"id",render:function(e,t,n){return t==="display"&&n.code?e+' <span class="label label-info">Go</span>':e}},{data:"source"},{data:"modified_at",render:function(e){return e?dateToString(e):""}},{data:"applied_at",render:function(e,t,n){var s=e?dateToString(e):"";return t==="display"&&n.drift&&(s+=' <span class="label label-danger" title="'+n.drift+'"><i class="fa fa-exclamation-triangle"></i> '+n.drift+"</span>"),s}},{orderable:!1,data:null,render:function(e){var t='<div class="btn-group btn-group-xs">';return e.code||(t+='<button class="btn btn-success btn-icon show" onclick="showCode(this)"><i class="fas fa-eye" title="Show"></i></button><a href="/dashboard/assetfs/?path=/'+e.source+"/migrations/"+e.id+'&mode=raw" class="btn btn-info btn-icon"><i class="fas fa-file" title="Raw"></i></a><a href="/dashboard/assetfs/?path=/'+e.source+"/migrations/"+e.id+'&mode=file" class="btn btn-warning btn-icon"><i class="fas fa-file-download" title="Download"></i></a>'),e.applied_at?t+='<a href="javascript:void(0)" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm rollback '+e.id+" for "+e.source+` migration" data-modal-callback="migrate('down','`+e.id+"','"+e.source+`');"><i class="fa fa-backward"></i></a>`:t+='<a href="javascript:void(0)" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm apply '+e.id+" for "+e.source+` migration" data-modal-callback="migrate('up','`+e.id+"','"+e.source+`');"><i class="fa fa-play"></i></a>`,t+"</div>"}},{data:"up",visible:!1},{data:"down",visible:!1}],drawCallback:function(){var e=this.api(),n=e.rows({page:"current"}).nodes(),t=null;e.column(5,{page:"current"}).data().each(function(s,o){var i=$(n).eq(o);t!==s&&(i.after('<tr class="no-hover" style="display:none"><td colspan="'+i.children().length+`"><pre><button type="button" class="close" onclick="hideCode(this)">×</button><code class="sql">-- +migrate Up
+`+s+`
+
+-- +migrate Down`+e.column(6,{page:"current"}).data()[o]+"</code></pre></td></tr>"),t=s)})}});window.showCode=function(e){$(e).find("i").toggleClass("fas fa-eye").toggleClass("fas fa-eye-slash");var t=$(e).closest("#sql tbody tr").next();t.find("code").each(function(e,t){hljs.highlightBlock(t)}),t.toggle()},window.hideCode=function(e){$(e).closest("#sql tbody tr").prev().find("button.show").click()},window.migrate=function(t,n,s){var o="/database/migrations/"+t+"/";s!==""&&(o+=s+"/"),n!==""&&(o+=n),$.post(o,function(t){if(t.result==="failed"){new PNotify({title:"Result operation",text:t.message,type:"error",hide:!1,styling:"bootstrap3"});return}typeof t.message!="undefined"&&(new PNotify({title:"Result operation",text:t.message,type:"success",hide:!1,styling:"bootstrap3"}),e.ajax.reload())})}})
\ No newline at end of file
@@ -1,523 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// templates/views/migrations.html
-// templates/views/status.html
-// templates/views/tables.html
-// assets/css/sql.min.css
-// assets/js/sql.min.js
-// assets/vendors/highlightjs/css/tomorrow.min.css
-// assets/vendors/highlightjs/js/highlight.pack.min.js
-// locales/ru/LC_MESSAGES/config.mo
-// locales/ru/LC_MESSAGES/database.mo
-// locales/ru/LC_MESSAGES/migrations.mo
-// locales/ru/LC_MESSAGES/status.mo
-// locales/ru/LC_MESSAGES/tables.mo
-// DO NOT EDIT!
-
-package internal
-
-import (
-	"github.com/elazarl/go-bindata-assetfs"
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _templatesViewsMigrationsHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x5d\x6f\xdb\x3a\x0c\x7d\xef\xaf\x20\xd4\x87\xb6\xc0\x4d\x7c\x6f\x9f\x2e\x3a\x27\xc0\xb0\x3d\x6c\x40\xfb\x52\x74\xcf\x05\x6d\xc9\xb1\x5c\x45\x72\x25\xda\x49\x60\xe4\xbf\x0f\xb2\xe3\xc4\x71\xe3\x6c\xfd\xc8\x30\x20\x40\xf4\xc1\xc3\x43\xf2\xd0\x54\x55\x01\x17\x89\xd4\x02\x58\x6c\x34\x09\x4d\x0c\xd6\xeb\xb3\x90\xcb\x12\x62\x85\xce\x4d\x98\x35\x0b\x36\x3d\x03\x00\xe8\x9e\x2e\x1f\x73\xd4\x42\x6d\x6e\x5e\xde\x92\x24\x25\x3a\xb7\xb5\x45\x7a\x3d\xad\x2a\x90\xff\xfd\xaf\x81\xdd\xc9\x99\x45\x92\x46\x3b\x06\x63\x58\xaf\xc3\x20\xbd\xee\x99\x17\xaa\xf5\xa7\xb1\x04\x8d\x65\x84\x76\x64\xe5\x2c\x25\xa8\xc9\x1f\xc9\x18\x15\x99\x65\x8f\xa6\xc6\x2a\xd9\x62\xb9\x35\x39\x37\x0b\x7d\xc0\xaa\xb6\x44\x48\xad\x48\x26\xec\x9c\xf5\x11\x23\x32\xb3\x99\x12\x0c\x38\x12\x6e\x36\x1d\x7f\x60\x8d\xdf\x47\x05\x91\xd1\x0c\xd0\x4a\x1c\x89\x65\x8e\x9a\x0b\x3e\x61\x09\x2a\x27\xd8\x34\xdc\xc6\x91\x20\x24\x38\x5a\x58\xa1\xe3\x94\x4d\xc3\x40\x4e\xc3\x00\x07\x62\xda\x65\xbe\x8d\x65\x2e\x74\xd1\x52\xd6\xeb\xc3\xd0\x4d\xf2\xc3\x97\x7b\x39\x67\x58\xa2\x8b\xad\xcc\xe9\xa6\x34\x92\x5f\xfe\x7b\xd5\x4b\x76\x6e\x38\xaa\xf6\x0c\xed\x4c\xd0\x84\x9d\x77\x0f\xeb\xf5\xa8\x56\x7b\xc2\xb6\xe2\x7e\x31\x3a\x91\x76\xee\xc3\x55\x11\xc6\x4f\x80\x4a\xc1\xbc\x55\xbc\x11\x7c\xcf\x41\x8c\x8d\xe1\x84\x35\x56\xe2\xf2\xc2\x67\x7d\xf1\xcf\x85\xff\x5d\x7d\x3a\x92\xed\x36\xa9\x5e\xa5\xbd\xbb\x05\x5a\xde\xd4\x1a\xb6\xb1\xdd\x77\x62\x6a\x22\x39\x5e\xab\x21\x91\x9a\xcb\x63\xa5\xfe\x7b\x74\xc0\x3c\x57\xab\xb7\x88\x50\xe4\xef\x91\x20\x57\xb8\xea\x97\xff\x73\x1b\xca\xe9\x6a\x1f\x06\x85\x3a\x30\x12\x5e\xd8\xbf\xb4\xeb\xce\xb0\x58\x09\xb4\x89\x5c\xfa\x04\xb8\x2c\x3b\x83\xae\xb7\xdd\x9b\x7b\xed\x14\x1d\xf6\x4b\x18\x29\x31\xb2\xc2\xe5\x46\x3b\x59\xf6\x87\x64\x6d\x5e\xdb\xec\x01\xa0\x81\xa5\xa6\x14\x16\x38\x75\xf0\xa0\xcd\xc2\x62\xce\x40\xf2\x09\x73\xcf\x6a\x68\xce\x51\x2a\x90\x0f\xdd\xd9\x23\x75\xa6\x74\x37\xb5\xbf\x7f\x6d\xa7\x35\xa5\xbf\x09\xb9\x33\x5c\x26\x52\x70\x40\x7a\x35\xd6\x37\xcb\x5b\xa1\xf1\xde\xe3\xf2\x0b\x1c\x38\x5a\xd5\xc3\x5d\x3a\xdf\xb4\x37\xa0\x8d\xf6\xd3\xfb\xc3\x71\x61\x30\x54\x6b\x8f\x39\xa8\x50\x18\xd4\xda\xf7\x7b\x77\xa8\x27\x37\xcb\xcd\x5f\x55\x81\xd0\xdc\x7f\x68\x67\x9d\xb7\xde\x13\xb1\xf6\xeb\xab\x2a\x70\x84\x24\xe3\x6f\x0f\x77\xb7\x70\xd9\xac\x7f\xdc\xdf\x02\x0b\x38\xba\x34\x32\x68\x79\x80\xce\x09\x72\x41\x29\x34\x37\xd6\x05\xa9\x9c\xa5\xca\xbf\xc4\x99\x0b\x62\xe7\x02\x32\x73\x63\xad\x59\x8c\xe7\x52\x8f\x63\xe7\x18\xd4\xef\xdf\xd5\x7b\x38\xfc\x64\xaa\x33\x77\x63\x2d\x68\x14\x35\x4c\xfe\xf4\xa1\x39\x8d\x8c\x21\x47\x16\xf3\x13\xb2\xee\xbe\xb3\x36\x80\xdd\xc9\x3b\x03\x20\x8c\xd0\x89\x96\xdf\xbb\x76\xcf\xaa\xf1\x42\xb6\x68\x9c\x1c\x96\x2f\x73\x1f\x26\x5e\xd6\xd9\x8e\x73\x8c\x9f\xea\x54\xb2\x13\x94\xd2\x53\x65\xcf\x85\xb0\xab\x71\x47\xc3\x53\xb1\x79\xb5\xb2\x23\xdd\x72\x12\xce\x5d\x6b\xf4\xb8\x3b\x3d\xf3\x07\xc8\x37\xb9\x0f\x36\xea\x2b\xe9\xf7\xfb\x34\x6b\xda\x34\x3b\xd8\xa5\x3f\x03\x00\x00\xff\xff\x32\x3b\x07\x47\x4f\x0c\x00\x00"
-
-func templatesViewsMigrationsHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesViewsMigrationsHtml,
-		"templates/views/migrations.html",
-	)
-}
-
-func templatesViewsMigrationsHtml() (*asset, error) {
-	bytes, err := templatesViewsMigrationsHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/views/migrations.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _templatesViewsStatusHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x41\x6e\xb3\x30\x10\x85\xf7\x39\xc5\xc8\xca\xe2\xff\xa5\x26\x14\x56\x55\x05\xec\x7a\x81\xe6\x00\x95\x83\x27\xc1\x92\x63\x47\xb6\x43\xa9\x2c\xee\x5e\x61\x08\x01\x8a\x51\xa5\xae\x12\x78\xf3\x3d\xcf\x3c\xc6\xce\x01\xc3\x13\x97\x08\xa4\x50\xd2\xa2\xb4\x04\x9a\x66\x93\x32\x5e\x41\x21\xa8\x31\x19\xd1\xea\x93\xe4\x1b\x00\x80\xf1\xdb\xfa\xe3\x4a\x25\x8a\x5e\xf9\xa9\x5a\x6e\x05\x8e\x54\x5f\x51\x26\xb9\x73\xc0\xe3\x17\x09\xe4\x80\xba\x42\x6d\xc0\x58\x6a\x6f\x86\xc0\x1e\x9a\x26\x8d\xca\x64\x86\x8c\x4c\x0b\x81\x54\x9f\x78\x4d\xf2\x34\x62\xbc\x1a\x9d\x3c\x7b\x9c\x34\x72\x1f\x2b\xec\x6b\xe9\x51\xe0\x4e\xa3\xb9\x2a\x69\x78\x35\xef\xda\x97\xfb\x9a\x09\x00\x1d\x56\xaa\x0a\x35\x01\xce\x32\x72\xe1\x67\x4d\x2d\x57\xd2\x2c\x38\x74\x2e\x25\x52\x16\xd2\xf4\xb2\xd0\x83\x8f\xe0\xde\x6a\x2c\x6e\x56\xe9\x7b\x64\xb6\x5c\x05\x87\xf4\x94\xd8\x5d\xd8\x2e\x26\x0f\xa7\x77\x25\xf0\xef\x2e\x87\xc9\x07\x0c\xf9\xa4\x51\x68\xc0\x96\x59\x89\xe5\xa8\xd8\xd7\xb2\xe6\x1c\x68\x2a\xcf\x08\x5b\xfe\x04\x5b\xe3\xf7\x09\x5e\x33\xd8\x77\x2b\x85\xa6\xdd\xe4\x65\xd3\xd5\xac\x99\x9f\x4d\x32\xac\x07\xd7\xe7\x6e\xb6\x40\x93\x63\xac\x8d\xe4\xdf\x14\x8e\xff\xc3\xf6\xd7\xfc\x84\x4c\x56\xb1\x70\xa4\xce\x01\x4a\x16\x1c\x3f\x0a\x84\x9a\x46\x7e\xa7\x67\x37\x25\x78\xd7\xfa\xbf\xfd\xcf\x70\xe6\x77\x00\x00\x00\xff\xff\x75\x77\xe4\x59\x53\x04\x00\x00"
-
-func templatesViewsStatusHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesViewsStatusHtml,
-		"templates/views/status.html",
-	)
-}
-
-func templatesViewsStatusHtml() (*asset, error) {
-	bytes, err := templatesViewsStatusHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/views/status.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _templatesViewsTablesHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xc1\x6e\x13\x31\x10\xbd\xf7\x2b\x46\x56\x0f\x20\x91\x58\x2d\x17\x54\x6d\x22\x21\x24\xc4\xa1\x70\x80\x72\x46\x8e\x3d\xc9\x3a\x38\xf6\xd6\xe3\xa4\x8d\x56\xf9\x0e\x3e\x88\x1f\x43\x6b\x67\xb3\xdb\xed\x6e\x12\x0a\xa7\xd8\x3b\x7e\xcf\xf3\x9e\x67\x26\x65\x09\x0a\xe7\xda\x22\x30\xe9\x6c\x40\x1b\x18\xec\x76\x17\x99\xd2\x1b\x90\x46\x10\x4d\x98\x77\x0f\x6c\x7a\x01\x00\xd0\xfe\xfa\xf8\xa3\x10\x16\xcd\x3e\xf2\x3c\x1a\x74\x30\xd8\x8a\xc6\x13\xf9\xf5\xb4\x2c\x41\x5f\xbd\xb3\xc0\xee\xc4\xcc\x20\x31\x18\xc3\x6e\x97\xf1\xfc\xba\x73\xb4\x45\x26\x0d\x0a\x3f\xd7\x8f\x6c\x9a\x71\xa5\x37\xad\x1b\x3b\xdb\x27\x09\xd4\x72\x86\x79\x43\x95\xc1\xc8\x23\x15\xce\x92\xde\x74\xb3\x8d\xc7\xe3\x99\x27\x00\x48\xb0\xdc\x6d\xd0\x33\xd0\x6a\xc2\xe8\xde\xf4\x40\x13\x3c\x47\xa1\x86\x62\xbe\x3f\xb0\x07\x1e\xd4\x3b\x33\x5a\xa9\xd1\x15\x6b\xac\xfb\x26\x73\x5c\x61\x6d\x5d\xc8\xff\x86\xe7\x9a\x75\x9e\xe0\x65\x34\x57\x20\x64\xd0\xce\x52\x8b\xee\xfd\xfe\xcb\x09\xc2\x8c\x0f\x29\xaf\x30\x47\xfc\x9a\x39\xb5\xed\x8f\x95\x25\x78\x61\x17\x08\x97\xfa\x0d\x5c\xa6\x67\xba\x99\xc0\x38\xae\xa8\x2a\xe8\x7e\x46\x5f\xab\x52\x48\xd2\xeb\xa2\xca\x7f\xe0\x29\x13\x40\x55\x6a\xd3\x05\xe3\xf8\x0a\xe2\x8b\x58\x61\x92\x3b\x90\xf7\x73\x60\xb4\xfd\x6c\xdc\x60\x10\x3a\xe5\x3c\x0b\x76\xb4\xf0\x6e\x5d\xc0\x61\x35\x7a\xa4\x23\x72\x0e\x24\xb3\x75\x08\xce\xb6\x78\x22\x03\xad\xa5\x44\xa2\xb8\xd6\xb2\x32\x26\xd3\xf5\x99\xb9\x20\x98\x8b\x11\x6e\x91\x41\xec\xf4\x09\x6b\xaa\x33\x77\x0f\x0c\x2e\x61\xb7\xab\x1a\x56\x4f\x33\x9e\xf8\x4f\x28\x79\xda\xcb\xcf\xc3\x43\x56\x1c\x29\xa7\xe6\x7d\xad\xab\x1b\x96\xc2\xb6\xca\x56\x69\x2a\x8c\xd8\xde\x58\x67\xfb\xfa\xbe\xa1\x50\x20\x9d\xa1\x42\xd8\x09\x7b\x7b\xc2\xcb\xac\xf0\x78\xbe\xdb\x61\x5b\xe0\x84\xa5\x0d\x6b\x46\x9d\x23\x64\xd3\xdf\xbf\xce\x33\x2d\xf2\x49\xa7\x0e\x13\x2a\x8e\xa2\x56\x89\xde\x9b\x8f\xce\x7f\xf0\x28\x02\xc2\x5c\x18\x4a\x25\x57\x21\xa6\x19\x3f\x9a\xee\x4b\x0c\x2f\x4b\x40\xab\x06\xdb\x8d\x0f\x74\x70\xc6\x63\xb6\x9d\x41\x3d\x38\xea\xf7\xcb\xfd\x4f\x73\xe7\x45\xeb\xbf\xac\x1a\x23\xac\x4e\xa4\x2c\x81\x82\x08\x5a\x7e\xba\xfb\x7c\x0b\xaf\xd2\xfa\xfb\xd7\x5b\x60\x5c\x09\xca\x67\x4e\x78\xc5\x05\x11\x06\xe2\x1b\xb4\xca\x79\xe2\xb9\x5e\xe4\x46\x2f\xf2\xb0\x24\x2e\x89\x78\x70\x2b\xe7\xbd\x7b\x18\xaf\xb4\x1d\x4b\x22\x96\xec\x7c\x7d\xd6\x1d\x41\xcc\x04\x61\x7d\x45\x45\x47\xf7\x26\xb1\x04\xbf\x4e\x24\xfd\x3a\x96\xf4\xdf\x54\x2c\x5b\xdb\x71\x21\xe4\xcf\x28\x65\xf9\x2f\x4a\x96\x49\xc8\xb2\x57\xc7\x9f\x00\x00\x00\xff\xff\xce\xb2\x3b\x43\x5a\x08\x00\x00"
-
-func templatesViewsTablesHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesViewsTablesHtml,
-		"templates/views/tables.html",
-	)
-}
-
-func templatesViewsTablesHtml() (*asset, error) {
-	bytes, err := templatesViewsTablesHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/views/tables.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsCssSqlMinCss = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x2e\x2e\xcc\x51\x28\x28\x4a\xad\x4e\x4a\x4c\xce\x4e\x2f\xca\x2f\xcd\x4b\xd1\x4d\xce\xcf\xc9\x2f\xb2\x52\x4e\x4b\x4b\xab\x05\x4b\x97\x64\xe8\x25\x26\x97\x64\xe6\xe7\x15\x57\xe7\x66\xe6\xe9\x96\x67\xa6\x94\x64\x58\x19\x1a\x19\x14\x54\xd4\x02\x02\x00\x00\xff\xff\xdb\x1b\xd3\x53\x3f\x00\x00\x00"
-
-func assetsCssSqlMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsCssSqlMinCss,
-		"assets/css/sql.min.css",
-	)
-}
-
-func assetsCssSqlMinCss() (*asset, error) {
-	bytes, err := assetsCssSqlMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/css/sql.min.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsJsSqlMinJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xe1\x6e\xdb\x36\x10\x7e\x15\xe5\x1a\x44\x22\x44\xcb\x29\x86\x0d\x83\x6c\x39\xc0\xd2\x9f\xc3\x30\x34\xdd\xaf\xa6\x18\xce\xe2\x49\x62\x42\x93\x2a\x49\xd9\x31\x0c\x3f\xc7\x1e\x68\x2f\x36\x50\xb2\x65\x27\x6d\x50\x60\xeb\x8f\xfd\xb1\xc9\x3b\xde\xdd\xc7\xef\x4e\x77\xbc\x4c\x84\x29\xbb\x15\x69\xcf\x32\x4b\x28\xb6\x49\xd5\xe9\xd2\x4b\xa3\x13\xb6\x5b\xa3\x8d\x3c\x2e\x15\x15\x97\x09\xbc\x71\x9f\x15\xb0\xec\x1d\x7a\xfc\x10\x64\xc9\xce\x79\xf4\x74\x87\x6b\xca\x2f\xae\x79\xbf\x79\xd7\x59\x0c\xb6\xf9\x35\x57\xa8\xeb\x0e\x6b\xca\x77\x9d\x55\x39\x4c\x05\xba\x66\x69\xd0\x8a\xa9\x40\x8f\xbd\x57\x37\x95\x6f\x7f\xd6\xd9\x83\x33\xfa\x46\x99\x12\x15\x15\x90\x6e\xa4\x16\x66\x93\xb9\x06\x85\xd9\xfc\xda\x4b\xf7\x1c\x1f\xf0\xe9\xe4\xc8\xe3\x12\x1d\x4d\x57\xb2\x1e\xa2\xb9\x29\xf0\x20\xbd\xb3\x65\x0e\x61\x01\x7b\x5e\x1a\xd5\xad\xb4\xcb\x3f\xee\x82\x20\x07\x29\x60\xcf\x0f\x6b\x67\x3a\x5b\xd2\x69\xbf\x32\x42\x56\x92\xc4\x9f\xe8\x81\x5b\xd2\x82\x6c\x3e\xb2\x20\xd0\x13\xdb\x59\xf2\x9d\xd5\x51\xd8\xdc\x84\x9f\x0f\xe6\xce\x5b\xa9\xeb\x41\x9d\x03\xec\x47\x6f\xd8\xb6\xea\x3b\x38\x33\x56\x90\x0d\x2c\xe5\x17\x6f\xfb\xdb\xe5\xba\x53\xea\x6b\x1e\x71\x48\x54\x69\xb4\x27\xed\x8b\x78\x2e\xe4\x3a\x2a\x15\x3a\x57\xc0\xd2\xeb\x49\x6d\x4d\xd7\x46\xe3\x6a\xf2\xe4\x60\x31\x5f\x76\xde\x1b\x7d\x76\xac\x3f\xe0\xba\xb2\x24\xe7\xfa\xb5\x2c\x8d\x8e\x5c\x63\x36\x10\x19\x5d\x2a\x59\x3e\x16\x10\xb6\xb7\x46\x50\xe2\x1b\xe9\x18\x2c\xe6\xf2\xe8\xa1\x42\x17\x55\x38\xa1\x2d\x41\xe4\xa5\x0f\xa9\xbc\x0b\xb6\x8b\xf9\x54\x2e\xe6\xd3\x21\xdc\x62\x8e\x51\x63\xa9\x2a\xce\xcb\x01\x9d\x23\x5f\xb9\xe9\x4d\x8b\xbe\x29\xa6\x71\x1a\xae\x94\x0d\x39\x4a\xe1\x59\x96\x07\x95\x14\x69\x7c\xb5\x32\x82\x0a\x8b\x1b\x78\x79\x05\xa9\x2b\x33\xe2\xff\x0a\xc2\x4a\xaa\x13\xc4\xf7\x38\x22\xc4\xef\x0d\x6e\x08\xf4\x02\xdd\x06\xad\x96\xba\xfe\x16\xc0\x89\x30\x1b\xad\x0c\x8a\x11\xe9\xbb\xa3\x60\x84\x1b\xcf\x4e\x75\x84\xd9\xa9\xea\x6e\x0e\x85\x90\x16\xf1\x78\xa3\x07\x5c\xa3\x2b\xad\x6c\x7d\xbe\x36\x52\x24\xd7\xec\x0b\x64\x02\x75\x4d\xf6\x04\xac\x77\x3b\xf1\xa6\xae\x43\xf8\x95\x11\xa8\x8e\x32\xb4\x35\xf9\x02\xde\x9c\x0b\xfb\xf5\xe4\x00\xf6\xd6\xe8\x4a\xda\x55\x64\x8d\x52\x4b\x2c\x1f\xa3\x78\x24\x07\xa2\xca\xd8\x08\x9e\xf3\x18\x8d\x3c\xde\x3f\x73\x57\xe2\x60\x5f\xdc\xc3\x70\x82\x92\x38\x30\x13\xf3\xf8\x44\x37\x9c\x76\x07\x7f\xf1\x7d\xcc\x66\xcf\x99\x0d\xc4\x06\x4f\x1b\xb4\xe7\x14\xe6\xff\x1f\xae\x42\x02\xb7\xdf\x93\xa8\xae\xfd\x77\x34\xb5\x0a\xb7\x67\x14\xf1\x23\x45\x30\x9f\x0a\xb9\x5e\x9c\x75\xba\xae\x05\xbe\x96\x4e\x0e\x4d\x6a\x14\x87\x0c\x3d\x53\x7c\xe2\xc2\xe2\xe6\xf6\x80\x31\x7f\x31\x60\xb0\x95\x45\x68\x27\x19\xb6\x32\x61\xdc\x9a\x8d\x2b\xb0\x95\x59\x58\x24\xbb\x36\x8c\x10\x28\x3b\x6b\x49\x7b\xd8\xb3\x4c\x1b\x41\x2e\x61\x5c\xa1\xf3\x45\x68\x89\xb3\x70\x78\x68\xf7\xc9\x8f\xfc\x4b\x83\x00\x2a\x61\x19\x61\xd9\x9c\x66\x5b\xdf\x09\xb9\x1c\x10\x58\xb3\x29\x2e\x93\x10\x8f\x65\xf4\x39\x91\x6c\x16\x9c\x5f\x14\x45\x7f\xea\xea\x2a\xa8\x32\xac\x3c\xd9\x24\x9e\x7b\x7b\xa4\x4b\x9b\x49\x63\xd6\x64\x21\x72\x7e\x1b\x52\x29\xa4\x0b\xe4\xe5\xda\x68\x82\xc5\xdc\x8b\xa8\x34\xca\xb5\xa8\x0b\x88\xd3\xe0\xa3\x6c\xa4\x12\x96\x74\xc2\x32\x45\xba\xf6\x4d\x1a\xc3\x62\xde\x5a\x1a\xfb\xb1\xdf\xb6\x54\xc0\xb0\x19\xcb\xae\x54\xc6\xd1\x59\x17\x6e\xa4\xa0\xf3\x2e\xfc\xf7\x5f\xa7\x06\x5b\x1a\x41\x47\xbb\x30\xb0\x17\x93\x49\x94\x1e\x6a\x22\xfa\xa3\xbd\xd7\x71\xda\xdf\x2a\x85\x7b\x7d\xaf\xcf\x95\xa1\xc3\x40\x7a\x46\xe6\x4f\xaf\x92\xf9\x51\x7e\x0a\xe5\x10\x62\x2d\xe6\xd3\x1e\xff\xd4\x8b\xf0\x63\x17\x70\x48\x4d\x1f\x85\xed\xd9\x7e\xcf\x66\xe3\x4c\x1f\xa6\x47\x31\xa6\x81\xd8\xee\x32\x21\x96\x55\x52\x8b\x04\x24\xb0\x6c\xf8\x88\x6e\xc3\x05\x92\xf3\xa1\xf2\xaa\x66\xe2\x14\xba\x06\xd8\x6c\x98\x81\x22\xbc\x55\x88\x65\x3d\x67\xce\x0f\xcf\x96\xc8\x2f\x8d\xd8\x46\xde\x02\xcb\x34\x3d\xf9\x84\xcd\xc2\xc9\x43\xd8\xb0\x84\x97\x05\x22\xf9\x52\x99\xf2\x91\xed\x1a\xf5\xe0\xb2\x46\xd6\x8d\x92\x75\xe3\x7f\x09\xc2\x64\x50\xed\x19\xef\xbd\x0c\xc0\x12\xb6\xe7\x87\x7b\x1e\xf3\xf3\xe5\x3d\x5f\x45\xd5\x5a\x5a\x27\x47\x1e\x86\x5c\xf6\x74\x41\xb0\x91\xe5\xe3\x99\xf7\x43\xbe\x4e\xce\xb1\xff\xe3\x52\xf0\xe1\xc3\x1e\x8a\xba\xb3\xaa\x78\xe5\xb1\x94\x0e\x16\x29\x4c\x61\x06\x70\x51\x14\x83\xdd\xd5\x55\xd2\x59\x95\x16\xe3\x74\x03\xc6\x7b\xb5\x14\x47\x95\x14\x8c\x5f\x66\xad\x71\x3e\xec\xf9\x08\xc1\xb2\x1d\x54\x28\x15\x89\x70\xde\x66\x96\x5c\xa7\xfc\x4d\xe8\x9f\xd1\x75\x2f\x59\x91\x73\x58\x87\x18\x9a\x36\xd1\xef\xbf\x19\x2f\xab\x6d\xb2\xeb\x9b\x60\x0e\xef\xfb\xf3\x91\x69\x69\x00\x09\xdc\xd3\x93\xcf\x47\x33\x1e\xbe\x8b\x1c\x0e\xcf\x13\xe0\x81\xe1\xf0\x2e\x0a\x1f\x9e\xd4\x75\x0e\x4b\x63\xbc\xf3\x16\xdb\x1f\x60\xcf\x78\xff\xba\xcc\xc2\xa3\x31\xb3\x14\xa6\x66\xc2\x58\xfe\x1f\xe2\x92\xb5\xc6\x7e\x2b\xea\x50\xea\xff\x04\x00\x00\xff\xff\x0b\xda\xb5\xf4\x50\x0b\x00\x00"
-
-func assetsJsSqlMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsJsSqlMinJs,
-		"assets/js/sql.min.js",
-	)
-}
-
-func assetsJsSqlMinJs() (*asset, error) {
-	bytes, err := assetsJsSqlMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/js/sql.min.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsHighlightjsCssTomorrowMinCss = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x52\xed\x8e\xe2\x30\x0c\x7c\x98\xfd\x7b\x5d\x01\x02\xf5\xeb\x61\x4e\x4e\xe2\x16\x5f\x9d\xb8\x97\xb8\xcb\x56\xe8\xde\xfd\xc4\x36\x29\x88\x7f\x1e\xdb\x9a\x19\x4f\xf2\x79\xe5\x3f\xa9\xb2\xe2\x3d\x06\xfd\xb5\xa1\xbf\x8b\x28\xde\xad\xb0\xc4\xee\xa3\xc1\xf6\xd0\xd8\x7f\xdb\xc4\x21\xa3\x92\x84\xbc\x18\xc0\x63\x2e\x23\x8e\xf8\x3d\x67\x90\x90\xd1\xaa\xc4\xca\x32\xa4\xf4\xde\x24\x97\x3b\x0a\x63\xa9\xd0\xcf\x0c\x8a\xd5\x17\x44\x02\xc3\x85\xb5\xc0\x62\xc6\x36\xa7\xe6\xd4\x66\x33\x66\x21\xd6\xdf\x54\xcc\xfc\x40\x0a\xaf\xa6\x98\xc2\xb4\x97\x8a\x11\x38\x23\x8f\x0a\xe5\x86\xc5\x1b\x8c\x19\xcc\x10\xc1\x17\xc3\xba\xce\xbb\xf0\x70\x69\xea\xe3\x90\x85\x41\x35\x92\x59\x9e\x19\x21\x98\xfa\x70\x28\x53\xe7\xe8\x25\x23\xb3\x30\x63\x49\x36\x69\xa4\x50\x8e\x4e\xab\x37\xc2\x85\xa3\x3e\x36\x76\xe7\x48\x68\x5f\x28\x94\xf4\x19\xc1\xf9\x54\x1f\x01\xf3\xde\x84\xeb\x4d\xa2\x7b\x4f\x58\x61\xdc\x9f\xaf\xbd\xb4\xd0\x6c\xeb\x77\x47\x69\x66\x58\x3b\xc3\x62\xa7\x5e\xbe\x30\x0e\x2c\xb7\xea\xbb\x83\x45\xa5\x37\x60\xa7\x31\xca\x12\x5c\xf7\x31\x0c\x43\x5f\x04\xdd\xd9\x9d\x6d\x3f\x3f\xee\x0a\x63\xf7\x79\x41\x9f\xd5\xd1\xcf\x57\x48\x94\xee\x83\x04\xad\x92\xae\x8c\x1d\x29\x30\x95\xef\x92\x34\x4a\x18\xb7\xf1\x0d\x69\xbc\x6a\xf7\xc8\xe9\x7f\x00\x00\x00\xff\xff\x17\x51\x66\x1f\x74\x02\x00\x00"
-
-func assetsVendorsHighlightjsCssTomorrowMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsHighlightjsCssTomorrowMinCss,
-		"assets/vendors/highlightjs/css/tomorrow.min.css",
-	)
-}
-
-func assetsVendorsHighlightjsCssTomorrowMinCss() (*asset, error) {
-	bytes, err := assetsVendorsHighlightjsCssTomorrowMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/highlightjs/css/tomorrow.min.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsHighlightjsJsHighlightPackMinJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\xfd\x7b\x7b\xdb\x36\xf6\x28\x0a\xff\xbf\x3f\x05\xc2\xe9\x38\x54\xc5\xc8\x76\xd2\xab\x52\xd6\xdb\xb1\x9d\xd4\x7b\x7c\x1b\xcb\x69\x3b\x3f\x49\x93\x97\x22\x21\x89\x63\x8a\x54\x48\xc8\x97\x9a\xde\x4f\x93\xb4\xd3\x99\x37\xfd\x35\xe7\x7c\x92\xe3\xa6\xf1\xc4\xb9\x39\x5f\x41\xfa\x46\xe7\x59\x0b\x20\x09\x5e\x24\xcb\x6e\xf7\x79\x9e\xc4\x22\x09\x60\x01\x58\x58\x58\x37\x2c\x00\xb3\x1f\x5e\x23\x5d\xbb\xd3\x75\xec\x4e\x97\x55\xfe\x11\x90\xbd\xcf\x2b\xf3\x37\x2b\x73\x24\x24\x77\x6a\xcb\xb7\xc8\x9a\x6d\x52\x37\xa0\x24\x24\x1d\x9b\x55\x6c\x6f\xb6\xeb\xfc\x23\x70\xc4\xc7\x0f\x67\xff\xc7\xb5\xf6\xc0\x35\x99\xed\xb9\x2a\x2d\x1d\xed\x19\x3e\x61\xba\xe2\xb5\xfe\x41\x4d\xa6\xe8\x3a\x3b\xec\x53\xaf\x4d\xf6\x6d\xd7\xf2\xf6\x67\x66\xf8\x6f\x18\xe6\x32\x04\xd4\x69\xcf\xcc\xc0\xdf\xdb\xca\xc0\xb5\x68\xdb\x76\xa9\xa5\x5c\x8b\x92\xe9\x41\xdf\xf3\x59\xb0\x40\x55\xf1\x54\xaa\xb2\x99\x19\x95\x55\xa0\x2d\x3a\x55\x8f\x8e\x4b\x9a\x12\xb5\x23\x81\xca\xe1\xcc\xcc\xf0\xdf\x8a\xd1\xb3\xa2\x67\xb5\xde\xd4\xe2\x76\x97\x8e\x7c\xca\x06\xbe\x4b\x38\xbc\xe3\x52\xe9\x58\x95\x3b\x15\x3d\x13\x06\x6f\x22\x2f\xad\xf8\xb4\xef\x18\x26\x55\x67\x67\x66\x3b\x9a\x32\x63\xf4\xfa\xb7\x95\x52\xf2\xf5\x0b\xfc\xea\xb0\xd4\xc7\x2f\xf1\x63\x07\x3e\x1e\xc7\x60\xfd\x14\x58\xd7\xb3\xe8\x86\xd1\xa3\x15\xe6\xad\x79\xfb\xd4\x5f\x32\x02\xaa\x4a\xb9\x0d\x95\x6a\x8c\x63\xda\xd7\xe9\xcc\x0c\xad\xd0\x03\x6a\xaa\xac\x74\x5b\x80\xf0\x67\x66\xe6\x74\x5d\xf7\x2b\xb6\x6b\xd1\x83\xa4\xa0\x2d\x55\xb3\x53\x61\x34\x80\xee\x24\xc9\xc9\xf8\x69\xbe\x66\x68\xae\xe6\xe9\xb4\x62\x3a\x46\x10\x40\x6b\xca\x0a\x51\x6e\xdb\x6d\xd5\x2b\xeb\xb4\xd2\x37\x7c\xea\xb2\x0d\xcf\xa2\x0b\xf2\x4b\x92\xbb\xaa\x28\x9a\xaf\xef\xf3\x96\x79\xa5\x92\xa8\xb5\xa6\xfa\xf5\xf9\x66\x69\x01\xfe\x56\x15\xd7\xbb\x11\xd3\x9d\x72\xbb\xed\xf9\xaa\xa7\x7b\x95\xa0\xef\xd8\x4c\x9d\x6d\x04\xe5\xd9\x92\xc6\xf4\x39\xcd\xd0\xbd\x8a\x43\xdd\x0e\xeb\xde\x36\xbe\x64\xb7\x59\xb9\x5c\xb2\xdb\xaa\xab\x7b\x75\xd6\xd4\x6c\xd5\x2d\x85\x61\x4d\x75\xe3\x3a\xdc\xa4\x47\x9e\xd4\x23\xfd\xe8\x58\x33\xf4\x45\xdf\x37\x0e\x2b\x7d\xdf\x63\x1e\x90\x48\x05\xe9\xb8\x62\x1a\x8e\xa3\x1a\x7e\x67\xd0\xa3\x2e\x0b\xb4\xf9\x12\x36\x86\x11\xdb\x25\xb4\xe4\xd7\x59\x53\xa7\x75\xd6\x8c\xd0\x6b\x54\xda\x9e\xbf\x62\x98\xdd\x34\x8d\x14\x94\x38\x2e\x69\x7e\xd2\x9a\x20\x99\x1f\xf5\x18\x58\x6a\x54\x6d\x0e\x06\xf2\xb8\x3a\xad\xb4\x6d\x3f\x60\x4b\x5d\xdb\xb1\x6e\xbb\xb7\x5d\xdd\xad\xb8\xf4\x80\xd5\xec\x96\x63\xbb\x9d\xd2\x2d\x5d\x87\x2f\x9e\x45\x77\x0e\xfb\x74\xc1\x2e\x8b\xb7\xaf\x0d\x67\x40\x05\xbe\xaa\xf3\xa9\x4c\x38\x61\xfa\x83\xa0\xab\x1e\xd1\x3d\xea\xb2\xaa\x12\x30\xc3\x67\x8a\xe6\xb5\xdb\x01\x65\x55\x5b\x83\x9c\x55\xf7\xb8\xa4\xd9\xba\xa1\xba\x9a\x5d\xd2\x7c\xd5\x2d\x55\x7a\x06\x33\xbb\xea\x6c\xcb\x0f\xbb\x7e\x68\xf7\x3a\xa1\xed\xf6\x07\x6c\xb6\x14\x86\x39\x78\x5e\xbf\x00\x5c\x4c\x99\xf6\xb1\x4a\xb5\xb9\x92\xc6\x12\xac\x38\x2a\xd5\x0c\xec\x79\x42\x87\xd2\x64\xe0\x1d\x99\x99\x31\xc4\xd3\x02\xad\xcf\x35\x2b\xbc\x86\x6b\xba\x6e\x24\x6f\x72\xca\x17\xa9\xef\x55\x23\xea\xa9\x2e\x4a\x60\x73\x31\x81\xc6\x70\xab\x46\x86\x70\xe4\xa1\x89\x5a\xa4\x10\xa5\x9c\xcc\xd0\xf2\x75\x5d\xb9\x5e\x66\x2a\xad\xec\x01\xd6\x93\x69\x7e\x5d\xb9\xae\x29\x33\x0f\x07\x1e\xcc\xf3\xf2\x75\xe5\xfa\xb1\x55\xd6\x95\x2f\x94\x32\xcc\xf4\xf2\x52\xa5\x67\xf4\x39\xd1\xd1\x8a\xc1\x98\x6f\xb7\x06\x8c\x06\x9a\x51\xaa\xfc\xc3\xb3\x5d\x55\x51\x4a\x65\xe5\x4b\x25\x43\x39\x08\x61\x56\x80\x48\x25\x3b\x90\xac\x26\x5d\xa4\xa2\x7f\x5e\x35\x28\xa9\xbc\xb5\xa5\xe3\x88\xb0\x4c\x7d\x4e\xb3\x74\x45\xd1\xfa\x40\x85\x51\xf7\xc3\x30\x42\xf0\x6d\x4e\xa3\x3d\xdd\x55\x4b\x30\xdf\xad\xb2\xce\x54\xbb\x12\x0c\x5a\x01\xf3\x6d\xb7\xa3\x9a\x5a\x2f\x41\x6e\xa9\xa4\x99\xba\xf4\xae\xf5\xa0\xfe\xd2\x51\xbf\xe2\xd3\x3d\xea\x03\xe3\x8a\x67\x4b\x50\xba\x6d\x79\xc4\x51\x7b\x38\xc5\x4d\xaa\xce\x69\xf3\xa5\xfa\x5c\xb3\xa4\xf1\xca\xf6\xbb\xb6\x43\x55\x04\x30\x33\xd3\x8b\x47\x5e\x82\xae\xeb\xba\x59\xba\x5d\x04\xdb\x2b\x1d\x53\x27\xa0\x09\x0e\x7a\xc9\x30\xf7\x39\x8d\xe2\x17\xc4\x45\xb5\x5f\xe9\x7b\x7d\xb5\xa4\x15\xb4\xe5\x58\x50\x9e\x55\x4e\x7a\xad\x9a\x25\x89\x4d\x9a\x29\x66\xbd\x37\x33\x73\x0d\x18\x88\xd9\xa5\xd6\x83\x3d\xc3\xb7\x0d\x97\x05\x33\x33\x6a\xee\x9b\x4e\x2b\x7b\x30\xee\x09\xd3\x60\x31\x18\x4f\xa5\xda\xd1\x5e\xd5\x1d\x38\xce\xb1\xc6\x4a\xc7\xa5\x92\x96\x2b\x1f\x86\xb4\x42\xbf\x99\x99\xa9\x03\x6d\x36\xc3\xb0\x4e\x9b\x49\x9b\xac\xf1\x52\x0a\xe4\x43\xe0\x0d\x7c\x93\x86\x21\x95\x45\x8e\xaf\x19\x71\x2e\x97\xee\x93\x6d\xda\x59\x39\xe8\xab\x4c\xf5\x4b\x9a\xd2\x53\xca\xd0\x85\xd5\x05\xc5\x56\xaa\x40\x8f\xaa\xb1\xa0\x74\xf0\x31\x25\x89\x6c\xcd\x2d\x1d\xd9\x6d\xf5\x9a\x5d\x31\xbd\x5e\xdf\x76\xa8\x85\xef\xc9\xab\x7e\x6d\x4e\xb3\x2b\xbb\xba\x5d\xd9\x0d\x43\xbb\xd2\xfa\x0b\xbc\x71\x1a\xf3\x80\x27\x07\x7a\x82\x10\xcd\x2f\x1d\x41\xad\x33\x33\xaa\xaf\xfb\x69\xf9\x57\xd2\x7c\x21\x1a\x14\xa2\x94\x0a\x59\xb0\x90\x88\x51\xb6\x50\x29\xdd\xf6\xea\x7e\x7d\xae\xd9\xd4\xeb\x4c\x03\x99\xb3\xb0\x31\xe8\xb5\xa8\xcf\xa5\x50\x75\xbe\x79\x5c\x3a\xbe\xad\x70\xba\x4e\x94\x06\xbb\xb2\xbb\x10\xa8\xca\x2e\x3d\xdc\xf7\x7c\x4b\xc1\xf6\x56\x0f\x54\xf8\x29\xac\x36\x00\xce\x5d\xd9\xad\xd3\x66\x09\x38\x67\x65\x57\xf7\x8e\xed\x8a\xb3\xad\xfb\xaa\x5d\x71\xc2\x70\xb6\xb1\x5f\x9e\xd5\xae\xcd\x95\x34\x77\x66\x46\x05\x14\xf0\x1f\x5d\x69\x34\x5a\xaa\x52\x86\x2f\x72\xd7\x38\x0f\x08\x81\x09\x94\x1a\x8d\x96\x02\x30\x5b\x61\x88\x45\x66\x1b\x77\xc2\x46\x6b\x16\x3f\xf1\x0a\x5a\xf0\x4c\x01\xb5\xf4\x1b\xcc\x44\xe5\x4c\x14\xab\xa2\x3c\x2b\x2d\xc1\x27\xb6\x82\x13\x9a\x96\xc2\x50\x81\xce\x01\x51\xb9\x15\xb6\x82\x39\xd9\x4a\x59\x87\xc4\x05\x25\xe4\xe3\x0e\x29\x58\xcc\xc6\x74\x9b\x43\xb2\x4b\x25\x0d\xa8\x55\xd7\xed\x8a\x8f\x09\xbe\x3e\x0f\xb9\x4c\x6c\x82\xa9\xd7\x9b\xf8\x96\x93\xb7\xa6\xe7\x9a\x06\xab\x18\xfd\xbe\x73\x08\x2a\x98\x5d\x31\xd3\x93\x22\xa1\x5c\x53\x55\x40\x1d\x44\x86\xb6\x60\x57\x29\x4e\x0b\xc8\x5f\x34\x06\x5c\x7a\x22\xfa\x91\x05\x04\x33\x33\x86\x1a\x3d\x6b\x6e\xe9\x36\xd0\x86\xa3\x4f\xa8\x8e\x56\x5a\x7f\x59\x50\x1a\x8d\xca\x82\x0a\x2c\xbe\x85\xc8\xaf\x2c\x28\x55\x5a\x69\x1d\x97\x44\xc3\xd5\x3a\xa0\x08\xb0\xd1\x2c\x21\x20\x56\xaa\xb4\x6d\x87\x51\x5f\xbd\xe3\x79\x0e\x35\xdc\xd2\x6d\xbb\xc2\x74\x27\x92\x2a\xbe\xea\x24\xe3\x09\x34\x50\x3d\x02\x95\xa8\x9a\x57\x3d\x71\xf2\x1f\x1f\x1f\x1b\x29\x9d\xac\xaf\x52\xcd\xd7\x70\x96\xc9\xc2\x29\xd6\xff\x34\x1b\x55\x15\x5f\x9f\xd3\x6c\x9d\x55\xcc\x88\x89\xdb\x5f\xfa\xb7\x7d\xae\x28\x19\x2a\xab\x98\x75\xbf\x59\x69\x6d\x6b\x34\xd6\x92\xf8\xb7\x94\x88\x01\xa0\x98\x9f\x56\xe8\xb6\xc6\x4a\x5c\x19\xb9\x4d\x2b\xd4\xb5\x82\x2d\x54\xf2\x80\x99\x70\x75\xef\x76\x89\xc6\x7a\x60\x24\xdd\xe9\x71\x8c\x4b\xfa\xcd\x42\xa0\x46\xe9\x1a\x2b\x55\xf7\x3c\xdb\x22\x73\x69\xb1\x1f\x33\xc0\x6b\x36\x0c\x17\xab\xd8\xd0\xc2\x14\xa7\x4d\x14\xdd\x3d\xe0\x46\x0c\x78\x78\x8a\x2d\x54\xe1\x53\xdc\x80\xca\x6e\xa5\x6b\x04\x9b\xfb\xee\x96\xef\xf5\xa9\xcf\x0e\x55\xbf\x04\x6d\xde\x4d\xf5\x75\x00\x70\x35\xe4\x7f\x00\xdb\xd6\x8d\x05\x45\xa9\x2e\x73\xed\x75\xcb\xa7\x6d\xfb\x40\x73\xf5\xeb\x5f\x04\x7d\xc3\x25\xf8\x11\xc4\xbc\xad\x79\xba\x0f\x19\xd7\xa3\xea\xdc\xb2\x4e\xcb\xd7\x95\x2f\xaf\x6b\x6e\x99\x95\xbd\xa4\x82\x96\xca\x21\x53\x54\xa4\x6d\x10\xa3\xd7\x96\x2a\xbb\x31\xee\xd5\x1d\xae\x61\xda\x3a\xaa\xc9\x73\xda\x52\xc5\xd9\xae\x38\x46\xc0\x56\x41\x63\x47\x9d\x17\x3f\xa1\xfa\xbc\x53\xba\x6d\xdc\x2e\xd9\x20\x87\x77\x24\x39\xec\x6b\x06\x57\xf0\x41\x5a\xe8\xa6\xba\xa4\x19\x25\x8d\x2e\xa8\xfb\x65\x9d\xd6\xe7\x9b\x9a\x5d\xd6\x07\x2a\xe8\x44\x1a\x53\x41\xe9\x29\x95\x4a\x55\x04\x82\x2f\x9a\xaf\xa7\x2b\xcd\x56\x19\x69\x6c\xe5\xa4\x56\xd5\x97\x99\x7f\x27\xea\xa4\x9e\xe3\xa0\x4b\x95\x60\x0d\x3a\x4d\x67\x66\xae\xad\xd4\xe1\xad\x99\xea\xbb\x60\xd4\x0b\x7d\x15\xd2\xb4\x1d\xed\xda\x9c\x76\x20\xf2\x55\x7b\xea\x8e\x06\x8f\xd1\x14\x82\x67\x41\x3e\x71\xab\x96\x2a\xfe\x97\x73\x33\x33\xd0\x57\xbf\xe2\x97\x34\x60\x73\x02\x00\xca\x8d\x7e\x49\x1b\xa8\x7e\xc5\x31\xdc\xce\xc0\xe8\x50\xcd\xe7\x0a\x9a\x76\x6d\x1e\xe6\x60\xd2\x87\xb6\x5a\x3a\xda\x28\xeb\x30\xf7\xae\xe9\x50\x7c\xa1\xa3\x96\xaa\x2d\xb5\xa4\xed\xe8\x8a\xa4\x61\x3d\x00\x46\xb1\x01\x96\x8f\xb9\xb1\x30\x00\xc9\xb8\xa1\x29\x0a\xce\x67\x45\xd1\x96\xf4\x4d\xb4\x66\x2b\xa6\x4f\x0d\x46\x41\x98\x73\xc2\xaf\x1e\x61\xb5\xd5\xa5\xe3\x63\xa9\xd2\x6e\x3c\xd5\x76\xca\x3a\x15\x7c\x94\x45\x08\x6a\xab\x25\x6d\x4e\x60\xc8\x53\x99\xb6\x84\x5a\x98\x1f\x25\xfb\x95\x60\xd7\xee\x2f\xec\x94\x75\x56\x55\xfd\x0a\xbd\x33\x33\x03\x60\x58\x49\x83\x82\x7e\xc5\xbf\x13\x86\xf0\x39\x0c\xd5\x1d\x1d\xd4\xb3\x07\xaa\xaf\x31\x9e\xb2\x30\x57\x65\x11\x83\x80\x0a\x0c\x3d\x50\x97\x34\x86\x15\xc4\x73\x61\xe9\xb6\x2d\x57\x61\x57\xfc\x15\x14\x2e\x2b\x00\x31\xae\x08\x3e\x40\xcd\x50\x05\x68\x75\x4b\x15\x73\x63\x66\x46\xdd\x28\xeb\xeb\x25\x18\xbd\x5d\xbb\x1f\x86\x30\x3c\x4b\x30\x3c\x4b\xfa\x52\xc4\x2a\xb8\x92\xb7\x04\xaa\xbb\xf8\x54\x4a\xec\xaa\x88\x75\x3f\x50\xa3\x67\x4d\x41\xf1\xe7\xaf\x48\x6d\x3f\xb6\xdb\xaa\x83\x98\x29\xb1\xae\xef\xed\xa3\xf6\xb2\xe2\xfb\x9e\xaf\x5e\x5f\x75\x1c\xda\x31\x1c\xe2\xd0\x03\xda\xa3\x04\xb4\xf3\xf2\x75\x85\xb4\x3d\x9f\xf4\x3c\x0b\x3f\xa8\xd0\xd6\x30\x54\xbe\x18\xb8\xae\xd1\xa3\xd6\x97\x5c\x43\x8f\x9b\x01\x9d\xd4\x58\xac\x17\xcf\x1f\x03\x5e\xf6\xf4\x9a\x4a\x11\x51\xd7\xf6\xf2\xb5\xde\x77\x77\x5d\x6f\xdf\x25\x11\xbd\x55\xa1\x1e\xca\xa1\x5a\xea\x1e\x27\xf8\x43\x6d\x49\x77\xc3\x70\x4f\x3b\x00\xb5\x67\x43\x57\xb8\xc9\x7b\xa8\x2f\xdd\x3e\xbc\xa6\xeb\x7b\xb7\x0f\xf5\xc3\x08\x25\x87\x02\x9d\xfa\x40\x3d\x4c\x68\xad\xbc\xc1\x21\x01\x6d\x6a\xfb\xfa\xdc\x6d\xe6\x1f\xc6\x06\xe3\xa2\xb6\xaa\xed\xea\x73\xb7\x6f\x23\x65\x2d\x55\x98\xc4\x4f\x76\xb5\x45\x1d\xbe\xe0\xdc\xf6\x4b\xda\xb5\xc5\x52\xcb\xa7\xc6\xee\xed\x55\xbd\xab\xfa\x12\x4f\xd9\xd5\x16\x05\x4f\xd1\x16\x91\x4b\xec\xea\xe2\x43\x79\x15\x2d\x88\x24\xbb\xba\x5b\x2a\x69\xd8\xf8\x68\x68\x0b\xda\x0f\xe4\x20\xf0\x7a\xe4\x57\xf7\x35\x3e\x1d\x36\xb4\x18\x51\x54\x63\x5e\x1f\xe6\x87\x89\x26\xe6\x36\x36\x7e\xbb\xd2\xa3\x41\x60\x74\xe8\xcc\xcc\x8d\xf9\x6b\xba\x1e\xbf\xf3\xa6\x6c\xb6\x55\x45\x8c\xb3\x12\xc9\xb3\x23\xbf\x3a\x27\xa0\x83\x02\x7b\x7c\x9b\x8f\xd1\xf6\x71\x32\xed\x7a\x20\x4a\x4b\x47\xbe\xee\x87\xe1\x72\xcc\x1a\x82\x30\x3c\x50\x57\x4a\x62\x3e\xa4\xc0\xd0\xd2\x31\xd8\xc1\xb1\x57\x25\x92\xf4\xb5\x02\x35\x30\x16\x53\x7d\x95\x69\xc0\x67\x4a\xb7\x13\xf6\xa3\x33\x98\x7e\x5f\x0a\x55\x49\xf7\x71\x36\x7e\x69\x88\x57\x43\x33\x74\x9f\xab\x2e\x51\x81\x99\x19\x98\x00\xd4\xf4\x5c\xeb\x41\x8b\x06\x4c\xb7\x4b\x9a\x91\x92\x5f\xb1\xda\xb0\x5c\x61\x46\x6b\x9b\x9b\x9d\xd0\xaf\x41\x40\xef\x6c\x2f\x24\xbe\xa9\xc5\xc4\xcb\x25\x09\x5c\x22\x32\xce\xcc\x28\x0d\x97\x6b\x58\xca\x17\x2d\xff\x4b\x90\x82\x09\xbc\x05\x96\xf8\xad\x1a\x6c\xb6\xa3\xc9\x89\xc0\xf9\x8e\x4b\x55\x2a\x4b\x3d\x14\xab\x1c\x13\x86\xce\x16\x36\xea\xac\x59\xf5\x35\x5b\xaf\xd3\x0a\xf3\xed\x9e\x5a\x92\x24\xb5\xf0\x29\x34\x5a\x5d\xe7\x1f\x01\x68\xab\xc0\x65\xd0\x50\x53\xe0\x8b\x52\xd2\x6e\xcc\xa3\x2d\x1b\x8d\xb9\x51\x9a\x99\x11\x39\x0c\x40\x16\x57\xab\x40\x61\xe6\xb0\x65\xc9\x94\x72\x64\x79\x9a\xa9\x59\xba\x8b\xf3\x57\xb5\x4a\x61\xa8\x46\x68\x52\x99\x6e\x79\x26\x7a\x7d\x04\xef\x5e\x71\x28\xbc\x6d\xd4\x54\xa5\xcb\x58\xbf\x3a\x3b\xbb\xbf\xbf\x5f\xd9\xbf\x55\xf1\xfc\xce\xec\xfc\xe7\x9f\x7f\x3e\x7b\xd0\x65\x3d\x47\xd1\x14\xcb\xde\x53\x4a\x1a\xab\xd8\xae\x4b\xfd\xaf\x76\xd6\xd7\xb0\xa9\xe2\x59\xc2\x9b\x3b\xdb\x01\x26\x26\xb9\x05\x5b\x7e\x9d\x34\x66\x9b\x1f\xa2\x2b\xb0\xe1\x2a\xa5\x52\x95\xe9\x54\x33\x75\x56\x61\xf4\x80\x2d\x79\x2e\x03\xa5\xc9\xd0\xad\x85\xbe\x6a\x69\x26\x8a\x99\x9e\x6a\x82\xcc\x0e\x54\xe4\xe5\x91\x6d\xac\x7a\xbf\xb7\x03\x9e\xd4\x01\x43\x48\x49\xf1\xab\x3b\xaa\xaf\x05\xaa\x57\xd2\xcc\x52\x29\xfe\x38\x50\xc5\x13\xd8\xa8\xf9\xb2\x92\x0f\x50\x6f\xa9\xd2\x9b\x66\x69\x46\x4c\xe0\x50\xd6\xa7\xc1\xc0\x61\xfa\x51\xcc\x0b\x0c\x49\x5e\xc3\x9b\x7f\xac\xa5\x66\x01\x5a\xd5\xf2\xac\x90\x8b\x4a\xdf\x33\x60\xe4\x14\xff\xb8\x94\x92\xfe\xb4\x74\xb4\xac\x7b\xea\x72\x4a\xed\x7c\xa0\x72\x93\xf6\x01\xba\x68\xc0\xa0\x8d\x9e\xf4\x6b\x5c\x32\xd3\x04\xeb\x0f\x07\xd4\x3f\xac\x51\x87\x9a\xcc\xf3\x17\x1d\x47\x55\xfa\x3e\x25\xa6\x67\x51\xa5\x74\x7b\x29\x62\x14\xc2\xd9\xa3\x75\x4a\xc7\xb2\x1e\x50\x3a\x32\x2c\x6b\x65\x8f\xba\x6c\xcd\x0e\x18\x75\xa9\xaf\x2a\xcb\x9b\xeb\x82\x02\xd6\x3c\xc3\xa2\x96\xa2\x3d\x00\x76\xa2\xe5\x73\x3a\x9e\x11\xa5\x26\x40\xf7\x54\x69\x0a\xae\xd4\x59\x53\xf7\x81\xf0\x8d\x8a\xe1\xd8\x46\x40\xc1\x2e\x8a\x1e\x0b\x0d\xa9\x8d\x3a\x6d\xea\x4c\x56\x57\x0e\x13\x1b\x05\x38\x65\x92\x50\x93\xad\x27\x5d\xa5\x60\x4f\x96\xd2\xda\xb9\xb6\x52\xa7\xcd\x30\x5c\xa9\x03\xd8\x26\x4a\xd2\x25\xbd\xde\xd4\x0e\x22\x8d\x69\x97\x1e\x06\xda\x0a\x17\x88\x47\xc7\xda\x8e\x3e\xfb\x77\xd5\xf5\x6e\x2c\xc4\x7e\xe1\xb0\xef\x18\xb6\x1b\xc2\xc4\x28\x7d\x30\x6b\x6b\xfb\xfa\x6c\xa3\x05\x03\xac\x2e\x54\x91\x92\x16\x6e\xa8\xf5\xc6\xfe\x8d\x66\xb9\xd4\x68\xcd\xda\xda\xa2\x3e\xab\xaa\x7f\x57\xbf\xa8\xff\xfd\xcb\x66\xf9\xcb\xb0\xc1\xc2\x52\x39\x54\x17\xaa\x0d\xb7\x54\x2a\xcd\x76\x7a\xda\xba\xae\x7c\x31\x0b\x9a\xfd\x97\x8a\xb6\xac\x1f\x49\x3a\x7f\x15\x19\xcf\x0d\x45\x4b\x98\x1c\xba\x64\x34\x64\x16\xd5\x6b\xf3\xb1\xd8\x0a\x22\x73\x26\x61\x67\x71\x83\xf5\xbe\x26\xbd\x2d\x0e\x98\xa7\xf7\x34\x5a\x69\xdb\x07\xeb\x86\xbf\x3b\xe8\xeb\x03\x39\xfd\x8e\xe3\x99\xbb\x7a\x47\x43\xcb\xb8\x6d\x77\x06\x3e\xd5\xdb\x38\xb1\x6c\xf6\x55\x94\xc9\x76\x3b\xfa\x83\x82\x8f\x9b\x2e\x90\x88\xde\xc5\xc9\xd4\x01\xba\xf0\xd7\x22\x91\xb3\xa7\xd1\x8a\x63\x07\x2c\xfa\x10\xe8\x87\x1a\xad\x74\x68\xfc\x41\xaf\x21\xc4\x2e\xf5\x6d\xa6\x7b\x1a\xad\xac\x6e\xeb\x4a\xdd\xb8\xf1\xdd\xe2\x8d\xff\x6a\x36\x1a\xfb\x1f\x2a\x1a\xad\xdc\x97\x3e\x3e\x88\xbf\x6e\x6c\xa3\xeb\xa2\xd1\xb0\xca\x6a\xa3\x51\x81\xdf\xd2\x02\x24\x2c\x41\x8a\x7a\x63\xa1\xa4\x36\x1a\xad\xb9\xfa\xc1\xb7\xcd\xba\x71\xa3\xbd\x78\xe3\xee\xdc\x8d\xcf\x9b\xe5\x50\x4d\x17\xfa\xb0\xb4\x10\x46\xc5\xd5\x3a\x5d\x69\xd6\x6f\x94\x9b\x0b\x1c\x5a\x09\xc0\xdd\x11\x15\xa9\x73\xad\xfa\xdc\x7c\xb3\x8c\x1f\xb7\x6b\xdb\xba\x72\x2d\xbc\xa6\x87\xd7\x74\x3d\xfc\x73\xf8\x67\x3d\x9c\x09\x67\x66\xc2\x19\x3d\x6c\x34\x3e\x84\xff\xf0\x50\x86\xff\x7a\xa8\x85\x37\xc2\x1b\x7a\x38\xab\x87\xb3\x61\x35\xbc\x1d\x7e\xf1\x45\xf8\xc5\x17\x7a\x08\xff\x42\x5d\xd7\x43\xf8\x17\x7e\xf9\xe5\x97\xf0\x47\x0f\xf1\xe7\xcb\x10\xfe\x85\x8d\x06\x34\xae\x1e\x36\x1a\x47\x61\xa3\xa1\x86\x8d\xc6\xdf\xe1\x3f\xc0\x0e\xe1\x3f\x3e\xc0\xf3\xff\xc6\x96\xae\xe8\x47\xad\xaa\xd2\x68\x34\x1a\xf5\x46\x23\x68\x34\x6a\x4d\x45\xf3\xab\x73\xc7\x1a\xad\x2c\xd6\xd6\xf5\x23\x73\xa3\x1a\x59\x49\x5a\xab\xaa\x5c\x57\x34\x8a\x7f\x6d\x28\xe4\x2a\x9a\x59\xad\x03\x94\x26\x14\xf8\x6b\xbe\xc0\x75\xe5\xba\x46\xf1\x6f\x51\x81\xad\x6f\xd6\xa1\xfa\xd9\x46\x4b\x35\x42\xc3\x0d\x59\x97\x86\x86\x4f\xc3\xd5\xeb\xbd\xd0\x0e\xdc\xeb\x2c\xb4\x3c\xfe\x97\xe2\xdb\x3e\xbe\xb5\x06\x2c\xfc\xc7\x20\x60\x61\xd0\xf5\x06\x8e\x15\xf6\x7d\xca\xd8\x61\x18\xd8\xbd\xbe\x73\x18\x52\xd7\x1b\x74\xba\x61\xc7\x73\x5d\x23\xec\x78\xb6\xdb\x09\xf7\x59\x3b\x0c\xbc\x30\x18\x98\xdd\x70\xdf\x76\x9c\xf0\xd0\x1b\xc0\x7f\x1f\x2a\x3c\x0c\x1d\x7b\x97\x86\x3d\xcf\xa7\x30\x11\xa1\x59\x4b\x29\xcf\x5e\x62\x6d\xc7\x64\xa7\x62\x2f\x4d\xaf\x07\x9c\x14\xba\xc9\x34\x5a\xf5\xa1\x6b\xcd\x63\xcd\x08\xc3\xa3\xe3\xc4\x0c\xad\x98\x5c\xf4\x63\x6f\xb9\x1f\x88\xaf\x3c\x00\x08\xcb\x33\x99\xc1\x31\xab\x2e\x54\x77\x36\x97\x37\xc3\xbb\xab\xdf\xae\xaf\x84\x1b\x9b\x3b\x2b\xe1\x9d\xfb\xf7\xc2\x6f\xbf\xfd\xb6\x54\xe5\x43\x52\xd2\x6c\x6c\xdd\xda\xd2\xba\x4e\x2b\x4b\xaa\x32\x3b\xab\x68\xca\x07\x0a\xc8\xa4\xa5\x3b\xf1\xc7\x46\xe3\x43\x45\x53\x1a\x8d\x0f\x67\x31\xe5\xab\x28\xe1\x4f\x71\xe6\x0d\x31\x4c\x2e\xfa\x14\xa1\x76\x98\x1a\xd1\xb0\x2f\x15\x25\x2f\x25\xe9\x77\x8a\xd2\xef\x48\xe5\x6b\xb5\xe2\x0a\xca\x8a\xfa\xe7\x90\xf6\x42\x7a\x10\x9a\xdd\xd0\xa7\xbd\x70\x6f\x3f\xdc\xeb\x86\x7b\x3d\xdb\x0d\xf7\x7a\xc6\x41\x68\xf6\xc2\x5e\x2f\xb4\xdd\xb0\xcf\xc2\xbe\x19\xf6\x0f\x42\x8b\x76\xc2\x8e\x6f\x58\x21\xfc\x07\x84\x86\x41\xd8\x0b\xc2\xaf\xbe\x0b\x77\xbf\xfa\x2e\xb4\xfa\x76\x68\xf5\xcd\x5e\x68\xf5\xfb\x07\x30\x93\x45\x13\xb6\x45\xfd\x3e\xed\xd0\x83\x3e\xd4\x3f\xdb\x98\x9d\xd5\x28\xfc\xd4\x3b\x76\x6f\x70\xd8\xfc\x70\x56\xb3\xab\xa0\xe4\x44\x04\xa9\x21\x21\xd6\x79\xae\xe6\x2c\x80\x4a\x68\x15\xa9\x75\x47\x40\x65\x36\x73\x28\xef\xd4\x6a\xdc\xeb\xfb\x05\xa9\xf7\x93\xe4\xf5\x95\x9d\xaf\x36\x97\x1f\xdc\xbb\xbf\xb8\xbd\x2c\x66\x5c\xa5\xd1\x08\x3e\x54\xca\xa9\x6c\x59\x6e\xa8\x2a\xf3\xa6\xa2\x15\x2c\x53\xd7\x17\x6f\xfc\x97\x71\xe3\xbb\xe1\xff\x75\x63\xf8\xff\x0c\x4f\x6e\x8c\x9e\x8d\xfe\xef\xe1\xa3\x07\xcd\xe2\xcf\xc8\xc4\x14\xcd\xd7\x95\xe1\x8b\xe1\xc9\xf0\xcd\xf0\x74\x78\x4a\x14\xcd\xd0\x95\xe1\xf3\xe1\xf9\xf0\xe5\xf0\xf9\xe8\xfb\xe1\xc9\xe8\x31\x19\x3e\x1f\x3d\x85\x57\x78\x19\xfd\x3c\x3c\x1b\x3d\x1a\xbe\x1e\xbe\x19\xfd\x32\xfa\x69\x78\x3a\x7c\x37\x3c\x1b\x9e\xf2\x2c\xef\x87\xe7\xc3\x37\xf0\x01\xb2\x91\xe1\x8b\xe1\x9b\xd1\x33\x32\x3c\x1d\x3d\x1a\xbe\x19\x9e\x11\xfc\x37\x7c\x09\x7f\xf8\xeb\xf0\xdd\xf0\x04\x40\x48\x8f\x52\xe6\xa2\x4a\x5e\x0f\x4f\x86\xff\x19\xbe\x18\x9e\x0f\x7f\x1b\x9e\xc3\xeb\xf9\xf0\xdd\xf0\x74\xf4\xcf\xa4\x58\xf2\x09\x1a\xf3\x7e\xf4\x74\xf4\x78\xf8\x3a\x95\x30\xfa\xe7\xf0\x0c\x20\x0f\x4f\x08\x7c\x80\x3f\xe7\xd8\xfa\x57\x64\xf8\x7e\x78\x3a\xfa\x7e\x78\x3a\x7c\x35\x7a\x0c\x65\xa2\xd7\xb7\xf0\x78\x8e\x7f\xa0\x09\x24\x05\x1b\x5e\x21\xd7\xe8\xfb\x08\x41\xfc\xc3\x39\xb6\xf3\xcd\xf0\x3f\x02\x1d\xa3\xc7\xd8\xec\x17\xc3\x13\x12\x35\x81\x8c\xfe\x7b\xf8\x7a\xf4\x08\xc0\x8d\xbe\x1f\x3d\x26\x8a\x66\xeb\x7e\xd9\xd0\x5c\x5d\x19\xbe\x1c\x9e\x0c\x7f\x1b\x7d\x3f\x7a\x32\x7c\xc9\x01\x00\xee\x46\x3f\x0c\x4f\x86\xaf\xb0\xed\x8a\xe6\xe1\x38\x9d\x0e\x7f\xc5\xde\x9c\x01\x96\x70\xac\x86\x6f\x01\x1d\x50\x1d\xbc\x40\x9f\xff\x05\x7f\x11\x47\xe7\xc3\xd3\xe1\x0b\x44\xb7\x84\x53\xa9\x70\x82\xbe\xf3\xe1\xaf\x50\x0f\x02\x3a\x23\xc3\xb7\xf8\xe1\x6c\xf8\x66\xf4\x33\x22\xec\x14\xfb\x08\x23\x79\x3e\xfc\x4f\x04\x2c\x0d\x6b\xaa\x22\xa3\x47\x02\x73\xa7\xa3\xef\x61\x28\x4e\x64\x18\x7c\x74\x32\x9f\x90\x54\xa4\x52\x53\x66\x1a\xfe\x3a\x3c\x1d\xbe\xe4\x1d\xc4\x1c\xaf\xb1\x6b\x48\x04\x45\xf0\xa6\x2d\x2d\xe1\x09\x47\x5e\xe4\x7f\x81\x44\x82\x54\x79\x4a\x52\x9d\x44\x3a\x78\x83\xf9\x9f\x0e\x5f\xa5\x9a\x7d\x3e\xfc\x75\xf4\x74\xf4\xd3\x78\x6c\x4d\x28\x3c\x7a\x82\x05\x4e\x86\xcf\x61\xd6\x01\xd1\x4e\x06\xf2\x0e\xa6\x45\x1a\x04\x51\xb4\x40\x77\xcb\x9e\xe6\xe8\x0a\x82\x7a\x39\x7c\x31\x3c\x85\x74\xec\xf2\x9b\xd1\xcf\x58\x31\xa4\xc0\x1c\xff\x27\x99\x98\xe9\x7c\xf8\x1a\x3a\x7e\x36\x7c\x8b\xdc\xe4\x0d\xa0\x6b\xf8\xeb\xe8\x09\x34\x0f\x27\xc0\x19\x51\x34\x53\x57\x0c\x37\xb0\x99\xe7\xd1\x1e\xf1\x68\x8f\x79\xf0\x0a\x74\xfb\x5c\x10\xf1\xd9\xf0\xf9\xf0\x6c\xf8\x62\xf4\x68\xf4\x04\x29\x9d\x0f\xc0\x79\x3a\x8b\xc0\xfa\xe8\x27\x64\x1b\x6f\x24\xae\x94\xcb\x74\x06\x13\x33\x9b\xf0\x06\xba\x34\x7a\x04\xac\x06\x91\xf3\x9c\xf3\xb3\x5f\x45\x5f\xdf\x21\xb2\x0b\xb3\xbd\x80\x29\x0f\x53\x95\x83\x8d\x5e\xf9\x14\x7c\x36\xfa\x67\xfc\x29\x6e\xdb\x39\xe1\x73\x1b\x99\xc3\x39\xe2\x06\x78\xc0\x19\x36\xe6\x74\xf8\x76\xf4\x14\x32\x44\xfc\x90\xcf\xab\xe7\x09\x4e\x47\x4f\xf2\xc9\xc0\x19\xe2\x0c\x9c\xd9\xf1\x66\xbc\x41\x96\x73\x36\xfc\x35\xfb\x09\x39\xf5\xe8\xe7\xe1\x4b\x6c\xc1\x89\x18\xba\x67\x9c\x01\xbc\x88\x86\x2d\xe2\x07\xd8\x39\x20\x76\x99\xbb\xc6\xc8\x84\x61\x4d\x73\xd8\xef\x71\x3e\x3c\xc2\x2e\x3f\xe6\x18\xe4\x2c\x7b\x72\xb1\x47\x22\x33\x60\xec\xfb\xd1\xe3\xb8\xd0\x19\x9f\x73\x9c\xc1\x72\xc6\xfd\x5a\x50\xf0\x73\x91\x35\xf5\x39\x46\x7e\xf2\xe9\x1d\xf2\xbd\x53\x2e\x23\x10\x05\xe2\x67\x7e\x0e\x18\xd5\x09\x1f\x02\x7c\x4a\xd8\xd5\x6b\x1c\xa2\x33\x1c\x6e\x68\xdb\x73\x98\xd0\x59\x32\x7c\x87\x53\xe0\x39\x9f\x13\x38\x16\xa2\xb1\xa3\x1f\x50\x90\x3c\x12\x2c\x26\x95\xe9\x1d\x76\xfe\x1c\xc9\x4b\xcc\xda\x28\xd3\x3b\x41\x2a\xc0\xf1\x91\xf2\x0b\x12\x22\x12\xe4\x49\x28\xac\x10\xdf\x4f\x87\x6f\xf8\xf8\xf3\xbc\x7c\xb0\x0b\x50\x2e\x25\x5f\x06\xe9\xbc\x58\x4c\x0c\xfc\x35\x37\x06\xfc\x73\x6a\x0c\xf8\xa7\xd4\x18\x40\x35\x6f\x11\xfc\x8b\xe1\xbb\xd1\x33\x19\x6a\x3a\x61\x4c\x29\xe9\x73\x52\xf6\x5c\xcc\x58\x40\x2d\x8a\x66\x64\x7b\x67\xd8\x71\xe0\x58\xcf\x08\xb2\x64\x14\xf8\xf8\xf7\xd5\xf0\x3f\xa3\x27\x08\x0d\xc6\x9d\x93\xad\x34\xb9\xb3\x99\x0b\x39\x40\x26\xd3\xe8\xd9\xf0\xe5\xe8\xe9\xf0\x35\x24\xfc\x24\x66\x35\x1f\x4d\x98\x9b\xef\x30\xfb\x39\xb6\xf4\xdf\x62\xfe\xc6\x7a\xc7\x19\x9f\x7b\x30\x91\x89\x98\xa1\x4f\x12\x5a\xe0\x9a\xc8\xe8\x19\x17\x3b\xd9\x64\xe4\x32\xa3\x27\xe3\x12\x91\x71\x00\xea\x50\x54\x14\x66\x4a\x31\x95\xd1\x33\x44\xa1\xa0\xd1\x7c\xe6\xf7\xa8\x1f\x9e\x01\x1f\x1f\xfd\x52\x0c\xef\xfd\xe8\x09\xd7\x42\x50\x72\xa6\x18\x56\x2e\xb3\x80\x80\x3c\xfc\x07\xd0\x8d\x60\xda\x0c\x9f\x73\x41\xc5\x1b\x8e\xa3\xc3\xc5\x86\x50\xaf\xde\x23\x76\x51\xdb\x1a\x5f\x93\x10\x51\x6f\xa3\x07\x41\x22\xf9\x4e\x64\x91\x04\x6a\x5a\x86\x8f\x61\x4b\x61\x14\x7e\xe3\xe3\x3a\xfa\x7e\xf4\x14\xaa\x9b\x2e\x23\x68\x90\x31\x63\x45\x22\x78\x29\x46\xed\x67\x6c\xd5\xff\x1f\xb5\x8a\xc7\x84\xcf\x48\x1c\xcc\x47\x69\x3d\x1c\x6a\xe2\x6c\xbe\x98\x33\x25\x52\x77\xf4\x03\x0e\xdc\xdb\xe1\x19\x7e\x7a\x3e\x6d\x87\x23\xc2\xe6\x8a\x2e\x50\xe3\x13\x22\xd4\x9d\x27\x48\xaf\xa7\x09\x1d\x12\x21\x3b\x33\x74\x33\xe6\xb3\x20\x6b\x31\x52\x27\x62\xc6\xc4\x83\xcf\xd1\x38\x2e\x11\x51\x27\xe8\x62\xf4\x2f\x9c\xe0\xc0\xd9\xde\x91\xd4\x8b\xa2\x59\xba\x62\x98\x5e\x40\x8c\xc0\x76\x89\xc1\x0c\x97\xb4\x8c\x80\x7e\xf2\x51\x8e\x2a\xf8\x67\x49\x66\x9e\x10\x28\x47\x0f\xfa\xc4\xf1\x3a\xf0\x7f\x7e\x8e\xf4\xbd\x7d\x02\x80\x82\x87\x3e\x23\x00\xec\xa0\xe7\xe4\x20\x1d\xf4\x9c\x14\x18\x78\x47\x04\x24\x4f\x50\x66\xf4\x13\x41\x21\xc3\xf5\x19\x2e\x5e\x04\x3f\x20\x42\xb7\x04\x82\x06\xad\x13\x95\x0d\x44\x32\xb0\xae\xb7\x17\x24\xa7\x54\xb0\x68\xea\xbe\x88\xb4\x96\xd1\x8f\x84\xab\x5c\x30\x70\x59\xa5\x49\xb0\x8c\xf4\xc7\xdc\x4c\x95\x12\xd3\x4a\x88\x9c\x20\xe9\x36\x9c\x62\xdf\x22\xe7\x7d\x27\xc8\xf2\xe7\xd1\x4f\x42\x29\x3f\x1b\x3d\x3b\xe8\x39\x3c\x17\xce\xe2\xd1\x23\x22\xa8\x37\x37\xf4\x05\x6d\xe1\x93\x8a\x2b\x67\x69\xe3\x28\xcd\xc7\xa5\x99\x97\x70\x8d\x9c\x85\x9a\x95\x17\xa3\x27\x88\xe5\x73\xec\xd8\xbf\x63\x82\x2e\x28\xf8\x5e\x4c\x2b\xe0\x4a\xaf\x25\xcd\xfb\x85\xe8\xf0\x13\x18\x2c\x2c\x18\xa3\x46\xd8\xc4\x89\x9e\x28\xf4\xca\xd3\x68\xae\x8e\x9e\x0e\x9f\xe7\xbb\x2c\xb4\x47\x82\x23\xfc\x8e\x5b\xd5\xf8\x90\x48\xbd\xe8\x43\x4a\x52\xbe\x40\xc3\xe4\x24\xc6\xa5\xa4\x90\xbe\x8c\xe7\xcc\xeb\x0c\x7f\x90\x5a\xc5\x8d\x77\x6e\xca\x44\x94\x1b\xe5\x8d\xf0\x32\x16\x50\x82\x54\xc4\xc4\x18\x55\xf3\x25\x36\x0f\x34\xa5\x7f\x89\xb9\x2e\x15\xcb\x6b\xc4\x59\x73\x38\x36\x6d\x47\xbf\x8c\x7e\x41\x6e\xf5\x16\xeb\x7a\x17\xd9\x43\xbc\xd0\x6b\x60\xbe\x5c\x69\x8a\x87\xe9\x75\x94\x88\x22\x84\xb7\xf9\x1f\x81\xe7\xe6\x3e\x22\xad\x66\xbe\x45\xf3\x26\x53\x20\x4d\x83\xa0\x12\x15\x53\x21\x2f\x20\x13\x53\x8e\x53\xe2\xec\x78\xc5\x19\xbb\x28\xc0\xa7\x8a\x84\xa6\x97\x28\x2b\xff\x15\x9b\x00\x13\xb1\x1c\x09\xc8\x84\x78\xb3\x26\x61\x3e\x5b\x6a\x00\x38\xba\x62\xd1\x9c\x1e\xec\xc8\x22\xe4\xd8\x46\xd9\x52\x60\xc1\x14\x93\xfc\x05\xa6\x0e\x0c\x32\x28\x35\xa0\x10\xe5\x33\x0a\x67\x48\x3e\x49\xc6\xf1\x29\xe7\xb1\x17\x98\x4c\x93\x2a\x4a\xfb\x5d\xe0\x35\x61\xed\xf8\xfe\x36\x72\xe0\x20\xdb\x13\x53\xf0\xc0\x62\x1e\x26\x8e\x9e\x45\xc4\x39\xfa\x19\x75\xa5\x53\xae\x52\xf1\xa4\xb1\xe3\x76\xc6\x6d\xec\xe1\x19\x92\xd3\x19\x92\x7d\x4a\x96\x8b\xb9\x79\x1e\x3f\x45\x12\x20\x9a\xbf\x31\xba\x11\x58\xac\x12\x20\x25\x82\x7e\x87\x53\xed\x5f\x60\x1d\x0e\x5f\x0b\xe7\x9a\x64\x21\xfe\x8a\x09\x50\xf3\x39\xd7\x00\xb0\xa3\x69\x8f\xce\x6f\xc3\x73\x4e\x32\xd8\x32\x41\xb1\xdc\xe8\x92\x41\x09\x95\x21\x6a\xdc\xe8\xc7\x18\x9d\xe7\xdc\xc6\x94\x33\x67\xb5\x92\x82\x2c\x9c\xfb\xfe\x86\x64\xf7\x96\x53\x11\x2a\x2b\x19\xba\x4c\x49\x2b\x9e\x81\xf3\xaa\x08\xa1\x62\x4e\xe6\xb1\x23\x4c\x46\x50\xf9\x7f\xc5\xb9\xf6\x34\x63\x18\x23\x6d\x09\xe3\xf2\x6d\x6c\x3e\x65\x58\x56\x62\x2c\x17\xd8\xcf\x68\xdc\xc8\x1f\xc6\xd8\xb2\x90\x54\x60\xcf\xf2\xcf\x67\x82\x68\x53\x95\xa5\xc4\x40\x62\x5c\xff\x84\x0d\x8c\x00\xe0\x54\xfe\x0d\xf9\x55\x44\x0f\xa2\xa7\xb2\xf2\x80\x58\xe2\xe3\xc6\x6d\x56\xee\x3a\x3c\x41\xc5\x12\x75\x4f\xc9\xb7\x5a\x00\x32\x53\x9c\x67\x7c\x3f\x5e\xe8\xc8\x7c\xa1\x20\x6b\x32\xe1\x5f\x0b\x85\xf8\x75\xec\xe3\x3a\xe5\x96\x13\xf7\x7f\xc5\x53\x37\xa1\x6f\x34\xfb\x4f\x81\x96\x84\xb5\x4f\x62\x37\x29\xd7\xc6\x12\xbd\x56\x96\x95\x88\x63\x92\xa0\x1a\x3a\xf1\x16\x71\x7a\x1e\x4f\xdd\x9c\xc2\x16\x1b\xe5\xd2\xa3\x18\x96\xf3\x84\xc9\xa2\xc4\x3f\x4d\x79\xc8\x9e\xa2\xbc\x49\xca\x08\x0d\x51\x88\x35\x14\xe6\xc3\xdf\xb2\x24\x2a\x59\xfe\xa7\x72\xe1\xf7\xc2\x56\xfe\x49\x62\x0a\xc0\xd8\x9e\xc4\xce\x7e\xc1\xfa\x53\x45\x24\x68\x27\xa9\xbe\x44\xc8\x87\xb1\x91\xdd\x0b\x19\x77\x00\xff\x28\xa8\x5b\xfe\x34\xc6\x4f\xc0\x13\x0b\xbc\x05\x51\x82\x44\xe3\x72\x42\xc6\x2d\x90\xf8\x33\x62\x3a\x17\x1f\x85\x88\x8d\x65\x68\x5e\x7a\x4e\xe2\xc2\x79\x20\x4f\x90\xe8\x73\x32\x34\x9b\x39\x43\xbe\xc2\xd9\x2d\x89\x11\x39\x73\xbc\xce\x90\xe8\x9d\x93\x32\x03\x7e\x33\x4b\x23\x29\x47\xff\xab\x02\x6d\xe8\xe9\x14\x40\x84\x19\xfb\x2f\x6c\x76\xa4\x8d\x24\x3a\xd9\x53\xe8\x39\xf6\xfb\x3d\x5f\xcb\xc0\xfc\x3f\x60\xf5\xaf\xfe\x10\xf0\x49\x97\x61\xe0\xf3\x20\x71\x4d\x28\x2d\x3c\xb2\x59\xb8\x53\x21\x59\x8f\x90\xe5\xc6\xc9\x45\x92\xe8\x72\xc0\x0a\x25\xd5\x44\x10\x71\x6f\x7f\x42\xdd\xf0\xbc\x00\x64\xc2\x7b\xa7\xa2\xc9\x7c\x15\x13\x3b\x34\x35\x81\x15\x67\xcc\xc2\x4c\xbc\x18\x02\x45\x2f\x90\x91\x81\x6c\xe0\x7d\xc8\xc8\x92\x31\x35\xe6\xc0\x64\xd0\x92\xce\x5c\xa8\x6e\xca\x19\x32\x0c\x6e\x7c\xeb\xd3\xee\x06\xa1\x6e\x5e\x62\x16\xe5\x01\xfc\x61\x33\xe8\x2a\xa0\xf3\xb3\x47\xb0\xc8\xb4\x7b\xf5\x14\xcd\xde\x1f\xb9\xc2\x04\xc2\x8c\x9b\xe8\x29\x73\x2c\x31\x45\x32\x0b\x86\x9c\xfe\x84\x13\x76\xf4\x68\x78\xca\xfd\xa1\xc3\x93\xe9\x94\x28\xb9\x68\x0e\xf2\x94\x20\x22\x1f\xc0\xbb\xc8\x53\x0a\x3a\xa9\xec\x36\xc8\x79\xe0\xf3\x59\x32\x3e\xc5\xd1\x2f\xb2\x07\x4e\xb8\x75\x33\x45\xd0\x2f\x00\x89\x68\xe2\x71\x5e\x14\x4f\xd1\xd1\x13\xae\xf4\x65\x1d\x90\xe8\x71\xce\x39\xa5\xc5\x72\x31\x1a\xfc\x27\x17\x49\xa0\xa8\x61\x2f\x84\x45\x20\xe9\x41\x80\x0e\x60\x41\x88\x03\x61\xa1\x16\x29\x3e\x89\x53\x23\xb6\xee\x0a\x3f\x0a\xed\x5e\xd6\xd9\xd0\x64\xe5\xed\xe6\xbc\xbc\xc0\x71\x02\x5f\x87\xaf\x53\x76\xea\x09\x5f\x41\x15\x0a\x38\x4c\x68\x79\x99\x13\xf5\xef\xd1\x3f\xb1\x82\x97\x79\xb7\xc2\x74\xd5\x15\x78\xf6\xa7\x2b\x56\xe0\xe0\xe1\xde\x1f\xe1\x27\xc8\xfb\x60\xd2\xc9\x67\xa8\xed\xf3\x15\x60\xc9\xa5\x10\x23\x4a\xf2\x37\xa0\x31\x38\xfa\x7e\xf8\x9f\x58\x01\xb8\xa0\x40\xca\x41\x91\x4e\x8c\x54\xe3\xf1\x09\xc2\xfa\x8c\x57\xb0\x78\xc6\xb7\xa2\x9b\x63\x59\x67\x76\x99\x62\x0a\x4f\x5a\xb6\x88\x3c\xd8\xaf\xd0\x52\x1c\x4f\xce\xe9\xa2\xd9\xc5\x10\xc8\xf2\x1e\x9d\x27\x7c\x0d\x95\xbb\xce\x33\x6e\xb5\x54\x94\xc6\x65\x94\x63\x59\xcf\x4a\x9a\x10\x5b\x15\x69\xad\x65\x6a\x2f\x53\x51\xb1\xff\xa3\x13\x64\xda\x0a\x73\x53\x64\xfa\x82\x05\x93\xa4\xa0\x70\x5e\x26\x49\x3a\xce\x85\xe2\xee\x4a\x00\xb3\x42\x0e\x81\x20\x82\x7e\x95\xc2\x26\xa6\xb0\x06\x45\x2c\x0f\xba\x01\xf8\x40\x3f\xc7\xf6\x70\xef\xde\xd3\xf1\x19\x72\x0c\x7e\x4c\xc6\xf4\x8a\xfc\xb8\x4c\x91\x7b\xf8\xa4\x28\x8b\xe4\x23\xcf\x26\x16\xae\xa8\xc9\x19\xf2\x2e\x9f\x5f\xf2\x2e\x9f\x6c\x21\x1e\x47\xc3\xcd\xb4\x82\xe4\x1c\x5d\x4c\x72\x3c\x66\x0b\x47\xe3\xf2\x44\x3c\xfd\x87\x3b\xae\xa5\xf5\x40\x31\x80\x17\x38\xc6\x32\x4b\x87\xa6\xd7\x4b\x2d\x9f\x65\xd3\x93\x05\x99\xdc\x0a\xe5\x09\x52\xce\xa9\x10\xe4\x71\xd4\x94\x68\x4c\x62\xda\xfd\x92\x2f\x9a\x75\x7b\xbf\x46\xbb\x30\xd2\x86\x50\x81\x18\xbb\x80\x3b\x46\xd3\x7a\x3f\x7a\x86\x68\xfd\x0d\x19\x44\xac\x58\x5d\x00\x08\xd9\xdb\x7b\x49\x8d\xc0\x85\xc8\x78\x95\xe9\x12\xc0\x32\xdc\x22\xdf\xc5\x38\x55\x2c\x2c\x15\x2c\x41\xc7\x8e\x06\x8c\x9e\x19\xb7\x78\xfc\x22\xc6\xf1\xbb\x24\x6a\x28\x0e\xb2\x49\x31\xff\xcb\xb2\xc5\x5c\x35\x69\x6f\x8b\xf0\xe2\x3d\x2d\x76\x04\x4e\x05\x42\x38\x18\x78\xc8\x20\x92\x3f\x8e\x5e\xe1\x82\x7a\x26\xca\x27\x15\xae\xf5\x08\xdb\xf2\x9b\x98\xe3\x39\x5f\xc2\x85\xc0\xb2\xde\xc6\x4b\x2c\xe7\x4f\xb7\x50\x91\x05\x78\x26\x16\x9a\xb8\x2f\xe8\x4c\x18\x69\xe7\x62\x35\x3a\xe7\xfe\x2b\x06\xf1\x32\x6d\x82\x0b\x0a\xfd\x31\x8a\x29\xc3\x01\xfe\x77\x11\x32\x39\x53\x28\x2a\x2c\xd9\x94\xc5\x85\x7e\x07\x09\x15\x30\x51\x0c\xd9\xfc\x3e\xb1\xcc\x64\xe0\x05\x00\x64\x2b\x41\x76\x05\x5d\x71\x0c\xc6\x81\x43\x8a\xfc\x15\x23\x52\x61\xb0\xaf\x04\x3c\x71\xaa\x9e\x0d\x5f\x15\x50\xef\x54\x74\x8a\xde\x55\x1e\x1c\x13\x63\xee\xcd\xc4\xec\xa8\x24\x3c\x19\x3e\xc7\x20\x49\xc9\xb2\x99\x3a\xeb\x45\x63\x30\xb6\x60\x2a\xc4\xb3\xb0\xe0\x18\x89\x20\xc4\x5e\x2c\x3f\x22\x2d\x30\x5d\x18\xed\xb5\x24\xa4\x6c\xf4\x04\x63\x2e\xde\x70\x8f\xa6\x60\x74\xe7\x88\x9c\x57\xc5\xd2\x2e\x51\x93\x64\xc0\x85\xba\x2e\x00\x4f\x74\xdd\xa2\xf6\x4c\x55\x6c\x3a\x13\x3c\x0b\x3a\x31\x95\xdf\x48\xce\xb2\x42\x9e\x50\xb4\xca\x30\x1d\xc0\x89\xeb\x5d\x39\x57\xd3\xd5\x7a\x31\xfa\x37\x92\x7e\x4c\xc3\x63\x32\x21\xd6\x24\x7b\x2c\x9f\xe9\x35\x5f\xee\xc8\x27\xbc\x17\x4e\x87\xc7\xf1\x92\xe3\x2f\x91\x95\x26\x22\x10\x64\x16\x57\x30\x49\x65\x2b\x37\x42\x4d\x26\x40\x44\xf0\xc5\x78\x41\xa2\x38\x7e\x2b\x63\x63\x8d\x7e\x18\x3d\x19\xbe\xe3\x56\xa1\x30\xa8\x84\x50\x46\x57\xd1\x7b\xfc\xfe\xaa\x38\xba\xb1\x28\x38\x2c\xa3\xcc\x8d\x1f\x3c\x21\x04\x0b\xb5\xea\x74\x38\x59\xa1\x5a\x8f\x11\x82\x45\x94\xfd\x74\xf4\x63\x4c\xd9\xe7\xc3\xd7\xc5\x00\xa5\x18\x0e\x1e\xbf\x92\x04\xad\xf0\xe5\xdd\x2b\x4d\xd1\xe2\xb0\x68\x54\x24\x1e\x17\x8c\xe9\xe4\xec\x17\x70\xb7\xc9\x85\x2f\xe0\x70\x89\x6a\x38\x7a\x7a\xa5\x49\xc3\xb5\x18\xb1\xb0\xc2\x97\x3d\x0a\x34\x9c\x62\x37\x62\x1e\xd4\x15\x3d\x82\x45\x80\x52\x2e\x85\x31\xb6\x44\x5e\xad\xe7\x45\x71\x11\x1b\xa6\x05\x5f\xce\x3e\x13\x1e\xd3\xc8\x3b\x78\x82\xfa\x4f\xbc\x4e\x59\x00\x22\xa2\xd1\x8b\x63\x6b\x93\x59\xe4\x59\x06\x33\x0a\xa0\xe1\xec\x7c\x02\x15\x03\x1b\x1d\x3d\x89\x75\xa5\xd3\x58\x4d\xbf\x98\xa1\x4a\x21\x7c\x67\x58\x5d\x64\x10\x5c\x09\xbf\x29\x7f\x49\x41\x42\x71\x99\x71\xdc\x72\x2c\xe3\x41\xd6\xc8\x19\x4f\xd1\x50\x4d\x55\x6c\x2a\x7e\x95\x68\xf4\x22\xc8\x97\x6b\xf4\x97\xc0\x4d\x96\x29\x9c\x25\x36\x7b\xca\xd5\x84\x35\x64\x75\xd8\xd3\x9c\xfa\x7b\x5a\x50\x34\x8d\xf5\xc2\x24\x6c\x4f\x14\x4c\x1e\x79\x12\xcf\x73\xce\xb3\x89\xac\xb8\x28\x54\x77\xac\x3f\xe5\x75\xe4\x6f\x1c\x63\x4c\x8d\x2d\x98\x0a\x49\x9f\x98\x51\xf4\xe2\xc2\x4c\x79\xbd\x74\x5c\xf6\x2b\x69\xca\x63\x81\xc9\xa4\xc3\xf7\x37\x24\xe6\xe0\x44\x7f\x07\x7e\x8a\x34\xee\x0b\xa3\xdf\x78\x17\x9f\x63\x37\x79\x0b\x13\xc3\x25\x17\x7e\x50\xb0\xf9\x4c\x6c\xe0\x3b\x8f\x28\xe3\x5c\x8e\x54\xe6\x81\x6c\xb9\xcf\x18\x8b\x90\xfb\x9a\x8e\x7e\x8b\x6c\xe3\x93\x28\xc0\x57\x44\xc1\x4a\x0b\x9d\x7c\x8f\x51\x3a\xc2\xe7\xe2\x45\x4e\x21\xd6\xfe\x88\x30\xc5\xac\x84\x94\xe6\x52\x14\x13\x9e\x6c\xdd\x2b\xda\x22\x87\x01\x79\xd1\xba\xe0\x19\xc6\xbe\xa0\x55\x80\x5b\x1a\xce\x84\x4f\x58\x66\xbf\xdc\x8b\x79\x61\x4c\x12\xd7\x2d\xb8\x97\x21\x9e\xa1\x18\x07\xfd\x88\xb3\x6b\x2e\x39\x78\xd0\xf8\x6f\x9c\x46\x53\x3b\xaa\x88\xa8\x24\x1b\x51\x73\xb1\x07\x7f\xf4\x28\xbd\xc2\x0b\x64\x85\xf2\x89\x2f\x4b\xa7\x75\xd0\x6c\xe6\xb1\x62\x2d\x57\xec\x3c\xbd\x6e\x35\xfc\x75\xf4\x04\xf8\xb0\xf0\xe9\x8f\x5d\x2a\xce\x46\xc7\xa7\xc8\x27\x93\x88\x63\xf9\x2b\x4e\x8f\xd7\xa3\x27\x18\x74\x87\x19\x60\x6a\xbd\x91\x1e\x87\xef\x93\x17\xf1\x28\x14\x7e\x5c\x0e\x8b\xc5\xf6\x39\x2a\x94\x67\x7c\xd3\x9e\xe4\x99\x28\x74\xba\x16\x64\x99\x7e\x29\x04\x43\x51\x4e\x87\x2f\xa2\x98\x7e\x61\x1a\x26\x41\xfe\x2f\xb9\x33\x76\xf8\x0e\x67\x11\x10\x34\x57\x4a\x9e\x65\x33\x49\x4b\x3c\xd1\xe7\x24\x04\x29\xf9\x80\x60\xde\x25\x60\x46\x8f\x48\x7a\xd2\x4a\x2f\x57\xd6\xc6\x04\x90\x42\x3d\x26\xa2\x7b\xae\xd4\xe4\xa6\x65\x94\x90\xa3\x9b\x38\x41\x48\x83\xcc\xe7\x38\xf8\x7c\xf8\x1c\x6d\x95\x84\xd1\xbe\xca\x65\x49\xef\x92\x78\x8c\x2e\x58\x69\x3b\x41\xa2\x27\x9d\xf0\x30\x7a\xbe\x2d\xa2\xf0\x73\xca\xaf\x9a\xce\x82\x53\xf7\x4c\xf8\x78\x1f\xc5\x2b\x72\xe3\x61\x5e\x5c\x00\xb7\xf5\x02\xfb\x7f\xc3\xb5\xfa\x84\x3f\xc0\x7c\x49\xe9\x77\x3c\xd0\x0a\x03\x47\xdf\xf1\xd0\xb3\xac\x0a\x74\x09\x47\xe4\x44\xd0\xe9\xad\x4f\xa0\xe9\xff\x0b\x52\x47\x3f\x60\x8b\xaf\x5c\xc9\x84\x58\xcd\x4c\x56\xc9\xc8\x95\xa3\xb3\x4e\xb2\xd9\xa2\x3d\x59\xc5\x9f\x33\xd2\x56\x2c\x17\xa4\x36\x6f\x14\x2c\x9d\x3e\x4b\x6d\xe8\x38\xe1\x59\x4e\x81\x41\x45\x01\x28\x79\xb7\xf8\x54\x33\x28\x5d\x7c\x7a\x9f\x69\xa6\xa0\x64\xb3\xe4\xa0\x4a\x2a\xe3\x18\xd2\x4b\x87\x44\x16\xed\xcc\xb9\x60\xdb\xca\xe5\x8b\x5c\xb8\x95\x65\x0c\xc8\x8b\x56\x62\x0a\x8b\x5d\xbc\xae\x5b\x5c\xec\x8a\x8b\x38\x17\x03\xbb\xe4\x42\xce\x05\x00\x2f\xbb\x98\x53\x08\xee\xf7\x2c\x60\x4c\x07\x30\x3b\xf3\x8a\x0a\xfd\x3e\x17\xfa\xe5\x40\x5e\xc1\x38\x28\xac\xe0\x2a\xae\xf4\x42\x40\x7f\x94\x17\x79\x0c\xf0\xc9\x81\xcc\x85\x85\xf2\x0a\xce\xf4\xeb\x2b\x85\x00\xff\xbf\x70\xf6\x16\x57\x7c\x25\x57\x6a\x21\xa8\x3f\xd2\x9d\x5a\x5c\xc1\x25\x0c\xc0\xb1\x00\xfe\x18\x6f\x67\xe1\x2e\xcd\xdf\x13\x08\x72\x35\x80\x99\x40\x90\x42\x20\x7f\x88\x31\x37\x0e\xf0\xe5\xfc\x84\xe3\xc0\x5c\xdd\x57\x58\x08\xf1\xea\xae\xac\xe9\xc1\xa5\xe5\x50\x02\xf7\x31\xc1\x89\xff\x86\xf0\x62\xa4\xb8\xf4\x98\xcf\x69\xa0\xc9\x7e\x52\x49\xbb\x4f\xed\xfa\x1e\xfd\x42\x46\xff\x8d\xc4\x94\x44\x71\x4c\xa7\x26\x29\x5a\x5f\x57\xf6\x83\xf4\x81\x09\xf9\xdd\x4e\x49\x20\xcc\x19\x02\x7b\x5d\x9c\x49\x5a\xef\xc4\x7d\xac\xb9\x35\xcf\x94\x6e\x51\x18\x47\x51\x00\x56\x4c\x49\xb1\xbc\xf0\xab\xd8\x97\x1b\x85\x0f\x9e\xf3\x03\x8f\xa0\xfd\x24\x45\xcd\xa7\x39\x6a\xce\x54\x95\xce\x9c\x8d\x4c\x3d\xcb\x65\xc0\x88\xb0\x53\x1e\x0f\xf5\x5c\x0a\x57\x8b\xf4\xd9\xf7\x22\x04\xfe\x3d\x2f\xfd\x1b\x4e\xc7\xe7\x22\x94\xa3\x20\x0e\x37\x95\x21\xea\x24\xdf\xd4\x9a\x3e\xa1\xe0\x29\x89\xbc\x22\xf2\x89\x36\x18\x7f\xf1\x04\x0f\x3d\x7a\x81\x58\x95\x56\x96\x65\xa7\xc7\xd3\xe2\xd8\xfc\x97\x3c\xc2\x1e\x48\x6a\xfc\x96\xb8\xe4\xdc\x8b\x77\x7c\x72\xe7\x78\x88\x38\x9c\xaa\x58\x81\x90\x23\xfc\x5f\x25\xc1\x64\xd1\xb8\x7c\x9f\x0d\xdb\x1e\x1f\xe7\xc4\x57\x50\xc5\x9c\x14\x9b\xbc\xb0\xfc\x63\xe1\x3d\x3d\x4b\x1f\xd1\xc0\x97\x10\x4f\xd2\x1b\xff\x0a\x86\xf8\x5c\x1c\x34\x84\x81\x20\x12\x6f\xe5\xa7\x37\x9d\x70\x87\x23\x64\x4a\x22\x55\x4f\x8a\x07\x63\xf4\x63\xd1\x60\x00\xc5\xa6\x08\xe7\xbd\x58\xaf\x78\x23\x34\x40\x3e\x04\xc2\x43\x9e\x8e\xf6\x49\x1b\x71\x05\x62\x35\x13\xcb\x54\x70\xea\x0f\x5f\x5a\xe2\xe7\x74\x3c\xe5\x27\xa8\x08\xd7\x54\x72\xb8\xc7\x49\x71\x96\x1f\x45\x75\xaf\x23\x14\x0b\x0a\x7d\x2d\x67\x07\x1a\xe1\x2c\x46\x86\x92\x3e\x12\x24\x5e\x00\x95\x8e\x8a\x12\x67\x8c\x0d\xe3\x7d\x2c\xe3\x17\x0f\xa6\x5d\x79\x10\x9d\x7e\x91\xa2\x9c\x9f\x13\x91\x4e\xd2\x52\x3f\x12\x33\xf2\x1c\x16\x2e\xd8\xd7\x62\x9f\xf6\x6b\xd9\x73\x9b\x72\x57\x14\xec\x3e\x18\x1b\xf7\x93\x3b\xfa\xe2\xd7\xd1\x93\xd1\x8f\xb8\xc1\xe5\x4d\x42\xbd\xf9\x6c\x3c\xfa\xf0\x1c\xb1\x7a\x9a\x78\x6f\x53\x99\x32\xe3\x98\x4b\x7e\x24\x08\x32\x76\x00\xf1\x0c\x6f\x22\x37\x19\x48\x56\x11\xa7\x7f\x22\x85\xc6\x8a\x85\xd2\x33\xc9\xdf\x80\xd3\x35\x72\x28\xc6\xcb\x29\x09\x67\x8d\x1d\x78\x42\xfe\x83\x8e\x7f\x2a\xb4\x69\x71\xc8\x06\x8e\x5b\x4e\x09\xce\x16\x2b\xd6\x8c\x0a\xe0\xbf\xc5\xc3\xb3\x7e\xe6\xb1\x68\x82\x29\x9d\xe4\xb3\x15\x4c\x70\xe1\x22\x4f\x26\x78\xae\x10\x90\xd3\x4f\xdc\x53\x90\x49\x8a\x54\x3f\x20\x49\xc1\xff\x64\xa7\xeb\xf0\x84\xe3\x48\xda\x65\x13\x07\xc6\x3d\x16\xd1\xaf\xa2\x68\x94\x94\x41\x7d\x4e\x44\x67\x63\xa3\xf3\x59\x4e\xb3\x8e\x28\x1c\x9a\xc7\xd2\x1c\x2c\x28\x92\x8d\xb8\x96\x24\x63\x34\xdd\x8a\x8a\x45\x6e\xe2\x1f\xa7\x69\x56\x31\x37\xe7\xeb\xe4\x05\x20\x8a\x37\xd4\x8e\x7e\x44\xf1\x72\x26\x4c\xec\xc2\xce\x5c\xb6\xa2\x8b\xb0\x23\x5b\xe2\x82\x31\x64\xbb\xab\x68\x3d\xdd\x29\x9b\x65\xab\xdc\xd7\x06\xba\xb2\x4f\x5b\xa3\x7f\x72\x0d\x78\x78\x22\xee\x43\x0c\x0a\xbe\xc4\x2e\x41\xe0\x5a\xd3\xe8\x59\x82\xe5\xbf\x8e\xf9\x2f\xf7\x96\xcb\xfb\x64\x49\x52\x8f\x9c\x47\xaa\x4a\xfa\xac\x68\x2d\x5d\x89\x8f\x13\x7a\x2b\x56\xdc\x22\x6c\x9f\x73\xab\x3f\xbd\xae\x2e\xef\x9f\x4f\xa8\x03\x85\x45\xd6\xfe\x3d\x01\xbd\x6a\xcc\x59\x45\x18\xb1\x18\x71\xf2\xd3\x38\x5b\xec\xe8\x02\xd1\x93\x3d\x09\x11\xe5\xe7\x49\x64\xf4\x0b\x28\x38\xd3\xd3\x3b\xde\xd3\x13\xe0\x39\xe7\x8c\xdc\x39\x9e\x30\x9b\xd1\x7f\x23\x2b\x95\xb4\x20\xce\xdc\x24\x77\x04\xa7\xb0\x34\x38\xf9\x2c\xc1\x98\xdb\x3f\x47\xee\xca\x27\xf5\x2b\x7e\x98\x1f\x37\xf6\x79\x6b\x4e\xc5\x18\xbe\x4e\x79\xaf\xdf\x0b\xaf\xff\xf7\x18\x8f\xf2\x1a\x79\x5b\x1c\x3a\x51\x54\xe8\x3c\xa2\xed\xf4\xf1\x14\x53\x67\xcd\xf4\xf8\x84\x08\xf9\xce\xcf\x0e\x79\x8f\xa7\x76\xa6\x9a\x70\x86\x31\xff\xa7\x22\x8e\x45\x88\xb0\xa2\x2c\x49\xcc\xf2\x69\x21\x72\x4f\xf2\x85\x32\x7b\xa9\xb2\x27\xf3\xf0\x4c\xd1\x16\xef\xd7\x45\xd5\xbe\x4f\x54\x33\xf4\x53\x48\xfa\x61\x94\xe1\x1c\xff\xf2\x45\x81\x57\x82\xa5\x48\xb4\xc2\xb1\x17\x65\x7d\x33\x7a\x96\xab\x64\xf4\x03\x8a\x85\xff\x70\x9d\xeb\x39\x0e\xea\x33\x49\x9d\x7d\x19\x91\x34\x72\xaa\xf7\xa3\x27\xa0\x80\x88\x56\x15\x10\x26\x77\x98\x9c\x21\x67\x12\x2e\xa1\x49\x23\x7b\xa9\xec\xf9\xd1\x8d\xc7\x55\x72\x6d\x9e\x88\xf3\xb1\xce\x23\xc3\xad\x38\xd3\xfb\x68\x03\x86\x18\x1f\x3e\xd7\x73\x33\x46\xc6\x97\x44\x45\xc3\xb3\x74\x56\x6e\xb2\x9c\xc6\xa7\x60\x24\x71\x0e\x82\x4d\x89\x63\x46\xe2\x58\xe3\x09\x51\xf0\x92\xc3\xa9\x50\x98\x93\xc2\xd8\xf6\x54\xed\xe8\x42\x9e\x50\x7b\xf6\x78\xbf\xb7\x3c\x42\x61\xf4\x24\x8b\x62\x14\x4d\x12\x0a\xc6\xbb\x63\x9f\x23\x7f\x14\x31\x1d\xe3\xb3\x89\x41\xe5\xfe\xee\x0c\x7b\xc8\x9a\x56\x7c\x5f\x65\x51\x5c\x8a\xb0\x72\x5f\xa3\x3c\x39\xc3\x3d\x76\xa7\xc2\xa3\x50\x40\x93\x29\xf3\x4b\xde\x9c\x10\x3b\xd6\x78\xc3\x4f\x49\xce\x82\x4e\xd7\xfb\x2a\x3a\x86\x47\x98\x78\x85\x33\x60\x22\x08\x61\xfe\x25\x93\x2a\x9e\x50\x7c\xa5\xbb\x08\xe0\x6b\x31\xef\x7f\x8b\xbb\x20\x13\xa2\x74\x08\x49\xea\x2c\x4b\x90\x45\xb2\x50\x89\x4f\x49\x88\xe7\x57\xa1\x29\x28\xf6\x2c\x9e\xf2\xb0\x9b\x29\x0b\x49\x0a\xcb\x09\x29\x72\xe9\xca\x87\xe6\x4e\x3e\x55\x8a\xb3\x83\x88\xa7\x46\xf2\x0f\xc0\xbc\xc8\x9f\xfb\x52\x9c\x39\x87\xc0\xa2\x6c\x6f\x85\x36\x54\x38\x84\x13\x0b\x88\x13\x9a\x5e\x08\x93\x6c\xba\xe2\x93\xa4\x45\xf1\x38\x3c\x2f\x62\xe5\xe3\xb2\x72\xb7\xf0\xa9\xd8\x9b\x5a\xd8\xa4\xa2\x82\x69\x3a\x8a\x16\x03\x5e\x8f\xcb\x9e\x55\x1d\x4e\x46\xff\x12\x02\xf9\xe9\xf4\x75\x66\x66\xd4\x34\xd8\xbc\x10\x4c\x72\xd8\x4c\xe4\x22\x89\x29\xf6\x12\x20\x73\x67\x9e\xe6\x8b\xa2\x15\x2b\x64\x4f\x31\x10\x49\x9e\x4f\x91\x25\xbd\x53\xaa\x38\x3b\x7c\x79\x34\x7a\x22\x07\x36\x5c\x4c\x3d\xf2\x59\xd2\xaf\xe4\x71\x1e\x5b\x00\x95\x5a\xb0\x5b\x4f\x64\x69\x34\xfa\x31\xc7\x02\xd3\x1c\xeb\x7c\x3c\xdb\x2d\xae\x28\xf6\x07\x89\xe0\x98\x84\x6d\x8c\xc9\xce\x0f\x5e\xe2\x7b\x09\x27\x60\x75\x6c\xc0\x9d\xd0\x43\xcf\xc6\xf3\x9d\x49\x7e\xb1\x88\x57\x3f\x4e\xa2\x79\xb8\xf4\xe2\xae\x05\x3c\x5d\xb1\x70\x0f\x7b\x01\x93\x94\x4f\xcf\xbe\x70\x08\xb3\x3a\xf6\xb8\x4c\x31\x5f\xcd\x4d\x89\xcb\x53\x6f\xa1\xe6\x7f\x59\x52\x4a\x5c\x42\x12\x57\x11\xbb\x60\xa3\x10\x04\xae\x6a\x3f\x8f\x3d\xe7\x05\xd4\x23\xf6\x9a\x26\x61\x93\x93\x1a\xf2\x5e\x6c\xde\xe5\xdb\xed\x85\x67\x7e\xf4\x68\xf4\x2f\x81\xc5\x93\xf1\x32\x64\x9a\xa2\x13\xf0\x78\x61\xf1\x8b\x19\x5c\x32\x0b\x9e\xcb\x83\x1e\xc7\xb6\x4a\xea\x49\x7e\x27\x52\x9e\x27\x4f\x90\x34\xf9\xe2\xb2\xf5\xcd\x57\x73\x0b\xcd\x91\x0b\x0b\xe6\xf4\xc6\xd8\x95\x25\x99\xda\xa0\x9e\xfe\x98\xda\xb1\x98\x01\x99\x1c\xa7\x16\x9d\xb8\x1c\xf3\x8a\x8b\x5a\x33\xa9\xe8\xa5\x30\xc2\xa3\x78\xbf\x17\x07\x08\x24\x7a\x66\xc4\x20\x1f\x45\xcb\x38\x45\x85\xa7\xb2\xc7\x2e\x2c\x26\x89\xd5\x2c\xf3\xbd\x94\x98\xcb\x1b\x50\x99\x38\xc7\xc8\x44\xc7\x18\x4f\xde\xc1\x93\xc2\x62\xd2\x51\x89\x29\x9e\x9d\xcf\x2a\x2b\x7a\x99\x50\xbe\x54\x73\xdf\x88\xd0\x86\x22\x34\x16\xb2\xa1\xa4\xde\x73\x71\x23\x47\xa6\x50\x82\xb4\xfc\x26\xaa\xd1\xf7\xc8\x0f\xcf\xb9\x2b\xac\xc0\x3f\x9a\x68\xf4\xe3\x2d\xd2\x57\x91\xab\xb9\x80\x85\x24\x15\x24\x3a\xf7\x14\x7a\x59\xca\x18\xc8\x4c\xa9\x28\x90\x78\x9a\x39\x3f\x49\x0f\x13\x3e\xf3\xc7\x78\x0e\xd0\x59\xc6\x14\xcb\x78\x56\x84\xdf\x62\x1c\xb7\x93\x43\x21\x84\xcf\xab\x18\x9d\x39\x3a\x93\x8b\x46\xfc\xec\x44\x8a\x0b\x4d\x0b\x3b\x39\xb3\x64\xad\xbd\x2b\x38\xdc\x53\xac\xf4\x66\x8b\xe4\xc2\xc8\xb8\x73\x7b\x42\x26\x99\xc8\x27\x37\x27\x5d\x6c\x6c\xd6\x9c\xfb\x27\x6d\xf9\x4d\xce\xfa\x68\xf8\x5c\x44\x99\x8d\xdf\x24\x27\x03\x28\xb6\xb7\x63\x25\x20\x3a\x61\xec\xbd\xd8\xb4\xf0\x38\x5a\x80\xb9\xa8\x78\x76\x89\xee\x44\x66\xb5\xe9\xfe\xa6\xe5\x5e\x46\x27\x2a\xe0\x33\x29\x37\x47\x26\x92\x34\xda\x44\x79\x99\x90\xb3\xb4\xd7\x44\x9c\x90\x23\x34\x18\x04\x26\xc7\x63\x5c\xe0\x62\xc9\x8f\xf3\xc5\x45\xf8\xfa\xcf\x45\x72\xef\x42\x30\x79\xfb\x28\xb5\x62\x16\x17\x4d\x0b\x23\xcc\xc6\x75\xd4\x4b\xb4\x40\xc4\x69\x0b\x47\xcc\x4b\x2c\xf8\x93\x14\x14\x54\xc8\x4c\xe2\xc2\xe2\xdc\x95\x53\xc1\x87\x26\x65\x96\x48\x3f\xd9\x97\x97\xf8\xd2\x85\x4e\xcc\x0f\xec\x4f\x8a\x8c\x75\x13\x48\x1e\xbe\xd7\x29\xc4\x8c\x1e\x89\xd5\xbe\xff\xc8\xfe\xae\xc9\x4d\x2b\x2e\xc2\xcf\x79\x92\xcf\x39\x38\x49\x17\x8a\x1d\x1e\xc9\xaa\x36\xdf\x91\xf1\x5c\x4c\x55\x1e\x43\x5b\xe0\x98\xcf\x6e\x1d\xb8\xca\x9a\x83\xb8\x36\x0a\x03\x16\x73\x3c\x91\xb3\x25\xd9\x30\xb8\x94\xbc\x90\x41\xe7\x43\xf0\xf8\x84\xfa\x2d\x1e\x35\xb1\x3b\x61\xf4\x4b\x14\xf9\x7a\xb1\x1d\x42\x0a\xc2\x9d\x4e\x64\xb7\x56\x9a\xcd\x14\x65\xe7\xae\xa8\x4c\xb8\x0d\x22\x76\x94\x5c\x9f\x50\x70\x90\xd1\xa4\xfd\x18\x22\x1c\x45\xe0\xfa\x54\x04\x07\x45\xd1\x71\x68\x6a\x7c\x1f\x85\xad\xc6\x6b\xf3\x67\x89\x26\x23\x8a\x61\x33\x8b\x3a\xcd\x01\x8d\x4d\x48\x34\xa5\x7f\x8e\x9e\x8e\xd5\x66\x44\x66\xf9\xd8\xe9\x93\x44\xe5\x10\xdb\x1c\xa6\x66\x46\xd1\xfd\x47\xa2\x07\x57\x2d\x36\xb6\x4f\x6f\x51\x7c\xbc\x16\x96\xf4\xa5\xa0\xa7\x8b\x8e\xad\x21\x75\x51\x59\xe1\xa9\x13\xf1\xd9\xb6\xdf\x73\x4d\x26\x7d\xf8\x73\xc6\x59\x9b\x3e\x84\xf3\x52\x0d\x1e\xa7\x09\x09\xf6\x7d\xb5\xa1\x99\xa8\xad\x0a\x5b\xf8\x37\xb1\x9b\x29\x09\x3d\xfc\x03\xaa\x88\xe9\x2f\x67\xd9\x49\x3a\x2d\x2a\x87\x97\xa8\x4a\xb2\x1d\xb2\x47\x2e\x5e\x12\x50\xb4\x2c\x2d\x86\xf1\x32\x85\x0b\x65\x51\x62\x57\xc5\xa1\x23\x63\x33\x8b\xc5\xaf\xe7\xe2\x58\xf6\x64\x61\x75\x2c\x80\xb7\x45\x26\x72\x91\x95\x13\x15\xba\xc8\x67\x90\x64\x43\x8d\xe1\x02\xad\x3d\xca\x9c\xdf\xd3\x95\xf3\xed\xf1\x7d\x06\x62\x99\xe1\xed\xa4\x9a\x73\xc0\x26\xae\x8a\x14\x14\x1b\x6f\x11\x46\x99\x85\xe0\x3d\xc7\xd9\x78\x59\x07\x83\x00\x92\x08\xd2\x24\x06\x22\x46\x49\x42\xe4\xdf\x0b\x19\xfe\xfd\x78\x94\xff\x20\x74\x91\x73\xdc\x50\x38\x25\xcf\x97\xc2\xa3\x52\xbe\xb0\x94\x93\xec\xc2\xae\xc4\x97\x22\xc5\x61\x05\x29\x3f\x51\x2a\x0c\x97\x9f\xbe\x3d\x5e\x7e\xc8\x59\x73\x16\x27\x8f\xf0\xe2\x41\x29\x97\x5f\x20\x55\xb4\x0e\x5e\x4a\xc9\x57\xcc\xa5\xf0\x86\xd1\xb3\x31\x41\x60\x63\x32\x17\x85\x82\xa5\x96\xc4\xc5\xe6\xf1\xdc\xa6\x8c\x4c\x98\x5f\x74\x77\x60\xe6\x43\xe2\xd4\x41\x21\x33\xfa\x97\x58\x81\x3c\x19\x1f\x6b\x3b\x79\x51\x93\x0f\xfa\x29\x9e\x41\xf2\x38\xdf\xac\xe2\xfe\x8c\x07\x27\x2f\x58\xe7\xf0\x3d\x39\xfc\x34\x31\xcf\xe3\xe0\xbf\xc4\x0f\x3e\xa9\x28\x0f\xfb\x7a\x99\x04\x56\x9e\x89\xfd\xcb\xb1\x3d\x80\x47\xe6\x5d\xbe\x9f\x05\x3e\x03\x69\x67\x59\x66\x07\x1f\x36\xf0\xac\xf8\x32\xae\xb4\xdd\x91\xea\x58\x41\x01\x45\x6b\x27\x61\x3f\x45\x9b\x77\x72\x17\x14\xe0\x2a\x69\x7e\x65\xfd\x55\xe6\xd8\xe2\xcc\x71\xa5\x99\x50\xd9\x1c\x9b\x1a\x73\x30\xc7\x98\x88\x86\x54\x76\x45\x7b\xa0\x2b\x93\x83\x8d\x45\x50\xe6\x0f\xc9\xbe\x2e\x89\x33\x24\xda\x41\x1e\x3d\x85\x6b\x94\xe9\x05\x8a\x42\xef\xe8\x99\xb8\xc7\x36\x8a\x1d\x8a\xc3\x2c\xd2\x22\x2b\xbb\x78\x31\x11\x90\xe4\x06\xba\x1c\x90\x94\x91\x73\xce\xed\x02\xb1\x3e\x9f\x08\x96\xab\x7b\xd5\x0a\x03\x47\xa6\x45\xb0\x6c\x45\xc9\xf7\x5f\xe5\xec\xfb\x29\xc1\x45\xc6\xc3\xf3\xe8\xb6\x3b\x2e\x18\xc5\x59\xe5\x29\xbf\x4e\xb4\x73\x35\x67\x14\xa6\x95\xe5\xcb\xd5\x8c\x67\x90\xa3\xd9\x8a\x98\xb8\x5c\xe1\x44\x5c\xf2\xb0\x6a\xdc\x1c\x25\x76\x36\x5f\x01\xc8\x33\x11\x5f\x7f\x89\xd1\xe0\x20\xde\x25\x17\x6e\xa6\x3c\xcb\x3c\x52\xe6\x47\x21\x54\x2e\x05\x72\x4a\xf7\xd1\x1f\x01\x34\x42\xc0\xab\x89\xd3\x64\x82\xde\x2a\xfb\xe6\x2f\xd3\x9e\xdf\x31\x6a\xe9\xd3\x23\x2e\x89\x07\xbc\xee\x8b\xc7\xe5\x5d\xaa\x58\xbc\x37\x6c\xfa\x62\xe7\x29\x39\x97\x9a\x4e\x3f\x45\x77\x3a\xe7\x99\x6f\x96\x8b\x64\x79\x28\x51\xb4\xae\xae\x8c\x8b\xda\x90\xf5\xb2\x82\x50\x4d\xb1\xbb\x20\xe2\x3d\xb8\x69\xfd\x35\x4a\xb6\x3d\x5d\xc9\xf5\x54\x56\xeb\x52\xdb\x03\xc7\xf6\x5a\xd1\x0e\xa1\x6d\xd1\x6d\x00\x5c\xfe\xc7\x31\xe6\x27\xb1\xe7\x28\x3e\xb4\x3a\xcd\x59\xb8\xa4\x4e\x67\x50\xb4\x5a\xac\x00\x16\x39\xa5\x0a\x25\x16\xe7\x93\x42\x04\x44\xbc\xfb\x59\x81\xba\x2e\xdd\x6a\x27\x4d\xde\x31\x87\xf3\xbc\x4a\x3c\x25\x29\xc3\x8c\xbb\x80\xa6\xaa\xaf\xd8\x50\x4c\xe4\x16\x51\xb4\x25\xbc\x3c\x3f\x39\xbf\x25\xbe\x9e\x84\x1f\xf0\x12\xa9\x2c\x69\x27\xbc\xb4\xa4\x21\x1d\x5f\x3a\xde\x7f\x9d\xba\xf6\xfd\x20\x1a\xfb\xa9\x3b\x96\x1d\xa2\x15\x49\x47\x4f\x5c\x1d\x27\x62\xa3\xf3\x19\xd7\x01\xa3\x68\xa7\x17\x7c\xd7\x00\x8f\x0d\x17\xeb\x62\xd2\x61\x0c\x39\xbf\x55\x41\x10\x4d\x8a\xce\xd3\xf5\xa4\xb7\x5a\x8e\x95\x5b\x69\x0e\x57\x48\xa8\x17\x82\x1d\xbb\xc4\x91\x3a\x40\xa4\x28\xde\x65\x1a\xd0\x13\x7c\x48\xb2\xcf\x83\x6f\xea\x3f\xe7\xf7\xba\xf1\x4d\xc0\xb1\x7c\x95\x3d\xda\xe3\xeb\x94\xc2\xf3\x50\xab\x8d\xef\x00\xbc\x34\xa0\x31\xe3\x9a\x38\xcc\xa6\x05\xc4\x15\x62\x71\x06\x94\xe0\x3f\x8f\xa2\x25\xc5\xcb\x02\x93\xb0\xf5\xfb\x71\x95\x00\xbb\x78\xbb\xd4\xe9\x38\xf0\xd2\xa5\x7e\xd1\xd5\x73\xc8\x54\x5f\x26\x9d\xe3\xc6\x41\xb4\x39\x29\xbd\xbe\x7e\x21\xf5\x9c\xe3\xca\xd6\xa9\xe4\x2f\x8f\x34\x94\x33\x31\x42\x69\x2c\x9c\xc9\xce\xb4\x37\x53\xd4\x90\xf3\x9e\xcb\xfe\xcf\x0b\x0b\x3f\x91\x57\xb7\xe3\xa3\x88\xa6\x6a\xe8\xef\x02\x2e\x9f\xcc\x23\xc4\xe1\x14\x43\x38\x55\x95\xc8\x0b\xff\x2d\xd9\x8e\x71\xc0\x6b\x66\x46\x29\xda\x86\xae\xec\x07\x45\x6a\x23\x0f\x64\x4b\x2f\x8d\x66\xb6\x51\x1c\xf4\x0d\xd6\x3d\x08\xb2\x7b\x35\xd2\x76\x2e\xae\x4b\xf3\xd3\xd4\xd2\xd9\xa2\xfb\xca\x84\xb4\x89\x56\x21\x38\xbc\xcc\x96\x86\xd4\xc5\x93\x41\x12\xd7\x7f\x22\x56\x05\xf9\x1e\xad\x82\xf8\xf4\x02\x71\x61\x79\x3d\x92\x3b\xdd\x44\xd2\x8c\xc6\x37\x6b\x6c\x21\x79\xa3\x7b\xbc\x33\x7b\xba\x82\x91\x0b\x2c\xca\xc6\xef\xce\xf9\x77\xe4\x8f\x4a\xe2\x03\xa5\xbd\xeb\xaf\x39\x12\xf8\xc4\x97\xce\x77\x48\x2d\x30\x8d\x2d\x96\x0f\xb6\x1e\xbf\x38\x7e\xc2\x57\x61\xd1\xb7\xf5\x98\xf7\x25\x5f\x3c\xbd\x56\x96\xf8\x9b\x93\xe3\xd4\xa5\x15\xd7\xcb\x80\x88\x87\x82\xa7\xc5\x03\x8b\x92\x16\x00\x25\x32\x1c\x57\x10\xe5\xb9\xc3\x41\xf0\x4c\x82\x74\x32\x57\xd6\x70\xc6\xf7\xdb\xf0\x1c\x32\xbd\xcb\x46\x17\x22\x64\x3e\x80\xfc\xce\xc2\xc2\xdb\x0d\xc7\x63\xb9\xf8\x56\x24\xb1\x9a\x1c\x45\x9e\xa6\xae\xdb\x3c\x1f\x3e\x2f\x2c\x38\xbe\xd1\x05\x58\xcb\xc7\x0d\x43\xc6\xfc\x8d\x33\xd2\xce\x6a\xb4\x41\xb0\x7a\x9c\x19\xef\x63\x85\x4b\x5c\x5a\xcd\x1d\x17\xe2\x3c\x44\xe9\x82\xf5\x67\xfc\x3e\xe8\x94\xce\x7d\xca\x41\x64\x7b\x5a\x74\x0d\x64\x7c\x26\x47\x9a\xc2\x92\x94\x4c\x3f\xb0\x01\xb1\xfc\xe2\xd8\x7e\x97\x72\x28\x03\x56\x92\xe2\x05\x97\x9b\x1d\x04\xe9\xd5\x95\x68\xdf\xf0\x59\x52\xac\x48\xf5\xb2\xbc\x1e\x72\x3a\xd9\x66\x7b\x83\x9f\x33\x5f\x10\x4c\x6c\xa6\xa4\xdf\xc6\x9f\xef\x0c\xad\x4a\x7b\xb7\x13\x96\x29\x1d\xc6\x9f\xbd\x6a\x31\x43\x3a\x98\x5d\xd1\x76\x22\xd5\x57\x3a\x4c\xee\x74\x38\xe5\x41\x0d\x59\xf6\x99\xdb\x5c\x3f\xc6\xbc\xb9\x10\x70\xa1\x78\xe1\xae\x16\xc9\x98\x9b\x06\xd4\x58\xdf\xd6\x73\xe9\x7d\x9c\x1f\xbf\x48\xad\xbe\x72\x85\x59\x3f\xe1\x95\x80\x14\xfa\xdf\xae\x0e\xee\x7d\x7c\xbc\xcd\xff\x09\xc0\x53\x90\xd0\x38\x00\x85\x0b\x8a\xbf\x07\xd8\xe8\x51\xe4\xad\x9b\x06\x10\x9f\xf4\xb9\x05\x1a\x29\xde\xea\x3c\x56\xed\x5f\x4f\x43\x14\x1c\xa0\xb4\x21\x2c\xe5\xa8\x9d\xb2\xf8\x15\xc7\x28\x53\x38\xb7\x70\x28\xf6\x8a\xc7\x31\x85\x53\x02\x2c\xd8\x00\x9a\x5e\x8a\x9a\x16\x50\xde\xcb\x9c\x90\xe5\x94\x20\x92\x43\x67\xc6\xb8\xa8\xaf\x02\x28\x1d\x47\x31\x25\x80\x2b\xd1\xc5\x55\xd0\x76\x91\x3b\x73\x5a\x30\xc9\x82\xf9\x3b\xc1\xb9\xbf\x17\xb1\x6b\xb2\x61\x3c\x15\xa8\xec\x6a\xfe\x1f\xca\x3e\xd3\x31\x16\xf9\xc5\xfd\x69\x51\x8d\x88\x4e\xef\x41\xbe\x14\xf1\x5f\x10\xac\x98\x5b\xbe\x2a\x3a\x1e\xee\xf7\x55\x51\x70\x53\xde\x05\x00\x73\x67\xf9\xfe\x11\xad\x94\x63\xe2\x9e\x47\xba\x5f\xac\xd5\xbe\xb9\x7a\x6b\x93\xee\x17\x64\x2d\x22\xaa\xc9\x9b\x6b\x8b\xa3\x75\x27\x28\x1f\xd1\x9a\x6c\x4a\x77\xbd\xb4\x0c\xc9\x1e\x3a\x3b\xd6\x64\xfa\x63\x25\x6f\xae\x9d\x05\x9b\x26\x2f\x06\x9e\x8d\xae\xb8\xb0\xb3\xe3\x97\x64\xa3\x93\xf5\xa4\x40\xe6\x2b\x9c\x09\xa6\x68\xfb\xa8\xa8\x9e\x24\x27\xf4\xc9\x17\xdb\xf2\xa3\xa9\x86\xe2\xcc\x16\xb1\x03\x2f\xba\x68\x23\xbb\x3c\x9d\xb3\x97\x64\xae\x79\x15\x90\xa9\xa9\x90\x39\xe4\x3c\x36\x6e\x2f\x00\x9b\xd2\x47\xa7\x04\xfa\x6e\x78\x6a\x04\xa6\x6d\x67\x55\xfb\xab\x75\xe2\xb1\x38\x73\x27\xc1\xc5\x54\xe8\xe4\x36\x37\xdf\x37\x7c\x2e\x8e\xe1\x2c\xa8\x3b\x0e\x71\xe5\x81\xa8\x42\x7a\xc5\x9b\xce\xa7\xa8\x49\xd1\x16\x75\x45\x8a\x37\xce\x1d\x40\x1c\xad\xbd\x08\x4f\xd2\x54\x67\x95\xca\x4d\xfa\x43\x00\x3e\x89\x43\x31\xde\xa5\x0f\x9e\x1f\x5b\x44\xd1\xd6\xb1\x5f\xc5\xd3\x39\x77\x16\x10\x0f\x46\x1b\x65\x2e\x9d\xc3\x73\x7e\xc7\x9c\x9a\x24\x6f\x33\xc9\xb8\x7f\x7e\x0f\xb0\x8c\x49\x5c\x08\x6a\xc2\x59\x4e\x9c\xdc\x7e\x7f\xf7\x14\x6d\x59\x57\x32\x13\xe4\x35\x4e\x64\x2e\xdd\xa4\x63\x98\x86\xcf\xbf\xb3\xfb\xf1\x87\x53\xc9\x1d\x34\x7a\x34\xfc\x8f\xf0\xd3\x3f\xfb\xce\xee\xcb\x29\xb2\xed\x2a\x26\x1e\xe4\x48\x61\x75\xbc\xf0\xe3\xd7\x0e\x9d\xe2\xfe\xe4\xb8\x1d\x99\xf2\x39\xab\x99\x3b\x89\x32\x97\x44\xe4\xca\xe5\x03\xe6\xe5\xfa\x30\x6f\x8a\x18\xb3\x7d\x54\xb4\x55\x5d\xc1\xb3\xb4\x9f\xc4\x9b\x96\x4f\x8b\x6e\x34\x1d\x63\x8e\x67\x83\x04\x38\xdb\x10\xfb\x85\x4e\xf3\xae\x9f\xe4\xfc\xab\xd3\xec\x06\xb4\x5f\xc5\xe1\xfc\x78\x12\x53\x82\x98\x0b\xcf\x68\x4e\x70\x51\xb4\x3d\x2e\x5f\x9c\x87\x31\xa6\x74\x18\xbe\x2c\x77\x86\x6a\xf0\x85\x47\xc1\xe5\xf5\xa8\xf8\x1c\xaf\x78\x43\x52\xea\x24\xaf\x64\x59\x42\xec\x65\x14\xf7\x09\x5f\xf6\xfc\xc0\x0c\x5b\x19\xaf\x4c\xbc\x1c\x9e\xe0\xd9\x5c\xff\x8e\xdc\xc6\xe3\xae\x9b\x6a\x33\xa0\x80\x5d\x5d\x19\x3b\xb8\xd1\x00\xf1\x75\x27\xe1\x75\x2e\x5e\xfe\xbe\xd8\x14\xe4\xc7\xca\x4e\x04\xc2\x0f\xcf\x7b\x1c\x5f\x02\x92\xd9\x6d\x7a\x51\xf1\xf4\xb5\xf0\x49\xec\xc1\xc4\x42\x79\xed\x30\x7d\xf5\x48\x2e\x96\xe4\x02\x80\x85\x41\xc0\xe3\x8a\x28\xda\xb6\xae\x74\x19\xeb\x27\xdc\x26\x51\x3e\xc7\x6b\xb4\x91\x54\x8c\xce\x61\x96\xbd\x24\x27\x92\xf6\x8a\x73\x33\x52\xe4\xe2\x80\x3d\x79\x73\x0e\x8f\x36\x4d\x9f\x9a\x95\x9a\x03\x82\x7c\xc4\xad\x00\xc9\xd9\x51\x51\xb4\xc7\xf7\x78\xda\x74\x14\xb3\x3a\x5d\x8c\x67\x14\x6f\x2a\x21\x55\x3a\x64\x95\x6f\x97\x99\xb8\x7c\x4c\x12\xa1\x8c\x97\x09\xc8\x67\x86\xbd\x12\xf6\x5d\x2e\x20\x12\x1a\x99\x5b\x46\x8f\x16\x7c\x2e\x5c\x05\x95\xaf\xb8\xcf\xfa\x55\x27\x19\x1f\xd1\x55\x64\x51\xdc\xca\xc9\xf8\xe3\x37\x27\x00\xe1\x67\xb7\x89\x75\xd6\xf3\xf4\xf9\x18\x57\x26\x93\xc9\xc7\x11\xc9\x41\xce\x17\x44\xe6\xbe\x2f\x3e\xda\x33\xb9\xfe\x29\xd9\x1a\x3d\xf9\x90\x58\x71\xd1\x41\xc2\x0b\x0b\x96\xd4\x2f\xdf\x9d\xa2\xcc\x05\x6b\x37\x93\xf6\x7b\x16\xdd\xa6\x55\xb4\xfc\x73\x99\x13\x41\x27\x8b\xd4\x64\x7a\xfe\xce\xb3\xe0\x49\xfe\x86\xcd\xf8\x4a\xf8\xf4\xfc\xc0\x93\x74\xe4\x2d\xa7\x93\xee\x8d\x7f\x2e\x16\x6a\x33\x34\x7c\x79\x00\x63\x0f\x98\xe5\xe7\x8f\x4c\x0b\x2e\xc5\xd1\xa6\x2f\x96\x4c\xcb\xd4\x19\xb6\x53\x03\x28\x3c\x06\xf5\x72\x00\xe4\xf3\x77\x4f\xa3\xa8\x20\xe9\x1a\xaa\xdf\xe4\xe8\xe3\x49\xcc\x6d\x7c\x84\xba\xcc\xfa\x27\x06\xf3\x4f\x07\xa2\x20\x70\x7a\x6c\xc1\x8b\x36\x23\x4c\x5f\x30\x7b\x8a\x2d\xd7\x52\x45\xd0\x2f\xc7\xf2\x84\x73\xd8\x50\x31\xe3\x2c\xf7\xdf\xc3\x93\x98\xbf\x64\xc2\x9a\xa6\x3c\xa6\x38\x7b\x04\x49\x2a\x68\xe0\x75\x74\x97\x1d\x77\xa7\xbe\xc0\xb1\x96\x5d\x16\xa9\x5d\x69\xa4\x70\x22\xca\xdc\x30\x26\x84\x13\xa1\xd8\xa7\xaf\x1b\xe7\x46\xea\x64\xde\x58\x08\x6e\x7a\xd6\x5a\x54\x3c\xcf\xea\x84\xab\xbe\xe8\x82\xe3\x0b\xc1\x67\xf7\x42\x64\xce\x0c\x15\x20\xa4\x8d\x71\x63\xf6\x48\x64\x89\x66\xba\xed\xfc\x6f\xa3\x3b\x1b\x53\x07\x45\x4f\x53\x54\xdc\x23\xf0\x63\x14\x76\x8e\xc8\x8c\xef\x15\x94\x84\x7c\x1a\x4f\xef\x79\x18\xae\xb4\x0d\xeb\x4c\x3e\x65\x3e\xe3\x6e\xc7\x11\xcf\x6e\x50\x97\x09\xff\xb7\xf1\xca\x22\xb7\xef\x87\xd2\xc5\x3f\xb9\x7d\xee\xa9\x3d\xc9\x27\xa9\x5e\x8c\x39\x10\x30\x47\xee\x69\x8b\x14\xaf\xe5\x3a\x8d\x42\x1e\x0a\xf0\x39\x3e\x53\xf1\xe5\x1f\xbf\xd3\xc6\xc3\xe6\x3e\xc1\x51\x7e\x83\xe7\xf6\xbd\xc3\xdb\x53\x93\x4d\xa8\xc2\x75\x20\x87\x17\x9f\x4c\x75\x04\xfa\x64\x10\xa9\xf3\xcf\x8b\xb2\x8e\x11\x1e\xc9\x65\x7e\x92\xb4\x97\x0f\x3c\x4a\xfb\xdd\x25\x5d\x30\x95\x90\x0e\x1a\x94\x8e\xaa\xb9\x68\x30\xe5\x3d\x1a\x93\x4e\x25\x8c\x2d\xbf\x18\xd3\xbf\x4b\xa7\x97\x6c\xc0\x17\x38\xa3\x2f\x52\x98\xd3\x05\xc6\x21\x33\xb7\xa8\x97\x6c\x7b\x98\x4e\x28\x16\x64\x9f\xb0\x95\xa2\xd8\xc6\xca\x6e\xbb\x4c\xae\x1e\x4b\xb8\x71\xca\x88\x21\x8a\xb6\x56\xe0\xed\x8e\xa2\x64\xde\x08\x6b\xb2\x60\x29\xeb\x72\x3a\xe8\x1f\x79\x2d\x5e\x06\x58\xf6\xc4\xaf\xc8\xe3\x7f\x65\x80\x7f\xd4\xcd\x7a\x99\x73\x9d\xc7\x5d\x32\x93\xb9\x90\xf7\xe2\x42\xf1\xd5\x16\xc9\x01\xa1\xa9\xb3\x96\x13\x2d\x2e\x39\xe6\x38\xbd\x0b\x2b\x22\xf5\xc2\x25\xbf\x4b\x58\x59\x91\x2d\x2e\xf6\x58\x93\x71\xe1\x3d\x51\x42\x72\x40\xb9\xf8\x20\x45\x7f\x8f\xbb\x8b\x9e\x67\x94\x46\x20\xb7\x4d\x50\x5c\x96\x10\xdd\xe0\x59\xb8\x8b\xf0\x25\xf7\x10\x4c\xb0\xde\x5a\x87\x8c\x7a\xbe\x45\xfd\x9e\xe1\xef\x4e\xb2\x42\x73\x77\x7d\x44\x57\xe9\x4e\x6d\x99\xca\xcc\xe9\x32\xfa\x0d\x11\x97\x08\x3c\x1f\x9e\x8d\xfe\x05\xb0\x38\x4b\x92\x75\xce\xc7\xf1\x46\x68\xb1\xdf\x81\xe4\xfd\xd5\x07\x2d\x23\xa0\x05\xdf\xe5\x05\x99\xf1\x56\xa3\xd4\x95\xa9\x43\xa7\xfe\x10\xe3\xb2\x18\xc0\x59\x6e\x1f\x69\x66\xb9\xf0\xc2\x73\x30\x53\x5a\x67\x72\x4b\xb3\x74\x73\x47\xc1\xa6\x88\x09\xfb\x4b\xa2\xdb\x92\x46\x8f\x72\xca\x63\x74\xbb\xca\x15\x0f\x56\xe2\x87\x35\xe3\x81\x39\x45\xc9\xb9\x7d\x2b\x9c\x0f\x5e\x00\x75\x7a\xd2\xe5\x2d\x8d\xe3\x5e\x7f\x2e\xee\x45\x91\x5f\xb2\xc0\x9d\x7d\x59\xa1\x9d\x07\x90\xa3\x88\xf1\xf2\xa7\xf0\xa2\xfe\x71\xda\x4d\xf1\xea\x92\xb8\x51\x61\x8a\xb5\x52\x59\x09\x4a\xaf\xfa\x8d\x5b\x09\x4d\xe1\xe2\x55\x51\x74\x68\xe2\x44\xce\x28\xf4\xb2\xdf\x3f\xbd\x29\x5b\x66\x15\x67\xd4\x65\xd4\x1f\x13\x3b\x53\x70\xe5\xcd\x99\x30\x45\xd3\x2e\xcc\xb3\xdf\x8f\xf2\xcc\xda\x02\x72\xd6\x37\xa3\x67\xe2\xe0\xff\xfc\xaa\xe8\x2b\x32\xfa\x11\x30\x23\x5f\xe9\x07\x68\xfb\x29\x8e\x95\x12\xa2\x50\xd1\xb6\xf4\x41\xb9\x55\xee\x94\xdb\xe5\x07\xe5\x6e\x79\xaf\x7c\x58\xae\x95\x97\xca\x07\xe5\x95\xf2\x46\x79\xa7\xbc\x5f\x5e\x2c\xaf\x97\x97\xcb\xab\xe5\xdd\xf2\x76\x79\x4d\xdb\xd4\x15\xd3\xeb\xc9\xd6\x1e\x69\xb3\x7e\xd1\xa5\x75\x04\xdd\xe6\x92\x3b\x1d\x3f\xa4\x96\x73\xe2\xdb\xe8\xa2\xa4\x3c\x90\xfd\xa0\xf8\x30\x27\x48\x90\x0e\x36\x22\x82\x37\xcb\x1b\x29\x32\x01\x23\xef\xc4\x5c\x15\xf1\x12\x07\x01\x29\xb0\x4c\x32\x5e\xec\xf8\xee\xef\x89\x37\x7f\x67\xd7\x6c\x87\xa7\xb8\x9d\x41\x9c\xcf\x91\x3d\xee\x73\x42\x84\x06\x8f\x9d\x8a\x5c\x81\xe7\x18\x1f\xc2\x0d\xc0\x93\xe1\x2b\xa1\xc1\xff\x24\x8e\xdb\x7c\x43\xc6\x1e\x1f\x73\x92\x2c\x68\xe0\x6d\x03\x63\xb2\x45\x4d\x3a\xc7\xee\xa1\x0e\xc0\x6f\x04\xb8\x18\xa2\x74\xe2\x55\x66\x13\x87\x74\x91\xd7\x55\x03\x8b\x53\xa8\x96\xfd\x20\xc3\x53\x52\x7c\x20\x2e\x57\x5e\xd2\x11\x34\x45\x9f\xe4\x13\x70\x65\xe1\x53\xbc\xb5\x71\x6c\x16\x49\x1b\x1b\x97\x25\xa3\x76\xc5\x99\xe2\x18\x82\x33\xd4\xdd\x93\x83\x79\x32\xb7\x3d\x15\x2e\x55\x66\x00\x8d\x35\xfc\xa6\xd5\x98\x39\xb8\xf7\x28\xa8\xcf\x87\xef\x72\x17\xda\x61\xdc\x7f\xe6\x5b\x97\xf5\x9c\xdc\x47\x79\x4e\x15\x2c\x9a\xe2\x75\x35\x45\x8b\xa6\xa7\xa9\x75\xa2\x78\x0f\x8f\x78\x6f\x1b\x01\xb3\xdd\xb6\x17\x50\x96\xfa\xce\x9b\x20\x7d\xe0\xdb\x18\xa4\x0f\x07\x99\x0c\x72\x48\xc1\x49\x76\x6d\xaa\x78\xd1\x2a\xad\xd3\xc9\x09\xe9\x9d\x15\x32\x7f\x2b\x58\xd9\x3d\x2d\x62\x6a\x5e\x9f\xba\x41\x80\x4d\x4c\x1f\x39\x17\xc7\xd4\x5e\x75\xea\xe0\xbd\xdb\x78\xef\x95\xbc\xe3\x45\xea\x88\x74\x00\x1b\xee\x36\x1a\x13\x84\x74\x32\x3e\x49\x04\x21\x64\x65\x32\x2e\xb2\x8d\x2f\xc2\xdd\xb3\x88\xa7\x1f\xa2\xeb\x13\x73\xd9\x25\x76\x3e\xc5\x8d\x86\xa7\xc5\xd7\x21\xbe\x40\xaa\x2b\xd0\xdd\xa2\xdd\x55\xc5\xc6\x49\xc1\xa6\xa8\x3f\xf2\xc2\xe5\x9c\x96\x96\x5a\xc3\x2e\xba\xb9\x6a\x9a\x78\xbe\xc7\x29\x59\x91\x26\xcc\xb4\xe9\x8a\xe6\xcc\x73\x71\x90\xf9\x0f\xf2\x01\x91\x5c\xd3\x10\x57\x35\x4e\xc8\x32\x41\xfc\x8d\x2d\x96\xd2\xe8\x26\x64\x8b\xac\x59\x61\x74\x49\xbd\x16\xc7\x07\xc8\x21\xdf\x17\xe2\xa5\x18\xc4\xa5\xd1\x1b\x5f\x65\x89\xa8\x8d\x8e\xd0\x4a\x05\x9f\x5f\xe5\xe2\xd4\x62\xb0\x7f\x4c\xeb\x52\x5b\x8e\xde\x89\xe3\xb8\xa4\x11\x10\x47\x71\x80\x28\x49\xfa\x71\x61\x55\xf2\x6d\xb1\x97\x89\xb6\x97\x0b\x5e\x05\x53\x6f\xc5\x15\x40\x27\x62\x57\xa0\xd8\xaf\xf7\x1f\x6e\x5e\x8e\x09\x35\x4b\x22\xe4\x23\x85\x45\x6c\xf5\xbb\x6c\xec\x74\xbe\x48\xc1\x75\x8b\x38\xb9\xb9\xf8\xc9\xef\x0d\xcc\x5d\xc5\x9b\x8f\x6a\xbd\x22\x9b\x8f\x4f\xbf\x90\x77\x01\xe3\xac\x47\xbd\x1c\x43\xb9\xe4\x65\xa9\x1c\x6b\x2b\xca\x26\x69\xc7\xc9\x6e\xc7\x4c\xa6\xac\x09\xc4\xb3\x49\xda\xcc\xa4\x55\xfa\x0c\xdb\x94\x39\xe0\x54\x2c\xf4\xaa\x15\x45\xe3\xf1\x92\xef\x6d\x8c\x2b\x9d\xee\x2e\xfe\x5c\xb5\x7f\xd8\x06\xba\x2c\x60\x29\xb0\x00\xfb\x72\x1a\x6d\x0b\x95\x75\x97\x4c\xa1\x38\xb0\xea\x39\x29\xb2\x93\x32\x97\xe5\xa4\x29\x21\xde\x9b\x7a\x51\xb1\x8c\x8a\x5f\x5c\xe0\xd2\x1b\x86\x8b\xc1\x4c\xd8\xa9\x5d\x58\xe0\xa2\x5d\xda\xc5\x85\x44\x86\xbc\x1e\x2b\x26\x51\x81\xc1\x19\xef\x8b\x4f\x76\xa2\x5c\x38\xc2\xef\x25\x06\x28\x3b\xd3\x4e\xa4\xf0\x99\x82\x2b\x9f\xb3\x11\x6f\xa9\x68\xa2\x6c\xe2\x34\x8d\x18\xa5\xf7\x09\x4b\xeb\xb1\xd1\x0e\xe0\x09\x59\x90\x7f\xe6\x2f\x35\x96\x0e\x07\x13\x39\x38\xf5\x16\x22\x9c\xef\x57\x2e\x38\x18\x88\xc4\x3a\xef\xc9\xd8\x23\x10\xe2\x2c\x53\x74\x35\x7f\x34\xc0\xcf\x62\x03\x74\x41\x4a\x26\xd6\x6f\x4c\x26\xf9\x84\x9d\x8b\xb3\x5c\xd0\x8f\x7c\xe1\x94\x98\x8a\xe3\x6f\xa5\x47\x5c\xb1\x3c\x19\xbe\xc5\xad\x63\xe2\x5e\x88\x9f\x92\xd6\x5c\xa0\x93\xbf\x17\x83\xf2\xab\xf0\xd6\x4a\x6a\xec\x01\x98\x20\x13\xd2\x51\x0d\x39\x29\xd8\x93\xfd\x76\xf4\x84\xb7\x36\xb5\x10\x77\x2e\x28\xf5\xb9\x74\xc8\xe5\x25\x77\x96\xc5\xa7\xf3\xbd\x89\x0e\xc9\x1d\xfd\x52\x10\x01\xf2\xc7\x57\x9b\x3e\x71\x0b\x8d\x95\xcc\xcc\x2c\xaa\x76\x9a\xfd\x3f\xe8\xca\xe3\xca\x4b\x3a\x76\x71\xe2\x39\x08\xb8\x0d\x3d\x5a\x1e\x3a\x21\x79\xaf\x81\x98\x6c\xd3\x78\x0d\x72\x0b\x25\x22\x14\x07\x28\x0a\xb7\xb3\xe7\x30\x96\x3a\xf8\x2f\xe3\xf9\x7d\x1e\x9f\xcc\x90\x3b\x21\x9d\x7b\xba\x71\xa7\x22\x1e\x46\x96\x0d\xdb\xcd\x2c\x84\x27\x19\xcf\xa3\x1d\xf7\x63\x3e\x4f\xb3\xcf\x6d\xe2\x45\xd8\x79\xb7\xb4\xe4\x81\x4f\xb9\xa8\x2f\xc8\x19\x49\xe2\x31\x59\xc7\xde\x89\x9d\xcf\x3c\x7a\x8a\x2b\xcc\xe7\xf1\xb2\x01\xe4\x78\x86\xc7\xd1\x9f\x8d\x7e\xc4\x6b\x94\xa2\x40\xeb\xe7\x93\xda\x77\x19\x38\x72\xeb\xc5\x36\x83\x74\x98\x11\x49\x5f\xa8\x8c\x83\x5c\x60\x63\x93\x3c\xa3\xc9\x2a\x62\x82\xcc\x7f\xe1\xeb\x0f\x11\x6f\x8c\xd8\x4a\xec\x60\x27\xd9\x25\xcf\xfc\x4d\x7b\xe3\x4e\xdd\x8f\x8e\x3c\x94\x97\x72\xf3\x59\x45\x58\x58\xec\xf6\x22\x89\x1b\x13\x69\x3f\x7e\x9b\x66\x6f\xea\x45\x2c\xa2\x20\x22\xad\x28\x0b\x57\x81\x50\xbf\x8a\xee\x09\xcd\xb9\x2b\x86\xa7\x05\xd1\x1b\xd8\xe2\x27\xf1\x32\x4f\x7c\xf3\x6a\x4a\xcb\x4a\xd9\xd6\x93\x2e\x3d\xcf\xde\x7e\xfe\x4a\x92\x3e\xd2\x29\x3c\x62\x7d\x98\x1f\xb4\x91\x7c\x16\x1b\xc9\xb8\x54\x10\x77\xae\x73\x76\x17\x6d\x19\x79\x2e\xbc\xb4\xbf\x0a\x4b\x34\x5e\x7a\x48\x03\x92\xee\xfe\x94\x2e\x2d\x8a\x8e\x60\x88\x2e\x10\x1e\x7b\x4d\xc3\xb4\xc0\xe4\x73\x75\x7e\x27\xb8\x57\xe3\x70\xf2\x56\x9c\x74\xf9\x87\x74\xa4\x18\xd4\x15\xbb\x91\x07\x36\xb6\x13\xb1\x2a\x30\xfa\x27\x6a\xd0\x99\xa4\xa8\xc2\x29\xc6\x3f\x5d\x38\xda\x89\x14\xf9\x7a\x0a\x5b\x3a\xdd\x71\x3a\x91\x0b\x51\x38\x3f\x24\xaf\xd7\x84\xf3\x24\x4f\x52\x0b\x8c\x27\x13\xb2\x16\x4f\xdc\x1f\x46\xfc\x2e\x08\xf1\x01\x9a\x81\x2b\x6c\xd9\xbd\x06\x69\xa6\x91\xbf\x03\x3f\x77\x1d\x92\x58\x4d\x20\x89\xd4\x1d\x9e\xca\xae\x6f\xf9\x3b\xba\xbe\xe5\x0f\xfc\x40\x19\xe9\xc3\x41\x26\x43\xda\xf5\x2d\xa7\xa4\x1b\x2a\x25\xa4\x3c\xc6\xa9\x84\x34\xae\xe3\x35\x0e\x92\xb9\x54\xfa\x2a\x67\x0a\x28\xda\x5d\x5c\x59\x0c\x8c\x36\x35\x7c\xdf\x38\x24\xf2\x99\x81\x79\x25\xf0\xad\x38\xba\xed\x8c\x87\xee\x9d\x27\x6b\x89\xc9\x59\x37\x24\xd6\x99\xce\xf3\x77\x22\x13\xd9\x79\xc6\x17\x60\x65\x1e\xff\xcf\xdc\xe6\x81\x57\xc9\x96\xeb\x31\x94\x95\x86\x55\x9c\x39\x56\xd3\x8b\x9a\x5b\x58\x04\xc9\x51\xee\xae\xa2\xdd\xd1\x37\xcb\x77\xb5\x7b\xba\xe2\x0e\x1c\x87\xc4\xf1\x8e\x18\xc4\x83\x42\x64\xf4\x33\x3f\xdd\x2a\x6f\x8c\x9d\x2b\xda\x43\x9d\x56\x6c\xb7\x4b\x7d\x9b\xa9\xb4\xb2\xb1\x5e\xd2\xee\xeb\x47\xff\xc3\xdc\xa8\x2a\x01\xf3\x6d\xb7\xa3\x68\xad\xea\x75\x25\x6c\x34\xc2\xeb\x1a\x85\xa7\x0f\xae\x6b\x66\xb5\x7e\x04\x5f\x95\xeb\xc7\xcd\x63\xed\x3b\xfd\xa8\x55\x55\xae\x2b\x1a\xe5\x7f\xef\x54\xaf\xcd\x69\x74\x05\xfe\x42\x46\x00\xe5\x0e\x7a\x2d\xea\x03\x28\xa5\xd1\xb0\x8e\x3e\x3a\x56\xeb\x8d\x46\xa5\xd1\x68\x34\x66\xab\x37\x9a\x0b\xf0\xed\xe6\x71\xe9\x68\x4e\xfb\xf8\x58\x01\x90\x1f\xa4\x1a\xb5\xb4\xb6\xb4\x5e\xd2\xbe\xd6\x11\x54\x8f\x32\x43\xd1\x9c\x2a\x03\x60\x7f\x0a\x67\xb0\xda\x0f\x14\x6d\xb7\x7a\x84\x69\x37\x76\xe9\xe1\xbe\xe7\x5b\x4a\xd5\x2e\x07\xc7\xd0\x82\x0f\x9a\xc7\xda\x37\xbc\x70\x70\xd8\x6b\x79\x0e\xb6\xe3\x7f\x63\xc1\xdb\x61\x55\xe1\x6d\x3d\xd6\xbe\xe2\x79\xda\x03\xd7\x64\xb6\xe7\xf2\x4a\xf6\xb0\xab\x4a\xa2\xef\xe3\x05\xe3\xdf\x0f\x4f\xc2\xf4\x62\x3a\x42\x6b\x34\x4a\xd0\x90\x82\xdc\x24\x93\xfb\x58\x43\xa8\xaf\x79\x74\xc2\xe8\x9f\xd9\x12\xa3\xa7\x61\x92\x28\x97\x1d\x9e\xf1\x1a\x26\x94\x24\x63\x4b\x1e\x37\xc5\xd0\x29\x8d\x86\x9a\xb4\x98\xba\x56\xb0\x65\xf8\xd4\x65\xf2\x98\xf5\x0d\xdf\xe8\x05\x11\xaa\x19\xe4\xd6\x04\xae\x34\xfa\x0d\xfc\xdd\xad\x1e\x09\x64\x57\x95\x68\x7a\x28\x9a\x63\x33\xea\x1b\x4e\xf5\x1e\x22\xff\xa1\x76\x5f\xfb\x0e\x46\xb4\x79\xac\xc9\x63\xba\xb3\x0e\x18\x60\xc7\xa5\xe6\xf1\x6d\x9f\xb2\x81\xef\x1e\x99\xab\x00\x14\xaa\x93\x00\xdb\x5a\x6b\x60\x3b\xec\x81\xed\x56\x7b\x9a\x62\x3a\x46\x10\x28\xd5\x2d\x8d\x1d\xf6\x69\xf5\x4e\xa6\xae\xaf\xb5\xaf\xb4\x0f\xb4\x6f\x34\x51\xe7\x71\x49\xa3\x15\x9f\x76\xec\x80\x51\x7f\xcd\x70\x3b\x03\xa3\x43\x55\xc5\x68\xb9\x6d\x45\x8b\x46\x59\xa5\xa5\xa3\x3d\xc3\x27\x4c\x3f\xf2\x07\x0e\x5d\xa6\xa6\x63\xf8\x06\xa4\x54\x95\xbf\xd7\x8d\x1b\xdf\x2d\xde\xf8\xaf\xa6\xf8\x9d\xbb\xf1\xf9\x8d\xe6\x87\x8a\x36\x70\xe9\x41\x9f\x9a\x8c\x5a\x4b\x5d\xc3\x0f\xaa\x4a\xfd\xda\xff\xfc\xd3\x07\x7f\x9f\xb9\xae\x2d\x94\xff\xf7\xff\x2f\xac\x36\x95\x63\xcd\xd7\xeb\xca\xe2\xda\xd6\x57\x8b\x8a\xa6\xdc\x59\xdd\x51\x34\x65\xe9\xab\xc5\x6d\xf8\xe1\x7f\xd6\xee\xc2\xcf\xce\x9a\xa2\x29\xcb\xab\xf7\x30\xc3\xf2\x5f\xef\x6f\xee\xac\x28\x9a\xf2\xd5\xca\xb7\xcb\xab\xf7\xe0\x61\x67\xf1\x8e\xa2\x29\x98\x77\xed\x9b\xda\x96\xa2\x29\x9b\x4b\x3b\x2b\x90\x19\x5f\xbe\x16\x30\x21\xa9\xa9\x19\x3a\xad\x2c\xa9\xca\x6d\x45\x53\x3e\x50\x4a\x9a\x9d\x25\xf9\xd9\x3f\xb7\xea\x73\x37\xe6\x9b\x65\xf5\x06\xff\x0d\xd5\x46\x85\x3f\x95\xca\x30\xf9\xe6\x8f\x67\x8f\x35\x37\x5f\xcc\xaa\xcf\xdd\xf8\x5c\x14\xfb\x3c\x2e\xf6\x79\xaa\x98\x97\x2f\x76\x00\x99\x16\x6f\xdc\x8d\x4a\xe2\x63\x54\x18\x5f\xa4\xf2\x41\xbe\x7c\x3d\xb0\x9b\xb3\xc7\x9a\x03\xec\x85\x55\x32\xa3\x53\x56\x1a\x8d\xe0\x43\x5d\xd1\x7c\xce\x6e\xaa\xb3\xfa\xac\xe6\x57\x13\xf2\x35\x18\xf3\xed\xd6\x80\x51\x00\x96\x2b\x7e\x9c\x90\x9d\x5d\x65\x95\xcc\x88\x6a\xbb\x55\xbf\xf2\x0f\xcf\x76\x55\x85\x28\x25\x00\xe9\x68\x86\x66\x6b\xae\xe6\x69\x81\x46\x2b\x7f\xad\xad\x6b\xc0\x2b\xc7\xd3\x98\x69\xd2\x20\x70\xbc\x4e\x9a\xd0\x22\x42\x2f\xe2\x8a\x2d\x60\x82\xf3\xda\xad\x63\x64\x8c\x93\x1e\xd5\x2a\x7f\xfa\xf8\xb8\xb4\xd0\x68\xb4\x80\x97\x14\x42\x2b\x43\x22\xe0\x44\x64\x90\xf9\xb9\x7a\x6f\x65\x27\xdc\xda\xac\xed\x84\x5f\xad\x2c\x2e\x87\x5b\xf7\x77\xc2\xe5\x95\xb5\x95\x9d\x95\x70\x69\x73\x63\x63\x65\x69\x27\xdc\xdc\xda\x59\xdd\xdc\xa8\x85\x5b\x8b\x3b\x4b\x5f\x85\x3b\xdb\x8b\x4b\x2b\x25\x2e\x01\xae\x03\x03\xba\xb7\xb2\x43\xa0\x3c\x81\xf2\x64\xeb\xfe\x0e\xe1\xe5\x89\x28\x4f\x44\x79\x82\xe5\x09\x96\x57\x34\x1b\xda\xe6\x42\xa3\xe6\xf3\xad\x9a\x6d\xd4\x67\x61\x20\x1b\xcd\xd9\x28\x63\x41\xcb\xa3\x36\x44\x39\x26\x8c\x01\xa0\x3d\x30\x7d\xbb\xcf\x8a\xe6\xbb\x22\x66\xf5\x83\x0f\xa4\xf9\xfd\xe0\x03\x98\xe0\xbe\xae\xa8\xf5\x0f\x9b\xe1\xb8\x1c\x25\x45\x33\x38\xbd\xfa\x34\x60\x0f\x0c\x1f\xdb\xa6\xd4\x2b\xcd\xa3\x5b\xc7\xc0\x57\x19\xef\x61\x44\x62\x86\x63\x1b\x01\x0d\xaa\x75\xc5\x08\x94\x66\x8a\x6f\x1a\x01\x69\xf9\xd4\xd8\x25\xa6\x11\x50\x62\x1a\xcc\xec\x12\xe4\x72\xc4\xf4\xdc\x80\xc1\x5f\x66\xbb\x03\x4a\x2c\xda\x36\x06\x0e\x23\x16\x75\x28\xa3\xc4\xf2\x88\x75\xe8\x1a\x3d\xdb\x24\xd4\x30\xbb\x84\x3a\x01\x25\xf4\x80\x01\x2f\x27\x6d\xdb\x35\x1c\xfe\xd7\x39\x24\x6d\xcf\x27\x51\xf7\x49\x87\x32\x62\xb7\x89\xdd\xeb\x3b\xb4\x47\x5d\x16\xc0\xa3\xe7\x33\x62\xbb\xc4\x76\x4d\x67\x60\x51\x62\xbb\x01\x33\x5c\x93\x7a\x6d\x62\xbb\x8c\xfa\x6d\xc3\xa4\xfc\x09\xc0\xda\x01\x71\x8d\x1e\x0d\xfa\xf0\xd5\x35\x98\xbd\x47\x89\x4b\xf7\x89\xb7\x47\x7d\xdf\xb6\x28\xe9\x1b\xe6\xae\xd1\xa1\xa4\xef\xdb\x7b\x06\x83\x5f\x8f\xe1\xdc\x22\xfd\x41\xcb\xb1\x4d\xc2\xb1\x42\x40\x9d\x0d\x98\xc1\x6c\x93\x04\x83\x3e\xf5\x49\xb0\x6f\x43\xf7\x59\xd7\x0e\x08\xeb\xfa\xde\x3e\x61\xfe\x21\x01\x56\xef\xb5\xc9\x20\xa0\x04\x06\x6e\xcf\xb3\x2d\xb2\xdf\xb5\x1d\x4a\xf6\x6d\xd6\x4d\xe4\x8d\xc2\xfc\x01\x25\x6d\x03\x10\x81\x9a\xd0\xc0\xb5\x68\xdb\x76\xa9\xa5\xa0\x74\xa0\x95\x45\x9c\xb6\x7c\xf2\x82\x4e\x01\x3f\x77\xf8\xcf\xc6\x3a\xa7\x34\x2e\x60\xb4\xd6\x5f\x40\xfe\x61\x37\x50\x4c\x1e\x29\x1c\xc2\xce\x7a\xf3\x38\x97\x91\x0f\x57\x8c\xa9\xa8\x40\xa2\x01\x41\xa6\x68\x68\x12\xc4\x2b\x20\x10\x13\x80\x5c\xaf\x81\xac\xf1\x80\xe0\x68\x70\x35\xa5\x48\xbf\xc9\x66\x3c\x16\x90\x12\x05\x06\xa0\x25\x6f\xb4\xaa\xd4\x8f\x6e\x37\xa3\x96\xe1\x04\xaa\xc5\x1d\xd3\x52\x42\x3f\xab\x22\x4c\xc4\x9f\xd1\xe4\xea\x4c\x15\x58\xb2\x52\xf6\x8f\x51\xd6\xaf\xaf\xec\x7c\xb5\xb9\xfc\xe0\xde\xfd\xc5\xed\xe5\xa6\x66\x57\x67\xff\x34\x3b\x76\xaa\x5a\x46\xe1\x0c\x6d\x34\x2c\xf5\x41\xd8\x68\x58\x25\x3e\x29\xeb\x74\xa5\x59\xbf\x51\x6e\x2e\x28\x65\xa6\x19\x3a\x2b\x2b\x6a\xa3\x51\x51\xca\xac\xac\x94\x16\x54\xa5\xec\xc3\xaf\xa2\xd9\x50\x72\xbf\xac\x68\x2e\x64\xf9\x93\x52\xb6\xa3\x8c\x36\x64\xf8\x53\x92\xd3\x83\x9c\x2d\x55\x29\xbb\x65\x25\x54\xca\x46\x59\x29\x29\x5a\xa0\x2b\xf5\xc5\x1b\xff\x65\xdc\xf8\xae\xa9\x3e\x58\x10\x8f\x73\x37\x3e\xaf\x34\xa1\x19\x8e\xae\xd4\x9b\x47\xc7\x7f\xfe\xd3\xf5\x86\xa2\x68\x26\x97\xb9\x37\x6e\x08\xa1\x6b\xe9\x5c\xbb\x0a\xca\x80\x8c\xb2\x40\x60\xf0\xa1\x5a\xd5\xc3\xdb\x61\xa3\x51\x0a\xf5\x2f\xc3\x0f\x4a\xc0\x09\x9d\x98\x36\x1c\xcf\xeb\xe3\x2c\xb5\x50\x40\x51\xe2\xb1\x2e\xf5\x83\x8c\x62\x26\x46\x37\x1a\x7e\x1c\x5c\xd7\x63\x9c\xd6\x91\x59\x18\x2e\x23\x5c\xf2\x24\xf3\xbd\xef\x7b\x26\xb5\x06\x3e\x4c\x60\xe2\x0d\x18\xe1\xbc\xc8\x22\xf4\xc0\xa4\x7d\x24\x0c\x01\x18\xe6\x19\x0c\x7c\x90\xd1\x07\x41\x80\x64\x95\xb4\x14\x07\x6b\x01\x11\x22\xff\x01\x36\x20\x26\xb7\xd1\x0a\xe0\x9b\xdd\x26\xd0\x46\x9f\xee\x51\x3f\xa0\xf0\x95\xf9\x86\xc9\x08\x75\x2d\x6c\x6a\x1f\x1e\x61\x96\x07\xd4\xa1\x66\xdc\xfc\xb8\x71\xc4\x6b\x93\x80\x02\x55\x32\x2a\x35\xdd\x66\xc4\xf3\x49\xe0\xf5\xe0\xa3\x23\xf0\x45\x82\x41\x0b\x3a\x41\x0c\xd7\x42\x74\x42\x77\x83\x43\xd7\xec\xfa\x9e\x6b\x7f\x47\x2d\xc2\x4d\xc9\x18\x37\x82\x63\xd9\x6e\x87\x18\x8c\x30\xa3\xd3\xa1\x16\xe9\x50\x97\xfa\xb6\x19\x73\x31\x66\x04\xbb\xa4\x45\x3b\xb6\x4b\x3a\x1e\xf3\x48\xdf\x37\x3a\x3d\x83\x30\xea\xf7\x6c\x17\x5a\xd5\xf2\xac\xc3\x98\xd7\xb1\x2e\x75\x81\xbf\x26\x68\xc7\x16\x21\x73\xb7\x5d\x89\x15\xc6\x03\x26\x71\xd8\x80\xf8\x86\x1d\x50\x64\x75\x11\x29\xf8\x86\xdb\x81\x37\xc7\x38\x24\x8e\xdd\xb3\xa1\xac\x4f\x4d\xcf\x07\x26\x48\x5d\x48\x61\x06\x41\x0a\xf2\x69\x4f\x30\x46\xcb\xee\xd8\x2c\x20\x3e\x45\x46\x8d\x7c\x12\x04\x46\xcf\x83\xb2\x0f\x07\x74\x40\xc9\x81\xe7\x4b\xac\x73\x07\x58\xe7\x5d\x60\x9d\x9c\x57\x9a\x39\xd9\xac\xa0\x68\x56\x66\x85\x01\x31\xab\x28\xb3\x82\x32\x72\x59\xaf\x57\xae\xcf\xe6\x74\x12\x4f\x56\x44\x12\x2b\xec\xba\x02\x46\x1a\xa7\x3f\x9b\x39\x48\x80\x30\x57\x5b\xd0\x66\x98\x41\xa5\x05\x78\x13\xe8\xe5\x1f\xe0\x9d\x0f\x0e\xbc\x43\x32\x0c\x01\x4f\xc3\x09\xa7\xda\x01\xce\xb1\xdd\x98\x89\xe3\x20\xa5\x4d\x53\xbb\xea\x70\xae\x05\x00\x54\xa8\x2e\x4c\xe8\xa1\x14\xd7\xa4\x46\xd4\x12\xc6\x43\x5a\x8a\x67\x36\x14\xb5\x83\x50\x34\x17\x7e\x05\xca\x61\xa6\xc3\xb4\xbf\xcd\x5b\x21\x11\x5a\xc1\xc4\xc4\xe1\xb1\x93\xe1\xe2\x33\x28\xd2\x6c\x93\xd1\x18\x87\xa0\x29\x5a\xa9\x86\xf0\x86\x48\xc9\xe1\xc0\x4a\x4f\x7f\x05\x7b\x01\x2d\x90\x01\x04\xe5\xf0\x76\x84\xd3\xa8\x7d\x32\xa4\x34\xcb\x00\xb8\xcd\xe3\x3c\x5c\x35\x18\xb4\x4a\x0b\xf0\x45\x66\x8e\x65\x84\xca\xf3\x71\x98\xbc\x5d\xe3\x35\xbb\xbe\x61\x76\x69\xa1\x0d\x97\x51\x84\xeb\x8d\xc6\x07\x7f\x6e\x82\x2e\xac\x14\x29\x63\x08\xc7\xf4\xdc\xb6\xd2\xd4\x38\x63\x43\x39\xf7\xd5\x92\x90\x86\x01\x8d\x04\x69\x55\xf9\x62\x96\x13\xd7\x97\x11\xbf\x4c\x19\x18\xb3\x8d\xfd\x32\xb7\x3f\x76\xab\x47\xae\xd7\x33\xfc\xdd\x41\xbf\xaa\xe0\x4e\x28\x62\x51\xf7\x10\xf8\x94\xb7\x0f\x3a\x0f\x75\xf7\x88\x4f\xf7\x7d\x98\x7b\x03\x87\x46\xcf\xd4\xed\xd8\x6e\xfc\x66\x7a\xae\x45\x2c\xcf\x1c\x80\xaa\xe0\x7b\x1e\x83\x92\x5d\xc3\xb5\x1c\xea\x13\xea\xfb\x9e\x1f\x25\x12\xc7\x33\xac\x9e\x67\x01\x28\x0f\x79\x66\x40\xba\xd4\x80\x7a\x1d\xc0\x1b\xe8\x59\x3e\xd0\x1f\x07\x02\x8f\x40\x66\xca\xb1\x16\x30\xc3\x67\x41\xf5\x88\x56\x67\x3f\x88\xdb\x1e\xb3\x04\xe4\xbd\x6d\x68\x36\x67\x09\xb2\x92\x82\xc3\xd6\x68\xd4\xc5\x08\x36\x3f\x88\x70\xb2\x67\xf8\xb6\xd1\x12\x54\x1a\x21\xff\x48\x64\x3b\x46\x35\x42\x09\xa8\xd3\x56\x34\xd6\x3c\xd6\x18\xd7\x26\x9a\xc7\xc7\xa8\x1f\x34\x6a\xe3\x15\x84\x7e\xdf\xa1\xe3\x55\x79\xd9\xb1\x00\xea\xc9\x91\x5d\x55\x94\xe3\x92\xe6\xeb\x17\x29\x35\xa2\x35\x5c\x03\x84\x36\x19\x19\x81\x6e\xf3\xf7\x46\x43\x6d\x34\x3e\x54\x34\xa5\xd1\xf8\x10\xcb\x1e\x25\x85\x8d\xe6\x71\x49\x73\xf5\x3a\x58\x88\x48\x3c\xcd\x3c\xa9\x79\x81\x21\x9a\xdf\xcc\x0a\x4f\x90\xc9\x2d\x6f\x8f\x12\xa3\xcd\xa8\x4f\x8c\x8e\x01\xfa\x37\x0a\x31\xc3\xf7\x06\xf0\x13\x80\x7c\x6a\x19\x26\x08\xa2\xb6\xe7\x53\x2e\x8f\x5c\xe0\x27\x2d\xda\xb5\x5d\x8b\xb4\x28\x50\x57\x8b\xba\xd4\x60\x5d\xd2\xa2\x01\x28\xe2\x2d\xca\xf6\x29\x75\x49\x6b\xc0\x48\xeb\x10\x45\x8e\x6d\x51\xe0\xd3\x68\x56\x18\xb6\x1b\xfd\x06\x89\x9d\x61\x7a\xfd\x43\x62\xd9\x7b\xc4\xf2\x68\x40\xa8\xdd\xe9\xb2\xc8\xba\x70\x2d\x42\x1f\x0e\x0c\x87\xff\x0d\x38\x1d\x12\x10\xed\x87\x5c\x26\xb7\xed\x36\xeb\x92\xb6\xed\x07\x8c\x5b\x1d\xde\xc0\x87\x0f\xbe\xd7\x83\x3f\x2e\x43\xf3\xa3\x63\xef\x51\x97\x74\x1c\xaf\x05\xd6\x44\x9b\xd8\x1d\xd7\xc3\x46\xa1\x09\xc2\x3c\xe0\x85\x36\x23\x20\xc1\x1c\x23\x00\x02\x37\x0d\x87\xf4\x28\xe9\xd9\x96\xe5\x50\x14\x63\xbd\x43\xe2\xda\x2e\xeb\xa2\x76\xe1\xb5\x09\x50\x2b\x14\x05\xa9\xbf\x47\x7d\xe0\xae\x7d\xfc\x43\x7d\x76\x48\xfa\x03\x50\x41\xda\xf0\x9f\xfa\xd4\x35\x61\x9a\xf5\xa9\xc1\x04\xab\x85\xba\xf9\xe8\x90\x80\xcf\x3c\x34\x4d\xe8\x1e\x85\x1a\x02\x1b\x0a\x04\xf6\x01\x3c\x83\xc6\xc1\xa8\xe3\x10\x86\x69\xac\x0b\x8a\x43\x97\x86\x73\x5c\xf2\xb3\xae\xed\x5b\x68\xbb\x0c\x3a\x90\xea\x0f\x08\xb3\x7b\x14\xc6\x18\x7e\x03\xc2\x3c\xc2\x7c\xc3\x0d\xb8\x71\x8a\xf6\xcd\xc0\x65\xb6\x03\xc2\x1c\x44\x01\xb7\x6b\xba\x5e\x20\xc4\x02\xfc\xf1\x06\x4c\x12\xd5\x8b\x30\x15\x6a\xbc\xb5\xdc\xd8\x71\x6c\x97\xb6\x29\xea\x05\xa8\x7a\xf5\x6d\xf2\x70\xe0\x31\xe8\x64\x00\x16\x23\xb7\xd4\x98\xd1\x22\x60\x21\x29\x89\x43\x4d\x41\xfa\x24\x30\xb9\x6c\x13\xdd\x22\xa4\xe5\x79\x0e\x35\x5c\xd9\x04\x05\x25\xc5\x02\xdd\xa6\x0d\x6d\x03\x75\xa5\x23\x78\x0b\xe1\x9c\x96\xf8\xd4\x70\x22\xa5\x84\xeb\x01\x84\xd1\x03\x50\xe6\x18\xd7\x8a\x5a\x94\xf6\x89\xe9\x0d\x5c\x16\x69\x32\xc6\xc0\x35\xbb\xc4\xf1\x3a\xc0\x64\x00\xdb\x3e\x35\x2c\xc2\xc9\xdd\x1f\xb8\x24\x30\x0e\x49\x30\xe8\xf5\x0c\xdf\xfe\x8e\x12\x64\x89\xc4\xec\x1a\xa0\x3b\x52\x3f\x79\xe2\x74\x8b\x66\xac\x05\x9a\x1d\xd0\x58\xcf\x0b\x18\xb1\x2d\x62\x33\xda\x23\x0e\x75\x3b\xac\x4b\x7a\x1e\xd2\x8a\xd1\x03\xeb\xd4\x37\x3a\xbe\xd1\xef\x26\x4f\x20\x72\x83\x44\x49\xf5\x07\x7c\x6a\xc1\x88\x11\xf8\x04\x88\xd9\xa7\x74\x17\xaa\xd8\x47\xcd\xcb\xf3\xad\x80\x1c\x52\xc3\xe7\xbc\x91\xc9\x26\x64\x84\xde\x58\xe8\x99\x8e\xdd\x6f\x79\x86\x6f\x11\xdb\x6d\x7b\x21\xeb\x52\x12\x7f\x0a\xe1\x13\xcc\x95\x10\x11\xaa\x5a\x76\xb0\x1b\x84\x6d\xcf\xb1\xa8\x5f\x0a\x7b\x88\xb2\x3d\xcf\x19\xf4\x68\x88\xf7\x13\x30\x2f\x54\x4d\xc7\x0b\x68\xe8\xf5\xa9\x0b\x05\x4b\x42\x67\x0e\xd5\x0e\x65\x61\x40\x59\x89\x50\xaf\x1d\x9a\x03\x1f\xa4\x31\x8e\x5c\x68\x79\x24\xe8\x02\xd5\x72\x2a\x0f\x61\x16\x72\xa8\x40\xe9\xcc\x76\x3b\x41\xe8\x1b\xae\xe5\xf5\xc4\x90\x02\x9c\xa8\xde\xe0\x30\x00\x4c\xc6\xe2\x2e\xfa\xc0\x3b\x03\x38\x62\x1e\xb9\xb7\xbe\x13\xaa\x20\x89\x42\x7f\xe0\x86\x01\xf3\x7c\x5a\x8a\x6a\xe3\x3f\x9c\xeb\xf4\xfa\x9e\x0b\xc3\x15\x2e\xd6\x96\x56\x57\x89\x1a\x8f\x64\xc8\x2b\x2e\x85\x38\xdb\x51\x5b\xe7\xa4\x14\x9a\x5d\x0f\xa6\x83\x2a\x91\x69\x68\x7a\x8e\xe7\x87\x40\x90\xf8\x07\x47\x56\x60\x2d\x44\x6e\x03\xd8\x0c\x7d\xda\x83\x69\x20\x17\xbc\xbf\xbd\x56\x0a\x2d\x3b\xe8\x03\x15\xaa\x86\x43\x7d\x16\x5a\xb6\xe1\x78\x9d\x52\xa9\xd1\x68\x85\x7f\x07\xad\x2d\x52\x7f\x62\xd7\x9a\x98\x7c\xf1\x88\x22\x75\x23\x7d\x59\x94\x2b\xe5\x7e\x10\x63\x5c\xae\xae\x67\x07\x01\x74\x7c\xcf\x70\x06\xa0\x91\xc5\x10\x13\x2b\x8e\x43\x34\xfa\x86\xcf\x90\x51\x86\x06\xf2\x6e\x7c\x04\x41\x00\xf3\xc2\x6b\x87\xc0\x42\xbc\x76\xd8\xf1\xa9\x01\x33\x00\x74\x81\xd0\x0e\xdc\xeb\x2c\x54\x81\x51\xc3\x03\x32\x6c\xd7\x63\x25\xa2\x22\x83\x0e\x4d\xe0\x56\x5c\x62\xf0\x67\x94\x30\xa1\xe0\xf9\xa5\x50\x15\xd0\x42\x87\x06\x41\x09\x61\xaa\xc0\x41\xb1\x74\x69\x21\x54\xb9\x86\xb0\x10\x82\x76\x17\xa2\xcc\x09\x16\x4a\xc8\x93\x22\x20\xd4\x22\xad\x43\x04\x1e\x10\x55\x54\x85\xb5\x94\x42\x83\xa8\x3e\x6d\x87\x31\xcf\x2d\x85\x5b\x9b\xb5\xd5\x6f\x91\x8d\x88\x47\x20\xe9\x50\x45\x12\x05\x42\x2a\x45\x43\x8e\xec\x0b\x6d\xb8\x5e\x84\x34\xb0\x76\x41\x13\xb3\xab\x4a\xfd\x83\x23\xfd\x76\xa3\xe1\x36\x85\x5b\xe2\xfe\xce\xba\xe6\x37\x8f\x9b\x15\xd3\x73\x4d\x83\xa9\x6e\x09\x72\xcd\xce\x86\x37\xbe\x04\x6b\xbb\xd1\xa8\x83\xaa\x32\x4e\xb5\x30\xfb\xfd\xb1\x9a\x64\x66\x94\xea\xc6\x8d\xef\x1a\x0d\xeb\x41\xf3\xc3\x07\x8c\x37\x4b\x28\x18\x91\xf1\xc3\x17\x8b\xae\xab\x83\xcf\x16\xc2\xfb\xa5\x85\xb5\x85\xac\xb7\x92\x37\xf8\xce\x0a\x77\x98\xc4\x19\xb7\xe3\x7c\xa9\x74\xe5\x7a\xa3\xd1\x68\x2c\x54\xa2\x95\x35\xbb\xaa\x54\x70\x79\xcc\x48\x2b\xba\x7b\xd1\x9a\x4e\x4b\x9d\x6b\xd5\xe7\xe6\xaf\x37\xcb\x25\xb1\xc2\xa4\xde\x58\x00\x0c\xaa\xf5\x46\xc3\xba\xde\x04\x63\xa9\xc2\x1f\x3f\x2c\x2d\x84\xf1\x4b\xb9\xa4\x0e\xc2\xfb\xa1\x13\xae\x85\x03\x27\xbc\xbf\x16\xb6\xc3\xbb\x61\x2b\xbc\x23\x43\x01\x9b\x63\xae\x7e\xf0\x6d\xb3\x6e\xdc\x68\x2f\xde\xb8\x3b\x77\xe3\xf3\xeb\xe8\xce\x6f\xb4\x2e\x04\x1e\xfb\x6d\xa2\x2f\x0b\x25\xe5\xb8\xc9\x2d\x42\x5b\x4f\x69\x96\xb3\x7f\x6a\x04\x1f\x02\xa2\x9b\xe5\x46\x0b\x6d\xce\x0f\x66\x0b\x7d\x5f\x6d\xa1\x94\x38\xf0\xe4\x5a\x76\x9b\x70\x6f\x1f\x77\xfb\x91\x7d\x83\xcb\x75\xae\xa3\x80\x88\x8c\x2c\x77\xbb\x0d\xe9\x76\x1b\xb3\xc5\xbe\xb3\xc8\xae\x6d\x34\x1a\x2e\xb7\x6c\xa5\xe5\x2a\x3f\x71\xd1\xdd\x10\x63\x7d\x5c\xca\x7f\x83\xe6\x7f\x51\xff\x7b\xc3\xfd\xb2\xf9\xe1\x97\x51\xdb\x63\x4f\x76\xca\x67\xd6\x3c\xd6\x5c\x9d\x56\x56\xb7\xf9\x3a\x06\xaa\xa5\x9e\x9e\xe8\x85\xb6\xcb\x48\xdb\xf1\x0c\x26\x54\x82\xc8\xbf\x00\xfc\x52\x78\x89\x85\xf7\x4f\xa8\xfe\xf4\x00\xdf\xf6\x6c\x9f\x81\x86\x06\x8a\x8f\xc1\x3c\x9f\x04\xf6\x77\xd4\x6b\x47\xbe\xe2\x07\xa6\x11\xb0\x70\x7e\x0e\xbd\x12\xd0\x7f\x94\xf0\xf1\x57\xee\x72\x06\xcd\x8d\xfb\x65\xe3\x84\x81\x0b\x32\x30\x71\xfb\x0e\xdc\xc0\xee\xc0\xe4\x77\x3c\xe0\x6e\x9e\x63\x30\x68\xa5\xf0\xe6\x26\x5e\x0e\x50\x27\x08\xa3\xbd\xbe\x03\x8d\xef\x0d\x18\x18\x07\xe8\x1f\xe1\x9e\xe0\xb6\x6f\x53\x34\x74\xb8\x6f\xc5\x18\x30\x8f\x3b\x78\xa9\x3b\xe8\xf1\x01\xe6\xde\x71\x7a\xc0\xa8\xef\x92\x01\x72\x53\x23\xe8\x71\x8f\x0a\xf4\xc2\xb6\x48\xd0\x85\xae\xfb\x14\x5d\x29\x7d\x9f\x26\x1d\x8a\x9d\xe6\xde\xa0\x85\xa6\x16\x9f\xfd\x80\x2d\xc7\x36\x6d\x46\x44\x37\x00\x10\x6a\x07\xe8\x70\xee\xda\x41\xe4\x8b\x8e\xd5\x62\xdb\x45\x1a\x12\xde\x77\xc3\x01\xa5\x55\xa0\x8f\x1e\xf4\xb9\xeb\x0e\x5d\x3d\xae\xc7\x9d\x57\x11\x0a\x8d\x20\xa0\x3e\x28\x89\xa0\xe1\x3c\xe0\xba\x2c\xa8\x1b\xbe\x6d\x32\xf2\xe0\x0e\x20\x08\xc4\xa2\x43\x0f\xc8\x83\xa5\xe8\x61\xb5\x67\x74\x6c\xd7\xf0\x0f\x89\xc1\x3c\x18\x37\x44\xa4\x78\x46\x1a\x10\xcf\x81\xfc\x32\x48\xa5\x20\x52\xa2\x94\xd4\x1b\x10\x57\x94\x20\x3d\xe3\x58\x46\xdf\xe5\x17\x27\x9d\x82\x6f\x2e\xdd\x17\x3e\xfa\xc8\xe1\xe7\x5a\x20\x3b\x5c\x8f\xc9\x1a\x66\xc0\x62\x95\xd0\x44\x73\x63\xc0\x88\x49\x7d\x9f\x98\xa0\xfb\x05\xcc\xb2\x5d\xf8\x8b\x9e\x3a\x66\x41\x02\xcf\x1d\x30\x9f\x1a\x3d\x62\xa7\xde\xbc\xd4\x1b\x10\xcb\x83\x3e\x03\xd4\x3f\x1c\x50\xae\x90\x72\xdf\x56\xc0\xc0\x54\xda\xa3\x26\x4c\x80\x9e\xd1\x47\x75\xbe\x65\x33\xf8\xe9\x0d\x1c\x66\xc7\x0f\x90\x38\x70\xd1\x4a\xa7\xd6\x03\xf8\x9c\xbc\xa5\xd3\xe2\x72\x99\x4f\x90\x8b\xbb\x15\x83\xae\x01\x5f\xa1\x45\xdc\x25\x6a\xb4\x02\x62\x98\x5e\x40\x8c\xc0\x76\x89\xc1\x0c\xf7\x26\xfe\x25\xa6\xe1\x38\x9e\x49\x4c\x6a\xc3\xd8\x07\x40\x65\x01\x37\xa0\xe8\x41\x9f\xb4\xa1\x5c\xdb\xf1\xc0\x7e\x02\x53\xa7\xdd\xf7\x6d\x97\xb5\x49\xbb\x3f\x60\x01\x69\xfb\x14\x94\x03\xcc\x18\x98\x86\xdb\x26\x76\x60\x38\x30\x5b\xe0\xb7\xdf\x35\x88\x1d\x98\x2e\xf3\x1d\x62\x07\xe8\x06\x24\x76\xc0\xd5\x5d\x3b\x70\xbc\x7d\xea\x13\x3b\x40\x80\xf0\x0b\x52\x8f\xd8\x01\x9f\xd3\x76\x30\xe8\xf7\x31\xfd\x80\x17\x64\x1e\x2f\xc0\x3c\x9e\xe0\x40\xc3\x1c\x0b\xaa\x76\xbc\xce\xfc\x1c\xaa\xef\x3d\xde\x17\x30\x01\xe0\xb7\x47\x7b\x66\xd7\xc7\x9f\x5e\x1f\x7f\xfa\x87\xf0\x83\x18\xf7\xac\x36\xe9\x7b\xfb\x44\x74\xa8\x3f\x60\x48\xb0\xd8\x2f\xde\x97\xc0\x76\xd1\xe0\x22\x81\x2b\x32\x05\xd1\xef\x43\x9f\x91\x40\xe4\x62\xbe\x69\x30\xfc\xe9\x22\xc1\x40\x5d\xf0\xd3\x3f\xc4\x9f\xa0\x0f\x44\xe5\x3b\x14\x7f\x5c\x91\xd7\x15\xb9\x5c\x91\xad\xdf\xf2\x77\xe1\x37\x02\x22\x4a\x05\xcc\x27\xcc\x70\xbb\xf0\x87\xec\x45\xc8\xdf\x8b\x7e\xa3\xf6\x50\xd7\x72\x88\xed\xda\xcc\x46\x6d\xd5\x7f\x80\xf4\x37\x70\xed\x87\x03\x0a\x34\x30\x7e\x5d\x0a\x08\x64\xe3\xfe\xda\x9a\x72\xac\x05\x3a\x37\x1d\xe4\xb5\x14\xcd\x2f\x70\x1f\x98\x8a\xa6\x98\xf0\xa7\x0b\x0f\xe5\x32\x3c\xf1\xbf\xfd\x3e\x7a\x14\x3c\x90\x33\x5f\xcc\x82\x82\x11\x44\x7a\x50\xdd\xd6\x22\xb5\x00\xa7\x08\x9a\x18\x21\x4e\x91\x10\xa7\x48\xc8\xa7\x48\xd8\x33\xfa\xa0\xf0\x87\x7c\x8a\x84\x11\xa9\x87\x11\x81\x87\xa9\x49\x11\xa6\x26\x4c\x98\x9f\x22\xd9\x4f\x50\x06\xa7\x08\xba\x44\xbf\x10\x5e\x34\x6c\x74\xda\x1f\x74\xd4\xaa\x72\x91\x58\xad\x62\xf2\xb1\x76\xc4\x55\x9b\x59\x1d\xa5\xe9\xed\x59\xcc\x33\xdb\x50\xf9\x3a\x71\x69\x56\x28\x86\x39\x66\x04\x92\x43\x11\x45\x9a\xa2\xa6\x04\x2f\x69\x18\xb9\x64\xde\xa2\x66\x89\xfb\xb7\x4b\x92\xe3\x5a\x5a\x59\xab\x2a\xaa\x52\xe6\xad\xad\x37\x1a\x1f\xce\x34\x1a\x41\xb3\x5c\x2a\x2b\x65\x37\x89\x48\xa8\x1f\xdd\xd6\x9b\xb3\xc2\x43\xca\xc7\x68\xb6\xfe\xf7\xc6\x7e\x23\x68\x7c\x38\xd3\x8c\x5c\xe9\x6e\xe2\xe8\xc5\xf5\x40\xa9\xbe\xc4\x71\x95\x6d\xaf\x88\x74\x48\xd3\x8e\xaf\x19\x88\xc7\xf4\x57\x7b\xec\x92\x65\xc0\xfc\x81\xc9\x14\xd1\xd4\x6a\xdc\xa2\xd9\x2f\xb0\xae\x2f\x67\x93\xf1\x69\x46\x8b\x95\xcd\x92\xc6\x55\x8d\xa0\x7a\xd4\xf7\x29\xba\x9b\x83\xc0\xf3\xab\xb6\x26\xb8\x73\xd5\xc7\xb1\x1b\xeb\xca\xf3\xad\x81\xed\x7a\xc5\x6e\xbc\x0e\x65\x69\xcd\xbc\x54\x11\xb5\x45\x73\x42\x76\x9e\x45\xce\x8a\xd6\x21\xa3\xdc\x30\x17\xa2\xa6\xc6\x7f\x38\x5f\x56\xca\xac\xb2\x5b\x11\x85\x64\xd1\x44\xd9\xa0\xcf\x57\x4e\xb8\x8e\xc5\xd5\x2a\xd0\x7e\x40\x99\x14\x2a\x09\xae\x4d\x09\x5d\xc0\x40\x7d\x33\x48\xd6\xe2\xc5\xca\x7d\xa4\x23\x08\xe2\xfb\x0b\x3d\x44\xdb\x7e\xc9\x73\x99\xef\x39\x0e\xf5\xc9\xba\x37\x08\xa8\xf4\x5e\xf3\xda\x6c\xdf\xf0\x69\x8d\xfa\xb6\xe1\x90\x15\xd6\xa5\xbe\x4b\x59\x0d\xdd\xb3\xf1\xeb\x92\x63\x83\x41\xb9\x66\x3f\x1c\xd8\xd6\x92\x7f\x18\x30\xc3\x21\xdb\x5e\xcb\x63\x02\x14\xb9\x57\x5b\xff\xda\xb3\x4d\xba\x64\x38\x09\x94\xfb\xcb\x5b\x64\x25\xe8\x3b\x9e\x6f\xec\xdc\xdd\x21\x5f\x31\xd6\x17\x90\xb0\xec\xba\x07\x62\xf1\x1b\xfb\xae\x2d\xbe\xde\xab\xad\xd7\x4c\xc3\x75\xa9\x4f\xee\xda\x0e\xad\x71\xf3\xbe\x66\x76\x29\x68\x96\x3e\xa6\xf3\x86\xfd\x6d\xe0\x8a\x32\x7f\x1b\xb8\xe2\xdb\xea\xd6\xa2\x65\xf9\x34\x08\x20\x5f\x02\x71\xdd\xb3\x68\x2f\x46\x45\xdc\x36\xb2\xe4\xb9\x81\xe7\x50\xc8\x72\x07\x54\x06\xd1\x50\x52\x63\x14\x85\xcc\x16\x27\x28\x6c\x1f\x74\xe4\x5e\x6d\xfd\x41\x6d\xbd\x46\xd6\x0d\xdb\x69\x79\x07\xe4\x7e\xed\xce\x57\x5e\xc0\xc8\x5d\xdb\xef\x19\xcc\x20\x5b\xa0\x2a\x51\x22\x2a\x16\x6d\xba\x57\x5b\xdf\x5a\xdd\xc0\xde\xac\x6e\x92\x3b\xbe\x6d\x75\x28\x89\x70\xbd\xb2\xb5\xbd\xb9\x0e\x24\x02\x4a\x04\x0e\x0c\x59\x1c\x58\xb6\x87\x85\x3d\x2c\x44\x76\x8c\x60\x97\xdc\xdb\xda\xae\x61\x3b\xc8\x37\xb6\x4f\x09\xe0\xf2\x5e\x6d\x9d\xd4\xb6\x56\x49\x6d\x99\xf8\x03\xb7\xd6\xa5\x8e\xb3\xe4\xf5\x7a\x86\x6b\x2d\x46\x0b\x8d\xde\x20\x70\x0e\x89\xe1\x1a\x8e\xd7\xf9\xc6\xb7\x19\xdd\xa6\x81\xe7\x0c\xd0\xbb\xe6\x53\xe6\xdb\x74\x0f\x87\xcb\x76\x3b\x1b\xdc\x7d\x86\x12\x05\xfa\x03\x14\xf1\xb5\xf0\x37\x71\x00\xdb\xd4\xb0\xa4\xf2\x01\x75\xad\x65\x90\xcd\x86\xb3\xe5\xf9\x6c\xcb\xb0\x41\xe1\x5a\x43\x37\xff\xa6\xbb\x06\xba\x65\xd7\x0b\xb8\x33\xed\x7f\x79\x87\x01\xb3\xcd\xdd\x3b\x03\xc6\xb0\x68\xae\x0a\x39\x57\x8d\x93\x78\x60\x02\x81\x2e\x73\xff\xc8\xb6\xdd\xe9\xa2\xc7\x36\x26\xb1\x1a\x33\xd8\x20\x48\xe7\x5a\xa3\x6d\xc6\x1d\x74\xeb\xb6\xe9\x7b\xdc\x81\x1a\x70\x3f\x5f\xea\x0b\xfa\x0f\x76\x7c\xc3\x0d\xd0\x2b\xc2\x63\x51\x6a\x76\xc7\x35\x1c\x18\x0c\xf4\xd3\xf9\x03\x37\x83\xc8\x0e\x65\x99\x2f\x4e\xae\xbb\x1d\xca\x96\xb8\xf3\x65\xc9\xf0\x7d\x9b\x7b\x24\xad\x45\xd3\xa4\x0e\xf5\xbd\x1e\x05\x8d\xbf\x47\x83\xc0\xe8\xd0\xc5\x3d\xc3\x76\xd0\x08\xc9\xe0\x32\x40\x1b\xf1\xae\xe7\x38\xde\xfe\x92\xe7\xb6\xed\x0e\x77\x58\xfa\x87\x1b\x46\x8f\xe2\x40\x66\x87\x1c\x6b\xe1\xac\xe6\x3e\xfa\x70\x7d\xba\x6f\x03\x50\x1f\xc5\xea\x21\xa6\xef\xd0\x1e\x9a\x62\x03\x1f\x7d\x6d\x4b\x8e\x67\xee\x2e\xdb\x7b\xb6\x25\x5a\xb9\x06\x48\xae\x51\x37\xf0\x7c\xd0\x29\x52\xf8\x89\x68\x20\x72\x5a\x5b\x94\x19\x66\x77\x15\xac\x1c\x7f\xd0\x67\x72\x03\x81\x50\x88\xc1\xd2\xe9\xd4\x35\xfd\x43\x5c\x12\xda\x01\xcb\x04\xaa\xbb\x73\xc8\x68\x20\x5a\x0b\xcc\x40\xea\x1c\x35\x2c\x1c\xaf\x7e\xd7\x73\x69\x92\x8a\x90\x4d\x9b\x49\x78\x18\x04\xd4\x4f\x17\x8c\xc8\xe8\x6f\xa9\xb7\x6f\x49\x0f\xa6\xd7\x36\x75\x28\xae\xdb\x7b\x7d\xea\x6e\xd0\x03\x86\x53\x0c\x54\xb9\x0d\xca\xf6\x3d\x7f\x37\x20\xae\x17\xb7\x3a\xe0\xab\xd7\x86\xc3\xa1\x23\xd5\xd4\xfa\xd4\xd8\x85\x41\x04\x70\x5b\xc0\x6c\xa8\x45\xec\x60\x11\xe5\xc7\x32\x34\x17\x53\x96\x7d\xbe\x84\x2f\xfc\x7b\x6b\x5e\xc7\x03\xd0\x8b\x03\xe6\x71\x92\x25\x86\x65\x6d\x81\x4c\x45\x92\xe0\xee\x41\x31\x09\x3b\x94\xad\x7b\x96\xdd\xb6\xa9\x1f\x90\x5d\xc1\xb6\xb0\xef\x51\x6f\xf1\x65\xdf\xb0\x91\xf9\x22\xbf\x17\xc2\x6f\xd5\xed\x0f\x18\xe9\x1b\x3e\xb0\x78\x4e\x19\x38\xa5\xe5\x79\x16\xf7\x94\xcf\x14\x4e\x8c\xa4\xe5\xd8\xee\x6e\x94\x2d\xc2\x31\xd6\x83\xa3\x21\x72\x05\x94\x2d\x1b\xcc\x00\x8e\xca\x6b\xd9\x32\xcc\x5d\x0a\x8a\x3b\x9f\xf9\xb8\x7c\x41\xd9\x1d\x9b\x6d\xe2\x72\x23\xa2\x2c\xce\x93\x90\x63\x0f\x18\x7f\xc0\xd1\x6a\xf9\xc6\x3e\x58\x9b\xa0\x06\x08\x7c\xf3\x81\x76\xa8\xe1\xd7\x4c\x9f\xf2\x75\x43\xdb\x70\x56\xf6\x80\xb1\xfa\x40\xa6\x3b\x1e\x4e\xf6\x80\xb2\x1d\x7a\xc0\x6a\xf6\x77\x94\x38\xb4\xcd\x76\x3c\xce\x29\x30\xb4\x20\x60\x77\x7d\xaf\x07\x18\x8c\x87\xdd\xe4\xf5\x70\x12\x4d\xf8\x22\x8f\x7c\xe0\x43\x0e\x5c\x56\xf0\x6e\xcb\x0e\x4c\xcf\x75\xa9\xc9\x08\x0c\xe2\x3a\x75\x30\xbe\x02\xfa\x7d\x17\x1d\x22\x46\x32\x9c\x1d\xca\xb6\x56\x37\xee\x43\x25\x41\xea\x71\x47\x2c\xba\xc0\x24\x5f\xc4\x2a\xf9\x64\x75\x70\xde\x25\xbc\x95\xe3\x8a\x37\xc7\x44\x67\xe8\x12\x58\x24\x1c\x53\x35\xe6\xf5\xa1\x23\x11\xc1\x31\xda\xeb\x7b\x09\xcd\x47\x8c\x75\xd0\x72\x29\x5b\x07\x81\x61\xd1\xd6\xa0\xb3\x85\xc6\x55\xcf\x30\x23\xb1\x88\x23\x7e\x0f\x31\xca\x7d\xec\x35\x4a\xa3\xb9\x8a\x22\x26\x61\x23\xd8\x5e\xf1\xc8\xa9\x73\x8b\x7b\x36\x10\x93\x8b\x8e\xc3\xe9\x7c\xdd\xdb\xa3\x16\xae\x07\x76\xf8\x8a\x49\x87\xb2\x6f\x97\xba\x18\x29\xd2\xa1\xec\x6f\xe2\xd1\x70\x83\x7d\xea\xa3\x6a\xd0\xa1\x6c\x9b\x2f\x06\xed\xc5\xea\x02\x75\x2d\x41\x24\xe8\xc5\xf0\x0d\x9b\x73\x64\x0f\xbf\x61\xab\xff\x57\x6d\x73\x03\xbe\x89\xae\x1a\x31\xf3\x14\x23\x44\x2d\xd2\xb6\x5d\x2b\xe2\x7e\x82\xbd\xa0\x29\xfb\xb5\xe1\x0c\x38\x9e\x78\xd7\x11\xde\x1d\x87\x5b\xe7\x3e\x5b\x03\xb5\x2b\x51\x1d\xec\x20\x42\x32\x62\xe0\x30\xa0\x07\xa4\x6f\x60\x57\x2d\x4a\x3a\x06\xa3\xfb\xc6\xe1\xea\x16\x72\xd0\x81\x0f\xbc\xb2\x43\xd9\x26\xed\xfd\x85\x1e\x12\x36\x70\x05\x27\x72\x3d\x21\x93\x70\x4d\x9c\x2b\x06\x5c\x77\x03\x6d\xc0\x73\xb7\x39\x81\xe2\x93\x49\xed\x3d\x74\xa7\xb9\x1d\x0a\xa9\x50\x0c\xf9\x92\xeb\xdd\x19\xb4\xdb\x20\x96\x81\xe4\x56\x5d\x86\x0b\x8a\x6d\xc7\xdb\x27\x66\x97\x9a\xbb\x90\x79\xd7\xf5\x5a\x09\xf9\x80\x62\xd0\xb2\xd9\x12\x4c\x1d\x32\xe8\x5b\x06\xa3\xab\xdb\xf0\x85\xb7\xaa\xef\x05\x36\x4a\x6f\x44\xc1\xf6\xbd\x3b\xa4\x6b\x77\xba\x77\x50\x67\xe5\xfa\x01\x92\x6c\xad\xcf\x97\xf0\x0c\x0b\xb1\xe4\x7a\x35\xe6\x7b\xbb\x54\xd0\xc1\xea\x16\x5f\x37\x84\x86\x05\xdd\x01\xb3\xbc\x7d\x97\x40\xe3\x71\x28\x39\x93\x5c\xaf\xc1\x98\xf2\x4a\x39\x79\x51\x8b\xf4\x0c\x9b\xaf\xf4\xba\x9e\xc0\x1c\xf6\x62\x9b\x76\x44\x77\xee\xff\x85\x04\x5d\xbb\xcd\x36\x07\xc0\x2d\xbe\x36\x1c\x74\xa8\xd9\x6d\xb6\xea\x92\xfe\xc0\x01\x14\x90\x78\x42\x02\x6d\x3b\x2e\x5f\x91\x5d\xdd\x22\x7d\xdb\xe5\x03\x44\xd9\xea\xfa\xca\x6a\xc4\x78\x01\x87\xc0\xd9\x22\x06\x89\x24\x64\xf0\x95\x3d\xb1\x68\x0d\xed\x5d\x46\x2e\x74\x67\x7d\x8b\x38\xde\x3e\xe2\x23\x88\xf2\x71\x82\x07\x1c\x22\x9a\xc1\xd0\x30\x7c\x40\xa5\xed\xb2\x1d\x0f\xb1\x24\xd0\x86\xf5\xbb\xde\x5d\xdb\x71\x10\x55\x7b\x34\x0e\x7b\xe0\xf8\xe3\x42\x53\x20\x84\xb8\xde\x0e\x88\x0b\x7a\x60\x07\x2c\x20\x2d\x3e\xd2\x5d\x5c\xe5\x86\xda\x6a\x30\x1b\x6c\xdf\xe4\x24\x8e\x4a\x00\x47\x9a\x58\x1d\x5b\xdd\xc6\x75\x4a\xe0\xc8\x1b\x88\x6d\x40\x7a\x87\x32\xa0\xc3\x1e\x2a\x3b\xa4\x67\x3b\x8e\x2d\xb4\x1d\x8e\x30\xb1\x7c\x09\x25\x0f\x49\xdb\x19\x04\x5d\xb2\x6f\x5b\x18\xf3\x03\xc4\x84\x42\x80\x33\x5e\xe8\x68\x10\x90\xde\xae\x65\xfb\xc4\xef\xc1\x5f\x5c\xec\xe3\x1d\x27\x87\x36\x75\x2c\x62\x23\x5d\xdf\xa9\xd5\x56\x97\x89\xe9\xd8\xe6\xae\x58\x53\xc5\x96\xe1\xca\x14\x62\xa1\x4f\xe9\x2e\x5f\x78\x05\xfe\xcf\x3d\xe0\xb8\x66\x18\x40\x42\x1b\xf0\x15\x00\x07\xe7\xa1\xb8\xc0\xea\xba\x5e\x8f\xe2\x7c\x26\x01\xa3\x7d\xc2\x00\x51\xe8\x76\xd9\xae\xd5\x56\x09\xd6\x47\x5d\x0b\xd0\x84\xbe\x11\xd3\x0b\xd0\x55\xd3\xf7\xf6\xd1\xc9\x66\xb4\x02\xd2\x33\x0e\x48\x8f\xf3\x11\x5c\xc6\xed\xa7\x96\xae\x71\xa3\xc3\xe2\xda\x83\xf5\x95\x5a\x6d\xf1\xde\x0a\xb9\xbb\xba\xbd\xbe\xb8\xb3\xf8\xa0\xb6\xb3\xbd\xba\x71\x8f\x2c\x6e\x2c\xae\x6d\xde\x8b\x53\xb7\x57\xb6\x36\xb7\x77\x1e\x88\x42\xd1\x2b\xcf\x44\x56\x37\xb6\xee\xef\x3c\xd8\xba\xbf\xb6\x76\x7f\x8b\xd4\x56\x76\x1e\x6c\xad\x6e\x3c\x58\xdf\x5c\x5e\x21\xab\x1b\x3b\x2b\xdb\x1b\x8b\x6b\x37\xbf\xfe\xf8\x13\x52\xfb\x5b\x6d\x67\x65\xfd\xc1\xf6\x4a\x6d\x65\x87\xac\xad\x2c\x3f\xb8\x73\x7f\x75\x6d\x67\x75\x23\xce\x35\xff\xf5\x3c\x64\x5a\xf9\xf6\x41\x6d\x67\x71\x7b\x27\xfe\x4e\x56\xbe\x15\x0f\xcb\x2b\x77\x17\xef\xaf\xed\x90\xcd\xfb\x3b\x5b\xf7\x77\x78\xc5\xe4\xab\xd5\x7b\x5f\x91\xb5\xcd\x6f\xc4\xf2\x6f\x45\xb6\x84\x73\xc1\xc5\xa9\xb0\xd9\x49\x9b\x06\xfc\x9e\x11\xf4\x8a\x77\x0c\x60\x70\x92\x14\xb9\xe4\xf7\x94\xa6\xe6\x54\x95\x46\xa3\xb2\xc0\xfd\x10\xda\x6e\xf5\xa8\x47\x99\x51\x55\x2a\x37\xd1\x20\xae\x7c\xc4\x7f\xd0\x57\x4d\x2a\x78\xef\x24\xff\xf9\x8e\x54\x5a\xe2\x2b\xcf\x62\xc2\x24\xaa\x58\x60\x4f\x55\xd0\xc0\xad\xc0\x40\x57\xf8\xb2\x0a\xfc\xf4\xf0\xaf\x4f\x2a\xf4\xe1\x80\x54\xa8\x0f\x4f\x07\x36\x83\xcf\xdc\x31\x5f\x11\xe1\x1c\x95\x2e\xda\xe1\x15\x28\xc7\x17\x5a\x2a\x62\xa5\xa5\x12\x45\x97\x57\x6c\xbf\x4f\x2a\xe8\x44\xae\xf4\x0c\xd3\xf7\x48\xc5\xa7\x7d\x06\x7f\x1f\x92\x8a\x08\xb9\x82\x07\x46\x2a\xc1\xae\xdd\x27\x15\xee\x9f\xac\x20\x6d\x57\x38\x7c\xc3\xef\x91\x0a\xeb\x0e\x7a\x2d\xde\xfa\xf9\x4f\xf8\xef\xad\x9b\xa4\xd2\xf6\x7c\x93\x3e\x10\x89\xf8\xf3\x00\x50\x4a\x2a\x0e\xf3\xfc\x0e\x59\x5c\x5b\x5d\xac\xc1\xdf\x7b\x1b\x64\x71\x7b\x9d\x2c\x6e\xaf\x2c\x92\xc5\x5a\x6d\x65\x7b\x87\x2c\xee\xec\x6c\x93\xa5\x0d\xb2\x04\x94\x04\x7f\xe6\x3f\xc1\x9f\x5b\x37\xc9\xd2\xe6\xfa\xfa\xe6\x06\x59\xda\x22\xcb\x8b\x3b\x8b\x64\x79\xe9\x0e\x59\x5e\x5a\x86\xff\xf7\xe1\xcf\x26\x59\x5e\xba\xbb\x8c\x7f\xe0\x7d\x95\x2c\x2f\xfd\x15\xfe\xc3\xcb\x37\xf0\xff\x3e\x59\xde\x20\x2b\x6b\xab\x77\xc9\xca\x5a\x6d\x85\xac\x6c\x2c\xc3\xff\xbb\xf7\x37\x96\xe0\x17\x3e\x6f\x80\xdd\xbe\xb1\xb3\xfd\x37\xb2\xf2\xd7\xfb\x64\xe5\x5b\xa0\x77\xf1\xb3\x58\x13\x04\x49\xee\xae\xae\xac\x2d\x93\xbb\xab\x6b\x6b\x04\xca\xee\xac\x6e\x6e\x90\x7b\x77\xd6\x16\xe1\xcf\x1a\xfc\xa9\x91\x7b\x2b\x3b\xe4\xde\xda\xe6\x9d\xc5\x35\xb2\x7a\x97\xac\xae\x23\x9c\xd5\x8d\xa5\x3b\x48\xf7\x4b\x6b\xf7\x71\x96\xdc\xdd\x24\x7f\x59\x59\xd9\x22\x6b\x4b\x6b\x8b\xf0\x67\x0d\xfe\xd4\xc8\xda\xce\xe6\xf6\x3d\xb2\xbe\xb8\xb4\xbd\x49\xd6\x17\xb7\xc8\x3a\x34\x74\x7d\xe5\xdb\xd5\x1d\xb2\xb1\x79\x77\x8b\x6c\x6e\xed\x90\x2d\x98\x4f\xdb\x5f\xaf\x7c\x46\xb6\xb6\x37\x97\xc8\x5f\x37\xc8\xf6\xca\xe2\xf2\xe6\xc6\xda\xdf\xc8\xf6\xca\xda\xe6\x12\xd9\x5e\xf9\xeb\xfd\xd5\xed\x95\xe8\xf7\x33\xb2\xbd\xb6\x5a\xdb\x21\x77\x37\xc8\xf6\xe6\xfd\x1d\x98\xaf\x8b\xf0\x67\x0d\xfe\xd4\x48\x6d\x83\xd4\xb6\x16\x97\x56\x48\xed\xfe\x9d\x1d\xb2\xf3\xd5\xfd\xf5\x3b\xfc\xef\xb7\x64\x67\x67\x8d\x7c\xf3\xd5\xea\xda\x0a\xf9\x06\xda\x21\xaf\x2c\xf8\x73\xc4\x9f\x27\xfe\x4d\xe2\xdf\x22\xfe\x47\xc4\xff\x98\xf8\x9f\x10\xff\x53\xe2\x7f\x46\xfc\xcf\x89\x3f\x0f\xc9\xf3\xc4\x9f\xbf\x49\xfc\xf9\x5b\xc4\x9f\xff\x88\xf8\xf3\x1f\x93\xbe\x49\x1c\x9f\x04\x7d\x62\xf7\x49\xe0\x90\xa0\x45\xda\x7d\x62\xcc\x13\xe3\x26\x31\x6e\x11\xe3\x23\xb2\x37\x4f\xf6\x6e\x92\xbd\x5b\x64\xef\x23\xb2\xf7\x31\xd9\xfb\x84\xec\x7d\x4a\xf6\x3e\x23\xed\x39\xd2\x9e\x27\xed\x9b\xa4\x7d\x8b\xb4\x3f\x22\xed\x8f\x49\xfb\x13\xd2\xfe\x94\xf4\xe7\x48\x7f\x9e\xf4\x6f\x92\xfe\x2d\xd2\xff\x88\xf4\x3f\x26\xfd\x4f\x48\xff\x53\xd2\xff\x8c\xf4\x3f\x27\xfd\x79\x48\x9e\x27\xfd\xf9\x9b\xa4\x3f\x7f\x8b\xf4\xe7\x3f\x22\xfd\xf9\x8f\x89\x39\x47\xcc\x79\x62\xde\x24\xe6\x2d\x62\x7e\x44\xcc\x8f\x89\xf9\x09\x31\x3f\x25\xe6\x67\xc4\xfc\x9c\x98\xf3\x90\x3c\x4f\xcc\xf9\x9b\xc4\x9c\xbf\x45\xcc\xf9\x8f\x88\x39\xff\x31\x79\x38\x47\x1e\xce\x93\x87\x37\xc9\xc3\x5b\xe4\xe1\x47\xe4\xe1\xc7\xe4\xe1\x27\xe4\xe1\xa7\xe4\xe1\x67\xe4\xe1\xe7\xe4\xe1\x3c\x24\xcf\x93\x87\xf3\x37\xc9\xc3\xf9\x5b\xe4\xe1\xfc\x47\xe4\x21\xd4\xd5\x0f\xfc\x07\x26\xff\x39\xe0\x3f\x01\xff\x69\x8b\xb4\x83\xe8\x57\x7c\x4f\x7e\x45\x8e\x20\xce\x19\xb4\x49\xc0\xe1\x05\x1c\x5e\xc0\xe1\x05\x1c\x5e\x20\xe0\x05\x11\xbc\x40\xc0\x0b\x22\x78\x81\x80\x17\x24\xf0\xe6\x48\x30\x4f\x82\x9b\x24\xb8\x45\x82\x8f\x48\xf0\x31\x09\x3e\x21\xc1\xa7\x24\xf8\x8c\x04\x9f\x93\x60\x1e\x92\xe7\x49\x30\x7f\x93\x04\xf3\xb7\x48\x30\xff\x11\x09\xe6\x3f\x26\xc1\xfc\x27\x24\x98\xff\x94\x04\xf3\x9f\x91\x60\xfe\x73\x12\xdc\x9c\x23\xc1\x4d\x80\x73\x93\x04\x37\x6f\x91\xe0\xe6\x47\x24\xb8\xf9\x31\x09\x6e\x7e\x42\x82\x9b\x9f\x92\xe0\xe6\x67\x24\xb8\xf9\x39\x09\x6e\xcd\x91\xe0\xd6\x3c\xb1\xe6\x88\x35\x4f\xac\x9b\xc4\xba\x45\xac\x8f\x88\xf5\x31\xb1\x3e\x21\xd6\xa7\xc4\xfa\x8c\x58\x9f\x13\x6b\x1e\x92\xe7\x89\x35\x7f\x93\x58\xf3\xb7\x88\x35\xff\x11\xb1\xe6\x3f\x26\xd6\xfc\x27\xc4\x9a\xff\x94\x58\xf3\x9f\x11\x6b\xfe\x73\x62\xdd\x9c\x23\xd6\x4d\x80\x73\x93\x58\x37\x6f\x11\xeb\xe6\x47\xc4\xba\xf9\x31\xb1\x6e\x7e\x42\xac\x9b\x9f\x12\xeb\xe6\x67\xc4\xba\xf9\x39\xb1\x6e\xcd\x11\xeb\xd6\x3c\x39\xda\x5a\x3a\x26\x47\x5f\x2f\x6e\x1f\x93\xa3\x9d\xed\xfb\x2b\xc7\xe4\xe8\xee\xe2\x5a\x0d\x7e\x37\xb7\x76\x8e\xc9\xd1\xd2\xe6\xc6\xdd\xd5\x7b\xc7\xe4\x08\x78\xc2\xe2\x06\x7e\x59\x5e\xa9\xad\xfe\x17\x64\x59\xda\xba\x0f\x05\xf0\xef\xe2\xf6\xd2\x57\xab\x3b\x2b\x4b\x3b\xf7\xb7\x21\x69\x6b\xa9\xb6\xb3\xb9\xbd\xb2\x79\xf7\x6e\x6d\x65\x07\x93\xd7\x17\x6b\xeb\x0f\xbe\x5e\xd9\xae\xad\x6e\x02\x18\x14\x6c\xc7\xe4\x68\x7b\x73\x6b\x15\x7e\xbe\xc1\x9f\xda\x37\x35\xc8\xbd\xb1\xc9\x1f\x2a\xe4\x7f\x4a\xc1\x9e\xb9\x18\x15\xcb\x0c\xd5\x87\xd6\x42\x18\x74\x17\xc2\x41\xfd\x61\xb7\xb9\x50\x5a\x30\x2c\x4b\xfd\x2c\x9c\xff\xa4\xb4\x10\x0e\x02\xc3\x32\x16\x3e\x0b\xd5\x87\xa9\x1c\xaa\x11\x84\x81\x51\x3a\x08\x0d\xd7\x0a\x0d\xcb\x77\x16\xc2\xa0\x65\x86\x7e\x50\x6f\x99\xcd\xd0\x08\xfc\xb0\x55\x77\x0e\x9a\x0b\x61\xcb\x39\x08\x5b\x07\xff\x08\xcd\x96\xbb\xf0\x5d\xc8\x5a\xf5\x56\xb7\x19\xb6\x6c\x33\x6c\xb5\xe1\xbf\x1d\xd6\x83\x41\xb3\xd5\x3e\x08\x5b\xbb\x7d\x16\x9a\x56\xff\xe6\x42\x68\x3a\xdf\x85\xa6\xe3\xd3\x83\xd0\xec\xf5\x43\xb3\xe7\x86\x66\x3f\xb0\xeb\xd4\x6a\xc2\x43\x88\xe1\xb6\x56\x68\xb5\x3a\xa1\xd5\x6b\x85\x56\xd0\x0a\xa9\xe7\x87\x76\xd0\x0a\x6d\x56\x67\xb4\x79\x34\xa7\xdd\x3a\x0e\x9d\xc0\x09\x9d\xc0\x0f\x7d\xcf\x0f\x7d\xff\x20\x74\xac\x9e\xaa\xd6\x6d\xab\x59\x37\x5a\xcd\x52\xd8\xae\x5b\x41\xb3\xb4\x10\x3a\x96\xaf\xaa\x41\x48\x0f\x4a\x0b\xf5\x56\xd7\x82\x4f\x3d\x6f\x8f\x2d\x84\xbd\x3d\x37\xec\xf9\x46\xd8\x33\xfc\xb0\x37\x70\xc2\xfa\x20\x68\xf6\x06\x8e\x13\x06\xbd\x81\x53\x6f\xed\xb3\x66\xbd\xc5\x9a\xf0\x56\x37\x82\xa6\x15\x06\x3d\xc8\x15\xf4\x7a\x8e\x11\xc2\xff\x41\xcf\x31\x0c\xf8\xe0\x18\xce\x82\x5a\xdf\x6f\x89\x02\x56\x29\xec\x39\x01\x7c\x0f\x9c\x05\x68\x44\x18\xf4\xcc\x30\xd8\x33\xc3\x80\xee\x85\x3d\xdb\x50\x21\xdb\xd1\xcd\xe3\xb0\xdf\x85\xc6\xf8\xfe\x82\x79\x73\x21\xec\x99\xbe\x0f\x3f\x7e\x10\xf6\x02\x3f\xf4\x7c\xf8\xef\x86\xfd\xdd\xae\xca\x5a\x61\x8b\x95\x42\xbf\x65\xb3\xd0\xa7\x7b\xea\xfc\x27\x61\x00\x45\x03\xea\x20\x7a\x03\x83\xa9\x38\x98\xae\xd7\x0f\xfb\xf0\x7f\x10\x74\x43\xbf\x4d\x13\x74\x2c\x84\x01\xeb\xa5\x5f\x7d\x35\xc6\xc9\x02\x92\x48\x69\x21\x18\xb4\x42\x15\xc8\xe0\x61\x4c\x08\xc1\xa0\xc5\x49\x05\x6b\x3a\x60\xaa\xb1\xd0\x0d\x8d\x85\x16\x56\x58\x0a\x03\x3f\x48\x41\xdd\xef\xb7\xe0\xaf\x1d\x06\x3d\x3b\x64\x01\x0b\x19\x7d\x18\xee\xb7\x69\xb8\xdf\xb6\x43\x54\x85\x4b\x2a\x7d\x18\xba\x34\x34\x83\xd0\x34\xc3\x9e\x1d\xf6\x9d\x70\x2f\x08\xf7\xcc\xb0\x6b\x87\x4e\x10\x76\x68\xe8\xb0\xb0\xc3\x42\x87\x86\x86\x13\x76\x83\xd0\xf1\x4a\x0b\xf5\xa0\xcf\xfc\x83\xe6\x42\x14\x7e\xce\x43\x33\x54\xa5\x7e\xfb\x7f\x36\x31\xe8\x57\x3b\xf2\xab\x73\xa8\xa3\x09\x05\x0e\x43\x8a\xd3\x9b\x1c\xc4\x76\xf7\xfa\xdf\x1b\x8d\x46\xa3\x79\x5d\xde\x66\x99\x84\xe9\x37\x1a\xff\x2f\x77\xff\xda\x9e\xb6\x92\xec\x8d\xc3\xaf\x9f\xfb\x53\x54\x14\x67\x02\x06\x8c\x9d\xb5\x66\x66\x6f\x12\x42\x30\xc8\x36\x7b\x61\xf0\x00\x4e\xb2\x6e\x44\x18\x21\x35\xa0\x58\x48\x8a\x24\x7c\x94\xf7\x67\x7f\xae\xaa\xea\x96\xc4\xc1\x59\xeb\x9e\x7d\x78\xf1\xbf\x12\xb7\xba\x5b\x4d\xab\xd5\xea\x43\x55\x57\xd5\xaf\x12\xf9\x90\x24\x6f\xfa\x78\xbc\x65\x1c\x21\xd5\x77\xc6\xaf\x0f\xea\x93\xc6\x31\x59\xcd\x9a\x95\xf9\xa4\x24\x95\x6f\x64\xfe\x6c\x7c\x7c\xb2\x99\xc7\x6a\xed\x4a\xc6\xc7\xa9\xc9\x1e\x33\x0b\x59\x3f\x59\x33\x4f\xc9\x8e\x94\xed\x19\x8f\x2b\xff\xae\x92\xaa\x1e\x52\x7f\xfb\x59\xb9\x9a\x6a\x40\xfd\xf5\x84\x6c\xac\xe4\x13\x5f\x24\x6a\xef\x57\xee\x7e\xe3\xcb\xd4\xac\xca\x30\x8e\xa6\xb5\xca\xa4\xa4\x95\xc3\xfa\x13\x1b\x96\x3b\x24\x82\xda\xb6\xe6\x25\x43\x5e\xf9\x7e\xb3\x5a\xb5\x6e\x44\x87\x9b\x85\xd4\xe7\xd9\x34\x46\x90\x22\x44\x65\xc0\xc2\x3f\x7e\x4b\xa9\xb7\x32\x35\xfe\x66\x44\xda\xdb\xfa\x87\x8f\xff\x9c\x94\xaa\xcf\x13\xfa\xb7\x2b\x84\x5d\xc6\xf8\x2e\xda\xbd\xbc\x86\x51\xa4\x95\x35\x33\xf6\x57\x98\xf9\x7d\x86\x61\x64\x53\x88\xb7\x03\x64\x43\xf3\x76\x05\x9b\x0a\xf4\x1f\x5e\xb5\xfb\xad\xd1\xef\x57\xba\x12\x87\x86\xb5\x93\xe3\xcc\x3a\x3f\x55\xad\xd7\xd8\x98\xae\x55\xd0\x3e\xbc\x22\xa5\xf4\x4a\xe5\x23\x8d\xd0\x13\x1c\xa2\x58\xf8\x83\x61\xbc\x32\x8c\x71\x0b\xc9\xd2\xdc\x0f\x0d\x63\xf2\x31\x35\xb4\x9d\xd5\xaa\x1f\x8c\x46\x21\x58\x06\xc5\x06\xcb\x11\x1b\x1f\xab\xe5\xa8\x5b\xd3\x82\x65\xa0\xa9\xc7\x56\x49\xc5\x9d\x7e\x7e\x58\xd5\xca\x48\x7c\xd7\x5e\x65\x26\x3e\xb1\xc9\x43\xff\x43\x14\x3f\xb8\xa2\xd0\xa8\x1b\x46\x94\xb0\x35\x9b\x92\xe8\x3e\x79\xe6\x4a\xe0\x87\x78\x70\xa5\x4e\x55\x38\xc9\xd9\x19\x68\x1f\xaa\x74\x0b\x1b\x46\x42\xd1\xa8\x5b\x1b\x6b\x16\x75\x24\x0e\x94\xc9\xf3\xce\xa3\x48\x77\xf4\xa7\xcf\x62\xb5\xfa\xfd\x0f\xa3\x7b\x9b\x4f\xdb\x34\x0b\xd6\xbe\x9b\xb7\x66\x9a\x60\x03\x8b\x99\x19\xee\x34\x88\xbf\x9b\x1c\x4a\x1f\x8c\xc6\xfd\xca\xcd\xfa\x71\xa3\x93\xc9\x10\x44\xde\xd9\xed\x3a\x69\x44\x52\x6d\x7c\xd4\x32\x70\x0c\x73\xc5\x36\x24\xe3\x6f\x46\xf5\xe3\x07\x23\x9a\x94\xa4\x06\x5a\x38\xf9\x89\x79\x33\xf2\x73\xb6\x6f\xed\xe5\x17\x73\x9c\x22\x16\x99\x48\x59\x71\x41\xfb\x56\x7d\xfa\xb5\xfc\x4c\x0b\x11\x2e\x47\x94\x3a\xc8\xc6\x93\x2c\x53\xdd\x58\x07\xb7\x56\xb5\x6f\xb4\x16\xdc\x1d\x1d\x1e\xa8\x45\x63\x5c\x37\x8c\xc3\x49\x5a\x31\x0d\x20\x2f\x97\x7b\xb0\x69\xf0\xad\x8c\x6a\x68\xc4\xab\xc5\xa9\x50\x67\x8b\x76\x38\x2a\x35\x0a\x60\x18\x27\xc5\x46\x56\xff\x37\xd2\xe1\x9c\x18\x86\x37\x29\x35\x0c\xc3\xc3\xaa\x2b\xff\x69\x18\xdf\x0c\xa3\x34\x79\x7a\x87\x4f\x78\xde\xb2\xa3\xad\x69\xdf\x6a\x47\xa5\x46\x2d\x5d\xe5\xa5\x18\x3e\xd7\x90\xac\xa4\x61\x8c\x8f\xb0\xe2\xc9\x41\x7e\x7d\x26\x2d\x54\x2e\x30\xdd\x7a\xb9\xe9\xee\x6b\x21\xf7\xc9\x85\xc7\x86\x51\x31\x8c\xa3\xed\x0e\xc9\x67\x1f\xe4\x66\x26\x3e\xbe\xb4\x55\x56\xe6\x1c\xa4\x53\x33\xd5\x9a\xc0\x39\x4b\x6b\x2a\xad\xba\xf9\x06\xcc\xd6\xae\x2b\x62\x6e\x42\xc1\x30\x0e\x4b\x89\x61\x54\x30\x38\x2a\x25\xd8\x83\xd8\x77\xb5\x1a\x9b\x85\xed\x1a\xe2\x7d\x2b\xf4\xfa\x23\x3d\x19\x75\xae\x12\xe6\x18\x9b\xbd\x51\xf2\xa5\x39\xe8\x75\x7a\xe7\x49\xab\x79\x8d\x8c\x66\x51\xda\xb4\x6e\x5a\xef\xfb\x72\x47\x34\x8c\x53\xc3\x38\x2c\x34\x5e\x8d\xc9\x46\x26\x9a\x14\x95\xf1\x98\x87\x14\x8a\x61\x1c\x16\xb5\x6c\x81\xa3\x32\x77\xda\x86\x0d\xa1\x58\x05\x4b\x33\x72\x94\xa5\xce\xe9\x5b\xac\xec\xed\x6e\x55\x6f\x37\x2b\x7a\x9b\x55\x94\xfb\x7a\x1b\x95\x4d\xb1\xa6\xe9\x6e\x4d\xd3\xe2\x3e\x80\x04\x39\x26\xff\xf9\xcf\xa3\x52\xe3\xed\x5b\x39\x0a\x29\x91\x0d\xb3\xf4\x73\x17\xf0\xc6\x3f\x13\xc3\x28\xd1\x10\x2a\x6d\xd4\x98\x1b\x14\x60\x18\xf1\x44\x81\xd5\xa8\x4d\x4c\xfb\xf6\xf6\xe9\x97\xf2\x33\xdf\x3c\xdc\x18\x15\x05\xd7\xf1\x6e\x6a\xc5\x46\x61\x19\xc7\x41\x82\x41\x94\xcc\xe3\x80\x95\xde\x9d\xd0\x4a\xe8\x1c\xb0\xd6\xc0\x8f\x32\x2c\xe1\x08\x3e\xc4\x11\x9c\x33\x1b\x4c\x6b\xc9\x8a\xe6\xdb\x86\x77\x64\x4f\xdf\xa5\xc4\xcc\x98\xf6\xe1\x3d\x90\x11\x1b\x7b\xd2\x86\xe9\x5f\x6e\x20\xfe\x64\xb1\x0a\x84\x15\x7f\xdf\x4b\x09\xb0\x66\xd4\x86\x9d\x2e\x29\x25\x2a\x53\x61\x56\x83\xdd\x50\x80\x55\x8a\x1f\x52\xdf\x94\x2c\xa0\x9d\x79\x4e\x7d\x95\x54\xae\x58\xab\x83\x0e\x9a\x58\x39\x88\x95\x2e\xe7\x41\x8a\x8c\x90\x29\xaa\x4a\x65\x5a\x09\x67\xc0\xf8\x09\x52\x9d\x63\x4b\x1f\x75\xd7\x90\x5f\xaa\x81\xd0\xf9\x3c\x69\x21\x78\xe2\x4e\xe9\xe8\x66\x48\x0a\x74\xd2\xc6\x38\x07\xa9\xbe\x2c\x69\x94\x48\x05\x51\xd6\xd0\x54\xf6\xaa\x5b\x8a\xab\x52\x5d\xf6\x45\xed\x54\x7a\xbf\x88\x3a\xc9\x71\xc5\x42\xd8\xc0\x3d\xde\x9f\x83\x69\xdf\x3a\x96\x10\xf7\xc2\x5a\x67\x76\xa7\xc2\x06\x6b\xee\xfa\x77\x6c\x36\x46\xd1\x4c\x99\xcd\x94\xe5\xc4\x56\x0e\xf7\xf6\x56\xe6\x9d\x13\x2f\x1d\x8f\x0e\x10\x63\x33\x5c\x88\x58\xe6\x40\xf6\xc4\x85\x88\xbf\x50\xde\xe8\x21\x10\x3d\x73\x25\x40\x19\x32\xe2\xbb\xfc\x87\xef\x78\x57\x74\xbc\xbd\x91\x1a\xd2\xc3\xae\xcc\x90\xf3\x75\xcf\x72\xfd\xc8\xf1\x16\xfb\x0a\x28\x5b\xe9\x80\x68\xbe\x68\x4b\xf7\x3b\xf2\xe7\x38\x7c\x84\x25\x6c\x92\xa2\x63\x75\x4d\xea\x9d\x8e\xfc\x3a\x84\x2d\xb0\x20\x93\xaf\x18\xcc\x70\x11\x91\x86\xa6\x96\xa9\x1c\xc5\x44\x91\x1a\xd5\xe4\x75\x35\xdd\x49\xab\xb4\x82\x49\x93\xc0\x2a\x6f\x96\xa9\x12\xf9\x5d\xe9\x53\x35\x37\x8d\x6c\xdf\x52\x14\xc0\x27\x05\x2d\x80\xa4\x33\xef\xb8\x2f\x1c\x16\x6f\xeb\x6c\xf1\x17\xd5\x76\x14\xcb\x9c\x5a\x75\x5c\x7b\xaf\x19\x63\x63\xa2\x34\xb8\xf6\xe2\x4d\x00\xd9\xc2\x05\x42\x7e\x1f\x4c\xe1\x50\xc2\x2b\x7f\xb8\x40\x84\xb9\x41\xa1\x52\xc0\x26\x2c\xae\x88\x7d\xd6\x62\xbf\x66\xe8\xa6\xaa\x51\x18\x7f\x33\x8a\x93\x43\xa2\x76\xc6\x45\x24\x5a\x6e\x6a\x71\x49\x03\xad\x14\x52\xd3\x4e\x9e\xff\x1c\x58\xc6\xe6\xeb\xb0\x1d\xea\x6e\x39\x7a\xcd\x3f\x7e\x4b\xd5\xc4\x89\x54\x0d\x24\xc9\x89\xb5\x8e\xa5\x75\xa5\xb4\x9f\x94\x86\x95\x34\x6d\x58\xd8\x2a\xed\x00\x35\xf9\x32\xdc\x9a\x13\x7a\xea\xc6\x43\x6b\xe2\xe8\x7a\x43\x6b\x3f\xa7\xbc\x47\x8f\x9d\x48\x96\xa2\x36\xa9\x6e\x68\x02\x4e\x98\x57\xe1\x6a\xe5\x90\xfa\x83\xaa\xb3\xee\x94\xf6\x08\x64\x2c\xbb\xa5\xf2\xa8\xbd\xa0\x9f\x88\x83\x10\x4a\xc6\x5d\xa9\x60\x1c\x15\x91\x2a\xc5\x5a\xe5\x27\x33\x8a\x46\x74\x58\x28\xf0\xaa\x51\x1c\x1b\x77\x46\x54\x9e\x94\x8a\x8d\xb1\xc1\xed\xdc\x52\x60\xc4\xd6\xe6\xd0\x4c\x5e\xe8\x82\x54\x25\xf1\x3a\x03\x35\xd9\xaf\xc0\xc8\x9f\x38\xde\x03\x2a\xd2\xcb\xd9\x47\x6c\xdb\x4b\xe4\x4c\xec\x52\xca\x6d\x73\x36\xbd\xb4\xf9\xac\x63\x7f\xe9\xc7\x37\xe2\x61\xaf\xa1\x0f\xee\xed\x63\xb2\x67\x1e\x4e\xb4\x2d\x9c\x8b\x1c\x1d\xbd\xbc\xd9\x32\xdd\x3d\xa5\x55\x3f\x55\x06\x69\x85\x4e\xec\x58\xa6\x0b\xfa\xbd\x13\x53\xd0\x0c\x02\x38\xf7\xa3\xf5\x0c\xce\xfd\xd8\x87\x9e\xb8\x83\xbe\x47\x77\xaf\xcc\x75\x94\xaa\x0b\x0e\x45\x7c\x8a\x9b\x45\xd7\xf1\x44\x84\xa9\x91\xb3\x12\x21\x0c\xd7\x51\x20\x3c\x1b\x46\x64\x38\x80\x17\xff\x0e\x58\xd2\x6e\x2e\x6f\xa6\x8e\x4d\x17\x9e\x26\x18\x0b\x64\x8e\xb8\x17\x74\x5d\x84\xfe\x3a\xc8\xdb\x8d\x26\xc7\x90\xd3\x44\xee\xf5\x47\xd0\xec\xb5\xa1\x3f\xc8\x1f\xaa\xb7\xfc\xd5\x30\x10\x16\xb4\x52\x93\xc5\x34\xd6\x74\x5d\xd0\x71\x41\xed\x8a\x5b\x91\xb7\x09\xdf\xb0\x77\x6c\x4e\x33\xa4\x24\x3a\x55\x88\xcb\x3b\xc6\xd9\x56\x6d\x1c\x4f\x14\xb3\xf1\x7e\xdf\x91\xcb\x96\x19\xbf\x38\xea\x0d\xf2\x44\xc9\x7a\x16\x31\x9d\xfb\xa6\xd0\xa8\x67\xcf\x7b\x3d\x3d\xf8\x24\xe9\xbb\x37\x6c\x28\xf6\x6d\xf3\x9e\xf6\xbc\xc7\x42\xf3\x1b\x0f\xe3\xbb\x12\x5e\xcb\xda\x1e\xde\x20\x3a\x7c\x7d\x57\xda\xa4\xdc\xf2\xc4\x33\xbd\x8e\x32\xfd\x22\x5e\xc5\xd3\xde\x4f\x4a\xb5\x5a\xa1\xf1\xaa\x5e\x7c\xcb\x96\x5e\xb9\x7c\xce\x4e\x09\xdf\x59\x4d\x2b\xcb\x47\xff\x74\x04\x3b\xfb\x41\xac\x4e\x1f\x06\x62\x0e\x2d\x24\x24\x5a\x44\xfe\xa8\x01\xa9\xb2\x28\x41\xea\x16\x6d\x49\x53\xb4\x9d\x15\xb4\x7d\xd0\x71\x18\x60\xd0\x99\x83\xee\xd9\x67\x6b\xcf\xc2\x2b\xa7\x86\x0c\xc7\x82\x31\x26\x35\x74\xcf\xc6\x4d\x1c\x74\x24\x84\xd2\x21\x4e\xf5\x9e\xf9\x21\xd0\xaf\xcf\x59\x06\xd8\x99\x43\xc7\x03\x52\xcf\x83\x9e\xb8\x8f\x61\x20\xf0\x91\x03\x35\xdc\xa9\x66\xde\xbe\x49\xed\x13\xe4\x23\x46\x4b\xe1\xc1\xc8\x97\x83\xfc\xb3\x22\x92\xbe\xe8\x9e\x0d\x5f\x88\x96\xfb\x42\x80\x4f\x61\x3d\x87\x57\x02\x4d\xcf\x86\x1e\x12\x80\x3d\x3f\x86\x7e\xa8\x95\xcd\xba\xd6\x9c\x45\xd0\x6c\xf9\x11\x34\x6d\xd7\x99\x0d\x94\xd5\x0f\xa5\xae\xbd\x2c\x1d\x59\xf8\xf7\x05\x9a\x43\xc7\x83\x66\x14\x39\x0b\x0f\x9a\x23\xd3\x83\xe6\x3a\xf6\x3b\xf1\x50\xc4\x7d\x46\xa1\xe1\xf4\x17\xc7\x3b\xc7\xe9\x19\xbb\x22\xcb\x19\xaa\x9c\x53\x21\x02\x38\x65\xab\x1d\xbe\x74\x85\x27\x63\x97\x8e\x2d\x63\x23\x5f\xea\xf7\x9c\x3a\x31\xce\xbf\x53\x27\xc6\xa9\x78\xea\xc4\xfd\x01\x86\x03\x3f\x46\x32\xf7\xd4\x89\x87\x4b\x67\x1e\x63\xe4\x2b\xde\x71\x7d\xeb\x86\x15\xcd\xe4\xba\x63\xba\x2e\xb4\xda\xa3\xd0\x7c\x80\x96\x70\x5c\xac\xb2\xb5\x0c\xf1\xef\x0b\x4d\xda\x73\x11\xd3\xf5\x6a\x9d\x2a\xdd\x92\x2e\x85\x8c\xb3\x9a\x48\x3e\x41\x93\x1b\xa4\x66\x71\x8b\x14\x0b\x54\x42\x6a\xb5\xc9\x64\xdb\x89\x48\x09\x48\x26\x75\x2f\x9f\x3a\xf3\xad\x75\xa4\x12\xe7\x22\xde\x4e\x5f\x10\xe9\x97\xcb\xb8\xf2\xf3\xb7\x47\x38\x5e\x64\xf2\xc2\xb1\xd3\x82\x5d\x27\x8a\x3f\x3b\xe2\x4e\xa5\x2f\xfd\xdb\xf4\xde\x50\x64\x6d\x1b\x6e\x56\x31\x5c\xfa\xe9\x4f\x46\xa1\x10\xb2\x8a\x08\xda\xc2\x82\xb6\x13\xb6\xfc\xe0\x81\xae\xa4\xfb\x85\xb1\x73\xc1\x8a\x6d\x6d\x27\xa4\x67\xb4\x9d\x70\xc0\x6a\x25\x6d\xd7\xa5\x4e\x97\x57\xa5\xba\x24\x93\x33\xd3\xba\x39\x0b\x85\xc8\xa7\xf1\xed\xe2\x30\x9f\x93\x0e\x3d\xcc\x23\xcd\x8e\xb6\xeb\xf6\x03\xe1\xe1\x75\x48\xea\xf8\xaa\x29\x2a\x7d\xce\xda\x7f\x1b\x19\xb2\xd6\x34\xcd\x2d\x56\x19\x43\xf5\x8b\xd0\xb9\x15\xf8\x73\xbc\xa6\xa9\x9c\x8a\xb7\xca\xea\x9a\x33\xe1\xa6\x29\xa9\x27\xad\x92\xa4\x3a\x4a\x89\x4b\x33\x68\xda\x76\x1a\x6f\xab\xdf\x5c\x9a\x34\xd8\x28\x3e\xdc\xa8\x6d\x18\x98\x96\xe0\x5e\x49\x93\x23\x3f\x56\xb5\x4b\x75\x62\xdd\xbb\xc5\xdf\xeb\xde\xed\x90\x2f\xd7\xa4\x43\x05\xfa\x2d\xed\xa6\xc8\x46\x08\xd0\xef\x03\x52\xcd\x66\xdd\xb6\xb6\xc3\xba\xea\xdc\x87\x14\xc3\x2f\x49\x11\xea\xbf\xde\xe8\x6c\xd8\x75\xbc\x9b\x5c\xd6\x10\x19\x2c\xa4\x04\x31\xab\xcd\x76\x7c\x18\xd5\x59\x17\x08\xa3\x67\x8e\x67\x9f\x39\x61\xc4\x1a\xaa\x2a\x27\x55\x59\xa5\x0c\xd2\xdf\xc1\xd8\xb9\x88\x9b\x64\x13\xaf\x52\x3a\xb2\x41\x38\x0f\x65\xba\xeb\x7b\x0b\x62\x77\x64\x1a\x47\xba\x8c\x6e\xb4\x45\xa5\xf3\x65\xe9\x8b\xca\x38\xd2\x03\x2a\xae\x94\x45\x49\xb5\x1d\xd9\x17\xd7\xa5\x38\x8d\x55\x8c\xd0\x60\x52\x91\x36\x59\xb6\x53\x92\xe6\xbe\x8a\x20\xa9\x91\x26\x46\x7e\x93\xec\x24\x38\x6d\x3d\x58\xae\xc8\xc7\xf5\x55\x10\xf3\xcd\xa1\x79\x2b\x72\x35\xf2\x42\x7e\x46\x46\xf7\x32\x23\xdf\x21\x43\xec\x10\x5b\x45\xd5\xbb\x0f\x73\xaf\xc3\x4b\x50\x1a\xe3\x46\x91\xdd\xdb\x59\x1c\xe0\x6f\x42\xff\xfe\x81\x76\x16\xea\x99\xf3\xeb\x8e\x9c\x1b\x18\x8b\x43\x97\x53\xcd\x5b\x67\x33\x43\x2a\x2b\x6e\xe4\xb5\x96\xc2\xba\x99\xf9\xf7\x5b\xb9\xfe\x6a\xe6\x6f\x67\x79\xb1\xb8\x8f\x2f\x85\xb7\xde\xbc\xd1\xde\x79\x70\x7b\xbd\x5a\x3d\x6c\x66\xe9\xb6\x13\x6f\xe6\x9c\x87\x66\xb0\x74\xac\xed\x4c\x7f\x1d\x6c\x66\x75\xac\xed\x26\xf3\x62\xbf\x91\xc5\x33\x6b\x33\xcb\x89\xb6\x0b\xa9\x95\x72\x6f\x6e\x07\xe7\xfd\xc6\x9d\xdd\x57\xc5\x9c\x3d\xe5\x7c\x2f\x5e\xb6\xcc\xad\xe7\xf7\x67\xdf\x37\x33\xae\xb6\x5f\xf6\x2a\xf4\x17\x6c\x1e\x92\xcf\x1d\x98\xb6\xb3\xd5\xf5\x52\x39\x77\x23\x6f\x64\xce\x76\x32\x76\x9b\x96\xae\xec\x7b\x73\x77\xcb\x5f\x07\xa4\x3e\x29\xf3\xe4\x52\x20\x53\xd9\x16\x95\x65\x0c\xe3\xdc\xc7\xa7\x89\x94\xc6\xad\xdb\xcb\x68\x91\x25\x25\xe1\x26\x3b\x7b\xe8\x87\xe9\xc7\xc1\x4d\x2a\x57\x14\x93\x23\x7f\x63\x08\x21\x1f\x70\xd3\xf2\x5d\x3f\xcc\xe5\xec\xa4\x59\x1d\x31\xcb\xa0\x95\x3e\x97\x14\xf3\xdd\x4a\xda\x62\xbe\x9d\x75\xe6\x7b\xb9\xa6\xc5\x5b\xc3\x74\x28\x62\xd6\x9e\xcd\xd2\x5d\x67\xe5\xe4\x7f\xd1\xf7\x58\x31\x3c\xcb\xc1\x29\x9e\xa5\x06\x22\x72\x1e\x71\x31\xcc\xb2\x36\xba\x91\xd2\x0f\x6e\x3e\x3d\x72\x68\x52\x64\xdf\xe3\x5c\xbd\x6f\xc7\x9b\xfb\x32\x43\xf6\xe1\x79\xee\xe7\xaa\xdb\xe5\x2d\x5c\x86\xd8\xf4\xc3\x8c\xfd\x30\x92\x59\xb9\x5e\xa1\x6e\xf5\x43\x5b\xc5\xd3\x2e\xcd\xf5\xcb\x50\xc4\x17\xc2\x0d\x64\x54\xcd\xce\xcd\xf7\xce\xbf\x52\xfe\x75\x86\xb1\x19\xc6\xe9\x1c\x97\xc4\xed\x85\xb8\x87\x0b\x3f\xfe\x4d\x3c\xe0\x26\x77\x11\xe7\x56\x38\x99\xb8\x8e\x44\xd8\x5c\x60\xdd\x17\x5f\x3c\x1b\x3a\x64\x00\x86\xdb\x1b\xc6\x70\x83\x94\x57\xea\x0c\x19\xa7\x6d\x02\xe3\x34\x2a\x3b\x9e\x23\x3b\xaf\xe3\x39\x2a\x07\xaf\x43\xa9\x54\xb7\x99\xec\x11\xda\x1c\xe5\x79\xe6\x4a\xe4\x0a\xf1\xca\xac\x22\xd9\x8d\x60\x1d\x9f\xfa\xf7\xd0\xf1\x62\xe8\x44\x4d\x7b\xe5\x78\x78\xa5\xdd\xa3\x13\x49\xd2\xb7\x13\x91\x4d\x7b\x27\x62\xb4\x65\x61\x63\x54\x91\x26\xd0\x89\x58\x89\xbf\x13\x11\xc7\xd0\x89\xf8\x5d\x23\xae\xf2\x37\xe6\xad\xa1\x13\x49\xab\x8c\x4e\x84\x0b\x4c\x27\x42\x82\xa7\x13\x49\xca\xb9\xeb\x2f\xe0\x52\xac\xe4\xc4\x8c\xe0\xd2\xb7\xa5\x81\x1d\x11\xad\x59\xb4\x1d\x9a\x0b\x4e\xb6\x71\xbe\x53\x2c\x1d\x53\x69\x12\xc7\xed\xa5\x52\xab\xe7\xd8\x75\xc0\xd7\x2f\x4b\x21\x5c\xb8\x8c\x16\xf8\xd6\xb2\x49\xfd\xd9\x77\xb9\x0d\xa5\xb1\x4e\x0a\xe1\xd8\x9f\x7d\xe7\xe1\xd1\x9f\x7d\xc7\x4f\xd6\x9f\x7d\xa7\xbd\xab\xef\x31\xbf\x80\x3c\x53\x4a\x03\xe6\x33\x73\x5c\xc9\x15\xbe\xe3\x95\x73\x2f\x5c\xda\xb5\xa2\xf5\x8a\x53\xe7\x6a\x35\xa0\xd4\x50\x98\xa1\xb5\x54\xe6\x72\x3c\x50\x64\x42\x12\x34\x32\x95\x76\x93\x4c\xd3\x8e\x21\xe3\x34\x04\x1d\x3f\x74\xe2\x07\x95\xf5\xc5\x74\xe2\x7c\x3c\xad\x99\x16\xf1\xfe\x7c\x9e\xc5\xbd\x34\x8a\x43\x1a\x3f\xd1\x80\xb5\xa4\x07\x62\x21\xc7\xe1\x40\x2c\x90\x61\xfc\x4d\x3c\xa8\xe8\x67\xd3\xc5\x28\x8d\xce\x81\x90\x96\x20\x03\x3a\x8d\x1b\xac\x3d\xfc\x6b\x46\x1c\x52\x4b\x06\x6b\x8f\xae\x44\xe3\x63\xf0\x9b\x10\x41\xd3\xa2\x53\x7a\xa4\x30\x88\x67\xc1\x08\x1d\x04\x0a\x1b\xc8\x40\x4b\x11\x8d\xf9\x04\x57\xa3\x54\xe7\x91\xe1\x1b\xba\xc8\xb1\x0d\xf1\xd9\x57\xae\xf9\xc0\xb1\xa1\x88\xbf\x98\xb7\xe2\x33\xa3\x23\x0d\x03\xd7\x8c\x96\xb4\x16\xf6\x3d\x99\xc2\x3e\xe0\x18\x72\x1a\x98\xfd\x23\x8c\x61\x28\xdf\x9d\x69\xda\x99\x19\x2a\x66\x66\x48\xb0\x06\xf4\xbe\xc3\xd8\x56\xc6\x26\x18\xf5\xb9\x6f\x87\x04\x7f\x20\x0b\xe4\xcc\x57\x9b\xb6\xdd\x1a\xc8\x38\x99\xea\x84\x42\xa6\xce\xfc\x70\x65\xc6\x2a\x11\xfa\x2b\x02\x53\xe2\x79\xc8\x99\x1d\x6f\x18\x87\x2a\x1e\x35\xdd\xde\x7a\x95\x4b\x05\x4b\x33\x4b\x11\x0e\x93\x4a\x91\x41\x5c\x9a\xe2\xa9\xaa\x52\x38\x45\x55\xbc\x4b\xc0\x00\x2a\x45\x74\x7d\x9a\xba\x26\x3b\x4e\x95\xfa\x9a\xaf\x92\xcc\x87\x54\xd4\x53\xb1\x5c\x5d\xc8\x27\x73\x0c\x87\xcb\x7d\xb0\x91\x18\x88\xc0\xcd\x1e\xb4\x9d\x62\xec\x2e\x99\x22\x7d\x7e\x8e\x0f\x03\x37\x7d\x3e\x86\x41\xda\xa9\x94\xfa\x32\x94\xa9\x91\xbf\xd3\x8b\x23\x5f\xae\x69\x32\x19\x3a\xab\xdc\x1b\x60\x32\xff\x20\x7e\xef\x91\xe9\xc1\xa8\x75\xd5\x64\x6c\xdb\x51\xeb\x8a\x3e\xb2\x34\xaa\xc1\xa4\x34\xa5\x18\xb5\xae\xd8\x7a\x0b\x63\xb8\x46\x8c\xfc\xce\x15\xc6\x91\xa6\xc0\x2b\x8d\x78\xbc\xca\x21\x5b\x86\xeb\x76\x9a\xa0\x1b\xb8\xcd\xac\x03\xce\xe7\x38\xd0\xd1\x61\xdb\x99\xcf\x39\xd6\xf1\x9c\x18\x46\xbe\xef\xe2\xd6\x3a\x0a\xcd\x07\xb9\x5e\x21\x45\x94\x25\x89\x02\xc4\xa4\xdc\x5e\x31\x8a\x45\xe4\x1c\x56\xc9\x8c\x32\xca\xe5\xf0\x06\x98\xcb\xa0\x31\xaf\xd2\xb9\xfd\x32\x97\xb5\xf9\x9b\x61\xee\x37\x43\xb2\x79\xb6\x6e\x54\x82\xf6\x5d\x19\xcf\x57\x84\xeb\x96\xb9\x8e\x44\xfe\x7e\x56\x2b\xd6\x98\x7b\x67\xbc\x5e\x9f\xd2\x0a\x73\xdd\xbe\x3a\x75\xf8\x9a\xff\x2c\xd7\xed\x2b\x62\x9b\xae\xdb\xdc\xfb\xd8\xa1\xd8\xfb\x9f\x79\x16\x23\x03\xfc\xc5\xf1\x9a\x0a\xcd\x4e\xc5\x29\xc6\x73\xf8\x8b\xe3\xc9\x55\xf7\x8b\xe3\x9d\xe1\xda\x00\x7c\x58\xd4\x32\x43\xde\x61\x64\xd2\x35\xe5\x0a\xac\xd2\x8e\xf0\x78\x17\xe7\x0c\xd9\xc3\x9c\xc8\x7e\x97\x59\x45\x7b\x69\xa7\xcb\xd3\x28\xec\xbb\xfc\xc1\xd4\x17\xc7\xfb\xcd\x71\x5d\xbc\xaa\x07\xe1\x07\x66\x6e\x8d\xbe\x3c\xe6\x38\x9e\xb3\x72\x1e\xc9\xa4\x6c\x33\x79\xed\xd9\x3e\x65\xf9\xfc\x7e\xd4\xf3\x23\x3f\x90\xf1\xf4\xd1\xc3\xdc\xf3\x30\x1e\x9a\x1e\x35\x0f\x97\x59\xad\xec\xd4\x9f\x6e\xa5\x9c\x6c\xe7\x80\xb7\xa0\xbd\xb6\x22\xad\xac\xbd\xb6\x84\x96\x66\xf8\x2b\x92\xde\x54\x48\x7d\x87\x6e\xaa\x1c\xe1\xd9\x5a\x91\xe0\x92\x58\xde\x7f\x30\x6e\xb2\x4a\x1a\x1d\x2f\xfb\xfb\x00\xb8\x7e\x0a\x56\x9c\x53\xfe\x52\xb7\xdf\xbe\x55\xb7\x27\xe4\x88\x82\x9a\x7e\xda\x4b\xed\x35\xca\xee\x26\x46\x95\xf6\xfa\x65\xa7\x33\x9a\x6a\x78\x0e\x4d\x9f\xae\x15\xdf\xb3\x04\xf4\x7c\x1a\xf7\x38\x6e\xd5\xf6\x4f\x73\x37\xdd\xfd\x25\x48\x95\xe5\xaf\x02\xc7\xc5\x0d\xf4\xc7\xda\x09\x05\xd1\x5a\x7b\x41\xaa\x48\x18\x94\x42\xc5\xef\x03\xca\x4a\x11\xae\xb8\xc9\x56\x6d\xec\xef\x81\xae\xba\xdd\xd4\x33\xe1\x8e\xfa\x2f\xf4\xe3\xe4\xb9\xec\x13\xf4\x84\xb5\xe3\x79\xe7\xd3\xc6\x17\xb4\xb7\xbd\xee\xe0\x0b\x21\x71\xa8\xfa\x98\x14\x27\x0f\x12\xc3\x18\x27\x6f\x72\x88\x6f\x7f\x84\xec\x4a\xbe\x3a\x26\x3b\xc8\xe5\x4e\xad\x6a\x54\x8d\xc3\x6a\x5e\x94\x13\x67\x12\x10\x33\x15\x98\x84\xd4\xdb\xca\xe7\x87\x5b\xb6\x7e\x06\x48\x7c\x1b\xfe\xdc\x72\x67\xc7\x52\x27\x03\x80\xb5\x2d\x30\x6d\x1b\x4c\xdb\xb9\x63\xcc\x57\xcf\x76\xc0\x8c\x42\x98\x59\x6e\x08\x33\xd7\x86\x59\x38\xb3\x30\x88\x60\x16\x5a\x18\xb3\x94\xca\xc1\x2c\x14\x3f\x60\x16\x2e\x04\xcc\xc2\x25\xde\x59\xe2\x1d\x07\x7f\xe2\x60\x9e\xeb\x63\x10\xc3\x2c\x5c\x39\x30\x0b\x3d\xcc\x0b\x5c\x98\x85\xd1\x12\x66\x61\x8c\xbf\x20\xf5\x85\x5b\x8c\xdd\x46\x30\x23\xa0\xa4\x28\x26\xf9\x37\x58\x33\x07\xac\x59\x08\x96\x6b\x81\xe5\x2e\xc1\x72\x1d\xb0\x5c\x0f\xb0\x5d\x96\x1b\x81\xe5\xc6\x60\xb9\xb7\x60\xb9\x8f\x38\x5e\xc1\x0a\xc0\x0a\x2c\xb0\x02\x07\xac\x80\x21\xcc\x41\x38\x54\x95\x70\xbe\xaf\x02\x10\x6e\xb0\x02\x41\xb8\x46\x6b\x97\x82\x88\xc3\x35\x70\x31\x2a\xc5\x8e\x28\x00\xa3\xae\x0d\xae\x8d\x7f\x0e\xb8\x76\x04\xf8\x73\x37\x72\xc1\x8d\x42\x58\xf9\xb7\xf8\x77\x07\x58\x15\xd5\xc4\x15\x79\x62\x01\x9e\x1f\x10\x10\x6c\xe8\x10\x04\x7c\xe0\x07\x10\xac\xa3\x25\x84\xf4\x90\x90\xd0\x46\x63\x07\x42\x7c\x44\xe8\xbb\x40\x5a\x02\x33\x0b\xa2\x19\x5e\x43\x8a\x44\x10\x09\x0b\x22\xb1\x84\x68\xe6\xe0\x5d\x0c\x1c\xbc\xe5\x44\x18\xdc\x41\x24\x1c\x88\xd8\x4c\x1a\x22\x11\x29\xcc\x58\x88\xc4\x23\x44\x44\x5a\x46\xc1\x0a\x22\x02\xb6\x02\xdc\x1a\xa2\xf5\x0c\xff\x1c\x88\xee\xcc\x00\xe2\x28\x86\x3b\x3b\xfc\xaf\x9b\xb4\x84\x27\x7f\x83\xf0\xe4\xef\x10\x9e\xfc\x1b\x84\x27\xff\x0e\xe1\xbb\x63\x08\xdf\x61\x3d\xef\x20\x7c\xf7\x0b\x84\xef\x7e\x85\xf0\xdd\x5f\x21\x7c\xf7\x37\x08\xdf\xfd\x1d\xc2\x77\xff\x06\xe1\xbb\x7f\x87\xf0\x97\x63\x08\x7f\x39\x81\xfb\xe4\x18\xee\x97\x70\xef\xc2\x43\x72\x0c\x0f\x4b\x78\x70\xe1\x31\x39\x86\xc7\x25\x3c\xba\xb0\xb6\xa2\xf0\xc4\x82\xb5\x1d\x9e\x70\xdc\xe4\xcb\x0c\xd6\xb3\x30\x3c\x71\xf9\xb2\xa4\xcc\x63\x8b\x52\xc7\x4b\x88\x2d\x2b\xfc\xc5\xe2\x8b\xc9\x97\x19\xc4\x96\x17\xff\xb2\xe4\x8b\x0b\x3e\xde\x5a\xf2\x85\x53\x33\x4e\xcd\x38\x65\x71\xca\x72\xc1\xb1\xc2\x5f\x96\x14\xba\x20\x62\x67\x15\xdd\xe0\x65\x1e\x52\xbd\x27\x16\x16\x3b\xe1\xd2\x27\x96\x0b\xf1\x9d\x15\x42\x7c\x67\x63\x60\x62\x10\x61\x30\x0b\xc1\x8f\x2c\xcb\x74\xe1\x7e\x65\x85\x26\x85\x33\x1c\x9d\xa1\x89\x9f\xc9\x8a\x42\xba\x84\x10\xf8\x61\xbc\x00\xdb\x0e\x17\x10\x20\x2d\x8e\xe9\x39\xa6\xe7\x10\x85\x62\x01\x51\xb0\x84\x28\x70\xe1\xde\x76\x6e\x21\x34\x57\xc1\x23\xd5\x82\x75\x61\xc3\x16\x32\xb4\x42\x10\xd8\xc4\x05\xb5\x93\x32\xa9\xc9\x2b\x6b\x6d\x71\x18\x71\xfb\x8f\xa9\x3f\x8e\xb1\xf5\xc7\x60\x46\x32\xf7\x84\x3b\xed\x84\x3b\xed\x84\x3b\xed\x84\x3a\xe6\x84\x3b\xed\x84\x3b\xed\x84\x3b\xed\x64\x46\xdd\x74\x42\xdd\x74\xe2\xd2\x8f\xdf\xd1\x8f\xde\xe1\x6d\x0c\xec\x10\xee\xec\xd8\x0a\x21\x9a\x3b\x7e\x08\x42\x98\xe1\x92\x42\x17\x84\xb0\x31\x43\xbe\xbd\x89\x6f\x6b\xe2\xdb\x9b\x94\x9e\x61\x7a\x86\xe9\x19\xa5\x2d\x4c\x5b\x98\xb6\x28\x6d\x63\xda\xc6\xb4\x0d\x51\x60\x87\x64\xda\x03\x51\x60\x85\x38\x6a\x8e\x79\x64\xf0\xa8\x39\xe6\x51\x73\xec\x82\x89\xef\x6f\xda\xab\xf5\x3d\x98\x36\xc7\xad\x25\x06\x2e\xd5\x29\xb0\x4e\x81\x75\x52\x30\xd7\xca\xd2\xb4\x51\xda\x2b\x46\x62\x01\x47\xf6\x0c\x8e\xc8\xb2\xd0\x16\xb7\x8e\x25\xe0\xc8\xe6\xec\x3b\x36\x59\x64\xd3\x42\x36\x58\xa4\x1b\x84\xb0\x96\x99\x21\x12\x60\x17\x85\x2b\xd3\x4a\x4d\x11\x3d\x9f\xf3\xfd\x70\x41\x36\x88\xca\x77\x8c\x72\x14\xb3\x87\xa0\x21\x42\xe1\xb4\xb7\x23\xb6\x26\xa3\x19\x24\x58\x72\x12\xf1\xe4\xd8\x1f\x1f\x57\xfe\xce\x00\x9d\x7f\xce\xb2\xc0\x49\x13\xe3\x6f\x6f\x27\xfb\x54\x37\x33\x5d\xfa\xe9\x91\xd2\xd1\xdf\x4b\xaf\xec\x08\xd0\x3f\x8d\xa5\x9c\xfe\xe5\xad\xed\xee\xe6\x45\x78\xd4\x0c\xed\x5d\xd2\x5d\xc6\xc1\xd8\xb8\x33\xec\xd7\x9f\x26\x74\x9d\x4e\x0e\x15\x62\xd7\x81\xf1\x54\x38\x3a\x6c\x14\x9f\x49\x27\x3a\xac\x6b\xa7\xfa\x79\xa7\x47\x56\x90\x0a\xc9\x53\xba\xae\xf0\x19\x81\xc9\xdb\x06\x57\x92\xc8\x8b\x1e\xd2\xbb\x18\x10\xee\x6f\xea\x4d\x81\x0c\x3c\xf1\x03\x27\x27\xc7\xa9\x73\x26\xd5\xa9\x0a\x54\x55\x35\xb3\xb0\x4e\x66\xc5\x46\xd8\x78\xfb\x56\x52\x30\x4c\xb8\x28\x25\x6e\x75\x5b\xd3\x24\xfd\xc3\x64\x4f\xee\x76\x98\xac\xc3\x62\x4a\xfd\xec\xde\x4a\xe9\xa6\xdc\xad\x59\x32\xcb\x7e\x93\xcf\xcb\x0c\x13\x72\xca\x30\x19\xd9\x92\x23\x16\x42\x09\x36\x6d\x96\xc5\xd1\xe0\x92\xb1\xe6\xff\xc0\x0f\xd9\xbd\x19\xc4\xe6\x5e\xc2\xe4\xa6\x26\x35\x2f\x37\x94\x2d\xff\x8c\x9a\xe5\xcb\x0a\x96\xbb\x7a\x95\x3f\xd3\xa4\xe4\x0f\x9c\x29\x4b\xe6\x34\x24\x49\x31\x92\x35\x22\xff\x1f\x35\x21\x15\x88\x37\x81\xd0\xcf\xcd\x28\xe6\x98\xef\xb1\x23\x2c\x86\x67\x00\xcf\x9f\x0b\xc2\x3b\x5a\xaf\xc0\xbc\x5d\xc0\xca\xf1\xfc\x39\xac\xcc\x7b\xc2\xf4\x5c\x7b\x31\xb0\xe7\x06\x52\xa2\x81\xd9\x03\x98\x91\x05\xb6\x88\x2c\x70\x3c\x5b\xdc\xc3\x12\x3b\xcd\x75\x6e\x04\x41\x1e\x98\xee\x03\x08\xdb\x41\x6a\x88\xf4\x3f\xd9\xcb\x02\xc4\x71\xc4\x28\x00\x71\x1c\x21\x57\xe0\x10\x0c\x21\xa3\xa5\x53\x2b\x14\x86\x72\x08\xa6\xf7\xc0\x7e\x62\xfc\xd5\xca\xf7\x08\x7e\x7f\xe5\xdb\xda\x2e\xc4\xdb\xae\xbe\xd4\x4f\x67\xfa\x9f\xf5\x52\xe5\xd4\xb4\x9a\xf6\xa7\xb4\xea\x5e\x1c\x6a\x33\x33\x5a\xfe\x4f\xac\x14\x3f\x73\x44\x83\xb3\xba\x1c\xef\xba\x9e\xc0\x5a\x32\x75\xb3\x14\x53\x39\x43\x4e\xce\x7b\xab\x51\x53\x72\xd7\xe0\x07\x5f\x48\x7b\x8c\x96\x64\x6a\x5f\x35\x66\x95\x06\x21\x40\x1f\x4d\x19\x9b\x38\x4f\xc6\x3b\x73\x76\x00\x90\x61\x12\xcf\x1d\x9a\x28\x3c\x47\x1c\x8f\x1c\xb6\xf9\x9e\xd4\xf9\x15\x91\x69\x41\xce\xd1\xe8\x2e\x54\x64\x9e\x2e\x94\x2b\xa1\x9d\x8d\x72\x71\x6b\xba\xa4\x61\x9b\xc2\x86\xe2\x54\x59\x88\xd8\x0f\xe2\x08\x96\x66\xb4\x84\xe0\x8e\xd1\x44\x68\xe4\x2b\x8f\x6b\xa4\x52\x12\x8b\x28\x75\x47\x10\x9a\x01\xac\x57\x66\x74\x03\x6b\x8f\xb4\x5f\xc9\x23\xc0\x8c\x5c\x4a\xe0\xf3\x1d\x46\x2b\xc5\x29\x29\x95\x40\x94\xb2\xad\xb0\x96\x3e\x08\xd6\xfd\x58\x0a\x37\x00\x57\x28\x57\x0d\xae\xbf\x40\xba\x7b\x65\x06\x73\x89\x5d\xec\xc5\x73\x89\xf0\x2f\x4c\x5b\xc2\xa5\xfa\xeb\xd0\x92\xee\x91\x30\x20\x6c\x55\x02\x53\x87\xb5\xc7\xcd\x20\x8a\x7a\xe9\x07\x8c\xf9\xe3\xfa\xa6\x0d\xb3\x05\x35\xee\x46\x3c\xc0\xec\x01\x3b\x33\x00\x6b\x29\x71\x32\x3c\xc1\x50\x43\xe1\x62\xcd\x1c\x38\xa6\x98\x93\xc1\x48\xcc\x57\x9b\xfc\x88\xcc\xb8\x2c\xb6\x8f\xcb\xd1\x4c\xe7\x28\x3b\x4f\xc0\x58\x6c\x2e\x38\x0b\x97\x1b\xbc\x12\x8e\x7b\x04\xb6\x13\x62\xc0\x6a\x30\xb6\x13\xf9\x77\x1e\xf5\x47\x6c\xf1\xc5\x01\xb1\x5a\x13\xe4\xf1\xdc\x82\xf9\x42\xaa\xb0\xab\xef\x4d\x78\x28\xd8\xf2\x85\x88\x5d\x0f\x96\x4e\x44\xf0\x4c\xca\xb3\xc2\x77\x7f\x16\xc1\x8d\xe3\xba\xec\xef\x89\x50\x56\x3d\x7f\xe1\xfa\x48\x7e\x05\x12\x5b\x8a\x18\x1a\x9b\x42\xd7\x83\x50\xd0\x27\x8f\x08\xf1\x25\xe2\xca\x23\x1a\x0c\xb4\x76\x43\x24\x75\x09\xe3\xf8\x01\xbb\x61\xed\xa5\xfb\xe6\xda\xa3\x5f\xae\x3d\xd5\xf3\xf2\x67\xb7\x24\xb9\xb9\x33\x9d\x98\xdc\x4c\x59\x22\x73\x50\x61\x2d\xe1\x51\x1d\x4f\x3c\xce\xf9\x28\xfa\x71\x1e\x07\xf0\x88\x19\x2b\xdf\xa6\x0f\xf5\x48\xf0\x39\x34\x1c\x1f\x83\xd0\x9f\xc3\x63\x10\x3f\xc0\x63\x28\x16\xe2\x9e\x6e\xc1\x63\xc4\xa7\x6f\x8f\x64\x12\x06\x8f\xb1\x15\x68\xe5\x69\x4d\xab\x78\x02\x2a\xe2\x07\x54\xdc\x18\x2a\x8b\x18\x2a\x73\xa8\xd8\x50\x11\x50\x89\xa0\xe2\x42\xc5\xdc\xe3\x8f\xa6\xfa\xed\xf5\xab\xf1\x37\xc3\x9b\x94\xa2\xa5\x11\x1d\x1e\x54\xf3\x36\x2a\x5b\x5a\xb1\x6a\xc1\x21\x7d\x58\x0c\x8a\x18\x3c\x55\xf3\x9a\xbc\xdb\x7e\x6a\x73\x3f\xab\x3e\x17\x27\x4a\x1f\xf7\x22\x03\xdd\x7c\x79\x55\x74\xf6\x9b\x68\xa9\xe3\x08\xed\xdb\x91\x56\x76\x6b\xda\xae\xaf\xd9\xe9\xc1\x9b\x57\xaf\x27\x87\xda\xc6\x42\xd3\x3c\x1d\x42\x73\xd8\x22\xdd\xcd\xe6\xa8\x07\xcd\xeb\x51\x3f\x39\x86\x53\x5d\xbf\x82\xd3\x6e\xbf\xd9\x4e\x4e\x8e\xe1\x74\xd8\xfc\xac\x63\xa4\xd5\xec\x76\x29\x18\x42\xab\x7d\xda\x85\xd6\x45\xb3\xd3\x83\xd6\x45\xbb\x33\x80\xd6\xc5\xe0\x80\xca\x74\x7a\x23\x68\x75\x06\xad\xae\x0e\xad\xae\xde\x1c\x40\xab\xdb\x1f\x62\x7c\x08\xad\x7e\xb7\x3f\x80\x56\xff\x32\x85\xbf\xe8\x63\xe1\xfe\x10\x5a\xc3\xde\x39\xb4\x86\x83\x2e\xd6\xf7\xb9\x0d\xad\xcf\x1d\x68\x7d\x1e\x4a\x70\x8c\xe6\x48\x3f\x80\xb6\x7e\x86\xcf\x6c\xeb\x67\xf8\x84\xb6\x7e\x86\x3f\xc1\xcb\x68\x80\x97\xe4\x18\x86\xfa\x39\x5c\x0f\x07\xca\x75\x68\xbb\x73\x09\xed\x41\xf3\x0b\xe8\xed\xce\x48\x22\x64\x7c\xee\x0c\xfa\x3d\x75\x3d\x00\xbd\x7f\x06\xfa\x3f\x3e\x83\x3e\x68\x0e\x75\xd0\x07\x6d\xfd\x33\x87\x07\xa0\x0f\xba\xa0\x0f\x06\xf8\xd7\x1f\x80\xfe\xf5\x2a\xc3\xca\xd0\x87\x70\xd6\xf9\x0a\x67\xfd\x41\x72\x0c\x67\x03\x9d\x21\x32\xfa\xc3\xeb\x53\xec\x80\xf3\xfe\xa8\x0f\x17\xfa\xd7\x03\xe8\x60\xa3\x46\x17\x7a\x8f\x30\x3a\x92\x63\xe8\xf4\x7e\xd3\x7f\x3f\x80\x4e\xef\x4a\x02\xbf\x50\xf8\x9a\x2f\x98\x8f\xef\xd2\xb9\xc4\xbb\x23\xe8\xf4\x5b\xa3\x2e\x87\x07\xf0\x9b\xfe\x3b\xf4\x7b\xd0\x3f\x3b\x03\xc2\xbe\xf8\xad\xd3\xed\x42\x57\x3f\x1b\x1d\x40\x57\xef\x41\x57\x1f\x41\xb7\xd3\xd3\xa1\x4b\x77\xf1\xc3\x41\xb7\xdf\xc2\xbf\xe6\x48\x87\x6e\xff\x0c\xba\xfd\x73\xe8\x5e\x0d\xb0\xe6\xeb\x61\xa7\x77\x0e\xdd\xa1\x3e\x82\x4b\x7d\x70\xae\xc3\x65\xa7\x7d\x00\x97\xbf\xe1\x67\xbc\xfc\x8d\xa2\x1d\x0c\x86\x07\x70\xd9\x6f\x43\xaf\x79\xa9\x43\x4f\xff\x02\x3d\xfd\xeb\x08\x7a\xfd\xce\x50\x27\x7d\xdf\x7e\x6b\x74\x40\x8d\x1a\xc0\x95\xde\x83\xab\x6e\xf3\x77\x18\x8e\x06\x9d\x73\xe8\x63\x9a\x3d\xb6\xc2\x29\xf6\x6c\xff\x7a\x04\x57\x4d\x7c\xf6\x55\xb3\xab\x8f\x46\x3a\x5c\xb5\xfa\x57\xbf\xc3\x95\xae\xff\x06\x57\x97\xcd\x2b\xb8\xea\xd3\xdd\xfe\x6f\x3a\x5c\xf5\x87\xc0\x0d\xa5\x70\x02\x57\xd8\xd2\x2b\x46\xdd\xc1\x5e\x1b\x34\x7b\xed\xfe\x65\xe7\xff\xea\x04\x14\x02\x03\xfd\x12\x06\x7a\xef\x1a\xc3\xa1\x3e\x4a\x8e\xf1\x3a\xea\x13\x60\xc8\xf0\xfa\x12\x2f\xa3\xeb\x41\x0f\xf3\x3b\xe7\x17\xa3\x03\x18\x5c\xe2\x9b\x0e\x7a\x6d\x18\x60\x95\x83\xeb\x1e\xe0\x08\x87\x61\x6b\xa0\xeb\x3d\x18\x9e\xf7\x60\x78\xa1\x77\xbb\x30\xec\xf4\x60\xd8\xbf\xee\xb5\x19\x51\xe4\x00\x86\x57\x2d\x18\xfe\x63\x00\xc3\x91\x7e\x05\xc3\x51\xa7\xf5\x1b\x0c\x47\x7d\x8c\x0e\x0e\x80\x71\x87\x0e\x60\xf8\xa5\x79\x25\xa1\x82\x60\xd4\x3c\x85\x51\xb3\x07\xa3\xce\xa5\x7e\x40\xe1\x00\x46\x03\xfc\x8c\x23\x1c\x88\xa3\x3e\x0d\xd8\xcf\xcd\x2e\x7c\x6e\x0e\xae\x46\x03\x79\x39\x80\xcf\x1d\xfd\x0b\x7c\x69\x76\x46\x79\xc0\x92\x2f\x9d\xf6\xe8\x02\xbe\x74\x7a\xed\xfe\x17\xf8\x32\xe8\x8c\x74\xf8\xda\x1f\x68\xff\x47\x32\x95\x92\x8a\x2b\x68\x03\xfd\x32\xe5\x2a\x33\x43\xcc\xb7\xbb\x66\x98\x1b\x6c\x1f\xf1\x6e\xb0\x61\x8f\xb7\xc5\x7d\x72\x11\x0c\x85\xad\xb7\x8f\x26\x87\xe3\xd7\xaf\x26\x8d\xe2\x3e\x2b\xf4\x59\x4d\x2b\xfc\x65\xbc\xbc\x98\x48\xfb\xf3\x66\xe5\x6c\xf2\x74\x52\xfe\xf5\xb9\xb8\xc7\xc1\x70\xe1\x2f\x63\xbf\x3f\x21\x4e\xf6\xe9\xa4\xfc\x37\x2c\xf3\xf2\x12\xb8\xed\x6e\x7b\xcb\x0b\xf2\xa6\x1f\x35\x05\x3d\xcc\xd4\x60\xad\x56\xaf\x6e\xf9\x29\xdb\x82\x28\xde\x46\x3b\xde\xe0\xa1\x7e\xd2\xa8\xd0\x74\xbc\xf9\xda\x7a\x99\xb9\xcd\xfb\x7c\x19\x1b\x46\xc9\x30\x2a\x13\xee\xb7\x5d\x42\x71\x36\xcf\x99\xd8\x66\xd6\xaa\x47\x65\xfe\xdd\x87\x8f\x60\x84\xe4\xaf\x04\x6b\x7a\xe9\xde\x53\x98\x9a\xd3\xed\xd8\xdc\xca\x9f\x4d\x5e\xb0\xcd\x1b\x63\x7d\x93\xcc\xac\xb0\x6a\x94\x8c\x52\x62\x54\x8c\x4a\x6e\x57\x23\x8f\x6b\x2f\x76\x88\x65\xee\xb7\xd3\x97\xac\x08\x52\xb0\x19\xc2\x3f\xdc\xfb\xa1\xb4\x5b\x63\x1b\x2b\xe6\x73\x88\xb4\x25\x3a\xf7\xce\x8b\xfd\x9c\xd3\x30\xf2\x0d\xc6\xb8\xca\xfe\x5c\xf9\xde\x62\x87\x59\x7e\xea\xff\x4a\x79\xf4\x45\xda\x83\x94\xbc\x99\x3d\x65\x9f\x55\x66\x3d\xc7\x08\x15\xaa\xb8\x5d\x57\x8d\xe7\x6a\x5e\xac\x54\x35\x0a\xc6\x21\x66\x1f\x22\xd1\x2f\x27\xd2\x24\x75\x83\xbe\x4b\xf1\xe7\xc4\x16\xcf\x93\xcc\xf1\x79\x56\xb0\xf0\xda\xb0\x4b\xc5\x52\xe6\xdd\x7c\xd7\xc5\x76\x81\xfd\x72\x97\x8a\x8d\x42\x7b\x94\xb4\x93\x91\x9a\x5b\xd1\x76\x6d\xa9\xe7\xea\x54\x9c\xb4\x29\xf2\x48\x1d\x37\xb2\x09\x52\xed\x3d\x19\xde\x64\xd9\x74\xb0\xf1\x82\xaf\xde\x4d\x74\xf0\x58\x0a\x2e\x72\x2e\x71\xcc\x62\x2a\x88\x51\x2c\x61\x4d\xeb\x9f\xfe\x87\xde\x1a\x41\x61\x84\x64\x6c\x72\xe6\x87\xab\x64\x20\x90\x91\x48\xda\x66\x6c\x52\xa4\xe5\xdb\x62\xed\x39\x71\xf2\xf5\xb2\x4b\x19\x24\x4a\x5c\x3b\xb1\x48\xae\xcc\x85\x48\xfe\xb1\x16\xe1\x43\x11\x0a\xd4\x05\x50\xc0\xa1\x1f\x92\xa5\x71\x51\xdb\x44\x35\x2f\xbb\x3f\x71\x21\x1b\xe7\x85\x2e\xa9\x80\x26\x27\x7d\x11\x47\xbd\xcb\xb2\x85\x75\xbc\x38\x7a\x03\x2f\x08\xfd\xd8\xff\x03\x6b\x78\x2a\xb7\x65\xc7\xc3\x28\xe8\x7c\x2e\x91\x39\x63\x65\x8f\x27\xcc\xff\x4b\xe3\x50\x76\x3e\xc2\x46\xa6\xa6\xe7\xf9\x31\x5b\x40\xa6\xdc\xb2\x27\x1d\xd1\x99\xd2\xe3\x2a\xbb\xbd\x73\xee\x85\x9d\x67\xe6\x3e\xfb\x8e\x0d\xac\x22\xe5\xc5\xff\x86\xc1\xc9\xdf\x30\xfc\xe5\x1d\x86\x7f\xfb\x15\xae\x29\xff\x9a\x6f\x5c\xf3\x9d\x6b\xbe\x45\x0a\x19\xbf\xbc\xe3\xeb\xdf\x7e\x05\x12\xfe\x92\x06\x60\xd3\x7b\x20\xab\x48\x11\x62\x54\x2a\x5b\xb5\xcc\xc0\x9c\x39\xae\x13\x3f\x40\xd7\x89\xe2\xfd\x7c\xe6\xc6\x56\xd4\x53\xa7\x4a\x9b\xd4\xf5\xa7\xe3\x7b\x22\x7f\x27\x4f\x27\x7f\x7b\x7e\x5f\xa5\x6f\xe4\x55\x77\x4e\x22\xab\x9f\x0c\x1b\x39\xe3\xdd\xc3\x87\x5c\x1f\xd3\xf0\xc6\xf9\xcb\x95\xec\xa3\xb7\xd3\x05\xff\x4b\x6a\x65\xfc\xfc\x5c\xdc\x63\x4c\xb8\x69\x46\xf8\xaf\x55\xfa\xf2\x98\x12\x0f\x2e\x4e\xce\x3d\x8b\x22\x2e\x7d\xab\x99\xfb\xa0\x9c\xef\x28\xab\x5d\x39\x50\x98\x7b\xdd\x3a\x70\x01\x7f\xf6\x5d\x58\xca\xfb\x21\x31\x92\xb8\x84\x3a\x0b\x79\x2e\x96\xb2\x64\xe4\x4b\x7d\x9e\x0e\xab\xc8\x8c\x9d\x68\xee\x88\x28\x3d\xa4\x8b\x94\x2b\x67\xc9\xd5\x6f\x1d\x94\xf2\x51\x9c\xb4\x2a\x56\x3e\xe2\xd5\x21\x6b\x1e\xca\x3e\x3b\x9e\x20\xf6\x96\xd1\xef\xe5\x09\x1e\x2d\xce\xc8\xd1\xd3\x21\x9b\xbf\xc6\x81\xc5\x47\x73\x4e\xa4\xd0\x33\x3d\xdf\x13\xab\x20\x7e\xa0\xc5\x9a\xbd\x9f\xe4\xac\xb6\x91\x31\x74\xd3\xd3\x3b\xd3\x22\xb7\x44\xea\x90\x06\x88\x39\x96\xd6\xd6\xb6\x08\x42\x61\x99\xb1\xb0\xf9\xb4\x30\x12\xa6\x8b\x55\x65\x53\x2c\x5a\x07\x84\x8c\xf9\x85\x0d\x7b\x23\x88\x56\xa6\xeb\x92\xa9\x8f\xed\x5b\x30\x7b\x00\xd7\xb1\x84\x87\xef\x27\x52\x63\x68\xf6\xe7\xac\xa5\x1b\x00\x1f\x7a\xe7\x2d\xd7\x67\xb5\xea\x3f\xff\x49\x0b\x27\x5e\x6e\x94\xd7\xfe\xb2\x57\x1f\xef\x2c\xde\x9a\x5c\xbe\xf1\xba\x89\x7f\xb0\xbd\xbe\xd3\xc2\xb5\xeb\x16\x59\x9e\xeb\xbf\xdd\x43\x4d\xbd\xce\x48\xae\xe9\xa4\x94\x18\xc6\xc1\xf8\xf8\x04\x63\x63\x96\x92\x17\x1a\x35\xc3\x38\xe2\x44\xa1\x51\x23\x6f\x5a\xa5\xca\xa4\xc1\x1b\x4f\xb1\x31\xbe\xb9\x3c\x1f\x5d\xad\xd6\x5e\x30\x9f\x34\x24\x12\x82\x5c\x6c\xc1\x39\xb2\xea\x5e\x39\x7f\x8a\xac\x6c\x4a\x49\xa2\x62\x3e\x4b\xf9\xfa\xf8\xdb\xf1\xc9\x24\x79\x3d\xfe\x96\x51\x7f\x5b\xc7\x8f\x05\x89\x0f\xa3\xec\x9d\x73\xbe\x21\x8a\x9b\xeb\xc5\xdb\x4f\xe4\xc0\xcb\x30\xee\x0e\xa9\xe9\x35\x6d\xfc\x4d\x9b\x1c\x6a\xc5\xc6\xdb\xbc\x97\xb6\x17\x27\x9e\x2b\x4c\xef\x8f\x16\x72\x2e\xa3\x39\x96\xab\x95\x35\xdb\x72\xb7\x16\x75\x67\x4e\xa3\xd7\xf1\xa4\x1b\x4d\x3a\xbc\xa0\x51\xef\xcf\xd3\x99\xc9\xd6\xdf\xec\x3d\xfc\xce\x89\x44\x76\xe0\xc9\xc3\x8e\x1c\x8a\x31\x76\xaf\xf4\x79\x28\xe7\x3b\xad\xec\x72\xb2\xff\x58\x9b\xae\x33\x77\xc8\xe4\x1e\xa2\x40\x58\x8e\xe9\x02\xd9\xc3\x4b\x97\x51\x73\x3f\x14\x38\xcb\xe5\x21\x9d\x45\x27\x52\x51\x6c\x5b\x0c\xcc\xcc\xde\xc6\x6d\x41\x46\x3a\x8e\x37\x77\xee\x39\x74\xf9\xf2\x33\x2f\xdd\x7f\x78\x34\x3c\xab\x69\x95\x8f\xc9\x87\xca\x38\xa9\x4d\x1a\x49\xad\x96\xbc\x7e\xd5\x48\x3e\x7e\xac\x27\x86\xf1\x64\x18\x49\x62\x18\x89\x61\x3c\x27\xb5\x7a\x3d\xa9\xd7\x12\x22\x65\x8e\x92\x0f\x1f\x93\x7f\xfe\x84\x9c\xb7\x5c\xff\x3b\x51\x29\x7b\xe8\x66\x4d\x9e\x19\x56\x08\xd2\xa6\xa6\xd9\x82\xbc\xb2\x91\xe6\x0b\xf9\x55\x35\x83\xc0\x7d\x00\x67\x5e\x41\x22\xd2\x99\x57\xe8\x1b\xb1\x8b\x78\xcf\x8f\xeb\x50\x87\x0f\xf0\x11\x3e\xd4\xe1\x63\x1d\xea\x75\x28\x41\x15\x0e\xa1\x42\x9e\xcd\x7f\xac\xb1\x90\x58\x34\x20\xf0\xa3\x06\x63\xde\x36\x80\x37\x9f\x06\xc8\x0f\xdf\x20\x72\xb1\xc1\x5b\x5b\x43\x1d\xa9\x35\x80\xd6\xa9\x06\x58\xbe\xeb\x36\x08\x1f\xb8\x01\x91\x88\x1b\xe0\xcc\xbd\x06\xe0\x9f\x19\x45\xbe\xe5\xd0\x7a\x84\xb7\x7e\xac\x85\x17\x3b\xa6\xdb\x80\xc8\x0f\x63\x61\x37\x58\x2a\x80\x11\x16\x30\x38\x33\x57\x34\xa4\xb3\x4c\x6c\x8c\xe5\xac\xb0\x34\x8d\xac\x06\xd8\x4e\x14\x3b\x9e\x85\x0f\x88\xcc\x06\x1f\xff\x35\x20\xa4\x51\x85\xc5\x42\x61\xaf\x2d\xaa\x0c\xb3\x1a\xe0\xdb\x76\x03\xc4\xad\xf0\x1a\x24\x78\xa1\x16\x34\xa4\x03\xab\x86\x74\x6a\xd2\x80\x95\x19\x34\xc0\x73\xf0\x31\xd2\x6b\x6f\x03\x1e\x45\xe8\x37\xd2\xa1\xdc\xc0\x5e\xac\x90\x43\x5e\x8e\x9a\xde\x03\xbe\xef\x0c\xc7\x65\x03\x2a\x1f\xa1\xf2\xf1\x23\x1c\x1d\xc1\x11\xa9\x58\x58\x52\xab\x94\x48\x76\x3e\x10\x5e\x99\x81\x45\xbe\xf0\x25\xf2\xf4\xad\xa0\x78\x45\x0a\xe7\x42\x9f\xce\x79\xe7\x1e\x45\x2b\xe4\x9e\x97\xee\x53\x8c\xf2\x94\x0b\x5b\xe5\x2c\x36\x24\xf8\x68\x3b\xe0\xd9\x27\xdf\x1c\xd8\xcc\x28\x0a\x5c\x27\xae\x98\xb1\x8c\xd0\x54\x4d\x3d\xf3\xb2\x17\x4d\x01\x34\xfa\x63\xe5\x09\x7f\x25\xc2\x85\x80\x47\x27\x58\x99\x41\x7a\x16\x8d\xf3\xac\x12\x89\x1f\xf4\xb5\xe4\x6b\xb1\xf7\x3b\x3f\x8c\x2b\xb3\x07\xb0\xfd\x70\xed\x81\xed\xe3\x94\xf3\xe2\x25\x0b\x11\xe3\x25\xb9\x7d\x0d\xcc\x30\xe6\x49\x4e\xa7\xea\xb6\x8f\x15\x99\x74\xf4\x49\x61\x05\xf7\x49\x1c\xa8\x26\x29\xe7\x9b\xb1\xbf\x22\x94\x72\x0a\x2a\xfe\x7c\xae\xe0\xaa\x2b\x81\xf0\x6c\xc7\x5b\x54\x22\xda\xb8\x4d\xdb\xae\xdc\xd1\xa6\xba\x32\x83\x5b\x98\x3b\x6e\x2c\xc2\x5b\xd9\xab\xf2\x0e\x55\x59\x61\x1e\x0a\x1b\x63\x86\x71\x85\x1f\x13\x09\x99\x5f\xd9\xf0\xfa\x9d\xa6\xb2\xfb\x2b\xdf\x16\xaf\x20\x8b\xa7\xf8\xe0\x5c\x53\x24\x9d\x01\xe3\x14\x9d\x7b\xec\xef\x26\x14\xd6\x3a\x94\x84\x42\x4c\x9e\x01\x3c\x27\xf6\xc3\x8a\x20\x02\x32\x4d\x21\xdb\x66\x8b\x39\x4b\xc6\x6d\x31\xa7\x15\xd1\xab\x00\x65\x88\xfb\x00\x19\xc2\x5c\xbc\x72\x42\x24\x85\xed\x47\x0f\x9e\xa5\xb8\xc5\x3b\x24\x24\x30\xa0\x29\x4f\x11\x57\xf0\x37\x82\xef\xeb\x7b\xd9\xfd\xb4\xeb\xe3\xa4\xb3\xf0\x0b\xaf\x7c\xe7\x51\xa4\xce\x87\xdd\x07\xe9\x20\x8f\xbc\x9d\x3b\x36\xce\xcc\xf8\x41\x11\x38\x04\x73\x1d\xf8\x01\x8e\x60\x1f\x02\x32\xa8\xb0\xc5\x5c\x12\x9c\xec\x8a\x9a\x3e\x33\x56\x87\x77\x88\x4d\xb0\x7c\x17\xc7\x63\x4c\x2b\x02\xe6\x92\x18\xc2\x16\x73\xe9\xc5\x98\x86\xf3\x6c\x1d\xd3\x35\x72\x16\x11\x84\xc2\x99\x3f\x28\xf7\xd0\x73\xe9\xe2\x9a\xc7\x11\x27\x3c\x4a\x60\x77\xad\xdd\xd8\xa1\x88\x88\x97\xbe\x0d\xb8\x2b\xd2\x2e\x54\xa1\x98\x87\x3b\x4f\xc5\x8b\xa4\xbf\x01\x8c\xc9\x9d\x84\x3c\xa5\xe2\x4a\x16\x41\x44\xda\x9a\x15\x8a\xdf\x9a\x2e\x79\xa4\xc0\x08\x84\x38\x38\xc9\x53\x61\xe6\x8c\x31\x08\xfd\x95\xc3\x82\x5c\x3f\x87\x42\x13\xe0\x32\x15\xc5\xc2\x8b\x5f\xe1\xcb\x7f\x7f\xc5\x8b\xdc\x2b\x5c\x9d\xe8\x1a\xf8\x01\x25\xbe\xbf\x82\x75\x24\xe4\xae\xc8\x3e\x0c\xd7\x2b\x29\xb3\x90\x52\x57\x16\xc5\x92\x80\x36\x75\xba\xe4\x2c\x1c\x2f\x96\x17\x12\x5c\xcc\x9c\x85\x2d\x2c\x96\x4f\x54\xe4\xcb\x73\xc2\x5e\x07\x3c\xda\x2a\xce\x5c\x89\x83\xa4\xe4\x80\x84\x05\x4a\x9c\xb0\x10\x71\xc5\xf1\x24\x86\x7e\x85\xf0\xd3\x21\x08\x2b\x4c\x14\xf3\x66\x4a\xe6\x97\x0c\xc2\xee\xae\xc3\x80\xa2\x15\xba\x15\xad\x67\xb7\xc2\xe2\x9e\x26\xa0\xf3\x95\x58\xcd\x3d\xf6\xe2\x1c\x8a\x0a\x01\x9b\x87\xa2\x22\x05\x3d\x21\x0e\x58\x7c\x81\x90\x46\xb1\x6f\x83\xeb\x5b\x37\xec\x5d\x12\x07\x56\xe5\xd6\x74\x1d\xbb\x32\xc7\x25\x46\x12\xf4\xa1\x88\x7c\xf7\x56\x90\x27\x71\x5b\x48\x7f\xe2\x24\xa3\xba\x33\x83\x57\x78\x5b\xc4\xaf\x68\x62\xd2\x6f\x71\x01\x7a\xa5\xd6\xd8\x0a\x3e\x8e\xee\x9b\xb8\x0c\xd0\x50\x90\x3f\x91\x71\xcb\x5f\xad\xd6\x31\xf7\x41\xfa\x7b\x2e\x8d\xcf\xa9\xb0\x93\xec\x79\x45\x8a\x8b\x2a\x2c\xa6\xc6\x9c\x95\xe3\xa9\x5c\x4e\x9b\xf7\x69\x5a\x78\x11\x79\x9e\xc1\x19\xe9\xf8\xaf\x88\xb9\xa0\x81\x8a\x23\x02\xa8\x41\xb1\x5f\x61\x89\xdc\x7c\x4d\x5e\x6a\xf8\x52\x61\x85\x3c\x2f\xbd\x6b\x62\x03\x16\xc2\xab\xf0\x38\xe1\x85\x9c\x80\xdf\x79\x65\x63\xe4\x78\xda\x68\x49\x00\x59\xc1\x5b\x14\xa1\x2e\xa2\x6d\x94\xf2\xb2\x28\xae\xcc\x32\x95\x2b\x83\x5d\x32\x7b\xc0\xdd\x4f\xb9\x70\xc4\x31\x3f\x77\xcd\x38\x16\x5e\x2a\xdf\xa7\xb1\x2c\x87\x32\xfb\x7e\xc4\x91\x4c\xc0\xf3\xcc\xba\xdb\xce\x5c\xf9\xcd\xa1\x6d\x48\x01\x8b\x33\x5f\x25\x2f\x15\x1a\xee\x32\x9e\xae\x0c\x38\x2c\xbc\x78\x29\x71\xf5\xb9\xa3\x99\x6a\x05\x6b\xb9\xf6\x6e\x38\xac\x48\xef\x01\x9c\x30\x03\x92\xb0\x71\x82\x57\x04\x8e\xd3\xe2\xa3\x70\xf1\x71\x7e\xac\x3d\x72\xbc\x20\x70\xf8\x59\x34\x04\x37\x72\x72\x29\x5b\x58\xbb\x39\xb9\x94\x27\x16\xb8\x31\x66\x19\xb8\xe5\x6c\x56\x68\xda\x76\x2e\x15\xad\x67\xc4\x9d\x6d\x15\x52\xd9\xb2\xc5\x3c\x3e\x28\x4a\x8b\x26\x45\xe9\xc3\xfc\x68\x40\x10\x22\xf3\x1a\x3e\xf0\x62\xe6\x9a\x8f\x0f\xbc\xf1\x06\x34\x27\xf1\x43\x1c\x92\x96\x03\x8e\x06\xa5\x85\xac\xa8\x33\x49\xad\xe1\x28\x8a\x1e\x56\x40\x38\xed\x29\x55\xe4\x3c\x0a\x72\xa8\xac\xb1\xfc\x6b\x6a\x18\x95\x57\x47\x8d\xd2\x61\xfd\xc3\xc7\xbf\xbc\x7e\x4b\xbc\xde\x58\x2b\x85\x25\x6d\xc2\x97\xe3\xca\xbf\x57\xdf\xd7\x26\x87\xc8\xe0\x69\xe4\x5c\x78\xf3\x3c\x4e\x63\x8d\x71\x93\x8f\xe3\x76\x0e\xf0\x1c\x75\x4c\xb7\x03\x4f\xe2\xd4\xbc\xb5\xeb\x3e\x17\xcb\x6e\x7d\x8f\xbe\x97\xb5\x73\x3a\x5c\x35\x66\x05\xa4\x3a\x13\x22\x3a\x13\xcf\x71\x8b\x74\x06\x62\x93\xc2\x3a\x9f\xdc\x3e\x31\xf2\x1b\x1f\xfd\x3e\x4f\xb4\xe7\x72\x20\x4f\xe3\x58\x5b\x5c\x1e\x29\x7e\xd3\x4a\xe6\x73\x79\xc5\xcf\x25\x78\xc3\x27\xe2\xb7\x9e\xb5\x62\x79\xbd\xa3\x47\x3d\xae\x4d\x9e\x4e\xca\xef\x9e\xe9\x47\x33\xa9\x1e\x9f\x29\x41\x3f\x97\x17\x0a\x4f\x95\x5e\x75\x5e\x27\x24\x2b\xb7\x66\x96\xf3\xa8\x93\xa6\x3a\x5f\x5f\x3c\x97\xa7\xf5\xf1\xac\x1c\x95\x83\xf2\xaa\xec\x96\xd7\x65\xbb\xec\x97\xad\xb2\x97\xf2\x92\xb3\x23\xab\xce\x67\xdc\x69\xb3\x35\xad\x58\x9e\x97\x17\x93\xf2\xe2\xc8\xaa\x4f\xcb\x36\x85\x2b\x2c\x67\x4f\xca\x1b\xfc\xda\x77\x6d\x42\xa7\x33\x43\x3a\x9d\xd9\x79\xcc\x1f\xf1\x1b\x15\x24\x08\x7f\x2a\x47\xc8\x64\xc1\x85\xb1\x71\x77\x54\x41\x4e\x3a\x3a\x7c\x3d\x2d\xd6\x3f\x6e\xcb\x10\xa2\x6e\x2d\xe5\x63\x7e\x22\x24\xb0\x56\xe6\x8d\xf8\x23\x46\x14\xcb\x1c\x39\x5e\x8a\xf4\xba\xa9\xdc\x6d\x4f\xad\x75\x14\xfb\xab\xa9\x52\x9f\xc8\x65\x49\xd8\x2d\xcc\xc9\x38\xcd\x48\xa6\x71\x35\x11\x9e\x45\xe7\x3e\xb6\x3d\x65\x34\x35\x3a\x3a\xc1\xa4\xeb\xcc\x42\xf2\x0e\x6c\xdb\xd3\x68\x3d\xb3\x53\x1f\x4f\x98\x41\xfa\x1d\xe6\xfa\x7e\xca\x2a\x16\xd3\xec\xae\xd4\x1a\x5f\x3b\xae\x9d\x36\x88\x5e\x60\xba\x72\x3c\x67\xb5\x5e\x4d\x43\xb6\x37\x50\xd9\x81\xef\x3a\xd6\x83\xf4\x85\xb2\x0e\xc5\x94\x14\x3a\x98\x72\xa1\xe7\xc8\x67\xf0\x02\x4c\xfe\xb7\xa7\x41\xe8\x13\xd2\x98\xd2\x85\x89\x04\x79\xe8\xc6\xb6\x4f\x5d\xd9\xb7\x2a\x8d\x55\x10\x52\x9b\x67\x23\x9b\x6c\x5a\x4b\x8a\xaa\xa3\x30\x76\x40\x91\x2a\x73\x0a\xcf\x66\xbe\x82\x7b\x83\x1e\x45\x66\x31\x92\xb5\xc6\x35\x87\x5b\x48\x31\xd5\x49\x94\x50\x47\x74\x32\x11\x2f\x65\x2c\xf4\x17\xa1\x89\xd4\x4e\x7c\x33\xbd\x0b\xcd\x60\xba\x26\xbd\x1d\x6a\x4a\xda\x8e\x85\x88\xa7\xb2\x43\xd4\xcb\x61\x56\xda\xb1\x9b\xd9\xd8\x02\x9c\x5d\xd8\xc5\x81\xef\x21\x2d\x86\xd9\x1b\x65\xe4\xa7\xc1\xa2\x9b\x37\x78\x50\x6c\xe5\x61\x47\xa7\x39\xce\x7c\xdb\xb4\x24\x6d\x08\x8e\x16\x95\xc7\x8e\x39\x4c\x77\xba\x8a\x82\xd0\xa7\xa3\x47\x75\x2b\x14\x8b\xb5\x6b\x86\x53\x71\x4f\xa7\x6a\xf8\x86\x8e\xc4\x92\x70\x1d\xef\x66\xa3\x3a\xa4\xcf\xa6\x96\x69\x2d\x85\x8c\xca\x61\xc3\x5f\x64\x65\x86\x37\x53\x33\x9a\x9a\xf6\x2d\xf2\x9f\xb6\x72\xb3\x07\x3e\xe3\xe6\xf8\xeb\x38\x58\xc7\xe9\xa8\x9a\xb2\xbe\x8d\x6a\xcf\x8f\x98\xbb\xdc\x0a\x82\x34\xbe\x76\x24\x87\xb4\x31\x27\x94\xfe\x92\x20\xc6\x4e\x4c\x33\x1d\x1f\xd6\xba\xdf\xf3\x2d\x1c\x56\xc9\xcf\xfa\x2d\xda\xec\xf6\x68\xbb\xe0\x66\xcf\xa7\xb9\x22\x8a\x37\x8b\x3a\xb1\x98\x12\x05\x2e\x2b\x63\x19\x81\xf4\x3c\x2a\x2b\xa1\x6e\xe4\xf1\xe7\x90\x9a\xd5\xc3\x54\xd1\xb8\x18\x47\x66\x94\xf5\xad\xd4\x81\xe8\x94\xf9\x41\x1e\xde\x3c\x41\xe9\x19\x38\xd2\xd3\x41\xca\xc3\x5c\x8d\xeb\xcd\x45\x42\x7e\x40\xd9\xc3\x2a\x25\x7f\x99\x65\x70\xfb\x22\xd8\x6a\x20\x8d\x6d\x3b\xe7\xb8\x90\x38\x7f\x5e\x59\xe4\x73\x22\x99\x8c\x90\x59\x98\xae\x4c\x6f\xe1\x0a\x7b\xba\x12\x91\x09\xeb\x98\x84\x0c\xb2\x77\xb3\x77\x92\x9f\x5d\xba\x40\xe3\x99\xf9\x23\xfe\xeb\x94\x2a\xa0\x93\xb4\x28\x4d\xab\xf9\x89\xe9\x74\x50\xe0\x08\x9a\xcf\xf3\xb0\x65\x92\xab\x14\x3f\xd6\xa6\x0b\x2e\xf9\x96\xa2\xc5\x28\x64\x87\xcf\xec\xd4\x36\x97\xc5\x05\x57\xd8\xb9\x22\xca\x29\x0e\xe5\xb5\x05\x35\xc3\x38\x78\xa2\x6d\xf3\x59\x7b\x4e\xb5\x6a\x95\x40\xe4\xe5\xdd\xc1\x9f\xcf\x85\x50\xd8\xd1\x7b\x4e\xc3\x72\xce\xfc\x95\xda\x2c\x7f\x5f\x75\xc8\x8e\xc4\xb8\xed\xe7\x7d\x16\xab\xb3\xfc\x6d\xf0\xd0\x8c\x55\xdf\x0f\xff\xf9\x10\x90\x93\x7f\x56\x91\x26\x37\x74\x8b\xf4\xc0\x5e\x79\x8d\xa2\x25\x52\xad\x94\xa1\xbf\x02\x33\xca\x8e\xe7\xe9\xb8\x83\x0e\xfd\xd7\x1e\x75\x23\x4b\x65\xe9\xb4\x80\xc0\x4b\x99\x91\x97\xdd\xef\x44\xe0\x44\x5e\xcc\xce\xde\x5f\xf2\x73\x0d\x6b\x8f\x77\x04\x1b\x1e\x44\x04\x9e\x2f\x3f\x67\x5e\x18\xe6\x05\x2b\x90\xa3\x84\x18\x74\x5f\xa9\x0b\xaa\x93\x56\xe9\x56\xe8\xce\xf1\x6c\x1f\x3b\xcb\xa2\x69\xcf\x84\xa2\x54\x7b\x3f\x98\x92\xca\x42\x9a\x38\xcc\xf4\xbf\x95\x9e\x7b\xf5\xb5\xf1\x44\xe7\xfc\xcf\x74\xcc\xff\x5c\x76\xea\xa9\x85\x5f\x46\xfd\xd1\x91\x69\x0e\x3f\xbc\x60\x18\xd1\x61\xb5\x28\x4f\xd4\x53\x0d\x8c\x4d\x53\xc3\x0d\x1d\xf2\x54\xd3\x74\xc7\x3c\x2e\x97\xbd\xa1\x56\xae\x94\x58\xcd\xc9\xae\xc5\x9d\xba\xa1\x64\x09\xac\x3b\x97\x9a\xea\x55\xab\x55\xc6\x12\xaf\x92\xdb\xef\xb1\xc9\x43\x57\x62\xca\x55\xab\xe3\x85\xb3\xc2\xce\xc8\x94\x02\xaa\x85\xc6\xab\x31\x1c\x4e\x8a\x05\xc3\x30\xaa\xc9\x51\xf1\xb0\x61\xc8\x62\x85\x46\xdd\xf8\x92\x1c\x14\xab\x0a\x93\xee\x93\x56\x0a\x9f\xcb\x4f\x48\x29\xe5\x21\xd2\xf3\xe2\x93\x14\x26\xf9\x9f\xd4\x0e\xbc\x4a\xa8\x64\x4e\x6b\xcf\x93\xe7\xc9\x7b\xf3\xc8\xaa\x3b\xef\x71\x4a\x78\xf5\x5d\xc5\xba\xf0\xb9\x58\xf6\xeb\xd8\xd5\x85\xa3\x43\xc3\x28\x16\x1b\x0c\xc7\x77\x3a\xae\xd4\x27\x1f\x35\x25\x46\xdf\xb4\xf3\x1b\x7f\x33\x8c\xc2\x16\xd2\xf1\x1e\x11\xb8\x94\x4e\x28\x81\x83\x53\xdc\x8b\xea\xcf\xd3\x58\x2b\x6b\x56\xe4\xb3\x44\x41\xd8\x24\x4c\x88\xf3\xa2\x68\x27\xf5\x0e\xce\x06\xab\xaf\x5f\x6b\x65\x0a\x8b\x79\x81\xe9\x86\x7f\x70\x42\x0e\x24\x56\x05\x23\x75\x4e\xf9\xc4\x06\xf0\xbb\xa5\xcd\xf7\xca\x51\x8a\x1a\x6a\x14\xca\xf5\xc9\x8e\x67\x83\x7c\xc5\x7b\xab\x88\x26\x2f\x82\xad\x66\x76\x94\xd5\x71\xad\xfe\x02\x82\xaa\x56\x4e\x3d\x2f\x6c\x16\xf2\x26\xcf\x65\x8f\x5b\x17\x96\x34\x86\x53\xaf\xa5\x90\x9f\x19\x00\xf4\xcb\xd2\x1c\xff\xc7\x0b\xf6\x07\xd9\x02\x39\xc5\xb5\x08\xb9\x6b\x7f\xee\xdc\x6f\xe8\x8d\x44\xb1\x12\x37\xbe\x03\xbc\x87\x8b\xe8\xdc\x0f\x91\x54\x99\xaf\x69\x59\xed\x9c\x81\xc3\xa2\xca\x15\x1f\xb6\xfa\x36\x5c\x85\x74\xc8\xa9\xe0\x35\x79\x61\x23\x3b\x6e\x96\xe1\x4b\x5d\x56\x27\x5e\x42\x73\x86\xeb\x61\x73\xe6\xaf\x63\x68\xda\x36\x34\xed\x95\x13\x73\x18\x0b\x1b\x9a\xae\x0b\xcd\x94\xd8\x68\x46\xd1\x7a\x15\x30\x49\xd2\xbc\x77\xfc\x15\x9c\x9a\xd6\x0d\x05\x23\x9f\x2e\xc8\x47\xdf\x00\x19\x99\x9f\xba\xa6\x75\x43\x84\x71\xcb\xf4\x7c\x8f\xb0\x41\x5b\x36\x10\xa8\x07\x90\x21\x38\x87\x02\xaf\x3e\x81\x35\x8a\xd0\x42\xaa\x49\x45\x22\x68\xf9\x67\xce\x3d\x3b\xff\x6b\xf9\x1d\xcf\x5e\xb3\xdd\x79\xcb\x77\x5d\x79\x9c\xd1\xf2\x57\x33\x5a\x63\x5b\xfe\x2a\x58\x33\xa4\x1f\x12\x57\xeb\x30\x1f\x8d\x18\x1c\xd2\xf4\x94\xb3\x4f\x4e\x87\x26\xd7\xec\xf1\x71\xa9\x1f\x32\x14\x1f\x03\xe8\x85\xbe\xeb\x22\xf9\xcc\x20\x01\x17\x8e\x17\xb7\x67\xd0\x5a\xc7\x20\x81\x5d\xa0\x2d\x57\xf7\x76\x26\x26\x6b\x0b\xd6\x23\x6e\xe7\x49\x13\x95\x88\xdb\x42\x22\xd1\xf9\x01\x08\x73\x1d\xfb\xa0\x7b\x36\xe8\x24\x41\x8b\x1f\x14\xde\x9b\xb9\x0a\x5c\x01\x64\x4e\xcf\x42\x98\x7c\x3c\xe2\x04\x7e\x44\xfd\x3e\xb7\xa3\x71\x06\x49\x1b\xf4\x7b\x3e\xcc\x90\x57\x42\x4b\xc3\xf4\x19\xed\x83\x14\x32\x16\x19\xa4\x7d\xcb\x20\x85\x38\xb6\xc8\x79\xed\x99\xa2\xfb\x55\xc4\x74\xe1\x5c\x78\xb8\xcb\x39\x8f\xc4\x83\x49\xa4\xcb\x73\x1f\x83\xd0\x9c\x61\xb0\x5a\x99\x21\x10\x90\x12\x9c\xaf\xcd\xd0\x16\x36\x5c\x08\x33\x00\xec\x39\x90\xdd\x87\x97\x08\x2e\x1e\x02\x3f\x5e\x0a\xfc\xf2\x2a\x8a\x3b\x2a\x76\x11\x74\xd4\xf9\x76\x67\x0e\x9d\xd5\x4a\xd8\x8e\x19\x0b\xe8\xac\x02\xd7\xb1\x9c\x18\x23\xf8\xd2\x1d\xc9\x00\x64\x43\xa2\x43\x92\x43\x89\x0d\x44\x08\xdd\xd0\x61\xf9\x63\xc7\x23\xe0\x68\x50\x68\xd7\x69\x24\x82\x8e\x17\x87\x3e\x87\x98\xb8\x95\xe0\x72\x69\x6c\xca\x5e\x29\xd5\x64\x06\x42\xbf\xe8\x8a\xd5\xca\x84\xae\x88\xa1\x9b\xd2\x8f\x5d\xc9\x6a\x75\x7d\xd3\xa6\xe0\x0a\x39\x2c\x86\x00\xc5\x30\x16\xd0\x8d\x4d\x0b\x2e\xbb\x40\x0e\x3b\x2f\x79\x77\xbf\x94\x44\xe0\xa5\xef\xf9\x2b\x3f\x24\x08\xaa\x4b\xba\x46\x2b\x86\x0e\xed\xf9\xf2\x2d\x7a\x4a\x17\xa0\x3f\x73\x9d\xc5\x76\x34\x82\x7e\x60\xfe\x58\x0b\x20\x1c\x87\x7e\x10\x13\x9c\x01\xf4\xe5\x5c\xcd\x7c\x3e\xa7\xb1\x34\x33\x74\x2c\xa0\xd6\x62\x10\x41\x60\xc6\x34\x8a\xae\x7c\xf7\x41\x35\xe9\x2a\xc4\x5f\xac\x80\x5d\xfb\x52\x48\x18\x3d\x92\x2c\xbf\x62\x76\x86\x9f\x14\xfa\x3e\x41\xe4\xa4\x5e\x5f\xaf\xee\x6c\xf8\x87\xb0\xe1\x1f\x6b\x27\x86\x81\xb0\xf0\xcf\x0f\x6d\xbc\xac\xc3\x08\x3f\xdd\x40\x30\xe5\x0d\x03\xe1\xf2\x8b\x0d\x04\x32\x56\x20\x81\x27\x25\x06\x00\x0c\x04\x19\x16\xd9\x14\xc1\xd2\x7c\x1c\x3d\x60\xb9\x11\x0c\x04\xfb\x19\x65\x4c\x92\x01\xb6\x70\x40\xca\x12\x43\xf3\x56\x90\x3b\xff\x15\x5e\xfc\x40\x86\x11\x0c\x69\x53\x07\x09\x25\xc4\x17\x5e\x09\x39\x7e\x41\x98\x34\x14\xbd\x92\xdd\xc2\x29\xf5\x28\x85\x0c\x35\x94\x7c\x19\x2d\xb6\x43\x11\xfb\x8e\x0d\x04\xbd\x39\xa4\x16\x0e\xe9\x60\x57\x62\xbd\xc6\x58\x70\x81\x83\x46\x46\x09\x5e\x65\xcd\x2b\xd6\x70\x3d\xe3\x95\x91\x74\xd2\x38\xc4\x84\x15\x3b\x16\x8c\xf0\x1b\x8c\x44\x14\xc3\x68\x29\xfc\x50\xac\x08\xe4\x04\x94\xaf\x67\x82\xa0\x60\x08\x78\x5e\xeb\x31\xb0\xe4\x0a\x8b\xf1\x08\xae\x3d\x5b\x2e\x50\x04\x73\x71\xed\xcd\x69\xe2\xcb\xab\xb0\x29\xe6\xe2\xc5\xe1\x53\x66\x15\xc1\x32\xd8\xe7\xd7\x5e\xb4\x14\xf8\x4a\xbc\x8f\x7c\x56\xea\x2c\x2a\x12\x71\xcc\x8b\xe1\xb3\x08\x67\xb8\xa8\x7f\x76\x22\x47\xea\x60\x65\xbb\x4e\x9e\x04\x4e\x35\x66\xcc\x59\xb4\x0e\x6d\x30\x69\x03\x62\xb4\x72\x16\x97\xe3\xde\xc8\xc2\x2e\x33\xdd\x7f\x40\x3a\xc2\xa6\x20\x94\x9f\x03\xe3\x6b\x7e\x03\x89\x73\x3e\xf3\xe3\xd8\x5f\xc1\x2c\xa6\xb2\xb3\x07\x16\xc2\x62\x30\x15\x3f\xc0\x9a\x79\x60\xcd\x6e\xa5\x17\x64\x16\x00\xe1\x36\x35\x75\x71\x9e\x67\x49\x72\xfe\x2d\x3d\xd4\x52\x38\xf3\xed\x07\xb9\x59\x2b\x19\xb2\x25\xb7\x1f\xcb\xf7\x16\x21\x0b\xf2\x78\xa7\xa1\x07\x65\x3b\x0c\x31\x2d\xa1\x69\x3b\x56\x9c\x8b\x92\xff\xf3\x75\x8c\x7f\xea\x65\x58\x42\x6c\x0b\xcb\xb1\xe9\xe2\xaf\x02\x9f\x40\x05\xe4\x66\x02\xb6\x90\x42\x3e\x15\xe1\x53\x7f\x2f\x5e\x3b\x32\x8a\x44\xeb\xca\xf1\x70\x60\x52\x62\xc0\x92\x6e\x92\x68\xd9\xdc\x25\x82\xbb\x58\xe4\x7a\x96\x77\x26\xc1\x76\x5a\xf9\x96\x8b\xf4\x89\x62\xa3\x6e\x81\x63\x0a\x84\xb8\xc7\xaf\x28\x14\xd1\xe2\xf0\xd2\x8c\x15\x3a\xde\x77\x25\x7c\xa0\x9e\xe5\xf2\xae\xb3\x62\xe9\x83\xe7\xaf\x17\x4b\x66\x69\x71\x98\x08\xd5\x03\x82\xfb\x5d\x44\x0e\xae\xfe\xf3\x07\xec\x49\x41\x92\x72\x10\x48\x4f\xcb\x07\x62\xe8\x3e\x4c\x49\xc9\x42\xdc\x23\xff\xe5\x2b\xca\x69\x3e\x65\xfe\x77\x6e\x3a\x2e\xcc\x69\xff\xa3\x70\xaa\xaa\xdc\x4a\xe2\x13\xa4\x68\x93\x8c\x26\xc9\x06\x92\xc9\x2e\xfc\xa9\xbf\x0e\x1d\xcc\xc8\x76\xc5\x85\xda\x15\x45\x16\xc5\x89\xb1\xa0\x27\x2e\x9c\x5b\x31\x5d\x93\x14\x68\x4a\x2d\x5e\x7a\x73\x70\x6c\xdc\x08\x08\xe4\x41\x75\x50\xd6\x3f\xcc\xf2\xc6\xb4\xe7\x39\xb4\x39\x51\x38\x55\xab\xb2\xc3\x5b\x55\xf6\x91\x9d\x74\xd3\x72\xb6\x36\x2d\x47\x3e\xd3\x89\xa6\x78\x71\xf9\x43\xbb\xe6\xe3\x03\x39\xad\x07\xd7\x31\xc1\x0d\x4d\x76\x96\xcc\x9a\x68\x53\x35\x88\x3d\xc7\x04\x2f\x32\xe3\x47\xf0\x57\x62\x61\x02\xf5\xad\x6a\x03\x0d\xc3\x40\xa1\x5a\x06\xb4\xe0\x07\x54\x98\x05\xed\x52\x84\x1c\x92\x37\x6f\xa4\x90\xf0\xe2\x8a\x7b\xe7\x16\xbf\x6e\x28\x56\x82\x70\xe4\x42\x3a\x23\xcc\xab\x3b\x98\x96\x34\x5f\xc5\xe4\xed\xc2\x47\x6a\x47\x8d\x05\x79\x9d\xe2\x5a\x21\xbd\xf1\x03\xbb\x89\x77\xbc\x45\xf6\x39\x43\x1e\xd5\xf2\x50\xcc\x77\xec\x69\xfe\xd9\x69\x16\x3f\x2b\x4d\xf2\x23\x64\x32\x7a\x58\xe1\xae\x98\x96\x66\x81\x72\x2c\x6b\xe0\xd5\x8f\x2f\xd3\xb5\xe7\xcc\x79\xfd\xa3\x06\x70\x28\x13\x72\x34\xf1\x16\x25\xd5\xa2\x70\x98\xf0\xf8\xa5\x70\x3a\x30\x67\x91\x8a\xae\xd6\x6e\x4c\x7e\xa7\x5d\x0a\x43\x20\xe6\x9d\x0c\x74\x09\x2e\x23\x6d\x56\xcc\x9b\x01\xbf\x28\x09\x77\x63\xb9\x01\xc4\x7e\x00\x1b\xad\x8d\x43\xe7\x16\x09\x21\xfa\x59\xf8\xe0\xcc\x41\xae\x8e\xd8\xf0\x07\x79\xc4\xc1\x2b\xf9\xed\x2a\xfd\xf8\xb4\x40\x6f\x1b\x68\x18\x46\x21\x55\x7e\x33\x8c\xa2\x56\xcc\x5b\xdf\xe2\x34\x56\xac\x31\xbb\xfd\x23\x66\x8f\x05\x31\x77\xd2\xb7\x5e\x95\x58\xb6\xea\x4f\xa4\x0d\x7e\xf4\xa2\x31\xc2\xe6\xa1\xc3\x86\xaa\x21\xa5\xb5\xb7\x19\x80\x51\xb8\x4f\x57\xbd\x90\x13\x8e\x1d\x16\x1b\x89\x12\x93\xc9\xe3\x01\xb3\xae\xa5\xe7\xa4\x41\x4a\x2c\xb1\xa4\x97\x42\x29\xce\x67\x03\x67\x99\x50\x1a\xaa\x66\xb4\x71\x6c\xc5\x1e\xcb\x33\xbb\x7d\x1f\xec\xe4\x78\x43\x18\x80\x84\xf6\xc2\x8f\x7d\x58\x9a\x6e\x4c\x5e\xec\x61\x99\x1c\x23\x33\xf7\xdd\x9f\xc1\xf7\xe4\x98\xcd\x1f\x6f\x92\x63\x12\xca\x83\x9b\x1c\x4b\xbd\x1f\xd2\x8d\x45\x12\xef\x07\x52\x53\x3f\x92\x63\x69\x55\x4a\x11\x79\x44\x1c\x43\x94\x1c\x43\x2c\x2d\xae\xd5\xc9\x99\xcf\x00\xdc\x3c\x16\x98\x60\x5a\x47\x02\x6e\x1d\x71\xa7\x6c\x0f\x68\x16\xdc\x25\xc7\x20\xd1\x03\xef\x93\x63\x78\xa4\x96\x3c\x7a\xac\x69\xf1\xe8\xc1\x23\x99\xcc\x3e\x72\xe1\xc7\x3b\x78\x7c\xb4\xd7\xab\x00\x1e\x65\x0e\x1f\x5e\x3d\x72\x7f\x3c\x3a\x1e\x6d\xdb\x8f\xd2\x22\x92\xef\xc9\xc3\xd5\xc7\x08\xc9\xb2\x47\x99\xbb\xba\x85\xd5\x2d\x89\xdc\x57\xb7\x56\x88\x69\xdb\x59\xc1\xea\x56\xfe\x86\x5e\xf7\x91\xf5\x6a\x22\xcb\x71\xb4\x97\xdc\x54\xd0\x20\xd2\x2c\x37\xa2\x23\x0c\x93\x9c\xe2\x91\x0f\x86\xbc\xa6\xe0\xb6\xe4\xb1\xfa\xbe\xba\xe3\xdd\x20\xef\x23\xa1\x5a\x68\xd4\x8c\x03\xe3\xa0\x91\x18\x47\xc6\x51\xd1\xf8\xd6\x50\xc6\x8a\xa5\xea\xbe\xe2\x58\x36\x85\xbd\x78\xa1\xcc\x1b\x52\x11\x25\xd5\xd6\x23\x8e\x16\x0f\xf7\x68\x94\x1b\xdf\xde\xa4\x0f\x1b\x1b\x77\x93\xb4\x4c\xce\x39\x6d\xf5\xf5\x6b\x1a\xa3\xc9\xeb\xd7\x74\xe2\x99\xbc\xe6\x73\xc7\xe4\xb5\xed\xac\xa4\x21\xd1\x5f\xa2\x1f\x6e\xee\x84\x28\x7f\x8c\x15\x75\x6b\x5a\xf4\xc3\x95\xb3\xf4\x2f\x85\xef\x51\xf2\x9d\x8f\xbb\x92\xec\xe4\xab\xa8\xec\x8d\x76\x7e\x9a\x3b\x1d\x93\x35\x2c\xe3\x95\xfb\xc1\x88\x0e\xe5\x4f\x8c\xe8\x50\xfa\x5a\xbc\x5f\xb9\x3f\x53\xac\x0c\x57\xfb\x2d\xe8\xb5\x00\x49\x0d\xe2\xf2\xc2\xc8\x9a\xc6\x62\x15\xb8\x66\x2c\xbd\x16\x91\xf0\x81\x8d\x9f\x57\x11\xac\x4c\x02\x11\x73\x91\xe5\x22\x9a\xca\x4f\xa3\x72\x33\x47\x9e\xdc\x5b\x4c\x63\x3f\xf0\x5d\x7f\xf1\xc0\x35\x3a\x64\x88\x6b\x5a\x6e\x2a\x93\xb4\x5c\x29\xd7\x58\x47\xb8\x57\xf9\xae\x80\xd8\x5c\x00\x79\x9e\xcb\x2f\x16\xf8\x73\x79\x88\x1c\x81\x1f\xa4\x71\x92\xca\xf3\xa9\x1d\x2b\x40\x61\x79\xc9\xaa\xf9\x01\x84\xc8\xfd\xa5\x86\x5f\x11\x4b\x10\xd8\xa3\x94\x56\xf6\xea\x1a\xcd\x6a\x9e\x51\xb6\xf0\x1e\x40\x1d\x24\x7b\x7e\x3c\x55\x71\xc7\x9b\xb2\x4a\x20\x61\x29\xe0\x16\x83\x54\x85\x54\xcb\x91\x9a\x1f\xe9\x23\x58\xaf\xe9\x9e\x04\x7f\x8a\x62\x90\x87\xd9\x6e\x0c\x8b\x98\xde\xce\x8d\x05\x2c\x90\xf0\xfa\x01\x9e\x00\xc3\xd0\xca\x7e\x5d\xae\xa3\xa0\x56\xe0\xa8\xae\x5d\x72\x27\x13\x6e\x1b\xb2\x35\x2e\xf1\x57\xb1\x1f\x04\xc2\x06\x5e\x5a\x82\xd0\x5f\xf9\xd4\x78\xba\x44\xb8\x35\x49\xb5\xba\x9c\x34\x43\xdb\x73\x1a\x19\xae\xb4\xb2\x16\x58\xab\x9b\x7d\x02\x65\x87\x54\xb7\x3d\x0a\xfd\xf4\xe0\x3d\x92\x9b\x15\x1d\x43\x92\x6f\x20\xf2\x3a\x97\x3b\xc9\xc6\xed\xa8\x30\x36\x8c\xbb\x69\x65\x52\xaa\x15\x1b\xd9\xcd\x9c\x99\x99\x2c\x37\x36\x8c\x03\x2c\x39\x91\xe5\x0f\xb5\xe7\x67\x86\x99\x8b\xff\x9f\x7e\xb5\xfd\xfc\x4f\x8d\x71\x56\xc0\x30\x8e\x6a\x59\xd5\xbc\x43\x69\xa5\xf0\x88\x88\x81\x82\x06\x5a\xf1\xe8\xbb\xef\x78\x05\x2d\xd1\x8a\x25\x8d\x7d\x16\x96\x6f\x6a\xe1\x0b\x2d\xd8\xd7\x66\x6a\xb2\xf9\x93\x26\x6f\x74\xc8\xf3\x06\x1a\x4f\x0a\xa7\x51\xf0\xad\x79\xc2\xba\xdf\x76\x82\xdc\xb7\x63\x89\xc4\x8d\x66\xc5\x1a\xfd\x58\xba\xad\xdd\x67\x4b\xb9\x6b\x1f\xb6\x8a\x92\x28\x59\x26\x2b\x25\x50\x28\xef\x9a\x19\x56\x26\x8d\x82\xe3\xd1\x69\xda\x43\xe2\x78\xf3\x0d\x43\x4d\xe5\x02\xb7\x5a\x90\x82\x0f\xe3\x60\x6a\xbc\xc6\x55\x11\x5f\xa2\x58\xcf\x3b\x1c\xdb\xeb\x6f\xf4\xa7\x7e\x7a\xad\xf0\x21\x8a\xb7\x6d\x00\x53\x01\x78\x5c\x10\xe5\x98\xd7\xa3\xb0\x4e\x2e\xa0\xca\xa2\x16\x67\x56\x06\xe1\xf8\x78\x72\x64\xd5\xc3\x72\xf8\xcc\x65\xb4\xc2\x74\xbc\x76\xe6\x13\x72\xf8\x9c\xfc\xf2\x2e\xf9\xdb\xaf\x45\x7c\x71\xb3\xae\x0c\xdd\xa7\x64\x1f\x30\x7e\xd5\xa8\x4f\x1a\xb4\x5a\xbc\xaa\x27\xaf\xea\xf5\xe4\x4d\xf2\xa6\x9e\xfc\x25\xf9\xcb\x5f\x92\xbf\xd4\x13\xc3\x38\xc4\x3f\x8c\x94\xf0\xaf\x9e\x94\x93\x4a\x52\xa9\x27\xd5\x7a\x52\x4d\x6a\xc9\xfb\xe4\xc3\x87\xe4\xc3\x87\x7a\x82\xff\x93\x7a\xbd\x9e\xe0\xff\xe4\xe3\xc7\x8f\x75\xd2\x73\xa7\xcb\xc7\x04\xff\x13\x80\xa0\x61\x3c\x25\x86\x51\x48\x0c\xe3\x1b\xfe\x61\xbd\xa4\x05\x5f\x67\x55\xf8\x24\xf9\x4f\x5a\x7e\xf6\x34\x31\x19\x57\x4a\xff\x39\x31\x8c\x4f\xf8\xc4\x8f\x1f\x93\xfa\x7f\xe2\xe3\x1a\xc9\x87\xfa\xc7\x64\xfc\xe1\xe3\xa4\x8e\x34\xd5\x21\xb6\x77\x5c\xa9\x96\xde\x7c\xfb\xcb\xe1\x7f\xfe\x33\x99\x24\x6c\xb7\x39\xae\x37\xf0\x25\xfd\x9c\x4c\x30\x63\xfb\x49\xb7\x90\xfe\x37\xc0\x8c\x56\xd2\x96\x52\x51\x54\xa9\x62\x26\xe9\xd2\xfb\x29\x1d\xc5\x7a\x11\x4a\xbf\x8f\x41\x75\x58\xb3\x8d\x8e\xc8\xf9\x6c\x3c\xd3\x13\xe0\x13\xbf\x69\xe4\x3c\x0a\x7f\x8e\x3c\x91\x49\x7a\xe1\x4a\x8c\xcf\xc7\x71\xac\xce\xea\xb8\x0d\x32\x64\x23\xe4\x3c\x52\x9d\xf3\xe7\x29\x2e\x50\x86\xec\x13\x8a\xc8\x22\xbc\x9d\x28\xf0\x3d\x3b\x9a\xc6\x7e\x43\xd1\x60\x4a\xa2\xc7\x5a\xac\x78\x99\x83\x7c\xb2\x64\xa0\x59\x32\xc9\x16\xa0\x0a\x00\xc4\x9f\x4b\xcd\xbd\xb5\x97\xfa\x18\x14\xf6\xa6\x3c\x52\x6a\x13\xa7\xa6\xa2\x2c\xdb\x9c\x4e\xdb\x9d\xc1\x74\x0a\xd3\xa9\xde\x6b\x4f\xbb\x9d\x9e\x4e\x89\xb3\x4e\x97\x23\x9c\x93\x93\x54\x4a\x21\xa5\xe3\xb2\x75\x69\x66\xad\x99\x02\x63\xbd\x56\x02\xe1\xf2\x4d\xcd\x4f\x2d\x36\xd5\xd6\x5b\xd9\xc6\xb3\xd1\xc8\xa0\xe2\x49\xae\x2d\xcf\x86\xf1\x9c\x3a\xe6\x7e\x7a\xc3\x1e\xa9\x28\x6f\xc2\xd5\x59\x7b\xf1\xa9\xca\x51\x8a\x50\xb5\x89\x13\xb5\xe9\xba\x5a\x5a\x2c\x71\x4a\x7b\x73\xd7\xd8\x42\xbe\x8c\x0b\xac\x06\x46\xe9\x62\xae\x54\xce\x7f\xa7\x2c\x35\xa6\x52\x93\x5c\xa9\xf4\xb5\xa9\xc4\x93\x56\xd6\x9e\x73\x77\x53\x2f\xb4\x74\xf7\x83\x56\xd6\x3e\xe6\xee\x4a\x71\xa4\x96\x66\xc8\x37\xcf\x32\x2a\x94\x51\xd1\x72\x8d\xca\x3c\xa4\x4b\x0f\xca\x1f\x2a\xc6\x5d\xe9\x80\xde\xf1\x9b\x11\x1d\x52\x42\x39\x76\xb5\xf7\x71\x43\xda\x9b\x1f\x7f\xa6\x07\x7e\xfc\x99\x0e\xf8\xf1\xb3\xf7\xff\xf1\xb3\xd7\xff\xb1\xf5\xf6\x3f\xb6\x5e\xfe\xc7\xd6\xbb\xff\xd8\xfb\xea\x6f\x8d\xbb\xd2\xdb\x17\x5e\x3e\x20\x0d\xbf\x82\x56\x72\x78\x47\x3c\xcc\x3c\x48\x2b\xc9\xf0\xce\x40\xd2\xaa\x55\x22\xae\x73\x62\x60\x4d\x36\x54\xe6\xcb\xe6\x84\x7f\xa6\x0b\xc3\x3f\xd3\x85\xe1\xcf\xba\x30\xfc\x59\x17\x86\x5b\x5d\x18\x6e\x75\x61\xb8\xd5\x85\xe1\x46\x17\x4e\x54\x3f\xad\xea\x7f\xd0\x27\xff\x5f\x78\x59\x7e\xd5\xf5\x16\x2e\xf1\xa7\xad\x36\x8f\x77\x7d\xef\x6d\xe1\xfe\x92\x59\xed\xac\x3e\x26\x7c\x5b\xd2\xc8\x5c\xe7\x05\xda\x3b\xf8\x61\x72\xa7\xe0\x65\x9c\x25\xcb\xc9\x7b\x92\x2d\xd7\xab\x19\x15\xba\x2b\xe7\x57\xca\x1a\xbc\xa5\x16\x6a\x35\xc3\xb8\x2b\x15\x0f\x0b\x86\x81\xfb\xe6\x2b\xa4\xc0\xa4\x37\x7b\x6d\x9f\x20\x1b\xb7\x2a\xda\xe3\x68\x87\xf8\x6f\x7e\xee\xe4\x05\xb0\xa0\xdf\xc8\x80\x8d\x4d\x8b\x4f\x8d\xd9\x5e\xab\xe2\x59\xcd\x2b\x0b\xcf\x8e\xae\x48\xee\x50\x7b\x75\x9c\xd9\x29\x6f\xd6\x84\x3b\x32\x6d\xb6\xff\xef\xf5\x95\xc3\xda\x5f\x77\x38\x63\xe9\x83\xb3\x60\x18\xaf\x12\xc3\x68\x14\x1b\xb5\x7d\xbe\x09\x67\x0a\xd7\xcd\xa2\xaa\x37\x1c\x64\x2b\x62\x35\xdd\xc1\x66\xc7\xb3\x02\x19\x9e\x1e\x8e\x8f\x4f\x26\x45\x56\x00\x91\x77\xfc\xc2\xf8\xb8\xf2\x77\xba\x55\xf9\xfb\xd6\xbd\x7b\x24\x48\xcf\xcc\xca\x9c\x0c\x54\x0f\xb3\xc4\x66\xb9\x02\xa3\x91\x8c\x65\x29\x8c\x93\x81\x2b\xa9\xa3\x1c\xe5\xb3\x8b\x8d\x42\x6a\xe3\xba\x99\x4f\x15\x4e\x36\x2d\x5c\xa3\x23\xab\x3e\x2b\xbb\x18\x1e\x45\xae\x63\x89\xc2\x49\x71\x43\xf5\x38\x24\xfc\x36\x13\xb7\xde\xb2\x55\x9b\xbd\x48\x01\xef\x3f\x7e\xdb\x43\xb1\x45\x30\x43\xba\x6c\xe6\xfb\xae\x52\xa9\x7d\x88\x85\x92\xad\xd0\x19\xd8\xd2\x0c\x41\xaa\xd7\x4b\xc4\x80\x1c\x90\x25\x19\x0b\x66\xc0\x86\xc2\x65\x3d\xfe\x4c\x38\xc1\xe4\x1c\xc1\xdd\x8b\x7b\x29\xf9\x65\x7d\xce\x54\x77\x8c\x70\x05\x14\xb0\x18\x2b\x45\x90\xce\x2a\x9d\xab\x21\xe9\xa7\x24\xc6\x74\xd2\x1e\xe7\xec\xd0\xd9\x28\xcf\x74\xc1\x89\xe4\xf1\x9a\xef\x2d\xc8\x9c\x08\xa9\x3e\x36\x52\x67\x6e\xdd\x0f\x89\x0a\xf4\x6f\x45\x18\x3a\xb6\x00\xc9\xcf\xef\xd2\x82\x12\x94\x31\x07\x37\x37\x87\x88\xe1\x1c\xd9\x96\x9b\xed\x87\x52\x1a\xd0\xb4\x6e\x4c\xd7\xf5\x2d\x85\x2c\x29\x95\x27\x15\x71\xc8\xda\x6d\xa4\xe9\x46\xc7\x75\x92\x38\x24\xa3\x05\x6a\x6c\x6a\xba\x00\x6b\x2f\x32\xe7\x02\xd6\xfc\x00\x79\x96\xeb\x84\x6c\x6c\xee\x3b\x76\xe6\x6b\x9b\xa9\x46\x82\xda\x96\x14\xb7\xc5\xe6\x7a\x60\xf2\xb9\x1a\xa9\xc0\xcd\x1e\x08\xe6\x51\xde\x51\xd6\xf3\x24\x45\x89\x58\x6b\x6e\x21\x62\xa5\x95\x26\xd1\x21\xbc\xd8\x07\xe4\x56\x49\x09\x45\x9d\xb4\xcc\x1e\x52\xcb\x36\xa5\x4d\xa9\xa8\xe1\x58\x5a\xff\xe3\x00\x63\x99\x20\x91\xb1\x39\xfa\x94\xd4\xe7\x72\xf0\x27\x7b\x70\x10\xdf\x7e\xda\x73\x08\xcc\x08\x87\xd9\x9a\x12\x96\x9f\x24\x54\x43\x71\xcb\x0c\x7e\xc6\xe0\x8f\x92\xc2\x8d\x9f\xcb\x79\xe5\x2c\x27\xf7\x33\x7f\x07\x15\xc5\x38\x20\x7a\x14\x9f\x9c\xa1\x2d\xe0\x1c\x7f\x7a\x92\x9b\xd5\x33\xeb\x51\x9e\xea\xa4\x39\xb4\x83\x84\x52\x35\x0e\x3e\xa5\x55\xec\xfd\xad\x7a\x1d\x02\x90\x77\x73\x2d\x8b\x54\xcb\xfe\xe0\x77\xde\xe4\xb9\xf8\xde\x39\xb2\xea\xe3\xa8\xec\x97\xc3\x3d\xb6\xd1\xd2\xad\x70\xd9\xc3\x42\x6e\xd9\x27\x50\xd5\xdd\x42\x39\xed\x40\x3a\x39\x55\xfd\x32\x21\x8d\x36\x8b\x7c\x05\xec\x3c\x62\x82\x94\xaa\x38\xa2\xf5\xf9\x03\x63\xbe\x97\x58\x9b\xb0\xcc\x5a\x60\x9c\x53\x3c\xfc\x58\x6c\x14\x98\x43\x2c\x36\xf6\x1d\x06\x45\x4b\x33\x0c\x32\x65\xb4\x5a\x2d\xe7\x7e\xbc\x5a\x55\x76\x29\xa9\x16\x5c\xde\xd5\x78\x5e\x49\x30\x25\xfd\x3e\xbc\xaa\x54\x92\x4a\x45\xc2\xfb\xa7\xa7\x03\x1f\x89\x7a\xda\x71\x46\xfe\xe7\x7d\x1e\x28\x74\x09\x02\xbe\x64\xa5\x7d\x3e\xa5\x63\x1d\xd0\x2d\x87\xec\x2e\xcb\xc4\x16\x52\xc3\x5f\xc6\x94\xe3\x03\xe9\xf3\x48\x7b\x7e\x2e\x5b\xa9\x1d\xfb\xcf\x1d\x87\x3b\xb5\xea\xf8\x9b\x11\xd5\x26\xd5\x14\x6e\x66\xdb\x79\x34\x9f\x8b\x29\x93\xc9\x9f\xfd\x78\x97\x82\x90\xc7\xcf\xb8\x4b\x35\x0c\xe3\xae\x78\xa8\x6d\x77\xd5\x64\xaf\xd7\x60\x26\xc6\xa4\x2f\x6d\x26\xc9\x32\x2f\xda\x3b\x0e\x18\xf2\x7c\x5e\xde\xc9\xb7\xdc\xe5\x24\xaa\x07\x2d\x52\x82\xf0\x41\xf7\xbb\xfd\x46\x06\xc1\x26\x95\xc3\x52\xb1\xa4\x46\xda\x96\xa7\xee\x6d\x97\xeb\x52\x5f\x92\x3c\x7b\xbf\xe4\xdb\x7c\x74\x99\xa7\x1d\xf6\x43\x0f\xe5\x8f\xc6\x19\x4d\xe3\x98\x69\x8f\x9f\x7b\xf6\x7e\xf9\x4c\x2a\x0a\x5e\x84\x4e\x3c\x79\x01\x33\xb1\xb2\x0d\x98\x88\x5b\x75\x65\x1d\x3a\x60\x2d\x1d\xd7\xae\x44\xa1\x85\x5b\xb1\x27\xac\x98\xe2\x72\x17\xa6\xf8\xdc\xf7\x54\x24\x5c\x55\xa4\xee\xd8\x3c\x34\x57\xa2\x42\xfa\x52\xa4\x20\xc7\x69\x2c\xe5\xac\x16\x74\x25\x25\x2d\x8a\xf1\xee\x49\xd1\xc0\x5d\x2f\x1c\x8f\xec\x0b\x23\x08\x09\x56\x89\x5a\x11\x99\x9e\x3d\xf3\xef\x81\x45\x06\x54\x94\xf0\x2f\x31\x96\xd3\x4f\x7f\x19\x37\x64\x03\x2e\x4d\xfb\x46\xfa\x7a\x5e\xac\xf4\x32\x19\xd7\xe6\x27\x3d\xba\x97\xc8\x51\x1d\x59\xd9\xe9\xc9\xb0\x4e\xa2\xcb\x66\xe5\xff\x1a\x53\xe3\xc8\xa8\x4c\x4a\x46\x74\x58\xcb\x5c\xc4\x6b\xef\x95\xe6\xe8\x7e\x38\x37\x63\x58\xcd\xb7\xac\xbc\x07\x85\x47\x29\xf0\x8e\x8d\x3b\xac\xbe\x90\x83\x2c\xdb\x15\x1b\x71\x21\x85\x15\xbc\x85\xf1\x9b\x5b\x80\x79\x94\x0d\x87\xbd\x6c\x41\xe7\xbb\xfb\xfc\x8b\x33\x00\x0b\x93\xad\xe4\x93\x64\x73\x32\xbf\x62\xdd\x79\xd3\x8b\x49\x7a\xb3\xc7\xa3\xc8\xb8\x6e\x54\x93\xb7\xc6\x81\x5a\x43\xb2\x87\xf0\xa6\xef\x87\x15\x47\x8a\xaa\x72\x58\xed\x95\x4c\x34\x96\x16\x4b\xd1\xb9\xaa\xc6\xd1\x1f\x14\x4d\xcf\x83\x8d\x31\x77\x03\x2d\x66\x39\x94\x77\x55\x30\x88\xc4\xda\xf6\xa9\x68\xad\x50\x2b\x36\xb2\x6f\x6c\x4c\x8d\x8a\x51\x32\x0a\x46\x51\x7b\x7b\xa4\xba\x55\xfb\x54\xa0\x89\x80\xab\x6c\x12\x98\x0b\x51\x4c\xf1\x49\xe9\xb8\xfb\xa6\xa6\xa5\xf7\x01\xef\x6b\x4a\x63\x9c\x8e\xe2\x9f\xde\x4f\x88\x2f\xe3\xbd\x6a\x47\x56\x67\xdc\xa5\x5f\x2f\xaa\x96\xf3\xc3\x40\xae\x16\x5b\xbb\x30\x7e\xc2\x4c\xb7\x39\x7d\x27\x79\xc0\x1d\x67\x7b\x5b\x4a\xce\x64\x46\x86\xf2\x43\x84\x3f\x59\x62\xec\x3f\x4b\xf3\x33\xcd\xe8\x3a\x0b\x8f\x0e\x69\x95\x42\x16\xa9\x55\xf9\xf6\xc3\x1e\x3e\x80\xdc\xa8\xb0\x40\x9c\xc4\xe8\xdb\x5c\xc0\x6c\xbd\xd8\xe0\x01\xe2\x3c\x12\x52\x76\xd4\xcb\x27\xbb\x52\xd5\x35\x63\x01\x32\x46\x20\x47\xfc\xcb\xeb\x54\x1a\x49\x27\x27\xc7\x39\x7e\x60\x25\xed\x07\xa5\x25\x08\xf1\x05\x48\xde\x6f\x73\x07\xb7\x52\x83\x0d\xd9\x03\xf3\xf1\x41\x1d\x11\x3b\xf7\x8e\x97\x1d\x14\xdf\x81\xe7\xf3\x96\xb4\xc5\x21\xb0\x15\x8f\xdc\xcc\x5f\xe4\x14\x82\x75\x28\xa4\xa8\x8e\x79\x38\x52\x4b\x94\x18\x52\x19\x53\x90\x1d\x15\x4b\x9e\x00\x29\xf5\x65\xe8\x7b\x74\x32\xac\x4e\x63\x25\xa7\x90\x99\xc8\x3c\x04\x02\xa9\x0e\xbc\x3a\xf6\xce\xd9\x72\x4c\xf6\x91\x4a\x08\xb8\x8f\x49\xa0\xa3\xe5\x9d\xf3\x63\x98\x4e\x17\xdc\x42\xec\xd7\xe9\x34\x5e\x92\x94\x72\x3a\x8d\x43\xd3\x89\x23\x98\x4e\xdb\xcd\x11\x97\xd3\xfb\x67\x74\x1d\x75\x2e\xf5\x34\x32\x1c\x35\x2f\xaf\x28\xf5\x59\xef\xb5\xfb\x03\x19\x1d\x0c\x3b\xfd\xde\x74\xba\x81\xf7\x8d\x8c\xa5\x25\x79\x41\x0b\xb9\x40\x8b\x19\x3d\x66\x2b\x19\x40\x9e\xe2\x39\xde\x91\xb5\xda\x24\x27\xb5\x09\x38\x0d\x8e\xbc\xed\x70\xbe\x43\x35\x10\x27\x45\x31\xc9\x9d\xf1\x4f\xd7\x34\x86\xd7\xf4\xd8\x3c\xcb\xc5\x85\xee\xe8\xb1\x77\x6a\x7b\xda\x3e\x51\x47\xbe\x25\xe5\x58\xb4\xc2\x71\x32\x3e\x41\x96\xdf\x20\xe4\xe4\x22\x89\x7f\xb6\x32\x13\xc3\xb0\x55\x94\xaf\xa5\x86\x61\xd8\x45\x12\x09\x1d\x8f\x67\xa7\x13\xc6\xc2\x22\xb9\x4c\x01\x4b\x48\x74\xaa\x2c\x8a\x3f\x9d\xbe\x74\xa7\x48\x42\xdc\xe3\xf1\xfd\xd7\x89\x56\xf2\xca\x11\x56\xa2\xce\x16\xb4\x92\x59\xd2\x70\x75\xab\x23\xd5\x64\x12\x8d\x4e\x0a\x32\x89\x56\x8a\x4a\x5a\x11\xdb\x56\x32\x8c\x23\xbc\x67\x96\xb4\x84\xa2\x21\xde\x6a\x14\xb5\xb2\x85\xef\x82\xf5\x16\x48\x24\x4b\x37\x3d\x2e\xd5\xa0\x58\x71\x1c\x5c\x6d\x3e\xc8\xa6\x07\x85\x25\x2d\xa1\x53\xdc\x44\x2b\xf9\x94\x1f\x50\xbe\x45\x39\x2e\xe5\xac\xea\x9a\x61\x18\x46\x61\xfc\xd6\xd0\x0c\xa3\x81\x71\x73\x36\xf7\xc2\xf8\x76\x92\xac\xf1\x05\xe5\x6e\xf5\xf4\xeb\x73\xa2\x60\x58\x7f\x79\x4e\xee\xf3\xb7\xde\x3d\x27\xd7\xf9\xf4\xbf\x3d\x17\x93\xbf\xc8\xc5\xdf\x30\xec\xc9\xd3\xbb\xf2\xf3\x7b\x4d\x1d\x22\x6e\xca\x2e\x89\x88\x2c\x74\x93\x75\x72\x9d\x74\xd7\x49\xf7\x3a\x59\x77\x93\xeb\x6e\x2a\xbb\x9c\xed\x55\x32\xd2\x4a\x41\x49\x2b\x8c\xe7\x67\x93\xa4\x9b\x38\x09\x46\x9c\xa4\xeb\x14\x1b\x09\xd7\x97\xcb\x52\xa2\xcd\xc5\x36\x77\xa8\xbd\x2d\x68\xa5\x55\x49\x4b\x8e\x8a\x92\xf0\xc1\x5d\xed\x48\x23\x03\xf6\x59\x6d\xc5\x3f\x9b\xee\x83\xd7\xb4\x6a\xe3\xf9\x84\xf8\xca\xb1\x75\x67\x4f\x1a\x6f\x9f\xcb\xcb\x9d\x72\xe3\xf0\xc7\x44\xb2\xce\xb2\x14\x9f\xaf\xdd\xee\x34\x44\x9a\x31\x71\x29\xed\xb9\xfc\xb0\x53\xd7\xbd\x96\x8d\x35\xc3\x88\x0c\xc3\x33\x8c\x70\x72\x98\xab\xf8\xe4\xf8\xb9\x3c\xdc\xf9\xdd\x0f\xcd\x30\x9e\xa8\x0d\x86\xf1\x8c\xfc\x6a\x6b\xeb\x20\xf7\xdb\xeb\x57\x5a\xaa\x92\xf3\xd7\xe7\xf2\xfd\xb6\x03\xba\x02\x32\x51\xc5\x8d\x32\x7a\x7d\x7b\xb3\xd5\x3e\xa5\x82\xcc\x71\x8a\xaa\x60\xd3\xa1\x7f\x4f\x61\x0c\x54\x0d\xa3\x44\x87\xda\x25\xc3\xd8\x44\x75\xe3\xd6\x17\x15\xb1\xe3\xf2\x91\xa3\x62\x16\x36\x59\xc5\x5e\xf9\xa1\x3c\x2d\x2f\xcb\xb7\xe5\x61\x79\x56\x5e\x97\x17\xe5\x56\xf9\xbe\xac\xbf\xb8\xed\xae\xcc\xf0\xc6\xf6\xef\xfe\x08\xe5\x6d\x65\x6b\x65\x6d\x25\x4b\x62\x84\x61\x76\x25\x29\x20\x79\x1e\xc9\xe9\x7e\x7b\x4d\x48\xc4\x99\xff\x0b\xcc\x3b\xc2\xc5\xc4\x1b\xd7\x2b\x34\xd8\x0f\x34\x65\xdd\x96\x9e\xcc\x2b\xb5\x9c\x0d\xcd\x27\xd7\x15\x7c\x44\xf2\xad\x30\x3e\x2c\x55\x26\x49\x41\x2e\x04\x45\xd6\x44\xc8\xb0\xfd\x7c\x05\xc0\x7b\x38\xc5\xf9\x76\x54\x6a\xc8\x98\x2a\x23\x56\xc1\xd2\x8c\x9c\x28\x77\xcc\x7a\x48\x8d\x52\x92\x97\xe9\x51\xa9\x31\xd5\x52\xaf\x7a\xf8\x1b\x52\x23\xe5\xe7\x7f\x64\xcd\x87\x0d\x97\x1e\xa4\xdc\xa1\x5e\xfa\x9f\xff\xfc\xe7\xdd\x61\x74\x78\x40\x65\x30\x85\x71\x69\x85\x77\x54\x6a\x28\x8b\xbc\x6f\x05\xc0\x95\xe2\xff\x97\x1b\x31\xca\x8b\x9f\xf6\x6d\x5c\x31\x8c\xc3\xc9\xd3\x2f\xe5\xcd\xce\x33\x8c\x31\xb5\x14\xd7\xd3\x82\x61\x8c\x27\x47\x87\x8d\xb1\x61\x14\x25\x0e\xf1\x06\x8d\x9e\x9b\x35\x1b\x22\x08\xb1\x65\x26\xa6\x74\x1e\xbc\x1b\x59\x76\xb2\x21\x8a\xc9\x31\x90\xbb\x07\xd9\x54\x78\xa7\x6e\xc9\xf0\xe4\x3c\xed\x7c\x33\xc6\xec\x0a\xc0\x98\xd4\xb6\x79\x89\xbc\x66\x59\x46\x35\xef\x3e\x56\xb5\xb0\x5a\x23\x53\x3c\x46\x8d\xa0\x62\xcf\x3f\x23\x26\xc9\x77\xe5\x4b\x2a\x9c\xe9\xc1\x1b\x29\xab\xe4\x4e\xdf\x76\x0c\x67\x89\x9e\x71\x22\x22\xb3\x88\xfc\xd9\x3d\x00\x94\xdf\x3f\x7c\xfb\x56\xb2\x87\x6f\xdf\xca\xf3\xaf\x30\x0f\x55\xc9\x5f\x32\x7c\x9b\x5b\x49\x0d\xc3\x4b\x4b\xbe\x4d\xcf\xf1\xb2\x6c\x2d\x5f\x65\xe6\x64\x65\xa2\x4e\xd7\x72\x38\x98\x5b\x37\xb5\xed\xc7\xec\xfe\x7a\xeb\x81\xf9\x02\x93\xe7\xf7\xb1\xc4\x17\xe9\x21\xcd\x4e\x07\x6c\x66\x9e\x16\x57\x36\x10\xb9\xd3\xda\x9c\x92\xc4\xcf\x48\x6d\xe5\x41\x68\x83\xa4\x66\xe5\x55\xee\xf6\x5d\x92\x9a\xb4\x27\xd4\x47\xa0\xaf\x12\x0a\x26\x31\x15\xc9\xba\x4d\x97\x6e\x92\xa1\x6b\x46\x55\xc5\x97\x20\x12\x73\x47\x69\x21\x2f\x4e\x48\xcf\x99\xf3\x74\x3e\xe9\xed\xb3\x3d\xfe\x42\xc4\x39\x67\x3c\x8a\x82\x57\xf8\x16\xe9\x61\x7d\x60\x86\x7c\xbe\x2c\x29\x68\x49\x08\xe7\x49\x4b\xd6\x27\x65\x77\xd2\xc4\x0d\xb4\xcd\x58\x90\x19\x4d\x7b\xcd\xfa\x79\x99\xf5\x5b\x07\x69\x3b\x2c\x43\x11\x7c\x00\x39\x94\xbd\x34\x03\xb8\xa4\xde\xee\x61\xb7\xf4\x59\x62\xa0\x0c\x84\x94\xdf\x66\x11\x93\x4a\x1c\xc3\x1a\x6c\xf8\xb5\x3e\x65\xd8\x24\xe9\x83\xd9\xf1\x6e\x60\xc8\xb0\x40\x64\xb4\x73\x1d\x3a\x2c\x58\x51\x24\xab\x17\x13\x1c\x99\x32\xfd\x56\xa6\xe0\x3f\xd6\x22\x7c\x18\x4a\x76\x70\x33\xd5\x74\x5d\xd0\x25\x5c\x9c\xbc\x12\xfc\x71\xce\xa5\x96\x54\x8c\x4d\x51\x4d\x37\x80\x4d\x71\x4f\x48\x37\x28\x85\xc5\x9f\x9d\xb7\xee\xb9\xbd\xa3\x5b\xfb\x27\xdd\x2e\xfd\x19\x87\x4b\x7b\xdd\x3c\x7d\x92\x67\x03\x13\xa9\x5c\xae\xd5\x3f\xfe\x44\xb3\xd4\x16\x6e\xb0\x74\xf6\x1e\xfc\xf0\x88\x8b\x40\xfd\x08\x08\xa4\x21\x5a\xba\xc0\xa8\x5f\xd2\x98\x21\xd5\xf7\xc4\xa9\xb1\xf2\xd5\x70\x66\x8f\x41\x64\x56\x31\x13\x2e\xac\x23\x11\x41\x68\x3a\xb8\x8e\xf9\x38\x0a\x7d\x42\x92\xa1\x1e\x88\xcc\xb9\x20\xfd\x66\xb2\xc9\xc8\x40\x91\xc3\x94\x49\x94\x83\x96\x50\x07\xd9\xcb\x96\x3c\x8a\x45\x56\x4f\x4e\x5d\x05\x9b\x9a\x32\x9c\xd1\x32\x24\x7e\x7c\x6e\x12\x80\x22\x01\x50\x28\x1e\xc8\x21\x54\x29\x1e\xd4\xc8\x98\x0a\x3b\x15\x0d\x21\xd3\x6a\x7a\x76\x8e\x31\x55\x93\xc9\xf6\xe1\xde\x0f\x59\xf5\x94\x78\x67\x4f\x98\x99\xc9\x0b\xe9\x5c\xd9\xce\x2d\x35\x80\xb4\xba\xa5\xc0\x0c\xf9\x4a\xa9\xb9\x25\x1f\x4f\x26\x29\xf2\xa8\x9b\x4c\x3c\x58\x83\x49\xd9\x10\xf9\xa1\xd4\x6f\x55\x88\x29\x0a\xe4\x43\x82\x60\x9a\x36\xa4\x5a\x50\x0a\x67\x9c\x5c\x8a\xab\xd5\xcc\x73\xc8\x19\x1b\x2f\x7e\x84\x52\x47\x4e\xa6\x08\x79\x9f\x59\x53\xec\x69\x7f\x4e\xcb\x50\x60\x46\x16\xc1\xd2\xe2\xc2\x98\x2e\x2f\x79\xe3\x26\x52\xc3\x52\xce\xd5\x96\xc2\xdb\xc6\xc2\x4d\x5d\xbb\x61\x91\x68\x29\xa8\xef\x18\x99\x1e\x97\x32\xdf\x5d\x93\x95\x0a\x19\xe8\x10\x32\x5c\xba\x2a\x99\xb4\x97\xed\xe8\xbb\x11\x82\x36\xa1\x06\xc6\xf2\xe3\x6c\xe1\x58\x5b\x41\x40\xaf\x65\x91\xad\x93\xb7\x5e\xc9\xfa\xc4\x7d\x20\x42\x87\x5a\xe6\x42\xe0\x9a\xf1\xdc\x0f\x57\xf9\xa3\x93\xb5\x97\xb6\x5d\xd8\xdb\x6b\xeb\xdc\x0c\x4f\xfe\x86\xc3\xf8\xce\x0c\xed\x14\x7e\x77\x29\x5c\xc2\xb6\xce\xad\xb1\x38\x44\xc3\x75\x10\x83\xe3\xb3\x4c\x42\x3a\xb7\x62\xf0\x45\x3f\xfd\x0c\xbe\xdc\x0f\x3c\x9f\xe4\x9a\x74\x3c\x0c\xbe\x6b\xcf\x03\x46\xfa\xcd\xd0\x85\x23\xf3\x56\xa8\x79\x16\x41\xe4\xcf\x63\x66\xc7\xf3\xd6\x31\x71\xe8\x58\xd2\x01\xd6\xc2\xc3\x01\x6b\x86\x66\xb8\x88\x40\x2b\x87\xff\xb2\x07\x04\x33\xcf\x3c\x28\xff\x6b\x4f\x06\xab\x19\x19\xcf\xe9\x61\xaa\x71\xa8\xf2\xf0\xf7\x48\x1e\xfe\x77\xf9\x4e\xc8\x0b\x16\x08\x08\x81\xd6\x84\x17\xc4\x0c\xa9\xc4\x70\x47\x81\x42\xda\x09\xe6\x46\x6d\x6e\x36\xbd\xe0\x3f\x61\xdf\xcf\x92\x93\xe3\xdc\x2f\x31\xf5\x5f\xf0\xb2\x50\x36\x53\x84\x89\xb0\xf8\xbc\x99\xda\x15\xe9\xd9\x41\xa8\x95\x35\x7b\x4e\x5a\xde\x66\xc4\x21\xf9\xde\xd0\xe6\xa1\x10\x69\xc2\x35\x1f\xcd\x70\x8d\xf7\x5d\xfa\x85\x3b\x5f\xe5\x14\xc2\x49\x1a\xa8\x25\xc6\xc1\xf8\xbc\xf2\x7f\x17\x95\xc7\x49\x62\x54\x8d\xc3\xe4\x83\x51\x4d\x8c\x24\xf5\x9c\x40\x70\xfe\x7e\x39\xda\x6c\xd3\x4b\x7b\x84\x33\xdf\xef\xa6\x25\x6b\x7b\x80\x7b\x79\x8e\x03\xe3\x01\x85\xa3\x4c\x8d\xaa\x6f\x9f\x3e\x41\xc9\xa8\x18\x76\xa9\x6c\xd8\x25\x28\x19\xa5\x34\xfa\xe9\xd3\x41\x55\xd1\xe7\x87\xf8\x0f\xb2\x7b\x9c\x61\x1c\x66\x25\x2a\xf8\x2f\x5f\xa2\xc2\xff\x0e\xaa\x99\x76\x90\xb2\x5f\x91\xcf\xee\xe0\x96\x51\x03\x49\xac\x73\x45\x75\x64\x6a\x36\x72\xbe\x19\x95\xa7\x5f\xb6\xb3\x0e\x9f\x7e\x79\x86\xad\xbc\xd2\x76\x31\xe3\xf0\xe9\xaf\xcf\x72\x7a\x3c\xfd\xf5\x39\xd7\x12\xd3\xb6\x9d\x3c\xea\xc7\x16\xc3\x46\x67\xbb\xb9\xdb\x95\xcd\xdb\xdb\xbf\x56\x8c\xff\x4f\xb6\xf3\xef\xa6\xb7\xf0\xf7\x32\x1a\xd8\xce\x24\xa5\x0d\x6a\x0d\x72\x52\x88\x2b\x16\x31\x19\x38\x04\x04\x12\xd3\x91\xd4\x49\x20\xff\x76\xb8\x47\xd1\xd2\x1b\xcd\x42\x78\x10\x91\xe7\x4b\x70\xb3\x85\x23\xdd\x04\x3a\x1e\xe1\x34\x7a\xb6\xbf\xa2\x15\x8a\xfd\xe2\x11\xd2\x94\xf3\x28\x24\x90\xab\x88\x2c\x33\x10\xb9\xda\xc0\x15\xde\x22\x5e\x4e\x9d\x08\xdc\xef\xeb\x28\x86\x90\x42\x6b\x1d\xc3\x3a\xa4\xd5\x6e\xee\xdc\x4f\xcd\x55\x20\xc2\xc8\x44\xea\x87\xd4\xf3\xf9\xe8\x52\xd6\x69\x99\x01\x9b\xa3\x06\x4c\xb7\xda\xce\xad\x43\xd0\xdb\x33\x42\xba\x63\xd8\x2a\x82\xd8\x58\x7b\xa4\x75\x21\x6c\x99\x0c\x5d\xe1\x11\x08\x3b\xbe\x80\x34\xf4\xa3\x87\x52\x37\x00\x76\x02\xe3\x7a\xf2\x92\xa5\xc0\x68\x1d\x4f\xf0\x91\x56\x04\xa4\x4a\xc4\xb6\x25\xb6\x63\xc5\x84\x2c\xad\x22\xf2\x7c\xdd\x56\x9b\xf1\xd4\x99\x4f\x3d\xdf\x13\x10\xb8\x6b\x69\x0e\xeb\xfa\x77\xe4\xcb\xcf\xf1\xe8\x04\x57\x84\xe9\xc6\xbd\xf1\x21\xa6\xcb\x78\xe5\xc2\x3a\xc0\xdd\x87\xfb\x0b\x82\xa5\xef\x89\x77\xb4\xdf\x39\xdc\xd2\xbb\xd0\x0c\xd8\xd4\xd1\xb4\xed\xc8\x35\xa3\xa5\xc0\xf6\xad\x17\x6c\xc1\x8b\x1d\xc4\xbd\xff\x3d\x62\x50\xcd\xa9\xfc\x18\x4e\xe8\xc8\x6e\x60\x20\x63\x73\x2e\x28\x88\xc4\x8f\xb4\x19\xd6\xd2\x0c\xe5\xde\x86\xed\x5e\x7b\x69\x94\x22\xf4\xd8\x75\x6c\xd1\xf3\x1f\x7d\x6f\xcb\x61\x48\x33\xef\xaa\x36\x5b\x2a\xbe\x3b\xde\x77\x33\x5d\xb0\xd2\x43\x16\xa9\xbc\x50\x35\x9e\xde\xe0\x26\xc0\x33\xd8\x88\x0e\xdf\x3c\xe3\xae\x45\x99\xc2\xb3\x37\xf2\xd5\xce\xf6\xf4\xba\x5a\xae\xbe\xc6\xf4\xa6\x26\xb6\x14\x04\xe1\xaf\x69\x72\x62\x55\x6a\x81\x52\x10\x97\x24\x74\xca\x66\x82\xac\x1f\xb2\x47\x31\x0a\xb1\xaa\x33\x36\x17\xe0\xcc\xd9\x28\xde\x66\x75\x06\x95\x48\xd5\x1b\xd8\x3e\x9a\x4d\x19\x19\xbc\x10\x9c\xb9\xe7\xc7\x6c\x6f\x4d\x65\xd2\xd4\x9d\x63\xc7\x4b\x62\xae\x72\xbe\x62\x58\x31\x9f\xb4\x11\x48\xbf\x1d\xb3\xd3\x44\x28\xa4\x6a\xd1\x3c\x57\x1f\x47\xa3\xc8\x01\xcf\xbf\x63\x32\x91\x6d\xe4\xd7\xa1\xab\x30\x78\xb1\x29\x1c\x63\xf9\xd2\x8c\x14\xbc\x84\x67\xcb\x08\x41\xa8\xad\x63\x5f\x8e\x0f\xe1\xd9\xb9\x94\x15\x85\xf3\x69\xec\xdf\x08\x4f\xc2\xf8\x2a\x75\x0e\x7a\x96\xe2\x29\x43\xd3\x8b\xb8\x5e\x8e\xaa\xca\x39\x45\xd8\x86\x54\x72\x1a\x84\x62\xee\xdc\x53\x0e\x49\xc8\x55\x06\xcf\x11\xc6\x54\x5c\x87\xa1\xf0\xe2\x0c\x16\x32\x8d\xe0\x5d\xf3\xd6\x74\xdc\x0d\xd8\xc8\x68\xef\xaf\xa6\x33\xc7\x76\xe8\x4e\x9a\xe3\x78\x73\x7f\x37\x87\xd7\x86\x74\x7c\x0b\xcf\xde\x33\xd6\x55\x6e\x6a\x5a\x8c\x83\x1e\x73\xd3\x78\xbe\x0d\x69\xe6\xad\x08\x67\x66\xec\xac\xb4\xe7\x2d\x19\xf7\x0d\xe1\xbf\x91\x0b\x61\x8d\xdd\x70\x31\x9c\x98\xda\x3d\xf6\xd8\x14\x30\x7d\x26\x11\xcb\x78\x3c\xc7\x3f\x3b\x76\xf2\xa2\x3f\xd8\xb9\x67\x8e\x67\x6b\x65\x8d\xa6\xef\xa6\x9f\x8c\x4e\x0f\x9a\xd0\x6c\x36\x9b\xd0\x3c\x1b\xb6\x4f\xa1\x79\xd5\x85\x56\xb3\x09\xad\x76\x6f\xf8\x9b\xfe\x3b\xb4\xda\x43\x68\xe9\x83\x11\xb4\xc8\xa9\x60\xfb\xa2\xd5\x69\x43\xbb\xfb\x19\xda\x9c\xe6\x52\xed\x21\x5c\x74\xae\xa0\x73\x35\xd4\x5b\x98\xa6\xbf\xaf\xe4\xd0\xf0\xf2\x2b\xf4\x9a\x57\xa3\x01\xf4\x86\xd0\x1b\xea\x2d\x0a\x7e\xe1\xf0\xaa\x39\x68\x5e\x02\xde\x1c\x0c\x86\x9d\x73\x18\x5c\x01\x5e\x86\xfd\x26\x0c\x07\x9f\x61\x38\xbc\x38\xbb\x82\x51\x13\x46\x58\xdf\xa8\x3b\x6c\xc2\x08\xef\x8f\xbe\x8e\x52\xff\x17\xdb\xf0\xbc\x5b\x48\xb0\xc6\x41\x61\x34\xea\x26\xe7\x7a\x4f\x1f\x34\x47\x7a\xd2\xe9\xb5\xba\xd7\x6d\x3d\xe9\x0f\x3a\xe7\x9d\x5e\x31\xf3\x55\x94\xf7\x6d\xc7\x8a\xaa\x4a\x6a\x72\x52\xfe\xf5\xb9\x56\x7c\xfa\xfb\xf3\x4e\x6e\x52\x2b\x16\x93\xbd\x85\xff\xf6\x5c\xa8\xed\x94\x2e\x14\xde\xfd\x75\x7c\x5c\xf9\xeb\x24\x79\x37\x3e\xae\xfc\x3a\x31\x0c\x3b\x39\x31\x0c\x1b\xaf\x24\x11\x23\xb9\x57\xc1\x30\x8e\xfe\x54\xc1\xe2\xd3\x2f\xcf\xc5\x97\x9b\xf0\xd7\xe7\x42\x61\xb7\x11\x45\x02\x0c\x2e\x26\xb5\xff\xdd\xc6\xfc\xfa\x62\x63\xf0\x67\xfb\x6e\x35\xfe\x47\x5a\xf8\x93\x26\xfe\xf2\x62\x13\x7f\x7d\xa1\x89\x4f\xc7\xe5\x77\xcf\xff\xdb\xcd\x7c\xf7\x62\x33\xff\xfa\x72\x33\x7f\xf9\x5f\x6f\xe6\xc9\x8b\xcd\xfc\xdb\xcb\xcd\xfc\xf5\x7f\xb4\x99\xb5\x97\x5a\xf4\xf7\x97\x5b\xf4\xd7\xff\xc1\x16\x15\x0d\x63\xb6\xcf\xb3\x66\x5a\x4d\x81\xeb\x49\x4e\x9e\x8e\xcb\x27\xcf\xac\x00\x9f\x8b\x1e\x15\x9f\x7e\x29\xff\xf2\xfc\x67\x8b\xf3\xd3\xf2\xea\x8d\x3d\xe9\xd9\x78\x66\xd8\xa5\xb1\xbd\xbc\x5b\x4d\x1a\xd5\xe7\xe2\xcb\xbb\x8c\x6f\xdd\x88\x10\x89\xfe\x3f\xd8\x6c\xb8\x60\x8e\x5b\xd5\x48\x6f\x7a\x65\x3a\xca\xdb\xbd\xb8\x27\x0c\x15\xe1\xdd\x82\x19\x2e\xd8\xc4\xdc\xf7\x08\xf5\x96\x4c\xa5\x23\x67\xe1\x99\x8a\x5c\xbc\xd8\x71\x6d\xa4\x34\x42\xc3\xb5\x07\xd6\x0a\x29\xb4\x38\x7c\x60\x20\xb7\x5b\xdf\x5d\x33\x99\x0c\x96\x1f\x3c\x20\xf5\x7c\x63\x3b\xa1\x3c\x9f\x5c\x0a\xd3\x8d\x97\x74\x9a\x43\x10\x26\x6e\xde\x48\xb9\x3a\xfe\x66\x18\x13\xc3\x63\x53\x7d\xf2\x66\xff\xfc\x3c\x29\x3b\x35\xed\x43\x55\x7b\xb9\x53\xf6\xea\xd3\x11\xc9\xfa\x8d\x8c\x9e\x43\xb1\x32\x66\xd5\x72\xf5\x20\x3d\x90\x49\x45\x39\x39\x57\xae\x64\x42\xcd\x6c\xdb\xd1\xb4\x31\xc9\x69\x7e\x1d\xbc\xfe\xf4\x9f\x47\x8d\xc9\x61\xa1\x96\x18\x46\x54\xa2\x17\x29\xbe\xe8\x87\xd4\x8c\xb5\xb2\x66\xad\xec\xb4\xf7\x53\x08\xcb\x2d\xe7\x58\x44\x09\xd2\xf9\x26\x91\xae\xe4\x9e\x9e\x8e\xab\xe8\xf8\xd5\xf3\x63\x86\x10\x62\x8d\x5d\x57\xdc\x0a\x37\xb5\xc1\x17\x3f\xd6\xe0\x89\x1f\xe0\x46\xc8\xe2\xfd\x80\x45\x1c\xc2\x42\xfc\xd8\x94\x32\x78\xe0\xad\x5d\x70\x83\xf8\x17\x0c\xde\x61\x70\x02\x16\x1d\xc3\xac\x7e\xc5\xe0\x17\x0c\xde\x61\x70\x02\xe6\xfa\x5e\xba\xa6\xb7\x6c\xc0\xcf\x45\xbe\xe5\x23\x11\xf3\x89\x9b\xa2\xc8\xe8\xb8\x39\x30\xc9\x57\x09\x7e\x5c\xe9\x72\x81\x3d\x41\x98\xb1\x34\xf6\x4f\x85\x42\x96\x1b\x91\xcf\xfc\xa5\x95\xfa\x88\x5f\xde\xd8\xd1\x0d\x5e\xbc\x78\x1e\x01\x15\x58\xd9\x04\x92\x10\xb2\x47\x1a\x42\xb3\x62\x64\x2a\xc2\xe1\x91\xec\x5f\x08\xb6\x13\xdd\x50\x09\x8e\x04\x0f\x60\xfb\xd1\x8d\x78\x00\x11\x92\x2b\xbd\x88\xdd\x5f\x60\x40\xde\x0f\x98\x9f\x64\xb7\x32\x8b\xd0\x9c\xc7\xe6\xcc\x65\x37\xf9\x37\xe2\x61\x26\xc7\xe3\xca\x86\x15\x0d\x4f\x72\xdb\xb3\xf2\x43\xc1\x68\x44\x84\x52\xc0\x2f\x2a\x9d\xbd\x93\xdf\x77\xf6\xf8\x1e\xfa\xab\x18\x08\x5f\xc8\xf2\x6f\x09\x47\x68\x95\xc3\x12\x92\x20\x42\x74\xf4\x0e\xe1\x0a\x2b\xa7\x9e\x8d\x58\xc3\x87\x04\x44\x04\xac\x23\xc9\xdb\x98\x10\xa9\x85\xc4\x47\xc0\xfa\x6e\x45\x88\x6c\xe5\xad\xef\x42\xe0\x78\x0b\xf0\x44\x0c\x4e\xc0\x08\xf0\x10\x9b\xd1\x0d\x21\xa2\xdc\x53\x1f\x84\xc2\x03\x5b\xb8\x2f\xe0\x2a\x57\xdf\xbc\x19\x7f\x83\x49\x42\x61\xa9\xf1\x26\x79\xc5\x91\x57\xd5\x3d\x7a\xcb\xe1\xd1\xac\x2c\x6a\x1a\x0e\xc9\x9a\xf0\xe7\x3b\xd6\x6b\x52\x19\xbb\x30\x9e\x4a\x9d\x5f\xc3\xb8\x3b\x34\x8c\xa3\xe2\xe1\x66\x56\xad\xd8\xd8\x48\x6b\xcf\x45\x16\x3f\xee\xb7\xcf\x97\x82\xff\x97\xfd\xe2\xda\x11\xbf\xfa\x1f\x42\xf3\x6c\xd8\xe9\xee\x98\x6b\xe4\x2c\x7a\xcd\x1d\x45\x93\xb1\x61\xdc\x55\x1a\x93\x12\x19\xa7\x49\x01\xf7\x17\xd9\xb2\x9d\x23\x58\x82\x14\xaa\x34\x76\x8b\x66\xd2\xad\x5c\xa3\xf7\xa8\x60\x6a\xdf\xb2\xdb\x0c\x85\xa0\xa5\x1a\x98\x27\xfb\xe0\x5f\xb4\x02\xf2\x4d\x09\xa3\xfe\x27\x0b\x11\x27\x91\x88\x13\x56\x59\x2c\x56\x0a\x64\x51\xb7\x5d\x95\x53\xd3\x5e\x7d\x7a\x7d\xf0\xe6\xdb\x5f\x0e\x0b\x45\xed\xc5\x9a\x2b\xfb\x7f\xfe\x5c\x8e\xc9\xaf\xbe\xa3\x20\x8d\x5f\xfa\x38\xf1\x9f\x82\x4c\xda\xb1\x82\x9c\xd5\xde\x16\x0a\xeb\x7f\x6b\x24\xd7\xc5\xa4\x5b\x6c\x68\x6f\xd9\xf8\xf0\xad\xcc\x6b\x0c\xf2\xb6\x35\x19\x74\xb3\xf6\xd6\x30\x0c\xa3\x71\xb4\xa9\x97\xb4\x0b\xb9\x94\x59\xd4\xbd\x04\xbb\x54\x58\x27\xd7\x89\x9b\x74\x93\xb5\x9b\x5c\x77\x93\x79\x72\x56\x64\x41\x9e\x38\x6a\xf5\x06\xca\x4c\xcf\xdc\xd6\xfa\xf9\x2f\x98\x60\x38\x73\x0e\x31\x21\xe7\x2b\x6e\xfe\x06\x6e\x78\x52\x87\x96\x1c\xaa\xd2\xb9\xc4\xcf\x9e\x23\x4b\x3c\x6f\x4e\xd0\x78\x9f\x69\xe9\x6e\x5e\x39\xaf\x7b\xf3\x7f\x94\xda\xc1\xe4\x79\x1b\xa2\x28\x93\x3d\x6c\x01\xb5\x93\xfe\x1a\xab\x0b\x32\x45\xe3\x65\x7d\x54\xc9\x0f\x72\xb6\x6f\x96\xa5\x2b\x93\xc3\xaa\x96\x3a\x81\xd9\xd9\x78\xb7\x94\xa4\xb8\xfa\x5a\x06\x0b\x90\x43\x9f\xce\x99\xf4\x8e\x43\x69\x9e\xb4\x21\xf2\xad\x55\x77\xaa\xb3\xa7\x9f\x26\x87\x46\xc4\xfc\xbc\x34\xbc\x08\xf3\x2a\x27\x3b\x9e\x99\xab\xd8\x2c\xa9\x21\xf2\x5e\x1e\xb1\xa7\xee\x90\x5d\x72\x88\x9c\x97\x39\x87\xb8\x7e\xe5\xa6\xbf\xb4\xf8\xfc\x49\xf1\xb2\x59\x4e\x85\x32\xb5\x1a\x29\xa1\xfc\xec\xc0\x79\x1d\xed\xd5\x6b\xa1\x41\x47\x58\x39\x6e\x2c\xc1\x73\x08\x3f\x47\x5a\xba\xa9\x03\xcf\x15\x6e\x67\x91\x08\xf6\x58\x38\xd9\x51\xbc\xf7\x94\xf0\xa5\x33\xbe\xf1\x6b\xa3\x3a\x51\x72\x2b\x24\x6e\xde\xef\x39\xed\x53\xfd\x7e\x54\x99\x94\xaa\x5b\xa7\x31\xa9\x92\xb9\xf2\x0f\xff\xf3\x43\x98\xad\x47\xdd\xd4\xe2\x97\x7b\x49\xec\x38\xba\xcf\x11\x83\x79\xc9\x5c\x4a\x01\x6e\x9a\x4a\x7c\x1b\xc3\xe4\x70\xcb\xa2\xa5\x32\x39\x2c\x8c\x8d\xa8\x32\x29\x6d\xdd\x98\x1c\x12\x5c\xd6\xd6\x0a\x51\x35\x1a\x47\x87\x46\xa3\x4a\xb3\x67\x46\xb6\xd1\x42\xf5\x51\xbc\x69\xe1\x96\x0d\x98\x4c\x5f\x1e\x17\x5d\xe7\xe5\xf7\x73\x9d\x7b\x27\xfc\x99\xf9\xc8\x34\x6f\x3e\x32\x39\xcc\x6c\x93\x09\xaa\xea\xbf\x1b\x54\xa5\xce\x70\x32\x26\x92\x5d\x6c\x2a\xb9\x14\x5e\x4a\xa5\x4a\x4d\x75\x42\x84\x92\xa0\x24\xec\x6a\x81\x9c\xa2\x31\x4a\x0a\x69\xe9\x10\x3a\x09\xe1\x8a\x10\xfc\x89\x94\xd2\xfb\x52\x98\x2f\xd1\x47\x3c\x47\x59\xfa\x22\x69\xcc\x32\x77\xf2\x67\x4a\xc2\x6c\xe9\x94\x86\xa1\x58\x88\x9c\xe6\xd3\x5d\x24\xe0\xb8\xc4\xdc\x03\xa9\xd5\xb7\x63\x86\xf9\x3a\xa7\x0b\xe6\xd6\xe2\xf2\x4d\xcd\xdc\x91\xa7\x2a\x35\x29\xe7\x8f\x00\x42\x26\xe9\x22\xb7\x63\xc7\x4e\xbe\x0f\x53\x77\x8e\x7f\x64\x85\x1e\xef\xb1\x6a\xcf\xfb\xca\xf2\x77\x14\x5d\xb0\xe3\x57\x01\xb1\x09\xb2\xef\xf3\xce\x16\x53\xd7\x8a\xfc\xe0\x99\xed\x1b\xb3\xe4\x20\x79\x5f\x25\x3f\x5b\x63\x4f\x82\x0e\x44\x65\x7f\xd7\x80\xbd\xd0\x78\x65\x18\x11\x21\x35\x8c\x3d\x76\x1a\x30\xd9\x6b\xeb\x1e\x13\x40\xfd\x5e\x38\xa6\x82\x61\xcc\x8e\xd9\x84\xbd\x54\x4c\x28\x75\xbf\xe1\x75\x99\x33\x59\x33\x3d\x1d\xbe\xd2\x2a\xbd\x54\x6c\x14\x93\xf1\x31\x8e\xdc\x59\xbe\xfe\x8d\xbd\xa9\x40\x6a\x7d\x5f\x8a\x49\x01\x63\x89\x61\x7c\x32\x8c\x4f\x8d\x22\xd3\x37\x72\x77\xd7\x52\xd3\xcb\x82\x56\x12\x47\x83\xe1\x20\x0f\xe5\x91\xc3\x5d\x50\xe0\x15\xdb\x7a\x72\x4e\x06\xec\xf1\x02\x8a\x47\xaa\x20\xa9\xee\x4c\xb6\xed\xe5\x9d\x23\xab\xee\x96\x9f\xe4\x58\x2b\x5b\x35\xf7\xe5\x19\xbf\xda\x4b\x5e\xdd\xca\xa3\xd7\x4a\x85\xce\x5e\xa5\x86\xd4\x13\xa6\x2a\xcf\x5b\x9e\xa1\x33\xea\x88\x51\x32\x99\x2e\x1a\x33\x9e\x9f\x71\xf7\x36\x85\x26\x31\x77\x1c\x98\x95\x9d\xd4\x42\xf8\xc5\xdf\xd3\x67\x2a\x14\xa4\xeb\xe2\x72\x42\xbd\x4d\x7e\x24\x34\xa2\xea\x79\x21\xd4\x72\x30\x1d\xe6\x91\x95\xdf\x2b\xa5\x97\x68\x67\xce\xcb\x07\xd1\x50\xca\x4f\x34\x1b\x67\xab\x95\x24\x73\xa0\x42\x56\xe6\xc4\x30\xa5\xe0\x4d\x7b\x1c\x37\x03\x97\x9f\xcf\x05\x31\x94\xec\x36\x09\xe7\x3d\x59\xfe\xd1\xd4\x54\xfa\x5f\xf9\x92\xfc\x38\x6a\xaf\x7a\x16\xed\xcf\xbb\x65\x94\x57\xeb\x3d\x55\x43\xf6\x53\xf2\x0c\x12\x4f\x78\x28\x7d\xc1\x5e\x7a\x2f\x87\x0b\xbf\x51\x46\xeb\x69\xca\x7d\x7d\xf4\xa7\x7e\x2e\x51\x4f\xd5\x8f\xb3\xfe\x90\xc4\x11\x92\xef\xf1\x24\x25\x2c\xf7\x38\xb6\xe6\xdf\xf2\x41\x7e\xef\x32\x55\x23\xdd\x6a\x15\x27\x59\x06\xb2\x61\x9d\x1d\xee\xc3\xfb\xf8\x46\xdc\x5f\x3a\xb6\x90\xfb\xcb\xfc\x60\x6b\x74\xa0\x53\x7d\x5f\x7d\x69\xc4\x87\xeb\xd9\xc3\xcf\x77\xb8\xff\xc6\xcd\x2b\xcc\x2b\xb7\x7a\xf6\x9f\xd9\xbf\x9c\x39\x9c\xea\xe7\x9d\xde\xd6\x46\xf6\xa7\xf6\x30\xbd\xd7\x56\xa0\x5d\x39\x57\x43\xcc\x1d\x90\xba\x1e\x29\xb4\x4a\x0d\x5a\xf6\xd5\xcc\x30\x5e\x52\x5f\x75\x77\xab\x63\x18\xb2\xdc\x86\x87\x04\xcd\x34\x14\xa6\x2d\x42\x8e\x93\x8a\x9b\x8c\x9b\x96\x25\xa2\xc8\x0f\x5f\xf0\x18\xe4\xb8\x5a\x4a\xcf\x28\x23\xf6\x6d\x4d\x47\x9e\xcc\xaf\x15\x11\x4e\x9e\xfa\xd9\x1f\x8b\x14\x02\xe1\x68\x9d\x28\x95\xcd\x6f\x86\x51\xa7\x1e\xd0\xca\x14\x17\x9e\xad\x8a\x48\xdb\x06\x59\x8e\x71\xc3\xa6\xa4\x01\xea\x1d\x68\xc5\x49\xca\x29\xec\xdd\xa5\x6f\x6a\xe1\x0e\xaa\x81\x5a\xa0\xfd\x7f\x05\xc2\x6b\xfc\xe3\x1f\x77\x5f\xee\x27\x8d\x4d\xef\x8d\x5b\xb7\x32\xcd\xf7\xad\x5b\x99\xeb\xaa\x8d\xec\xac\x93\x36\xb2\xb7\x60\x87\x54\xf6\x16\xfa\x90\xca\xde\x02\x21\xca\x5a\xb3\x8d\x5d\x65\x9c\x1a\x8d\x82\x61\x18\x36\xdb\x21\x19\xc6\x7d\x0e\x06\x86\x84\x4e\x89\x61\xac\xf3\x79\xbf\x62\x4e\xc3\x18\x4a\x21\x1c\xe1\x5f\x15\x2a\x8d\xe2\x3e\xf4\xaf\x94\xcb\xda\xf4\x03\x94\x33\x1c\xc8\x13\x2b\xfc\x81\x08\xfb\xc1\xf9\x29\x8c\xd1\x5e\x1c\xa1\x7f\x1d\xb9\x28\xc3\xe4\x52\x1b\x3c\xb3\x57\xc5\x06\xc7\x71\x2b\x56\x8a\x58\x5e\xf1\x67\x78\x43\xdc\xa8\xfd\x84\x19\x12\x4c\x9b\xd5\xe4\x18\xb9\xff\x02\x4c\x50\x9e\xca\x8a\xf8\x49\xfb\xd0\x82\xfe\x27\x68\xa9\x3f\x45\x3d\x6d\xeb\xe2\x25\xcc\x9b\x25\x55\xfe\xdc\x9b\x44\x55\xc2\x6b\x9e\xa4\xad\x6e\x6a\x1a\xa7\x35\xe6\xa2\xf7\xe2\x83\xf9\x13\x05\x67\xf2\x53\x0a\x4b\xce\xb6\x97\xd1\xd3\xb6\xee\xb0\x3a\xd7\xab\x3f\x45\xa7\x65\x5f\x95\x29\xb6\x2c\xfd\xde\x3f\xb2\xea\x16\x81\x1c\x59\x64\x18\x61\xd7\xb5\xf1\xc7\xc6\xe4\x23\xd9\x0e\xe2\x66\xf7\x7a\x52\x22\x33\x9d\xbb\x92\x61\x14\x6b\x86\x61\x97\x28\xf8\x48\xa6\x84\x94\x5f\x29\x36\xa4\x19\x53\x76\x29\x04\x0c\x4e\x3a\xfe\xf6\x71\x82\x65\xd7\x75\xd6\xe3\x33\xa2\xc3\x4d\xaf\xa9\xbc\x51\x5b\xcf\x3b\x40\x17\x0c\x37\x91\x90\xe5\x5f\x42\x86\x7b\x45\x6d\xdf\xef\x26\xbb\xcc\x7f\x38\xd3\xca\xda\x42\xac\xa2\x40\x58\x5a\x59\x0b\x7c\x5b\xc6\xe2\x25\xee\x12\x9a\x13\xce\x48\x5b\x21\xcc\x3b\xe2\xf2\x54\xa7\xac\x8b\x2a\x66\xbd\xa8\xd4\x28\xf0\x11\x7b\x84\x55\xdb\x0a\x49\xda\x87\x37\xb8\x83\xbc\xf9\xa8\xc9\xa9\xfc\x66\xfc\xa6\x5e\x99\x30\x3a\xca\xf8\x4d\x65\xd2\x78\x23\x6d\xc5\x98\x40\xd8\x30\x3d\x7a\xf9\xe1\xae\xe9\x2d\x5e\xf6\x5b\x7b\x53\x7b\xca\x24\x26\x51\x60\xde\x79\x40\x21\x39\x8f\xa4\x2d\x5d\x2b\x67\xf4\x01\xfb\x03\x41\x96\xd3\xb3\x4d\x37\xf2\x93\x93\x63\x98\x61\x5a\xc2\x85\xe2\x0e\x3e\xf3\x43\x98\x45\x2e\xcc\xa2\x10\x66\xf7\x7e\xb8\x61\x19\xe3\x4b\xcd\x75\xa2\x19\x18\x1e\x14\x69\x5f\xfc\x9d\x4f\x9b\xb8\x1f\x22\x59\x80\xf5\x92\x35\x05\x84\xc2\x12\x84\x39\x2d\x56\xa4\x43\x4e\xf4\xc5\xbd\x1f\xe6\x4e\xfb\xb3\x61\x40\x42\xc6\xd2\x47\x50\x47\xbd\xd4\xab\x6f\x24\x7b\xb0\xcf\x54\x14\xc7\xdd\xeb\x31\x2f\x19\xf4\xdb\x64\x0b\x2e\x97\xac\x74\x95\x5b\xe7\x62\x43\xd9\x8b\xe6\x45\x81\xcc\x30\x34\x0a\xb8\xc4\x12\x28\xae\x5a\xa2\x8b\x8d\x62\x69\x9b\xe3\xf2\x6f\x64\xe4\x95\x96\x2e\x39\x33\x9c\x7c\x6f\xf3\xa7\x16\x6f\x27\x87\xb5\x7d\x99\x72\x49\xdb\x77\x27\xb7\x8a\x8d\xb7\xc0\x48\x52\xee\xe6\x0f\x06\xc9\x8b\x24\xe7\xf6\xc3\xe8\x20\xa5\xa0\x95\x88\xd3\xc5\x30\xa1\x90\x2c\xab\x9f\xfe\xc5\xc1\xf2\xf8\x2f\x0c\x96\x3f\x39\x52\xb6\xed\xc3\xa5\x69\xb8\x53\xdf\x18\x1e\xde\x8b\x0e\x0b\xfe\x95\x01\x42\xea\xe6\xb8\xad\x92\xe9\x24\xf6\x4e\x95\x04\x3d\x44\xb2\x91\x13\xbb\x0d\xca\x41\x3a\xb2\xab\x29\x18\x92\x30\xfb\x9a\x9b\x14\xc6\x97\x2d\x87\x77\x44\x93\xe4\x59\x4b\xfa\x9d\x25\xb9\xd8\xd9\x94\x07\x64\x4e\x92\x3b\x39\x4c\xad\xa7\xa5\x6b\xf0\xad\xd1\x92\xb2\xc2\x8c\xf4\xf9\x1a\x77\xb3\xeb\xce\x80\x1a\x97\x77\x75\xb8\x71\x67\x0b\xfc\x42\x36\x6d\x55\x7f\x52\xa0\x83\xea\xe3\x38\x6c\x09\x82\x5f\x99\x99\x4b\xa4\x86\x6f\x6a\xe6\xf3\x7b\x72\xd7\xed\x94\xfd\x0d\x76\xaa\xa9\xd0\x22\x91\xbc\x59\xd1\x19\x32\x4e\x39\xaf\x2c\x91\x22\xd9\x3e\x6f\xcd\x3f\xdc\x7b\x3f\x3a\xb2\xc6\x27\x93\x23\xab\xbe\xa6\x7d\x6b\x5d\x0e\xd2\x0c\xfa\xed\xec\xe7\x24\x9d\x55\x5b\xef\x91\x70\x8b\xd0\x95\x9e\x0d\x9c\x9a\x56\xf8\x50\x4d\xd1\xa1\xeb\x84\x0a\x2d\xf1\xa2\x31\xbb\x20\xe3\x86\x51\x2c\x6a\x7b\x1d\x29\x6a\xdf\x68\x78\xa4\x66\x08\x82\x56\x0b\xd9\xd5\xc4\xef\x16\x92\xd7\x49\xb5\xaa\xaa\x35\x8c\xa4\x26\xe9\xb2\xd9\x1e\xd6\x33\x7e\x2e\x4e\xf2\x3b\xdf\x7b\xc2\x0b\x90\x67\x2c\xeb\xe7\x67\x24\x46\xf1\xa1\x15\xf9\x8e\x47\xf4\xb5\x94\xe8\x8d\x1e\xea\x22\xad\x4d\xb4\x22\xd2\x2c\x15\xc9\x03\x56\xa4\x11\x56\x85\x79\xb5\x8a\xb4\x64\xa9\xb0\x48\xa7\xc2\x32\x1d\xa8\x98\x6b\xdc\x37\xa1\x62\xf9\xc1\x03\x3b\x88\xa9\xd8\xbe\x05\x95\xdb\xc8\x83\x8a\x64\xed\x2b\x8a\x53\x53\x91\xa9\xeb\xcc\xf0\x27\xec\x9b\xb8\x22\x45\x46\x15\x62\x10\x2b\x2c\x46\xaa\x90\x49\x58\x65\x26\x96\xe6\xad\xe3\xaf\xc3\x34\x1a\x42\x85\x37\x6c\xec\x90\xc9\x33\x59\x1c\xe0\x18\x08\x68\x04\xb8\xcc\x17\x1c\x1d\xfc\xc4\xe5\x89\xb8\xb7\xc4\xfe\xcd\x31\xfb\xe2\xf7\x6e\x74\xaf\x95\xf1\x92\x4a\x0a\xdc\xf4\x8c\x7f\x32\x36\xee\x8c\xa3\x09\xeb\x37\x64\xbb\x69\xf3\x74\x08\xcd\x56\x6b\xd0\xe9\x8d\xd4\xf5\x12\x9a\xad\xfe\x90\x82\x0b\x0c\x47\x14\x5c\x40\xf3\xfc\x7c\xa0\x9f\x37\x47\x3a\x34\xdb\xed\x81\x3e\x1c\x42\xf3\xb2\x3f\x68\xeb\xe7\x83\x16\xc5\xba\x9d\x5e\x0b\x9a\xbd\x36\x34\x07\xcd\xd3\x4e\x0b\x9a\x03\xbd\x39\x84\xe6\xb0\x05\xcd\x61\xa7\x47\xc1\x05\x34\x47\xcd\x1e\x05\xef\x28\xbc\x80\xe6\x67\xbd\xad\x7f\xc6\xcb\xa0\x79\xae\xab\x6b\x53\x45\x3a\x67\x59\x6c\x08\xa7\xcd\x8b\xd1\x48\xff\x3a\x82\xd3\xe6\x50\x87\x53\x7d\x38\xd4\xbb\x1d\x79\xfd\x0f\x79\xfd\x4d\x5e\x7f\x87\x53\x7d\xd4\x6c\x77\x86\x23\x8a\x1c\xa5\xb1\x4e\xef\x33\xe7\x50\xa4\xd3\x7b\xd7\xd6\x5b\x74\xbd\xd0\xbf\xd2\xb5\xdf\x1a\xe1\xb5\x7f\xc9\x3f\xc1\xd8\xd1\x56\xf4\x68\xd0\xec\x9d\xeb\x32\x83\xeb\x19\xe1\xab\x9f\x76\x46\xdd\xe1\x45\xe7\x0c\xcb\x8e\xfa\x03\x0c\x07\x69\xfa\x6b\x7f\x00\xad\x66\xb7\x0b\x2d\xbd\xd3\xed\xf4\xce\xd5\xf5\xe8\xb2\x39\xba\x48\x13\x57\x03\xbd\xd5\x19\xea\xd0\xd2\xb1\xe4\x45\x73\x00\xad\x8b\x0e\x3d\xbe\x75\xd1\xc1\x47\xb5\x2e\x3a\x23\x9d\x93\xc3\x7f\x1c\xa9\x3b\x32\x7a\x34\x50\x29\x59\x94\x63\x59\xb6\xfc\x69\xbf\x8f\x8f\xe8\xea\xcd\x1e\xb4\xfa\x6d\x1d\x5a\xfd\xee\xf5\x65\x4f\x5e\x86\xd0\xea\x5f\x9e\x76\x7a\xf2\xd2\xc4\xeb\x55\x57\xff\x0a\xad\x7e\xaf\xd5\x1c\xc9\x8b\xde\xc3\xb1\xd0\xea\xf7\xce\x3a\x6d\xbd\xd7\xca\x47\x8f\x7a\xfd\xc1\x65\x3e\x4d\xbf\xf9\x4c\xda\xb1\xfd\xc1\x40\xef\x02\x8e\x30\x1a\x60\x38\xbe\x68\x78\xb5\xfa\xd7\xbd\x11\x87\x4d\xbe\x9c\x76\x9b\xbd\xdf\x38\xda\x39\x53\x57\xfc\xd9\xf5\x55\xbb\xf9\xfb\x69\x1a\xcb\x22\xbd\x16\x45\x7b\xad\x36\x5f\xaf\x2f\xe9\x7a\x45\xe9\xcf\xd8\x95\x18\x76\x9a\xd8\xa4\xab\x7c\x62\x08\xad\x41\x67\x44\x9f\x13\x5a\xc3\x16\xfe\x5d\x40\xeb\xfa\x54\xff\xed\xaa\x73\xa9\x5f\x9e\xea\x03\x4a\xed\x44\xaf\x06\xfd\x2b\x7d\x30\xfa\x9d\xb2\x06\xcd\xde\x6f\x7a\x3b\x57\x66\xa8\x8f\xd4\x55\xbe\xdd\xf5\xa9\xfe\xb9\xd9\xbd\xd6\xa1\x75\x7d\xd9\xb9\xba\xc4\x9c\xcb\xab\x01\x4e\x9c\x36\x76\x26\x06\xed\xce\x19\x5d\xb9\x5c\x5b\x4d\x8c\x76\xf3\x77\xa0\x77\xc5\xe0\x97\xbf\x1d\x43\xfb\x14\xda\xa7\xad\x21\xb4\xb9\xea\xb6\xec\xb9\x36\xe6\xeb\xad\x77\xf8\xf9\xf0\x8a\x83\x1a\xaf\x38\xa8\xdb\x7a\xab\x73\xd9\xec\x02\xce\x59\x5d\x1f\x42\x5b\xef\xe2\x2f\xf4\xcf\xc3\x7f\x40\xfb\x5c\x1f\x41\xbb\x33\x6c\x41\xfb\xb2\xf9\x15\xda\x97\xf8\xfb\x7e\xb7\xdb\x1c\xc8\x4b\x5b\x97\x91\xb3\x01\xb4\xaf\x06\xfd\xf6\x35\xd6\x38\x1c\xe1\xec\xe5\xcb\x15\xb4\x87\xd7\x97\xd0\xbe\x1e\x34\x47\x9d\x7e\x0f\xda\xd8\xe3\x18\x5c\x81\x4e\xaf\xa7\x9f\x9d\xe9\xad\x11\xe8\x3d\x1c\x72\xd7\x83\x2e\xe8\xfd\xcb\x7e\x6f\x74\x01\xfa\xe0\x0c\xff\xd2\xb1\xaf\x0f\xce\x5a\x14\xe4\x72\x06\xfd\xc1\xd1\xe8\xf7\x2b\x1d\xf4\xeb\x41\x5f\x8d\x26\xfd\xb3\xde\x03\xfd\x6b\x13\x6b\xfd\x7a\x85\x7f\xfd\x1e\x4f\x09\x8a\x52\xec\x0c\xef\x62\xd0\xee\x5f\x9f\x76\x75\x38\x6b\x76\x87\x7a\x72\x0c\x67\x5c\xf0\x8c\xc3\x74\xf6\x9c\x75\xba\x23\x7d\xf0\xf5\xb2\x0b\x67\x9d\x5e\x9b\x82\x53\x38\xa3\xe9\x74\xa6\xa6\xd2\x19\xa5\x3a\xc3\x0b\x7d\x20\x2f\x9c\xf1\x55\x6f\xc3\x59\xb7\xdf\x1f\x70\xc8\x13\x9b\xa3\xea\x45\xce\xfa\x03\xbd\xd5\xc4\x27\xca\xc8\x91\x3e\x1a\x6e\x24\x8e\x68\xde\xf4\x36\x4b\x1c\x0d\xf5\xe6\xb0\xdf\x6b\x76\x3b\xa3\xdf\xb7\x6e\x8c\x9a\xb9\xa2\xdd\x4e\x4f\x6f\x0e\x30\x7d\x79\xdd\x6d\xd2\x5a\x79\x36\xd0\xff\x71\xad\xf7\x5a\xbf\xc3\x19\xcf\xfe\x33\x0e\x3f\xc3\xd9\xe7\x61\xeb\x42\x6f\x5f\x77\x75\x38\x6f\x5e\x5e\x36\x39\xe4\x6e\xa1\x68\x16\xa3\xf7\xa7\x58\x1a\xe9\xf6\xd4\x35\x7d\xb9\xf3\xe6\xf5\x70\x08\xe7\xad\x36\x9c\xeb\xfd\x4b\x5c\x5c\xce\xf5\xe1\x48\xbf\x82\x73\x7d\x74\xd5\xf9\xdc\x1f\xb5\x9b\xa3\x26\x9c\x0f\xfa\x5f\x46\x17\x70\xd1\x1c\x50\x91\x0b\xfd\x2b\x8d\x56\xbc\xe2\x52\x8c\x57\x1c\xad\x17\xdd\x7e\xff\xb7\xeb\x2b\xb8\xe8\x5f\x0f\xe0\xe2\xf7\x2b\x1d\x77\x98\xdf\x30\x86\x95\x73\x2b\x65\x82\xe2\x9d\xb3\xe4\x18\x3a\x67\x34\x52\xa0\x73\xd6\x6b\x02\xae\x16\x9d\x4b\xdc\xe2\x3a\x97\xcd\xf3\x4e\xaf\x39\xf8\x1d\x63\x83\xf3\xeb\x4b\xbd\x37\x82\xce\x65\xab\xdf\xfb\x8f\x6b\xda\xd2\x30\x3e\xe4\xf0\x82\x2e\x74\x7b\xd8\xe2\x10\xb3\xda\x9d\xcf\xd0\xb9\xc4\x51\xd6\xb9\xec\xf6\x30\xe8\x9f\x9f\x1c\xf3\xf5\x1d\x74\x2e\xaf\xfa\x5f\xf4\x01\x5e\xe5\xd4\xe8\x5c\x0e\xf4\x66\x17\x3a\x97\x43\xbd\xc5\x21\xd6\x82\x3b\x21\x85\x94\xf8\xc7\x00\xcb\x0d\xaf\x4f\x29\xbc\x84\xce\x25\x6e\x8f\x9d\x5e\x5b\xff\x8a\x61\x67\x80\xd3\xa5\xd3\x3b\xeb\x03\x8e\x87\x4e\x6f\xa4\x0f\x5a\xfa\x15\xc5\x06\xd4\x6a\x5c\x42\x3a\x83\x01\x74\x86\xbc\x58\x76\x86\x3a\xa5\x64\x27\x0c\x69\x7e\x74\x86\x72\x38\x40\x67\xd8\xed\x9f\x77\x5a\xd8\xac\x21\xf6\xcf\xb0\xd7\xef\xd1\x18\xe9\x0c\x7b\xd7\xb4\x6c\x75\x86\xfd\x76\x1b\x3a\xc3\x81\x7e\x06\x9d\xa1\xbc\xd7\x3f\x52\x3b\x56\x67\xd8\xff\xa2\xeb\xbf\xe1\xc2\xda\x19\xe2\xc3\xff\xa3\x33\x84\xdf\xae\x07\x23\xe8\x36\x07\xe7\x3a\x74\x5b\x97\xd0\xd5\xcf\x46\x14\x9c\x42\x57\xef\xe1\xdf\x29\xe0\xb0\x1c\x8e\xa0\xdb\x83\x6e\xff\x1c\xb8\xe7\xba\xfd\x73\xca\xeb\x9f\xe3\x90\xea\xf6\xcf\x71\xd3\xe0\xcf\x2a\x13\xf9\xf8\x11\x17\xa2\x11\xd1\xa5\xae\xbe\x6c\x8e\x5a\x17\x80\x8b\xd5\x65\xf3\x6b\x13\x03\xfc\xde\x97\x6d\x7d\xa4\x0f\x2e\xe1\x32\x5d\x86\x2e\xf5\x76\xa7\xd9\x83\xcb\x4e\x1b\xff\x4e\x23\xc0\xa5\xed\xb2\xd3\xa3\xd2\xb8\xbb\x5d\x76\x7a\xd7\x23\x1d\x2f\x9f\xf5\xc1\x10\x23\x83\xff\x3f\x6f\x6f\xd6\xdd\xc6\xad\x35\x0a\x3e\xdf\xfe\x15\x08\x93\xf3\x45\xb2\x44\xc9\x92\x73\xf2\xe5\xe8\x1c\x45\x47\x03\x65\xeb\x3b\x9a\x22\xd2\x76\x12\x95\xcc\x0b\x56\x81\x24\xac\x2a\xa0\x08\xa0\x28\xd2\xa6\xef\xba\x4f\xfd\x87\xfa\xa5\xdf\xfb\xad\x1f\xfa\xfe\xa5\x5e\x7b\x6f\xa0\x06\x92\x72\x72\xef\xea\xaf\xd7\x22\x81\x8d\xa1\x30\x0f\x1b\xc0\x1e\xee\xd8\xd5\xd5\xdb\xcb\x1e\xbb\xba\x39\x83\x7f\x07\x8d\x9d\xe0\xd5\xd9\xe9\x5e\xbf\xbe\x64\xb4\x76\x5d\xdd\xdd\xbc\xbd\x3e\x63\x10\x06\xdb\xc7\xc5\xf1\x25\xbb\x7a\x7b\x7d\xd1\x63\xd7\xec\xfa\x98\x5d\x77\x5e\xd7\x10\x88\xe0\xf2\x8e\xde\xfb\x9b\xbb\x7f\xe1\xa2\x5e\x83\x77\x2e\xae\x7b\x97\x0c\x52\xba\x3e\x06\x3b\xb4\x49\xd9\x20\x00\x40\x63\x94\xad\x82\x40\xb7\x8a\xd5\x2d\xa1\x9d\x6e\x19\xa1\x4b\x00\xf8\xbf\x67\xd7\xb7\x9d\x3b\x76\x7d\xfb\x8e\x51\xaf\xd3\x56\x73\x73\xda\xc3\x79\x08\x36\xcc\x43\xb0\x61\xf7\x80\x01\x71\x73\x76\x76\x7e\x7b\x77\x71\xda\x41\xe8\xb7\x8b\xce\x25\xfa\x5d\x96\x7e\x97\xde\xef\xfc\x1c\x36\xbd\x9b\x3b\x76\x5b\xb6\xff\x6d\xe7\xf8\xae\x8b\xf6\xdd\x69\xe7\xba\x77\x71\xd9\xd9\xe9\xfc\x7a\x5a\x77\xc2\xfe\x57\x39\x03\x08\xdb\x69\x3d\x26\xba\x6b\x51\xc1\x0d\xf0\xd5\xdb\x9e\xb7\x30\xbf\x63\x76\xfb\xe6\x82\xdd\xbe\xb9\xb9\xee\xf4\x2e\x4e\xd9\xed\x05\x83\x71\x7a\x7b\x73\xd1\xed\x86\xbd\xc1\x3b\x18\x4d\xd9\x5b\x0c\xc7\x8a\xa0\x89\x9b\x20\x42\x57\xc7\x10\x70\x73\xc2\xc2\x9c\xa6\xed\x9e\xdd\xbe\x63\xbf\xbc\x3d\xbe\xc3\xc2\x06\x00\x4b\x5a\x3a\xa0\x98\xbf\xbc\xbd\xe9\x5d\xc0\x3a\x73\x77\x0c\x03\xb0\xcb\xee\x00\x43\x04\xe3\xa4\xd3\x7b\xdf\xe9\x5c\x33\xac\xd1\xf1\xbb\xd7\x04\x74\x7e\x41\x9b\xe1\xcc\xbe\xeb\x9c\x76\x2e\xde\x75\xce\xd8\x5d\xe7\x35\xcc\xc3\xbb\x9d\x0b\x80\x6f\x2f\x8f\x4f\x3b\xc1\x3e\x01\xa0\xc7\xee\x2e\x5e\xbf\xf1\xe6\x09\xbb\xbb\xb9\x3a\xbe\x66\x34\x24\xd1\x3c\xbb\x79\xef\xdd\x6f\x6f\xd9\xdd\xcd\x7b\xf8\x77\xd9\xdd\xdd\x05\xbb\xeb\xfe\xc2\xee\x7a\x67\xac\xdb\x39\xbe\x3b\x7d\xe3\xad\x13\x06\xcb\x15\x2e\x56\xdd\xce\xe9\xcd\x35\x04\xdf\x5d\x74\xba\xb0\x3a\x75\xdf\x74\x3a\x3d\x32\xbb\xac\x7b\xf1\xfa\x9a\xc1\x6a\x86\x6b\x59\xf7\x5f\x9d\xf7\x68\xec\xdc\xb2\xee\xe5\x35\xeb\x5e\xde\xdc\x76\x58\xf7\x0a\xb0\xdd\xee\x2f\x97\x3b\xb8\x05\x75\x7b\x0c\x57\x3c\x30\x6e\x2f\x58\x17\x30\xe6\xe3\xbb\xb3\x8b\xdf\x3b\x8c\xd0\x08\x34\x21\x05\xb4\xbb\x64\x1f\x33\x8f\x5c\x90\x05\xce\xce\x6f\xbf\xb2\xee\xdb\x93\x6e\xef\xa2\x07\x13\xb7\xfb\xf6\xa4\x77\xd3\x3b\xbe\x64\x58\xc8\xb7\x57\x17\xe7\x64\x76\xc1\x0a\x5d\xd7\x7d\x7b\xd5\xfd\x05\xcc\x5f\xf7\xaf\x7e\xdb\x27\xe0\xd6\x03\xe8\xf1\xfe\x02\x96\x93\xee\x6f\x67\xac\xc7\x60\x15\xc6\xd3\x49\xef\xe4\xe2\xf2\xb2\xf3\x0b\xd9\x34\x46\x10\xa4\xd1\xde\xa3\xe1\x44\xd6\xce\x7e\x09\xdd\xf5\x58\x8f\x42\x60\xfd\x04\xe3\x3f\x6e\x2e\xae\x59\xef\xe2\xaa\x83\x06\xcd\xb7\x1e\xce\x4d\x34\xf1\x5b\x74\xdd\x00\x9e\x07\x43\xbb\x7b\x0b\x18\x7a\xef\xae\x73\x7d\xc6\x7a\x77\x17\x57\x68\xe0\x7e\xd9\xbb\x7b\x0b\xe8\x4b\xef\xee\xed\xf5\x29\xeb\xd1\x96\xde\x23\x13\xd0\xa3\xb7\xd7\x17\x78\x6a\x00\x1b\x56\xae\xb7\xb7\x30\x64\x29\x4b\x40\xc8\xde\x1d\xdf\xed\xdc\xa2\xd9\x05\xf3\x98\x21\x82\x06\xc6\x31\x7b\x77\x76\xc2\xde\xf9\x45\xf6\x7d\xe7\xa4\xdb\xb9\x7b\x07\x55\x86\x25\x1f\xca\x15\x96\xfe\xf7\x9d\x8b\x93\xb7\x97\x97\xc1\xa6\x56\xf0\xeb\x57\xb0\x69\x19\xfb\x15\x96\xd2\x5f\x61\xa5\x81\xc3\xcf\x6f\x80\x9a\x80\x71\x7e\x77\x7c\xca\xa8\x0d\xd1\xc4\x29\x87\x10\x4c\xb9\xdf\xa9\x4e\xbf\x83\x59\x11\x60\x7e\x20\x12\xad\xfb\x0f\x48\x14\xe8\x49\x67\x77\x51\x97\xd7\xde\xea\x2b\xcd\xae\xa7\x7c\xc0\x07\xb5\x28\xd9\x8a\x06\xfe\xeb\x28\x29\x85\x39\xaf\x7b\xdd\xd9\xa5\xaf\x5e\xe2\x57\x2f\x0e\x1a\xae\xdd\x70\xaf\x7a\xd2\xa9\xab\x40\xab\x6e\xe3\xc4\xce\xf5\xdd\x56\x6b\xe3\x2f\xe5\xed\x15\x11\xb4\x0d\xae\xa3\x8d\xdd\x6d\x14\x86\xf0\xb9\x2e\xf8\xb9\x94\x90\xfe\xdc\xa1\x7f\x28\x67\xeb\xa5\x3a\x7b\x31\xc0\x1f\xa2\x62\xff\x87\x97\x7b\x51\xf1\xf2\xe5\xcb\xbd\x87\x2d\xaa\x61\xc3\x6f\xb7\x71\x69\x02\xad\xb6\x17\xda\x73\xa3\xf9\xf9\xe1\xc3\xd6\x26\x26\x70\xb8\x36\xc0\x94\xc9\xec\x6d\x07\x82\x3c\xff\xa0\x49\xfd\xb2\xd1\xcc\x78\x33\xe4\x4c\x15\xae\x3d\x00\x23\x99\x0d\xde\x4e\x3c\x5b\xed\x74\xb9\xde\xeb\x89\xc8\xbf\xdf\xd8\x59\x44\xd1\xfd\xec\xd7\xe2\x6d\xed\x72\xf0\x61\x6b\xf3\xfb\x55\xba\xf2\x25\x75\xb0\x24\xfe\x9f\x88\x65\x48\xd5\x1d\xb6\xe7\xcb\xf6\xdf\x22\x15\x39\xd6\xfa\x7e\x63\x73\x7b\xe7\xbf\x7e\xfe\x12\xdd\x47\x0f\x07\x7f\x7f\xb8\xff\xb0\xd6\x7b\x6b\xf1\xec\x27\x87\x0f\xbb\x25\x61\xee\x7a\x45\x29\xf7\x07\x87\x9f\xa3\xfb\x8d\x48\xfd\xbd\xec\x25\x7a\x93\xaf\x48\x6e\x3e\xaf\x79\xfe\xaf\xbd\x74\x2c\xa9\x3c\xab\x24\x76\x79\xa5\x16\x35\xf9\xb5\x29\x2a\x4e\x4e\xbd\xb4\xa0\x54\x20\xa9\x69\x3c\x66\xa5\x9c\xf7\x52\x95\xc4\x3c\x0f\x64\x0c\x75\xed\xbe\x75\xa2\x58\xa4\x7d\x24\xc2\x92\xe7\x47\xae\x36\xce\x70\xf5\x6c\x2f\x3e\x73\x95\x89\xdd\x56\x56\x7a\xe7\x1c\x2a\xbc\xc3\x76\x7a\xa6\x10\x3b\xb5\x8a\x3f\x4a\x85\x62\x7f\x89\xc4\x22\x48\x45\x42\xa6\x1c\xa9\xa0\xc3\xad\x8c\x3d\xc9\x0f\x34\x0a\x41\x5e\x83\x78\x12\x6c\x7f\x65\x28\x54\xe2\x89\x70\x51\x93\x45\x90\xb6\x44\x1a\xe3\x92\x44\x7b\x0a\x71\xd2\xc5\x8b\x6c\x3f\xc8\xc3\x8e\xf2\x6a\x54\x3a\x47\x21\x3c\x5c\x2a\x5b\xd2\xf0\x06\xa1\x67\xa8\x63\x79\x2c\x54\x10\x15\x64\x8b\x81\xd1\x85\x93\x0a\x9f\x03\x4a\x61\x2b\x41\x98\xd1\x0e\x57\xc9\x0e\xdb\xd1\x66\x87\xed\x28\xed\x76\xd8\x4e\x0a\x55\x17\x93\x1d\xb6\x33\x02\x68\x84\x7e\x6e\x87\x78\x91\x50\x25\x2e\xf6\x78\x61\x4b\xc2\x2b\x5f\x11\x1c\x17\x44\x43\xc2\x06\x29\x57\x8f\x2c\x91\x06\x6b\x88\x8c\x4a\x78\x49\x39\xcc\x50\x19\x4a\xe6\xf9\x6f\x9c\x48\x98\xd4\xd6\x71\xe7\xa5\xf6\xf0\x4c\x24\x48\x22\x63\x44\xcc\xbc\x62\x4f\x9d\x0b\x85\xea\xec\x62\xf8\xa7\xcc\x8a\x49\x21\x14\x2a\xee\x80\x2f\x0b\x8b\x4a\xa3\x43\x72\x28\xe7\xa9\x14\x51\xe4\xd9\x7c\x3c\x4f\x12\x32\xe3\x23\xff\x54\xdc\x57\x45\x9a\xf6\x49\x5c\x6f\x9f\xa7\xc2\x80\xdf\x80\xc7\x8f\x34\x2c\xe3\x3e\x7c\xd9\x1f\x0a\x54\xe1\x52\xd8\x31\x43\x71\x76\x41\x33\x8c\xd1\x85\x82\x92\x67\x76\x54\x4a\x3c\xd6\x85\x65\x4a\xe7\x30\x2d\x94\x56\x7d\x2f\x00\x0b\xc5\xb3\xa1\x67\x25\xc9\xaa\x14\x66\x5c\x8a\x5c\xaa\x89\x17\x83\x99\x03\xdf\x57\x23\x8a\x74\x91\x24\x62\x28\x8c\x11\x95\x44\x24\x92\x88\x57\x13\xbb\x44\x53\x12\x99\xad\x50\x3d\xfb\x40\xe2\x60\x2a\x32\x16\x43\x6a\x2c\xee\x93\x84\xe0\xb8\x8f\xf2\x82\xc9\x0a\xb0\x45\x69\x46\xa1\x41\xac\xfc\x24\xfa\x8e\xbe\xfb\x29\x00\x7b\x3f\x06\xe8\xd5\x7e\x80\x7e\xfc\x21\x40\xfd\x54\x70\x5b\x45\x26\x67\xf5\x09\xb9\xab\x0f\xc9\x5d\xfb\x7c\xd8\xf8\x7a\xd8\xfc\x78\xd8\xfc\x76\xd8\xf8\x34\xe3\xb3\xbe\x63\xa7\x00\xe6\xce\xa0\xaf\x17\xc6\xdc\x0f\x12\x9a\xa9\xaa\xa5\x0b\x83\x51\x9d\x78\x2a\x66\x65\xb4\x9a\x47\x2d\x7a\xcd\x97\xa4\x3e\x87\x36\xc2\x01\x94\xbb\x12\x1c\x16\xca\xbb\xc4\x53\x1f\xc5\x8a\xc5\xfd\x98\x1b\x23\xf9\x48\xf4\xfd\xec\x8c\xfb\x63\x6d\xe4\x27\x98\xbb\x69\xdf\xf1\x01\x8b\xfb\x53\x61\x9c\x8c\xbd\x53\x5a\xdd\x8f\xfb\xd0\x71\xd2\xf7\x50\x0c\xc5\x45\x91\x1f\x30\x4e\x43\xdf\x26\x0c\xbc\xfb\x5e\xfb\x23\x8b\x7d\x39\x7c\x11\x20\x15\xbf\x12\xf6\x85\x9a\xa2\x50\x6a\x1e\x3b\x61\xfa\xd6\x69\x03\xc5\xb1\x28\x5d\xc1\x18\x6d\xfa\x38\x5f\x60\x6e\x36\x03\xa5\xca\x0b\x47\x81\x34\x41\xfb\x42\x25\x25\xa8\x0d\xf3\xe2\x4f\x9a\x5f\xe9\xc2\x95\x9f\x61\x01\x8d\x8e\x43\x21\xa5\x10\xa2\xcf\x8d\x74\xe3\x4c\x38\x19\x93\xdb\x16\x39\x8c\xf8\x7e\xa1\x12\x61\x86\xa9\x7e\xea\xc3\xd4\x35\x3a\xa5\xe0\x91\xa8\x07\x21\x13\x1e\x7d\xb6\xea\xaf\xc4\x53\x35\xf5\x47\x05\xcc\x46\x23\xe2\xc2\x58\x89\xfc\x7a\x09\xcb\xb5\x45\x31\x3e\xcc\xab\x8d\x48\x44\x2a\x33\x94\xe3\x46\x62\xde\xa0\x52\x3c\x99\x72\x15\x0b\xa6\xb2\xb4\x26\x02\xaf\x92\xa3\x06\xeb\x13\x8a\xa8\x28\xc5\x83\xe0\x5a\x04\x0b\xb7\x17\xc5\x96\x92\xe4\x74\xf8\x78\x84\xbc\x7f\x3c\xad\xda\x9f\x85\xb1\x94\xea\x11\xf4\x39\x4b\x64\x26\x14\xa9\x3a\x4e\x51\x0f\x34\x2c\x17\x28\xee\xaa\x54\xf1\x5e\xca\x82\x0b\xfa\xa2\x20\x18\x45\xd9\xf8\xe1\x9c\x1b\x11\x4b\x4a\xc3\xc2\x2c\xc6\xbc\x95\x63\x1a\xe9\x4c\xa1\x40\xbe\x03\xbc\xe6\x68\xa9\x50\xb8\x5e\xac\xb3\x4c\x2b\x26\x26\x85\x9c\xf2\x14\xeb\x90\x70\x24\x16\x28\x1f\x69\x78\xaa\x47\x0c\x8c\xbd\x97\x8c\x67\x7c\x46\xe6\x1e\xe3\x99\x54\x2f\xd1\x04\x58\x27\x2c\xe6\x03\xcb\xe2\x58\x5b\x16\x8b\x19\x2a\xbb\x1e\xb1\xd8\x4a\xc5\x62\x3b\x41\x1e\xcd\x81\x65\x09\x87\xf0\x84\x83\x37\x64\xe4\xcd\x7d\x96\xa0\x7f\xac\xed\x98\x25\x89\xcc\x58\x02\x49\x24\x28\x4c\x08\xd2\x49\x28\xff\x04\x73\x4e\x30\x4f\xd8\x43\x59\xa2\x30\x0a\xd6\x38\xc1\x44\xad\x54\x63\x96\x50\x8e\x98\xbe\xe3\x6a\xec\x05\xad\x4b\x28\x82\x84\xe4\x25\x26\x2d\xe9\x73\x89\x34\xaf\x98\x06\xd6\x0f\x33\xc1\xda\x61\x46\x56\x8d\x52\xc6\xd3\x11\xcf\x38\x8b\xb1\x16\x31\x96\x36\xc6\x32\xc6\x58\xbe\x18\x33\x8f\x29\xdf\x78\x82\x91\x26\x18\x69\x82\x91\x26\x18\x69\x82\x91\x26\x54\xb8\x38\xcb\xd3\x19\x54\x59\x7d\x1c\x31\x18\xc4\x68\xc4\x2c\xa1\xb2\x26\x23\x9e\x65\x1c\xc6\x06\x87\xea\x63\xee\x72\x02\xc5\xc5\xd4\x27\xd8\x90\x13\x6c\xc8\x09\x36\xe4\x84\x1a\x72\x42\xe9\x4e\x28\x5d\x2c\xc3\x04\x9b\x75\x02\xf5\x9e\x40\x3e\x13\xcc\x07\x0b\x36\xa1\x5c\x26\x98\xcb\x84\x72\xc1\xb2\x4e\xa8\xc1\x27\xd8\x16\x13\x6c\x87\x09\x34\xf8\x04\x5b\x6c\x82\x8d\x85\xd5\x99\x60\x83\x53\x9d\x26\x58\x0e\x6c\x70\x28\x23\x16\x91\x63\xd2\x1c\xbe\xe2\xf8\x2d\x16\x19\x4b\x4c\x05\xa6\x85\x14\x0b\x4d\x65\xc6\x66\x83\x12\x43\x01\x25\x06\x07\x39\x93\x0e\xe6\x0c\xa3\xa2\x65\x7c\x06\xfd\xc3\x30\x51\x2c\x0f\x24\x8c\xa5\xc1\xc2\x40\x0e\x58\x14\xe2\xcd\xf5\x5a\xca\x65\xc6\xd2\x91\x60\xe9\xc8\xb1\x34\x15\x2c\x4d\x91\x4a\x1b\x25\xc7\xca\xe1\x3c\x4c\x40\xd8\x68\x4b\x90\x27\x1f\x0b\xeb\x52\x6f\x1b\x88\x53\xc6\x4b\x18\x57\x73\x56\x5b\x91\x07\xd2\xd1\x22\x38\x40\xf5\x05\xb1\x90\x29\x2e\xe2\x28\xc4\x2a\x26\xee\xe9\x84\x3b\xd1\xe7\x2a\x41\xa9\x34\x0c\x05\x78\x59\x96\x68\x07\x0b\x65\x52\x00\x22\xa0\x29\xa2\xc8\xad\x4c\x3d\x95\xb8\x82\xd9\x3c\x4c\xb5\x36\x6c\x68\xfc\xe2\x35\x2e\x46\x82\x49\x0e\x4b\xf2\x20\x4e\x0d\x93\x03\x48\x49\x0e\x2c\x4c\x70\x58\xc9\x24\xfc\xed\x78\xe8\xc8\x8c\x59\x3a\x40\x9c\x25\x15\xaa\xef\x8c\xcc\x00\x11\xcf\x8a\x14\xda\xb2\xcc\x23\xe3\x33\xd8\x67\x32\x3e\x9b\xf2\x94\x65\xc2\x8c\x04\xb4\x73\x15\x2e\x71\x1f\xca\xa4\xc2\x70\x40\xfe\x34\xcb\xa6\x98\xb5\x12\xdc\x40\xb5\x73\x1e\x3f\xc2\x92\x64\xe1\x83\x50\x2b\xc3\x13\x39\xf3\xf2\xc9\xfa\x1e\xab\xf3\x2e\x0b\x18\x16\x69\x89\xf7\x52\x38\x8d\xb0\x63\x9e\x0b\x66\x0c\xa0\x62\xa8\x75\x2e\xe6\x80\x65\xc6\x5c\x79\x54\x53\x24\x88\x06\x20\x3e\x5e\xfa\xc0\x52\x1b\xbc\x5c\xbf\x2c\x34\x25\x86\x1d\x53\xa6\x97\xa3\xf4\x4e\x5b\x64\x8c\x34\x87\xf7\x63\x14\xbe\xe4\xa4\x9a\x93\xf8\x24\x94\x7e\x80\xcd\x54\x50\xb3\x15\x0a\x2b\xe6\x59\xaf\x39\x0d\x4d\xb2\xf0\x83\xa1\x30\x2c\x21\xc5\x80\xce\xcc\x59\x02\x35\x67\x71\x5e\x50\x47\x7b\x06\x80\x3e\x37\x23\x14\x91\xdb\xa7\x41\x81\x62\x8a\x3c\x6f\x40\x0d\x2e\xa3\xa1\xa7\x50\x53\x69\xb4\xc2\xcf\x02\x3b\x0b\x93\xb6\x5f\xdf\x95\xff\x73\x77\xd6\x5a\x66\x28\x5b\x76\x2a\xfa\xa4\x1b\xb0\x44\x74\xca\x3e\x80\x06\xf1\x7d\xc0\x33\xd1\x87\x33\x4f\x1f\x99\x15\x10\xbd\x25\xb7\x1e\x72\x9c\xe3\x1c\x27\x2c\xc7\x89\x3a\x10\xd6\x8a\xb4\xff\xf1\x65\x09\xed\x95\x90\x0a\xd0\xbc\x0c\x9d\x97\xa1\x73\xc5\x60\x5d\xc3\x65\x0d\x8c\x3e\x8e\x95\x84\xd1\xe2\x96\xea\x51\x9f\xa0\xf1\x3c\xd7\x28\x0a\x34\xdb\x67\xdc\xe9\x4c\xc6\x7d\x4f\x49\xe1\x5d\x06\x4e\xb2\x33\x11\x17\x4e\x94\xfd\x80\x75\x4b\x05\x4f\x3e\x41\x27\xcb\xf4\x13\x6b\x20\x3b\x38\x41\xfa\x38\xfa\x07\x23\xc1\x06\x23\xc7\xa0\x6f\x06\x29\x6c\x4b\x30\x8b\x53\x6f\x1b\x94\x3a\x00\x2d\x26\xf1\xac\x08\x0b\x87\xcc\xa1\xc3\xe6\x0c\xd6\x48\xd1\xf7\xe7\xe3\x58\xfb\xd1\x16\x80\x8c\xdb\xc7\x14\x4d\x44\xb8\xfa\x18\xdb\x32\xff\x6d\xae\xf3\x98\x64\x0f\xe4\xdc\x4b\x11\xe0\xcc\xe7\xec\x33\x76\x63\xe8\xbd\x0c\x75\x00\x35\x95\x22\x55\xf4\x1b\xc3\xbf\xbd\x6c\x6d\xb7\x86\x7f\xfb\xeb\x2a\x2d\xe7\xfd\x5a\x82\xa3\x70\xb9\x11\xe4\x9a\xae\xd1\x60\xdb\x8c\xb2\xe6\x3a\xa2\x3a\x96\xae\x9c\x49\x91\xa9\xea\xfe\xbb\xcf\x87\x51\xa4\x82\x92\xdc\xb7\x3d\xe2\x3e\x41\xb2\xb4\x6f\x56\x05\x4a\xd5\x89\x9f\x8f\x0e\xa3\x68\xe0\xf5\xd1\xb7\x17\x51\xb4\xb3\x89\x5e\x3b\x51\x94\x2c\x50\x14\xcc\xd1\x81\x27\x50\x03\x60\xe7\x08\x39\xbf\x37\x8e\x0e\xee\x13\x41\xca\x40\x28\x14\xe5\x0f\xec\x1c\xfd\x01\x79\xe0\x90\x34\x00\xae\x17\xf5\xd8\x60\x46\x5e\xa5\x47\xda\xfd\xbe\x4e\x5b\xb6\x85\x57\x72\xab\x04\x55\x43\x8b\xfd\x52\xd3\x74\x84\x22\x35\x82\x82\x23\x52\x74\x8a\x54\x83\xe5\x9d\xcc\x1a\xad\xa5\x88\x2f\x3e\x29\x54\x7a\xe4\x65\x10\x13\x23\x3a\xdd\xde\x24\x5e\x78\x31\x71\x26\x91\x12\xa3\x4a\xee\x7a\x50\x62\x54\xa8\xaa\xb3\xbc\x86\x4d\x2f\x85\x9d\x6a\x16\xe4\x29\xaf\x51\x65\x8a\x8a\x8a\xaa\x0b\xa0\x4c\xe0\x26\xe0\x2f\x10\x82\xe2\xa3\xea\x5a\xa8\x94\xe9\xae\x87\x78\xfe\x47\xf2\xd7\x52\x87\x51\x50\x5a\x14\x94\x9a\xc6\xe5\xed\x87\x1c\x2d\x29\x27\x22\x0d\xf6\xba\x92\xf7\x8e\xf7\x30\x45\x8e\x0d\x51\x58\x01\xa7\xeb\x20\xff\x7d\x8d\xe6\xfa\x56\x63\x36\xac\xea\xca\x1a\x6c\x60\xb4\x05\xe5\xbf\x48\x85\x5b\x24\x7a\xb3\x94\x9f\xf1\x27\xb4\x81\xae\x68\x49\x69\x28\x07\xf0\x3a\x40\x36\x4a\xf1\xe7\xa8\x00\x7b\x85\x67\xa2\xe4\xf4\x8a\xa2\x8d\xc5\xe1\xe2\xbb\x9a\xf8\xf2\x6a\xee\x34\x09\x82\xa3\xe8\xde\x8f\x4e\xd4\xd5\xb0\xf6\x36\xbb\x15\x45\x27\x1b\xdf\x07\xfe\x1e\x94\x50\x54\x93\xb7\x50\x5e\xcf\x2d\xcd\x7f\x79\x00\x3d\x47\xbc\x3b\x5f\xb9\xab\x1b\xe1\x4d\xdc\xd7\x75\x7b\x21\xb3\x5b\x6c\xb4\x9a\x67\xc1\xb6\xa5\xa6\xaf\xd4\xcf\x04\xc4\x00\x07\x52\x71\x24\x9e\x34\x09\x4b\x24\x20\xce\xd2\xe6\x29\x9f\xfb\x01\x3e\xf1\xd7\x4f\x32\x15\x96\xc6\xb2\x11\x82\x8d\x90\x21\x1e\x87\x30\x1d\xd7\x00\x2f\xd4\x3a\x67\xc8\x13\x3f\x93\x99\xfc\x04\xf8\x42\x26\x55\x09\xea\x44\xa4\x64\x02\xce\xc3\x14\x4c\x31\x38\x61\x2a\x4d\xb4\xb7\x34\x85\xc8\xb2\x44\xb4\x1d\x8e\x9e\x53\x3c\x49\x26\x2c\x2f\x1c\xfc\x73\x94\x29\x5e\x38\x97\x06\xcc\x07\xf6\x4c\x6e\x99\x15\x99\x84\xbd\x1a\x01\x44\x71\x01\xe1\xb5\x80\xf1\x5a\x9d\x4e\x05\xb3\xda\xee\x81\xb1\x5f\x43\x61\x18\xcd\x20\x1c\xee\x5e\x9a\x2a\x32\x41\xd2\x80\x9e\x69\x14\x1c\x5b\x23\xf7\x15\x39\xb2\x44\x32\xc5\x5b\xdb\x24\xf1\xa3\x2d\x55\x0b\x57\x19\xc6\x0d\x9e\xe5\xb8\xc1\x33\x1c\x37\x31\xa2\xec\x68\xed\xb3\x13\xe1\x38\x1b\x08\xc7\xef\xc4\x08\x5a\x5d\x67\x12\x8e\xb8\x42\xa6\x28\x46\xd5\xe8\x7c\x5e\xe1\xf1\xf1\xf4\x16\xa5\xac\x26\x72\x0a\xff\x97\x4c\x9c\xa1\xcc\x22\x1f\x0f\x6f\x20\x68\xdf\xed\x02\x1e\x08\x68\xff\x10\x96\xb8\x0a\xdb\xf5\x3b\x39\x9a\x90\xa3\x3f\x05\x60\x29\x52\x3d\x7a\x1d\xf6\xf9\xbd\x97\x60\xee\xb3\x8c\xe7\xef\x78\x5a\x1e\x11\x10\xc3\x8f\xf3\xd3\x2b\x30\xcf\xc1\x78\xf7\x36\xd7\x4f\x04\x40\xed\x00\x25\x80\x33\xb2\x64\xb9\x4e\x61\x4b\x7d\xf2\xb8\xe9\x49\xa8\x1a\x38\x2e\x25\x0a\x99\x07\xb0\x67\x24\x57\xa3\x54\xf8\x3b\x40\x43\x15\xb4\x72\x94\xc1\x22\xe2\x4f\x23\x23\x15\xbc\xc3\xb9\x24\x85\xba\xd9\x94\x4a\x6a\x53\x58\xb3\x2c\x9e\xc3\xac\x3f\x6f\xd9\x89\x81\x3f\xfa\xd7\xcf\x30\x24\x0e\xb7\x50\x12\xaf\x4d\xbd\x7d\xa1\x1c\x9b\xc6\x94\xc7\x40\xeb\xb4\x8f\xa4\xe3\x00\x88\xc9\x94\x00\x99\xe5\x04\x10\x29\xb9\x4e\xfb\xda\xc7\x9d\xa1\x28\xd4\x1e\x8c\x14\x23\xe0\x0b\xb4\x10\x01\x4f\xfb\x23\xbc\xb0\xef\xa7\xe4\x4a\xc9\xa5\x04\x1b\x25\x7c\xce\x46\x89\x7e\x62\xa3\xb1\x2e\x0c\x1b\xa5\x82\xe7\x6c\x94\xc9\x34\x95\x56\xc4\x00\xa9\xc2\x09\x36\xca\xb4\x72\x63\x36\xb2\x02\x49\xd4\x47\x73\x68\xb7\x8f\x28\xa5\xe9\xa3\xd2\x4f\xec\x23\x89\x38\xfa\x48\x92\x3c\xa1\xff\x2f\x51\x76\x15\x8c\x81\x0e\x2a\x9f\x85\x75\xe1\x4e\xa4\x02\xf6\x35\x80\xdf\x79\x3d\x70\x63\xae\x92\x54\x9c\xea\x14\x2f\x9b\xc9\x75\x46\x5a\xf9\xc8\xd1\xa5\xcb\x60\xef\x28\x06\x99\x74\x6c\x2c\x78\x7e\x0e\x53\x1d\x80\x4b\x19\xbc\xba\x80\xbf\x7d\xd4\x83\x37\x18\x17\xa0\x7f\xc9\x34\x05\xdb\x27\xf2\x51\x0f\x7a\xc2\x64\x52\x41\xc1\x53\x19\x0b\x65\x05\x15\xd4\x3b\x7c\xbc\x8c\xcf\x3a\x65\xc9\x6d\x2a\x04\x89\x01\x3e\x4d\xf1\x00\x01\x90\xce\xc8\xab\x93\xf2\xdc\x8a\x84\xe0\x99\x20\x71\xbd\x5d\x68\x8c\x4a\x77\xcc\x1f\x2a\x6d\xad\x64\x13\xf9\x35\x3a\x08\x8b\x3f\xbc\x4f\x47\x42\xcd\xe2\x87\xc3\x20\x0c\xfb\xbb\xba\x7c\xf8\x25\x51\xdc\x75\x8d\xa5\x8d\xd7\xa9\x65\x76\x76\x12\x1c\x5f\xb1\x5a\xb5\x2a\xb1\xea\xcd\xc7\x99\x25\x15\x9e\xb0\xec\x13\x9d\x7f\x25\xe5\x25\xf0\x99\x6d\x04\xc7\x36\x7b\x78\x11\x6d\x6e\x1e\xdd\xb3\xc8\xe1\x2b\x62\xd0\x58\xfa\x5d\x4d\x63\xa9\xdc\x56\x4b\xd2\xc4\xf1\x0d\xd1\x0b\xe2\x78\xd9\xfe\xdb\xbf\x7d\xfb\xe2\xf0\xe8\x9f\x3f\x47\xd1\x3f\x0e\xa2\xf6\xf6\xc6\xe6\x77\xd1\x7d\xf4\xd0\xdf\xf9\xfc\xe5\x9b\xad\xbf\x7c\x78\xd8\xda\xdc\xda\xf5\x08\xdc\x2a\x66\x35\xca\x6c\x43\x64\x7b\x10\x7e\xfc\x21\xfa\x4e\x2b\x27\x66\x6e\x77\x1b\xe1\xe1\x10\x1d\x9b\xab\x2a\x84\xbf\xf3\x85\x78\xd8\x2a\xd5\x36\x9d\xac\xe8\x0f\x6e\x48\x76\x6a\x7c\xf3\x25\x60\xb4\x1f\x68\x7f\xf7\x9c\xfa\xcf\xb4\x2b\x32\x0c\xa0\xf2\x18\xe1\x6c\xed\x86\xb0\x84\x2c\x2b\x0f\x88\x01\xb0\x78\x78\x86\xd3\x01\x5a\x16\x15\xa5\x13\x96\xe7\x01\xdb\xf2\x4a\x62\x03\xa7\xd5\x9f\x2a\x8b\xda\xd6\x81\x71\xdc\x91\x80\x05\x4a\xa4\xc6\x05\xd1\x08\x22\x2e\x72\xfd\x3f\x55\xdf\x30\x8e\x56\xd8\x02\x76\x3f\x34\x06\xd5\x76\xd4\xde\xfa\x9e\x6d\x6c\x7e\xf7\xb0\x15\xed\x7c\xde\xff\xb2\xac\x52\xaa\x7a\x69\xfd\x50\x8e\xc3\xad\xdd\x2f\xdb\x66\x9b\x97\xca\x5b\xf8\x57\xd0\x94\x62\xbd\x4a\xde\x26\x6b\x38\xbe\x0d\x04\xc1\x76\x69\x8a\x86\x98\x09\xd2\x96\x5d\xa9\xaa\x88\x53\x58\x0f\xd1\x1c\xb1\x18\x56\x09\x8c\x9c\xc2\x7e\x99\xe1\x4d\xa1\x27\xf4\xaf\x94\x6c\xa0\x50\xaf\x52\x1b\x69\x9c\x72\x23\x4a\x6d\xa4\xd2\x62\x6f\x27\xa5\x6e\x94\x34\xc5\xdc\x11\xe5\xb7\x0c\xb6\xd4\xc4\xab\xa3\x46\x03\x25\x4f\x71\xba\xa7\x48\x82\x58\x6c\x7a\x69\x14\x2a\xc9\xc1\xd0\x5e\xa2\xa0\x1e\x95\x00\x47\xa1\x0a\xb5\xab\xe8\xa1\x0a\x4a\x4d\x33\xee\xe8\x61\x70\xa4\x6d\x31\x60\x23\xc3\xf3\x31\x20\x54\xbe\x6d\x10\xe3\x4f\xe5\xa0\x54\xde\x42\x47\x6a\xcd\x13\x34\x48\x83\x0d\x40\xd0\x54\x60\x0f\xd1\x7c\x44\x33\x43\x33\x47\xd3\xa2\x39\xf3\x1a\x3e\xfc\xf5\x1c\xa0\x6a\x4a\xcc\x9c\x3f\x3d\xd3\x6d\x5f\x9a\xa3\x34\x6f\x96\xda\xb1\x7e\x82\xd3\x86\x91\x33\x96\xd9\x79\xc6\x54\x92\xc7\xa9\x98\x05\xdd\xa8\xd5\xb9\x02\x1f\x2d\xc0\xa2\x2f\xf3\x54\x3b\x34\xe0\xa3\x5c\xe7\x2c\x37\xb1\x55\x41\xd0\x1f\x98\xd0\xb6\xb9\xd1\x31\x4a\xfc\x83\xb3\x47\x5e\xca\x00\x50\x49\xac\xd1\x02\xcc\x03\x2c\x7c\x9b\x55\x09\xdd\x73\x15\x8a\x5e\x50\xc1\x80\x7e\xb2\xb1\x11\x42\x81\xa5\x53\x94\x9e\x48\x2d\x82\x45\xb7\x39\x37\xa8\x50\x47\xe7\x41\x63\x4d\x50\xb8\xea\x31\x3e\x03\x27\x25\x87\xa2\xe7\x51\x9d\x0c\x4a\xcc\x41\xc8\xf7\x2c\x39\xe8\x70\x48\x30\x8a\xbb\x27\x10\x5f\x5d\xcb\xe8\x25\xd2\x58\xa9\x58\x55\x58\xd7\xc6\x83\x00\x5e\xed\x0e\x19\x27\xbe\x4e\xc6\xc5\x5c\xe0\x9b\x00\x03\xcc\x55\xb1\x63\x7c\x12\xe2\x0a\x71\xa7\x53\xa8\xe0\x8a\x4f\xff\xb5\x11\xe2\xd1\xae\x09\xb8\xc8\xf2\x77\x7a\xe9\x8b\xdb\xc2\xad\x78\xac\x4d\x01\xfc\x97\x13\xe8\x36\x4b\xd0\x5d\x9b\x7d\x77\x6d\xde\xdd\x46\xc6\xdd\x75\xb9\x76\x6b\x59\x72\x40\x35\x39\x76\x35\x57\x4a\x93\x42\x9e\xd7\xc2\x9d\xd1\x51\xdc\xd6\x7c\xbb\xc2\x9d\x3c\x26\x4d\x8f\x73\xad\x5c\xd3\x07\xf0\xcd\x53\x14\x7a\xb9\xe2\xdd\x75\xf3\x54\xac\x7a\xf7\xc6\x32\x7e\x54\xc2\x62\x5e\x05\x4f\x7b\x86\x27\x52\x8d\xce\xf8\x7c\x15\x9f\xf7\x17\xac\x38\xd6\xe8\x26\x0f\x41\x9a\x46\x1e\x94\x8e\x20\xa7\x61\x8a\x73\x1b\x4b\x89\xd3\x96\xdb\x98\x44\x63\x72\xeb\x12\x34\x2c\xe3\x70\x0c\xa9\xdd\xeb\xf3\xea\x0e\x95\xcf\x32\x6e\x90\xa3\x91\xa7\xf8\xb2\x46\x4c\x8e\x5c\x25\xf1\x18\x16\x4c\x0f\x58\x0f\xa7\x2e\x40\x66\x8a\x96\xd5\x69\x0e\xf1\x0d\xde\x73\xec\xbd\x64\x03\x31\x7a\x92\xc8\x27\x69\xad\x48\x3f\x7a\x7b\x8e\x27\x12\x36\xd0\x33\xf8\xc7\x7a\xc6\xe2\x64\x88\x67\x04\x6f\x5f\xa8\x29\x82\xe5\x79\xa5\x82\x43\xd0\x54\x79\x6b\x3f\xd8\x02\x80\x53\x5e\xc4\xe3\x79\x05\xf9\xe8\xa7\x63\x19\x7b\x5b\x7a\x5b\xc5\x25\xe0\x23\x75\xf0\x95\x08\x2c\xef\x71\x8e\x51\xce\x55\xb0\xbc\xf7\x6b\x9e\xa1\x25\xd4\x2d\x37\xc2\x69\x70\xbc\x99\xe7\xc2\xbc\x16\x08\x5f\x72\x12\x1b\x5a\x81\xfe\xc3\x4b\x0d\xdb\x15\x95\x24\xc0\x3e\x28\x3b\xa5\xeb\xde\x53\xda\x3f\xe2\x64\x78\x45\x35\xbc\x0a\x35\xbb\x9a\xaa\x38\x00\xde\x76\x21\xc4\x85\x10\xfa\xf4\x1a\x0d\x6c\x98\x6b\xcc\xec\x5a\x8c\xea\x6d\x59\x73\xfa\xec\xaf\xb1\x55\x6e\xb5\xb4\x56\xab\x1a\xe8\x83\xef\xf8\x3c\x15\x72\x34\xae\xc3\x3e\xa8\x17\x93\x89\x09\xf4\xa8\xa9\x7a\x54\xf4\xf7\x42\x0e\x8a\x34\xad\x81\xf4\x8d\x34\x74\x00\x3d\x45\x25\x10\x67\x28\x48\x96\xc4\xc9\xca\x13\x6e\xba\x93\x02\xf6\x4c\x1c\x70\x60\x24\x8a\x85\x31\x07\x76\x91\xb3\x78\x6c\x2c\xed\xc6\x0c\x75\x71\xc4\x1a\x37\xe4\xd4\x0e\x61\x3f\x1e\xd0\x29\xa0\x84\x92\xf2\x19\x97\xc4\xe5\xaa\x04\x9f\xaf\x50\x7f\x11\x3b\xd5\xaa\x1b\x6b\x43\x1b\x38\x1c\x94\x62\x0d\x45\x24\x69\xb5\x96\x3b\x6d\x9d\x29\x9d\xce\x38\x6d\x39\x8b\xb5\x31\x19\x99\x16\xad\x69\x8c\xd6\x8c\x4c\x5b\x3b\x4f\xeb\x42\x39\x4b\xd6\x13\x00\x46\x5b\x9b\x9b\x04\x80\xc2\x91\x99\xb3\xd8\x9a\x18\x2a\x67\x4d\x8a\x6f\xb0\xd3\x3b\xc1\x93\xab\x00\x74\x8f\x59\x5c\x64\xb9\xd1\x49\x0c\x80\x2d\x32\xb0\xcd\x54\xb0\x78\xea\xf0\x89\xd6\xf1\x80\x74\x94\x60\xa8\x31\xf8\xe0\x03\x38\x02\xb0\x20\x10\xa0\x73\x04\x70\x4b\x05\x00\x77\xb9\xc4\xa7\x21\xa0\xce\xde\x86\x6d\xcc\x83\xf3\x0c\xbe\x9e\x4b\x35\x87\xd0\xb9\x1e\x3e\x09\xf1\xc8\x92\xc1\x71\x92\x9c\x71\xc7\xf1\x62\x33\x19\xd0\x51\x2a\x19\x9c\xea\x0c\x4e\x6f\xc9\x80\x06\xf4\x2f\xc8\xd7\x9c\x0c\xe0\x28\x15\xe0\xd7\xc2\x9d\x6a\xa5\x44\xec\x6e\xfc\xfd\x0b\xfa\x85\xc4\xae\x79\x26\xbc\x8f\x91\x53\x61\x96\xdd\x3e\xfa\x1b\x6d\x5d\x15\x74\xc9\xad\xc3\xd3\xdd\x75\x91\x2d\xf9\xf4\xc4\xcc\x91\xd7\x35\xd1\x42\xf0\xf4\xd6\x88\xf8\x56\xa7\x32\xf6\xc5\xb9\xe5\xd6\x22\x1e\x44\x2e\xd4\x50\x03\x50\x0f\x70\xb0\x37\x28\x86\xc7\xd6\x7c\x3c\xfc\xd6\x96\x85\x7b\xc3\xed\xb9\xe0\xae\x00\x9c\x6f\x70\x61\xa9\xa0\xc7\x41\xed\x06\xfa\xdd\x60\x9b\x7b\x80\x0e\xa2\xc9\xc0\x7b\x62\xcb\x9c\x48\x95\xbc\x23\x1a\x22\xf2\x38\x45\x24\x34\x38\x60\xac\x7b\x18\x1a\xf3\xd6\x88\x1c\x95\xbc\x7b\xbf\x73\xe1\xe2\xf1\x71\x9a\x5e\x2d\x7b\x74\x8f\x1b\x3e\x37\x4a\x5c\x2d\x7b\xd4\xa2\x48\x25\xed\x38\xb8\x5e\x0b\x77\xa2\x8b\xa5\x52\x35\x3c\x6d\xcd\xf7\x1c\xc9\x02\x2b\xf7\x52\x97\xac\x78\xfb\x7e\xa9\xfb\x5f\x28\x2b\x8c\xbb\x38\x5b\xf2\x0e\x03\x27\xf8\xdd\x06\x12\x11\xef\x75\x61\x8f\x63\xbc\xb7\x2b\xdd\xe7\xa4\x96\xee\x46\xa5\xf3\xca\x13\xb5\x6c\x96\xae\xae\x97\xad\x19\xdc\xef\x78\x2a\xcb\xe2\xfb\xd6\x0d\xce\x3b\xfd\x54\xd6\xb4\x4b\xa3\xbf\x84\xcf\x51\x6d\x50\xcd\xe3\x92\x37\xdd\xd7\xb5\x7a\x82\xfb\xd6\x88\xa9\xd4\x45\x2d\x41\xd7\x2c\xee\x1d\xaa\x94\xaa\xcd\xad\x3b\x9d\xa6\x03\x1e\x43\xb6\xdd\x35\x73\xa7\xbb\x32\x77\xba\x8d\xe9\xd1\x7d\x6e\xe4\x77\xcb\xb1\xde\x6d\x8c\xe6\x1e\xe0\x05\xfe\x05\x9b\xae\x6e\xce\x25\x8a\x21\x4c\x51\x18\x6e\x6a\xb0\x39\x84\xb2\xa2\xa7\xbb\x79\x05\xdd\x75\x00\xee\xe9\xdf\x85\xd1\x2c\x11\x44\xf3\x21\x1c\xfc\x53\x96\x0c\x87\x0e\x0d\x19\x2e\x7e\xf9\xc8\x4c\x59\x22\x3d\x19\x85\xb6\x4f\x52\xb1\xb3\x9b\xee\x7b\xa9\x4e\xc3\x89\x8b\x9c\x34\x4b\xb4\x1b\x8a\x89\xb7\x32\x87\xc0\x48\x78\x2b\xb8\x83\xe5\xdd\xa9\xf0\x56\x70\x07\xcb\xbb\x95\xf0\x16\xb8\x0d\x7f\x62\x89\x81\x15\xd2\xfa\xcd\x98\x70\x6f\x34\xb3\xd2\x6e\xee\xd7\x89\x4b\x82\x35\x67\x89\x23\x7a\x01\xe7\x74\xe2\xa6\x68\xe3\x92\xea\x9c\x2e\x5c\xcc\x12\x37\xf5\xd7\x98\x89\x9b\x42\x14\xb2\x31\xa8\xc8\xb2\x39\x99\x03\x43\x76\xa2\x98\x90\x23\x86\xbb\x2f\x18\x53\x30\xa7\x4c\xd0\xfd\x54\x1d\x6f\x44\xc5\x42\x78\x4e\x9c\x8e\x84\x63\x42\x0f\x99\x98\x74\xf1\x0a\xda\xdb\x99\xab\xa0\x66\xf9\x4b\xef\x9b\xc2\x35\xbd\x2c\x24\x55\x7f\xb3\x8d\xf3\x74\x86\x00\xd9\xb0\x80\x09\xa8\xb6\x65\xc2\x8d\xad\x88\x99\x80\x9d\x92\x75\x0a\xa3\x73\x51\x3f\x5c\xac\xf8\x04\x24\x7d\x35\xc0\x23\xea\x8d\x00\xc0\xf1\x97\x3d\xd6\xa6\x50\xc3\xf4\x4b\xff\x6e\xb3\x04\xdd\xb5\xd9\x77\xd7\xe6\xdd\x6d\x64\xdc\x5d\x97\x6b\xfd\x70\x21\x66\xb1\xb3\x59\x4e\x57\xa4\x68\x0c\x46\x78\x53\xee\xdf\xe9\xe0\x0c\x36\xc4\x6b\x06\x61\x0c\x1b\xe2\xdd\x02\x42\x62\xc2\x68\x44\x0f\x89\x82\x15\xe6\x09\x4e\x93\xe1\xd0\x65\x68\x20\xa8\x18\x0c\x77\x1a\xeb\xc3\x91\x70\x96\x4c\xee\x2d\xef\x09\x16\xfe\x33\xa2\x16\x44\xfd\x4d\xf8\x9c\xc2\xd9\x10\x95\xc2\xe1\xf7\x74\x6f\x9f\x02\x84\x31\xe1\x14\x0c\xb3\x81\xa6\xc2\x50\x03\xf6\x32\x44\x2c\x81\x2e\x0f\xe2\xa9\x07\xd4\x94\x0d\xf3\x02\x42\xc1\x74\x6c\x68\x61\x39\x1c\x5a\x67\x68\x44\x0c\x9d\x48\x53\x36\x74\x1a\xbe\x00\x34\x65\x88\x73\xe0\xb4\xfe\x3e\x80\x03\x08\x21\x29\x19\xde\xdb\x08\xc7\x46\x09\x3f\x26\x35\x04\xa3\x84\xfb\x91\x38\x4a\xf8\x59\x17\xa6\x60\x00\xae\x08\x7e\x2d\x1c\x2a\x29\xf4\x30\xae\x5a\x15\x68\x3d\x7c\x63\x70\xdb\x26\x07\xaa\x44\xae\xc0\xe0\xfd\x8e\x9b\x0b\xd4\x70\x95\xf0\x0b\x7b\x8a\xe5\x4a\xf8\x25\xe0\x4b\xa3\x84\xdf\xc2\x92\x3b\x4a\x38\x60\x63\xc1\x3e\x99\x97\x39\x23\x92\xa6\x29\x6b\x84\xe9\x22\x20\xb8\xe8\x0e\x00\x5d\xb9\x36\xf5\x9c\xba\x80\x74\x8d\x12\xfe\x36\x4f\x7c\x2d\x09\x3a\x56\x49\xc8\xf2\x1d\xa7\x92\xbf\x27\xf5\xb9\x1e\x78\xb5\x5f\x82\x94\x71\xb8\x88\x18\x09\x97\xc8\x0c\x95\x7a\x0d\xc1\x40\x46\xdb\xb1\x8f\xe2\xef\x56\x4a\xe8\x87\x33\x80\x91\x8e\xdb\xdb\xf8\x0d\x6c\x53\xd5\xaa\x12\x7c\xde\x0b\xf1\xe8\x9d\xca\x80\xa9\x7d\xab\x0a\x87\xca\x15\x46\xc2\x85\xfd\xac\xf9\x71\xf0\xad\x25\x70\xa7\x9f\xc0\xa2\x2b\xce\x57\x67\x15\x4c\x25\xea\x19\x1f\x01\x17\xb3\x51\x9a\xb1\x91\xe1\x09\x0d\x16\xc3\x93\xab\x9e\xb7\x32\x6f\x07\xb7\xf7\xc8\xe9\x56\x2b\x37\x8e\x00\x18\x54\x63\x6e\x91\xb8\x6d\x4c\x92\x14\xc9\xca\x1c\x1b\xc3\x01\x1c\x8c\xab\x9e\xb7\x46\xc1\x7e\xf2\x40\x16\xec\xe0\x11\x62\x96\x51\xab\xb8\x65\x64\xf2\xc8\xd9\x18\x50\x6f\x30\x86\x68\xe6\x0c\xd7\x47\x2c\x8a\x54\x49\x3c\x25\x72\xb9\x98\xa3\x7e\x66\x19\x0b\x1b\xec\xfd\x00\x0c\x4b\x40\x01\xa4\xf0\x13\xcb\xa7\xf4\x04\x6a\xb8\x13\x7b\x49\x05\x37\xd7\x74\xa9\x1c\xf8\xee\x07\xe0\x15\x00\xe3\x7c\x8f\xac\x7d\xb2\xbc\xe7\x0f\x64\xad\x24\x30\x29\x78\xb2\x17\x80\xfd\x00\xe0\x47\x26\x15\x54\x0e\x04\x2c\x47\xd0\x02\x2e\x25\x95\xb3\x32\xcb\x99\xc4\xe2\x4e\x73\x28\xe1\xd4\x3e\xe5\x4c\x5a\xec\x46\xb2\x86\x4c\xda\x44\x28\x26\xad\x54\x43\xc5\x55\x26\xad\x65\xd2\xa2\xf5\x28\xe6\xf0\xe7\x53\xbc\x99\x64\x29\x1f\xc1\x7f\x0f\x0c\xc5\x52\x9e\x77\xe4\x68\x3c\x08\x80\x0c\xc0\xb4\xf4\x9a\xa2\xdf\xa8\x23\x47\xc1\x1e\x97\xc0\x34\x40\x04\x74\xe3\x71\x61\x08\x9a\x26\xb1\x75\x01\xb4\x25\x80\x5e\x10\x58\xd8\xa9\x07\xad\xb7\xd1\x27\x49\x73\x30\xe0\x80\x9a\x4a\x45\x1b\x2e\x1c\xba\x50\x47\x71\xaa\x58\xaa\xe2\x64\x38\x98\x56\xc0\x3e\x41\x59\xf0\x0a\x96\xf7\x57\x31\x4b\x15\xbe\xab\xa6\xaa\x5a\x28\x53\x95\x87\x4f\x10\x70\x04\x78\x37\x69\x5c\xa4\x6b\x5a\x7f\xed\x08\x20\x4e\xa1\x54\xc3\x30\x47\x33\x73\xc1\x6e\xf6\xb4\x7f\xa6\xf5\x16\x12\x3c\xcf\xbd\xc6\xcd\x54\x3f\xa1\x26\x4f\xb4\xf6\x58\xea\x8c\xc4\x9a\x16\x2c\x2d\x00\xc8\x78\x3c\xee\x78\xf2\xc7\x8c\x3f\x0a\x34\xa6\xb0\x6e\x01\x80\x05\xf0\x77\x4a\x19\x1c\x3d\x89\x62\xd1\xe1\x8d\x55\xc6\x9d\xd3\xb4\x72\x65\x7c\x36\x98\x3b\x81\x2f\x74\x48\xd2\x08\xf3\x03\x49\x1b\xd1\x10\x31\xcb\xe8\xea\x48\xc8\x0a\x7a\x59\x81\x7b\x01\x2c\x83\xab\x50\x08\x14\x5c\xc5\x0c\xb5\x26\x2a\x22\x04\x1b\xcc\xc9\x9e\x72\xc3\x32\xa9\x90\x4c\x92\xf2\x84\x51\x8b\x83\x10\x0c\x31\x43\xcb\x4c\x59\xa6\x91\xc6\x8f\xac\x84\x65\x7a\x2a\xd5\x08\x56\xf0\x12\xea\xcc\xf2\xa7\x91\xab\xdc\xef\x47\x8e\x55\xf7\xdd\x00\x91\x38\x59\x25\xa6\x04\x60\xe3\x28\xd8\x1d\x91\x76\x06\x8c\x3d\xa6\x8a\xec\xd4\x5f\x5d\x10\x4d\x42\x8a\xff\xcc\x91\xd9\xec\x37\x9d\xda\x89\x21\x73\x9f\x2c\x88\xa7\x84\x65\x3a\x77\x48\xd7\x80\x99\x69\xe3\xc6\x4c\x17\xce\xcd\x73\x96\xf3\x78\xe8\xb5\xb7\x23\xd6\x0e\x20\x3e\x4a\x59\x11\x54\xe4\x94\xb7\x68\x39\xde\x8f\x81\xd5\x99\xe5\x60\x55\x57\x5e\x79\xed\xca\x2b\xaf\xae\xbc\xf2\xda\x25\x17\x8e\xce\xbc\xba\x55\xca\x6b\xf7\x48\x79\x75\x3b\x94\x4b\x96\x43\x9b\x83\x91\xd1\x4d\xfe\x71\x92\x1c\x1b\xa3\x9f\x82\xe3\x84\x9b\x12\xd4\xb3\x00\xbe\x81\xd5\xb5\x06\x9f\xd7\x1d\xb7\xc1\x71\xab\xd3\xea\xeb\x6e\xcc\x1d\xbe\xc1\x79\x27\x5e\xac\x7a\x07\x9c\x43\x07\x55\xea\xbd\x6e\x80\x7e\xfd\x8d\x20\x23\x38\x02\xa1\x34\xa1\x28\x78\x3e\xbf\xe4\x73\x5d\x50\x71\x4e\xfd\x55\x12\xc2\x85\x75\x3a\xab\x05\xd6\xaf\x99\xc1\x5d\x56\xa2\xaa\x41\x55\xfc\xda\x77\x97\x7a\x74\xa9\x47\x01\xfc\x35\x00\x54\x34\x38\x18\xbd\x97\x2a\xf1\x2d\x56\x55\x19\x51\x0c\x04\x6a\x15\xef\x0a\x77\x3c\x13\xf6\x56\xa8\xe0\x0c\x35\x22\xf0\x5c\x42\xaf\x94\xce\xae\x23\x6d\xf2\xc1\xe7\x31\xa1\xab\x6e\xef\xae\xc7\x7e\x6d\x64\x19\xef\x52\x8c\x00\x8b\x0b\xae\xf2\x86\xbc\xe6\x41\x77\xe3\x75\x0f\x7c\x26\xaf\xfb\x54\xf7\xe4\xde\xf3\x5a\x3c\xd5\x6a\xda\x15\xae\x87\x2a\x97\xbc\xe3\xfd\x58\xc6\xe3\xdf\x8e\x67\xb2\x8c\xff\x2b\x38\xba\xe3\x2a\xfe\xaf\x97\x78\x1f\x1e\x5c\x77\x48\xcb\x1c\x5c\x3d\x19\x5f\x28\x27\xcc\x94\xa7\x75\xbf\xc6\x27\xbf\x2d\xa7\xf8\x5b\x33\xb8\x91\xe2\xef\xcb\x91\x7f\xaf\x45\x2e\x3c\xbf\x4a\xaa\x9d\x1f\x6d\x30\xd4\xa8\xf3\x74\x3a\x47\x72\x65\x00\x04\x16\x47\xa7\x73\x42\x9e\x01\x22\x25\x56\xe9\x1c\x57\x5c\x02\xbc\x87\xd1\xda\x43\x45\x4a\x00\xf9\x4c\x46\x70\xae\xce\x3d\x3a\x46\x6b\x52\x6e\xa4\x42\x25\x5c\xf8\x74\x34\xcc\x82\x4d\xa4\xe0\x31\xcb\xad\x64\x79\xe1\x91\xcb\xbc\x70\x43\x30\xa6\x3c\xb5\x2c\x9f\xfa\x15\x28\x9f\x96\x58\x38\x82\xb7\xdc\x10\xf2\x1d\x5c\xef\x04\x69\x93\x9f\x5e\x7a\x6d\xcc\xd3\x4b\x1c\xf2\x53\xc4\x71\xc9\x92\xde\xce\x82\x1d\x3c\x6c\xb0\x4b\x8f\xac\x04\xd0\xab\x68\x64\xd1\x43\x92\xf6\xe9\x5b\xa2\xfe\xfe\xe5\x5a\x3c\x39\xad\x82\x9d\xb9\x0a\x6a\xae\xa2\xa5\x77\x75\xf8\xf5\x5e\x5d\xe1\xd8\x2f\xb7\x46\x8f\xc8\xcc\x5c\xb0\x2f\x94\x8f\x38\x99\x18\xf8\x23\x20\x72\x06\x2e\x70\x10\x0c\xfb\xe3\xc4\xe0\x23\xc9\xc4\xcd\x0d\x1a\x82\xcc\x9c\x4d\x0a\xae\x90\xad\x30\x00\x09\x9b\x40\x1c\x8c\x42\x31\x3e\x31\xc3\xd5\x23\x1a\x52\x25\x33\xe4\x7e\xf2\x4c\x4a\x46\x20\x3d\xa1\x1c\xce\x6b\xe0\x29\x9c\xc8\x8c\xc0\x4b\x71\x23\x62\x2b\x0c\x37\x1e\x88\x73\x0f\x98\x98\x19\x61\x50\x8e\x21\x11\xea\x97\x04\xfc\x02\x3f\x9e\x32\x03\x27\x4f\x83\x47\x4f\x34\x73\xb4\x14\x99\xe4\xc8\x99\x51\xc9\x89\x30\x4a\x17\x69\x2a\xc9\xe1\x38\xda\x25\x1d\x96\x4a\xfc\x2e\x02\xd0\x58\xfa\x6b\x7d\x70\xd0\x8b\x2b\x35\x20\x1c\xe7\xd0\x17\x76\x18\xa3\x12\xa2\x10\x03\x40\x68\xb4\x8a\x0c\xe6\x8b\x51\x49\xb9\xdd\x18\x95\x60\x96\xff\xba\x1a\xf8\x4c\xff\x75\x35\xe2\x19\x01\x3e\x44\x79\x6b\x40\x76\x4e\x56\x41\xd6\x14\xe3\x86\x2d\x0b\xc0\xd3\x90\xd2\xe5\xa9\x4f\xe9\xf2\x54\x92\xa5\xbc\x35\x20\x3b\x27\xab\x20\xcb\xa7\xa4\x47\xd7\xda\x20\x78\xd5\xc3\x90\xab\x77\x8a\x2c\x7c\x4c\x46\x98\x12\xa8\x3f\xc4\x18\x95\x60\x6a\x61\x7f\x34\x2a\x29\xf7\x47\xa3\x12\x6c\x99\xee\xa3\xc4\x38\x98\x2a\xe5\x16\xf6\x4d\x00\xa5\x1d\x73\xe3\x98\xd1\x10\xd7\x04\x1a\x37\xfd\x64\xd1\x18\x32\x63\xc4\x90\x59\x9e\xe5\x29\xde\x23\x32\xff\xa2\x71\x8a\x2f\xda\x09\x9a\xfe\x80\x0a\x20\xe2\x23\x25\xf7\x46\x2a\x5e\x79\x97\x21\x7a\x94\x1a\x9a\x0e\x4e\x0f\x8c\x9d\x8e\xc1\x2a\x0c\xb3\x82\x9b\x78\x6c\x75\x61\x62\x91\x93\x8e\x22\xf1\x68\x02\xbf\xb1\xc5\x6b\x43\x2b\x26\x1c\x8c\x8c\x59\x38\xac\x0e\xbd\x65\xc1\xd3\x21\xda\x08\xf6\x93\x41\x56\x06\xeb\x0f\x81\xa8\x5b\x32\xd0\xc8\x7b\x22\xbd\x51\x2a\x68\x11\x0b\x04\x7b\x22\x67\xf4\xfc\x68\xb5\x71\x31\x99\x64\x91\xd7\x38\xf6\x16\xd9\x98\x2e\xd9\xe4\x91\x91\x65\xc8\x8c\x99\xcd\x4f\x64\xac\xd5\xc7\xd7\x06\xce\xf7\x29\xb3\xf9\xe9\x98\xac\xa6\x27\xad\x03\x36\x3f\x43\x3a\xb3\x62\x00\x2b\xb1\xcd\xcf\x24\x1f\xdd\x4d\xaf\x10\xc6\xb3\x86\xcd\x3b\x73\x88\x76\x79\x76\xc9\x6c\x4e\x7c\x18\xf9\xe5\x5b\x66\xf3\xeb\x22\xbb\xfe\xbd\xc3\x6c\x7e\x03\x88\x1b\x71\xbb\x74\xc7\x42\x38\x7a\x07\x5a\xf2\xe8\x1e\xd7\x7d\xe8\x62\xc0\xe6\x5d\xea\xb5\xbc\xcc\xbf\xa7\xb1\x3c\x00\x99\x5e\x09\x76\x3d\x75\x51\xfe\xbb\x30\xda\x32\x3b\xc9\xe9\xb8\x12\x80\xab\x5e\x0d\x6c\x2e\x92\x95\x7f\xca\x47\xc8\x01\xb4\x12\xa0\xcb\xe5\x33\xf8\xc1\xfa\x89\xf4\x91\x16\x06\x28\x60\xce\x96\x59\x07\x0d\xee\x12\x0b\xa6\x8e\xa7\x0c\xf5\xbe\x5b\x67\xe8\xc5\x4e\x10\xf5\x44\x32\x03\x3b\x15\x0a\xac\xbc\x80\x14\x4c\xe9\x8d\xa7\x4c\xb0\xf3\x54\x56\xc0\x2d\x4f\x18\xbe\xd1\x25\x8e\xec\xa1\xb7\xf0\xfc\x04\xa0\x91\x59\x1a\x00\x43\x40\xa1\xe2\x12\x48\x4b\x28\xf7\x49\x01\x6c\x98\xf5\x8d\x5a\x0c\x6c\xec\x6d\x57\x40\x2d\x8b\x01\x9c\x4c\xf0\x49\xce\x16\x90\xa2\xdf\xd1\xed\x34\x81\xff\x1e\x18\xfb\x60\xe0\xd1\xd1\xc2\xb9\xd1\xd2\xa1\xd1\xce\x2d\x36\x09\x73\x7c\x50\x23\x1e\x15\x59\x8e\x17\x32\x44\xe7\x71\x52\xa3\x04\xe9\xa0\x3e\x0b\x0f\x9d\x97\xc4\x22\x15\xf4\x1f\x15\xa9\x08\xde\x95\x95\xea\xd6\x13\x82\xad\x33\x68\x17\x2e\x0e\x6a\x2a\x1f\x87\xfb\x22\xb7\x68\x83\xa5\x63\x58\x4b\x9c\x4e\xf8\x1c\xbe\xd1\x02\x1a\xf5\x13\x23\x05\xf6\x4e\x13\x62\xe2\x0c\xcf\xe3\xf1\x23\x73\xc6\x5f\xd8\x53\x5b\x52\x43\x22\xb1\x3e\x18\xf1\x14\xad\x21\x2b\x94\xd4\x8a\x4c\xcb\xc1\x9e\x60\x1f\x06\xc0\xfb\x15\xc2\x5b\xe0\xce\xa1\xad\xd1\xdc\x63\x45\x9e\x0b\xc3\x0a\x17\x63\xa7\x7a\x7b\xca\x0a\x7f\x08\x45\x54\x64\xca\xcd\xa9\x9e\x5e\x75\x3d\xf0\x2b\x02\x23\xe1\xbc\x05\xb1\x4c\xc6\x53\x6f\x1b\x81\x5f\xc0\x88\x22\x0b\xfd\xa9\xb0\xd3\x38\x83\xbf\x65\xd3\x78\x06\x7f\xcb\xa0\x7b\xa7\x22\x1e\x83\x61\xc0\x00\x3c\x03\x6f\xd7\xa7\x52\x3c\xa1\x31\x9b\x7f\x62\x53\x7c\x63\x9d\x42\xd7\xc5\xd3\xa0\x33\x77\x8a\x79\x20\xeb\xda\xd4\xb7\x09\x0a\x25\x00\x23\x66\x4f\x3c\x7d\xa4\xf5\x8b\xe4\x4e\x3c\x11\x52\xeb\xf5\x7b\xcc\x88\x68\x63\x96\xda\xd7\xc2\xe1\x44\x3f\x45\x16\xb4\x9a\x07\xd2\xc4\xd6\xdc\x74\x9d\x39\x4b\xed\x15\x7f\x14\x84\x83\xce\x52\x4b\x4b\x88\x07\xba\xc7\x00\xd1\x92\x11\x80\xab\x12\x82\xd0\x5c\x25\x6c\xe6\x64\x6c\xd9\x6c\xce\xa0\x66\x73\x2a\xc8\x1c\xfd\x3e\xe1\xa2\xf1\x09\xb6\xca\x4f\xe4\xff\x09\xfd\xe3\x64\xd8\xc9\x72\x69\x88\x47\x5a\x3b\x36\xfe\xab\x7f\x91\x1e\xff\x15\x2f\x8f\xc7\x7f\xc5\x66\x20\xeb\x38\x28\x69\x63\xe3\xbf\x12\xaf\xa7\xb7\xab\x80\x34\x49\xc3\xe1\x0b\x9f\x08\x6b\xe7\xbf\x3a\xd2\x7c\x7a\x76\x5e\xe5\xeb\xf1\x6b\x3c\x64\x64\x3c\xaf\xdc\x78\x18\x43\xac\xdb\x36\xcf\x26\x48\xc7\x13\x4e\x11\x62\xe6\x10\xf3\xcf\x8d\xa8\x9d\x93\x00\xf9\xa7\x96\x0f\xd8\xfd\xb2\xc7\xef\x48\x66\x6c\x89\x12\x3d\x83\x45\x03\x69\xb6\xfc\x22\xc3\xec\x3c\x9d\x0a\xeb\x44\x5d\x74\xf7\xd9\x49\xff\xf8\xbc\xd7\xb9\xeb\x5f\x1e\x77\x7b\xfd\xbb\x9b\xf7\x0c\x7c\x2e\x2f\xfb\xbd\xe3\x93\xcb\x4e\x17\x5c\x27\xc7\xbd\xd3\x37\xfd\x9b\xdb\x0e\x09\x47\x23\xbf\xce\xf9\xcd\x5d\xa7\x7f\x7e\x71\x57\x7d\x75\x72\x79\x73\x02\x76\xe7\x5d\xe7\xba\xd7\xbf\xbe\xe9\x5d\x9c\x5f\x9c\x56\x9f\x9c\x5f\x5c\x5f\x74\xdf\xf4\x7f\x79\xdb\xb9\xfb\x0d\xdc\x6f\x2e\x5e\xbf\xe9\x93\x14\x46\x94\xbc\x79\x42\x45\xb8\xb8\xee\x76\xee\x7a\xfd\x8b\x33\xf4\xb9\x79\x5f\x45\xe9\x7b\x61\x98\x2b\xfe\x17\xd7\xbd\x57\xfb\x6b\xbd\x7f\xfc\x61\xd5\x9b\xe4\xc7\x61\x91\x50\x00\xde\xed\x65\xa7\x7f\xd7\xe9\xbe\xbd\xec\xf5\xbb\x9d\x1e\xfa\x5f\x1f\x5f\x75\xce\xfa\x28\xb0\xec\xcd\xcd\xe5\x99\x8f\x7d\x7b\xd3\xbd\x40\x69\x6d\x97\xab\x41\x77\x9d\xdb\xe3\xbb\xce\x19\xd6\xee\x82\x9a\x0d\x2b\xda\xef\x5e\xfc\x8e\x25\xee\x5e\x5c\x41\x46\x97\x37\xa7\xff\xba\xb8\x7e\x8d\x3e\xbf\x75\x7b\x9d\xab\x5a\x3b\xd7\xa0\xbb\xe3\xeb\xee\xf1\x69\xd9\x74\x41\x2e\xd6\xd9\x49\xff\xdd\x45\xe7\x7d\xb7\xa2\xfa\xfe\x53\xda\x43\x3d\xb7\x22\x59\xb1\x5d\xec\xbd\x5c\xa3\x36\x94\xc9\x61\x8a\x72\xa5\xe5\x30\x50\x82\xca\x61\xc6\x63\x26\x87\xda\xee\x3f\xa1\x16\xad\x42\xc9\x59\xc9\xf5\x25\x87\x48\x91\x09\xc8\x22\xd9\xc3\x21\xb3\x26\x46\x5e\x19\x6b\x62\xf0\xfb\xff\x4c\x15\xe9\x3a\x55\xa3\x25\xe9\xf9\x76\x4d\xd3\xe8\xb2\x9e\x51\x7e\xe8\x75\xa4\x78\x39\xe0\x47\xad\x6d\x79\x78\xff\x15\x82\xf9\xc7\x03\x57\xa9\x91\x3c\xbd\xbe\x5a\x4e\x71\x8d\xa6\x8b\x91\x6d\x92\x82\x4b\xd4\x3e\xf0\xf9\xfe\x2f\xdf\x3e\x2c\xc0\x88\xa2\x2f\x9b\xad\x67\x12\x24\x92\xe6\x7f\xb6\xb6\x5b\xff\x6c\x6d\x6e\x9b\x55\x8e\xae\x35\xaa\x6a\x57\x19\x22\x91\x9e\xb4\x24\x15\x27\x6a\x6a\x62\xe2\xf2\xa4\xe9\x9f\x07\x07\xbc\xa2\x6c\x46\xb6\xae\x87\x52\xdc\xd7\x4a\x99\x4c\xa9\x9b\x44\xae\x57\x64\x33\x54\xcf\xe6\xe2\xf5\xe2\x44\xd1\xe6\x11\x35\xf9\x21\x69\x69\xf9\xb3\x99\x7f\x35\x6b\x94\xbd\x5f\x52\x12\x6f\x40\xb5\x17\xbe\xda\x8b\xa1\xda\x44\x9d\x00\xff\xd3\xd5\x6f\x8c\x97\x3f\xc8\x91\x1e\xab\x16\xd4\x41\x0b\xbc\x8a\x58\x78\x5a\xdb\x2a\x08\x4e\x30\x41\x3f\xd0\xf3\x65\x5a\x1e\x57\xcf\xd2\x90\xc3\x01\x7a\xbd\x0a\x09\x52\xca\xe9\x79\x40\x77\xbc\xfe\x88\x28\xfa\x0b\x6a\xce\xbc\x38\x67\x67\x37\xec\xfd\x9b\x8b\xcb\x0e\xeb\x5c\x9f\x11\x80\xa2\xbc\x3b\xd7\x67\x28\x6e\xf0\x04\x20\xb0\x5e\xdf\xf4\x6e\xd8\x5d\xe7\xb6\x73\xdc\x03\xaf\x00\xfd\xc2\x2e\x7b\xec\x75\x8f\x5d\x77\xd8\xeb\x0e\xbb\xec\xb0\x9b\x3b\xf6\xeb\xcd\x5d\xa9\xe5\xb2\x5c\x7b\x36\xee\x6f\x1e\x36\x37\x48\x49\xc8\x66\xa9\xb7\x6b\x69\x90\xef\x06\x81\x72\x4d\xae\xdf\xd3\xeb\xa0\xfa\xbb\xbd\xf5\x70\x44\x89\xbc\x88\xa2\x9d\x23\x4a\x0e\xa0\xcd\xcd\x05\x34\xe7\xe9\xf5\xdd\x12\xc7\xf0\xf1\x12\xc7\xe0\x73\xbc\x84\x0d\x05\xb2\xad\x8d\xfb\xd7\x65\x69\xcb\x8c\x8e\x4a\x2d\x44\xb5\x78\x57\x5f\x8b\x87\xd2\xe4\x30\xde\xbb\xd3\xc5\xbb\xee\xe2\x5b\x52\x73\x8d\x9a\x2d\xd6\x45\xfa\xfd\xe6\xfc\xd7\x05\x98\xbf\xa1\xf9\x7b\x19\xa7\xa9\x8c\xfb\xb8\x77\x7c\xbd\x38\x3e\xe9\x2e\x8e\x4f\x6f\xba\x8b\xe3\xee\xc5\xf5\x02\xfe\xe0\xe8\xfc\x7a\xbb\x38\xbf\xf8\x75\x71\xfe\xf6\x76\x81\xd2\x31\x17\x97\xd7\x8b\xde\xf1\xf5\xe6\x46\x14\xdd\xfb\xdc\xbf\xd6\x8a\x1b\x51\xf4\xb0\xb9\xac\x44\xca\x33\xf5\x5c\x7b\x0e\xd0\x64\xcb\xab\xa5\x7c\x8f\x42\xef\x56\x17\x3b\x15\xd7\x04\xf5\x07\x1d\x93\x4d\x7d\x31\xe6\xcb\xb6\xac\xeb\xb0\x7a\x6e\x60\x8f\x85\x79\x94\xea\x0f\x14\x04\x0c\x89\x82\x8d\xd8\x98\x03\x39\xdb\x09\x8f\x1f\x47\x74\xbf\x70\x3c\x90\xa9\x74\x73\x76\x52\x58\x89\x57\xb3\xd7\x42\x24\xac\x1b\x0b\xc5\x8d\xd4\x25\x60\x2b\xaf\x9b\xc2\xe1\x81\xb7\xf4\xe8\x79\xf5\xc0\xac\x33\xc3\xcb\x09\xcb\x5e\xcb\xa9\x50\xec\x58\x25\x0c\x39\xdd\x4e\x0a\xc7\xde\x8f\x85\xaa\x14\x4d\x34\xd8\x5c\x5f\xd4\x95\x6e\x95\x93\xe2\x9f\xf7\x1f\xfe\x19\x45\xf6\x21\xa8\xaa\xa9\x69\x55\x8b\xa2\xa7\x17\xdf\xd5\x12\xab\xe9\x8a\xff\xb0\xf0\xac\x3d\xab\x2a\x48\x2b\x95\x6f\x35\x55\xa2\x5f\x61\xfe\x25\x1d\xc8\xcf\xb4\x7d\x6a\x9f\x55\x5b\x54\xb2\xa6\x78\x8e\x94\xc0\x4c\x92\x48\x4b\x1c\xb2\x9a\x50\x80\x21\xb2\x02\x06\xe6\x05\x22\xfc\xf7\x12\xf9\x50\x9a\x5b\xe0\x25\x2f\xf5\x20\xb3\x20\x9b\x6a\x50\x0c\x87\xc2\xb0\x38\x7e\xf2\x6c\x9f\x32\xa9\x80\x29\x37\x73\x92\x7d\x02\x47\x11\xe5\x58\x0c\x27\x18\xd5\xcf\xf8\x47\x8d\xd4\xaf\xd6\xb1\xf8\x89\x25\x22\x77\xe3\x3e\x57\x73\x0f\x8d\x10\xc7\x37\xde\x85\x2a\x11\x09\x2c\x54\x8c\x94\xbd\x09\x13\xdc\xa4\xf3\xfe\xd0\xf0\x11\x0a\xc4\x70\xc2\x3a\xe2\x69\x4a\xfb\x41\xba\xc7\x30\xe5\xae\x14\x94\xc2\xd3\xbe\x98\x0a\x55\x05\x56\xfe\x3a\x49\x4a\xef\xb1\x1c\x8d\x73\x62\x64\x27\x71\x33\xba\x70\x4c\x2a\xec\x3b\x85\x90\x8e\xfd\xf3\x19\x9c\x13\x61\x94\xb2\x94\xde\x52\xc0\xd1\x87\x2e\xcc\x99\xf7\x07\xb3\xcf\x93\x8f\x3c\x16\x2a\x9e\x13\xcb\x0a\x49\x54\x9b\xd5\x1d\x8d\x90\x4f\xc4\xd3\x02\x67\xdb\x54\x3f\xe5\x2c\xe3\x33\x2f\x19\x4c\x58\x7c\x66\x2c\xb2\x9c\x29\x9d\x0b\x63\x73\x41\x34\x89\x7a\x38\xb4\xc2\x31\x6d\xe4\x48\xaa\x3e\x1e\x6c\xfb\xa9\x18\x22\x23\x8b\x7f\x8f\x63\x39\x32\xdb\xe7\x72\x26\xd2\x3e\xf4\x8e\x30\xfd\xc0\xea\x8c\x52\xa1\x48\xc0\x07\x82\xd6\x0b\x92\xaa\x0b\x94\x9a\x14\x3c\xb1\xcc\xec\xed\x0d\xfb\x23\x30\x06\x7b\x2f\x87\xcc\xec\xfd\x08\xff\xbe\x55\x78\x45\xb9\xf7\x23\x7a\x49\x30\x0a\xc9\xcc\xab\xfd\x21\x18\x08\x81\xfb\x27\x66\x7e\x0a\x71\x7f\x02\x37\x78\x0a\x9e\xa0\x14\x43\x83\x24\xc1\xb1\x63\x66\x04\xa9\x8e\xaa\x64\x47\x98\xee\x08\x13\x1e\x51\xca\x23\x4c\x7a\x84\x69\x8f\x28\xf1\xd1\x4f\xf0\x2f\xbf\xf9\x09\xbd\x30\x60\xb0\xf7\xb2\xcf\xf7\x4b\x80\xfc\x38\x66\x02\x56\xf9\x09\x38\x86\xde\x0e\x71\x7c\x64\xca\x0e\xec\xe0\xf6\x01\x3f\x91\x59\x4b\xe3\x27\x1f\x00\x11\xf4\x93\x1f\xe7\x74\x4d\xca\xec\x18\xc9\x69\x6d\xa6\xb5\x1b\x33\xeb\x92\xbd\x1f\x5e\x82\xf5\xc3\x2b\xb0\x8c\xe0\x19\x73\x9e\xa7\xd8\x8f\xa3\xe0\xb4\x15\x54\x1b\x4f\x81\x15\x38\x4c\xb2\x72\x98\x94\x62\x05\xf1\xf0\x0b\xed\xdb\xda\xfe\xdf\xdc\x3c\x17\xb0\x87\xa1\x90\x93\x42\x2a\xe7\x39\xd5\xa6\x22\xde\x47\xf3\x15\x9a\x3f\xb0\x24\xe3\x6e\x9f\xcc\x59\xb0\x5f\x79\x9b\x42\xc9\xf5\xca\x87\xbe\x9a\x05\x37\x85\x7a\xd3\x87\xfe\xe0\x43\x7f\x80\x50\x92\x53\x96\x60\x96\x09\x66\x99\x60\x96\x5e\x34\x17\x8a\x27\xd9\x3b\x2b\x81\x63\x7c\x1d\x22\xd7\xfe\x59\x09\x34\xbd\xaf\xba\x35\xb0\x19\x74\x87\x24\x23\xe8\x78\x15\x3e\x3f\xc1\xe5\x8a\xe0\xd3\x62\x20\x58\x05\xfa\x8f\x43\x21\x1a\x65\x08\x45\x68\x94\xa0\x2a\xc0\x52\xfe\xf5\xec\x43\xee\x55\xe6\xac\x96\xf9\x52\xde\xb0\xc4\xd0\x68\x31\x50\x84\x12\xf4\xc1\xde\xbd\x7f\x56\x03\x97\x83\xa0\x38\x35\xc7\x72\x30\x15\xca\x3b\x5f\x55\x09\x85\xa2\x79\x27\x95\xae\xe6\xf0\xe9\x60\xd7\x49\xec\x3a\x89\x5d\x87\x83\xc5\x8f\x15\x3f\x54\xfc\x48\xc1\x81\xe2\xc7\x89\x1f\x26\x7e\x94\xe0\x20\xf1\x63\xc4\x0f\x11\x3f\x42\xaa\xba\x2f\x55\xbd\xe9\xec\x8e\x79\xa2\x9f\x2a\xcf\xa6\x7b\xff\x8c\x2d\xb5\x4e\xd3\xb9\x1c\xfb\xaa\xcb\x56\x1a\xac\xd9\x5e\x0d\xd7\xf2\xe7\x4d\xf7\xab\x32\x73\xdf\xa2\xf5\x06\x5d\x69\xcf\x65\x8f\x66\x5a\xe0\xed\x7d\xc2\xa8\x2c\x1a\xc3\xb2\x08\xe3\xb2\x68\x0c\xcc\xa2\x1a\x99\xc5\xd2\xd0\x2c\xea\x63\xb3\x08\x83\xb3\xa8\x8f\xce\xa2\x1a\x9e\xc5\xd2\xf8\xc4\x65\xa3\xa8\x3a\xa9\x58\xea\xa5\xa2\xea\x81\x62\xa9\x0b\x8a\x7a\x73\x17\x2b\xed\x5d\x34\x1b\xbc\x78\xae\x35\x8b\x7a\x73\x16\x2b\xed\x59\xe0\xf8\x2c\x70\x7c\x16\x38\x3e\xd1\x03\xdd\xe4\xd4\x32\xa9\x33\x32\x8e\xd2\xfe\x15\x9f\x1d\xe3\xaa\x88\xb7\x6f\xc2\x9c\x10\x62\x63\xd9\xba\x30\x2c\x05\x5e\x90\x52\xe8\x69\x2a\xf3\x33\x69\x1d\x57\xb0\xf0\x56\x7e\xb7\x29\x57\x95\x07\x3d\x3f\x24\x6b\x92\xfa\x6a\x94\xe5\xc0\x0b\xe8\x8d\xb7\xb4\xea\x3f\x17\xe6\xec\xb1\x4a\xce\x3d\x5a\x74\x83\x0c\xad\xcb\x51\x7b\x62\x06\x88\x77\xf5\x45\x15\x9e\x17\x4e\x7c\xbd\x94\x2b\x31\x96\xc2\x9e\x29\x23\x04\xfd\x41\xbe\xfe\x23\x70\xa1\xc0\xb6\xa5\xe0\xf7\xda\x3c\xbe\x36\xba\xc8\xe9\x92\x74\x7d\x58\xad\x67\xce\x0c\x7f\x6a\x96\x3e\xb4\xca\x57\x2a\xb8\x36\xca\x72\xe0\xba\x2a\x96\x61\x2a\x2f\xdc\x4a\x15\x1a\xa1\x44\x53\xb0\x1c\xf4\x5c\xed\x97\xc2\x9b\x1f\xbf\x16\x3a\x13\xce\xcc\xbf\x52\xa3\xb5\x51\x96\x03\xd7\xd5\xa8\x0c\x5b\x5b\xa3\x10\x4a\x23\xec\x0f\x82\xdf\x05\xc4\xa4\x19\xf8\xdc\x78\x28\xc3\xb5\xe3\xe9\x1f\x64\xf0\x5c\xb3\x85\xf0\x77\x84\x1b\xad\x84\x63\xa6\x5d\x7f\x42\xac\x79\xd5\xcb\x71\x29\x47\xe3\xd2\x71\x0b\x08\xf4\x52\x45\x6e\x49\x4c\x59\x4f\xcc\x44\x7a\x43\x48\x38\x05\xf4\x84\xb5\xfe\x8d\xf3\x2b\x5d\xf3\x5c\xac\x35\xe1\xeb\x3a\xa8\x1e\xbc\xb6\x8f\x6a\x11\x9e\x69\xc5\x5a\x8c\xe7\x3a\xa3\x1e\xe5\x2b\xfd\x51\x8b\xf6\x5c\x97\x40\x94\xce\x94\xa7\x24\x6d\xe2\x0f\xda\xe5\x99\x88\xeb\xa3\x3c\xd7\x3a\xb5\x18\xcf\x36\x50\x15\xe7\x2b\x35\xab\x22\x7d\xad\x99\xaa\x58\x5f\x6b\x82\xd7\x42\x91\xfc\x98\xca\x0b\xc7\xd6\x9a\xc8\x98\xd5\xa9\xd6\x26\x59\xf2\x5b\x93\x3d\xfa\xd7\xbd\x9e\x1b\xf9\xde\xff\x1c\x50\xee\x25\xbf\xe6\xe2\x02\x83\x5d\xac\xdb\xfe\xbe\x12\x61\x39\xc8\x19\x39\x68\xfa\xad\xeb\x2b\x0a\x79\xa6\xfd\xeb\x81\xeb\x0a\xf8\x5c\x93\x50\xe8\x73\x3d\xd1\x08\x5d\x4a\x56\x8a\xa7\x5c\x1b\x1f\x55\xaa\xf5\xb3\xfc\x84\xc7\x8f\x44\x76\xe8\x1d\xb8\x5a\x5c\xe9\x44\xa4\xb7\x5e\xd8\x69\x3d\x60\xc9\xef\x8a\x3b\x61\x24\x4f\x83\xbb\x8b\x42\x92\x38\x32\x61\x52\x92\x75\xc4\x22\xb8\x11\xa9\x08\x0e\xaa\x01\xba\xc2\x37\x67\x22\x77\x63\x7a\xa0\x1d\xa5\xfd\xce\x5c\xe0\x07\xbf\xd4\x1d\x77\x75\x47\xb7\xee\xe8\x81\xe3\x5c\x8f\xbc\x85\xc3\xce\xc3\xb0\x07\x55\x6e\x74\xf8\x1c\x57\x42\x90\x16\x28\xc0\x50\x1c\x72\x68\xe5\x6a\xdf\x68\xe5\xce\xe9\xba\x25\x38\xd7\x36\x5e\x15\xb2\xec\x59\x6f\x3e\xf4\x58\x6d\xbf\xd7\x28\x95\xf0\xa2\xbc\xaf\xb9\x38\x03\xdf\x0b\x45\x2d\x1a\x5c\xcd\xd0\x4b\x3e\x17\xf8\x71\x55\x9c\xd2\xd5\x45\xda\x23\x74\xea\x78\x35\xe1\x65\x4f\x62\xe1\x49\xfb\x98\x08\x8c\xa8\x2b\xcf\x23\xb3\xe2\x75\xa1\xa6\x02\xb9\x7a\x9e\x0b\xe9\x95\x32\x14\x56\xa3\xac\x0f\xbb\x35\xfa\xa3\xc0\xdb\xad\x35\xb9\x2e\x07\xae\xcb\xff\x99\x38\x7f\x2e\xb7\x66\xac\x22\x75\xb2\x27\x66\x38\x48\x5e\xae\xf8\xec\xad\xf8\xec\xaf\xf8\xbc\x5a\xf1\xf9\x61\xc5\xe7\xaf\x2b\x3e\x3f\xae\xf8\xfc\x3b\xf8\x5c\x13\x7b\x64\x09\x55\x0d\x44\x6e\xa2\x76\x02\x67\x91\xd5\xd0\x83\xeb\x22\x2b\x71\x4c\xf4\xb8\x19\x40\x9d\xab\xf9\x55\x73\xdf\x2d\xb9\xbb\x4b\x6e\x9c\x68\x0d\x74\xe2\x42\xa1\x97\x96\x84\xd7\x22\x50\xce\x2a\x74\x05\xbc\xb6\xe4\x4b\x06\xd8\xc8\x0c\x85\x09\xd2\x08\xac\x39\x7d\x7a\x6b\x46\xc1\x57\x3a\xff\xcf\xf4\xf9\x57\xbb\x9a\x9a\x8b\x0a\x43\xf0\x15\xb7\x8f\x4d\x17\x95\x8c\xdc\xf5\xba\xac\xce\x5f\x42\x28\x7c\x1b\x80\x03\x37\xcd\x0b\xa5\x84\x69\xf8\xdc\x14\x2e\xf8\xcc\x6a\xf1\x71\x4f\xe8\xa8\x69\x2d\x3d\xf4\xaa\x9a\xa2\xe1\x51\x6b\x87\x75\xfe\x8d\x7a\x36\x22\x34\x42\xaa\x25\xd9\xef\x75\xd8\x16\x61\x3f\x29\xd7\x84\x72\x24\x51\xf8\xca\xe1\x45\x62\x93\xe8\xc2\xb1\x4e\x26\x5d\x17\x6f\x06\x7d\xd2\xe0\x11\x40\x95\x94\x5d\x0e\x0e\x8a\x57\x79\x55\xd2\xd7\x49\xc8\x32\x4a\xc9\x9c\x7b\xd1\xeb\xa5\xc4\x65\x2f\x76\x99\xae\x05\x8f\x93\x24\x40\x2a\x40\xb0\x73\x76\x9f\x78\x5e\x3a\x71\x9f\x6f\xba\xce\x44\x6c\x90\x04\xaf\xe9\x7d\xa1\x9a\xde\x9d\x19\x5d\xde\x7b\xe7\x15\x9f\x05\x08\xa5\xc1\x03\x74\x13\x52\xfe\x55\x1b\x36\xe0\xc6\x48\x61\xd8\x40\x7a\x1a\xa5\x81\x74\x43\x29\xd2\xa4\x33\x23\xf9\xb7\xc1\x4d\x4c\xfd\xa5\xf3\x4e\x50\x67\x92\x28\xca\x94\x67\x79\x4d\x6e\x86\xd1\xd6\xb2\xe4\x3c\x99\x81\x31\x67\x89\x18\x19\x21\x2c\x4b\x84\x23\x29\x7f\xca\xb1\x24\x6c\xbb\x89\x76\xf4\xa4\xe0\xb9\x6c\xf3\x7d\x36\xe4\xb1\x18\x12\x2f\x3d\xca\x72\xbe\xec\x9e\xa0\x7d\x05\x36\xa0\x54\x27\xd2\xd9\x9e\xbe\x20\x41\xed\xc1\xf9\x56\x56\x82\xdb\x33\x4e\x6f\x0f\x6c\x68\x50\xca\x25\x49\x0d\x47\x4d\x38\x24\x75\xca\x3f\x7e\xf4\xc6\x5c\xd5\xe1\x0e\x16\x64\x04\x03\xe5\x4a\x64\xda\xcc\x4f\x7c\xf3\xe0\x1d\xcd\x71\xd9\x81\x75\xa7\x6a\x38\xcb\xae\xac\xf9\x95\x7d\x52\xf3\x83\x8e\xa9\x3b\xa5\xaa\x3b\x6f\x1a\x39\x42\x3f\xa1\x13\x59\x5a\x11\xc2\x61\x4c\x90\xd3\x06\xc0\x22\xed\xa0\xdc\x6f\x81\x0c\x85\xd4\x24\xe7\x74\xe5\x8b\x84\x51\x3a\xe5\x4e\x1c\xbb\xd3\xf0\x6a\xd4\xf0\xf5\x28\x57\xc3\x8f\x16\x10\x26\xfd\xac\xf5\x36\x92\x83\x22\xcf\x1f\x93\x56\x71\xc5\x52\xd4\xce\x90\x12\x77\x40\x2a\xac\xc5\x36\x0d\x00\x35\xa8\x67\x4c\xdb\xf7\x4f\xfe\xd0\x46\xb0\x6b\x90\xdc\xd0\x46\x3b\x37\x5c\x0d\xac\xb7\x19\xe4\x6f\xaa\x1a\x7e\x88\x9d\x36\xbd\xba\xf4\x1a\x80\xb2\x49\xe5\x0c\xe5\x93\x66\x3a\x19\x32\xa5\xa5\x15\x7b\x64\xed\x93\xf5\x8a\xac\x1f\xbc\x8c\x52\x68\x5f\x85\x42\xcc\x1d\xd5\x41\xc3\x1a\x18\x70\xa4\x9c\xc7\x8f\x67\x78\xd1\xbe\x3f\x7b\xb5\x8f\xce\x37\x3c\x1d\xee\xcf\xf6\x7e\x44\x47\x17\x65\x9f\x37\x5c\x3f\xcc\x7e\x42\xc7\xdb\x46\xd0\xdb\x32\x48\x3f\xa1\xe0\x7e\xae\x2c\x33\x62\x88\x24\xd7\x46\xd0\x20\x0e\x74\xe1\x85\x4a\x3a\x53\xa1\x98\xc5\xeb\xca\xbd\xb3\x12\xb8\xd4\x49\x09\xc3\xf6\xd1\x70\x54\x81\xfb\x67\x25\x50\xf7\xac\x7d\xb1\x5f\x7d\xd1\x54\xf4\x80\xcf\x29\xd6\x89\x3c\xd0\x03\x8b\xbc\x4e\xf7\x3a\x13\x29\x4a\x21\xa9\x81\x7e\x48\x39\x5a\xc8\x83\xbd\x77\x56\x41\x90\x4d\xe9\xc0\x42\x34\x5c\xb5\xe0\xfd\xb3\x0a\x6a\x78\xd7\xbf\xda\x5f\xfe\xea\xd5\x59\x05\x35\xbc\xeb\x5f\xbd\x5a\xfe\x0a\x2f\x3d\x6b\x70\x2d\xe8\x35\x77\x63\x61\x9a\xae\x66\x35\xeb\x7e\xb6\xf4\x34\x3c\xa9\xc3\xcd\x4f\x6a\xe9\x5f\xea\xa5\xb0\xa6\xab\x5e\x6c\x80\xeb\xe9\x06\xf7\xea\x17\xb5\xf4\xbd\x73\x35\x4e\xd3\x07\xc5\x99\x78\xba\xc8\x86\x57\x95\x12\x2e\x3f\x75\xdd\x0d\x28\x35\x97\x27\xc9\x29\x37\x86\xae\xb0\xeb\x2b\x50\x51\x5f\x9d\x48\xb9\x43\x6d\xf6\x90\x47\x39\x7f\xc8\x59\xcd\xa0\x9a\x1b\x26\x0a\x39\xdf\x2e\x05\x97\xf3\xa8\xb0\xc5\xe0\x44\x1b\xa3\x9f\x6a\x24\x9b\x35\xc5\x7a\x5f\xb6\x65\x45\x1d\xb6\x44\x71\x73\x7d\xb5\xbd\x9e\x44\xf0\x2b\xb4\x46\xfa\xeb\xc2\x2a\x89\x22\x20\x3c\xec\x43\xcc\x9a\xb8\xf6\xba\x26\xb7\x8c\xe7\x41\xe0\x20\x6c\x16\x44\x30\x80\xb2\x1e\xa1\x7a\xb0\xb0\x05\x4a\x01\x7c\xcf\x1f\xf2\x34\x75\x63\xa3\x8b\x11\x0a\x80\x24\x95\x1e\x48\xbf\x5d\x57\xc6\x16\x34\x9c\x79\x8a\x03\x28\xdc\x48\x93\x6e\x33\xff\x30\x39\x27\x51\x5b\x79\x2a\x66\x3f\xfe\x10\xa0\xbd\xfd\x9f\x68\x63\x7d\xb5\x4f\xf6\x8f\xc8\x5f\xfe\x13\x43\xb5\x64\x0c\x55\x92\x31\x54\x47\x16\x44\x25\x16\x18\x5c\x50\x78\x41\x11\x0a\x8a\x81\xaf\x19\xc1\xc8\x9d\x61\xa6\x50\x62\x6d\xdf\x30\xa9\x1d\x67\x4a\xa6\x0d\x21\x88\x24\x4d\x22\xe6\x79\x29\x32\x2c\x48\x03\xcb\xe9\x11\x10\xf6\x1e\x62\x5d\x56\xe2\x89\xe5\x5c\xc9\xb8\x2e\x3d\x32\x55\x25\xc7\x95\x9e\x22\xb9\x43\x2a\x5c\x4d\x3f\x43\x8d\x2a\x51\xa7\x5c\x8d\x90\x6e\x06\x49\x12\xff\xb1\xdb\x5a\x1d\x26\xcb\xfa\x26\x3d\xe5\x54\x29\xe5\xf7\xfe\x43\x14\x45\xd1\x83\x17\xf6\xdb\xfa\xaf\xe8\xf9\x5f\x2b\x02\x95\xa0\x3a\x81\xa8\x88\x90\x46\x6b\xab\x75\x9f\x0c\x53\x2b\x49\x2e\xfc\x97\x4a\x12\xeb\xe0\x60\xf7\xe0\x70\x77\x3d\x3d\x61\xa1\x62\x54\x30\x19\xd9\x17\xd1\xe7\xdd\xba\x00\xfa\x9e\x2f\xe4\xf3\xd4\x9a\xf2\x60\xf7\xbe\xf5\xfd\xc3\xee\x97\xaf\x11\xd1\xe9\x54\xff\x21\xbd\x4b\xad\x81\x93\x34\xc8\xec\xf4\x63\xce\x2b\x1b\x28\x35\x18\x90\x2c\x51\x3f\x12\x53\xa2\xef\xf7\x34\x30\x5e\xba\xa9\xe0\xb0\x81\xc8\x0c\xe5\x28\xd0\xac\x40\x2d\x01\xa4\xc3\x00\xb5\x04\x2c\x11\xd8\xf0\x62\x44\xa8\x2f\xd9\x5e\xd4\x2e\x24\x03\xe8\x22\x1b\xca\x14\xd9\xaa\x44\x52\xc4\x02\xa6\x09\xca\x6b\xc7\x99\xa5\x61\x9d\x46\x0e\x7f\x87\x72\xec\x1d\x7d\x83\xca\x17\x0c\x47\xff\xb1\xd1\x4f\x4c\x9b\x8b\x21\x0a\x9c\x3a\x9b\x2b\x0e\x88\x19\x1e\x31\x17\x7b\x2f\x83\xc7\xbb\x20\x03\xd8\x4f\xde\x9b\x81\x15\x66\x8a\x3e\x38\xa5\xa1\xa2\xc4\x77\x80\x4c\x06\x48\x00\xb9\x7e\xf4\xab\x22\x4d\x83\x26\xce\x37\x35\xe1\xbc\xab\xcb\xd2\x3f\x83\x8e\x80\xad\xaf\xad\x4c\x86\x27\xa9\x58\xaf\x59\xd6\x13\xde\x56\x1d\xe9\xe0\x10\x99\xd3\xc1\x13\x4e\x31\x1e\xb4\xcc\x16\x83\x12\xe6\xc6\xc9\x21\x07\x08\xa6\x67\x62\x63\x23\x73\xd4\x00\x37\x94\xa3\xc2\x78\x82\x9d\x44\xc0\x7c\x15\x2a\x96\x02\x10\x19\x94\xc5\xaf\x0d\x38\x88\xb1\xac\x0b\x1b\x63\x22\xe8\x63\xaf\x18\x4e\x38\xc1\x86\x46\x67\xb0\x56\xe8\x52\xd9\x9b\x98\x79\xa5\x6f\x74\x29\x84\x4c\x92\xc8\x96\x96\x08\xeb\x3c\x7f\xfd\x99\x34\x21\xbe\x2d\xd5\x00\xd0\x07\x80\x64\x72\x27\x3d\xb1\x1b\x69\x63\x6b\xfa\x21\x9a\x8f\x04\x4c\x90\x4c\xa2\x49\xe2\x97\x26\x49\x60\xe0\xed\x90\x71\x26\x91\x06\x4b\x07\x36\x9e\x5c\xc4\xb0\x52\x25\x19\x64\x10\xc3\xc2\xc4\xfd\xf2\xec\x89\xbf\x82\x62\x10\x4f\xfc\x15\x94\x4e\x92\x22\xc9\xba\xa2\x0f\xaf\xc8\xd5\xf3\x57\x49\x7f\x7b\xa6\x87\x4c\x79\x65\x07\xb0\xac\x05\x25\x1c\xa5\x5a\xcb\x52\x1d\x87\x57\x44\x4a\x6a\x38\x68\x8f\x08\x4a\x32\xe5\x27\x91\xf8\x81\x8c\xa6\xa5\x3d\x5b\xc2\x9c\x81\x71\x5f\xd1\xb0\x10\x2d\x1a\x52\x07\x0d\xf3\x72\xcb\xf1\x53\xb9\x1a\x9f\xcc\x16\xb9\x20\x3d\x37\xa4\xe3\x83\x2b\x97\x1a\x1c\x3d\x28\x13\xc9\x22\x4b\x78\xac\x13\xa1\xb8\x89\x69\x8d\x86\x8d\x46\x70\xe5\xf7\x1a\x54\x42\x46\x9a\x53\x88\x50\x65\x18\xce\x28\xb5\x8d\x11\xb5\x64\x92\xfa\x4c\x54\x16\x12\xc4\x34\x9b\x42\xf5\x64\x26\x2a\xed\x12\x3d\x23\xfc\x21\x1c\x4e\xdd\xb4\x55\x70\x8b\x1c\xca\x1c\xd9\x62\x70\x22\x92\x88\x68\x2f\xca\x1e\xd2\xe2\x46\xf4\xb4\xd7\x15\x86\xca\x12\x8a\x2c\xa7\x75\x03\x8c\x13\x28\x28\x00\x28\x2e\x0d\x80\x4b\xa9\x04\x83\x03\xef\x3c\x2c\x2c\x2a\x39\x4e\xd3\x72\x90\x8c\x84\x3b\x46\xfd\x51\x1d\x83\xd2\x75\x2e\xd0\xeb\xa6\x40\x3f\x14\x90\x37\x32\x02\x8e\x84\x41\xf5\x8a\x54\x1f\x49\xd2\x0a\xd2\x97\x51\xcd\xbd\xec\x95\xa9\x7e\x14\x57\xc2\x8d\x75\x62\x99\xb4\xa7\x30\xb2\x90\x25\x25\x15\x43\xd7\x45\x6d\x65\x99\x54\x85\x65\x59\x91\x3a\x99\xa7\x73\x18\x1e\xf8\xf2\x42\x77\x13\xe0\xa4\x7b\xfc\xca\x7d\x0b\xeb\xf2\x7b\x62\x4d\x52\xe2\xe9\x8e\xc4\xe8\x28\xf1\x54\xfa\xcd\x1c\xcb\xd3\xc2\x92\xfc\x65\x94\x1b\x12\x38\xcd\x97\xb6\x52\x14\xc1\x9c\x17\x50\x5d\xe4\x09\x42\x3e\x37\x94\xfc\x01\xd0\x25\x92\xe4\x19\x7f\x35\xe0\xed\x0e\xb4\x2b\x1d\x61\x48\x2f\x18\x74\x2b\x72\xfc\x75\x42\xd3\xd2\xc1\xa2\x18\x5c\xf1\xdc\x6f\x00\x0e\x4e\xf7\x48\x39\xe7\x34\x76\x27\xb2\xb1\xc1\xe7\x45\xee\x34\x32\x61\x9d\x6b\x73\x63\x50\x91\x00\x6c\x0d\xf5\x3a\x82\xdb\x57\x12\x40\xdf\x10\x00\xd6\x22\x10\xe8\x85\x55\x11\x23\x13\x9a\x97\x25\x17\xc6\x32\x7e\x48\x52\xd2\x69\x51\x46\x22\xfc\xbb\xaf\x90\x8b\x1a\xad\xa7\xf3\xe7\x36\xd0\x67\x97\xff\x4a\xfb\x31\xce\x16\xaf\x41\x96\x34\xf3\x39\x9a\x16\x61\x3a\x79\xed\x85\x34\x89\x70\x96\xe0\xc2\x84\x7b\xa7\x57\x56\xe4\x0c\x97\xae\x3e\x65\xcb\x65\xcb\x2f\x17\xe5\xfc\xaf\x96\x05\xbf\xac\xac\xae\x37\x8d\x35\x85\x16\x31\x9a\xc7\x75\x75\xca\xa4\x96\xba\x24\x76\x5d\x25\x71\x6d\xe2\xc3\xe5\x62\xb9\xb4\x46\x2d\xef\xc8\xb5\xe5\x31\x2c\xa3\xb0\x28\xfa\xe5\x29\xac\x56\x7e\x29\xac\x16\xd0\xb2\x1b\x37\x5a\xbb\xa8\xcc\xc7\x6b\xf5\xd9\x25\x6d\x56\x25\xa7\xcd\xd3\xd6\x3f\x77\x6b\x04\xc9\x89\x8e\x1d\x1f\xad\xd9\x69\x97\xc5\xe7\x7f\x9d\x9e\x78\x99\x52\xf9\xfb\xef\xbd\x1e\x88\xef\xbf\x6f\xad\x86\x46\xd1\x77\x5e\xeb\x43\x14\x7d\x17\x14\x04\xd5\xb4\x80\x19\x31\x12\xb3\x1c\xb1\xb9\xff\x76\x14\xed\xde\x7f\x88\x76\x23\xf5\xb0\x15\xed\xee\x36\x74\x04\x95\x2a\xc1\x2a\xbd\x09\xdf\x7e\xb3\x5b\x58\xb3\x3b\x90\x6a\x57\xa8\x69\xe0\x1f\x92\x07\xad\x48\x21\x65\xf4\x49\xc0\x2f\x6a\x1a\x8e\x96\x7b\x96\x46\x12\xf4\x2f\x7e\xff\x19\xbf\x3f\x68\x95\xaa\x07\x6a\x1a\x95\x7d\x47\x61\xd2\x6f\x7b\x57\xa5\xcc\xff\xe7\x51\x98\xe5\xb6\x80\xca\x1d\x3d\x7c\x7e\xf9\xc5\xc7\x79\xd9\xfe\x5b\xff\xbb\x87\x2d\xf6\xe2\x20\x68\xdb\x38\x22\x74\xf6\x60\x77\x55\x87\xd2\x87\x28\xb2\x2f\x9a\x1f\x1e\x78\xa5\x62\x80\xf7\x7e\xbb\xf8\x47\xb4\xbb\xfb\xdc\xac\x1d\xf3\x6c\x3d\x91\x37\x61\x4c\xcb\x64\xfb\xad\x0f\xdf\x7c\xf3\xcd\x06\xdb\xf8\xeb\x62\x2f\x8a\x76\xf6\x16\x5d\xdc\x3f\x17\xe7\x86\x67\xc2\x0a\xb7\x38\xe1\x56\xc6\x8b\x2b\x3d\x90\xa9\x58\xdc\x9d\x9d\xf3\xc5\xaf\x57\x97\x51\x34\xd8\x79\xb1\xb9\x79\x54\xeb\x64\x52\xd1\x60\x5f\x6c\x7c\x73\xf8\xed\xe2\xf0\xdb\x45\xfb\xdb\xc5\xee\xe6\xce\x8b\xef\x5a\xdb\xdf\xec\x95\x7a\xd7\x42\xdd\x36\xda\x8b\xc3\xc5\x37\x87\x9b\x1b\x47\xdf\x7c\xbb\xd9\xda\x46\x05\x2f\xf6\xe0\xb3\xf0\xaa\x44\xec\xe5\x41\xcb\x14\x83\x79\xeb\x8b\x6f\x9b\x30\x94\xf1\xe3\xbf\xd4\x68\xe8\xf1\xf0\xa9\x4d\x3b\x44\x88\xa2\xa7\xaa\x33\x42\xa0\x24\x45\x1a\xdf\xde\x47\xd1\x53\xfb\x61\x35\x3c\x8c\x19\xf8\x7c\xa7\x16\x69\x70\xd0\xfa\x4c\x6c\x4c\x58\x30\xfb\xe2\x8b\x1f\x2c\x07\xad\x03\xc8\x08\xbc\x0e\x7f\xc6\xd0\x6d\x62\xf8\x21\x66\xa3\x52\x13\x49\x93\x19\xe4\xc0\x17\xae\xbe\x08\x7f\x0e\x65\xf6\x1a\x47\x1e\x02\xd7\xc0\x46\x23\xe3\x28\xda\xac\xe9\xe7\x0a\x1f\x61\xfe\x21\xce\x1f\xe5\x5e\xe5\xf2\x07\x05\xf0\x45\xa0\x31\x78\xf8\xdf\x1e\xb0\x20\xe4\xf7\xed\xe7\x46\x5f\x7d\x69\xf4\xd4\xb3\xdb\x08\x69\xd7\x19\x70\xb3\x5e\x27\x06\x71\xc2\x48\xd5\x46\x16\x9c\x83\x16\xa2\x30\x52\xd1\x59\x29\x2c\xc4\x85\x42\xda\xfe\x81\x54\x09\xd4\x28\x28\x64\xf6\xf8\x10\xa1\xe0\x83\x62\x84\x0a\xb9\xf4\x88\xe9\xc2\xa5\x78\x17\xe4\x59\x3c\x0a\x45\xca\x11\x91\x63\x9a\xb4\xb4\xad\x39\x3b\x8f\x07\xb6\xb5\xdd\x1a\xbb\x2c\xdd\xa9\x83\x55\xf1\x03\x0b\x0c\xd4\x7a\x06\xb3\x2c\xac\xcc\x9f\x3f\x7f\xb3\xd1\x6e\x6f\x1e\xb5\xb6\x5b\x68\x7f\xf9\x12\x34\xae\x85\x22\x84\x11\xba\x1b\x7d\x8e\x3e\xdf\x7f\x1b\xed\x3e\xd0\xf4\xff\x12\x7d\xa9\xf4\xc4\x05\x26\xa4\x5d\xaf\xe4\x2f\xda\x69\x3f\x6c\xd1\x71\xb7\x6c\x76\xec\xdd\x92\x55\x91\xb8\x3d\xc2\xb9\xbc\xcc\xac\xce\x41\x82\x39\xd6\x32\x7b\x3c\x70\x5f\xeb\x2b\xfb\x28\xd2\x74\x6d\x47\x4d\x7d\x65\xdb\xed\x4a\x0f\xcb\x46\xeb\x33\xb8\xda\x5f\x5a\xdb\x9f\xe3\x83\x7b\x98\x53\xc3\xd6\xc3\x97\xcd\x87\x55\x56\xd5\xcf\x6d\xba\x89\xfa\xb6\xfd\xa5\x55\x2a\x25\xaa\xad\xf0\xe1\x9a\x2a\xf0\x99\x91\x82\x3a\x62\xc5\x83\xb5\xf0\x01\x66\xe6\xf7\x0f\x81\xed\x86\x94\x0c\x45\xd1\x86\x9f\x00\x9b\xad\xea\x52\xcc\x6c\xf3\xed\xe7\xd3\x78\x78\xb1\x11\x45\x1b\x1b\xa8\xde\x71\x67\xb1\xbd\x80\xe1\xbf\x19\x45\x9b\x9b\x47\x38\x39\x97\x55\x2e\xb6\xee\xfb\xa8\x3a\xc6\xe7\xfe\x65\x13\x35\xe3\xa1\xa6\x22\xd8\x43\x68\x1e\xc4\x07\x6a\x27\x5e\x37\xa4\xbc\x26\xc6\x94\xb4\x68\x37\x8e\xef\x88\x4b\xe8\xa1\x67\xbe\x4f\x74\xb8\x6d\xf0\x18\xc1\x58\x22\xd7\xcc\xa4\xe0\xa9\x1c\x4a\x38\x03\xcd\x73\x92\xaf\x0e\x78\x03\x39\x84\x91\x53\xe4\x9a\xf1\x5b\x9d\x7f\x8e\xe2\x95\x4a\x47\xa9\x50\x5f\x35\x98\x29\x59\x74\x4d\x21\x47\xa4\x29\x18\x50\x33\x3c\x5a\x58\x97\xd0\x11\x03\x70\x68\xc4\xa3\x3f\x4e\x33\x96\x68\xa7\x84\x63\x96\x0f\x61\x12\xa1\x35\xe4\x99\xa4\x43\x1f\x44\xcf\x12\x4d\x7a\x4d\x8c\x88\xab\xad\x94\x6a\x82\x8d\x83\xb5\x6b\x41\x1b\xf8\xea\x79\x8f\xf8\xe0\x5e\x6d\x3b\xaf\x3e\xea\x3d\xf4\xc4\xdf\x4b\x96\xa9\x01\x35\x01\x2a\x0f\x0c\x9c\xc2\x2d\xdf\x2c\x55\x7b\x70\xeb\xdb\xe8\xd9\xc4\xea\x08\xc1\x41\xeb\x03\xae\xa9\x9b\x47\x1b\xe8\xb5\x08\xad\xb5\x19\xb2\xa9\x4a\x4a\xcd\xe9\x2b\x5a\xb6\x6a\x55\x70\xb9\xad\x2a\xf5\x88\xf5\xdd\x63\xb0\x01\xfd\xb3\xd8\x50\xe2\x69\xf3\x08\xba\x68\xb3\x59\x07\xec\x3d\x9f\x2e\xf6\xe0\x72\x4f\xb6\x68\xf8\x42\x06\x9a\xb2\x80\xe6\xf4\x9d\x59\x53\x8b\x54\x96\x80\xb8\xaa\x57\xfa\xb8\x16\x95\x30\x17\x57\xee\x2c\x03\xdf\xfd\xcd\x92\x85\x31\xe1\x5b\xf9\x7f\x69\x68\xb4\x82\x9a\x2f\xd8\x58\x56\xd5\x47\x7e\xfb\x4d\x14\x01\x16\x17\x45\x80\xc7\x45\x11\x60\x72\x70\x1a\x2e\xd7\x1c\x3f\xff\x61\xf6\xd6\xef\x8d\xe4\xba\x49\xf9\x61\x75\x56\xa2\x7f\xfb\xe7\xc5\x3f\xda\x5f\xb9\x51\x1a\xf3\xd9\x7a\xb6\xda\x0b\xe5\x18\x5d\xf4\x77\xe9\x3a\xf8\x44\xeb\xf2\xc6\x8c\xbd\x83\xb3\x09\x51\x8e\xb7\xd6\xcc\xf2\x19\xce\xf2\x15\x1e\x3a\xba\x51\xb1\xee\x2b\xd7\x2a\x89\xcf\xc0\xb3\xd7\x17\x59\xa9\x4b\x95\xb4\xa7\x7a\xdd\xdd\xb0\x42\xd0\x15\x0b\x74\x0b\x72\x9d\x21\x37\xa3\x82\xe3\x29\x9e\x23\x2a\x55\xa7\xfe\x24\x11\x4e\x3f\x23\x52\xff\xf5\xcc\x5d\x0b\x9e\xab\xfc\xe1\x86\x6e\x44\xe8\x14\xe3\x15\x05\xd1\x30\x4d\x50\x8a\x0a\x42\x5e\x47\x64\x75\xef\xd9\xda\x72\xb5\x9b\x6e\xff\xdd\x58\xda\xe7\xef\x07\x59\xbf\xc6\xdd\x5f\x3f\x5b\x78\x0d\x73\xe1\x2c\xe0\xb1\xb4\x62\x60\x5d\x38\x5d\x44\xd1\x67\xbf\xdc\x7f\x29\xb1\xb8\x7a\xb8\x0f\x7c\xff\xc5\x4b\x05\xf0\x83\xe8\x0f\x5e\x48\xfe\x79\x50\x8e\xbd\x3f\x2f\x5d\xa1\x54\x84\x1b\x74\x64\x25\x24\x88\xbc\xc2\x59\xc3\xde\x73\x70\xcf\xfe\xcb\xc3\x0b\x7f\xb1\x5e\x21\xf5\xec\xbf\x44\x51\xfb\xe7\x87\x56\x5d\x59\x5f\xed\x00\xb7\xee\xf3\x28\x7a\xdf\x6a\xea\xf6\x6b\x46\x85\x71\xf0\xd5\x98\xb5\xd3\x51\x79\x0e\xc2\x36\xf5\x97\xee\xcb\x6b\xda\xbf\x2a\x1d\xc2\x54\xfe\x28\xfa\xfc\xdd\x43\x85\x7f\xd7\xf6\xd7\xda\x3e\xfc\x95\x12\xe2\x0d\xe9\xd6\x9f\xaa\x8c\xd3\xcf\x46\xc4\xa2\x36\x26\x5c\x79\x29\x80\x19\x38\x5d\xf6\x42\x73\x75\x0e\x2b\xbf\x3f\x12\x6e\xfa\x1c\x42\xb5\x02\xa7\xfe\xf2\xd1\xb1\xb5\x4e\x93\x2f\x26\xe8\xcf\x8c\x8b\xea\xcc\x88\x69\x3e\xae\x3d\x4d\xae\x34\x9b\xd8\xb9\xb8\xf3\x78\xf7\x76\xbd\xf9\x57\x9f\x49\xc8\x25\x42\x43\x63\x39\x71\xb3\xeb\xd6\xfa\x0e\x0f\x87\x5f\x3d\x1a\xda\xfc\x2b\x27\xc3\xf4\x60\xf7\x3e\x7a\x8a\x76\xfa\x84\x73\xb6\x6a\x4a\xda\xfc\xa2\x41\xcb\x9a\x57\x3d\x8b\x1a\x5c\xca\x25\x0d\xc5\x0a\x71\x34\x13\x99\x31\x0b\x46\x78\x0e\x49\x64\x46\x9b\x5c\x91\xc3\x3f\x77\xa8\x40\x8e\xd4\x94\x29\xf1\x94\xe9\x84\x25\x22\x45\xba\x06\x23\x86\xa8\xf2\x4c\xcc\x30\x77\xad\x58\x86\x5b\x8f\xbf\x0c\x4a\xf5\x28\x13\x78\x6f\x92\x72\x28\x95\x2d\x32\x41\xf8\x3c\xd3\x4a\xcc\x24\x0a\x9e\x45\xe1\xef\x5a\x3d\x8a\x39\xd3\x2a\x4e\x65\xfc\x08\x76\x96\x30\x31\x43\x35\x34\x74\x57\x9f\x3d\x26\xa5\xd2\x9f\x44\x1a\x7c\xd8\x18\xa0\x7a\x9a\x01\x6a\xa2\x19\xe0\xbd\x6f\x26\x32\xbc\xa7\x0d\xb3\x3d\xd7\x8f\x82\x3d\xa1\x99\xa2\x39\x22\x4d\x03\xf1\x38\xc9\x33\x88\x2d\x66\x39\x57\x09\x40\x31\x7d\x0e\x4b\xaf\xd2\x4e\x58\x91\xa2\xcd\x93\x04\xed\xc4\xbb\x31\x4b\x8c\x00\xb9\x1a\xae\x12\x9d\x79\xba\x0d\x51\xa8\xf0\x15\x2e\xe2\x41\xd2\x98\x11\xf8\x26\x83\xea\x4e\x07\x85\x73\x70\x10\x1a\x8f\x12\x69\x73\x12\xf8\x9f\x48\x0e\x87\xa0\x2c\xc3\xb4\xb3\x0c\x95\x1a\x67\x19\xb6\x77\x16\x4b\x96\xe3\x76\x80\x49\xce\x6d\x8c\x74\x6f\x28\x2d\x13\x2f\x49\x49\x2e\x57\xae\x2d\x8b\xa5\x89\x53\x81\x9a\x06\x87\xa8\x5b\x78\x6e\xd1\xd6\x83\x8f\x78\x07\x9a\xcb\x18\x33\xa0\x14\x72\x9e\x96\x80\x70\x4e\x30\x23\x50\x8d\x85\x27\x55\x82\x8a\x8c\xb0\x49\x47\x9f\xb4\xce\xd8\x08\xd9\x88\x07\x59\x8e\xd5\x1e\xdb\x29\x7d\x3c\x12\x0e\xfa\x0d\x7a\x63\xa0\x67\x2c\x1e\x3f\xa2\xa5\x33\xb0\xa4\xca\x0b\x07\x45\x45\xa5\x5d\x9e\x69\x8e\xd4\xe1\x0d\x46\x36\x36\x2c\xd3\x05\xa0\xd4\x83\x8f\x98\x1b\x2a\xc8\xc3\x5d\x32\x4e\x8d\x1e\x7c\x64\x03\x3d\x1b\x42\x68\x6e\x32\xb0\x48\xbc\x9f\x83\xf1\x31\x32\x22\x76\xf0\x05\xca\x43\x1b\x59\x92\x13\x39\x32\x3c\xc1\x0f\x90\x46\x09\xd3\x7d\x94\x39\xd8\x5e\x23\x62\x2c\x52\x6a\x01\x91\x26\x72\x0a\x16\x14\x50\x89\xa7\x58\x67\x6c\x52\x08\x33\x07\x20\x11\x29\x58\xb1\x9a\x5a\xa7\x9f\xa0\x32\x46\x58\xc6\x67\x50\xa4\x27\xa9\xc0\xb2\x42\x25\x99\x45\x55\x8e\x62\x4a\xdc\xef\x99\x98\x72\x33\x62\x16\x1f\xdb\x48\x23\x14\x4f\x53\x7c\x7c\x8f\xd5\xf4\xc9\x21\x9d\x5a\x26\xa6\xd8\xed\xa9\x1c\xc0\xac\xf2\x5a\xfe\xc6\x36\x47\x6d\x21\x63\x9b\x03\x76\x80\x70\xac\x1c\x43\x21\x8a\xce\x60\xe7\xc1\xec\x4d\xc5\x94\xd9\x62\x00\x96\xc4\x87\x56\xf6\x44\x56\x4a\x96\x11\x43\x18\xdb\x46\x0c\x93\x29\x4f\xf1\xf6\xd6\xe0\xbc\x45\x99\x78\x25\xb5\x14\x58\xfb\xde\x7e\xe5\xed\x1f\x82\x44\x33\xe8\x51\x14\x03\x97\x0b\xf1\xc8\x9e\xd0\x4c\xd1\x9c\x72\x03\x65\x9e\x72\x03\x9d\x06\x83\x1c\x15\x44\x00\xba\x6d\xf0\x39\x24\x45\xdd\xbb\x23\x3f\xd0\xc1\xd7\x3a\x93\x49\x2c\xc0\xb0\x94\xfa\x5f\x4a\xb9\x92\x0a\x89\xf7\x02\xa9\x22\xd1\xf8\xf8\xab\x65\x3e\xb0\x43\xc0\x69\x87\xb0\x82\x0c\x29\xe5\x21\xcb\xf5\xd3\x30\xa4\x3f\xa4\xc1\x33\x23\x6b\x4e\xd6\x13\x1b\x3f\xa9\x84\x8d\xcb\x23\xc0\x38\x89\xd9\x08\x8b\x09\xa3\x02\xec\x44\x1a\xb4\xed\xdc\xa2\x0d\xa8\x0f\xac\x64\xfd\x3e\xb5\x7e\xbf\x4f\xe0\xab\x97\x08\x25\xdc\x09\x04\xa0\x4d\x10\x40\x61\x00\x00\xc0\x3a\x03\x00\xa9\xed\xc4\x8f\x12\x31\xec\xf7\x51\x65\xb9\x36\xa8\x90\x5b\x69\xe7\x47\x7c\xdf\xab\x6f\xf1\xae\x30\xe9\xbc\x73\x0c\x88\xa0\x87\x87\x72\x26\x12\x7a\xb4\x20\x0f\x07\x88\x6d\x08\x34\xa8\x7b\x01\xa6\x42\x7f\x94\x48\x0f\x65\xc2\x4f\xd0\xbe\x19\x0d\x5e\x7a\x90\xa7\xf9\x98\xd7\xbc\xeb\x6e\x58\xd4\x08\x42\x95\x9e\x08\xa1\x08\x01\x4e\xcd\xd5\xcf\x66\x01\x98\x7b\x00\x36\x6b\x82\x70\xb2\x22\xf4\x34\xdb\x0b\xd0\xbc\x84\x66\xfb\xa5\x5f\x80\xa6\x21\xb5\x69\x48\x0d\xea\x37\xab\xc1\xc1\xff\x49\xaa\x59\x05\x06\xdf\x4c\xd8\x59\x05\x06\x5f\xe3\xed\x91\xb7\x07\xde\xce\x79\x0a\x23\x94\x1c\x30\xdd\x66\x35\x38\x7c\x1c\x07\xcf\x38\xf8\x48\xe5\x64\xe2\x61\x25\x9e\x4a\xb8\xcc\xda\xce\xc3\x28\x22\x99\x0a\xc1\x31\xc8\x60\x2d\x0b\x2e\x1c\x81\x30\x3b\x32\x3e\xa3\x7d\x02\xba\x32\x91\xa6\x1f\x17\xb8\x73\xf5\xc5\x8c\xdc\x4f\x52\xa1\x6d\xe7\x96\xc2\xb3\x04\x57\x3f\x80\x13\x61\x1f\x61\xf9\x07\x38\x9b\x27\x3a\x46\xc8\x4d\x71\x65\x0f\x63\x09\xe1\x81\x4e\x13\x82\xa4\xe3\x70\x60\x40\xb8\x50\x89\x30\x98\x1a\x3a\x01\x6b\x7f\x14\xba\x20\xfd\x2c\x7d\xae\x9c\x24\x15\xfb\x7e\x59\x0d\x29\x06\xe7\xa8\x90\x61\xeb\x40\x77\x61\x05\xba\xfd\x4a\xdb\x87\x95\x96\x65\x76\xa4\xdd\x58\xc6\x2c\xb3\x99\x54\xf1\x58\xc3\xf9\x88\x74\x8c\x7a\xfd\xa2\x80\x35\xc0\xb9\x08\xdf\xfa\xfa\x84\x8d\xf4\x4b\x14\x64\x9d\x14\x12\xfb\x44\xb1\x2c\x7e\x9b\x00\x56\x92\x82\xe1\x95\x00\xf4\x73\xc9\x0c\x4f\xf6\x13\x31\x62\x89\x18\xed\x43\x31\x60\x35\xc0\x59\xc9\x0d\xc1\x93\x82\x27\x7d\xa9\x6a\x0e\xa8\x78\x3d\xa8\x74\xc7\xc5\x40\xc6\x65\x5c\x72\xad\x04\xd6\xbf\x36\xae\x16\x3f\xb8\xd7\x44\x28\xbd\x06\xba\x50\xb1\x28\x3f\xf1\xce\xd5\xe0\xd2\xc7\x8e\xf9\x63\x15\x9f\x5c\x2b\x81\xa5\x07\x6c\x0b\x6b\x08\x86\x1a\x3a\xa5\x97\x1e\x8c\x3e\xfb\xf7\xa2\x2f\xdf\x37\x1e\x71\x4e\x37\x5a\x7f\xc7\x7b\xc2\xf2\xf2\xff\x4f\x1f\xad\x78\x92\xe0\xe5\x2e\x6d\x76\x59\xc2\xe2\x2c\xd7\x48\xa5\x91\x91\x17\x52\x8f\x25\x62\x48\x31\x12\x31\x0c\x36\x3e\x71\x57\x82\xea\xe8\x3c\x8d\x82\xb8\x31\xc6\x08\x59\x7d\xf0\x20\xdd\x10\x81\xe7\x89\x35\x32\x9d\x50\x8a\x99\x4e\x82\x4d\x4a\x22\x74\xe2\x84\xc9\xc2\x05\x1d\x26\x08\x06\x14\xca\x88\x11\x14\xd1\xc0\x40\xcd\x84\x97\xb0\x57\x58\x01\x5b\x7e\x61\x45\x2a\x07\xe1\x05\x6f\x49\x4e\xd5\xb2\x6c\x3b\xc4\xd9\xaf\xaf\xd6\x4a\x47\x5b\xfb\x44\xf4\x62\x23\x8a\x9e\xb6\x16\xff\xdc\xc4\x6b\xcb\xeb\x52\xfb\xf5\x73\xc8\xbf\xcb\xd2\xe7\x2e\xe1\x5b\xab\xf7\xe9\x71\x60\x84\x63\x5e\x73\x6f\x79\xc1\x5e\xd2\x18\xb4\x65\x20\xf1\x23\xfd\x36\x78\x5d\x4f\x48\x5a\x2a\xd5\x63\xdb\x69\x06\xb3\x0c\x71\x51\xd2\x96\x9c\x02\xe2\x09\x63\x9a\xa7\x84\x1f\xb5\x89\xc0\x8b\x19\x01\x0b\x0c\x52\x66\x72\x23\x78\x79\x61\xef\xef\xfd\xf1\x2d\x80\xf0\xfc\xa9\x14\x4f\xad\x6d\x73\xf8\x59\x86\x0b\xed\xea\xbe\x9c\xf4\x74\x1f\x06\x6d\xde\xe1\x79\xb4\xfe\x0e\xd2\x8c\x8a\x0a\xef\xf9\xe1\x46\x23\x31\x54\xa0\x4f\x96\x7f\x5c\x2d\xaf\xe3\x23\x7f\x1f\xff\xb9\xbc\xf1\x70\x5f\xfe\xf8\x6a\xbe\x0a\x68\x1c\x59\x6d\x8d\x44\xd0\x7d\x29\x3f\xda\x36\xd8\x9b\x0f\x5f\x36\xff\xde\x38\x9e\xfd\xff\xf7\xe8\x50\xd6\x72\xe9\x69\xa6\xaa\x2b\xff\x13\x4f\x0e\x3e\xb9\x87\x90\x2c\x26\x5a\x7b\x85\xf8\xe3\x96\xf8\x0a\x65\xdf\xd8\xb9\x7c\xed\x40\x7e\xd3\xeb\xdd\xee\xde\xbf\x6c\xff\x2d\x8a\x76\x1e\xb6\xd6\xdd\xd5\x39\x97\xdb\xd6\x43\xfd\xf4\x8c\xf7\x89\xad\x2d\x57\xdd\x99\x36\xa8\x1c\xe9\xa8\x1f\x45\xc9\xe7\x57\x5f\xa2\x68\xd0\x2a\x5f\xc8\xf0\x45\x61\x8b\x6d\xec\xbc\x38\xda\x64\xad\x2d\xb7\x55\x69\xf3\x6f\xa6\x54\xbb\xe4\x62\xb8\xee\xb1\xe5\x9b\x8f\x01\xd4\x7b\xe5\x86\x81\x32\xa8\xe8\x2e\x4b\x99\x5a\x61\x09\x78\xc2\xd4\x0e\x58\xe3\x4a\x40\x2d\xa2\xc8\x2e\x0e\x1b\x4f\x75\xdf\xd1\xab\x49\xb8\x03\x56\xf4\xcc\xea\xc3\xed\xe5\xc1\xfd\x03\xbd\x1b\x7e\xed\x19\x6f\xfe\xa7\x9e\xef\xbe\x39\x64\x7f\x61\x7f\x39\x64\xff\xc6\xfe\xed\x90\xbd\x60\x2f\xe0\x77\xc8\xe0\x97\xf1\x9c\x6d\xb1\xad\x43\xb6\xcd\xda\x6d\xa4\xbe\xa3\x97\xd7\x76\x9b\xb5\xdb\x74\xb3\x09\xe0\x21\xdb\x61\xbb\x6c\x17\x7e\x87\x6c\xf7\x90\xfd\x83\xfd\x03\x7e\x87\xec\x1f\x87\xec\x90\xfd\xcc\x7e\x3e\x64\x3f\xff\xcc\x7e\xfe\xf9\x90\xfd\x93\xfd\xf3\x90\x7d\x60\x1f\x0e\x3d\x0b\x53\x5c\x64\x05\x3e\xf7\x11\x0b\x53\xe2\x09\xaa\xda\xb8\x94\x59\x01\x60\xa2\x89\x34\x87\xe7\x6d\x5c\xc6\xbc\xdd\x26\x04\x83\xe7\x6d\x4f\x6e\x89\x90\xb1\x0e\x00\x39\x04\x33\xe5\xe4\x82\x5a\x90\xd5\x46\xc2\x4e\x9e\xb7\x73\x99\xe3\xb7\x9e\x46\x13\x21\x22\x42\xcc\xf3\x74\xce\xb8\x6d\xff\x4c\xea\xb0\xc3\x35\x06\xb7\x56\xc7\x6c\x20\xc3\x8d\xca\xa0\x70\x29\x5d\x13\xa7\x69\x49\xec\x25\xd5\xa8\x4d\x1b\x0e\x36\x2d\x8b\xb9\xf1\x37\xca\x89\x61\xf1\x98\xc3\x79\x67\x6c\x70\xc9\x3e\x2a\x35\x0d\x23\xed\x60\xa0\x34\x03\xdb\xc0\xf2\x19\x14\x0e\x23\x64\x8f\xaa\x5b\x1b\xa2\x1e\x8b\x0b\x63\xe6\x2c\x2e\x1c\x8b\xe7\x31\x2a\xd7\xc4\xcd\x34\x20\x51\xed\x0c\x29\xb9\x70\xc3\x25\xa2\x37\x31\xcc\x78\x8c\x7a\x35\x09\x68\x13\xf2\x17\x9c\xbb\xa3\x6f\x08\x06\x84\x54\x0c\xab\xcf\x91\xdc\x0b\x00\xf4\x57\xd5\x67\xb4\x7b\x2b\x03\xa6\x21\xa2\xa7\x44\x0c\xad\x98\xc0\xe1\x19\xfe\xf8\xe8\x4b\x37\x37\x6d\xa3\x0b\x07\x58\x6d\xec\xb0\x67\x19\xdd\xe0\x58\x68\xdb\x0c\xa5\x46\x49\x8b\x42\xcc\xda\x94\x52\xdb\x97\x55\x5a\x27\x55\x8c\xb4\x72\x78\xe3\x04\xe8\xa5\xd3\x5e\xcd\xa6\xd1\xbe\x87\x11\xa2\xd1\x20\xb2\xdc\xcd\x8f\x00\x95\x68\x5b\x31\x29\x04\x1c\x00\x51\x0d\x0a\x18\x6d\xae\x92\x76\x68\x68\xf4\x80\xd1\x50\xf3\x11\xea\x88\xa8\xed\x8e\x98\x98\xc5\x22\x77\x74\x37\xe3\xc7\xd7\xb0\x41\x9f\x89\x64\x50\x47\x6c\xa8\x18\xb4\x04\xb4\x83\xa7\x26\x7e\xe1\x55\x1d\x96\x42\xe8\xd8\x48\x28\x31\xcb\x0d\xd8\x76\x9e\xe1\xce\x3b\x12\x0e\x9b\xc7\x63\x37\x64\x59\xa2\x0c\x6d\x0f\xe6\xb0\x33\x63\x38\xee\xd0\x63\x31\x63\x32\x61\x32\x11\xca\x49\x37\x27\x5c\xe8\x05\x93\xc3\x36\x9c\x2d\xe5\xb0\x9d\xcf\xdd\x58\xab\xfd\xc6\x53\x43\xec\x77\xf5\x70\x0e\x3e\x62\x41\x24\x9c\xb7\xdb\xf1\x98\x9b\xd2\x97\x00\x54\xc9\x26\x02\x3b\x96\x15\x4c\x5a\x26\x6d\x1b\xc6\x2b\xd9\x28\x19\xaf\x8d\xad\x8c\xc0\x14\x15\xb1\xb5\x89\x44\x51\xda\xb6\x27\xaa\xb4\xed\xf2\xf8\x8d\xb0\xcf\xb8\x04\xdb\x44\x5a\x66\xdb\xf8\xdc\x80\x84\x89\x1e\x76\x1a\xfd\xfd\x8a\x0a\xa0\x12\x23\xb4\xb4\x12\x64\x63\xfa\x8a\x34\xd2\x02\xa8\x13\x8c\x97\x6b\x2c\x9b\x67\x0c\x00\x88\xb4\x02\x49\xdb\xfe\x24\x8c\x46\xde\xb1\xb2\x4c\xa9\x44\xcb\x16\x03\x7f\x6d\x0c\x1d\x1c\x0a\x73\x44\x90\x13\x2c\x14\xe9\x28\x88\xec\x0d\xf6\x11\x4b\x79\x36\x48\x38\xc3\x01\x48\x57\x2b\x80\x41\x0d\xe1\x8f\x65\x4c\xa5\x75\x2f\xd0\xa4\x11\x8f\x64\xe8\x96\x6e\x60\x69\x02\xd2\xa5\x27\xc2\xe1\x06\xb2\x82\xdb\x7b\x0d\x17\x3e\xdb\xf2\xdc\x33\xae\x99\x91\x68\x23\x7a\x45\xd3\xb4\x9d\x88\x58\x53\xd3\x21\xc3\x19\xcc\xd8\xba\x1f\xb8\x1b\x8b\x0d\xae\x4d\x4a\x8c\x8e\xe8\x54\x06\x4d\x7b\x04\x26\x91\xca\x7b\xee\x33\xc0\x14\x95\x76\x47\x4c\xb9\x31\xf3\xcd\x7d\xc4\x74\xec\x98\x4e\x92\x23\x86\x02\xda\xb5\x61\xd0\x28\x88\x1e\xe2\x50\xcf\x85\xc9\x0a\xe7\xb3\xc9\xb5\x3d\x02\xc3\xf9\xe9\x0f\xe0\x13\x4f\x1f\x91\xe7\x2c\x37\x82\x60\x4f\xe6\xe9\xf9\xdb\x8c\x1e\xca\x54\xec\xc2\x62\x6a\x2b\x57\x5e\xb0\xbc\x08\xc9\x4c\x0a\x6e\xe5\xa4\xd0\x08\x82\x49\x74\xf3\xc4\x8a\x52\x12\x87\xb6\xe9\x1a\x2c\x2e\x8c\x95\x53\xd1\x36\xc2\x6b\x6d\xa1\xf5\xde\xa0\x8e\xe4\x70\x2d\x4e\x96\x48\x50\x54\x61\x0e\x9f\x4d\x0a\x69\x50\xc5\x4d\x8d\x09\x0e\x72\x02\x13\x9b\xde\x2f\xb0\x96\x99\xa7\x8c\x59\x64\x70\x66\xb0\xf6\x59\xe1\xfb\xdb\xfa\x19\x6e\x85\x9b\x32\xab\x33\xa2\x3f\x15\x49\xe0\x5c\x21\xeb\x88\xd9\x22\xab\xa8\xa7\x61\xc0\x1e\x31\xc7\x1f\x05\x1a\x6d\x68\x7a\x04\x68\x75\x73\x82\xde\xf7\x3c\x86\x5d\x28\xaa\xbf\xb7\xdb\x36\x4f\x65\xec\x5f\xfa\x2c\x6a\x0a\x20\x3e\x06\x7f\x12\x87\x01\x03\xc6\x0b\x34\x6b\x03\x04\x9d\xb4\x38\x59\x36\x93\x78\x6b\x45\x88\x3b\x9a\x6d\x7c\x9f\x81\x39\x74\xc4\x3e\xc9\x1c\xfe\xed\x54\xab\x11\x34\xce\x82\x2d\x0e\xd9\x7f\x43\x21\xe7\x2d\xc2\x19\xfb\x51\xd4\xfe\x66\xe7\x68\xeb\xc5\xe1\x3f\x7e\xfe\xb7\x6f\xbf\x47\x01\xc7\xf7\xad\x2d\xb3\xd5\x7a\x20\xeb\x65\xfb\x6f\xbb\x7f\x3f\x78\x78\xd1\xda\x96\x87\x2d\x14\x82\x1b\x45\xc9\x16\x91\x76\x24\x5b\x80\x1a\xab\x15\x3a\x93\x6f\xca\xc7\x3e\xa4\xe1\xe0\xf4\xe6\x66\x0f\x97\x70\x3e\x49\xfe\xe9\xe1\xf3\x02\x86\xe3\xc3\x35\xc7\xdc\x84\x12\xf2\x8f\x9f\x84\x0d\x0f\x36\xee\x7b\xee\xc1\x14\x62\x71\x7f\x3e\x7c\xe0\xa9\x15\x0b\x25\xd3\xc5\xb5\x56\x62\x33\x1a\xec\x7e\xd9\xce\x91\x9a\xe4\x3e\x8a\xee\xa3\xe8\xf3\x43\x78\x93\x7a\x88\xa2\x2f\x0f\xad\x2f\xdb\x19\x25\x19\xeb\x2c\x13\x2a\xbc\x75\x7e\x68\x6d\xf1\x2f\xdb\x05\x15\x21\x8a\x3e\xe0\x33\x1e\x3e\x8c\x6e\x6e\x0f\x0e\x97\x8f\x8b\xf7\x07\x0f\x9f\xf7\xb6\xf7\xbf\xe0\x47\xa3\x15\xd2\x99\x2f\xdb\xc3\xc3\xea\x98\xf2\x65\xbb\x7f\xf8\xf9\xf1\xc0\x6d\xa7\x07\x7c\xbb\x7e\x44\xe0\x01\x65\x1c\x7e\xd9\x1e\x1f\xde\x8f\xb6\xd3\xed\x6c\xbb\xd8\x8e\xb7\x07\xdb\xf9\xb6\xdd\x4e\xb6\x75\x10\x1d\xcc\x46\x3b\xf1\x21\x1d\x53\xca\x62\xb7\x5a\x9b\xdb\xfd\xed\xe1\xc3\xf6\x70\x27\x3e\x1c\x6f\xe7\x68\xd6\x31\xf3\xb9\x67\x5d\x82\xc3\x58\x77\x97\x88\x3a\x56\xf2\x78\x16\x3f\x95\x0a\xf6\xca\x7f\x5f\x7b\x2a\x88\xa2\x7b\x2f\x25\xfb\x61\xcd\x91\x40\xfe\x7b\x4d\x90\x7b\x8d\x9f\x64\x0c\xe3\xdf\x68\x9d\xc1\x52\x64\x51\xb7\xa4\x62\x4f\x1a\x4c\xae\x64\xc6\x53\x44\xa4\xb8\x54\xc2\x30\x5b\xe4\xb0\x5f\x0a\xc3\x06\x3c\x7e\x24\xa4\x42\x6b\xc3\x6c\x2c\x14\xec\x67\xb8\xc4\x21\xf3\x57\x02\xcb\xf7\x23\x72\x38\x5b\x99\xc0\xe1\x19\x8f\xbe\xd2\x32\x6e\x04\xb3\x7c\x8e\x77\x08\x06\xb6\x97\x84\x3d\x4a\x95\x30\x3d\x64\xa6\x48\xc5\xda\x57\xf3\x4a\x54\x7c\xfd\xb4\x5b\x3e\x8a\xc3\x99\xc6\x94\xe7\x07\x2b\x4a\x01\xe7\xbb\x1f\x36\xde\xa1\x8e\x90\xc5\x89\xd6\x8f\x8b\x5b\x6e\xdc\xe2\x74\xcc\x73\x27\xcc\xa2\x4b\xd1\x16\x3d\xd8\xc0\x60\x80\x96\xaf\xe3\xf8\xdd\xe9\x58\xc4\x8f\x0b\x62\x92\xd4\x85\x5b\x90\x52\xe1\xc5\x85\xb2\x0e\x16\x4c\x3d\x5c\xf4\xf4\xe2\xae\x48\xc5\xe2\xfd\x58\xa8\xc5\x89\x18\x6a\x23\x16\xc7\x43\x27\x4c\x48\xec\xa0\x55\xd1\x31\x6e\xf4\x90\x5a\x20\x8c\xc4\xf2\x09\xba\x1a\xed\x58\x89\xed\x1a\x79\xd9\x57\xc6\x80\x5c\x7b\x48\xa9\xb7\x59\xb8\xa4\x0a\x32\xb0\xeb\x24\xcd\x9e\x9a\xf5\xf3\x12\x1d\x74\xdd\xdb\x37\xf7\x97\x1a\xbb\xdc\xf7\x50\xea\xd5\x61\xe5\x74\x96\x96\x03\xab\x1a\xd3\xb5\x35\x63\xb3\x21\xd3\xb9\xde\x39\x91\x7d\x11\xdd\xfb\xf3\xf2\xc3\x96\x27\x19\xfe\x70\x4f\xa4\x03\xd1\x7d\xf4\xd0\x6f\x3f\x6c\x45\xf6\xc5\x61\x64\x5f\xf8\xee\xa9\x24\xdd\xaf\x5e\x78\xd4\x3e\xf2\x69\x1d\xee\x6e\x37\xee\x2b\xd6\x2c\x5a\x5a\x2d\xf4\x70\xb8\x70\xb0\x6e\x21\xf5\xc6\x62\x2e\xec\x42\x69\x5c\xb1\x96\x04\x55\x4f\x3d\x15\xfa\x77\xf7\xd1\x53\x94\xb4\x1e\xd0\xea\x3f\xbc\x08\xc4\xce\xdf\x45\x9f\xf1\x98\xfc\x05\x6f\x5c\xdc\xf6\xd2\x62\xbb\xbb\x71\x1f\x6d\x45\xed\x87\xad\xcd\xa3\xfb\x28\x79\xd8\xea\xdf\xc3\xd7\x50\x56\xba\x0a\xf9\x5a\x97\x9b\x7c\xf8\xb7\x97\xcf\xf6\x7a\xc5\x40\xb8\xb4\xe0\x99\xc3\x8a\x83\x61\xe7\x1c\xaa\xb7\xc3\x76\x7a\xa6\x10\x3b\x35\x06\x06\x9c\x7e\x89\xf6\xbb\x5e\xa0\xa8\x21\x2d\x19\x0a\xc6\x93\x95\xb1\xa7\xdf\x13\xa9\x15\x04\xe1\xb3\x97\x50\x49\xb0\xfd\xad\xa1\x50\x89\xe7\x95\x15\x2a\xa9\xf3\x1b\x24\xf0\x4f\xb4\xbf\xb3\x94\xc3\x40\x5a\x27\x54\xe2\x21\x14\x7c\xec\xd7\x9a\x8a\xb4\xaf\xa4\xe4\xd1\x39\x51\x15\x7a\x0a\x1f\x5b\x0c\x00\xb7\x90\x4a\x2c\xf6\x5e\x56\x34\x44\x39\x49\xa7\x62\x3b\x5c\x25\x3b\x6c\x47\x9b\x1d\xb6\xa3\xb4\xdb\x61\x3b\x29\x54\x5d\x4c\x76\xd8\xce\x08\xa0\x11\xfa\xb9\x1d\xe2\xdc\xc5\xf7\x59\xa2\xc1\xb5\x22\xdc\x80\xd6\x99\x7e\x79\x1c\x23\x69\x6e\xca\xd5\x23\x9c\xc5\xb0\x86\xf8\xd2\x4e\x1c\x4e\xa8\xc4\xdd\x64\xfe\x24\x03\x58\x8b\xd4\xf8\x24\x49\x98\x23\xcf\x44\x82\xa8\xa3\x11\x31\xa3\x11\x81\x8b\xa5\x00\xd4\x0b\xc9\x0a\x53\x46\xc7\x2f\xbc\x35\x84\x2f\x0b\x40\x57\xaa\xe4\x0a\x25\x5d\x83\x63\x18\xf5\x50\xa2\x1a\x59\x3a\xcf\x22\x61\x40\xdc\x87\x5d\xbb\x4f\xbc\x5b\x7d\x9e\xc2\x39\x3c\xee\xc3\x62\x6d\x73\xe8\x87\xb8\x0f\x5f\xf6\x87\x42\x24\x8c\x94\xdf\x13\x31\x83\x88\x71\xa5\x27\xcc\x4d\xea\xcc\x8e\x4a\x2e\x12\x5d\x58\xa6\x74\x0e\x38\xbf\xd2\xaa\xef\x29\xb0\xf0\x08\x82\x9e\x15\xdf\x49\xc9\xa3\x52\xd2\xa9\xd4\xc8\x43\xe0\x98\x05\xdf\x57\x23\x6a\xca\x53\xa2\x12\x13\xc6\x88\x04\xcf\x7c\x70\x3e\x21\x86\x15\x81\xf8\x33\x22\x5b\x74\xde\xc0\xdb\x04\xc9\xbd\xa4\x75\xba\xf4\x8e\x21\x35\x16\xf7\x3d\xf3\x4d\x1f\xb9\x6e\xc8\x0a\xb0\x95\x23\x25\x92\xd0\x20\x28\x52\xdc\xd1\x77\x3f\x05\x60\xef\xc7\x00\xbd\xda\x0f\xd0\x8f\x3f\x04\xa8\x9f\x0a\x6e\xab\xc8\xe4\xac\x3e\x21\x77\xf5\x21\xb9\x6b\x9f\x0f\x1b\x5f\x0f\x9b\x1f\x0f\x9b\xdf\x0e\x1b\x9f\x66\x7c\xd6\x77\xec\xb4\x4f\xcc\xd8\xe8\x4b\x87\xc5\xb8\xef\x1f\x7c\x7d\x55\x4b\x17\x06\xf7\x4b\xb6\x6b\x1f\x50\xf3\xa8\x45\xaf\xf9\x22\x7f\x79\x1c\xda\x08\x07\x50\xee\x4a\x70\x58\x28\xef\x12\x4f\x7d\x22\x34\xe8\xc7\xdc\x18\xc9\x47\xa2\xef\x67\x67\xdc\x1f\x6b\x23\x3f\xc1\xdc\x4d\xfb\x8e\x0f\x58\xec\x85\xb4\x7b\xa7\xb4\xba\x1f\xf7\x83\x88\x7c\x28\x47\x0c\xc5\x2d\x14\x01\x65\xdf\x26\x0c\xbc\xfb\x28\x77\x5d\x40\x9e\x94\xb3\x2f\x02\xa4\x32\xd4\xc6\x19\xae\xfa\x42\x4d\x91\xdb\x8a\xc7\x4e\x98\xbe\x75\xda\x40\x71\xf0\xd5\x10\x4f\x89\x7d\x9c\x2f\xf8\xda\xdc\x08\xc4\x23\x3e\x05\xd2\x04\xed\x0b\x95\x94\xa0\x36\xe1\xdc\xd6\xfc\x4a\x23\x9b\x1e\x7d\x86\x05\x34\x3a\x0e\x85\x94\x42\x88\x3e\x37\xd2\x8d\x33\xe1\xe0\x7c\x0d\x6e\x8f\x28\xd1\x8b\xe2\x30\xd5\x4f\xf8\x80\x67\xe0\x70\x0d\xc1\x23\x51\x0f\xc2\x77\x51\xfa\x6c\xd5\x5f\x89\xa7\x6a\xea\x8f\x0a\x98\x8d\xe5\x19\x8d\xe5\x3c\x61\x79\x90\x1b\xc5\x03\x8b\x40\x2a\x33\x3c\xd0\x11\xab\x1c\x54\x8a\x27\x53\x3c\xc6\xab\x2c\xad\xb1\x0a\x41\x2d\x44\x52\x18\x5a\x9f\x90\x36\x28\x3c\x12\x55\x57\x41\x44\xd6\xc5\x53\x96\x43\xc4\x70\x31\x81\x7c\xfa\x65\xfb\x97\x9c\xfe\xa9\x1e\x91\x6a\x32\x99\x09\x85\x92\xf2\xf1\x09\x92\x23\x2b\x25\xac\xd3\xb8\x51\xa1\xde\xaf\x52\xef\x8c\xcc\xf0\x10\x86\x9c\xda\x78\x65\xe1\x87\x73\x25\x6d\x9f\x5b\x14\x71\x02\x79\x2b\xe7\x79\x87\x51\x4f\x2f\x75\x00\x31\x0c\x33\xa9\x50\xc0\x3f\x60\x54\x5a\x31\x38\x8f\x4e\x79\x8a\x75\x40\x7a\xe4\x81\x18\x49\x05\x1d\x37\x95\x80\x88\xfe\xdb\x92\x5b\xa8\xa4\x72\x50\x18\xe9\xc2\x84\x00\x82\xc8\xb7\xe4\xb6\x80\x80\xd2\x81\xa8\x68\xc9\xcc\xa7\x0b\x38\x9b\xea\xa1\xeb\x13\xe8\x13\x66\x4a\xf7\x13\x91\xb3\xa1\x11\x82\x49\x93\xf7\x61\x23\x34\x79\x1f\xf7\x1d\x04\x68\x6f\x34\x79\x9f\xba\x0e\x20\xe8\xc8\x86\x94\x85\x54\x8f\x18\x18\x7b\x2f\x19\xcf\xf8\x8c\xcc\x3d\xc6\x33\xa9\x5e\xa2\x09\xb0\x4e\x58\xcc\x07\x96\xc5\x28\xe9\x49\xcc\x50\x24\xc3\x88\xc5\x48\x3f\x42\x34\x23\x10\x9c\xa0\x44\xac\x04\x85\x60\x25\x48\x4f\x82\xe6\x3e\x4b\xd0\x1f\x45\x44\xe1\x73\x32\x4a\x0e\x82\xfd\x9c\x25\x90\x4e\x42\xf9\x27\x98\x73\x82\x79\x26\x78\xdd\xa8\x30\x0a\x76\x57\x82\x89\xa2\x4c\x9a\x84\x72\xc4\xf4\x51\x0c\x8d\xbf\xf4\x82\x22\x48\x48\x5e\x62\xd2\x92\x3e\x97\x48\xe7\x8d\x69\x60\xfd\x30\x13\xac\x1d\x66\x64\xd5\x28\x65\x3c\x1d\xf1\x8c\xb3\x18\x6b\x11\x63\x69\x63\x2c\x63\x8c\xe5\x8b\x31\xf3\x98\xf2\x8d\x27\x18\x69\x82\x91\x26\x18\x69\x82\x91\x26\x18\x69\x42\x85\x8b\xb3\x3c\x9d\x41\x95\xd5\xc7\x11\x83\x19\x88\x46\xcc\x12\xcf\xbd\x49\x3a\x18\x13\x14\x63\x91\x50\xee\x72\x02\xc5\xc5\xd4\x27\xd8\x90\x13\x6c\xc8\x09\x36\xe4\x84\x1a\x72\x42\xe9\x4e\x28\x5d\x2c\xc3\x04\x9b\x75\x02\xf5\x9e\x40\x3e\x13\xcc\x07\x0b\x36\xa1\x5c\x26\x98\xcb\x84\x72\xc1\xb2\x4e\xa8\xc1\x27\xd8\x16\x13\x6c\x87\x09\x34\xf8\x04\x5b\x6c\x82\x8d\x85\xd5\x99\x60\x83\x53\x9d\x26\x58\x0e\x12\x68\x56\x4a\x3f\xc3\xa4\x39\x7c\xc5\xf1\x5b\x12\x80\x16\xe8\x89\xf6\x3d\xdb\x37\x16\x9a\xca\x5c\xca\x0a\x83\x02\x4a\xcf\xe1\x4a\xca\x40\x5c\x10\x17\xb5\xf7\x92\xee\xd9\xa4\x62\x98\xe8\x92\x48\x22\x28\x4c\x29\x82\x88\xae\xaf\x68\x80\x23\xa5\xc2\x48\xb0\x74\xe4\x58\x9a\x0a\x96\xa6\xf8\x0c\x8d\x04\xd4\x72\x38\x0f\xab\x07\x60\x09\x25\xc8\x93\x8f\x85\x75\xa9\xb7\x0d\x3d\x83\xf8\x40\xff\x18\x52\x6d\x27\x03\xe9\x68\x05\x1f\x38\x61\x1d\x8a\x40\x23\xfd\x2b\xf8\x22\x80\x7a\x6e\x19\x52\x2b\x71\x98\xcd\x32\x83\x22\x8d\xa4\xb3\x2c\xd1\xae\x1f\xae\xd8\x84\xa6\x88\x22\xb7\x32\xd5\xc4\x51\x82\x6f\xc6\x5e\x84\x59\xb8\xb5\x1e\x17\x23\xc1\x24\x1c\x68\xe5\x20\x4e\x0d\x93\x03\x48\x49\x0e\x50\x5e\x17\x2c\xc3\x12\xaf\x6a\xc7\x43\x47\x66\xcc\x52\x7a\xff\x4d\x85\xea\x23\x79\x57\xc6\x5d\x56\xa4\xd0\x96\x65\x1e\x19\x9f\x21\x11\x07\x9f\x4d\x79\x4a\x37\x99\xd0\xce\x55\xb8\xc4\x4d\x34\x93\x0a\xc3\x01\x73\xd5\x2c\x9b\x62\xd6\x4a\x70\xbc\x90\xa3\xa7\x7c\x23\xac\xa8\x5d\x1c\x1a\x9e\xc8\x99\x27\xc5\xec\x7b\x94\xd4\xbb\x2c\xa0\x87\xe1\x76\xd0\xdf\xf7\x79\xd5\xd5\x26\x68\x86\x29\xb5\x17\x2b\x8f\x27\x8b\x04\x71\x18\x3c\x4c\x94\x3e\xb0\x4f\x04\x2f\xd7\x2f\x0b\x4d\x89\x11\xb9\x56\x48\x0f\x55\xed\xd2\xe5\x1e\x12\xf8\xf5\xe3\x54\xc7\x8f\xcc\x49\x35\x6f\xc8\x2f\x92\x19\x2b\xc2\xb3\x39\x56\x6c\x2a\x0c\x8e\x15\x1a\x9a\x64\x91\x14\x39\x61\x58\x32\xc0\x43\x89\x33\x73\x96\x40\xcd\x59\x9c\x17\xd4\xd1\xb0\x51\x40\xaf\x73\x33\x2a\x50\x67\x0e\x0d\x0a\xd8\x9a\x7d\x50\x1d\x2e\xa3\xa1\xa7\x50\x53\x69\xb4\xc2\xcf\xc2\xf1\x90\x49\xdb\xaf\xa3\x14\xff\xb9\x68\x41\x2d\x33\x6d\x58\xa6\xa7\xa2\x4f\xd4\x3e\x25\x96\x56\xf6\x01\x34\x88\xef\x03\x9e\x89\x3e\x1c\xd8\xfa\xbc\x64\xcf\x26\xb7\x1e\x7a\xb1\x88\xa5\x24\xc4\x31\x1b\x08\x6b\x45\xda\xff\xf8\xb2\x84\xf6\x4a\x48\x05\x68\x5e\x86\xce\xcb\xd0\xb9\x62\xb0\xae\xe1\xb2\x06\x46\x1f\xc7\x4a\xc2\x68\x71\x4b\xf5\xa8\x4f\xd0\x78\x9e\xe3\xc5\xb9\xc9\xf6\xbd\x98\xc3\xbe\xa7\x6e\xf1\x2e\x23\x86\xf8\x9e\x54\x38\x51\xf6\x03\xd6\x2d\x15\x3c\xf9\x84\xac\xce\xe9\x27\xd6\xc0\xd4\x70\x82\xf4\x71\xf4\x0f\x46\x82\x0d\x46\x8e\x41\xdf\x0c\x52\xd8\x96\x60\x16\xa7\xde\x36\x28\x1a\x02\x5a\x4c\xe2\x41\x17\x16\x0e\x99\x43\x87\x79\x85\x25\x7d\x5a\xe5\xd2\x58\xfb\xd1\x16\x80\x8c\xdb\xc7\x14\x4d\xc4\x16\xfb\x18\xdb\x32\xff\x6d\xae\xf3\x18\x66\x99\xce\x73\x6e\x48\x93\x36\x67\x3e\x67\x9f\xb1\x1b\x43\xef\x21\xb5\xed\xc5\xdd\x6d\xff\xf8\xf2\xe2\xf5\x35\xee\xf5\xc8\x7a\xf5\xe5\xef\x4b\xb2\x5f\x0c\x5e\xab\xec\x46\x2f\x76\x97\x29\x6c\x56\x08\x95\xe8\x72\x76\x2d\x11\x4e\x33\xca\x1a\x02\xfc\xea\x40\xbd\x72\x9a\x46\x1e\xf2\xfb\xef\x3e\x1f\x46\x91\x7a\x68\x95\x6a\x06\x91\xfb\x09\x2f\x7a\xbe\x69\x5c\x0e\xa3\x17\x61\x4b\x89\x8e\x5b\xdb\x2d\x40\x95\x10\xfa\x8c\x57\x4c\x9b\xcb\x17\x23\xad\x8d\xa3\xc3\x28\x1a\x2c\xa2\x68\x6b\x11\x45\xed\x45\x14\xed\x6c\xa2\xd7\x4e\x14\x25\x8b\x28\x4a\x36\x37\x8e\x0e\xe8\xae\x1b\x81\x9d\xa3\x28\x4a\x5e\x80\xe7\x7d\x22\x1e\xee\xb7\xda\x74\x23\xbe\x79\x84\x14\x0c\x3b\x47\x81\xcd\xf7\x99\xcb\x94\x8f\x7c\xca\xd7\x2b\x1d\xfc\xbf\xfe\x7b\xfb\xff\xfe\x3f\xfd\xad\xfc\x77\x0f\x4d\x27\xaa\x9d\x6b\x6d\x9b\x43\xb7\xd5\xda\xf8\x07\x12\x42\x20\xab\xdd\x36\xb2\x0e\x83\x73\xf3\xc5\xcf\x9b\x47\x78\x7d\x4f\x6c\x40\x0d\x39\x0c\xb8\xd7\x96\xfc\x1b\x88\x53\x94\x37\x2d\xb0\x62\x05\x61\x11\x9e\x65\x09\x59\x88\xe4\x30\xc8\x10\xd3\x86\x84\xb8\xd0\x15\x0d\x09\x5d\x09\x22\x60\x82\xe8\x85\xea\x20\x1e\x8e\xdb\x24\x94\x86\x0e\xd4\x28\x77\x82\x48\xc5\xd2\x52\xba\x43\x25\x4c\x82\x78\xb7\x6a\x12\x6d\x48\xaa\x05\x32\x4e\x95\xe7\x7a\xba\x09\x21\x24\x97\xce\xdc\x81\x0d\xab\xe2\xf5\x22\xdc\x9d\xee\x68\x90\xb3\x6a\x2c\x6d\x4d\x20\x5a\x4d\x88\x84\x5d\x15\x91\x13\x5a\xc4\x5f\xc0\xf8\x57\x25\xeb\x19\xf5\x60\x57\xc6\x37\x90\x28\x1a\x6c\xbc\xbc\x1f\x9c\x3c\x6c\xdc\xbf\xdc\x7b\xd8\xba\x7f\xb9\xd7\x47\xf3\x61\x6b\x81\xe6\xe6\xe2\xe5\xfd\xec\xd7\x87\x8d\x7b\xde\x1e\x1e\xb7\xcf\x51\x4d\x60\x05\xf7\xeb\x0e\xf8\xa4\xe6\xd8\x5c\x6c\x6c\xdc\x47\x51\xf2\xb0\x05\x66\x9f\x2c\x88\x83\xd6\xe6\x46\x14\xed\x7c\x2d\x7c\xf3\x68\xf1\x47\x31\x36\xee\x45\xe7\xa1\x7c\xc4\xd9\x3c\xda\xbc\x4f\x2f\x87\xe7\x0f\xd5\x23\xce\xf2\xe3\xcc\xea\x95\xeb\x47\x9b\x23\x27\x1e\xf7\x9c\x30\x8b\x6f\x77\xff\x73\xa5\x67\x34\x58\xe9\xbf\x2e\x7f\x02\x25\x9d\xdd\x7f\xfe\xfb\xe1\xc3\xee\x57\x18\x8d\xe4\xc1\xee\xfd\x41\x2b\xba\x8f\x1e\x1e\x76\xff\x9c\x64\x0a\x8c\xa1\x84\x97\x3c\x12\x6e\x05\x61\x28\xd7\x15\x14\x36\x94\x9b\x6e\xe0\xe3\x59\x14\xd9\xad\xcd\xad\xd6\x56\x53\x55\x6e\x45\xc1\xb4\x5c\x58\xee\x5b\x6b\x7d\xfc\x92\xee\xed\x6d\xc5\xc7\xf4\x9c\xf4\x36\x5e\xc5\xae\xcb\xa3\xf1\x74\x8f\x44\xf0\xb8\x4c\x00\xa9\xfe\x57\xe4\x87\xc1\x7a\x46\x52\xba\x9e\x55\xa5\xca\xdb\x9f\xbe\xeb\x3f\xdc\xbf\x6c\xff\xad\x74\xe0\x5a\x56\xbd\x03\xc1\xf1\x39\xdc\xcb\x06\x61\x6f\x7e\x61\x81\x74\xa0\xe9\xcb\x6d\x21\xd1\xa5\x9c\x42\x5c\x55\xaa\x05\x65\x65\x19\xc1\x37\x54\xea\xb3\x06\xc9\xf2\xf3\x4b\xc3\x3c\x87\xef\x3c\xff\x52\x2a\x9c\x7f\x58\xa5\x85\xd0\x73\xec\xd2\xba\x54\xd2\x6a\x72\xcb\x38\x2c\xb6\x9e\x35\xcb\x2f\xa1\x41\x24\x96\xd1\x19\xe3\x5f\xe3\xd4\x44\x6a\x56\xa9\x44\xc2\xae\xf9\x35\xbb\x50\x43\xa9\xa4\x9b\xd7\x0f\xdd\x48\x10\x23\xed\x39\x04\x00\xee\x05\xf1\x50\x33\x2e\xf1\xd2\x22\x78\x81\x02\xc7\x62\x9d\x88\xb7\x77\x17\x15\x54\xaa\x5a\x60\x42\x85\xc0\x12\xaa\x05\xda\x18\x50\xe2\x42\x79\x80\xa4\xd7\xb1\xf3\xd0\xe4\x27\x7e\x7b\x40\x2d\xed\xac\x33\xe5\x29\x41\xa8\x4a\x5d\x05\x17\x2a\x3b\xf0\xa0\x18\x0a\x23\x54\xec\x9d\x5d\xa7\xf3\x0b\xa4\xcd\x80\xd4\xba\x73\xe5\xf8\x8c\x42\x7a\xf3\xdc\xc7\x79\x7b\x77\x41\xc0\x35\x9d\x06\xae\xb8\x1b\xb3\x33\x58\x8f\x3d\xb3\xf0\x9d\x18\x75\x66\xb9\xe7\x10\x3e\x27\xb1\x93\x35\xc7\x8f\x3f\x90\xe3\x42\xb9\xbd\x1f\x4b\x30\x44\xb9\x50\xee\x27\x82\xde\xca\x2a\xc2\x5b\x59\xc5\x00\xb8\x16\xe5\xa7\xd3\x94\x67\xb9\x48\xc8\x07\x4d\x2f\x4b\xf8\x8c\x3b\xfe\x4e\x8a\x27\xf6\x1f\xdd\x1b\xe8\x30\x38\x0d\x7a\xf4\xdc\x96\xa4\x08\x7e\x0f\x81\x71\xa3\xd3\x52\xed\x7f\xa2\x63\x42\xe3\xbb\x44\xeb\xd2\x15\x8e\x5d\xf1\x9c\xbd\x17\xfc\x11\x60\xb0\xc1\x7d\x6b\xf4\x6c\x0e\x8d\x88\x4f\x00\xb7\x46\x67\x12\x45\x92\xf2\xc3\x35\xb2\x21\xab\x0d\x89\xb6\x9f\x8a\xc5\x7f\xe3\xe5\xbd\xbe\x81\x79\xf7\xef\xa5\x37\x29\xfb\xf5\x2a\x9a\xbd\x14\x88\x67\xd9\x7f\x11\xfd\x8b\x0f\xee\x1f\xbe\x84\xed\xa1\x46\x65\x19\x24\x56\x96\xac\xc4\xf2\xe1\xcb\xdf\x25\x3d\x49\x57\x2b\x8e\x42\x46\xf3\xbb\xab\x87\xbf\xc3\x3c\xd6\x87\x72\x27\x0e\xfa\x6b\xef\x2b\x76\xe1\xcb\xd3\xab\x87\xcd\x75\x5b\x4d\x6b\xbb\xf5\xd1\x12\xe7\xb7\x59\x12\xab\x03\xc8\x5c\x78\xc7\xbb\xff\xbe\xf5\x50\x58\xc1\x36\x08\x2d\x59\x70\x9b\x6d\x82\xdf\xee\x12\xb7\xf1\xee\x87\x6f\xbf\xc1\x35\xf2\xbb\xdd\xa6\x88\x16\xb5\xb4\xe7\x70\x7c\x52\xbb\xff\xbc\xfd\x80\x8f\x7f\xd5\x36\xe6\x68\x51\x3e\x68\x7d\x85\xfe\xd9\x35\x05\xcd\x6c\xc0\xf2\x7f\xd7\xbd\xdb\x6a\x2d\x90\x3f\x96\x5b\xb1\xa0\xaa\x2e\x70\x69\xda\x8c\xa2\xc1\x26\x89\xa2\x79\x3c\x68\xf9\xa5\xad\x5a\xb4\xd6\xb2\x0a\xdc\x5d\xad\xdb\x72\xa2\x68\x63\xe7\xc5\x51\x14\x6d\x2e\x08\x23\x0c\xc2\x73\x4a\x7a\xd9\xe0\x11\x4a\x1c\x36\x0f\x1a\x48\xce\x3f\x24\x6e\xc0\xae\xb3\x19\x9e\x15\xab\x4d\xa5\xce\x44\x4d\xfd\xa1\xcb\x5a\xee\xfe\x03\xa3\x6d\x44\xb0\xcf\x2f\xa2\xa7\xad\x68\x77\xf3\xe7\xdd\x06\x41\x35\xc6\x82\x10\xfb\x22\xda\x85\xb0\x47\x99\x07\xca\x5c\x0c\x58\x9f\x02\xc5\xfa\x6a\x02\xd5\x9b\xf5\xc3\xfa\xed\x78\x89\xc3\x77\x77\x49\x12\xea\xb2\xe4\x03\x17\x0e\x09\xeb\x37\xd7\x7a\x3b\x60\x1b\xe0\x11\xe9\x7e\xf1\x97\xf2\x25\xf6\x7e\x63\xe7\x01\x47\xd8\x55\xa7\xf7\xe6\xe6\xac\xff\xfa\xed\xf1\xdd\xd9\x7a\xe4\x65\x15\x65\xf9\x1a\x7a\xb2\x8c\x93\xe0\xbe\x64\x8a\xd8\x69\xd3\xac\x98\x97\x62\xb5\x71\xf4\xcd\x37\x9b\xcf\xf2\x2a\x7f\x1c\x68\x6b\xdb\x71\xba\xfe\x41\x7f\x80\xbc\xca\xed\x87\x2d\xf6\xe2\x0f\xc8\xfd\x31\x16\xbe\x3a\x9b\xe5\x17\xe1\x7a\xbb\xc5\x07\xf7\xce\x77\x90\x5f\xc8\xea\xc3\x77\xf7\xe0\x3e\x7a\x8a\xda\x3b\x0f\x5b\xbb\xcd\xd5\xa9\x92\xf7\x15\x9d\x00\x7e\xbc\xbb\xf3\xb0\x49\x51\xa3\xdd\xc3\x87\xad\xcd\xad\xdd\x72\x79\xaa\xe5\xdb\x6e\x63\x9c\xc3\x68\x17\x8a\xb6\xba\xb6\x3c\xc9\x34\x19\xa6\x73\xac\xfd\xc3\x76\x7a\xd0\xba\xe7\xed\x4f\xed\x07\x64\x2c\xaf\x91\xcd\x23\xf5\xec\x80\x44\x4b\x24\x2c\x4e\x05\x37\xe1\xee\x06\x16\x77\x45\x92\x22\xd1\x96\x5a\xb5\x87\x1c\xba\x62\x5e\xf7\x22\x16\x46\xee\x78\xdb\x0b\x28\x4d\x44\x9e\xea\xb9\xb7\x60\x37\xf0\x51\x2a\xb7\x9e\x0a\x93\xf2\x39\x13\xf1\x58\xa3\xd1\x4e\x32\xc3\xc6\x22\xcd\xd9\x58\x5a\xcc\x40\x0e\xd9\xc7\x64\x10\xb7\x13\x23\xa7\xc2\x50\x0a\x1f\x33\xdb\x9e\x14\xa2\x10\x8b\xfd\x97\xe8\x70\x3a\x97\x31\x38\x52\x1b\x74\x0e\x3f\x25\x6c\x52\x48\x12\xca\xd8\xae\xf4\x47\xa3\x53\xe7\x61\x87\x36\xc4\x32\x6d\x74\x9a\xea\xc2\xb5\xf3\x94\x2b\x66\x0a\xd5\xa6\x86\xb0\x02\x0e\x60\x85\x4b\xf4\x93\xf2\xf4\x6d\xd4\x4e\x80\xca\x60\xdd\x0a\x45\xf2\x6c\x0d\xbe\xa0\xcc\x78\xbb\x56\xff\xe7\xc4\x6d\x2f\xc9\xb3\x85\x8d\x43\x6e\x9b\xe7\xd1\x4e\xab\xd5\xda\x61\xfb\x15\x91\xb9\xe6\xf0\xbe\x86\x07\x3f\xc0\x28\x14\x07\xad\xed\x96\x27\xd3\x08\x33\xda\xa0\xfc\x26\x18\x7f\x4d\xf1\x43\x4b\xc3\xbe\x85\x03\xbb\x55\xc9\xd5\xf3\xac\x01\x0d\x09\x47\xb4\x91\x1c\xec\x7e\xd9\x2c\xd9\x21\x2a\xb9\x4a\xf7\x7e\xa7\x0d\xb7\x1a\xe5\x47\xb5\xc8\x81\xd8\xcb\xec\x20\xb9\xa0\xd8\x30\x3b\xc4\xfe\xbb\xfd\x72\x5b\x6e\x2b\x58\xa8\xa8\xc0\xe5\x17\xcf\x35\x58\x91\xca\xb5\x17\x0f\x0d\x2c\x5c\x5a\xee\x89\x2d\x06\xdc\x08\x8f\xc5\xe0\x6d\x4a\x03\xc7\x8e\xbd\xa8\x54\x54\x19\x5e\x8a\x5c\xd1\xab\xe2\x3a\x66\x35\xc1\xb0\x75\x59\xb6\x25\x36\x5f\xe3\x17\xf3\xd4\xd0\x68\x41\xf2\x48\xa2\x8b\x24\xae\x44\x5e\xee\x4b\xe3\x89\x48\xfd\x3e\x09\x9d\x8c\x63\x10\x65\xa6\x78\xba\xcb\x79\x2e\x6a\x42\x54\x2b\x21\xbc\xd2\x59\x0c\x03\x83\x86\xec\x7a\xc4\xfc\xf8\xee\x75\x97\x9d\xf6\xaf\xdf\x5e\x5e\xb2\x33\x31\x45\xc1\xce\x9d\xeb\xb3\x8b\xe3\xeb\xfe\xc9\xcd\x15\xeb\x5c\xbf\x63\x17\x80\xaa\xc3\x7f\xef\x47\x30\x5f\xed\x83\xf9\xe3\x0f\x8c\xd4\x9f\x48\xad\xba\x50\x99\xff\x78\x7b\x79\x71\xdc\x7f\x73\x73\xd5\x61\x97\x37\xc7\x67\xfd\xdb\xe3\xde\x1b\x76\x25\xcc\x48\x60\x30\x60\xf2\xd7\xfc\x7a\xef\x47\x30\x5f\xed\x83\xf9\xe3\x0f\xec\xf6\xee\xe6\xf5\xdd\xf1\x55\xff\xfc\xe2\xb2\xc3\x7e\x29\x64\xfc\x88\x91\xef\x74\xa1\x92\x33\x98\x73\x08\x9d\x1b\x9d\xfd\x2e\x8c\x26\xd7\xb5\xbf\x6c\xaf\x3b\x7a\x52\xd8\xe3\x27\x3e\x5f\xf1\x7c\x9b\x93\x57\x4f\x57\x09\xbc\xcd\x59\xb7\x77\xd6\xb9\xbb\x03\xeb\xe2\x1a\xcc\x9b\xb7\x3d\xf6\xae\x73\xd7\xbd\xb8\xb9\x86\x6e\xe7\xb0\x0a\x88\xc5\x4b\x26\x0a\x34\x52\x61\xe8\x26\x75\xa4\xd3\x44\x28\x26\x33\xa6\x34\x11\xf4\xe5\x92\xfd\x3f\xff\x07\xfb\x1f\xff\x9d\xfd\x8f\xff\x9d\xd5\x4f\x32\xc7\xd7\xbf\xb1\x63\xdf\x1b\x1e\xb1\xf6\xae\xd3\x31\x57\x4a\xa4\xa5\x9b\x8e\x36\xc1\xe5\x15\x05\x05\xe7\xdd\xf5\xeb\x12\xee\xa2\xee\x31\xf9\x49\x98\x9a\x57\xf5\x65\x17\x4f\x47\xcd\xbc\xc8\x6f\x29\x4d\xf2\x24\xe5\x73\x95\x27\x9d\x3c\x82\xf3\xad\x92\x8e\x34\xba\x05\x9f\x77\x22\xbe\x31\x57\xb5\x92\x86\x04\x14\x9c\x19\xe8\x50\x40\xa7\x1a\x5f\x04\xeb\x47\x87\xf7\xf4\x6f\x45\x72\x2a\xd8\x09\xb7\xe2\xc7\x1f\xce\xf0\xe4\x76\x2b\xf3\xe0\xd1\x51\x95\x87\x4c\x24\x1f\xe1\xeb\xf3\x89\x24\x9d\x81\x00\xc0\xd1\xef\x44\xfa\xf6\x3c\x91\xa1\xb1\x4e\x64\x28\x0c\x4a\x59\x3a\x81\x4e\xb6\x94\xad\x57\x9c\x4b\xe2\x6e\x4f\x79\x0c\x7d\x76\x0b\x91\x4e\x79\xee\x0a\x23\x92\x0e\x72\x53\xc0\xf4\x3c\xe5\xc6\x09\x2b\xb9\x57\xa2\x56\x3a\xa9\x19\x4e\xf1\xf2\xf0\xd4\xdf\xbb\x9d\xd2\xc5\x62\xe8\xca\x53\x0c\x93\xca\xa1\xe1\xe9\x49\xf0\xf2\x10\x4d\x0f\x15\xb0\x4a\x5d\x71\xc5\xe1\x28\x7d\x9a\x25\xec\x54\x27\xe2\x02\xb6\xb3\x53\x9d\x42\x01\xfc\xe3\xfe\x69\xa5\x72\xc0\x83\xaf\xf6\x03\xf4\xe3\x0f\x08\x69\x2b\x9d\xa8\x15\x5d\xab\x44\x06\xe8\x23\x35\x0f\x40\xbe\x7d\x00\xf4\x0d\x74\x9a\x3b\x93\xc8\xe1\x10\x0a\x48\x37\x8b\xa7\x9e\x56\xe7\xd4\x96\x00\x0d\x85\xd3\x82\xaa\x8c\x9a\x0a\xd0\xf4\x15\x2b\xa8\x3e\x45\x55\xb5\xc2\x27\xf5\x84\x4f\x20\x00\xf8\x34\xe0\x00\x09\xe7\x5e\x3a\xdd\x82\x71\x4e\x04\x55\x00\xa2\xdc\xea\x33\xa1\xc2\xa0\x45\xd0\x17\x19\xe1\x72\xc8\x05\x17\xd4\xe0\x2c\x0c\x8c\x33\x19\x3b\x76\x16\x08\x20\xae\xa4\x25\xa1\xf8\x67\x32\xb3\xec\x0c\x09\xc7\xb0\x23\xfd\xc0\x3e\x93\x16\x05\x97\x9c\xc9\xa9\x4c\xfc\x39\xfc\x4c\x67\x5c\xfa\xf1\xd9\xb9\x39\xf7\x40\x90\xc0\xdc\x51\x45\x86\x06\x31\x5c\x60\x68\xd5\xe4\x75\x88\xae\x16\x24\x4f\x4f\x78\xfc\x78\x33\x1c\x82\x97\x61\xe7\x88\x20\xc9\x4f\x84\x67\x9c\xcb\x54\x5c\x69\x25\xa1\x0a\x38\x9e\xf7\x7e\x0c\x27\xfb\x70\xa8\xaf\x2e\x21\xce\x0b\x54\x10\x43\xba\x04\xef\xc4\x90\xbd\xd6\x4e\xff\xbf\x9c\xbd\x6d\x77\xe3\x36\x92\x28\xfc\xfd\xfe\x0a\x8e\x93\x9d\x98\xdd\xee\xee\x24\x33\xbb\xcf\x5e\xef\x74\x34\x94\x48\xd9\x9a\x96\x44\x35\x49\xb5\xdb\xb1\x1c\x0e\x4c\x42\x12\xd6\x14\xc1\xf0\xc5\xb6\x12\xf4\xfe\xf6\xe7\x54\x15\x40\x52\xb2\x3b\xbb\x7b\xcf\xb1\x45\xa0\x40\x82\x20\x50\x28\x14\x0a\xf5\x32\x97\x29\xb7\x2e\xa3\xd9\xd4\xba\xe4\xe5\x4e\xd4\x82\xe5\xd6\xc4\xb7\x26\xbe\xde\xac\x4f\xfc\x91\xcc\x6b\xfe\x54\x5b\x13\x5f\x23\xfc\x64\xe1\xa4\x69\x69\x4d\x16\x0f\x7f\x85\x1f\x20\xdf\x29\x7f\x6a\x31\x9b\xb2\x53\x32\x4e\xd7\x9d\xb5\xcf\xb8\x35\xc9\xf9\x13\x4b\x6a\x23\xf8\x10\x6d\xaa\x46\x91\xc3\x8f\xff\x4e\x92\x07\x12\x3a\xc0\x2f\x2e\x06\x35\x82\x81\x22\x19\x3f\xd5\x28\x33\x29\x9b\xa2\xee\x3a\x6b\x92\x3f\xb0\x4c\xa4\x61\xcd\xfa\xf8\x3b\x29\x89\x1b\x63\x99\xf5\x81\xef\xe9\x6d\x53\x76\xc7\x33\xfc\xe6\xa9\xc8\x43\x54\xaa\x83\x96\x92\xc8\x84\xe0\x92\xa5\xfa\x5e\xf9\xc8\xcb\xa8\x14\xc0\x01\x64\xac\xb4\x66\x93\x99\x67\x69\x4c\x9a\xf1\xb2\xe2\x79\xce\xa3\x47\xe4\x12\x2c\x72\x24\xae\x2f\xf4\x38\xa5\x51\x3f\xd9\x9a\xd1\xca\x3b\x8f\x9a\x02\x2e\xfc\xf1\x81\xd1\xdb\x60\x65\xec\x5a\x0c\x39\xbc\x5f\x8b\x70\x48\x8c\x34\x49\x11\x29\xfd\x32\x85\xf5\x9f\x08\x87\xdf\xd4\xfe\x9a\x22\x67\xd1\xdb\xfc\x07\x3a\x5f\xa4\xdc\x82\x09\xf8\x29\x2b\x6e\xf2\x68\xcf\xdc\xad\x85\x0b\xb4\x5b\xe1\x29\xe0\x35\xcd\x13\xa4\x90\x0b\x89\x36\x7d\x80\x56\x57\x80\xf7\xbc\xb4\x16\xa5\x4c\x78\x55\x79\x4f\xa2\xee\xd3\xb5\x45\x5d\x5a\x1f\x81\x91\xc0\xcf\x08\xf0\xe4\xd9\xe5\x0f\x22\xe1\x24\xc6\xa2\x5f\x22\x7a\x81\x19\x86\x80\x3d\x8e\x5d\x2b\xe0\x2c\xf5\xf3\x6c\xdf\x6f\x7e\xc0\xa1\x98\xf3\xbc\x2e\x59\x5e\x4f\x65\x72\x6f\x01\x86\x06\x7c\x03\xcf\xc3\xef\x0c\xe7\x61\xc0\x77\xb2\xe6\x86\x42\x52\xae\x6b\x54\xc0\x1f\x8c\xac\x0b\xa8\xb5\xc8\x37\x33\x6c\x9d\x7c\xd4\xd3\x3c\x0c\x9d\x4f\xa8\xb7\x18\xf2\x2e\x90\xc5\x18\x45\x9a\x66\x1d\x44\x10\x62\x12\x4a\x97\x28\xb0\x15\x75\x11\xa5\x35\x06\x50\xc6\xd4\x8b\x7a\x8a\x56\x88\xc2\x6f\x03\xcb\x64\x8d\x3f\x7a\x30\xfb\xab\xe6\x28\x1c\x59\x07\x0b\x66\x58\xb3\xe4\x1e\xfd\x19\x53\xf2\x70\x38\x11\x14\xa1\xdb\xba\xb0\xe6\x05\x75\x70\x9b\x9a\xf2\x1c\x45\x7c\x69\xdb\x4e\xca\x98\x86\x52\xae\x25\x7b\x5d\x9e\x5e\x8c\xfd\x15\x36\x77\xfa\xd9\xe6\xce\x80\xf6\xbb\xa8\xec\x56\x4c\x23\x71\xdb\xef\x76\xbc\x2e\x45\x62\x85\x78\xce\xaf\xa5\x90\xa3\x45\x28\x93\x7b\x5e\x5b\x11\xab\xee\x2d\xf4\xc4\x0f\x3f\x86\x3e\x02\x51\x2e\xad\x7e\x75\x34\x13\x90\x8a\x77\x22\x4c\x48\xcd\x58\xe1\xe1\x81\xbf\xce\x50\x78\x61\xc8\xcc\xa1\x77\x20\xf1\x89\xd1\xad\x29\x76\xf1\xd2\x35\xef\x5e\x02\x2d\x59\x6a\x62\xb2\x24\x6a\xb2\x24\x72\xb2\x24\x7a\xb2\x44\x82\xb2\xcc\x53\xbe\x0e\xf8\x5a\xcb\x4d\x21\xf7\x89\x95\x26\x27\x80\x5f\x68\x33\x6b\x59\xee\xc2\x84\xa1\xde\xc9\x32\x07\x24\xc3\x5f\x27\xcb\xac\x8e\x9b\x59\xe6\xa4\xa8\x6a\x2d\x8b\xe2\x80\x64\x7c\x62\x99\xf5\x89\x95\xac\xdc\x58\x9f\x78\xe2\xd1\xc1\x88\xd5\x0e\x85\x1e\x83\x4f\xb4\xcf\xd3\x78\x82\x6e\x1c\xaf\x38\xbb\xff\xc0\xf7\x38\xf1\x21\x0d\x93\xe1\x4a\x96\xf7\xbc\x1c\x61\x18\x10\x9d\x41\xa6\xe3\xe4\xff\xa0\x39\x92\x3e\x28\x88\x57\xab\xe6\xfb\xef\x9d\x1f\xde\xac\x56\xcd\x78\x3c\x1e\xdf\x1a\x38\xda\x9b\x1f\x16\xbd\x3a\x81\x2d\x5c\xd6\xee\x7f\xc8\x31\x9b\x11\x1c\xf4\x55\xdf\x57\x77\xdf\x3f\xa1\xbe\xfb\xab\xd3\xd5\x5b\x4a\xd8\x03\xf5\xfd\xd3\xea\xed\x2a\xd5\xd9\x82\x8e\xc5\xd2\xd7\x6a\x75\xf7\xfd\xcd\x9d\x7c\xba\xed\x1d\xcd\xf5\xcf\xef\x5e\xa9\xd3\xd5\x9d\x79\xac\x5f\x5d\xaf\xb2\xd3\x1b\xee\xad\xc7\xb7\xa6\x4a\x7b\xf0\xae\xf5\xa8\x7b\x24\xd3\xf8\xee\xf4\xad\x5a\xad\x6e\x9e\x3e\x37\xcb\xdb\xce\x6f\xc2\xed\x6b\xfb\xbb\x77\x68\x3d\x75\x20\xa0\x7d\xb7\xfa\xf6\x30\x44\x4f\x6b\x54\xd5\x77\x0d\x80\x5e\x1a\x5f\x97\x5f\xce\xb2\x17\xad\x32\xce\xe4\x59\x75\xdb\x5a\x13\x3c\xbe\x3a\x39\xd1\x3b\xd9\x93\x93\xd5\x23\x0a\x3b\xb5\x21\x06\x16\x52\x11\x14\x7c\xb9\xfd\x72\x96\xfc\x41\x8d\xfd\x28\x46\xc6\x44\xab\x3b\x4a\xc2\x06\x15\x47\x56\x56\x5a\x80\xfd\x0d\x79\xd8\x7e\xff\x4d\xcf\x38\xa4\x1f\x53\xcb\xec\x81\xd9\xdb\xe4\x3d\xba\x65\xcd\xce\x92\xb3\xf4\xac\xe8\x9b\x76\x1c\x7b\x0d\x3c\x3d\x35\xdb\x3e\x55\x98\x30\x9c\x36\x1e\xc7\xc1\xe6\x4f\x9d\xea\xcd\x21\x42\x06\x24\x43\x43\x47\xb2\x5a\x1c\x79\xfe\xee\xcb\xed\x99\x7c\x9b\xbc\x67\x6f\x93\x33\xf6\x47\x5b\x6a\x34\x55\x7c\xd9\xe5\xdf\x91\x1b\xf8\x77\xbf\xe0\x03\x3f\x51\x17\xf7\x5c\x07\xbc\xfb\xe5\x74\xf0\xa7\x1b\xeb\xf6\xf7\x7f\xfb\x62\x93\xcc\x94\x36\xeb\x5f\xce\x7a\xb2\xf0\x2c\x95\x49\xcd\xab\xfa\x8f\x6c\x73\xee\x65\x9d\x89\x97\xc5\x22\xcf\xdd\x38\xb2\xca\x7a\x60\x19\x9e\xaf\x3d\xd0\x1c\x37\x6e\x4c\xe9\xc4\x54\xd2\x21\x10\x5a\x55\x7d\x35\xac\x4c\x2e\xb5\x97\x54\x8c\xe5\xa9\xd3\xc6\xe1\x6a\xa7\x4c\xdf\x3a\xf7\x6b\x0d\x3d\xe8\xa0\x8f\xe7\xad\x33\xd4\x17\x62\x39\xf4\xcf\xf8\x05\x06\xac\x58\x37\x79\xe7\x85\x15\x23\xb4\xe4\x24\xaf\x22\x77\xc5\xfa\xfd\x19\xab\x39\x3a\x95\x81\x9f\x4e\xe3\x98\xe5\xb9\xd4\xb1\x9f\x50\x29\xb7\xe2\xa8\x18\x24\x72\xa3\x0b\x8c\x3e\x7e\x49\x0a\x26\x4b\xd4\xe7\xbd\xdb\x5b\x3d\x11\xab\x09\x91\x81\x41\x0e\x5e\x88\x89\xa1\x55\x1e\x5a\x57\xc2\xdd\xc6\x17\x43\xc5\x84\xb4\x1f\xd8\x32\xe2\x1a\xa7\x52\x7b\xc0\xe5\x2c\xd7\x6e\x71\x29\xd8\x1d\x91\x50\x20\xcf\xd6\x9c\x36\xd4\x7f\x18\x08\x4a\xcb\x5c\x7b\x13\xe0\xdd\xea\xee\x14\xa5\x35\xca\xc8\x66\xba\x03\x07\x51\xa1\x81\x98\x41\xbe\xd6\x9a\xad\xb5\x63\x7c\xf7\xf7\xd5\x23\x0a\x73\x5b\x29\x6d\x57\xa6\x8f\xa5\xff\x7e\xf2\xf2\xd9\x51\x5f\x56\x66\x4e\x57\x28\x44\x59\xfe\x35\x2a\x85\x35\x76\x84\xae\x8b\x97\xf6\xcc\x3e\x0c\x4d\x15\xc5\xed\xa1\x51\x18\x4a\xde\xf3\x7e\x68\x8b\xbe\x01\xd9\x51\xe9\x19\x3e\x7f\xdb\xd2\xcc\x8e\x38\x9d\x0e\xce\xd7\x22\xe3\xaa\x28\x61\xf4\xeb\xbd\xc2\xd0\xb5\x6a\xc3\x6b\x55\xf1\x5a\x95\x3c\xe1\xe2\x81\x97\x0a\xe5\xcb\x00\xa2\x44\xca\x33\xbe\x61\x35\xd2\x95\x57\xe7\xa8\x1c\x54\xbd\x6a\x0f\xfb\xd1\xd7\x79\xf6\x9c\x0e\x62\x71\xab\x15\x71\x20\x1e\xef\x44\x80\xf2\x25\xe7\x45\xb7\x3d\x1b\x38\x58\xf1\xfe\x5b\xb5\x8b\xff\x95\x9e\x45\x79\xc6\xce\xaa\xb3\xec\x2b\x87\x27\x64\x30\x7b\x7a\xab\x3a\xff\x2b\xfa\x20\x08\x57\xde\x75\x93\xaf\xaa\xd7\xa7\x7f\x7b\xfb\xea\x27\x7b\x70\xf3\xcb\xaa\x5a\x9d\xde\xbe\x3e\x5d\x55\xaf\x4d\xda\x5e\x55\x78\x8c\x70\xfe\xaf\xff\x6b\x15\x07\xe3\xca\x55\x9f\x31\xfd\xf4\x8e\xce\xc9\x70\xca\xf7\xf5\x2f\xbe\x72\x5a\x93\xa7\xd5\x82\x95\x3c\xaf\x4d\x73\x7b\x4a\x29\xe7\x78\xdb\xcd\xfb\x33\xf2\xdb\x73\x10\xd6\xa1\xf3\x20\x8b\x28\x7a\xa8\x2c\x61\xa2\x3c\xf4\x3b\x10\x3a\x4f\xb6\x28\x7f\xe8\x56\xea\xbf\x8b\x99\x42\xc7\x40\xe7\xab\xdf\xa1\x87\xbb\x93\xa0\xaf\xba\xb8\x85\x8a\x5a\xb5\xa5\x56\xf3\xca\x50\xb2\x56\xb7\xab\x77\x3c\xa4\x4f\x8e\xbe\xd6\xa5\x7f\xec\x21\xf9\xdd\xcd\xd9\xf9\xad\xb6\x83\x7c\x77\xf3\xb7\xd5\xe9\x19\x35\x93\xc6\x8c\x1c\xec\x54\x67\xd9\xed\x97\x33\xf9\xbf\x0d\x2b\xf3\x47\xfe\xb4\xa0\xa7\x5e\x0c\x9c\x79\xa2\x59\xa5\x18\xbd\x93\xbf\x25\x75\x92\x93\xbf\xad\x56\x83\x53\x7c\xe6\x94\x34\x52\xec\x81\x7a\x6f\xa3\x4e\xdc\x6a\x75\xab\x56\xab\xc1\x4f\x27\x40\xb8\x5e\x24\xa6\x32\x47\xd5\x66\xb4\x44\x5b\x37\xa4\xd6\x4d\x1a\x26\xda\x39\x64\x2e\x6b\xeb\xee\xd1\xca\xef\x1e\x2d\xfe\x88\x2a\x29\x49\x6e\xe5\x49\x6e\x65\xb5\x95\xd5\xdc\xda\xd4\xd6\xa6\xe6\x16\xff\xd5\xca\xf9\xaf\x56\xf9\x64\xe5\xe5\x93\xb5\x3e\x58\x06\xd0\xd1\x28\xaa\x7c\xb7\x46\x6f\xa9\x8e\x88\xd7\x1a\xb7\xec\x58\x61\x15\xb0\xff\xd5\xd2\xa2\x9a\x6d\xac\xa7\x5d\x46\xca\x22\xfd\x18\x69\x55\xeb\xe4\x02\x8d\x67\x50\x52\xce\x2d\x3c\x00\xa2\x23\x1a\xd8\x75\x69\x75\x14\x7a\x31\x09\xd3\xf5\x82\x4f\x6a\xc3\x5a\xfc\xae\xd7\xc3\x6c\x6d\x69\x02\xc4\x53\x74\x59\xc3\xf3\x3a\x21\x99\xa0\xb5\x11\x0f\x3c\xbf\xcb\x64\x72\xdf\x33\xde\x4c\x58\xb2\x25\x13\x97\x3b\x56\xf1\x38\x67\x3b\x5e\x75\xd9\x2a\xd9\xf2\x1d\x3b\x02\x22\xdb\xa5\x61\xa8\xf8\x12\xc3\x17\x9a\x24\xda\x73\xee\x98\xc8\x62\x3a\x6b\x22\x65\x95\x18\xbe\x67\x5b\xef\xb2\x58\xb3\x8e\x16\x45\x0c\xd1\x97\x98\x5c\x73\x6c\xc9\xab\x0d\x3a\x48\x43\x3e\xc0\xb8\x05\xc9\xfe\x93\x3d\xc5\xda\x50\x27\x13\xf9\x3d\xfe\xc4\xfa\xfb\xb4\xfa\x7c\x1f\x44\x01\xff\xfa\x90\x92\x27\xd4\xd1\xf9\x7d\x9c\xf2\x9a\x89\x8c\xd2\xe8\x63\xa6\x77\x3b\xe6\xfb\x37\x67\xec\xa0\x1c\xb2\xfd\xe2\x9c\x3f\xf5\x8b\xb5\x8d\x66\x5b\x5c\x94\xfc\xa1\x57\x0c\x59\x53\x8c\xa6\x0f\xb2\x40\xe3\x29\xb4\xae\x8c\xe9\x04\x44\x1b\x8b\x21\x3a\xd3\x41\xca\x31\xf3\x66\x51\x15\x18\x3e\x9a\x97\x68\x4e\x85\x46\x90\xa5\x56\xb7\x47\x8b\x7c\x5e\x35\x59\x0d\xbd\x8e\xaa\x92\x15\x67\x65\xb2\x8d\x59\xb9\xe9\xa7\xb5\xca\x8b\x36\x54\xad\x64\x59\xeb\x3b\x74\x4a\x97\xd7\xdb\x92\xb3\x34\x26\xad\x6a\xb2\xbb\x8c\x11\x63\xb5\x43\xa8\x3b\x34\x9f\x64\xfa\xe0\xc8\x5a\xc3\xe0\x8b\xb5\xb9\xc2\xac\x83\xa4\x25\xd6\xb1\xf6\x63\xb3\x8e\x75\x18\xd8\x75\xac\x15\x55\x63\x9c\xc8\xd0\x1f\x31\x55\x87\xc9\xf6\x60\x4a\xe7\x9a\xbc\xb6\xb4\x2f\x3c\xba\xc4\x4d\x61\xce\x91\xe8\x12\x93\x55\x68\xd9\xe4\x71\xb2\x15\x59\x5a\xf2\xdc\xaa\x24\x2b\xb4\x66\x38\x20\x2a\x66\x69\x18\x7f\x6d\x78\x55\xf7\x01\x55\x21\xf3\x8a\xc3\x8c\xc5\x99\x60\xb1\x2a\xe1\x64\x74\xc8\x1e\x78\x09\x5c\xec\xdd\xde\xb8\x50\x4c\x79\x5b\x98\x4a\x0a\x6c\x5f\x59\xeb\x52\xfe\xc6\x73\x1d\x70\x52\xe3\x36\x7c\x3c\x1a\xbf\xf5\x9c\x05\xd5\xd2\xc4\xfa\xab\x75\xd4\x53\x63\x00\x23\x73\x4b\x96\x30\x0b\x0a\x5c\xf2\xfa\x0c\xbb\x36\x03\x6b\x63\x14\x18\x9f\x29\xf0\xe1\x5b\x74\x78\x72\x2f\x0a\xf2\x80\x1d\xd3\x98\xa1\x3d\x04\x79\x37\xa1\x7c\x2d\x35\xf3\x8b\xf3\x94\xce\x09\x7b\x7e\x03\xf1\x17\xaa\xc2\x33\x4e\x64\x4d\xfe\xf6\x27\x0c\x65\xf3\xe6\xcd\x4f\xad\x82\xb6\x3c\x62\x89\x56\xab\x9b\x5c\x16\x24\xa6\xc3\xb3\xd0\x83\x50\x55\x37\xef\x0e\x0b\x4b\xa3\xb6\x91\x03\x87\x7a\xcc\xc9\x1d\xdc\xaf\x0e\x36\xc1\x9d\xa3\x8e\xef\x50\x3b\xe6\xbb\x13\xd8\xce\x3e\xd3\xac\xe9\x74\x41\x30\x88\x41\x1b\x7a\x57\x9d\xbe\x19\x08\xad\x9c\xa7\xe6\x6c\x4e\x9b\xc5\x43\x75\x77\xe7\xc0\x75\xc9\xd7\x9d\x9a\x7c\x4d\x95\xea\xcb\xd9\xef\x7a\x47\x7c\xf3\xcd\xb7\xb7\x27\xaf\xeb\xfe\x4e\x78\xb5\x4a\x5f\x9f\xe8\xe8\x2b\x9d\x0b\x87\x2e\x84\xc1\xb9\x89\x66\x55\xb7\x37\x9d\xbd\xa0\xe4\x73\xf2\xe6\x74\xf0\x27\xf3\x21\xf6\xc9\xeb\x5a\xaf\xf6\xa4\x3f\xf4\x96\xfe\x6c\xe3\x80\xef\xdd\xe9\x9b\x9f\xd4\xea\xad\xdd\xd7\x82\xca\x5e\xe0\x69\x08\xa9\xcd\xe8\x90\x0f\x7d\xa5\x35\x8d\x5e\xd0\xaf\x79\x7d\x72\xfa\xfe\x74\xf0\xa7\x9f\x6c\x7b\xa0\x6e\xde\xbc\x7e\xf5\xee\x5f\x6e\x31\x4b\x3c\xee\x73\x65\x8d\xac\x3a\x39\x23\x66\x40\x6b\x9a\x1a\x97\x0f\x19\xd4\xa5\xfe\x7c\x93\x6d\x6e\xeb\xff\x38\x39\xbb\x3f\x17\xcf\x82\xaf\x91\x22\xec\x21\x4e\x01\x66\xe8\xb6\xea\x8f\x42\x6c\x92\x67\xd5\xd9\x0b\xb8\x19\x6b\x0f\xba\x77\x25\x4b\xee\x39\x70\x60\xcf\x30\xf4\xf8\x16\x44\xd5\xff\xc7\xc6\xf5\xf1\xbd\xd7\xd0\x5e\x23\x6f\x8d\x0a\x5d\x62\x7f\x39\x8e\xa7\x41\x6e\x58\x5a\x06\xee\x99\x53\x1e\xec\xc5\xff\x6b\x82\xca\x1d\xd4\xf4\x15\x1e\x2c\x15\xeb\x3f\x14\x6f\x1c\x79\x4c\x4c\xf3\x23\x87\x89\xcf\x5d\x24\x1a\x29\xcf\xff\xc8\xdf\xe2\x8b\x1e\x16\x8f\x3c\x69\x9c\xfc\xf2\xa6\xf5\x38\x84\x32\xa1\xaf\x73\x94\x9c\xfd\xf1\xd7\x1c\x68\xd4\x7d\xf3\xba\x27\x7d\xbc\x7d\x65\xbc\x5b\x58\xbf\x1f\xee\x81\x5e\x8a\x5c\xf1\x4d\x1b\x03\x0f\xbd\xfd\xb7\xbe\x26\xa1\xb2\xdb\x83\x5a\x8f\xe7\xe9\xb3\x50\x1f\x07\x1b\x98\x3f\x70\x4f\xf3\xcc\x63\xc8\x1f\xbc\xf2\x0f\xfd\x7d\x64\xbc\x7a\xd9\x85\xad\x09\xdc\x07\xcc\xf6\x29\x52\x51\xf5\xf7\xdf\xf1\xfa\x05\xd9\xec\x9b\xdb\x33\x01\x3f\xf9\xfb\x97\x7a\xf8\x90\xe8\xfd\xd7\xe0\xe4\x35\x7f\x7d\xf2\xf6\x15\x5c\x01\xaf\x7b\xcf\x9c\xd5\x67\x65\xff\x39\xae\xb5\x2a\x4b\x22\xf9\xc7\x3d\x94\x18\x63\x05\xf1\xb6\x68\xaa\xed\xe9\x21\x4d\xcf\x4f\x4f\xbe\x3b\xb1\xcf\xf2\xd3\xef\x4e\xbe\xc3\x3d\x6f\x18\x12\x65\x3f\x39\x6d\xca\x4c\xa1\x6a\x52\x53\x0a\x1b\xab\x6c\xe5\x22\xbd\xc6\x92\xa7\x28\x9b\xcc\x8f\x48\xbb\xee\xcb\x99\x3c\x6d\x05\xcc\x27\xdf\x90\x6a\xfb\x98\xbd\x59\xdf\xbe\x86\x75\xc1\x3e\xab\xe0\x86\x6e\x24\x4e\xfe\xfe\xf7\x01\xd0\xda\x1f\xbe\xb7\x8f\x0b\x74\xff\x9d\x60\x81\xd9\x2a\x9c\x9c\x9d\xfc\xd7\xe0\x9f\x37\xbf\xfc\xf3\xf6\xd5\xe0\x9f\xc6\xe5\xec\xc1\x54\xe9\x14\x51\xc9\xd7\x4f\xf9\x2c\xca\x4b\x3b\xf5\xff\xa4\x55\x6a\xf2\xfa\xe4\x8b\x8d\x7a\xb8\xd9\x7b\x61\x26\xfe\x91\x8e\x13\x43\x9f\x5b\xb8\xbd\x7c\xdc\xf2\xfc\xa4\xb7\x27\xc6\x48\x35\xe4\x28\xfd\xa4\x23\x1c\xc2\x46\x61\xef\xdd\x79\x79\xa4\x19\x0b\xbd\xf6\x1f\x5f\x30\x56\xfd\xa1\xce\x60\xfb\x09\xa5\x6e\xfa\xd1\x3c\xbf\xd2\x5b\xdf\x9b\xbf\xbd\xff\xb6\x7d\x5c\xa0\x9b\xdf\xe2\x99\xa8\xeb\xe4\xef\xa7\xf4\x75\x6a\xc7\x53\xc1\x54\xb2\x65\x65\xc5\x6b\xb5\x96\x79\xfd\x06\xb6\xd7\xea\xf4\xcd\x0d\x8a\x3c\xde\xd8\x83\x7b\xbe\x47\x67\xfc\x95\xd2\xa6\x90\x95\x32\x1a\xd9\xaa\xe5\xa3\x55\xc1\x36\x5c\x3d\x08\xfe\x88\xd5\x6e\x65\x45\x82\xe1\x3e\x21\xba\xf9\x8f\xdf\xbf\xf4\x78\x11\xa1\xc9\xd2\xee\x58\xca\xa5\x97\xdc\xbf\xe3\x10\x9b\xde\x39\xff\xe1\x5f\x69\xbd\x05\xf0\x97\xdb\xc3\x7a\xfb\xd5\x66\x5f\xbe\x9c\x35\xef\x5b\x96\x60\xb5\x7a\xfb\xcd\xf9\x9f\x57\xab\x9b\x9f\xb4\xf7\x32\x6c\x04\x56\x55\x52\xb0\xd5\x2f\xb7\x46\x8a\xe2\x75\x7d\xf8\xdd\xb7\xab\x93\x6e\x14\x0e\xa7\x46\x02\x38\xd7\xf6\xe6\x09\xcb\x32\xc2\xde\x3f\x42\xd1\xc3\x78\xa0\xe5\xeb\x93\x7f\x19\x9c\x9c\x7d\x7f\x58\x24\xa0\xb6\x6f\x4e\x5e\x97\x87\x60\xcd\x30\x9c\xac\x56\x6f\x61\x5d\xfb\xfe\x85\xfa\x4e\xfe\x8c\x95\x69\x6f\x4d\xba\xa4\x17\x60\xb3\x53\x99\x3b\x8e\x30\x5a\x54\xbc\x49\x25\x69\x92\x9e\x9e\xdb\x83\xee\x30\x65\x15\xaf\xde\xac\x5e\xaf\x4e\x57\xf6\xc9\x77\x6f\x8d\x77\xa6\x63\x0a\x92\x11\xb4\x3f\x57\xfa\x07\x0f\x2f\xd0\x95\xe2\x6c\x77\x96\x9e\x35\xd0\xd8\x89\xe9\xee\xf7\x3f\x7d\xf7\xee\x6f\xa7\xd4\xe3\x30\x99\xbe\x46\x60\xc5\x71\x94\xa0\x23\xb1\xc6\x6a\xf5\x66\xb5\x7a\x8d\x62\xbd\x77\xab\xd5\xdf\x56\xab\xf7\xab\xd5\x4f\xab\xd5\x9f\x57\xab\x6f\x7a\xa7\x44\x7f\x74\xdb\x9f\xb4\x4c\x64\xb5\x52\x37\xbf\xdc\xbe\x1a\xac\x56\x0a\x05\x21\xa7\x64\xe2\x88\xe6\x8a\x9d\x1b\x3f\x85\x15\xa0\x89\xe3\x69\xaa\xb8\x5a\xab\x4c\x55\xca\x55\x9e\x1a\xab\xa9\x0a\xed\x53\x6d\x1c\x69\x0f\x8c\xd3\x3f\xf1\x07\x4e\xff\xf2\x67\xfe\xf9\xf0\x48\xa6\xfe\xfd\x87\x2f\x2a\x17\x99\x3e\x67\x91\x2f\x19\x33\xb0\x8e\x1d\xfd\xe6\xf4\x4e\x0d\xed\x9b\xef\xdf\xfc\x70\xfb\xfa\xf4\x1d\x5d\x51\xa6\x4a\x85\x52\xf9\x36\x99\x36\x60\xe1\xff\x77\x50\xf8\xa4\x3e\x43\xe1\xff\xa5\x63\x3b\x7d\x4b\x9b\xeb\xdd\x98\xa8\x11\x12\xfe\xd7\xec\xf5\x89\xf5\xfa\xe4\x35\xeb\x3b\x49\xab\xfe\xc0\x31\x61\xf6\x92\x63\xc2\x44\xaf\x4d\x26\x40\xd8\xab\xee\x24\xec\xc0\x51\xe0\x9f\x89\xb9\x47\x97\x84\x9a\x01\xdf\x21\x0d\xc5\x09\xff\x1c\x39\xb5\x57\xb6\xb3\x1c\xd6\x95\xb3\xe2\xf6\x0b\xba\x1f\x3c\xbf\x01\x86\x35\x01\x24\x3c\x2b\x5a\x5f\x6b\x37\xdf\xfd\xf3\xf6\xd0\x0d\x97\xae\x90\xf4\x26\xbb\x6a\xef\xcf\x7f\x07\xa2\x77\x7e\x82\x05\x27\x5f\x8c\x64\xfd\x35\x3a\xb3\xdb\xb4\x84\xe7\xbb\x6e\x23\x42\x3b\xa7\xd3\xf3\x73\x6d\x71\x8a\xbd\xb4\x7e\xff\x3c\x9c\x2e\xbc\x36\xee\xbb\x3b\x34\x33\x69\xfd\x36\x79\xdf\xf7\x89\xde\xb7\x3c\xc0\xf7\xc6\xb7\x67\x31\xdc\x73\x77\xb6\x39\x5b\x63\x88\xc6\x4a\x1f\xf7\xed\xe0\x23\x7f\xef\x9c\xbc\x49\x0c\xe1\x08\xa5\x74\x6f\xf1\x75\x7e\x46\x3c\xa0\x5a\x5d\xc5\xcb\x07\x40\xb5\x17\x95\xdd\x31\x7e\xe9\xa6\x2e\xaa\x5b\x72\x09\xde\x8b\x0d\xbd\x5a\xbd\x01\xe6\xef\x4e\xad\x56\xdf\xc6\xda\x61\xb8\x66\x64\xdf\x77\x56\x2c\xa4\xbb\xfc\x2c\x78\xeb\xcd\x2f\xe7\xb7\xef\xde\x11\xe4\xf6\x8c\xbd\x3f\xde\x0e\x75\x46\x3c\xf1\xab\x52\x6c\x5e\x7e\x79\x67\xd3\x13\x1b\x5f\x73\xab\x37\x46\x8c\x2f\x9e\xd5\xa9\x27\xdc\x51\x2d\xaf\xf5\x9e\xb5\x6f\xe2\xfd\xc3\x81\xba\xfb\xb7\xf1\xc8\xf7\x3f\x4c\x3c\xeb\x5b\x54\x3e\x0d\xad\x6f\xe3\x0b\x2f\xa2\xdf\x78\x38\x99\x3b\xc1\xb5\xce\x04\xce\x95\xf5\x6d\xbc\xf0\xc3\x48\x5f\xba\x62\xcc\x51\x79\xe8\x85\xa8\x3d\x0a\xa9\xe0\x93\x17\x58\x30\x0a\xe8\x39\xa7\x4b\x55\x56\x45\xca\x2c\xe6\x5a\x61\x99\x76\x3b\x44\x09\xb8\x27\xaf\xd1\x9d\x8e\x49\x54\xa8\xfa\x7b\x25\xcb\xb4\x4d\x90\x58\x67\x53\xb2\x62\x6b\xb1\x35\xfa\x89\xdc\xd7\x5c\xcb\x4a\x79\xbe\xc9\x44\xb5\xb5\xea\x2d\xd7\x01\x4f\xb6\x75\x5d\x60\xfc\xb4\x07\xb4\xe6\x6b\xc5\x31\x24\x47\x43\x01\x62\xc9\x59\xf6\xef\x24\xe1\xa8\x30\x68\xd4\x16\xcf\x2d\xab\x3a\xc5\xb8\x2c\xfa\xa4\x52\x7b\x29\xb2\x50\x16\xfb\x88\x0d\x59\xcb\xa6\xac\xb7\xd6\x1d\xfa\x65\xb4\xee\x32\x96\xdc\xa3\x6f\x92\xad\x55\x89\xa7\x7a\xdb\x39\x2f\xaa\x30\x59\x41\x95\xbc\x2c\xad\x66\x92\xd7\x3f\xd0\x6f\x85\x97\x1f\xe9\x17\x6f\x10\xb9\x91\x09\x43\xe3\x2a\xab\xe4\x19\x1d\x38\x96\x3c\x43\xd7\x23\x6b\xb1\xae\xb7\x96\xa8\xf9\xae\x22\xc3\xc3\x9d\x48\xd3\x8c\xc3\xc5\x62\x35\x89\xd7\xe4\x5a\x9f\xb3\xd6\x74\xf8\x2a\xca\xd4\xd2\xbe\xaa\xac\x9d\xc8\x32\x41\xce\x71\x2b\xeb\xf0\x0a\x17\x4c\x5b\x02\x5a\x28\xb0\x81\x22\xaf\xff\x8a\x3f\xfa\x38\x23\x47\x47\x48\xf5\x8f\xf8\x53\x59\x3a\x40\x0d\x6a\xdf\x41\xa3\xb4\xf3\x65\x99\x6f\x2c\x92\xa1\xf2\xd4\xe2\xeb\x35\x4f\xf0\x23\xe0\x3b\xff\x4a\xbf\x95\x71\xfc\xc1\xf3\xd4\x24\x97\xc1\xd4\x12\x64\x6e\x80\xf2\x2f\x13\x4f\x53\xea\x1e\xc7\x98\x03\x56\x2d\xef\x79\x6e\xdd\x89\x1c\xbf\x07\xdd\xcf\xd7\x38\x6c\xac\xb2\x6a\x91\xdc\xd3\xaf\x09\xe2\x05\xc3\xfb\x57\x94\xc9\xb1\x9a\x4f\xb0\xdb\x60\xac\xd1\x91\x13\x3a\xa2\x69\x5d\x4c\x75\x92\x3c\x2e\x36\xdb\x7a\x6b\x3d\x6e\x65\x86\x82\x6f\x52\xd8\x34\xee\xad\x49\xe5\x53\x7b\x1e\xaf\x8b\x56\xa8\xcf\xee\xee\x4a\xfe\x40\x5e\x61\x20\xad\x01\x3d\x77\x87\x95\x31\xaf\x17\x6b\x2b\x15\x0f\xe8\x88\xe6\xb1\x64\x85\x39\x7d\xb8\x13\xb5\x93\xa3\x4b\x99\xb9\x44\x4d\x76\x1f\x61\x9f\x65\x69\xb1\x1d\x74\x29\x3a\xda\xce\x2d\x66\x31\x8a\xeb\x8d\xb1\xba\xb4\x3b\x43\x3c\x42\x42\x34\x46\x55\xfe\x0a\x67\xc1\x3d\xdf\x57\x80\x0e\x70\xed\x1d\x30\x87\x93\xcf\x56\xe4\xcd\xad\xb1\x1f\xcc\xc6\x9e\xe7\x5a\xf3\xc9\xdc\xb3\x7e\xf6\x02\xdf\x9a\xfb\x73\xcf\x0a\x17\xce\xc8\xb3\xc6\xfe\x32\xb0\xc6\xce\x34\xf4\xac\x91\x3f\xf5\xe7\xd6\x28\x98\x8e\xad\xc5\xc4\x1a\xf9\xb3\x99\x63\x79\x73\xd7\x1f\xa3\xfe\xba\xe7\x8f\x2d\x6f\x72\x71\x19\x59\xe3\xc9\x27\xcf\xfa\xb8\xf4\x23\xcf\xf2\x66\x8b\xe8\xda\x82\xda\xa2\x60\xe9\x59\x81\x17\x2d\x03\xa8\xc2\x9a\x4e\xe6\x1e\xbe\x34\xc0\x47\x86\xce\xe8\x43\x38\x75\xc2\x4b\x0b\x55\xf1\x43\xef\x93\x37\xb7\x22\x67\x68\x45\x97\x81\xe7\x59\xd1\x95\x0f\xf3\xc9\x42\xff\xee\xb2\xdc\xa1\x63\xc1\x1c\x26\x2e\x7a\x10\x47\x19\x38\x79\x1e\x84\xf9\xa8\x0f\x7a\x12\x54\x2b\x4e\xca\x6c\x6d\x15\x82\x6c\x79\xa0\x7f\xe4\x9a\x50\x46\xae\x69\x88\xad\x35\x20\x25\xad\x99\x24\x77\x86\xda\x50\xce\x6c\xdc\xce\x95\x38\x0f\xf1\xa5\x25\x3e\x82\x9e\x0e\x33\x56\x6d\xe9\xb0\x06\x49\x86\x55\xb3\x3b\x14\x9f\x72\xab\x7e\x94\x56\x30\x31\x1a\xf5\xc1\x24\x8c\x9c\xc8\xb3\xa0\x97\xe2\xc0\x73\xdc\x78\xe6\xbb\x3a\x7b\x15\x4c\x22\xef\xe5\xbc\x3b\x09\x9e\x15\xe3\xd3\xcb\x99\x13\x7e\xe8\xdf\x4e\x00\xb8\xbf\x57\xde\x3d\x8e\xf9\xfe\xe1\x54\xd1\xd4\x3d\x4f\x4d\x99\x60\x55\x6b\xc5\x6b\xb1\x3c\x6f\x44\xbd\xb7\xf0\x1c\x89\x74\xd4\x29\x4d\xea\xe9\xcf\xfc\x38\x19\x79\x37\x7b\xd8\xc0\xbf\xcb\x1f\xac\xbb\x9e\x7e\xbb\xce\xe8\x87\x21\x33\x92\xf9\x03\x2f\x01\xb1\x73\x56\x9a\x37\x50\xc6\xdc\xb5\xaf\xb9\xbf\x5e\x57\xbc\xc6\x64\x24\xe7\xcd\xce\xc2\xf3\xa7\x14\x68\x43\x9b\x22\x8a\xaa\x8b\x45\xb1\xe5\xe5\x1c\x0f\x9b\xda\x05\x47\x57\xd2\xe6\x17\xfa\xd0\xbf\x83\xe8\x87\xf5\xe2\xd3\xde\x8f\x56\x5f\xa6\xb2\x5d\x81\xee\x58\xfa\xf1\x1f\x2a\xd8\x36\x98\xf2\x4a\x1f\xf3\x75\xda\xdd\x29\x6f\x6f\x26\x17\x9e\xb2\x14\x1c\xd2\xd5\x3d\x69\xf2\xba\xf3\x30\x44\x0e\x05\x5d\x4e\xc0\xff\x0f\xf0\xf3\x23\xa6\xbe\x27\x97\x39\x75\x85\xa4\xba\x22\xff\x99\xde\x03\x41\x64\x96\xc2\x53\x26\x90\x81\x66\x6f\xa8\x29\x1b\x2e\x49\xe3\x71\xc6\x59\x7e\x1c\xc0\x60\xcb\x2a\x52\x63\xb5\xb6\xac\xdc\xc9\x5c\xdf\x05\x9b\x5e\x27\x4d\xb1\xad\xbd\x74\x24\x51\x87\x11\x20\x07\x89\x48\x9a\x9b\x45\xa5\xb5\x00\x27\xa1\x1f\xc9\x19\x4b\x90\xf0\xeb\x1e\x44\x82\xd3\x0f\x6b\x28\xee\x96\xc1\x14\x55\x15\x5d\x56\x33\xcc\x97\x19\xf4\x56\x9b\x0d\xa6\xeb\xba\x18\x69\x7b\x3b\x02\x4c\x59\x55\x5f\x46\xd1\xe2\x12\x4f\xfb\xaa\x1e\x34\xb8\xec\xc3\xca\x6c\xd6\x64\xb5\x28\x58\x59\x43\x95\x4e\x9a\x2e\x58\x59\xbf\x54\xd4\x7b\x9b\x56\x65\xc4\x89\xad\x5b\x9d\xe5\x56\x96\xff\x40\x67\xa7\xd4\xa3\xda\xed\xd8\x8f\xda\xf7\x18\x2c\x68\x63\x91\xf1\x05\x83\x6f\x92\x8f\x78\x86\x9b\x44\x72\x12\xfa\x74\x38\x33\xda\x36\xf9\x3d\x25\x51\xb3\x74\x87\x8a\xad\xd4\x84\x6c\x4f\x47\x37\xe9\xbf\xba\x02\x3d\xb4\xa3\x18\x23\x6f\x5d\x6d\xd1\x82\xdc\x26\xaa\xfe\x1a\x7d\xb8\x5e\x63\x28\x01\x99\xd7\x5b\x6a\x24\xe9\x22\x8d\xda\xa9\x40\x8b\xb1\xf8\x8d\x63\x13\x72\x80\xd0\x48\xe5\xcd\x2e\x92\xa8\x9f\x84\x29\x54\x4f\xa2\x54\xcb\xab\x61\x76\xde\x56\x68\x49\xea\x19\x0a\x29\x50\xf0\x9c\x30\x12\x52\x5a\xdb\xfa\x30\x37\x71\x29\x4b\x6a\xae\x3d\x2e\x4d\xf7\x30\x0a\x3e\x47\xdd\xf1\x5c\x7b\x48\xc7\x79\x69\x10\xab\xa0\xe5\x77\xc6\xab\x8a\xfc\x1d\x65\xac\x46\x97\xb7\x85\x2c\xc2\x3a\x05\x02\x53\xc8\xa2\xc9\x8c\xfa\x73\x9e\xb2\x12\xa0\x82\x0e\xd7\xbb\xb2\x4f\xac\x14\xe8\x8d\xb2\x90\x45\x97\x36\x0d\xd5\x3e\xc8\xf4\x65\x88\x9c\xa3\x0e\x45\x80\xfd\x46\xe7\xa2\x56\xc9\x1f\x46\x25\x67\x35\xff\x3c\x9b\x46\x40\xda\x8f\x01\xe3\x52\xee\x00\x27\xb0\x80\x8e\x90\x03\x3a\xb8\x3d\x06\x4c\xaa\x31\x72\x0b\xcf\xe1\x80\xd2\x00\x76\xf5\xc9\xf9\x48\x66\xcd\x2e\xa7\x7e\x7a\x06\x9e\x54\x68\xa4\xf6\x0c\x3e\xc5\xa9\x56\x3d\x2f\x20\x2c\x79\x11\x9c\xbe\x00\x46\x5c\x79\xa9\x24\xda\x17\xc7\xf5\xa0\xe9\x6b\xd0\xf6\x54\x5b\xd0\x94\x95\x2c\x0f\xee\x85\x0e\xef\x72\x68\x6b\xf0\xac\x5d\xa8\x63\xad\xf3\xd0\xad\x1f\x1b\x5e\xee\x01\x90\xde\xc5\xe8\xec\x9d\xea\x35\x10\x6c\xd4\xdd\xde\xb8\x94\xeb\x01\x13\xd3\x73\x2d\x44\x54\xb9\xee\xb4\x16\x94\x75\xfd\xd5\xc2\x72\xd3\xa4\x16\x52\x9b\x8f\x46\xc8\x6e\x27\xba\x7a\xc9\x24\xf8\x20\x87\xc1\x35\x8e\x21\xc0\xed\x6b\xd8\x81\x8a\x41\x0b\xab\x64\xd9\xdd\x7d\x00\x3e\x78\xd4\x74\x69\x7a\x17\xa7\x77\xb5\xee\x2b\xc8\x88\xea\xb0\x31\xda\x95\x99\xce\x89\x0a\x38\x1d\x93\xbe\x6b\xd3\x3b\xf9\xc0\xd7\x06\x21\x75\x3e\x63\x07\xd9\x9c\x26\x82\xc9\x16\xc0\xc7\x52\xf6\xd7\xde\xd0\x60\x1a\x8f\xf9\x29\x4f\x9f\xd7\x1f\x83\x52\x66\x19\x70\x4c\x3a\xdb\xd3\x0b\x29\xf9\xc3\x05\xaf\x0d\x02\xb8\x68\xe2\x8c\xd4\xb5\xe4\x0f\x84\x89\xfe\x3a\x68\x95\x18\x1e\xfc\x82\xe7\xe6\xe6\xe3\x3c\xde\x80\x28\xd3\xbf\xe3\x00\x30\xcc\xe4\x5d\x0b\xec\xd0\x16\xb3\x93\xca\xa9\xc3\x1a\xd6\x8d\x3e\xc4\xc3\x6d\xc9\xc3\x32\x17\x4f\x80\x91\x33\x96\x98\xd6\x7d\x9e\x4d\x9d\x9e\x51\xf5\x41\xbe\x3a\x06\xa0\x29\x87\x81\x8e\xb6\x22\x4b\xd1\x56\x89\xdc\x88\xb4\xb0\x76\x42\x00\x00\x69\x4c\x9f\xc0\x5c\x09\x4d\xf0\x81\x83\x78\x7e\xdb\x8b\xc5\x66\x26\x7d\x86\x56\xb7\xd3\xe9\xf3\x6c\xea\x3d\xb0\xac\x01\x22\xd6\xfb\x1c\xa4\x4e\xd8\x10\x0d\x40\x43\x16\x91\x6f\xd0\x70\x86\x40\x73\xfe\x54\x87\xe2\x2e\x23\xcd\x11\x84\xc8\x94\x1f\x7d\x8c\x19\xb8\x91\x51\xac\x20\x30\x9d\x6c\x99\x0c\x6c\x92\x64\x53\x1d\x56\x16\x48\x59\xf7\xde\x16\x2c\x46\x31\x7d\x61\xa0\x55\x30\x3a\xb8\xab\xc5\xf9\x55\x0f\x46\xb6\x10\x5d\xfe\x82\xd7\x97\xf2\xe0\xa9\x0b\x5e\x6b\x03\xa8\x03\xd8\x42\x47\x68\x06\x90\xa6\xfe\x6d\x9d\xdd\x64\x3a\xba\xbf\xa5\xcf\x47\xf0\xa3\x0f\x30\x60\x43\xdd\xfa\xe0\xb6\xef\x0d\x44\x96\xc7\x35\x96\xb2\x96\x89\xcc\x0e\xa1\xcf\xbb\x03\x81\x5a\x2b\xe5\x00\xaa\x2d\x4e\xf4\xb7\xe9\x15\x4c\x27\x4d\xdf\x7d\x62\x99\x00\xf6\xd5\x8d\x90\x4a\xff\x2c\x0a\x97\x57\x49\x29\xee\x70\xab\xac\x41\xad\x29\x20\x6d\x9f\x09\x08\x13\xd0\x29\x93\xad\x78\x80\xca\x90\x4f\xae\xd8\xae\x5b\x6e\x81\xa3\x31\xf2\x04\x2d\x63\x20\xa1\x8e\x66\x08\xaa\x2d\xfb\x41\xb3\x43\xe4\xfc\x18\xb7\xd5\x2d\x8f\x85\xae\x5d\x0b\x9e\x08\x96\x8d\x91\xf3\x25\xe8\xaf\x25\xea\xbc\x1d\xad\xfc\x55\xcd\xea\x80\x9a\x40\x6c\x82\xf6\xf1\x49\x58\x41\x82\x00\xc3\xf5\xa1\x97\x58\xbe\x2b\x90\xb9\xad\xf9\x53\xad\xf7\x21\x90\xd4\xbb\x10\x14\x1f\x18\x49\x02\xdc\x25\x49\xe6\xa0\x5b\x5e\x4b\xb4\xba\xb3\x6a\x89\xa6\x34\x07\xae\x43\x1b\xfe\x28\xcb\xd4\xdc\x58\x36\x79\x62\x35\xb9\xd0\xaf\x68\x72\xa1\xdf\xd0\xe0\x83\xcb\x60\xaa\x4b\x80\x45\xce\x4d\x2a\xd4\xae\xf9\x1b\x91\x6a\x0f\xf6\xe6\x9c\x86\xc8\xc4\x83\xe9\x62\xe3\x7b\xe1\x91\x89\xda\xe5\x45\xbd\xb5\x1e\x39\xbf\x4f\xd9\x9e\xee\xeb\xb5\xe4\xa9\xca\x6a\xa7\x28\xb2\x3d\x9a\x39\x56\x5b\xfe\x32\xac\xe5\x68\xa0\x6c\x2a\x59\x7a\x74\xfb\x21\xa8\xbd\x9b\xa5\x29\x39\x0e\xd0\x52\x3f\x68\x5c\xa6\xfd\x68\xb4\xea\x88\xa4\x8a\x88\xfb\x6f\xad\xcf\x88\x41\x90\x33\x8c\x51\xa7\xb7\x4a\x7b\x94\x00\xe1\x82\x6f\x55\x84\xbd\x9a\x6b\xd3\x81\xf9\x78\xbb\x05\x83\x04\x6e\x26\x13\xa4\x12\xa8\xaa\x49\xd6\xfe\xcf\xea\x4c\x79\x52\xee\x8b\xda\x38\xfc\x6a\xdb\xf2\xdf\x34\xc3\x84\xbf\xb3\x52\x34\x9c\x45\x0d\xae\x9c\x6a\xd2\xc1\xe7\xc8\x27\x38\xb9\x33\x47\xd9\x58\x05\x2b\xf1\xbd\xc0\x70\xf9\xb0\xd3\x70\xe5\x63\x9e\x49\x96\x46\x12\xfb\x89\x80\x63\x99\x65\xf2\xf1\xb2\xae\x8b\x80\xd3\x1b\xab\x6e\x1b\xb4\x2c\xd0\x29\xc7\x51\xbe\xf7\x74\xc9\x2b\x5e\x3b\xfa\x0d\x65\x16\xf2\xda\x69\xea\xed\x88\xe9\x65\x96\x6e\x0a\x79\x3d\x6a\xaa\x5a\xee\x8e\xf7\x50\xf8\x80\xf7\x54\xf0\xa4\xfe\xe1\xfb\xef\xbb\xbb\xc7\xd1\x62\x2a\xaa\xfa\x70\x13\x46\x70\xb4\x4e\xec\x03\xc2\x5a\x16\x28\x0f\x6b\x81\x84\xb0\x5d\x1b\xe0\x0b\x76\x66\xe7\xd3\x8e\x23\x2f\x58\xd9\x72\xe1\x18\x77\xcc\xaa\x38\xbf\x47\xc1\x66\x4f\xca\xc9\x52\x12\x6b\x9a\x1b\x4b\xe4\x17\xac\x2e\x56\x58\x1b\x06\x8c\xfa\xa1\xe4\x95\xcc\xf0\xc9\x07\x27\x4d\xf5\xb2\x84\xb9\x02\xf6\x13\x9f\x67\x53\x64\xbb\x0f\x99\x48\xcc\xf6\x99\x85\x11\x32\x78\x87\x90\x62\xdf\x72\xf7\x90\x26\x1c\xd1\xb9\xde\x7b\x5c\x44\xab\xae\x98\xf2\xcf\x6e\x70\xb2\xac\x4f\x7b\xdb\xbb\x0c\x61\xd6\xab\x4d\xf8\x11\xdb\x7b\x21\x01\x05\x4b\xfe\x40\xae\x26\x7a\xb5\xcd\xe4\x43\xef\x65\x90\x03\xec\x2a\xab\xde\xde\x83\x80\xb8\x5b\x3e\x82\xc1\x22\x7e\x0c\x33\xeb\xf1\x31\xfc\x30\xdf\x6b\xc1\xa2\xa9\x27\x79\x2d\x7b\x90\x40\x66\x68\x01\xde\xef\xbf\xf0\x6b\xcc\x5d\xc8\xeb\x17\x38\x28\x58\xb3\x68\xfb\xde\x2e\xc7\xb8\xd6\x9b\xbe\x3b\x5e\xf4\x9d\x34\xd5\x6b\x96\xbe\x73\xdc\x2d\x70\x26\x4b\xe8\x71\x58\x95\xa9\xe7\x59\xc1\xf1\x9b\xc3\x67\x1c\x44\xf8\x02\x07\x11\x3e\x5b\xb9\xc3\x17\x57\xee\xb0\xbf\x1c\xff\x2c\x0a\x27\x4d\x61\x31\x05\xd6\x0d\x25\x13\xcf\xa0\x06\xf5\x08\xba\xcc\x8d\x58\x89\x7f\xe5\xb9\x97\xee\xe8\xd5\x31\x22\x9a\xac\x33\x80\xfd\x7a\xed\x6e\x57\x7d\xe8\x83\xfe\x9a\xff\x84\x06\x64\x00\xd1\xd4\xeb\x05\xf8\x27\x43\x4a\xa9\x8c\x3e\x7d\x03\x8d\x68\x89\x01\x95\x04\x38\x89\x7b\xd5\x07\x34\x9d\x5f\xf8\x96\xa3\x92\xde\xab\x7b\x1c\x07\x30\x13\x29\x29\xc0\xa3\xb3\xc6\x22\x23\xa7\x8c\xc8\x1d\xc8\xc2\xaa\x9a\x3b\xb2\x7f\x6b\xd0\x74\xac\x41\x2a\x4c\x81\x68\xd0\x2d\x3c\xb9\x22\xaa\x5f\xb4\x2a\xe4\x79\xba\x5a\x55\x62\xad\x0d\x05\xff\xd8\xcb\x98\x8e\x4e\x5c\x9f\x89\xb3\x43\x1f\x9c\xc3\x36\x02\x39\xbb\x7d\xc9\x75\x68\xfb\xa2\xd7\x5d\x00\xf7\x13\x9e\xa7\x58\x9d\x38\x63\xc6\xc9\x19\x39\x00\x23\xb2\xfc\xbf\x7f\x25\x9d\xdc\xeb\xd3\x47\xb4\x9c\x28\xf9\x83\xca\x12\x65\x4e\x4b\xed\xbe\x35\x26\xdc\xd0\x1e\x42\x0e\x7e\xc2\x83\xdf\xaf\xbf\xc2\x28\x07\x95\xf6\x99\x38\x3f\xf9\x8f\x6f\xd5\x2f\xab\xd5\x8d\xfa\xe5\xbd\xfa\xb3\xfa\xfd\xab\x0e\x8a\xe0\xbd\x5f\x77\x24\x7a\xe8\xa5\xe8\x25\x47\xa1\xb0\xcc\x77\xbe\x41\xff\xc8\x2d\x68\x67\x72\xf8\x3f\x70\x02\xda\xfa\xf9\x3c\xf0\x20\xda\xfa\x2f\x82\xd6\x69\x37\xa3\x3c\xef\x22\x3d\xd6\x22\x23\xfd\x7c\x74\x66\x4c\x96\x8e\xfa\x24\x07\x63\xb4\xe6\x35\x1d\xd8\xd4\x56\xbd\x65\xb5\x25\xeb\x2d\x2f\x1f\x45\xc5\x69\x81\x03\xae\x52\x64\xd6\x9a\x65\x19\xb4\xb5\xd9\x6c\xb5\xe9\xe1\xd7\xdb\x80\x96\x2a\xe4\x90\x29\xe3\xb5\xf6\xb5\xfc\xd4\xf3\xa8\x64\xac\x13\xe3\x78\xcb\xaa\x45\x29\x0b\x2b\x8e\x8d\x75\x51\x1c\x53\x84\xd5\x18\xa3\xe4\x58\x31\x79\x1f\xf7\xd7\xff\x23\xb7\xa5\x7b\x5e\x59\xb9\x44\xcd\xf3\xf5\xba\xfd\x22\x68\x4f\xff\x74\x21\x2f\x76\xed\x02\x6d\xbc\x62\x52\x30\x06\xed\xd6\x49\x4b\xa6\x8f\x3c\x65\x9e\xf4\x6d\xc3\xbf\x8d\x6f\x4f\x07\xe7\x6f\x0e\x1c\xc9\xaa\x83\x9c\x8d\x26\xe1\xcf\x8f\xc3\x4b\x3c\x26\x3f\x32\xa6\xfe\x06\xbd\xe5\x9d\xbf\xfb\xa2\x4d\xa9\xf3\x67\x37\x74\xef\x25\xdf\x84\x83\xf3\xd5\x1f\xbf\x5d\xd7\x24\xdf\xdf\xd0\x84\x78\xe6\x6a\x7c\xb5\xba\x43\xef\xd0\x07\x0e\xa1\x6d\x75\x4a\xd1\xcf\x4f\x57\xab\x54\xc5\xab\x55\x6a\xbf\x42\x67\xcf\x87\x20\x7b\x60\x0f\x4e\xe3\x57\x37\xdc\xbb\x3d\xbd\x79\xf3\xfa\x16\x4a\x63\xe3\xa3\x1c\x4b\x6f\x62\xf6\xe6\xb7\xdb\x57\xf6\xc9\xb1\x5a\x31\xea\x66\xbc\xb3\x07\x07\x0a\xb4\x87\xd6\x96\xef\xbe\xfb\xee\x3b\xfc\x4a\xbc\x1e\x18\x54\xbe\xd3\x05\xc7\xe0\x9e\xe5\x78\x67\x64\x29\xce\x72\x53\x7c\xe4\x20\xad\x57\xb4\x5a\x69\x67\x8f\x95\xfa\xd6\x6e\x5d\x16\xea\x76\x95\x7c\xc3\x9f\x8a\x96\x36\xbd\x7b\x87\xa4\xed\xdd\xbb\x9b\x8d\xd8\xdd\xbe\xd2\x54\x50\xab\xf9\x52\x6d\xef\xd0\x7e\xfa\xd5\xad\x7d\xba\x5a\xad\xde\xa9\xb7\xf6\xab\xc1\x4a\xdf\x7e\x3a\x78\xbf\xba\x82\x97\x18\x77\x9c\x64\x89\x0e\xa9\x7f\x6a\xbd\xfb\x7f\x1e\x44\x8a\x27\xfb\xeb\xce\xa9\xf1\x97\x5b\xf4\x6a\x2a\x51\x71\xb2\x7a\x39\xe2\x5d\x17\x16\xf0\xd8\x0d\x73\xdd\x8b\xa9\x68\xa8\xa2\xb4\x5f\x8c\x65\x98\x55\xe8\xe7\xb4\xee\x3b\xdc\x97\x7d\x67\xa9\xa7\x27\xa8\xa5\xa5\xed\x3c\x57\xab\x77\x87\xc1\x0d\xbb\x35\x24\x39\xbf\x61\x67\x95\x51\x06\xd4\xdd\xd8\x3a\xa4\x7e\x75\x3a\x38\x7f\xaf\xce\xdf\xa3\xd5\xaa\x3d\x20\xaf\xa1\xab\x95\x6d\x0f\xc8\x16\x73\xb8\x5a\xbd\xf9\x69\xb5\x7a\x35\xd0\xba\x3c\x3a\xd3\x3a\x33\x7d\xb1\x96\x3f\xbd\x50\xcf\xcd\x9b\xff\xa2\x88\xa8\x5d\x6d\x47\xa0\x3f\xae\xf3\x79\x8d\x7f\x1a\xbc\x50\xe7\x33\xe0\x97\xaf\x19\x5d\x76\xc6\x87\xef\x6e\xce\xdf\x7f\xcd\xc5\xe6\x99\xd1\x40\x3d\xba\x09\x56\x4e\x46\x48\x54\xbe\x3e\x39\x52\xbc\x6d\x8d\x09\xbe\xdc\x7e\x5d\x93\x3e\x7b\xd8\xbd\xa8\xf5\x77\x7a\xf3\x86\x74\xfa\xbe\x7d\x4b\x06\x8d\xab\xd5\x9b\x6f\xdf\xc2\x3c\xee\xac\x7d\x29\xa4\x01\x6a\x44\xf4\x48\x71\xca\x93\x0c\xb6\x4e\xda\xa8\x48\xd3\xd0\x76\x23\x6c\xd4\x0b\x32\x91\xdf\xf3\x32\x36\xd9\xd6\x4e\x94\x3d\x30\x81\x1e\x7b\x62\x13\x10\x2b\xdb\xe3\xcd\x32\x4f\x78\x9b\x88\x65\x5a\x5a\x8f\xb0\x92\xc2\x0f\xe6\x58\x51\x18\xe3\xa5\x2c\xd3\xeb\x4b\x9a\x65\x7a\xc1\xd1\x51\xb0\xcc\x3a\xb5\x15\x69\x8a\x3e\x04\x8c\x29\x12\xbd\x2c\xc6\x2a\xdb\x48\x5c\xda\x4c\x8c\x84\x02\xbf\xf1\x52\xa2\xf1\xbe\x76\xed\x86\xab\x0d\x2d\x3c\xb0\x36\x32\x91\x99\xc0\x5b\x75\x29\x0a\xd2\xc4\x60\x19\xdb\xcb\xa6\x67\x98\x9f\x37\x8f\x56\x5e\x3d\x5a\x79\xce\x30\x32\xd0\xda\xca\xab\xdf\x2c\x56\x26\x85\xb5\x66\xe8\xce\x1b\xd8\x40\x91\x63\x60\x0d\x3c\xf2\xde\xe4\x16\x4b\xd3\x92\x74\x06\x74\xb0\x50\x2d\x3a\xd0\xab\x14\xab\x76\x56\x25\x52\x4e\x7a\x2b\xd6\x26\xb1\xd2\xbb\xcd\x51\xff\xd2\x87\xb1\x4e\xee\x8b\x56\x93\xcc\x1c\x91\xd2\x67\x61\xa4\x7a\xfc\x58\xe3\x2d\x81\x0c\x38\x01\x5c\x94\x7c\x2d\x9e\xac\x06\x4f\x1e\xd2\x18\x9e\xb4\x92\x24\xc1\x56\x27\x89\x95\xc8\x2c\x4d\x12\xeb\xe9\xdf\xff\x2d\xae\xea\x34\x61\x59\xa6\x73\x58\x4e\x59\x56\xee\x62\x56\x24\x95\x49\x1e\xa7\xe3\x87\x75\x91\x24\x56\x51\x3f\xc5\x29\xb9\x3d\x82\xe4\x3d\x2f\x73\x4e\x41\xda\xb2\x58\x26\x59\x7c\x27\x92\xc4\xda\x55\xc5\x5f\xff\xf2\x3d\xc6\x4e\x4c\x12\xab\x2a\x44\x19\x03\x12\x53\x4a\x3f\x02\xaf\xff\xb7\xbf\xc6\xd5\xbe\x7a\xd0\x8d\xf9\xb7\xbf\xc6\x8f\x22\xff\xb7\xbf\xea\x2c\x70\x5a\xba\x6d\xf0\x87\x41\x9a\xf8\x53\x8d\x23\x8d\x0a\x42\x79\xc9\x37\x56\x55\x72\x60\x8c\x9a\x1c\xd8\x00\x2b\x97\x9a\x99\xcb\x25\x0d\x42\x2e\x8d\x35\xea\xdd\xfe\x81\x65\x56\x4e\xe2\x4e\x96\xa2\xaa\x07\x24\x30\x8c\x27\x99\x7f\x6e\xf1\xe8\xd2\xf8\xa7\x60\xd9\x23\xdb\x1b\x07\x15\xb2\xa8\x29\xfc\x4f\x55\xc0\x7f\xc9\x7f\xc5\x77\xa5\xbf\x41\x35\xb9\x34\x41\xe1\xc8\x9f\x5d\xce\xee\x79\x6a\x21\x27\x83\x3a\x35\x19\x34\xcc\x64\x73\x99\x36\x70\x33\xca\x99\x64\x9e\xb1\xdf\xf6\xc8\x42\xc9\xa2\xd6\x6d\x82\x0f\xa8\xe2\xfa\x11\xfa\xb8\x62\x30\xf8\xbf\xf1\xd8\x20\x43\x0b\xd8\xd1\x29\x7c\x9b\x37\x06\x78\x55\x51\xd5\xa5\xcc\x37\x56\xf3\x58\xeb\xed\x14\x54\xc8\x53\x32\xc1\x93\x05\xfb\xb5\xd1\x36\xc7\x56\x95\xd5\x56\xb5\xa9\xad\x2a\xe3\x56\xb5\xe1\x16\xcc\xbd\x66\x53\x5b\x80\xb7\xcd\x86\x5b\x92\xff\x8a\x4a\x2c\x32\xab\x2d\xb9\xa9\x2d\x60\xc1\x24\xc0\xcb\xd4\x6a\x72\x69\x35\xfc\x57\xab\xc9\xb9\xf5\x64\xb1\xe4\xd7\x18\x95\xca\x12\x62\xd8\x70\x6a\x90\x3d\xb1\x36\xe3\xd4\x6e\x40\x33\xce\xf2\xa6\x30\x42\x2f\xc4\x9b\x14\x90\x3b\xa9\x5b\x8b\xc4\x9d\xcc\x65\x2d\x73\x91\x58\x39\x30\xc0\x14\xe6\x99\x65\xa2\xde\x5b\x25\xcf\x38\x30\xb4\x15\xff\x35\x4e\xaa\xda\xaa\x44\xbe\xc9\xb8\xfe\xf4\x06\x2a\x68\xa0\x86\x26\x47\x93\x46\x9e\x5a\x4f\xc9\x76\x83\x22\xc5\x35\xfc\x54\xcd\x9d\xb5\x86\x9f\x5d\x93\x59\x6b\xf8\x69\x52\xf1\x60\x55\xf0\xb3\x86\x9f\xa6\xe4\x3b\x40\xa9\x9d\xb5\xc6\xd4\x36\xb3\xb2\x6a\x5b\x5a\x0c\x7f\x88\x1b\x7f\x02\x8e\x3c\xd9\x15\xd6\x1a\x7e\x8a\xad\xa0\xd0\xb3\x24\x9f\xfd\x0d\x30\xb3\x82\x9f\x75\x41\x90\x75\x01\xb9\x46\xd4\x72\x5d\x58\x15\x5d\xd6\x45\x2d\x1b\x81\x97\x4a\x40\x27\xd4\xb2\xa8\x4b\xab\xa8\xcb\x1a\x85\x9e\x77\xa2\x4e\x80\xde\xb4\xa4\x05\x73\xda\x6c\xf6\x81\xc5\xac\xdc\xc0\xb0\x5a\x77\xa5\xd9\x8a\x88\xfc\x41\xde\x73\x4b\x4f\x83\x26\x2f\x39\x4b\xb6\x14\xe6\x29\x27\xb9\xe0\x5d\x69\x65\x0c\x29\x70\x81\x01\xf4\xab\x66\xc7\xad\x1d\x05\x61\xa2\x78\x64\x14\xe8\x0f\x37\xb7\x55\x2d\x4b\x6e\x6d\x78\xad\x35\x15\xa1\x75\x9c\x36\xeb\x46\x77\x51\xa0\x2c\xc9\xe4\xaa\x6d\xb3\x5e\x67\xfc\x81\xfc\x3b\x6d\x78\xad\xcf\xbd\xf5\x43\x24\x79\xa6\x47\x28\x4d\x58\x51\xee\x1e\xad\x64\x57\xe0\x28\xad\x49\x6f\xa8\xdc\x00\x66\xc3\xa4\x24\x27\x91\x27\x2f\x1a\x4b\x09\x34\x6e\xd4\xe1\x7c\xfe\x03\xf9\x9b\xbc\x17\xd0\xe7\x59\x18\x21\xe3\x1f\x04\x0d\x9f\x6e\x7e\x59\xad\x56\xab\xdb\x93\xef\x0e\xbc\x7f\x6b\xab\xab\x9e\x05\x86\x66\xff\xf4\xab\x50\xd9\xbf\x85\xfe\x09\xa1\xaf\xeb\x2e\x8c\xb7\x56\xd7\xd6\xcf\xff\x4b\x7b\xe7\xbf\xf4\x9e\xff\x86\xd2\xe6\x99\x23\xb7\xfc\x47\x7c\xfe\xad\x79\xea\x0d\x69\xde\x0f\xce\x6f\xde\xde\xb6\x61\x85\x0e\xe2\xb2\xf4\xcb\xec\xc1\x89\xfe\xae\xaf\xab\x37\x57\xd9\xd7\x23\x72\x77\xae\xa3\x56\x37\x75\x7e\xb2\x5a\xdd\xbe\x6b\x5d\xc6\xbc\x14\xe2\x1c\x9d\x74\x3f\x8b\x34\x60\x02\x07\x98\x4d\x54\xab\xda\xdf\x0b\x41\x30\x38\x5f\x4c\x54\x74\xe5\xc7\x8b\x89\x5a\x4c\xe2\xe1\x75\x1c\x5d\xf9\xca\xf5\x2e\xe2\xc8\x8f\x03\xc7\x55\x81\xe3\x42\xd2\xf5\x2e\x54\xf8\x31\x88\x7e\xec\xfc\x2d\xe9\xe7\x3f\x2f\x62\x2f\x08\xfc\x20\x3e\x1d\x9c\x7b\x9f\x17\x5e\x30\xf1\xe6\x23\x2f\x8c\xdd\x49\xe8\x0c\xa7\x9e\xab\x3a\x20\xdc\xd2\x82\xc3\x65\xb8\xf0\xe6\xae\xe7\xda\x6a\x32\xff\xe4\x4c\x27\xee\x61\x0d\x6a\xe1\x04\xce\xcc\x8b\xbc\x20\xb4\xd5\x07\xef\x3a\x9e\xfb\x51\x3c\xf6\x97\x73\x57\xcd\x9c\x68\x19\x4c\xa2\xeb\xd8\xfb\x3c\xf2\x3c\xd7\x73\xd5\xdc\x9f\x7b\x0a\x6e\x38\x1d\x9c\xd3\x3d\x0b\x2f\x98\x4d\xa2\xc8\x73\x4f\x07\xe7\xf1\xd4\x99\xbb\xf6\xc0\x56\x73\x3f\xee\xbd\xe0\xe3\xd2\x8f\x9c\xae\x8e\xc0\x8b\x82\xeb\x78\xb9\x70\x9d\xc8\x53\x61\xe4\x07\xce\x85\x87\xa5\x8b\x68\xe2\xcf\x11\xe2\x75\x5f\x15\x5d\x06\x7e\x14\x41\x6a\x39\xff\x30\xf7\xaf\xe6\xd4\x0b\xb6\xfa\x47\xe8\xcf\x63\x67\x01\xdf\xa6\xc2\xc8\x89\x96\x21\x34\x6a\x71\x79\x1d\x4e\x46\xa1\x0a\xfc\xc8\x89\xbc\xf8\xe6\xf3\xf5\xcf\xb7\x6a\x71\xe9\xcc\x23\x7f\xa6\x42\x67\xee\x0e\xfd\xcf\x6a\x38\xf5\x47\x1f\xe2\x8b\xc0\x19\x42\xa3\xfd\xe1\x3f\xbc\x51\x64\x0f\x14\xf6\x9a\xa7\x48\xe1\xd2\x8e\x9d\x28\xf6\xdc\x0b\x4f\x8d\x9c\x30\x8a\xc3\x4b\xc7\xf5\xaf\x42\xe5\x7f\x50\x33\x67\x3a\xf6\x83\x99\xe7\xc6\xd8\x73\xa1\x8a\xae\x17\x5e\x3c\x9b\x84\x33\x27\x1a\x5d\xaa\x21\x74\x4b\x48\xad\x3c\xec\xaa\x70\xb9\x58\xf8\x41\x44\x23\x11\x79\xc1\xdc\x99\xea\xdb\xae\x2e\x27\x91\x37\x9d\x84\x51\x3c\x76\x26\x53\xb8\xc1\xb9\xf0\xe6\x11\xb4\xee\x74\x70\x3e\xbc\x86\xdf\xa9\x77\xe1\x8c\xae\x63\xb5\x0c\xbd\xc0\x9e\x3b\x33\x4f\x8d\xa7\xd7\x93\xf9\x85\x72\xa2\xc8\x19\x5d\xce\xbc\x79\x14\xaa\x70\x14\x4c\x16\x91\xe7\xaa\x99\xbf\x0c\xbd\xa9\xef\x7f\x50\xe1\x24\x8a\xe0\x36\x7f\xae\xbf\x54\x39\x57\xce\xb5\xba\x72\xa6\x1f\x00\x3c\x99\xc7\xce\x24\x80\x6f\x80\xdc\x28\xf0\x97\xa3\x4b\x48\x0d\x97\xe1\xb5\x72\xa6\x57\xce\x75\x18\x07\xcb\xb9\x72\x96\x91\xbf\x98\x4c\xfd\x48\x61\x3b\xa1\xab\x9d\x60\xe4\x4d\xb1\x07\xaf\xe6\x5e\x60\x0f\x54\xe0\x5d\x4c\xfc\xb9\x6d\xdb\x03\x35\x72\x66\x5e\xe0\xe0\x6d\x13\xe8\x15\x77\x12\x46\x0e\x20\xc3\xd0\xbb\x9c\xcc\xdd\xb9\x17\xe2\x70\x39\xf3\x8b\xa9\xa7\xa6\xce\x85\xad\xb0\x9b\x46\xcb\x50\x2d\xfc\x70\x02\x98\x60\xeb\xcf\x8f\x2e\x03\x2f\xbc\xf4\xa7\xae\x82\x61\xf3\x5c\xbc\xdd\x1e\x28\xbc\x3b\xf6\xc7\xe3\xd0\x8b\x94\x33\x8a\x26\x9f\x3c\x5b\x39\xf3\xc9\x2c\xf6\xe7\x6a\xea\xfb\x0b\x15\x78\x9f\xbc\x20\xf4\x14\x7c\x5a\xbc\xf0\xe7\x17\x2a\x9c\xf9\x7e\x74\xa9\xb1\x43\x85\x23\x67\xea\x29\x67\x3a\x8d\xc3\x89\xeb\x85\x6a\x3a\x99\x7f\x80\x37\x06\xbe\x1f\x29\xac\x75\x3a\x85\xbc\x1f\x5d\x7a\x41\xa8\x46\x97\x93\xa9\x1b\x78\x73\x5b\x45\x97\x93\xd0\xd6\xef\x54\x0b\x27\x0c\x4f\x07\xe7\x90\xc4\x4f\xc2\x3e\x53\x93\x31\x4e\xa4\x4b\x67\xee\x02\xf2\xce\xa1\x29\xb6\xdd\x0d\xd0\xc8\x9f\x47\x81\x8f\xd5\x8f\xaf\x5c\x35\x74\x46\x1f\x14\xbe\x3a\x8a\x91\xe6\x4d\xbd\x71\xa4\x50\xd1\xd7\x56\xcb\x85\x72\xfd\xab\x39\x94\xcf\xa6\xb1\x3d\x98\x0e\x97\x51\xe4\xcf\x6d\x9a\x7c\x68\x45\x80\xcd\x46\xc4\xd5\xa3\x1c\x2a\xd7\x1b\x4e\x22\xe5\x7f\xf2\x82\x60\xe2\x7a\x31\xf4\x8c\x03\xfd\x1a\xaa\x70\x32\xf5\xe6\x51\xec\xa1\x8e\x6d\x3c\x73\xe6\xce\x85\x07\xf8\xa3\x22\xe7\x83\x17\xeb\x96\x85\x2a\x0a\x26\x17\x17\x5e\xd0\x3d\xa9\x51\x4d\x8d\x2e\x9d\xf9\x85\x17\x43\x77\x85\xd0\x28\xfd\x84\x8a\x02\x67\xf4\xc1\x8e\x69\xec\x55\xe4\x4d\x3d\xc0\x77\xa4\x3b\xde\x3c\xf2\x03\xc4\xe3\xc8\xfb\x1c\x2d\x03\x4f\x85\x38\x27\x34\x4a\x52\xb7\x28\xa0\x1f\x33\x27\xf8\xa0\x46\x53\x3f\x42\x34\x9c\xfb\x91\x37\x72\x02\x57\x0d\x7d\xf7\x7a\xe1\x04\x91\xea\x5a\x73\xe1\x85\x58\x93\x33\x9d\x22\x61\xb2\x75\xc3\x90\xc0\xb5\xef\x54\x23\x7f\xea\x07\x2a\xbc\x74\x16\x66\xc4\x4d\x13\xe0\x03\xe0\x69\xff\xca\x73\x63\x37\xf0\x17\x0a\x31\x59\xe3\x31\xa0\x5f\x18\x39\x01\x90\x06\xf3\x2d\x6a\xe6\xb9\x13\xc7\xd6\xcd\x86\xf7\x8c\xa6\x93\xd1\x87\x18\x50\xc1\x9f\xab\x4b\xe8\xed\xf8\x12\x55\xba\xd5\x14\xe8\x06\x56\x18\x4f\x5c\xd5\xa3\x4d\x64\xee\xa1\xc2\x28\xf0\x9c\xd9\x64\x7e\x61\xc7\x23\x3f\x8c\x5a\xfa\xe6\x7a\x91\x33\x99\xc2\xb7\x04\xce\x28\xf2\x82\x38\x9a\xcc\xbc\x96\x86\x99\x4a\x22\x6f\xb6\x88\xfd\x79\x1c\x78\x3f\x2b\xa4\x06\xae\x17\x8e\x60\xee\xa8\x45\x30\x99\x61\xcb\xfc\xe5\x3c\x52\xde\xc7\xe5\xe4\x93\x33\x05\x42\x6c\x6b\xd2\xf6\x87\x93\x57\x05\xde\xcf\x3f\x7b\x41\xfc\xc1\xbb\x56\x81\xef\x0f\x22\xf5\xc9\x9b\xfa\xa3\x49\x74\xad\xfc\x99\x77\xe1\xe8\x2e\xba\x08\xfc\xe5\x42\x8d\x02\xcf\x89\xfc\x40\xe3\x05\x50\x44\x7f\x32\x8f\x54\x00\xeb\x4d\x10\x5f\x51\x3f\x00\x05\xf3\xdd\xeb\x98\x46\x60\xe1\x44\x97\xe3\xc9\xdc\xc5\xef\x06\xca\x89\x58\xbf\x9c\xcf\x61\xb4\x23\x3f\x72\xa6\x76\x4c\xd8\x10\xd3\x07\x20\x2c\xee\x50\x88\xa0\xfa\x16\x98\x11\xde\x0c\x46\x19\x3a\xc9\xb6\x89\x16\xe3\xf0\x47\xde\x85\x17\xa8\xf1\xd4\x77\x22\xe8\x6a\xa8\x1e\xbe\xe9\x93\x37\x82\x16\x23\x05\x80\x21\xd3\x6b\x22\xd2\x1f\xd7\x1b\x2e\x2f\xd4\x62\x39\x9c\x4e\x46\x76\x0c\xc8\x34\xf7\xa6\xfa\xe3\x70\x6a\x7f\x72\x22\x27\x88\x47\x1e\x50\x6f\x35\xba\xf4\xc2\x48\x5d\x7a\x8e\x9e\xbd\x0b\x6f\xfa\x69\x12\x02\xe5\x8d\x60\x8a\x4c\xe6\x6a\xee\x8d\x3e\xa8\xb9\x1f\x02\xd5\x9b\x4e\xaf\xd5\xcd\x34\xb8\x3d\x1d\x9c\x87\x97\xfe\x72\xea\x7a\x81\x02\xe2\xa0\xc6\x40\x6c\x3c\x27\x50\xde\xb5\xa7\x6e\x96\x53\xb8\xc3\x09\x66\x6a\xea\x5d\xd8\xea\x72\xb2\xc0\x96\xf5\xa8\x41\xbc\xf0\x46\xea\x72\x89\x48\x4e\x2d\x89\x6f\x7e\xf8\xf1\x56\x45\xfe\x02\x89\x00\xf6\xb9\x6e\x22\x3c\x66\xab\xa1\x1f\x45\xfe\x4c\x53\x53\x2a\xc7\x02\x58\xa9\xa1\x49\xd0\x8c\x18\x3a\xe8\x07\x05\x98\x87\x4b\x8e\x13\x7a\x2a\x82\x97\xc3\x0d\x57\xd0\x79\x63\x87\x18\x8b\x7f\x38\x57\x08\x84\x26\xe3\xf5\xda\x53\x91\xbf\x9c\x5f\x78\x36\x60\xc5\x64\xae\x80\xc0\xc7\x50\x04\x9f\x66\xe3\xa4\xa6\x05\xec\x93\x37\x55\x81\x33\x09\x3d\x05\x53\x2e\x30\xd4\x78\xee\x03\x08\x89\x75\x64\x2b\xd7\x89\x70\xdd\xf0\xe7\xd3\x09\x30\x1b\x80\xdb\x43\x3f\x98\xab\x90\xd0\x1a\x50\x9c\xd6\x7b\x15\x38\xb0\xb2\xd9\x6a\xe1\x5c\x4f\xe6\x63\x1f\x13\x40\xc7\x62\xc8\x51\x1d\x68\xaa\x05\x6b\xa6\x0b\x98\x3d\xf3\x23\x2f\x36\x2f\x30\xe3\x1b\x78\x1f\x97\x30\x92\x81\xb7\x98\x5e\xdb\x6a\x11\x5e\x87\x7a\x86\x20\x7e\x0d\xc7\xf0\xfb\xb3\x17\xf8\xca\x9f\x7b\xd0\x8b\xb3\xc9\x9c\x98\x0d\xd7\x0b\x01\x4b\x91\xbc\xf8\xcb\x00\xfa\xc7\x99\x2e\x2e\x1d\x22\x39\xb8\x0e\xfe\x77\xb7\xa8\xe1\xd4\x9b\xbb\xf1\x78\x39\x1f\xc5\xa7\x70\xb3\xbe\xcd\x56\xe3\xa9\x73\x81\xd4\x15\x69\x90\xf2\xe6\xae\x4d\xb3\x1a\xea\xa2\x3a\x88\x9a\x5d\x4c\xfd\x2b\x5b\xcd\x9c\xcf\xb1\x73\x41\xb3\x69\x32\x1c\xfa\x73\x75\x35\x99\xbb\xc4\x68\x2c\xba\x07\xf1\x11\x1b\x99\x94\x91\xa7\xc6\x3e\x10\x3f\x28\x0d\x83\x51\x3b\xd1\x6d\x15\x39\xc1\x85\x17\x99\xde\x86\x71\x70\x02\x5b\x79\xb8\xd6\x7c\xf2\xec\x78\xe6\x84\x1f\x6c\x15\x06\x23\x9c\x80\xfa\xcd\x0b\x27\x02\xa6\x46\xe1\x9a\x8e\x3d\xe7\x5d\x4c\xe6\xd8\x70\x35\x5c\x06\xc4\x34\x04\xb0\xee\x62\xd7\xd2\x3c\x0e\x1c\x77\xb2\x0c\x55\xb8\xf0\x88\x6c\xcf\x26\x73\xf8\x14\x1b\x56\xd7\x91\x37\x6d\x49\xb5\x6e\x11\xcc\x60\x22\x43\xfa\x6d\x38\x0c\x40\xb9\xbd\xcf\x8b\xa9\xef\x7a\xf4\x76\x18\xa5\x91\x1e\x2d\x34\xa7\x81\xad\x82\x6d\xdb\xea\x93\x77\x39\x19\x51\xeb\x02\x6f\xec\x05\xc8\x2d\x8f\x81\xff\x6d\x29\x07\xb2\xb8\x21\xac\xd9\x23\x27\x50\x43\x20\x1f\xce\x24\x58\x4c\x9d\xb9\xa7\x86\xb0\x58\x00\x91\x04\x74\xc6\x5e\x81\xf7\x2d\xa7\x4e\x80\x63\x33\x0e\x26\xb8\x0e\x20\xc5\xa6\xc1\x99\xf9\x91\x1f\xc4\xee\x24\xf0\xb0\xc4\xd6\x9d\x19\x13\x5c\x33\x2f\xb4\x6c\x9c\x0e\xce\xf5\xca\xe1\x8d\xc7\x93\x11\xf0\xce\x44\xd6\xcc\x98\x2d\xfd\x6b\x07\x60\x2f\xbf\xdc\xf5\xc6\x53\x7a\x09\xf2\xf7\x2f\x56\x41\x6f\xc5\x9b\x47\xce\x75\x6c\x0f\x7a\x65\x30\xfb\x26\x23\x67\x1a\x3b\x11\xac\xe3\x7f\x5c\xd1\xd0\x99\x03\xf7\x78\x74\xc3\x6c\xf2\xb9\x7f\x13\x60\x30\xf5\x68\xdc\x6b\xdc\x72\xa1\xa6\x93\xd9\x84\xb0\xc1\x9f\x4e\x63\x7f\x3e\xbd\xd6\x2d\x5b\x2e\xec\xae\x33\x4e\x07\xe7\x57\x0e\x50\xb1\xc8\x0b\x02\x67\x32\x57\xcb\x85\x4d\x37\x5f\x4c\xfd\xa1\x33\xd5\xeb\xac\xdd\xf1\xba\x88\xc8\x91\xe7\x05\xd8\x3e\xdb\x30\xa1\xae\x37\xf2\x97\x0b\x60\xab\xed\x76\x71\xc4\x09\x84\x56\x42\x86\xc9\x86\x79\xd8\xa2\xb8\x02\x0c\x27\xa6\xc2\x1e\xa8\xb9\x1f\xcc\x9c\x29\x20\xe9\x08\xba\x1b\x51\xc5\x3c\xe6\x7f\x56\xa3\xeb\xe9\x04\x16\x3c\xa8\x3c\x9c\xa9\x70\x71\xe9\x01\xc6\xfa\xc1\x32\x54\xd1\x72\xe8\x29\x5c\x7c\xc2\xd1\x72\xba\x00\xa2\xab\x2e\x7d\xc2\x40\xd7\x1b\x3b\xcb\x69\xa4\x46\x93\x60\x34\xf5\x54\xf8\x71\xe9\xc0\x73\xc1\x04\x71\x18\xa6\x74\xe4\x05\x13\x67\xaa\x5f\x15\x46\x80\x99\x33\x2f\x72\xa6\xea\x62\xea\x84\xa1\xba\xf2\x7d\x57\x8d\xa7\x5e\x78\xa9\x16\xc0\x6d\x4c\x46\x2a\x58\x0e\x87\xc0\x68\x0e\x54\x78\x39\x99\x5f\xb7\x08\x3d\xf5\xaf\x90\x77\x59\xce\xd4\xe5\xe4\xe2\x12\xb0\x69\xb6\x68\x4b\x87\xb4\x2a\xb8\xc0\x7a\x61\xa5\x43\x48\x0d\x83\xc9\xe8\x03\xb0\xbd\xde\xe8\x03\xac\x77\xfe\x7c\x14\x78\x11\xac\x0b\x53\xdc\xb8\xcd\x3d\x60\xeb\x3f\x84\xea\x22\x70\x80\xb4\x0f\xa7\xfe\x10\x98\x4b\x58\xdb\xd5\x14\x27\x2c\xdd\xb9\x1c\x8d\x7c\x15\x2e\x89\x47\xba\xf2\x1c\x60\xd4\x23\xef\xf3\x85\x37\xef\x77\x03\x4c\x31\x20\x33\xd4\x51\xc8\x2a\xea\x29\x79\xd0\xa3\x34\x13\xdb\x3e\x27\x52\x64\x50\x6d\x36\xc1\xfd\x14\x70\x0b\x41\x04\xc3\x4d\xdc\x92\xe9\x41\xe0\x40\x88\x16\xce\x3f\x79\x9f\x69\x37\x0b\x18\x81\x23\x8a\xf4\x5a\x93\xbc\xc0\x8f\xec\x78\xea\x8f\x60\xd4\xa7\x93\x11\xb1\x8c\xd0\xd5\x9f\x27\xc3\xa9\xa7\x90\xcd\x89\xa7\xd8\x69\x7d\x46\xac\x65\xd2\xf4\x16\x46\x85\x93\x9f\xbd\x8e\xd7\x30\xc4\x0c\xd7\x34\x22\x63\xc8\xb5\xe1\xbe\x83\x28\x9c\xe6\x56\x71\x74\x70\x04\xd5\x78\x39\x9d\xea\xf1\xa1\x3e\x43\x82\x4f\x8c\x28\x61\x72\x14\x4f\x66\xb8\x87\x9e\xc3\x96\x59\xb5\xfc\x3b\x7c\xe8\x32\x08\x80\xb1\xbf\xf4\x67\x9e\x1d\x2f\x83\x29\xee\xe3\x70\x35\x86\x0d\xd2\x62\xea\x5c\xeb\x55\xe4\x67\xdf\x9f\xd9\x6a\x3c\x01\x3a\x4d\x3c\x2d\x2e\x1d\xce\x08\x19\xb8\xab\x89\x1b\x5d\x2a\x3d\xe1\xe2\xc5\xe4\xb3\x37\x0d\xbb\x5d\x2b\x92\x59\x7c\xb9\x3d\xc0\x3d\x49\x68\x58\x30\x7c\x5e\x37\xc8\x26\x49\x43\x3c\x73\x3e\xb7\x6d\x24\xbc\x76\xe6\x2e\xb0\xf8\xb3\xc9\x7c\x42\x7b\x9a\x16\x31\xfb\xcc\xa6\x33\xbf\x46\x26\x1f\xd6\x9b\xd3\xc1\xf9\x32\x98\xc6\x53\x6f\x7e\x11\x5d\xf6\xb6\xcf\x50\x1d\x74\x39\x2e\x2e\xf6\xd7\x5a\x0e\x2b\x01\xe0\x4d\xcb\xe9\xf4\x5f\x03\x6c\xc8\x35\x8a\x39\xbc\xcf\x51\xd7\x9c\x28\x70\xe6\xe1\x18\x10\xce\x77\x27\x63\xd8\x55\x2c\x80\x6a\x7d\xc2\xed\x07\x7e\xda\xc8\x9b\x12\x3f\x0a\x03\x83\x96\x9e\xc4\xf7\x84\x11\xf4\xb4\xb3\x84\x8d\x28\xf4\x37\xf6\xbc\x41\x05\x18\x12\xe4\xef\x71\xc3\xaf\x96\xf3\xa9\xef\xb8\x34\x48\x18\x7c\x1c\x31\x93\xd0\x88\x50\xc5\xf7\x17\x71\xe8\x45\x1d\xca\x3b\x66\x09\x1f\x4f\x71\x69\xc0\xaf\x88\xed\x01\x71\xc8\x61\xb7\xa7\x42\x32\x3a\xf6\x83\x99\x72\x1d\xc0\x17\xe2\xde\x71\x3e\x98\x67\xf4\xd6\xd1\x06\x86\x09\xeb\x1e\x8d\xf4\x46\x9d\xfa\x06\xfa\x18\x89\x7f\x0c\x29\xe2\xec\x60\xfb\x1b\x53\x01\x4e\x98\x18\x37\x76\x44\xa8\x03\x0f\x38\xef\x51\x14\x2f\x96\xe1\xa5\x91\x3e\x98\xf6\xea\x51\x9d\x4e\x6d\xbd\x65\x8d\xbd\x79\x14\x5c\xdb\x34\x78\xd8\xe7\xc0\xfa\x1f\x0c\x0e\xee\xc4\x55\xe8\xc1\x62\xe2\xb9\x38\xf7\x6c\x45\x1b\x26\x6c\x66\xb7\x13\x3a\xc0\x9c\xc0\x73\xd4\xc4\x55\xa1\xe7\xc5\xc4\xc3\x87\xb6\x4d\x72\x0c\xe0\x24\x35\x13\xa3\x90\xf7\xf0\x9c\x39\xcd\xad\xb9\x0a\x23\x37\x76\xbd\x4f\x2a\x5c\x22\xf3\x4c\xf4\x39\x84\xf5\x60\x39\xd3\xec\xcb\x85\xe7\xcf\x3c\xf8\xc4\x18\x1f\x0c\x1c\x64\x83\x17\x0e\x12\xde\xcb\x89\x0b\x8d\x41\xea\x66\xeb\xfd\x55\x7c\x38\x6c\x7a\x24\xc6\x93\xcf\x9e\x1b\x87\xcb\xb9\x16\x4d\x75\x43\x65\x44\x56\x5a\x1e\xa6\x19\xb9\xe9\x24\xc4\x8d\xbc\x26\x6f\xb6\x7e\x0c\x30\x40\xd7\x8b\x0c\x47\xdc\x6e\x5b\x5f\x18\x08\x5b\x5d\x46\xd1\x82\x30\x36\xba\xf4\x61\xfa\xcd\x3c\xc4\x36\xdc\xad\x51\x9f\xe8\x09\x16\x05\xcb\xf9\xc8\x41\xc9\xd5\x68\x19\x46\xfe\x2c\x86\x6d\x0f\xae\x78\xce\xc5\xcc\x89\xe7\x7e\x3c\x82\x0d\x20\x30\x11\x43\x3f\xf4\x62\x23\xb5\x03\xc0\x64\x7c\x1d\x8f\x90\xb5\xa7\x6d\x18\xcd\xa6\x89\xd9\x90\xe0\x06\x0a\x06\x10\xf7\x23\xfd\xed\x74\x4b\x05\x22\x7f\x39\xba\x54\xe1\x24\x52\xc3\xe5\x35\xf4\xae\xf2\x17\x1e\xee\xcf\x69\x3b\x3e\xa0\x89\x45\x24\x0c\x6f\x8e\x8d\xa8\x13\x76\x2d\x6a\x11\xf8\x68\xfa\xdb\x72\x77\xb4\x79\xa4\x9d\xa3\xad\x88\x8d\xc5\x6d\x01\x31\xdd\xae\x17\x41\xef\x19\x6a\xae\x59\x61\xa4\xd2\xf3\xe5\xcc\xb0\x03\x81\xef\x23\x43\x4a\x14\xe9\x72\x12\x85\x2a\xf0\x8c\x58\x00\x3a\x32\xa4\x49\x8d\x84\x78\xee\xcf\xed\x01\x0d\x97\x33\xc5\x89\x63\xc3\x8b\xbd\x00\x59\x1d\x14\x31\x02\x19\xe8\xa4\xa4\xb0\xdb\x59\x78\xc1\x38\x06\x62\xaf\x45\x03\xb6\xd2\x22\x9b\x6e\x5e\x1a\xe9\x05\x24\x91\x82\x20\xc6\x23\x43\xe8\xb8\x2e\x6e\x79\x3e\x11\xbf\x36\xf7\xdc\x18\x6f\x39\x2a\x83\xf9\x33\x0f\x61\x7f\x6f\xd8\x57\xc4\x96\x49\xb4\x24\x21\x4b\xe0\x7c\x9a\x44\xd7\xf1\x6c\x39\x8d\x26\x8b\xe9\xc4\x0b\xd4\x87\xb1\x96\x2f\x20\x89\x53\xa3\x19\xbe\x9e\xd6\x92\x96\xd6\x01\x5b\xe6\xc2\x0e\x23\xb8\x02\xda\x4e\xcb\x4d\x2b\x87\x33\x92\x39\xe8\xf4\xde\xfa\x08\x34\x76\xea\x10\xa3\xac\xbb\xe6\xc2\x9b\x7b\xc1\x64\x64\x08\x6c\x27\xf1\x0a\x9f\x0b\x62\x83\xe5\x1c\x3b\xb1\x7f\x53\x2b\x3f\xb6\x7b\x12\x15\x68\x3d\xb5\x1a\xe8\x33\xee\x42\x81\x62\x42\xe3\xff\xb1\x24\x92\x12\x4e\x02\xea\x56\x94\x98\xd8\x03\xdc\xa1\xb4\xdb\x95\xc8\xb9\x8e\xaf\x26\xd1\xe5\x64\x1e\x53\xc3\x94\x9e\x29\x7e\x30\xf1\xe6\xfa\x73\x9c\x11\x51\xb2\xb1\x1f\xc4\xe1\x87\xc9\x62\xe1\xb9\xb4\xd7\x08\x95\xf3\xc9\x9f\xb8\x0e\x6c\x3e\xb0\x97\x7a\x0c\xe5\x8d\x33\x1c\xb9\xb7\x2d\xeb\xa9\x17\x3b\xd7\xc3\xcd\x10\x34\x45\x37\x41\x53\xe7\x29\x6d\x9d\x60\x45\x5b\x2c\x83\x70\x49\x7c\xb5\xde\x57\x8c\x97\x3f\xff\x0c\x33\x20\xf2\x03\x75\xe1\x3b\xd3\x38\xf2\xa7\x5e\x80\x62\x58\x5c\x9c\x47\xde\x02\x09\xd3\xc7\xe5\x24\x40\x71\x9e\x17\xfb\xe3\x38\x44\x5e\x63\xec\xc3\x66\x55\xd3\x91\x85\x13\x5d\xb6\x1b\x04\x75\xe9\x07\x93\x9f\xfd\x39\xd0\x66\xfc\x0e\xbd\x99\xa6\xbe\x0d\x95\x7b\x3d\x77\x66\x93\x51\xec\x0f\xc3\xc8\x19\x4d\xbd\x50\x0b\xe7\x16\x4b\xdc\x2e\x7c\x72\x5c\x4f\x53\x47\xd7\x9b\xab\x70\xe1\x47\x48\x58\xc6\xce\x64\xba\x0c\x88\x31\xd6\x35\xf4\x64\x45\xdd\x81\x40\xef\x10\x03\xbe\x49\x91\x88\x4e\xcd\x7d\xa2\xff\x9f\x66\xc0\x0e\xd3\x2d\xb0\x9f\x9e\xcc\x35\x3e\xd1\xd2\xa1\xf7\x91\x17\x40\x06\x5a\x31\x58\x6c\x0f\x96\xf3\xc0\x73\x46\x97\xc8\xc3\xa8\xb6\xea\xa9\x7f\xe5\xa2\x48\x4e\x4b\xaf\xed\x18\xef\x82\xe6\x46\xc0\xf3\x06\x21\x74\x6a\x37\x78\x63\x27\x8c\x94\xde\x40\xfa\x57\x28\x07\xf7\xe7\x11\xcc\x39\xc3\xca\x3a\x40\x50\x90\xb2\x5f\x46\xb3\x29\x1e\x62\xa8\xe9\x34\x74\x55\x10\x86\xc4\x64\x7e\xc6\x82\xcf\xb3\xa9\xad\x2e\x46\x73\x92\xe9\xb4\x58\x17\x4d\x46\x36\xb5\x1b\x05\xae\x57\x0e\xf0\xc0\x76\x8c\x33\x2e\x76\xa2\xf8\xca\xb9\x46\xde\x34\x54\x3e\xb1\xd0\xb4\xdc\x75\xa8\xaf\xbc\xcf\xa3\xe9\x12\x25\xc6\x9f\xfc\xe9\x72\xe6\x29\x7d\xae\x80\xc2\x5c\x2f\x6a\x37\x1d\xa6\x58\xaf\xb8\xf8\xea\x76\x48\xf1\xf4\x80\xfa\x0a\xe8\x14\x2e\xa2\x8b\x51\x14\xc3\x6b\xc2\x38\x8c\x3c\xc0\xf5\x67\x27\x58\xe8\x11\x03\xd6\x12\xef\x59\xd1\xcf\x5e\xe0\xc7\x86\x0e\x3c\x2b\xf5\xfc\x31\x1d\xf7\xa0\x68\x2c\x70\xae\x95\xeb\x4d\x61\x27\x42\x35\x6a\x8c\x50\xf3\xe5\x74\x0a\x6b\xf3\xd0\x0b\x5a\xb9\x32\x09\xfe\xf0\x9d\x58\x8e\x12\x04\xcd\x7c\xd1\xb6\xcf\x99\x7f\x30\x8b\x34\x2d\xfc\x6a\x31\xbd\xc6\x4d\x10\x92\xa3\x85\x03\x0c\xb4\x0d\x8c\x5a\xac\x25\x45\xc4\xbc\x38\x73\xe0\x40\x5c\x6f\x3e\x81\xe9\xf7\xe2\x07\x69\x59\xe3\xe1\x8a\x44\x22\xf0\x91\xef\x07\xae\x16\x46\xea\xa7\xbb\x48\x10\x9d\xa3\xc3\xb6\xbe\x2c\x83\x6f\xdf\xf0\xbc\x9e\xe4\xde\x53\xc1\x4b\xc1\xf3\x84\x23\x8b\x8f\x76\x3c\xca\x65\x35\x0b\xc5\x6f\x5c\x91\x46\xba\xfa\xc0\xf7\x68\x6d\x07\x89\x4a\x05\x9c\xa5\x6a\x59\xa4\xac\xe6\xf6\x07\xbe\x47\xe3\x4a\x75\x41\x26\x2d\x54\x15\x4c\x3b\xf4\x3b\x53\x29\xb4\xfe\xd2\xc6\xe4\xb6\x0a\x50\xa7\x82\xa2\x95\x55\xc3\x3d\x30\xf6\xae\xf2\x1f\x73\x5e\xda\xea\x1f\x95\xcc\x4f\x07\xe7\x3f\x4e\x45\x55\xab\x9b\x8b\xf0\x96\xd7\x54\x35\xfe\x46\xfb\x82\xdb\x2a\x14\xb9\x1a\xc9\x4a\x45\x2c\x57\x4e\xcd\xf2\x1f\x55\xf8\x6b\x59\xab\x85\x7c\x54\xce\x5d\xa5\xc6\x0c\x7e\x4a\x96\xa7\x6a\x9c\x49\x59\xaa\x11\x17\x99\x42\xa3\x34\xf5\x89\x27\xc0\x86\xb0\x8d\x9a\xcb\x72\xa7\x5c\x51\xd5\xb6\x0a\x64\x0d\xe3\xc6\xeb\x47\xce\x73\xf5\x23\xa0\x47\x93\xf1\x52\x8d\x1f\x53\x35\xe5\xeb\x5a\x2d\x0b\x1b\xe7\x08\x81\x9d\x27\x5e\xd9\x3f\x06\xb2\x56\x57\x5b\x51\x15\xbc\x44\x09\x19\xdf\x08\x99\xeb\xaf\x18\x84\x6c\xaf\xc2\xad\x6c\x6a\x05\xdf\xc1\xe1\x8b\x46\x32\xaf\x4b\x99\xa9\x80\xef\xe4\x03\x87\xdd\x34\xcf\x2b\x59\xe2\xa3\x05\x67\x75\x57\xe0\x72\xd2\xdb\x02\xe2\xc3\x76\xd8\xef\x54\xaf\x82\xcf\x57\x0b\x59\xa9\x4f\x3c\x53\x17\x25\xbb\x83\xa6\xab\x8b\x52\x36\x85\x9a\x8a\xfc\x9e\x6c\x3e\x6d\xe5\x0a\x8e\xd0\x3c\x55\x57\x22\x4f\xa1\x7d\xd4\x95\x36\x0c\x79\x2e\x76\x68\xab\xe7\xeb\xc0\x2e\x8a\x5c\x52\x4c\xc5\x4e\xd4\x6a\x51\x8a\xdd\x8c\xa7\x82\xa1\x11\x46\xa5\x16\xac\x4c\x78\x36\x6b\x2a\x91\xc0\xc6\x82\x06\x0d\x47\xb6\x4a\x14\x34\xcf\x56\x8b\xed\xbe\x12\x49\x35\x63\x35\xc6\x44\x53\x64\x83\xa4\xc2\x84\x65\x5c\x8d\x64\x26\x4b\xe5\x64\xc5\x96\xa9\x88\x3f\xd5\x4d\x49\x5f\x00\x0d\x1f\xcb\x32\xe1\x2a\x92\xe5\xaf\x0d\x62\x45\xc5\xeb\xe7\x8d\x83\xe5\x14\x6b\x22\xfb\x39\x78\x10\x50\xce\xd4\x05\xfd\x27\x6b\x7b\x10\xa1\x7a\x1a\xf6\xa6\xee\x46\x78\xb0\x96\x85\x3d\x20\x1b\x1a\xba\x41\xa1\x99\x1d\x3d\xa4\xc3\xcc\xd9\x83\xc9\xae\x68\xb2\x8a\xab\x10\x2b\xf8\xa0\xbd\x56\xa6\x33\x09\x37\x28\x6d\x57\x8b\x7d\x4f\xa3\x0c\xed\xc7\x8e\xc4\xa8\x5d\xf6\xe0\x13\xcf\x64\x22\xea\xbd\x1a\x36\x72\xcf\xf2\x64\xaf\x2e\xe5\x03\x2f\x2f\xd1\xb1\x0d\x7d\xa5\x93\xa7\xf4\x9d\x0a\x63\x9b\xa1\x23\x11\x15\xa2\xc6\x2b\x06\x8f\x53\x2e\xdb\xb1\x0d\x37\x5d\x04\xdd\xa0\x42\x18\xc0\xd3\xc1\xf9\xc7\x86\x37\x5c\xe4\x1b\x15\xb0\x54\x34\x95\xad\x3e\xf1\xad\x48\x32\x98\x5f\xd8\x28\xa0\x83\x99\x64\xb5\xa2\xf8\x60\xca\x7c\x9a\x8d\x43\x88\x88\x1b\xc9\x26\xd9\xaa\x50\x40\x47\xf1\xa7\x5a\x8d\xd8\x8e\x97\x0c\x30\x7a\xcf\x95\x53\xdb\xba\x6f\x17\x26\x72\x93\x1e\xfc\x51\x26\x92\x7b\x07\xf5\x1c\x10\xbf\xe0\xa3\x71\x24\x69\x54\x8f\x6e\x87\x76\xb0\xaa\xb6\x07\xe6\x2b\x34\xb2\xc1\x9a\x85\xef\x53\x88\x58\xb6\xa2\x38\x7c\xf4\xf5\x4e\x92\xf0\xaa\x5a\x88\x5c\x2d\xd8\x7e\x51\x8a\x84\xab\xa9\x4c\x58\x06\x83\x4a\x28\x34\xdc\x53\x10\x4b\x20\x2e\xc4\x5e\xcd\xd8\x93\x9a\x89\xdc\xc6\x62\x5d\x88\x86\x34\x55\x3d\x67\x0f\x0b\x29\xa0\x6f\x31\x0e\xc3\x82\xd5\xf0\xd9\x3b\xc8\x55\xca\xcb\x1f\x9e\x7d\xa2\xfe\xac\x63\x30\xcd\x24\xe0\x7b\xd6\xa1\x48\x79\x65\x0f\x70\x1a\xe2\x7c\xd4\x5f\xd1\x19\xf4\xa9\x71\xc9\xf9\x32\x98\x56\xfd\xe9\x41\x84\x6f\xc1\xcb\xdd\x8c\x55\xf7\xf8\x5e\xa4\x9e\xb6\x9e\x52\xf4\x1d\x00\xae\x54\x7b\x77\x29\x76\x48\x2a\x80\xca\xe2\x94\xaf\x6c\x5b\x39\x75\x8d\xce\x76\x60\xc3\x20\xb0\x7f\x51\x80\x05\xbd\x00\x2f\x56\xcb\x8a\xa7\x36\xcd\x61\x8d\xa0\x86\x72\x00\xae\xb9\x82\x1c\x71\xa8\xf1\x22\x54\x23\x59\x02\x1d\x41\xaa\xaf\x5b\x13\x48\xa4\x7e\x0b\x59\x61\xf0\xd6\x0e\x7b\xd4\x32\x17\x4f\x50\x03\x72\x07\xd8\x66\x5d\xbd\x3d\xce\xd8\x06\xa7\xc0\x15\xcb\x32\x75\x31\x8b\xec\x24\x93\xc9\x3d\xf4\x74\x93\xb1\x5a\x96\x97\xb2\xaa\x73\x68\xc1\x50\x07\x56\x1c\xca\x27\x75\x61\x3c\xe3\x8c\x78\x5e\xf3\x52\xe1\x32\x23\x4b\x65\x2c\xd5\xf5\x30\x54\x6a\x2e\x6b\x9e\xb0\x32\x9d\x8a\x9c\x57\x8a\xfa\x5f\xb5\x94\xe1\xa0\x1b\x34\x62\x21\xca\xd8\xf4\x19\x0a\x51\x07\x28\x24\xe0\x56\xa6\xfc\x1d\xdf\x30\xec\x8a\xd3\xc1\x79\x55\xb3\x5d\xa1\xfc\xb5\xcb\xf6\x38\x31\x68\xc0\x14\xb5\xc8\x5f\xdb\x83\x19\xab\x2a\x05\x3f\xb3\x0f\xa1\xf2\x72\x5e\x6e\x0c\xf1\x85\xbb\x89\xba\x03\x26\x84\x4d\x4e\xf1\x5d\xa1\xcf\x3a\x7c\xd7\x13\x89\x28\x16\x36\x64\x92\xc3\x5c\x97\x25\x10\x1d\xfa\x52\xd5\x52\x75\x9c\xbe\xa6\x1b\x0c\xaa\xd8\x98\x12\x55\x25\x64\x5e\x11\x3d\x82\xd5\xa2\x66\x25\x99\xb0\x73\xe8\x3b\xe8\x01\xe8\x08\x74\x9f\xa1\x30\x02\x22\xad\x8d\x2e\x10\x13\x1c\x5f\x40\x12\x58\xbd\x27\xf9\x5a\x2a\xa3\xbd\x84\x0f\xda\x90\x4d\xf1\x63\x9c\xfa\xa0\xb7\x35\x49\x32\xf8\x83\xa4\x89\x96\xbe\x0b\x20\xbd\x40\xa1\x61\x1a\x3a\x79\x8a\x69\x1b\xd1\x63\x91\xb1\xbd\xa1\x55\x61\xc6\x90\xf8\x4e\xa5\x2c\x0c\x6c\xc6\x2a\x68\xb2\x29\x02\x72\x54\x8a\xcd\x06\x40\xb5\x2c\xd4\x82\xa2\xdf\xdb\x78\xbb\xa2\x19\x0e\x6f\x23\xae\xc3\x0e\x9b\x3b\x45\x96\x97\x36\x45\x9a\x54\x91\x3c\x1d\x9c\xa3\xbd\xb7\x42\xeb\x6f\x5b\x5d\x88\x07\xe8\xfc\xb6\xab\x91\x79\x98\xc9\x9c\xef\x81\xd8\xfc\xa6\x07\xb9\x5d\x10\xa6\x52\xde\x3b\xb5\x0a\x33\xce\x0b\x20\x65\x99\x80\xbe\x1e\xa3\x52\x25\x36\x8f\xdd\x03\xa9\x47\x65\x49\x5b\xaf\xdb\x34\x49\x93\xed\xb8\x94\x3b\xe7\x81\xd5\xac\xd4\xf3\x32\x92\x94\x85\xe7\xf8\xae\xb0\x07\xd0\xda\x9a\xe5\xb5\x66\x77\x74\xe7\xcd\xf9\x53\x6d\x0f\xbc\x1d\x13\x19\x7e\x36\xae\x10\x40\xc5\x70\x29\x70\x79\xc6\xf6\x80\x2f\xa6\x69\xf8\xa5\xdd\x00\x47\xa5\x20\x42\x8e\x68\x80\x15\xd8\xed\x84\x50\xb4\xb4\x11\x9e\x6b\xa7\x04\x40\xda\x5b\x46\xcc\x1e\xf4\x30\xaa\xc7\xe0\x0d\x4b\xce\xee\x6d\xa0\x80\x94\x74\xb2\x0c\x32\x78\x0b\x74\xa9\x61\x49\xda\x7e\x55\x57\xb0\xc6\xab\x05\xab\x2a\x5c\x51\x78\xd5\x7b\x1f\x22\x5d\x87\xee\x36\xb0\x8f\x2a\xe2\x19\x2f\x64\x59\x1b\x8c\xbc\x94\x3b\xae\x28\xa4\xf0\x48\xca\x32\x05\xc2\x3a\x93\xa9\x58\xef\x01\x25\xbb\xc1\x20\x64\x40\x24\x23\x94\x54\xba\x3b\xa1\x81\x3c\x55\x8b\xa6\xda\xea\x61\x85\xd6\xb4\xcf\x55\xca\x79\x12\x95\x93\x6f\x32\x8e\x1c\x5a\x20\x6b\xe0\xe7\x00\xa8\x10\x6a\x2b\xe7\x74\x70\x9e\xc8\x4a\x55\x22\xb7\x09\x66\x38\x3f\x27\xcb\xe4\x63\xfb\x01\x6e\x29\x0b\xd5\xc6\x37\xc5\xd0\xb4\x38\x7b\xa0\xbe\x51\xf8\x49\xe9\x00\xc3\xc8\xb1\x2a\x5a\x80\x35\x82\xc2\xd4\xee\xd6\x62\x33\x57\x75\x20\x55\x7b\x60\x6b\xd4\x0e\x9b\x3b\x44\xd4\xb6\x9c\xd5\xa2\xaa\x45\x52\xa9\x50\x96\xb5\xa2\x20\xb9\x30\x83\x11\xb5\x61\x06\xa8\xb1\xc0\x5e\x41\xb3\x4c\x9b\xa6\xb2\x97\x6e\xb8\xbf\xbe\x92\x65\x96\x2a\x27\xfd\xcf\xa6\xaa\xb1\xef\x3e\xc9\xac\x21\x2a\xf3\x23\x2d\x05\x34\xe5\xb0\x0c\x39\x3d\x9e\x2a\x0f\xfa\x0f\x10\x1a\xfb\x5e\x7f\x16\x86\x01\xa6\x0f\xb1\x89\x0b\x07\x56\x6c\x46\xe3\x13\xb2\x1d\x27\x76\x73\x99\x87\xa2\xd6\x4c\x26\xce\x7b\x59\x70\xe4\xa9\x59\x86\x1c\x93\x6c\x60\x5e\x62\x31\xf0\xb7\x19\x22\x2f\x6f\xb9\x5c\xcd\xc2\xb4\x0b\x09\xcd\x21\x3f\x27\x45\x25\x91\xdf\xdb\x83\x50\xd4\x9a\x67\x6b\x89\x92\xcb\x72\xe8\xf1\x45\x29\xd7\x22\x23\x46\x97\x65\x72\xa3\xe8\x63\x89\x6f\x26\xa4\xf1\xeb\x2d\x2f\x35\xe6\x10\xbb\x81\x0e\x13\x10\x00\xcc\x06\x90\xea\x82\xe7\xc4\x36\xd8\x74\x07\x20\xac\x0e\x15\x0c\xcc\x79\xda\x41\x55\x97\x84\xce\xdf\xd3\x88\xd0\xf0\xeb\xae\x8a\x24\xc5\x8f\x57\x9f\x65\xa9\x53\x53\x09\x33\xf8\x87\xef\xed\x81\x22\x08\x12\x2e\x8d\x23\xfa\x71\xbb\xdf\xdf\x1f\x38\x2f\xe6\x4d\x96\xc1\xac\xfa\xcd\xa9\x61\x65\x36\xf3\xab\x5d\x59\xb1\x39\xc8\x52\xce\x64\x53\xf1\x4c\xca\x7b\x3c\xbd\x95\x2c\x35\x24\x0b\x77\x2a\x6a\x99\x73\xbb\x4a\x58\xc1\x6d\xe0\xdb\x35\x1b\x0f\x9c\x0b\x32\x18\x68\xc5\x8a\xa3\x8b\x0e\x69\x6c\x98\x84\xb0\x7f\x9a\xb1\xc2\xe5\x55\x2d\x72\xa2\x2c\x2d\x3f\x0d\x58\xa2\x9c\x34\x8d\x24\xf5\xaf\x0d\xe8\x80\x7c\x41\x55\xa9\x21\xcb\x11\x19\xcd\x18\x8f\x32\xce\x4a\x9b\x96\x66\xcd\x4f\x01\xb3\x84\x95\xe1\xd7\x28\xe3\x25\xc5\xc6\xa5\x13\x98\x03\xb3\xe9\x41\xba\x02\xf4\xfd\x93\x1a\xb3\x84\xf6\x0a\xb0\x1c\xa9\xa1\xc8\x6d\x42\xaf\x90\x8e\x61\x66\xee\xbf\xaa\xf0\xd2\xf9\x41\xb9\xcd\xae\xc0\x89\x69\x16\x88\x8e\x26\x85\x3c\x69\x4a\x6e\x0f\xa0\x0b\xb0\x55\x9a\xc7\x40\xb6\xcf\xc6\xee\x50\x2d\xc2\x61\xd4\xeb\x24\xe3\x14\xa9\xb8\x5b\xf4\xb0\x32\x60\x2e\x96\x15\x2f\x91\xab\xd1\x71\x8a\x69\xfb\x43\x3c\x51\xc8\xf6\x91\x54\x23\x56\xd5\x01\xdb\xab\x0b\x9e\xa3\x97\x16\x5c\xe4\x4b\x96\x57\x6b\x5e\x4e\x45\x9e\xf2\xdc\x95\x59\xc6\x4a\x60\x31\x72\xb6\xe1\x5e\x05\x2b\x2c\xf1\xc0\xfd\x9d\x37\x2d\x7d\x23\xe3\x0f\x55\x79\x4f\x3c\x69\x73\xa3\x5d\xaa\xbc\x07\x96\x72\x35\xce\xc8\x33\x91\x9a\xb3\x07\xb1\x61\x35\x8f\xa4\x5a\x30\x58\xae\x90\x09\xae\xd4\xa2\x29\xab\x86\xeb\x6d\x7a\x57\xdd\x15\xcb\x53\x5e\x5e\xa1\xd7\x52\x2d\x2a\x30\x96\x4f\x02\x8d\xac\x92\xf3\x9b\xf2\xec\x59\xec\x58\x0a\x7e\xf8\xae\xef\x6d\xf9\x5d\x2f\x0e\xa2\x7d\xfb\xe5\x8c\x19\x2f\xf1\xda\x34\xee\x40\x5f\x19\x3f\x56\x69\x33\xa5\x67\xf2\x0d\x2c\x8d\x4f\x07\xe7\x1c\xb8\x19\xc5\x9f\x44\x6d\xab\x5a\xa3\x43\x4c\x37\x94\xb5\xe2\x79\xaa\x4f\x55\x33\x96\xa7\xb1\x3d\x48\x0c\xed\x7f\xe9\xb6\x1a\x76\x5b\x2a\xc3\x4d\x38\x3c\x93\xcb\xd8\x1e\x54\xb8\xf3\xc6\x60\x9d\xb0\x23\x47\x70\x1d\xdb\x03\x56\xc3\xf3\x25\xa6\xc9\xdc\x49\xed\x80\x89\x50\x18\x21\x4d\xf1\x76\x41\x8d\x8b\x03\x0e\x2d\x4e\x79\x2e\x80\xb2\xab\xb2\xc9\x63\x78\x65\xff\x06\x95\x6c\x81\x6c\xa5\x8a\x21\xbf\x80\x01\x30\xc8\x33\xb6\xda\xc9\x07\x91\x6f\x0e\x1b\xad\x30\xf4\xd8\xae\x63\x1e\x64\xae\x28\x34\xac\x1d\x97\xfc\x37\x55\x22\x29\x8a\xa1\x16\xb5\xad\xeb\x22\x46\xbe\x93\xa6\x93\x22\x54\x55\x05\xab\xb7\x71\x83\x83\xae\xd0\x25\x0e\x05\x5d\x8b\xc9\xac\xa0\x93\x0d\x9d\x89\xa3\xa8\x46\x34\x12\xda\x8b\xae\x42\x7b\x1c\x45\x5a\xeb\xea\x9e\xef\x15\x19\x28\xa8\x5f\x1b\x60\x03\x72\x20\x10\xa5\xde\x2d\x60\x17\x9b\x8a\xbf\xa6\x37\xdf\xb0\x17\xcd\xf1\x56\xab\x9b\xf7\xaf\x28\x8e\x0e\x06\x0e\x84\xcc\x2d\x86\x9a\xbe\x3b\xaf\xcf\xf8\x79\xd9\xb3\xe9\xfc\x72\x26\xde\xdf\x68\xaf\xdf\xa7\x83\x3f\x91\x5f\xf4\x3e\x42\xbe\x79\x83\x31\x96\xce\x7e\x47\x0b\x42\xb2\xa8\xb7\xdb\x10\x47\x19\x45\x97\x3c\xbb\x3f\x7f\x39\x28\xa1\xc8\x7a\xc1\xf6\x58\xae\x7d\xe9\xa0\xbf\x99\x4c\x07\x4c\x13\x6b\x34\x0a\x5c\xcb\xd2\xda\xc8\x5a\x52\xf8\x3b\xed\x5b\x27\x97\xb5\x25\x4d\x5c\x37\x63\x19\xaf\x0d\xd5\x6b\x91\x91\x01\x7d\xdf\x4a\x3b\xbe\xb0\x62\x6f\xfe\xc9\x8a\x8d\x97\x5a\x6d\x0a\x6e\xc5\x71\xce\x1f\x4d\x72\x27\x53\x6e\xc5\x31\x1a\xca\xc4\xf1\x8e\xd7\x8c\x6c\x93\xe2\xb8\x96\xda\x43\x75\x1c\x67\x3c\xb7\xe2\x78\x93\x58\x71\xcc\xd2\xd4\x8a\xe3\xaa\xb9\x83\xbb\x1b\x78\x26\x15\x0f\x54\x8f\x15\xc7\x85\x7c\x84\xca\xd0\xce\xd5\x8a\xe3\x26\xdf\x59\x71\xcc\x7f\x85\x2a\x6a\xac\xc7\x62\x55\x85\xee\x7c\x64\x96\xf1\xa4\xde\xb0\xf2\x8e\x6d\xb8\x95\x4a\xf2\xd8\x8b\x8e\x9c\x36\xbc\x5e\xf3\xfc\x01\xae\x5d\x7b\x44\xc1\x44\x59\x91\x0d\x0c\xfc\xe0\xfd\x90\xa0\x46\x6a\x93\x3e\x34\x43\xa3\x3b\x0b\xfc\x24\x32\x4b\x2f\xd9\x23\x46\x65\x83\xc4\x86\x63\x1e\xe3\xe1\x69\x1b\x76\x13\xf5\x8e\xde\x5b\xf5\x5f\x5b\x4b\xed\x7d\xb0\xed\x0d\xb4\xd5\x6a\xf2\x82\x25\xf7\xd6\x13\xbd\x84\x95\x1b\x0c\xb8\x98\xc8\x52\x36\x35\x39\x1b\x47\x23\x1e\x0a\xa5\x56\x91\xa3\x27\xf4\x00\xad\xdd\x18\x95\x4d\x9e\xa3\xc5\x25\xbf\x6b\x30\x56\x73\x53\x90\xf1\x0d\x01\x2a\x5e\x6f\xa5\xbc\x3f\xec\x81\x8d\x06\x1e\xb4\xaf\xe2\x35\xa1\x47\x5d\xb2\x84\xa3\x2b\x8e\xaa\xeb\x3e\x91\xaf\x25\xe4\x4d\xed\x1b\x73\x37\x9a\x01\xc1\x1c\x2a\xf7\x6d\x77\x0b\xa9\x7d\x91\xa3\xeb\x0c\xed\x9e\x09\x5d\xd3\x6a\xef\xa0\x18\x8d\xd0\xd2\x7e\x09\xd1\x14\x09\xfd\x9c\x93\x37\x73\x91\x43\xa1\xf6\xa6\x5e\xe0\x03\xf5\xae\x20\x57\xe4\xe4\x4c\x75\x83\x66\x64\xe8\x91\x78\xdb\x6c\xb8\x95\xa5\xfc\xa9\x20\xbf\xcd\x95\x55\xb3\x7c\x6b\x01\xf6\xa4\x7c\x83\xbe\xbb\x12\x59\xa1\x77\xb0\xed\xa1\x1b\xcf\x8a\xf3\xd4\x5a\x97\xf0\x64\xc2\x45\x66\xad\x33\x09\x93\x82\xa5\xe8\xee\x18\xfd\x86\xed\x64\xba\x26\x27\xc6\x64\xb2\x96\x5a\x6b\xf8\x21\x07\xaf\xe4\xd2\x18\x1e\x6f\xbd\x1c\xcb\xca\x82\xf5\xa0\xed\x4a\x72\xf4\x8b\x26\x56\xf9\x83\x95\x8a\xf5\x1a\xbd\x83\x95\xc8\xab\x90\xa7\x30\x94\x48\xc0\xf7\x69\x9f\x45\x78\x31\x4e\x19\x4d\x94\xeb\x82\xf6\x9e\x88\xa4\x99\xb8\xc3\x2b\xa7\x2c\xfa\x8a\x07\x42\x6a\x25\x14\x42\x1f\xd3\x15\xe7\x80\x4d\x1a\xcf\x60\x8a\x91\xff\x30\x98\x7d\x9b\xb5\x20\xff\xe9\x16\x26\x28\x50\x20\x3a\x36\x4f\x9b\x5d\x61\x6d\x08\x80\xfe\xef\x2b\xf2\xaf\x6c\x3c\x08\x6f\xa0\x22\x72\x65\xdb\xa2\x4c\xae\x0d\xbe\xe0\x23\xd1\xdd\x36\x67\xc9\x56\xc0\xf8\xb4\x39\x4b\x4f\xe2\x8a\xdc\xde\xc0\xb7\x9f\xfc\x9f\x2f\x67\xc9\x79\x1b\x6d\xe8\x59\x8c\xad\x63\x9f\x29\x26\xe0\xc5\x0b\x11\x0d\x4e\xd1\xbb\x81\xf3\xe6\xe7\xdb\xd5\xea\xf1\xd5\x6a\xf5\xd6\x7e\x75\x08\x3a\xd7\x0e\x10\x4c\xfe\xc4\x84\xda\x7b\x16\x59\x4b\xc7\x34\x12\x87\x61\x8c\xc8\x7b\x49\xdf\xab\xc9\x91\x95\x54\x4b\xfc\x91\x90\xff\xeb\x1f\x98\x7a\xef\xd8\x3d\x5f\x23\x6d\xfd\x8a\x5d\xd6\xb3\x18\x41\xab\xd5\xb7\x18\x87\xa4\x0d\x8b\x6c\x9f\x1c\x79\x5b\x58\x7d\x7b\xf3\xf7\x7f\xf9\xdb\x60\xf5\xcb\xea\xf5\xea\xd5\x2d\xfa\x33\x78\x66\xc7\xf5\xdc\xe7\x42\xcf\x98\xab\x1f\x18\xec\xdd\xea\xdb\xd5\xe9\x0d\x46\xf3\x5a\x55\x3d\x7f\x05\xbf\x77\x6b\x01\x9a\x8e\x01\x96\x51\x02\x5e\x42\x98\xa4\x91\x4d\x1b\x7d\xd2\xe5\x0d\x4c\x61\x1c\xf7\x36\x0a\x02\x3a\xff\x44\x5f\xa2\xe4\x4d\x8d\xe9\x44\x2a\x30\x96\x2e\x5c\xaa\x66\xbd\x16\x4f\xe8\x00\x9c\x02\x66\xa6\xa9\x06\xb1\x34\xd5\x56\xd0\x18\xe8\xf2\x51\x64\x69\xc2\xd0\xe5\x14\xcb\x10\xf1\xd9\x5d\x85\x57\x22\xff\x8f\xac\x44\xda\x48\xee\x02\x65\x29\x36\x22\xb7\xd6\x19\x7b\x90\x65\x8b\x9c\x62\x0d\x6b\x21\x2c\xa2\x48\x80\xf9\x03\xcb\xc8\xe7\x1c\xd2\x39\xed\xf6\x07\x17\x74\x18\x8f\x5f\x7a\x43\x51\xbd\xba\x39\x7f\x3d\xb8\x1d\xbc\xd7\xf1\x17\xf3\x43\x8f\x0f\xed\xbd\xe8\xe1\xc0\xdc\xd9\xfa\xb4\xc8\x0f\x23\xe5\xbc\xfb\x65\xf5\x76\x71\xe9\xcf\xaf\x29\xf6\xf4\xb7\xd8\xeb\x14\xe8\xdb\x98\x44\x9e\x9f\xd0\x1d\x27\x5f\xce\xb2\xf3\x77\x37\xab\xb7\xab\x47\x0c\x5b\x64\xa2\xa4\xf3\xd6\x57\xd0\xbb\x5f\x6e\x7e\x59\x55\xb7\xaf\xdb\xba\xe8\x1b\x9e\x3b\x97\xd8\xdd\x9f\x9c\x01\x56\xa2\x8b\x09\x1d\xae\x11\xf8\x06\xbe\x6e\x3d\xb9\x58\x62\x0d\x59\xb1\xce\xe9\xc2\x7f\x85\x34\xff\x55\xb3\x1a\x79\x8a\x9c\x05\xb9\xb4\x7b\x63\x12\x95\x49\xb4\x51\xf9\xb5\x0f\x80\x26\xd7\x09\xe3\x74\xe0\x01\x86\xeb\x84\x3e\x08\xd0\x4e\xa3\xe8\xe5\x68\x76\x56\x63\xec\x71\x71\x96\x9f\xc9\xaf\x32\x6b\xb0\x20\xf0\x1d\xc6\x32\x7e\x31\x7e\x5f\xef\x53\x77\xec\xe4\xf6\x2c\x43\xa7\x27\xdf\xaa\xd5\xea\xce\x86\xe1\xa1\x91\xbc\x43\x5f\x4a\xce\x1d\xcf\x04\xcb\x51\x70\x60\x39\x18\x30\x16\x7f\x3f\xa0\x2d\x7b\x45\x99\x85\x8e\x96\xeb\xdc\x01\x09\x77\xee\x00\x5c\xc9\x0c\xa8\xb5\x49\x8c\x64\x49\xde\xe9\x64\xfe\x12\x6c\x6c\xfc\xf3\xb4\x65\xe4\xe9\x17\x4f\x0e\x5b\xa0\xcb\xaa\x2d\xa0\xae\xc9\x8f\x05\x39\x73\x6c\x01\x7e\x41\x1e\x84\x4d\x1e\xb7\x52\xa1\xf8\xad\xbb\x23\xda\x8a\xe4\x3e\xe7\x55\x77\x0f\x3a\xe1\xeb\x65\xf0\x05\x09\x7a\xdf\x15\xf9\x66\x2c\xcb\x1d\x64\x1b\xdc\xc3\x73\x4c\x96\x2c\xd9\xb7\x89\x0b\xc9\x32\x8b\xce\x59\x50\x12\xa8\xd3\x33\x9e\x37\xbd\xe4\x50\x3e\x1d\xe6\xda\x96\x52\x44\x0f\xba\xa0\x67\x2f\x4a\xa2\x7f\x48\xcb\x49\xf6\x49\x26\x92\x8b\x92\x15\xdb\x8f\x96\x93\xa6\x7e\x7e\xc9\xb3\x02\x7d\xc0\xe1\x8e\xde\x72\xd2\xff\x64\x09\xcf\x93\x3d\xde\xd3\x65\x61\x53\xdd\xe5\x66\xe8\x46\xdd\x22\x19\x13\xec\x09\xb1\x3d\xfd\x5c\xdb\x1e\x04\x42\x9f\x84\xb0\x61\xaa\xc6\xb2\x24\x1b\x6c\x67\x0d\x88\x4f\x1b\x62\xec\x13\x8c\x1a\xe3\x88\x72\xef\x08\x7d\x81\xdd\x39\xd7\xe9\x9f\x79\x29\x31\x39\x14\xfa\xd2\x95\x0e\x75\x69\xb6\xe1\x77\x25\x13\x89\x96\xa7\x7c\xec\x20\xda\x33\xfe\x51\xde\xe5\xb9\xdc\x89\x9c\xd5\xf2\x59\xd1\x1c\x9b\x74\x08\x5b\xc8\x6c\x0f\x0f\xc0\xf8\x1c\x96\x44\xc0\xcc\x75\xc0\xa0\xc9\x78\x75\x94\x45\x57\x6a\x2d\xa8\x57\xba\xcc\x45\x0d\x2d\x15\x9b\x1c\xed\xbf\xdb\xd4\x8c\x95\xf7\xd8\x66\x9d\x47\xdc\xb3\x9c\x0c\xde\x0e\xfc\x41\xea\x8a\x1d\xcf\x2b\xea\x65\x80\xe0\x9c\x42\x21\x96\xa5\xa5\x97\xc0\x1f\x8e\x78\x96\xe9\x1b\x68\x8e\x4d\x8c\x6f\x0f\x3d\x79\xa0\x24\x20\x46\xe4\xb8\x06\x92\x91\x4d\xf9\x03\xcf\x46\xb8\x71\xb5\xf0\xc8\x4f\x0b\xc6\x2c\x27\x43\xff\x21\x80\xd6\x7a\x3e\x1b\xc0\x03\xbf\xdc\x17\xb2\xde\xf2\x4a\x54\x7d\x68\x65\x39\xbb\x3b\xc1\xf3\x7a\x8a\x41\x3b\x9c\x9c\x65\xfb\x5a\x24\x96\x93\x27\x5b\x59\xf2\x34\xc4\x00\xd7\x96\x93\xa7\xf0\x8f\xee\x0a\x5c\x56\x66\x22\xdf\x44\x1c\x70\x26\xdf\xf0\xf2\x1f\x16\x09\x6a\x29\xdc\xab\xa5\x4f\x5e\x2f\x18\xf0\xa8\x24\x07\x37\x57\x21\xf3\xd1\x3e\xc9\x8c\x6b\xdb\x43\x60\xc0\x0b\x5e\x0b\x8d\xa4\xa6\xa4\x3d\xc6\xe9\x83\x50\xbe\x43\xb3\xda\x00\x83\x83\x97\xbc\x58\x55\xa0\xb7\x0c\x04\x00\x0c\xd3\x09\x9c\x2a\x5d\xba\x9d\x28\x1a\xe4\x91\x1f\x00\x00\xe4\x7a\x43\x0d\x49\x0c\x01\xa2\xaf\x2e\x90\xb0\x1c\x07\x91\x55\xf4\x8e\x5a\x54\xfb\x1d\x9d\xa8\x59\x4e\xc1\x80\xaa\xc2\x6f\x88\xa1\x71\xd1\x07\xa3\x53\x14\x9c\x95\xe8\xa0\xb6\x4b\x76\x2f\x2b\x0a\x9e\x65\xe3\x1f\x2c\x72\xcf\xa9\x2f\x40\x0f\x8a\x22\xdb\x5b\x4e\x99\x8c\x64\xa5\x2f\x5b\xba\xd6\xfa\x42\xd9\x2a\xd1\x17\xcc\x86\x3c\xd1\x17\xca\x8a\x5c\x5f\x5c\xd8\xc3\x88\xbb\x86\x3e\x0b\x41\x78\x47\xc4\x72\x7d\x81\xec\x06\xfe\x67\xec\x09\x2f\xf8\x2c\x05\x34\xc7\x63\xca\x8f\x96\x13\x4c\x66\x8e\x0e\x70\x60\x39\xa5\xa8\xb7\x3b\x5e\x8b\xe4\xe2\x20\xda\x86\x13\xf4\xee\x09\xba\xbb\x4b\x20\xa9\xf0\x3b\x92\xbb\x42\xe6\xf4\xf5\x14\x5a\xa5\xc0\x6f\x29\xd9\x7e\x9c\xb1\xba\xe6\x39\x65\x16\x2c\xd5\x89\x0c\xbf\xb9\x64\xfb\x8f\x74\x09\x78\xb5\x65\x05\xd7\x19\x9a\xf4\x90\xc4\x8b\x7c\xa4\xdf\xbf\xb8\x38\xe0\x90\x6c\x13\x5b\xce\xd2\xca\x72\xaa\x82\x27\x75\x00\x43\xdc\x4f\x8f\xc5\x13\x4f\x2d\x87\xf6\xdd\x4e\x55\x35\xb4\x82\x40\xc2\xa0\x0a\xfa\x25\x91\x3b\x91\xb0\x8c\xe8\x4a\xb5\xcf\x93\x2d\xc0\x9a\xea\x20\x13\xb1\xea\x9e\x8e\x46\x9e\x81\x2b\xcb\xa9\xe5\xee\xa3\xd5\xf3\x91\xee\x34\x18\x04\x8c\xa7\xa1\xc1\xa5\x3e\xc9\x6b\x6a\xe9\xe8\xb9\xd1\xd4\x92\xc4\x92\x98\x34\xae\xcb\x75\x44\x14\x00\x19\x99\x67\xba\xd0\x9b\x2b\x00\x4e\xf2\x14\x09\x5c\x9b\x0c\x0b\x96\x88\x7c\x43\x8f\x4c\x6a\x96\x89\x84\x62\x89\x41\x1e\xf6\x5d\xb4\x2e\x35\xb5\x44\xc7\xe7\x98\x42\x36\xa4\x4b\x4d\x76\x6c\xc3\x69\x41\x86\xdb\xc8\xbb\xad\x48\x28\x14\x05\xfa\x91\xc0\x02\x22\xd3\x14\x0c\x06\x83\xec\x03\xd0\x2f\x78\x3e\x97\x35\xbf\x93\xf2\xbe\x6a\x21\x0b\x96\xf1\x9a\x7a\xa3\x96\x65\x93\x87\xfc\xd7\x86\xe7\x89\x79\x28\x4c\x58\xd6\xa5\x4b\x99\xd1\x0b\xf4\xa7\x50\x1a\x96\xda\x76\x5a\x1b\x80\xfe\xb2\x27\x4e\x3f\x5e\x0a\xbd\xf2\xc4\xab\x29\xbb\x03\x52\xfa\xc4\x2b\x9f\x58\x67\x48\xea\xd5\xfa\x49\x54\xd6\x90\xdd\xed\x67\x32\x07\x8e\x0c\x89\xec\xd0\x1a\xb2\xe4\x1e\x7f\x36\xe4\xdf\xbb\x4b\xe2\xb8\x86\x1c\x3f\xb1\x42\x38\x85\x44\xc2\x14\xec\x24\x44\x4d\x33\x0f\x00\x8f\xc0\xd3\x0f\x59\x4e\x3f\x05\xab\x2a\x3a\xf5\xc4\x6c\x55\xcb\xa2\xcd\x96\xec\x8e\x55\xc2\xc9\xee\x78\x59\x23\x5b\x70\x30\x8b\x87\xac\x1c\x6d\x81\xe2\x98\xc4\x5f\x5c\x48\x4e\xf9\x86\x63\xdd\x65\x26\x1f\x17\xa5\xac\x92\x2d\xcb\x27\x3a\xc2\x67\xc2\xa1\x20\xe7\xd5\x05\x5c\xf5\x87\x0f\x59\x69\xba\x71\xc8\xca\x1a\x86\xe1\x92\xe5\xf9\x15\x79\x25\x34\xa0\x36\x5b\x61\x70\x1f\x4c\xa0\x63\x21\x93\x30\xda\x0b\x08\xa0\x8e\x1c\xb2\x9a\x57\x47\x8d\xae\xeb\x8c\x4f\xf9\x8e\x95\x82\x5f\xb1\x07\xc0\x66\x6b\xc8\x13\xd6\x54\x1c\xae\xf7\x3b\x96\x1f\x12\xab\x21\xe7\x85\x35\xa4\xa0\x72\x43\xf4\x07\x86\xbf\x74\xea\xb4\xa0\xb5\x08\x21\xe3\x52\xe6\xb5\x97\xa7\xc0\x85\x94\x24\x6c\xed\x17\x9b\x19\x31\xe4\x59\x36\xc4\xdf\x6b\xf8\x85\x4f\xe2\xf9\x5a\x96\xe9\xd1\x5b\x73\x91\x8b\x63\xd0\x7d\x8d\x42\xfb\x0b\x71\x57\xb2\xfa\x2b\x85\x57\x5c\xdc\x35\x59\x76\x54\x5a\xe6\xb2\xc9\x32\x31\xec\x92\x2f\xdf\xf0\xc2\x30\x9b\x22\x43\x4a\x01\x50\xd5\x5c\xe4\x43\x06\xab\xfc\x90\x57\x15\xcf\x08\x67\x66\x32\xe5\x99\x86\x4c\xf4\xf5\x1f\xe6\x8a\xbc\x1a\xa5\x3f\xe8\xeb\xb5\xb9\xea\xb2\x9a\xe1\xcf\x50\x10\xed\x39\x6a\x47\xcd\x9e\x01\xe6\x7c\x83\xfc\xc5\x57\x9f\x40\x66\xf1\x19\x34\xe0\xc8\x3b\x88\xdf\x78\x6a\xe9\x83\x5f\x60\xe5\x47\x3c\xaf\x4b\x72\x93\x34\xe4\xbf\x09\x5e\x8e\x9a\xf2\x81\xf7\xd3\x44\xd4\x8f\x01\x66\xce\xf7\xe0\x47\xb7\x3d\xbb\xa9\xdd\xa7\x0c\x05\x6c\x07\x4a\x96\x99\x59\x27\x72\x6c\x18\x25\xf6\x3a\x8e\x15\x65\x90\xe8\x7d\xd4\x99\x80\xb3\xb4\x97\x44\x3e\x9d\xb2\x57\x28\x17\x1c\x0a\x8a\x5b\x53\x41\x0a\x4a\x31\x41\x34\xfd\xe5\xee\xd2\xc0\x76\x9c\x05\xc5\x2f\x3a\xba\x49\x96\xf5\x56\x6e\x64\xce\xb2\xb0\x80\x69\xd7\x4e\x22\x8c\xed\x24\x6a\xae\x37\x19\x43\x51\xe6\x77\xac\xd9\xf5\xa7\xbe\x06\x85\xac\x41\x26\xef\xa8\x66\x0c\xb6\x37\x14\x35\x1e\xd2\x41\xe2\x02\xab\xad\x49\xff\x01\x52\x73\x89\x00\x1f\x4b\x43\x2a\x0d\xb7\x62\x5d\x4f\xf9\xba\xcb\x04\xc8\x63\x0e\x29\x50\xdf\x10\xe3\x40\xe2\xef\x8e\xe5\x97\xac\x2c\x45\x65\x48\x89\x06\xce\x9b\xba\x66\x59\x76\x04\xed\xb2\xf9\x3d\xfd\x12\xd9\x81\xd4\xbc\xc9\x32\xbd\x3e\x70\x82\xf4\x72\x48\xfd\x50\xf0\x88\xbf\x74\x84\x0f\xe9\xdd\xaf\x0f\xa2\xaa\x09\xcb\xfb\x39\x64\x71\x87\xb0\x3f\x1d\x66\x4d\x69\x0d\x65\xca\x91\xe5\x18\xca\x6d\xaf\x1d\x32\x4b\xad\xa1\x94\xf7\x3b\x56\xde\x57\x90\x02\xfa\x06\xbf\x2c\x1f\xc9\xbc\xe2\x49\x03\x13\xa1\xc3\xab\xb6\x08\xdd\xf2\xb7\x59\xbd\x1b\x3d\xba\xed\x38\x4f\x9b\x40\x9d\x99\xb1\xa7\x9a\x97\xbb\xaa\xcd\x8b\x5c\xec\x10\x45\xdb\xfc\x41\x79\x9b\xc0\xe0\x42\x26\x63\x9c\x53\x43\x69\x99\xf2\xb2\xb7\x8b\x19\xca\x92\x67\x11\xec\x2d\xca\x43\x94\x90\x75\x0d\x5d\x87\x97\x4b\x56\x77\x9b\x46\x54\x29\x63\xe5\x5e\x93\x79\x72\x26\x88\x73\x4e\x3e\x99\xf5\x00\x71\x01\x00\xc8\x2a\xc1\xb5\xff\x46\xe0\xb5\xe0\x17\xd3\x34\xb2\x74\xa5\x19\x47\x21\x96\x00\x54\xb2\x1d\xbc\xe1\x69\xe2\xc2\xef\x8c\x95\x18\xcc\x11\x93\xb8\x17\x1e\xca\x27\x64\xdf\x10\x66\x14\xe7\xfa\x69\xda\xbf\x0d\xe5\x93\x69\xec\xd3\xd5\x56\x54\xf7\xdc\xac\x9e\x25\xb3\xf4\x9e\x46\xe4\x9b\x21\x60\x7e\xc9\x3e\x70\x2c\xd8\x8f\x9a\xb2\x16\x38\x4f\x68\x02\x95\x9c\xa5\xf5\x16\xfd\xcb\x87\x09\xcb\x11\x70\x6f\x0d\x4b\xf9\x98\xd3\xef\x58\x96\xd5\xbe\xde\x72\xc2\x29\x80\x08\x96\x0f\x4b\x91\x6e\x78\x3b\xaf\x4b\xf9\x58\xf1\x72\xc4\x6a\xbe\x91\xe5\xde\x1a\xd2\x3c\xd6\xd4\x9c\x32\x9a\xf0\xf5\x32\x9a\xf2\xf5\x20\x2f\xe4\x5b\x1a\x47\xe0\x0e\xab\x28\x1f\x36\xe5\x1a\x36\xcc\x87\x59\x5d\x73\x73\x77\x97\x71\xdd\x25\x5d\x1a\x78\x0a\xcc\xa1\x36\x9d\x49\x8b\xdf\x60\x70\x9a\x2c\xe3\x35\xed\xff\x86\x4d\x25\x80\x82\xbb\xc0\xa3\x0f\x9b\xba\xe6\xe5\x3a\xd3\xa2\x0c\xca\x3e\x02\xdd\x3a\x58\xa5\x9a\xba\x86\xa6\xe1\x05\xbb\x9d\x52\xd8\x16\x9d\x6a\xbf\x07\x01\xb0\xa3\x36\x49\x13\x67\x87\xb2\x84\x61\x98\xd4\x4c\xb1\x34\xf5\x79\x4f\x05\xcb\x53\x9a\x08\x08\xd0\x08\x45\xe9\xb6\x83\x30\xdb\x22\x17\xe5\x44\x4e\xda\xc3\x3a\x0f\x2c\x6b\x2f\x09\xdc\xab\xce\x86\xb2\x29\x13\x53\xa6\xb1\xac\x4b\xcf\x78\xde\x00\xfd\x47\xfe\x6a\x0f\x75\xec\x6b\x4e\x21\x73\x20\xe5\xc3\x64\x84\xb2\x91\x35\x42\x15\x53\x12\x95\x61\x1a\x3b\x50\x24\x95\x35\x62\x40\xd6\x18\xc5\x0c\x34\x19\x8c\xa3\x33\x62\x59\xa6\xd9\x9c\x11\xcb\xef\x78\x59\xb2\x16\x5d\xb5\x97\x7b\xba\xe8\xfe\x1e\xb1\x3c\xcd\x78\x55\x8b\xe4\x9e\x06\x7b\xc4\x0a\xf8\xc7\x29\x38\x62\x85\xa8\x61\xc5\x59\x63\x10\x4c\xd8\xe9\xba\xd6\x88\x95\xa9\xc8\x59\x76\x80\xa7\x23\x56\xee\x44\xb2\x65\x3c\x9b\xb2\xdd\x5d\x0a\x8d\xaa\x38\x80\xab\xed\x1a\x78\xaa\x11\xab\x64\xc9\x6a\xc1\xe0\x8d\x35\xcb\xba\xab\x16\x09\x8d\x70\x67\x31\x62\x4d\xb2\xdd\x1f\x10\x1d\x02\x69\xaa\x3b\x62\xfb\x8c\x6b\x3c\x1a\xb9\x63\xf8\x77\x79\x91\xc9\x3d\xa4\x26\x39\x1d\xdd\xe0\x53\xee\xd8\x2c\x84\x23\x2e\x70\xa7\x80\xe8\x02\x3f\xb8\xc3\x78\xe0\x25\x9d\xd7\x01\xa4\xe5\x5d\x31\xd3\xa9\xc7\x52\x9e\x88\x81\xc1\x3d\x00\x91\x10\x26\x12\x3b\xae\xf3\x06\xfd\xda\xcc\x53\x8d\x69\x57\xe4\x9b\x3b\xa6\xd3\xe4\xe3\xd4\x7b\xc2\x30\x04\xd8\x48\x9e\x65\x5e\x2a\x6a\xb7\x75\x9f\x89\x10\x2d\x0b\x38\x6e\x87\x86\xb7\x9b\x35\x84\x15\x22\x93\xf4\x69\x1a\xd1\x85\xcc\x8f\xaa\x6b\xe1\x2d\x76\x13\x98\xe7\xb5\x93\x74\xdf\x44\xf3\xa0\x4d\xa1\x36\x79\x97\xc5\xdd\x91\x99\x0f\x87\xd0\x5e\xbe\x7f\x03\x49\xa2\xda\x14\x61\xaa\xc9\x99\xf1\x30\x19\xda\xb4\x03\xe4\x52\x96\xe2\x37\x99\xd7\x2c\xa3\x5d\x9d\xb9\x73\xe2\xe2\x85\x76\x69\x6d\xaa\xb7\x03\x6e\x61\xfd\x46\x20\xc0\x6c\x44\x4c\x55\xfd\x1b\xf4\xa6\x1c\x93\x05\xa7\xae\x59\xe0\xd9\x37\xa5\xa4\xe9\x5e\xba\x1d\x77\xb8\x98\xc0\x59\x0d\xa9\x88\xe9\xb1\x00\x0e\x56\xef\x87\xb1\x8f\xf3\x4a\x96\xfc\x70\x17\x41\x40\x6a\x07\xac\xd1\xfa\xe2\xca\xda\xd2\xbc\xee\x4c\xa2\xf0\xf1\x20\xa7\x37\xf1\x7d\x36\x61\x44\xd3\x73\xcb\x76\x05\x6c\x07\x1e\x73\x6e\xa6\xd1\x96\xe5\x9f\x78\xc5\x5b\xb6\xb5\x55\x30\xea\x52\x18\x4a\x09\x1b\x71\x0c\xa9\x70\xa3\xdf\x82\x51\xf3\xb1\x7b\xe7\x01\xb8\x27\x91\x68\x0b\x02\x14\x4d\x8e\xba\xb8\xde\x48\x51\xba\xed\x1f\x66\x35\x1a\x13\x3e\x1c\x01\x0e\xde\x65\x0a\x5e\x04\xea\xba\x0d\x02\x52\x1a\x5d\x1f\x53\x1a\x37\xbd\xba\xc0\xbc\x9c\xdf\xed\xab\x2d\x7f\xf8\xa1\xbf\xea\xb4\xd0\x1f\x5f\x84\x76\xa4\xd3\x40\xa2\x2e\xb9\x84\x64\x72\x4f\xbf\x74\x84\x42\xc9\x2c\xa3\xc4\x1d\xcc\x5c\x9d\x80\x15\xad\x4d\xf7\xe1\xdd\xaa\x36\xda\xf2\x4e\x94\x34\xda\xf2\xaa\xba\x93\x8c\xf6\xa1\xba\x0d\x87\x9b\xc4\xd1\x16\xd6\x58\x1e\xf0\x1d\x13\xc0\xbf\x03\x80\x04\x8d\x47\xb7\x49\x91\x70\x22\xf7\x95\xce\xd1\x6e\x19\x32\x19\xaf\xee\x31\xd6\xf0\xae\x30\xfb\xf5\xd1\x56\x16\xd6\x48\x94\x09\xf4\x1a\x5e\xb0\xc1\x98\x42\x54\xc5\xd4\x4c\xe4\x4d\xa5\xd3\x8b\xac\x4d\x6a\xa2\x28\xca\xa4\xc9\x58\x5e\x6b\x52\x2d\xea\x3d\x7d\x15\x6e\x25\xf0\x17\x7b\x09\x13\x9d\xe0\x0b\xf3\xa4\xea\x87\xeb\x1d\x00\xee\xaa\x64\x7b\x21\xcb\x14\x56\x8c\x4c\x24\xf7\x0b\x06\x74\x3a\x13\x05\xfe\x60\x0f\xb5\xcb\x2f\x40\xc6\x02\x2b\x16\x05\xd0\x15\x3d\xf4\x99\x28\x16\x19\xcb\xf1\x0d\xa2\xd0\x68\x8a\x5b\x02\xfc\x25\x9e\x05\x93\x8f\x02\xa3\x77\xd6\xb2\x29\xf1\xf4\xa2\x04\xfc\x46\x41\x3c\xfe\xa6\x74\x31\x87\x64\x98\x39\xda\xa9\x02\x0c\x27\x17\x5d\x51\xee\xc4\x1e\xb8\xc9\x23\xdd\xb5\x46\x59\x53\xd5\xb8\xcc\xf7\xa4\x9f\xa3\xd9\xf5\x07\x4d\x76\x25\x2b\xe1\x95\x92\xaf\xd7\x22\x11\xf8\x44\x97\xd6\xb2\xcd\x1e\xc4\x95\x80\x02\x7d\x08\x6e\x3f\x7b\x79\x4d\x60\xa5\x58\x67\xbc\x6e\x57\x46\x52\x26\x82\x2b\x0c\x3b\xfc\x12\x5d\x81\x46\x8c\x74\x44\x31\x9d\xa1\x6d\x0b\x66\x68\x24\x4d\xaa\x9b\x9e\x00\x79\x39\x67\x84\x75\x08\x44\xaa\x04\x09\x14\x16\xe8\xb4\x4f\x4a\x32\x98\xd6\xaa\xc4\xdd\x6b\x3e\x36\x2c\xaf\xdb\xc7\xb4\xaa\xb5\xce\xe8\xef\xca\x64\x19\xa2\x36\x12\x5c\xb5\xdc\x4d\x43\x0b\x56\xb6\xaf\x81\x32\x98\x28\x5d\x1a\x51\xfb\x20\xdb\x4e\x47\x84\x66\x22\x6d\x1f\x28\xf4\x6b\x9b\x5d\xae\x2f\xed\xa1\x50\xa5\x01\x9d\x0c\xd0\x40\x4c\x8f\x36\xbb\x1c\x6d\x67\x74\xba\xf2\x50\xb1\x8a\x32\xdd\x9a\x8e\xd9\x2b\x91\xd6\x5b\xc8\xec\x76\x32\x77\x49\x35\xb3\xbf\x0d\xa2\x02\x8e\x23\xac\x53\x5a\x5a\x41\xf9\x65\x2e\x6a\xba\xab\xc9\x45\xbd\x3f\xdc\x9c\xb5\x60\x9c\x9a\xb8\xb1\x6d\x41\x86\x9c\x9a\xbc\x56\xa3\x35\xcf\x15\xac\x16\x77\x19\xa7\x93\x32\xc8\x6b\x33\xa6\xae\xc7\x5a\x10\x69\x89\x6b\x08\x37\xd7\xb4\x4d\xf4\xf0\x64\x57\x68\x8f\xcb\x94\xd4\x02\x8b\xc3\xdc\xc7\x36\xfb\x81\x95\x7b\x8c\x09\xa6\x01\xf0\x62\x40\x76\xc3\xf3\x22\xf4\xc9\x5c\xa9\xb3\x20\x45\x7c\xbf\xc9\x4d\xf2\xb5\xc8\x71\xb6\x52\x5e\xd4\xfb\x83\x16\xe1\x8c\x9c\x71\x56\x35\xa5\x59\x6e\x0c\xd4\x50\x08\xfe\x54\xe3\x59\x30\x16\x54\xdc\x5c\xf5\xc4\xc3\x34\x9d\xb4\xea\x9c\xe8\x2a\x6f\xf2\xb4\xcf\x05\x6a\xd0\x42\x8a\xaa\x92\x87\xc2\xce\xa3\x32\xb3\x65\x34\xe0\x80\xe7\xfc\xb1\x93\x10\x8d\x4c\x6c\xf5\x51\x1b\xeb\x4a\x4f\xa2\x3c\x35\xaf\xd7\x29\x96\x1d\xb4\x40\x43\x71\x80\x70\xce\xe7\x44\xf3\x65\xbe\x16\x29\xcf\x13\x8e\x67\x8f\xbd\xbc\xa6\xa1\x6d\xbe\x13\x05\x20\x6c\xd3\x94\xb4\xd7\x46\x7e\x42\xe6\x9b\xb2\xa1\x11\xce\xff\xb3\xd1\x93\x5e\xa7\xa2\x36\x3a\x24\x82\xda\x31\xa0\xb0\xbd\xfa\xca\xd3\x3e\x99\x34\xb0\x16\x33\xf2\x9c\x1b\x19\xd2\x48\xe6\xf2\x81\x97\x11\x4d\x0e\x0c\xb1\xa3\x8d\x54\x8e\xb2\x2d\xbe\x20\x50\xb3\x7c\x26\x66\x84\x49\xd0\x29\x95\xc9\x55\x94\x2a\x99\xc8\x79\x3a\x63\x4f\x07\x59\x24\xbf\xb8\x0b\x58\xb0\x94\xb8\x2b\xbd\x29\x38\xe0\xe3\x09\x46\xa4\x0a\x37\xa1\x06\x42\xe4\x4d\xef\x1c\x0e\xd0\x0b\xd3\x95\x49\x50\xb4\x31\xd4\x14\x3a\x80\xcc\x0d\x44\xe4\x0d\x33\x7d\x48\xb1\x9c\x4c\x22\x1d\x6b\x31\xf8\x73\xc8\x07\x03\x92\x4d\xe5\x1c\xdc\x23\x9b\x6a\xc6\xca\x7b\xf9\xd0\x21\x99\x81\xc3\x52\x8f\xfc\xd2\xc7\x1e\x50\xaf\x2d\x06\xef\x0e\xa1\x07\x58\x02\x6b\x2c\x59\x18\x98\x5c\xb7\xbd\x3d\x5e\x81\x29\xdf\x92\x27\xca\x69\x6a\x8a\x19\x4d\xc7\xda\xf4\x5f\x5c\x93\x6b\x6f\x09\xb7\xac\x1d\x17\xc8\xee\xa4\xac\xb7\x7d\x80\x26\x73\xb0\x92\x6f\x59\xb9\x93\xb9\x3e\xc1\xd4\xd6\x66\xe6\xaa\x35\x3b\x4c\xae\x55\x18\xd0\x80\x8c\xdd\x89\x8c\xc8\xeb\x4e\x74\x4f\x1b\xb0\x96\x52\x75\xd0\x8c\x1f\x71\x5f\xbd\x92\x5e\xef\x02\x88\x97\xae\x9e\x57\x3d\xd0\xc1\xae\xf7\x25\x68\x3b\x14\x54\x32\x15\xf9\xbd\xf9\x6a\x82\xcc\x58\x2e\x0a\xd2\x82\xe9\x01\x29\x94\x5f\x07\x30\x53\x59\x67\xd1\x0c\xd1\xe4\x17\xb0\x20\xf7\x3b\xa1\x0a\x78\x6e\xc4\x18\x04\x21\xe1\x04\xb2\x11\x1b\x33\x8d\x75\x80\xd6\x6e\xf9\x30\x10\xb3\xaa\x23\xd3\x11\xc9\xa1\xa8\x77\xac\xe8\x66\x2c\x41\x17\xb2\xd2\x76\x68\x2f\xc1\x7a\x77\x63\xfc\xcb\x91\xcc\x1f\xd9\x9e\x14\x2b\xe4\x0f\x87\xd9\x1f\x0f\xb3\x7f\xb1\xd0\x0c\x4e\xe4\xda\x3e\xc3\x20\xb3\x81\x55\x91\x94\x59\xd7\x68\x03\xee\x23\xf7\x33\x98\xae\xa2\x28\xc5\x8e\x7f\xc4\x84\x4c\x1b\xc4\x7c\xe8\xf9\x23\x9a\x5f\xec\x35\x69\x28\xf6\x6e\x1b\x73\xb5\x0d\xb8\x09\x89\x88\x6d\xe8\x2a\x5b\x26\xd8\x22\x3b\xe2\x96\x11\x80\xcc\x9c\x8b\xcd\xf6\x4e\xe2\x1c\xe8\xd4\xb4\x7a\xe9\x6e\x6f\xf1\x82\xca\x56\x0f\xa6\xe9\x29\xd4\x53\x6d\xf1\xa7\x37\x37\x2b\x91\xf3\x5e\x45\x90\x6d\xa9\x71\xd5\xbb\x0f\x6a\x40\x1c\x6a\x10\x51\x1a\xd8\xfa\x3a\x55\xd5\xe3\xa0\x10\x44\x74\xd2\x24\xbb\x7e\x46\xc8\xd7\x99\x74\x2a\xef\xc4\x7d\x1a\xd0\xfb\x1a\xcc\x4f\xf2\xa4\x5b\xd2\x11\x62\xe6\x7d\x97\xe9\x4b\xe8\x10\x1e\x48\x69\xee\x2f\xf5\x46\x46\xb6\x61\x86\xbb\xa4\x57\xd5\xa4\x3b\xd2\x7b\xa9\x29\xeb\x81\x9e\x04\x3b\x5e\xe5\x9f\x26\xf9\x86\x97\x95\xcc\xb2\x40\x56\x55\x47\x6a\x9f\xf4\x7e\x54\x27\xc6\xa2\xb6\x46\x25\xdb\xf1\xf2\x93\xcc\x67\xa2\xe2\x15\x0d\x0c\x2a\xc7\x9b\xd8\x8f\x94\x33\x3b\x3c\x9d\x69\xb1\x88\xf2\x26\xca\x27\x65\xf1\x84\x74\xc7\x53\xd1\xbb\x15\x19\x17\x2c\xd5\x07\xf1\x87\x39\x33\xbd\x10\x16\x26\x5b\x9e\x36\x19\xc7\x73\x6f\x0d\x8b\xf8\xae\x90\x25\x6b\x5f\x69\x30\xa2\x14\x35\xec\x71\x81\xe1\x62\x22\x6b\x4a\xde\x3b\x8f\xea\x15\x86\x0d\xda\x5f\xbd\x50\x18\x6a\xc5\xa0\x51\x29\xa1\x8b\xe0\x57\xe4\x1b\xb3\x90\x40\xd6\x90\xd8\x2a\xb1\x50\x0f\x66\xd4\xdc\x71\x18\x41\x48\xe0\xea\xd2\xdc\x49\x91\xea\x0b\x62\x1b\x2a\x01\x42\x77\xe8\xc4\x4b\x12\x97\xa6\x80\x7f\x94\x8e\x36\x65\x86\x3f\x7b\x17\xe3\x79\x7c\x6c\x24\x74\x0f\x00\xda\x64\xc9\xf3\x1a\x8f\x07\x4d\x26\xdb\x87\x05\x67\x40\x79\x4d\xd7\xf5\x15\x21\x47\x4d\xf9\xc0\xea\xa6\xe4\xe3\x4c\x3e\x9a\x29\xdc\x94\x0f\xdc\xec\x3a\xf7\xb0\x92\xec\x93\xcc\x30\xc7\x90\x44\xf3\xd7\xbe\x1c\x06\x80\x15\x5e\x44\xa2\xc5\x6f\xfb\x24\x93\x14\xad\x66\xb4\xcf\xb4\x94\x40\x27\xf0\xcb\x31\x5d\xa2\xd4\xe1\x60\x15\x72\x2d\x97\x6d\x9a\xdd\x01\x9a\xba\x68\x3c\xd8\x00\x2b\x98\x57\xdb\x9a\x8b\x8e\x7c\xb8\x6c\x07\xdb\x6d\xf2\xfb\x60\xb9\xa4\x31\xe7\xb2\x6a\xcb\x53\xcb\x28\x77\xa2\x29\xa7\x66\x4c\xa9\xba\xa3\x03\x64\x34\xe5\x44\xbe\x92\x8c\x3a\x51\x15\x0e\x9e\xaf\x11\xc2\x5d\x9e\xa1\x91\x6c\x59\x51\x56\x4b\xa5\x13\x2a\x6d\x47\x0a\x32\xc8\x85\x9b\xc4\x54\x6e\x90\x3d\x30\xf9\x36\xb3\x60\xb0\xe5\xa3\x27\x50\x84\x01\x89\xb6\x05\xda\x00\x14\x93\x91\x48\xee\x2b\x7d\xf6\xeb\xb2\xe6\x8e\x27\x5b\xc1\x0d\x57\x63\xb9\xec\x41\x54\x47\x9f\xf2\x58\xc9\x7c\x6c\xb9\x8c\xdc\xf3\xb4\x09\x5c\xa9\x72\x7d\xcf\x1e\xd5\x69\x3e\x42\x0a\xd9\x37\x97\xed\x75\x33\xf6\xa6\x15\x7b\x8a\xdb\xee\xf2\x61\xd9\x88\xff\xd4\xe7\x80\x2e\x1a\xbb\xe0\x2f\x2c\x04\x2e\x4f\xc4\x8e\x65\x70\xcd\x58\xc9\x3f\xe4\xf2\x51\xeb\xdc\x54\x06\x66\x94\x1d\xcc\x20\x63\x8a\xe8\xa0\xe5\xf2\x94\xdf\x8b\x3c\xf5\x6a\x66\xe9\xcd\xa6\xb9\x76\x2a\x30\x1a\xd0\xc9\xf2\x0c\xc0\x1c\xa3\x99\x3c\x9d\x2a\xe8\x1c\x49\x33\xda\xcc\x11\xaf\xa0\xe1\xad\xb8\xba\x93\xab\xb6\x25\x9a\xdb\xd1\x79\x2d\xfb\x33\xd9\x31\x4b\xf8\x45\x29\xd2\xc3\x26\x8e\x05\xcf\xd2\x4b\x91\xd7\x87\x50\xd9\x7b\x4e\xe6\xf5\xa2\x94\x05\x2f\x6b\xa0\x72\xcf\x36\xd8\xcf\x21\x63\x59\x1e\xd6\x06\x34\xf8\x39\x04\xb1\xe4\x00\x0c\xcd\x43\x3e\xf5\x00\x4a\x0a\xa1\xcf\x5f\x38\xc9\x8b\xe6\x85\x76\x20\xdb\x7b\x50\x01\x2c\x50\x07\x80\x39\x10\x0f\x96\x19\x55\xf1\x16\xcc\x1f\x9f\x75\xea\x9c\xf7\x14\x52\x0f\x4b\x8c\x18\x4d\xe7\xcd\xd2\x6b\xb2\x28\xa2\x79\xde\xbc\x83\x3a\x30\xe3\x72\xdc\x5d\xf7\x1f\x8e\xf8\xd3\x0b\x8f\x02\xf4\x6b\x9d\xf1\xbc\x33\x81\xed\xaa\x45\x71\x00\x43\xf2\x89\x6f\xe1\x88\x68\x22\xe7\x9e\x89\xa6\x47\x59\xec\xd4\xb0\x2e\x39\xdb\x69\x9e\x96\xe0\xf4\x39\xcf\x0b\x34\xfd\xe3\x9b\x92\x73\x7d\xe9\x49\xf7\x08\xf0\x5c\x2b\x87\xe0\x53\xfe\x24\x12\xb9\xa1\x6d\x8c\x86\x69\x3a\x76\x5c\x24\x0e\xb5\x7f\x5d\x0e\xed\xcd\x38\x6d\x0a\xf5\x91\x06\x5d\xe8\x78\xbe\xb7\x01\x26\x30\x1d\xb0\xe9\xb4\x39\x7f\xd2\x41\xbc\xdb\xe5\x5e\xe7\xcd\x0c\x33\x37\x20\x1f\xa9\x1d\x1e\xec\x58\x96\x3d\xab\xfc\x4a\xd4\xdb\xc3\x4a\x85\xcc\xaf\xb4\x81\x49\x4b\x87\xbb\xd4\x38\x63\xd5\x16\x55\x73\x5b\x10\xd2\xb6\x83\xdb\xa1\xa6\x4e\xd1\xdb\xe5\x79\x65\xa4\x50\xb0\x2a\xeb\xfc\xa5\xa8\x6a\xe8\xa6\x9d\x01\x10\xb5\xe6\x05\x47\x55\xc5\x4e\x6b\x41\x9f\xfb\xd1\x05\x96\x09\x54\x1b\xc5\xdf\xee\x3c\xde\xe5\x68\x79\x01\x6c\x51\x97\xd4\xeb\xab\xab\x23\x2d\x4b\xda\xcb\x90\x74\xcf\xe5\xc0\x8f\x6a\x0e\xc2\x05\xda\x7e\xc1\x9a\xaa\x12\x2c\x6f\x89\xbd\x60\x89\x66\x45\xfa\x07\x4b\xae\x60\xa8\x84\xd3\x26\x4c\x1d\xc4\x87\xd1\x45\x6b\x5e\x9a\x4c\xd1\x98\x34\x89\x57\x28\xdd\x4d\x43\x52\x69\xa3\x33\x28\xca\x90\x97\x36\x9d\x69\x69\xbc\x60\x3b\x09\x8b\x04\x5d\xdb\x17\x27\xc0\x95\x57\x62\x27\x32\x56\x22\xee\x0a\x5c\x20\x59\xb9\x9f\x4a\x79\xdf\x14\x56\xb7\x82\xba\x3c\x03\xea\xdf\xe6\xf1\x10\xba\x97\x47\xf6\xe9\x30\x1b\xf0\xb4\x81\x0e\x6b\x81\x95\x75\x78\x4e\xdc\xcf\x1d\x3c\x6f\x00\x47\x35\xd4\x02\x59\x67\x3d\x38\x62\x23\x6a\xd2\xda\xe9\x92\xa8\xe8\xd2\xec\x08\xd2\x1d\x6c\x51\x96\x96\x59\x48\x7e\xb4\x5c\xb1\xe5\x69\xc9\xf4\x19\xa4\xf1\xb6\x64\xf5\x14\x4e\x5c\x51\xb2\x64\x24\x77\x77\x94\x32\xdf\x5f\xa2\x60\x09\x75\x42\xfb\x99\xaa\xcd\xe9\x05\xb8\x9f\xfb\xd8\x66\x5b\xa9\x62\xa7\xbe\xae\x53\x0f\xa6\x3e\x9c\x94\x26\x45\x8b\xbe\xc9\x7d\xec\x92\x21\x86\x19\x74\x45\x29\x92\x6d\xc6\x0f\x3e\xd5\x80\xcc\xbe\xb6\x85\x1c\x92\x23\x03\x9d\x76\xc9\x6e\x7f\xda\x82\x34\x53\xee\x8a\x0a\xe6\x54\x5f\x16\xa6\x99\x54\x57\x54\x49\x09\x04\x66\x2b\xca\xe2\xe7\x7e\x0d\x1a\xde\x35\x83\x00\xa6\x23\x29\x77\xc9\x52\xb6\x63\x65\xfa\xfc\xc1\x49\x9e\x02\x45\x02\x3a\xa0\x21\xd3\x8f\xed\xf6\xe9\x82\x09\x1c\x22\x53\x40\xea\x81\xcf\x0b\xf6\xac\x68\x72\xf9\x10\x1e\xb4\xe0\x50\x5a\x65\xa0\x44\x45\x7a\x99\xbf\xb8\x6d\x96\x34\xbf\xdb\x9c\x48\x12\x56\x8b\xc3\x4a\x49\x77\xc9\xe4\x7a\x82\x2f\x03\x5a\xe6\x02\xb7\xf9\x87\x83\x40\x65\x3d\x9a\xa5\x21\x7d\x21\xd9\x11\x8c\x3a\xfe\x79\x87\x3d\x93\xa0\x61\x81\x00\x6a\x8a\x78\x5f\xb5\xb2\x53\x57\x54\xf7\xf8\x03\x0c\x3e\x5c\x5b\x82\x50\x15\xa8\x64\xe1\xa2\x83\x44\x98\x07\xcf\x37\xac\xda\xca\xc2\x5c\x1d\x60\x11\x78\x51\x99\xbc\x97\xa7\x1d\x66\x00\x60\x9c\x35\xd5\x16\x37\x3a\x47\x70\xdd\x44\x4c\x1f\x55\x7e\x78\x27\xc9\x7a\x74\x26\xe4\x28\x09\x3d\xbc\xc3\xf0\xe1\xda\x00\xa4\xdd\x58\x6a\x00\xac\x55\xfd\x74\xc0\xd7\xfd\xac\xe9\x7d\xcb\x6c\x56\xfa\xcd\x41\xc0\x41\x9f\xea\xb3\xc8\x5e\x32\xed\xa7\x5b\x71\x6c\x07\x3b\xe0\x76\x7a\xe3\x4f\xea\x34\x7d\x88\x3e\xa6\xeb\x83\xc6\xa2\xc6\x2d\x7c\x1f\xd6\x7a\x14\xeb\xdb\x1a\xbc\x78\x03\xe0\x5f\xbd\xe5\xba\x7b\x1e\xe0\x1f\xc5\x6b\x48\x55\x1f\x44\x6a\x2e\xc3\xbd\x4e\xc0\x1a\x2c\x1e\x44\x25\xa8\x43\x1e\x44\x25\x0d\x48\x96\xa1\xd8\xec\x58\x9b\x01\x3a\x3b\x0b\x69\x23\x35\x0b\xcd\x18\x48\xcb\x95\xc9\x3d\x4f\x49\x25\xc2\xc8\x11\xba\x25\x4b\x12\x42\x22\xe3\x5f\x59\xae\x1f\x76\x8c\x9f\xe5\x4a\xfc\x37\xdb\x42\x59\xd3\x11\x98\x2b\x6b\xec\x64\xdc\x4b\x1f\x6a\xde\x11\xec\x58\xd4\x43\x50\x57\x3e\xe6\x64\xde\x41\xf9\x29\x5f\xd7\xc7\x79\xd4\x3c\x3d\x06\x46\xc0\xd7\x51\x4e\xe6\x9b\x67\x8f\x69\xd8\xf3\x47\x65\xbe\x79\x06\x7c\x19\xd0\xbd\x61\x59\xf4\x0b\x97\xc5\x71\xa3\x3f\xc1\x1e\x24\x61\x59\xfb\xb1\x7b\xbd\x86\x40\x05\x8f\xb9\xd5\xbf\x5f\xa7\xe8\x56\x9d\xe9\xea\x7f\xcc\xdb\x56\x93\x9b\xab\x16\x16\x71\x7e\x04\x79\x31\x6b\xea\x35\x5f\xd0\xbb\xe9\xb8\xd6\x5e\xde\x3c\x55\xb1\x5d\x91\x51\xa3\xe9\xeb\xf1\xda\x35\xce\xf0\xe9\x25\xdb\x38\x79\xea\x96\xb2\x80\xf4\xa3\x5e\x5c\x4b\xf6\x88\xda\xfa\xb0\xa3\xa3\xec\xa5\xd8\x6c\x33\x78\x0d\x60\x06\xde\xad\x09\x72\x6d\xb9\x0d\xcb\x60\x4f\xc5\xca\x45\x89\x9c\x22\xda\xe5\x00\x94\x34\x07\x2a\x92\x99\xb9\xcd\xae\xb8\x00\x22\xd2\xec\x0a\x3c\x80\x6f\xb9\xe0\x71\xc9\xf9\x47\x4b\xab\x63\x99\x2b\x12\xcb\x36\xd9\x6e\x7d\xb4\xce\x56\xab\x43\x05\xa4\x5f\x02\xdb\x46\x05\x53\x99\x68\xd6\x82\xf2\x33\x72\xa7\x71\x90\xeb\xd5\xdc\x02\x8e\xea\x27\xf8\x82\x95\xc8\x1d\xf6\x61\xa6\xdf\x08\x46\x0c\x43\x97\xa6\x20\xdd\x1a\x10\xf0\x56\x1c\x42\x00\x7d\x10\x6e\xb2\xe8\x70\xa8\x97\xbf\x2a\x19\xba\x49\x38\xcc\xf6\x5a\xdb\x41\x4c\x73\x3d\xcb\x4b\x12\xd8\x0c\x89\x44\xd4\xfb\xde\xb6\x08\xc6\xd1\x49\x53\xbc\xbe\xac\xc0\x0f\x25\x23\x56\xb0\xa4\x97\xc1\x45\x02\xd3\x28\x27\xa0\x14\x1e\xee\x89\x87\xf6\x36\x59\xd5\x3a\x01\x0c\x1e\xa5\x1e\x80\xf4\x41\xd2\xc8\x96\x30\x4d\x9b\x26\x4a\xa2\x34\x10\x92\xed\x3b\x50\x5a\x86\xa9\x7f\x48\x91\xb7\x60\xdc\x5c\x9b\x2a\xf4\x01\x53\x9b\xa4\xad\x26\x66\x85\x6e\x86\xdf\xfb\x04\x6a\x43\x7b\xd4\xd1\x2e\x2c\x08\xc5\x65\x0d\x52\xe1\x96\x15\xfc\xa0\xac\xab\xb6\xb3\x5a\x86\xdc\x15\xa9\x97\x7a\xa9\xa0\x6d\x20\x24\xb4\xe2\xa8\xd1\x69\x00\x90\xd1\x3a\x3b\x00\xb6\xa2\x38\x0f\xa3\xb2\x8b\x07\x92\xea\xc2\xda\xe2\x89\x0d\xcf\x2b\x9c\x1b\x94\x7e\x20\xa4\xa2\x34\x4e\xe3\xfe\x40\x75\xd0\xca\x32\x12\x97\xbe\xd2\x96\x97\x21\xd7\x01\x5d\xad\x53\x42\xe6\xb4\x61\xf0\xb2\x4c\x14\xb5\x48\xbc\x2e\xf5\x54\xf4\xd3\x64\x2b\x6c\x00\xe3\x2e\xd5\x53\xbe\x32\xb0\x0f\x6d\x6a\x2a\x37\x6d\x7a\x2e\x77\xd0\xef\x3a\xb7\x10\x6d\x92\x76\x13\xe9\x25\xcb\xd6\x0b\x5e\x0a\x99\x56\x6d\x51\xb4\xe5\xd8\xee\x5e\x4e\x37\x64\x27\xc8\x33\x9f\xe5\xed\x8a\x2d\xab\xc4\x6f\x3c\xdc\xe7\x35\x7b\x42\xdf\xd8\x15\x40\x05\x09\x47\xfb\x7c\x9d\xb7\x2b\xa0\x9f\xe0\x57\x6f\x00\xbc\xfc\x2b\xfc\x33\x15\xa4\x16\x2a\xd8\x71\xcb\x83\x37\xe5\x29\xce\x94\x3c\x3d\x30\x59\xf2\xf2\xf4\xeb\x06\x4b\x5e\x9e\xfa\x6b\xdc\xb4\x63\x0a\xa8\x1f\xa5\xf4\xb2\xac\xd9\x32\xb6\x01\xf0\x46\xe4\x9c\xf0\x11\x71\x1c\xf5\x0c\xf1\xb7\x3b\xf1\x6f\xab\xad\x79\x09\x0b\x74\x97\x2f\x65\xb1\x37\x57\xbd\x15\xf3\xf2\x07\x51\x4a\x3a\xe3\xd4\xfa\xa6\xb4\x70\xe3\xaf\xd6\x66\xa1\x4c\x20\x1f\x75\x2a\x12\x19\x7c\x2f\x7a\xa5\x4a\x23\x09\x29\x91\x89\xbb\x52\x34\x3b\x4c\x97\xa2\x28\x32\xbd\x13\x27\x6d\x2a\x04\x3f\xb0\x0c\x5f\x53\xae\xe1\x3f\x81\x1f\x61\xfd\xff\x84\xbd\xdb\x77\xdb\xb8\x92\x37\xfa\x7e\xfe\x0a\x9c\x1c\xf7\xda\x97\x2f\xb3\xbb\xe3\x9e\x6f\xd6\x9a\x3e\x6b\x1e\x92\x38\x8e\xd3\xb1\x1d\xdb\x52\x3a\x7b\x7a\x67\x1f\x2d\x88\x84\x44\xb4\x40\x80\x01\x40\xd9\xca\x9e\xfe\xdf\xcf\xaa\x0b\x2e\xa4\x94\xfe\x1e\x44\xfc\xaa\x40\xf1\x02\xe2\x52\x28\x14\xaa\xde\x78\x23\xed\xf6\x15\xa7\xaf\x39\x9d\x7e\x12\xef\x02\xa5\x9e\x36\xf6\x26\x90\x7b\x2e\xa0\x71\x33\x39\xa2\xf4\xc2\xf8\x99\x79\x36\x9d\x5b\x13\x09\xc5\x33\xd3\xcd\xcc\x4d\x33\x8b\xd9\x6c\x25\x93\x79\xb2\x22\xde\x8c\x8d\xd1\xad\x92\x45\x4d\x8e\xbe\xca\xdf\x50\xf2\x56\xf6\xbd\x24\xa8\x79\x83\xc7\x3d\x91\x77\x9d\x16\x3c\xe2\x50\x37\xc0\xfb\x3a\x33\x68\x33\x4a\xef\x91\xc7\x27\x34\x72\xae\xc8\x6c\x24\xc3\xab\xfa\x25\x2b\x1b\x52\x16\xd6\x0d\xd6\xd1\x8a\xb4\x1a\xdf\x23\x73\xb2\x9c\x57\x58\x6c\x40\x5b\x31\xa8\x27\xc8\xeb\x70\x19\xe1\x80\x25\xde\xec\x95\xbd\x17\xe4\x60\x15\x7b\x13\x40\xf5\xd9\xca\xc6\x2b\xb4\x49\x9f\x12\x4b\xb9\x25\x3a\xf5\xd1\x4f\xb2\x89\xb3\xbd\x41\xc8\x23\x43\xd8\x7b\x22\x1e\x9c\x8b\xef\x82\xe3\xf9\xff\x9b\x27\x94\x52\xe8\xbe\x4f\x8d\x1a\x22\x24\x66\x6c\x55\x0b\x6d\x25\x24\xea\x0e\x3b\x0f\xc0\xa4\x2c\x28\x90\x7b\xee\x27\xdc\xbe\xf5\xe6\x49\x47\x3c\xb0\x8e\x07\xfb\x3a\x32\x33\xa2\xe4\x25\x7c\x0b\x44\xb5\xe2\x8b\x38\xd9\x66\x83\xc8\xdb\xb1\x57\x3e\x65\xab\x26\xa6\x07\xce\xf8\x0d\x13\x69\x3f\xc0\x9b\xa7\x01\x6b\xd0\xb4\x92\x3e\x0d\x49\x4a\x7e\x33\x21\x34\x50\xa8\xe1\xcb\xa0\x8c\x48\xcc\x98\xef\x3f\xab\xf8\x97\xb2\xd7\xe6\x50\x73\x4e\xac\x90\x55\xb9\x37\xe8\x43\xef\xe5\x5e\x79\xec\x92\x4a\xc6\x9d\x7b\x9c\xed\x29\x4a\x99\x79\xb7\x68\x62\xc0\xbc\x13\x09\x1f\x39\x79\x39\x46\xc7\xc6\x7f\x5c\x73\x91\x9d\xda\x00\x12\xa9\x43\x2c\xc6\x4d\x05\x52\xdb\x38\xea\x04\x9f\x86\xa5\x5b\x7a\x0d\x7f\x8a\x30\x94\xb7\x6f\x5f\x5f\x10\xe6\xff\x47\xa8\xa1\x33\x5b\x64\xe2\x92\x6b\x88\xc0\x14\xda\xf0\x24\xed\xf3\x6b\x89\x37\x23\x82\xbd\x98\xce\xcc\xaf\xdf\x3c\x45\xe0\xa4\x34\xad\xdf\x02\xa9\x7a\x92\xfc\x26\x45\x05\xd2\x31\xf6\xe9\x79\xe1\x43\xcc\x96\x40\x78\xfd\x8d\x92\x64\x31\xc7\xa4\x96\xa6\xa0\xf3\x02\xd9\xe2\x7c\x46\x9f\xf8\xbe\xf9\x0c\xfc\x88\x4c\xb2\x8b\x0f\xa6\x50\x4a\x22\x58\x79\x3c\x98\x33\xaa\xb3\x96\xb8\x4f\xad\xc2\x9c\xa7\x8d\xe0\xe5\xe1\x59\x09\x98\xa0\xc4\xe5\xc4\xef\xc0\xe5\x9b\x6f\xab\xaf\x2e\x95\x6a\xd7\xb2\xd9\x2d\x68\xee\x32\x25\xb9\xc8\x98\x87\x4b\x0f\x97\x6f\xbe\x39\x92\x5f\xea\xb5\xb3\xb2\x69\xb4\xc8\x0b\x4c\x62\xb6\xd4\x84\xe4\x8d\x0c\x3b\xd5\x12\xc6\x0a\x81\xc3\x36\x1c\x5e\xc9\x40\x2d\x1e\x89\xbc\xe7\xe7\x92\x4c\x5d\x89\x4d\x3d\xcb\x3d\xe3\x54\x05\x81\xe2\x39\x34\xc0\x2b\x19\x3a\x04\xb5\xe9\x4f\xee\x4f\x12\x20\x7d\x78\xa6\xa6\x63\x5c\x66\xc3\xd4\x2a\x11\x20\x17\x67\xa2\x9c\xc3\x36\xb3\x99\x1c\x8c\x8e\x99\x5a\xca\x1d\xdd\x92\x2c\xe8\x00\x51\x49\x6a\x63\x54\x4b\xdb\xd6\x2a\x0c\x43\x33\x90\x50\xfd\x39\x4d\x65\x87\x44\x51\xc5\x90\x68\x40\x52\xf4\xa5\xb6\xd2\x36\x5a\x9a\x57\xce\xb6\x85\xc2\x7e\xbc\x50\x45\xd5\x9f\x79\x45\xcb\x78\xa9\xe9\x9f\x2d\x3b\xb4\x48\x10\xdf\xd8\xb6\xaf\x8d\xfe\x32\x2a\x86\x68\x9a\x1d\x88\x80\x87\x46\xcb\x27\xa0\x4e\xea\x60\x02\x67\xed\xc9\xd7\x35\x52\x79\x96\x52\xa8\x31\x12\xe6\xf1\x1e\xd7\xea\x91\x43\x73\x5e\x44\x3a\xb1\x34\x9d\x7c\xaa\x11\x22\xdb\xe5\x79\x27\xd3\xe4\x25\xa2\x2e\x3d\x4b\xca\xe9\x64\xca\xab\xf9\x1e\xc8\x7c\x17\x5c\xef\xfc\xd0\xe9\x50\x9d\x89\x9e\x76\xf3\x35\xaf\xa0\xc7\x8f\xce\x4e\x1e\x15\xa6\x50\xbc\x0e\x83\xd3\x18\x15\xe7\xec\x5f\x94\x8f\xea\xa9\x64\xb0\xdc\x43\x04\xf6\x14\xb7\xa3\x31\xac\x4a\x00\xee\xfb\xd7\x46\xda\x84\xf2\x37\x78\xff\xda\x8c\x6b\x42\x77\x46\xd1\xd7\xba\xd6\x6b\xd4\xfa\x11\x86\xe9\xff\x83\x6a\xd0\x86\x82\x6f\x40\x5d\x87\xb6\xed\x8d\x7c\xc2\x65\x82\x0a\x5f\x1a\xf7\x98\x68\x1a\x3f\x91\xe0\xf5\x84\x0a\xc3\x74\xb3\x9c\xcc\x3c\xfe\x76\x37\xda\x96\xff\xde\x29\xdf\x8f\xb1\x7c\x84\x3b\x17\x62\x2f\xed\xd2\x8d\xf4\x62\xdc\x3d\xcd\xaa\x09\xae\x83\x00\x48\xfb\x93\x27\x1f\xb6\x6a\x9e\xb6\x5d\x74\xce\x47\x15\x62\xae\x7d\x89\x91\x6f\xb1\xec\xbc\x0a\x9d\x33\x54\xb1\xa9\xe8\x4b\xad\x63\x9a\x9e\x1d\x9f\x59\x87\x2e\x29\x3d\x2e\x51\xc7\x54\xfb\x00\x4b\x9d\x91\xc5\x1d\xe3\xa7\x18\xdc\xdc\x3c\x96\xb2\x87\x07\x43\x43\xda\xe5\x7c\x43\xff\xa5\x0e\x4d\xc7\x4e\x32\x2e\xf5\xf9\xf9\x9c\xf1\xe3\x9c\xf1\xef\x34\x19\xbb\xd4\xa1\x53\xfe\xea\x30\x28\xbf\x4d\x35\x7a\x7e\xe1\x4e\x79\xd4\xd6\xa3\xce\x94\xe8\x5f\x67\xe7\x00\x3f\x82\xe4\x75\xa9\x63\x54\x2d\xcd\x29\xd1\x97\x0b\x6d\xfc\x2d\x90\x2a\x8c\x91\xa1\x23\x7b\x5c\xfc\xbf\x91\xc8\x43\xb7\x33\x9c\xbe\x24\xce\xd2\x0d\x2c\x67\x5e\x1a\x3d\xfc\xa2\x15\x00\x07\xf5\xd8\x8c\xa1\xc3\xfe\x8f\xd6\x94\xd3\x88\x81\x5f\xc6\x19\xae\x98\xce\xd2\x81\x74\x1d\x97\xa8\xed\x42\xa9\x0a\x21\x36\x5a\x67\x23\x75\xe1\xce\xc6\xa4\x67\x40\x63\x85\x6c\xd5\x98\xb3\x2b\xfb\x05\x20\x1f\x94\x4a\x52\x05\x90\x34\xf0\x00\x40\xb3\x26\x44\x95\xdb\x11\xfa\xcf\xd2\xcb\x66\x97\xfe\x81\x5a\xf2\x92\xc7\x9a\x88\x4b\x7c\x52\xdc\xaa\x93\xc6\x1f\x4c\xb8\x5b\x2e\x6b\xf6\x05\x82\x90\x96\x76\x8b\x10\x97\x55\x58\x40\x60\xf7\x4f\x69\x9a\x98\x5d\x3a\x1f\xbd\xb4\x5c\x00\x1e\x9d\xa1\x70\x9a\x9d\xa3\x5c\xba\xd1\x6b\xa8\xd6\x94\xd6\xdb\x62\x32\x2b\x9c\xe6\xb2\x09\x7e\x61\x54\x7d\x24\xf1\x2e\x5e\x2f\x2b\xc8\x73\xde\xc2\xe0\xc5\x93\xc4\x58\x2c\x2b\x38\xcd\x9b\x52\x75\xc3\x27\x4e\x6a\xf2\x47\x4f\x30\x7d\xc6\x85\xb6\x27\x5e\x65\xa1\xed\xd1\x69\x47\x17\x3a\xc1\xd0\xdb\xf4\x37\xd6\x28\x48\x93\x76\xa1\x53\x28\xa5\x2c\x46\xe5\x7c\x18\x08\x32\x89\x5f\xac\xe0\xfc\xd5\x98\x85\x9a\x08\xda\x39\x8a\x47\x3e\xbd\x57\xd3\x73\x7b\x90\x88\x20\x79\x67\x83\x8a\xa2\xec\x1d\x25\x68\xf8\x01\xca\x06\xd2\xca\xc0\xa6\x58\xd6\x88\xb9\x91\xcd\x25\xf6\x05\xd3\xf6\xef\x55\xd3\xcd\x16\x43\x49\xdf\x7b\xe9\xa9\xfc\x0f\x0b\x98\x04\xc2\x98\x5a\x2b\x1f\x2e\xbd\x0a\x56\x99\xd7\x09\x2c\xc4\xa5\xd7\xad\x3c\x88\x4b\xef\xd6\xca\xea\x31\xf0\x06\xcb\x4c\x93\x3e\xfa\xd2\xbb\xbe\x38\x81\x87\xf9\x33\x72\xe6\x1b\xaf\x88\x39\x37\xc3\x07\x2e\x89\x7e\x00\xf4\x56\x47\x3a\xf3\xe2\x06\x6e\x8f\x1d\x06\xab\x84\x8e\xf7\x0e\xa7\x9c\xc9\x3e\xde\xcc\x64\x97\xba\x89\xe6\x49\x7a\x22\x1f\x54\xa0\x1d\xea\x73\x06\xcf\x9e\x12\x1b\x97\xa1\xa9\x2f\x61\xce\xd2\xed\x94\x9d\x52\xf3\x7b\x61\x73\xa7\xdd\x7b\x99\x45\xab\x8d\x22\xeb\xf8\xb9\x0f\x4c\x64\xee\xeb\x46\xe8\x68\x46\x63\xd0\x3b\x13\x80\xca\x22\x07\x48\xea\x25\x46\x63\xb2\xd5\x08\x10\xb9\xa2\x8d\xc6\x2c\x34\x7c\xdc\x0d\x5c\x2b\x0d\xac\x0c\x78\x66\x9e\x48\x54\xb2\x0d\x49\x33\x90\x37\xaa\xa1\x42\xfd\x72\x0c\x41\x81\x98\x12\x94\x39\x54\x26\xa8\x6f\xe5\x3a\x1b\x28\x20\xe6\x26\x8f\x38\xd9\x87\xbc\x95\x3a\x3b\x0e\x00\x7c\xd7\xc9\xa0\x0a\xa3\xef\xa5\x38\x9e\xb8\x23\xa7\xf6\x82\xf3\x56\x0e\x77\xca\x4a\x13\x0f\xe2\xad\x8c\x1d\xde\x10\x92\x57\x40\x8f\x5b\x05\xc5\x36\x9f\x93\xe6\x0c\x6a\x1d\x44\x42\x93\x39\x7d\x22\x36\x31\xf8\xb8\x15\x59\xfe\xc8\x6a\x16\xc4\x69\x9a\x8b\x44\x3e\x07\xad\x62\x72\x69\x10\xc9\x42\x5e\xc8\x8c\x5c\x42\x6c\x44\x43\x03\x28\xcc\xb0\xdf\xaa\xad\xb2\x6b\x39\x2a\xff\x5a\x90\xa0\x6b\x52\x0a\x25\x40\x22\x5e\xb6\x7a\x4e\x8e\xcc\xf2\x56\xa5\x90\x59\xb8\x16\x29\x2a\x4f\x67\xb9\xe3\x3d\x21\x3f\x23\x4b\x37\x29\xfd\xa6\xfd\xeb\x5b\x65\x5d\x4f\x9a\x22\x82\x6c\x32\xf3\x56\xb9\x56\x05\xdd\xa4\x5d\x9e\x89\xce\x36\x26\x89\x91\x34\x92\x89\xfe\x30\x28\xcb\x7f\xa8\x82\x79\x70\xf6\x81\x6f\xe4\x8a\xe1\x08\x12\x2c\x3e\xbf\x55\x0e\x26\xf9\x77\xe5\xe1\x58\x3c\x3a\xdd\x95\xbf\x3d\x29\x3d\x4d\xbd\xf2\x4d\xa8\xf4\x11\x8f\xe6\x11\x72\xfa\xcf\x29\x9f\xfc\x84\xfc\x51\x66\x6a\x98\xdf\x38\xe7\x0f\xfe\x3e\xfd\x73\xfd\xe2\x09\xbf\xb9\xfd\x58\x93\x7f\xff\xef\x5f\x91\xf4\xee\x37\x2a\x40\x2e\xd1\x10\x47\xaf\x92\x3e\x71\x4a\x2e\x25\x7c\x0d\xa8\x5a\xb1\xda\xa2\x55\x99\x1f\xbc\x45\x33\x1b\xdc\x91\xf5\x56\xc5\x5a\x0d\xfe\x56\xc5\x3c\xdf\x06\x9c\xba\x59\x7a\x64\xb8\x75\xb9\x04\x54\x64\x8c\x58\x50\xcd\xd6\x4b\xee\x8d\xb2\x63\x28\xe4\x9d\xdc\xaa\x57\xdf\x38\xd9\x48\x94\x77\x05\x47\x6a\xaa\x76\x10\x17\x69\x81\xf2\xee\xbc\xca\x36\x59\xc4\x59\xf0\xa8\xf1\x16\xa6\x36\x6f\x9d\x69\x95\x25\xab\x97\xb7\xae\x07\x39\xf2\xeb\x8d\xdc\xa9\x4e\x4e\xed\x57\xde\x3a\xd7\xf6\xd2\xbe\xf7\x63\xd8\x49\xc3\x5d\xd7\x31\x0f\x65\xf1\xb7\x2e\x3a\xf1\xd6\xcb\x16\x0f\xf4\x2c\x0c\x52\xed\x62\xf2\x83\x87\x23\xed\x34\xc9\x39\x43\x47\xc7\x97\x21\x40\x67\xcb\xeb\x7f\x34\x83\x25\x4f\x09\x69\x36\x9b\x36\x8b\x92\x09\x26\x7e\x64\x44\x64\x2c\xc8\x84\xa6\x1e\x20\x51\xd9\x4e\x3c\xd9\x6d\xfe\x06\xe2\xff\x47\x8b\x2f\x99\x4c\x39\x65\x7d\x02\x52\xa9\xef\x02\x5e\xbd\x00\x46\x8c\x7e\xad\x68\x9f\x1f\x92\x79\xbd\x7a\x46\x72\x6f\x89\xbc\x71\x2d\xf2\xb8\x95\xc0\x8f\x17\x15\xc4\x06\x51\x53\xb9\x0d\x30\x93\xf6\x23\x26\x2a\x7b\x2d\xc9\x8c\xea\xff\x27\xfe\x4d\xa3\x6e\x45\x8d\xbd\xad\x48\xda\x38\x3b\xa3\xa7\x0f\x55\x33\x8f\xaf\x8e\x79\x27\x4e\x3f\x79\x32\xdb\x96\x26\x46\x29\x58\xdd\x04\xe8\xec\x26\x44\x7d\x51\xb2\xe9\x9b\x50\xd3\x87\x2c\xac\xf9\x5d\xc9\x6f\xe3\xfc\xd4\x6f\x9d\x98\xbf\xad\x6e\x42\xfe\x9e\xd3\x32\x7c\x80\xd6\xc4\x38\xb9\x4c\xcc\x74\xf9\xf2\x28\x6b\x84\xd4\xb1\x03\x87\x7d\x55\x10\xd6\x76\xf7\x86\x5b\x70\xc3\x5f\xf7\x4e\x79\x3d\x74\xca\x27\xd2\xb8\x58\x50\xaa\x33\xa4\x87\x25\x48\xd3\xc1\xa9\xe7\x1c\x5e\x53\xc2\x84\xc2\x94\x32\x56\x8d\x1e\x3c\x46\x47\x65\x86\x4b\x97\xaf\x1e\x3a\x37\x0f\x3c\xe9\x40\x16\xaa\x6f\xbd\x92\x91\x3d\x41\x94\x36\xa3\x24\x35\x36\x4c\x69\x6d\xb0\x26\xae\x71\x50\x22\x06\xc8\x68\x93\x33\x66\xff\xae\xcf\xc5\xc9\xeb\xe4\x64\x5a\x55\x7c\xeb\x95\x82\x27\xc3\x4a\xa2\xdb\xaa\x19\xa4\x9a\x82\x69\xd9\x99\xc9\x8c\xca\x55\x27\x73\xb2\x51\x14\xd3\x34\x93\x61\xe2\x5d\x54\x3d\xc9\x47\x15\xcd\xe5\x83\x8c\x52\x6b\x90\xcc\x9b\xf8\x81\xc6\x4d\x4f\xba\x5a\xb8\x07\xe6\x6c\x1b\x03\xb0\x92\x53\x9f\xda\x55\x29\xf0\xcb\x93\x4c\xe6\x45\xc0\x48\xdd\x25\xef\x2c\x10\xb3\x3d\x06\x6f\xbd\x53\x6b\xab\x3c\xf9\x65\xc2\x9a\x4c\xd3\x03\x28\x27\xa2\xd3\x6a\xff\x57\x2c\x77\x37\x0e\x49\x40\xf4\xae\xff\xe4\x7c\x3b\x61\x96\xc1\xb7\x62\xde\x4f\xa8\x30\xa1\x96\xae\x5c\x83\x25\x30\xe7\xf9\x94\xa9\x63\x58\xf2\x47\x87\x19\x1f\xfc\x5a\xa7\xeb\xd0\x0a\x22\xc2\x3c\x1e\x52\x5c\x2c\x62\x2e\x14\x6e\xc2\x5f\x44\xdc\x52\x9b\x5f\xe2\xdb\xf4\xeb\x4e\xc2\x7f\xc7\x56\xf9\x5e\x5a\x10\x98\xc4\x5b\x98\xcd\x4d\xd7\xbb\xae\xa4\xcc\x42\x7c\x32\x5c\xe5\x21\xe0\x4a\x9a\xcd\xed\xb1\xf3\xc5\x4a\x49\xca\x6d\xed\x8a\xac\x91\x72\xdb\x60\x9a\xc5\xde\x2b\x69\x77\xca\x5c\xbd\x48\xe0\x9c\x41\xbe\x8b\xb5\xbc\xd1\xbf\xfc\xa1\x40\x2f\x5b\x79\xeb\x7c\x74\xa4\xb0\xbb\xba\x45\x9e\x67\x6f\x58\x57\xf5\x8e\xe5\x9a\xe0\x41\x36\xb1\x78\x1a\x8b\x6b\x08\x70\xa0\x6f\x70\x25\x71\xe7\xad\x05\xf4\x55\xfa\xe2\x9e\xe1\x4a\xc9\x16\x0f\xc9\xcd\xc1\x15\xba\x4c\xbe\x52\x72\xaf\x83\x6e\x15\x7b\xfb\xca\xf4\x9d\x2e\x98\xcc\x2e\xae\x94\x69\xe9\x81\x15\x62\x54\x2f\x5c\x29\x33\xb0\xdf\x3b\x16\xac\x2b\x4e\x5e\x65\xae\x78\xb9\x1d\x5d\x29\xdf\xeb\x38\xdb\x33\xcd\xcc\x2b\x06\x79\xde\x71\x2f\xae\x54\x08\xca\xae\x95\xdf\xce\xff\x81\xf3\x11\x71\xa5\x9e\x64\x4b\xfb\xa7\x8a\x95\x35\x30\x3b\xd5\x7a\x67\x2b\x08\x3d\xcb\x84\x4a\xcd\xf5\x4a\xb7\xad\xb2\xc9\x97\x5e\x1e\x29\xf8\xb3\x24\x92\x36\x03\x02\x59\x79\xf8\xbd\xd2\xdb\x5e\xda\x85\xee\x69\xc4\xb9\x5a\x88\x2b\x8d\xde\x7d\x73\x3e\x52\xa9\x7e\xe4\xad\x11\x19\xfd\x78\x51\xf0\xb4\x6a\x26\x2e\x2a\x1f\x33\x55\x34\x44\xc7\xac\xe9\x8c\xf8\x4a\xc7\x1b\x1d\x2a\x75\xd9\xd5\xbb\xe5\xa2\x32\x13\xba\x72\xed\x56\x5d\x40\xdf\x7c\x05\xf2\x27\x48\x42\x17\x15\x44\xa1\xf0\xca\x99\x16\x0f\x2f\x8d\x49\x69\x5e\x95\x04\x7a\x42\x90\x6a\x19\x11\xde\xcf\x41\x5d\xa1\x0d\x7a\x80\x1f\xf8\x8a\xba\x95\x87\xe4\xf1\x4e\x5c\xc1\x24\x2d\x1b\xd6\x03\x75\x87\xc5\x9c\x9d\x88\x55\x90\xaf\x9a\x48\x72\xfa\x33\x77\x37\x96\x7b\xbb\x2b\xda\x4d\x4d\xff\x89\x8a\x56\xa7\x4e\x78\x57\xba\x72\x87\xa9\xb6\xe9\x6a\x79\x73\x8d\xf6\x86\x57\x23\xfc\x7a\xb4\xf9\x84\x14\x09\x1a\xce\xae\x46\xff\xa8\xe3\xd7\x6b\xe5\x9b\xee\xae\xd3\x89\xfe\x15\xdb\xcb\x61\x50\x7e\xed\xcc\x6c\xfa\x86\xec\x66\x5c\xf3\x06\x50\x24\xd5\x37\x56\xee\x31\x73\x93\x57\x7d\xa7\x2a\xf5\x1f\x2e\x5f\x1c\x73\x6a\xd5\xc3\x34\xf3\xc5\xd1\xe9\x2f\xfe\xe8\xf4\xf3\xa3\xd3\xcf\xff\xe8\xf4\xe3\xc7\xce\x59\x77\x97\xf7\xc7\x9c\x6f\x5f\xe9\x23\xd1\x46\xdb\x5d\x41\x47\xa3\xf0\xd5\x61\xe8\x14\x4f\xbd\x2b\x9c\x9b\xf2\x61\x70\x7f\x50\xa8\x1c\x48\x01\xea\x36\xca\xab\xef\xc4\xbb\x16\xce\x8c\x87\x0c\xb8\xa9\xbe\xdb\x88\x77\x5b\xeb\x3c\xee\xd2\x12\xef\x7a\x41\xcd\x1f\x8f\x3f\x5e\xa4\x74\x61\x34\x4c\xd1\x90\xaa\x42\x91\x10\xdd\xb6\x09\xfc\x36\x86\xc8\x18\x44\x1a\x86\x18\x0f\x80\x60\xe5\xbf\x9e\x19\x41\xf5\xd0\xad\x23\x45\xba\xb8\x02\x7f\x91\x46\xb7\x89\x1e\x60\x42\xcc\x04\x45\x93\xe0\xe7\x41\x0f\x5b\x84\x8a\xef\x23\xa6\x69\x24\x60\x82\x37\x80\x30\xe5\x9a\xbc\x26\xc7\x1c\x68\x46\x21\x13\xe8\x50\x40\x55\x64\x18\x1c\x4e\xbd\x29\x38\x26\x67\x78\xc7\xf7\xa6\x62\x4e\x88\x67\xc2\x44\xab\x66\x72\xeb\x0b\xd5\xbb\x20\x75\xc3\x54\x99\xf3\x31\x9d\x77\xfe\x30\xcd\x03\x34\x52\x64\x32\x49\xf8\x52\xe1\x3e\x6c\x5c\x1c\x61\x8e\x36\x75\x69\xe3\x62\x3c\x75\xcf\x99\xc6\xbd\x66\x89\x2a\x39\xce\xa3\xa6\xa7\xf6\xf1\x95\x32\x1e\xeb\x5d\x31\x54\x1f\x31\xab\xf4\xf1\x48\xbe\x57\x87\xa1\x2a\x18\x14\xc2\x13\x46\xe1\x0f\x61\x92\x0e\x13\xb1\xcb\x25\x3e\x71\x61\x44\x1c\x92\xc0\xf8\x65\x38\x22\x07\x6f\xe0\x68\x33\xc0\x89\x2d\x13\x69\x59\x98\x48\x34\xad\xac\x9e\xf0\x4e\x79\xac\x7e\x7a\xaf\x4e\xbd\xcf\x3d\x25\xb8\x9d\xb9\xaa\x88\x0f\x6a\x63\x72\x91\x93\x97\xa9\x84\x03\x88\xdc\x09\x3b\x33\x96\x6b\xa1\x03\x5f\x55\x63\x7e\x60\xf4\x45\x5b\x30\x9f\xbe\xc8\x17\x02\xc4\xf6\x6f\x99\xae\x1e\x06\xc8\x24\x98\xe6\x92\x03\xe6\x83\x7c\x64\x62\x5c\x93\xa9\x0e\x52\x68\xf3\x40\xe8\xc4\x1b\x2f\xbd\xe6\xa2\xc1\x95\x31\x44\xb4\x6c\x5c\x60\x1a\x65\xe0\x5e\x70\x53\x4c\x9d\x8f\x9c\x1c\x19\x3a\x11\x9b\xb5\xf3\xef\xfa\xc1\xbb\x3d\xe6\x54\xba\xe9\x77\x56\xbc\xb3\x0d\x39\x63\xa2\x21\x22\x93\x28\x02\x64\x2a\xf5\x50\x14\xb3\x2a\xf9\x36\xa2\xd9\x02\x33\xa7\xd6\x27\xcc\x44\xa3\x38\xc6\x0b\x4d\xc1\x5e\x96\xca\xf7\x22\x7b\xd4\x10\xbc\xbd\x91\x12\x6d\xb7\xb7\xea\x11\xfd\x0a\xa7\x89\x11\x65\xdc\xc8\xa7\xbc\x02\x52\x4c\x07\x1a\x72\x91\x7c\x6c\x62\x70\x5f\xf3\x3e\x5a\x3d\x39\x27\xdb\x1b\xd0\x59\x11\xc4\x3f\x34\x4d\x46\x6b\xef\xd4\xfd\xa7\xee\x1d\x99\x50\x51\x20\x4d\x85\xd4\xaa\xa7\xa5\xdc\x8a\x77\x16\x23\x5d\x62\x5f\x6e\x55\x6d\x48\x38\x21\xe1\x22\xbc\xc9\x0f\x00\x94\x63\xf5\xfd\x29\x5c\x1f\xde\x01\xd1\xa2\x71\x50\x05\xa6\x5b\x7d\xa7\x24\x2a\xaf\x8f\x59\xc5\x9e\xf2\x44\x66\xda\x88\xcb\xfb\xb8\x8f\x3d\xa9\x4c\x32\x2a\x8f\x27\xc4\xa7\x25\xa9\x77\xd6\x42\x47\x65\x07\xa9\xf1\x9b\x0d\x23\x1f\x5f\x52\x14\x31\x26\xaa\x2d\x48\xc4\x38\xaa\x9b\xb8\x69\x1d\x03\x76\x16\x08\xc2\xf2\x84\xfa\x50\x5f\x03\xa5\x2a\x44\x59\xd1\x82\xd4\xad\xec\x93\xee\x95\xe8\x34\x11\x40\xaa\xce\xc9\x03\x7a\xb5\xbb\x3b\xe3\x7c\x3d\xc2\xf5\xff\x96\x8e\xbd\x8a\x67\x26\x46\x77\xa1\x24\xf9\x06\xe7\xd5\x32\x62\x3e\x60\x78\xde\x20\x68\xe5\x12\x8f\xa4\x6e\x2a\xb0\xbc\x5b\x50\x31\x67\x4d\x33\xa2\xc4\xcf\x8c\xe9\x42\xf9\xbd\xc6\x26\x9b\x1f\x96\x8c\xe8\x38\xe5\x95\x40\xa6\xb2\xe1\x26\xd3\xec\x7d\x9f\x29\x9c\x4d\x55\x38\x75\x2b\x29\xf2\x56\x5e\x90\x61\x50\xdf\xd1\x4b\x93\x00\xb6\x18\xb6\x3a\xdf\x4f\xf0\xd2\xa3\xd7\x2c\xda\xcc\x86\x7c\xf8\xf4\x2d\x43\x98\xea\xf1\xe5\xbc\x95\xc6\x1c\x5e\x49\x03\xdd\x51\x3b\x9d\x6d\x55\x53\x8b\x6a\x2d\x66\xc2\xad\xfc\x98\x4c\x27\x22\x13\x8a\x54\x03\x13\x56\x12\x1c\x26\x3c\xaf\x1a\x1d\xca\xbf\xbd\x8a\xf5\xc5\x12\x49\xdf\x6a\xc6\x29\x1f\xad\xce\x98\x3d\xb4\x57\x71\xa9\xfa\xc1\xe4\x82\x83\x89\x41\xd4\x86\xbd\x87\x20\xcb\x8f\x43\x2c\xa8\xaa\xb1\x95\x32\x10\x89\x7d\x7a\xe7\xbd\x34\x27\x73\x6f\x54\xea\x89\x88\x26\xd5\xdc\x3b\x4b\x41\x4b\x39\x9d\xc7\xd1\x60\xf6\xeb\x8b\xcb\x0c\x4f\xba\x87\x4d\x99\xdf\xf6\xd5\xc6\x67\x1c\x6f\x97\xe4\x8c\xe9\xd6\x8c\xc4\xf4\x9b\x0a\x36\x09\x27\x53\x8e\x29\x39\xb1\xcb\x98\x66\x1d\x5b\x4d\xcc\xf2\x6b\x3b\x88\x69\xd6\x31\xbf\x7c\xc4\x09\x1d\x12\xe3\x78\x61\xb6\xce\x38\x51\xba\x69\x5d\xf3\xe4\x7f\x6a\x7d\x13\xf3\x8a\x9e\x85\x19\x3f\xcb\xc6\xad\xf5\xeb\x8b\x19\x7d\x3b\xa3\x17\x53\xfa\xe2\xf5\x8c\x9e\x9d\x7f\x31\x3b\xff\x76\x76\xfe\xed\xec\x7e\xb7\xb3\xf3\x17\xb3\xf3\x17\xb3\xf3\x17\xf9\x7e\xd7\x12\x87\x82\xa3\xa2\xae\xcd\xe3\x98\xf5\x20\xdb\x42\xb0\x55\x4a\xa2\x46\xbf\xd7\x7b\x69\xe6\x1f\xe8\x5b\x55\xf1\x93\x02\x41\x2d\x7a\x19\xc2\x5d\xe2\xfd\x3a\x39\x8b\xb7\xb8\x66\x04\x02\x3c\x6b\x1d\x0b\x93\x7c\x15\xbf\xf3\x5e\xb5\x63\x03\x8c\xd2\x09\xdd\x0b\xde\x36\x60\xb7\xa5\x8d\x26\xb3\xc9\x14\xb7\xf1\x5d\x70\xd1\x0d\x3c\x49\xc1\xb0\x59\x02\x23\x3d\xc2\x01\xfb\x16\x4a\x73\x8f\x92\x14\xca\x45\x93\xfc\x2e\xba\xb4\x6a\xfb\xb3\x6c\x1a\xf2\xf6\x5c\xb9\x6c\xa0\xe2\x7e\x09\x42\x62\x1c\x5b\xc5\xb4\xb4\x22\xd7\x9b\x5c\x61\x72\x4d\xc9\x55\x24\xd7\x8d\x5c\x29\x72\x6d\xc8\xd5\x20\x7f\x7f\x02\x77\x22\x57\x80\xfc\xe5\xf3\x27\x67\x40\xd1\xbc\x88\x40\x8d\xc5\xcf\xd2\xee\x1c\x8e\xe4\x3f\xbf\xa8\x89\xf3\x9a\xf8\xb1\x26\xfe\x5d\xfc\x2c\xfd\x97\x51\xbd\x52\x5e\xbe\xbc\xbe\x41\xc9\xef\x67\xd7\xd9\xb9\xfb\x53\xb4\x48\x86\x83\x6a\x39\x21\x8b\xe2\x0a\x43\x39\xe7\x0d\x7d\x3f\xa3\x9f\xe8\xe9\xd8\x43\x3c\x34\x24\x98\x66\xbc\x17\xef\xe5\x56\xd3\xc0\xf6\x5e\xea\xa0\x3c\x05\x3f\x62\x85\x2b\xb1\x32\x61\x7a\x59\xb6\xcb\x33\xcd\x93\xbd\xf7\xd2\x77\xa3\x55\xf6\xda\xa9\xfd\x4c\x31\x99\xbd\xd5\xbe\x97\xf1\x6b\xa5\x3d\x7b\xff\xda\x79\x55\x4d\x0b\xdf\x4f\x5e\xfb\xbd\x32\x7b\x6d\x5f\x29\x9d\x91\x67\xf4\x3e\xf3\xde\x23\xcf\xb6\xd2\x98\xa5\x1c\x2b\x88\x85\x49\x56\x4d\xc9\x34\x87\xa8\x1b\xfd\x14\xe7\x06\xf5\x94\xc3\x02\x4f\xf2\x6a\xfd\x5e\x45\xf1\xbe\xd3\xb6\xe9\xb4\x15\xef\xb5\x6f\xba\xce\x6d\x36\x24\x2f\x67\x92\xa7\x11\xef\x8d\xd2\xf6\x9d\x25\x2f\x77\xf1\x67\xf1\xde\xe2\xf6\xe1\xe4\xcc\x53\xbc\xb7\x8e\x74\x25\xe8\x95\x8a\x3c\x07\xbf\x77\xa6\x77\x5b\xe7\xdd\x7e\xd1\x6b\x6f\xdd\x9e\x1e\xd9\x3b\xab\x9a\x9d\xf2\xe4\x42\x22\x93\xa7\xbe\x5c\xca\xbb\x63\x2f\x8a\x99\xc1\x95\xf3\xfd\xa8\x07\x76\x02\xfb\x7e\xec\xa5\x97\xe1\x51\xf6\xd3\xc5\xb1\xf7\xa3\x8f\x0e\xe6\x3c\xef\xc7\x47\xd9\x49\x2f\xf9\x63\x92\x59\x1a\x1e\x55\x9b\x52\xf6\x02\x9d\xf6\x8a\xe6\x6e\xaa\xda\x24\x7a\x2d\xb7\xa3\xf2\x5e\x5d\x0b\x52\x89\x57\x1f\x17\x18\xca\xc7\x4f\xe2\x5a\xda\xe6\xab\x4b\xae\x6a\xaf\xa5\x6d\xe5\x38\x79\xa8\xec\xbf\x29\x81\x1c\x14\x86\xfb\xd9\xd9\xd9\xb3\xbe\x97\x18\xd0\x3d\x64\x94\xdf\x8a\xe9\x99\xdd\xce\xb5\xf4\x78\x37\xbf\x45\x22\x44\x71\x2d\x23\x75\x35\x09\x5c\x3b\xbb\xcd\x9c\xa8\x1b\xea\xee\x18\xb3\x0b\x97\x6b\x39\xda\xa6\xe3\x24\x55\xa3\x6b\x79\x50\x9e\x7d\xa4\xe0\x02\x26\x32\xa0\x03\xac\xca\xef\xe0\xc6\x58\x4f\xa1\xae\x5f\xdf\x88\x6b\x25\x37\x64\x7d\x7c\xad\xe4\xf0\xdf\x4a\xfa\x7b\x40\x81\xa3\x68\x86\x09\x31\xb1\xe2\xc3\xb0\x53\x65\x47\x7f\x46\xaf\xa4\x2f\x44\xb5\x67\x1f\x78\xbc\x55\x9d\x6d\xd2\x13\xe7\x24\x99\x2e\x33\xbb\x42\xbd\x3d\x3e\x39\x18\xe0\xb4\x9c\x34\xbd\xc5\x12\x9a\xcb\xd6\xa8\x09\x91\x2e\x9f\x68\xd2\x15\x03\x87\x1c\x07\x54\xff\xff\x38\x4c\xaf\xf7\x71\x38\x41\xa4\xeb\x55\x59\x75\xc6\x56\xd9\xb6\x0a\x46\x4a\x0c\xa8\xf3\x08\xca\x37\x42\x92\x9b\x05\x63\x5c\xbc\x26\x22\xe9\x9e\x32\x85\xca\xa7\x9a\xc2\x21\x8f\x18\x5e\xdd\x65\x74\x9f\x11\xea\x49\x78\x5a\x43\xc9\xa7\x4e\x63\xd9\xb0\x7a\x1c\x17\x85\xe1\x90\x4a\x84\x51\x5a\x3b\x06\x46\x59\x58\x06\xaa\xce\xc9\xff\xae\xd6\x93\x81\xa4\xc5\xe4\x6b\xdc\xdd\x52\xd6\x7e\x88\x86\x87\xdb\xe3\x0b\xef\x95\x25\xcd\xc4\xb5\xda\xeb\xd7\x7a\xaf\xa3\x5c\x62\x14\x0f\x60\x4c\xbb\x94\xa9\x8b\x2f\xde\xaf\x50\xca\x71\x4a\xe3\xd6\xd3\x39\x73\xd2\x12\xa6\x59\xd7\x4e\xb6\x73\xde\x47\x6b\x2a\x6e\x7d\x46\xce\x69\x94\x0d\xea\xdd\x85\xb8\xd6\x9b\x98\x6d\x5e\xa9\x05\x13\xe7\x48\xc4\xc2\x88\xc0\x18\xfd\x8c\x10\xc6\xb2\x42\x88\x4e\x28\x11\x41\x39\xf1\x1a\xd7\x21\x21\xa5\x38\x13\x26\x84\x09\xbc\xa4\x4a\x0e\xd4\x8d\xdc\x2a\x8b\xf7\xdd\x76\xf1\x83\xc7\x79\x12\xe2\x3b\x6d\x77\x8c\x46\x3f\xa4\xd3\x1f\xa0\x26\xa2\x05\x0d\x5a\xa6\x06\x22\xfe\x5b\x19\x03\x0d\x4a\xef\x94\xd1\x9d\x73\x70\x4e\xaf\x23\x1d\x43\xed\x8f\xeb\x88\x83\xc6\xaa\x70\x19\xdb\x1a\x35\xfb\x70\x20\x90\xc3\x81\x66\xf6\x64\x7a\x98\x7a\x48\x22\xb2\x75\x74\x5d\x50\x13\x0b\x45\x22\x49\xa1\x5a\x7d\xf4\xb9\x9f\x8a\xa3\xad\x2b\xc4\x20\x6b\xe2\x0a\x4f\x2e\x91\x9e\x8a\xc2\x8b\x65\x8b\xb2\x12\xe8\x3b\x94\x6c\x12\x68\x32\xc9\x0b\xe7\xd7\xa8\x0f\x32\x8e\x5e\xe8\x32\x3d\x3f\x0d\xd2\xd7\xe8\x26\x0f\x75\x77\x05\xd6\x6a\x3a\xe2\x92\xca\x80\x34\xfe\xb4\x07\x0a\x7b\xf5\xd3\x79\x14\x1f\x0c\x32\x39\x00\x29\xc0\x64\xe8\x7b\x5d\xb4\x82\x88\x3f\x79\x39\xdc\x49\x4f\xaf\xb1\x43\x07\xdb\x30\xb3\x06\x82\x82\x6f\x20\x62\x9f\xf0\x15\x56\xed\x3d\x91\xd8\xdc\x11\xa6\x76\x65\x77\xb8\x2d\x83\x50\x55\x96\xbb\x2b\x17\xe8\x1a\x65\x92\x0e\x54\x1a\xc2\xb4\xdd\xe1\x36\x68\x00\x2c\x17\x21\x1c\x94\x65\x40\xc2\x3c\x60\x5e\x9e\x64\xc2\xbb\xe8\x1a\x67\x90\xc0\x60\x90\x09\x5c\x29\x53\x88\x03\x3d\x31\xfd\x87\x22\x43\x66\xc4\x27\xba\x71\xaf\x8d\x49\x4b\xeb\xa8\xbd\x85\x03\x2e\xd4\x03\x48\xc1\xb5\x01\xd7\xee\xcf\x67\xf4\x8f\x17\x89\xb3\xe7\xca\x05\x44\x5a\x81\x41\x2a\xed\x6e\xcb\x7f\xaf\x56\x55\x88\x2c\x2e\xf8\x80\xc6\x32\x08\xf1\x9b\x53\x73\xc8\x9c\x6a\x6f\xd0\xff\xea\x1f\xd6\x1e\xca\x2f\x84\xdb\x72\xa3\xa9\x39\xec\xc1\xb5\xf6\xe6\x8a\x9e\x5c\x35\x08\x7d\x15\xa4\x86\x52\x51\x95\xcd\xcf\x84\x5f\x3e\x63\x88\x77\x06\x7b\x31\x76\x0c\x9b\x00\x17\x1f\xaa\x97\x26\xb4\xa9\x9e\xed\x1e\x8f\xa4\x7f\x9c\x17\x16\x71\x0b\x49\x06\x02\xd5\xa5\x68\x30\x9e\xff\x8d\xb8\x27\x48\xfe\xd7\xaf\x75\x71\x63\xa4\xd2\x94\x72\xd0\xf6\x6b\xd7\x7c\xcb\x3c\x14\xb3\xf4\xd7\xca\x23\x59\xda\x48\x98\x9d\x2f\xb0\x1e\x3e\xf1\x0b\x01\xad\x8a\x52\xdc\xe2\xc3\x2d\x8e\x38\x58\xec\x19\xe6\xb2\x25\x0e\x19\x70\x62\x53\x27\x06\x46\xf0\xa9\x70\xf5\x6f\x26\x67\x57\xe0\x05\xa2\x6b\xf4\x3c\x25\xae\x1d\x5c\x69\xfb\xe2\x07\x38\x9e\xc3\x21\x85\x30\xbe\x76\x5b\xb2\x52\x4d\x60\xda\xcb\xbb\xad\x6e\x30\xee\x85\xb4\x78\x95\xac\x04\x85\x9c\x10\x67\xab\xd1\xc0\x8c\xa5\x77\x87\x6a\x59\x06\x9d\x69\x25\xc5\x0a\x7a\xf2\x0a\xb9\xb2\xba\x2d\xae\x30\x9d\x88\xe0\x7a\xed\xb6\x27\x4c\x8b\xaa\x3f\x3e\x48\xbb\xe3\xcf\xc0\x9b\x79\x66\x67\xda\x2d\x0b\x35\xce\x6e\xe9\x3c\x4c\x5f\x86\x46\xe1\xd2\x6a\x8e\x85\xca\x19\x14\x24\xe6\x8f\xb9\x65\x85\x6a\x9a\x3d\xae\xc3\xe9\xff\x95\x8c\xa3\xbf\x52\xd0\x70\x20\x68\xdc\x29\x13\x8a\x89\xff\xad\x13\x9e\xb7\x66\x3e\xb7\xae\x9d\x1b\xd6\xb2\xd9\x21\xb8\x4c\x7e\x58\xef\xc5\xb5\x7b\x54\xfe\xb5\x0c\x8a\x61\x7d\xcd\x47\xe5\x27\x57\x78\xc4\x5d\x51\xd2\x26\x55\x1e\xb0\x2a\x8b\x9c\x23\x2f\x84\xd7\xf7\x6f\x8b\x47\x8f\xeb\x7b\xda\xe1\x37\x73\x48\x78\xe4\xa1\xf0\xfa\x23\xf4\x3b\xf5\x96\x3b\x71\x3d\x36\x32\x5c\x43\xd2\x68\xb7\x90\xbd\xae\x27\x88\x1f\xa7\xf3\xdb\xa9\x63\xc3\xeb\x83\xb3\x64\x1f\x74\x7d\x10\x37\x12\x66\xe5\x20\xd1\x31\x42\x1b\xf4\x84\x79\xc5\x8a\xc9\xa2\x05\xbf\x81\xa1\x36\xba\xd0\x91\x87\xac\x3b\x89\x2b\x6b\xe3\x20\x92\x50\x76\x23\xb7\x56\x6f\x92\x82\x8c\x28\xb8\x99\xb6\xf4\x0c\x80\xa2\xd4\x96\xf7\x21\xe1\xe2\x69\x10\x37\xf2\x37\x87\x6a\xaa\x1b\xb9\x53\x14\xbc\x15\x50\xb5\x4b\x09\x48\x5a\x6e\xba\x91\x76\xeb\x4c\x1b\x79\x54\xbb\x91\xb6\x93\x31\x56\xbe\x51\xaa\x28\x14\x19\x3a\x0f\xd8\x7e\xea\x74\xb4\xea\x80\x8d\xe0\x46\xda\xa8\x43\x90\x79\x59\xe4\x46\x5a\xa8\xfc\x37\x12\xde\x66\x78\x69\x10\xbe\x04\xfe\x90\xd6\xfb\x8a\xb3\x80\x1b\x39\x2c\x3b\x0f\x83\xf3\x8d\xf4\xcd\xd8\x43\x59\xf9\x56\x4b\x0e\x76\xd5\xf2\x2d\x80\x95\x14\x04\x15\x6b\xb1\x53\x8f\x16\x66\x0e\xcc\xda\x6a\x3b\x6b\xb6\x13\xe7\x93\xd5\x1e\xcd\x1b\x19\x70\xab\x65\x72\x8a\x3b\xd5\xf2\x21\x17\xfb\x66\xf8\x9a\xf7\x33\x3a\x08\x76\x13\x7e\x03\x53\x1a\x8d\xef\x1a\x3b\x85\x61\xf7\xe5\x6d\x0a\x70\x0b\x3c\xad\xc6\xd7\x19\x4c\xa2\xf5\xbd\x3c\xcd\x7e\x75\x9a\x5d\x95\x2d\xe5\xd2\x06\x5d\xa6\xc8\x90\xf3\xc5\x8b\x19\x7d\x3e\xa5\xcf\xe7\xf4\x8f\x33\xfa\xdf\x13\xbd\xc8\xa0\xdc\xe6\xe6\x1a\xbb\x0a\x82\x4b\xf5\x84\x8f\xe2\xd1\x5a\x86\x14\x4f\x6f\x9e\x06\x46\xe9\x44\x80\x49\xce\x23\x12\x06\x0a\x42\xd8\x95\x32\x44\xa3\xe9\x64\x1e\x48\x29\xf4\xb0\xe2\x46\x3e\xc1\xef\x15\xc8\xaa\x37\xf2\x89\x5d\x88\xdd\xc8\x27\x74\xcf\xf1\x4a\xda\xf6\x91\xc2\x72\x25\x56\xb5\x15\x09\x64\x65\xb2\x1b\x21\x4c\x7d\x09\xee\x3d\x87\x2e\xe6\x46\x3e\xbd\x8b\xca\xcb\x7c\xf2\x8d\xea\x9d\x3f\x7c\x0c\x0a\x6f\xc5\x8a\xb9\xa4\x37\xb9\x91\xf8\xbc\xbc\xae\x05\x54\x46\x38\x6d\xe0\x86\xf5\xb4\x40\x89\x70\x56\xfd\x9e\x16\x51\x0d\x59\x6e\x67\x3a\x24\xb0\xe0\xa7\x21\x7b\x84\x1b\xf9\xf4\xa8\x66\x91\xe9\x6f\x9a\x6b\x39\x6e\x3b\xa3\xc9\xc8\xf4\xa6\xb9\x16\x68\x51\x0a\x87\xd3\x32\x05\xe4\xb0\x9b\xec\xda\x77\x1b\xb1\xf7\x9a\x6b\x67\x31\x44\xbd\x49\xe1\xac\x4b\xcb\x57\xd2\xa6\x48\x25\xec\x77\x1b\x58\xe4\x73\x35\xa3\xfc\xf7\x56\xe3\xf3\x40\x52\xdf\xa0\xd5\xd5\x2d\x5a\x3d\xf6\xe2\x46\xe9\xdf\x94\x7f\x0b\xe9\xd3\x3c\x94\x75\x5a\x52\xa3\x2f\x51\x05\x72\x62\xce\x3b\xfb\x31\xc0\x63\xd8\x11\x0f\x95\x9e\x04\x48\x18\xf3\xa4\x6d\xdf\xab\x03\x92\xc5\x7d\x11\x50\xb8\x04\x00\x80\xd7\x98\xd1\x59\xbb\xf3\x30\x1d\xe6\x72\xcf\xde\xdb\x01\xe1\x46\xf2\x1b\xe5\x6b\xa3\xa5\x20\x6e\x54\xe8\xf0\x50\xd6\xa8\x80\xa2\xe5\x45\x40\x29\x9a\x11\x62\xbe\x18\xc5\xb9\xe2\x94\x9d\x12\x31\x85\xb3\x08\xc6\x34\x64\x10\x4e\x92\xd6\x34\x2a\x16\x53\x99\x1d\xf2\x4a\xfc\x8d\xaa\x3c\xd9\x04\x24\x6b\xa5\x05\x3b\x52\xa7\xa4\x5c\xfb\x49\x37\xd2\x5e\xc9\x1c\x78\xf0\x46\x1d\x54\xb6\xb5\xbe\xd1\x16\x7e\xa9\xc1\xe9\xfc\x15\x35\xcc\x73\x4c\xb5\x30\xcc\x9c\xe2\xae\x9b\x36\xf7\xe7\xf8\xe7\x37\x20\x5f\x05\x48\xaa\x86\xa2\xed\x82\xf3\x4e\x35\x18\x0c\x62\x89\x47\x8c\xbc\x90\x5d\x3c\xdc\x68\x0c\x2b\x92\xd2\x0b\x19\x65\x7a\x37\x1d\xa3\x04\x41\x65\x83\xbe\xbc\x6e\xf4\x93\x6a\x1f\x64\xab\x9f\x2a\x48\x01\x0b\xa1\x15\x9c\xd0\xb9\xdf\xc0\x45\x5c\x0b\xef\x03\x13\x4d\xf4\xf3\x28\x7d\x4a\xd3\xf8\x48\x8e\x24\x31\x81\xe7\x72\x6a\xad\xc7\x70\x33\x0a\x76\xd7\x43\x89\xf4\x07\x46\x69\xcb\x3d\x51\xd9\x63\xd8\x37\x9d\xf9\xdc\x38\xdb\x4a\xf8\x33\xb9\xf8\xba\x71\x54\xc4\x54\x4d\x98\xa0\xc5\xf6\x1b\x68\x1b\xec\xac\xe1\x46\xdc\x38\x5f\x05\x90\xbc\x71\x7e\xe8\x9c\x21\xc1\x3a\x87\x9c\x9d\x72\xa1\xd5\x74\xa9\x0b\xab\x73\x2a\x01\x68\xc2\x47\x17\x29\x6c\xa6\x3e\xc9\x20\x65\xc5\x84\x75\xa7\x60\xc8\x88\xf3\x33\xcb\x14\xe9\xc6\xe1\x0b\x8d\x41\xbd\xb4\x36\x8f\x95\x48\x57\x4d\x7a\x4a\x2f\xe5\x96\x58\x29\x66\x2a\x12\xe8\x58\x03\x11\xbe\x0c\x99\xa9\x27\x06\x0b\x6e\x53\xbf\x57\x44\xa5\x0e\xcb\x1d\xe6\xb2\x02\x4c\x08\x54\x76\x07\x89\xa4\x41\x2d\x44\xb2\xef\x41\x16\x29\x24\x69\x79\x31\xd4\xac\xfa\x6f\x75\x70\x77\x9e\x68\x50\x8b\x29\xf8\xf8\xde\x27\x26\x23\xf5\xfe\x8c\x3d\x7b\x81\xcf\x3c\xac\xd0\x40\x84\xec\x2e\x03\x49\x5c\xfc\x89\xea\x0f\x2c\x7b\xeb\xd3\x4e\x85\x39\xac\xf3\x97\x93\x9c\x5b\x71\x2b\x77\x72\x2b\xfb\x69\xe8\x5b\x92\x93\x48\x3a\xca\x8e\x50\x61\x1e\x79\x0b\x73\xbb\x5b\x76\xf2\x73\xcb\x1e\x7e\x6e\x5f\x29\x6f\xdd\x68\x8c\x7e\x25\x6e\xc9\xf0\xef\x96\xfd\xc9\x72\x4a\x8d\xfe\x56\x49\x74\x98\xc9\x69\x2e\xd1\x5b\x85\x8b\x8d\x9e\xb6\xc8\xe0\x56\x4f\x76\xb7\xc8\xfd\xe5\x89\x13\xd8\x39\x7f\x75\x82\x51\xbd\xb4\x9f\x46\x1b\x9a\x6e\x51\x64\x3f\xc8\x09\x02\x43\xa1\x6a\x78\x1e\x06\xaf\x4e\x7d\xb5\x94\xf9\xad\xaf\x9a\x06\x51\x98\xa3\x52\x63\xb9\xa5\xd7\x09\xe8\xab\x7e\xb2\xdf\x8a\x98\x59\x3f\x4e\x24\x39\x19\x21\x81\x1d\x38\xd8\x1d\x00\x20\x85\x7c\x46\xcc\x47\x35\x15\xee\xf2\x78\x3d\xa1\x2e\x26\xd4\xed\x84\x5a\x88\x5b\xf5\x08\x82\x9e\x86\x37\xa1\x3a\x7c\x5b\xfb\xbc\xbb\x05\x69\x0f\x0e\x24\x0d\xde\xa6\xfd\x03\xb7\x65\x8f\xc0\x6d\xde\x0f\x70\xab\x9b\xce\x99\x50\x36\x43\x31\x03\x05\xbe\xdb\x62\xdc\x74\x9b\x65\xb1\xdb\x2c\xfe\xdc\xe6\x71\xe3\x36\x77\xfb\xb7\xe8\xa5\xcf\x14\xfd\xc8\xad\xb3\x2f\x43\x70\x8d\xe6\xcf\xe3\x6c\x43\xab\xb8\xaf\xd4\x2c\x7a\x51\xc9\x3a\x6d\x67\x53\xf2\x4f\xb8\xad\x28\x99\x0b\x98\x19\xdb\xb8\x9c\x67\xa3\x23\xa7\x58\x3e\xff\x60\x0e\xc4\x4e\xb1\x29\x6f\x9d\xc5\xe7\x33\x53\xb5\x34\x70\x60\x26\x01\x72\x54\x9a\xe4\xde\x3a\x5b\x2a\x9c\xb3\xd4\x7d\x21\x86\x3c\x6f\x46\xdb\xbe\x12\xe8\x2c\x93\x34\x13\xe2\x84\x82\x82\x58\xd9\x7e\x8f\x48\x2c\xcc\x84\x5a\x2a\x84\xf6\xd8\x0d\x26\x9d\x12\x4a\xfb\x72\xbe\xaf\x08\x78\xe8\x58\x82\x86\x02\x41\x81\xb7\x6e\x5d\x3c\x76\x9b\x9d\x45\x93\x04\xc8\x56\x3b\x53\x29\xfe\x72\x62\x90\x42\x39\x53\x34\x6e\x66\xeb\xac\xcc\x27\xfd\xd6\x94\xa4\xe8\x1b\xac\x15\x4e\x59\x29\x64\x52\xa1\x71\x47\x4b\x26\xf3\xee\x94\xcc\x39\xf2\xe5\x91\x72\xf2\xb0\x5d\x18\x95\x73\x8f\xc4\xcc\x3b\xcf\x0b\x03\xfa\xbc\x8a\x38\xf9\x9c\x6f\xd5\x04\x1f\x2d\xbd\xa6\x9e\xaa\x9c\x02\xb2\xcf\x40\x3b\x5f\xc2\x2c\xb7\x96\xf9\x0a\x2f\xe2\xae\x13\x35\xbb\x31\x2a\xf1\xca\xd3\xce\x72\x51\xb1\x5e\x13\x27\x1f\x1e\x9d\x60\x65\x02\x5d\xcd\x65\x6a\x9c\xe0\x93\x7f\x47\x75\x7c\x21\x82\x8a\x13\xe7\x11\xa5\x6c\x0b\x9a\xd4\x19\x20\x5e\x56\x79\x79\xfc\x2b\x9c\x38\x0e\xff\xa7\x22\x0d\x38\x9d\x48\x14\xe9\xfe\x6f\x5d\xde\x0e\x7a\xcb\x0e\xf1\x69\x5d\x12\x28\x72\x33\x7a\xeb\x62\xee\xb4\x33\x24\x8d\x5f\xa1\xcb\xf2\x67\xe1\x1d\xff\x8b\xba\xfa\x4c\x56\x0b\xa2\x85\x99\x6f\x3f\xd9\xbb\xc4\x74\x3e\x79\xb2\x88\x3d\xa3\xb9\x55\x1e\x2f\x65\x23\x93\x9e\xa0\xac\xe6\x32\x31\x79\x81\x7a\x09\x97\xc9\xfa\x8f\xd3\xe7\x2e\x6b\xb9\xb7\x2e\x9e\x1e\xeb\x12\xbf\xbe\xd0\x9d\x57\x8d\x6a\xd5\x04\xe7\x6b\x26\xc6\xf4\x4e\x89\x9b\xef\xc6\xb1\x9d\xaa\x2f\x48\xde\xf3\xab\x82\x99\x30\xb8\x64\x26\xbc\x7c\x75\xea\x2e\x51\x91\x1a\xe7\xf4\xd1\x49\x03\xdc\x37\x1e\x73\xca\x89\xe5\x3a\xb3\x2b\x8c\x4d\x43\x32\x47\xc1\x47\x99\xd3\xf7\x4e\xdc\xfc\xde\x93\xfb\xcf\xef\x9c\xcf\x42\x30\x65\x4f\xbe\x32\x72\xf2\xd9\x93\x3e\xfd\xce\xbb\x35\x07\xcc\x45\x02\xad\x7c\x12\x20\x77\xa1\x41\xdc\x52\x58\xce\x5b\x96\xe4\x16\x63\x8f\x07\xf2\x29\x7a\x3b\x82\xcc\x30\x1a\xf3\xa0\x1a\xe7\x5b\x62\xd0\x2e\x26\x40\x9f\x98\x87\xb3\x0c\x4a\xd0\x54\xfd\xb5\x91\x21\x1c\xb3\x27\xc1\x56\x2a\xfe\xe5\x68\x5b\x89\x33\x31\x43\x41\xd7\xab\xbc\xb4\xbc\x40\x5b\x2b\xaa\x0c\x18\xee\x1e\x14\xf4\xfe\xe4\x08\x63\xaf\x26\xd9\x45\xdd\x5c\x33\xe1\x55\x3f\x6c\x3e\xe2\xce\x83\x8a\xbf\xd0\x5b\x8b\x8a\xa7\xc4\xc4\x51\x3b\x43\x99\x9e\xf6\x46\xfa\x5d\xba\x4b\xd9\xeb\xc2\x8c\xb4\xe1\x87\x29\x74\x93\x97\x74\xc9\x94\x72\x14\xfd\xfc\x4c\x70\xdb\x74\x39\x36\x29\x2f\x1e\x8c\x11\xe9\xa6\x8c\xe8\x44\xc3\xb5\x62\x94\x26\x19\xd8\xdd\xb2\x63\xb8\xdb\xc3\x97\x51\x87\x58\xc9\x70\xc4\x40\x19\xee\x83\xf8\xb0\x0e\xca\xef\x67\x41\x95\x27\x4c\x36\x45\x4b\xbc\x79\x40\xe5\xc2\xe7\x98\x3d\x1f\xda\xf6\x5e\x7c\xd8\x6c\x04\xef\x7e\xfa\x70\xfd\x06\x2d\x22\x3e\x58\xf1\xe1\x96\xdd\x21\x7e\xb8\x15\x1f\xac\xca\xdb\xfb\xd2\xc2\x35\x8e\x56\x70\x80\xd9\xa2\x6d\x11\x2a\xcf\x09\xcc\x3f\x32\x4a\xca\x0f\x62\xa0\x92\x07\x60\xb1\xf4\xc0\x5d\x53\xd9\x13\x79\x72\x0f\x44\x23\xa0\xa4\x2b\x2f\x06\xd5\xc0\x3c\xbc\xba\x54\x89\x7e\x03\x14\x8d\x21\x1f\x06\x1c\xca\x38\xd5\x76\x4b\x5a\x7e\x01\xff\x63\xdf\x97\xf4\x7e\x03\x99\x30\x30\xc8\x8f\x90\x85\x1f\xe2\xdf\x8b\x72\x43\x4c\xf3\x23\x32\x45\x7b\x72\x89\x24\xb1\xb9\xc2\x54\x08\x35\x99\xaf\xe6\x05\x9b\x7d\xd0\xbc\x12\x8f\x13\x99\x12\x39\x0a\x3e\x8e\xe7\xe0\xd1\x08\xd0\x3d\xdc\x07\x6f\x43\x54\xda\x7e\xec\x8c\xb2\x6b\xd5\xec\x92\x4a\xff\x83\x8f\x9d\xc3\x88\x6b\x20\x7d\x7e\x18\x23\xfc\xe0\xf2\xb8\x52\x03\xe2\xdf\x87\xbd\xf2\x8f\x54\x52\xc8\x3b\x1d\x91\x7b\x9e\x57\xaa\x4b\x8e\x7b\x58\x41\x2a\x50\x24\xb3\x0c\x4c\xe4\x8d\x8c\xdd\x9b\x56\xc7\x4a\xbe\xa3\x8c\x6a\x77\x4b\x5a\x45\x0a\x83\xb3\x21\x3d\xd6\x42\x7f\x55\x64\xcd\x52\x87\x26\x14\xf0\xf4\x78\x80\x8e\x11\xd2\x0b\x68\x16\x7b\xe5\x37\xc6\x3d\x22\xb8\x92\xc4\x30\x72\x08\x0c\x0e\x29\xc5\xcf\x91\x61\xfe\x14\x30\x6c\x51\xe8\xec\x02\xd3\xa9\x99\xaa\xcf\xa6\x6e\x1a\x10\x5b\x93\x7d\x78\x54\x76\x29\x3e\xe4\xc8\x2e\x77\xe4\x4c\x92\x95\x64\xd0\xa3\x90\x53\x75\x91\x3a\x97\x3b\xd9\xaa\x97\xc3\xe0\xdd\x93\xee\xa1\x17\xbd\x93\x2d\x9a\xeb\xdd\xc9\x16\xc7\x43\x91\x7d\x3d\xbc\x5c\xbb\xbd\x2a\xe4\x2b\x05\x2f\x3a\xf7\x04\x71\x87\xfb\x28\x5d\x54\x1c\x07\xbe\xd0\x84\xaf\x94\x6c\xeb\x3c\xa2\x13\xce\x37\x7c\x90\x76\x57\xd9\xe5\x02\xeb\x93\x6e\x63\x27\xee\xa4\xf6\xaa\x7d\x25\x3d\xd9\xd3\x10\x59\x76\x67\x12\x4d\x31\xe4\xe7\xdc\x25\xae\x0f\x11\xfe\x95\x31\x06\x4d\xce\x53\x95\x1c\x44\x19\x6f\x64\x95\x48\xcb\xe0\xb3\xf5\x6f\x20\x0d\x1d\x53\xae\x99\x67\xab\x16\x53\x92\x49\x9d\x9f\x10\xe9\x4f\x15\x5d\xfe\x3b\x28\x9f\x5e\xd5\x4b\xdc\x70\x9e\x22\x03\x5f\x20\x6b\x4b\xae\x6b\xd0\x2c\x28\xd3\xc9\x6a\x07\x18\xc6\x28\x43\x1e\x89\x12\xc5\xcb\x68\x99\xbe\x70\x19\xe6\xb9\x4d\x62\xa4\xed\x70\x85\x2e\x79\x37\x72\xc8\x98\x14\x24\x89\x4a\xb2\x40\xa2\x17\xe3\x1a\x5a\x4c\x21\xfb\x8c\xc9\xb3\x45\xa6\x3c\x3d\x26\x69\x0b\x33\x2a\x26\xdd\x99\x75\x4a\x67\x9b\x33\x8b\x66\x80\x59\xf5\x40\x57\x58\x49\xbd\x74\xc4\xa1\xfe\xb2\xb0\x71\x90\x9b\x92\x3f\x62\xf1\x93\x4e\x17\x0d\x9e\x88\x2a\x13\x49\xa2\xb9\x71\x01\xec\x68\xd3\x2c\x11\xa8\x9b\x01\x18\xdd\xfc\x15\x90\x1f\xb5\x34\xa7\xa2\xbd\x73\xd6\x05\x81\x86\xc3\x24\x94\xed\xbf\x65\x77\xd9\x5d\x85\xef\x01\x7f\x55\xc9\x4b\xc9\x9d\x0c\xf3\x68\x29\x77\x32\x04\x9c\xc7\x06\x8c\x68\x55\xc8\x8f\xf0\x8d\x03\xd6\x88\x10\x15\xef\xcb\x2b\xa1\x69\x03\xf3\x29\xa0\x30\x37\x95\xd8\x91\x5e\x2b\x23\xb8\x3f\x8d\x47\x9c\x66\xb3\x06\xa6\xb9\xfd\x8d\x46\x73\x3f\x0f\x38\xe9\xaf\xef\xe4\x18\x14\x1d\x5b\x8c\x5e\x7a\x77\x71\x29\xee\x94\xf4\xc1\xd9\xac\xc5\xa1\x2b\x30\x73\x16\x12\x9f\xd9\xd3\x17\x56\x1e\x67\x80\xb6\x51\x6f\x9d\x34\x82\xb6\x4c\xeb\x66\x6a\x18\x55\x6f\xa4\xae\x30\xb7\xa7\xb2\x7b\x86\x83\x7a\x1f\x71\xee\x6b\x16\xf9\xc4\xaa\x18\x6c\x4d\x5b\x73\xb0\x5a\x4c\xe9\xc9\x35\x6e\xe4\xd3\x84\xd4\xb6\x26\x69\xc8\xae\x18\xb4\x8e\x5a\x33\x52\xcb\x2c\x2c\xde\x27\x5a\xb3\x26\x6f\xb2\x18\x07\xdc\xf5\x4c\x2c\x3c\xcf\x59\x79\x23\x8d\xde\xb1\xa6\xea\x4e\x79\x90\xb3\x74\x83\x4b\x24\x77\x6f\x1e\x96\xb3\xa2\xc6\xdd\x72\x1c\x16\x7b\xe2\x69\xf3\x4e\x8b\x3b\xdd\xec\xc4\xdd\xbb\x0b\xaa\xc8\xef\x2e\x8e\x22\xc0\xde\xbd\xbb\xb8\x54\xaa\xdd\xb0\xdb\xe1\xbb\x77\x17\xcb\x11\x7a\x2f\xad\x1a\xf5\xa8\x43\x85\xd8\x68\xe8\x4e\x2b\x1e\x0e\x18\x40\x5b\xd5\xc6\x48\xbd\xf4\xf8\xa2\x05\x53\xf5\xd0\x76\x27\xee\xf4\xde\xa1\x51\xec\x9d\x7e\x82\x2e\xb2\x2c\x35\x22\x83\x7b\x84\x0c\x8b\xf9\xcc\x1d\xed\xb4\xc4\xa4\x73\x06\x3f\x40\xc1\xb9\x70\x8d\x84\x4f\x65\xa4\x95\x3e\x35\x09\x10\x00\xe0\x40\xcb\x85\xd4\xc7\x70\xcf\x02\x49\x6a\x09\xc6\xc5\xe4\xb7\x1e\x09\xde\x69\x03\x90\xec\xbd\x09\xa1\xb5\x37\x42\xb4\xe4\x0c\x88\x93\xa5\x77\xb5\x54\x0d\xb0\xdc\x91\xb6\xfc\x1b\x3d\xa0\x6c\x94\x39\x59\x1c\x00\x06\xd9\x75\x01\x24\x45\x30\xae\xc1\xc1\x81\xd6\xe5\xee\x5c\xd3\x75\xb2\xef\xe1\xc5\x5d\x8b\xcb\x7e\xc0\x6c\x79\xd8\xc2\x79\x0a\x1e\xc9\x6e\x17\x61\x06\x97\x7a\x3b\xfa\xf4\xb9\x90\x81\x1d\x26\x3a\xfe\x26\x8b\x54\xc4\xb8\x38\xc8\x6b\x12\xf0\x6d\xc6\x59\xef\x75\x62\xb5\x82\x79\x49\x00\x9d\xba\x6c\x42\xfb\x40\xf4\x5f\x9b\xd1\x07\xaf\xb7\xf0\x89\x80\x2e\xb3\x9c\x62\x48\x88\x88\x3c\x1c\xdf\x39\xa3\x7e\x55\xde\xe5\xd2\x75\xe6\x20\x5f\xaa\x61\x30\x7a\xf6\x08\xe6\x40\xa6\x6e\x80\xb6\xcc\xd9\x26\xa7\x9c\x35\x91\x07\x7c\xe2\x55\xd9\xc7\x99\x57\xce\x90\x33\x85\xc4\xa8\xb7\xa3\xe6\xb3\xca\x19\xe4\x0b\x89\xda\x97\x33\x87\x6b\xb7\x15\x65\xb9\xb6\x0e\xa7\x52\xb8\xfc\x15\x12\x39\xcd\xbc\x7e\x7d\x53\x51\x37\x20\x48\x56\x3b\xf2\x2a\x3c\xba\x88\xc6\x07\xc7\xac\x07\xd5\x4b\x14\x62\xaa\x3c\xde\xa5\x52\x33\x8e\x4f\x82\xd7\x1b\xc6\x01\x75\x74\x19\x51\x69\x15\xa2\x94\xd7\x30\x92\x0b\x78\x44\xf9\xdb\xf3\x94\x33\x6b\xd4\x13\x60\x3f\xc6\x2a\xd9\x9d\xdc\xb9\x80\x3b\x10\xe1\x5b\x23\x15\x37\xd0\x20\xb3\x9f\x77\xc1\xdd\xeb\x51\xb8\x1c\xe4\xa4\xde\x08\x30\x15\x12\x01\xea\xe3\x81\x80\x99\x23\xc8\xb4\xc9\x2d\x26\x32\x43\xad\x74\xa0\xb7\x78\xc4\x2d\x53\x2c\x7b\x94\x85\x76\x56\x74\xe1\x30\x9a\x21\x7d\xb9\xa4\x35\x9b\xaa\xcc\x4e\xe8\xcb\xa6\xca\xb2\x62\x8b\x96\x11\x0d\x8e\x1e\x66\xeb\xec\x3f\xe2\xce\xab\x96\x62\x54\xa3\x47\xc2\x3b\xaf\x54\x3f\xc4\x3b\xef\xd0\x32\xa0\xf2\x6a\x4a\xd2\x80\x57\x58\x68\x5e\x15\x0f\x14\x77\x1e\x3d\x43\xa4\x74\xe9\x00\xc1\xf4\x5f\x91\xbb\x93\xf4\xf9\xbc\xda\x6b\x07\x3d\x8c\xd7\xcd\x89\x68\xee\x77\x5e\xf7\xd2\x1f\x26\x3d\x2f\xae\x46\xe1\xf1\x76\xa4\xf4\x43\xaf\xb6\x92\x20\x8c\x36\x98\x42\x89\xde\x13\xe6\x24\x50\xf2\xab\x8a\xf2\x4e\xe4\x85\x2f\x7e\x41\x6d\x1b\x3d\x4c\x96\xc2\x33\x8f\xc7\x05\xd4\x75\xe3\x91\x3d\x98\x20\xe6\x6f\xa1\x2d\xb9\x97\x19\x34\xdd\x06\xc9\xf2\x92\x44\xdf\x25\xff\x2b\x99\xb3\x88\xd2\xa7\x1c\xd6\x75\x95\xac\x83\x51\xb5\xab\x5b\x8a\x81\x53\x7d\x3d\x6d\x63\x51\x42\xdc\x79\x1d\x7a\x3a\x62\x53\x61\x50\x3d\xc2\x5e\x46\x14\xe7\x66\xac\x2a\x5e\xd8\x34\x83\x62\x1f\x4c\x59\x53\xf7\xba\x89\x5d\x16\x0d\x26\x6c\xa8\x1b\x40\x14\x05\x62\x85\x67\x5f\x39\xf3\xa9\x1f\xae\x68\x5f\x53\xd8\xe1\xe5\x53\x2a\xab\x60\x1e\x02\xaa\x49\xc4\x2c\xea\x47\xed\x3e\x63\x9e\x77\x9f\x38\x38\xb0\x71\x6c\x60\x66\x61\x50\x8d\xcc\x21\xb9\x8a\xd2\xf9\x1b\x00\x0f\xfb\x5d\xef\xb6\x5e\x85\x50\x42\xde\x1c\x71\xe8\x0b\x1d\x33\x4b\x01\x26\xe7\xc9\x82\x83\xce\xdf\x79\xd7\x0f\xa9\xb2\x65\x63\xc6\xe4\x6c\x34\x03\xea\x77\x98\x48\xf5\xd6\x81\x68\xc7\xd7\x62\x88\xed\x3d\x35\x66\x4c\x41\xda\x00\xa4\x79\x10\xf1\x23\x6a\xc1\xee\x82\x1a\x5b\x97\x1c\x1c\xf0\xb6\xa0\x3b\x90\x42\xc6\xc8\x9a\xb7\xbb\x83\x97\xbd\xce\x29\xbe\x5b\x86\xe9\x8d\xee\xd3\x32\xba\xb8\x2f\x41\xb0\xee\x69\xe0\xbc\x3f\x76\x37\x76\x5f\x09\x1c\xf7\x65\x88\xbd\x7f\x98\x2a\x14\xef\x47\xd9\x7a\x19\x75\xf3\xce\x93\x4d\x1f\x0c\x4e\x64\xf2\x63\x54\x06\x58\x5f\xb2\x21\x50\x02\x58\x96\x89\x40\x3b\x5b\xb4\x84\x4e\x9c\xfb\x8c\xd0\xd3\xcd\x3d\x7a\x95\x68\x02\x03\xa3\x8a\xc1\x5b\xe2\x94\xbc\x64\x9d\x2a\xee\x47\x35\x2a\x74\xbf\x13\x1f\x9d\xcf\x8a\xb2\xc4\x9e\xd0\xd5\x67\xbd\x1f\xb5\x82\x5b\xe2\x7d\x79\x7c\x3d\x1e\x55\x1f\x64\xab\x51\x57\x52\x69\x4d\x80\xd7\x48\x54\x51\x14\x98\xbe\x01\x70\x1c\xcf\xe2\x2a\xfc\x4a\xfa\x09\xc9\xff\x2d\x64\xf5\x7f\xfc\x67\x2f\xed\xf8\x9b\xb4\x4b\x39\x4e\x88\xeb\x09\x45\x2e\x27\x6b\xce\xaf\xe2\x41\xda\xd6\xf5\x9c\xbc\xee\x9c\x6e\x54\x22\xd8\xe7\x31\x51\x79\x26\x4c\x24\xcd\x29\x08\x93\xf7\x36\xc6\xec\xac\x85\xa8\xda\xcf\x01\x73\x70\xa0\x20\xfc\xa0\xa4\x61\xb8\xa0\x00\xc9\x4c\x28\xd5\x32\xa4\xc0\x2b\x29\xe3\x93\x34\xf9\x7b\x51\x9f\x8d\x47\x9e\xaf\x20\x46\xa5\x70\x36\xd5\x7e\x90\x76\xa7\x5a\x98\xbb\x31\xd2\xc0\x0b\x74\x36\x24\x3f\x5e\x64\x40\x65\x9c\x71\x29\x60\x60\xd1\xc4\x93\x70\x39\xf1\xe8\x34\x90\x96\x09\x2d\x08\x72\xbb\x4e\xa0\x98\x2b\x26\x4e\x7d\x1a\x73\x21\x79\x84\x5f\xba\xe9\x23\x19\x8f\x3f\x48\x52\x54\x3f\xc8\x47\x36\xe1\x7c\x90\x07\xa3\xf4\x76\x3a\x3a\x3f\x28\x81\x2a\x72\x38\x60\xd7\x03\xa0\x74\x28\x54\xe8\x4a\x9a\x57\xc6\x35\xbb\x0b\x2d\x51\x39\x8c\x0d\x0f\xb8\xec\x5d\x07\x60\xb6\x73\x06\x02\x59\x83\xa0\xe5\x22\x4e\xae\x71\x01\x96\x70\x5e\x02\x41\x46\xa4\x05\xbe\x8c\xb0\xc8\x2a\x22\x97\x5a\xe2\xd1\x44\x64\x4a\xc2\xb7\xc9\x1b\x01\xd3\x37\xce\x0c\xd2\x5f\x11\x8d\x26\xe3\xf0\xdc\xfc\x1a\xf0\x80\x28\xd3\x3e\xa8\x0d\xdd\x9a\x45\x23\xdc\x59\x87\x73\xa9\xcc\x4a\x73\x89\x19\x23\x9f\xa5\x2d\x26\xbc\xa4\xcd\x73\xc3\xc2\x28\xb6\x74\x0f\x6a\xe3\x55\xe8\x52\xfa\x80\xd5\x16\xa7\x70\xd9\xf4\x8f\xc8\xd2\x92\x90\xc4\x9e\xb0\x40\x7c\x6b\xdc\x7e\x51\xa9\xc8\xb3\x6f\x15\xae\xd6\x4a\xb3\xcf\xa4\x07\x65\x94\x0c\x2a\xa5\xe8\x52\xf4\x41\x19\x7d\x6a\x40\x07\xbe\xda\x70\x63\x45\xcc\xb7\xee\xdd\x5e\x71\xf2\xd2\x0c\x9d\x64\x57\x87\x89\x15\x0e\xb6\xe9\xbc\xb3\x6e\x0c\x4b\x19\x76\xcc\x6e\x39\xad\x5c\x4c\xb1\x76\x9b\xf8\xb5\x96\x7e\x92\x91\xc7\x47\x22\x17\x4d\xa7\xda\xd1\xa8\x96\xaf\x6d\x65\xed\xb3\x94\x68\x0c\xfd\x46\x81\xa4\x5f\xe2\x4b\x73\x48\xe9\x52\x8d\xac\x7a\x94\x26\x77\x0d\xca\xee\x5c\xaa\x51\x83\x92\x54\xed\x09\xe0\xc2\x67\x22\x78\x6d\x2e\xe9\x0a\x38\xa5\x5b\x20\xcc\x1e\x79\x99\xae\xdc\xd5\x31\x87\x5b\x18\xe2\xfa\xcc\x4a\x79\xc1\x9c\xea\x51\x02\xc7\xd8\x01\x88\xb3\x45\x4a\x27\x11\xfe\xd0\x0e\xa3\x2e\x88\x30\x0d\x87\x80\x8c\x79\xe1\x05\xdd\xe2\x0d\x03\xaa\x57\xd1\x06\x8c\x3c\x74\x49\x4b\x28\xaf\xb1\x90\x21\x08\x27\x47\x51\x3f\x89\x9d\xbd\x91\x4d\xb8\x13\xa2\x0a\x8f\xcc\xab\xfd\x29\x7d\xa5\x5d\x5e\x7a\x5a\xc0\xdb\x26\x77\x35\x62\x66\x16\x90\xc8\x2a\x04\x32\xb3\x78\x8c\x21\xe2\xe3\x30\x3b\x83\xf7\x49\xbe\x7c\xd2\xa1\x22\x53\x13\x7a\xfb\x8a\xf6\xf3\x3e\xe8\x06\xc6\x02\xd2\x37\x3f\xe8\x99\x8f\x88\x07\xdd\x96\xe1\x43\xab\x5e\x5a\xfb\x90\xc0\x42\xab\xad\x32\x3c\x64\xd6\xac\x5f\xc5\x83\xde\x6c\xe0\xab\xe1\x3a\x49\xb5\x7f\xaa\x40\x1c\xbf\x33\x55\x76\x5c\x3d\x90\x97\xfc\xa0\xe2\x74\x89\x9b\x32\xa6\xce\x16\x32\xeb\x34\x9d\x6f\xb1\x54\x2a\x83\xea\x2e\xb3\x0b\x65\xab\x8b\x23\x93\x8b\x13\xf6\x16\xc8\x9a\xb8\x55\x60\xce\xec\xa2\xd9\xb1\xc2\x84\xca\x17\xad\x33\xeb\xac\xb0\x7b\xd9\x74\x5a\x1d\x79\x69\x84\x1c\xec\xb9\x71\x01\xb6\xe2\xbb\xad\xf2\x61\x29\xad\xee\x1d\xea\xed\xab\xdd\x41\x38\x55\x84\x43\xbd\x7a\x86\x81\x8d\xd9\x31\x51\x40\xea\xda\x35\x23\xd9\x3d\x02\x85\xdb\x26\x50\x7d\x8d\x24\x5b\x0e\xdc\x23\x91\x86\x0e\xb4\x9e\x80\xe3\x02\xea\x1b\x39\xd7\xa4\x84\xb4\x7f\x8c\xd5\x26\x32\xe4\xea\xc0\x66\xd4\x3c\x1f\x4d\x24\x0e\x43\xee\xc8\x8d\x5a\x62\xa5\x71\x85\xc9\xaa\x1b\xc0\xcd\xb9\x78\x4c\xce\x30\x1f\x38\x1c\x0b\x07\x2d\x78\x80\x0f\xee\x1e\xb3\x3f\x7f\xe4\x94\x8d\xbd\x44\xe2\xdd\x1f\x69\x65\x0f\x39\xa4\x65\x7b\x70\x8f\x37\xda\xf2\x82\xdf\x83\x7b\xcc\xef\xfe\xc8\x9a\x8b\x07\xf7\x98\xfd\x53\x3e\x70\x23\x1a\xdb\xbd\x34\x9a\xf6\xdc\x3d\x8c\xe2\x61\x84\x4a\x35\x9a\x12\x7c\x08\xa9\x0b\x65\xe4\x01\x7a\xbb\x91\xa2\xb3\x22\xf0\x64\x06\xf2\x30\xc2\x39\x76\xd6\x7b\x8d\x76\xd9\x79\x37\x6e\x3b\x80\x51\xf7\xea\x65\xa4\x96\xaa\x42\xe2\xe4\x62\xa3\x10\x54\x0f\x72\x56\x19\x16\x68\xf8\x0c\x47\x54\x30\x93\x54\x49\xa1\x5e\x09\xb7\x18\xcf\x3e\x8f\xc0\x35\x13\x7b\x72\x62\xe0\xd8\x9d\x02\xa1\xd1\xf2\x83\x58\x4c\xc2\xea\x2e\x5e\x3e\x54\x84\x8c\x3a\x6c\xd2\x98\x4b\xbe\x59\xa6\xbc\x14\xea\xb3\x3a\xd7\xe0\x83\xc6\xd1\xb7\x12\x9e\x7b\xaf\xf0\x80\x42\x0d\xda\xd3\x8d\xd1\xb1\xad\x24\x90\x25\xd2\x17\x5c\xe2\x31\x3a\x17\xbb\x4f\xf8\x27\x1c\x3f\xd8\xc5\x2b\x26\x25\xd6\x2a\x71\xa7\x36\xf8\xc8\x63\x95\x2b\x4d\xdc\x59\xe3\x8c\x04\x8a\xd0\xa1\xc2\x24\x06\x2d\x68\x70\x2a\xd2\x2b\x33\xb8\xd6\x2e\xe6\x63\x17\x7a\x99\x9d\x7c\x5d\x74\x5a\x00\x6f\x5c\x33\x51\x9a\x9d\x70\xd8\x30\x71\xc2\x83\xbb\x75\xa3\x9f\x4e\x32\x17\xb8\xbb\x0a\x04\xfd\x4b\xba\xa1\x57\xca\x16\xc1\x93\xe8\x23\x6d\x0d\x69\x0f\x53\x44\x0c\xdc\x37\x15\x98\x49\x0e\x4d\x08\xa7\x4d\x31\xb5\xbd\x37\xe1\xb9\x2f\x5c\xf2\x8a\xbe\x96\x19\x56\x42\xc9\xcc\x63\xfa\x42\x35\xf0\xeb\xf0\x30\x19\x84\x16\x24\x49\x66\x43\x09\x8a\xbf\x4c\xf2\x4b\x85\x7f\xbc\x60\x2a\x7d\x3d\xb2\x4e\xe1\x15\x67\x98\x33\xf1\x54\x8e\x96\xb3\x39\xa1\x0a\x85\xb0\x52\xa3\x11\x23\x99\x77\x26\x2a\x2f\xc0\x17\x0b\xce\x8c\x92\x57\x85\xcc\x40\xcf\xac\x13\x8a\xac\x80\x8f\xf9\x6c\x02\xcc\x55\xa9\xce\xa1\x25\xd9\x79\xc6\x89\xcb\x5c\xa8\xf5\xb8\xdd\x52\x50\xaa\xc2\x1c\x69\x2b\xc6\xec\xdc\xbc\x94\x7e\xc4\x39\x75\xe5\x1b\x90\x7d\x33\x55\xab\x34\x33\x73\xa1\x26\x8f\xf8\x49\xc7\x2e\x07\xe8\x59\x28\xb3\xb9\x76\x6e\x28\x28\x9d\xda\x6b\x69\xb6\x6a\xed\xa5\x6e\x72\xc1\x57\xdd\x00\xfa\xff\xd1\xf0\x20\xbc\x20\x9b\x40\x89\xcb\x92\x83\xe0\x62\x0d\x67\x02\x25\xfc\x44\x90\x3d\x0c\x3b\x0b\xa4\xa4\xde\x7b\xc8\xdb\xe1\xb1\x95\x91\x78\x87\xab\xb8\x0b\x05\x59\xf1\x65\xd3\x8c\x5e\x36\x07\xc4\xb5\xd8\x0f\x74\xe9\x79\x17\x2a\xae\x65\xb3\x43\xc0\xab\xd0\xb8\xa5\x84\xa5\xbe\x85\x8a\xa9\xa7\x5f\xd4\xf2\xea\x62\x1a\x12\x0c\xc8\xac\xd3\xac\xea\x59\xcc\xf1\xbd\x19\x5f\x3b\x72\xa8\xca\x91\xc5\x16\x2a\xbb\xb9\x5d\x44\x19\xc7\x80\xce\x68\x16\x2a\x2b\x41\x0b\x2c\x0f\x54\xf4\xb1\x48\xf0\x74\x03\x3e\xe3\x71\x45\x8f\x8b\x4e\x7a\x55\x8d\x08\x89\x93\x4c\x19\x90\x33\x28\xd5\x74\x25\x74\x5e\xb9\x15\x7b\xb1\x28\x8d\x3c\x92\x75\x59\xf5\x78\x11\xeb\x12\x24\x20\xfc\x30\x72\x4f\x05\xd5\xe7\x52\x53\xa6\xa0\xb0\x22\x6d\x62\x5c\x74\x12\xfd\xfa\x2c\xe0\x03\x39\x9b\xe4\xe8\x45\x27\x07\xed\xdd\x27\x6d\xc8\x01\x02\x3e\x37\x1e\x07\x65\xc5\xa2\x53\xd2\x57\x7d\x34\x93\x55\x27\xdd\x29\xfb\x5a\x42\x85\xcc\xa7\x38\xe8\x71\xe0\x08\x82\x1f\x89\x92\x29\xac\x72\x06\xe4\xae\xa0\x0e\xbf\x5c\xca\x0e\x98\x45\xd6\x45\xb2\x92\x8e\x91\xfe\x38\x64\x82\x0e\xb8\x03\x01\xda\x4c\x40\x12\x5a\xe7\x2b\xcf\xe5\xcb\x34\xc9\x5b\x89\x5a\xca\x2d\x9f\x6a\x5c\xa0\x2e\xec\xa5\x57\x92\x58\xb9\x5d\x32\xe1\x9d\x61\x62\xf4\xc1\x79\xf4\x5b\x0f\xdf\xa3\x73\x8f\x64\xc8\x38\x28\x8b\xd7\x79\xd7\xd0\x0b\x14\x36\x9f\x96\xdd\x61\x56\xfb\x3a\x81\x9f\x2d\xae\x98\xe4\xc5\x99\xbd\xca\x86\xfe\xc8\xaf\xba\x52\xa0\xa0\x08\xb8\x15\x11\x83\x6c\x18\x67\xd7\xa6\x79\xe9\x9c\x79\xb0\x51\x3e\xe5\xa2\xf2\xda\xee\xb4\xdd\x62\xe3\x63\xf2\x93\x97\x43\x15\xab\x4f\xd4\x33\x18\xc6\xe3\x8e\x1d\x05\x2c\xf4\xd6\x8a\x62\x1e\x0b\x08\x07\x90\x5d\xce\x45\x2d\x1a\x74\x32\x34\x32\xea\xad\xcd\xa2\x82\xde\x5a\x3e\x2d\x49\x5e\x3c\x48\x6a\x90\x9e\x68\xda\x56\xe1\x7b\x91\xe3\x8e\x2e\x60\xe0\xd2\xb6\x81\xc3\xb6\xbb\x91\x6d\x2b\xcd\x74\xa3\x10\x64\x98\x6a\x01\x84\x19\xd3\x7d\x7e\x35\x8f\x3b\x5c\xf6\xb9\x8e\x2d\x67\x26\x2b\xd4\x59\x24\xe5\xd5\x1c\x9c\x14\x4c\x38\x78\xfd\x0e\x0f\xd9\x09\xca\x42\xdb\x0a\x3f\xfd\xcc\xae\x14\x17\x3b\x68\x8a\x70\x13\x06\x55\xfb\xda\x29\x63\x66\x71\x67\xb2\x12\x1a\xc0\x89\x6d\x43\x8b\x9d\x1e\x04\xc6\xbd\x98\xb2\xc9\xc1\x22\x25\xe7\x17\x19\xe0\x47\x2e\x38\x77\x24\xc8\x2a\x99\xf3\x2c\x5c\x99\x5d\xe0\x6b\x1b\x17\xcb\x08\xd4\x4b\x18\x19\xe1\x48\x31\xcc\x08\xc3\x8d\x7b\x1d\x3b\xac\x69\x30\x94\x29\x1b\x70\x05\x05\xb9\x9f\x64\x44\x37\xbe\xd5\x9e\x3d\x32\xfb\xe3\xb5\xd5\x62\xfd\x37\xb7\x06\x9c\xd1\x20\xde\x20\x87\x36\xfa\x4f\xdf\xdf\x41\x23\xc1\xad\xa2\x34\x9a\x39\xea\x1f\xdc\x4e\x9a\x85\x55\x32\x76\xb3\x69\x00\x4e\x54\xf0\xf8\xd2\x3c\xca\x43\x20\x9c\x07\x2a\xec\xe7\xa0\x25\xc2\xa9\x30\xbf\xc2\xe3\x4b\x0e\xf5\x8f\x15\x0c\x18\xb8\x85\x15\xd1\x2f\xce\x8c\x30\x72\x42\x9f\x85\x46\xf2\x78\xa4\x56\x0c\xc8\x8b\x3c\x4b\x5a\x0c\x20\xfb\x0e\xd2\xd6\xee\xc7\x80\xce\xcd\x39\xcd\xdf\x90\x7b\xe9\x5d\x4f\x16\x9d\x89\x7a\xe5\x60\xb6\xc2\x04\x4e\x2f\x81\x80\x71\x6f\xd9\xe9\x66\x47\xd5\x67\x80\x5e\xfb\x89\xa4\x54\xc0\x49\x62\x1d\xa4\xb5\xb3\xae\x63\x90\x36\x2f\x62\x03\xe5\x83\x22\xc5\xf2\x62\x90\x51\xf3\xbe\xe1\x69\x71\x0f\x4a\xee\xe8\x58\xe9\x77\x80\x86\x2f\x5d\x3a\x89\xc4\xe8\x9c\xa0\x15\x75\xfe\xb0\x05\xa7\x1b\x29\xb4\x50\xc2\x4f\xc3\x5b\xa6\xd0\x54\xac\x49\xb7\x43\xd6\x05\x2d\x6e\x4b\x92\x66\x4e\x30\x71\x5d\x3b\x65\xe4\x1a\xcd\xf4\x02\x04\xc4\x30\x91\x04\x86\x4e\x41\xd7\x86\x09\xb6\x06\x40\xb8\xfe\x82\x9e\x6c\x7f\x9e\x33\xfe\xbb\x30\x72\x2c\xb2\x39\xe7\xbc\xe6\x50\xcc\xb0\xea\x6f\xac\x7d\xca\x34\x9b\xf0\x20\xed\x74\x2b\xcd\x1b\xbd\x55\x76\x4f\xa3\x7b\x66\xfe\xec\x34\x7c\x34\x5a\x83\xab\xf8\x77\x8b\x09\x41\x4b\x27\x85\x73\xbf\x98\x10\xf3\x6c\x5a\x8a\x3a\xba\xe8\xe2\xc5\x84\x98\xff\x6b\x71\x3e\x21\x52\x36\x74\x49\x9c\xb4\xb3\xba\x82\x16\x89\x38\x14\x33\xc1\x4e\x2a\x88\x78\x6f\x1d\xd6\x7f\x54\x00\xb2\xfa\x01\xa8\x48\x47\x68\x81\x83\xdb\xa9\xe4\xeb\x7f\xf1\x05\x9a\xe6\x17\x1c\x24\x53\x9a\xbf\x34\x29\x6c\xbe\xb9\x6f\x92\x32\x28\x9c\x39\xe1\x89\xbb\x78\xf6\x88\xfa\x20\x26\x3b\x89\x8e\xb7\x11\xcd\xf6\x10\x9d\xda\x40\x44\x3c\xf2\x33\x4f\x98\xe6\xe2\x31\xdb\xcc\x93\x95\xdd\x9c\xc1\x43\xd5\xb1\xa3\x05\x0c\x25\x4e\xc7\x57\x0a\x27\x77\x00\x73\xfc\x2f\xa4\xde\xd9\x4e\xaf\xa1\xdc\xa2\xb4\xad\xf4\x6d\x59\xec\x3c\xe2\xb0\x46\x33\xf1\xa9\x9f\x62\x02\xbb\x09\xc0\xeb\xc3\xfc\x11\xf0\x1f\xec\x99\x18\x8d\x20\x8e\x54\xff\xc9\x34\x22\x7b\x4b\x41\xc6\x87\x0d\x49\xe5\x84\xd3\x97\x04\x6a\xaa\xe1\x41\xd6\x38\x70\x97\x8b\xcb\xfa\x25\xf0\x10\xd2\x97\x4a\xb5\x30\xdb\x20\x3f\x51\xc8\xa2\x75\x84\x62\x43\x80\xcc\xbc\x37\x60\xee\xec\xa2\xd0\x0f\xaa\xc4\x14\x29\xdc\x6a\x78\x26\x4d\x9b\xf4\x47\xc5\xc0\x6c\x16\xb3\xa9\x33\x39\xf5\xbf\x23\xdf\xa0\x34\x43\x21\x61\x14\x61\xb5\x08\x0c\x25\x96\xdc\x49\x2c\xa2\x56\x26\xfe\xa6\x02\x2d\xa0\x2f\xa2\xf6\xd8\x83\xbd\x28\xf0\x5c\x2c\xa2\x1b\x4e\x94\xbf\x1b\xe6\x65\xea\x65\x94\xc5\xee\x1a\x69\x67\xdd\x5e\x37\x5d\xd6\x4f\xe1\x24\x05\xf5\xe1\x45\x56\x3f\xe6\xb1\x1a\x47\x1c\xfb\xeb\xab\x7c\xf5\xa5\x09\x0f\x9a\x34\xce\x67\x3f\x48\x26\x36\x6b\xa6\x08\x73\xc5\xe7\x9a\x01\x09\x39\xeb\x64\x7c\x88\x8a\xd5\x66\x24\xf9\x62\x84\x15\xc6\x15\xff\xc2\xbb\x81\x61\xb5\x38\x46\x0c\xae\xe4\x09\xca\xf4\x1f\xee\x0f\x10\x73\x3c\x13\x22\xd0\x7b\x3a\x41\xb6\x07\x26\x82\xdd\x49\x71\x74\x94\xea\xdd\xb4\xdd\x26\x76\x5a\x31\x9a\x50\x24\x5a\xd6\x1c\x5c\x10\x4a\x1c\x5a\x1d\x61\xaa\xe8\x8e\x6b\x06\x69\x90\x89\x53\x24\x4b\x22\xa9\xcf\x44\x8c\x11\x96\x18\x3a\xde\x07\xc3\xa4\xd7\x04\x07\x5a\x32\x46\xf8\x81\x16\x72\x88\x80\x39\xc2\x80\x22\x21\x65\xbb\x9d\x4a\x25\x37\x36\x71\xf4\xd2\x54\x7a\xf7\xc4\x54\x2d\x0f\xe4\x99\xae\xa6\x35\xd1\x8f\x7b\x75\xc5\xe9\xb5\x58\xc4\x71\x2d\x4e\x6e\xd3\x4f\x95\xe0\x40\xeb\xc1\x09\xd4\x9a\x4e\x66\xe5\x1e\x1f\x68\x92\xf8\x10\xd5\x4a\x50\x60\xf0\x93\x1f\x8c\x7a\xaf\x0e\x37\x92\x6c\x69\x91\xbe\x51\x76\x84\xef\x91\x19\xb7\xb8\xac\x28\x8d\xdb\xe6\xfd\x5c\x99\xcf\x90\x8c\xc9\x10\x2e\x3a\xa5\x22\x89\x1b\xe3\xba\x84\x0a\x5c\x8c\xeb\x2d\xf5\x8e\xe3\x9a\x6c\x70\x17\xe3\x1a\xad\x72\x17\xe3\xda\xa7\x85\xb6\x13\x86\x94\xdf\x3a\x61\xca\x47\x8a\x37\x1e\x65\x84\x45\x55\x11\xb9\x6c\x12\x0f\xc6\x5d\x1e\xcb\xea\x51\x0c\x31\x9e\x46\x3d\xfb\xb8\x0e\x38\x86\x95\xcb\x17\x32\xdd\x63\xc2\xa9\x6e\x44\xd1\xbd\x12\x00\xb9\x14\x88\x34\x57\x4a\x3b\x66\xa7\xdb\x65\x4f\xec\x95\x9d\x6e\x94\x5d\x8c\x4d\xb7\xec\xa0\x9d\x8e\x70\xb9\x9e\x7c\x0d\x6c\x69\x2a\x32\xa2\xff\x1d\x1c\x75\x2f\xe4\x76\x0b\x83\x19\xe0\x54\xe8\x83\xf2\x5c\xec\xf5\x2b\xcd\xde\xe7\xf4\xcb\xa4\x61\x7d\x3a\xa0\x03\xc5\xe5\xe4\x5b\x3c\x50\xdd\x22\xaf\xa4\xb4\x94\xc8\x44\x99\x23\x70\x58\x8e\x69\x25\x9f\xc7\xea\x48\x0c\x1e\x9f\x6a\xea\x12\x5a\xf4\x18\x06\x45\x0e\x53\xf0\xa9\xbe\x8e\x3b\x3d\xbb\x20\xb0\x28\x64\xef\xf8\x55\x2c\x1e\xd1\xfb\x1d\x99\x68\x2f\x1e\x35\x2a\x63\x78\x2e\x8a\x09\xfa\x3e\x58\x1c\xfa\xca\x0f\x51\x25\xfe\x27\x44\x5b\x22\x32\x99\x0c\x6f\x4f\x59\xbb\x66\x5e\x5e\x99\xcb\xac\xaf\xaa\x82\xa9\x8f\x98\x31\x58\x33\x50\xb8\x17\x29\x3a\x59\xad\x8f\x2e\xd9\x55\xf8\xb2\xd3\x27\x4c\xfa\xd6\x32\x3e\xce\xc2\x88\x55\x39\x1f\x87\x56\x52\x47\x80\x1a\x14\x4e\xe8\xfb\x22\xac\xdd\x35\x10\x27\x0d\x08\x48\xa4\x6f\x83\x04\x94\x51\x88\xaa\xa7\xbe\x84\x3b\x56\xe4\xbc\x79\x6a\xd4\xc0\xd7\x00\xfa\x4a\x99\x81\xba\x10\x24\xa7\x37\x99\x71\xa8\x8f\x63\xcd\xa1\xb2\x62\xa6\x44\x44\x2a\x70\xa8\x07\xd4\xf7\xcc\x39\x79\xf1\x13\x7a\xd1\x69\xa6\x9a\xb3\x2a\x99\xab\x62\xa3\xd3\xbe\x26\x4e\x78\x49\x1c\x4b\x5b\xad\xea\x3c\x54\xca\x4d\x2f\x41\xbb\x60\x6a\x4e\xd9\xf9\x76\x7c\x81\xa4\xaf\x3e\x91\x53\x8b\x82\xd3\x13\x70\x74\x59\x4a\xfc\x5d\x6a\x9c\xf8\x09\xb2\x04\xc2\x63\xb5\x08\xba\xa4\xad\xce\x43\xec\x18\x6a\x9f\x0c\xf5\x91\xc6\xef\x8f\xe8\x4a\xa1\x9a\x95\xf9\x69\x41\x05\x09\xd4\x94\x64\x04\xdd\xca\x52\xae\xf3\x54\x7f\x29\xd7\xe9\x84\x2a\x9b\x61\xfa\x78\x4b\xb9\xa5\x0c\x48\x50\xa3\x40\xb0\xca\xdf\x82\x00\x80\xcd\x64\x29\x61\x78\xe2\x24\xa9\x2b\x80\xc2\xa1\x73\x29\xb7\x1f\x31\xce\x1a\x0a\x00\x70\x20\xc7\x43\x4b\x69\xcc\x41\x2c\xa5\x85\x1f\xbc\xae\xdf\x16\x27\xeb\x81\x69\x5a\x79\x5d\xca\x31\x3a\xe3\xb6\x87\x7b\xb1\x54\x46\x6d\x39\x3a\x3e\x8a\x89\x29\x64\x17\x3e\x6e\xc1\xf9\x41\x99\x35\xd1\x19\xb1\xad\xb3\xc1\x0a\x5c\x0c\x9f\x33\xca\x5a\x74\x8a\x0a\x80\x2a\x59\xa8\x65\x44\x56\x55\x91\x18\x6c\xba\x4f\x44\x32\xed\x25\xea\x9e\x53\xf4\x46\xc9\x90\x56\xab\x89\xe0\x1e\xe2\xc0\x24\x4a\xe5\x18\x88\x95\xe8\x4f\xaa\xdd\x02\x8e\x3e\xc5\xae\xae\x30\xbd\x71\x4d\x96\x97\xfe\x3b\x55\x14\xf5\x77\x5c\xa3\x45\x67\x9b\x70\x20\x7b\xc1\x8c\xca\xf9\x4f\xb1\xac\xe0\x00\xf5\x8a\x5e\xe8\x29\xd6\x9a\x59\xa2\x29\xc5\xf5\x28\x04\x46\x0f\x6b\x27\x7d\x8b\x11\x1f\x80\xc3\xa5\xfa\x14\xf9\x19\x9e\xe2\xcf\x63\x88\xc5\xbc\x11\x38\x38\xf5\xaa\xd4\x34\x04\x79\x3f\x2a\x52\x0f\xaa\x71\x5b\x0b\x9d\x35\x51\x6c\x3c\x85\x14\x57\x2c\x85\x7b\x3a\x53\xfa\xda\x39\xdf\x62\xa0\xc8\x3c\x8a\x1d\xe5\xa4\xc9\xc2\xb2\x53\x5e\x6d\x1c\xfc\xb9\x53\xbe\x77\xb8\x40\x42\xc1\xa3\x51\x5d\x25\x8a\xd2\x6a\xd9\x69\x8b\x07\x4b\x7f\xd4\xe1\x5a\xc3\xb7\xec\x5c\x3f\x04\x5e\xff\x5c\x76\x82\x3d\xd0\x42\xa2\x92\x9a\x15\x88\xd0\x39\x83\x6c\x34\x11\x80\xf4\x51\x2c\xbb\xb1\x5f\x5b\xa9\xe1\x8c\xd1\x07\x90\x19\x68\xa7\x10\x1e\xf9\xe5\x50\xda\xa8\xbc\x6d\xcc\x5c\x6d\x54\x7e\x36\x96\xba\x57\xf5\x0e\xb4\x09\x1d\x05\x05\xc4\xc2\xe3\xab\x03\xa6\xd4\x87\x5d\x3a\xaf\x1a\x19\x62\xc5\x42\xe3\xec\xa8\xd9\xc4\x1e\xf8\xe8\xce\x14\x00\xad\xed\x00\xfa\xd5\x59\x04\x58\x1c\xda\xc2\x79\xd1\xa8\xbc\x0e\xbc\xd4\x91\x8c\x2c\x96\x02\x03\x33\xc2\xbd\x5d\xd6\xec\xbd\x76\xf0\xc0\x8e\x44\x13\x48\x53\x80\x3a\xb8\x32\x09\x1b\x4b\x87\x2b\x6b\x4b\x87\xfe\x39\x54\x54\x75\x96\x82\x09\xc5\x57\x5e\xfa\x59\xba\x6a\x3e\xb5\x74\xd9\x87\xd2\xd2\x6d\x55\xec\x14\xdc\x60\xbb\x35\x8a\x93\x4b\x69\x42\xc2\x29\x0b\x17\xb7\x12\x84\x9a\x9d\x61\x6e\x1a\xee\x4a\x99\x76\x72\x9f\x54\x4a\x14\x6b\x8c\xd7\x31\x31\x12\x05\x39\x20\x59\x3a\xa3\xc8\x07\xe1\xd2\x65\x2f\xd6\x62\xe9\x2a\x0f\x1f\x62\xe9\xdc\x2b\xbd\x85\xc4\x44\x3d\xa4\x94\x1e\x21\xc1\xf2\x08\xc8\xa1\x31\x94\x09\xae\x24\x0e\xfe\x3a\x5c\xc9\x6a\x3a\xbf\x74\x43\xf2\x98\x88\x1a\xe3\xa5\x63\xf3\x6d\xb8\x10\xf7\xd7\x8e\xe7\xb4\x4b\x17\xa1\x2a\xc1\x91\xad\x69\x10\x93\xf9\x72\xd1\xc8\x20\x93\xf6\xe7\x2d\xdd\xd8\x74\x01\xed\x14\x60\x06\xf4\xab\x73\x7d\xcd\xe3\xc5\xab\xbb\x14\x49\x54\x2c\xdd\x47\x98\xb8\x51\x09\x78\x41\x3b\x2a\xf1\x48\xea\x63\x82\xdc\x5a\x01\xbf\x92\xcd\x0e\x37\x6e\x22\xc5\x23\x21\x42\x72\x7d\x8a\x98\x63\x2d\x13\x91\xe2\x54\x12\x45\xeb\x3e\x08\x3f\x6c\x36\x0c\xf8\xda\x64\x82\x90\xce\xa4\x89\x13\x42\xb4\xfc\xa0\x55\xb6\xe4\xe3\x4f\x4c\x62\x64\x22\xc1\xc6\xd0\x58\xc8\x15\xcd\x9d\x4d\x76\xda\x5c\xe5\x9d\xe2\x91\xb8\x41\xdf\x4b\x65\x2d\xc6\x6b\xa8\x2f\xe6\x88\x9d\x46\x96\x19\x9b\xd5\xa3\x73\x36\x37\x92\x19\xf7\xce\xe1\x37\x9f\x71\xab\x0a\x33\xcb\xf9\x55\x79\x97\xce\x4f\x92\x5e\xe9\x51\x4f\xb2\xe7\xa7\xa7\xf6\x99\x98\x33\xed\x6b\xc5\xe7\xd6\x00\x0c\xf4\x12\x9e\xd0\xf1\x13\x0e\xbc\x7d\xde\xa4\x17\xa7\x61\xd2\x2b\x96\x89\x92\xe7\xf8\x82\xee\x11\xa2\xfe\x67\xe9\x95\x6d\xb9\xcd\xb0\xc9\x20\xea\x3e\x8b\xd7\xf8\xd9\x03\x42\xdb\xf4\x7a\x9b\xcb\x5f\x6f\x73\x99\x27\x88\x8a\x13\x20\xb7\x8a\xd8\x69\x60\xf7\x7a\x8b\x3d\x13\xa0\xbe\x57\x2d\xfa\x5a\x5e\x7a\xe8\x3e\xfd\xa8\xe0\xa9\x46\xdb\xc8\x38\x2f\x94\x20\x8d\xd1\xe1\xfe\xcd\x37\x42\xd5\xa7\xfc\x93\xd1\x29\xc9\x3b\xc5\x72\x5c\x2b\x3c\xbc\x52\x5f\xb5\xf2\x39\x94\xde\x31\x2b\x77\x2d\x90\x93\xce\x60\xa5\xf8\xe4\x5f\x53\x5e\xf9\x9b\xa2\x51\x6b\xdc\xa9\x03\x39\xb7\x9d\x3e\x0e\xf0\x79\xff\xe4\x72\x1c\xb0\xfe\x8d\x9e\x5d\x44\x03\x44\x85\x15\xfa\xb3\xaf\x3f\xae\xf8\x08\x63\xf4\xbd\xf8\x68\x1b\xd7\x63\x87\x2b\x3e\xda\x16\x8d\xd9\x5b\x44\xd8\x5d\x23\x40\x3f\x29\x88\x4c\xc9\x75\xc5\x03\xca\x8c\x86\xf7\x39\x66\xa5\xd7\xc1\x9c\xfa\x8f\xb3\x3f\x9d\xfa\x03\xca\xe5\xaa\x7d\x03\xf2\x59\x21\xe9\x05\x67\x34\xbc\x50\xeb\x9a\x11\x3a\x44\xf2\x46\x7f\xf9\xe6\x4e\xfa\xa0\x92\x9f\xa0\x79\xf6\xdb\x64\xf3\x51\x4c\x9b\x3f\x52\x24\x6b\x48\xd9\x2c\x08\xde\x59\x43\xf3\x98\x94\x3c\xf3\x8e\x57\xc7\x38\x63\x31\x1e\x9d\xcf\x36\xf9\xb4\x2e\x80\x47\x54\x52\x7c\xb4\xfa\xcb\x08\xef\xa6\xb9\x20\x74\xf6\x47\x0c\xb8\x12\x82\x81\x04\x39\x0d\xd2\x07\xe7\x22\xd6\x45\x20\xf2\xfa\x39\x12\x51\x0d\x08\xb2\x49\x2f\x10\x6c\x6b\xfb\xd1\x0e\xbc\x87\xec\xa3\x0d\x68\x0d\x09\xe9\xbe\xd8\xb3\xc0\x5d\x02\x96\x43\x48\xa6\x2e\xe2\x23\x4a\xe5\xbb\xc9\x49\x83\x48\xa6\x1a\x9c\x62\x8d\x21\x58\xcc\x43\x70\x6e\xad\x38\x61\xd7\x8d\x64\xc4\x17\x4e\x32\xab\x69\x39\xe7\xe7\xf8\xa1\x64\x6e\x9c\x2e\x5b\x1b\x7b\xe7\x81\xef\x9e\x60\x31\x31\x41\xb2\x32\x31\x41\x7a\x1a\xc0\xe2\xe3\x10\x68\x6f\xd1\xc7\x01\xa6\x56\x78\x4c\x33\x2b\xb4\x67\xa6\x63\xfa\x3f\x2b\xb5\x3e\x3e\x5c\xc3\xef\x52\xc5\xa6\xcb\xa0\x56\xc7\x03\x13\x67\x04\x9c\x4e\xf3\x42\xd6\x12\xd1\x36\xa5\x8f\xe8\x2d\x1b\x8f\x69\xbb\xa6\xf8\xe5\xfc\xad\x8a\xe2\x17\x69\x74\x5b\x3b\xa5\x20\xf1\x30\x3b\x8b\x9a\xbb\x89\x42\x1a\xfb\x69\x44\xf7\x94\x90\x91\x57\xf9\x7a\x88\x40\x6e\x4a\x60\x1e\xc0\x26\xf3\xd3\xba\x4a\x1e\x97\x52\xce\x71\x84\x98\x94\x43\x17\xc0\xea\x46\x01\xcc\x08\x4f\xd7\x17\x4e\xf0\xd2\x94\xe1\x38\xb0\x0f\x71\xde\x9a\xc3\xd0\xd1\x8b\x94\x20\x3f\x93\x00\x3f\x4c\xd0\xe2\x03\x11\xf7\x9c\x26\x9a\x56\x1d\x18\xe3\x30\xf5\x8b\x82\x9f\x5f\xcb\xa8\x7b\x04\x30\xda\x71\xca\xed\x70\xe9\xca\xde\x72\xee\x26\x7e\x51\x5e\x6f\x0e\xb5\x4e\x92\x38\x0b\xde\x67\x10\x98\x5e\x62\x28\x99\xb2\x8d\xf5\x17\x45\x8e\xd5\x39\xe5\xfd\xc3\x14\x88\xff\x65\xdb\x32\x7a\x2d\xd9\x63\x1a\x93\x50\x4c\x21\x13\xac\xf5\xca\x74\x15\x37\x20\xb1\xd2\x34\x8c\xc4\xd0\x39\xb7\x70\xb2\x3f\x96\xca\x3e\x23\xe5\x05\x6d\xd5\x09\xf6\x58\xdd\x79\xaf\xb0\x80\x81\x80\x0f\x73\x7c\x07\x5e\xeb\x4d\x04\x2a\x9b\x98\xd0\xcd\xf1\xd5\xdf\x34\xe8\xc7\x57\x57\xef\xfe\xce\xce\x5f\xf8\x9d\x9d\x5c\x15\xc3\x94\x30\xe6\x28\xc0\x47\xd7\x4d\xa1\x47\x6b\x32\xd4\x44\xaa\x0a\xc8\xd0\x21\xdd\x8a\x5d\xed\x32\xf5\x61\x8c\xf3\x67\xf9\x30\xc6\xc9\xc3\xa4\xd2\xc8\xb3\xe8\xaa\xc2\x13\x9f\x16\x86\x88\x5a\x74\x72\x98\xe0\xd9\xd9\xb8\x84\xca\xb0\x7a\xc0\xa3\xc9\x76\x7a\x40\x5a\x40\x17\xc9\x17\xa4\xa8\x9d\x42\x26\x4c\x7d\x03\x13\x34\x11\x4f\x54\x71\x53\x98\x39\x64\x58\x94\x48\x9a\x05\xff\xa2\xd5\x23\x37\x6c\xad\x1e\x29\x3e\x15\x42\x16\x46\x01\x92\x77\x91\x8c\x92\x07\xad\xbc\xb6\x42\x39\x9e\x4e\xa1\x3e\x10\x10\x0f\x52\x04\xd3\x4b\x68\x1f\x47\xf6\x95\x4c\xad\x9f\xa3\x53\x73\x8a\xfa\x91\x5f\x9c\xde\x4e\x37\x77\xff\xe2\xec\x8d\x0e\xb3\x68\x4f\x9f\xa4\x8e\x2f\x8d\xa1\x74\xbe\x78\x0a\x4c\x74\x9f\x0d\xe0\xa3\x8d\x1a\xce\xdb\xa9\xd9\x3a\xf8\x27\x69\x8c\xb2\x7a\x0c\x7f\xe0\xb0\xfd\x13\x9a\x52\xfe\xf7\x38\x5b\xc5\x47\x5b\xa8\xd0\xa9\xb6\x52\x5c\x7f\x92\x31\x38\xfb\xb1\xf2\x95\xf4\x49\xc6\x18\x16\xd1\xbb\xad\x8c\x5f\x8f\xc5\x0b\x56\xda\xbf\x52\x21\x92\x6b\x4c\x66\xd0\x0c\xb2\x32\xef\xcd\x39\xb8\x29\x0d\x3b\x4b\x66\xe4\x60\x6d\x4c\x57\xb1\x7e\x32\x27\xc7\x7d\x49\xcb\xdc\x9d\xce\x30\x64\x48\xbd\x69\x45\x90\x85\x4f\x5a\xf9\xce\x5a\x99\x4f\x4a\xee\xcc\x21\x87\x1d\xac\xdf\x7f\x9a\xc3\x62\x1c\x70\xf3\x42\x3a\x34\xe3\x4f\x4a\xc6\x8e\x43\x6f\x7e\x52\x6b\xe5\xdf\x08\xd2\xd8\x7d\x52\xad\x25\x11\xf9\x93\xd2\xeb\x71\x16\x8f\xa5\x8a\x74\x7e\x25\xcd\x86\xb6\x6a\x84\x9a\x9d\x83\x2f\x4f\xb8\x77\x13\x82\x8c\x5c\x2a\xce\x42\x6f\x7b\x59\x33\x30\x90\x37\xb5\x3e\xd5\xbe\x6c\x7f\x93\x8d\xb2\xcd\x81\x64\xd4\x23\x36\x37\x93\xc4\xe7\x77\x22\x22\xbf\x3f\x59\xc2\x7c\x52\xa6\xe9\x58\xbc\xff\xd4\x29\xc5\x41\x1c\x3e\x75\xca\xa2\x17\x30\xf1\xa9\xd3\x0d\xd0\x1a\x3e\x43\xa7\x23\x1f\xd1\xa1\x7f\x05\x4b\x80\x56\xe0\x45\xb9\x53\xfe\xa6\xc0\x4f\xe2\x93\x56\x56\xa5\x98\x9d\x44\x24\xad\xef\x27\xbd\xb5\xca\x5f\x70\xba\x50\xbd\x6e\xd0\xf8\x6f\x5a\xce\xda\xec\xc2\x27\x4e\xa8\x16\xe3\x43\xbf\x36\xba\x61\x7f\xcf\xcc\xe1\x5d\x7d\x81\xc9\x4b\xe3\x68\xed\x85\x49\x2f\xa1\xa4\x0b\x9e\x9d\x9e\x0c\x67\x98\x72\xe8\x97\x94\xa9\xe4\x64\x94\xa8\x64\xca\x9d\x48\xe8\x49\x19\x16\x2b\x0c\x62\xa0\x56\x2d\x61\xe7\x68\xbb\x06\x91\xa4\x8a\xf9\xa4\xe1\xe0\xcc\xc6\xcb\x1e\x2d\xe1\x27\x04\xea\xd0\x6a\x46\xf6\x5a\x50\x33\x69\x9b\xa5\xf8\xe4\x7c\x8b\x07\xd4\xf6\x4a\x7f\x40\xa2\xfa\x3c\xce\x73\x85\xc0\x3d\xd3\x18\x91\xf0\xd3\x74\xfb\xf4\x27\xe7\x77\xe8\x87\x20\x19\xb1\x93\x1b\x53\x3c\xb2\xb6\xe9\x93\x77\x36\xec\xb4\xb4\xe2\xef\x37\xd7\x69\x1f\xe5\xdf\x6f\xae\x79\xfb\xcc\xdf\xad\xf3\xe2\xef\xce\x0b\x8e\x07\xcb\x1d\x55\x65\x20\xf9\xab\xf2\x56\xef\xd4\x03\x00\x57\x96\x07\x81\xc2\xaf\x0b\x00\xcb\x86\x54\x9e\x58\xfd\xe1\x00\x7c\xf1\xab\x1e\x36\x93\xda\x41\x5e\x1c\xab\xc8\x8b\x67\x2f\xd7\xce\xc3\xc7\x39\xe3\x60\xa5\x69\x7b\xcb\xbb\x8b\x9a\xf5\x5e\x1d\x6a\xf2\x63\x50\x18\xcb\x30\x44\xe5\xf1\xbf\x6d\xfb\xc1\x86\xb2\xa5\xe0\xec\x65\x08\xca\x97\xb8\x13\x67\xb5\xdc\x75\x76\xd4\xdd\x9f\xbd\x92\xa1\xda\x49\x7c\xf6\x4a\xc6\xa6\xa3\x05\x3b\xc2\x64\x59\x24\xce\xd8\x1e\x3b\x88\xb3\x57\x87\xa8\xb2\x73\xd5\x33\xd2\x24\xc1\x93\xe4\xef\xf7\xc6\x36\xae\xa5\xcc\x39\x2b\x88\x33\x8e\x7b\x84\x1a\x79\x20\x06\xcd\xfa\x17\x5c\x80\x01\x16\x6f\x90\xc3\x4d\x1c\x40\x6e\xd0\x0b\x57\x9b\x62\x5b\x9d\xa5\x6d\x0f\x09\xe0\xfa\xe1\x19\xeb\x02\x69\x0f\x55\xda\x25\x70\x86\xbb\x59\x68\xed\x10\x6e\x47\xf1\x35\x50\xa3\x7e\x06\xac\x89\x29\xcc\x19\xef\xc1\xb9\x74\xb6\x22\xd2\x4c\x24\x31\x60\x20\x41\x93\xf2\xbd\x6e\x54\xe6\xd2\x33\x5c\xe8\x30\x18\x79\xc8\xa0\x7c\x85\x5c\x15\xa0\xdf\xc7\xa7\x0e\xe2\x2c\x79\xf1\x2f\x66\xdc\x67\x6f\x9a\xce\x89\xb3\x37\x83\x36\x6e\x2b\xce\xde\x3c\x0d\xce\x47\x7a\xbc\x20\xce\x2e\xa5\xc6\x92\xbe\xd4\x56\xda\x46\xd3\x82\x12\xc5\x36\x16\x67\x74\x16\xae\x8a\x9c\x95\x87\xce\xa1\x44\x58\x9d\x7c\xf6\x56\xb9\x14\x97\x53\x9c\xa1\x5d\xb1\x3f\xf0\xa4\xea\xec\xca\xd5\xbb\xca\xcf\xae\x96\xcb\xbb\xd7\xce\xed\xb4\x0a\xe2\xec\xdd\xd6\x3a\xaf\xde\x7c\xb8\x14\x67\x93\x12\x80\xac\x7e\xf2\x94\xbc\xc6\x5c\x5d\x88\xeb\x13\xed\x94\x4e\x5a\xf3\xb3\xa3\x3d\xf1\xf8\x5f\xf6\x27\x8c\x30\x4a\x63\xaa\xaf\x37\xe1\xd4\x57\xe7\xad\x04\xf5\x56\x9a\xb3\x1c\x2c\x8d\xb6\xff\x9c\x51\xed\x21\xc1\x9d\x09\x68\x67\x39\x5a\xfe\x19\x05\xef\xad\xae\xcb\x61\xb0\xe9\xcb\x72\x14\xec\x37\x4f\x83\xf6\xd5\x23\x95\xe0\xd8\x09\xf2\x88\x01\xe5\xc2\x9c\x85\xf2\x7b\xe5\x0b\x39\xae\x87\xa3\x73\xe8\xb3\x51\x9b\x80\xda\x09\x5f\x19\x52\xf6\x6e\x88\xa4\x93\xad\x6a\x2f\xd1\x5d\xcb\x19\x6b\xb3\x5e\xb6\xad\xe7\x0b\x31\x87\x1d\xfe\x4c\xc9\x92\xfd\x66\x08\xda\xb8\x92\x0f\x0f\x5e\x07\x81\x3c\x3b\x0a\xfb\x98\x38\xf4\x84\x29\x6e\xdd\x24\xff\xe9\xf8\xd5\x0b\x73\xfa\xba\x37\xf2\x69\x12\x67\x12\x39\x15\xcc\xce\x12\xc9\xb8\x0d\x59\x93\x5b\x3d\x38\x97\xe6\x1a\x67\x1c\x58\x0c\xfb\xcc\x90\x49\x9c\xb8\x24\xe2\xce\xb3\xde\xfd\x2c\x87\x23\x3b\xbb\xd1\x76\xfe\x0c\xda\x56\xd0\x79\xf6\x13\x51\xf1\xea\x87\xc0\xa8\x5a\x29\x8f\xfd\xe3\xf0\xcb\x02\xfd\x98\x62\xa8\x01\xe6\x95\xb9\xb3\x12\x1b\xe2\xac\xf6\xa5\x7e\xf6\x61\xb3\x11\x67\x73\x9f\xdb\x67\xa9\xcf\x2d\xae\xa2\x43\x22\x8a\x83\xe7\xb3\x63\xdf\x11\x41\x9c\x71\x4c\x21\x44\xa5\xab\x23\xcc\xdf\x07\xbe\xf9\x9d\x0c\xe1\xd1\x79\xac\x4e\x40\xa1\xb5\xcc\x9e\x9a\x04\x55\x78\x38\x5a\xd9\xab\x32\x1d\x3a\x9b\x3b\x25\x3d\xbb\xd3\x83\xaa\x6a\xe8\x9d\x83\x92\xbf\xf3\x0a\x0f\xc9\xcf\x5a\xd5\xa2\xca\xd7\xb8\xf3\x0a\xdd\xaf\x9c\x65\x4f\x64\x81\x71\x8a\x47\x7c\x56\x3f\x2d\x41\xe7\xb9\xf9\x66\x9a\x2a\x25\x2f\x81\xd7\xb6\x23\x67\x1c\x2e\x9d\x2a\x35\x3b\xcc\xc1\xcd\xe9\x67\x39\xac\x1b\x17\xe3\xec\x63\x63\x05\x2b\x8f\x3c\xb5\x23\x3d\x23\xed\xc7\x64\x08\xe3\x9e\x15\x9e\x73\xa1\x62\x5d\xea\xd3\x4d\x73\x21\x31\x8a\x02\xea\x0c\x8d\x7c\xf3\xa0\x51\x53\x65\xe4\x80\x02\x26\x6f\x5b\x5c\x17\xae\x94\x84\x2f\x5d\x29\x09\xeb\x01\x84\x0c\x70\xae\xa4\x6d\x0d\xbc\x4e\xaa\x52\x94\x9e\x18\xa2\xd9\xca\xe6\x22\x21\x90\xb3\xd2\x78\x90\x0d\x14\xaa\x02\xc9\x3c\x76\x9d\x77\x56\x16\xc8\xcf\x40\x0e\x6a\x3f\x40\xcd\xc5\xb5\x5c\xab\x19\xe1\x12\xee\xd9\xd2\x0d\xf5\x75\xd2\x92\xd9\x59\x5a\x33\x23\x90\x5c\xf1\x32\xe5\x42\xf2\x59\xc7\x0c\x9f\x96\xef\xce\x3e\xfa\x2d\xf6\xf4\x20\x11\x1d\x49\x41\xc0\x9c\x89\x36\xc0\xba\x60\xc5\xf7\xfc\x54\xaa\x27\x49\x2d\x75\x36\xd1\x4b\x3d\x7b\xfe\x7f\x35\x3f\xfd\xe3\x5f\xcd\xed\x4f\xcf\x1a\xd7\xc3\xbf\x9f\x3d\x5f\xff\xf4\xfd\xe7\x3f\x7f\xfe\xeb\xf7\xcf\xd5\x4f\xdf\x7f\xfe\xeb\xe7\xbf\x7c\xff\xfb\x73\xf5\xb7\x97\x8b\x9b\xe7\xea\x6f\xf7\x78\x7c\x7d\x7b\xf3\xfc\x5f\x70\xd6\xbf\xe8\x9c\xdf\xbf\x7f\xae\x7f\xfa\xfe\xa7\xef\x7f\xff\xe7\xef\xbf\xff\xe5\xb9\xfa\x9b\x67\x11\x2e\x8d\x3f\x7f\x7e\xd6\xcb\x68\xe4\xfa\xd9\xf3\x0d\x7f\xf9\x3f\xab\xbf\xfc\x6b\x2f\xbd\x88\xff\xf5\x0f\xbe\x1c\x3c\x41\x40\x81\x05\x1e\xe0\xd9\x9f\x9e\x3d\x57\x78\x6c\x7e\xfa\x87\xfa\xdb\xab\x37\x70\xbf\x67\x7f\xfa\xd3\xb3\xdf\xff\xf9\xfb\x3f\x9f\xfb\xff\xfa\x97\xff\xe9\x07\xc8\x82\xa7\xf8\xd3\x3f\xfe\xf4\xf9\x6f\xff\xfc\x2b\xdc\xfd\xff\xf5\xe8\x92\xe4\x5f\xbb\x9f\xfe\xb5\x53\x07\xe8\x03\x7e\x7a\xb6\x46\x2b\xe3\x46\x06\x25\x1a\xdc\xb8\xd8\x18\x19\x42\xab\x36\xa2\xa1\x05\x73\x25\x94\x09\x74\xd0\x1b\xa1\x6c\x2b\x94\xa5\x50\x0d\xaa\x15\x0a\x3d\x31\x8b\x8d\xf3\x22\x3d\xbb\xd8\x1a\xb7\x96\x46\xe8\x8d\xe8\xb9\x67\x72\x30\x73\x45\x87\xb8\x83\x84\x6e\x44\x0c\x50\xc6\x21\xc2\x27\x1c\x8a\x03\x31\x7a\x3a\x11\x86\xbe\x15\x81\x0c\xf7\xa2\x3f\x88\x47\x98\xdb\x3d\x7b\xbe\x1e\xb5\x89\x2b\x6d\x7f\x7a\x16\xb4\x15\x41\xdb\x16\x0e\x9d\x90\x40\x4a\xa4\x25\x32\x1a\x17\xe0\xd7\xc2\xa1\x13\x12\x48\x89\xb4\x44\x46\x94\x16\x7e\x2d\x1c\x3a\x21\x81\x94\x48\xc3\xf1\x1c\x8f\x9d\x08\xaa\x81\x5f\x0b\x07\xb8\x85\x6a\xf0\xd0\xe2\xb1\x13\x4d\x68\xe0\xd7\xc2\x01\x6e\x11\x1a\x3c\xb4\x78\x84\x27\x88\xf0\x83\x27\x88\xf8\x04\x11\x0f\xf8\x04\xb1\x13\xdd\x61\x70\x51\xa8\xa7\x01\x7e\xfd\x0b\x01\xe2\x9e\x71\xdb\x17\x03\x1e\x7f\x80\xe3\xb9\x18\xdc\xe3\xb9\xf0\x4a\x9a\xc1\x3d\x62\x0a\x67\x41\x1a\xbe\xf8\x28\xf0\x60\x63\xe7\x9d\x8b\xc2\xaa\xa7\x88\xa7\xcb\x75\x10\xb4\xde\xd1\xb0\x67\xb2\xc6\xd9\xdf\x84\xee\x25\xfd\x55\x8c\xf6\xd1\xcb\x41\xe8\x80\x54\x33\x98\xa7\x41\x6a\x2f\xa0\x2d\x6f\x8c\x73\x5e\x34\x4a\x1b\x41\xe1\xee\x7b\xd7\x0a\xaf\x7a\x11\xf4\xd6\x0a\xa9\xfd\x41\xac\x71\x0f\xd0\x6f\x9c\x26\xba\xe3\x54\x73\xba\x13\x6b\x98\x0c\xc1\x41\xdb\x06\x53\x63\x85\x32\x46\x0f\xbf\x51\xb2\x53\x42\xf9\x0d\xfc\x1a\x3c\x3c\xc1\x51\xdb\x3d\x14\x07\x8c\x10\x5b\x34\xfa\xc7\x23\x5c\x01\x81\xb1\x62\x08\x5a\x18\x34\xeb\xf4\x4a\x34\xde\x85\x20\x5a\x17\x05\x19\x1f\x0b\x1d\x06\x54\x52\x0c\xe4\x33\x73\xdb\xb4\xc2\x34\xbd\xf0\x32\xc2\x2f\x40\x95\xeb\x83\xb0\x4d\xe7\x5c\x50\x3b\x51\x4c\x96\x1b\xe9\xe3\x79\x18\x3a\x02\x83\x33\x62\x70\xe6\x1c\x08\x11\x86\x8e\x40\x17\xf6\xe7\x7e\xbb\x16\x7e\xbb\x3e\xef\xc2\x5e\x7c\xc5\x75\x6b\x67\x55\x10\xea\xa0\x84\x57\x43\x8f\xf7\xb1\x2d\x1e\xac\x30\xda\x92\x06\xc2\xb8\x2d\x81\x8d\x57\x5f\x08\xf5\x2a\x74\x5b\xaf\xa1\x36\x34\x63\x2f\xd1\x26\x14\xfd\xaf\x1b\xea\x7a\x6d\xab\xe1\x49\xc7\x5e\x19\xd1\xea\x00\x9f\x4b\xf5\x43\x3c\x40\x8a\x0b\x73\x9c\x3e\xea\xd8\x21\xb0\xd2\x06\x68\xbc\xc2\xab\x80\xfa\xd4\x56\xcb\xad\x58\x9b\x1d\xa6\xd1\x6b\x03\x87\x51\x6c\x8c\x1e\x8c\xc7\x64\x6c\x31\x69\x75\x2f\xbc\x8b\xff\xf9\x83\xd8\x60\x7b\x1a\xd7\xe7\x90\x6a\xdb\x9e\x87\x71\x2d\xd6\xe1\x69\x33\x5a\x61\x5b\x7c\xdc\x81\xbd\x6c\xeb\x04\x42\xa7\x37\x11\x2e\xd1\x68\xdf\x20\x21\xc2\x97\x51\xa9\xaf\x4a\xe8\x10\x1a\x69\x24\x7c\x96\x3d\xc9\xf4\xf0\x8c\x6a\x08\x58\x0f\x7b\xf9\x44\xa9\xb6\x62\xd0\x42\x0b\x6d\x37\xc2\x4a\x2b\x74\xa0\x23\xd0\x3a\x90\xcb\x5b\xf1\x9b\x78\xec\x0e\x58\xa3\xa5\x15\x5b\xdc\x6f\x79\x10\x9d\x6c\x65\x2f\x7d\x2b\x3a\xdc\x75\x26\x3a\x6d\xd6\x42\xdb\x3d\xa6\x30\x47\x69\xc4\x80\xbe\xe4\x05\x54\x14\xe5\x45\x64\x7b\x1e\xb1\x97\xe8\x87\xf0\x51\x9b\x9d\xb6\xc1\xd9\x67\xbf\x3f\xd7\x3f\xfd\xe9\xcf\xdf\x7f\xff\x3f\xcf\xfe\xe7\xff\xf9\x9f\xef\x3f\x7f\xfe\xeb\xff\x7c\xfe\x1c\xfe\xd7\xf7\x9f\x3f\x3f\xfe\xaf\xbf\xfc\xe9\x79\xea\xfe\x53\xf7\xf6\xec\xf9\xfa\x7d\x4d\xa9\x9f\x9e\x9d\x71\x37\xfc\x71\xc9\x1d\x35\x48\x4d\x7d\x78\xf6\x7c\x8f\x3d\xf0\xb3\xcf\x9f\xff\x8c\xe7\x7d\xfe\xfc\x97\x67\xbf\x3f\x27\xce\x3f\x98\xf3\x4f\xec\xb2\x91\xfb\xfd\x3f\xe4\xbf\x7d\x7d\xf9\x6f\xbf\xae\xfe\x99\xc0\x0f\xff\xf6\x9f\xff\xfc\x6b\xea\xbf\x9f\xfb\x57\x3f\xfd\xdf\x3f\x3c\xaf\x7a\xf6\xd3\xe7\x7f\x0f\xa7\xfc\xfe\xdc\xff\xad\xf9\xc7\x0f\xff\xfc\xe7\xf1\xfd\x9e\x37\x3f\x45\xbc\x4a\x88\xd2\xc7\xf0\x93\x4f\xa7\xfc\x0b\x4e\xf9\xfe\xf7\xef\x4f\x9f\xf0\xfd\xe7\xbf\x7c\x3f\xe3\xaa\xbf\xbd\xfe\xf3\xb3\xff\xef\xf3\xe7\xf0\xd7\xcf\x9f\xbf\xfb\xfc\xf9\x5f\x80\xce\x9e\x3d\xaf\x58\xbf\x13\xeb\x2f\x74\xea\xe7\xcf\xdf\x3d\x7b\xfe\xec\xec\xd9\x5f\xfe\xf9\xb7\xc6\xd9\x46\xc6\x3f\xc7\xbf\x7c\x7b\x44\x7c\xd2\xbd\x3c\x35\x22\x3e\xd3\x1b\x11\x3b\x65\x27\xc3\x12\x0c\x2a\xb1\xf3\xa3\x68\x1d\x8d\x17\x62\xb4\x18\xf6\x25\x44\x35\x08\x18\x20\x6c\x2b\x9c\x17\xd6\xc5\x67\xcf\xfd\x7f\x3d\x8b\x7e\x54\x62\x83\x56\x5a\xa3\xdd\x59\xf7\x68\xb1\x0e\xf6\x58\xf1\x6c\x2b\xa0\x03\xfc\x4e\x89\xef\xb4\xf8\x6e\x80\x5f\xa7\xc5\x77\xd8\x0f\x3d\x7b\x2e\xff\xeb\x99\x90\x6b\x19\xd4\x40\xfd\xed\x3a\x40\x9f\x25\xd7\xc1\x99\x31\xaa\x15\xd4\xeb\x55\x84\x9e\x28\x8f\x3c\xd5\x10\x50\x0d\x16\x00\x51\xb1\xa3\x84\x6c\xdb\xc6\x19\x48\x56\xa8\xd2\x4d\x20\x00\xea\x53\xe0\x72\xd9\xb6\xde\x3d\x62\xe6\x1e\x17\x3b\x32\xe4\x6c\xd6\xae\xae\x7a\x52\xaf\xca\xf6\x37\x07\x6f\x8e\x49\x14\x72\x23\xe4\xb6\xa5\x9e\x1c\x0e\x2b\xa9\x29\x5d\x73\xda\x26\x46\x0b\x1c\xb3\x0d\x87\x00\xc9\x2a\x82\xa0\x2d\x8d\x96\x40\x1a\x8f\x5e\x9f\xa4\x19\x3a\xd9\x74\xd2\x0f\x04\x2d\x85\x68\x1a\x84\xec\x9d\x8f\xc9\xc4\xfc\x3b\x1c\x56\xad\x1d\x75\x3c\xac\x36\xfb\x0c\x07\x80\x11\x7a\x40\x38\xea\xcd\x46\xbc\xb4\x51\x97\xf0\xd5\x42\x92\x8f\x56\x4a\x36\xf0\x39\x25\x06\x65\xc4\xe3\x0b\x4a\xce\x29\x59\x03\xe9\xdd\x00\x65\xed\xb7\x70\xd0\x71\x35\x79\x0a\xe0\x74\xbd\x8a\xba\x21\x18\xc6\x5e\x50\xaf\x8b\x47\xbe\x32\x40\x6d\x37\x8e\x50\x2f\x77\x8a\x50\x50\x91\xcf\x08\x8d\xd6\x45\x14\xa8\x04\x0f\x80\x3b\xcd\x6e\x3d\x65\xd8\xd1\x88\x19\x82\x6b\xe8\xb8\x4a\xa3\xd6\x6a\x98\x33\xbe\x00\x63\x84\xca\x82\xc9\x4a\x3d\x91\x81\xd9\x6a\x7d\x88\x6a\xe5\xd0\x7e\x4b\x86\x43\x3f\x48\x21\x63\x11\x56\x92\x98\x22\xa3\xb2\x41\xf7\x83\x90\xd1\xf5\x42\x46\xda\x37\x2b\xc7\x6d\xe3\xd4\x26\x55\x83\x71\x4b\xe6\x1e\x2b\x23\xb7\x5e\xda\xad\x96\x76\x45\x92\x99\x90\x7b\x21\x29\xd8\xf0\xaa\xa5\x89\xf5\x5a\x36\xbb\x88\x96\x73\xa8\x04\x86\xc3\x60\x5c\xcc\x60\xd5\x2a\x32\x51\x81\x92\x85\x56\xf0\x1f\xff\xce\xc9\xaa\x55\x8d\x6b\xe1\x7f\xa1\xd3\xb6\xc5\x3a\xb9\x46\x89\x12\x8f\xc6\xc9\x56\xac\x9b\x73\xb1\x6e\xf7\x12\xc4\x02\x63\xac\x58\x2b\xab\x36\x3a\xae\x1a\x98\x3d\xae\x41\xf4\x87\xc3\xe0\x50\xb8\xe0\x80\x4d\x2b\x89\x96\xfb\x15\x43\x91\xa5\x54\x61\xf0\x1f\x40\xfa\xe0\x64\x95\xa4\x91\x55\x12\x57\x56\xbb\x04\x42\x32\x15\x61\xfa\x50\x04\x96\x95\xb6\x4d\xda\x52\x3a\xa3\x57\x5b\x8c\xf8\x88\x81\x49\x8f\xf2\x7c\xf2\x3e\x89\x79\x5f\xdd\x18\xc5\x7a\x93\x1b\xcb\x7a\xb3\x81\x37\xde\xac\x60\x90\x5d\xa1\xc8\x06\x44\xaf\xed\xaa\x71\xeb\x83\x91\x62\xbd\xe9\xbe\xe2\x33\x6c\x8c\x93\x91\x93\x41\xac\x37\x20\xf3\xe0\xf1\x07\xb1\xde\xd0\x29\xba\x49\x4b\x45\xab\xa6\xac\x22\xad\x35\x6b\xa8\xd7\xc9\x63\xf1\x5a\x0f\x39\xf6\xca\xda\xb8\x66\x47\x47\xaa\x15\x83\x58\xbb\x56\xad\xb6\x52\x5b\x42\x43\x07\x33\x80\xb5\x8b\x1d\x54\x1d\xb1\x86\x02\x77\x4f\xf4\xe9\x29\x9d\x7e\x79\x9c\x35\xac\xc7\xed\xca\x2b\x0c\xc1\x01\xd2\x79\xbb\x82\x06\xf4\x3f\x2f\x7e\x20\xea\x0b\x33\xd9\xba\x64\x0d\xb2\x7d\x03\x5d\x66\x23\x71\xb6\x0e\x69\x54\xc0\xc3\x45\x5c\xb4\x03\x6e\xa4\xdf\xa2\x20\x26\x91\x1f\x55\x80\xda\x3a\xb0\x9d\x3b\x4f\x50\xe4\xd8\x74\xb9\x97\x6b\xa8\x74\x9b\x76\xb3\x5a\xa7\x28\xcd\x4c\x45\x49\x20\x95\x08\x10\xf4\x67\x82\x9d\x3e\x27\x90\x2d\x83\x57\x23\x99\x2e\x21\xbb\x65\x9b\xe0\x09\x13\xa4\x76\x48\x37\x78\x24\x49\x15\x11\xd5\x8e\x15\xc9\x2c\xf9\xbf\x9c\xc7\x4b\xa4\x44\xc1\xc4\x92\x1e\xa6\x9b\x2c\xa0\x22\xcb\x48\x5a\x1e\x47\xec\x60\x54\x4c\x19\x6e\x4b\xf1\xb6\x91\xb2\x1c\x7e\x77\xfa\x72\x36\x47\x01\x2e\x2f\x57\xf1\x02\xed\x39\x5c\x45\xce\xc8\x57\x1b\x30\xda\x0f\x41\x0a\x46\x81\xd8\x4b\xbb\x5b\x41\x6f\x49\x04\x79\xd2\x45\x22\xa0\x33\x14\x3c\x81\xe8\xc9\x95\x1f\x69\x1d\x52\x34\x2d\x6b\x37\x60\x56\xa1\xed\x56\xa4\x07\xe9\x29\x06\x7d\x43\xe2\x2c\x25\x1a\x75\xcc\xa2\xd9\x88\x66\xd3\xc2\x04\x65\x10\xcd\x86\x5b\x7a\xb3\x55\xae\x55\x01\x4a\x62\x4b\x21\x39\x87\x82\xd2\x1f\x3b\x69\xb7\xe8\x0b\x95\x21\x48\x0b\x4d\x27\x5d\xd8\x32\x0f\xa6\x02\x90\x80\x48\xcb\xe9\x39\x02\xa3\x03\xe5\x0c\x74\x84\xde\xa4\xe9\x5a\x0d\xff\x57\xeb\x43\xe8\xd4\x1e\xde\x2c\xe3\x11\x70\xb3\x6b\xf5\x9e\xc0\xca\xa5\xe0\x69\xa8\x59\x84\x99\x74\xe7\x8c\x0a\x3b\xb8\x8c\xd7\x21\xba\x0d\x00\xd7\xcb\xa8\x9b\x95\x46\x6b\x8d\x42\x5b\x52\x3e\x35\x30\x46\x83\x20\x3d\x1a\x69\xe3\x8a\x36\x27\x34\x46\x49\x8f\xa2\x00\x14\xea\xb6\x8b\xcc\xf1\x68\xd4\x42\x98\x64\x81\x95\x41\x17\x40\x8d\x51\xeb\xd0\x74\xab\xad\xf3\x2d\x34\xa1\x44\xa6\xab\x85\x30\x70\x92\x8a\x0d\xa3\x19\xe3\x11\xc7\xd9\x26\xd5\x45\xa7\x36\x1b\x51\x0d\x23\x8d\xdb\x7a\x09\x33\x5a\x03\x3f\x78\x5b\x85\x40\x35\x31\xe2\xd4\xaa\x71\x66\xec\xad\x1b\x18\xd0\x5c\x87\xf1\xa3\x4c\x6c\x9e\x0d\x34\x18\xe8\x8b\xc6\xe7\x86\x83\x7e\x41\x6f\x76\x3e\x8c\x9a\x44\x7d\x4f\x0c\x7a\x28\x5c\x29\xca\xe9\x2a\x33\x69\x61\x2f\xbd\x5e\xea\x8d\x53\xcf\xa7\xe6\x19\x35\xf9\xc4\x05\xbf\x1a\x44\xd5\x8d\x92\x57\x1c\xb5\xda\x64\xc5\x1d\x4a\xaa\x96\x92\x88\xf3\xe9\x71\x0b\x32\x5b\xe3\x6c\xaf\x62\xab\x3c\xd4\x82\x53\x3d\x32\x33\x8b\x80\xd6\xf0\xe5\x30\xde\x76\x06\x03\x21\x1d\x31\x56\x35\x62\x1a\xc5\xa1\x57\x3a\x87\x17\x41\xc4\x99\xa9\x9f\x82\xd9\x24\xd2\xec\x23\x1f\xe1\xe8\x57\xd8\x61\x37\x69\x0f\x51\x02\x24\x55\x12\xc5\x5f\xd1\x46\xaf\xd7\x2b\x18\xaf\x1b\xb6\xb4\x6c\x9c\xf3\x90\x33\x1c\xf0\x90\x0a\x79\x38\xe4\x62\x1b\x0e\xd4\x52\xdc\x70\xc8\x95\xc2\x27\x75\x4b\xd6\x7a\x00\x80\x22\xd9\xbf\x80\x03\x4a\x77\x8d\x83\xcf\x8e\x89\x0f\xa2\xf1\xcd\xf9\xbf\x63\xb7\x82\x2d\x38\x5d\x9e\x08\xba\x03\xa9\x57\x3a\xd1\x04\x15\xc7\x41\x34\x01\x4d\x83\x45\x13\xe5\xc1\xc0\x2d\x41\x62\x70\xbe\x05\x09\xb5\x89\x79\x51\x95\x20\xda\x6a\x37\xe3\x3a\x5f\x78\x5c\xbb\x26\xf2\xc6\xa5\xcc\xb3\x06\x66\xd6\xcd\x5e\x34\x87\xc6\x40\x7f\xcd\x19\x48\x25\x6c\xb4\x6d\xd1\x4b\x89\x68\xe5\x21\xfc\xf8\x1f\x3f\x88\x76\x6d\xa0\x9d\xb6\x2a\xcb\xee\xad\x6a\x0c\x54\x56\x4e\x57\xd5\x17\x4c\xac\xb6\xd8\xaf\x26\xd6\xa6\xc4\x7d\xfd\x3f\x65\x8f\xb8\x2d\x2d\xe5\x7c\x89\x87\x8c\x63\x32\x65\x6f\x33\x0b\x4e\x5e\xd1\x17\x45\x6d\x66\xcd\x2f\x17\x79\x64\xe3\x8a\x56\x35\xe1\xd0\x8b\x56\x6d\x1a\x3c\x17\x5d\x79\x53\xb2\x92\x26\xae\x52\xff\xcd\xac\x7d\xda\xaa\x86\x34\x94\x02\x74\x0e\x4d\x07\x00\x7a\x24\x48\x03\x6e\x80\x07\xc4\xdf\x8a\xe4\xca\x55\x48\xfb\xe1\x5a\x65\xe0\xa7\xb0\xe8\x20\x81\x1b\x9b\x28\x45\xab\x7a\x87\x07\xbd\xc7\xe2\xb4\x0e\x9e\x6c\xc0\x80\x51\xd8\xcc\x58\x42\x45\x8c\xd5\x84\x04\x92\x35\xb0\xc8\x9d\x73\xab\xa0\x2b\xa2\x20\xbb\x2d\x89\x52\xed\x56\x8e\x21\xac\x64\x02\x6b\xd1\x6e\x95\xda\xc3\xb1\xef\xe1\xf8\xc5\x6f\x20\x09\x7b\x3a\xb6\xa4\x3c\x81\x03\xd7\x71\x80\xab\x0a\x27\x01\xaa\xd5\x1c\x0b\x10\xab\x78\xab\xb7\x3a\xd2\x18\xd2\xea\x5e\x36\x01\x24\x05\x10\x8c\x98\xd2\x7d\xa2\xe8\x5b\x17\x84\xd3\xd4\xba\x0e\x64\xb8\x92\x81\xda\x03\x99\x78\x73\xe2\xfc\x41\x64\xd1\x04\xfb\x81\x56\x07\x9c\xe9\x71\x1a\xe1\x11\x82\xc3\x3d\x0e\xa8\x3f\x82\x03\x7e\x60\x1d\x06\x6c\x28\x08\x46\x62\xfc\x4c\x23\x45\xfe\xd4\x3a\x0c\xf4\x2d\x75\x18\xa8\x63\x6f\x69\xf9\x5a\xb4\x7a\xaf\x5b\x85\x49\x70\x1e\xf8\x7b\x68\xc5\xed\x6e\xec\x7b\xe5\x57\x05\x8d\xa2\x35\x68\xd0\xd6\xba\x56\x35\xd3\x96\xd7\xba\x98\xa4\xb9\xd6\x45\x9c\xbb\xb4\xd8\xc9\xb5\x5e\x3e\xe2\xe1\xbc\xc5\xe4\x47\x4a\xda\x0d\x26\xd4\x1b\x21\xe2\xeb\xb0\x7e\x49\x35\x9d\x32\xce\x0a\x1c\x23\x1b\x67\x1c\x5a\x26\x30\x55\x99\x85\xd2\x74\x5a\xe9\xad\xb2\x61\xb5\x3e\xac\x7e\x93\x8d\x5b\x6b\x62\xec\xc9\xa2\x99\x30\xdb\xca\x62\x0b\xe9\x04\xba\x91\x57\xda\x0a\xa5\xd1\x51\xb2\xd2\x7b\xb4\xdc\x56\x38\x00\xb6\xd5\x6c\x3f\x73\x46\x9b\x18\xea\x1c\x86\x03\x04\x03\xed\x16\x27\x2e\x8c\x71\xca\xa8\x1e\x0f\x0a\xbe\x97\x32\x8a\x2c\x3c\x56\xa8\x69\x53\x46\xf7\x78\x58\x49\x63\xd6\x63\x44\x8c\x76\x8e\x05\xad\x46\xb4\x98\x46\x85\x2a\xea\x41\x0c\x88\xe6\xcd\xaa\x86\x4d\x85\xc7\x82\x37\x05\xee\xaa\x53\x06\x2d\x14\xd7\x73\xd4\x37\x72\x49\x23\x1e\x84\xb2\x2d\x0e\x60\x68\xec\x98\x4e\x03\x1c\x71\xf7\x28\x60\xad\xbc\x20\x91\x0e\x8f\x03\x24\x7a\xbf\xc2\x05\x05\x28\xdf\x4a\xe7\x3b\x99\x4d\x29\xbf\xd1\x42\x79\x4f\xe2\xbd\xf2\x1e\x2e\x07\xc7\x3e\x6c\x09\x04\xa1\x46\x03\x57\xdf\x0b\xb5\x47\x49\x96\x3e\xf3\x5e\xd9\x01\x8e\xfe\x20\xb2\x7f\xf3\x82\xce\x5b\xa1\xf6\xa3\xc5\xf6\xa9\x9e\x68\x16\xc2\x5a\x77\x90\x2a\x29\x79\xf4\xb1\xa0\x15\xa9\x85\x55\x9b\x54\xd1\x94\xa0\x53\x3b\x10\xa9\xa7\xf4\x94\x54\x53\xea\xc5\x94\x9c\x9d\x5b\x26\xa1\x35\xdb\x4c\xcf\x0a\xb3\x1b\x06\x24\x8d\x6e\x34\x3e\x18\x4a\x6e\xaa\x6c\xe1\xd1\x5f\x91\xc4\xbd\x2c\xea\x69\x80\x73\x02\x54\x81\xa7\xc8\x25\x40\xb2\x00\x8c\xa4\x20\x54\x7e\x19\x39\x8a\x17\x64\xa8\x1e\xae\x4f\x4e\x32\xd4\xd7\x6d\xd3\x8a\xef\x36\x62\xf3\x9f\x3f\x88\x8d\x6c\xa0\x91\x43\xb9\x80\x9c\x96\xb4\xed\x94\x54\x99\xa4\x4b\x27\x04\xd3\x5f\x42\x29\x37\x88\x8d\x0c\x71\x95\xe4\x7f\x95\x6c\xda\x90\x6b\xb4\xa5\xee\x1b\xa8\x88\xda\xfb\x8d\x42\xa7\x8b\x83\xd8\x28\x6f\x37\xd2\x28\xb1\xd9\x44\xb1\xd1\x6b\xf8\x45\x37\x74\x5a\x6c\xf4\x26\x76\x02\xba\x05\x90\xf9\x57\x3d\x4c\xa4\x90\x5c\x05\x32\x1a\x43\x8c\xba\xab\x8d\x36\x86\x14\x3e\x30\x01\x6f\x95\x28\xf3\xf0\x8c\x56\x30\x31\x2d\x14\x55\xc2\x42\x7b\x65\xc4\x46\xfb\x10\x79\xad\x44\x46\x18\xb8\x36\xac\xb1\xa7\xa1\x06\x8f\x76\xec\x07\x5e\x4b\xd9\x18\xf7\xa8\xfc\x2a\x58\xe9\x77\x62\x63\xc6\xd0\xd1\xf1\x05\x09\x8d\x88\x5b\x4a\x2c\xa7\x2b\x47\xab\xff\xb5\x6e\x60\xe3\xd0\x36\x6a\xe3\x3c\x8c\xf7\x62\xe3\x46\x0f\x22\x17\xa4\x5c\x91\x01\x42\xdb\xe3\x74\x85\xdd\x07\x12\x36\xa6\x34\xfd\x45\xdb\x18\x34\x5d\x05\x3b\x5f\x02\xc4\x80\x37\xc9\x3e\x21\x37\x1e\x8a\x75\xcd\x8e\x46\x37\x5e\x29\xb7\x11\x18\xb7\x03\x45\x31\x40\x56\x99\x55\x93\x51\x10\x1b\xef\xfa\xd5\x91\x1a\x72\xe3\xc7\xa6\x4b\x32\xfa\x66\x34\x06\xc7\x34\xa8\xfa\x40\xf4\x72\x48\xa9\x41\xe0\x25\x8d\x0a\x09\x8f\x6b\x28\xf4\xd1\x98\xa0\xf8\x4f\xb6\xe1\xea\x72\x5a\x86\x3a\x96\x9d\x80\xa3\x36\x90\xa0\x5a\x6f\x33\xda\x41\x6c\xf6\x62\xfb\x83\xd8\xbe\xa8\x17\x9b\x56\x20\x5f\xec\x18\x57\x9a\xa0\x39\x63\xd2\x79\x1d\x65\xd6\xba\x20\x94\x39\x44\x12\x41\x92\x04\x82\xe9\xe0\xdd\x1a\x57\xa9\xb6\x0d\xcc\x07\xb7\x0d\x0f\xaf\xdb\x66\x23\x1b\x3a\x42\x43\xdb\xb6\xa2\xba\xdb\xca\x48\x98\xb3\xc4\xd5\x23\x70\xe1\x14\x48\x57\x46\x6e\x47\xe5\xbd\x02\x82\x4b\x1d\xc6\xb9\x43\x2f\xb6\xca\x4d\x95\xa1\xc8\x28\x1a\xd9\x9a\x1c\x90\x84\x0f\x52\x54\x12\x19\xad\x7a\x25\xf1\xdf\xd0\xa4\xa1\x3e\x6e\x55\xa4\x40\x33\xb1\x88\x25\x5b\x15\x27\x93\xd5\x2d\x88\x77\x7b\x64\x9b\x91\xfb\xd6\x80\x24\xd5\x73\xe8\xc7\x95\xec\x53\x77\x0e\x19\x83\x7e\x52\x86\x90\x71\x71\xe5\x06\x7e\xea\xb8\x82\x49\xae\xaa\xac\xbc\x4e\xf0\x56\x2d\x19\xe4\x61\xde\x64\x5e\xbc\x4d\xc5\x59\xfa\xa5\xed\x76\x23\xb6\x1a\x2a\x3d\x2d\x58\x93\x78\x8b\xbe\xa7\xb7\x56\x6c\xed\x88\x0f\x40\xf3\xe3\x44\x79\x85\x13\xac\x42\x42\x7f\x59\x51\x11\x24\x99\x44\x33\xe5\xc4\x5b\x17\x06\xe5\x39\x59\xc0\xad\xfd\x79\x2b\xb6\xfe\x47\x38\x48\xa8\x9a\x5b\x2f\xfb\xd0\x74\xbd\x6e\xa3\xc0\x86\xf2\x1f\x49\x9b\xca\x94\xb2\x13\x8a\x44\x4b\xa6\x58\xb4\x44\x0a\xbb\x58\xe5\x13\x91\xb4\x19\x44\xd6\xc2\x0e\x71\x70\x6b\x23\x41\xd2\x35\x13\x1e\x94\xd7\x43\x07\xa3\x2a\xd3\x2c\xb1\x11\x85\x6b\x95\x04\x47\xdc\xc8\x87\x9a\x98\x95\xef\x60\x1e\xdf\xb8\xa0\x4f\x88\x7d\x93\x53\x4e\x4c\xc8\x40\xec\x29\xd0\x42\xc1\xb8\xb5\x55\x7e\xb5\x46\x0b\xff\xad\x77\xf1\x6b\xd6\x63\x74\xb2\xd7\x26\x3a\xbb\xc2\xd9\x5a\x21\x07\x19\xbb\xbc\x1e\x88\xc9\xea\x45\x02\xe7\xa2\x63\x67\x94\x19\x50\x95\xee\xe4\x5e\x74\x4a\x3e\x3a\x97\x1e\xa0\x83\x39\x44\x54\xa2\x53\xb8\xf9\x55\x74\xdb\x0d\x48\x04\x9d\x36\xd0\xf4\xa0\x7d\x30\x4c\x3d\x5c\xa7\x07\xf7\x28\xba\xec\xb7\x35\xa3\x89\xca\xb4\x73\x30\x09\xef\x9c\xb7\xca\x8b\x99\xe6\x4f\xff\x20\xf4\x0b\xf1\x9d\x5e\xab\x20\x74\xf3\x42\xe8\xe6\x5c\xe8\x66\x95\xa6\xe6\xba\xe9\xfc\x0b\x3c\x02\xdb\x85\x69\xd9\x7d\xab\xcc\x75\x33\xfa\x3d\x39\x2f\xd6\x18\x84\x1d\x8f\x1b\xe7\x09\x80\x54\x4c\x8b\x2f\xba\xd5\xbd\xd0\xed\xd8\xf7\x07\xa1\xd5\x17\x2b\xbe\xd3\x1b\xa1\x53\x73\xd5\x38\x12\x40\x1a\x84\xa6\xce\x4a\x27\x7d\x1c\x4f\xc3\x85\x36\x11\xad\x0c\x14\x1e\x51\x9e\x4f\xfa\x68\x94\x73\x40\x62\x49\x60\xd5\xe6\x30\xca\x85\x87\xcd\x4a\xd3\x16\x8c\x15\x4b\x94\x69\x11\x41\xdb\x76\x6c\x54\x0b\xe2\x09\xbf\x98\xe5\x75\xa2\xa1\xc0\x15\xf9\x08\x03\x86\x7e\x82\xa3\xcb\xb3\x57\x0d\x73\x62\x99\x2f\x0a\x13\xe4\x4c\xac\x2c\xf4\x54\x6b\x7c\x4f\x6b\x95\x4f\x35\x5d\x5b\x7a\x09\x0b\x0c\xa1\x2d\x62\x5c\xe0\x19\x12\x58\x65\xf5\x7a\x10\x2c\xa2\x45\x85\x08\x1d\x6a\x16\x04\x1f\x5f\xf3\x1e\x4e\xfc\xb7\x1b\x9c\xc6\xff\x60\x67\xaa\xd3\xfe\x8b\x17\x05\x9e\x03\x84\x7f\xaf\x40\xee\x49\x98\xe6\x2c\xab\xa6\x3d\xe2\xd8\x23\x4e\x98\x73\xda\xe6\x88\x73\xf4\xaf\xf6\xe8\x5f\xf6\xe8\x5f\xf6\xe8\xee\xf6\xe8\x5f\xe1\xe8\x5f\xe1\xe8\x5f\x81\xef\xce\xaf\x17\x61\x52\x96\x96\x2a\x0b\xc7\x8c\x40\xac\x7a\xf8\x08\xde\x0b\x28\xb6\xb0\xaa\x96\x40\x88\x64\x75\x1f\x10\x93\x9c\xa4\xd5\xd1\x81\x86\x25\x9d\x9b\x46\x98\x81\x95\xf3\xd5\xd9\x9d\x7b\x84\x1c\x1d\x5c\xef\xfc\xd0\x41\x2d\xe6\xf9\x74\x66\x85\x1e\xce\x18\x30\x6a\xb6\xd0\x68\x8e\x43\x56\x35\xab\x01\x32\xc2\xe4\x39\xa2\x57\xf8\x70\x3c\x24\x95\xc7\x88\xaa\x0f\xab\x5c\x87\xc5\x77\xbf\x89\xdf\x7e\x10\xbf\xbd\x10\x3c\x3b\xa5\x44\x5a\x51\xbe\x7c\xf9\xe2\xe5\x4b\x97\x2f\x5c\xbe\x6c\xf9\xa2\xe5\x4b\x96\x2f\x58\xbe\x1c\xa3\x41\x94\x4f\x57\x3e\x59\xf9\x54\x3f\x5f\x8a\xdf\xac\x40\x2d\xc3\x6f\xa4\x30\xf8\x6d\x34\x5a\xd2\x71\x05\xa3\x20\x23\x6d\xc5\x77\x3b\xb1\x6b\x95\x09\x78\x8c\x52\xec\xb4\x31\x78\x68\xd8\x76\x7f\xe7\x42\xdc\x49\xb1\x83\xae\x8a\x4f\xf1\xce\xaa\x66\x07\x8d\x8a\x1b\x60\x56\x2a\x64\x9d\xc2\x6e\xf4\xd1\xc1\x60\x90\x40\xb5\xde\x53\xb1\xe0\x6a\x99\x4a\x8b\x23\x99\x83\xcb\x22\x85\x3a\x5e\xf8\xc9\x79\x47\xab\x3f\x39\x07\xa6\x90\x99\xd8\x14\x48\x92\x64\x21\xbf\xb1\x22\x54\x9d\x90\xba\xff\xc2\xa2\xb5\xa1\x4c\xcf\x86\x89\xcc\x4f\xab\x44\x85\x91\x96\x8a\x6a\x0e\xaf\xf0\x64\xd6\xf1\xa2\x51\xc9\x9a\xad\x1c\x9d\xca\x28\x8b\x3c\x55\xee\xf4\x0e\xbc\x90\x54\x68\x5e\x4d\xca\x8c\xbc\x8a\x94\x39\x27\xae\x9a\xd6\x8f\x48\x74\x33\xb4\x55\x95\x17\xaf\x95\xc8\xc2\xae\x41\x67\x10\xa2\x88\xc4\xa9\x54\x28\xc5\x65\x6e\x1a\xa0\x8d\x0c\x51\x98\xf5\x66\x1b\x84\x69\xce\x77\x6d\x14\x86\x54\x75\xa6\x59\x19\x34\x13\x33\xcd\x6a\x14\x86\xf5\x9a\x06\xb5\x66\x26\x8d\x1f\xd5\xea\x7d\xb5\x6e\x6f\xb2\x6a\x88\x27\x81\x46\x45\xf8\xd1\xd2\x8c\x51\x34\x8b\x31\x6a\xaf\x57\x8d\xde\xeb\x28\x85\xe1\x49\x94\xd9\x46\xaf\xb4\x31\x3a\x08\xd3\x05\x61\xb4\x30\x5a\xe1\x40\x6c\xd0\x2a\xf9\x5a\xdb\x36\x44\x05\x4f\x89\x53\x75\x4e\x70\x08\x19\x9c\x61\x12\x5a\x0a\x4a\x1b\x4c\x7a\xb5\x4d\x6e\x83\x80\xc3\x3d\x4c\x9e\x5b\xc3\x9c\x8b\xe6\xc0\x80\x56\x4d\xd9\xf7\x1c\x44\x9a\x8f\x61\x0e\x15\xd9\x0a\x37\x21\x2b\xca\x5b\xd9\x66\xd5\xbb\xe4\x8f\xd3\xe0\x82\x16\x8c\xa0\x0c\xf7\xd2\x13\x1c\x04\x5a\x76\xa1\x59\x17\x2e\xf7\xc3\x01\x15\x77\xc6\x35\xd2\xe0\x31\xaa\xfa\x0e\x87\x64\xfb\x98\x97\x2c\x8c\xdb\x72\x43\x32\x0e\xa4\x2a\xe3\x60\x8a\xf1\x75\xb5\xc5\x7d\xba\x38\xa3\x6e\xd0\xf6\xc6\xe0\x00\x5d\xa6\x88\xc6\x93\x4b\x14\xe3\x29\x12\x9d\x30\x81\xdc\x59\xaf\x14\xed\xe0\x87\x97\x39\x62\xb1\x71\x01\xb9\x05\x3c\x91\xad\x9e\xf0\xa1\x52\x46\x1f\xaa\xb3\x3c\x06\x13\x94\xe6\x34\x17\xee\x36\xf6\xc2\x94\x6f\x3d\xae\x30\x4c\xcc\xb8\x16\x66\x6c\x24\x32\x78\x56\xf2\x5d\x2f\x7a\xd9\x78\xc7\xf3\xf9\x0a\xbf\x10\x30\x65\x5d\xd1\x97\x03\xb8\x76\x4f\x98\xe2\xdc\x0f\x00\x15\x16\x9e\x45\x9f\x1c\xa1\x51\x7b\x65\x56\x83\x46\x5f\xb4\xc8\x42\x45\x32\x00\xdc\xbe\x15\xe8\x34\x98\x1c\x70\x2f\x08\x02\x62\xcd\x1b\xf1\xf3\x64\xce\xd6\x59\x22\x3c\xda\xd3\xc3\xe4\x26\x2a\xe6\x6c\xd7\x93\x3b\xc1\x68\x80\x19\x34\xad\x5b\x69\x5b\xa6\x7a\x93\x8c\xc9\x24\x90\x72\xca\x62\x4e\x2f\x6d\xab\xcc\xda\xbb\x58\xc1\x15\x5d\x7b\x80\x1f\x9a\xbc\xf4\x72\xe0\x17\x8b\x4d\x97\x96\x62\x90\x00\x31\xb0\x97\xb8\x18\xd2\x22\xd8\x8c\xc6\x8c\x16\x6d\x20\x94\x67\x0e\x5b\x2c\xf7\x32\x76\xbd\xc9\x02\x23\xe4\x59\x7a\x06\xec\x3e\x28\xa1\xdb\x92\xee\x9f\xab\x30\xce\x84\x7a\x19\xc9\x60\x06\xfe\x56\x50\x7d\x2b\xb8\xc4\xd3\xaa\x31\xe8\xe5\x04\x20\x2f\x65\x00\xc4\x29\x18\x5a\xbb\xe9\xaf\x6a\x65\x06\x64\xea\xe2\xe2\x93\x5f\xf9\x69\x85\x6f\x05\xd3\xe4\x5e\x1e\xd6\x4a\xf4\xed\xff\x86\xfa\x85\x73\x18\x38\x54\xa3\x21\x93\x51\x32\x4a\xbd\x3d\x52\xd8\xbd\x13\x3a\x1e\xfd\x90\xdf\x66\xb7\xe9\x44\xce\x07\x42\xe4\xc2\x20\x88\x60\x43\x09\x57\x43\x84\xdf\x18\xf8\x38\x33\x0d\x66\x44\xd2\x80\x87\x78\x36\xd8\x21\x2f\x75\xe9\x44\xa4\x41\x2e\x51\x3c\xfc\x20\x79\x3c\xb8\x11\x7b\x36\xb0\xcd\x99\x65\xf8\xe1\x9c\x72\x45\x1e\xcc\x08\xf3\x40\x86\x44\x1e\xc4\x90\x9a\x5d\x21\x0d\x5e\xbd\x6a\x35\x7e\x1a\x48\x26\x65\x8a\xcb\xfd\xbd\x0a\xf0\x7f\x34\xed\xc3\x96\x2e\xe1\x3f\xdb\xcd\x8b\x55\xe8\xe4\x0b\x01\x5d\x68\xaf\x6d\xae\x28\xda\x92\x04\xdb\x8c\x11\x8d\x14\xb3\xc6\xb5\xd7\x56\xf7\xd2\xdc\xc1\x2c\x1f\x71\xaa\x45\x80\xc7\x7e\x15\x38\xde\x05\xc9\xa0\xbd\xb6\xce\xc3\x71\x90\xcd\x6e\x95\xfa\xac\xcc\xa0\xa1\x02\x6e\xc6\x92\x2a\xae\xd8\x4e\x18\x70\xff\x5d\xab\xbd\xe8\xad\x7a\x8c\xf0\x3e\x0e\x4d\xc5\xd5\x2a\xb7\x3e\x20\xf2\x0c\xf3\xc6\xb5\x8a\xb7\x7b\xf7\x4e\xad\xf5\x18\x44\xef\xec\x79\x68\xba\xd1\x03\x72\x22\x8d\x2c\xb5\x0e\xaf\x1f\x4d\xd4\x33\xfb\x2e\xe4\xa5\x36\xba\xda\x38\xbf\x8a\xea\x09\x2d\xf6\x28\x07\x57\x54\x10\xa6\xef\x5f\xf0\x8a\xcc\x19\xe8\x44\xe7\xd7\x3a\x12\x46\x3d\x0d\x3c\x30\x67\x0d\xa3\x46\x14\x0e\x74\x29\x34\x29\xed\x0f\x8d\x56\x26\xec\x34\x77\xb0\x56\xfa\x83\xc0\xa8\x40\xac\x61\x14\xb6\x49\x1f\xca\x36\xa8\x79\xb7\x59\xf3\x92\xab\x65\xea\x21\xcb\x84\xd3\xaa\x47\xf8\x25\xa9\xd8\xaa\xc7\x56\x61\x92\xee\xa3\x1e\x51\xd5\xca\x05\x0d\x19\x79\x05\xd6\xaa\xa7\xb8\x22\x83\x77\xab\x1b\x05\x73\x77\x18\xad\x01\xa7\x69\xb3\xd5\x36\x76\xc2\x3a\xe8\x08\xad\xb3\x34\x84\x54\x35\x9f\x8d\x70\x2c\xda\x31\xb0\xc3\x11\x0b\xb3\x96\x6d\x9e\xe9\x5a\x67\x69\x99\x0d\xe1\x57\xe5\x9d\xb4\x2d\x4b\x31\xd6\x45\x5a\xeb\xb1\x0e\x3a\x86\x83\xb0\x43\x54\xd1\xcb\x56\xd8\x61\x2f\x2c\x59\xbe\x59\x5a\x41\xa4\xa4\xda\xb0\x00\xdd\x14\xdc\x6e\x34\x86\xec\x44\xec\xd8\x0b\xb2\xc7\x50\xed\x6a\x4d\x3b\xc3\xf1\x85\x90\x37\x70\xba\x8a\x6e\xe5\x9a\xa8\x22\x66\x40\x5d\x88\xda\x36\xb1\x9e\x89\xa3\x21\xea\x1e\x1e\x6b\xec\x79\x74\x85\x53\x27\x67\x40\xbf\x69\xd1\x98\x0e\x8f\x9a\x12\x2f\xe8\xd2\x70\x13\xb6\xc9\x29\x0c\xa7\x5b\xe1\xda\x76\x45\x0a\x43\xd7\xb6\x83\x70\xad\x3a\x87\xc3\x0a\x6d\x80\x00\x19\x6d\x85\xd3\x6d\xf5\x94\x6e\x10\x6e\x50\x56\xd2\x11\xba\x26\xa8\x3b\x40\x78\x3a\xd6\xac\x47\x3a\x56\x2c\xdc\x7b\x07\x97\x20\x11\xc7\x0d\x11\xdb\xb7\xf8\xce\x79\x41\xb5\x18\x8f\x41\xa0\xf2\x0e\x75\x6c\x15\x1c\x08\xe3\xfa\x72\x46\xc0\x4c\x41\x87\x57\xc5\x84\x86\xb8\x38\xf4\x7b\xd5\x8c\xbe\xa2\x59\x97\xeb\x46\x5c\xf7\x1b\x00\x54\x5a\x13\x37\x46\xb7\x41\x7d\xc6\x20\x5b\xdc\xdf\x23\xd7\xce\xe8\x66\x45\xd6\x13\xca\xaf\x5a\x64\x72\xa7\x5e\xe0\x2a\x90\xb3\x70\x60\x6d\xe1\xc0\x5a\x52\x0c\xbf\x93\x34\xc2\x44\x44\xdd\x2b\x74\xa2\x85\x22\x1f\x1c\xce\xa1\xd1\x20\xda\x78\x89\x57\x65\x43\xc7\x8c\x70\xd8\x04\x0a\x37\x74\xc8\xd8\xe5\xe9\x3d\x12\x05\xe2\xea\x51\x51\x61\x67\x16\x5a\x92\x65\x0a\xd7\x91\x86\x89\x8d\xe1\x90\x6c\x0c\x87\xda\xc6\x70\x28\x36\x86\x43\xb2\x31\x1c\x4e\xdb\x18\x0e\xa7\x6c\x0c\x07\xb6\x31\x1c\xd0\xc6\x70\xc8\x36\x86\xc3\x1f\xd8\x18\x0e\x13\x1b\xc3\xa1\xd8\x18\x0e\xc7\x36\x86\x43\x65\x63\x38\xd4\x36\x86\xc3\xc4\xc6\x70\x38\x69\x63\x38\x9c\xb0\x31\x1c\xbe\x65\x63\x38\x14\x1b\xc3\xa1\xd8\x18\x0e\x95\x8d\xe1\x50\xdb\x18\x0e\xb5\x8d\xe1\x30\xb3\x31\x1c\x26\x36\x86\x43\x65\x63\x38\x28\xe9\x03\x7c\xee\x14\x37\x6c\xc0\x60\x5b\xb8\xb3\x0c\xb7\xa9\x70\x42\x83\x6f\x85\xe1\x4c\x54\xd0\x25\x65\xcc\xa0\xa2\x77\x7b\x31\xe8\x66\x27\xa9\xa2\x51\x8f\xbd\xe2\xc5\x6c\x26\x01\xa8\xa6\xa3\x13\x08\x4c\xd4\xbc\xa4\x12\x5a\xc1\x28\x4f\xb1\xe0\x60\xb8\x02\xc1\x5f\x0c\x30\xd7\x81\x11\xe7\xbc\xc5\xe4\x47\x4a\xda\x0d\x26\xea\x8b\x18\xf2\x80\x3c\xb8\xa6\xeb\x64\xdf\x43\x7b\x20\x5f\x5e\x50\x66\x38\x47\x04\x80\x63\x0c\x00\x98\xad\x42\x0a\xed\x12\xd2\xc1\x8c\x74\x2a\x4e\x3e\x11\x60\xcf\x01\x88\x56\x56\x11\x79\xdd\x8b\xc1\x19\xe9\xe9\x48\x15\x0f\x50\x74\x68\x68\x82\x18\xba\xb1\xa7\x83\xc0\x3e\x40\xb6\x2d\x81\xf5\xd8\x74\x6b\xa8\x4f\x7e\x4e\xaf\x1a\xaf\xa3\xf2\x58\x04\x34\x83\x30\xce\x82\x1c\x20\x8d\xc8\xd3\x0c\xfc\x2c\xce\x1c\x5a\x0c\x3f\x47\x7c\xb6\xf1\x59\x31\x99\x0c\x1c\xf4\xf4\xdf\x38\x11\x5b\xf1\x1a\x19\x73\x70\xb2\x94\x30\x5d\x78\xb5\x6d\x88\xb5\x4d\xcf\xc1\x0b\x09\x13\x82\x3e\xe9\x94\xc5\x72\xd9\x84\x47\x0b\xe2\xfc\x60\xf8\x30\xab\x89\x56\xb7\xe2\x03\x0c\x28\x13\xfc\x71\xce\x8b\x3a\xeb\xf4\x1f\x8e\x4e\x36\x4d\x4f\x80\x05\xbc\xf2\x0f\x10\xbe\x28\x87\xa4\x19\xfa\x5c\xd6\xf5\xea\x5c\x19\x95\x08\x8d\xd5\x17\x4e\xa3\xf6\xb8\x4a\x1f\x3c\x31\x60\x4c\x99\x92\x64\x77\x84\xbc\xe9\xf3\xe3\x0e\x05\xb2\x60\xa2\x5c\xaf\x7b\x4d\x02\x0e\x36\x09\xe4\x05\x35\xb6\x6e\xf2\xb1\x68\x72\xde\x4e\xbf\x86\xcf\xe1\x12\x90\xfc\xf6\x4d\xc2\xaa\xbc\x09\x73\x52\xc8\x0d\xa0\xa2\xa3\xcd\x61\x74\x3e\xbc\x6a\x88\x20\xf5\x0c\x2e\x92\x65\x84\x18\x70\x25\x9e\x4a\xeb\x11\xbe\x1f\x0a\x17\x8c\xa3\x18\x68\x3b\xf3\xe0\xd5\x7e\x55\xed\x22\x1c\xd2\x66\xc2\xc1\x6b\xdb\xe8\x81\x47\x4c\xb6\xef\x1c\x70\x79\x1d\x8f\x1b\x4e\xb4\x51\x84\x52\x97\x02\x98\x06\x47\x44\xde\x0d\x00\x5d\x8b\x83\x16\x6b\x3a\xab\x0d\xb0\x00\x51\x9b\x32\x04\x2d\x78\xd0\x1f\xe2\xc4\x59\x23\x48\xa8\xc3\xa8\xc9\xb2\x08\x00\x7e\xe7\x51\x17\x0b\xa3\x61\xd4\x3c\x9e\x01\xc4\x32\x16\xc3\x18\x3a\x31\x40\x67\xb8\x17\x5f\x20\xfd\x42\xba\xb4\x2f\xf1\x20\xbe\x8c\xb2\xc5\xc6\xe9\x9d\x21\xe2\x8b\xdc\x66\xa0\x33\x1a\x32\x0a\x09\x3d\x36\x19\x6d\x32\x72\x19\xd1\x79\x5e\x1b\x49\xdb\xfa\xbf\xa0\x5b\x20\xa3\x32\xa8\x46\xd3\x8a\x15\x65\x45\xa5\xa1\x27\x73\x78\x70\x2d\x34\x0c\x42\x85\x3a\x1e\x66\x73\xde\xd1\x58\x9b\x73\x60\xc0\xcd\xc4\xa6\x40\x1a\x7a\x0b\xf9\x8d\xf1\xb7\x3a\x21\x0d\xb3\x85\x45\x23\x71\xa6\x67\xc3\x71\xe6\xa7\x31\xb9\x30\xd2\xc0\x5c\x73\x78\x3c\xcd\xac\xe3\x21\xba\x64\xcd\xc6\xe9\x53\x19\x65\x48\xad\x72\xa7\x77\xe0\x61\xbb\xd0\x3c\x76\x67\x46\x1e\xb3\x33\xe7\xc4\x55\xd3\x68\x0d\xc3\x1b\x9d\x93\x86\xeb\x2f\xa3\x86\xf3\x2c\x1e\x5d\xc4\xa8\xcc\x5e\x36\xb2\x5b\xed\x39\x7d\x14\x5e\xb6\x8d\xb4\x90\xc0\xf4\x91\x14\x50\x9c\x54\x35\x28\x33\xa2\xcc\x38\x95\x4a\xa6\xa7\x86\xe1\xcc\xe6\x2a\x95\x28\x28\xad\x84\x8f\xab\x13\xe7\x24\x69\x32\x93\xb3\xba\xc5\x7c\xa8\x59\x0c\x37\x09\x50\xad\x4a\xc4\x37\xea\x54\xce\x4e\x35\x25\x31\xea\xbb\x22\xf1\x22\x53\x54\xd5\x98\x9a\x55\x34\xe6\xa6\x6a\x96\xc8\x54\xc9\x0a\xcd\x15\x80\x19\xc7\x15\x2c\x67\xa0\xef\x93\x4c\xce\x6a\xdb\x31\xbb\xd4\x8a\x9c\x57\xdf\x88\xeb\x59\xa2\x2a\x99\x2d\xb1\xb8\xe2\x31\x99\xab\x5d\xa2\xc9\xea\x66\x5a\x3e\x47\xf7\x8c\x6e\xf4\x16\x8d\x83\x32\x07\x66\xed\x8c\x53\x2d\xa5\xbe\x11\x05\x4f\xde\x3e\x8e\x7b\xa2\xbc\x8c\x64\x95\x0c\x00\x44\x31\x4c\x71\xa7\x8a\x97\x91\xc5\x11\x74\xe6\xc2\x8a\x7a\x1a\xcc\x64\x01\xd0\x81\x7b\xb4\x11\x53\x9e\x01\xfe\x17\x0f\x28\xb2\x65\x75\xb6\x97\x11\x47\x02\x2f\x23\x4f\xc0\x30\xe6\x03\x1c\x58\x1b\x8c\x90\xe6\x8a\x27\x38\x38\x7e\xd7\x0c\xd6\x65\x02\x6b\x7d\x80\x0a\xa6\x64\x0b\xd2\x2b\x9d\xd4\xc9\xd0\xa9\xfa\xca\xa8\x79\xc0\xac\x72\xa5\xea\x12\x2b\xab\x02\x6e\x29\x4c\x99\xce\x1a\x7e\x80\xa7\xa1\xc7\xcd\xdd\xab\x64\x0a\x00\x82\x64\xd9\x37\x81\x2e\x0d\x40\x54\x00\x40\x5a\x02\xaf\xd2\x7d\x9b\x48\xee\x0b\x00\x51\x33\x62\x00\x35\x73\xa5\x37\xab\xbc\x7d\x02\x32\xa2\x23\x21\xd6\xd3\xea\x09\xc9\x18\x74\x4a\x48\x14\x99\xb5\x78\x8a\x32\x4a\x49\xad\x5f\x38\xe2\xac\x50\x84\xf6\x0a\x6e\xcd\xa1\xc9\x10\xf1\x03\xf6\x6b\x07\x05\xd0\xc3\xf0\x1b\x08\x58\x4a\x9c\x47\x77\x09\x9b\xd1\x72\x42\x92\x8d\x57\xbd\x51\xf0\xb8\xbd\xdb\x2b\x4e\xea\x3d\x02\xcc\xa9\x74\x5f\xcc\xc1\x2d\x1b\x8c\xbf\x61\xe9\x76\x22\x97\x0c\xde\x38\xa3\xb6\xa0\x62\x16\x6f\x1c\xf6\xaa\xe7\x6f\xd0\xa3\xb1\xb7\x57\x7d\x38\xe0\x2b\xa7\xa7\xc2\xe9\x2f\x25\x64\x7b\x4d\xb6\x4e\x78\x4c\x4a\x38\xb8\x51\xd0\x2d\x9e\x1f\x9c\xd9\x4b\x1b\x6b\xb8\x92\x26\x2a\x6f\x95\x7a\x51\x33\xcb\x6a\x7d\xc5\x6c\xb5\x6a\xbd\x34\x13\xde\xce\x28\x6d\x8f\x18\x3f\xd6\x1c\x14\xa1\x74\x5c\xd1\xb3\x67\x36\x14\x82\xd4\x7e\x72\xb5\xbd\x56\x1e\x3f\x25\xd6\x57\x0e\x27\x97\x3c\x8e\x78\xb5\x87\x89\x86\xe7\x08\x84\x98\x46\x4e\xce\xd1\xe9\x03\xae\xa7\x08\xdf\x05\xe1\x75\xd3\x68\x91\x16\x99\x7c\x36\xd9\x10\x5e\x87\xa6\x13\xd0\x25\xf6\xad\xf6\xc2\xdb\xb4\xaf\xc9\x3b\x98\x59\x6c\x85\x77\xd0\x77\x40\x8d\xcf\xab\x5d\xe4\x69\xc3\xbb\x47\xf8\xb9\x01\x8e\xb8\x57\xca\xf3\x62\x16\x1a\x93\xab\x10\xc3\x08\x05\xf6\x5d\x10\x41\xee\x15\x1c\x60\x62\x99\x54\x73\x90\xaa\x76\x95\x37\xbe\xce\xe8\x1f\xe6\x8c\x17\xc4\x48\xf6\x3d\xa1\x91\x16\xe6\x8f\xa1\x41\xef\x3b\x68\x89\x53\xe1\xc9\xe4\x36\x34\xca\x2a\x81\xca\x5b\x92\x40\x03\xef\x8b\x0a\xb8\x6f\x28\x34\xd8\x89\x85\x62\x78\x14\x5a\xf7\x68\xd1\xb9\x0c\x7b\x54\x41\xd7\x2a\xce\xb6\x82\x7d\x59\x84\x7a\xf3\x1f\xd4\xad\x7a\xdf\x49\x50\xd8\xd1\xb2\x75\x04\xd6\x3c\x2f\x1f\x93\x95\x5f\x40\x4e\x6d\x6f\x18\x54\x54\xa6\x7f\xc2\x94\x26\xed\x6c\x34\x8a\xb3\x38\x38\xbb\xd2\x03\x86\x99\x8d\x21\xd2\xde\xf5\x03\x5e\x67\xba\xce\x15\x4e\xd8\x1f\x9e\xe0\x65\xfb\xc3\xa0\x22\xb5\x44\xdc\xbf\xb4\xa2\x90\x52\xb2\xc5\xff\x8c\xc3\xaa\x75\x31\x19\x80\x73\xa0\x22\x7a\x9c\x89\xc9\x62\x40\xdf\x3a\x50\x5e\x4f\x83\x71\xad\x12\x61\x23\x42\x27\x5f\x84\xb1\x87\xf4\xfc\x7f\xff\x07\x21\xe7\xa1\x86\x90\xf1\x5b\xa6\x1e\xd9\x9b\x6a\x66\x3f\xe2\x81\xba\x2d\x40\x69\x7c\x09\x5a\xf9\x41\xa3\xaf\x4c\xa3\x2a\x0a\x6b\x84\xde\x5a\x3c\xc0\x7d\xb2\x6b\xcc\xb2\x44\x07\xaf\xb0\xd2\x14\x2d\x40\xc7\x83\x48\x36\xed\xa8\xcf\x41\x02\x1f\x5b\xf7\x43\xde\x29\x17\x74\x8f\x56\xcc\xec\xc2\xa7\x83\x03\x46\x1c\x0d\x64\xf3\x43\x55\x45\x3f\xc1\x43\x27\x11\x31\x81\x4a\xd6\xab\x58\x51\x56\x54\x12\x52\x32\x07\x85\x91\x42\x1d\x8b\x73\x39\xef\x48\x82\xcb\x39\x20\xc3\x65\x62\x53\x20\xc9\x71\x85\xfc\x86\x24\x57\x9d\x90\x84\xb1\xc2\x22\x91\x2d\xd3\x33\xa1\x2d\xf3\x93\xd8\x56\x18\x49\x70\xab\x39\x2c\x51\x65\xd6\xb1\xf0\x56\xb2\x66\xf2\xda\xa9\x8c\x22\x3d\x55\xb9\xd3\x3b\xb0\xd4\x56\x68\x16\xd2\x32\x23\x8b\x69\x99\x73\xe2\xaa\x49\xee\x0a\x6c\x49\x11\xd0\x4c\xfb\xff\x67\xec\xcf\xbb\xdb\xc6\x91\x46\x71\xf8\xff\xf7\x53\x60\xd4\xce\x69\x3b\x71\xec\x38\xbd\xcc\x8c\x7b\x32\x8e\x17\x39\xd1\x6d\x6f\x63\xd9\xc9\xcc\x63\xa9\x75\x21\x12\x94\xd0\x26\x01\x06\x00\x2d\x29\x61\xde\xcf\xfe\x3b\xb5\x80\xa4\x1c\x67\xee\xe3\x73\x4c\x56\x15\x49\x08\x04\x81\x42\xa1\x50\x8b\x2f\x64\x9e\xff\x77\xab\xd2\xb5\x5b\x9e\xb0\x2a\xf5\x85\x5c\x0a\x5f\x40\x5f\x2b\xb4\x27\x5f\x35\x6f\xec\x02\x3b\xb7\xa9\xa6\x93\x8e\x9b\x20\xe2\x4f\xfd\x06\xee\x89\xe1\x71\xe2\x80\x87\x45\x68\x02\xb2\xa1\x87\x15\xb7\xb7\x05\x2c\xe8\xbd\xc5\x90\x41\xd2\x79\xd5\x98\xef\x46\x94\xed\x77\x1b\x94\x0c\x78\x23\xca\x16\xbc\xbe\x54\xe8\x05\xec\x63\x72\xf4\x16\x9a\x34\xd1\x0c\xbe\x21\xad\x3a\x24\x32\x76\xdd\xfb\x86\xf2\x7a\x8d\xc2\x76\xb0\x2d\x09\x35\x7d\x9f\x85\xa7\x24\xe6\x1e\x13\xe9\xfa\xc6\x13\xc0\x93\xa6\xc3\x7f\xca\x9c\x68\x82\x4f\xa5\x0a\x24\x40\xe1\x59\xb0\xe1\x33\xb9\x48\xf8\x38\x91\x7a\x76\xc5\xf0\xd4\x34\x1e\xe3\x0e\x0b\xd6\x49\xd2\x89\x9f\x80\xf9\x9a\xc6\x7e\x27\x09\x77\x07\x9e\x44\xeb\xc1\xe0\xf4\x0c\x2e\xf0\x3c\xc5\xc0\xfa\x24\xc5\x3f\x13\x52\xf8\xdf\x83\x43\x97\x75\x20\x06\x5c\x23\xa4\x9d\x81\x11\x52\x1e\x08\x21\x7d\x92\x4d\x84\xf4\x09\x0e\x11\x52\x62\x0e\x21\x05\xbe\x10\xd2\xc8\x12\x00\xfa\x1e\x37\xc0\x6b\xcd\x00\x07\x8c\x79\x40\x48\xbf\x19\xfe\x21\x6d\x47\x3e\xc0\xcd\xa0\x27\x24\x8e\xc6\x90\x3e\x35\xd4\x81\xfa\x78\x94\xaf\xd3\x3a\x43\x11\x2f\x34\xa5\xc5\x61\x0d\x60\x1c\xd1\x21\xed\x0c\xe6\x90\x3e\x7a\xb8\x19\xc2\x41\x15\xfc\x65\x28\x4f\x77\x03\xec\x35\x10\xd4\xc3\xe9\x82\x8e\x39\x9d\x9c\xe0\x1d\x1f\x3a\xd9\x2a\x30\x04\xad\xeb\xac\x99\x75\x35\x6f\x1e\x13\x29\x4f\xe6\x11\xc8\x85\xaf\xa6\xb9\xf6\x7c\x0a\xf1\x3c\x89\x5b\xa1\xbe\x9a\xf2\xda\x05\xfa\x1c\x39\x82\xb1\xb3\x53\x54\xf1\x72\x9f\x84\x23\xdb\xfe\x22\x0c\x4d\x81\xf9\x39\x09\x6d\x2f\x50\x65\xab\xe9\x83\x74\x7c\x82\x32\x0a\xf8\x6f\xa4\x3b\x5f\x61\xa8\x48\x18\x5e\xc8\x39\xaa\x92\xba\x78\x55\xc6\x3d\x5f\x8f\x21\x42\x4b\x38\x17\x5d\x49\x27\xc6\x9b\x86\x2b\x18\xc3\x31\xc8\x7b\x35\x49\xe6\xd2\x18\x95\x13\xd2\x5a\x8d\x72\x40\xbb\xb9\x60\x8f\x5b\x3a\xe1\xce\x38\x81\x25\x9f\x1b\x99\x43\xe1\x4d\xc1\x02\x07\x0b\x49\x1e\xbd\x8e\x42\x53\xf3\xa0\xd0\xf9\x06\xcf\x28\xd2\x45\x40\x66\x01\x1e\x56\x50\x7d\x3c\xcd\x05\xca\x1b\x68\x86\xd2\x40\x30\x9b\x36\x2f\x83\x54\x56\x2c\x87\x15\xa1\x18\xb0\x87\x3c\xd7\x1f\xe1\xdf\x3e\xda\xec\x10\x21\x86\x32\x49\x03\xc5\xfd\x21\x24\x44\xff\x8d\x75\x6c\x82\x8b\x6f\x41\xf5\x5d\xee\xc1\xa1\x91\x2f\x61\xd1\x5e\x05\xf1\x0c\xde\x62\xae\x5d\x2a\x02\xe6\x4c\x44\xf7\xcd\x66\xd3\x11\x00\x47\xc7\x09\x35\x6a\xb4\x13\x0c\x64\xaf\x17\x6c\x0a\x3c\x63\x89\xce\xc0\x4d\x98\xb1\x60\xef\x95\xf1\x22\xd8\x09\x7a\x9a\x87\x36\x55\xde\x04\xb9\x61\xb0\x64\xd6\xc0\x67\xb6\xbd\x08\x36\xc8\x9c\x95\x0a\x08\x47\xdf\xae\xc0\xba\xb1\x10\xf7\x34\xc9\xd2\x08\x8f\xdc\xb7\x11\x66\x69\xd6\x8b\x46\x4c\x8b\xf1\x57\x1a\x6f\xf1\x16\xa2\x95\x5d\xeb\x35\x1f\x9c\x52\xe8\x6d\x07\x00\xeb\xec\x45\x63\xc0\x06\x80\x81\x4e\x11\x93\x2a\xad\x6b\xa6\x81\x33\xb3\x12\x04\x40\x47\x77\xce\x9a\x52\xf4\x8c\x7a\x92\xab\x4c\x42\x47\xaa\x0b\x27\x28\xeb\xce\xc5\x2d\xf1\x89\x09\xb9\xbd\xf8\xad\x2b\x44\x7b\x2d\xb4\xc9\x4a\x9b\x6b\x93\x85\x74\x46\x9b\x19\x88\x62\x41\x84\x6a\xaa\x44\xa8\x42\xa3\x0d\xac\xa2\x73\x6f\xc5\x2e\x77\x55\x1e\x9c\x6c\x27\xe3\x0a\x53\x80\xad\xb4\x99\xc5\x07\xc8\xb5\x94\x9c\x60\x2a\xca\x61\x05\x62\xff\x9a\x23\x31\x10\x4a\x41\xab\x01\xf4\xce\xc7\x88\x64\x00\x71\x28\x8c\xca\x90\x8e\xa2\x32\xd0\xc9\x2b\x13\xc7\x5e\x65\xa8\xe7\x55\x86\x3e\x75\x55\x96\xd1\xf6\xb1\xa2\x45\x68\x15\x57\xa1\x15\x86\x57\xa0\xc0\x76\x85\x35\x69\xb4\xb1\x14\xc0\x9d\x1e\xa4\xdb\x83\x43\x67\x0e\x24\x2c\x48\x02\xe2\x8c\x01\x08\x4e\x13\x08\x7c\x3b\x07\x02\xf9\x9b\x39\x10\x88\x30\x07\xc2\x39\xc3\x23\xcd\x81\x08\x7d\x67\x0e\xa4\x6b\x71\x96\x43\x8c\xe6\x40\x00\x1f\xcd\x81\x40\x8a\x73\x20\xc2\x71\x0e\x64\x84\x67\x2d\xc0\xbe\x9d\x03\x91\xfa\x68\x0e\x7c\x44\x6b\xa7\x31\xba\xd0\x94\xc6\x73\x20\x82\x3c\x07\x02\xdc\xcc\x81\x80\xac\x3f\x1c\xe7\x40\xfe\xae\x74\x6a\xf7\xa0\xb8\x43\xc0\x18\x78\x50\x6e\x0a\xcb\x52\x0c\x87\xd1\x98\x5a\xb1\xef\x7a\x83\x77\xbc\xd7\x99\xc6\x46\x46\x11\xd3\x20\x20\x25\x0d\xae\xba\xa9\x83\x5a\x57\x83\xf5\x87\x6c\x15\xba\x14\x9c\x1e\x23\x80\x8a\x3a\x74\xa9\xea\x52\x70\x05\xf9\x6c\x21\x16\x6a\x95\x8b\xc5\x5c\xa9\x9c\xbb\xff\x02\xb3\x64\x70\x3c\x9b\x05\x26\xc7\x98\xfc\xf4\x67\x84\x7e\x6d\xa0\xbf\x03\x14\xe6\x13\x1f\x52\x98\xd7\x17\x4e\xa3\x1a\x08\xb5\x92\xa9\x0c\x92\x28\xa8\x94\xa4\x6b\x2d\x11\xf9\xd3\xa2\xc9\xe5\xb0\x64\x66\xb2\x44\xbb\xac\x67\x2b\xf1\x3f\x0a\x3d\xb1\x66\xca\x61\x48\x4e\xf4\x37\x47\x28\xb3\x44\xe1\x71\xd0\x82\xa5\x40\xc3\x9f\xcf\x18\x04\xe2\xf3\x1c\x8f\x14\xac\xe0\xb3\x99\xc8\x34\x9d\x04\xb4\xaf\xfa\x6c\x26\x89\x74\x85\x4e\xe6\x52\xe5\x13\xb6\x34\x07\x62\x0c\xa2\x8c\x9d\xb0\x71\x69\xfc\x0c\xcd\xdc\xc6\x9e\xf8\x6c\xf8\x0a\x0f\x81\x78\xcf\x9a\x5f\xcb\x67\x34\x61\x84\x53\x51\xe5\x81\x7f\x57\x4e\xfd\xd4\x2e\x51\x2f\x8b\x11\x46\x54\x12\x13\x06\xc8\x54\x96\xf0\xe9\xca\x30\x17\x32\x4d\x71\xcf\x55\xc8\x54\x17\x42\xe2\x40\x94\xf9\x4c\x4d\x9d\xd4\x09\x42\x1c\xea\x1d\x40\xb4\x60\xc6\xf0\x7d\x0a\x03\xf8\x4d\x2b\x40\xf3\x09\x87\x5f\x98\xa0\x4e\x1b\xaf\xc4\xd4\x00\x32\xcf\xfd\xaa\xa0\xc0\x7e\x53\x8a\x9b\x67\x34\x07\xd7\x96\x26\xe8\x46\x3c\xe1\x08\x79\x42\xfa\x7b\xe0\x01\x1c\xc3\x0e\x43\xf1\x35\xe0\xa4\x74\x2a\x65\x9c\x63\x36\x48\x12\x7b\x30\x68\x1d\x86\xa3\x81\x2f\x33\xdb\x13\x72\x09\x35\x5c\x6a\x3f\xf9\x29\xa5\xf3\xd4\x06\x0c\x6c\x07\x70\xae\xb2\x40\x90\x43\x65\x0f\x82\xc1\x96\x42\x7e\xd6\x45\x15\xe6\x18\xba\x6e\x46\x1a\xb5\x16\xa4\x71\x25\xd8\xf2\x9a\xa2\xcc\x91\xee\x6b\x3d\xa0\x1c\xf9\xa8\xd1\x92\xaa\x89\x2f\x17\xe4\x44\xba\x99\x07\xe9\x03\x46\x42\x0c\xf1\x87\x17\xe2\x4d\x19\x89\x51\xd3\x8c\x26\xb6\xa9\x36\x29\xc8\x1f\x62\x4a\x5c\xbd\xa3\x59\x26\x1d\x32\x47\x56\xc3\x4f\x8c\x51\xd4\xaa\x52\x3c\x4b\x44\x22\x4b\x4c\x69\x4c\x7b\x51\x18\x1d\x67\x4a\xfb\x10\xc9\x34\xe8\xc4\x0b\x76\xec\x4c\xa2\xcf\x79\x42\x0e\xda\x59\x2e\x67\x22\x21\x23\x3e\x46\xf0\x85\xf1\x38\x99\xca\x0e\x34\xa1\x72\x00\x85\x4a\x70\x68\x26\x91\xd8\x82\xf6\x5a\x1e\xd6\xc2\x06\x53\xbc\x20\x10\x52\x9b\x2e\xc8\x41\x86\x9a\x60\x43\xa8\x14\xc5\xe0\x3f\xa6\xd4\xf4\xdb\x41\xae\x4a\xfb\x40\xe7\x80\x27\x0e\xe4\x0c\x20\x46\xe4\x8a\xa1\x7b\x04\x71\x43\x6b\xb8\xd6\xc1\x91\x7c\x00\x62\x80\x60\xcf\xe2\x49\xae\x02\xc6\xba\x9a\x94\x14\xda\x5e\x44\xab\x32\x01\x3c\x02\xc5\x17\x32\xd4\x4a\xd5\xb4\x9a\xa1\x21\x67\xaa\x12\xa7\x24\xc6\xc6\x60\x6d\xdc\xb7\xa5\xa4\x8a\xa2\xda\x90\x89\x75\xa2\x15\x47\x98\x91\xd3\xa9\x53\x0f\x04\x53\x6f\x49\x55\x00\x9e\x85\x71\x5f\xa8\xcb\x73\xb4\x97\x62\x3d\x64\x8b\x2f\xf1\x2d\x58\xb0\x7c\x9d\xd6\x7b\xaf\x44\xc7\x54\xb5\x90\x81\x2c\x47\x8c\xcc\x63\x3c\x95\x2a\x28\x0c\x1c\x26\x52\x2b\xf3\xbc\x58\xda\xd2\x8b\x94\x32\x38\xa4\xb6\x58\xa2\x49\x0b\x00\xcd\x95\xa5\x49\xc8\x86\x27\xb5\x26\xb0\x6d\x63\x6a\x7d\x12\x6f\x00\x08\x77\x2f\x52\x1b\x5e\x19\xd6\xc5\x02\x1c\x81\xbd\x08\x50\x6c\xca\xd4\x06\xdc\x88\x20\x8f\x95\xd4\x86\x26\xd0\x8b\x05\xc1\x38\xc4\xbb\xc9\x79\x14\xd8\x45\xc2\xb7\x36\x61\x58\x1a\x27\x14\x24\x35\x5b\x3b\x6d\x30\x96\xa7\xe2\xb2\xb4\xd6\x7a\x88\xa2\x69\x1d\x69\x71\x31\xd7\xd0\x33\xd5\x34\x67\x13\x16\x45\x3c\x53\xf8\x71\x95\x49\xcb\xb9\x86\x53\x98\x03\x1b\x57\x66\xa6\x8d\xc2\x6c\x34\xb1\x99\x31\x0a\x83\x17\xca\xcc\x61\x6e\x4c\x7f\x4a\xc5\x33\x8a\x57\x2e\x98\x29\x4e\xf2\x52\x28\x97\xd1\x0f\xb8\x6c\xe2\x54\xe9\x94\x57\x86\xb7\x1b\xd7\x03\x8f\x90\x89\x3f\x83\xab\xc2\x33\x88\x75\x7e\xa6\xd8\x54\x14\xd8\xb8\x7a\x90\x39\xc6\x23\xc1\x43\x56\xc1\x99\x7e\xe2\x81\x90\x09\x1a\x72\x75\x42\x8d\xd0\x5e\x22\xc7\xf7\x68\x22\x92\x68\x13\x40\xf6\xc7\xb0\x1e\x78\x44\x38\xb4\x4d\xb2\x2c\x43\x74\x46\xc4\x8f\x44\xf1\x11\x64\x12\x63\x41\x60\x48\x8e\x49\xdc\x7b\x80\x6e\x82\xd1\x20\x28\x5e\x07\x94\xd0\xd8\x8e\x4f\xda\x47\x60\x28\x4e\x70\x0b\x2f\x9b\xc6\xe0\x1b\xf1\xec\x45\x3b\xcc\x10\x62\xb7\x0d\x8e\xd1\xda\x09\xb9\x31\xc1\x10\x4e\x5d\x02\x2e\x7d\xba\x04\x0a\xeb\x0b\xa3\xa3\x4b\xc5\x2d\x8e\x35\x4a\x85\x59\x34\x9a\x10\x1e\x9d\x82\x10\x5d\x2b\x26\xe7\xae\x86\x60\x4a\x49\x33\x11\x51\x29\xba\x78\x88\x4c\x2f\x55\x27\x50\x6e\x96\x6b\x6a\x4b\xec\x2a\xaf\xa7\x99\xc8\x2c\x06\xca\x30\x81\x3e\x77\x06\x4b\x51\x74\xbe\x40\x10\x2d\x94\xbd\xc8\xca\x12\x56\xf1\x70\x42\x5b\x1e\x80\x9b\x28\x71\x14\x05\x82\x1b\x94\x02\x96\xeb\x42\xcc\x52\x0c\x94\x60\x48\x44\x9a\x29\x90\xf0\x4d\x55\x88\x77\xef\x4e\x8f\x4f\xcf\x0f\xff\x0d\xc0\xe0\xe2\x74\x70\x31\xb8\xf9\x0f\x47\x21\xa0\x65\x60\x13\x7d\x80\x92\x4d\xb4\x78\xe5\x1e\xd4\xc4\x87\x55\x8e\x51\x0a\xba\xc4\xa0\x43\x97\x18\x79\x1f\x08\x25\xdf\x14\x93\x56\xc5\xf4\xe9\x2b\xd8\xc4\x18\x47\x77\x8d\x82\x1f\x3f\x52\x40\x84\x44\xc1\x2c\x12\xca\x34\x7b\xba\xb4\xb2\xf8\xa9\x83\x98\xd9\x77\xee\xb2\x3e\xc8\x62\xda\x2d\xd0\xa9\x47\x04\xff\xf4\xa3\xfe\xe1\x3b\x65\x02\xb1\x41\x1e\xb4\x5a\xd0\x4b\x91\x8d\x31\x48\xc5\x1d\xc9\x91\x62\x30\x78\x8c\x41\x80\x07\x0c\xce\xa0\x4d\x2a\xe6\x32\xcf\x70\xa9\xeb\xc5\x1c\x37\xde\x71\xd9\x8b\xe0\x14\x03\xf9\xe3\xfe\x38\xcd\xc7\x08\x23\x5f\x98\xd3\x66\xd4\xfa\x42\xe6\x31\x9b\x79\xa6\xb1\x42\x7a\x2a\xbd\x12\x3a\x41\x9f\x7f\x74\xfa\xb7\x15\xce\xf3\xe4\x8e\xbf\x44\x7f\x7c\xd2\xbf\xea\x6c\x2a\x74\x06\x37\x66\x70\x63\x36\x83\x7f\x2d\x74\xe6\xd8\x45\x3f\x06\x6b\x21\xf3\x3e\x9d\x39\x8d\x8e\xfa\x0b\xe4\xad\xd8\xe1\xf5\x7d\xd8\x83\xc3\x6b\x74\xd3\x27\x33\x73\x8d\xbe\x0a\x70\x8a\xd3\xa6\x36\x19\x72\x33\x6d\x70\xe1\x06\xb7\xd0\xd3\x26\xb3\xb9\x86\x91\xaa\x4d\x01\xff\x50\x17\x53\x40\x65\x4c\x90\x46\xe6\x2b\xaf\x7d\xf4\x8e\x8f\x67\xdc\x4f\x4e\x5b\x07\xf9\x49\xe5\x15\x86\xd4\xf1\x36\x6b\xa8\x20\x05\x34\x3b\xfb\x4f\x11\x27\x4d\xb3\xb0\x6d\x05\x94\x44\xa3\x5e\xe3\x5c\x08\xa3\x4d\x97\x14\x3d\x8b\xcf\x13\x6d\x84\x76\x8d\x0d\x06\x33\xcd\x09\xb0\x5e\x9a\x46\x75\xcc\x12\x05\xa0\x63\x07\x66\x36\x50\x57\x4e\x3c\xbb\xdf\x13\xcf\xee\x5f\x8b\x7b\xa5\x4a\x0a\xf0\x73\xaf\x56\xf0\x8f\x02\xee\x7d\xbb\xf1\x7b\x1f\xba\x9e\xaa\x13\xdc\xcd\xc4\x1d\x42\xc2\xad\xd3\xcd\x57\x6f\x7c\x59\x61\x02\xd6\x24\x73\xa1\x5f\xea\xc4\xa0\x47\x26\x73\x13\x26\xe1\x67\x98\xa0\x4b\xa5\xca\xc2\x9f\x95\x0f\xec\x80\x8a\x7e\xa6\x12\xdd\x4d\xd7\x04\x1b\x2f\xf2\x6c\x26\xf2\x99\xc8\xe7\xd6\x97\x3a\xc8\x1c\xda\x25\xd7\x05\xbb\x48\x76\xdd\x49\x49\xe1\x44\x18\xf6\x00\x00\x72\x60\xa9\x00\x00\x97\x42\x37\x52\xec\xd1\x00\xd1\xe4\x8c\xb4\x06\xa4\xbd\x10\x8a\x76\xdf\xe0\x0b\xe9\x8c\x00\x7e\x4d\x5e\xb4\xe8\x77\xaa\xf1\x7e\x1f\xd8\xf0\x81\xfd\x45\xb1\x8b\x13\x56\x90\x40\x9c\x5b\x49\x5b\x96\xc8\x70\x00\xa3\xbe\x9f\xdb\x99\x9c\x7a\x3c\xb9\x04\xfd\x45\xa7\xec\x35\x8a\x8e\x3d\x98\x38\x83\x99\x6f\x6e\x67\xe4\xc2\x82\xa9\x17\xd0\x07\xd7\xce\x96\x78\x98\xd0\xd6\xb3\x24\xb7\x53\x3a\xac\xd3\x3e\x93\xfb\xa8\x28\xf6\x4a\x90\xbe\x93\x79\xc7\x17\xd3\x93\xa7\x60\xe2\xac\x17\x20\xa5\x81\x34\x5b\x48\x53\xc9\x9c\xe6\xbd\x42\x96\x14\x35\xaa\x90\x34\x45\x44\x2f\x41\x8e\x76\x85\x46\xd4\x8f\x48\xb0\xf2\x7b\x4c\x6b\xd5\x71\x85\x5c\x62\xe8\x71\x5a\xf4\x45\x8c\xd9\x0b\x4d\x81\x93\xa0\x8a\x32\xd5\x0e\xbe\x1b\x53\x2a\xaf\x5c\x4b\x31\x6a\xa6\x96\xe2\xfc\xf0\xdf\x93\xcb\xeb\x13\x20\x40\xc9\xe8\x8f\x58\x7a\x60\x1b\x09\x89\x21\x2d\x0e\x5f\x9e\x30\x6e\x46\x42\x4a\xeb\x19\x09\x72\x45\x4b\x9b\x42\xf9\x39\x46\x13\xf3\x3c\x14\x39\xa8\x78\x61\xd3\xc9\x54\xcf\xd8\x92\xb6\x68\x5c\x64\x28\xa8\x56\xd9\xa1\x74\x61\x54\xce\x21\x01\xc5\x01\x02\x9a\xf4\xa9\x85\x4d\xd1\xbc\x6b\xbd\xef\x22\xb5\xf2\xd1\x97\x0b\xf7\xd2\x1f\x54\x8b\x6a\xb4\xaa\x2d\x56\x51\x27\x4a\x9e\x5b\x6a\x16\x05\x5d\xa3\x66\xbe\x2a\x1a\xd9\x6a\xdd\xe1\x2a\xae\xa1\x09\x83\xc6\x05\xae\x60\xd4\x32\xe4\x72\x85\x49\xe7\xd0\xb9\xa8\xf5\xb8\x2a\x9d\xca\x84\x29\x84\x29\x12\x61\x2c\xb2\x50\x63\x79\xc4\x1b\xdb\x68\xa7\x72\x74\xa6\x8a\x6c\xb2\x71\xb1\x42\x3f\x2a\x3c\xe0\xb8\x01\xc0\x0b\x53\x0a\x53\x6a\x61\x82\x4e\xee\xbd\x30\xb8\xf9\x45\xc2\x2c\x1e\x27\xb1\x97\x5a\x9c\x46\x6c\x8a\x4e\x4a\x20\x6f\x5a\x4e\x05\x6a\xcb\x8e\x79\xf1\x9a\x1f\x11\xdb\x3c\x73\xd3\xa8\x20\xba\x7c\xca\x3a\x3d\xd3\x66\xcd\x29\x28\x54\xce\xf8\x49\x8c\x63\x82\x8e\x3f\xd4\xda\xb6\xc2\xb8\x97\x82\x79\x10\x19\x41\xcb\x5c\x05\x76\xd8\xe1\x05\x20\x3a\xb4\xe0\xb5\x4c\x91\xa8\x2e\xca\xb9\x46\x0b\x1d\x0a\xdb\xf7\xac\xa4\x29\xb1\xd4\x2a\x51\xa2\xd4\x0f\x96\xd9\xfe\xc4\x2f\x19\x2d\xe4\x12\x11\x94\x4b\xb8\x8c\xd6\x82\xc3\x0b\x0e\xc3\xc4\xab\x10\x10\x38\xe8\x17\x1b\x6f\x8a\x49\x74\xae\x5d\x76\xfc\x2a\x4c\xf0\x64\x00\xaf\xa3\x00\x48\xc4\xc9\x9f\x56\x1b\x95\xf2\x05\xf2\x00\xb2\xda\xe7\xe8\x3c\xc1\x1e\x2c\xe4\xd8\xd0\x64\x1c\x9e\x90\xd7\xd2\x63\x3f\x06\x1a\x2f\x40\xe6\x5e\xb3\xe6\x65\x20\xf3\x99\xc5\x14\x05\xeb\x64\x5c\xd2\xb2\x75\x7f\x74\x91\x6c\x0b\xc2\xd1\x25\xdd\xf7\x7e\x87\x3f\x18\x09\x47\xad\x2d\x7f\xc3\xec\xbe\xf7\x58\xb0\x65\x6b\x96\x4f\x2b\x82\xd2\x7a\xe8\x51\xcd\x76\x30\x5a\xcd\x63\x1f\x45\xcd\x0e\x1c\x88\xef\x91\xcd\x7c\x0c\xab\x6c\x33\x16\xeb\xd9\xce\x1d\x27\x7e\xf6\x65\xe2\x45\x23\x9f\x71\x65\x57\x3a\x5b\xd8\xa0\x68\xf9\x06\xeb\xaa\xa9\x9e\xd1\xac\xcb\x36\x39\xed\x16\x91\xcc\x27\xa8\x29\x62\x73\x7a\x1f\x1d\x41\x3c\x1b\xaa\xc9\xb4\xb1\xc5\x64\x83\xe1\x94\xb6\x9a\x61\x8c\xbb\xee\xd4\xeb\x64\x90\xf9\xac\x31\xea\x6c\x82\x75\x47\x4b\x4f\xf6\x60\x00\xb9\x25\x06\x7b\x00\x98\x19\x83\x93\x20\x79\xac\x59\x79\x16\x4b\x34\xe7\x44\xee\xef\x80\xad\xb3\x95\x18\x59\x77\xe2\xa2\xce\x47\xd3\x4e\x1a\x16\x8d\x7d\x27\x9b\x7a\xae\xb0\x9c\x45\xc8\x1f\x72\x4a\xd9\x04\x07\x36\xae\xc4\x10\xa9\x4e\x65\xe4\x70\xd8\x19\x3a\x31\xf2\x58\xc7\xb0\x4d\x27\x68\xa3\x26\x1c\xcf\xac\xcf\x9c\xa9\xb8\x27\xb0\x3d\x1a\x4c\xa8\x0c\xea\x86\x58\xc0\x2b\x12\x18\x6c\xde\x58\xab\xe1\x27\x42\xa4\x79\xf9\xca\xa0\x5c\xad\x9c\xf0\x20\x7b\x2e\x57\xf1\xfc\x19\x8d\xd4\x52\x95\xe1\xb9\x63\x65\x06\x4c\x8e\x4e\x51\x15\x8b\x76\x68\x74\x9c\x90\xb1\x16\xbd\x5a\x04\x28\xcb\x80\x57\x01\x78\xa9\x9f\xdb\xc5\xa4\xa3\x59\x68\x70\xdf\xb9\x84\xa3\xb4\x45\x49\x52\x41\x5c\xa7\x74\x66\xe3\xaa\xb9\x5d\xe0\x36\x1f\xd2\xba\x2a\xf8\x35\x0a\x72\x83\x2e\xa1\x2d\xbf\x59\x4e\x22\x16\x0d\xd0\xd0\xfc\x2d\xee\xb2\xa6\x71\x63\xb5\x31\x8c\xe2\xb1\xe0\x91\x12\x1d\x40\x00\x26\x8b\x29\x13\xf5\x68\x38\xbf\x91\x93\x94\xf5\xf0\x1b\x80\x37\x5a\x4a\xc4\x9a\x86\x05\xc4\x54\x79\x8e\x72\x17\x4d\x8c\x64\x2f\x8f\x30\x4c\x19\x74\x05\xfd\x6c\xc9\xe2\x84\x4c\x3f\x14\x59\x76\xcc\x5a\x5f\x35\x56\x2f\xa3\x7d\x47\x9c\x14\xa1\x67\xe1\x68\xc7\x2e\x56\xce\x35\x01\xa4\x7f\xf1\x41\x06\x3f\xa1\x19\x89\xf6\xc4\xf9\x56\x57\x25\xa4\x3b\xc0\xb5\x2a\x6f\xc8\x4f\x50\x7e\x89\xda\x74\x5f\x4d\x4d\x55\x50\x8b\x55\x05\x0f\x59\x5f\x15\x68\x7e\x92\xd1\xfe\x39\x79\x88\xf2\x79\x32\x87\xc1\x08\x4b\x3e\x1c\xe7\xad\x16\x3a\xc8\x29\xef\x70\x53\xfd\x79\xb7\xbb\x95\x09\x99\x80\x7c\xae\xcb\xae\x7d\xbc\x12\xf7\x0d\x3b\x7e\x46\x5e\x50\x90\x30\x8c\x5e\xc4\xaa\xfd\x5c\x34\xc6\x98\x58\x07\x2f\xb0\x19\x3a\x23\x91\xb6\x89\x53\x45\x66\xb4\xb8\x26\xc7\x6d\xe2\x26\x9d\x1d\xee\xd2\xde\xd3\x9e\x6b\x0c\x6b\xdf\x5a\xd4\xb5\x9b\xb6\xcb\x55\x77\x9b\x56\xfa\x30\x61\x5d\x3b\x89\x87\x98\x69\x92\x60\x57\x19\x11\x1c\x5d\x9e\x48\x3f\x01\x29\x24\x38\x32\x69\x6e\x42\x5c\x50\xd3\x97\x70\x81\xb4\x28\x61\x35\x29\x38\x2b\x2a\x51\x91\xef\x26\xd2\x4c\x48\xa4\x98\x44\x55\x30\x5c\x8b\x85\x24\xb8\x6f\xc0\x36\x8e\xed\x7e\x2f\x2a\x1d\x5b\x94\x56\x57\x01\x45\x08\x34\xda\xf1\xbc\xfb\x3b\x83\x8a\xea\x07\x8d\x5b\xe0\xd4\x60\x70\x89\xfb\x4f\x70\x93\xe8\xe0\x8c\xd3\x74\x6e\x2d\xd6\xcc\xab\x42\xb7\x2d\x45\x06\x99\x13\x5c\x35\xf0\x8e\xee\x64\x2a\xd3\xf5\x3a\xb6\x97\x32\xb5\x2c\x5d\x83\x15\x28\x94\x35\x18\x30\xb5\x88\x54\x86\xe3\x17\xa4\x6b\xa4\x0c\x24\x80\xd6\xed\x3e\xe0\xe6\x6d\x08\x2b\x9b\x65\xb8\x81\x3c\xa1\xe0\xb9\xca\x8b\x2a\x9b\x89\x6a\x26\x9e\xe1\x06\x30\x75\x3b\xdc\xee\x6d\xf6\x81\xa1\xa7\xe2\x8e\xad\x80\x99\xb0\xfb\x49\x41\x62\x6f\x75\x1e\x80\x35\xf6\xa3\x1c\xad\x11\x0a\x99\x50\xca\xbb\x07\xe5\xa6\xb0\x38\x58\xe3\x59\xdf\xd9\x43\x6c\x35\xad\x5d\x7e\xd6\x65\x65\xc0\xc2\x45\xdc\x16\xe7\x2d\x3e\xe4\x99\x0b\xcc\xfe\x8e\xcb\x30\x02\xa9\x2f\x2f\xb4\xc3\x48\x7c\x34\x30\x01\x43\xd5\x83\x58\xca\xa5\xf6\x74\xe4\x2a\x11\xdc\xae\xae\x08\xc7\x1f\x25\x90\x7e\x67\x49\xfc\x98\x4e\xdd\xdb\x59\xad\xb2\xa4\x4d\x10\x3a\x75\xaf\xe3\x66\x06\x1e\x27\xf4\xdb\x08\x3a\x8b\xf6\xba\x5d\xe4\xf1\x43\xdf\xc7\xb9\xa0\x8a\xd4\x08\xcb\xc9\x83\x5d\x42\xd5\x38\x15\xc2\x72\x55\xe6\xd2\xc0\x79\x42\xf3\xd6\x0a\x6f\x5f\x75\xde\x79\xf5\xe8\x9d\x57\xed\x3b\xaf\x3a\xef\xbc\xa2\x77\x5e\x3d\x7e\xe7\x15\xbf\xf3\x8a\xde\x79\xf5\xf8\x9d\x57\xf8\xce\xab\xf6\x9d\x57\xdd\x77\x5e\x3d\xfd\xce\xab\x47\xef\xbc\x7a\xea\x9d\x57\x0f\xf4\xce\x2b\x7e\xe7\xd5\x92\xcd\x66\x3e\xe3\xe5\xcf\x9d\x77\xfc\xdc\xbe\xd3\xe7\xce\x3b\x61\x70\x08\x3c\x4e\xe9\xa8\x9c\xc1\xad\xd6\x67\xa5\x16\x9f\xe9\x85\xe9\x14\xeb\xfb\x99\xdf\xf6\x33\xc8\x1c\x9f\x0d\x0a\xb4\x18\x6b\x99\xac\x6a\xba\x94\xd2\x29\x60\xbc\xbd\x6d\xfd\xa6\x37\x11\x93\x89\x78\x56\xbf\x12\xcf\x9e\xd5\xaf\x7a\x31\xa3\x69\xbe\xdf\xbb\x3b\x7c\xf9\x3f\xf2\xe5\xe7\xc9\xb3\xf1\xdd\xab\x97\x7f\x6f\x90\xe7\xbd\xed\x4e\xba\xd3\xb0\x9d\x53\x8e\xe1\x7d\xd7\x26\x14\x95\xdb\xb4\x21\xb9\xaf\xbf\x6e\x3f\x91\xed\xb5\xb7\x3b\x1a\x3d\xe7\xf4\x79\xcf\x77\x31\xd7\x5f\xcf\xab\x3c\xeb\x8d\xbf\x72\xca\x57\x7c\xc2\x70\xc2\x58\xb7\xff\xaa\x4d\xfc\x37\xdd\x1c\x8d\xd2\x17\x35\x1c\x46\xa3\x9d\x7a\x34\xda\xe9\xe2\x70\xda\xba\xeb\xab\xf1\xdd\xcb\x17\xe3\x03\x22\x4e\x9b\x14\x81\xff\x9b\x67\x8f\xa6\xeb\xcf\x6e\xbb\xfd\xbd\x57\xdd\x02\xbe\x7d\xe6\x89\x9f\x78\xd5\xb6\xd8\x18\x6e\xd8\x39\xc0\x9b\xc6\x5f\xc7\xdb\x7a\x7f\xf7\xed\xee\x77\x93\xf4\xa9\x7c\x3d\x43\x5f\x93\x5f\xb6\x87\xa1\xa7\x51\x7e\x67\x5b\x39\xb6\x8a\xe0\x0d\x79\x9d\x51\xfa\xbe\xb8\x3b\x08\xcb\xd7\x38\xb1\x50\xfe\xbe\xd4\x62\x52\x3f\x1d\x73\x4d\x35\xd9\x68\x39\xbd\x6c\xe9\x6c\x12\xfd\x37\xe4\xd4\x56\x81\xb2\xf1\xa5\xe9\x61\x08\x2e\x9e\x71\x97\xad\x9f\xea\x60\xdd\x85\x4d\xd5\x7b\x95\x97\x70\x89\x73\xef\x03\x78\xa1\x16\xc3\xb9\xca\xb3\x1b\x39\x05\xf4\xea\x0a\x8f\xd2\xa8\xfc\x58\x06\x35\xb3\x6e\x85\x04\x5c\x2c\xdf\x58\x4c\x15\x2c\xd3\x07\x69\x12\x75\x63\x2f\xd4\x32\x9c\x38\xfd\xa0\xcc\xef\x6a\x25\x64\x96\x61\xc0\xc7\x0b\x15\x18\xf6\x42\xea\xe2\x18\xb7\xd8\x24\x66\xe0\xd3\xae\xc9\xa3\xa7\xa5\xa7\x8a\xe6\x98\x2c\x0e\x8e\xc7\x61\xc9\x40\xe5\x1e\x14\x81\x43\xe6\xb4\x32\xcf\x3f\x68\xb5\x38\xd5\x41\xc8\x62\xaa\x31\xcd\x34\x6e\x82\xa3\x46\x1a\x8f\x47\x2a\x2c\x94\x32\xb8\x57\x7f\x6c\x8d\x22\x00\x8a\xa2\x06\x40\x3c\xe6\x9b\x06\x18\x0a\x14\xd2\x18\x1a\x8e\xb4\x9d\x33\xc4\x8f\x75\x48\xd9\xf1\x4a\x56\xa9\x58\x43\xef\x0d\x22\x04\x54\xfa\x8a\x7c\x81\x10\xbf\xa1\x44\x79\xc9\x99\x32\x27\xf8\xbb\xb8\x65\x4c\x84\x59\x98\x9f\x34\xf9\x2e\xa4\x4b\x72\xa8\x1d\x14\x7d\x0e\x3f\xe6\x84\x74\xe1\xa7\xf4\x0a\x9a\x06\x5f\xdd\x05\x28\xbc\x03\xe2\xa5\x0f\x38\x5b\x75\xa8\xc3\x7b\x6d\x1e\x3f\x74\x63\x6d\x0e\xf0\x51\xa5\x73\x2a\xf1\x5c\x99\x0a\x28\xa7\x79\xa5\xd3\x4e\xb9\x57\x55\x08\x2b\x86\x87\x2a\x57\x49\x68\x90\xd0\x2d\xf5\xd6\x2b\xd7\xe2\x1e\x84\x17\xce\xfc\xcd\x18\xa6\xe4\x3e\x51\x0f\x3a\x51\x4c\x81\xf6\x84\x25\xea\x29\x6d\xb0\x29\x2f\x64\x08\x32\x99\xf3\xe7\x44\x98\x1e\xa0\x2f\x8f\x84\xe6\x03\x43\x15\x81\xc1\x0e\x73\x9d\x2a\xf7\xce\x95\x4c\x2a\x4a\x19\xf4\x54\xa3\xed\x24\x51\xd0\x53\x3b\xde\xd0\x37\x55\x71\x89\xea\x0f\x7a\xe3\x6f\x48\xf1\xc6\x53\xad\xf2\x74\x0d\x59\xff\xa5\x0b\xf9\xa0\x67\xf8\xb1\x1f\xfd\x02\x7d\x6d\xe8\x43\x1f\xb5\x41\x0a\x8d\xaa\x25\x6e\x05\x34\xf8\x00\x73\x27\x46\xac\xa9\x0c\x62\xff\xaa\x14\x8c\xa3\x2a\xd8\xdf\xd5\x8a\x04\x06\x40\xae\x72\xca\x33\x78\xaf\x8e\x73\x5d\x22\x80\x9f\x6b\x38\x97\x18\x39\x03\x08\x57\x20\xc2\xc8\xfc\x3d\x2c\xcc\xdd\x0a\x49\xd7\xb8\xca\xf5\x08\x0f\x75\x51\x51\x18\x43\xcc\x42\x88\xf2\x4a\x04\xfa\x4b\xca\x3c\x78\xad\xd0\x62\xa0\xc9\x0f\xf8\x10\x8f\x57\x20\xb7\x4e\xb5\x39\xc7\xd0\x0f\x7e\x0e\x75\xd0\x26\x85\x0e\x26\xa6\xb9\x32\xe9\x6b\x3a\x0d\x31\xa9\x6e\x0b\xf2\x78\x6a\x09\xc8\x2f\x08\xbf\x59\x58\xfc\xba\xd3\x5c\x9b\xf4\x44\x06\x89\x69\xea\xa7\xd6\xa8\x33\x19\x60\xc1\x18\x2d\x42\xd0\x12\xe4\xc4\xe6\x39\xf6\xc6\xa9\x5d\xfe\x8f\xb5\x05\x82\x55\x96\x29\x47\x9d\x06\x13\xe8\x1d\x59\x7b\x5f\x48\x77\x8f\x6d\x8a\x94\xd8\x8a\x4c\x09\x01\x5e\x1f\x4f\xe7\xd2\xe8\x52\x1c\x1f\xbd\x13\x89\x4c\xe6\x0a\x53\xfc\x37\xd0\x71\x4c\xb4\x15\x09\xe7\x98\x34\xa1\x41\x6f\x70\x69\x92\xc8\x42\x39\xc9\x27\xe4\x11\x89\x34\xc7\x98\x9d\x89\x4a\x4f\xa4\x79\x90\x5e\x24\x12\x77\x18\xf4\x67\x45\x4c\xa3\xc9\xce\x17\x92\xf9\xbf\x2a\xad\x02\x25\x93\xa6\x64\x6c\xc3\x6a\x9a\xea\x87\xe3\x68\xc3\xcd\x6c\xe8\x0c\xbf\x45\xf7\x8e\x6b\x72\xd4\x64\x4b\xe7\x23\xbb\x14\x8d\xc5\x55\x0b\x9d\xcb\xb2\x45\x2e\xab\x90\x6b\x13\xbf\x49\x6b\x9f\xf5\x59\xb5\x39\xdc\x92\x7b\x2a\x89\x80\x77\x98\xe7\x4d\x25\xf7\x27\x34\xe1\x50\xff\x78\x47\xdb\xaf\x22\x99\x5b\xf8\x4a\x89\x06\x7e\x15\x13\xb2\xb9\x53\x9d\xe7\xf0\x46\xb9\x2c\x4a\xca\xbb\x46\xc7\x63\x68\x3b\x91\x40\xf7\x85\x43\xac\x45\x03\x1e\x93\x21\xcb\x8d\x2e\x14\x7c\x5b\xb8\x30\x4c\xe6\x2a\xad\x72\xb5\x86\xc4\xd7\xa0\x67\x6f\x9c\x2e\x8e\x54\xd6\xe4\x67\xa3\xce\x80\x60\xe4\x17\x49\x5e\x79\x6c\x94\x22\x85\x4f\x77\x49\x8a\x86\xa4\x48\x87\x89\xb3\x79\x8e\x03\xbc\xbf\x54\x49\xc5\x37\x75\xc8\xd7\x98\xb1\x91\xc9\x73\x95\xe7\x22\xb1\xd2\x79\x65\xe8\x13\x10\x57\xd4\xd6\x9c\x51\xb2\xaf\x3c\xd7\x9e\x52\x8c\x35\x76\x45\x87\xe1\x0a\x8d\x19\x10\x89\xef\x0c\xf0\x80\x72\xdb\x35\x60\xcb\x62\x92\x96\xb7\x1d\x61\x4f\x7d\x44\x64\xb4\x2a\xcc\x99\x5c\xc1\x6c\xce\x3b\xba\xfd\x64\x6e\x23\x7c\xc6\x59\xe2\x00\xbe\xb2\x98\xb2\xac\x28\x65\x12\xde\x4b\xed\x86\x64\x27\xdf\x78\x09\x34\xb5\x2a\x48\x71\x69\x66\x03\x13\x94\xb3\x94\xed\xad\x0a\xea\xca\xe6\x2b\xaf\xc2\x07\xf8\x4d\xb4\x75\x4a\x75\xcc\xa4\x86\x68\xa6\x5d\x71\xa2\x65\x6e\x67\x31\x93\x1b\x8e\x6b\x86\x99\x47\x46\x34\x0a\x14\x8c\xfe\x1f\x6e\x1d\xb2\xe0\xb4\x06\x79\x63\x12\x05\x81\x16\xfa\x10\x13\xbd\x91\xc2\x44\x5b\x13\xf9\x1c\x48\x3b\x92\xfa\x03\x41\xfe\x1c\x43\xe2\xad\x42\x63\x97\x15\x0b\x8d\xf5\x80\x65\xdd\xa9\xb3\xc5\x65\x9e\x9e\xc9\x15\x26\x5a\x23\xe2\x20\xcb\x6e\xec\x15\xe6\xc3\x47\x1c\x25\x87\x02\x33\xf2\x21\x3e\xb4\xb9\x4e\x6f\x96\x11\xbd\x41\x3e\x99\xc7\xdc\x7c\x48\xbb\x35\x9a\xf2\xbe\x91\x64\x00\xd0\x69\xae\x96\xd8\xc0\xe5\x0a\xa4\x1f\x38\x03\xe3\xfc\xc8\xca\x4c\xcc\x0a\x57\xd2\xa7\x16\x49\xc9\xe3\xa4\x3c\xce\x6d\x98\x0f\x61\x24\x95\xc7\x6d\xcf\x2a\x3b\x42\x12\x22\x0f\x78\xdf\x8d\x3d\x57\x7e\x2e\x92\xf2\xd4\x3a\x4c\xa0\x57\xbe\x53\x50\xdb\x07\xe5\xe8\x5b\x94\xc4\x78\x93\xf2\x8a\x36\x39\x56\x22\x29\xaf\xf5\x4c\xa7\x4d\xd9\xa7\x3a\xc7\x9e\x5e\x0e\x95\x2c\xf0\x84\x3d\x83\xa0\xb5\xa2\x08\x69\x00\x60\xdb\xf0\x8b\x28\x59\x24\xe5\x6d\x99\xca\xa0\xb0\x56\xb7\x1f\x3c\x27\xab\x8b\x5c\x0c\x5a\x3b\x92\x9c\x5c\xe0\x40\x47\x2c\x76\x42\x44\xf0\x36\x95\x5e\xf9\x94\xb8\x32\x71\x54\x1b\xb0\x96\x2a\x4f\x99\x12\x05\xd2\x88\x62\x8a\x3e\x04\x89\x4f\x75\x7f\x6d\x9d\x67\xa2\x7a\x00\x8f\x57\x31\x5f\x6a\x58\x1e\x4e\x71\xa0\x84\xe5\x31\xe5\x27\xc1\x3b\xc3\x12\x6a\x76\x8e\x45\x87\xe5\x8d\x93\xe4\xae\xc5\x56\x77\x58\xff\x96\x6f\x75\xe1\xce\x25\xea\x12\xc4\x98\xe0\x78\x98\xa6\x57\xf1\xd1\x07\x75\xfc\xa1\x01\xfb\x57\x2d\x48\x3c\x31\xa2\x83\x26\x56\x3e\xa2\xe7\x76\xed\xe6\xcb\x46\xf8\x45\x74\x78\xaf\x42\x32\xa7\xab\x01\x7b\xdc\x2a\xc9\xd5\x31\xea\x8b\x3b\x56\x81\xb3\x2b\x0b\x4b\x09\xca\xcc\x87\xec\x1d\xbb\x3b\xf0\xb2\xe3\x38\x11\xf0\x85\x56\xe2\xe1\x04\x77\x30\x5d\x0f\x95\x43\x9b\x3c\x24\x7c\xd0\x2e\x54\x32\x67\x29\x2f\xc5\x7d\x2b\xbc\x38\x43\x9f\x1e\x99\xae\x67\xb2\xc3\x9e\x44\x20\x8b\x30\xef\x9c\xad\x4a\x7f\x68\xd2\x73\x09\x73\x12\xcc\x2f\xf1\x3a\xaf\x39\x08\xbd\x1d\x44\xc0\x54\x5e\xa5\x47\xae\xf2\x73\x34\x4a\xcc\x9d\x34\xa9\xc7\x62\x5b\x51\x92\xe0\x8e\x28\x49\x84\xd8\x5c\x29\x5e\xe0\xbe\x47\x08\x8d\x93\x74\x86\x3c\x23\x9d\xa5\x1a\xc6\x4a\x3a\x43\x75\x58\x3a\x23\x3f\x83\x54\x17\x1f\xe7\xca\x70\x96\xb9\xdf\x15\xca\x25\x84\xe0\xfe\x06\x2d\x38\x52\x8d\x41\x00\x53\xed\xc8\xee\x52\x7b\xca\x08\xa8\x7d\x97\x4b\xb6\x28\xb1\xc2\x54\xfb\x7b\x62\x01\x64\x14\x99\xe0\x56\xf7\x8d\xc5\xb0\xa5\x6c\x27\x79\xc8\xeb\xa7\x88\xa3\x34\xdc\x20\x55\x9e\x47\x18\xa5\x86\xcb\xec\x44\x05\xa9\x1b\x22\xac\xd0\x22\x7c\xfd\xee\x68\xed\xe1\x61\x61\x6d\x98\x1b\xca\xb3\x47\x94\x20\x43\x07\x41\xf1\x25\x62\xb1\x15\xd9\xba\xbe\xb3\xaa\xe9\x90\x62\x12\x3f\x7b\x94\x57\x4e\xa4\x20\xc6\xd1\x11\x1b\xcd\x96\xf0\x81\x55\x9c\x84\x52\x8b\xe6\x93\x57\x4d\xde\xbb\x6a\x9a\xab\x2b\x67\x33\x9d\xab\x23\xed\xa4\xce\x9b\x1f\x75\x72\x86\x87\x43\x96\xf4\xf1\x67\x9d\x9c\xcd\x54\x07\xb5\xa5\xcd\xb2\x33\x9b\x48\x2c\xbc\xa2\x55\x9a\x6a\x21\xee\x25\x11\x6d\x0a\x5f\x19\xfe\x06\xab\x28\xe3\x03\xd8\xe7\x3c\x85\x08\xc5\x10\xe2\xe9\xca\x44\x81\x28\x5d\xd1\x72\x2b\xbe\x0c\xa0\x2e\x68\x18\x7c\xf0\xae\x2b\x43\x4d\xbf\x32\xd7\x2a\xef\xa7\xdc\xd3\x18\x8b\x4f\xc0\xe4\x78\x66\x65\x2a\x54\xaa\xb1\x87\x9c\xe9\x42\x07\x8f\x68\x97\x8b\xc6\xdf\x7c\x4c\x67\x09\x1d\xe9\x1d\x36\x78\x98\xfe\x59\xf9\x80\xb6\x36\x8f\xae\x74\x0b\xea\x90\xbb\xe5\xa0\x71\x2a\x9c\x6e\x54\x51\x92\x3d\x25\xf6\x40\xa0\x17\x3a\xe0\x01\xe6\x0e\x65\xa0\x87\x33\x0f\x20\x3f\x5e\xee\x31\xcd\x7a\x19\x20\xff\x51\x87\xb9\x50\xe6\x81\xb2\xde\xc9\x1c\x6b\xd5\x80\x37\x36\xe7\x04\x78\x98\xdd\x8e\xac\x45\x81\xb5\x64\xca\x61\xbe\xb9\x07\x99\xa3\xac\x83\x5e\xff\x02\xad\xf6\x3f\x5a\x07\xd2\x7f\x65\x80\x95\x00\xe3\x52\x4b\x10\xfb\xf4\x83\xc2\xb1\xd8\xf0\x33\xb5\xc4\x34\x6f\x28\xec\x9d\x5a\xd7\x97\xc9\xfc\x72\xfa\x27\xf0\x55\x45\x8b\x34\x4e\x7a\x17\x3f\x6e\x0b\xd2\x17\x02\xe6\xa8\x80\x1c\x94\x49\xa9\xf3\x10\x1c\x7b\x8e\x5a\x06\x57\xa5\x4a\xf0\xce\x63\x46\x49\x91\x32\xcc\x08\xc6\x89\xcd\x66\x2a\x50\x5e\xb0\x19\x06\x06\x22\xbb\x50\x3c\x1c\x39\xbb\xf0\xca\xb1\x2c\x05\x94\x0e\xd8\x6f\x02\xbd\x01\x86\xec\x09\x00\xb4\x00\x41\x13\xce\x40\xf5\xc9\x68\x1e\xa7\x53\x97\xd4\x67\x1b\x56\x44\x86\xa1\x4a\xb5\x1d\x90\x37\x75\xa6\x4d\x7a\x98\xe7\x83\x4e\xc0\x3e\xca\xf0\x76\x18\xf5\x22\x84\x36\x4b\x52\x40\x60\x45\x35\x00\x41\x12\x90\x6b\x85\xc9\x55\x41\xac\x39\x66\x71\x3b\xa3\x79\x06\xbd\x96\xaf\x70\x13\xe6\x32\x13\x99\x0e\x47\x9c\xa1\x36\x23\xa9\x08\x35\x5f\xfd\x4f\x74\xa6\xd9\xbd\x05\x41\xd4\x45\x8c\x64\xf2\x23\xc9\xf7\x93\x20\xdc\x85\x5f\x77\x91\x56\x6c\xee\x10\x19\xe5\x6c\x73\x22\x83\xe5\x33\x0e\x72\x6a\x4a\x40\xfb\xdc\x8f\x11\xf9\x60\x97\x2a\x8f\xd7\xfc\xfc\xd6\xa4\x56\x64\x85\x4d\xd1\xfa\x35\x7e\x17\x5b\x2a\x23\x60\xae\x63\x51\x9c\x4d\x2d\x32\xda\xd7\xc6\xc6\x8a\x57\x30\x28\x51\xe6\x14\xf4\xbb\x82\x17\x49\x99\x53\x0b\x0d\xdf\xc4\xd9\xe2\x02\x37\xda\xaf\x64\x98\x8b\x0c\x3d\x7a\x9a\x7c\x6b\x98\x18\x8d\xac\x23\x57\x1d\xf1\x6f\xa6\xc2\x61\xab\x77\xfa\x40\x69\x6c\x0f\xfd\x29\xaa\x12\xe1\x22\x4c\x2f\x33\x15\x8e\x73\xe9\x7d\x13\x3e\x03\x28\xbc\x9a\xc3\xb9\x13\x70\x90\xb2\x70\x09\x03\x70\xd4\x02\x01\xd2\xd1\xdc\x5c\xa3\xa2\x7d\xa6\xc2\xb9\x5c\x49\x64\x5d\x24\xf7\x01\xc5\xa6\xe8\x29\x8b\x08\x71\x35\x04\x88\xf5\xc5\xb2\xae\xf2\x6a\xa6\xcd\xb5\xf2\xb6\x72\x89\xea\x24\x3c\x2b\x75\x2a\x66\x39\xab\x1e\x22\xc0\x0c\x91\x34\x98\xc3\x80\xaa\xcf\x19\x85\x25\x98\x59\x99\x8b\x99\x0d\xf6\x48\xc3\xe2\xc6\x63\x86\xad\x29\x4d\x63\x33\x27\x53\x0d\x82\x19\x33\xed\x47\xf8\x05\x69\x1c\xd0\x20\xbf\x51\x28\x20\xc6\x0a\x2e\x9e\x38\x90\x84\x6b\x7c\xbe\x01\x5d\xcb\x1a\xab\x57\xfe\xa4\x33\x10\x59\xe8\x48\x4c\xc4\x1f\xad\x50\xfd\xf7\x3e\x3b\x24\xa5\x2a\xaa\xb7\x6e\xec\xe1\x10\x48\xa8\xf9\x42\x10\x75\x6f\xfd\x4f\x95\xcc\x61\x7d\xce\xf8\x69\x05\x4b\x67\xe5\x3b\xf8\xa0\x40\x4b\xc5\xf7\xd9\x31\xcc\xa5\xf9\xe1\xe9\x35\xc0\x2c\x3c\x0c\x6f\xec\xfb\x53\xc0\x51\xbe\x6d\x7e\x4d\xbc\xcf\x4e\x48\xc4\x82\x5f\x82\x66\x44\xe0\x4a\x61\xec\x52\xbe\x19\x28\xd7\x18\x86\xa0\x79\x0e\xd6\x43\x48\xa7\x96\x38\x44\x95\x9b\x4a\xbb\x04\xc7\xfb\xe8\xef\xb3\x5b\xd3\xbc\xcd\x5c\xba\x54\x19\x5c\xe4\x12\xb3\x01\xc2\x42\xba\x16\xa0\x0f\x4a\xfd\x62\xae\x64\xea\x6f\xcb\xa8\x5b\x65\xf4\x9c\xb6\x57\xc5\x5c\xe5\x25\x1e\x70\xf9\xda\x24\x17\xd3\x29\x1c\x72\x82\x61\xd1\x15\xc4\xdc\x06\x60\xe7\x73\x1b\xee\xd5\x8a\x4f\x24\xe0\xce\xfd\x03\x4a\x9c\xb3\xa9\x98\x57\x29\xaf\xaa\xe6\x15\xab\xf0\x5a\x28\x5e\x59\x5c\xab\x8c\x57\xf1\xf0\x31\x00\xc7\x8e\x18\x01\x9c\x8b\xd1\x40\xf9\x1d\xa5\x3c\x03\x90\x5f\x06\x40\x10\x5f\x15\x80\x36\x08\x9d\x58\x73\xa3\x96\x81\xcb\x8e\x68\x33\xfb\x44\xc2\xb5\x4c\xb5\x7d\x74\x13\xd2\x8e\x29\x4b\xbc\xee\xd0\x89\xf3\xe1\xe8\x6c\x48\x41\x06\x9d\x9c\xe1\x46\x91\xbe\x7f\x2f\x4d\x9a\xab\x06\x80\x0e\x1b\x61\x6e\xe6\x21\xee\xc1\xe9\x7b\x5e\xb7\xe9\xfb\x21\x72\xe0\xee\xfd\xac\x13\x88\x00\xb2\x3d\x7d\x9f\xdd\x73\x09\xe7\x64\xae\xa8\x73\xe0\xed\xe8\xa4\xc7\xb3\x42\xcc\x64\x96\xd1\xe8\xf6\x42\x9b\xb9\x72\x3a\xdc\x34\xfb\xf4\x14\xb4\xe6\xff\x70\xb2\xaa\x06\xc6\x9f\x45\x94\xc5\x67\x46\x8c\xe5\x6e\xd4\xe2\x71\x6a\xd5\x86\x9d\x38\x23\x80\xe2\x74\x44\xd0\xbc\x99\xe6\x8f\x08\xbc\xa3\x0c\x64\xed\xdc\x01\x08\x75\x83\x06\xe2\x7b\x94\xbb\xb1\xb7\x03\x11\x1d\x8f\x3e\x48\xd7\x4d\x4f\x56\xba\x3e\x7a\xcf\x08\xed\x9b\x19\x51\x68\x7f\xdc\x49\x5c\x75\x82\xeb\x04\xed\x9b\x99\x4e\xfb\xa1\x2c\x14\x0b\x17\xda\xdf\xb8\x0a\x1e\xf9\x20\x73\x9d\x12\x11\x39\x05\x53\x58\x36\x62\xec\x56\xf3\x35\x34\xc3\xa6\xe1\x87\x09\xb0\x78\x89\xde\x82\xc8\xcd\x5a\x94\xbb\x6e\x4b\x40\x8d\x2a\x25\x0a\xc3\x63\x9c\x9b\xff\x8c\x9f\x00\x81\xb5\x6e\x82\x94\xa8\x80\xbd\x57\xab\x1b\xe0\xfb\x06\x2d\xba\x69\xee\x8f\x40\xa3\x86\x8b\x04\x5a\x5c\x3f\x52\xe3\xad\x5f\x3c\xe9\x2e\x98\x1e\x5d\x8a\xec\x78\x9d\x3c\xe8\xf6\x91\xf5\x4b\xb0\x08\x7e\xf2\x02\xbe\xc8\xd3\x57\xb0\x2d\xbf\x73\xe9\x69\x7a\x33\x0b\x44\x32\x2d\x88\xd0\x2e\x3d\x4e\x17\x39\x37\x17\x9f\x4f\x70\xf5\xcb\xa5\xe5\xb2\x32\xc9\x9c\x4f\xc8\xd0\x79\x6a\x43\xd3\x58\x66\x02\x39\x29\x3c\x90\x97\x38\xd4\xd7\x31\x05\x86\x7b\xe5\x54\x24\xc1\xac\x43\x27\x16\x40\xf2\xb8\x62\x6f\xa1\x58\x7c\xc4\x89\x57\x21\x9a\x6b\x73\x8f\x76\xe9\x5d\x49\x8f\x8d\xdc\xaf\x9c\x4a\x74\xcc\x89\x84\xb6\x1b\xb9\xf6\xe1\x10\xbd\x59\x71\xa0\x21\x0a\xfd\x0d\x80\x63\x54\x79\x93\x6d\xfa\x71\xa3\xbc\x23\xbc\x59\x6d\xcb\x64\x4e\xb9\x8b\x81\x1a\xd5\x75\x00\x77\xc4\x8a\xc3\x25\x67\x4e\xea\xd0\xa8\x51\xbe\x21\x13\x01\xc4\xce\x43\xda\x89\xa3\xca\xfa\x70\x61\x53\x45\xc2\x48\x1e\xdf\x97\x77\x24\x63\x5a\x26\x14\x4f\xf5\x03\x63\x43\xc5\x75\x6a\xb8\x14\xa1\xb7\xc6\x63\xff\x50\x29\xa2\x8d\x10\x92\x5b\x99\xa2\x58\x84\x50\xa3\x75\x02\x84\xd8\x5e\x07\x8c\x3b\xbe\x51\xce\xf1\x74\xcd\xa9\x8c\x45\x04\x4a\xf0\xa4\x3f\x2b\x5a\xff\x11\x17\xcf\x6d\x72\x8f\xea\xab\xdc\x66\x81\x13\x3d\x99\x19\x70\x81\x4b\xf8\x1d\x7b\x7f\x33\x77\x95\xc0\x44\x49\x37\x73\x67\xab\xd9\x9c\x59\x41\xee\x71\x88\xe7\xfe\x76\x20\x40\x30\x0b\xb6\x70\xa2\x90\x33\x4c\x11\x34\x88\x99\x45\x00\x39\x43\x73\x6f\xdc\x26\xd2\x86\xbe\x28\x60\xd7\x36\xcf\x11\x18\x6a\x33\xcb\x9b\x05\x30\x50\x6e\xaa\xa9\xba\x34\x9c\x4a\x09\x53\x0b\xe9\x12\xc6\x5c\xec\xf5\x0d\x81\x56\xa8\xd0\xd9\x91\x74\xc9\x26\xc6\x88\x5c\xe3\x96\xe9\xda\x23\x44\x7a\xf4\x10\x8e\xd9\xb5\xdb\x90\xd2\xbd\xcb\x71\x32\x22\x74\x35\x58\x8a\x42\x15\xd0\x9f\x0a\x65\x2a\x3c\x1c\x49\xc7\x72\x19\x60\xfc\xe9\x8a\xb8\x48\x89\xc0\x8d\xa5\x8f\x07\xf8\x50\x85\x78\xc6\x85\x39\x5b\x79\xa1\xf0\xc1\x59\x65\xd0\xc6\xea\xb0\x2c\x45\xa1\x61\x35\x4a\xd3\x58\x61\x53\x95\x3f\xe2\x73\x48\x8b\x3f\x0a\x30\x0d\xbb\xc2\x56\x5e\x89\xc2\x3e\x0c\x0c\x1c\x2f\xa1\x76\xf6\x01\x29\x6a\xf0\x7b\xb0\xa7\xbf\x23\xc8\xbc\x02\x40\xda\xc4\x3d\x84\xef\x7f\x12\x35\x4a\x64\xa6\xff\xa4\x1a\xa4\xa8\x82\x12\x8d\x9a\x41\x18\x59\xa8\xb8\xf9\x0a\x30\x4d\x88\x00\x71\xf2\x90\x08\xe1\x0c\x6f\xd4\x02\xab\x09\x0d\xe3\x9b\xe4\x29\x36\x55\xc7\xd2\x07\x04\x06\x89\x35\xcc\xa3\x00\x6d\x78\x3e\x20\xbc\xb7\x6d\x78\xf4\x09\x63\xb5\x87\xa3\x39\x23\xe7\x19\x8a\x10\xd1\x59\xb0\xb4\x11\xd4\x4d\xe5\xa6\xfe\xc8\xda\x5c\x49\x43\xc8\xb1\x2d\x57\xb7\x1f\xe0\x8b\x10\x5a\x4d\xf9\x2e\x68\xd3\xdb\x0f\x04\x5f\xa1\x55\x11\x82\x3c\x2b\x12\x8c\xa9\x00\x08\x66\x45\x59\x07\x9e\x59\xe3\xf1\xf3\x32\x8d\x34\xc1\xeb\x58\x7b\xbd\x53\x87\x0f\x5a\x2d\x9a\x4f\xf0\x81\xd4\x1d\x76\xfa\x27\xef\xe6\x5a\x1c\xce\xc7\xe4\x6a\x4e\x08\xe9\x9b\x09\xc6\x16\x69\xc1\xdb\x81\xb0\x53\x6f\x41\x2e\xbf\x72\x36\x11\x36\x51\xd2\x5c\xe0\x3b\x39\xf5\xa0\xd5\x82\x5e\xcd\x66\x99\x8f\xeb\xf8\x0e\xdc\xea\x77\x89\xd8\x60\xa5\x32\xef\xce\x5a\xc5\x00\xe0\xb8\x3c\x83\xd7\xb1\xed\x56\xb7\x5d\xdb\xe2\x26\x0c\x44\x9c\x4e\x62\x14\xe8\x7e\xe4\xd6\xd0\xf9\x60\x96\x3f\x37\xf7\xeb\x88\x52\xd7\xb6\x0f\xca\x39\x9d\xaa\xb8\xfa\x13\x25\xaa\xbe\xc8\x9c\x23\x0a\xf8\xa5\xd4\xee\x28\x57\x26\x8d\x7e\x0e\x38\x93\x95\xd2\xc4\x65\x71\x89\x65\xe1\xf1\xd8\x9a\x4c\xcf\x9a\x28\xff\x40\x8a\x93\x06\xfa\x4d\x75\xd4\x8b\x11\x8f\x91\xef\x01\x8d\x5a\x3e\x36\xf8\xa4\x53\xe7\x65\xca\x38\x46\x22\xc0\x1f\x32\xa2\x83\x46\x90\x8c\x14\x12\xa7\x70\xa4\x74\xd3\xa6\xf8\x00\xe3\x15\x13\x9e\x1c\x36\xc1\x1e\x4a\x09\x03\xbd\x24\x75\x4f\xa9\x5c\xc2\x69\x35\x50\x21\x60\x12\x15\x99\x62\x99\x2d\x7d\x70\xf6\x5e\x79\x4c\x9f\xf1\x51\xe6\xf7\x31\x5d\x86\xc0\x84\xde\xc0\x50\x39\x33\xc6\xd0\x65\x82\x0c\xe9\xa8\x2d\x39\x3b\x46\x53\x14\xe0\x98\xaf\xb1\xcc\x2b\x54\x83\x22\x80\xf3\x48\x89\x93\x11\x55\x7d\x6d\xdd\x7d\x1b\x74\x4e\x6e\x1a\xdd\xa6\x69\x56\x6f\x8f\x89\xa4\xc9\x46\x90\x52\x53\x9d\x57\x39\xe3\x97\x6c\x7c\xc3\x48\xec\x93\x88\x5e\xb5\x1e\x10\xb9\xa2\xa1\xb3\x56\x72\xbe\x3a\x24\x97\xe8\x16\x3c\x95\x89\xc2\x5e\xd8\x92\x88\x15\x12\xa1\x0a\xf6\xca\xd9\x3f\x3b\xd9\x24\x0e\x1f\x94\x93\x33\x75\xc1\x79\x53\x5a\x4a\xe7\xb1\x23\xdc\x35\x47\x08\x3a\x21\xe9\x08\x08\x65\x9b\x03\xc2\xac\xcd\x2f\x29\xef\xc3\x91\xd3\x29\x88\x68\x33\xca\xc6\x80\x0a\xa2\x73\x6b\x74\x74\x45\xa1\x45\x26\x41\xba\xbc\xc4\x2c\x23\xf9\xea\x38\xd7\xe5\xd4\x4a\x97\x32\x66\xbd\x3a\x6a\x5d\x45\x60\x55\x27\x4b\xdf\x29\x95\x09\xf8\xce\x91\x62\xdd\x7a\xa5\x90\x74\xc2\xd5\x47\xe4\x4a\xb9\xce\xbb\x91\x09\x4c\xf3\x7c\x01\x1d\x9e\x61\x13\x01\xe0\xa9\x04\x3a\x25\xbd\xea\x80\xb1\xa5\x49\x21\xd0\xa9\x48\x8b\x37\xb7\x00\x2f\x26\x20\xc4\x73\x73\x2d\x6e\x27\xb5\x08\x46\x80\x7a\xf4\xad\x4e\x60\x6e\xe4\x97\x3f\x51\x79\xfb\x73\x27\x2a\xef\xbc\xd2\x49\xd4\xc3\x1f\xc2\xb7\x42\xa9\x72\x9d\xde\x14\xc2\xb3\x42\x0b\xd2\xee\x3c\xe2\x0f\x32\xaf\x30\x05\x12\x20\xa4\x8e\x6d\x9f\x23\xbc\xad\x00\x13\x3a\x95\x38\x45\x8b\x04\xbe\x1f\x10\xfe\x1d\xdc\x59\x65\xf8\x9d\xb2\x43\x0c\x15\x46\x2f\xfe\x5e\xe5\x9a\x1e\xa6\x11\x87\x80\x0f\x32\xcf\x0f\xdb\x36\x20\x86\xc7\x05\xe0\x26\x5b\xdc\x80\x3f\xc6\x9d\xe3\x26\x87\xc7\xb9\x2c\x63\x4b\x9f\xcb\x32\xf6\x80\x73\x59\x0e\xd5\xa2\x03\x12\xa3\x00\x54\xb9\x4e\x8f\x6d\xb0\xf8\x89\x90\xd0\xbe\x70\x8b\xae\xdd\xc0\xf5\x42\xb8\xd3\x18\xe7\x28\xf6\x9c\xd2\xc0\xce\x57\xb8\xef\xd8\xfc\x92\x7d\xe8\x96\x1b\xb1\x58\x92\x7d\x50\x1d\xb0\x53\x66\x67\xc4\x12\xb8\xde\xaf\x2f\xa2\x94\xc0\x4f\x5f\x96\xe1\xf6\xc1\x47\x90\x38\x1f\xc0\x64\xc6\x01\xe0\x95\x2e\xa9\x46\x57\xc8\x36\x1f\xf5\x3d\x9a\x59\x19\x0a\xd6\xe8\x04\x77\xf1\x89\x62\xef\xf9\x52\xcc\xcd\x12\x31\x5f\x30\xb4\x5e\xd6\xca\xc9\x82\x9f\xfd\x57\x25\x23\xa0\xdc\x23\x86\x72\x4d\xc1\x97\x00\x64\x41\xa5\x05\x1f\x31\xc2\xc7\xc4\x2e\xb3\x69\xad\xee\x5a\x0c\xba\x7b\x4b\x81\x99\x2f\xc4\x1f\x0a\x37\x16\xbe\x40\xa7\x7d\x87\x6a\xd1\x7c\xae\x21\x07\x3c\xbe\x92\x61\xde\x14\x80\xdb\x7d\x04\xda\x2c\xb4\xf7\x92\xd3\x4b\x03\x3e\x31\xa4\x87\x18\xfa\xb4\x81\x9a\x12\x01\xe9\x94\x93\xeb\x70\x1d\x1d\xfd\x10\xeb\x7c\xe9\x21\xbc\xf1\x6c\x1e\x94\xb9\xfd\xd0\x61\x97\x24\x92\xe9\xce\xa8\x6d\x28\x6d\x77\x6b\xe4\x38\x42\x5c\x45\xbd\x82\x56\x74\x5c\xd0\x4d\x0c\xba\xc7\x2d\x44\xb2\x1d\x42\x46\x33\xed\xa3\x4a\x69\x38\x88\x12\x75\xf9\xa5\x2d\xab\x12\x85\x26\xf4\x2b\x2e\xed\x42\x94\x4e\xc1\x1a\xee\x5a\x65\xfd\xb4\x49\x65\x63\x31\x85\xf0\x91\x74\x0d\xfc\x11\x6d\xff\x01\xfd\xf3\x54\xe7\xea\x03\x79\x7c\x95\xd4\x6a\x3c\x55\x12\x12\x55\x2a\x65\xd3\xa2\x8d\x5c\xd3\x50\xc8\x7e\x8c\x1c\xfa\x78\xad\x5f\x3a\x5b\x9e\xdb\x14\x5b\x1a\x41\x28\xd1\xa7\xc7\x64\x00\xd6\x88\xe6\xa5\x4f\xa1\x8f\xb0\xe6\x00\x0d\x28\x80\x44\x5b\x97\xa5\x4f\xd7\x2e\x54\xa8\x84\x2f\x17\xa9\x28\x57\x61\x8e\xc9\x4a\x94\xe3\xf6\xa6\x84\x23\x4e\x62\x54\xce\x54\xcd\xd0\x49\x10\x9d\xf7\x5a\xed\x65\x07\x06\xd1\xd2\x3d\x52\x62\x22\x1e\x37\x8a\xd6\xe8\x45\x49\x53\x1a\x54\xca\x91\x07\xa2\x49\x2d\xac\xb6\x4e\x6d\x9e\x83\xf4\x45\xe9\x4b\x28\xa8\x38\x9a\xf7\x47\x9d\xbe\x53\x32\x45\x5b\x5a\xa7\xd0\xc4\x8f\xda\x96\x91\x28\x86\x38\x95\x58\x47\xfb\x18\x04\xf2\xae\xa4\x53\xa9\x15\x4e\xc5\xb0\x99\x0d\x84\xa6\x2e\x0d\x46\x56\x98\x0e\x2d\x1b\x9e\x32\xf5\x72\x2a\x73\xca\xcf\xe3\xf9\xb0\x2f\xa2\xc5\xf7\xa3\x5d\x0e\xa7\xe6\x12\xef\x83\x1e\x84\xda\x1f\x4e\x2f\x40\x2b\x49\x82\xd1\x5e\x29\x8a\x9f\x4c\x5b\xb7\xab\x5e\x4b\x32\xc0\x2f\x05\xe0\xe3\x5a\x01\xed\x76\x00\x00\x25\x63\xe8\x58\xeb\xa1\x58\xe8\x5a\x89\xd6\xb5\x5b\xbd\xf1\x5b\xb6\x14\xd2\x62\xae\x51\xac\x0f\xb0\x72\x51\xde\x77\xc9\xb7\xa6\xec\xde\x4a\xbd\xd6\xb1\xc2\x3f\x8a\xcc\x84\xff\x8b\x42\x9f\x77\xea\x31\x54\x21\xa0\x33\x0d\xa1\x37\xf3\xaa\x98\x1a\xa9\x73\xb2\x20\x62\x2a\x0d\x2b\x5e\x81\x74\x49\xeb\x3b\x36\x74\x05\x5f\x3a\xe6\xb1\xc0\xf3\x09\x5b\xa2\xc0\x8f\x7c\xaa\xb4\xc3\x44\x16\x98\x59\xd3\x29\x8a\xf6\x49\x9a\x1d\x5c\xcb\xe2\x77\xf4\x2a\x5c\x65\x4b\x5a\x3b\x92\x9a\x8b\x88\x68\xde\xd4\xfa\xaf\xf1\x7b\x04\x89\xf1\x2f\x39\x8c\x73\xec\x8a\x88\xb4\x5d\xf1\x01\x23\xd5\xb8\xd9\x14\x46\xd2\xdc\x3f\x08\xa7\x67\x3a\x3d\xb2\xe9\x8a\x20\x56\xcc\x3b\x9b\xe7\x78\x80\x21\x0e\x75\xbc\xcc\x84\x83\x9a\x92\xd9\xb9\x63\x9d\xd7\xa0\x09\xec\x81\x8d\x6b\x2b\x14\x96\x30\xf8\xc7\x35\xbb\xf1\xda\xc5\x71\xd7\x40\xd0\xd9\x45\x84\x2a\x83\x1a\x0b\x56\x11\xb8\xca\x54\xa5\xa0\x76\xa0\xde\xe9\xe5\x83\x3a\xcc\x73\x10\xa7\x1e\x14\x25\x51\xe8\xd8\xb1\x03\x4a\xed\x05\x50\xfb\xc4\xc0\xe8\xa0\x65\x3e\xa4\x0c\x00\xf2\x81\xcc\x6d\x01\xe8\x6a\xbe\x22\x4e\x10\xe9\x61\x00\x82\x96\x6d\xfa\x42\xc7\xd1\x15\xf7\x27\x8f\x30\x84\x1d\x45\xb7\x27\x1d\x51\x1b\xdc\x98\x75\x46\x71\x3a\xf5\xac\xf7\x6d\x00\x68\x47\xcc\xc3\xc0\xdd\x07\xe1\xdb\xc1\xb5\x6a\xec\x6a\x84\x4f\x8a\xb9\xa0\x80\xd8\x74\x37\x40\x74\x3b\x0e\x13\x22\xfc\x1f\x3b\x65\x08\x3f\x3b\x81\x37\xa8\x44\x63\x98\xd5\x4a\x84\xb1\x6e\x6f\x1d\x43\x8d\x80\x6f\x6d\x4b\x19\xe6\x0f\xe3\x93\x2a\xc7\x74\x0a\xd2\x25\x73\x98\xa2\xc9\x7c\xd0\x2b\x95\x0a\xaf\xf2\x33\x2b\xd3\xb6\x89\x48\xa4\xf0\x6b\x03\x80\x31\xb2\x28\x63\x0c\xdb\xa2\xab\xf2\x6e\x90\xae\xc2\xbe\xa1\xa3\x95\x1b\x81\xa8\x41\x88\xa0\xb6\x0e\x73\x16\x20\x4a\xaf\xc1\x7a\x52\x68\x8d\x58\x1f\x9a\xc6\x58\x13\x2c\x3c\x89\x26\xd0\xe8\xbc\x13\xcd\xe7\xbe\xa9\x8a\x86\x3d\x32\xed\x9c\x23\xfa\x31\x7a\xa1\x13\x75\x81\xf5\x6b\x6f\x6b\x3f\xf3\x35\xc8\x30\x4d\xc0\xf5\x66\x33\xbb\x6b\x46\x86\xe4\xe8\x6d\x42\xbb\x16\x40\x61\xcb\x53\x36\xd1\xa7\xf7\x8e\xea\x72\xdf\xdd\xf9\x06\xc4\x26\x15\x66\x9a\x18\xc4\xd0\x3e\x7e\x6d\x3b\xbc\x53\xe5\xa6\x6d\x01\xc2\xfd\x7b\x02\x9b\x1f\x8e\x30\xae\x41\xfd\x5c\x96\xea\x06\xb9\xc6\x47\xca\x7a\x01\x63\x85\x9b\x1e\xbb\xd7\x53\xaf\x1f\x55\xdc\xa7\xe8\x67\xac\xd8\xa0\x82\xa1\x76\x9b\xfd\x09\xde\xe5\xbf\xd9\x79\x07\x0a\xc9\x19\x00\x0e\x83\x2c\xca\x13\x0e\x05\x46\xb8\x0b\x55\xb3\x25\x4b\x14\xca\xeb\x01\xe3\xf4\xc6\x02\x74\x3b\x68\x2c\x81\xbc\x0a\xff\x06\x16\x4b\xac\xdf\x2b\x78\x27\xfa\x14\xdd\xde\xc0\x1f\x87\x8d\x18\xae\x15\xb2\x38\xa6\xa2\x8e\x61\x9d\x74\x41\x29\xb3\xe2\xaa\x36\x52\xb1\x91\xa0\xfd\xda\x0b\x2a\xcf\x78\xfa\x42\x38\x8e\x26\xb6\x19\xec\xa0\x71\xd0\x81\x14\xcc\x8a\x75\x3f\xb7\x0b\xf4\x73\x42\x40\xa7\xa9\x32\x08\xe2\xab\x50\x6d\xe2\x34\x83\x6c\xf7\x06\xfd\x3a\x91\xd8\xe9\x6c\xd8\xa9\xb9\x13\xcd\xed\x82\xc5\x40\x4e\x08\x42\xce\xec\x31\x87\xc7\xec\x3b\xb6\x6c\xe4\x3b\xaf\x3f\xab\xa3\x55\x80\xf1\xd4\xb1\xa2\x01\xf8\x8a\xd5\x49\x1e\xa5\x75\x9a\x63\x08\x66\x49\xb2\x29\x07\x89\xb8\x79\xe3\x8d\x2c\x5f\x07\xfb\x1a\x01\xe4\x03\x46\x96\xd4\xcb\x8c\x2c\x6f\xec\x4c\x85\xb9\x42\xfb\x52\xc0\xfd\xdc\x06\xe1\x6d\x46\x87\x73\x9b\xc6\x33\xde\x80\x19\x09\x30\x58\xa8\xe7\xc9\x06\xe5\xb0\xd8\x9d\x4a\x99\xa8\xa8\x7c\x6b\xbc\xe5\xe7\x28\xd4\xf9\xd2\xb2\x0a\xa9\x81\xa2\xbe\x93\xb2\x38\x80\x2c\xd7\xb5\xff\x23\x37\xfb\x98\x3b\xa1\x92\xae\x99\x45\xbd\x6b\x59\x5d\x90\xc9\xfd\x8d\xa3\x58\xff\xd2\x85\x61\x8c\x87\x2f\x5d\x20\xdb\x32\x8f\x06\x23\x87\x06\xc4\xde\xdc\xa6\x8a\xb4\x03\x44\x6d\x0a\xec\x62\x57\x14\xe2\xc4\x07\x97\x14\x25\xfb\xe1\x21\x07\x06\x11\xcc\x60\x3f\xef\xd0\xd8\xce\x7c\x9d\x56\x99\xd0\x25\xd0\xc6\xe5\x61\x20\x3b\xff\xb5\x0b\xbc\xf7\x66\x5d\x97\x7c\xcd\xd9\x21\x1e\x53\x9e\x28\x81\x2e\x34\x4a\x11\xff\xed\xb5\x3e\x46\xb2\xed\x90\x6f\xec\xb0\x9b\x34\x20\xa2\x3c\xc9\x04\xa7\xe9\xa5\x4b\xf2\xde\x3b\x45\x73\xa4\x02\x6b\x03\x2b\x64\x5f\x4d\xd3\x47\x1a\x38\x20\x1d\xe7\x4a\x9a\x1b\x8a\xd6\xbe\x22\x0a\x2b\xb6\x10\x79\x4a\x9b\x03\x74\xd6\xdd\x00\xf8\x3d\x35\x08\x5c\x63\x35\x08\x83\x51\xe1\x41\x68\x48\xe6\x6b\xbf\x4b\x6a\x0a\x04\x6f\x2c\x2e\xc5\x19\xc6\xcd\x00\x04\x79\x61\x78\xfb\xc1\xdf\x58\xb2\xed\xf4\xb4\xca\xa1\x13\x2b\xc8\x08\x39\xf9\xc6\x18\x96\xb2\x1d\x84\x2a\xa8\x0e\x08\x72\x52\x93\xdd\x80\x69\x91\xc5\xad\xa5\x3e\x58\x8b\xcd\x10\x15\x47\x11\xc5\x3d\x5f\x94\xdf\xfd\x02\x5e\x8c\x7f\x9c\x06\x08\xfa\x7c\xb2\xa0\x81\x8e\xb7\x91\xd9\x21\xd2\x18\x96\xf8\x95\x3f\xa5\x40\x0f\x9c\x02\x21\x72\x3e\x4e\x78\x30\x5b\x57\x85\x07\xb5\x3c\xeb\x6e\x55\xc7\x81\x15\xd4\x92\x78\x5f\x07\xef\x6c\xf8\x31\xca\xca\xb6\x0e\x75\x7d\x93\x2f\xa8\xe5\xda\x6e\x1e\xe0\x6b\xa2\x4a\x43\x78\xac\x92\x80\x0b\x45\x95\xce\xd4\xed\x87\xce\xbd\x1f\xb5\x81\x99\x87\x6f\x40\x52\x60\xf3\x13\x00\x89\xaf\x37\x50\xbb\x18\x6d\x48\x11\xa1\x29\x2a\xac\x1b\xd5\x00\x1a\xa5\xb7\x40\x4b\xe3\x13\x36\xbc\x26\xcd\x11\x13\xdf\x4b\x4d\x5e\x87\x91\x70\x15\x65\xc8\x4e\x55\x81\xce\x13\x40\x98\x03\x6f\x23\xb6\x00\x30\xb1\x98\x43\x97\xe0\x7b\xa0\x0c\x4e\x6c\x14\x60\xfc\xd8\x68\x5b\xfe\x6f\x11\x6c\xc7\x12\x30\xd8\x19\xa6\x0f\xc0\xd3\xe1\x52\x7b\x06\xe9\x47\x3e\x68\x1f\xfd\x1d\x31\x87\x02\xe6\x16\x60\x80\x5e\xce\xe6\xca\x51\xa2\x07\x9b\xdb\x05\x26\x47\x68\xfa\x10\x80\x9d\x35\x39\xa0\x27\xce\x96\xa5\x4a\x11\x7e\x2f\x7d\xd4\xb7\x01\x1a\xfd\x46\xe2\xeb\xa1\xca\x25\x58\x0c\xb5\xdf\xc9\xb0\x70\x4f\x47\x7c\x49\x1e\x72\x8d\x77\x71\x27\xd3\x42\x9c\xc0\x1b\x02\x5b\x39\xc7\x40\x1e\xd0\xcc\x4e\x17\x8f\x52\x20\x9c\x36\x36\x9b\x0d\x09\x3f\x0b\x51\x2a\x8c\x09\x41\x27\xfc\xfd\xca\x4d\xab\x9c\xb2\x63\x2c\x6c\xb7\xf9\x2b\x7d\xad\xbc\xa8\x74\x23\xc4\x54\xe6\x5b\x4f\xd5\xca\xa4\x16\x0f\xb8\x0e\xa8\x0c\x59\xfb\xc5\x60\xfe\x98\x86\xb5\x32\x1d\x1b\x80\xca\x50\x46\xc6\xdb\x0f\x98\x8f\x20\xe4\x0a\xbd\x57\x90\x8f\x56\x06\xdf\xa6\x2a\xf1\x13\x56\xb8\xbe\x3d\xec\x63\xfc\x0a\xac\xcf\xc3\x99\x36\xf7\xa2\x7a\x18\xc6\xb9\xf8\x41\xe6\x1a\x6e\xc2\x7e\x89\x45\x90\x53\x38\x06\xa5\xd0\x6a\xf1\x3a\x8d\x43\x02\x30\x16\xf5\x10\xcc\x75\x49\xfd\xf6\x81\x9d\xa0\xe1\xfc\x5e\xc9\xf4\xd2\x20\x78\x66\xed\xfd\x21\x51\x69\x3c\xf0\xee\x63\x42\x4f\x0c\x15\x5c\xf3\xd6\x89\x07\x74\xf7\xc2\x0a\x3f\x58\xd4\xd9\x2d\xa4\x86\x81\x07\x17\x39\x0e\x86\x58\xa8\x29\x1b\x2d\x77\x40\x5a\xe3\x2d\xe6\x32\x0c\x3c\x87\xc4\xe0\x13\x2e\x2b\x16\xda\x51\x3c\x8c\x38\x70\x40\xd4\xa3\xed\xe9\x85\xd3\xe6\x3e\x6f\xce\xcd\x0d\x4e\x07\x85\x2a\x9f\xe5\xb4\x38\x93\x66\x06\x63\x03\x3b\xf8\x12\x3e\x46\x6f\xfb\xff\xa7\xf7\x7b\xff\xa0\x78\x03\x6a\xe7\xf8\xe2\x7c\x5b\xed\x1c\x0e\xcf\xbb\x21\x07\x88\x17\x63\x8c\x82\xff\x8b\x01\x0a\xfe\x2f\xdf\x7d\xd4\x1f\x93\x8b\xff\xdd\x68\xb4\x31\x1a\x3d\x1b\x8d\xde\x8e\x37\x47\xa3\x3f\x46\xa3\xc5\x68\x34\xad\x7f\x18\x8d\x16\x2f\xea\xbb\x3f\x46\x23\x3f\x1a\x2d\xbe\x8c\xeb\x2f\x40\xf8\x5a\xc3\x71\xab\xf7\x75\x5b\xed\x1c\x9f\x1d\xc3\x2f\x1d\x1f\x1d\x9f\x8f\xbf\xef\xfd\xef\x92\xca\xad\xd6\x23\x00\x3c\x48\x27\xc2\x9b\x26\xde\x42\x0f\x43\xf4\x61\x50\x93\x09\x83\x94\xec\xaa\xc1\x4c\x92\x57\x69\x73\x51\x99\xb4\xbd\x82\xcb\x6f\xed\xd1\x1e\x80\xcd\xe2\x88\xf4\x19\x8d\xb5\xbb\x80\x57\x64\xda\x46\x36\x75\x30\x17\x15\x4d\x30\x3b\x0c\x53\x86\xc1\x5e\x30\x1a\x30\x86\xae\xd0\xc6\x73\x1c\x25\x27\xa4\x59\x71\x90\x7a\xb3\xa2\x80\xc1\x1a\x96\x30\x85\x4e\x15\x06\x00\x17\xc6\x1a\x38\xa1\xbd\x02\x1a\xf0\x1b\x65\x2b\x2f\x32\xa9\xf3\xca\x29\x91\x24\x13\xbe\x23\x49\x28\x11\x3b\xfe\x46\x92\x4b\xef\x5b\xfb\xbe\x05\x29\x9f\x9d\x9c\x51\x04\xb4\x02\xea\xac\x96\x1c\x13\x9c\x78\x8d\x0c\x16\xd6\x72\xd6\x4d\x30\x38\x02\x2b\x77\x38\x14\x4f\x50\x93\x18\x33\x8c\xe9\x29\xea\x68\x08\x8e\xf5\x8d\x38\xd5\x23\x62\x5c\xbf\xa6\xc0\xe4\xd1\xf5\xf6\x15\x22\xa5\x7d\xcd\x48\xe1\xd7\xed\x6d\x17\x2a\xc8\xfd\x9e\x36\x14\x2b\xd1\x4e\x18\xc2\xc0\xcc\xbe\xc4\x14\x69\x20\x43\x53\xd4\x94\x4c\x26\x31\xb7\x41\xb4\x15\x40\x23\x17\x91\x5b\x5b\x52\xe4\x47\x32\x49\x91\x39\x06\xe4\x69\x62\x2d\x05\x8c\x49\xae\xfc\xc4\x70\x38\x5f\xa4\x91\xe3\x6e\x83\xd3\xe7\xc5\xb6\x9c\xb4\xbe\x16\x93\x24\x97\x95\x57\x5e\x64\xd6\x29\x3d\x33\x13\x8c\x15\x11\x91\x54\x25\x79\x83\x60\x56\xb6\x88\x60\xcf\x88\xc8\x7a\x37\x8d\x54\x4a\xdc\x36\xc1\xf8\xa8\xeb\xb4\x16\xa5\x08\x9a\x2b\x8a\x96\xc4\x83\x44\x2c\x74\x9e\xe3\xab\xac\x51\x69\x3e\x9d\x78\x99\x41\x4b\x86\x49\x17\x2f\xe4\x6a\xaa\xd6\x28\xf1\x4d\x4b\xdc\x57\x67\x04\x3e\x3c\x12\xb0\x91\xd3\x49\x66\xdd\x44\x5b\x32\xc8\xa2\xdc\x31\x3a\xc7\xa0\x46\x74\xa6\xa8\x03\x98\xcd\xc0\x72\x72\xcb\x44\x9a\x49\x29\xbd\x9f\x48\x1f\x2b\xc6\x71\xce\xe8\xbb\x35\x55\xc7\xec\x48\x13\xb5\x4c\x14\x25\x5a\x83\x97\x2c\x6c\xaa\xb3\xd5\x04\x8b\x6f\xef\x5c\xa3\xc2\x6d\xad\x97\x12\x60\x06\x63\x50\x47\x0a\x07\xcb\x98\xe4\xfa\x41\x91\x93\x56\xfc\xec\x74\x65\xfd\x82\xf9\x96\x6c\xec\xc4\xcf\x25\x8a\xa5\x95\xb9\x37\x76\x61\x1a\x9c\xcf\xbd\x36\xd8\x4b\x0f\x53\xf6\xc9\x3c\x87\x06\x17\x3a\x13\x61\xae\x0c\x05\x23\x09\xae\x52\x38\xaa\x45\x26\x09\x5f\x75\x9d\xda\x27\xd2\xac\x22\x5f\x98\xe0\xbd\x11\xa1\xdb\x5b\x4c\xe7\xc0\x83\x2a\xa7\xe8\x36\x82\x45\xfc\x52\xbd\xaf\xdb\xee\x8d\xda\x39\xde\xec\x3d\xeb\x6d\xf7\x36\x7a\x5b\xdb\xf2\xcd\x5a\x04\x99\xe9\x7e\xef\xd5\x8f\x3b\xa3\x51\xfd\xea\x6e\x6a\x97\x18\xce\x46\xbe\xcc\x0e\x5f\x9e\x8e\x9f\xf7\xbe\x6e\xeb\x37\x6a\x87\x0d\x92\x37\x69\x46\xf8\xe2\xf6\x5f\x7d\xdd\xda\x36\x6b\x17\xfe\xd5\x5e\xb0\x54\x3c\x0a\xef\x58\xfa\x68\x34\x1a\xdd\xc9\x69\x66\x5c\x78\x18\x8f\x46\x35\xe0\xcb\xee\xcf\x8c\xf0\xaf\x7e\x76\xf7\xf2\xc5\x0f\xe2\xf9\xce\xab\x97\x7f\x1f\x3f\xbf\x4b\xb5\x5d\xfe\xbb\x4a\x7c\xa6\xfa\xb3\x77\xe5\x18\x23\xdd\x7c\xfd\xcd\xec\x24\x3b\x65\xe5\xe7\x9b\x76\xeb\x37\x4c\x86\x46\x3f\x16\xdb\xbb\x17\x63\xe1\xfc\xe3\xcd\x3f\x39\xec\xcc\x3f\xde\xd0\xa3\x88\xbc\xf9\x67\x07\xd9\x85\x5f\x6d\x82\xd3\x8c\x46\xbb\xbd\xaf\xe3\xaf\xdb\xf9\x77\x4a\xdc\x7f\x39\x1a\xd5\x2f\x5f\xc6\x62\xb9\xd4\xf1\xd7\x18\x13\x88\x73\x9e\xec\xdf\xf5\x8a\xde\x76\xaf\xb0\xb6\x37\xde\xbe\xdf\x0f\x30\x43\xfa\xed\x7c\xdb\xf1\xfc\xb6\x2d\xb7\xd5\xce\xc5\xf9\xb6\xde\x36\x50\xce\xee\xfe\xcb\xdd\xaf\xdf\x9f\xf3\x74\xe9\xa5\x2f\x9e\x8c\x7a\x93\x0c\xf6\xff\xf2\x6a\xbb\xf3\xab\xba\xf4\xbd\xf1\x76\x0e\xef\xb2\x73\xd0\x7b\xa1\x76\x06\xd7\xdb\xf7\xfb\x5f\x88\x7f\xee\xbc\x46\x67\xee\x9d\x9f\xe9\x44\x71\x60\x76\xa4\x4f\xb4\xa6\xd3\x67\xb1\x33\x65\x2a\xdd\x82\xbc\x6a\x07\x93\xe3\xec\x60\x7f\xdd\x51\x26\xc5\x83\xce\xf0\x54\xe0\xd1\x89\x1d\xf5\xa9\x12\x3b\xca\x01\xb4\xd4\x01\xc8\x38\xcf\x88\x1d\x8e\x9d\xb3\x33\x47\x17\xad\x1d\x78\x4e\x67\xa9\xc2\x93\xa1\x33\xcd\xc7\x62\x47\xbb\x52\xec\xe4\xd6\xcc\xc4\x0e\x06\x3d\x16\x3b\x4e\x95\x01\x8e\x9f\xc4\x4e\xb4\xb2\xdd\xf1\x2a\x88\x1d\x7f\xaf\x4b\xb1\x43\xc2\xce\x0e\xca\x36\x3b\x54\x7e\x1e\xac\x9b\x89\xee\xe0\xdb\x78\x25\x36\xf6\xc4\xc6\x6b\xb1\xf1\x93\xd8\xf8\x59\x6c\xfc\x22\x36\x7e\x15\x1b\x7f\x15\x1b\x7f\x13\x1b\x7f\x17\x1b\x7b\x70\x79\x4f\x6c\xec\xbd\x16\x1b\x7b\x3f\x89\x8d\xbd\x9f\xc5\xc6\xde\x2f\x62\x63\xef\x57\xb1\xb1\xf7\x57\xb1\xb1\xf7\x37\xb1\xb1\xf7\x77\xb1\xf1\xfa\x95\xd8\x78\x0d\xe5\xbc\x16\x1b\xaf\x7f\x12\x1b\xaf\x7f\x16\x1b\xaf\x7f\x11\x1b\xaf\x7f\x15\x1b\xaf\xff\x2a\x36\x5e\xff\x4d\x6c\xbc\xfe\xbb\xd8\xf8\xe9\x95\xd8\xf8\x69\x0f\x03\x52\x09\x19\xc4\xc3\x2b\xf1\xb0\x27\xe4\x2b\x21\xf7\x84\x7c\x2d\xe4\x4f\x42\xfe\x2c\xe4\x2f\x42\xfe\x2a\xe4\x5f\x45\x78\x25\xc2\x9e\x08\xaf\x45\xf8\x49\x84\x9f\x45\xf8\x45\x84\x5f\x45\xf8\xab\x08\x7f\x13\xe1\xef\xc2\xbf\x12\x7e\x4f\xf8\xd7\xc2\xff\x24\xfc\xcf\xc2\xff\x22\xfc\xaf\xc2\xff\x55\xf8\xbf\x89\xfb\x57\xe2\x7e\x4f\xcc\x4a\xe1\x4b\x91\x95\xc2\x49\xb1\x91\xbd\x12\x1b\xd9\x9e\xd8\xc8\x5e\xf3\xff\xcf\x62\x23\xfb\x45\x6c\x64\xbf\x8a\x8d\xec\xaf\x62\x23\xfb\x9b\xd8\xc8\xfe\x0e\xf7\xe0\x8d\x70\x27\xbc\x73\x06\x2f\x9d\xc1\x5b\x67\xf0\xda\x19\xbc\x77\x06\x2f\x9e\xc1\x9b\x67\xf0\xea\x19\xbc\x7b\xf6\x1a\xcb\xc6\xc2\xe1\x09\x78\xff\x0c\x1a\x20\x83\x16\xc8\xa0\x09\x32\x68\x83\x0c\x1a\x21\x83\x56\xc8\x7e\xda\x13\x51\xf2\xbc\xc6\x5d\x49\xd1\x37\xc1\xad\xce\xec\xab\x08\xb4\x37\x5c\xc9\x99\x3a\x97\xfe\x5e\x7c\xd4\x20\x17\xe1\xf5\xf7\x5a\xbc\xff\x78\xdd\x37\x52\x1c\xc9\xf4\xc3\x61\x9a\x3a\x41\xeb\xc0\xb8\xea\x19\x5e\x8b\x81\x09\xc7\x21\x17\xc3\xeb\x21\x9f\xce\x65\x29\x8e\xd1\x88\xac\x7f\x75\x2c\xae\xae\x07\xa9\xe8\x1f\x49\xaf\x04\x99\xc5\xf1\x69\x8f\xcf\xaf\xf9\xfc\x93\x38\x3b\xc3\x1f\x38\xc1\x10\xb8\x27\xf0\xec\x49\x7f\x78\xf8\xa1\x2f\x70\x55\xd4\x77\x4e\xf4\x8f\x8f\x45\xdf\x39\xeb\xe0\xe2\x8d\x9c\x9d\x59\x71\x22\x83\x3c\xb3\x80\xbc\xd7\x88\xbc\xd7\xe2\x23\x30\xee\x33\x4b\xe7\xf7\x5a\x5c\x29\x97\x41\xdd\xf0\x6c\x82\xe8\xb5\x11\xbf\x58\x64\x65\x06\x39\xdd\x94\x69\xaa\x0f\xaa\x83\x5a\x9a\x54\x1f\xd4\xd3\x4d\x99\x6f\x1d\xd4\x53\xf5\x29\x3f\xa8\xa7\x33\xf5\x19\x71\x84\xc3\x67\x38\xe5\x8a\x4e\xa1\xbd\x62\x54\x7e\x50\x27\xf9\x9d\xfd\x3c\xae\x53\xfd\x50\x1d\xd4\x6a\x19\x6a\x6d\x7c\xfd\x27\x95\xf6\xa7\xcc\xdd\xe6\xce\x7c\x0a\x60\x04\xf2\x69\x75\x50\xe7\x73\x38\xe4\x75\x5e\xe9\x3a\x5f\xdc\xe5\x6e\x7c\x50\x17\x32\x4d\xab\x83\xba\xc8\xe6\xba\x2e\xb2\xdc\xd6\x85\x7d\x30\x70\xf8\x0c\x07\x55\x17\xbe\x82\x47\x8b\x00\xd7\x03\x5c\xaf\x72\xf8\x0f\xd5\x41\x6d\x6c\x59\x1b\xeb\x6a\xeb\xf4\x41\xed\x6c\x70\x0f\x07\xb5\x9f\xd6\x3e\xa9\xbd\xba\x9b\xce\xc7\xb5\x9f\xd7\x3e\xcf\x81\x9a\x07\x7c\x6b\xef\x24\x60\x0e\x69\x58\xb0\xe7\x7a\x2c\xb1\x90\x85\x9f\xce\x6b\x39\xf5\x3b\x77\x3e\x1d\xd7\x32\x4d\x19\xc8\xcd\xc3\x4e\xe9\xeb\x69\xb2\x77\x97\x85\x31\xbc\xff\xce\xa6\x3f\xc8\xea\xca\xd4\xd5\x81\xfa\x54\xdf\xd9\x6a\x9c\x07\x3a\xa9\x1a\x96\x96\x07\xb5\x57\x9f\xea\xfc\x4e\x85\x71\x6d\x66\x70\xda\xa2\xb2\x36\x13\xa5\xf3\x1a\xbd\x1b\x6b\xdc\x10\xac\x71\x7d\xbc\xb5\x73\x97\x2f\xc6\x74\x4b\x92\x25\x7b\x75\xf2\x10\x76\xd2\x9d\xbb\xdc\x2f\xc6\x08\xe7\x3b\x77\x69\x84\x4b\xbf\xe3\x11\xf0\x3b\x77\x69\xce\x44\xbf\x53\xde\xe5\x15\xc1\x0b\xa0\x7b\xfc\x3e\xf0\xd8\xb8\xce\xd3\xe5\x41\xb2\x57\xe7\xd5\x12\x8e\x0b\x44\x8a\xe6\xfd\x0a\xf8\xc1\xc2\x3e\xdc\x65\x26\x7c\x1e\x1f\xd0\x23\xd0\xf2\x7c\x39\xcc\xf1\xfe\x2a\xa7\x2b\x46\xcd\x18\xa0\x22\x08\xc4\xdb\x01\xc4\x6a\xc0\x3f\x34\x99\x53\x89\x2e\x77\xb2\x22\xd4\xee\xc0\x7f\x72\x81\x6e\xf1\x54\x9d\xe6\x11\x8f\xf5\xf2\x54\x2f\x8c\x8c\x56\x63\xfc\x9e\x3a\x3d\x50\x4e\x85\xfa\x2e\x55\x63\x5d\xab\xf9\x14\xaa\xfa\xaa\x2e\x42\xf2\xaa\x46\x33\xce\xba\x74\x2a\x5b\x1e\xd4\x2e\x9d\x2f\x5c\xed\xd2\x72\x56\xba\xda\xa7\xd3\x69\x59\x7b\x0f\xfd\xc3\xaf\x4c\xa2\x0f\x6a\xbf\xf2\x20\xa2\xd6\x41\x7d\xd2\x07\x75\x98\x29\xec\x0e\x21\x9f\x6e\x96\xb5\xab\x17\x77\xda\x8d\xb7\xea\xc0\xbd\x24\x18\x05\x7d\x41\xea\x50\x2f\x1c\x94\xb8\xc5\x71\xf2\x3c\xad\x22\x37\x7b\x77\xbf\xfd\x30\x66\x29\x87\xa7\xdb\xa7\xd7\xaf\x3f\xe2\x83\xb0\x24\x1d\x8d\xc6\x3f\x46\x99\x00\x6e\x42\xad\x03\x8f\xca\x9a\x8b\xaf\x7b\xdb\x1a\xce\xa6\x7b\x63\x14\x9f\x58\x3c\x78\x15\x25\x9a\xf1\x8b\x36\xde\xdd\x4b\x8c\x94\xd7\xfb\x3a\xee\x3c\x47\x6a\xc7\xe6\x39\x58\x15\x3f\xbf\x93\x2f\x3f\x4f\x30\x62\xde\xc6\x18\xe0\x57\x2f\xff\x1e\xd1\x17\xfb\x5c\x1c\xdd\x08\xb2\x51\x43\x22\xec\x6e\x9a\x8d\xe3\x4f\x8c\xa1\xa2\xbb\xbd\xef\xcb\x13\x9f\xa5\xfb\x5e\x0c\x3d\xce\x2c\x2f\x1e\x6c\x22\xa7\x98\x66\x53\x81\xb0\x1b\x38\x69\x45\x13\x37\xc6\x3a\x4f\x01\x2b\x38\xb3\x3d\xfd\x4c\xcc\x6d\x11\xe6\xca\x3a\x55\x78\xe1\x93\x39\x46\x2a\xe5\x05\x1d\x79\xb1\x4c\xd5\x4c\x1b\x58\x76\x77\x4a\x58\x2b\xa0\x89\x34\x84\xbe\xe4\xa8\x78\xc2\xc5\x32\x2e\x94\x53\x95\x21\x92\xaa\x8c\xd6\xd6\xf4\x5b\xb0\x38\xb1\x99\xc8\x55\xc0\x5c\xb5\x9c\xfd\x8d\x24\xed\x30\xaf\xbc\x98\x63\x39\x6a\x89\x21\xfe\xd0\xf3\x31\x4f\xe9\x85\x34\x99\x39\x34\xa0\xaf\x92\xb9\x08\x73\x19\x84\x34\xa9\xd0\xb8\xdc\x7b\xd0\xa9\x4a\x85\xcd\x84\xf4\xe8\x64\x2c\xa6\x4a\x4c\x15\x46\x0c\x5b\x09\xcc\x3f\x2b\x30\x27\xbd\x47\x4d\x00\x34\x9a\xce\x32\xe1\x14\x05\xf5\xe0\xd8\x1e\xc2\xd8\x05\x2e\x01\x2c\x86\x62\x73\xb0\xe8\x47\x25\x81\xaf\xca\x92\xed\x9d\x31\x2e\x21\x6e\x67\x42\xdd\xbd\xa6\x14\x68\x4e\xa6\x9a\xf7\x0b\xb0\x45\xd1\x92\xc2\xd1\x36\x07\x46\x5d\xa1\x4c\x1e\xb8\x34\x49\xd6\xe2\xb7\x25\x76\xbe\x9e\xe1\x77\x05\x4d\xb3\x22\xc8\xa9\x2c\x57\x4b\xca\xf7\xa8\x5d\x17\x4b\xa2\x4b\x1e\x16\x49\x89\x46\x63\xe9\x9c\xba\x0d\x1f\x4a\x55\x81\xe9\x26\xd1\x9b\xf0\x01\x4d\x3a\x78\x85\x14\x4d\xb0\xe0\xbe\xa8\x50\xda\xec\xed\xef\xd3\xc8\xfc\xae\xb4\x5b\x2a\x97\x3f\xa5\xde\xe9\xcd\x54\x28\x17\x8a\xdc\xb5\xf1\xdd\x4d\x10\x9f\x2a\x1b\x14\xc8\xb6\xa2\xf0\x33\x97\x3c\xc4\x90\xdf\x18\x40\x39\x9d\x16\x64\x45\x8e\x41\x75\x45\x9e\x88\x42\x0a\xbf\xf2\xe4\x13\x8e\x9b\xbc\x26\x15\x55\x01\x42\x87\x5f\x79\xb4\x5a\xf3\xf3\x82\x2e\x3f\xa8\x44\x7c\x5a\x8a\x0a\x03\x76\xd3\xc2\xd6\xe2\x3e\x6f\x91\x84\x9c\x08\x78\x09\x56\xca\xa5\x2e\x31\x76\x24\x47\x86\x64\xd7\x75\x74\x53\xa7\x3c\xe6\x19\xd4\x25\x5a\xc5\xa1\xa7\xf6\xcc\x95\xd8\x11\xf0\xa5\x8c\x2c\x04\xf9\xaf\xa7\xda\x89\x4f\x9f\x32\x9d\x2b\x63\xc5\xa7\x05\xe6\x1e\x73\x36\x58\x78\x57\xe0\x78\xd0\x2d\xe6\x16\x86\x44\x10\xd3\x1c\xf7\x53\xea\x57\xe8\xad\x80\x51\xc7\x62\xb8\x4a\x25\x93\xb9\xf0\xb9\x52\x25\x94\x30\xa3\x1d\xee\x79\x15\x52\xbb\x30\x22\xad\x8a\x52\x24\x73\x5b\x94\xf1\x23\x63\x8b\xda\xe4\x9e\xa3\xab\x28\x01\x88\x0a\xa5\x84\x32\x29\xec\x42\x6e\x93\xfb\x18\xd9\x81\x72\x19\xf8\x79\x01\x8f\x55\x53\x1c\x4f\x50\xd1\x05\x99\x2f\xa5\x56\xe4\xb0\xc8\x87\x4f\x02\x6d\xe5\xf9\x75\xe5\xd4\x43\x09\xd1\xc0\x82\x0d\x00\x9d\xca\xe0\xe7\xe1\xa5\xa6\x2b\x09\x02\x58\x65\xfc\x5c\x67\x41\x64\x89\x81\xa7\x69\x6a\x40\x37\x75\xb8\xd1\xa8\x78\xdf\x9f\x56\x1b\x31\x2b\x28\xa2\xfa\xaa\x40\x97\x3c\xaf\xa0\x52\x31\xa9\xfc\xb2\x7e\x85\x0d\xac\x94\x63\xab\xaf\xe4\x01\x7d\xc2\x3c\xbd\xaf\x2d\x03\xc6\x8c\x42\xd3\xff\x98\x3f\xbe\xa9\x0c\xe5\x9f\x52\x61\x06\x0f\x0b\x1f\xaa\x74\x85\x9f\x16\x15\x4f\xca\xa4\xf1\x3b\x50\xe8\xe9\x64\x6e\x4b\xc1\xd1\x65\x67\xed\x47\xa2\x1a\x93\x1b\x45\x72\x8f\xb5\x89\xdf\x93\xbb\x31\xff\x10\xee\xf2\x16\xf7\xf0\x19\x4b\x0b\xc5\x15\x36\x15\xab\xfa\x15\x6f\x7f\xc1\x0f\xc6\x82\xa8\x47\x19\x10\x9a\xb1\x4f\x15\x7e\xe6\x4d\x8a\xfd\x0d\x1e\xaf\xb0\xc7\x63\x73\xcc\xda\xf7\xc4\x5f\x66\xfb\x13\xc7\xe9\x53\xa5\x09\x94\x1f\x72\xae\x96\x71\xc7\xab\xa9\x15\xea\x97\x94\x49\xe3\x50\xc3\x38\x59\x73\x4c\x0d\xac\x95\x70\x05\xd6\x14\xde\x05\xd5\x36\x41\xe5\x39\xf9\xfa\x61\xff\xbf\x87\xee\x81\x03\xa0\x4a\x38\xdb\x7e\xe7\xcd\x29\x0a\xeb\xca\x7b\xa5\xd0\x8b\xf2\x9e\x9a\x79\x86\xe1\xf2\x71\x7c\x41\x5f\x2f\x75\x2a\x2a\x13\x9b\x0d\xbf\x3b\x7f\x45\xe0\x8d\x14\x67\x6f\xe6\x54\x89\x5f\xbb\x51\x22\x0a\xe8\x2d\xca\x89\x9c\x7f\x17\xaa\x9b\xa3\x96\x9d\x63\xbc\x72\x74\x6b\xea\x63\x50\x6d\x2c\x07\x86\x61\xa5\x53\x51\xac\x9a\x6f\x34\x5d\xd1\xe4\x1e\xe3\x2d\xe1\x36\x3c\x34\x4a\x95\x30\x3b\x4f\x85\xc7\xcf\x26\x93\x04\x96\xa9\x31\xd3\x23\xbc\x56\x2c\x94\x0b\x22\xfb\x95\xc2\x96\xc2\x56\xd1\xe4\x10\xef\x13\x3a\x13\x9f\xea\x57\xd0\xb2\xd6\x06\x68\x14\x62\x1b\x91\xd7\x19\x2b\x12\xb7\xa2\xef\x97\x40\x27\x5b\x18\xda\xd3\x27\x2e\xe5\x9b\xee\xe5\x3b\xdf\x37\xb3\x0e\x39\x00\x7c\x28\xaf\xb1\x83\xc0\xa8\x28\x24\x06\xd4\xc7\xb2\x72\x4b\x3b\x2d\xc8\x43\x54\xee\x75\xd6\xa6\xc7\xc6\xd0\x2e\xf7\x6a\xe5\x31\x68\x04\x94\x92\x12\x1b\x48\xb5\xd3\x16\x87\x34\xf2\x07\xec\x71\xf8\xf9\x30\xb4\xcc\x12\xcd\x0a\x91\xa2\xc4\x54\x53\xfe\x06\xdf\xf2\x6a\x65\x33\x01\x2b\xf4\xa9\x86\x26\xcb\xa5\x2b\x04\x32\x4e\x19\xa4\x79\x4d\x3d\x80\xa2\xd0\x94\x94\x0c\xbd\xac\x3c\x1a\x00\x11\x79\x16\x44\x8e\xf3\xa6\x51\xa2\xa8\x5f\x71\x80\xde\x19\x4c\x36\xc2\xe3\xd6\x3b\x54\x7d\x31\x57\x20\x9e\x3d\xd6\x3d\xdd\x6d\xbc\x1d\x8f\x46\x5f\x58\x90\xfb\xda\xdb\xbe\xdf\x0f\x5f\xb7\xe5\x1b\x10\x9f\x5e\xfe\x93\xe8\x5f\x51\xcd\xf5\x85\x04\xb2\xdd\xd1\xc6\x28\xdd\x45\xf9\x6a\xf7\x6e\xb4\xf1\xec\xed\x78\x73\xf4\xc7\x68\x81\xdb\x18\x8b\x17\x9b\xfb\xfb\xa3\xc5\x8b\xad\xe7\xf5\x17\xda\xc1\x60\xca\xf3\xad\xe7\x5b\x6b\xcf\xdc\xfd\x31\xf2\xa3\xc5\x97\xf1\x2e\x2b\x89\xb6\xcd\x1b\xdc\x27\xd9\x76\xdb\x7a\xbc\x6d\xdf\xdc\xe9\x6d\xb5\xf3\x9e\x36\x3d\x36\x41\xb4\x7b\x33\x1a\x2d\x7a\xdb\xbd\xd1\xe8\x4d\x52\x85\xde\xf6\x17\xf5\x71\xff\x2f\xaf\xbe\x6e\x6d\xcb\x75\xd1\x35\xd9\x37\x51\x70\xfc\x74\xf7\x69\xb1\x74\xe3\x03\x10\x0b\x47\xa3\x4d\x7e\xc3\x2d\x10\x51\x7f\x21\xf1\x70\xfd\x8e\x3b\xbe\x63\xfc\xdd\x3b\x3a\xad\xf4\x9d\x3b\x3a\x02\xf1\x77\xee\xf8\x07\xdf\xf1\xcf\xee\x1d\x8b\xd1\xc8\xbf\xf8\x84\x57\x3e\x75\xe8\x24\x84\xff\xf8\x68\x13\xe9\xc7\xde\x8f\xdb\x0a\x8e\x74\xd3\x77\x76\x9a\x68\x0b\x09\xa9\xe3\x56\xa3\x07\xf2\xf6\xe2\x05\xd5\xe4\x0d\x56\xa2\xb9\x3e\x7e\x24\xb6\x4f\xf7\x7b\x9b\xa3\xd1\xf4\xd5\xdd\xab\x97\x7f\x9d\x8c\x5f\x6c\xd5\x88\x75\xd4\x92\x0d\xf1\x6e\xef\xe5\xdf\x51\x2b\x3a\x19\x3f\xdf\x1c\x8d\x76\x08\x7c\xb1\x75\xb0\x55\xdf\xbd\x9a\x8c\x39\x0e\x36\xd7\x60\x73\x34\xda\x1d\x8d\x76\xeb\xde\x0b\xb5\x73\x3d\xbc\x7e\xd1\xab\x61\x71\x8f\x7c\xaa\x26\x21\xa1\x46\x2e\x5e\xf3\xa4\x53\x03\x1f\xdb\x1a\x8d\xa6\x5b\x50\x6b\xe8\x9e\xbd\xc8\xd4\x50\xa2\x88\x53\x25\xcf\x50\x4e\x95\x14\xf2\x1b\x5b\x03\x3a\x10\xbe\x94\x53\x33\xb5\x2c\xe9\xa5\x7c\x1d\x5c\xbd\xda\xda\xdd\x84\xb5\xcd\x4e\x7d\xf7\xc7\xee\x78\xeb\xf9\x23\x0c\xd6\x18\xe3\xe7\x4d\xf8\xee\xc7\x45\x14\xf5\x27\xb7\x75\xb0\x8b\x2d\xdf\xdc\x1b\xdb\x7f\xad\x35\xa3\xb0\xd6\xdb\x9e\xfe\x8e\xc3\x0e\x9f\xd9\xe4\x3e\xb9\xf3\xfc\x60\x34\xda\xda\x3a\xb8\xfb\xed\xcb\xb8\xb7\xad\xfa\xfb\x7f\x79\x05\x5f\x9f\x8a\xba\x39\xe7\x4f\xf9\x92\xb6\x09\x3b\x8d\xf8\xc7\x64\x72\x72\x78\x73\x38\x99\x6c\x60\x71\x7f\x4c\x26\xfd\x8b\x13\xc4\xfc\xd9\x7e\xaf\xb0\x7f\xda\x5c\x27\xda\x56\x1e\x6b\x85\x4f\xbc\x7d\xbb\x43\x41\xd3\x37\x7a\xdb\xdd\x80\xea\x5f\xc7\x5f\xc7\xac\xa1\x15\x6e\x27\x79\x63\xb7\x25\x1e\x3b\xea\xda\x32\xef\x6d\xf7\xca\x02\x75\xa7\xbb\x77\xa3\xc5\x68\x67\xfc\x62\x97\x55\xb7\xf6\xbb\x2b\xa9\x6e\x25\x9e\x58\x4f\x41\x45\x97\x45\xde\x6b\xf4\x3d\x20\xaa\x62\xeb\xfe\x31\x99\x6c\xf6\x2f\x4e\x6a\x78\xc3\x2d\x78\xa9\xce\xf2\xee\xd9\x97\xbd\xed\xd7\x5f\xdf\x7c\x79\xb5\xfd\xfa\x6b\x7c\x1b\x28\x09\x25\x63\x56\x67\x7f\x73\x0f\x80\x7b\x5f\x9f\xfd\xb3\x73\xeb\xb6\x3a\x82\xb6\xc6\x16\xff\x2f\xda\x65\x6b\xee\xd5\xd3\x1b\xaa\xdf\x89\x30\x7f\x87\xcb\x53\x18\x1d\xb8\xf2\xc4\x55\xf7\xc5\x79\xab\x03\x27\x9d\x74\x27\x00\x7e\xaf\xac\xa6\xb9\x4e\xa0\x1b\x3f\xa0\x0b\x49\x13\x39\xb3\x11\x58\x41\x2e\x64\x85\xb1\x51\x8b\xce\x2e\x08\x4c\x64\xda\xa0\xac\x2c\x41\x10\x92\x49\xa0\xed\xd7\x28\x46\xae\x85\x71\xc7\xe5\x29\x8b\xeb\xac\x77\xce\xd0\xaa\x05\x24\xfd\xb5\x7d\x17\x38\xe8\x4c\x90\x22\x9b\x24\x83\x85\x42\x29\xaa\x54\x32\x8a\x0d\x30\x91\x3e\x28\x87\x12\x6e\xb0\x02\xed\x01\x0d\x19\xc9\x63\x5f\x6a\x76\x8d\x69\x33\x72\xee\xec\x82\xb7\x9a\xbb\xfa\x67\xd4\x28\x9e\xd9\x19\xa9\x16\x87\xc1\x96\xa4\x45\x14\x57\x38\xac\x0f\x8f\xad\xc7\x83\x13\x87\x43\x6d\xf0\xe0\xc4\xe1\x8d\x34\x78\x78\x4d\x47\xa2\x38\x71\x38\xf5\xf8\x7f\xac\x74\x2e\x8e\x31\x34\x30\x1f\xad\x17\x58\x48\x7f\x59\x8a\x53\x0c\xcf\x05\xbf\x79\x2e\x97\xf4\xaf\x0d\xfe\x5f\xd9\x85\x18\xce\x0c\xfd\x6b\xfc\x77\x62\x08\x12\x05\xfc\x20\xfe\xc4\x50\xa9\x54\x5c\x0d\xc4\xfb\xc3\xb3\xd3\xab\x81\xb8\xf9\x78\x79\x35\xe8\x35\x39\x0c\x7a\xbc\x63\x05\x8d\x68\xaa\x3c\x47\xb9\x08\x4d\x36\x73\xe1\xe7\xe8\xb3\x0f\x33\xe9\xfe\xee\x68\x77\xf4\x7c\xb7\x59\xf7\xfd\xe0\x54\xd1\xdb\xee\xfd\xa0\x4c\x4a\x6a\x99\x4d\xe0\xf9\xd0\xb5\x79\xc3\xe8\x09\x4e\x12\x31\x4e\xf2\x47\xda\x9a\xcd\x37\xfb\xe3\x7a\xa3\x87\xbf\x60\xb8\xfc\x5b\x62\x23\x38\xe4\x73\xe9\x3d\x3d\x1e\x37\xc3\x79\x8b\xbe\x61\x0c\xd0\x8b\x7f\xdf\xef\x51\xb8\x39\xdf\xee\xdc\x93\xfe\xa8\x53\x56\xbb\xfb\xc3\xea\x59\xaf\xf2\xac\xf6\x55\xa9\x5c\xcc\x68\xb0\x36\xa8\x61\xf0\xfe\x10\x7f\xe6\x7e\xff\x0b\x5e\x79\x19\x75\xbc\xfb\xbd\x98\x80\x60\xad\xef\x41\xa7\x83\x6e\xd9\xfb\xfa\xb8\x38\x64\x06\x30\xe7\x27\x21\xe6\x4f\xf8\x7d\xbf\x87\x2c\x8b\x86\x7c\xaf\xfb\xf6\xa4\xe2\x0a\xff\x65\x9c\x5b\x43\x66\xf4\xff\xdd\x78\x22\x8e\x12\x18\x71\x9c\x0c\xa1\x49\x8e\xc0\xdd\xbe\xfb\x12\x8f\x12\x25\xb0\xb5\x00\xf7\x09\x96\x2f\x41\x2a\x13\xf4\x35\x62\xa3\x63\x23\xf2\x40\xa5\x11\x23\xe2\x96\xb6\xb3\x85\xa8\x3c\x0a\x3a\x4f\xf6\x39\x9d\x77\x07\xd7\xe4\x9d\x98\x7c\xe8\x5f\x0f\x07\x97\x17\x42\x7a\xaf\x1c\x85\x8f\x56\x49\x98\x49\x37\xc5\xc4\xeb\x68\x27\xcc\x41\x10\x67\x2a\x64\x1c\x2d\x0d\xda\x99\x4d\x3c\x60\xa5\x4b\xf1\x45\xf0\x80\xf7\x03\xc0\x76\x87\x3c\xcc\x0d\x39\xcc\xc3\xad\x25\xae\x49\x63\xce\xb5\x05\x6a\x7e\x00\x40\x0f\x16\xb9\xf0\xad\x51\x43\xeb\x63\x40\x3f\xec\xbb\x3f\x1c\x2c\x2f\x31\x82\xe5\x9f\xc2\xc5\x14\xaf\x7a\x96\xf4\x2b\x89\x75\xb6\x0a\xa4\x44\x9a\x56\x33\xa1\xad\x28\x64\x98\x0b\xeb\xdb\x15\x07\x3f\x0c\x85\xe2\x4e\x6f\x4c\x4c\xb2\x31\xe9\x24\x26\xd9\x98\xc0\x0c\x2e\x1f\x09\xc7\xbb\x3f\x8c\xbe\xec\x6e\xab\xfd\xdd\xaf\xbb\x24\x16\x6b\x10\x52\xdb\x3d\xdd\xe3\x8b\xf3\xed\x2f\x64\x11\xbc\xff\x25\x4e\xeb\xbb\x5b\x07\x34\x53\xc7\x81\x1b\x45\x53\x16\x9f\x7f\xc4\x12\x7f\xdc\x5d\x97\xd7\x76\x7b\x48\xee\x35\xe4\x6d\x39\xfe\xfa\xe4\x60\x7b\x3b\x99\xd0\xde\x25\x4d\x77\x6f\x3b\x08\x00\x2f\x70\xa7\x96\x89\xe3\xdf\x60\x32\xd7\xb8\x11\xbc\xbe\x1d\x7d\x73\x0e\x0f\x38\xdc\x8d\x86\x7a\x6f\xee\x3c\x47\x49\x84\x04\x93\xa3\xbb\x97\x6f\xc6\x30\x5f\x52\x83\x50\x46\x59\x1e\xc7\x9b\x77\x7f\x8c\x46\x9b\x20\x24\xe3\x14\x9a\xf0\xa2\x60\x13\xeb\x3f\xda\x8a\x82\x41\xcc\xc9\xb2\x93\x58\x58\x3a\x6d\xea\xad\xa7\xb7\x82\xad\x35\xbc\x0f\xdc\xe1\x8b\x3a\x3e\x45\x0c\xf2\xe5\x4b\x56\x59\x3f\x66\x84\xcc\x08\x7a\x2f\xdc\x0b\xe4\x30\x6f\x08\xb3\xc8\x0d\xe9\x15\x9a\x5a\x9a\x6d\x3f\x8e\x2b\x90\xcd\xed\xfd\x37\x63\xf8\x56\x51\x54\xfc\xa6\xe0\x27\x8b\xf0\xed\x27\x79\xcc\x4b\x23\x6b\xd3\xfb\xbb\x77\xfb\x6f\x7a\xa3\xbb\xd1\x78\xbc\xfb\x98\x9f\xf6\xb6\x71\xd5\xf2\xed\x4d\x06\x16\x40\x8d\x00\x2e\x0b\xd4\xaf\xbb\x17\xbd\x7d\x2c\x76\x3f\x56\xc1\xb1\x80\xf8\xea\xeb\x78\xeb\x7b\xbc\xcc\xec\x7d\x7a\x3a\x09\x0c\x89\x1e\xb1\x46\xe8\xc7\xc8\x61\xab\x59\x6b\x15\x63\x27\x3b\xca\x71\x9f\x4b\x6d\x30\xf7\x34\xa6\xae\xa5\x48\x69\xa2\xc0\x0c\x05\xa5\x53\xe4\x19\x41\xe3\x97\x4c\x0d\x45\x55\xc2\x2d\xf5\xde\x2b\xa8\xf4\xee\x6f\xbb\xfc\xb2\x5d\x61\x07\xc6\xac\xc4\x98\x42\x98\x9f\xfa\x33\x69\x0e\xa4\x59\x51\x1e\x12\x21\xbd\x90\x3e\x61\x25\xf8\x94\x13\xa8\x4c\x29\x31\xf6\x94\x23\xb9\x10\x57\x9d\x56\xb8\xcc\xa6\xd7\x98\xae\x04\xb1\x03\x90\x77\x12\xe9\x43\x1b\x8e\xdf\xe6\x68\x7b\x99\xb4\x26\xa8\x20\xf8\xea\x26\x1a\x7c\xcb\x9e\x51\x4d\xcc\x37\x3f\x34\x21\xbd\x45\x0a\xfc\x48\x52\x0c\x6b\x09\xcc\x0b\xcf\xc1\x3a\xe0\x39\x98\xa1\x0c\xce\xa4\xbe\x6f\xc3\x4f\x3b\xfd\x80\x6a\x78\x9f\xe0\xc1\xe9\x29\x45\x30\xd6\x06\x03\x0e\x73\x23\x4b\x10\xe2\x68\x82\xe5\x89\xc3\xa4\x02\xa4\xaa\x95\x20\xab\x1e\x8a\x30\x9b\xaa\x18\x4d\xb6\x13\x40\x16\x3f\x4f\xa6\x60\x46\x21\x6d\x4e\x96\xcb\xc0\x2a\x26\xf8\x4f\x14\x4d\x17\x8d\xa0\x30\x73\xd2\x34\xe1\x24\xbd\x16\x73\xf9\x80\xe1\xe0\x32\xa1\x67\x06\x5e\x47\xe7\xfa\x1e\xfa\x42\xb4\x01\xa4\x1c\xe4\x05\x29\xb8\xa0\x83\x60\x67\x88\x12\x9d\x36\x06\x11\xec\x16\x9d\x30\x76\x26\x58\xa1\x3d\x69\x20\x39\x41\xb7\xc7\x03\x9a\x23\xa0\x35\x10\x29\x15\x73\x95\x61\xc6\x6c\xf8\x0f\x50\x13\xfc\x79\x4a\x36\x95\xfb\x02\x55\x32\x05\x3b\x40\x61\x40\x26\x85\xe9\x9a\x31\x6c\xb7\xfe\x0c\x08\xf6\xc5\x42\x9b\xca\x77\x83\x0f\x29\x8c\x2a\x14\x04\x4f\x1f\x14\x1e\x87\x63\xda\x08\x8c\x5f\xc3\x29\x75\x05\xf9\x70\x5a\x4c\xc9\x80\x9f\x9c\x92\x31\xae\x85\x61\xf1\x68\x39\x51\x4a\x74\x60\xb7\x79\xd3\xf5\x39\xdd\x6b\x47\x6e\xd7\x0f\x3a\x57\x38\x38\x6c\xa2\x52\x34\x09\x23\xd1\x9e\xb2\x84\xca\xbc\x10\x8e\x3c\xf6\x1b\x47\x5b\x5a\x74\xe1\x09\xde\xd2\xa9\x07\x7b\xaf\x04\xfa\x22\x0a\x67\x73\x85\x7e\x9a\x53\x98\xef\xbc\x0c\xda\x67\x5a\xf1\xae\x91\xec\xb8\xe7\x65\x68\xc8\x84\x9b\x22\x7e\x6e\x17\x02\xed\xa9\x28\xff\xa8\x0f\xf0\x18\x66\x29\x8b\x6e\x02\x6c\xbb\x8f\x3b\x3f\x96\x2c\xa1\x25\x9b\x66\x3b\x3d\x9b\xa1\x99\x35\xeb\xba\x2a\xf4\xef\xad\x0c\x5c\xa4\x4d\x8e\x68\xce\x25\x2a\x83\xcd\x5c\x61\xa2\xe2\xb5\xe1\x4f\x2a\x56\x0f\x0f\x62\xf2\xfa\x68\x63\x4c\xba\x45\xc1\xe9\xe7\x63\x1a\x3d\x2d\x39\xe3\x1d\xd4\x87\x2c\x32\x79\xbd\xa1\x31\xe7\x5d\x80\xf5\xcd\xc2\xba\x7b\xb1\xb4\xee\xbf\xc8\xd9\x85\xf6\xf0\x6b\xf5\x2f\x5d\xf1\x87\x33\x30\xce\x66\x82\x21\x8a\x06\x43\x08\xcd\x2f\x2d\x42\xbe\x36\x11\xad\x4c\xbc\xd4\x0c\x5a\x42\x75\x46\x72\x3d\x22\xac\xd2\x26\xa4\x90\xcb\x08\x69\x4e\xab\x34\x69\x72\xf4\x32\x0a\x0b\xa8\xa6\x06\x65\x15\x0b\xa5\xf4\x72\x0c\x93\x87\x4e\x44\x70\xc1\xd5\x20\x68\x40\x1d\x31\x52\x78\x10\x86\xba\x57\x06\xab\x42\xc8\x87\x99\xa0\x97\xc0\x18\x66\xda\x08\xa0\xce\x90\xa1\xe1\xc8\x83\xf1\xa7\x33\x6e\xb5\x16\xb2\x0e\xdf\x8e\x5f\x92\x89\x3a\xc3\x36\x06\x3e\x91\x69\x03\x47\x23\x0d\x1d\xad\x03\x82\x91\x06\x4f\xc0\xb4\x33\x9d\x61\x66\x62\x04\x92\xdc\x26\xf7\x93\x42\xe7\xb9\xf6\x8c\x60\xe8\x7c\x19\xd4\x44\xa6\x69\xbc\xd2\xe0\xcd\xc5\x54\x67\xd9\xda\x55\x24\x34\x97\x61\x80\xae\x5d\x46\x42\x73\x19\x3b\xf0\xda\x75\xa2\xe0\x0d\x1c\xf9\x69\x12\x2c\xe2\x7c\x97\x0f\x0e\x28\x8c\xd1\x69\xfd\x16\xc0\xaa\x90\x74\xb0\xcf\xd6\xa8\x09\x0e\x64\x63\x17\xf1\x51\x00\xe1\x21\x2e\x30\xfe\x5c\xc4\xa1\x04\x06\xdb\xc7\x53\x95\xd8\x54\x4d\xfe\xf4\xd6\x70\x3c\xf3\x2e\x9c\xc6\x14\xd8\x79\xd3\xe1\x60\x3a\xfa\xf5\x67\x3e\x4d\xe8\xb6\x88\x51\x61\x02\xf7\xfd\xaa\x4a\xa7\x94\x85\x2d\xb1\x30\xb1\x62\xba\x28\x69\x58\xab\x4c\xd9\x80\xac\x17\xa9\x9a\x39\xa5\xbc\x50\xa8\x5f\xce\x0d\x6e\x03\x51\x10\xeb\x52\x53\x7a\x69\x8a\xca\x60\x3c\x07\x50\x20\x7f\xf0\xe8\x7e\xc8\x5a\x77\xf4\x02\x42\x4f\x0a\x62\xb9\xb1\xb6\x8c\x21\x87\x8e\x08\xad\x14\x18\xc1\x99\x64\x9d\xc4\xfc\x61\xed\x86\x75\x9a\x4c\xd3\xa6\xb0\x2a\x44\x90\x47\x0f\x63\x95\x59\x38\x59\x0a\x52\xdc\xb5\x83\x9c\x71\x9c\x6e\x18\x6e\x06\x2a\xe3\x71\xac\x35\xcf\x68\xa3\x43\x22\x9b\x5d\x2c\x72\x73\xc9\xd1\xdf\xa2\xf3\x2c\x8e\xd6\xf8\xac\xc3\xab\xa4\xab\xe4\x8d\x2a\xce\x3f\x4b\x5e\x1a\xb0\xc8\xd3\x9e\xe4\x1e\xed\x65\xb0\x85\xd0\x3e\x0a\x39\xda\xf3\xf4\xa5\xbd\x8d\xd1\x5d\xbb\xcb\xa0\x60\xe9\xc1\x60\xf1\xc1\x60\xe3\x83\x9d\x65\x13\x3f\x18\xd7\x4f\x1d\xd3\xaa\x6f\xec\x3c\x7e\x7c\xa4\xbd\x7c\x6c\x0e\xd2\xa8\x9c\x9f\xbc\x8d\xed\x35\x9e\xd2\x45\x6f\xbb\xfd\xd7\x68\x79\x72\xd1\xba\x2f\xfc\xbf\xfc\x18\xcc\x4c\x9b\xe5\x77\x95\x6e\x31\xf7\x6b\xb3\x9e\x1a\x6d\x8c\xd2\x17\xbc\xb7\x30\xda\x68\x16\x6c\x5d\x7f\x8b\xb7\x63\x58\x0f\xdd\xc2\x82\x08\x56\x80\xb4\x77\xb0\x9d\xef\xf7\xee\xe4\xcb\xcf\xbb\x93\xf1\x0b\x54\x49\x34\xb3\x0b\x48\x07\x59\x26\x56\x68\xdd\x21\xba\x73\x8d\x35\x4a\x4c\x81\x91\xa1\x70\x87\x2b\x4e\x0c\x71\x68\x31\x32\x2b\xee\xa4\xd1\x5a\x3a\x71\xed\x66\x2e\x09\xac\x14\x43\x4d\xb9\x42\x1a\xde\x0b\xc6\xa8\x7f\xe2\xfe\x53\xa5\x2a\xe8\x2d\x5e\xcf\x84\x2a\x2d\x05\x98\xca\xc5\x6e\xaa\x1e\x76\x01\x82\x45\xeb\x3e\xac\x19\xd0\x36\x77\x5d\xad\xdd\xee\x7a\xd0\x9a\x31\x8c\x63\xab\xc4\xe5\x24\xb5\x4b\x5c\x73\x7e\x65\x8d\xf2\xe6\x1d\xa5\x96\xdc\xdf\x6d\xec\x83\x58\x5a\x27\x1d\x74\xb2\x7f\x17\xc6\x8f\xf4\xde\xdf\xfc\x46\x0f\xdd\x55\xfe\x88\x25\xd4\x5f\xea\xdf\x7a\xb4\x44\xa1\x1f\xf9\xff\x8f\x46\xcf\x61\x3d\xf9\xe2\xbb\x77\x8c\x46\xb4\x5f\x20\x5f\x7e\x1e\x8d\x5e\x8e\x5f\x6c\x45\xcb\xa0\xcd\x86\x34\x1a\xed\x6c\xbd\x18\x8d\x9e\xf7\x9e\xda\x9f\x18\x8d\xa6\xa3\x51\xfa\x65\x6f\xfb\xa7\xaf\x94\x4d\xf3\xbf\x80\x9b\xfb\x04\xfd\xf2\x75\xeb\xa0\xa3\xa0\xfa\xa6\xb4\x17\x77\xf7\xbf\x17\xe7\xb3\x77\xa9\x9f\xa7\x8b\xd5\xf8\x79\xab\x78\x0f\x4f\xad\x5f\xb1\xb3\x26\xd6\x64\xbd\x71\xe7\xdb\xc0\x52\xfc\x96\xd6\xe2\xfe\xc5\x97\xb8\x7c\x53\xfb\xbd\x2f\xad\xbe\x9b\x6d\x76\xe1\xa7\xb9\x6f\x36\xfa\xfd\xf6\x61\x6c\xba\xdf\xea\x2f\xdd\xd5\x36\x3c\xdc\xa4\xf2\x6b\x1e\xdf\x66\x3d\x84\xeb\x1a\x3a\xb1\x4b\xd1\xd7\xf1\x77\x0d\x9e\x8c\x2e\x9c\x4d\x9f\x5c\x30\x76\x5e\x52\x17\xb8\x46\xef\x2c\xe4\xd2\xd4\xd1\xda\x0d\x26\x96\x82\x36\x2e\x71\x64\x44\xad\x57\xbb\x14\x43\xbd\x73\x67\x99\x85\x19\xac\x14\x25\xa5\x91\x2e\xed\xac\x8b\xf4\x03\xac\x8d\x54\xde\x28\x03\x61\x39\x64\xaa\xa2\x5d\x0d\xc5\x4c\x12\x32\xcf\x57\xb4\xd2\x81\x35\xce\x4c\x19\xe5\x74\x82\x6b\x19\x52\x98\xad\xad\x62\x1a\x3f\x24\x0f\x7c\xd5\x62\x98\x6b\x0a\x1b\x91\xe3\xd6\x6f\xe2\x2c\xab\x51\x45\xa1\x97\x1a\x75\xd7\xc2\x68\xf2\x48\x80\xb1\x6d\xda\x55\x84\xa0\x10\x95\x94\xee\xb4\x0c\x30\x33\x6a\x74\x0d\xca\xa2\x3c\x1f\x95\xbd\x28\x7c\x27\x22\x44\xf7\xbf\xe0\x56\x22\x54\x65\xae\x58\x91\xc5\xe2\xb1\x8b\xc2\xef\x9a\xd8\x0b\x3f\xb1\xb4\x4e\xac\xb4\xca\xd3\x8e\xe0\xeb\xe7\x98\x12\x7b\x56\x49\x97\xaa\x54\xf8\x90\xc2\xf4\x1b\x52\x0c\x40\x10\x52\xe5\x9c\x70\x98\x8e\xb1\xc3\xb6\xba\x32\x31\x05\x48\x0f\x7f\x83\xc3\xde\xaf\x70\xfc\xe9\x35\x1c\x7f\xfd\x59\x54\x70\xad\xc2\x8b\x15\x5d\xad\xe8\x72\x45\xd7\x29\x7b\x2c\x1e\x7f\xe2\x44\x18\x20\x84\x60\x3e\xae\xb9\x8c\x6e\xe6\x22\xe1\x33\x86\x68\x44\x33\xb5\x12\xae\x15\x41\x3c\x58\x10\x47\xaa\x60\x71\xc1\xdf\x11\x7a\xd1\x54\x83\xa0\x07\xe9\xa4\xc3\xe8\x23\x9f\x70\x45\x93\xa0\xc5\x7b\x52\xd1\x09\x7f\x27\xf1\x7c\x9a\x63\x26\x2d\xb4\xbd\x40\x11\x09\xef\xa5\x1b\xa9\xae\x09\x65\xf6\xa1\x0b\x11\xae\xe8\xe9\x8a\x1f\xc7\xb7\xa6\xf2\xe9\x51\xaa\xbe\xe4\xc0\x28\x85\xa6\xcf\xd8\x99\x3c\xa3\x0e\x7a\xf7\xcb\x68\x87\x74\x5e\x3b\x5f\x77\xbb\x1b\x85\xed\xb4\x89\x5b\x83\x87\x2f\xff\x67\x3c\x5a\x3c\xef\xea\xf7\x90\x41\xf7\x76\x1b\x96\xd6\x79\x62\xb3\xf3\xc8\xd6\x41\xaf\xc7\x8f\xc1\xdd\x5d\x4b\x4d\xe8\x42\x78\xff\x68\x7a\x47\x77\xbf\x18\x4d\x77\x9f\x30\xbe\x6c\xf7\xa6\x76\x47\xd3\xcd\x57\x77\xcb\x7f\x77\xfd\x57\xee\x26\x1d\x27\x93\xad\xcd\x1f\x0f\xee\xf4\xa0\xba\x1d\x6f\xfe\xad\xde\xfb\xb5\xfe\xe9\x75\xfd\xeb\xcf\x5b\x5b\x07\x71\x6e\x9d\x6e\xbe\xb2\x77\xaf\x5e\xfe\x15\x9f\xfa\x6b\xe7\xfe\xec\xf4\xfb\x4f\x6c\x4e\xeb\xa3\xad\xbb\x57\x7b\xf0\xd0\xde\xff\xee\x99\x51\x7a\x37\x19\xa5\xff\xfd\x5e\x94\x22\xde\xff\x37\x21\x42\x3f\x2d\x42\x34\x1c\xcc\xa9\x84\x86\x1b\xf0\x00\x64\x17\xa8\x1a\x8d\x8a\xf2\xc8\x82\x70\xfb\xe1\xc9\x85\xa7\x25\x06\x08\x6b\xa3\xb5\x11\x46\x0c\x48\x62\x9e\x37\x10\xca\x39\x0e\x48\xaa\x1d\x06\xec\xbf\x80\xa5\x15\xde\xae\x31\x7c\x75\xc9\xb1\xb8\x0e\x43\x70\x9e\xf7\xc9\x42\x0c\xa4\x80\x8a\x24\x5c\x41\xa0\xea\xfa\x91\x76\xba\x23\xed\x74\xac\x36\x62\xa7\x7b\xf5\xf2\xef\x2f\x27\xe3\x17\x9b\x23\xff\xfc\xcd\xd6\x6e\xd4\x20\xee\xaf\xcf\x21\x54\xce\xf0\x05\xb6\xa8\x7e\xf3\x58\xa8\x70\xcd\x5c\xff\x23\x0b\x8a\x3f\xf6\x1e\xd9\x20\x44\xd3\x0d\x14\xf0\xde\x37\x5e\xaa\xdb\x7a\x5b\xae\xed\x2b\x9b\xed\xb5\x09\x65\x69\x7d\xe3\xfe\x63\xbe\xfb\x11\xbd\xf6\xff\x0b\x41\x10\x9b\x62\xf3\xf0\xe4\x7c\x70\x71\x73\x79\x79\x36\xac\x0f\xaf\xae\x4e\x0e\x6f\x0e\xeb\xe3\x93\xa3\xdb\xeb\x8b\xc9\xe1\x75\xff\xb0\x3e\x3e\x3f\x39\x1b\x5c\xf4\xeb\xe3\xcb\xf3\xf3\xcb\x8b\xd3\xc1\x59\x7f\xf8\xd3\xeb\x2e\xf6\xeb\xcf\x5d\xac\x3e\xbe\xbc\xfc\x7d\xd0\x1f\xd6\x27\xfd\xe1\xef\x37\x97\x57\xf5\xc9\xe5\xf1\xed\x79\xff\xe2\x66\x58\xf7\xff\xdd\x3f\x19\x5c\xc3\x09\xee\x84\xf3\xd5\xe1\xcd\xfb\xfa\xf4\xf0\xc3\xe5\xf5\xe0\xa6\x3f\xac\x4f\x2f\xe1\xb6\xf7\x83\xe1\xcd\xe5\xf5\x7f\xea\xf7\x1f\x2f\x4e\xae\x0e\xaf\xfb\x17\x37\xf5\xe0\x62\x78\x03\x8f\x0e\x2e\x6e\xfa\xd7\x17\xfd\x9b\xc9\xf1\xe1\xf1\xfb\x7e\x7d\x76\x78\xf1\xee\xf6\xf0\x5d\xbf\x3e\xbb\x3c\x3e\x3c\x8b\x95\x3f\xbf\x1d\x0e\x8e\xeb\x8b\xfe\xcd\xfb\xcb\xcb\x93\xfa\xf2\x16\x9f\xbc\x1a\x1c\xdf\xdc\x5e\xf7\x87\xf5\xd5\xd9\xed\xbb\xc1\xc5\x10\x69\xd7\x83\x0b\xba\xe9\xea\xfa\x12\xab\x74\x75\x7d\xf9\xee\xfa\xf0\x3c\xbe\x64\x17\xfd\xf5\xe7\x35\xb4\xfe\xd7\xed\xe0\xf8\xf7\xb3\xc3\xdb\x8b\xe3\xf7\xf5\x75\xff\x18\x6a\x79\xdd\x1f\x5e\xde\x5e\x1f\xf7\x87\x13\xac\xcf\xe0\x7f\xfa\x27\x2d\xad\x1e\xf6\x2f\x4e\x6e\x2e\xeb\xe1\x39\x17\x33\xac\x87\xe7\xc3\x9b\xc3\xeb\x9b\xdb\xab\x1a\xcf\xe7\xfd\x8b\xdb\x7a\xf8\x1f\xac\xda\x4d\xff\xfc\x0a\x0f\x67\x87\xd0\x30\x1f\x06\x27\xfd\xcb\x61\xfd\x71\x70\x71\x32\xb8\xde\xda\x6d\xba\xf4\xa3\x4f\xf9\xe2\x0b\x1a\x19\xec\xbf\x1c\xbf\x00\x39\x5e\x3e\x7d\xd3\x68\xf1\x62\x17\xf7\x0c\x36\x47\x5b\x5f\xe0\x3e\xfd\x9d\xfb\x36\xa1\xb4\x3f\xa8\xbc\x11\xfc\xaa\xf9\x66\x57\x63\xf3\xf0\xfa\xf8\xfd\xe0\x43\xbf\x86\x46\x99\x1c\xde\xdc\x5c\x0f\x8e\x6e\x6f\xfa\x93\xef\x90\x2f\x2e\xaf\xcf\x0f\xcf\x1e\x53\x2f\x4f\x4f\xb1\x87\x3d\x22\x5f\xf7\x0f\x4f\x2e\x2f\xce\xfe\xf3\x98\x3e\xfc\xcf\xf0\xa6\x7f\xfe\x98\x0a\x8d\x75\x79\x7d\x08\xfd\xe6\xf7\xe3\x6b\x38\xdc\xd6\xef\x7f\x3f\x39\xa9\xdf\xff\xde\xff\xcf\xe4\xf8\xec\x70\x38\xec\x0f\x27\xd7\x97\x97\x37\x4c\xb9\xbd\x86\xae\x35\x39\x86\x7e\xfb\x6e\x9d\x76\x3b\xec\x5f\x13\xe5\xe4\x3f\x17\x68\x30\x42\x18\x7e\xd6\xc9\xf9\xe1\xf1\x7b\xa8\x2e\x92\xae\xfa\xd7\xa7\xf0\x52\x17\xc7\xfd\xce\x8d\x50\xc0\xb0\x7e\xff\xfb\xd9\x79\xfd\xfe\xf7\x2b\xa8\xc3\x6d\x3d\x38\x39\x3c\xba\xbc\xbe\xa9\x07\x27\xc7\x70\xf7\x59\x3d\x38\x19\xbc\xbb\xb8\xbc\xee\xd7\x83\x93\x8b\xcb\x7a\x70\x72\xf9\x7b\x3d\x38\xb9\xee\xdf\x5c\xff\xa7\x1e\x9c\xfc\xa7\x3f\xac\xcf\x8f\x26\xf8\x08\xd2\xf8\xde\xf3\xa3\xc9\x49\xff\xf4\xe8\xf6\xe6\xe6\xf2\x62\x6f\x0d\x7b\xbd\x86\xfd\xb4\x86\xfd\x0c\xd8\xe0\xf8\xf2\xa2\xff\xef\xe3\xb3\xc3\xf3\xc3\x9b\xc1\xe5\x45\x24\x0d\x2e\xf0\x05\xba\xa4\x7f\xdd\xf6\x87\x5d\x7c\x08\xe3\xf8\xfc\x68\x72\xf9\x3b\x1d\xf9\x05\xce\x8f\x26\x58\xb5\x0e\x3a\x78\xf7\xfe\x06\x81\x9b\x33\xf8\x7c\x83\x8b\x77\x80\x0d\xfb\x37\xa7\x97\xd7\xfd\x77\xd7\x97\xb7\x17\x27\x40\xb8\xb9\xbc\x3a\xbf\x1c\xe2\x9d\xd0\x54\xf0\x1b\x00\xff\xa7\x3f\xbc\xb8\xac\xb9\x93\xc4\x5e\xd1\x74\x83\xe1\xfb\xe3\x9b\x7f\xd7\xc3\xf7\xfd\xb3\x33\xf8\x6a\x37\xfd\x7f\xdf\xd4\xdc\x17\x9a\x8f\xbf\xd5\xfb\x1a\xbd\x44\x3b\x6e\x50\xbb\xa3\xbf\xa0\x17\x14\x09\xb6\xa9\x76\xb5\x4c\x53\x8a\xbc\x8d\x08\xea\x37\x33\x9d\xab\x3a\x49\x6b\x32\xc3\xac\x53\x95\x23\x45\x25\x73\x5b\xc3\x8c\x56\xe3\xd6\x7a\x8d\xcb\xd4\x9a\xb7\x15\xea\xe8\xbd\x5f\xcf\x54\x48\xf3\x9c\x23\xe4\x90\xb2\xb8\x46\x8f\xc4\x5a\x67\x28\x28\x77\x40\x43\x30\x9f\x6a\xae\x56\x4d\x5b\x02\x78\x47\x8d\x47\x65\xd2\x3a\xa2\xf7\x0a\x78\x7a\x5d\xca\xe4\x7e\x9e\xba\x9a\x22\xd1\xa1\xda\x9d\x61\xd6\x9e\xd4\x20\x36\x63\xc5\xd1\x40\xbd\x7e\x50\x6e\x6a\xbd\xaa\x39\x24\x04\x8c\x62\xff\xed\x74\xb8\x39\x1a\xdd\x19\x17\xc6\xf5\x68\x03\xee\x60\x47\xd5\xb8\x55\xb7\xbf\x0b\x52\xd2\xfe\x68\x1f\x38\xc7\xd7\xed\xe4\xcd\x13\xbb\xb3\x8f\x6c\xeb\xa2\x76\x64\xdd\xd2\x0e\xd7\x56\xd1\xc2\xc2\x6f\x87\x6d\xb7\x2d\xb7\xf5\xba\x91\xcf\xde\xda\x72\x89\xb2\xbe\x1e\xa6\xe9\x91\x93\x98\x3d\x90\xa2\x1a\x1e\xa6\xe9\x10\x24\xd7\xc3\x3c\xb7\x8b\x6b\x6b\xc3\x89\x76\x1c\xd3\x99\x68\xc3\x7b\x5d\x52\xbe\xae\xc3\x2a\x58\x0c\xf9\xcd\xa1\x51\x8e\xde\x9d\x5a\x13\xc4\xd1\xbb\x77\x9c\x7c\x4c\xc4\xa2\x6f\xd4\x12\x10\x0c\xc0\x74\xea\xe0\xa6\x63\x28\x0f\x0e\x50\xf6\xc9\xd9\x99\x38\x96\xb4\x07\x72\x8c\x99\xbe\x6f\x07\x82\x62\xfc\x68\xcc\x42\x7c\x9c\x2b\xe9\xd0\x08\xc7\x0b\x4e\x52\x4b\x29\x62\x44\x13\x44\x89\xb1\x72\x45\x75\x3b\xbe\x3e\xa6\x88\xe5\xc7\x9c\x86\xd7\x61\x18\x91\x15\xe3\x58\x51\x02\x31\x16\xcf\x71\x15\x04\x65\x0f\xe3\xd3\xe0\x62\x30\x54\x49\x07\x09\x8e\x91\x6b\x35\xfb\x5d\xad\x5a\x84\x12\x34\x53\xa6\x51\xb2\x10\x22\xd8\x53\xb4\x19\xac\xd7\x89\x76\xf1\xfc\x41\x3a\x3c\x29\xa7\xb3\x95\xe8\x63\xe2\x48\x6e\x3f\x8a\x10\x88\xa5\x33\x48\x65\xf7\x97\xc9\x5c\xf4\x97\x2a\xc1\x03\x85\xf4\x02\xe8\xa3\xd4\x41\x50\x72\xc3\xbe\x79\x20\xf1\xcc\x0b\x8c\x87\x04\x87\xa3\x2a\xc3\x2f\x89\x69\xdd\xd1\x2d\x00\x20\x6c\x45\xac\x0c\xe6\x04\x2f\x95\x41\xe0\x5a\xc9\xb4\x01\x8e\x56\x41\x35\xc8\xed\xcd\xe9\xde\xaf\x67\xfd\x06\xff\x68\x1d\xdd\x39\x54\xea\x1e\x81\x8f\x68\xdb\xdc\x40\xcd\xd3\x88\x75\x1f\x47\x02\x3f\x6f\xd2\x58\x27\x93\x9e\xe2\x66\x21\x40\x17\x54\x31\x93\x72\x93\x9c\xe6\x95\x9f\x0f\x2e\x06\xe2\x94\x05\xae\xbe\x49\xc5\x3b\xcc\x0e\x01\x1d\x07\x63\x32\x12\xea\x94\x09\x87\x69\xea\x94\xf7\x40\x39\xc9\x67\x98\xfe\x04\xc0\xb3\x33\xce\x11\xb8\x8e\x9d\xa1\x35\xcb\x3b\x15\xb0\x49\x28\xcf\xfc\x3b\xca\x09\x88\x39\x8d\x3b\x70\x73\xeb\x69\x95\xe7\x57\x32\xcc\x31\x32\x0d\xe2\x54\xad\xce\x2f\x63\x87\xd6\xd4\x5b\x01\xc7\x44\x37\x9d\xeb\x37\xaa\x28\x9b\xf8\x38\xef\x6c\xb0\xe2\xbd\x4e\x63\x17\x18\x24\xd6\x88\x41\x46\x63\x73\x90\x71\x97\x1f\x64\xf8\xe5\x68\x9f\x75\x90\x5d\xab\xa9\xb5\x01\x23\x22\x0e\xb2\xa1\xc6\x2c\xa2\x03\xa3\x39\xdc\xa1\x3f\xd1\x4e\xf0\x88\xed\x74\x41\xa6\x60\x24\x27\x1f\xb1\xce\xad\x27\xda\x71\xdf\x03\xc2\x15\xc7\x76\x86\x1f\xa1\xbb\xb1\xa9\x23\x80\xaf\xc1\x85\x02\x3e\x6c\xf0\x70\x5c\x94\x7c\xba\x85\xf3\x69\x81\xd4\xcb\x52\x0c\x62\xa0\x68\x90\xa5\x79\x2d\x71\xa6\x13\x65\xbc\x3a\xba\xa7\x00\x53\x8c\x62\x34\x71\x86\x31\xf8\x7c\x13\x8a\x30\x52\xbf\x2d\x02\x7f\xfe\xcc\xca\x34\x4a\xea\x38\x0c\xce\x6c\x72\x1f\x7f\xd5\xce\x86\x0a\x6e\x21\x66\x74\x2e\x8d\xce\x94\x0f\x27\x57\x83\x43\x4c\xda\x17\x09\xc3\xaa\xc4\xd4\xf7\xe9\xe5\x50\x70\x2c\xc8\x23\xbb\x14\xe7\xda\x27\x9d\xd6\xc4\x8f\x77\x61\x4b\x71\x59\x61\x27\x41\x77\x68\x3c\x00\x4f\x9b\xca\xe4\xde\x23\xd6\x5f\x42\x87\xbd\xb2\xa5\xb8\xaa\xfc\x5c\xfc\xab\xd2\x41\xc0\x20\xa2\xf1\x8a\x20\x33\x17\x00\xaf\xd5\xec\xe4\xe3\xe5\xf5\x49\x44\x88\x0e\x1f\x5b\xc0\xa5\xb3\x33\x71\x4d\xdb\xb4\xd7\xea\x53\xa5\x7c\xa0\xe4\xfd\xd0\x95\xb1\xf3\x5e\x93\x8f\x19\xd6\xe7\x9a\x56\x39\xd7\xe7\xf0\x89\x87\x4d\x70\x55\x31\x54\xcd\x30\x62\x10\x7a\x31\x7e\xe5\x16\x8f\x9f\xb5\x4b\x43\x56\xd2\xa2\xd8\x0a\x11\x75\xb6\x2a\x3b\x25\x0e\x4c\x84\x86\x8f\xca\x1e\x3e\x51\xf6\x70\xbd\xec\x61\x53\xb6\x49\x63\x2c\xce\xa1\x0a\xcd\x5c\x03\xc8\xfa\x8c\x35\x54\x18\x4a\x0f\xc7\x57\x07\xb6\x6e\x1d\x3b\xd1\x49\xfc\xa1\x70\x1c\xe2\x40\x18\xae\xf3\x92\xa1\x0a\x27\x68\xec\x65\x29\xdd\x48\xc1\x0f\x9c\xc0\x44\x21\x1f\x08\x26\xf6\x4e\xbc\xbe\xc5\x3f\x68\xb5\x00\xb4\xc3\x4d\x22\x86\x3f\x03\x9f\xa5\x13\x11\x0c\x28\x96\x0a\xb8\xac\x02\x7f\x9b\x70\xf9\xa0\x1c\xb9\x8a\x0c\x55\xe8\x8c\x73\xc4\x66\xf1\x27\x70\x0a\xf8\x20\x9b\x74\xcf\xd8\x84\xc8\x06\x86\x73\xbb\x40\x06\x44\x75\x42\xfc\xd6\xe8\x47\x14\x1e\x12\xf4\x4c\x9c\xdc\x09\xbb\x35\x0d\x8e\xfe\x68\xc3\x52\x26\x38\xb8\xbc\x18\x06\x07\x83\x9b\x4e\x43\x3c\x97\x2b\x38\x9d\x29\x23\x86\xd5\x34\xce\xe0\xb7\x46\xe3\x7e\x1f\xfd\xee\x3a\x17\x6a\x68\x9d\x9b\x89\x80\x9c\xaf\xc1\xd6\x8b\x23\x5a\xa7\x00\x1c\x6d\xb7\x86\x07\x05\x4c\xa9\x1f\x06\x87\x69\xca\x8c\x1d\x98\xd8\x87\x01\xc6\xa2\x67\xbe\xff\x61\xc0\x89\xb5\x23\x81\x5a\x00\x7f\x13\x27\x25\x1e\x84\x08\x5f\xab\xd9\x91\x36\x0d\x4c\x23\x32\x62\x34\xdd\x76\xef\x6d\xe0\xa6\xa2\xca\x89\x7f\x5f\x0d\xc3\x0a\xd6\x7b\x8d\x96\x46\xa6\xb8\x07\x9f\xe7\xa4\x6e\x9c\xda\x30\x87\x43\xb0\x85\x98\x7e\xd6\xe5\x6b\x91\x40\x87\x54\x29\xba\x83\x72\x9c\x67\x4e\xa3\x2f\x92\xca\xc3\x7d\x69\x23\xc4\x90\xb6\x87\x2c\x6a\x38\x4b\xe8\x6c\xae\x70\x03\x3d\xd5\x19\xee\x87\x63\x10\x7e\xa8\x50\x86\xf2\x50\x2e\x7d\xa8\xbc\x4a\x45\xae\xa0\x1b\x93\xa1\x0b\xb1\x4e\xf4\x03\xb3\x26\x5f\x89\xfc\x73\x21\x85\xc1\x5d\xfc\x39\x7a\xbe\xa2\x0b\x2c\x26\x55\x30\x36\xa0\xad\x4a\x96\x09\xce\xb7\x14\xcd\x29\x29\xca\x29\x5a\x79\x50\x1f\xa4\x13\xfa\xed\x51\x96\x05\xe8\xa3\x58\x7e\xb0\x25\xe9\x80\x83\x5b\x89\xca\xec\x74\x5e\x15\x30\x7a\xcb\xca\xec\xb4\x2f\x5a\x99\x9d\xf6\x1d\x2a\xb3\x13\xab\x5c\x61\x5b\x63\x28\x08\x57\x90\x6d\xc7\x47\x6d\xf6\x5e\xc1\xf1\xaf\x70\xf8\x1b\x1c\x3e\x68\x1f\xa4\xf8\x9c\xeb\x29\xe9\x4a\xd7\x94\x41\x68\xc5\xf7\xdb\xff\xcb\xa6\x39\x4e\xf0\xcc\xcd\x23\x97\x5a\x63\x7d\x6c\x67\xf7\x75\x3b\xd9\xb6\x24\x77\x6f\x9b\xed\x9c\xad\xec\xbf\xa3\x46\x22\xe5\xbd\x7e\x50\xc9\x77\x95\x49\xdf\x98\x33\x1f\x7e\xa8\x8f\x0f\xeb\xe3\xd3\xfa\xf8\x5d\x7d\x3c\xa8\x8f\xcf\xea\xe3\xf3\xfa\xf8\xa2\x3e\xbe\xa9\xcf\x7f\xaf\xcf\xaf\xea\xf3\x9b\xdf\xeb\xf3\x9b\xb3\xfa\x62\x58\x0f\x8f\x2f\xea\xe1\xef\xf5\xed\xa0\xfe\xf8\x7b\xfd\xef\xe3\xad\xd1\x68\xf1\x82\xf4\x75\xad\xe5\xb0\x61\xd5\x39\x2b\xfa\x51\x09\xcd\xdb\x19\x5e\x7f\x56\x36\xc3\x5d\x81\x54\x65\xbc\x5f\xc2\xbe\xcb\x99\x6d\x2d\x70\xbc\x9e\x19\xe8\x54\xd6\xcc\xc4\x03\x46\x66\xcf\x55\xdc\x65\x40\x35\x7c\x13\x96\x2d\xeb\x18\x24\xa3\x73\x28\xaa\xde\x69\x27\x05\x3a\x33\x6d\xd1\xb0\x4f\x81\xf4\x05\xed\xd6\x90\x46\x3c\xba\x0b\xb0\xbe\x3c\xb6\x26\x9a\x9c\xe9\x44\x07\xc1\xd5\x80\xea\xe2\xfc\x18\xe6\xda\x47\xdb\xe6\x66\x97\x67\x01\x2f\x38\x09\xd1\x58\xcc\x29\x99\x62\xaf\xa4\x08\x88\x88\x47\x2f\xbd\x3c\x13\x6f\xfd\xca\x24\x73\x67\x0d\xda\x75\x69\x2a\xdc\x66\x30\x28\x38\x04\x1b\xd9\x44\x57\x46\x63\xc3\x0d\x8e\x2e\xab\x90\xab\x20\x06\x47\x9c\xfb\xc6\x07\x67\x31\x52\x20\x1a\x5c\x97\x2b\xa1\x0d\x6e\xd3\x68\x03\xc7\xe9\x0a\x69\xd3\x15\xa6\x2c\x33\x6a\x21\x57\x62\x32\xe1\x67\x26\x13\x7c\x6a\x32\xa1\xed\xab\xc9\x04\x78\x86\x53\xb9\x92\xb8\x43\xf3\x36\x5a\x78\xbd\x2d\x9d\x0d\x94\xb8\xf1\x2d\x5b\x76\xbd\x85\xb1\xf5\xb6\x71\xd9\x78\xab\x4c\x2a\xde\x92\x0a\xf7\x2d\xb9\x68\xbc\x8d\x7b\x55\x6f\xdb\x52\x15\x1a\x92\xc1\x3b\xa3\x13\xfb\x67\x25\xde\x72\x4a\x7e\xf1\x96\xb6\x8c\xad\x13\x6f\xc9\x4c\x4d\xe6\xe2\x2d\x5b\x4b\xa7\x50\x3e\x32\xfb\xb7\xd1\xb6\xf9\x2d\x2b\x9b\xdf\xa6\x2a\xf3\xe2\xed\x9a\x73\xfb\x04\xb5\xad\xf0\x5a\x98\x6c\xaa\x01\x26\x31\x62\x66\x4b\x71\x30\x5d\xc1\x37\x7d\x44\x11\x93\x49\x62\x51\x9d\x66\x02\xc2\x26\x38\xd9\xe2\xf7\xc0\xd7\x33\x31\xb9\xb0\x06\xed\x85\x26\x17\x55\x9e\x63\x07\x44\x68\x52\x19\x5f\xaa\x44\x67\x1a\x4b\x3e\xbd\xbd\x38\xbe\x19\x5c\x5e\x4c\x26\x62\x32\xb9\xba\xee\xdf\xdc\xfc\x67\x9d\xd6\x6c\x69\x4e\x26\x62\xa6\x30\x45\xba\xa7\x13\xd7\xa6\x32\x5e\x66\x6a\x52\x99\xa6\xbe\x86\x7f\xd9\xc4\x1f\x36\x8f\x7f\x17\x09\xe8\x15\x4b\x43\x63\x3d\x08\x20\x6e\xc4\x5d\x0c\x27\x27\xfd\xe1\xe0\xdd\xc5\xe1\x4d\xff\x64\x32\xb8\x18\xdc\x0c\x50\xe9\x79\x0d\x57\x6e\x2f\x0e\x3f\x1c\x0e\xce\x0e\x8f\xce\xfa\x80\x5e\xf7\xff\x75\x3b\xb8\xee\x0f\x27\xc3\xdb\x2b\xba\xe1\xba\x7f\x73\x7b\x7d\x31\x9c\x0c\x2e\x2e\xfa\xd7\x93\xab\x4b\x54\xed\x0a\x24\x9c\x0d\x2e\xf0\xa1\xb5\x12\x4e\xfa\x57\xd7\xfd\x63\xf8\x29\xc0\xfa\x17\xb7\xe7\x70\xbe\xbc\x82\x66\x18\x02\x38\xfc\x38\x38\xbd\x79\xfc\xbb\x87\xc3\xe1\xed\x79\x7f\x72\x71\x79\x71\x71\x7b\x76\x36\x39\xea\xbf\x1b\x5c\x3c\x41\xef\x5f\x9c\x50\x9d\x4e\x07\x17\xfd\x93\xc9\xe9\xe5\x35\x15\xd7\x96\x7b\x71\x78\xde\xef\x60\x97\x37\xef\xaf\x2f\x3f\x62\xbd\x6e\xaf\x07\x17\xef\x00\x7a\x7f\x78\x71\x72\x46\xef\xd0\xbf\x38\xe9\x60\x1f\x0e\xcf\x6e\xfb\xf4\xbe\x88\x5e\xa2\x9a\xef\xf6\xfa\xa2\x33\xf3\xc6\x40\x6d\x95\x12\xa7\x87\x67\xc3\xbe\xb8\xb9\xbe\xed\xe3\x5e\xea\x7f\xfa\x43\x71\x71\x29\xa0\x9e\xdd\xed\x92\xa3\xcb\xcb\x33\x91\x6a\x5f\x62\x44\x37\x6b\x12\x35\x09\x2d\x8e\xb6\x11\x5d\x02\x30\x8a\x16\x93\xeb\x28\x3c\xdd\xfb\xba\x2d\xdf\xc4\x2d\x90\xb7\xe3\x76\x2f\x64\x32\x7e\xbe\xbb\xad\xdf\xf4\xde\xb6\xfb\xc1\x6f\xa9\x37\xe0\xc0\xb6\x09\x8c\xc9\xf5\x48\x95\xbd\x27\xcc\xd5\x8b\xde\x36\xcc\x27\x09\x9d\x5e\x26\xb8\x89\xe1\xb6\xf3\x7d\xb9\xdd\x86\x07\x0d\xeb\x11\x3b\x1b\xfb\x97\x6f\x02\xad\xb0\xd2\xe9\x6d\x63\x5e\x13\xa3\xa8\xac\xfb\x72\x3e\x0e\xc6\xa2\x1b\xe4\xee\x8f\x1f\xc7\xad\x69\x44\xe3\x1e\xf3\x43\xd7\xa1\x27\x5e\x79\xf9\x5f\x55\x5d\xe4\x97\xfa\x4f\xf4\x07\x7c\x64\xe3\xbe\xdf\xdb\xec\xbd\xd0\x3b\x68\xca\xb4\xd9\x13\xbd\xad\x9d\x3f\xad\x36\x9b\xbd\xba\xb7\xf5\xa2\x87\xde\x3e\xe8\xd3\xf8\xa5\xde\xd8\x8a\x4e\x8c\xf7\xfb\x1a\xdb\xa4\xeb\x82\x84\x33\xeb\x0e\x5b\xe2\xb0\x93\xe4\xf7\x66\xec\x44\x16\x4f\x5b\xb1\x77\x3e\x45\xfe\xd8\x26\x21\x9a\x23\xe0\x06\x9e\xf4\x8e\xed\xc8\xe9\x2b\x27\x6d\x64\xea\xd4\x8a\x14\xc4\xad\xd4\x2e\x4c\xb0\x1d\x53\x83\x26\x92\x62\x13\x07\x14\x4d\x0c\x2a\xd3\x5a\x51\x23\x60\x1d\x9a\x1a\x74\x6d\x0c\x70\xf3\xf0\x2f\xcd\x2e\x62\x1b\x19\xd5\x89\x1c\x2a\x96\xcb\xcf\x2b\xb2\x76\xb6\x4e\xe4\x3e\x17\xb9\x77\x22\x5f\xda\x26\xd9\x2c\xda\x1f\xfc\xa5\xde\x7b\xd5\x98\x22\xd0\x7f\x95\xb7\xf1\x56\x8d\x5a\x74\x2d\x11\x4a\x65\xfe\x42\xe2\xa1\x75\x8d\x21\xb2\x53\x09\x4c\xd1\x51\x80\x68\x2d\x7d\x57\x64\x77\xf0\x20\xf3\xbf\xc0\x41\x3c\x68\x17\x2a\x99\x7f\x63\x7e\x80\xda\x58\x47\x26\xba\xdf\x98\xd3\x92\xa0\x31\xc5\x0c\x02\x2c\xc3\x98\xfa\x17\x16\x6d\xd8\xb8\xa0\xb1\x28\xc8\x31\x65\xb4\xfc\xbc\x9a\x84\xfa\x17\x61\x30\xe0\xb4\x36\x00\xb3\x8d\x00\xc6\x37\xd6\x66\x92\x50\x76\x29\x98\xb1\x1b\xd8\xa9\xec\xc9\x7d\xd7\xc6\x81\x6e\xb7\xfe\xe7\x3f\x77\xa3\x11\xd7\x64\x3c\x1a\x2d\x9e\xff\xe5\xa0\xed\xf1\xfc\x28\x0b\x74\x77\x9b\x18\x56\xb1\xde\x3a\x18\x8d\xc6\xf5\x68\xb4\xc9\x8e\xe0\xaf\x38\x2c\x12\x52\x9e\xa3\x7f\xf9\x73\xbc\xf4\xa5\xf5\x60\x69\xfc\x78\x5a\x3b\xb7\x1f\xd9\x87\x68\x32\xde\x3c\xf8\xcb\x8f\x5b\x77\xa3\xd1\xe2\x47\x0c\x06\xb9\xb6\x39\xdf\xfb\xbf\xb8\x37\xff\xbd\xab\x23\xde\xbb\x8f\xd7\x5b\xf7\xde\xf6\x8d\x7e\x5c\xbf\xf8\x4d\xa4\xc9\x2e\x33\x21\xb9\xfa\x9b\x98\x93\x7a\x1f\x26\xc1\xf8\x12\xeb\xf6\x4e\x6c\x10\xd0\x78\xac\x4e\xc6\x2f\xee\xce\x72\x33\x3e\xa8\x5f\xdd\xd9\xcb\x31\xbb\x7e\x37\xa4\xe9\xd1\xf8\xee\xd5\x5e\x4b\xc1\xf0\x4a\xd1\xf1\x1b\x69\x75\xeb\xff\xfd\x7c\xeb\x60\xf3\x4e\xf5\xc7\x77\x2f\x5f\x8c\x0f\x5a\x97\xf0\x83\xad\xf6\x45\x77\xd1\xc1\xe6\xbf\x84\x77\x84\xce\xed\x13\x99\x7e\x57\x6e\x5f\x0f\x12\xb7\xb1\x99\xdd\x49\x6f\xc6\x75\xa8\x1f\xca\x3b\x17\xd2\x71\x9d\xcc\x75\x9e\x3a\x65\xb6\xda\x0d\xf4\x6e\xcf\xc0\x2e\x55\x43\xef\xaa\xaf\x06\xb4\xd7\xd0\xfb\xfa\x44\xfc\x4f\xb6\x0d\xdb\x24\x83\xb2\x17\x5b\x07\x9b\x8a\x42\x57\x45\x67\xaf\x47\x61\x40\xd7\x5b\x9e\x37\x1c\x89\x3d\x3f\xe5\xfc\xc8\x6c\xa4\xc2\xee\x3d\x6d\x08\x75\xe5\x95\x68\x18\x73\xdc\x11\x5a\xf7\xc6\x6a\xc3\x1a\x34\x3d\x16\x57\x45\x61\xdb\x8d\x71\x93\x04\xfa\xd3\xf3\xbf\xfc\xf0\x8c\x03\x84\xc6\x5d\x91\xf5\xa5\x57\x0c\xab\xdb\x10\xb1\xd0\x37\x35\xc6\x3c\x48\xf6\xef\x6c\xf4\xfe\xfc\x76\x4a\xc4\xef\xb3\xce\x89\x5b\xe7\xd4\xb5\xa0\xd2\xe8\x1b\x40\x7e\x93\x6d\x66\xf8\x49\x46\xdc\x14\xb9\xf0\x68\xf4\xec\x69\x61\xe2\x6a\x40\x91\x8a\xba\x0c\x29\xd1\x2e\x81\x15\x10\x25\x66\x2e\x29\x0b\x33\x45\xef\xe7\x0c\x21\x49\x35\x55\x22\xe9\x66\x0f\x9d\xab\xd4\xa1\xaf\x03\x45\x80\x57\x31\xed\x15\x25\x84\x72\x24\x8e\x53\x1a\x6e\x8c\x2f\x5d\x60\x3e\x5a\xd2\x17\x44\x1f\x92\x39\x19\xc7\x9b\x7b\xbb\xf0\xf7\x9a\x97\x67\xa9\xce\x62\xba\xb7\xee\xdb\xa1\xf9\x75\x63\x27\x9d\x58\x8f\x66\xd2\x4f\xda\x63\x93\xc5\x35\x59\x56\xa3\x6d\x36\x5b\x62\x97\xb0\xda\xff\x44\x4e\xa0\x94\xad\x8e\xf2\x86\x4b\xa8\x16\x3a\x31\xe4\xd6\xde\x57\x98\x5b\x03\xa3\xd0\xd0\x46\x9a\x88\x69\x2e\xf8\x3c\x81\xd5\x9f\xb1\xae\x10\x89\xb3\xde\x73\xea\xe2\x26\xce\x77\x32\xb7\x8d\xed\x1c\x45\x5a\x4e\x97\x99\x48\x97\xd9\x84\x32\xf7\x4f\x14\xe5\x30\x15\x8f\x50\x6a\xbf\x06\x8d\x69\x28\xda\x1c\x83\x31\x4f\x5c\x1c\x88\x58\x26\x55\x01\xa0\x54\x17\x38\x2d\xd2\xc2\x30\xaa\x0e\xba\xc2\x93\xdc\x36\xd8\x9d\xfd\x76\xfe\x7d\x3e\xb1\x5c\xcd\x94\x51\x4f\xc6\xc6\x6a\x5c\xe3\x65\x9a\x92\xc9\x62\xd7\xd5\x4c\xc2\x5a\x81\xac\x7e\x8a\x29\x2e\x50\x41\xa4\x64\x07\x34\xf2\x34\x23\xab\xc6\x15\x9b\x34\x46\x61\x02\x1a\x3e\x9a\x36\x96\xab\x6e\xf0\xb7\x8e\xad\x23\xbb\x90\xc5\x25\xb5\xca\xd5\x8c\x08\x3e\xf9\xc6\xec\x91\xa5\x91\xb8\x14\x64\xe7\x30\x10\x4d\x8a\x32\xac\xd8\x16\xd2\x57\x4e\xf1\x42\x9e\x22\xaa\x29\x8a\x1f\x13\xed\x23\x39\x54\x00\x65\xac\x6e\xa5\x18\x52\x61\x61\x68\x80\x47\x81\xda\x41\x40\x69\xec\x29\x51\x7d\xcc\xfe\x63\x0b\xe9\xd2\x47\x1e\x64\x59\x85\x19\xa4\x39\x78\x00\x79\x92\xcd\xa5\x67\xb3\xcb\x6e\x88\xf7\xd6\x6f\xbc\x0d\xf9\x66\x1a\x2f\x32\x64\x92\xb0\xd0\x8f\x9e\x64\x8d\x81\x26\x3a\x8f\x19\x5e\x62\x7a\x34\xd8\x8c\xa6\x9a\xe8\x50\xc6\xa6\xd7\x70\x42\x8f\x31\x6b\x4b\x92\x9f\xd0\x49\xec\x1b\xb9\x69\xdd\x2b\x0c\x96\x83\x6a\xb1\x66\xd8\x99\xad\xda\xb5\x23\x48\x54\x79\xca\x1a\x37\xfa\xcd\xae\x8f\x58\x93\x8a\x1c\x13\x81\xe7\x39\x26\x14\x07\x46\x4c\xfe\x62\x32\x17\xa5\x36\xb0\x28\xed\x44\x72\x88\x7e\x60\x51\x41\xd0\x6a\x10\x58\x81\x80\x89\x30\xf1\xd7\xa3\x19\xa9\x4c\x5b\x65\x09\xe5\x94\x14\x0e\x84\x26\x54\xa9\xc6\xbc\x8d\xad\xab\x01\xf9\x51\xb3\xc9\x67\xf4\x09\xf2\x4a\xe6\x94\xd1\xad\xe3\x22\xf6\xa9\xa2\x40\x77\xe8\x26\xc6\x16\xaa\xf7\xba\x8c\x0a\x24\xca\xe7\x64\x33\x8e\x10\xd8\x8a\x8d\xd5\xb7\x96\xab\xd1\x23\x2c\xf0\x92\x9c\x23\x42\x60\x88\xf7\xd6\xac\x35\xca\x97\xf8\x66\xbe\xd5\x58\x7d\xeb\xe6\x85\x8a\xa0\xc6\xda\x55\x48\x8c\xe6\x56\x48\x4c\x12\x92\xaa\xd2\xa9\x44\x06\x32\x76\x25\x2f\x4f\x8c\x18\x5f\x4a\xe0\xd9\xad\x7e\x0a\x2a\x82\x97\xe1\x3b\x91\xbb\xba\x9e\x3a\x74\xd1\xcb\x65\xc0\x74\x1a\x6d\x46\xce\x52\x62\x3f\xa2\x00\x02\xf4\x31\xfc\x5c\x91\x75\x6a\x57\x49\xd3\xa8\x63\xba\xfa\xa5\x56\x01\xd1\x8b\x81\xc3\xbf\xf4\xb6\x7b\x5f\x1b\xd5\xa6\x7c\xf3\x1d\x99\x12\x8d\x44\xb7\x1e\xdb\xd9\x3d\xf2\xc2\x60\x6b\x3b\x32\xac\x7b\x7c\xe3\xe6\x0f\x28\x74\xbc\x68\xa5\x81\xef\x84\x85\xe8\xf2\xa3\x54\x35\x60\xdb\x29\xbb\x71\x23\xf6\x7f\x1b\x63\x60\x9d\xa7\x9e\x86\x15\x49\x5b\x00\x60\x4d\x19\xed\x62\x05\x9d\x7e\x39\x68\xcd\xf6\x7f\x15\x52\xd8\x39\x5c\xa3\xbb\xb3\xdb\x96\x8f\x43\xa3\xe4\xfb\xbb\xa3\x9d\x03\xb4\xed\x22\x9f\xf0\x1f\x37\x7b\xf5\x68\xb4\x71\xf7\xee\xe5\xff\xcc\x5e\x7e\x06\xd9\x7d\x77\x34\x7a\x5e\xff\x63\xb7\x7e\xf3\xcf\xfa\xe5\x3f\xb7\xd0\x25\xc5\x61\xd0\x70\x9c\x35\xf4\xb6\xa1\xf8\xe1\xf6\xf1\x22\xf6\xcd\x68\x34\x45\x2c\xae\x58\x95\x49\x7f\xeb\xd6\x68\xbb\x53\x8c\x1d\x7f\x5f\x2a\xa5\x15\xd2\x4f\x4f\xcd\x36\x6b\xbd\xa1\xbb\x84\xf8\xed\x71\xd8\x9b\x26\x46\xd1\xf1\x66\xef\x8f\x1f\xda\x90\xac\xd0\x6f\xfe\x70\xaa\x68\xbb\xd4\x5e\xf4\xc7\xd8\x7a\x1c\x04\xe3\xed\xe6\xc1\xfe\xd1\xe1\xb0\x5f\xdf\x0e\xfb\x35\xda\x86\xd5\xac\x49\xda\xda\x58\x8b\x5d\xd4\xc6\x6c\x7d\x7b\x87\xc1\x84\xd0\xb3\xe2\x0e\xe0\x3f\x7e\x03\xec\xf9\x68\x34\xde\x3c\xd8\xff\x86\xb6\x75\xb0\x79\xb0\xbf\xf3\x7c\x6b\x23\xae\x5e\xba\xd6\x75\x3d\x74\xae\xf9\x72\xc0\x45\xa2\x6c\xbc\x0f\x05\x7f\x3d\x88\xb7\xaf\xcb\xe7\x7f\x3c\xba\xf3\x49\x27\x8c\x3f\x7e\xe8\x18\x28\x53\x04\x9d\xe3\xff\xa2\xdc\x2f\xb3\xff\xad\x85\xe8\xdd\x68\x31\x4a\x7f\x78\x3b\xc6\xf3\x64\xfc\xfc\x3b\xf6\x87\xff\xd8\x3c\xf8\xcb\x68\x77\x0b\x0d\x6b\xff\xb9\xfb\x84\xc0\x5b\x66\x3b\xec\xf0\x91\x93\xb1\x2d\xac\x6d\xfe\xf1\xcf\x97\x14\x14\xe9\x4b\x2b\xa0\x92\xe4\x40\xeb\xfb\x12\xc4\x06\x64\x4b\xd2\x24\x73\xeb\xea\x5f\x84\x34\x41\xff\x7f\x94\xbd\x6b\x7f\xdb\x36\xf6\x30\xf8\xfe\xf9\x14\x88\xda\xfe\x62\x27\x56\xdc\xa4\x33\x7d\xe6\x49\x27\xf1\x28\x92\x9c\xa8\x23\x59\x1e\x49\x4e\xda\xbf\xe5\xaa\x10\x09\x49\xa8\x29\x82\x01\x48\x5f\x1a\x66\xf7\xa3\xed\x8b\xfd\x62\xfb\x3b\x17\x80\xa4\xac\xa4\xb3\x6d\x2c\x9e\x73\x00\x82\xb8\xe3\x00\x38\x17\x97\x19\xb3\x82\x71\x84\x36\x4d\x29\x0f\xb5\x4b\x03\x5c\x78\x80\x09\xfc\x58\xe8\xe8\x1a\xd6\x26\x1b\x9b\x2d\x29\xe6\xa3\xd8\xb2\xca\x7f\x24\x4b\x83\xb4\x54\x23\xff\x06\x4c\xa6\x01\x46\x32\x57\x32\x49\xcc\x6d\xdb\x64\xb9\x03\x2e\x43\xd9\xbc\x8d\x33\x5f\xee\x31\xab\xb2\xe4\xde\x23\x90\x08\x72\x00\xb4\xb2\xeb\x68\x9b\xb5\x61\xc2\x07\xe0\x47\x84\x44\x22\x97\xb0\x4d\x27\xa3\xb3\x66\x29\xd9\x8e\xaa\x55\x91\x56\x37\x2a\x6e\x43\x06\xe9\xa8\x22\xb3\xda\x08\x3c\xbe\xcb\x91\xe9\x5d\xc3\xdf\x5a\xc5\x74\x7f\x74\xad\x54\x26\x56\x56\xae\x51\xbb\x1c\x38\x0e\xe3\x50\x27\x5e\xae\xda\xb9\x81\xfa\x58\xea\x54\xe6\x0c\x57\x90\x8d\x6d\x08\xcf\xd1\x38\x29\xea\xb2\xb6\x61\x11\x6b\x2a\x27\xb6\xad\x59\xea\x94\x3c\x9f\xb4\xd1\x3d\x32\x2d\x74\x6d\xa8\xa2\xb8\xc8\xa0\xb4\x58\xfa\xb6\xaf\xab\x76\x6e\xd8\xcd\xf0\x52\xa6\xf1\xad\x8e\xf3\x4d\xe0\xdc\x3c\xc3\xfd\x11\xd5\xd3\xb1\x5d\xdb\x99\x49\x74\x74\xcf\x6a\x60\x2b\x9d\xae\x95\xc5\x5b\x3a\x27\x36\xc6\xe5\x3a\xf6\xaa\xec\x66\x5d\x31\x38\x86\xae\xb9\x91\x49\xb2\x8a\x99\x4e\x25\x6c\x91\x28\xa7\xf2\x76\x3d\x58\x2c\xa5\xd3\x11\xdd\x0b\x66\xe4\xcf\xb1\x5a\xb0\x55\x9b\x73\xe6\x08\xe3\xbc\xe4\x7a\xab\x4c\x91\x7b\xb6\xb4\xc0\x8d\x88\xc8\x60\x03\xe0\xf2\xd4\x10\x5b\x84\xdb\x9d\xfb\x34\xb3\xe6\x8e\x4d\x03\xfa\x03\x1c\xd2\x05\x6e\x2f\x71\x0b\x92\x9a\x36\xb2\xc2\xd9\x2a\x31\xb7\xd8\x43\x13\x93\x65\xf7\x5c\xa3\xe4\x85\x8b\x39\x41\x8b\x67\x54\xf2\xae\xed\x6c\xd4\x4e\xd1\x5b\xab\xc7\x30\xfd\x0a\x8d\x4c\x9a\xd6\xe1\x36\x3a\x96\x0f\x2b\xf6\x2a\x29\xdc\xc6\x45\xb6\x58\x96\x7f\xc7\x77\xb6\x0e\x37\x3b\xed\x3c\x07\x9e\xad\x1d\xe3\x50\xa1\x66\x6e\xeb\xba\x5e\x0c\xde\xf5\xa6\xf7\x10\x09\x1b\x93\xb8\x9e\xc2\x66\xab\xf6\x8a\x1c\xad\x28\x94\xb1\x29\xff\xee\x75\xd8\x9b\xb7\x94\xe1\x78\x16\xb7\xcd\x5f\x9a\x6a\x36\xd9\xde\xb9\x86\x66\xc2\xa7\x7c\xd6\xbc\xf8\xbf\xdb\xff\xef\xff\x53\x3f\x78\x46\xfc\x49\x75\xfe\x10\x14\x48\xfe\x39\x3f\x39\xc8\x36\xd9\xe1\x49\x39\x3f\x79\x5d\x09\x3a\xef\x2a\xf5\x05\x21\xfc\xc7\xcb\xe6\x91\xed\xb2\x12\x4f\x7c\x70\x30\x14\xce\x1e\xbe\x78\x2a\x71\xe5\x6d\x3a\xaa\x67\x6f\xce\x46\x7e\x96\xdd\x33\xdd\x6d\xb2\x1f\x5a\x47\xf0\xf8\x1b\x3d\xfe\x4e\x8f\x1f\x5b\x57\x47\xde\x9c\x59\x8b\x8c\x41\x93\xeb\x28\x9c\x1e\xf0\xec\x2f\x6c\xbd\xb8\xa3\x78\xd6\x98\x76\x9b\x61\x54\x48\xe7\xb9\x50\x95\xc6\xde\x4a\x12\x6e\xd2\x58\x1d\x1d\xe8\xc4\x30\xd2\xee\xca\xe5\xde\xb0\xae\x37\xe7\x89\xcd\x4d\xca\x54\x10\x4d\x3b\x98\x4f\x83\x69\x35\xcf\x75\xe3\xfe\x28\x58\x00\xc5\x7b\x3a\x36\xd5\xe4\x4f\x28\x16\x0b\x94\xc7\x5e\xf0\xa6\xcb\xfb\x5a\xc2\x22\xc5\x86\xed\x78\x92\xdd\x41\x9a\x2b\xa2\x04\x06\x68\xe1\xe0\x55\x5c\x81\xf1\x2e\x6b\x38\x38\xc3\x44\xaa\xcb\x56\xba\xc0\x47\x63\xa0\xb0\x09\xa1\xdb\xc1\xc5\x62\xd4\x9f\xbd\x1b\xf7\x16\x0b\xda\x5c\x56\x07\xd0\x61\xcb\xa8\x83\xdb\xa9\xe6\x1d\x9a\x4a\x63\x6f\x7e\x84\xf6\x75\xe8\x0e\x72\xe7\x16\x96\x2a\x88\x8d\x69\xf9\xe8\x64\xb4\xa1\xa6\xc8\x92\x5b\xe0\xb7\xf1\x9e\xd8\x5f\x8c\x99\x15\x82\xb0\x11\x59\x89\xc5\xa2\x37\x98\x60\xb1\xce\x3a\xa3\xfe\xf4\xbc\xd3\x85\xb2\x11\xaf\xce\x5b\xc7\x56\xe3\xc6\xff\xa0\x75\x7c\xcc\x77\xfd\xa8\x43\xf2\x99\x19\x9b\xe3\xc0\x0e\x1f\x53\x10\x76\xf7\xd8\x44\xb9\x5c\x33\x73\x42\xc7\xaa\xb0\xec\x87\xb7\x16\x8b\x8d\x4c\x72\x74\xba\xa5\x13\x65\x9f\x3d\x3d\xf9\xa9\x75\xf4\xe8\x39\x5b\x00\x85\xce\xb7\x13\xa3\x75\x94\xb0\x46\xe5\xe1\x03\xf5\xaa\x7f\xfe\xf3\x9f\x97\x8f\x5b\x57\xc0\x60\xe2\xf3\x5b\x5c\xe6\x7f\x9b\xdf\x3e\xfd\x09\x60\x3f\xe4\xea\x92\xc5\x95\x9a\xf1\x6d\x50\x33\xfe\x34\xa7\x17\xe7\x9f\x8f\xe9\xbe\xc4\x3e\x60\x77\x8e\xe7\xdf\xe6\x1b\xed\xe6\xcb\xe3\xcf\x47\x39\xbe\x75\xf0\xf2\x25\x30\xac\x61\x9e\x98\xdf\xfd\xef\x55\x7b\x7e\xb7\x5a\xd5\x27\x8b\x8a\x08\xfc\xc9\x57\x38\x7c\x34\xb2\x73\xf9\xd3\xa7\xab\x63\xbe\x72\xc1\xab\xa3\x6f\xcb\xf9\xfc\xb2\xfc\xae\xb2\x7e\xf6\x75\x6e\xbc\x3a\x32\xcc\xab\xe3\x16\xfd\x65\x2b\x47\x3b\x16\xe3\x3e\xb5\xc2\xb7\x8f\x2f\x5f\xce\x0f\xe6\xdf\xb6\x1e\x1a\x3d\xfa\x82\x11\x39\x88\x11\xf6\xe7\xa4\xe6\x4a\xd6\x93\xe6\xcf\x1e\x5f\x35\x6d\xd7\x41\xd4\xc2\x85\x48\x3b\x77\x4a\xe8\xc7\x08\x73\xfd\xa5\x69\xdb\xa4\x7f\xe1\x14\x4f\xe2\xde\x48\x92\xdc\xa8\x59\x79\xc5\xd6\xa5\x1f\xbc\xfe\xd0\x07\xfb\xd7\x82\x35\xbc\x19\x83\xbd\xb9\x4e\x9d\x8e\x60\x5e\x2a\xb6\xaa\x1a\x7d\xd5\x71\x8f\x5e\x2b\x97\x9b\x15\x29\xb7\xd6\x8c\xdc\x6d\x97\x8a\xcc\x2f\x62\x92\xfe\xae\x09\x2d\x6d\xc4\x8a\x2f\x98\x9a\xca\xab\xb9\x48\xe4\x76\x19\x4b\xd6\x5c\x85\xd1\x8e\x67\x1a\xc6\x9b\x40\xa0\x9b\xa0\xad\xce\x35\x1e\x13\x90\x29\xa3\x70\x5c\x40\x0a\xaa\xf5\x9b\x21\x1c\xfb\xe1\x72\x28\x6c\xe4\xbd\x62\x6a\x73\xa7\x8e\x46\x58\xd8\xdd\x9c\x33\x78\x8b\x84\x9c\x9d\x4d\xc5\xd2\xdc\xed\x5e\xd9\x6c\xb4\x13\xd5\x11\x6e\x4d\x81\xad\x66\x56\xa0\xc5\x2b\x1a\x3c\x69\xbf\x22\x1f\x46\xda\xb5\x3d\xf0\x40\x53\xed\xcb\x76\x0c\x78\x07\x5d\xbf\x73\x59\x9c\x84\x5b\x97\x70\xaf\x62\x5e\x05\xeb\x69\xde\xd7\x85\x7b\xb5\x7f\x04\x60\x5f\xa9\x5d\xaf\xe2\x96\x97\x36\x8d\x57\x5f\x38\x5c\x87\x16\x5a\x15\x74\xa8\x1e\x74\xf8\xc9\x00\x5b\xdd\x6e\x1a\xda\x28\x33\xbb\xc6\x12\xd0\x05\x96\xb7\xa5\xc5\xa6\xc2\xfc\xe7\x7e\x0a\xbe\x29\x82\x17\xad\x94\x85\xa2\x6a\xe9\xfc\x17\xae\x23\xd1\xc8\x07\x3a\x30\xff\x12\x83\xf3\xfb\x25\x6a\x92\x4f\xfd\x2d\xc2\x83\x9d\x53\x98\x26\x71\x7b\xc5\x9b\xac\x97\x30\x8b\x5d\x85\x46\xad\xdd\xbc\x1c\xcf\xbf\x3d\x80\xd5\x9d\xee\x5e\xb0\x9b\x1c\xe2\x5c\xa9\xf7\xa9\x65\xec\x98\x33\xf8\x17\xa1\xbf\xb5\xfe\x75\xfc\xf9\x8a\x2e\xdc\xed\xc3\xed\x29\xe4\xa5\xd3\xfe\xf3\x0a\xe3\x5e\xfe\x86\xe0\x9e\x53\x95\x1d\xb3\x7c\xfc\x05\x42\x7f\x7b\xfc\x2f\x7c\x85\xcf\x5a\xfc\x52\xe5\xcb\xfa\xec\xc0\xdd\xa7\x26\x73\xda\x95\x64\xe5\x0b\x17\xef\x52\xdd\xa1\xf7\xe5\x52\xa7\x59\x91\xbb\xd2\x14\x39\x3e\x53\x93\x2b\x57\x26\x3a\xbd\x2e\x83\x30\x5e\x69\x4d\xa2\x4a\x5f\xe5\x12\x76\x53\xde\x4e\xf6\xfc\xd9\x01\x4e\xdb\x2a\x57\xb6\xe4\x03\xd9\x8d\x4a\xb2\x1c\xdd\xfa\xc3\xd4\x59\xa7\x46\x32\x57\x6b\x63\xef\x4b\xab\xb6\x26\x57\x40\xb2\x45\x8a\x93\x6b\xe9\xcf\x81\x81\x78\x38\x77\x4f\xbd\xbe\xa7\x6b\x5c\x59\x75\xb1\x3d\x8e\xe0\xe7\xf0\xc8\xdb\xfa\xfe\x06\xab\xe1\x5b\xce\xd2\x3f\x09\xfd\xe6\x35\x57\xbb\xa9\x1d\x6c\xd4\x18\x46\xf2\xb2\x76\xdc\x86\x81\xf6\xe7\xfc\xd9\x1c\x77\xc6\x0f\x2d\xdf\xfa\x4b\x1f\xcf\x90\x79\x17\x11\xfe\x10\x2a\x36\x3c\xf7\xd0\xac\xe4\x8d\x6a\xe2\xd1\x3c\x5a\x9d\xb5\x32\x43\x6b\x6c\xfe\xcc\x1c\xab\x0b\xe7\x53\x9a\xb6\x89\xc3\x23\x6a\x98\x95\xfd\x61\xb7\x4e\x3d\xbb\x44\x5c\xa1\x46\xb3\x74\x39\x9a\xf4\x32\x11\x7a\xf3\x80\xe9\xb5\x7e\xe1\xd4\x89\xe3\x76\xd7\x6c\x33\x34\x18\x46\x48\x8a\x06\xe6\x01\x7e\xa7\x1d\x4a\x51\x02\xfc\xb3\x59\xce\xd8\x9a\x16\xa0\x23\x85\x16\x59\x00\x3c\x9f\x4e\x53\x99\xe9\x14\x11\x94\x82\x46\xcd\x19\x54\x96\xaf\xd2\x46\x15\x9c\x90\x3a\x61\xfd\x1b\x95\xe6\x43\xb3\x66\xd4\x7f\x8e\x31\xe3\x7c\x3c\xd4\xc4\xa8\x40\xef\x51\x99\x49\xef\x79\x7c\x78\x2f\x61\xed\x31\x89\x13\x78\x45\x9f\xf6\xac\x66\x11\x8c\xfd\xa9\x43\xa6\x95\x73\x75\xca\x87\xe9\x48\x22\x86\x07\x07\x28\x50\xcd\xc8\xa9\x35\xdb\x76\xd7\xdd\x34\xf0\x9f\x9d\x49\x1b\x84\xa9\x8a\x0a\xab\x58\xab\xa0\x11\x80\x24\x54\x4a\x60\xf2\xcc\xd4\x93\x9b\x99\xf6\xbb\x7c\x9b\xd4\xd0\x7a\xda\x33\xb3\x37\xe5\x99\x69\xff\x82\xef\x64\xf7\x5c\x3d\x1e\x0a\xb5\x83\xe6\x87\xdb\xe7\xdc\xf2\x3d\xed\xa0\x92\x42\x83\x4c\x14\x19\xfc\xf3\xf4\x5a\xfb\x7a\xd2\xf9\xf4\x0d\xf4\x39\x6c\xc8\x1a\x71\x02\xa3\x11\xb2\x52\x91\xb8\x2e\xc9\xb5\x9a\xb7\x0f\xe5\x83\xb1\x62\xbb\x56\xc5\xd3\xe9\x39\x10\xa3\x07\x4d\x50\x23\x52\x2b\xf8\x57\xa7\xd1\x46\xc5\x45\xa2\xe2\x9f\xcd\x92\x75\x9d\x1e\x14\x80\xc9\xb5\xfc\x33\xa5\x91\xfd\x40\x0b\xb9\x0f\x94\xbd\x99\xe7\xd0\x7d\x19\x68\x94\xa7\x0f\xcc\x4c\xad\x28\xfd\x3b\x9d\x37\xd0\xcc\xd8\xbc\xdd\x41\xb9\x45\x46\xba\x89\xbe\xdb\x26\x01\x33\xa9\x33\x89\xaa\x50\x3c\xe1\x08\xa8\xbb\xf1\xe0\x29\xba\x99\xc1\x6e\xc4\x94\x11\xde\x08\xf1\x48\x64\x5a\xf5\xe9\x53\x63\xfb\x32\xda\xf8\xd1\x40\xaf\xb7\xbb\x24\x24\xcd\x18\xba\x82\x66\x98\x9c\xe5\x33\xf2\x41\xc7\xa8\x9f\xd4\xee\x44\x09\x3d\xb1\x04\x08\x15\xc0\x65\xe5\x28\xa3\x3f\xd5\xeb\x54\xe2\x05\x1a\x84\x74\x37\x3a\x89\xbd\xd6\x14\xb4\xd3\x56\x92\xb6\xd5\x6e\x9b\xa1\x4b\x71\x0e\xa0\xf9\xc0\xc3\xd6\x24\xe7\x32\x55\x49\x2d\x15\xaa\x0f\x84\xad\x8a\xe1\xcb\xa4\x4a\xd5\xee\x16\x49\xf8\x74\x0f\x78\x52\x00\x70\x4a\xa9\x20\x98\x5c\x02\x32\x2d\x96\x64\xb6\x92\x92\x0b\xda\x2f\xe7\x74\xd2\x04\xb4\x5a\x25\x03\x8a\x73\x10\x01\xf9\xa9\xbe\x23\x50\x25\x19\x01\x3c\x5d\x01\x3c\x70\x6a\x9a\xea\x2c\x53\x14\x3d\x64\xbf\x31\x20\x81\x00\xfd\x88\x9f\xbe\xbb\x02\x3a\x34\x11\xf5\x3b\x40\xb8\x45\x11\xa4\x4b\x3f\x00\xcf\x57\x77\x5d\x65\x73\xbd\x22\x8f\xb6\x48\xe2\xb1\x8d\x70\xbd\xb7\x13\xa1\x2b\x93\x64\x9a\xcb\xe8\x9a\xf1\x9e\x05\x06\x9a\xe0\x73\x72\x71\x65\x19\xf5\x9d\xa6\x81\x35\x47\x04\x07\xd1\x54\x0f\x08\xbb\x78\x04\xb0\x31\x4c\x76\x09\xe4\x0c\x9f\xc8\xca\xa2\x8b\x78\x80\x67\x56\x46\x6a\x8a\x47\x6f\x1e\x0f\xb3\x34\xe2\xf7\x99\x0a\x0d\x71\x31\xa8\xb7\xf6\x05\x59\x6e\x04\x30\x4c\xfe\x88\x28\x4b\x9f\xff\xa0\xd3\xaa\x23\x7c\xd8\x6a\x1e\x03\x88\xd5\x47\x6f\x20\x0c\xbc\x0b\x6d\x14\xc8\xf7\x63\x66\xb0\xad\x8d\x5e\x46\x78\xf4\x7a\x8c\xbb\xa7\x47\xdd\x8d\x07\x6b\x3d\x82\x29\xa8\x04\xa8\xff\x54\x31\x96\x8a\x89\x55\xd5\x33\x81\x5b\x7c\x90\xde\x98\x6b\xd5\xee\xb8\x0f\xc6\x5e\xaf\x12\x73\xeb\x29\x7e\x58\x31\xda\xbf\x43\xf5\x24\x4c\x80\x28\xbe\x5b\x32\x8a\x5d\x91\x61\x18\x7e\x23\xba\x51\x66\xca\x07\xb5\x64\x5d\xb0\x40\xd9\xea\x9d\x28\x50\x3f\x2c\x92\xfe\xb3\xd1\x29\x2d\x8d\x43\xd8\x8f\x55\x63\x6c\xa4\xa4\x2b\x6c\x95\x3b\x8f\x73\x45\x8e\xcc\x0d\xe7\x24\x40\x61\x54\x9c\xa9\x5b\xae\x61\x80\xa8\xd9\x02\x04\x69\x03\x52\xab\x4d\x44\x21\x29\x0f\x34\x52\xaa\x8d\x2b\x40\xf9\xfb\x00\x72\xc5\x56\xa0\xd7\xd8\x43\x92\x1f\x1d\x04\xfb\x36\x69\x60\x8d\xe1\x80\x4a\x72\x8d\x60\xee\xe6\x44\xc3\xce\x0c\xed\xd9\xa0\xfa\xb6\x0c\x33\xcf\xde\xd0\xfa\xc7\xf7\x8c\x23\x24\xf3\x38\x02\x78\xa6\xb7\x6a\x9a\x49\x0a\x08\xc3\x01\x90\x0f\x6a\xc9\x11\xcf\xf1\x60\x1c\x69\x7e\x64\x20\xd2\xe8\xfa\x81\xd2\x2c\xd0\xb8\xc8\xdb\x3d\x3e\xba\x03\x18\x4b\x0e\xc0\x5b\xab\x63\xd4\x28\x03\x04\x67\x4a\x00\xd0\x16\x0c\x00\xa8\xdf\xa6\x2c\xc2\xcc\xbb\x9c\x9b\xac\x9a\xe9\xce\x0b\xb7\xa9\xb0\x89\x92\x31\x25\x32\x51\x91\xd2\x37\xb8\xa0\x8b\x09\xef\xea\xda\xfd\x74\xad\x53\x45\x39\x0f\x44\x6a\xdc\x1d\xe2\x17\x66\xaf\x10\xde\x98\xaa\x02\xf5\xc3\x56\xfb\x74\xb6\xd0\x43\x03\xdb\xca\x78\x23\x30\xf4\x4c\xc6\xb1\x37\xd6\xe0\xd0\x21\x99\x46\x5f\xf2\xa0\xef\x9e\x4c\xe1\x6e\xc9\x58\x63\x16\x0f\x34\xea\x9a\x01\xf5\x1d\xa4\x22\xd0\xa4\xcc\x78\x98\x38\x19\x0f\x7d\x82\xf1\x6a\x3e\xf4\x84\x46\x37\x20\x15\xd1\x7a\x0d\x20\xce\x85\x0c\x70\xad\x90\xae\xb6\xc2\x8f\xd0\x7f\x87\x3a\xf7\x56\x86\x27\xca\x99\x04\x32\x09\x93\x06\xcc\x3f\xd2\xe6\xf5\xb4\x89\xe0\xfb\x33\xf3\x07\x8d\x08\xc5\xd6\xd7\x20\x82\x3e\xea\x54\xde\x28\x5a\x8b\x49\xbb\xd7\x0f\x75\xc6\xb8\xc7\x31\x06\xac\xf2\x54\xa5\x71\x7b\x24\x75\x52\xd3\x0a\x45\xf6\x66\x1a\xd8\x9b\xe9\x17\xd9\x9b\x69\x8d\x57\x99\x7a\x7e\x63\xba\x87\x89\x98\xfa\xd5\x7f\xba\xbb\xfa\x4f\x9b\xab\xfe\xb4\xbe\xea\x4f\x77\x17\x70\x22\x90\x6f\xe2\xe9\x97\xd7\xe4\xe9\xee\xda\xbb\x4b\xe0\x31\x3c\xad\xad\xbd\x08\xa3\x90\xc8\xc8\xc4\x84\xd6\x97\xe2\x69\x7d\x51\x9d\xd2\xf2\x19\xfa\xc6\xf4\xc1\x7a\x19\x28\xff\x29\x74\x74\xcd\x2e\x97\xa7\x1b\x73\x1b\x96\x02\x46\x76\x78\x3b\xa4\x86\x81\x34\x85\x95\xcf\x37\x60\x96\x68\xde\x7f\x4d\xb1\x73\x60\xb9\x10\xf2\xfc\xce\xb4\xd1\x69\x18\x23\x75\x53\x84\xeb\xac\x44\x8d\x82\x87\x19\x62\x9a\x9b\xac\xea\x60\x88\xd1\x17\x4c\x56\xfb\x80\xc9\x6a\xe9\x9b\xac\x91\x40\xe1\x32\xe8\x4b\xf8\x16\xc3\x3e\xee\x4c\x85\x35\x6f\xa6\x5c\xd5\xd3\x69\x0b\xd7\x65\x39\x70\x0e\xc2\x1d\x0f\xe4\x11\xf1\x9d\x45\x09\x69\x58\x0b\x04\x7d\x79\x15\xc2\xd6\x0b\xd5\x7d\x91\xd2\x55\x2e\x06\x5d\xa4\xb1\x69\xd4\xc6\x45\x6a\xc3\x94\x8a\x53\x5a\x8d\xf0\x85\x3e\x56\x8b\xd1\xe8\x6a\x17\x68\x22\xbb\xce\x31\x33\x05\x07\x25\xc3\xb8\xc1\x60\x38\xcc\x4b\x17\xae\xb9\x27\xff\x20\x3d\x2b\x41\x20\xa4\x8e\x80\x6f\x8e\x0f\x1b\x55\xf1\x12\xa8\x2b\xdb\xa6\xa1\x41\x30\x99\x4a\x60\xd8\x77\x29\x42\x71\x45\x21\x70\x8c\x47\x56\x8c\x78\xfb\x04\x8c\xbe\x27\x53\x2c\x8c\x7d\x20\x83\x2c\x74\xce\xd1\x9b\x9d\xd3\x5d\xb3\x4a\x73\x9a\x87\xfd\xe6\x74\xd4\x9b\x8d\x4c\xaa\x73\x63\x7d\xeb\xf3\x06\xf1\x61\x00\xb2\xf2\xbd\x59\x4f\x65\x89\xb9\xdf\xc2\x56\x64\x23\x61\x47\x1f\x4c\x99\x73\x04\x7e\x2d\xb0\xbd\xa3\xde\x6c\x8c\x82\x7b\x3a\x5d\x4f\xd1\x72\x4d\x57\xe6\x32\xe1\x4d\xcd\x9e\xbc\x79\x26\xb2\x37\xeb\x64\x59\xa2\x79\x7a\xa9\xa8\x18\xcb\xd6\x08\x3b\xc9\xd7\x42\xce\x59\x21\xaf\xa2\xcc\xa4\xbb\x9e\x7a\xa1\x3b\xe4\xa3\x7a\xb3\x9e\x77\x25\xe0\x97\xb3\x66\x21\x2a\xea\x6e\x4e\xfd\x54\xbf\x27\x68\xfa\xb0\x32\x68\x84\x3c\xa8\xc1\x40\xaf\xc7\xe5\xee\x56\xcb\xdc\x94\xec\xcb\xd7\x02\x76\x92\xa9\x42\x86\x3a\xbd\x56\x71\x6f\x5a\x23\x8d\x54\xac\xe5\x03\x1c\x3a\xc7\x7b\xb9\xcd\x59\x31\x1c\x8d\xae\xd0\x16\x1c\xa8\x98\x91\x53\x9d\x52\x9c\x91\x4c\xe5\x5a\xc5\xbc\x36\xf2\xbe\x65\xeb\xd5\x8f\xb1\x8d\x06\x71\x20\x73\x8a\xbb\x38\x9a\xd6\xd8\x36\xbf\x30\x08\x2a\x39\x75\x1a\x2a\x91\xef\xfb\x42\x3d\x88\x53\x05\xc6\xfe\x86\x7b\xc9\xc3\x50\xf8\x26\x17\xbc\x46\x6d\x1d\xfd\xaf\xd4\x6c\xa5\xbd\x2e\xb2\x97\xad\x76\xaa\x44\x5b\x7d\x14\xed\x24\x17\xed\x35\xfc\x29\xd1\x4e\x94\x68\xa7\x26\x17\x6d\x34\x48\x0d\x20\x01\x74\xbb\x03\x38\x43\xc1\x18\x35\xd0\x2a\x44\xa7\x48\x80\x07\x1b\x4f\x22\xd1\x86\x9c\xc4\x1a\xf4\x51\x7a\x24\x8f\xec\x91\xfb\xf2\xe1\x3f\xcd\x1c\x78\x18\xbe\xd7\xf1\x75\x75\x64\xfb\xa6\x40\x29\xf7\x18\x38\x50\x65\xc5\xf9\x7b\xba\x71\x3a\x47\xeb\x0a\xe7\x64\x22\xe2\xfc\xad\x95\xd9\x06\x06\xea\x3b\xe9\x36\x23\x99\x55\x9e\x3b\xee\x73\xd6\x7c\x26\x79\x7a\x56\x31\xae\x94\x87\x50\xb5\x99\xb9\x91\x0e\x0a\x00\x9c\x42\x58\x4f\x47\x39\x41\x38\x45\x0e\x52\xa2\x0c\x52\xc2\x7f\x9e\x8e\xcf\x28\x36\x40\x3c\xf3\xf9\x05\x92\xce\x13\x75\x00\xf1\x0d\x3a\xbe\xc1\xdf\x89\xb9\x15\xbf\x8c\x86\x7c\x51\xd5\xd4\x43\x4e\xeb\x7e\xd5\x30\x93\x5e\x80\x01\x0b\xe1\x8b\xc5\xb2\x0b\x5e\x56\xa1\x92\x4f\xc0\x3b\x76\x3a\xc2\xc6\x92\xd1\x79\x34\x09\x9d\xae\xb2\x70\x12\x5d\xc9\x27\xb0\x00\x3e\x69\x4a\xf1\xbd\x3e\x9f\x8a\xef\xd5\xe0\x46\x35\x06\x34\xfe\x54\xc9\x14\xd4\xae\xf0\xb1\xbe\x49\x73\x3a\x48\xdf\xa2\x9c\x01\xab\xc8\x91\xc2\xb7\x57\x26\xde\x51\xfc\x66\xe1\x09\xbc\x1a\xdc\xa7\xe1\x8d\x85\x71\x0f\x45\x9a\xb9\x8a\x6a\x17\x81\xe7\x2f\x7a\xe2\xfc\x87\x1e\xfa\x4c\x5b\x9c\x0f\xc4\xf9\x40\xfc\xe7\xa2\x33\x99\xf5\x27\x80\xcd\x3a\x17\x62\xf6\x61\xbc\x38\x1f\xb4\x8e\x50\x4c\xf1\x65\xcb\xa9\xbc\xc8\x44\x6c\xe5\x6d\xfd\xf4\x3d\xd6\x2e\x4b\xe4\xfd\x3b\x85\x46\x10\x18\xfb\x80\x62\x5a\x5b\x53\x38\xf5\x2b\x3d\x7e\xa1\xc7\x39\xac\x58\x90\x2b\x26\x66\x1c\x87\x5d\x92\x74\x81\x9d\xcb\xf4\x9d\x4a\x9c\x58\x99\x08\xad\x35\xac\xac\xdc\x2a\x3c\xa4\x20\x70\x02\x2d\xbd\xa1\xcf\x91\x38\x18\x99\x99\x45\xa1\xfe\xd0\xc9\xf1\x3e\xa2\x67\xe5\x2d\x07\x4c\x37\x32\x23\x0f\x59\x04\x9d\xd3\x43\xda\x48\xa8\x24\xd1\x99\x23\x15\x0a\xb2\x8f\x2b\x3e\x16\x28\xf0\x1d\xe5\x22\xb7\x5a\xa6\xeb\x44\x89\xa5\xfa\x53\x2b\xcb\x0f\xb2\x24\xc2\x08\x1d\x05\x12\x3c\x93\xe9\x9a\xcc\x55\xd8\x1b\x45\xbf\x1c\x17\x61\x8a\x8a\x60\x23\xe6\x0c\x4a\x83\x2e\xc8\x1d\x66\x0b\x7f\x91\xb7\xc5\x72\x00\xeb\x69\x0a\x56\x9c\xa4\x8c\xd3\xe7\xde\x2b\x9b\xab\x3b\x4a\x83\x61\x95\xc6\x3e\xba\x4a\xb9\xb0\x50\x1c\x58\x22\x23\x8e\x73\xc3\x51\xa9\xe0\xf8\x9d\xd4\x4c\xc9\x52\x05\x94\x1a\x29\x6c\xb9\xc2\xe5\xd6\x5c\xab\x2e\x3a\xe2\x05\xe8\x67\xa3\x53\x06\x3f\x50\x23\x60\x13\x76\x13\x8d\xb2\xdb\x88\xf4\x2c\x09\x15\x22\x32\x32\x37\x1e\xf4\xad\x8f\xc8\x84\x04\xba\x19\xfb\xb0\x51\x2a\x81\x2e\xe0\xe3\x54\xe0\xb5\xba\x0f\x71\xaf\xd5\x3d\xf0\x60\x31\x0b\xee\xe0\x6f\x92\x0a\x27\x6f\x14\xfe\x9c\x5a\x74\xdd\x20\xef\xc5\x06\x6a\x20\x78\x8e\x48\x8b\x5c\x89\xad\x49\xa1\x40\x2a\x32\x69\x2c\xee\x15\xcc\x15\x32\xba\x5e\xb3\x6e\x4f\xa2\xfc\x0c\x88\xc5\x5f\xe9\x24\x11\xa9\x39\xa5\xc7\x14\x0b\xcc\xe5\x16\x32\xc9\x36\x52\x2c\x93\x02\x46\x7c\x68\x3a\x9a\x3d\xd7\x56\xa9\x54\x6c\x0a\x25\x12\x65\x33\x32\xea\x64\x55\x2c\x1c\xec\xc2\xa4\x57\xbf\x52\xc9\x2f\xf4\xf8\x95\x1e\xff\x23\x5c\x04\x2f\xfe\xc2\xcf\x5f\xf9\xf9\x3f\x42\x6e\x97\x38\x95\x28\xf4\xfb\x01\xc5\xdc\xe8\x54\x53\x5f\xc9\x54\x54\x24\x12\x45\x24\xb8\x9b\xd3\x5c\x4f\x9d\x46\x6e\x95\x95\x22\xa2\x07\x74\x0b\x82\x56\xb6\x70\x79\xb1\x15\xc6\xe6\x1b\x83\x52\x88\x19\x99\xe1\xa0\xda\xe4\x58\x08\x9f\x57\xea\x42\x51\x61\x1d\xda\xf5\xf6\x23\x30\x35\x5d\x22\xe1\x3d\x1d\xaa\x7c\xa4\x66\x08\x8f\xcc\x64\x68\xf9\x05\xdd\x21\x13\x64\x15\x4c\x1b\x0f\x5c\x46\x85\x85\x87\xa6\xf1\x0d\xaa\xa1\xa0\x91\x0d\x51\xa4\x1c\xb9\x48\x81\x8c\x6a\x26\xac\x92\x0b\xbf\x1d\x68\x95\x95\x48\x57\x91\x48\x57\x99\x48\x57\xce\xbb\x74\x80\xdf\x99\xb9\x56\xa9\x23\x8f\x0e\x75\xf7\x32\x5d\x56\x09\x8a\x24\xaa\x6d\x49\x74\x9e\xc9\xca\x19\x30\xe9\xaa\x94\xdc\x65\xb3\x33\x76\x57\x2c\x9d\xca\x51\x86\x82\x35\xd7\xe8\xe1\x47\x34\xd5\x78\x0a\x2c\x39\xc1\xbc\xfe\xc2\x2c\xf3\x06\x75\x71\x01\xaa\xd6\x41\x8f\xf1\x22\x88\x93\x11\x5b\xac\x03\x98\x96\x3f\x80\x60\xe5\x23\xfb\x32\xd2\x3a\x05\x18\xf4\x6d\x0a\x27\xdd\x87\x53\x93\xc4\x68\x89\x01\x10\xca\x02\x36\xfa\x04\x6a\x19\x01\x52\x52\xa1\x7c\xf1\xbe\x81\x10\xdc\x20\xe0\xe4\x00\x91\xe1\x41\x51\xf1\xb0\x8b\x02\xe1\x6b\x54\x00\x80\xaa\x02\x78\x8c\x0b\x00\xe8\x34\xb7\x4a\x6e\x3d\x88\x65\x01\x18\xf3\x8c\x99\xe3\x6f\x67\x26\x1b\x91\xd2\x1e\xf6\x2c\x0f\x17\xc0\x8a\x20\x88\xd6\x20\x3c\x4c\xda\x7f\xf4\xf8\x85\x9f\xbf\xf2\xf3\x7f\x58\x2b\xd0\x6d\x94\xb4\xbf\xd0\xe3\xd7\x9a\xea\x20\x0f\x98\x21\xaf\x47\x56\xf1\x1d\x3e\x11\x12\xf8\x3d\x95\x49\x62\x56\x2b\x42\x1c\x3d\xa6\x7e\x30\xa5\x66\x48\x64\x36\xfb\x83\x2b\x02\xbd\xec\x32\xc3\x90\xc6\x71\x86\xbf\x38\x5b\x40\xab\xd1\xd8\x4b\xcd\x8c\x3c\x1f\xe3\x91\x34\xd1\x72\xa0\xe4\xea\x0e\xcf\x61\xf8\x89\xaf\x31\xfc\xc1\x02\x3b\x96\x40\x3f\x45\xad\x35\xbe\xf5\x5e\x2b\xea\x24\xe7\xb4\x20\x56\x4e\x9d\x98\x80\x6f\x84\xcf\x4f\x37\xc4\xfc\xd1\x13\xab\x93\x49\x8e\x1e\x51\x65\xf6\x11\xe2\x23\x80\x6a\x99\xf0\x13\xb0\x4e\xa2\xd7\xa4\xae\x39\x54\x32\x46\xcf\x26\xea\x2e\x0f\xb9\x45\xf3\x61\x00\xd0\x12\x8f\x6f\xb8\x48\xf1\xcb\x3d\x45\xb0\x5c\x3a\xef\xc8\x86\xd5\xfb\x51\xaf\x0e\x75\x25\x49\xa1\x12\x26\x47\x14\xa1\xdf\xca\x35\xfb\x14\x23\x97\x48\xa8\x0b\x99\x99\x5b\xef\xcc\xe6\x23\x69\x59\xee\xf7\x95\x53\x73\x93\xe3\x5d\xe1\x40\x14\x88\x91\x1a\x8d\xae\x41\xb4\xf3\xe3\x15\x61\xf4\x14\xeb\x25\xd2\xf1\xf1\x56\x16\xce\x69\x99\x32\x0a\x11\xf6\xe9\x3b\x92\x00\x2f\x09\xeb\xfe\x85\xea\x03\x89\x85\xef\xf7\xf6\x87\xc9\x9e\x8d\x82\x3e\x79\xa7\xfd\x3f\x8b\xab\xcb\xf9\x3c\xf6\xf0\xd3\xf9\xfc\x59\x1d\x47\x2f\x19\xde\xdf\xa0\xaa\x9c\x74\x1c\xa4\x91\x4c\x12\x57\xe6\x26\xcf\xf5\x56\x95\x51\xb1\x85\xe7\x61\xe5\xcc\xb5\x45\x31\x84\x8f\xf1\xfc\x7b\xc1\x91\x00\x84\x2c\x92\x4b\x43\x56\x48\x59\xd6\x15\x8d\xe0\xc5\x86\x34\x15\x14\x98\x63\xd6\x6b\x62\x57\xde\xab\x26\xc6\xf8\x6d\xc3\x9b\xf3\xd7\x0d\x4b\x64\xd6\x24\x66\xfd\x05\x21\x27\xf2\xe4\xcd\x32\xa8\xde\x64\x48\x5d\xda\xc9\x5b\x00\x60\xe1\x18\x92\x23\x6b\x58\x18\x21\x39\x99\x45\x33\x0d\xef\xd9\x83\xcc\xb3\xd7\x24\xbe\x58\x71\x1c\xa9\x97\x44\xbd\xaa\xec\x53\x7b\x0f\xdd\x47\xcb\x97\xc7\xdf\xb1\x1c\x0e\xd5\xd1\xf9\x87\x51\x25\x94\x54\x93\x6e\xfd\x1d\x63\xfd\x5e\x77\x2d\xea\x1e\x44\xfa\x7e\xfe\xf8\x60\x3e\x9f\x3f\x2e\x9f\xd5\x6c\xe4\x36\xc3\xe7\x73\x47\x06\x72\x97\x2f\x8f\x5f\xb6\x8f\x3f\x1f\xc5\xaf\x2e\xc9\xca\x6d\xc4\xfa\x55\xdc\x53\xa9\x8f\x42\x2b\x99\x23\x87\x66\xb8\xa0\xf9\xbc\xd9\x77\xf9\x2c\x7a\x15\x1f\x69\xfc\xfd\x14\xbd\x8c\x83\x53\x4f\x12\x6f\xfa\xf6\xf8\x2b\x6e\x2c\x51\xa9\x65\x59\xac\xfe\x72\x4b\xea\xb7\x2f\xbc\x77\xf2\xde\xfd\xbc\xf5\xa4\x60\x3c\x89\x64\x20\x55\x4c\xda\x2d\x8d\x8d\xc5\xce\x36\xb4\xe1\x11\xa3\xf2\x7f\xe1\x08\x73\xec\x0d\x43\xdf\xa9\xf8\x87\x17\xf4\x84\x50\x4f\x70\x9e\x82\x96\x32\xd8\xd0\x05\x1a\xcc\xf8\x92\x39\x0b\x6c\x2c\xaa\xc9\x4a\x60\x6f\x57\xf8\x70\xcb\x07\xf1\xb8\x05\x74\x74\x54\x86\x22\xc0\xf3\x4f\xc7\x0d\x7f\xcf\x4d\x17\xaf\xc1\x39\x6d\xe5\xdc\xe7\x33\x8a\xfe\xef\x11\x53\xb4\x59\x14\x3c\x77\xb2\x11\x17\xa0\xf1\x0e\xd4\x91\x11\xe4\xe3\xdf\xe6\xee\xc9\x25\x4d\x20\xd4\x63\xdd\x93\x57\xc7\x7f\xe5\x48\x3d\x2b\xb2\x4c\xfd\x85\x73\x65\x60\x92\x1a\x0e\xcb\xbd\xa8\xac\x5e\xf1\xee\x9c\x6d\xae\xb0\x4b\x64\x2a\x27\xac\x9e\x31\xea\x05\x60\x9d\xa2\x91\x00\xef\x5b\x19\x15\xa5\x1c\x79\x97\x74\xe2\x5b\x6e\x8b\x86\xd6\x88\x96\x4e\xc8\x22\xd6\xc0\xd5\xac\x8c\x85\x05\x6e\x9d\xa0\x3d\xc9\x14\x38\xcc\xe0\xa8\xd8\x4b\x43\xa0\xbc\xab\xb9\x4d\x91\xaf\x41\x65\x6c\x52\x96\xda\xe2\xd6\x46\x6e\x55\xf9\x3d\x30\x9a\xe9\x5a\xa1\x92\x38\xae\x7a\x64\x3a\x50\xa7\x51\x22\x12\xe0\x16\x61\x4d\x5c\xa0\x47\x49\x93\x26\xf7\x7a\x05\x7b\x68\x92\x2f\xe0\x9a\x16\xd6\x18\x32\xf3\x87\x8e\xef\x50\x34\x77\x11\xa1\x85\x65\x95\x2e\x58\x4c\x59\xe8\x2c\x80\x56\xc9\x04\x6d\xb1\x45\x7c\xb0\xad\xd2\x1b\x6d\x4d\x8a\xda\x55\xb4\x3f\x31\xb5\x8d\x09\xfe\xc2\xd6\x05\xed\x64\xa1\x10\xaf\x05\x16\xe0\x56\xa9\x6b\x20\xd3\xfa\xed\x44\x74\x1b\x0b\xb3\x26\xf1\x45\x61\xd5\xca\x2a\xb7\xf1\x4f\xb2\x31\x68\xb5\x02\xee\xf7\x7e\xe1\xf0\x46\xa1\x40\x95\x2c\xd8\xeb\x14\x99\xc0\x0c\xbb\x82\x44\xef\x70\x03\x0a\x2b\x82\xaf\x0d\x72\x39\x9a\xe8\xf4\xda\x09\x5c\x2a\x44\x56\xd8\x35\x8a\x2c\x17\xd6\x85\x27\xe9\x50\x79\x97\x6a\x80\xa7\xc5\xdd\x82\xde\x5e\x78\x1d\x28\xa0\x93\x3b\x19\x00\x0c\xf1\xae\x28\xcf\xec\x54\x82\xfa\x66\xa4\xb5\x04\x0f\x6d\x23\xb5\xc8\x94\xc5\x4d\x8e\x81\x65\x1c\x83\x58\x57\xda\x7b\xab\x5c\x44\xdb\xb8\x42\xf8\xf3\x58\x9b\xa8\x51\x17\x17\xb0\xa3\x0f\xf6\xca\xb6\x28\x83\xe2\x36\x3a\x13\xb2\xc8\x37\x75\x1c\x4b\xcb\x3e\xf7\x75\x2c\xb4\x5c\x60\xeb\x7b\xbb\x3e\x14\xd1\x7b\xe5\xdc\x18\x97\x2f\x58\x8e\x52\x40\x62\xf0\x2d\x15\xa3\xfb\xc2\xeb\xc5\x4d\x02\x5c\x47\x4d\xce\x54\xc4\x0a\x66\x81\x45\x61\x13\x11\x17\x59\x82\x7b\xf2\x48\x66\xae\x48\x68\xfb\xa7\xf2\x8d\xb2\xde\x9e\x0e\x1d\x2a\x61\x2a\xd0\xee\xbc\xb3\x8d\x74\x26\x13\x47\x5f\x5a\x60\xb7\xc3\x12\xb0\xa8\x33\x80\x58\x8f\xd2\x5f\xf4\x41\x75\x90\x06\xdf\xc2\xac\x16\xa9\xd8\x2a\x48\x5f\xbb\xad\x23\x2d\x30\x47\xb7\x22\x0b\x1a\x22\xec\xad\x88\x26\x63\xc7\xf9\x15\x2b\x87\x69\x2a\xbc\x00\x10\x1b\xe9\x2c\xdd\x6c\xd6\x6c\x6e\x66\xc0\x55\x12\x7b\x07\x0f\xe8\x26\x42\xe6\x68\x9c\x17\x6f\x6c\x38\x25\xff\x28\xb6\x19\x29\x58\x5a\xb5\x35\x45\x9a\x3b\x74\xa1\xa6\xec\x02\x46\x6b\xe1\xa0\xa2\x69\xf6\xbc\xd5\xf9\x06\x47\x1e\x5a\x08\x45\x9d\x3a\x2a\xba\x57\x56\x47\x2c\x8a\xad\xd9\x0a\x2f\x51\x0b\xfd\x77\xa5\xd7\x64\x16\x73\x95\xc8\x1b\x43\xb6\x3e\x65\x92\x2c\x7c\xc9\xfc\xa1\x9b\x3f\x4c\x5b\x84\x59\xa7\xa6\x7c\xee\x32\x93\x3a\xb5\xf2\x56\x1b\x0b\xc7\x96\x35\xeb\x09\xdd\xa8\x34\x0e\xce\xe1\x17\xd4\x2f\xa8\xc2\x99\x54\xe8\xd8\xef\x6e\x23\xba\x27\x64\xa5\xcd\x8d\x74\x9c\xea\xd6\x5f\x8b\x65\x32\x47\x0b\x8f\xbe\x7e\x6d\x91\xe2\x5d\x8d\x77\x0e\x6b\xb2\x7a\x77\x2e\xf2\x0d\x3a\x16\xd6\xf6\x1e\xfb\x2a\x4e\x6c\x4e\x6c\xcc\x56\xb1\x15\x06\xcc\xe1\xb5\xba\xaf\x77\x70\x74\xef\xbb\xc5\x33\x7b\x8c\x59\x0b\xaa\x79\xcf\x25\x60\xb1\x95\x77\x0b\x68\x86\x8a\xa0\x53\x22\x10\x27\x5b\x4f\x98\x49\xce\x9b\x12\xa1\xf9\x61\xe1\xdc\x66\x81\xdf\x84\x71\x5e\x8f\x0f\x38\x6c\x09\x13\x98\x39\x55\x92\x08\xac\x29\xe9\x14\x0c\x8f\xc8\xb8\x9c\x86\x0e\x77\xbb\x98\x9f\x5c\x48\xef\x66\x79\x25\x75\x62\x6e\x94\x65\x53\x12\xeb\x6c\x4d\xd3\xee\x3a\x5b\x5f\xab\x7b\xb1\xc9\xf3\x6c\x11\x49\x32\x37\x51\xf3\x92\x0c\xcf\xec\x7a\xed\x50\xaf\x55\x26\xfa\x86\x1c\x70\xc6\x32\x97\x0b\xac\x51\xc2\xb1\x1b\x93\x71\x19\x54\x8f\xcb\xac\x36\x56\x57\x76\x21\x04\xe9\x64\xe2\xef\xa2\xaa\x3d\x44\xa1\x0f\xb0\xd7\xe0\xcc\x2c\x42\xc6\xdc\x0f\x0b\x5f\x20\x77\xad\xb3\x85\x5e\x2d\x8a\x54\xde\x48\x4d\x36\x2d\x9c\x4b\x22\x19\xe1\x59\xaf\x4b\xa2\x04\xb6\xab\x4d\x0c\x4a\xe5\x5c\x72\x43\x16\xf2\x71\xf0\xa8\xb8\x69\xf5\x2b\xda\x68\xc8\x1c\xac\x75\xb2\x58\x2b\xe9\xbc\x61\x99\xda\x48\x74\x62\x69\xa4\x8d\xb7\x32\x2d\x56\x12\xe3\x5a\xa2\x64\x74\x0b\x82\x39\x47\x82\x43\xff\xcd\xec\xa3\x32\x5a\xc1\xf7\xe3\x4d\x94\x2d\xd0\x9a\xb6\x75\x82\xd5\x9b\x55\xf4\x62\x81\x3f\x50\x6e\x94\xf1\x86\x74\x29\x0e\x9a\x22\x81\xae\x81\xa3\xc3\x89\x04\xb6\x58\xab\x8f\x71\x8a\xdd\x76\x23\x6d\x7c\x2b\xad\xd2\x4e\x06\x18\x4f\xbe\x70\x7a\xc5\x8c\xe8\xb8\xfc\xbe\xd2\xac\x81\xa9\xb6\x5a\x53\x19\x5a\x54\xe0\x8f\x35\x10\xc8\x98\x8c\x77\xce\x19\x26\x8e\x6b\x65\x53\xe5\x1f\x5b\xf9\x87\xcf\x28\x11\xd8\xa6\x04\x63\xff\x4d\x58\xe2\x96\xb0\x91\x8d\x4c\x4c\x56\x56\x19\xaf\x4f\xff\x4c\xd2\xb1\x87\x7c\x4a\x89\x5b\x6e\xe5\x1f\xbb\x14\x9d\x1a\xbb\x43\xf3\xe0\x56\x46\xbe\x06\x2a\x70\x01\xb0\x71\x77\x0b\xf4\xb1\xee\xf3\xc5\xb4\x7a\xbb\x36\x49\xfb\x23\x02\xd5\xa4\x8b\xad\xfc\x03\x2d\xde\xed\x79\x61\x81\x19\x14\x8d\x2e\xb4\x55\x5b\x63\xef\x57\x56\x29\x06\xf1\x00\x3e\x55\xa4\xfa\xbd\xa5\xe7\x02\x08\xb7\xc6\x5e\x2f\xd8\x70\x0b\xcc\xbb\xb4\xa0\xee\xe0\x5b\xf9\x87\x2f\xf1\x4e\x48\x20\xbb\x95\xdc\xea\xe4\xbe\xf2\x01\xee\xd8\xf7\xf7\xe6\xde\xe9\x48\x26\x7c\x57\x66\x2c\xb9\x57\x0e\xa8\x78\x18\x10\xea\x27\x73\x82\xf8\x5f\x5f\x35\xb6\x58\xde\x3b\x9d\xab\x58\x5b\x84\x3d\x9d\xd9\x9b\xc0\xe6\xd0\xc2\xb3\x40\xde\x72\x87\xc6\x0a\xdf\x81\x4a\xc6\xab\x77\xa2\xee\x23\xaa\x14\x59\x92\x38\x10\x28\xa5\x2a\x0b\xb5\xe1\xe9\xb2\x85\x70\x6e\x13\x3b\x49\xd3\xc4\x46\x45\x15\x6c\x19\xba\x95\x99\x4a\x23\x7b\x9f\xa1\x89\x96\x5b\x99\x61\x73\x01\x80\x8d\x05\xac\xdd\x9f\x26\xad\x4c\xc7\x7c\x2c\x94\x8e\x45\x91\x21\xcf\x47\x8f\x45\x2c\xef\x9d\x87\x81\x5d\x0d\x08\x1d\xa1\x3b\xf2\x64\xec\x87\x1a\x31\x42\x77\xb0\x52\x6e\xf1\x0a\xf4\xcf\x95\x5b\xf8\x02\xc0\xb7\x52\xa9\xf0\xe9\xc4\x9f\x99\x31\x89\x0f\x43\x0d\x34\x54\x29\xf5\xe6\x36\xe4\xab\xd6\x41\xb0\xe4\x57\xbe\x7c\x79\x78\x30\x9f\xdf\xc2\xf3\x49\x6b\xc7\x9e\x1b\x69\x6b\xc9\xca\x96\xdb\xae\x1d\x8c\xd6\x53\xf9\x60\x47\xee\xb5\x49\xd3\xab\xfd\x4a\x46\x41\xa9\x69\xaf\xda\x75\x46\x1e\x45\xed\x51\x7a\x64\x48\x07\x92\xb7\x83\x78\xea\x81\xae\x54\xf5\xcb\xe3\x57\xc7\x64\xbc\xc4\x7a\x45\x49\xf2\xfb\x13\xb6\x86\x7b\xbd\x8d\x0e\x26\x47\x2a\x8d\xdd\x39\xaa\x30\xd3\xc6\xed\x28\x28\xc0\xcd\xe7\xee\x29\x9a\x7d\xf3\x4e\x4b\x8f\xe7\xd3\x2a\x9d\x9a\x86\x2b\x44\xf7\xfa\xb0\x5e\x1b\x16\xad\xa9\xb0\x69\x13\x73\x64\x9b\xa7\x4f\x4f\xe7\xb0\x59\x7c\x5d\x73\x87\xca\xda\x70\x0d\x77\x74\x98\xec\x1e\x67\xaf\x07\xf3\xf9\xf2\x7b\xb6\x48\x78\x58\x22\x76\x57\x59\x0a\x09\xc4\xcb\xe7\x35\x9b\x84\xc1\x18\xe1\xd3\xc3\x93\xc3\xf2\xf2\xfb\xc5\x55\xe5\xce\x35\xbd\xf2\x9e\x52\xbf\xbc\x6b\xb5\x0a\xad\x30\x7c\xd1\xc2\x48\x4d\x4b\xf1\xe0\xff\x3a\x3c\xd9\x35\xe3\xfa\xe0\xe0\x68\xf9\xb2\xf5\x4d\xa8\x8e\xf9\xfc\xf6\xc9\x7c\xfe\xed\x49\x6b\x5f\xeb\x2f\x5b\x47\xad\x6c\xa9\xd1\xb0\x5e\xab\x93\xc6\xa2\xe3\x04\xca\xbc\xa1\x23\x1f\x94\xe8\x59\x2b\x2b\xba\x30\x6b\x75\x59\xf1\xb9\x81\x78\x31\x54\x8f\xf7\x93\x5a\x60\x3f\x8d\x07\xab\x3a\x46\xb2\x7f\x15\x05\xa5\x84\x3c\x56\x8b\x1a\xe2\xf1\x2d\x30\x4a\x51\xc0\x8f\xb7\xd5\xde\x4f\xe3\x3a\x4a\xa2\x47\xf8\x4b\x6e\x1a\x7c\xb6\x7a\xd8\x4d\x45\x4f\x6f\xbd\x8c\x50\x67\x3a\xf2\x60\x28\x1e\xe3\x7d\x6f\x7b\x1c\x0b\x01\x3f\x83\x15\x0b\x10\xc1\x5b\x04\x85\x97\x08\xad\xde\x49\x63\xf8\xeb\xa7\xc5\x56\x05\x85\x95\x18\x13\x88\x49\x46\x04\xa1\xa0\x68\xdb\x4f\xe3\x11\xba\x84\xed\xa7\xf1\x79\x30\x97\x54\xd5\x51\x1f\x5d\x12\x14\xc4\x11\xd5\x91\x8b\x94\xd3\xfe\xa0\xf3\x8d\x68\x7c\x8f\x35\xa1\x4f\xe5\xb5\x62\x67\x21\xa7\xc6\x0a\x72\xc0\x43\xaa\x27\xf8\xbc\x51\x56\xbc\x25\x53\x09\x6f\x8d\x2b\x96\xe4\xb2\x66\xb0\x62\x59\x16\x7e\x74\xc5\x80\x58\xce\x37\xb4\x0f\x60\x0c\x85\xd8\x18\x46\x59\xb8\xaa\x48\x54\x9e\x33\x75\x8b\xd2\x0f\x67\x26\x17\x63\x2b\x42\xd9\x38\x47\xe7\xe1\x26\x1f\x20\x83\xf3\x75\x80\xba\x28\x74\x2c\x26\x0a\x1a\x6c\x42\xea\xc4\x17\xa8\x96\xe7\x55\x8b\x38\x15\xae\xa4\x29\xed\xfc\xa6\x24\x1a\x31\xcd\x51\xdc\xd0\x57\xda\x4e\x8d\x4d\x6f\x65\x26\x66\x46\x7c\x50\x69\x2c\x3e\xa0\xb8\x04\xd6\xe0\x2f\xf5\x82\xfd\x52\xcf\x71\x05\x75\x6b\x60\x6f\x38\xac\x30\x40\x7a\x6c\xf1\x80\x9f\xdd\x00\xd4\x02\x7b\xc3\x61\x2b\xd8\x61\xfa\xba\x87\x02\xb2\x98\x1a\xbe\x50\x72\x0a\x87\x07\xdd\x12\x52\x2c\x7b\xc3\x21\xb9\x96\xa6\x19\xfa\xc0\x2b\xc7\x37\x3d\x37\xd7\x2d\x22\xfd\x55\x62\xd5\x39\xfc\x8e\x12\xf3\x33\x98\x3b\x6a\xda\xf4\x5f\xb5\x5a\x72\x9f\x6f\x20\xff\x7f\x75\xf6\x86\x6e\x97\xb5\xf3\xb6\x3a\x24\x99\xec\x5b\x91\x61\x21\x32\x4f\x87\xc6\x24\xc8\xd4\x60\x25\xbc\x82\x56\x7f\xa9\x7f\xf2\x1e\x1c\x3b\x82\xba\x53\x51\x5d\x56\x25\x35\x39\x29\x91\xf3\xd9\x02\x89\xa0\x90\x15\x89\xdc\xde\x7b\xbf\x08\x35\xe5\x79\x81\xa6\xcb\x56\x5e\xe3\x9d\x2c\x33\x92\x71\xb8\xd4\xa4\x78\xa8\x52\x3e\xff\x5e\x9c\x01\x77\x31\x43\x8b\xe3\xbb\xde\x8e\xfb\x28\x77\xa0\x1d\x74\xf9\x81\xb7\x42\x80\xb7\x2e\xbb\xa6\x58\x7e\x3b\x78\xfd\xfa\x75\x39\x7f\x06\xff\x1f\x8a\x9a\x2d\x96\xca\xbb\x5c\x73\x8d\xd3\x2f\x8f\xbf\x79\xa8\xad\x1c\x94\xcf\x79\xbd\x3f\x28\xca\xe5\xe1\x89\x3d\x79\xfc\x98\x57\x7e\x78\x92\x5f\xd5\x70\x19\x12\x22\xd5\x9d\xfd\x3e\x8c\xb4\xb2\xa5\x5d\x95\xab\xc3\x07\x49\x1d\xc9\xab\x9d\x28\x0f\x12\xaa\xa2\x14\xa5\x2d\x0b\x7b\xe8\xf9\x90\x66\x2e\x30\x28\xf8\x29\xae\x05\x2d\xcb\x65\xf5\x4e\x9d\xd6\x54\xcb\xae\xf2\xe8\x93\x8f\x5e\x5e\x3e\xcc\x5d\xcd\x11\xb2\xbc\x6a\xdc\xf2\x54\xca\xd9\x0f\xdd\x17\xab\x67\x6f\xce\x26\x4f\x5b\x97\xc9\xf0\x8f\x9f\xaf\x4e\x5a\xde\x9e\x78\x70\x4a\xfc\xf4\xb0\x11\xa4\x9e\x75\x1b\xd1\xaf\xf6\x98\xcf\xdd\x51\xbe\x67\x4b\x18\xc0\x70\x55\xce\xfc\xf0\x12\x83\x8c\xc3\x5d\xd5\x3d\xd8\x66\xf7\xad\xa3\xd6\xfa\x3e\x63\x27\xb6\xfa\xe5\xf1\xc1\x3f\xe7\xc7\x65\xfb\x75\x39\x3f\x39\x2c\x5f\xbd\x3e\xf6\xbc\x9b\x66\xb7\xb8\xa8\x51\xfd\xf0\xd0\x9d\x0c\x0e\xef\x35\x35\xd0\xfa\x7c\x05\xb9\x7b\x89\xe7\xfb\x97\xdf\x7e\x7a\xf5\xd3\x3c\x3d\xaa\x99\xc6\x40\xb6\xf0\xe5\x71\xfb\xb5\xb7\x0e\x70\xfd\xb2\x05\x83\xe1\xa1\x3d\xf8\xe3\xdf\x2e\xe7\xb9\xb8\x7a\xf2\xaf\x86\x36\xf7\x7c\x79\x80\xa3\x17\xbd\x40\x1e\xce\x0f\xbe\x62\x87\xf9\xe3\xd7\xa7\x8f\xa0\xa9\xcd\xc7\xa8\x31\x79\xf7\x5b\xde\xf3\x35\x32\xce\x21\xb5\x73\xf7\xef\x97\xe2\xf9\xb2\x3e\x50\x53\xb5\x26\x9b\x9c\x45\x12\xee\x4c\xac\x8a\x34\xec\xaa\x64\xc2\x37\xb9\x91\xd2\x09\x1e\x5f\xe9\x75\x5a\x6c\xd1\xce\xe7\xdd\x52\x5a\x71\x97\x98\x35\x9a\xec\x80\xd9\x09\x8d\x01\x45\x32\x45\xa3\x52\x74\xc2\x22\xb6\xdb\x42\x24\xee\xa3\xd0\xe9\x8d\xd8\xc6\x7f\x17\x09\xee\x3d\xd6\xf8\x4b\xdb\xb5\x15\x3a\xc5\x83\x62\xc5\xea\x46\x6c\xd1\x33\xd0\x8d\x88\x8c\x15\xde\xb0\x94\x85\x0f\xb8\x62\xeb\x44\x66\xc9\x02\x2f\x5a\xb5\x72\x28\xbe\xe3\xa0\xc4\xb9\x74\x02\xd7\x7b\x27\xe4\xcd\xda\xb1\x45\x60\x91\x59\x75\x23\x52\x58\xe9\xad\x4c\xaf\x83\x18\x88\x96\x2e\x12\x1a\x0d\xc1\x02\x84\xc0\xd6\x41\xb1\x28\x47\x5b\x79\xb3\x16\x5b\xc8\xcd\x1d\xbe\xb7\x45\x13\x64\x5b\x79\x27\xee\x30\x45\x96\x5c\x08\x46\x64\xe9\x1a\x82\x2c\x76\xac\x12\x9d\xd1\x7e\x0b\x76\x68\xb0\x48\xaf\x55\x4e\x06\xdd\xf1\xd4\x5a\x44\x45\xce\x97\xfc\x38\x09\x5b\xf9\xa7\xb7\xea\xe9\x7d\xb6\xe3\x1c\x4f\x56\x7a\xdd\x8d\xb8\x71\xc2\xa1\x8d\xcc\x5c\xa8\x14\x1f\x56\xc9\xf8\x7b\xfc\x7d\x2e\x36\x28\x41\xb2\x89\xd0\x63\xd8\x06\x4f\x5a\xdc\xfd\x56\x6c\x78\x27\x4c\x4d\x42\x1b\xf2\x04\x25\x65\x2c\xfe\xe2\x4f\x62\x6e\x95\x15\xb0\x3b\xb6\xc2\x39\x2b\x6e\xb4\xba\x25\x3b\x78\x0e\x61\x14\x76\x72\xe2\x0e\x7f\xf1\xdc\xef\x0e\xca\x04\xb8\xb8\x83\x8a\xbb\xc3\x9a\x5b\xd1\x31\xa4\xca\xa5\x48\xfe\x10\x12\xfe\x7d\x2f\xf4\x1f\x22\xfb\x43\x48\x67\x56\xa2\xf8\x43\xdc\xde\x8a\xdb\x3f\xc4\xed\x1f\xcf\xc5\x6a\x79\x2f\xee\xa8\xbe\x8a\x94\x9e\xea\x0f\x92\xec\x42\x2b\x64\x28\x95\x22\xc8\x22\x19\xfa\x5a\x8a\xdc\x0d\x09\xc8\x90\xfd\x26\x6f\x0c\x0e\x5a\xe8\x16\x7f\xa0\xdd\xea\xf6\x9d\x5d\xb1\x6d\x1d\x41\x03\xbc\x6c\xfd\x4e\xd7\x8a\xbf\xf3\x25\x23\x2c\x96\xbf\x43\xc7\x73\xb9\xdc\x66\x0c\x66\x32\x15\xbf\xc3\x38\xc1\x1e\xf9\x3b\xfd\x7a\xd9\xa5\xdf\x69\xef\x20\x7e\x47\xf9\xa5\xdf\x51\x94\xe9\x77\x92\xdc\xfc\x1d\xa5\x4a\x7f\xb7\x4a\x26\xe2\x77\xba\xda\xc1\x64\xc4\xef\x2c\x87\xf6\x3b\x8b\xa0\xfd\xbe\x2e\x74\xbc\x6f\x9b\x71\xdd\x3a\x6a\x5d\xc7\x4b\x9e\xc4\x92\x97\xc7\x07\xbf\x9f\x1c\xd6\x2f\xaf\xd1\xab\x7c\x5d\x44\xe1\xaf\xa5\x12\x3e\xee\x7a\x6e\xd8\x9d\x2d\x74\x8a\xb6\x76\x89\xb9\x30\xde\x64\x97\x67\x28\x20\x36\x1b\x4c\x09\x66\x20\x1a\x32\xb0\x15\x4b\xf1\x40\xe0\x15\xf9\x0b\x92\xb2\x6d\xdc\x24\x7e\x59\x88\x95\xfc\x0c\xf1\x7c\x95\x28\xcf\x90\x90\x0c\xaf\xf7\xcf\x84\x12\xb4\xb1\xdf\x5e\xa0\xe1\xe8\x8a\x15\x21\xd6\x67\xbf\x8b\x78\x9c\xce\xf0\x4a\x12\xdd\xd5\x9c\xc9\x33\x31\x48\x57\x3a\xd5\xf9\x7d\x7d\xf6\xc3\x5e\xa5\xdd\x29\x04\x28\xa1\x1d\xc4\xc3\xfe\x76\x4a\x42\xc7\x00\x0e\x52\x98\x56\x23\x13\xab\x8b\xc9\xa0\x82\x82\x4b\x56\x41\xbe\x81\x20\x30\x40\xb5\x40\x17\x49\x3c\x7e\x61\xc0\xeb\xb9\xfb\x3a\x7e\xc3\x9d\x8d\xf6\x7d\xfd\x1b\x99\x10\x84\x5b\x88\xd4\x63\x13\x99\xae\x15\x83\xde\x94\x2e\xa1\xd3\xdc\x64\x03\x7f\x47\x20\xa6\xf7\x69\x2e\xef\x28\x64\x76\x9f\x71\x9c\x8b\xc9\x80\x80\x33\x3a\x5d\x1a\xc1\x1e\x85\x74\xc3\x68\xc2\x27\x8f\x6f\x75\xc1\xef\x1f\x5e\xd4\x90\x1f\xff\x46\xc8\x20\xcd\x9f\xff\x18\x40\x1f\x65\x90\xe6\xff\x20\xe8\x42\x57\x11\x2e\x74\x15\x03\xe0\x5a\x94\x7f\x74\x13\xb9\xcd\x54\x4c\x14\xfc\x25\xe9\x76\xdc\xce\xa2\xae\xe6\xcf\xd3\x31\x34\x58\x9e\x08\x69\xd7\x05\x99\xb3\xf6\x37\xc2\x7c\x83\x17\xb1\x45\x82\x5b\xf2\xf2\x17\x9b\x08\xe3\x89\x29\x0d\xda\xa9\xca\xc5\x48\x66\xe2\x83\x92\xd7\x00\xc3\x13\x70\xd2\x31\x9d\xa8\x15\x2e\x97\x6f\xd4\x46\xde\xc0\x42\x85\x22\x03\x24\xdd\x19\x19\x63\x63\x9d\xe2\x1c\x8f\x3f\x34\x7f\xa8\xda\xae\x72\x65\xd2\x5c\xac\x95\x61\x3b\xf8\x6b\x65\xac\x8a\x72\x12\x22\x5e\x2b\x43\x02\xbe\x28\x56\x0f\x33\x36\x59\xb2\xff\xdb\xdd\xdf\xbc\xe5\xba\x20\x80\x0c\x4d\x4c\x86\xda\x51\x5e\x22\x22\x8f\x64\xc1\x47\x87\x4d\x84\xf7\xf9\x74\x83\xd7\x73\x2f\x62\x06\x7e\xf0\xc0\xdf\x60\xa3\xbc\xd5\x8e\x8c\x3c\xd5\xc4\x8d\x82\x6c\xcc\x7c\xfe\x6c\x71\xf5\xa4\xe5\xd9\xea\xa6\x59\xd8\xa5\x37\x90\x8d\x9b\x27\x2f\xb2\x50\xe7\xab\xd5\xcb\xd6\xc1\xcb\xf2\x55\xf9\x53\x79\x54\x1e\x1f\x97\x68\x01\xf8\xdb\xc3\xd6\x91\x25\x81\x06\xcf\x86\xef\x24\x0b\x8c\x82\x4c\xbe\x9a\xe8\x7c\x7e\x50\x7e\x2d\x61\x66\x47\xc3\x5d\xaf\x4f\x5a\xc7\xf3\xb9\x7b\xf2\xf2\x4b\x09\x5b\x4c\xe0\xf9\x67\xb6\x2c\x65\xf1\x54\x0d\xa2\x37\xf7\x82\x8d\x64\x2d\x6d\x1b\x31\x5a\x4d\x92\x29\x58\xa3\x0a\xc9\x7c\xf2\xb6\xd7\x28\xb1\x60\x48\x78\xf7\xb4\xd2\x7e\x3e\xdc\x77\xb0\xf8\x31\x67\xc3\x8d\xcf\xbd\xe9\xe3\x1d\x5e\x71\xee\x9e\x5c\x3e\x6e\x5d\x15\x4e\x89\x03\x52\x56\x28\xa5\xdb\x1e\x02\xed\xf8\xeb\x42\x59\xde\x41\xf6\x5e\x3b\x7a\xc1\x5e\x30\xef\x8f\x3f\x23\x9f\xda\x94\x7d\x6b\xf0\xfe\xc4\xf7\xb3\x9b\x12\x72\x3d\x72\xf5\xf4\xb0\xce\xf9\x7b\x1f\x25\x81\x8c\x5c\xbf\xaf\xb3\x4f\xe4\xb8\x48\x3d\x9b\x4c\x27\x4f\x5b\x25\xbc\x01\xab\x42\x49\x35\x52\xe2\x3a\x71\x38\x9f\x2f\x0f\xa1\x52\x51\x8e\x8d\xd7\x99\x6a\x05\x69\xed\x13\xcf\x9b\x90\xe5\xae\x7f\x92\x85\x60\xa8\xad\xc3\x9f\xe6\x57\x57\x28\xce\x75\xe4\xed\x3b\xfb\x4c\xe8\x23\xf9\x97\x56\xfc\x70\x6b\xd9\xe7\x8e\xf1\xb0\x1d\x8f\x79\x41\xfe\x76\x81\xe7\x9a\x01\x79\x72\xec\x0f\x29\xf6\x6f\x69\xea\x42\x71\xbb\xc5\xb8\x62\x1f\xe6\x97\xe5\x77\xc7\x0d\xd7\x4c\xec\x99\x09\x0f\x9d\xdd\x15\x6f\x73\xbf\xb0\xd2\xdb\xbd\xfe\x16\x0e\x78\xd4\x5f\x95\xf3\xf9\x33\x86\x9f\x5d\x1d\x56\x73\x01\xce\x04\x3f\xd5\xe5\x14\x71\x7f\xb4\x44\x0e\x24\xdf\xef\x48\x84\x44\x7f\xf8\x24\x01\x99\x69\x3a\x83\xf2\x06\xa4\x82\x6d\x3b\x5a\xe0\x89\xa5\xc0\x65\xdf\xde\x77\x91\x4d\xc0\xeb\x6e\x76\x1f\x1f\x66\x71\x6f\x22\x5e\xe6\x39\x70\xa9\xb1\xc2\x87\x97\x16\x51\xde\x00\x84\x53\xf9\x5b\x95\x2a\xab\x23\x04\x81\x65\xac\xe1\x5d\x3c\xd0\x78\xf6\xec\x59\x8d\x09\x38\xbb\x18\x0e\xc5\x59\x87\xdc\x9f\xb1\x27\x34\x71\x0a\xab\x3f\x72\x01\x67\x9d\x05\x70\xda\x6b\x65\x17\x78\x84\xd1\x59\xc0\xe4\xeb\x61\xe0\xf4\xf0\xb6\x33\x10\xd0\x3a\xd3\x1d\xa0\x30\xc3\xbe\xfc\xfe\xe1\xe9\x38\x39\xf2\xa9\x19\xce\xbe\x1c\xea\xab\x93\xea\xb4\xfb\xa1\x9d\xed\xf8\xe9\xc1\xc9\x4b\x74\xd0\xf3\x74\x3e\x6f\x43\xdc\xf8\xc9\xe1\xc9\xf0\xeb\xaf\xcc\xe7\xcf\x0e\x4e\x1e\xcd\xe7\xf1\xe1\xc1\xc9\x4b\x0d\x83\xe7\xe4\xeb\x1f\x20\x87\x39\x4f\xd0\x8e\xf8\x83\x8f\xe9\xaf\x66\xf0\xd9\x97\xf2\xd8\x78\xad\x3e\xed\x54\x09\x7d\xe1\x40\xe6\x4b\xee\xfd\xbf\x62\xec\xdd\xea\xe5\x17\x64\x10\x5b\x1d\x1b\x6d\xf4\x8d\x62\xb1\xf6\x8e\x55\x12\x65\xb6\x59\x39\xbc\x93\x6f\x0d\x4b\xf3\x07\x57\xc4\x15\xf4\x06\xcd\x98\x05\xb4\x9f\xc6\xe2\x8d\x74\xda\x09\x0a\x78\x93\x98\xe5\xf2\x5e\xbc\x41\xd1\xe4\x6e\xa2\xb3\x0c\xed\x59\x31\x70\x9e\xc8\x54\x09\x52\xf9\xc0\xdf\x29\x1a\x9c\x73\xa2\x8b\x02\x96\xa8\x2f\x8c\x42\x27\x5d\x83\xf1\x3c\x2b\xc1\x7a\xab\x48\x98\xde\xbb\x5a\x3c\x6b\x32\x76\x54\xdc\x2d\xec\x0d\xa4\xe4\xbd\xe6\xf4\x54\x96\x6f\xc6\xab\x53\xe4\x8b\x59\xc0\x99\x1e\xc8\x0e\x8a\x9e\x76\xd7\xf0\x53\x89\x48\x11\x72\x4f\x07\xef\xc0\xf1\xbd\x93\x69\x9c\xb0\xd9\x25\x94\x99\xeb\xdf\xe5\x0a\x77\xe6\xa4\x10\x2d\x50\x99\xa6\x83\xea\x21\x13\xe0\x6d\x88\x40\x35\x81\x20\x79\xa2\x4f\x61\x6c\x9d\xb3\x9f\x9f\xb7\xca\x6c\x15\xac\x4e\x9d\x2c\xb3\xe6\x4e\x6f\xbd\xe5\x1d\x24\xdf\xa3\xa3\x77\x2b\xde\xdd\x67\xca\x2e\x4d\x02\x3b\x87\x01\x1a\x44\xca\xef\xc5\x20\x49\x8a\x2d\xf1\x56\x28\x36\xcf\x7c\xae\x46\xaf\xe8\x55\x03\x8e\xe4\xb5\xea\x16\x4b\x75\x2a\x23\xd5\xaf\x09\xd4\x01\x7d\x28\xf3\xa1\x49\xd7\xbb\xe4\xe9\x46\x42\x1d\x02\x38\x63\x29\xfc\x91\xcc\x73\x25\x46\x06\x72\x47\x25\x22\x18\x8a\x74\x56\x9c\xe3\xdc\x44\xec\x38\x85\xb2\xa1\x8e\x34\x66\x28\x58\x0f\x18\x67\x32\x82\xdc\x7b\x53\x23\x56\x07\x77\x2b\xe7\xd2\x4a\x2e\x24\x25\x88\xbf\x23\xe5\x36\xe2\xbc\xa6\x76\x83\xd2\xfc\xa7\x24\xf2\x8f\xb0\xef\x36\x88\xa0\x21\x03\xf8\x10\xea\x8e\x39\x7e\x34\x6b\xdd\x53\x6b\x28\xb5\x86\x3f\xc8\x96\x89\xa8\xe9\xf2\xfc\xa7\x90\x69\x0e\xbc\xe4\x44\xc9\x98\x47\x8b\x98\xa8\x04\x45\xd1\xb8\x2f\x4d\xe8\x40\xa4\x5e\x9c\x09\x9d\x6d\x4c\x51\xfb\x62\x8a\xea\x49\xde\x8b\xf6\x06\x35\x05\xb0\xb9\x32\xc3\x62\x6e\x4c\x44\x65\xa1\xe9\xa6\x40\x97\xa1\x53\x1d\x2b\x27\xa6\xd7\xea\x56\x4c\x4d\xa2\x63\xaa\x5b\x04\xd1\x79\x3a\x8d\xcb\x69\xb1\x8c\xf5\x8d\x76\xda\xa4\x58\x59\x53\xf6\x5e\xc4\x6d\x57\x0d\x1c\x27\x66\xc6\x16\x4e\x54\x23\x26\x40\x94\x72\x40\x21\xf5\x80\x70\x4d\xce\x82\xca\xc8\xcc\xea\x2d\x0e\x30\xf1\xc1\xd8\x84\x73\x85\x60\x3f\x8d\x5b\x35\x0f\x92\xc1\x96\xf2\xd9\xa8\x79\x40\xfa\xa5\x59\xca\x2c\x0d\x3a\x25\xd8\xb7\x22\xf3\xd2\xdb\x5e\x5c\x5d\xfe\x36\x9f\xa7\x9f\xae\xf8\x7e\x77\x0f\x6f\x7b\x5c\xc5\x0d\xe2\xbd\x2f\x3d\x77\x12\x84\x89\xd1\x0c\x2f\xf3\x9d\x7b\xcc\x6e\x3e\xab\x27\xf2\xd0\x11\xc4\xf1\xfc\xc0\x8b\x67\xcf\x0f\xf1\xd0\xf1\x21\x93\xba\xb6\x32\xdb\xb4\x8e\x5a\x7e\x73\xef\xea\xc6\xc6\xfd\x96\x9b\x9d\xa5\xfc\x06\x6d\x96\x8b\xd6\xd3\x1c\x66\xf3\xcf\xe4\xc9\x04\x48\x2d\x3a\x83\xc5\xba\x64\x63\xc1\xbf\x01\xaf\x17\xa4\xf5\xcc\xaa\xf9\x16\xcb\xd0\x16\x69\x2e\x58\x6c\xb2\x12\xed\x6b\xa3\x14\x52\xc0\xd0\xb0\xbd\xa8\xa5\x84\xb7\xf1\x7c\x53\x1e\x4e\x92\x1b\x9f\xae\x3e\xd5\xc8\x16\x3f\xbf\xd8\xb0\x45\xae\xac\x71\x7b\x1b\xd6\x5b\xce\x0c\xe7\xaf\xec\x4b\x0d\xaf\x6c\x72\x43\x4e\x7d\x90\x45\x32\x69\x9b\xcc\x16\xf3\x39\x69\x6a\x72\xa1\x53\xe8\x03\x2d\xbe\xef\x21\x21\xd5\xa5\x52\x99\x88\x15\x4c\xdf\x59\x91\x8b\x44\xa5\xfe\x8c\x24\xd3\xd1\x35\xea\xd1\xe0\xf1\x94\x53\x78\x05\xd4\x94\x34\xce\x95\xdd\xa2\xce\x32\x7d\x4a\xa1\xb9\x17\xc5\x47\x67\x96\x8c\xdb\x88\xdc\x90\x69\xf7\xdc\xe0\x6e\x37\x87\x49\x2b\x37\x3a\xc3\x9f\x1f\x45\x6e\xd2\x02\xf2\xee\x72\x2b\x72\x03\xdf\x82\x4d\x63\x4b\xc6\xb1\xf7\x7d\xc4\x72\xa3\x31\x5d\x0d\x63\x4e\xd6\x2a\xf7\x06\xd6\xe9\xc8\x46\xc5\x9a\xf3\x67\x8b\x94\x5d\x34\x50\xa6\x74\xba\x32\x9e\x0d\x6c\x1d\xe9\x57\xf5\xe3\x9a\x7b\xe5\x44\x6a\xa0\x6e\x50\xc0\x0f\x1d\x45\x15\x49\xd2\x3a\x4a\x21\x9a\x5c\xad\x74\xd4\x46\xf3\x62\x1e\x59\xe3\xe4\x98\x1b\x2b\x56\xda\xaa\x5b\x99\x24\xc2\xb1\xd9\x0f\x2b\xa4\x94\x42\x46\xd8\x9b\x20\x35\x96\xaa\x6a\x93\x61\x7c\x96\xb6\x92\xa8\x03\x25\xad\x92\x95\xd3\x89\x36\x89\xc2\x89\xe5\x2a\x16\xcb\x75\x26\xd8\x7f\x33\xc9\xeb\x89\x08\x7d\x8a\x44\x66\xbb\x2d\x52\x58\x0d\x48\x1e\x48\x44\x95\x81\x14\x7f\x24\x41\x2e\xde\xf1\xe4\x8a\x15\xbb\x37\x51\xd6\xe6\x64\x10\xe6\x0f\xc5\xda\xa1\x99\xe8\x7b\x11\xa7\x4e\xa8\xf6\x16\xa6\x65\x94\x1d\x4e\x55\x30\x8c\x1a\x0a\xb8\xd2\x76\x7b\x2b\xad\x12\xeb\xcc\x09\x1c\xa6\x50\x3a\xef\x0c\x8c\xe4\xed\xc4\x46\xc9\x24\xdf\x88\x8d\xc9\x5d\x06\x3d\xcd\xaf\xbc\x7a\xbd\xcd\xda\x24\xe5\xa8\xd3\xc8\x6c\x49\x8e\x92\xc7\x50\xcd\xd6\x75\x26\x74\xe6\x54\x24\x74\x76\xf3\xa3\xd0\xf6\xa3\x48\x5e\xe4\x21\xbf\x49\x14\x8b\x24\x46\xa5\xae\x35\xfa\xfd\x92\x91\x0f\x02\xf0\x56\xa7\x4b\x73\x27\xb6\x74\x10\xb2\x95\x69\x81\x67\xb6\xe4\xd2\x6f\xab\xc4\x36\x4b\x9c\x48\x65\x2e\xd2\x58\xa4\x4a\xaf\x37\x4b\x18\x0f\x24\x42\x06\x6d\xaf\x44\x9a\x67\xc2\xb8\x6c\x85\x3f\xed\x9b\x1f\x84\xb9\xc9\x52\xff\x89\x0c\xe5\x55\x15\x40\x7a\x2b\x90\x2d\x63\x61\x2c\xf4\x18\x45\xf7\xa0\x59\x06\x7f\x46\xf9\xea\xce\xb2\x2a\xfb\x99\x55\x2b\xd4\x4e\x24\x9f\x1e\x99\x35\x99\x71\xe8\x1c\x01\xaa\x05\x5d\xa6\xa0\x8e\x59\xe1\x70\xcc\x20\x2b\x62\x75\x06\x7f\xb0\x73\x41\xef\x16\xf0\x8b\x57\x21\xb8\x38\x0a\x36\xb6\xe3\x54\x54\x58\x9d\xdf\xb7\x83\xec\x2c\x7f\x92\xf5\x45\xfc\xb3\x4d\xc7\x9a\x5e\x44\x19\xa5\xb4\x9d\x70\xdb\xa5\x70\x5b\x27\x5c\x4a\x17\x17\x2e\xdd\x66\xc2\xa5\xc6\xe0\xd9\xbc\x89\xae\x9d\x70\xae\x2a\x06\x9f\xe8\xe7\x38\x88\xad\x24\x87\x6c\x24\xe4\x95\xad\xad\x8c\xe1\x99\x66\xe8\xee\xa5\x4d\xf2\xc1\x16\x11\x47\x1e\x60\x48\x0e\x5d\x45\x56\xe5\xe2\xc6\x5a\xd8\x9a\xe5\xd1\x26\x36\x6b\x71\xab\x96\x6d\x19\xa1\x2d\x9b\x5b\x6d\x15\x6a\x72\x40\xfd\x51\x8d\x0a\x78\xef\x56\xa6\x22\x91\xf7\xca\xfe\xef\x76\xf0\xdd\x4c\x02\x7c\x0e\x8d\xcd\x0b\x34\x1d\x60\xbe\x6e\xa2\xba\xe9\x09\x88\x4d\xfc\x7f\x3a\x78\xf6\xe4\xe4\xf0\x33\x9b\x47\xde\x55\x8d\xaa\x5f\x5d\xe2\xf9\x86\xd9\x6b\x75\xba\x79\xad\x48\x8a\x58\x57\xfb\x54\xad\xc2\x95\xea\xc3\x05\xb0\x9a\xf7\x5b\x5b\x7d\x6d\x4d\xae\xaf\xc3\x12\x98\xbc\x3c\x7e\x79\x72\x39\xbf\xf5\x1e\x87\xfc\xae\x53\x07\xd7\x41\xf9\xd3\x96\x78\xd9\x7a\x9a\x3f\xf4\xd9\x2c\x5e\xb6\x0e\x29\xd0\xee\x0f\x44\x7d\x24\x6f\xfe\xf9\xb7\x9d\x1b\xc3\xe3\xf9\x13\x2a\xda\x93\xf9\x31\xd3\xbe\xfb\xae\x11\xe5\xb7\xc7\x4d\x74\xee\x9e\xcc\x8f\x29\xaf\xaf\x76\xd3\x3a\xde\x89\x7a\x39\xa7\x33\x95\xf9\xeb\xf9\x55\x30\x39\xcd\xb1\x5e\xfb\x58\xb4\xd8\x07\xef\x0b\xb4\xb4\x3e\xc7\xdd\xe2\xb3\x03\x74\x8e\xf6\x6d\x8b\x93\xc5\xe3\x8b\x67\xc7\xec\xe2\x37\x38\xd9\x72\x47\x09\x5f\x9d\x52\xbe\xe6\xaf\x0e\x2e\x7f\x9b\xbb\xf9\xa7\xf9\xe7\xf9\xe5\xfc\x6a\x7e\x30\x3f\xbc\x7a\x4a\xda\x79\x5f\x3b\xaf\x3b\xbe\xfc\xed\xd5\x95\xf7\x08\xf1\xca\xdf\xc1\x32\x57\xc4\xdf\x78\xe8\x88\x78\xf9\x57\xae\xb4\xf9\xb6\xbc\x75\xf9\x5b\xeb\xea\x49\xab\xac\xe7\x0c\x72\x55\x73\xcf\x5d\x6d\x96\x8f\xe7\x4f\xea\x5b\xff\xe3\xea\x88\xac\xf5\x54\x7e\xe9\x63\x07\x97\xf3\xb9\xbb\x3c\xb8\x2a\xaf\x0e\x77\xcf\x25\xf1\x6a\x42\xa7\x6d\x52\xdb\x84\xf4\x6f\xc9\x62\x78\x15\xba\x00\xee\x81\x4f\xe8\xc8\x2b\xee\xb3\xe3\xf2\xb8\x9c\xcf\xdd\xe1\xc1\x41\xeb\x69\xfa\xa5\xaf\xfe\x74\x02\x51\x9e\xd6\x94\x41\x8f\xe7\xcf\xe6\xcf\x8e\xbf\xba\x05\x77\xfb\x7d\x81\x37\x4e\x8a\xf0\xaa\x84\xce\xce\xe9\x64\x9b\xb5\xaa\xe9\x84\x9a\x8f\xbf\x9b\x6c\x52\x75\xb3\x54\xbb\x54\x22\x63\xe9\xe1\x8a\xa8\x21\x0b\xbf\x74\xac\x1e\xcc\xa6\x12\x70\xe1\xae\xeb\x0a\x87\x1d\x7f\xe8\x29\x22\x92\x49\x84\x4e\x97\x38\x3f\xa4\xad\xac\x92\x84\xf4\x86\xa3\x44\x6e\x33\x74\x26\x51\x39\x15\xad\x74\x8d\x63\xd8\x7c\x8b\x9e\xb2\xfa\x06\xef\x93\x0b\x47\x57\xbe\xb8\x5e\xf6\x0a\xd1\xbb\x69\x30\x60\xa8\xf5\x2c\x23\x15\xdc\x77\xe2\xda\x8d\x5c\x20\x5d\xa1\xaf\x68\xbf\xbd\xb2\xca\xa5\x2a\x81\x35\x18\x97\x66\xe0\xf3\xd6\xf9\x86\xd5\xd4\x69\xc9\x21\x95\x69\xb2\x83\x40\x0a\xd3\x5b\x13\x07\x65\x67\x28\x0a\xca\x62\xe7\x61\x47\x64\xb2\xcc\x38\x9d\x2b\xaf\x82\x99\x6d\x0c\xac\x8d\xf4\x46\x66\x6e\x89\x43\x5b\x89\x2c\x4f\xf0\x36\xcc\xeb\x51\x7b\x1d\x69\xbe\x0c\xb1\x6a\x85\x06\x77\xc8\x6d\x2c\xb2\x6b\xac\xa0\xad\x72\xac\x26\xa7\xf2\x3b\x0f\xdc\x7b\xe0\x4f\x02\x68\xfb\x1d\xfc\xed\x92\x65\x11\x72\x6d\xe9\xb5\xee\x3d\xb0\xb4\xf1\x0a\xed\x2f\xa4\x8a\x14\xbf\x31\x1a\x34\xe3\x8e\xfe\x3c\x66\x01\x56\x38\x25\xaa\xc2\xde\x54\x20\x65\x86\x72\x82\xd9\x40\x0f\xe5\xd5\x46\xae\x79\x78\x5c\x69\xcc\xb2\x7a\xf6\x5e\xbf\xfd\x0f\x25\x42\xbc\x63\xdd\xb8\x7e\xd2\x82\xed\x25\x6e\x4c\x52\x6c\xd9\x46\x80\x0d\xc2\x67\x2c\x0e\xac\xab\xb3\x0e\x0f\x42\xdf\x59\x4b\xe0\xef\xaa\xc8\x5f\x1e\x7b\x45\xa2\x5c\xc2\xe8\x5f\xdb\x87\x1a\x0c\x87\x8b\xf3\xfe\x64\x30\xee\x09\x84\xa7\xb3\xce\x64\xe6\xc1\xf1\xb9\x98\x4c\x17\xfd\xd3\xd3\x7e\x77\x36\x78\xdf\xe7\xc0\x1d\x12\x45\xfa\xf9\x62\x32\x98\x2e\xba\xe3\x5e\x1f\xb0\xf1\x79\x77\x4c\xc8\xe0\x6c\xd6\xeb\xf4\x7a\x9d\xd9\x6c\x32\x78\x73\x31\xeb\x97\x7f\xf7\xa4\xf7\xa3\xe9\x5b\xc6\xde\x0c\xc7\xdd\x7f\x8f\xcf\x9b\xd8\x59\x87\xf1\xee\x70\x3c\xf5\xef\x75\xc7\x17\x67\xb3\x3a\x3c\x9d\x75\x66\x17\x53\xf8\x94\xa7\x4e\xfa\x9d\x59\x7f\xd4\x99\xfe\xbb\x41\xe8\x75\x7e\x7d\x40\x3b\xed\x74\x67\xe3\xc9\x03\xf2\xbb\xce\x59\x6f\xd8\x4c\x6e\xfc\xbe\x3f\x99\x0c\x7a\x7b\x53\xf1\x61\x0f\x02\xaa\xac\x3d\x08\x9a\x8d\x2f\xa8\xd2\x99\xda\xeb\x0f\xfb\xa1\x6e\x7a\x83\x73\x78\x99\xb1\xfe\x2f\xe7\xe3\x89\x47\xde\xf6\x67\xfd\xc9\x64\x3c\xa9\x95\xd7\x93\x46\xfd\xe9\xb4\xf3\xd6\x53\x07\xd3\xfe\x7f\x2e\x3a\x43\xc6\x7e\x1e\x0f\xce\x18\x1c\x8e\x3b\xbd\x1a\xd8\xe9\xce\x2e\x3a\xc3\xee\xc5\xac\x46\xeb\x41\xde\x6b\xf8\xbb\xc1\xb4\x1e\x3c\x7c\x88\xee\xbe\x01\xb4\xfe\x59\x7f\xf2\xf6\xd7\x1d\xe2\x4e\x52\x93\xfe\x10\x9a\xa6\xfb\xae\x73\x76\xd6\x1f\xd6\x02\xa6\xe7\x75\x64\xd6\x79\x3b\x38\x7b\x5b\xa3\x5c\x8c\x47\x4d\x6c\x37\x03\x17\xe3\xd1\xce\xa7\xde\xf7\x27\xd3\xc1\xd8\xd7\xc2\xf8\xbc\xef\xc1\x49\xbf\xd3\x3b\x1d\x4c\x42\x64\xc0\xcf\xfa\xbf\x54\x68\xb7\xde\xe7\x26\xfd\x61\xbf\x13\x7a\xe3\xa4\x7f\x3e\xec\x74\x03\x36\x1e\x0e\x3b\xef\xdf\xd6\xb0\xf3\x7e\xc7\xb7\xfb\xb4\xdb\x19\x76\x26\xa1\x8f\x03\xea\xdf\x9b\xf6\x67\xbb\xc3\x63\xda\x9f\xf5\xa6\xb3\xf3\xce\x64\x36\xe8\x0e\xce\x3b\xe1\xf3\xd3\xfe\x6c\x3a\x9b\x54\x95\x31\xed\xcf\xde\x77\x86\x17\xfd\x1d\x94\x7a\x9e\x27\xbe\x1b\x9c\xce\x70\xe4\xce\x06\xa3\x10\x73\x34\x1e\xcf\xde\x79\xa4\xea\x60\xd3\xf3\xc1\xbf\xfb\xb5\xde\x37\xbd\x78\x33\xed\x7b\x64\x36\xbe\xa8\xa0\x66\x4d\xcc\xc6\x17\xf5\x8c\x5c\x9c\xf7\x78\x00\xf8\x5c\xf8\xd0\xe9\xac\xd7\x7f\x5f\xeb\xf3\xfd\x5f\x6a\x8d\xd4\xff\x85\xfa\x63\x83\xd4\xec\x9c\xfd\x5f\x76\xdb\x7a\x27\x85\xbd\x7d\x6a\xda\x79\x4f\x5f\x1a\xbd\xe7\x01\x40\x40\xa7\xdb\x9d\x35\x10\x9f\x78\x45\xe1\x7e\x44\x84\x66\x70\x23\x68\xb8\x8b\x34\xe3\xd6\x7b\xff\xa9\x38\x1d\x4f\x04\x74\x32\xd1\x1b\x9f\xf5\xc5\xb4\x3f\xec\x77\x67\xa2\x7f\xd6\x13\xdd\xce\x70\x28\x3a\x6f\xc6\x93\x99\xe8\x0e\xfb\x9d\x89\xe0\x72\x08\x9a\xab\x04\x24\x23\xce\x2e\x46\x6f\xfa\x13\xe1\x27\x1e\x31\xed\xcf\xc4\x87\x7e\xff\xdf\xa2\x37\x98\x52\x3f\x1a\x8c\xcf\xce\x60\xf6\xed\x0f\xa7\x7d\xf1\xe1\x5d\xff\x4c\xcc\xe0\x67\x3c\x7b\xd7\x9f\x7c\x18\x4c\xfb\x62\xd0\x3f\xbb\x18\x89\xee\x14\x9a\xa2\x3b\xbc\xe8\xf5\xc5\xb0\xdf\x79\xdf\x17\x90\xde\x44\x40\x75\x09\x6a\x1f\x71\x36\xc6\xa6\x13\xd3\x3e\x7a\xb2\x13\x1f\x3a\x93\x33\x8c\xb0\xa0\x46\x16\x3d\x98\x80\x46\x83\xb3\xce\xd9\x4c\x0c\x3b\x6f\xfa\x43\x31\xe9\xc3\x94\x25\x26\xfd\xf7\xfd\xb3\x8b\xbe\xe8\x77\xba\xef\xc4\xe0\x4c\x9c\x4e\xc6\x23\x31\x1b\xcf\x3a\x43\x28\xd5\xe4\x6d\x5f\xe0\x4c\x2f\x3a\x67\x3d\x31\x9e\x40\x66\xd0\x97\x9f\x98\x74\x66\x7d\x5a\x3c\x3a\x17\xbf\x0c\x86\x83\xce\xe4\xd7\x05\xcc\xa1\x67\x3d\x71\x31\x80\x36\x81\xf1\x28\x26\x53\x51\x5b\xbc\x16\x5c\x87\xef\xc6\x17\x93\x29\xd0\x16\xa3\xf1\xd9\xec\x1d\x36\xfe\x02\xe7\x47\x5a\xa9\xa6\xfd\xce\x74\x7c\xb6\x98\x76\xdf\xf5\x7b\x17\xc3\x3e\x3a\xc8\x13\x9c\x24\x57\x71\x67\x32\xe9\xfc\x7a\x71\x7e\xde\x9f\xbc\x19\x5f\x70\x93\x4c\x67\xe3\x49\xbf\x77\x3e\x19\x77\xc5\xdb\xfe\xac\xd3\x1b\x77\xc7\x67\x67\x5c\x21\x6f\xfb\x33\xc6\x00\xec\x75\x66\x9d\xe9\xf8\x62\xd2\xed\x03\x06\x73\xf0\xe0\x74\xd0\x9f\x00\x72\x31\xed\x4f\x06\x3d\xf1\xae\x33\xa5\x1a\x85\xb6\xa4\xb2\x00\x34\x3e\xc7\x07\x57\x2a\x80\x14\xeb\x7c\x32\x9e\x84\xb5\x4a\x4c\xa6\x8c\x43\xa3\xbf\x19\x9c\x9d\x77\x66\xef\x00\xec\xbd\x19\x8d\xcf\x06\x10\xe3\xc3\x7f\x16\x30\xbb\x61\xe5\x0c\xce\xde\x62\x85\x08\x6a\x28\x48\x66\x32\x1e\x9d\x0e\xc7\x9d\x19\x62\x30\x21\x00\x85\xe6\x94\x40\x9a\x8d\xeb\x84\xb1\x8f\xff\x2b\xfc\xf5\x06\xa7\xa7\xf0\xc4\x8a\xeb\xbd\xf1\xaf\x60\xc5\x8b\xd1\xe0\xec\x62\xd6\x17\x54\xf5\xf8\x8b\xd1\x11\xa2\x8c\x20\x88\xef\x4e\xa0\x72\x31\x5f\xd3\xce\xa8\x0f\xdd\xb7\xd7\xf9\x75\xd8\x99\xce\x7e\x85\x6e\x3f\xed\x77\xc7\x67\x3d\xc1\x64\x7a\x42\x52\x18\x08\x3f\x40\x85\xe7\x74\x36\x11\xdd\xf1\xe8\x7c\x7a\x31\x12\xef\x42\x85\x02\x34\xea\xfc\x42\xcf\xc1\x99\x7f\x9e\xfd\x0f\x42\x54\xb1\xa3\xce\x2f\x67\x93\xce\xd9\x5b\x84\x18\x18\x30\x05\x52\x1c\xfc\xfb\x7d\x07\x81\xda\x73\x02\xb5\xf5\xef\xff\xfc\xfb\x03\x12\x86\xa7\x62\x80\x9c\x8d\x38\x1d\x76\xde\x8a\xe1\xe9\x8b\x7f\x7f\xa0\xdf\x77\x90\xe6\xbf\x3f\x88\xf3\xf1\x87\xfe\xc4\x37\x5d\xbf\xd3\x1b\x9c\xbd\x7d\x71\x01\xcb\xb4\xe8\xbc\x7f\x4b\x7d\x11\x62\x7a\x08\x2a\x67\xf8\xeb\xa8\x0f\x63\x83\x68\x3c\xf6\x2e\x46\x1c\x05\x66\x24\x48\x08\x27\x17\xc4\x66\x9d\x37\xc3\x3e\xcc\x30\xa2\x3b\x3e\x3b\x1d\xbc\xed\xf4\x3c\xf4\xb6\x3f\x13\xcc\xa2\xbc\xf9\x19\xba\x27\x21\x3c\x3a\xfb\xa3\xce\x60\xd8\x1d\x0e\xfa\x67\x33\xd1\xff\xe5\xfc\xcd\xf0\xdf\xa3\xde\x88\xf2\xd6\xff\xe5\xbc\x0e\x8f\x27\xb3\x05\x21\x54\x92\xc1\x19\xb1\x7f\xbe\x4f\x4f\xcf\xfb\x5d\xe8\xe4\x3d\xec\xc4\x21\x74\x30\x7d\x37\x1e\x0e\xa0\x9d\x26\x17\x67\xd4\x65\x61\xd6\x38\x9f\x8c\x71\x94\x4f\xfb\x33\xca\xc9\x6c\x30\x1b\xf6\x05\x24\xd4\xff\x65\x30\x13\x1f\x3a\xb3\xd3\xf1\xc4\xbf\x32\x1b\x0b\x2c\xa0\xe8\x74\xc7\x53\xd1\x99\x0e\xce\x44\x67\xd6\xa1\x9f\x17\xe2\xcd\x60\x06\xb3\x42\xb7\x3f\x18\x0a\x08\xef\x8e\xa7\xfd\x2e\xcc\x43\xdd\xf1\xf4\x9d\xe8\x8e\x67\xd0\x98\x67\x33\xd1\x1b\xbc\xff\xcf\xc5\x18\x9f\x93\xfe\x08\xca\x24\x4e\x3b\x6f\xa6\xe2\x74\x38\x1e\x4f\xc4\xe9\x68\xdc\x13\xa7\x93\xfe\xf9\x08\x7e\x7f\x39\x3f\x13\xc3\xf1\x5b\xf8\x7b\xfe\x3d\x34\x0e\x76\x14\xe8\x1a\x82\x7a\xd0\x68\xdc\x3b\x85\x86\xa5\x1e\x4c\xbf\x2f\xa8\x9d\x10\x1e\x9c\xcd\x04\x67\x03\xb2\x3b\x1d\x9c\xbd\x13\xd3\xff\x4c\xc6\xe3\x99\x80\x9c\xcf\x3a\x67\xef\x04\x0e\xa9\x17\x3c\xca\xea\xc8\x59\x57\x0c\xce\xa0\x4f\x0f\xfb\xa7\x33\x31\xec\x9f\x89\xe1\x6c\x32\x18\x89\xd1\xa0\x27\x26\x83\xb7\xef\x66\x62\x82\x38\xbf\x1a\x5e\x9a\x8d\x87\xd0\xd5\x04\x30\x96\xe7\xf0\x84\x48\x67\x17\xa3\x5e\xe7\xd7\x29\xf6\xbc\x05\x0d\x35\x62\xa5\xea\x7b\xe4\x41\xaf\x7f\x36\xa3\x39\x8a\x5d\x91\x8b\x5f\x46\xc3\x45\x7f\xd8\x1f\x41\xd5\x01\x3c\x3e\xaf\x93\x16\xe3\x53\xd1\x1b\x8f\x7a\xe3\x2e\xf5\x27\x46\xa0\x0b\x62\xc3\x56\xe8\x2f\xa3\x21\x63\xd0\xf2\xb5\xc0\xb7\xfd\x19\xd6\x07\x61\x9d\x5e\xef\x7c\x00\x30\x2c\x59\x6f\xfb\x33\x9a\x5a\x02\x3a\xfb\xf5\xbc\x8e\x52\x4d\x57\x78\xf7\xdd\x60\xd8\xeb\xce\x6a\x14\xe4\xe6\x90\x5c\x23\x4e\x07\x6f\x86\x38\xa5\x11\x85\xb2\x8e\x91\x7c\x49\x39\xa4\xce\x8d\xed\x7e\x86\xa3\x7e\xe1\x6b\x3b\x09\x55\x1f\x7d\x18\x10\xbe\xd0\xdd\x4b\x1d\xec\x23\xbe\xf9\x75\xa7\x62\x88\xf0\x5f\x59\x24\xdb\x39\xd8\xe2\x63\xa0\x6f\xe6\x73\xe7\x9d\x9b\xce\xe7\x62\x3e\x7f\x16\xfc\x2b\x7e\xaa\x29\x3e\x72\xd0\xd3\xaf\x4b\x5d\x14\x6e\xaf\xb9\xa0\xd6\xc1\x65\xa1\xaf\x0e\xfe\x51\x3e\xff\xb1\xfc\xe1\x45\xf9\xe3\xdf\xca\xe7\x2f\xfe\x51\x3a\xfd\xa7\x3a\x2c\x57\x07\x48\x39\x44\x91\x94\x57\x2d\xbc\x5b\x31\x2b\xef\xc8\xd3\xdc\x79\xa9\x61\xf6\x8e\xcd\x22\xc0\x91\x25\xf1\x4a\x36\x37\x84\x16\x76\xe9\x08\x88\x2e\x85\x56\xa9\x3f\x2e\xd2\xdb\x2c\x11\x3a\x45\x31\x61\x34\x43\xc9\xc7\x23\x26\x16\xdb\x22\x17\x66\xb5\x72\x2a\x47\xc9\x66\x74\x8f\xae\x6f\x50\x29\x5c\x64\xc5\x52\x64\x85\x55\xc2\xaa\x55\x10\x53\x52\xc9\x4a\x4c\xe1\x07\xb2\x6e\x56\xde\x86\x30\xbb\xe6\x24\x89\x63\xef\x9b\x93\xe5\x94\xfd\x45\x5c\x91\x3a\xb9\x42\x37\xbe\x68\xa5\x18\x9d\x75\xb2\x66\x34\xbb\xea\xc4\x13\x28\x12\x64\xc6\x1b\x33\xbe\x00\xc2\x8b\xb4\xd8\x9a\x4c\xe8\x7f\x08\xfd\xfc\x47\xa1\x7f\x78\x21\xf4\x8f\x7f\x13\xfa\xf9\x8b\x7f\x08\x8d\x42\x9f\xc5\x3f\x44\xf1\xfc\x47\x51\xfc\xf0\x42\x14\x3f\xfe\x4d\x14\x10\x52\x60\xc8\xea\x87\x17\x62\xf5\xe3\xdf\xd0\x66\x66\x30\x7f\x2c\xde\x98\x3b\x2f\x90\x30\x51\xae\x48\xbc\x99\x65\xf1\x5e\x45\xe8\x66\x0d\x5d\x49\x88\x29\x66\x75\x7a\x9f\x46\xa2\x07\x19\x38\x4d\xc5\x69\x3a\x2a\x72\x71\x9a\x8e\xa1\xb6\x66\x66\x7c\x9b\xaa\x58\x74\xd1\x9b\x32\x29\x8d\x9e\x4b\x9b\x6b\x99\xf4\x3f\x7a\x68\x6c\x63\xd1\xff\x28\xe0\xd1\x71\x13\xb5\x12\x1d\x07\x49\x0c\xd2\xdc\x88\x53\x6b\xb6\x41\xb7\x94\x24\x91\x8d\x65\xbd\x4b\x8c\x11\x68\x3d\x14\xa4\xed\xa7\xb1\x8a\x6b\xf1\x64\x84\x06\x07\x03\x65\x9a\xe8\x48\x91\x34\x4d\xff\x2e\x17\x33\xc3\xc5\x22\xb5\xb5\x47\xfc\x5c\xa8\x8f\x8f\xc4\x52\xe7\x68\x81\xe5\x11\x59\xf9\x7a\x24\xa2\xd5\xfa\x91\x88\x4c\xf2\x88\x4f\xf4\xfc\x73\x81\x67\x6d\xee\x11\xdd\x47\x2e\x7c\x52\x75\x0c\x13\x54\xe9\xcd\x23\x91\xc9\x54\x47\x8f\xd0\xce\xc5\x23\x3e\xb6\xf3\xcf\x85\xb4\xf0\x35\xef\x6e\x7b\xa9\xd3\x0a\x71\xb9\x7d\x84\xf6\x85\x1f\xd1\x85\xee\x02\x6d\x90\x5c\xab\xfb\x47\x2c\xb1\x8c\x86\xa7\x1e\xf1\x19\xdd\x82\x3e\x65\x75\x9a\x3f\xf2\x26\x6e\x1f\xb1\x12\xcf\x23\x16\x00\xd6\xab\xfb\x47\x22\xb7\xf7\x8f\x44\x91\xea\x4a\x87\x0e\x50\xbc\x7c\x96\x4b\xc8\xe1\x8d\x8a\x1e\x89\x5b\xab\x73\xc5\x0f\x48\x68\x2b\x23\x6b\x16\x78\x82\x14\x6a\x0c\x72\xd6\x28\x71\xaa\x1e\xb5\xf6\x5c\x7f\x38\xd4\x49\x08\x27\x4b\x76\xaf\x7c\xfd\xd4\x6c\x15\xe9\xff\x8d\xaf\x45\xdf\xda\xda\x42\x24\x3f\xa3\x17\xe8\xc1\xe4\x69\xeb\xd1\x49\x6b\xef\x81\xdc\x1e\x87\xd4\x74\x71\x7f\xb5\xcf\x6d\xfa\xf2\xe5\xf1\xf2\xa4\x75\x7c\x14\x1c\xab\xef\x73\x22\x6a\x0f\xbe\x79\x72\xd8\x3a\x78\x56\xce\xd3\xc3\x27\x27\xad\xf9\xf3\x83\x93\x47\xdf\x78\x87\x9e\xcb\x93\xc7\xf3\xf9\xc9\xc1\xdd\xfc\xf6\xd3\x8b\xcf\x65\x31\xbf\xfd\xf4\xb7\xcf\xe5\xc5\xfc\xf6\xd3\x3f\x3e\x97\xcf\x0e\x1f\x57\x07\xec\x95\xb6\xf8\xf1\xe3\x3d\xb2\xd4\x8b\xca\x35\xf4\x43\x51\xd9\xef\x97\x07\x97\xdf\x3f\x5f\x5c\x3d\x3d\x6c\x3d\xcd\xc3\x3d\xc0\xf7\xe6\xc0\x6b\xce\x37\xc8\x77\x07\x97\x9d\xf6\xa9\x6c\xaf\x58\x49\xbe\x1e\x78\x30\x9f\xc7\x97\x73\xbc\x1f\x6b\xe8\xd1\x1f\x90\x3c\x5e\xfb\xea\xa4\x52\xad\x87\xf7\xae\x6a\xe2\x77\x3b\xc2\xae\x69\x25\x6c\xfd\xcf\xc3\x56\x5d\x24\x95\xdd\x38\x37\x0f\x41\xe7\xf3\x47\x27\xf3\xf9\x25\x9f\x4e\x5e\xb5\x1a\x32\xca\xed\x3d\xf7\x71\xfb\xfc\x55\x93\xb6\x6c\xcd\x63\x74\xd5\x9e\xe8\x16\xbb\x69\x00\xe1\xf0\x8a\x74\xf6\xa7\x7b\x8e\x5e\x69\x1e\x26\xc3\x7b\x34\x3f\xa3\xae\x94\x97\xc2\xfe\x6f\x13\xbf\x9c\xcf\x6f\xe7\xf3\xf8\xaa\x55\xb3\xb5\xf0\xf2\x65\x0b\x7a\x79\xad\xd7\x52\xf5\xb7\x5f\x7f\xe5\x48\xd6\x45\x32\x91\x5f\x34\x49\x10\xaa\xb1\xee\x4c\x3d\xd8\x21\xa8\xbb\x34\x6f\xcd\xd1\x41\x6e\xa5\xeb\x13\x44\xab\xbf\x25\x19\x6d\x92\xd0\x96\xfb\xdc\xe5\x3e\xde\x35\x75\x50\x33\x39\xf9\x69\xbf\x63\x67\x20\x5f\x52\x7e\xbe\xf0\x2e\x19\xb1\xd8\xb1\xab\xc0\xaf\xd4\xd3\xab\xe9\xc6\xd6\x7c\x41\x57\x26\x16\x1e\xcf\xe7\xb7\x5c\xdb\xd0\x79\x4f\x1e\x3d\x3e\x6c\xed\x75\x05\x4d\x06\x3c\xeb\xb6\x3a\x2b\x7f\xd0\x18\x57\xe7\x89\xbf\xeb\xfb\xbe\xfd\x7f\xe6\xe9\x3c\x17\xad\xc7\x07\x87\x47\xcf\x7e\xff\x84\x37\x72\x2f\x7f\xba\xba\xfc\x6d\x2f\xf9\x69\xf9\xc5\x57\x5e\x5d\x1d\x7f\xdd\xc5\x34\x79\xf2\xf6\xeb\x3f\xf7\xe3\xe3\xcb\x97\xaf\x3e\xcd\x2f\xe7\xe9\x4f\x57\x35\x69\xf1\x86\xa3\xf5\x5b\x9d\x6f\x1a\x17\x6b\xc1\xa6\xe8\xae\x81\x54\xef\x48\xfa\xbf\x94\x1e\xaf\xbd\x61\xbe\xe4\xe4\x3a\x56\xab\x5a\x36\x0f\x76\xf2\x69\xea\xce\xaa\x3f\x7d\x49\x57\xaa\x15\x2e\xb0\xb1\x99\x98\x87\x49\xe4\x9f\xf7\xa8\xce\x69\x75\x8c\xcc\x0c\xe9\x79\xdd\x48\x54\x4c\x11\x4e\xc9\x44\xc5\x95\xa7\x06\xef\xa3\x81\xaa\x8f\xeb\x92\x54\xcd\x70\xbd\xa8\x54\xce\x48\xce\xff\xa1\x1b\x86\x9a\xe3\x7a\x63\xf3\xa6\x1f\x86\xba\x46\x7b\xf0\xb9\x40\xfc\x1a\x7b\x89\x97\x4e\x3d\xf0\xa9\x10\x14\xd0\x88\x65\xdc\x75\xa2\x00\xeb\xaa\x8e\x74\xbe\x8f\xf9\x96\x68\x71\x35\x39\x72\xcc\x7a\xe7\x5f\x99\x17\x36\x6a\xab\xf6\x4b\x00\xfe\x36\x9f\x1f\xcc\xe7\x87\xf3\xf9\xe5\x7c\x7e\x35\x9f\x7f\x9a\xcf\x3f\xcf\x5b\x47\x8f\x7f\xff\xe9\x9b\x72\x8e\xff\xb9\xab\xa7\xc8\x35\x1f\xcc\xe7\xed\x72\x3e\x7f\x7a\x78\x82\xd2\xd7\x97\xcf\x8e\xaf\xf0\x52\xfd\x04\xb8\x47\xfb\xb4\x45\x82\xd8\xad\xa7\xf6\x69\x4b\xb7\x60\xec\x35\x2f\x8b\x5f\xb6\xa0\xfc\x6d\x56\xd9\x8f\x64\x92\x1c\x47\x11\xd7\x0c\xa9\xc1\xb5\x09\x51\x77\x3a\x6f\x6f\x58\x30\x78\x85\xed\xcc\xd5\xcd\x53\xa9\xd0\xa9\xce\xdb\x1c\x12\x24\x83\x12\x95\x3f\x69\xa3\x26\xab\x03\xb8\x06\x1e\xab\x48\x6c\xf5\x9d\x46\xdb\xaf\x3e\x03\xa1\xd7\xd4\x2c\xac\xdd\x20\x4e\xce\x2a\xac\x62\x9b\x6a\xa4\x0e\xc0\xcf\xf6\xca\xd8\xb6\x43\x25\x36\xd1\x78\xb4\xb1\x71\x19\x26\x79\xb2\x22\xd5\xf9\xb1\xd3\x6b\x6f\x73\xf4\x76\xa3\x52\xec\x9f\x3e\x01\x99\xc6\xec\xb3\x1a\x6a\xa3\x8d\x41\x6c\x99\xa9\xcd\xfd\x40\x06\x53\xc8\x14\x8c\x7e\xc3\xdb\x2b\x72\xd7\xe1\x89\x64\x86\xd3\x53\x1d\xaa\x9e\xc5\x5c\xa7\xbe\x6a\xf9\x93\x24\xbf\x17\x1b\xef\x17\xbb\x7d\x8b\x92\x7a\xac\x2a\xd1\x46\x91\x41\x1d\xec\x2a\xe0\x76\x46\xe5\x4f\xb0\x3e\x39\x85\x44\xe5\x56\x45\xfc\xf0\xc4\xad\xcc\x84\xb1\xbe\xf8\xc8\xd6\x89\xc7\xe2\x89\x78\x2a\x8e\xc4\xd1\xbf\x44\x5b\x3c\x7b\xf6\x4c\x1c\x8b\x9f\xc4\x3f\xc5\x3f\x5f\x89\x57\xe2\xd5\x6b\xf1\x5a\xbc\x7e\x25\x7e\x17\xd5\xc5\x39\xca\x64\x79\x4b\xf9\x59\x96\xdc\xf3\xf5\xb9\x73\x26\x82\xdf\x8f\xf0\x73\x43\xd7\xe9\xac\x01\x7b\x22\x22\x29\xad\x88\x64\x6c\xff\xff\xd5\x91\xa7\x72\x1f\x89\x20\x91\x38\x8e\xf9\x01\xa9\xc5\x95\x52\x39\x6c\x69\xda\xaf\x59\x29\x83\x30\x74\xbe\xb0\x54\xb9\x8e\x4e\x88\x10\xe9\x7f\xbe\xaa\xc0\x00\x55\xb4\xd7\x35\x90\xa1\xd8\xdc\xa6\xd4\x5c\x80\xa1\xba\x33\xf6\x22\x0e\x46\x75\xbe\xf0\x01\xab\x64\x7c\xcf\x70\x91\x55\xaf\xa1\x7e\x74\xfd\xb5\xdb\x8d\xce\x15\x3a\x77\x27\x82\xcf\x17\x67\x8a\x31\x9f\x1d\xce\xcb\x89\x40\x15\x6d\xae\x3a\x1c\x6b\x44\xe0\x6a\x23\x0a\xa9\x9a\x9c\xe0\x36\x19\x65\x11\x7c\x67\xad\xbf\xc6\xa4\xfa\x8b\xb1\x4a\x0d\x5e\x34\x1b\xeb\x9d\xb3\x08\x65\x56\x6d\x9a\x80\x4f\x84\xfa\x08\x7f\x85\x4c\xe0\x71\x73\x42\xaa\xd4\xea\x46\xa5\x27\x42\xc1\xf6\x0b\x2a\x1f\x01\x42\x4f\x50\x98\x41\xdd\x65\x79\x25\xba\x10\x29\xb1\x8e\x62\xbc\xe8\x6e\x67\x12\xa7\x0a\x7e\x95\x5e\x64\xf4\x44\x54\x59\x3d\x11\xdc\xa4\xed\xd7\xb8\x69\x65\x8c\xc8\x96\x7c\xaa\xb5\xeb\x22\x14\x49\xb4\xad\xa4\x21\x1c\xe9\x4e\xb6\x5f\xb3\x2e\x24\x21\x2c\x51\x82\x08\xec\xe9\x11\x40\x59\x76\x80\x4e\x48\x89\x9c\x2d\xce\xa7\x3a\x2f\x62\x25\xb6\xf2\x5a\xb5\x33\x93\x48\x4b\x20\x2b\x6a\x16\x81\xc0\x1f\x40\x38\x48\xac\xdc\xb1\x35\x4d\x78\x7c\x84\x9f\x1b\x2f\x87\x51\x24\x46\xa4\x6a\x8d\x72\xf4\x27\xb0\x10\xa1\x18\x83\xb7\xa0\xd0\x28\x10\x10\x4e\x04\x6d\x14\x42\x41\x08\x45\x32\x6f\x78\x4d\x1c\x9f\xa0\x2b\x88\xfa\xc8\x42\xbc\x3e\xa8\x6a\x0d\x7e\x22\x32\xa9\xed\x89\xc8\x94\xba\x6e\x63\xdd\x32\xd5\x38\x4d\xd9\xca\xbc\x71\x9b\x13\xf1\xb1\x90\x4e\x7f\x2c\x4c\xae\x44\xf5\x8b\x22\x34\xa4\xcb\x0a\xbd\xc2\x43\xfa\x4f\x85\x86\x05\xf0\x87\x52\xb6\x4a\x26\xd4\xe2\x00\x9d\x08\xab\xb6\x92\xb4\x53\xbc\x39\x05\x16\x11\xc1\x15\xb0\x6d\x15\x7a\xfe\xaa\xd7\x82\x53\xb0\xa1\x55\x79\x3b\x92\x96\x81\x18\x00\x9d\x7a\xe1\x0f\x4b\x96\x51\xe1\xd1\x7e\x8d\x4d\xef\x11\x6f\x0d\x8f\x51\xd6\xc7\x67\x94\xe7\x32\xc6\x68\x86\xa8\x90\x1a\x5c\xa7\xbf\x6e\x20\x15\x6c\xb2\x7b\x0f\xaf\x74\x92\xf8\x2d\x78\x9b\x3b\x24\x63\xd0\xe7\x18\xa4\x62\x21\x5c\x7d\x38\x7c\x35\x50\xaa\xef\x85\x8f\x9d\xa0\x4d\x6b\x2e\x35\x96\x28\x74\x0e\x42\x4f\x48\x16\x26\xb8\x72\x6c\x9b\xd5\xaa\x81\x42\x60\x91\xa2\x7f\x6d\x9e\x61\xb9\xe3\xd2\x83\x2b\x91\x11\x2a\x0e\x23\x5c\x1c\xc6\xa0\x38\x0c\x62\x71\xea\xd3\xbb\x35\x5b\xea\x78\xf5\xe9\x3d\x37\x4c\xb3\x3a\xe7\x5f\xea\x26\x7f\x2a\x6b\x4e\x2a\x4e\x3f\xec\x84\x7e\xfb\xe6\x51\x90\xab\x61\xce\xbe\x2e\x94\x77\xf0\x4d\x5e\x7e\xb3\x2a\xbf\x01\x36\xa8\xf5\x34\x7f\xda\x22\xf0\x19\x6c\x1a\xdc\xab\x3d\xfb\x6c\x1b\x0e\x35\x65\xed\x78\x73\x79\xf9\x7d\xfb\xf9\xd5\xd3\x83\x63\x7a\x1e\x7a\x1b\x35\xdf\xb0\x7d\x1a\x0c\xf8\xdf\xf5\x00\x6f\xbe\x8e\xc3\x08\x3c\x24\x63\x35\xc9\x2b\x3a\x77\x88\x5e\xed\x33\x4d\x45\x56\x0c\xe7\xf3\x12\x4f\x2f\xca\x6f\x5a\x87\x57\x47\xf1\x2b\x54\xa1\xc4\x0c\x65\x0f\xf7\x44\xb8\xb5\xdf\xbe\xfa\x14\xc4\x14\x3f\x1f\x15\xaf\x3e\x05\xbb\x84\x5e\x93\xee\xf3\x95\x57\xab\xa8\x79\x58\x68\xd5\x94\x1a\x0c\xf2\xa2\xf1\x51\x86\x82\x88\x4b\xae\x1f\x16\x14\xf4\x1a\x9c\xfa\xf3\xd1\x1a\xbd\x07\x10\x8f\x11\x84\x23\xbd\x94\xe1\xf2\x68\xc7\x13\x42\x63\xc3\x0c\x31\x62\x4c\x7e\xe5\x73\xd8\xc8\x8d\xdf\xa6\x56\x27\x04\x94\xeb\xf5\xd1\xf2\x68\x5b\x59\xee\xd9\x3e\x8b\x5e\x5d\x92\x5f\x82\xf8\x28\x3b\x2a\x8e\x56\x57\xde\x03\x41\x74\x78\xf4\xa9\x52\xd7\x48\x31\xce\x6e\x8c\x2f\xb3\xd9\x3a\x91\xcb\x7d\x6c\xf6\x65\xed\x70\xbc\xa6\x15\xfd\xb8\x9c\xb7\x48\xd1\x10\x81\xa0\x1b\x0d\x41\xa4\x7b\xf8\xf0\xfc\xcb\x45\xba\x75\x75\x94\xbc\x3c\xfe\xee\x64\x7e\x4b\x52\xbe\x95\xb1\xae\x25\xac\xb7\xde\x04\x06\xca\x10\xa2\x47\xa9\xe6\xd6\x82\x4f\xb4\xf5\xca\x1f\x6c\xc7\x41\x99\x16\x26\x2c\xd8\x05\x05\x02\xab\x80\xe8\x95\xc8\x64\x81\xba\x1a\x58\x81\x16\xbd\x08\x7b\xa3\x3e\xb4\x87\x01\xee\x76\x83\x4e\x47\xc2\x26\xee\xbb\x95\xf8\xee\x54\x7c\x97\x8b\xef\x66\xe2\xbb\x4c\x8b\xef\x54\xe6\xc4\x77\x3a\x5d\x89\xef\x52\x99\x8a\xef\x94\xf8\x4e\x8b\xef\xfe\x14\xdf\xb9\x07\xc2\x94\xc0\x05\x36\xfd\xad\x90\x78\x64\x2c\xa2\x4d\xac\x2d\x95\x8c\x73\x17\x19\xb7\x61\x86\x64\x9b\x59\x83\xbc\xef\x0a\x59\x8d\x9a\xbe\x09\xfe\xb8\x1c\x9d\x45\xb9\xdc\x11\x13\x71\xaf\xc4\x5a\xe5\x64\x8b\x9f\x78\x09\x16\x45\x5c\xe1\x3a\xb3\x22\xc5\x14\x60\x2b\x84\x76\xb0\xbf\xd4\x4e\x6d\xb3\xfc\x5e\x68\xa7\xd3\x95\x76\x50\x0a\xed\xfc\xb2\x2f\xa1\x3b\x58\xf4\x2c\x43\x6c\x02\x2e\xf7\x3a\x45\x76\xac\x62\x1a\x48\x00\x3f\x31\xeb\xe7\xdf\xc3\xef\x0b\xe6\x08\x58\x94\xd2\x71\x0e\xb6\x64\x35\x67\x8b\x26\x74\xd0\x64\xa9\xb1\x68\x71\x36\x32\xe9\x8d\xb2\xb9\xc8\x8c\x37\xb2\x86\x7e\x02\x62\x91\xdd\x92\x5b\x19\x36\xd7\x40\xeb\x9e\x4e\x37\xb4\x96\xc1\xe2\xb9\x66\xd7\x52\xf4\x92\x5f\xde\x22\x49\x8f\x6d\x86\xfe\xaa\x60\x9e\x2f\xb6\x41\x8a\x5f\xa6\x1b\x9a\xef\xfd\x45\x03\xee\x8b\xbc\xba\x34\x4c\xac\x8e\x45\x68\x51\xba\xf1\xf1\x41\xab\xfc\x86\xac\x25\xcc\xe7\xee\xe9\xf1\x7c\x7e\xfb\xf4\xf0\x71\x38\xa7\xfb\xb2\xae\x7b\x70\xf3\x82\xc7\x64\xcd\xd3\x87\xe6\x18\x67\xad\xa9\xdd\xf3\xcf\xc5\xf7\xed\xff\x73\xf5\xe4\xe0\xf1\xd3\xcb\xf9\xfc\xd9\xe3\xab\x27\x25\x3d\x9f\x92\x3b\x9a\xda\x75\x53\x6d\x7a\x78\xfc\x84\x23\x7b\xa5\xb5\x9c\x45\xc2\x8f\x8f\x49\x24\x3c\x8c\xfa\xfc\x2b\xa3\xde\xed\xd7\xc1\xf2\xba\x6e\xf5\x03\xda\x36\x7e\x6a\x8f\xa1\xd8\x03\xb4\x14\x9b\x07\x51\x6f\xf9\x6a\x57\xed\xf9\x1b\x52\xf1\x3f\xc5\xb5\xa0\xf5\xf9\xa7\x83\x3d\xf6\x27\x2e\xf1\x16\x6d\x81\x52\xd7\x6d\xd8\xb7\xd7\x7c\xf6\xe0\x49\xe3\x6f\xb0\x9f\xaf\x6c\x53\xd4\x9c\x7f\xa0\xd1\x35\x28\xfc\x74\x7a\xf6\x40\x1c\x35\x58\x60\x08\x2b\xe7\x23\xda\x93\xcb\x34\xa7\xf3\xc8\xa0\xb5\x3f\xf0\x9f\x7a\x75\x5c\x3e\xbe\xda\x23\xe9\xca\x46\x67\x13\x5a\xd8\xb5\xb7\xc9\xf1\x4d\xed\xb4\x0d\xb3\x5e\x53\xd9\xf6\x91\xfd\x79\x18\xea\x80\xff\x17\xf1\xbd\xe1\xd8\xc6\x8a\x00\x99\x0b\x72\xb4\x21\x6a\x2e\xd7\x41\x0e\x5f\x96\x72\xb9\xb4\xa5\x8c\xac\x49\xef\xb7\x25\xab\x64\x95\xd2\x2a\x59\x4a\x9b\xeb\x28\x51\xa5\x74\x3a\x56\xa5\x2c\x62\x6d\xca\x65\xb9\x94\x4e\x95\x4b\xbd\x2e\xd1\xf2\x39\x32\xb2\xe5\xd2\xc4\xf7\xe5\xd2\x96\xcb\x22\xcf\x4d\x5a\x46\x32\xbd\x91\xae\x8c\x24\xde\xaf\x94\x91\xce\x55\x19\x99\x18\x7e\x12\xf8\x43\x3d\xa9\x92\xdd\x74\x94\xb1\xcc\x25\xcc\x15\x65\x1c\x97\xb1\x4a\xca\x18\x55\x62\x5d\x19\xaf\xd2\x32\xd6\x37\x65\x9c\x94\x71\x5e\xaa\x6d\x09\xdb\x80\xb8\xc4\xc3\x10\xa7\xf2\x72\xa5\xd7\xfe\x0b\xe8\x7c\x5a\x95\x2b\x63\x72\x65\xcb\x95\xb1\xdb\x12\x5d\xe7\xd1\x2f\x44\x3e\xd8\x5c\x3e\x6f\xff\x78\x75\x58\x6e\x94\x8c\xf1\x47\xd9\x72\x43\x19\xd9\xd8\x72\x93\x6f\x93\x52\x97\x9a\xde\xd2\xdb\x75\x89\xbc\x57\xa9\x53\x57\x5e\x2f\xe3\xf2\x5a\xdd\xaf\x55\x5a\x26\x72\xa9\x92\x32\x51\x6b\x95\xc6\x65\xa2\xcb\x44\xa7\xd7\xe5\x56\x66\xe5\x56\xda\xeb\x12\x3a\x0c\xfc\x28\x5b\xa6\xf2\xa6\x4c\x0d\x7d\xbd\x4c\x0d\x71\x8b\x25\xed\x02\x4b\x93\x94\x26\xcb\xe9\xdb\x74\x05\x55\x12\x53\x57\x66\x25\x4e\x04\x65\x66\x55\x99\xb1\xc3\xe9\xf2\x63\x69\xb3\xd2\xe6\xa5\x2d\x96\xf7\xa5\x93\xdb\xac\xe4\xe4\xd8\x9a\x71\x49\x0d\x5b\xba\xad\x4c\x92\xd2\x65\x32\x2d\x61\xdd\xbd\x56\xf0\x30\xe9\xba\x74\xf9\x7d\xa2\x4a\x57\x2c\x4b\x57\x64\x25\xda\x15\x2b\x73\x6c\xb2\x3c\x2e\x61\x41\xc0\xd6\xce\xa1\xf6\xca\x7c\x53\xe6\x58\x45\xe8\xb8\x0a\x8f\x7e\xcb\xdc\x96\x79\x5e\x16\x49\x79\x23\x6d\x79\xa3\x63\x65\x0e\x9b\xa6\x07\x5e\x1e\xdc\x68\x34\xe5\x5d\xa2\xb7\x90\xd2\x42\x4a\xe8\xa9\xb1\xf4\x9e\x87\x4a\xdc\xc7\x20\x9b\x4a\xa0\x49\x93\x7b\x28\x76\x1b\x7d\x96\x94\x5e\x17\xb6\x04\x7a\xdb\xac\xda\x30\xfd\x12\x12\x6d\x74\x12\x97\x29\xb0\xbe\x4c\x06\x18\x56\xa0\x87\x84\x2a\x2e\x43\x26\xa7\x46\x4a\xd4\x2a\x2f\x1b\xef\xd4\xe2\x27\x32\x85\x06\xa7\xcc\xc3\x96\x8a\x75\x38\x73\x55\xea\x94\x33\xb8\x31\x37\xd8\xb5\xa2\xc2\x95\x68\x28\x2f\x24\x44\x18\xec\x3d\x3d\x08\xcb\xac\x65\x84\xbe\x80\x70\xc9\x4e\x0a\x4a\x5c\x57\x4b\xd6\xdd\x84\x4e\x8f\xe7\xa3\x25\x3a\x33\x50\x71\x49\x4e\x78\x4a\xb9\x82\x54\x24\x6a\xad\x7b\x76\xef\xe5\xcb\x03\x22\x73\x9c\x68\x63\x74\xa4\xdc\xbe\x0f\x63\x86\xc8\x40\x48\x1b\xca\x74\x17\x90\x5c\x6d\xb9\xcf\x40\xef\xc1\x6d\x0b\xa4\x6f\x8f\xf6\x5b\xf9\x39\xf8\x93\xdf\x07\xc6\xab\x7d\x6b\x65\x46\x10\x2c\xf5\x3a\x5d\x13\x82\x8c\x58\x89\xaa\x9b\xf0\x6b\x6e\x5d\x89\xc7\x33\x18\x4b\x95\xd0\x3f\x96\x3a\xd1\xf9\x7d\x09\x0b\xba\x8e\x64\xd2\x46\xd1\x84\xb2\x48\x35\x4c\x0d\xed\xa5\x8e\x75\x49\xae\x7c\xf1\x38\x22\xd7\x5b\xdc\x01\xf2\x5a\x53\x0f\xf2\x86\x92\xea\xb4\x98\xbd\x7d\x36\x68\x2a\x91\xf5\x48\x65\x50\x57\x68\xd3\x90\xa8\x70\x63\xf5\x5a\xd7\x22\x94\xb9\xc9\x70\x68\xb4\x0b\xd8\x61\x43\x5d\xb6\x69\x4f\x0f\xc9\x00\xbd\x16\x15\x50\x52\xba\x20\xd8\x2b\x6d\xac\x09\x85\x8e\xb3\x4a\x7c\x20\x54\x65\x9a\x13\x1c\xab\xc8\x50\xb6\x7d\x86\x76\xa8\xd8\x86\xbb\x44\x54\xef\xd9\xa5\x12\x8e\x55\x8a\x23\xa1\x86\xc3\x88\x6f\x03\x4f\x44\x43\xbf\x9d\xc8\x7b\x53\x54\xe3\x13\x43\x70\x1a\x77\x65\x28\x22\x2a\x0f\x29\xdb\x56\x37\x2a\xcd\x5d\x59\x73\x5b\xea\xab\xaa\x46\x2a\x33\xb9\x56\xd4\x03\xda\x3a\xc5\xc5\xa2\x46\xe1\x9e\x5a\xa3\x50\x0f\xce\x64\x1c\x43\x0b\x43\x4d\x7b\x98\xf2\xe4\x31\x1c\xb3\x1e\x59\x9a\x3c\x37\x5b\x8f\x96\xbe\x52\xdb\xf7\x15\x78\x57\x81\xd8\x4b\x43\xc5\x9b\x02\xb5\x6d\xda\xd4\x3d\x3d\x46\x55\xee\x31\x12\x5c\x09\x28\x55\x32\x63\xa5\xb1\xd9\x46\xa6\xae\x34\x16\x96\x0d\x43\x36\x28\x78\x3a\xaf\xfa\x05\xe3\x2b\x9d\x97\xa4\x1b\x54\xa6\x26\x55\xb0\x16\xb4\x8b\x0c\x1f\x54\x3e\x80\xb0\x6c\x00\xd0\xd0\x02\x28\x36\xb7\x69\xb9\xd5\x29\x67\x13\x20\xf2\xbb\x5c\x6e\xe5\x9d\x27\xca\xbb\x8a\xe8\xae\x71\xdd\x71\xf0\xbb\xd6\x29\xd6\x24\x83\x96\xa3\x20\x82\xdf\x62\x98\xab\x91\xb0\x12\x0f\xed\xb0\x1e\x78\x4e\xac\xf0\x50\xaa\x1a\x0d\x95\x6b\x6a\x84\x12\xab\x8a\xf3\x43\x93\x4f\x98\x15\xf0\xa3\x7f\x14\x2e\xd7\xab\xfb\x36\x3b\xbf\x2a\x35\x79\x9f\x2f\xf9\x76\xa3\xd4\x5b\xd5\xde\x02\x63\x80\x29\x43\xd7\xf2\xe6\x2f\x98\x82\xfa\xf2\x45\x83\xe0\xc7\x96\x8e\x4c\x5a\x6e\xee\xb3\x8d\x4a\x5d\xc9\x79\x58\x99\x34\x6f\xdf\xd6\x60\xb6\xd9\xd6\x4e\xf4\x5a\xe6\x85\x85\x99\xb2\x46\x26\x84\x8a\xc2\xa0\x55\x79\xb4\x61\x44\xff\xa9\xda\x32\x86\x32\x54\x04\x82\xbc\x2a\x50\xdb\x5f\xad\x10\xf9\x5a\xe1\x4e\x81\x90\x95\xc2\x2f\xb6\xbd\x86\x31\x53\xd1\xb3\x06\xc2\x25\x6a\xeb\x95\xab\x44\xdd\x51\x7f\x45\xc8\x6d\x2c\xac\x57\x08\xaf\xad\xb9\x25\x08\xbb\x31\x42\xc1\xc5\x2a\xa1\x4b\xe9\xb4\x43\xb0\x24\x3d\x37\x5a\x5c\xda\x91\x4a\x80\x85\xa2\x23\xe8\xb2\x7a\x89\x1c\x0b\x97\xa8\x90\xaf\x6c\x1b\xfd\x96\x06\x4c\xa7\x91\x45\xc9\x96\xd2\x37\x58\x64\x92\x62\xeb\x7b\x24\x23\xc8\x61\x30\x6c\x8b\x44\x35\x43\x91\x42\x55\x5a\xa7\xd0\x78\xaa\x51\x3c\xbc\x96\x99\x07\x57\x3a\x49\x3c\x8c\x59\x62\xc4\x95\xfc\x76\xa2\xb3\x36\x6c\x0b\x11\x2a\x71\x5b\xec\x99\xcc\x36\x4e\x3b\x8d\x39\xa8\x31\xfd\xd4\x67\x9e\xa5\xb9\x83\xb6\x84\x96\x42\x90\xa6\x6e\x00\x6b\xb3\x2c\x2d\x68\x3c\x5c\x96\x38\xf0\xb9\xa0\x8c\xe4\x26\x7b\x48\xa0\x82\xd7\x08\x38\x10\xdb\xa4\xc9\x5e\xa7\xc3\xf0\xd8\x43\xa6\x82\x56\x04\x0f\x36\xd2\xf5\x43\x8c\x51\xfa\x44\x23\x2b\x44\x6a\xbc\x44\xa4\x46\xfa\x34\x49\x78\xa4\x91\x17\xcc\x5e\x23\x49\xa4\x34\x52\x44\x4a\x23\x41\x1c\xf3\x0c\xd3\x70\x6d\x24\x41\x24\xd2\xac\xdc\xa1\x25\x7a\x97\x44\xcc\x4a\x93\x66\x8a\x1c\xba\x6b\x9d\xe6\x91\x46\x3e\x22\x93\x24\x32\x73\x21\x90\x5a\xb1\x99\x19\xa6\x35\x4a\xc4\xb4\x7d\x6d\xc6\x41\x7b\x9a\x8d\x43\x1a\x19\x68\x74\x9b\xb2\x72\x7b\x4e\x53\x48\x0d\xf7\xa5\xac\x28\x61\xde\xad\xd1\x78\xc9\xad\x51\xb8\xec\x15\x81\x3f\x5f\x23\xc0\x10\xa9\xe1\x64\xdc\x0d\x07\x77\x8d\x8a\x3e\x8d\x69\x0a\xae\xa8\x08\xae\x64\xa4\xda\x35\xb6\x4d\x16\xb9\x29\x65\xca\x16\xa6\x1e\xb0\x67\x55\x08\x4c\x38\x64\x18\xa6\x46\x44\xa7\x90\x15\x1a\x3c\xa9\xf1\x38\xaf\x42\x60\x0e\xa0\xfc\x54\xb4\xc0\xdb\xd5\x48\x61\x42\xab\xd1\x90\xdd\x0b\x78\x49\xcc\x90\x53\xc9\x8a\x41\x60\x7c\x1d\xc3\x3c\xc1\xd1\x46\xe6\x7f\x55\xc7\x05\x95\xe0\x57\x75\xbb\x58\xde\x4a\x9d\x97\xb7\x6d\xe6\x95\xb0\x52\x12\x55\x31\xb2\xc0\x6a\x55\x18\xec\x8f\xc8\x22\x48\x54\xe2\x95\x25\x9a\x5b\xa4\xcb\x4b\x6b\xb6\x32\x65\x18\x2f\x56\xcb\xc0\x5e\x12\xef\x49\x56\x49\x89\xfb\xdc\x00\x43\xba\xd1\xd1\x35\xf2\x72\xcc\x75\x7a\xb6\x94\x7b\x58\xbe\x6c\xdb\x84\xf9\x3a\xda\xd4\xb6\x69\x5f\x49\x24\xda\x10\x33\xc9\x85\x12\xa0\xa4\x46\xc9\x56\x2d\x49\xe8\xb6\x74\x1f\x0b\x69\x55\xe9\x0c\xec\x81\x70\x2b\xd9\x8e\x64\xe6\x4a\xa7\x60\x4b\x9a\xab\xd2\xa9\xf0\x7a\x64\x0d\xec\x34\x3d\x6e\xf3\xa4\xb4\x26\xa4\x8e\x96\x5a\x02\x7b\x89\xfd\x9b\x77\x1e\xf7\x1e\x80\xbd\x08\xd9\xbf\xaa\xb6\xb9\xcc\x72\x22\xd7\x46\x4c\x57\x91\xe3\x3c\xce\xa3\xde\x2c\x13\xfd\xb1\x50\x65\x6a\x70\xb1\x4c\x0d\xb0\xb7\xe8\x1a\xb2\xc9\x6d\x85\x7c\xa4\xc6\x0f\xaf\xd4\xb4\x63\x6b\xb2\x32\x55\xb7\x2e\x93\x50\xf6\x34\x14\x26\xf5\xc0\xd6\xdc\xa8\x72\xab\xe3\x38\x51\xe5\x56\xc5\xba\xd8\x96\x49\x6e\xcb\xc4\xb6\xf3\x65\x89\x57\xd6\xd8\x90\x74\x79\x4d\x0d\x49\x30\x35\x64\x62\x8c\x63\xde\x08\x37\x58\x58\x04\xe4\x8f\xf2\x8d\x35\xc5\x7a\x43\x88\x8a\x91\x83\x5a\x6f\xa0\xa8\x38\x5f\x5e\x2b\x95\x41\x49\x3c\xb3\x54\xea\x5c\x26\x3a\x2a\x89\x01\x87\xcd\x15\x83\xa1\x63\x95\xbc\xc0\xe9\x14\xea\x45\xa7\xf8\x29\x7a\xb4\xf1\x50\xa6\xe2\xaf\x52\xda\x3e\x96\xe1\x5d\xde\x8f\x55\x38\xf5\xb8\x8d\x02\x36\xa2\xa2\xf2\x8d\x7c\x8d\x42\xa5\xdc\x18\xab\xff\x34\x69\x2e\x93\x72\xa3\xe3\x58\xa5\xe5\x46\x25\x59\xb9\x91\x69\x5c\xae\xad\x81\x2a\x44\xcf\xbf\xa5\x62\xa7\x13\x65\xa8\x68\xb2\xdd\x5b\xc6\x26\xcf\x61\xcf\xab\x1d\x6f\x33\x6b\x20\x67\xae\x46\xe0\x1d\x6d\x8d\x02\x1d\x13\x4a\x8a\xcc\x4d\xf4\x70\x13\x1d\xab\x48\x43\x67\x88\xa5\xdb\xa8\xb8\x44\xeb\xed\x1b\xa9\x91\xf7\xa0\x45\x21\x32\x89\xcf\x13\x19\x0d\x2e\xa3\x0d\x30\x12\x0a\xbb\x5f\x24\x33\x6c\x80\x3f\x3d\xcf\x80\x2d\xc0\xec\x43\x92\x54\x1c\x01\x2e\x25\x09\xce\xef\x26\x89\xe9\x34\xac\x84\x5d\x6c\xc5\x14\x2e\x15\xb0\x6d\x4b\x89\x5e\xc6\x14\x4f\xa1\xc9\xad\xbc\x87\x59\x28\x69\xf3\x38\x92\x4b\xe4\x71\x95\x3f\x99\xc1\x91\x0b\xfc\x5b\x65\x09\x03\x0f\x36\xbd\x10\xa7\x3d\xda\x7f\x76\xf9\xb5\x43\x4b\x4c\xe4\x5f\x98\xc8\xe5\xa7\x9f\xae\xd0\xce\x17\xc9\x09\x79\x07\x8e\x24\xee\xe5\xa5\xfc\xe9\xb6\x44\x06\x23\xa2\x50\x43\x4e\x05\x17\x27\x64\x4b\x0a\x46\x89\x24\x53\x40\xde\xbb\x2d\xf3\xb8\x11\xb9\x01\xa6\xcb\x80\x5b\x69\xd3\x60\xed\xab\xca\x6e\x55\x08\x9a\x71\x5d\xed\x90\xf3\x59\x38\xe5\xfc\x8a\x76\x04\x3a\xb8\xdc\xab\x8c\x5f\xdd\x20\xb1\x19\x28\x72\x22\xb6\x73\x1f\x3a\x9f\xbb\x4f\xdf\x1f\xfd\xf0\x99\x85\x24\x49\x2a\xec\xe0\xf0\x5f\xed\xab\x27\x97\xaf\xbf\xfb\xf6\x9b\xfa\xd9\x31\x1e\xd9\xbb\xe1\xcb\xd6\x52\xba\x4d\xeb\xf3\x57\x32\xb5\x95\x89\xde\x7b\x2d\xd6\x92\x71\xdc\x3a\x6a\xc9\x14\x7e\xa3\x6d\x46\xbf\x6b\x7a\x24\xf0\x30\xa9\xcb\x5b\x47\xad\x58\xdf\xc0\x2f\x0e\x97\xd6\x11\xd9\xf4\x68\x1d\xb5\xd6\x26\x37\xad\xa3\x96\x5e\xa1\x41\x38\xa0\x24\x26\x85\xd7\x61\xe2\x82\x47\x01\x89\xa4\x6a\x8d\xbf\xb7\xf0\x6b\x32\xfc\x85\xb8\xc6\xb6\x8e\x5a\x56\x6d\xf1\x17\x2a\xa9\x75\xd4\x72\x9b\x04\x7f\x21\xc8\x65\x05\x44\x73\xc5\xb2\x75\xd4\x42\x81\xbc\xd6\x51\xab\xa0\xb0\x3b\x63\x5b\x57\x47\x16\x8a\xb0\x56\x10\x4b\x52\x64\x34\x61\x06\x19\xdf\xa8\xe8\xba\x75\xd4\x62\x53\x67\x90\x67\x0d\x2d\x83\x0f\x15\x73\xde\x8f\x9f\xff\xe8\xa1\x1f\x5e\x40\x19\x28\x2d\x6f\xf8\x0a\xc1\x1b\x73\x8d\x00\xa5\xbf\x35\xa9\xce\x31\xe3\x99\x8c\xae\x31\x21\x47\x2f\x39\xb4\xa6\xd6\xba\x3a\x92\xaf\x2e\x5b\x74\xc0\x83\x3e\xe2\xa9\x0e\x6d\x11\xd1\x6b\x5e\x1a\x12\xb3\x92\x4a\x2c\xed\x3d\x4c\x76\xb9\x8e\x20\x55\x14\x7f\x03\x80\x84\x25\x11\x62\xf1\x47\x88\x8a\x0b\x63\xeb\xa8\x45\xb6\xc7\xf0\x65\x97\xab\x6d\x6b\xdf\x4d\x25\x36\x7b\xd5\xcb\x6a\xa2\xbb\x41\xce\x17\x8f\x4b\x6b\x9e\xf7\x1a\x1e\x96\x82\x65\xc0\x70\xd3\xeb\x9e\xcc\xe7\xcf\x54\x1a\xc3\xc8\x08\x97\x2a\x57\x4f\x78\x42\xf8\xed\x52\x5c\x3d\xa9\x4c\x0b\x37\x74\x8c\xe6\x73\xf7\xb2\x71\x51\xd4\x0c\x43\x73\x38\x75\x6b\x34\xad\x2f\x1b\xb5\xa1\xe8\xf9\x8e\x7d\x1e\xd7\xaa\x27\xd6\x0c\x3d\x40\xd9\xc9\xe3\x9a\xa5\xe3\xab\xa7\x87\x4f\xe1\x9d\x4a\x26\x97\x5f\xb4\x7f\xfd\xe2\x93\xea\xc5\x5d\xe9\xf6\x97\xad\xe1\xe5\x6f\x07\x3f\xbd\x9c\xa7\x57\x4f\x7e\xaa\x95\xf0\xf2\x26\x43\x13\xc0\xa4\x5b\xf5\x95\x61\x9a\xe4\x32\xb9\xfe\xe2\x6d\x56\x53\xd5\x20\x5c\x64\xd5\x6e\xb4\xe7\xf3\x6f\x9f\x7d\x7a\xfe\xb9\xba\xc0\xaa\xfb\xb7\x6b\x3d\x55\xcf\x2e\x06\x93\x3d\x36\xad\x5c\x4e\xee\xec\x50\xfb\x89\x64\x66\x53\x9d\x88\x9a\x10\x70\xbe\xd1\xae\x4b\x6e\xd3\x83\x57\x2e\xe8\x40\x8f\x5b\x8f\x0f\xeb\x73\xfd\x7f\x25\xc4\xfd\x69\xf9\x32\x7f\x0a\xeb\x87\xef\x7e\x67\x30\xf5\x5a\x6e\x86\x12\xba\x11\xde\xc5\x1d\x5c\x8a\xab\xa7\x74\x2b\xf7\x84\xfa\x56\x59\x77\x7e\x58\x1e\xd7\x2c\x3b\xb2\xb9\x23\x7c\xf9\xf0\x04\xd5\x1e\x7c\xbb\x7e\xb3\x2b\x30\x41\xf9\xb5\xfe\xcb\x5f\x9b\xcf\x77\x5d\x66\x3c\xa8\xba\x6d\xd2\xd4\x87\xc1\x01\x8e\x9e\xb9\xd3\x18\xfe\x64\xe2\x8c\x90\x2c\x7d\x1c\xcb\x5c\x62\x60\xa5\xd5\x16\x0b\xf5\x91\xbc\x6e\xa3\x6b\x17\x74\x1f\x90\x8a\x55\x91\x92\x10\x80\xb1\x82\x64\x71\xbd\x8f\xfd\xe0\xdb\x78\xa5\xef\xe8\xd7\xb2\xde\x5b\x24\x13\x91\x1a\xa4\x9b\x95\x30\x99\xa0\xab\x6b\x8b\x1f\x22\x37\x61\x56\x45\x68\x54\x8e\x9d\xf5\xa0\x3d\x1e\x3c\x11\x0a\xfa\x6d\xc1\x15\x1c\x0a\x14\x60\xc6\x6e\x64\x42\x72\xdd\xf0\x83\x14\xd2\x63\x63\x69\x83\xba\x47\x62\x2b\xef\xd9\x55\xc2\x46\x5a\xa1\xee\xd2\xca\xcb\x01\xdb\x1e\xc2\xdd\xa7\x77\x68\xb0\x0a\xd2\x5d\x41\xe2\x89\xef\xf2\x8b\x54\xe7\x82\x26\x9f\x7d\x22\xe9\x67\x68\x4d\x61\x3c\xea\x8b\x61\x7f\x3a\x15\x68\x02\x45\xbc\x25\xad\x67\xe8\xb9\x78\x15\x8e\x86\xcc\xca\xd7\xaf\x8f\x8f\x12\xba\xad\x26\xc7\x8e\x8f\x4e\x2a\xb5\x95\x9a\xb0\xd1\xf1\xfc\xf2\x60\x5e\xce\xcb\xc3\x93\xf9\x55\x39\x3f\x98\x1f\x1e\xd7\x26\x47\x94\xd1\x66\x85\x24\xec\x44\x4d\x95\xa4\x07\x22\x3d\xc1\x75\x29\xaa\x38\xc0\x62\xfe\x98\x66\xc9\xc6\x10\xf9\x9d\x06\xc8\x17\x42\xc3\x00\xe2\xf0\xda\x6c\x12\xca\xf2\xb8\x7a\xb9\xae\xe9\xd2\xd9\xf5\x00\xe0\x65\x93\x76\x34\xa7\x9a\x1a\x53\x0d\x13\xdf\xcb\x03\xb2\x5b\x4e\x96\xcb\x48\x0b\xe5\x72\x98\xa4\x57\x27\x65\x30\xee\x5f\x27\x91\x17\x80\x8a\x82\xb3\x9d\x77\xf6\x89\xb4\xb2\xd2\x55\x7a\xe2\x75\x95\xda\x4f\xeb\xba\x4a\x27\x87\x55\x21\x8f\x2f\xdb\xaf\xae\x5e\x7f\xc5\xf1\x95\xfb\xb8\xd7\xa4\xae\x7a\xb6\x56\x79\x15\x2b\xca\xb2\xd6\xe1\x33\xb2\x3d\xea\xf6\x5d\xfc\x1f\xcf\x97\x8b\xa7\x95\xe3\xcf\xdb\x27\x95\x87\xb9\x9a\x8e\x09\x2f\x68\xf5\x65\x60\xb1\x4a\xa3\x05\xc5\xd7\x5f\xd7\xc3\x89\x18\xe7\xe5\xf6\xaa\x69\xe1\xdc\xcf\x5d\x8f\x1f\x57\xcc\xe5\x9e\x09\xfa\xe3\xaa\x66\x67\xb7\x9a\x6c\x48\xca\x08\xe5\x8b\x82\x31\x51\x3f\xb5\xdc\xe9\x1c\x7f\xd0\x65\xe3\x8a\x04\x75\xd1\xa1\x25\x1a\xa1\xd5\x2b\x6f\x92\x9f\x46\x3a\x6a\x5c\xe4\x86\x3c\xfe\x49\x9d\x93\x0f\x49\xd6\x5b\x45\xf5\x95\x5d\x73\x6b\xd1\x4c\x6f\x15\x4b\x09\x91\xb4\x12\x3d\x06\x3d\x4f\xf8\xb7\xba\xaf\x83\x68\xd2\xbb\x4e\x38\x03\x7e\x7c\x17\x27\xb7\x2f\x44\x04\x02\x83\xe7\x28\x8d\x82\x08\xf0\x45\x9d\x38\x36\x69\x85\xc6\x3b\xf8\xbf\xd5\xbd\x90\x71\xfc\x43\xaf\x7f\xd6\xad\x0c\xb0\x32\xa5\x7f\xa3\xd2\xdc\x9b\x3e\x67\xda\x50\xde\x13\xd2\x09\x51\xdf\xc8\xe8\x1a\x98\xbc\x3a\xdc\x95\x76\x6d\x1e\x10\xd8\x33\x68\x8d\x5c\x51\xba\x72\x3b\xdd\xc8\x6b\x85\x70\x81\x22\xbd\x3e\xaf\x81\xd0\x95\x5b\x65\x65\x07\x6d\xd6\x05\x62\x3f\xd6\xb8\xed\x22\x63\xe3\x6e\x27\x44\xa7\xeb\x9d\xf8\x6c\xd2\x5a\xc6\x31\x84\x1b\xcb\x9e\x84\x00\xdf\x29\xef\x5b\xb3\x5e\x27\x0a\xa3\xa2\xc7\x84\x41\x44\x05\x86\x28\xeb\x22\x1d\xe4\x6a\x8b\xa8\x92\xf1\x5a\x49\x7c\xc5\xd3\xe0\x19\xea\x20\x20\x55\x69\x81\x74\x0e\x0b\x00\xc3\x33\x53\xaf\x45\xa2\x5c\xa4\x1a\xcd\xb3\x05\xc2\x7b\x45\x86\x77\x87\xfa\x46\x4d\x73\x49\x85\x18\xc9\xb5\xfc\x53\xa7\xaa\x0e\x77\xb6\x5b\x13\xbe\xee\x89\xfb\x09\x55\x96\x3c\xf9\x21\xc5\x67\xd4\xe3\x8d\xef\xce\x0a\x6b\x15\x66\x6b\xa4\xd2\xc2\x3f\x7d\x3d\x8c\xb4\x73\xda\xa4\xbb\x35\x3b\x3a\x7f\x40\x29\x9c\x8e\x76\x89\xa8\x52\x3d\xe2\xc2\x9d\xa3\xbd\xd4\x69\x64\x2c\x65\xe0\xdc\xea\xad\xb4\xf7\x1f\x94\xcc\x4c\x68\x8b\x73\xdc\x16\xbc\xe7\x89\x6b\x37\xbd\x89\xf4\x86\x8c\x27\x6c\x97\x16\x53\xc2\x34\x03\x30\xd5\x31\x21\xe8\x79\xec\xc1\x17\xa6\x38\x19\x40\xea\x17\xb0\xf6\xca\x38\x9e\x29\xb9\x1d\x91\x98\x3b\x60\x66\x82\xb2\xdd\xae\x6b\x12\x92\xd9\x01\x6a\xad\x2d\xdf\xab\x8d\x8e\x12\x45\x20\x35\xe8\x07\x79\x4f\x16\x1f\x01\xc6\xaf\x55\x50\x68\xb6\x1a\x5a\x35\x11\x11\x77\x71\x9f\x57\xc2\x7c\xf3\x11\xe6\xdb\x6b\x8d\xe6\x1f\xd7\xe8\x12\xaa\xf3\x76\x38\x33\x9d\xe9\x50\x48\xbd\x55\x71\x27\x9f\x49\xbb\x86\x38\x7a\x7b\x0e\x93\x96\xb6\x3d\x95\x3a\x9d\xdf\x4f\x66\x3d\xc0\x60\x91\xc0\x5a\xea\x0c\xa6\xa7\x3a\xd5\x6e\xf3\x0e\xf8\x14\x99\xe8\x1b\x25\x64\x92\xe0\xdc\x91\xe6\x3a\xd7\x50\xbf\x49\x02\xfc\xb0\x35\x09\xc1\x34\x08\x19\xce\x71\x3a\x41\xa4\xa7\x64\xec\x9f\x23\x95\x22\x48\x37\x60\x18\x8c\x03\x10\xa1\x91\xcc\x46\xd2\x5e\xf3\x6b\x23\xea\x8f\x00\x60\x4f\x0b\xb3\x40\x92\x98\xdb\x1f\x7a\x23\x13\x2b\x82\xbb\x56\xdd\x0e\xd2\xc1\x76\x6b\x96\x30\x55\x13\x8d\x32\x33\x34\x6b\x1d\x0d\xd6\xa9\xb1\x0a\xe6\x0a\x7e\xb9\x27\x61\x1a\x0e\x70\x85\x9c\xea\x44\x8d\x33\x3e\x04\xe7\xc8\xa7\x89\x52\xd8\xb5\x00\x79\xab\xf2\x41\x4a\xe0\x94\x8c\x7a\xcb\x24\xa1\xee\x8b\xd1\xa7\x3a\xa7\x3c\x53\x5b\x20\x08\x9d\xa9\x02\x2e\x3a\xef\x01\xf6\xfd\xd8\x09\xb9\xdd\x1a\xe6\x94\xf5\x56\xe6\xca\x3f\x7b\x06\x2d\xa2\x23\xcc\x4e\x1f\xc2\x21\x3a\xaf\x1a\x1e\x3d\xdf\xc0\x1a\x18\x50\x8a\xbd\x4b\x44\x9b\xf0\x4d\xed\x26\xbb\x35\xf6\xde\x4f\x9a\xb0\xe6\xa0\xef\x02\x87\x73\xa6\xd3\xa9\xe8\x4c\xa1\xf3\xbc\x1d\x32\x30\x1b\x7a\xcf\xb8\xd2\x01\xdb\xdc\x71\xdc\x83\xeb\xd8\x20\x8d\xd5\x5d\x45\x22\x61\x32\xf4\x4c\x47\x94\x9e\xd5\x37\x35\xf4\x6d\x91\xa6\x35\xd4\x77\x61\x44\xb9\x0d\x19\x53\x71\xfd\x6b\x2a\xde\x4d\x5a\xc5\x8d\xa4\x55\xdc\x48\x1a\x0d\x2a\x6c\x5d\xc0\xfc\x38\xf0\xa8\x92\xdb\x80\x84\x61\xdc\xc4\x27\x26\xd0\x68\x14\x22\x58\x7b\x73\x66\x3a\x34\x82\xbc\xc5\x55\x99\xbe\x10\x32\x5f\x8b\xce\xcc\x0f\x42\xbc\xed\x51\xb1\x5f\x98\x1a\xa8\x0b\xf8\xcc\x30\xd8\x88\xe7\xa9\xd7\xe4\x43\x3c\x16\x4b\xbf\xae\x2c\x1b\x4b\x73\xc0\x4c\x9a\x4b\x0d\x55\xe0\x29\x54\x05\x1e\xab\xe6\x7c\x4f\x99\x66\x32\x52\xa7\xc6\x8a\x25\x39\x7d\x2b\x00\x4a\xa3\xcd\x56\xda\x6b\xb1\xd4\xa9\x21\x2b\xa5\xcb\xa4\x58\xa1\x43\xb8\x22\x8d\x75\xba\x7e\x63\xee\xea\xf0\x04\x26\x0c\x8f\x77\xf1\xf4\x96\xe4\xc1\xc7\x05\x0b\x86\xcf\x8c\x58\x5a\xad\x56\x3a\x5d\x23\x77\x03\x5c\x15\x44\xee\x03\xbb\xe6\x11\x98\x9e\x48\x6e\x91\xd9\x11\x42\xa6\x2a\x67\x3c\x92\xb1\xca\x71\x12\x88\x64\x92\xe0\x0f\x9a\xd4\x70\x14\xb8\xe5\xfe\xe1\x41\x9d\x57\xd0\xb9\x55\x19\xba\x00\x0f\x94\xdc\x23\xa9\xcb\x0b\x4c\x7f\xaa\x72\xe6\xb7\x80\x6e\x95\x44\x73\xb9\xdb\x9e\x72\xb9\x35\xf7\x00\x2a\x2b\xfb\xab\x15\xb4\x4f\x1d\xa1\xc6\x79\x77\xd1\x63\x2a\x0e\x29\x58\x0c\x08\x1d\xa7\x0c\xa0\x13\xbf\x48\x6e\x33\x09\x3d\x1d\x0d\xe5\x9f\x92\x6a\xe0\xf6\xdc\x2a\x94\xdb\xae\x40\xe5\x11\xc8\xf6\x1b\x99\x5e\xd7\xd0\x9e\xb6\x0d\xec\x46\xd5\xd0\x53\x13\x15\xae\x81\xdf\x34\x31\xf2\x93\x53\x91\xa0\xda\x2b\x6c\xa2\x92\x26\x81\x87\x4d\x24\xb7\x53\x95\xfb\x8c\x4c\x55\xce\x99\x40\x88\x32\x30\x55\x79\xf8\x38\xc2\x37\x15\x14\x3e\x0a\x95\x6c\x7c\x94\xea\x63\x53\x95\x57\x1f\xaa\xa0\x0b\xa7\xce\xde\xbf\x15\x91\x4c\x3b\x71\xcc\x8f\x1d\x0e\xab\x4e\xf4\x0b\x73\x9d\xf6\x9e\x5a\x22\x8d\x54\x32\x45\x83\x21\x33\xe9\xae\xa1\x4d\x75\xea\x15\x5d\xd3\x53\x6d\x21\x73\xe9\xc8\x60\x49\xd2\x69\xa2\xd3\xf5\x90\x1a\x04\xa6\xd1\x94\x80\xc2\xe1\x54\x1a\xc9\xf4\x22\x85\x36\x1a\xa4\x5d\xb3\x5d\x4a\x4c\x9e\x67\x0c\x1a\x8f\x28\x0a\x81\x49\xe1\xf3\xac\xd8\x8a\x68\xd9\x25\xa1\x42\x11\x2d\xa7\x2a\x0f\x08\xea\x17\x90\x73\x0f\x3f\xc8\xa3\x8d\x92\xb9\xab\x61\xd1\x75\x67\x70\x4a\x42\x2c\x84\xbe\x0f\xb7\xc1\x22\xd2\x37\x3a\xd1\x32\x25\xa5\x66\x1c\x5c\x28\x8e\xd1\x49\x12\x1c\xfb\xa7\xd6\x6c\xab\xba\x82\x90\x26\x57\xff\x90\xc4\xfc\x07\x06\x04\x4e\xd9\x11\x5e\x71\xc2\x4d\xb4\xfe\x4e\xe0\x86\x11\x6b\x32\xac\x0f\x49\xf5\x37\x1b\x2c\x2a\x52\xc6\x37\xca\x26\xf2\x9e\x90\x89\x8c\x35\x27\x51\xe7\xa6\x76\x09\xf5\x14\x6b\x3c\x13\xb9\x3e\x00\x26\xd4\x92\x9e\x69\x4f\xcb\xc4\xac\x3d\x4c\x1a\xa2\x88\x84\x8f\xf2\x3d\x15\x4d\xc9\x33\xab\x14\x92\x54\x94\x03\x4f\xf4\x4e\xbb\xdc\x58\x88\x05\x5d\x80\x66\x25\x9a\x7f\x3a\x36\xd7\x49\xa2\xec\x3d\x75\x2b\x22\x76\x37\x68\x4b\xdb\x2f\x60\x0c\xd5\x63\x9c\x1a\xe0\x31\x02\x36\x1d\x79\xf0\xad\xca\x61\x22\x65\x4c\xa7\x6b\xe4\xcd\x19\xa5\x2e\x4b\x30\x57\x10\x21\xd3\xdc\x64\x01\x2e\xb2\xcc\x2a\xe7\xf4\x8d\xaa\x7f\xd1\x8f\x33\xc2\x3e\xb0\xc2\xce\x16\x35\x14\x23\x9c\x24\xab\xaa\xd8\x66\xe4\x0f\x1f\x9f\xa7\x68\x82\x80\x74\x73\x73\xe5\xf3\x9a\x19\x87\x6e\xa6\xd8\x09\x08\xfa\xa1\x53\x8e\xb1\x53\x76\xa7\xbf\xd2\xeb\x77\x5a\x59\x69\xa3\x8d\xf7\x16\x42\x9d\x96\xc0\x02\xe6\x75\x04\xcf\x49\xb6\x54\x87\x14\x88\xc7\xc1\x6d\xe4\x50\xbb\xbc\x41\x1d\x99\x78\x17\xf7\x71\x60\x1b\x3c\x63\x4f\x30\x33\x03\xbc\x58\x44\x1c\xac\xff\x16\x72\xb3\xd8\xd1\xbb\xb9\x05\x4a\x76\x0f\x83\xa6\x9b\xe8\x6c\x69\xa4\x8d\x91\x32\x33\x4d\xdc\x33\xf9\xac\x93\x4c\x3e\x7a\xe0\xb7\x9f\xaa\xed\x3d\x81\xa7\x56\xab\x34\x4e\x18\x43\x1e\x1b\xa1\xd9\x7d\xc6\xd0\x45\x7a\x9d\x9a\xdb\x54\x44\xb8\xd8\xd0\xbe\x7d\xeb\xe5\x4e\x6a\xd4\x3e\xb9\x2b\x21\x42\x07\x99\x7d\x82\x79\xb1\x25\xc4\x77\x68\x8f\xd8\xfb\xa1\x86\xe9\xba\xc2\xd9\x35\x5c\x8d\x32\x2d\x88\xdf\xf0\x24\x1e\x06\x88\xbd\x55\xd2\x36\xd2\xc4\x4a\xf2\x70\x21\x6d\xac\x62\x64\x25\x99\x34\x34\x91\xac\x65\x9c\xf8\xfa\x06\x32\xc4\xe3\x5a\xa6\x60\x27\x26\x10\x36\x85\x1e\x44\xc6\xbf\x99\x91\xd1\x79\x97\x57\xcd\x26\x9d\xe6\xf3\x71\xbd\x04\xd5\x14\x1f\x08\xb9\x4f\x7b\x0a\x1c\x0a\xf3\xd5\x44\xa9\xc5\x43\xa6\x8e\x41\xab\xd1\xb3\x32\x61\xb8\x31\x24\xd0\x73\x89\x0d\x0c\xb6\x22\x4d\x4a\x28\xe5\xad\x88\x72\x9b\x74\xf8\x74\x86\x90\x9d\x83\x09\xa4\xa1\x15\x02\x84\x8a\xdc\x4c\xf1\x2e\xba\x87\x36\x13\x9a\xb4\x89\x42\xc3\x09\x4d\xe2\x34\x53\x8a\x68\x61\x49\x01\xb8\x5a\x0c\x00\x63\x66\x28\x80\xb9\x8f\xc6\x4c\x4e\x6e\xe1\x83\x8a\x41\x5a\x79\x6a\x20\x45\x3e\x95\x31\x11\xdf\xcd\x46\xc3\x21\xb3\x28\xb9\x4d\x06\xbd\x2e\x3f\x7b\xf8\x1c\xc9\xac\x93\xea\x2d\x2e\xd8\x15\xda\x25\xa5\xc0\x1a\xa1\xca\x14\x53\x7a\x26\x55\x1e\xef\xa2\x74\xa4\xc7\x46\xa6\xa0\x69\xd9\x13\xc8\x17\x5b\x40\xac\x52\xe9\xcc\xa0\xe3\x32\x4f\x44\x64\x66\x28\x8c\x88\x26\x56\x49\x05\xf5\xb4\xed\xa4\xf1\x45\x56\x51\xaa\x44\x49\xf5\xb3\x06\x76\xeb\xd3\x04\xd1\xc3\x28\xcd\x6d\x32\x41\x57\x50\x9d\x24\xa9\xb7\xad\xab\x05\x3d\x68\xf3\xea\x5b\xcc\xde\xde\x28\xf2\x9f\xe8\x49\xa1\x4f\x00\xb2\xa7\x5b\x34\xc8\xb5\x9e\xd1\xa0\x57\x9d\x03\x79\x37\x2f\x56\xd6\xf8\x52\xbd\xdb\x4c\x55\xfe\x30\xa7\x2a\x0f\x2d\x5f\x71\x78\x1e\xe1\x5a\x62\xf8\xdd\xf3\x26\xf6\xa6\x81\xbe\x68\x62\xcd\xc0\x1f\x9a\x58\x33\xf0\x6f\x4d\xac\x19\xf8\xf7\x26\xd6\x0c\xfc\xb1\x89\x35\x03\x51\x3c\xf9\x21\x65\xa7\x14\x44\x7b\xb1\x87\xf6\xc3\x1e\xda\xdf\xf6\xd0\xfe\xbe\x87\xf6\xe3\x43\x5a\x38\xa3\xaa\x07\x9d\x37\x90\x46\xfe\xf7\xc4\xb7\x6a\x4f\x0b\x57\x1d\xdf\x23\x8d\xbe\xef\x89\x8d\x2e\xd9\xe8\xde\x53\x95\x37\x02\xa7\xfe\xbe\x2c\xa6\xe5\x9e\xa8\xbb\x70\x23\x0f\x33\x63\x92\x5c\x67\x3b\x28\x46\x81\xcd\xe4\x1e\xf2\x74\x53\xeb\x75\xf5\x80\xea\x3b\x1b\x73\x1b\x00\xca\x69\x08\x03\xa0\xd6\xbe\xb4\xe4\xe6\x36\x79\x4f\x22\x83\x22\x6a\x1c\x4a\x47\xf5\x13\xe9\x26\x86\xe7\xcd\x0f\x28\x5d\x6f\xa6\x85\x03\x8c\x5a\x79\x78\xf7\x04\x3b\x7a\x78\x7c\xfd\x90\x44\xf9\x23\x72\x6d\xb2\x6b\x9c\x71\x33\x36\xe1\x4b\xa1\xea\x0c\x81\x03\xa6\xa8\x48\x04\x23\x99\x70\xcf\xa2\x74\x8d\x0b\x26\x52\x80\x99\x08\xa7\x5d\x9e\xd8\xa5\xed\x47\x40\xfd\x2e\x9a\xd0\x70\x24\xbd\x83\xb3\xbf\xcc\xbd\x54\x3e\xd3\xd9\x09\xdb\xa1\x16\x7f\xfe\x99\x84\x54\xcf\x82\x90\x12\x13\x68\x69\xae\xe0\x60\xf8\x65\xb6\xb1\xe6\x56\x2e\xab\x57\xdf\x93\xc3\x4c\x64\xc0\x89\x12\x4e\x60\x3d\x4e\xa7\xb0\x0d\xac\x11\xbf\x7e\x96\xca\xb4\xff\x51\xd6\x70\x0b\xbb\x70\xad\x40\x88\x67\x9b\xd1\xa1\x1d\xf1\xf5\x08\x32\xfb\x5d\xe0\x7c\x89\x1c\x7b\x91\x8f\x97\x7f\xc0\x63\xe2\x22\x78\x60\xf7\x8c\xe9\x28\x32\xf6\xbe\xf4\x67\xe6\x8c\x2c\x7a\xc4\xf2\x1e\x9d\x19\xc6\xaa\x53\xbb\xd0\x89\x95\x3f\x39\xa1\xb7\xd5\xb2\x58\x03\xc3\x8d\xc0\xd0\xac\x45\xac\xe0\x0f\x56\xef\xc6\x51\x2d\x91\x3a\x39\x03\xcc\x29\x32\x42\xdb\x18\xe8\x03\x44\x68\x5c\x9e\x10\x89\x96\x3a\x82\x83\xab\x5a\x42\x03\xa3\x47\x28\x33\x7a\x75\x84\x58\x20\xa2\xd0\xd6\x9f\xe1\x70\x52\x4f\x38\x72\x67\x0c\xe6\x32\x2f\x3c\x1d\xb9\x31\x02\x3d\xd3\xd5\xc0\x90\xe9\x22\x4a\x68\x6c\xf6\x96\x1d\x2b\x92\x1b\xa2\xb3\xe4\x58\xcb\xf5\x82\x44\x2f\x99\xb5\x3c\x9d\x8e\x1a\xe4\x29\xaa\x70\x36\x49\xff\x39\xdd\x4b\x9d\x36\xa8\xb0\xb9\x2f\xac\x42\x87\xc0\x0d\xca\x34\x31\xb7\x75\xaa\x89\xd5\xb9\xb2\x68\x2c\x35\x8d\x98\x18\x5b\x79\x8b\x5d\x10\xb1\xe0\x43\x32\xc0\x31\x21\xab\xcc\x05\x60\xa4\x53\x86\x21\xed\x33\x43\x08\x8a\x19\x10\x04\x7d\x81\x81\x9d\x2c\x6c\x95\x5d\xab\xda\x89\x13\x12\x2d\x6e\x06\xa8\xd7\x0f\xf5\x56\xfb\x72\xe5\x3a\xba\xc6\x4b\x4e\xc2\xf0\xda\x0c\x60\x4e\x3f\xec\x19\xfa\x64\xd2\x20\xd6\xf1\xcf\x83\x73\x7e\xd0\xf6\x3a\xd6\xab\x95\x8e\x8a\x04\x3a\x4c\x00\xfb\xa1\x5c\x3b\x94\xc9\xac\x57\x23\xb2\xf5\xde\x20\x56\xce\xd0\x54\xde\x7b\x17\x9b\x9d\x81\x87\xa0\x1b\xe3\xe0\xc7\xcb\xde\x40\x4c\x6f\x94\x75\xdc\x41\x89\xd6\x0b\x63\x88\x6e\xda\x98\x7c\xf6\xfe\x6d\xff\x63\xa1\x33\xdc\xf0\x32\x0d\xf8\xb5\x5c\x4d\x55\xea\x8c\x0d\x11\xa7\xca\x6a\x99\xe8\x3f\x1b\x89\xce\x06\x0f\x5e\xbe\xe8\xbc\xe7\x8b\x57\xc9\xc7\x33\x3e\xc0\x29\x3b\x48\xb3\x22\xf7\xb6\xa8\x76\xf7\x01\x4c\xc6\x1d\x28\xc3\xb8\x3f\x65\x98\x19\x52\xc6\xbe\xc0\x6e\x36\x42\xf7\xa5\xbe\xcb\xde\xc5\xda\xa1\xad\x09\x1a\x6d\xde\x8f\x97\x07\x5e\xf4\x02\x38\xfd\x88\x91\x49\xf2\x57\x9b\x14\xd6\x20\xa8\x08\x03\x33\x0e\x1f\xce\xc6\xa6\x79\xee\x11\x1b\xff\xa0\x73\x8d\xd8\xe0\xac\x65\xf8\x34\x23\x36\x78\x72\x11\x1b\x63\xe9\xda\x22\x36\x78\x62\x11\x9b\xdd\xc3\x8a\xd8\xf0\x84\x1b\x1b\x3a\xa2\x80\xb1\xd3\xb1\x16\xd2\xb4\xf2\xb6\x8f\xf2\xcd\x0a\x61\xbc\xd3\xf5\xc0\x0f\x3d\x04\x87\x68\x90\x8e\x81\x8a\x76\x4d\x40\x98\xc9\xac\xbc\xf5\xce\xa3\x01\x9e\x90\x45\x2c\xe8\xf9\x74\xc5\x80\x86\xaa\x95\x74\xb9\x50\xd1\xc6\xa0\x47\x57\x28\x3c\x4f\x2a\xc1\x71\xba\xc3\x10\x9e\x48\x15\xce\xaa\xbb\xb5\xae\xf0\xd0\x18\x19\x7e\x7f\x1a\x84\x9a\x62\x9e\xe5\x72\xec\x52\xb6\x33\x08\x80\x3f\xfc\x63\x5c\x6f\xcf\xad\x8a\xb0\xe7\x7b\x12\xde\x12\x78\xa4\x88\xb5\x69\xbe\x12\xee\xe3\x3d\x5a\xb8\x5c\x47\xfe\x4b\x9e\x01\x60\xac\x31\xaa\x3c\x2d\xd3\x89\xc9\x19\xdb\x1d\x4e\x4c\xd5\x72\x3d\x44\x85\x7c\x26\xf4\xd3\x98\x8f\x0f\x3c\xbe\xd6\xa9\x0a\xbd\x24\x50\x6b\x0e\xe3\x90\x72\x2a\x73\xbd\x2e\x7c\x5e\xdf\x16\x29\x7a\x41\xf7\x9f\x19\x4c\x86\x12\xfd\x76\x12\x3a\xd2\xdb\xaa\x20\xe7\xca\xba\xb0\x90\x13\x89\x16\x65\x86\xe9\x90\x9d\x11\x93\x51\xb5\xf9\x32\x4e\x65\x7e\x86\xea\x0b\xe3\x94\x79\x1b\x4f\xbf\x01\x46\x80\x11\x58\x46\x53\x58\xc0\x18\xd7\xdb\x82\xfd\x7e\xef\x12\xf8\x1c\x92\xc9\xb9\xdc\xea\x54\x32\x06\x03\x8e\xae\x9e\x99\xf0\x70\xe2\x08\xf4\xea\xa4\x89\x48\x8d\x83\x66\x95\xc6\xb0\x13\x87\x96\xa0\x8d\xae\x4a\xfd\x2d\xbd\x50\x58\xdd\x63\x0f\xb8\x81\x1b\xa7\x30\xd7\x32\x3a\xc9\xb6\x35\x6c\x66\xec\xc7\x42\x11\x81\x19\x08\xe5\x72\xbc\x89\x8c\xfb\x69\x3c\x45\x27\xab\xb8\x2a\x04\x32\x60\x43\xb5\xca\xd1\x74\x1e\xf5\xf8\x8e\x5d\x17\xd4\x90\x37\xca\xde\x87\x63\x67\xc4\xaa\xbb\xa9\x60\x18\x87\xb8\x0e\x5a\x54\x91\x00\x33\x04\x3c\xdf\x8f\xbc\xb9\x3d\x5c\xca\xeb\xa7\xf5\x24\xd5\xf4\xf3\xe0\x7c\xa4\x9c\x43\xc9\x1a\x75\xaf\x7a\x61\xb5\x50\xf7\x78\x9f\x81\x82\xef\x2b\x16\xd0\x59\xc9\x58\xa1\x44\x02\x42\xd4\x21\x00\xc2\xf3\x20\x82\x32\xa5\xa2\x8d\x58\x49\xed\x6f\x9e\xc5\x4a\x27\x09\xf1\x85\x78\x84\x8e\x47\xc8\xe8\xc1\x19\x7e\xba\xe6\x06\xcd\x84\x42\x07\xa7\x03\x29\x80\x1b\x4c\x14\x12\xea\xc3\xfa\x21\x65\xa2\x64\x4c\x6f\x9e\x29\x69\x95\xe3\xb3\xc3\x15\x5e\xc2\x73\x3e\x06\x29\x79\x8e\x06\x3e\x69\x05\xb3\x21\xfc\x84\x3b\x7d\xb4\x2f\x10\xaa\x79\x95\xc8\x35\xfe\xd0\x2a\x0c\x10\x1e\x3a\x02\xe0\xdd\xff\xaf\xf8\x6a\x9b\x0d\x0f\x25\xf7\x83\x94\xb7\x49\x35\xb8\x33\x1d\x8a\x95\x59\xc3\x1f\x6c\x29\x23\x98\xf5\x56\x66\xcd\x97\x63\x68\xf9\xb0\x53\x49\x41\x20\x1e\x8f\x64\x46\x50\x9f\x74\x17\x22\x15\x28\x13\xe0\xec\x6f\x53\x42\xe8\x48\x02\xc1\x0f\x32\xb9\x66\x08\x2b\x1a\x67\x7b\x8f\xe7\x1b\x65\x61\x5e\x5a\x2b\x2f\xc1\xc5\x52\x19\x0d\x8c\xce\x42\x1b\x24\x5c\xcc\xbc\x63\x49\x7c\x60\xe5\x7b\xa8\xea\x2a\x81\x34\x44\x75\xad\x0a\xa7\x74\x5c\x45\xa8\x9a\xd0\x53\x70\x77\x42\x18\x72\xe5\x00\xfa\x64\xac\x52\x43\x63\xae\x51\xe0\x8c\xba\x84\x58\x15\x2a\x11\xab\x22\x49\x90\x6f\x5d\x2b\x69\x07\xbd\x2e\xca\xf5\xe0\xc1\x31\x10\xa6\x89\xc9\x1f\x52\x7a\x32\x97\x62\xad\xf2\x6a\x9d\xa5\x4b\x7d\x4f\x0a\x6e\x3d\x99\xc0\x3b\x58\x8f\x05\x21\x30\xe7\x49\x7c\x92\xdc\xc0\x06\x3d\x8f\xbf\xb5\x3a\xf6\x30\xde\xfa\xf8\xed\x32\xd3\x50\xc0\x23\xec\x30\x3c\x11\xe6\x8f\x9d\x88\xbb\xcb\x62\xa0\x87\xbd\x2d\x13\xc2\xa6\x75\xad\xf2\x0e\x6a\x32\xe2\x5e\x1a\xb0\x24\x79\xa7\x73\xda\xfb\xb2\xf4\x06\x51\x83\x1c\x11\xe6\xa0\x92\x8e\x02\x24\xd5\xdb\xc6\xea\xc8\x34\xec\x74\x21\x61\x14\xb5\x43\x80\x57\x22\x48\xa3\x41\xe8\x9a\x6d\x56\xe4\x0a\x96\x6e\xf2\x49\x5d\x0f\xec\xcf\x3a\x88\x7b\xa1\x84\x9a\xd8\xc9\x1e\x3a\x1e\x1f\xaf\x55\xde\xbc\x75\x03\x42\xa2\x14\x4c\xdc\x24\x60\x84\x4e\xcb\x55\xfe\xa6\x40\xbd\xf5\xf7\x32\x29\xb0\xb8\xdd\x70\x71\x5c\xf5\x5b\x24\x07\xb1\x0b\xc0\x70\x6b\x37\x5e\x8d\xa4\xc3\x9c\x76\xf1\xa6\x2b\x74\x94\x1a\xca\x1b\x4d\x20\x52\xd7\x50\xf1\x45\xe7\x3d\xe2\xb8\x83\x6d\xb6\x80\x97\x93\x01\x50\x91\xa5\x1b\xfe\x7e\x4f\x5b\x7e\xbc\xd7\xae\x90\x09\x22\xc3\x2e\x7e\x9d\xba\x7c\xd5\x11\x09\xc7\xbd\x4e\xc0\x68\x8e\x9c\x46\x26\x23\x62\xa2\x6e\x70\x58\x8d\xd1\xf4\x03\x90\x3c\x0f\x00\x20\x5e\xa3\x20\x34\x1d\x79\x21\x1a\x44\x0b\x95\x84\xea\xac\x64\xfa\xea\x08\x4f\x59\x75\x12\x62\xef\x74\xac\xd0\x47\x07\xc2\x39\x3f\x42\x8d\xfa\x8e\x57\xf5\xbb\xea\xe2\x73\xad\xf2\xe6\x9d\x26\x12\x60\xd3\x4b\x07\x5e\x01\x85\x75\x28\x20\x53\xb4\x84\xe6\x31\x3c\xee\x41\x8c\x5a\x8c\x87\x0b\xed\xd0\x1e\x10\x42\x6f\xf0\x97\x23\x5c\xd3\x8c\x3e\x18\x94\x78\x9a\x37\x48\x57\x94\x35\x18\x71\x61\x02\x5b\xab\xbc\xea\x03\x3b\x6b\x76\xa0\x74\x37\x3a\x89\xad\x4a\x2b\x4a\x6f\xd8\xad\x90\x91\xcc\x71\x3b\xe4\x2a\xd2\xf9\xff\xc7\xda\xbb\x37\xc9\x6d\x63\xf9\x82\x7f\xef\xfd\x14\xe8\x9c\xee\x6b\xc9\xaa\xd2\xcb\xee\xb6\xbb\xfa\x7a\xec\xd2\xcb\xd6\x6d\x49\xd6\xb8\x24\xfb\xce\xa8\xd4\x1c\x24\x89\xcc\x84\x0b\x04\x58\x00\x98\x0f\x3b\x1d\xb1\x1f\x6b\xff\xdf\xff\xf6\x53\x6d\x9c\xdf\x39\x20\x99\x59\xe5\x9e\xbb\x11\x1b\x91\x89\xf3\x20\x09\x82\x24\x1e\x07\xc0\x79\x20\x56\xfc\x40\xca\x60\x33\x39\xa1\x3c\x30\x53\x5c\x9f\x65\xba\x41\xdc\xed\x6e\x69\xfc\x41\x43\x60\x49\xee\x7d\x32\x4d\x79\xda\xb7\x24\x80\x72\xb5\x3a\x68\x0b\x13\xfe\xf4\x31\x27\xec\x1f\x02\x7b\xb2\x38\x62\xcb\x18\x4a\x4c\x68\x73\x15\x29\x78\x60\x88\x76\xe2\x40\xbf\xe7\x8e\x52\xbe\xeb\xdb\x90\x68\x9c\x1c\xb0\xb1\x25\x30\xfd\x53\x41\xdf\xbd\x1a\xb1\x83\x93\x0e\x08\xde\xbc\x58\x42\x27\x42\x9a\x97\x68\x47\x00\x9b\x4c\x4a\x9f\x89\xa1\x25\x1f\xe8\xb4\x8d\x43\x3d\xfc\x61\x70\xd2\x41\xd4\x05\xdc\x29\x4c\x5f\xf4\xc5\x2a\x64\x9e\x4a\x22\xdb\x51\xcd\x81\x08\x0c\xcc\x84\x88\xa8\xca\x68\x1e\x6a\xdf\x30\x2f\xe3\xdc\xdf\x99\x18\xb5\x9d\x48\x0c\x4b\x59\x02\x5e\x9a\x4c\x9d\x1e\xe5\x1b\xfa\x81\x7c\x07\xf7\xf7\x4b\x93\xa7\x2d\xf8\x40\x96\x5d\x9a\x3c\xdd\xd6\x1f\xc8\x8b\x0d\x77\xd7\x3f\xbd\xc5\xdb\x70\xf4\x30\xe7\x59\x9c\x44\x62\xf9\x8d\x51\x16\xec\x96\xa2\xe6\xbb\x0c\x39\x28\x98\x5b\x73\xca\x27\x12\x46\xed\xfe\x8d\xc9\x2f\x1b\x26\xa9\x4f\xe0\x51\x88\x4b\x35\xf4\x13\xc3\x90\x06\x8e\x9c\xcd\xa2\x15\x50\xb9\x1f\xa1\x65\x14\x63\xbd\xbf\x09\x8b\x7b\xff\xd8\x61\x2e\xbf\x64\xed\xb4\x65\x9f\x72\x52\x2b\xed\x32\x8c\x5c\x44\xf7\x38\x15\x62\x58\x6f\x15\x5a\x16\x2f\x89\x4a\xd4\x55\xad\x74\x7a\x39\xf8\x9f\x5f\xe9\x34\xa9\xd1\x44\x96\xd3\xeb\xf3\x41\xcd\x72\x55\x03\x91\xbe\x70\x55\x8b\xa0\xb2\xaa\x87\x75\x84\xe1\x44\xe6\xf0\xd2\xdf\xaa\x1e\x06\xe7\x82\x8e\x07\x46\x74\x15\x36\x4f\x74\x14\xcc\x03\x2d\x2a\xd4\x2b\xdb\x98\x27\xa1\xd9\x01\x11\xb9\x78\x44\x65\x7e\x44\x8c\x8b\xe2\x03\x4b\xad\xac\xcf\x48\x9e\x72\xaa\x1b\xc3\xf4\x85\x75\xd4\x49\xad\xda\x46\xad\x42\x2a\x7d\x9f\x5a\xe5\xd6\xa1\x06\x7f\xf7\xfe\xd9\xeb\xb0\x86\xcf\x94\x57\x66\x6d\x5c\x52\xab\xbe\xb5\x0d\x09\x3b\x70\xd1\x20\x06\xb3\xe3\xc3\x16\xab\xdc\xda\xb0\x85\x11\x56\xe6\x19\xb0\x84\x60\x7d\xad\xe1\x5f\x88\x26\x3b\x3c\x9a\x5a\xdf\x98\xce\xc0\x6f\x94\xb2\x7e\xe1\x74\x6b\x0a\x6c\x94\xf5\xdf\xea\xd6\xbc\x7f\x79\x3c\xdf\x17\xf3\x13\x4c\x23\x10\x45\xe0\x9c\xc3\xb5\xbf\xb2\x0b\xba\xba\xac\x3a\xc0\xcd\x9d\xac\xa5\x58\x8f\x45\xd3\xef\x17\xe3\x64\xd9\xfa\x64\xe2\xc1\xf0\xc9\x3e\xc3\xa1\xba\x69\x13\xc9\x52\x02\x5e\xf7\x2e\xdb\x0e\x3d\x96\xb2\xe9\x9c\xa6\x9b\xe1\x49\x84\x44\x4d\x34\x24\x66\x2b\x06\x07\x36\x9d\xf7\x39\x7c\x47\xd3\x99\xef\xbd\x50\x3e\xb4\xa1\x4f\x42\x64\x93\xe8\xec\x22\xac\x10\xca\x22\x8a\xb2\x09\x9b\xcc\x04\xcb\x82\x01\xe6\xe9\xc8\x47\xd6\x0b\xca\x9a\x9f\x4d\xcf\x4c\x63\x6b\x7a\x91\x84\xbf\x7a\x7a\xbe\xd6\xd6\xa1\xbd\xd9\xf4\xbc\xcc\x53\x6d\x7a\x7e\xdd\x6b\xf7\x2e\x0c\x18\x8d\x1a\x13\xfc\xdc\xb9\x03\xd2\xef\x0e\x48\x79\xa2\x81\x21\x15\xde\xa6\x17\xd6\x99\xb7\x3a\xd7\x2b\xeb\x97\x63\x91\x5e\x38\x9d\x56\x6e\x28\xf2\x0b\xa7\xf3\x73\x71\xb3\xfa\x02\x53\x1a\xcc\x4f\x40\x1c\xcc\x13\x6c\xfa\x0e\x06\xf7\xca\xa6\x97\xfe\x86\x7a\x38\x31\x8b\xe1\xeb\x0b\x38\x43\x1c\xef\x28\x4b\x17\xb8\xdd\xdf\xcd\x8e\xb7\x7d\x09\xb7\xbe\xf9\x7e\xa1\x6c\x1a\x5f\x20\x16\xd0\x11\xa5\xcc\xa6\xd7\xda\xf7\xda\x61\x76\x44\x44\xbc\x32\xcd\x8b\x10\x27\x6b\xf8\x36\x1d\x7e\xf3\x09\x75\x11\x1c\xbd\xce\x37\x96\xde\x1c\x7a\x21\x02\x90\x00\x6c\xe2\x7a\x34\x3c\x0d\x93\x3f\xbc\x7b\x46\xb8\xff\x81\x5a\x95\x4d\x6f\x6d\x37\x3e\xc0\xdb\x72\x87\x1f\x8c\x76\x58\x0d\x20\x94\xc6\xa6\xe7\x6c\x71\xdc\xdc\x60\xfc\xcf\x97\x6f\x95\x4d\xbc\x7e\x40\xc8\x2a\x6c\xac\x5f\x7e\xf6\x8c\x45\x31\x9b\x58\xa7\xfa\x9c\xfd\x47\x10\xcd\xa3\xcf\x78\xcf\x8b\x6c\x74\x5b\x9a\x3b\x91\xd1\xe8\xb6\xa8\xd6\xbc\x7f\x39\x9e\x88\xc1\xc7\xa6\x77\xa1\xc7\x87\xfe\x96\x7d\xd1\xda\xf4\xae\x8f\xde\x34\xdf\xf7\x38\xd8\xe7\xef\x68\x42\x31\x5e\x35\x5d\x79\x41\x75\x1c\x19\x7c\x9c\x67\xb9\x63\x01\x0f\xc6\x2c\x9b\xa8\x92\x70\x73\xe0\x9e\xf7\x99\xe9\x5c\xd8\xe1\x4c\x66\xfc\x60\x12\x72\x1a\x04\x48\x38\x3f\xe1\xf4\x27\x9b\x57\xa3\x4e\xee\xcf\xc1\x7a\x24\xe7\x49\x80\x74\x7a\x44\x4c\x51\x36\x1a\xbc\x9a\x9f\x37\x0d\x4d\x10\xe7\x3a\x99\x43\x8a\x45\x9b\xc4\xcc\x77\xa1\xb3\xb5\xba\x9a\x7f\xa7\x53\x41\x7f\x30\xba\xce\x80\xd4\xdd\x17\xee\x3b\xe3\x9c\xba\x9a\xff\xa4\xd3\x85\xb6\x8d\xba\x32\x3b\xd8\x2e\x11\x02\x55\x92\x2b\x1f\x36\xe9\x7c\x4e\x83\xba\xd3\xbe\x41\x72\xce\xb8\x04\xdb\x2b\xde\xb8\x94\xa3\x7a\x2e\x02\x96\xa3\x42\x28\x37\x67\x0d\x10\x37\x67\x31\xd9\xcd\x9f\xf6\xf1\xc2\x38\xe5\xe6\x98\xe4\xba\xb9\x68\xa0\xb8\xf9\xcb\x34\x0c\x3c\x6e\xfe\xd6\xb2\x65\xa6\x9b\x8f\x83\x84\x83\x06\xa5\x64\x43\x68\xc9\xe9\xc2\x64\xc9\xec\x82\x44\xbd\xf1\xca\x42\x4c\x2e\x9a\x72\x06\x91\xea\xc6\x91\x49\x49\x2e\x4c\x66\x6a\x92\xc9\x84\xf1\x03\x56\x51\x26\xdc\x72\x51\xd9\xfa\x06\xc1\xd2\x94\x9b\x63\x3e\xe0\xe6\x17\x21\x66\x01\x4f\x76\xe5\x18\x2a\xb2\x9b\x0b\xc9\x9d\x0e\x03\xe8\x9f\x3d\x33\x58\x14\x9a\x70\xbe\x35\xf9\x87\xb0\x49\x53\x56\x39\x65\x3d\xec\x9f\x39\x3b\x8f\x3a\xee\x9e\x46\xd3\x90\x90\x22\xe4\x33\x9b\x6a\xa7\x6d\x6b\x22\xb1\x16\x86\xc7\x39\xf4\x8a\xe7\x53\x7d\x74\x70\x9e\x99\x63\xce\xcb\xf4\xbd\x67\xcc\x63\x9e\xec\x6c\x6b\x45\x92\x74\xd6\x1b\x1d\x65\x37\x06\x5f\xcd\x7a\xf3\x04\x5e\xc2\x09\x1b\xec\x0e\xd2\x11\xf9\xfd\xfc\xe7\x63\xd6\x45\x0f\x69\xe7\x98\x8d\xf5\x69\x67\x3d\x94\xdc\x47\x4f\xd7\x65\x5b\xdc\x79\xe5\x3c\xd5\x3d\x1e\x18\x18\x7f\x0a\x3f\x66\x42\xfc\x10\x36\x84\x49\xc5\xf4\xa5\x66\x7a\xa9\x9a\x72\x98\x6b\x94\x97\xfa\x39\x66\xc0\xb4\x9c\xf4\x2d\x2a\x64\xdf\xfa\x34\x4c\x42\x9c\x1f\x2b\xaf\x9f\xd4\xd8\x82\xcb\xc9\x85\x31\xbd\xe5\x50\x8f\xfd\x41\x45\x06\xc5\xb5\xc3\x4f\x2a\x93\x97\xda\xe4\xe7\xe5\x98\x1c\xe0\xc0\x1f\xba\x39\x9f\x27\xc0\x61\xa5\x90\x08\x6c\x04\x12\x42\x52\x0b\x90\x61\x97\x97\x88\x41\x0c\x25\xa2\xa8\x81\x12\x2e\xfb\xb4\x84\x96\x15\x40\xc2\xa1\x66\xcd\xe6\xd8\x4e\xc6\x2e\x20\xb0\x5d\xa1\x71\xa7\x10\xe3\xfb\x09\x28\x49\x7d\x25\xd3\xb1\x20\x04\xf5\x91\x84\x89\x6d\x07\xa1\x54\x9d\x00\xc6\xa3\x83\xed\x07\x13\xb2\xe6\x4f\xc4\xe4\x31\xea\xab\x09\xff\xa7\xb7\xf0\x79\xee\xc2\x72\x98\x2c\xbb\xb0\x7c\x63\xf2\x26\xc4\xab\x09\xfa\xae\xb8\x9c\x55\x2e\x84\xab\xf3\x2c\xe0\x2d\x5c\x8e\x4f\x27\xfe\xed\xd0\x73\x0f\xd8\xf9\x68\x06\x34\xf2\xda\x36\x1c\x52\x47\x97\x13\xeb\x05\xbd\xa2\x81\x23\xfb\x0f\x47\xf4\xf0\xf9\x8e\xf8\xe5\x33\x1c\xb1\xf1\x45\x06\x9e\xbc\x88\xf6\x40\x17\x43\x8a\x36\xe8\xeb\xb5\x53\x5d\xbd\xf6\x40\x4f\xaf\x9d\xe8\xe8\xb5\xba\x93\x35\x27\x2f\x13\x8f\x56\x77\xdf\x46\xdb\x0c\xdf\xb6\xd5\xf1\xea\x3c\xb1\x39\x99\x69\xbe\xf7\x18\xc4\xc1\x35\xf1\xdc\x75\x2b\x2d\xf8\x93\xd8\xa7\x95\xe0\xdc\x3c\x18\xa7\x19\x70\x3b\xac\xa2\x30\x76\xb1\xd2\x9d\x29\x38\x55\x2f\x46\x51\xe7\xdb\x71\x59\x65\x8c\x53\x93\x54\x6b\x7c\x2f\x22\x35\x50\x7a\x44\xe3\xfb\xa2\xf2\x06\x9c\x9f\x95\x30\x51\xf7\x06\x81\xe6\x07\x84\x47\x25\x42\x45\x2f\x72\x44\x39\x87\xe7\xdb\x8e\x46\x43\x42\x21\x48\x03\x2b\x6d\x96\xf0\xd1\x80\x45\x28\xdc\xbf\x10\xc3\x9d\x26\x2d\x5d\x48\x6e\xc6\x20\x56\x21\xe6\xba\xcf\x07\x04\x3f\x39\x31\xf0\x36\x08\xa1\xe1\x84\x90\xe1\xd0\xfb\x1f\x5e\x01\x4a\x56\xd6\xd3\xdf\x88\xe4\xd9\xb2\xc2\x0e\xc6\xaa\x27\x3b\xd5\x4e\x17\x9d\xd0\x3d\xb4\x45\x1b\x77\xd8\xcf\x17\x0e\xe4\x81\x09\xce\xaa\x3b\xc2\xb8\xc8\x9a\x8a\xc1\xc4\x8f\xd2\xfb\x23\x2c\x71\x68\x8c\x2b\x7a\x9a\x53\x42\x56\x42\xda\xd0\x88\xcc\xde\x86\xe0\xa9\xa3\x87\xa1\x22\x28\xde\x24\x6e\x43\xd4\x54\xae\xb0\xe6\x64\xb2\xe6\x4d\xe4\x4b\x4f\x73\x02\xc6\xa4\x91\x31\x3e\xec\xb4\x32\x2d\x5d\x07\x13\x62\x34\xc6\x44\x69\x26\x61\x5d\xd4\xfe\xc3\x73\xcf\x71\x8b\x49\x82\x84\xb8\x44\x72\x2f\xcb\x4d\x02\x9e\x16\x45\x78\xa1\x5f\x68\x8b\xca\xd1\x27\x5b\xff\x48\x1d\x3d\x3b\x61\x42\xc2\x9b\x4e\x34\x34\x0e\xbd\x90\xe7\xdd\x9f\x27\x62\x06\x55\xe8\x61\x6f\xfa\x88\x4e\xc7\x0c\x1a\x07\x9f\xf5\xf3\xf9\xe4\x5a\x19\xa3\x0f\xa8\xe1\x3a\x59\xb9\x99\x72\x6f\xc1\x79\x77\x8a\x18\x34\x13\xe0\x43\x17\x7d\xd7\xb9\x52\xe4\x22\x64\x7a\x63\x1a\xd9\x59\xf5\x58\x56\xf1\x58\xe7\x43\xa7\xef\xcd\xa6\x8c\x1d\xde\x6c\x73\x31\x26\xe6\x85\x56\xe2\x1c\xee\xf4\xf8\x72\x42\x92\xe0\x51\xd8\xd0\x09\xcf\xa8\x33\x66\x9f\xbe\x83\xc5\x29\x93\xe3\x5a\x0e\xd3\xa2\x27\x11\xe4\xf6\x61\xfe\xb3\x0c\x57\xc1\x3f\x91\x2d\x6b\x5e\xb8\x18\xe9\x37\x34\xc7\x9d\xd0\x6f\x9d\xf6\x13\x72\xb2\x55\x1b\x4a\x3d\x7a\x1d\x1a\xc3\x25\x6e\x54\xf0\x1c\xc1\xf9\xa9\xb3\xf5\x95\x0a\xfe\xb9\xae\x57\xac\x79\x13\xfc\xb0\xe6\x5c\x0e\x0e\x0c\x7a\x29\xcf\xa1\x90\x75\xc4\x7c\x45\x22\x9b\x0a\xfe\xbb\xa7\xdf\x8e\xab\x48\xd4\x28\x87\xdb\xbd\xd6\xdd\x85\xf5\xcb\xf1\x8e\xb2\x7a\x39\xcc\x59\x0a\x87\x44\xbb\x29\x93\xed\xca\x4a\x9f\x3c\x72\xd0\x5c\xc1\xa0\xc9\xd9\x1b\xb3\x91\xca\x13\xfc\xf4\xd9\x3b\x53\x8c\x33\xc7\xd5\x27\x62\x3e\x7b\xf5\xf4\x2d\x49\xfc\x84\xbf\xd6\xec\x7e\xe5\xdf\x43\x9f\xfb\xb9\xf9\xd1\x36\x26\xa8\xd0\x2d\x42\x54\xf8\x35\x26\xb2\x35\x2d\x75\x93\xd8\x55\x2c\xc8\xb0\xcd\x18\xb0\xbe\x06\xdf\xfb\x9c\x26\x05\x0f\x53\x32\x67\x05\x8e\xce\x8d\x30\xb1\x26\xe4\xce\xa7\x93\x20\x09\xe9\xdf\x7a\x8d\x1d\xf4\xce\xaa\xce\xd6\x57\x13\x13\xa0\x0e\x8f\xd2\xd9\xad\x71\xd8\xe9\x1a\xb0\x27\x08\x92\x59\xa8\x37\xe1\xfd\x4b\xd6\x77\x05\xeb\x3b\x06\x3f\x29\x9a\x51\x63\x2f\xdf\x85\x5c\x26\xd1\x85\xc7\x2b\x7f\xd0\xec\xe1\xae\x7e\x44\xdf\x84\x8d\x92\xa9\x39\x03\xd9\x0f\x45\x3f\x22\xb3\x74\xc4\x80\x64\x9b\xe2\x49\xc6\xdf\x9a\x84\xe1\x80\xf0\x32\x03\x06\x4e\xdd\x5f\x41\x4a\xf6\xbc\xc3\x0d\x35\x1f\x6c\xd5\x98\x66\x7a\x09\x77\x3b\x03\xf6\xd9\x33\x35\x98\x99\x14\xa4\x88\x61\xdc\x3d\x77\x21\x1d\xea\xd5\x77\xb2\x46\x3d\xe8\xd4\x77\x1d\x9b\x3f\x9e\xc3\x31\xf3\x40\x8a\xbc\x70\x48\x52\xfd\x1a\x38\x6c\x74\x50\xc8\x62\x5e\x59\x68\x19\x65\x0f\xc9\xf1\x6a\x2c\xcd\xbc\xf4\x6f\x7e\xfc\x56\x75\xc3\x36\x5f\xc7\x35\x59\x46\x02\xa1\xa4\x1a\x0b\x25\x2f\x80\x89\x77\x36\x43\x41\xf7\x80\xfe\x21\xd5\x44\x23\x4c\x5c\xc2\x9a\xd1\x11\xf9\xca\x7a\xa9\x87\x49\x75\x53\x7f\x05\x87\x14\xf7\x5f\x07\xac\x41\x98\xee\xa2\x0d\x11\x95\x53\xa2\xc2\x4a\xf6\xa3\xb5\x4e\x17\x43\xd3\xd7\xb9\x0c\x9b\x5d\x0c\x0b\xeb\x0c\xc6\xdb\x09\x2e\x55\x7e\x64\xb0\x80\x55\x5c\x78\x1e\xea\x84\x14\xee\x20\x9d\x15\xc6\x54\xcf\xbb\x3b\xf0\xb5\x70\x44\xf2\x16\xe2\x11\x53\xd6\x74\xba\x3e\xad\x48\x32\x1d\x90\xf7\xde\x5e\xf7\x94\x43\x9e\x34\xbd\xeb\xde\xc4\x1d\xde\xcc\x48\x1e\x98\xfb\x81\x33\xb9\x20\xea\x86\xfe\x36\xc8\xb6\x0c\xc9\x6e\x53\x5a\x6a\xd1\x94\xc5\xab\x19\x07\x2c\x12\xb6\x60\xa3\xbf\xf4\xc7\xfc\x57\x7a\x6e\x1c\x33\x65\x8c\xa6\x41\x11\xc9\x3c\x6c\x10\xd3\x28\xb4\x04\xae\x90\xbc\x6c\x10\x11\xd0\x2f\xd5\x34\x58\x62\x1c\x94\xb1\x59\x47\xb9\xd0\xd0\x0f\x10\x9b\x5e\x06\xa5\x1e\x47\xac\x8e\x89\x3d\x89\x50\xcf\xb7\xa6\x9e\xa0\x54\x62\x90\x22\xdf\x8c\xae\x5c\x46\xe6\xc1\x0a\xf3\xc8\x66\x87\x2e\x4c\x9f\x4f\xaf\x39\x17\x47\x0e\x07\x0a\x83\xc3\xa1\x73\x51\x12\x18\x19\x07\x16\xf4\x03\x7b\xd0\xe1\x99\xf2\x0e\x34\xe9\x8f\xd9\xa3\xca\xfc\xcd\x63\x13\xfd\xf7\xc9\xc1\xdf\x29\xe2\x77\xd3\xad\x92\x81\x7b\x1b\x79\xb8\xa8\x36\x1c\xbb\xc5\x79\xc9\xf4\xe8\xdb\xdf\x3b\x70\xec\xca\x64\x72\x6c\xa2\x89\x30\xf0\x6e\x38\x32\x99\x1c\x13\x7d\x22\x61\x0c\xd3\xb9\x43\x52\xb6\x45\x98\x79\xdb\xcb\xbd\xe9\x3e\xe7\x80\x7f\x6c\x7f\x70\xe3\x60\xb1\x42\xe0\x03\xcf\x8a\xda\xe4\x48\x52\x3f\x54\xae\xbb\xa5\x9e\x91\xcc\x75\x63\xd9\x9b\x0f\x15\x1f\x3b\x71\xdc\x46\x9a\xe4\x3d\xf5\xb4\x23\x9c\xb2\x53\xc4\xe4\xe4\x08\xa1\x07\xa6\xcb\x87\xec\x32\xe5\x3d\xe4\x62\xc6\x3b\xb2\x4a\x39\x86\xde\xf7\x90\x3c\x78\xd1\xc7\x15\xe6\xf5\xd1\xbc\xf9\x90\x7b\xc8\x2c\x1e\x72\x84\xbc\xc5\x49\x8e\x1c\x79\x7b\x1b\xf3\x86\xab\x1c\xe6\x8f\xde\x72\x98\xbe\xe9\x20\x87\xf9\xb7\x39\xb6\x91\x23\xa3\x29\xa4\x30\x0e\x9d\xdd\x30\x73\xe2\xef\x86\x19\x87\xaf\x76\xf2\x4a\x65\xac\x9b\x12\x07\xaf\xf0\xc0\x8a\xa2\x44\x1b\x2a\xe3\x18\xc2\x1b\x0c\x5a\xa8\xa0\x2e\xfa\x39\x76\x4a\xc7\xdd\xfc\x68\x52\xd9\xac\x66\xb7\xc0\x04\xc4\x5e\x20\xb2\xd0\x54\x56\x33\x69\x1e\xa3\xe3\xa1\xb2\x49\x34\x6b\xf6\xb0\x47\x40\x5e\x9d\x84\x63\x65\xd8\x40\x19\xe2\xdf\x55\x0c\xba\x39\xcf\x00\x69\x90\x9b\xdf\x05\x15\x83\x33\x53\x4d\x97\x38\xa8\x88\x8e\xc6\x36\x53\x1e\x2e\x29\xe4\xd0\xc1\x0f\x1c\x39\x5c\x46\x2a\x42\xfb\x0c\x58\x64\x1e\xc6\xa1\x83\x4a\xe8\x73\x3f\xae\xa0\x10\xfd\x8a\x23\xfa\x11\xca\x37\x7e\xef\x61\xe7\x27\x28\x24\x9a\x18\x68\x3a\x04\xa9\x28\xfd\xf0\xee\x99\xd0\xa2\xdc\xc9\x5b\x20\xb1\x87\x1a\xa1\xe8\x59\x26\xbd\x30\xff\x11\xbc\xf9\x6e\xc0\x7e\x1a\xb1\xf3\x79\x1a\x88\xff\x35\x62\x53\xf6\xbf\xab\xa4\x79\xb0\x79\xe9\xa9\xda\x86\xb8\x03\x07\xcb\x88\x84\x0c\xeb\x6f\x44\xfc\xcf\xb0\x4b\xd9\xd6\x57\x09\x54\x99\x0b\x12\xfe\xf6\x58\x9a\x21\xa6\xcc\xd6\x08\xfd\x51\x47\x82\x93\x2d\xbb\x84\x2b\x77\x8f\x9f\x51\xfa\xd9\x33\x95\xea\xd0\xb1\x64\x94\xe0\xf3\x29\x0d\x0e\x9f\x12\x04\x9f\xb4\x0a\x59\xd0\x22\xc4\xf2\xb7\xc5\x22\x16\xa3\x72\x39\x50\x9a\x31\xa6\xba\x97\xbd\xde\x74\xd8\xb0\x8e\x69\xee\x60\x8e\x98\x43\x4f\x23\x31\x2a\x19\x3c\x31\x29\xbf\x75\x58\xc0\x66\xc6\x81\xe5\x75\x92\xcd\x82\xe9\xd6\x6e\x39\xf1\x60\xbb\x77\x08\x14\xc5\xae\x80\x06\x72\xa8\x32\xcc\x91\x5d\x4a\x26\x5e\xeb\x6d\xc1\x6c\x39\xe1\x4d\x90\x6d\x3c\x26\x0f\x88\x1f\x58\xea\x61\x62\x78\xf0\x91\x90\xd6\x9d\x8c\x6f\xce\xdf\x8b\x06\x2e\x28\xee\x6c\x8a\xe9\x18\x71\xd0\xf1\x14\x44\xf6\x87\x88\x7c\xff\xec\xed\x78\x21\x49\x90\xe3\x45\x13\x6a\xdc\x20\x3e\x60\xf3\xae\xe2\x84\xcf\xdf\x6f\xd4\x4e\x4e\x28\xdd\x91\x72\xe4\x31\x23\x15\x0e\x66\x81\xe9\x16\x7d\xc7\x34\xd1\x77\x1c\x88\xa9\x9e\x63\xba\x5d\xcf\x71\xc8\x19\x3a\x58\xc7\x67\x4f\x74\xa1\xd2\x91\xfa\x63\x32\xf9\x5c\x7c\x0b\x12\x3a\x71\xcb\x95\x64\x99\x36\x4d\x75\x1d\xd3\xb1\x5e\x23\x31\x3a\x13\xd9\xab\xe9\x88\xbf\x31\x1b\x90\x53\xb7\x4f\x44\x1f\x94\x77\xb2\xe9\x9f\x4c\x7e\x32\x38\x02\x4a\xb7\x29\x29\xa6\xa2\x94\x38\x78\xb9\x99\x74\xec\xcf\xcc\x42\x16\xf0\x26\xcc\x03\xce\xfb\x64\xde\x59\xc6\xd9\x45\x4a\x3a\xd2\x5f\x4c\xd8\x1b\x99\xe8\x13\x30\x67\xf0\xb1\xc1\x54\xa7\x53\xfa\x3e\xd5\xd6\x89\xee\x7d\x32\xf9\x86\x58\x54\x6c\x34\x27\xc7\xe4\x4d\xdd\x14\x86\xca\x37\x79\x7a\x8b\xe9\x24\xb3\xa7\x86\x92\x23\x47\x2a\x79\x21\x07\x5b\xb4\x34\xd1\xa8\x2c\x77\x15\x65\xc2\x34\x2a\x56\x02\xe5\xfa\xf9\xec\xd0\xca\x0f\x1c\x78\xb6\x94\xb1\x0d\x8c\x51\x0b\x1e\x64\xd6\xd6\xbd\xd6\xdd\x13\x67\x7c\xf3\x56\x47\xbc\xbb\x67\x36\x0a\x90\x31\x95\x88\x22\xe2\x01\x0f\x1d\xbe\xdc\x5a\xe3\x39\x26\xda\x99\xe9\x36\xed\x4c\x62\xca\x3c\xde\x37\xa5\x9b\xc9\x2f\xd0\x5f\x33\x3c\x2f\xee\xc6\x98\xc1\x6a\x9b\x84\x0e\x8a\xe8\x42\x94\xda\xfc\x62\xa2\x8e\x4e\x64\x58\x32\x88\x93\x6c\x0e\xf4\xac\x85\x21\x8f\x26\xea\xa0\xc0\x06\x05\xeb\x74\xa8\x1c\x9a\x58\x39\xb4\xc0\xa1\xcd\x8c\x12\x69\xba\xa9\x24\x3a\x65\xa5\x89\x46\xd8\x01\x7f\xd2\xa2\xbf\x15\xbd\xb0\x11\x15\x71\xa8\x30\x86\xa7\xff\x16\x1a\x5f\x89\xd5\x4e\x9f\x98\x95\xe5\xf2\x7f\x67\xb3\x00\x5e\x0f\x4d\x37\x14\x4f\x89\xc3\x71\x1e\xa8\x8c\xce\xe9\x6d\xa9\x4c\x37\xd5\x9d\x92\xc9\xe3\xc8\x6b\xf2\xcb\x51\xbd\x29\x99\x71\x50\xc8\x68\x53\xa2\x81\x34\xd2\x39\x1b\xdf\x0f\xaf\x1f\xbc\x27\x88\x1b\xe2\x0d\xb7\x49\xb0\x78\x87\xa6\x50\xcf\xf4\x6e\x68\xa0\x40\x5e\x38\x1d\xcd\x6b\xbd\x1d\x34\x0e\x0f\x0e\x60\x93\xa2\x70\xc6\xb5\xfd\xc2\xe1\xfd\xe3\x42\xbd\x4f\x06\x17\x81\x11\x6a\xed\x7e\xb2\xbe\x19\xbf\xd3\xeb\x9b\xbb\x58\xa9\xe8\xd6\xf2\xf6\xd2\x21\xc9\xe6\xa8\x03\x8f\x77\x9d\x0e\xc9\xa3\x53\x86\x47\x9d\x90\x47\xa7\x48\x7d\x1c\x88\xa3\xc3\x6f\x43\x3a\x20\x8e\x0e\xf3\x6e\xd6\x21\x79\x7c\x8a\xbc\xb1\x91\x3a\x3a\xa1\x74\x15\x23\x75\x7c\xc2\x6e\x7a\x0f\xa2\x26\x27\xf0\x87\x85\xfd\x14\x90\x03\x45\x61\x62\x60\x66\xc2\xee\xce\x06\x72\x3a\x51\x49\x46\xc4\x27\x86\xbc\xc2\x96\x8e\xf5\x8a\xd3\xc8\x2a\x9a\xc3\x37\x39\x63\xcb\x99\x2a\x14\xa7\x23\x85\xe2\x1b\x8c\xe3\xcb\x0e\x14\x8b\x89\x5b\xd6\x99\x09\x2f\xad\xf1\x58\xdb\x38\xc1\xed\x1b\xd6\x8e\x9f\x22\x0a\xc9\x01\xc7\xc9\x7b\x2a\x0c\xa8\x61\x4d\xe8\xb1\x52\x16\xce\x20\x43\xdd\xae\xb2\x9c\x6e\xd7\x4d\x4e\xb7\xe9\x26\x17\xe6\xd0\xce\xde\xda\xb7\xe3\x17\x79\x8b\xe5\xec\xc4\xea\xc9\xa5\xab\x7a\x7b\x63\x89\x39\xb1\xca\x72\x1a\x54\x96\x07\xec\xf1\x88\xfe\x54\xd0\x77\xe5\xf8\x50\x0f\x06\xe5\xe4\x64\x32\x74\x5e\x5f\xa7\x25\xe3\x10\x29\x01\xe7\x61\xc3\x28\x3d\xfa\x2b\xdb\x09\x81\xbe\xfb\x87\xc9\x7a\x59\x3a\x54\x59\x4e\x37\x94\x94\xd3\xa1\x92\x32\x91\x32\x6c\x8c\xd3\xd9\x73\x04\x6e\x99\xf4\xc4\xe3\x21\x1e\x74\xb1\xa5\x7a\xc0\x9f\xce\xea\x8e\x0f\x4c\x07\xd5\xf1\xc0\xf8\x05\x26\x3c\x69\x4e\xb0\xf1\x93\x9d\x2b\xf1\x79\x0a\x2e\x37\xd7\x8b\x2b\xeb\x50\x27\x47\xf5\x6a\x22\xa8\x75\x8c\xdf\xee\xa2\x33\xfa\x8a\xeb\xa3\x18\x9f\x09\xd6\x94\xb1\xb8\xa8\x61\x8f\xe8\x45\xbd\x32\x6d\x39\x26\x9b\xc3\xe9\x50\x2f\x9b\x48\x84\xc6\xf8\x7e\xc1\x3b\x12\xa9\xf8\xd5\x3b\xe7\xe1\xe4\x40\x18\x07\x55\x66\x3b\x65\x5b\xb0\xc8\xc4\xa5\x73\xa1\x3a\x24\x0a\x85\x96\x0b\x8c\xd5\xf2\xf1\x51\xc4\x5d\x92\xf8\x2c\x90\x52\x14\x66\x34\x7a\x42\xe2\x5e\x43\xa7\x20\xcc\xe1\x46\x42\xda\xd6\x84\xfe\x80\x23\xaf\xbd\xc0\xf7\x9e\x46\xe2\xda\x8a\xc0\x4a\x0f\x7a\x2e\x56\x93\xe9\x50\xf7\x5c\x48\xa9\xfe\x82\xfe\x64\xf4\x55\x21\x4b\x1d\x05\x6e\xea\x60\x1d\x0d\xb0\x0b\x5b\x97\xe1\x71\x54\x5d\x07\x71\xe0\xf5\x8f\x38\x89\x1f\x3c\xf8\xf2\x14\x53\x19\xe4\x47\xac\x8d\xc9\x38\x31\x10\xec\x79\x65\xe0\x14\x1c\xeb\x1a\x65\x28\x9b\x90\xcf\x78\xc0\x2f\x1c\x92\xe4\x27\xf4\xd0\x8e\x84\x66\x79\x64\x74\x44\x75\x35\x21\xa7\x8d\x5a\x58\xef\x6c\x11\x1c\x85\xf1\xa3\x2e\x53\xaa\xfc\xa3\x71\xa1\x96\x97\x5a\xf0\x77\x25\xd6\xee\xf0\xa1\x8f\xbb\x5c\x69\x96\x2f\x17\xef\xa2\x31\x45\xa5\x02\x85\xfa\x49\xaf\x79\xbe\x51\xa4\xe4\x83\x99\xc6\x28\x72\x4f\x45\xfd\x09\xb7\x73\x06\x46\x93\x08\xf9\x37\x3d\x76\xd4\xb6\x0b\x1b\x4b\x22\xb7\xde\xe2\x40\xd4\x2c\xcc\xef\x8e\x87\xbf\x72\xe0\x55\xb0\xd9\xc4\x9b\xb7\x65\x7e\xa9\x93\x85\x5b\x5e\x5e\xa1\x6f\xcb\xb1\x2c\xc4\x4c\x38\xd0\x98\x9b\x32\x0e\x1a\x4a\xe1\x4e\x9a\xc6\xc0\x3a\xba\xff\xa4\x57\x2c\xea\xa7\x8e\x37\x83\xca\x58\xf0\x93\x48\x9f\x3f\xc1\x7a\x35\x16\x14\xaf\xab\x10\x17\x99\xf9\xb0\xb8\x48\xab\xb0\x29\xfa\xba\x84\xc3\x92\x02\x88\xf5\xa6\xd5\x4f\x38\xb8\x05\x18\x87\x1e\x0a\x85\xd5\x61\x04\x5d\x0d\x0e\x9a\xa7\xac\x6f\xdf\x5e\x00\x7e\xf7\xfe\x19\xa0\xd8\x8f\x13\xfa\x5a\x77\x80\xfe\x86\x55\x1e\xb3\x87\x62\xf8\x69\x86\xfe\x96\x9b\xbc\x31\x9b\xc3\x85\x14\x3a\xaf\xdc\xd9\x97\x5b\xfb\xe1\x86\x6f\x35\x97\xc0\xb3\x85\x07\x50\xd8\x02\xc9\x7c\x80\xe8\xf7\xe7\x3f\xbe\xc0\x37\x23\xe2\x27\x1d\xa3\xf6\xb9\x10\x18\x95\x57\x61\x53\xf2\x19\xb3\x39\xca\xe5\xa2\x9f\x23\xdc\x02\x17\x73\x9a\xe5\x34\xc7\x31\xc3\x71\x7a\x39\x21\x92\x4a\x18\x21\x6d\x63\xa6\x86\x05\x44\xf3\x2b\xb2\x8d\x61\xbd\x76\x60\x30\x31\x4e\x30\x7f\x13\xef\x84\x44\xb0\xf5\x22\x61\x52\x58\xdb\x98\xe2\x9c\x30\x0d\xe3\x1f\xdd\xaa\x58\xb7\x0f\x8b\x72\xc4\x79\xea\x42\xdf\x3c\x2b\x12\xfd\xc0\xf9\xbe\xae\x5d\xcf\xe3\x12\xf1\x5e\x7a\x70\x25\x17\x19\x3f\x2f\x76\x9e\x6e\x4c\xa7\xd0\xf0\x69\x7f\x31\xdf\x2f\x54\xe2\x21\x94\x52\x76\x35\x99\xae\x6c\xc7\x15\xd8\x19\xd3\xa9\xe4\x6c\x03\xa1\x5a\xda\x16\x48\xf6\x33\xc3\xf8\x74\x67\x73\xe0\x1c\x9d\x20\x8d\x8e\xc9\x82\xf3\x80\x7d\x9e\x78\x21\x3a\xb3\x67\xa9\x14\x5c\x63\x4d\x1c\xf7\x08\x52\x68\xa5\xab\x0e\x31\xab\x44\x43\xbb\xec\x21\xb2\xa5\x73\x2a\xa3\x3b\x67\x3b\x0e\xeb\x9d\xb3\x59\xd4\xb7\xd3\x35\x5d\x7a\xdd\xeb\x61\x5e\x23\x7d\x68\xd6\x31\x1f\x6e\xe0\xa6\x4c\xcf\x9c\x03\x27\x6c\x2f\xf1\xc3\xbb\x67\xa0\x3a\xba\x01\x35\xd7\xde\x7f\x1f\x5f\x07\x7a\xde\xbe\xa3\xa9\x1f\x0c\x00\x93\xc8\x06\x2f\x68\xd0\x60\x2d\xda\x97\xe9\x27\x8d\x46\xc4\x24\xbb\x3c\x28\x14\x77\x27\x1b\xdb\xbe\xf4\xcf\x4c\x97\x57\x12\x2d\x63\xd4\x70\x60\x5a\xb6\x16\x99\x28\x2b\x14\x20\x8a\xca\x02\x53\x22\xb7\x02\x87\xd2\x42\xda\xf9\x7a\x15\x83\xb7\xbf\x8c\x2b\xea\x53\x9e\x8c\xf8\x87\xcc\x49\x4d\x1f\xb9\x72\xf5\x79\xd3\xdc\xc2\x95\x9d\xe0\xc9\x81\xe2\xd3\x71\xc2\x1a\x5b\x13\x24\x26\x6e\x2c\x07\xc2\x53\xd6\x5e\x65\x48\x4f\x7f\xf7\x61\xe3\x4c\xb3\x34\x42\xa7\xf3\xe5\x32\x9a\x25\x49\x4e\xc2\xf8\xb7\xde\xc4\x9d\xca\x37\xc4\x53\xe2\x0c\x06\x95\x20\x06\x65\xb1\x7c\x28\xb0\xe6\x23\x39\x35\x1f\x89\xa7\x44\x7f\x47\xe5\x25\x84\x67\x74\x62\x2f\x4b\x0c\xac\x5e\x13\x22\x2a\x50\x79\x14\xf4\xf2\x20\xe5\xe5\x22\xc4\xe6\x71\xd7\x67\x44\x39\x0b\xa3\x5b\x0c\x62\x84\x24\xa4\xa2\x02\x34\xa2\xa5\xed\x13\x47\xb2\x2b\x2a\xb3\x99\x85\xc8\xd1\xab\xfc\x31\x83\x26\x20\x99\x64\xa5\x8c\xe9\xea\xb2\x40\x1e\x94\x55\x5e\x2a\xf8\xc0\xca\x87\x52\x27\xba\xc9\xa7\x7d\x66\xa4\xf8\xd7\xca\x45\x8d\x23\x17\xfd\x0d\x20\x10\xc5\x72\x60\xb5\xef\x1c\x5e\xd8\x2d\x95\x35\xbc\x0a\x1b\xca\x29\x48\xfb\xcb\xe1\x7d\xd7\x11\xe3\x40\x7c\xa5\x33\x6f\xc8\xb3\x79\x22\xcc\x16\x5c\xb6\x80\xca\x7e\xd4\x01\x5b\xc6\x99\x03\xde\xd1\x89\x93\x01\x3e\x4f\xc4\xe0\x7c\x28\x03\x1f\x92\xb2\xc8\x38\x70\xf1\xe6\xd9\x7d\x13\x66\xf5\x8c\xf3\x3c\x97\x71\xec\xf3\xe5\x35\x35\x92\xbc\x66\x4d\xd7\xbc\x1e\xf4\x5c\x09\xed\x29\xbf\xb5\x18\x50\xe4\x35\x57\xc4\xb5\x28\xbd\xe6\xb5\xe8\xb6\xe6\x75\xd1\x4c\xcd\xeb\xd1\xde\x02\xb8\x5c\x31\x51\x5e\x9d\x12\xbc\x8c\x72\x83\x33\xd8\x5b\xdc\x38\x32\x2c\x88\x4f\x8f\xb0\x69\xc5\x0d\xce\xcd\xcc\x47\xf6\xad\x77\x18\x0f\x1f\xde\x86\x5f\xfd\x7a\x62\xab\x01\x82\x67\x58\x79\x0d\xad\x5a\x06\xc5\x48\x23\xaf\xe5\x9a\xf1\x02\x39\x80\x8f\xb2\x93\x7d\x29\x42\xbe\x5f\x28\x36\x29\x82\xc2\x47\x6f\xc7\x0d\xaf\xde\x5e\x60\x34\xeb\xfd\x61\xf0\x83\x42\x63\x2f\xb7\x10\x70\x6b\x51\x88\x52\x99\x10\xb3\x78\x83\xee\xbc\x97\x6d\x5b\x81\xa3\xb7\x15\x61\xf0\x7e\x95\x10\xe3\x48\xd6\xd3\xe4\x89\xb5\x0b\x80\x22\x48\x08\x8f\x9b\x47\xb4\xe8\xe8\x12\x77\xd8\x94\x27\xe2\x25\xe2\x5b\xf4\x32\xcd\xea\x31\x91\xd2\xcd\x4e\xb0\xe3\x29\x55\x8f\x8e\x15\xe9\x13\xe3\xc2\x46\x9c\x8f\xf4\x7e\xb0\xe3\xe8\xbd\x0b\xf5\xd5\x79\xbd\xb2\x86\xd7\x37\x55\xef\x0f\xd4\x6a\xfa\xae\xd1\x79\xd4\x5b\x60\x72\xd8\x84\x67\x72\xe2\xb2\xbb\x4f\xe6\xfc\xe5\x45\x36\x26\xc2\xb5\x41\xdb\x05\x8f\x4c\x13\xfb\x49\xa2\xb6\xf5\x22\x44\x1a\x9d\x92\xc4\x61\xa3\xe6\xc2\xd8\xd3\x50\x78\x4f\x63\x48\xe9\x2d\xeb\x66\x09\xeb\x99\x5d\x2c\x06\x34\x8e\x98\xbc\xa8\x42\xcb\xb0\x7e\x48\x5e\x5c\x0f\x17\x84\x7c\x98\xed\x8b\x18\xda\x77\x41\x88\xd7\x7a\xe9\x6d\xee\x1b\x73\x4c\x8f\x19\x48\x2f\xb9\x13\x92\xc7\x73\x58\x0e\xae\xcb\xfc\xb2\x20\x43\xc9\xb8\xf6\xac\x27\x53\xc8\xd2\xa5\x17\x1e\x0d\x86\x82\xb3\x44\x28\x44\x2a\x48\x99\x28\xae\xcb\x2c\xb1\x20\xec\x4b\x14\x15\x7c\x6d\xa2\x5d\xec\x2e\x86\x70\x7b\x6b\x93\x32\x92\xb1\x76\x12\xc5\x55\x93\xb0\xb1\x5e\xae\xa7\xd3\x4a\x89\xf3\x5d\x64\x7a\x21\x49\x82\x17\x94\x44\x77\x41\x87\xea\x7b\x44\xd3\xb8\x23\xac\x89\x14\x2e\x1c\x16\xb1\x37\x98\xa0\x6e\x44\x2c\x38\xdc\xf8\xbf\xc1\x2e\x4d\xf0\x90\x7f\xeb\xc9\xc7\xa7\x8e\x93\xd2\xcd\xcd\x49\xef\xe6\xf7\x66\xbc\x9b\x5b\xa6\xbb\x9b\xdb\xe7\xba\x9b\x1b\x13\xdd\xcd\xad\xb3\xdc\xcd\x6d\x53\xdc\xcd\xcd\xf9\xed\x66\x3a\xb9\xdd\x1c\xcf\x6c\x37\x83\x78\xb6\x39\x9c\xe0\x0e\x07\xa4\x72\x51\x3f\x31\x9c\xb2\x0a\x9b\x91\x80\x98\xbc\xb9\x39\xf7\xdd\x1c\x4d\x7c\x8f\xe8\x32\x20\x6e\xa6\x93\xe1\xcd\xd1\x4c\x78\x83\x69\xf0\x79\x5d\x9b\x94\x42\xb4\xf4\x85\x8f\x39\xbc\x88\xb2\x99\x38\x1f\x60\x7c\x5c\xb4\x65\xfa\xa5\x37\x31\x5b\x2d\x14\xe4\x09\x2a\xb7\x68\x75\x09\xe4\xda\x3c\x25\xa6\x59\xb2\x1c\x26\x47\x65\x37\x7d\x83\x3e\x90\x04\xfa\x0d\x35\x90\x9f\x5e\x5c\xd8\x86\x85\x98\x8d\xf5\xcd\xec\xe4\xbf\xdd\x16\x0c\x92\xe3\x3d\x72\x4c\xd2\x57\x4f\x5f\x9f\x98\xfb\x4f\x9f\x00\xbc\x79\x7d\x12\x4f\xf4\x89\x3d\xc9\xf7\x47\xf5\xd5\x10\x3f\x9e\xd8\xb3\x07\xff\xf2\xe0\xf7\x03\x0b\xba\xdb\x03\x0b\x3e\xbd\x33\x3b\x3d\x45\x94\xdc\xbb\x25\x40\x1f\x07\xe4\xb3\x67\x0f\x3e\xfc\x8f\x7f\xfd\xf5\xb7\x4f\xff\xe5\xa3\x84\x22\xfd\xfb\xd9\x6c\x6e\x96\x16\x4e\xc8\x78\x1a\x23\x51\x04\x54\x0c\xce\xcd\x69\xc0\x48\x7a\x6d\x58\xe8\x86\xf5\x97\x76\xa3\xf7\x7a\xf6\xb0\x17\x0d\x2c\x25\x10\xdf\x85\xfd\x7b\xaa\x26\x48\x30\xd0\x28\x46\xf0\x4a\xd4\x2a\x3b\xc7\xdb\x90\x8e\x85\xad\xde\xd7\x94\x0d\x0f\x00\x0a\x8a\x00\x54\xc9\xba\xa8\x97\xad\x56\x4b\xcc\xa7\xe1\x08\x53\x35\x68\x42\x73\x8c\x0d\xca\x6c\x3b\xa7\xad\x57\x2b\xe3\x3a\xd5\x98\x1a\xdb\x4e\x1d\xc7\x3e\x51\x12\x48\x59\x35\x46\x3b\x18\xb0\x19\x15\x8d\x33\x3a\x19\x19\xa7\x54\xd7\x53\x8e\x50\x68\x42\x1c\x0f\x9a\x61\xd2\x4c\x4d\x7b\xed\x76\xbf\xd0\x93\xd4\x2b\xa3\x16\xae\x4f\x2b\x44\x03\x6d\x59\xad\xa9\xd3\x36\x2a\xcc\x72\x11\x76\xd9\x61\x60\x15\x0c\x21\x3e\x52\xdf\x42\xc9\x29\x94\x98\x1f\x08\xd9\xd3\xd3\x1b\x5a\x87\x2b\x53\xe2\x34\xcc\x4e\xcc\xd9\x83\xbf\x3d\x38\x31\x3f\xd1\x37\x71\x67\x0f\x3e\x5c\x6e\x2e\xef\x7f\xbc\xf7\xe0\x28\x3a\x2b\x42\x14\xcd\x93\x2a\x21\xce\x95\xae\x6b\xfa\x03\x31\x1d\xa7\x19\x20\x25\x01\xa6\x11\x04\xed\x48\xd7\x1c\xe5\xe0\x38\xf4\x21\xd4\x98\x75\xd3\xd0\x1f\xa2\xbb\x6e\x5a\xeb\x39\x45\x25\x53\xba\x59\x53\x37\x4e\x67\xac\x6d\x32\x4a\x9b\x54\x35\xa6\x8e\x3b\xba\xa1\x49\x95\xf1\x82\x2f\x70\xb6\xc4\x32\x2b\xf3\x2b\xed\x2c\xfd\x35\x12\x0e\xd0\x55\x33\xdf\x85\x8d\xd4\x20\x0e\xe5\x3e\xbc\x73\xed\xa1\x19\x10\x77\x12\x64\x8b\xe0\xae\x21\x41\x55\x20\x7d\x2c\xed\x77\xa9\x5e\x99\x16\x4c\x8e\x5d\x2b\x21\xb2\xea\x15\x42\x9f\x31\x6c\x0a\xe2\xc2\x52\x51\x95\xa0\x32\xa4\x9a\xfe\xd6\x72\xcc\x2c\x9d\x92\x69\xe7\x74\x29\x42\x65\xe1\xc5\xa4\x14\x6a\x8b\x62\x96\x19\x67\xe8\x93\xd2\x43\x9c\x28\x84\x89\xca\x11\x89\xe5\x74\x2e\xa0\x2f\x30\x0f\x88\x19\xb1\xa4\x74\xdf\xd8\xac\x74\x9f\x57\xc6\x67\x71\xaa\x30\xa1\x70\xfb\x3e\xaf\x2c\x73\x11\x9d\xad\xcf\x01\xc9\xf8\xf2\xfa\x1c\x9a\x39\xc9\x5c\x40\x25\xe4\x3c\xa1\x34\x60\xd4\x4a\xb3\xfa\x0d\x2f\xaa\xeb\xf5\xb2\x54\xbe\xb9\x6e\x16\xd6\x19\x35\xd7\xc9\xd6\x8c\x99\x05\x55\x51\x6e\xf8\x48\xb1\xa9\x36\x09\x22\x65\xf2\xc6\x18\xaf\xe6\x7c\x3a\xa5\x15\x2c\xd6\xe7\x76\x49\x7f\x66\xd3\xc5\xd6\xeb\xb8\xab\x38\xdc\x7a\xa1\x10\x73\x9d\x08\x7a\xfd\x73\x9b\x2b\xfa\xa2\x04\xb9\x32\x12\xe6\x58\x4b\x8e\xd0\x10\x01\xb6\x0c\x5b\xdd\xa9\xb9\x0b\x73\xb9\x1d\x9a\x2b\x52\x2c\x35\xcd\x43\xb3\x53\xf3\x40\x57\x62\xe3\x72\xde\x2f\x16\x26\x56\xdc\x5e\x85\xe8\x42\x70\x1c\x9e\x4a\xcd\x7b\x77\xa5\xe6\x3b\x35\xdf\x65\x83\x04\xcb\x9e\xfc\x84\x3b\xfa\x2c\x7c\x21\xa5\x70\x1b\x5d\x02\x54\x31\xe1\x6b\x43\x24\xdc\x89\xec\x54\xad\x53\x0d\xe7\xe6\x0c\x1b\x25\x01\x4d\x11\xa8\x28\x73\xc0\x0d\x9d\xcd\x12\xe1\x65\x10\x20\xa8\xb8\x1b\x5f\x51\x4f\x25\xdd\x4c\x2d\x26\x41\xf5\x4a\x47\x7e\x44\x60\xf2\x3a\x08\xd7\x75\x36\x37\x19\x69\x82\x72\xa7\x15\x2d\xf4\x0f\x08\x2b\x9c\x64\x32\x47\x52\x62\xdc\x4a\x1c\xa2\xb1\x6f\x2a\x48\xa5\x97\x54\x2e\x7a\x45\xf5\x2a\x04\x94\x22\xaa\x7a\xd5\xfb\x2b\x0e\x49\x84\x40\x3c\xbe\xef\x38\x20\x8f\x84\xe1\x51\xb5\x0b\x73\x24\x52\x72\x87\xd8\x0f\x0e\xd7\xbb\x9e\xba\x8d\x8a\xee\x29\x68\x17\xc3\xbc\xd4\xc6\xc2\x43\x49\x19\xc7\xab\x09\xda\x99\x54\x53\xc7\x68\x62\x3d\x44\x47\x0a\x8e\xc3\xf8\x64\x23\x10\x7e\xcf\x59\x6d\x99\x60\x8f\xa4\xe5\xd4\x0b\xa8\xd6\x98\xcb\x31\x91\x0a\xac\x78\x64\x69\x8e\x42\xe4\x20\xf2\x8d\xae\x05\xe6\xf1\xce\x88\x94\xd3\x0c\x51\x72\x24\x42\x8e\xcd\xa6\x82\x95\x3c\xd3\x54\xf3\x08\x89\xd4\xed\xd6\xbc\xc0\xad\xea\x40\x43\x99\x80\x6a\x83\x00\x38\xe2\x96\x9c\xa3\xdc\xd0\x87\x09\xde\x4b\x78\x9b\x12\xe5\xa6\xc0\x6a\xbe\xab\x6c\xaa\x77\x35\x47\xdc\x19\x59\xce\xe8\xc5\x94\x13\x43\x18\x2f\x42\xdf\x5d\x8f\xb6\x06\x75\xf0\xc9\x72\xa8\x22\x9f\xa8\x23\xe7\x9b\x63\x59\x94\x91\xa8\xed\x01\x9a\xd4\x24\xe8\x3f\x22\xeb\xb0\x8c\x4f\x98\x5c\x9d\x8d\x9c\x96\x25\x4e\x10\xf7\x6a\xd4\x49\x49\x24\x9e\x85\xc4\x09\x5a\x23\x31\x90\x21\x00\xab\xfc\x8b\xaa\x43\x8c\x48\xaa\x2b\x06\x09\xc0\xa4\x2e\x60\xdb\x01\x54\xdf\x49\xf9\x11\x9b\x27\x4f\x83\xf3\x54\xd4\xe7\x00\xc3\x87\x59\x6b\x6a\xe2\x9d\x60\x49\xb7\x9d\xaa\xbb\xbe\xea\xd0\x0d\x50\xfb\x15\x22\xc9\xee\x6a\x1d\xeb\xcf\x1e\x17\x71\x05\x80\xb9\x96\x3a\x5e\xa7\x6a\x9a\x23\xaa\xba\x9f\x1b\x55\xf7\xad\xa9\x1a\xcb\x8e\xf7\x21\x8f\x0c\xdf\x8f\x61\x35\x65\xca\xbb\x9f\x10\x29\xa3\x30\xc2\xe9\x13\x07\x03\x40\x44\x94\x3e\xca\xd9\x29\x87\xb6\xd1\x99\x2a\x2f\x3e\x35\x06\xb8\xa6\xf8\x03\x29\x48\x02\x86\xb7\x5a\x10\x66\x49\xb7\x40\xe5\xa8\x68\xf8\x06\xc2\x9d\x18\x50\x71\xa1\x09\x3c\xf5\x73\x20\xe5\xbc\x86\xa6\xbe\x84\x2c\x22\x55\xfa\x98\x91\x25\x8b\x6e\x84\x10\x0b\x08\x15\xf5\xf1\xf4\xa4\x1d\xfd\xab\x1c\x2a\xd6\x9c\x25\x4a\xae\xda\x85\x45\x1b\x3c\x4a\xb4\x0b\x8b\x8d\x31\x57\x8c\xed\xa8\xd7\x68\x68\x78\x6f\xe6\x55\x0c\xce\x54\xd2\xff\x35\x73\xca\xfd\x17\xea\x38\x9a\xc6\x4d\x45\xb4\x22\xc5\x35\xa6\x86\x8b\x75\x23\xc1\xa9\x08\x8b\xbc\xa6\x50\xe4\x8f\xc6\x34\x7d\xe7\xac\x5c\xb6\x40\x3c\x69\x0e\x2a\x2d\xa1\xa5\xc7\x08\xd3\x0c\x13\x21\x26\x92\xe0\xdf\x98\x85\x45\xe2\x39\xa5\x1c\x96\xd1\xb0\x73\x7b\xbd\xc3\x19\x8e\x85\x98\x22\xca\x02\x54\x22\xdc\x52\x2f\x80\x93\xa0\xfe\xda\x18\x9f\x4c\x05\x7b\xa3\x06\x4b\xef\x8d\xb9\xee\x4d\x0f\x69\xb5\x1a\x8b\x3b\x8a\x4b\x84\x5f\x99\x5d\xc5\xdf\xd6\xa4\x9a\xe5\x5a\x91\x6e\x8b\x90\x3b\x0a\xbb\x8d\x4c\x1c\x43\x84\x8f\xfc\xc8\xf2\x99\xad\xe1\xe7\xdd\x07\x76\x08\x4d\x5f\xd6\x44\xc3\x7e\xc0\x5b\x09\xad\xc8\x2e\xd8\x2b\x88\xdd\x8c\xd3\xc8\x24\xae\xcd\x0b\xe4\xa7\xb2\x89\xe5\x32\x42\x06\x19\xa8\xb1\xa9\xd6\x91\x25\x87\x66\xb0\x4a\x25\xf4\x8a\x3d\xb4\x51\x4b\xb1\x9e\x59\x40\x22\x67\x51\xa4\x9f\x11\xa5\x02\xac\x69\x4a\xd0\x84\x9a\xdd\x16\x36\xa1\xa5\x41\xb1\x09\xd9\xc3\x47\x38\xa4\x87\x26\x6c\xfc\x32\xd2\x18\x8b\x59\x45\xd3\xb7\x1d\xdf\x7d\xfc\xd8\xbd\x04\xc1\x30\xf0\x00\x2d\xba\x8d\x02\xf1\x5c\x82\x27\x65\x1c\xd7\x19\x03\x4f\xe3\x36\x49\xa4\x71\x97\xec\x42\x19\x97\xd9\x7b\xb7\x78\x67\x16\x80\x97\x61\x3c\x06\xb4\x86\x10\xaa\x87\x00\xec\x4a\x9a\xbf\x20\xbb\xca\x6e\x4e\xd9\x21\xb2\x6f\xac\xa6\xb9\x13\xfb\xd3\x15\xc7\xcc\xc5\x3f\xb3\x32\x9e\x6b\x03\x74\x65\xbb\x48\x72\x34\x2b\xfb\x99\x54\xeb\x0e\xb9\x06\x6e\x3f\x26\xc6\x10\x39\x4d\x0a\x47\x29\xb7\xb5\x76\x68\x68\x84\xf4\xf4\x02\xcc\x5a\x1c\x35\xfb\x8c\x9e\x03\x58\x52\x66\x8b\x89\x00\x03\x76\xb8\x2c\x18\x8e\x71\xd3\x33\xdb\xda\xf5\x4d\x81\xb8\x39\x4d\x94\x64\xba\x34\xcc\x9a\xcc\x96\x5e\x8d\x32\x1c\x28\x40\x42\xb3\xc3\xad\xb3\x8d\xe3\xc4\x8b\x3d\x3a\x0b\xc0\xb0\xce\xe2\x28\x95\x7a\x8b\x81\x83\x40\xf4\xda\x0d\x48\xf5\x68\x44\x1f\x0f\xa8\xdb\x11\x4a\x92\x0d\x3c\x39\x9b\x46\x40\xe5\xc2\xd2\xfa\x4a\xe7\x4c\xc5\x49\xe0\xb2\x03\x67\x6d\x1d\x9c\x22\x6b\xc2\x53\x56\x0b\x76\x9a\x8e\x42\x14\x9c\x65\x81\x85\xc9\xf5\x4a\x2d\xac\x71\x0d\xa7\x49\xa1\x42\x41\x9c\xa5\x17\x5b\x95\xe1\x0a\x3d\x2c\xb6\x91\x2a\x67\xaf\x0c\xdd\x7c\x09\x8f\xcb\xd8\xc8\x64\xe7\xce\xec\xb8\x99\xd3\x72\x03\xec\x52\x2c\x9c\x4e\xab\xaa\xcc\x19\x75\x5a\xcd\xd9\xb1\x33\x3b\x6b\xa6\x39\xe4\x02\xde\xf4\x91\x21\xb0\x54\x82\xdc\x53\x8d\x43\xd5\x51\xc5\x2b\x73\xab\x47\x3f\xc8\xf0\x74\x4d\x69\x15\x71\x4d\x34\x06\x0e\x73\x0a\xc2\x2c\x92\x77\x81\x25\x89\x97\x4f\x09\x24\xb4\xbf\x7c\xce\x38\x3a\xe2\x45\xee\xe0\xcd\x58\x95\x05\x03\xb5\x34\xde\x44\xf8\xb2\x24\x28\xfe\x7c\xe9\x5f\x86\x11\x42\x21\x80\x2f\x4d\x6e\xc4\x31\x6d\x9f\x6b\x46\x59\x8b\x8f\x01\x5e\xa6\xe0\x6e\xa7\x96\xa1\x78\x72\x24\xb1\x03\x69\x52\x4b\x8c\xc0\x29\x4f\xfd\x3b\x56\x22\x34\x31\x61\xc5\x5d\x23\xbc\x89\x0a\x32\x30\x93\x5a\x66\xdb\xd0\xa0\xc6\x95\x65\xd9\x6b\xca\xd7\x18\x60\xcd\xb0\xda\xb0\xd2\x69\x85\xe4\xca\xec\x92\x5a\xb1\x1b\xf8\x95\xd1\x70\x70\x88\xc4\x72\xea\x05\xe0\x68\xc7\x2b\x09\x2b\xb3\x55\xab\x21\xd0\xe1\xca\x2e\x57\x48\xaa\xc1\x02\x78\x15\xe0\x06\x32\xf4\x51\xad\x72\xee\x94\x6d\x94\x6d\x68\xb4\x2f\x92\x02\x53\xd6\xd7\x51\xd0\x64\x8c\x9c\x63\x17\x76\x40\xf3\x4e\xd9\xc6\x19\x96\x21\xec\x42\xd9\x85\x87\x9b\x37\x44\xc0\x56\x96\x38\x54\x0f\x95\x75\xad\xb2\x6d\x6b\x1a\x74\xc9\xec\xfd\x90\x3d\x1f\x72\x5b\x66\x08\x57\x66\xbe\x8c\x92\x3c\x2f\x40\x6a\x12\x43\x3e\xa1\x31\x5b\x4c\x99\xad\x87\x5b\xbf\x10\x19\xc3\x49\x26\xff\xa5\xd2\x19\x5e\x0c\x09\xf5\x39\x68\xa0\x23\xb3\xf0\xd0\x82\xac\xb7\xd9\x6a\x57\x20\xfb\xbc\x63\xdc\xed\x80\x45\xed\x29\xdf\xd6\xb4\x01\x8e\x10\x3d\x56\x82\x7c\x68\xe6\xec\x34\xb1\x2c\x0b\x95\xf5\x13\x40\xf6\xeb\x08\x69\x95\xf5\xb1\x88\x88\xec\x33\x11\xce\x0b\x80\xc1\x43\x56\x33\xf5\xa4\xe8\x73\x50\xd6\xaf\xb5\xb3\x0d\x7b\x7c\x94\x25\x62\x65\x93\xb2\xa9\xa2\x56\xc2\x15\xd9\xa6\xca\x76\xeb\xcf\x0b\xac\x58\xfc\x27\xd2\x87\x02\x2a\xfe\x14\x89\x24\xb8\xa6\x5c\xc6\xf9\x26\x39\x14\x64\x4e\x82\x25\xbe\x6c\xd4\xcf\x7a\xad\xc5\x13\x5c\x0a\x9c\x54\xd2\x81\x5e\x19\xd3\x21\xa9\x86\x61\x8c\x98\x3b\x85\xfa\x89\xc5\xa4\x89\x03\xb6\xc8\x6e\xd8\x32\x12\x6a\xb7\x8c\xf0\xbb\xa2\xb6\x00\x92\x3b\x1f\xa7\xb7\xca\x61\x12\xea\xa8\x6a\x3b\xa9\xcd\x0e\xf1\x2e\xf0\x8e\x92\x72\x66\x41\xb8\xa7\x3f\x3c\x9d\xb1\xfc\xe8\x68\xe2\xe2\xcc\xda\x38\x4e\x07\xcf\x62\x0a\xb5\x8e\x92\xc7\x48\x3f\x47\x5a\xb3\x8b\x30\x78\xf2\x82\x3f\xaf\xab\xd1\x71\x17\xcd\x24\x9d\xcd\xd9\x19\xf8\xee\x2a\xde\xab\x58\xc0\xa1\xf9\xa2\x0b\x70\x64\x35\xf8\x98\x42\x95\x1f\x30\x96\x99\x45\x04\x04\x08\x71\x74\x32\x05\x17\x52\xf0\xfe\xf4\xe8\x21\xa5\x8f\x29\x91\x9c\x97\x2c\x15\x97\x9e\x9a\x86\x0b\xbe\x07\x60\x15\x8d\x6e\xd2\x38\x2b\x70\x61\x19\x16\x0b\x00\x2a\xe5\x92\x2e\xc4\x55\x81\xee\xbe\xa1\xff\xd8\xca\x1d\x36\x9d\x5d\x47\xcf\x12\x73\xb4\xad\x72\x48\x21\xb3\xb4\xfa\x8a\xc7\x1a\x42\x50\x2d\x08\xc1\x53\xb5\xda\x6b\x9a\xdc\x33\x44\x63\x6c\xe1\x06\x52\xb5\xba\xa3\x3f\xba\xb6\x56\xa7\x2b\x4a\xb2\x89\x02\xaa\x2e\xa4\x6a\xa3\xe1\xb0\x89\x46\x2c\xa4\xc8\x27\x4b\x0c\x1b\x10\x5b\xfa\xf3\xe8\x9a\x08\xe5\x65\x49\x42\xa4\xed\x80\x4b\x5f\x9b\x40\x79\x41\x8c\xaf\x24\xf0\x2d\x53\x83\xab\x25\xbd\x4d\x92\x07\x37\xd8\xb6\xf9\xb3\x6a\x8d\x4e\x70\x04\x8d\x6e\xc7\x03\xf4\xad\xb8\x67\x22\x30\x4c\x7d\xa5\x71\xf3\xfa\x6c\x6b\x69\x2a\xc5\x13\x84\xd6\xd2\x7f\x29\xc2\x5a\x71\x60\x54\x4a\x6e\xbd\x6d\x29\x43\xd6\xe4\x6e\xad\xef\xc1\x24\xe1\xa3\x45\x2b\xee\xc5\x2b\x11\x1d\x15\x0f\x44\xec\x79\x88\x52\xbb\x28\x0b\x66\x20\x76\x04\x7a\xb8\x17\xf2\x36\x87\xc8\x17\x91\x48\x85\x34\xa9\x16\x33\xc9\xc1\xf9\x10\x7f\x95\xde\x65\x8b\x6f\xd8\x67\xb3\x1d\xfd\xfc\x54\x98\x0b\x03\x4d\xca\x6b\xaf\x58\x8b\x43\x3b\x20\x6b\x3a\x29\xf7\x11\xe4\x5a\xf9\x7a\xa5\xa3\xf2\x58\x14\xf1\xec\x2f\xd2\x1b\x92\x50\xbc\xd9\xd0\x9f\x5a\x0b\xdc\xe5\x20\x59\xd3\x55\x41\xf9\x50\x6d\xa2\xcd\x86\x66\x53\xb2\x40\xe6\xc3\x64\xb1\xd2\x07\x5e\x29\xf4\xa1\xac\xda\xf9\xc0\x8b\x38\x3e\x0c\xef\x9d\xd0\x6e\x47\x00\x73\x48\x1f\x30\x75\x21\x38\x91\xd9\x7d\x38\x12\x35\x7d\x18\xc7\x4c\x1f\xd0\x2f\xf9\x50\xda\x93\x0f\xa5\x7a\x12\xb6\xe5\x8f\xe0\x03\xbe\x15\xd5\x10\xa0\x03\x77\x7c\xd5\x9e\x66\x72\x94\x4c\x64\x70\x1f\xbc\x2c\xd2\xfa\xc0\x7a\x86\x3e\x74\x9c\x04\x4e\x5b\x01\x5d\x81\xf4\xc0\xd1\xb8\x1d\x40\x32\x19\x2d\x94\x70\x36\x05\xf6\xac\xef\xe4\x43\x0c\x9b\xe2\xcc\xb7\x86\x4b\xa3\xc0\x77\x2a\xaf\x88\xb5\x96\xd8\x0f\x51\xc8\x2b\x2e\x61\xb6\x35\x65\x81\x26\xec\xc3\x30\x4a\xf8\xb0\xa1\x3f\x35\x3b\x9f\x57\xd2\xa9\x52\x07\x6f\x17\x00\x49\xf9\xbe\x85\x3b\x23\xf6\x69\xa4\xfc\x9a\x3e\x14\x7d\x73\x41\x1e\x8b\x03\x23\x15\x6a\x5b\x07\xe7\x08\x22\x6b\x81\xe8\x14\x08\x2f\xd3\x96\x50\x5b\x5b\xec\xb8\x42\x6d\x5d\x98\x97\x2e\x2f\xd4\x96\x1d\x27\x11\x16\xcd\x42\x80\xac\x1f\x10\x11\x36\xb6\x21\x24\xb1\x26\x0c\xb6\x6d\x3b\xca\x9e\x6e\x9f\xcd\xb0\xa4\x1a\x16\x8a\x7a\xba\xb0\x58\xa0\x02\x06\xd6\x36\x0a\x74\xb1\x6d\x58\x36\x08\xae\x51\x54\x1a\xcf\x67\x78\xb7\x53\xa1\xd3\xd7\x3d\x3b\x04\xa2\x24\x8a\xd3\x2a\x46\xa9\x04\x5d\xb6\xf4\x09\x86\xdd\x90\xc0\x13\x0d\x06\x18\xf5\xe1\x2d\x48\x53\x85\x64\xc0\xab\x25\x21\x6a\xcc\x54\x42\x6c\xe8\x4f\xb5\x3b\x10\xd2\xd8\x3a\xb4\x40\x42\xcd\x5e\x86\x28\x65\xaf\xcf\x84\x78\xf6\x07\x14\x62\xb3\x66\x8f\x44\x71\xa9\x7d\x09\xe6\x15\xa2\xd3\x9e\x0e\xba\x35\x0d\x5a\xa1\xcf\xf4\xa7\x2c\xfa\x8c\xea\x1c\xfa\xcc\xcf\xd6\x67\x92\x35\x30\x71\xa0\x64\x41\x7d\x3d\x21\xd1\x62\xb0\xec\x74\x7d\x45\x77\xa4\xae\xbe\x83\xb5\x96\x71\x03\x52\x22\xbc\xc1\xa5\x91\xc1\x22\x6c\xc7\x7a\x5a\xf0\x68\xc4\xce\x8b\xb4\x63\xc8\xfe\x58\x0a\x46\x67\xa6\x1a\xc7\xb8\x1f\x23\xb8\xa1\x77\x50\x90\x6a\x19\x75\x2d\x02\xe0\xc0\xa3\x31\xef\x88\x15\x4d\x9f\x4c\x45\x9d\xff\x11\xeb\xf0\x34\xde\xb5\xaf\x06\xd9\xbe\xc3\x40\xd2\xe9\x8c\x84\x3f\x7b\x57\x67\x48\x89\xf0\x9b\x54\xe7\xbc\xa2\x29\x03\xd5\x85\xae\xce\x24\xeb\x10\x2c\x7e\x3f\x3b\x13\x6b\x3c\x29\x43\x5e\xe2\x10\x82\x66\x4f\x75\x18\x0f\x12\x4d\x3d\x0f\xd1\x43\xc0\xbc\xce\x44\x1b\x1a\x01\x58\xab\x12\x14\xcb\x50\x9d\xa1\xd3\x70\x83\xd5\x2e\x61\xe0\x86\xe7\xa7\xce\x20\xa1\x6f\xd7\xa8\xce\xae\x43\x56\x9d\xeb\x97\x4b\xfe\x0e\xae\x5f\x5a\xaf\xba\xe0\x6c\xbd\x3b\x70\x85\x94\x2b\x0c\x5f\xb2\xc1\xd5\x85\x0d\xfd\x4d\x2c\xdb\x88\x5d\x34\xf3\xde\x3a\xb8\x15\xaa\x4d\x63\x92\x20\xf8\x30\x53\x7f\x44\x8d\x95\x1c\x06\xb4\xc2\x6a\xe4\x84\x6e\x60\xc1\x99\xa6\xac\xe9\x42\xf7\x84\x4d\xcd\xae\x6c\x49\x52\xbf\x8d\xa7\x25\x18\xd7\xe2\x53\xe8\x36\xcf\x42\x80\x55\x5a\x6a\xe0\xd6\x99\x25\x8a\x1b\x6a\xd3\x60\xe4\x29\xa8\x19\xb1\xaa\x6c\xa1\xc9\xbe\x31\x64\x33\x71\x33\x84\x6b\xc5\xa5\x12\xfa\xd9\x2e\x86\x2c\x8b\xc5\xec\x19\x88\x41\xa2\xbe\x92\x4d\xb2\x31\x1a\xf2\x0e\xe9\x75\xaf\x23\x35\x2b\xf8\xf9\x51\xd7\xbd\xad\xaf\x28\xc5\x92\xfd\x75\x1f\xb2\x46\xca\x4b\x88\x51\x93\xb4\x90\x54\xd4\x36\x19\x78\xe2\xa1\x64\x69\x8a\x37\x9e\x8d\x22\x91\x0c\x49\xe2\x94\x37\x55\x79\xa7\x86\xe3\x1a\x0a\x48\x80\x6b\x78\xb7\x00\xdc\x11\xd2\xc7\x44\x43\x70\x34\x3c\xea\x45\xd3\x04\x4a\xfa\x1a\x0e\x7a\x16\xf4\x97\x45\xb0\x01\x6b\x46\x34\x0d\x28\xbb\x03\xe2\xf9\x72\x34\x4b\xb3\xed\x30\xe7\x1f\xdc\x00\x11\x12\x2b\xbd\x5e\x6e\x07\x6c\xc7\x18\x2f\x4a\x03\x45\x37\x8e\x45\x17\x90\xf1\x31\xc3\xe4\x42\x67\x04\xdd\x6e\x0b\x42\x97\xe3\x1b\x44\x43\x92\x7f\x34\xae\x88\x15\x8c\xe2\xa9\x9c\xde\x91\x00\x50\x76\xaa\x05\xf2\xfc\x23\x1a\x67\x4d\xaa\xe0\x6d\x63\xdc\xd1\xa6\x9c\xe0\xf2\x43\x5b\xb8\x49\x94\x6d\x78\xd9\xa7\x8e\xa6\x33\x3a\x0f\x3b\xee\x04\xad\x5c\xc9\x18\x3b\xef\x60\x8f\x1f\xb2\x17\x3e\x8e\xbd\x47\xae\x3c\xe0\xc9\x83\x9f\x80\x77\xb7\x09\x46\x0b\x6d\xbc\xc8\x0a\xab\x0c\x64\x19\x84\x88\x16\x8d\x16\x18\x01\x12\xfe\xf8\x96\xb9\x8f\x05\xf0\xb7\x20\x8c\x6e\xd9\xa7\x03\xef\x1f\x81\x3e\x0a\x34\xcd\x22\x7f\xa0\xe0\x38\x49\xa3\x6e\x02\x21\xc8\x24\x38\xd7\x77\xc5\x77\x46\xd8\xd0\xbf\x7c\xaf\x23\x99\x81\x47\xd2\x18\x36\x34\xbc\x63\x61\x85\x65\xfc\xd8\x43\xa9\x5f\x2f\x8c\x4a\xda\x65\x95\x74\xdb\x39\xf6\x70\x31\x51\x81\x48\xf3\x47\x2a\xcd\x1f\xab\x34\xff\x5c\xa5\x5a\x7b\x25\xa2\xce\x54\x0e\x49\xb5\x67\x3f\x17\x2a\x21\xa2\xb7\x4a\x4d\xa8\x96\x26\x44\x16\xfa\x89\xca\xa1\x03\xab\x35\x39\xee\x54\x32\x24\x50\xa8\x64\x6a\x92\x0b\x33\x1b\x7d\x40\x92\x4e\x83\x01\x62\xdd\x47\x83\xb1\x0d\x28\x9b\xf8\xc0\xfe\x64\xd9\xb2\xd9\x53\x71\x5e\xb1\x50\xc9\x5c\xf7\x86\x4d\x7b\x08\xc9\x6c\xbd\x29\xd1\x30\x47\x37\x0c\x02\x78\xe9\x31\x0d\xc6\x68\x80\xbc\x7d\x21\x04\xcf\xa3\x84\x03\x4b\x16\x58\xb8\x70\x94\xa5\xb4\xd2\xf4\x7f\x44\xc9\x63\x4a\xa2\xe1\xb4\x11\xc0\x1b\xb2\x69\x05\xad\xfb\x55\xd8\xa8\xb4\x8a\x34\x85\x4c\xab\x3e\x37\x6c\xab\x50\xe9\xb5\x89\x7a\x69\x6a\x36\xe0\xb5\x15\x10\xcc\x59\x96\x51\xb7\xc4\x91\x95\x3d\xf4\x6d\xc9\x56\xa5\xf7\xd7\x6e\xb8\x26\x65\xeb\x1c\x0b\x10\xc9\x56\xb9\x58\x9f\xda\xb9\xe3\x72\xda\x46\x25\xbb\xf4\x87\x76\x0b\x55\x06\x48\x30\x56\x50\xc9\xe1\x63\xb3\xb9\x42\xab\x9d\x2b\x52\xdc\xb8\x1b\x02\x36\x7f\x07\xaf\x3b\xf6\x56\x12\xe8\x7b\x05\x34\xbe\xd1\xb6\xc0\x6c\x95\xb4\x1d\xf1\x94\xc2\x42\x43\xe2\x35\x6d\x18\x15\xa8\x74\xed\xe8\x5f\xcd\xed\xb2\x92\x66\x04\x92\x37\xb2\x27\x1c\x6e\x55\x8c\xb9\xba\x9a\x2c\x0a\x12\x0f\x65\x9a\x9e\xbe\xd6\xd1\x6a\x9f\x69\x64\xea\x4c\xa4\xaa\x72\xed\xb0\x9a\x9d\xae\x1d\xa4\xb1\x74\xed\xe4\xb3\x5f\xf3\x0a\x73\xba\x76\x89\x6d\x0a\x8b\x85\x43\x84\x55\x83\x6f\x34\x76\x79\x81\xce\x77\xa2\x21\x84\x14\xf6\x10\x84\xf4\x9d\x4a\x45\xfd\x0b\x98\xad\x19\xf0\xee\x04\xa1\x89\x26\x3b\xa1\xb5\xda\x55\x58\x03\x64\x1e\xf6\xde\xb2\x9e\x0b\x79\x95\xa6\x07\x31\xeb\x13\x74\x33\x3d\x10\xbc\xa9\x36\x7a\x57\x69\x92\xe5\x85\x97\x71\x42\x75\x48\x21\xf0\xc7\x21\x4b\x1e\x65\x64\x50\x57\x09\x63\x0c\xe2\x6d\x52\x1c\x6f\xd4\x53\xc9\xe9\x48\xd3\x98\xb5\x00\x6c\x3c\x0a\x8a\x9d\xc7\x94\xf9\x20\x5b\x79\x44\xcd\x8a\x44\x51\x52\x31\xf2\xc8\x91\x5a\x35\x2b\x38\xe5\xa8\xa9\x47\xab\xb0\x58\x94\x72\xac\x91\x09\xf5\xa4\x4a\x04\x7b\xde\x8b\x55\x29\xf7\x8b\x85\x4a\x79\x47\x95\xa5\x9f\xf3\xd5\xfd\x7c\x94\x35\xa7\x44\x22\x2a\x65\x9b\x8b\x9b\x15\xa2\xa2\x00\x64\xda\xcf\xb9\x57\xe9\xe7\x60\x54\x2c\x11\x12\x09\xe3\x91\x9e\x55\x88\x52\xdf\xaa\xd4\xa7\x0e\xba\x60\x3c\x95\x62\x20\xb3\x08\x21\xd6\x62\x93\xa1\xa6\x6a\x32\x69\xe7\x83\xdf\xb5\x2a\xed\x08\x4f\xd5\xb6\x75\x7a\xb9\x24\x54\x27\x70\x75\xbf\x25\xc0\x4f\xc2\x90\xca\x54\xb2\xde\xa5\x66\xae\x09\x50\x95\x15\x9b\x0e\x01\xd2\xf5\xec\x92\x2c\x3e\xb3\xb1\x81\x66\x4b\x8d\x39\xf5\xda\x0c\xd0\xce\x60\xff\xd1\x04\x95\x4d\xdb\x39\xb6\x6e\x68\xbb\x80\xc5\xad\xc1\xe2\xa1\x21\x34\x5b\x1d\x77\x15\xeb\xf1\x25\x85\xef\x9e\x57\x74\xf1\xca\x50\x02\x69\x25\xaf\x62\xe8\x97\x2b\x95\xd9\x9e\x81\xee\x54\x0c\x1d\xca\x22\x39\x70\x6c\x68\x12\x06\xe9\xf6\xb0\xc7\xc8\xc5\x2e\x60\x58\xee\x1a\x30\x12\x8b\x07\x62\xb8\x94\x32\xab\xf4\x7c\x1e\x47\x4a\x96\x47\x06\x3a\x72\x2c\xdf\x1c\x54\x0e\x65\xa9\xbf\xd4\x31\xc0\x5d\x52\xc3\x7e\x2d\xa1\x47\x6f\x3b\x47\xbc\xaa\xa8\x6b\xc4\xc1\x98\x4a\xcf\x13\x5c\x3b\xa6\xf8\x35\x0a\xc6\xe7\x90\x68\x21\x26\x0d\x05\x56\xde\xa4\xcc\x8b\x8a\xb9\x58\x04\x61\x60\x1d\xb4\xfc\x72\xdc\x55\x50\x98\x01\x22\x5b\x2f\x84\x73\xd7\x88\xaa\xd8\xcf\x1f\xa9\x7e\xfe\x58\xf5\xf3\xcf\x55\x5f\xb3\xce\xde\xa0\xd5\xd5\x7b\xa8\xfd\x00\x1b\x56\x43\xa0\x4f\x4f\x69\x50\xbd\x5f\x99\xad\xea\xbd\x45\x67\x37\xd1\xae\x1f\xd5\xf5\x58\x47\x9e\xe6\xba\xbd\xb7\xdb\xc9\xbe\x7d\x2f\x96\x73\xbd\x2f\x7b\xbc\xa2\x2c\xd8\x7b\x9e\x88\xf4\x5e\xa4\x51\x54\xbc\xde\x43\x52\xe8\x3d\xfb\x5c\x54\x3d\xcd\x83\x28\x8d\x7d\xe2\x8b\xfb\x24\x27\x62\x6e\x25\x1a\x8e\x45\x1d\xa5\xef\x78\xa7\xb3\x87\x2d\x58\x0f\xa3\x96\xbe\xc3\x8a\x78\x1f\x9d\xea\x59\x48\x29\x59\xc0\xf1\x12\x49\x47\x34\xf1\xe3\xae\xa5\x92\x29\x6e\x52\x68\x1c\x94\x54\xe8\xda\x81\x8d\x2e\xd8\x7a\x84\xdd\xed\x31\x09\xed\x73\xcd\x55\x84\x90\xc9\x93\xf7\x74\xab\xde\x36\x15\x0f\xd2\xc3\x0a\x49\x41\xaa\x61\x9a\x99\x72\xe4\x55\x06\x39\x04\x9d\x6d\x8d\x41\x99\xd7\x4f\x90\x26\xb5\xd6\x51\x0d\xea\x19\xb2\x5a\x52\x13\x62\x91\x68\x4e\xe7\x02\x5c\x81\x66\x40\x12\x63\x50\x24\xd7\x11\x79\xd0\x14\x82\x41\xcd\x70\x27\xba\xea\xaa\x4c\x5a\x05\xb2\x22\xba\x5a\xdb\x98\xa1\x39\x2f\x7b\x01\xeb\x60\x1b\x85\xe5\x9e\x0d\x4d\xef\x09\xb0\x00\x2a\x30\x29\xe8\x2f\x50\xd2\xe8\x1d\xa0\x28\x32\x6c\x8c\x73\x54\x93\x4c\xa3\x36\xd4\x39\x50\x62\x38\x5d\x0b\x28\xa4\x89\x84\x44\x22\x69\x98\xdf\xac\x6c\x96\x7e\x69\x63\xf3\x0a\x89\xf5\x00\x50\x8d\x0e\xf1\x4a\x6d\xa2\x46\x25\xd8\x36\x73\xb5\x6d\x9d\x92\x8e\x93\xc0\xa8\x3f\xb8\x6d\x1d\x5a\x0e\xc1\x40\x07\xd6\x1a\x67\x96\x6d\x6e\x42\x79\xc3\x61\xdb\x52\x59\x0d\x9f\xcb\xdd\xfc\xb6\xc5\x20\x8f\x72\xe0\x84\xce\x52\xca\xb3\xba\x6d\xeb\xa0\x52\xb4\x6d\x9d\x88\xb2\x84\x95\x75\x67\x22\xb8\xa7\x25\x84\x1a\xe8\x36\x44\x85\xb7\x42\x09\x0d\x6c\xbc\xda\x4a\x54\x42\x4a\x2f\x6e\x76\x72\xab\xfa\x73\xef\xdc\xec\x04\x53\xf1\xca\xfa\xb3\x19\x7f\xc4\xb9\x5d\x5a\x68\x05\x7a\xea\xa3\xe7\x36\x43\xff\x4f\xcd\x43\x70\x46\x43\x71\x2e\x8e\xca\x6f\x50\x50\x51\x8d\xa9\xe9\x8f\x15\x29\x56\x36\xb4\xd8\xf8\xca\x5f\x62\x57\x68\x69\x64\xcf\x08\x8b\xae\xbc\xac\xe6\xfb\xd6\x44\x5b\xd3\xcc\xd3\x95\xd9\x66\x2a\x8e\x4d\x08\x7c\xc9\xa2\x1d\x6c\xf5\xcc\x36\x97\x5a\x4b\x70\x47\xb5\x84\xaa\x0f\x2b\x6f\xff\x5a\xbf\x39\x9b\xf1\xc0\x3a\x3b\x99\x9f\xcd\x3e\x99\x9d\x18\xa4\x50\xec\x7e\xf2\xfc\xe4\x57\x62\x7e\x32\xfb\xed\xe3\x6f\x27\x47\xe7\x7e\x32\xfb\xe4\xc4\x20\x9d\x9c\xfb\xc9\x6c\xf6\xc9\x2d\xe7\xce\xfe\x13\xf9\xfe\xe7\x90\xef\xc7\xdf\x4e\xcc\xfd\xa7\x6f\x06\xb5\xf1\xcc\x0c\x41\x7f\x4f\x47\x3c\x6b\x7f\xa8\x24\x5e\x14\xc2\x29\xd3\xef\x58\x09\x7d\xa2\x8b\xfe\xeb\xfc\xcc\xdc\x7f\xff\xf2\x87\x13\x27\xf0\xea\xec\x57\xaa\x3c\x67\xb3\x05\x36\x0c\xa5\x62\xcb\xec\x92\x7b\x3d\xbb\xe0\x41\xd3\xb8\x64\x66\x27\x69\xd7\xce\x83\x3b\x9b\xcd\x4d\xf4\xa1\x77\xce\xaa\x01\xc3\xa6\x3e\x3e\x35\x64\xc1\x01\x29\x7c\x93\xf5\x20\x28\xaa\xd5\xae\x33\x51\xa6\x48\xb6\x2e\xca\x95\xac\xb4\x35\xe2\x72\x29\x56\x10\x45\x6b\x00\xfa\x31\xde\x2c\xc7\xac\xa6\x44\xf5\xf8\x88\xa4\x6b\x54\x17\x6c\x4a\x58\x3b\x02\x04\x0f\xbb\x04\x25\x07\x5e\x81\x36\xdb\x8e\x04\xd3\x4a\xc8\x74\x65\x36\x03\x9e\x7b\x6c\xf8\x66\x55\xeb\xbe\x5e\xed\x44\xab\xa5\x32\x5b\x36\x5e\xb2\xb2\x39\x85\xd2\x2d\xa9\x52\x82\x96\xab\xeb\x95\xad\x44\xea\xb6\x7e\x5d\x4d\xc8\x54\x6b\x67\x9a\xea\x88\x3b\xcd\x95\x0e\x2d\x75\xdb\x6a\xb5\x31\x76\x8e\x2d\xfe\x68\xea\x15\xcd\xe7\xf5\xce\x91\x7c\xa3\x36\xd6\x88\x8b\x76\x93\x83\x80\x8a\x9a\x73\xf5\x58\xad\x83\xaf\x5a\x9b\xcc\x60\x68\xc6\x0f\x5e\x9e\x6b\x4a\x54\x5d\x34\xf5\x21\xa7\x5e\xd1\x84\xfc\x6a\x27\xdc\x65\x37\x20\xc7\x87\xc6\x17\xb4\xd4\x7d\x4a\x56\xfb\xaa\x71\x6d\x15\xe6\x49\x35\x36\xda\x7a\x45\x7d\xb2\xbb\xfa\xb9\x82\xc2\x60\x41\xc6\x5c\x36\x96\xe6\x93\x19\x8f\x2b\xf8\xec\x64\xc6\xf3\xde\x10\x4f\xb3\x5e\xce\xce\x66\x16\x0b\x34\x83\x25\x97\xd8\xc1\x43\x26\xc8\x95\xf0\xa4\xae\xc8\x0a\xe2\xda\x54\x85\x11\xc3\xa6\x9c\xd3\xea\x1c\xed\x56\x95\x9b\x57\x0b\x4d\x6c\x2e\xda\x4d\xe6\x9a\x15\x1b\xcb\x45\x61\x2d\xe8\xec\x04\xb6\xad\x67\xb3\xd2\xfe\x92\xec\x63\x61\xa4\x9e\xac\x2b\x5f\xf7\xba\x04\x96\xc8\xc5\x5b\x07\xf4\x31\x44\x33\xe3\xd6\xfe\x74\xf6\xdb\x49\x3c\x7b\x28\xfd\x06\x77\x73\xe8\x37\x1e\x7e\xd8\xfe\xaf\x8f\x1f\x1e\x9e\xfe\x55\x9f\x2e\xce\x4f\x5f\x7c\xbc\xf7\xe1\x95\xfd\xf8\xf5\xe5\xe5\x7c\xf6\xff\xcf\xf9\x97\x97\xcd\xbd\x3b\x5f\x9f\x7d\x30\xcf\x3f\x7e\xb8\x77\x79\x79\x4a\xe7\x36\x9f\xde\xfd\xfa\xd5\x3f\xbf\xe4\xf2\xf2\xfe\x9d\xaf\xff\x70\x79\xd9\xdc\xbd\xf3\xf5\x99\xbd\xbc\x9c\xdf\xfd\xfa\x9f\xdf\xe0\xf2\xf2\x3e\x67\x7c\xdb\xcd\xec\x3f\x2d\xe0\xfd\xdf\x2b\xe3\xe4\xb2\x7f\xd6\x65\x66\x7d\x6b\x9f\x09\x6b\x06\x93\xce\x3e\xcc\x9a\x30\x3b\x99\xe9\x26\xcc\x3e\x9e\xb0\x65\xcd\xd5\xd9\x0c\x9a\x65\x09\x91\x29\x69\x04\xd6\xf5\xaa\xa8\xf9\xac\x91\x68\x4e\x9d\x80\xbe\x40\x33\x20\x89\x95\xd5\x09\x60\x21\x61\x6b\xb1\xdc\x9a\x1d\x16\x5f\x55\xad\x3b\xac\x69\x68\x84\x73\xd3\xb5\xd2\x75\xf5\x85\xd2\x75\x17\x3b\x17\xf2\x80\x10\x8f\xdd\xfb\xeb\x26\xd0\x1f\xdb\x00\xba\x09\x22\x83\x6a\x38\x5b\xd3\xad\xd1\x3e\x29\xed\x95\xf6\x81\xfe\x6b\xc5\x33\x6a\xa4\x15\xc9\x86\x59\x70\x9a\x37\x25\xc6\x1b\xb3\x50\x9a\xb7\x6b\x74\xa7\x74\x87\x3f\xac\x30\x8c\xe7\x94\x6d\x2f\x90\x54\x4d\x64\x28\x99\x11\xda\x01\xb8\x56\xe9\x68\x60\x9b\xb1\x04\x6b\x99\x94\x8e\xb6\xd5\x9c\xf2\x85\x84\x94\x2b\x09\xef\xd8\x8c\xa3\xed\x62\x20\x29\x61\xc0\xca\x49\x03\xed\x16\x13\xa2\x5d\x6c\xab\xaa\x71\xcb\x09\x8b\x32\x4a\x30\xfc\x60\xeb\x0f\xb1\x01\x51\x7a\xcd\xef\x71\x5d\xde\x22\x90\x54\x60\xf5\x85\x9a\xd7\xd4\xe5\x3f\x54\xf3\x65\x68\x16\xd1\x90\xb8\xe2\xe9\x39\xe6\xb6\x7b\x48\xb7\x9d\x5b\xe4\x30\xb7\x5d\x27\x64\x1c\x60\x07\x80\xa2\xcf\x2d\xa6\x9b\x0c\x2c\xc1\xb0\x81\xd8\x83\x51\xb0\x35\xad\x18\x3b\x50\x56\x21\x64\x48\xd1\x40\x72\xd4\x13\xac\xfa\x52\xcd\xc3\xb6\x0e\x95\x13\xd8\x31\xdc\x0a\xa8\xfe\x52\x90\x4e\xcd\xcb\xad\xa3\x9a\x23\x9e\xdb\x3c\xd2\x9c\x76\x1e\x03\xfd\x37\x48\x12\xa7\x46\xcd\x23\x1d\x89\x78\xad\xf3\xa4\xe6\x78\x74\xac\x87\x56\x1b\x41\x4c\x81\x38\x74\x8d\x97\x20\xe7\x67\x9d\xc1\x24\xf8\x25\x41\x14\x9a\x4b\xfc\x85\xaa\xb5\xaa\xcb\x77\xad\xe9\xa3\xd6\x5a\xde\x5a\xad\x5b\xf0\x7c\xf0\x9c\x56\x5f\x16\x88\xd3\x08\x2b\xd7\x11\x4e\x3c\xfc\x79\x87\x03\x6a\xdd\xba\xcb\xdc\x4c\xa4\xb1\x0c\x6d\x06\xba\xf9\xb5\xaa\x6b\x8c\x21\x0c\xa8\x34\xb5\x55\x75\xa3\x6a\xe3\x53\x98\xa7\x8a\x45\x5d\xd9\xd4\x52\xf5\xa2\x48\x9f\x8d\x8d\x6c\x3c\xd1\x38\x51\xab\x00\x45\x5f\x8f\x8d\x35\xe5\x9d\x80\xbb\x72\xdd\xe4\x1c\xb1\xbb\x70\x9d\xa2\x5b\x59\xab\x6a\x37\xd8\x58\xe8\x94\x7a\x12\xa0\x06\x2b\x0b\xfa\x27\x24\xb0\xb6\xa0\xff\x12\x09\xd5\x22\xc0\x0e\xc0\x72\x5a\xa5\x0d\x23\x59\x40\x39\x0d\x95\x9c\x11\x86\xae\x9c\x2a\x19\x7a\xb3\xc6\x1d\xb1\x59\xc4\x72\xb8\xd8\x65\x0c\xb6\x1a\x83\x1e\xc8\x60\xbc\x91\x43\x37\x10\x39\x1a\x73\x40\xd0\xc7\x62\x12\x5f\xb1\x6d\x70\x27\x1f\xe9\x6f\x90\x2c\x39\xa5\x92\x01\x52\x89\x7c\x02\x3f\x34\x66\x1e\xc2\x15\x9b\x7b\x74\xe9\xf3\x82\xc0\x9e\x23\x44\x12\x20\x2a\x3f\x9f\x12\x1b\x36\xdd\x88\x66\x6a\x89\xe1\x17\x6c\x6a\x21\x06\x17\x53\xbb\x8b\x68\xd8\x3a\x82\xcd\x1d\xc4\xe8\xa1\xd8\x41\x0c\xf6\x10\xa3\x61\xc4\x91\xb5\x44\xb6\x1e\xc6\x25\x9e\xb5\x0f\xa1\x7f\x41\x09\xef\x4f\x10\x26\x8b\xbd\x35\xac\x28\x62\x3d\x9a\x3d\x3c\xc6\x60\x0f\x01\x81\x06\x79\x31\x85\x68\x83\x18\x43\xb4\x7c\xaa\xe1\xd4\x09\xd0\x05\xe6\x01\xe1\x33\xb0\x1e\x5e\x87\x1e\x16\x11\x83\x99\x04\x09\x1d\xd4\xca\x29\xa5\xd7\x1a\xb6\x6c\xa1\x13\xb6\x2b\xfd\x0b\x01\x7c\x6d\x19\x27\xc6\x61\xa2\xa6\x72\x46\x93\x91\xf4\x9c\x46\x01\xbe\x98\x46\xd0\x8f\x6d\x83\x05\x42\x38\xae\x93\x55\xf4\x22\x72\x45\x15\x36\xc3\xb0\x27\xa7\x5c\xfd\x99\x01\xbd\xe2\x9c\x61\xc9\xd1\xb7\xa9\xe3\x94\xee\xd8\xb7\xbd\x53\x75\x8f\x56\x41\x29\x78\xd4\x06\x9a\xfd\x43\x88\x45\xc9\x2e\x0b\xf4\x03\xa2\x47\x2c\x4f\xd0\x7e\x8a\xc7\x03\x82\x6d\x1a\x72\x58\xa8\x66\xae\x1a\x9a\x59\xa8\x66\x98\x38\x06\xb6\x32\x18\x6d\x0d\x16\x0b\xd5\xc0\x04\xe0\x7f\x47\x21\x9f\x97\x54\x9b\x05\x67\xba\x58\xba\xa4\x9a\x45\xef\x1c\xcd\x4e\xad\x6a\x6c\xb5\x24\x81\x96\xfe\x58\x56\x56\x8d\x4d\x45\x9b\x9e\x20\x34\xe7\xbb\x8a\x5a\x16\x10\x06\x8e\x53\x2d\x60\x37\x51\xaf\x0f\xaa\x09\x86\xfe\x0d\x12\xcb\x29\x1d\xc8\xf8\x9e\x02\xab\x2f\x54\x23\x9d\x7c\x13\xf5\x86\xe4\x73\x51\xa4\x4f\xaa\x49\x15\xbf\xe4\x94\x1b\x1a\x5a\x26\xba\x88\x4d\x1f\x69\xea\xad\x9a\x0d\xba\xd6\x66\xd7\x6c\x95\xd9\x3f\x54\xa6\x51\x74\x2f\xdc\xc9\x2c\x69\x76\x67\xd7\xd4\x56\x4d\x4b\x32\x00\xd1\xbe\x86\x76\x3c\xab\xc8\x0f\xfa\xf2\xd7\xca\x44\xaa\x7b\x06\x27\x53\x3b\x77\x0b\x86\x1d\x83\xb4\x01\xa4\xf9\x89\xc0\x6a\x29\xa7\x08\x5e\xa5\xd5\x48\x76\x03\x6a\x27\x67\xd9\xc3\xb3\x2c\xce\x42\x86\xb9\xe7\x34\x0a\xf0\xca\x44\xd6\xac\x2f\x4a\xf6\x29\xd3\xbf\xaa\x17\x58\x31\x11\x9c\xf5\xec\x09\x75\xb6\xbe\x62\xab\x80\x94\x69\xf6\xa6\x7d\x03\x14\xaa\x12\x84\xe4\xe1\x68\xef\x07\x26\xa3\xe1\x4a\xf1\x08\x85\xb4\x2a\x66\x28\x4c\xa5\xbe\x6d\x05\x5d\xd7\xa6\x82\xde\x0d\xc6\x7f\x19\x46\x4c\x52\x26\x87\x26\x6c\x14\xea\xad\xc9\xa1\x6d\x76\xca\x6c\x95\xd9\xda\x41\x11\x1f\xa5\x01\xa8\x9d\x5a\xe8\x9a\xfe\x19\x49\xe0\x34\x0a\x90\xa1\x52\x88\x6e\x40\x6a\x5d\xc5\x80\xf0\xe8\x85\xc5\xa4\x50\xd0\x3c\xc7\x52\x13\xd2\xaa\x98\xb7\x31\xb5\x8c\xba\x5b\xa9\x45\xa3\xa9\xb5\x30\xa8\x0b\x8c\x03\x62\x47\x6c\x3e\x41\x0d\xe1\x49\xaf\x05\x80\xec\x93\x51\x8b\x55\x95\x58\x05\x9f\xf5\x8f\x16\xbc\xc0\xa0\x1b\xc6\xd8\xc0\x70\x50\xdc\x5f\x58\x58\x0f\x2f\xac\x73\x24\x68\x5b\xdf\x54\x2b\xd7\x55\x72\xdc\x37\x03\x62\xb3\x80\xea\x0b\xb5\x20\xc2\xa9\x85\xb3\xf4\x4f\x48\x32\x89\xdd\x7f\xae\x1e\xde\xa6\x7a\x2f\x6a\x1c\x6a\x11\x75\x5d\x3d\xfa\xf3\xe7\x8c\xe8\xe6\x67\x46\xea\xd5\x95\x20\x61\xcb\x48\xd3\x74\x82\x50\xee\x40\xd6\x0c\xa9\x90\x04\xdb\x5e\x90\x4e\xce\xec\xae\x05\xca\x89\x9b\xa5\xdc\x6f\x1b\x00\xa9\xcd\x11\x44\x1b\x2f\x08\x3d\x0b\xa1\xc1\xed\x18\xa1\x79\xeb\x22\x42\xdf\x98\xe1\xca\x33\xec\x18\x64\x5f\xe0\x63\x20\xd8\xc9\x58\xf0\x86\x00\x41\x24\x54\xcf\x16\x39\x6c\x58\xb3\x7f\xff\x50\x2d\x75\x5b\xda\x65\x41\xbb\x82\xd9\x91\x69\xc1\x6c\x35\xa7\x55\xf3\x58\x10\x61\x53\x53\x07\x42\x6d\x7d\xd9\xd8\x6a\x65\xb6\x7a\x19\x3c\xf0\xd4\x85\x2b\x93\xd4\xd2\xd0\x2c\x17\x33\x5d\x9e\xee\xca\xa4\xb7\xcc\x7d\x87\x39\x30\x21\x50\x25\x59\x1a\x9f\x32\xe6\xc6\x6b\x9a\xc2\xa8\xa5\xc9\x39\x5c\x51\x1e\x4e\x2d\x9d\x6e\x68\x6e\x22\xb0\xfa\x42\x2d\x9d\x6d\x2b\xf7\xf0\x51\x41\x1e\x17\xe4\xb3\x82\x7c\x5e\x90\x3f\x17\xe4\x2f\x05\x19\x2e\xff\xb2\x20\x7f\x15\xe4\xd1\xc3\x82\x94\x9c\x1f\x95\x9c\x17\x0c\xdb\x9e\xa1\xdf\x3c\x2a\xc8\xe3\x82\xc8\xbd\x3b\x06\x6b\x39\x61\x2d\xc7\xd7\x72\x78\x2d\x25\x5b\x4b\xc1\xd6\x52\xae\x35\x15\xab\xa5\x7f\xf5\x17\xa4\x1d\x52\x7a\xd9\xae\x45\x85\x58\xba\x00\x6b\x0c\x36\xc9\x98\xd8\x6a\x90\xa4\xc8\xa0\xfa\xb2\x20\x9d\x5a\xf2\x54\x70\xe9\xe7\xd1\xa0\xfc\x84\x2c\x05\x54\x7f\x2e\x48\xa7\x96\xa1\xc5\xbc\x86\xa0\xc1\x0d\x09\x89\x72\xc4\xc4\xfc\xcb\x80\xe0\x93\x87\xb6\x1b\xaa\xd1\x88\x53\xc7\x3d\x90\xdd\x80\xda\xc9\x59\xf6\xf0\x2c\xaa\x67\xdd\xaa\x09\x99\x00\x75\x11\x04\x22\x89\x66\xcb\x2e\x9a\x45\x22\x10\xe6\x96\xca\x21\x23\xa0\x40\x7a\xca\xa8\x96\xf1\x0b\x05\xf5\x9d\x6e\x07\x28\xb6\x1a\xcb\x58\x35\x73\xa4\xa5\x7f\x22\xdc\x46\x80\xa8\x37\x05\x56\xd0\xa1\xd9\x31\x19\x3a\x82\x18\x15\x05\xae\xad\xd9\x84\x0e\x16\x2e\x95\xd9\xf2\x3c\x60\x44\x1f\x33\x0e\xc3\x8d\x65\xac\xa4\xd4\xb1\xba\x4e\xec\x18\x98\x50\xac\xc3\x2f\x23\x74\xd6\x19\xb2\x3d\x4d\x9c\xdc\x19\x1d\xe6\x92\x2d\xa8\x19\x8c\x19\xf0\x80\xb4\x8c\x15\x36\xc1\x96\x50\xd5\x50\xdc\x55\x23\xa5\xa7\x37\x73\x4d\x0f\x66\x2c\x5a\x1b\xc3\xea\x8b\x01\xa3\xf7\x44\xb5\x60\xbe\x1b\x10\x3a\x9a\xd0\xaf\x5a\xbf\x08\x05\xc7\x1e\xc0\x32\xf1\x48\x50\x0e\x60\xa4\x59\x62\xc9\x62\xb9\x09\xa1\x51\xab\xfd\x43\xb5\xd2\x8d\x6d\xd7\x41\xad\x30\xd3\x5f\xe9\x3e\xb5\xda\xab\x95\x5e\x9b\xa8\x56\x46\xd1\xdc\x88\x7a\x0c\x82\xe8\x32\x18\xf1\x80\xa8\x65\x40\xa2\x1c\xa1\xef\xa9\x56\xc6\x15\x43\x1d\xe4\x69\x32\xcf\xaf\x19\xe9\x18\xe2\xbc\x8c\x09\xf6\xca\x6c\x9b\xbe\xed\xd4\xca\x3a\x9b\x8d\x5a\x51\xa7\x4f\x49\xf5\x85\x1a\x95\x4b\x56\xdc\x2c\x56\xae\x57\x2b\x6e\x08\xab\x90\xe9\x46\x94\x42\xb9\x68\x25\x95\x6a\x85\x9b\x8a\xb2\x8e\xad\x9b\xbf\x22\xa9\x16\x0b\xc0\x4e\x59\x9b\x94\xe5\xe1\xd2\xb6\x28\x80\xf5\x90\xc3\x47\x73\x9d\x05\x2c\x68\xd8\x8c\x66\x34\xa6\x59\xd8\xad\xb2\xbe\x83\x65\xcc\x68\x1e\x43\xff\x95\x31\xc0\x3b\x24\x86\xd3\x5a\x40\xe6\xdd\x0e\xa4\x9e\xd2\xc8\x04\x35\xd6\x2f\x0a\xd2\x01\x59\x3e\xae\x60\x64\x93\xe3\x72\x8a\x3c\x56\xb6\x0b\x98\x5b\x58\x4c\xe1\x6d\x54\x36\x2e\xe8\x5f\x89\xc5\xb4\x8d\x8b\x56\xd9\x68\x95\x4d\x55\x5a\xef\x04\x90\xf8\x6e\x93\x6d\x94\x15\x81\xd2\xae\xe9\x1d\x55\x8f\xe8\x6b\x08\x3e\x60\x36\x0f\x08\x95\x06\x52\x24\xd2\x6a\x11\x42\xf6\x81\xee\xb2\xce\xe3\xd5\xb9\x5c\x9c\xe5\xda\x2c\x97\xfe\xac\xeb\xab\x2b\x6f\x17\x06\x18\x23\x53\x50\xfd\xa5\x20\x5f\xaa\x9f\xaf\x50\x29\x7f\x0e\x96\xea\xf4\x95\x76\x3a\xb6\xfa\x91\xba\xd2\x1d\xfd\xab\xcf\x28\x6d\x0d\x0d\x86\x57\xba\xeb\x34\xa5\x34\x00\x5f\x35\xe2\x10\xb5\x20\xd5\x17\x62\xc2\x93\x5a\xfa\xdb\xe8\xc3\x5a\x5d\x65\xdd\xab\xab\x8d\x76\x24\x4b\xb8\xfd\x43\xe5\xb4\x72\x7a\x4e\x7f\x83\xc4\x71\x8a\x39\xad\x0c\x49\xc5\xd0\x06\x20\x2c\x80\x40\x61\xc4\x91\x70\x42\x49\xd5\x29\x67\x95\xb3\xbe\x0e\x2d\x6c\x6d\x60\x6a\x83\x7a\x84\xfb\xc0\xe4\xc6\x85\x41\x62\x9e\xe0\xd4\x59\x0e\x64\x37\xa0\x76\x72\x96\x3d\x3c\xcb\xf2\x59\x58\x42\x00\x92\x2a\xe1\xc8\x5d\x78\xa3\x62\xc0\xa8\x3f\x77\x34\xa7\xa0\x4f\xc3\x08\x5d\x89\x5d\x00\xba\x52\x76\x0b\x04\x0e\x67\x0f\x65\x9d\x10\x28\xc6\x40\x77\x23\x6e\xa7\x27\xda\xa3\x13\xa9\xc0\x9e\x57\xdf\xc4\x39\x32\x04\x23\x17\x6a\xd8\x0d\x0d\x26\x46\x6c\x0a\xc4\x5b\x2d\x28\xeb\xf0\x28\x82\x50\x69\xd1\xe2\x79\xf8\x63\x31\xb9\x0c\x85\xbc\x42\x02\xb3\x22\x08\x1b\x2e\x78\xb3\x41\x10\xfd\x70\xb5\x80\x69\x52\xb8\xea\x61\x32\x04\x13\x2a\x80\xea\x0b\xe5\x8a\xc0\xe8\x22\xec\xd6\x95\x8b\x54\xae\x18\x6a\x3a\x18\xf9\x1b\x26\xe5\x92\xf1\x92\x12\x1f\x70\xab\x1c\x97\x80\x7b\x71\x06\x74\x34\x47\xab\xfd\x52\xb9\xb5\x72\xeb\xf8\x98\x1f\x56\x90\xea\x0b\xd5\xee\x1f\xaa\x56\xab\x56\xd7\xf4\x8f\x48\x02\x4c\x91\x6a\x9f\x14\xf5\xa3\x2d\x2f\xe7\xb6\xd3\xf5\x5c\x21\x3a\x41\x32\x47\x9b\xf7\xd4\xdd\xc1\x2b\x0a\x25\x96\x23\xc1\x87\x9e\x23\xb4\xcb\xca\x56\x8b\xab\x73\x55\xeb\xae\x03\xc2\x8b\xc0\x84\x75\x7d\xae\x22\xa3\x51\x8e\x6a\x24\x55\x2d\x31\xe9\xb3\x1e\xc7\x58\xa6\x68\x1c\x05\xd6\xea\x7c\x78\xa8\xd5\x19\x63\x5d\x21\x68\x2c\x63\x5c\xac\x8d\x80\x3b\x3b\x17\x2c\x8c\x67\xcb\xb8\x09\x7c\xb3\xb2\x6c\x49\xa5\xb9\x3d\xb6\x3a\xd7\x1d\x7d\x94\x56\x67\xb4\xa4\x56\xe7\x72\xb6\x6c\xf7\x94\x4d\x1f\x06\x15\xfa\x61\x5e\x3a\xa6\x32\xe5\x68\xaf\x54\x5b\xd7\xf4\xb7\xaa\x6d\x1e\x56\xaa\x6d\x1e\x21\x69\xcc\xbc\x5f\x12\xf6\x18\xc9\x40\x26\xfa\x97\x37\xdf\x50\x03\x6b\x9b\x84\x35\xa9\x25\x61\xb0\x40\x6b\x9b\xd4\xf2\xe1\xb4\x32\x9d\x8e\x8d\x6a\x9b\x1d\x89\xe5\x00\x0b\xd5\x9a\xaa\x31\xc4\x35\x30\xc5\x82\x91\x56\xb1\xcb\x12\xeb\x2b\x5e\x2d\x6e\xcd\x35\xab\xd1\xb4\xb0\xd2\x8a\xcb\x62\x97\xb5\xe8\x54\xbb\xd8\xaa\x16\x03\x67\xbb\x0a\x4d\x03\x73\x2b\x76\xa0\xd3\xda\xad\x69\x68\x44\x28\x48\x05\x8d\xf5\x56\xb5\x57\xb2\x28\xde\x5e\x91\x48\xd4\x5e\xa1\x94\x57\xa9\x43\xc7\xd4\x3a\xd5\xba\xea\xcf\x94\xe8\xe6\xe7\x44\x70\xbe\x5a\xad\x80\xd4\x55\x03\xc0\xf1\xf2\x5d\xa9\x04\xae\xaa\x7d\x26\x80\xd7\xc3\xc8\x02\x67\x9a\x87\x9c\x56\xf3\xc5\x32\x09\xca\x3a\xde\x8c\x37\xf4\x04\x84\x59\x80\x47\x9c\x8e\x67\x3f\xc2\xbd\x05\x1d\x2f\x7c\x34\x5c\xf8\x98\xd3\xc9\xb1\xf9\x62\xf9\x50\x90\x38\x20\x9c\xf1\x7c\xf5\xf0\x5a\x90\x95\x1c\x5a\x95\x73\xa2\x14\xa1\x2e\x48\xb3\xe8\x1e\x0a\x12\x07\x84\xf3\x69\x86\x73\xb8\x94\xa6\xd0\xcb\x82\xb8\x85\x80\xf1\x51\x08\x2f\xcf\xe2\x16\x93\x02\xbb\xc5\xf0\x34\x6e\x51\x2e\xe7\x6b\x7c\xc9\xcf\x47\x29\x43\x6c\xfa\x11\x19\x33\x67\xaa\x64\x3f\x50\xd7\x23\x39\xde\x0f\x64\xb9\x23\x08\x1f\x87\x8c\xb6\x0d\x4e\x1a\x46\xef\xd6\x55\x4b\x73\xed\xf1\x91\x97\x51\x37\x0f\x05\xe9\x70\xa7\x55\xb9\xe5\x8a\x0f\x60\xc9\xa4\x75\x95\xf5\x36\x33\x5c\x13\xc0\x8e\xbb\x83\xa5\x0b\x01\x87\xfe\x47\x90\xea\x4b\xa0\x6c\x02\xe8\x2a\x3f\x47\x46\xa1\xc3\x19\x28\x23\xba\xc7\xd6\x89\x2c\xdd\xba\x2a\x36\xcb\x88\xdb\x44\xd3\x05\x94\x2c\x55\x28\x29\x87\x1d\x24\x84\xe4\x38\x42\xb2\xa9\x57\xac\x12\xd6\xba\xb2\xa4\xd3\x3a\xb3\xd6\x74\xb3\x45\xa5\x5a\xd7\xea\x3c\x87\xcc\x03\x94\x11\x14\x97\x46\x9a\x96\x47\x13\x06\xd3\x97\x22\x43\x4a\xeb\x30\x3b\x68\x1d\x5f\xb7\x36\x35\x10\xef\xa8\x07\xa1\xa2\xa1\x3c\x61\x2d\x36\x8a\x22\x31\x4d\xf6\xb2\xc6\x6d\xab\x16\x5f\x02\x80\x7a\x9e\xb5\xac\x5f\xb6\x6b\x59\x7c\x6b\x21\x5b\x21\x95\x6e\xc7\xef\x1f\x2a\x9e\xcd\xf1\x1c\x4e\x3b\x41\xdc\x42\x95\x59\x1d\xc3\xb4\x81\x15\x23\x4e\x35\x59\x79\xb3\x31\x3b\x4e\xab\x2f\x04\x76\x04\x93\xf2\x4e\x79\x47\x4c\x57\xfd\x15\x09\x1d\x70\x92\x80\x4f\x02\x0c\x52\x30\xcd\xb6\x7b\x2c\x00\x47\x09\xd1\x05\x16\xce\x67\x02\x40\x2f\x43\xfb\x99\x80\x42\x7f\x2e\x00\x34\xaa\x8e\x27\xd9\xe4\x33\x01\x60\xbb\xb0\xfc\x5c\x00\xe8\xb0\xac\x62\x03\x88\xd3\xe5\x7b\x30\x42\xd3\x20\xc6\xb0\x93\xe1\x31\x82\xb3\x85\x26\xef\x8f\xf9\x60\xe9\x9f\x90\x30\x6a\x9d\x80\x9d\xc2\x27\xa6\x24\x71\x8a\x61\xc2\x77\x39\x1a\xdf\x28\xdf\xb7\x3c\xe8\x10\x42\x63\x4d\x68\xe6\xb5\x0a\x0e\x66\x5a\x2a\xb8\x40\xff\x25\x12\xcb\x29\xbd\xfd\x20\x9a\x2c\xa5\x9c\x41\xb6\x6f\x60\xa9\x67\xe8\xbf\x41\xa2\x95\xc8\x24\xa1\xab\xea\xe0\x7c\xcb\x48\xdb\x11\x84\x12\x69\xe8\xd0\xa8\x42\x57\xa5\x1c\x55\xe8\xf0\x0f\x48\xe6\x9c\xe2\x7e\xa8\x56\x02\xe8\x7e\x52\xf3\x04\x12\x23\xa7\x0a\xde\x42\x60\x78\x53\x8c\xf4\xf2\x8a\xca\x1e\xf3\x0a\x8b\x50\xa1\x2f\xe6\x77\x0b\xb6\xbe\x13\x1b\xbc\xa9\x29\x5e\x42\xb2\xe2\xd4\x08\x18\x60\x56\x61\x0d\x41\xa4\xd3\x35\xfd\xab\x2f\x54\xa7\x1d\xcd\xe0\x46\x3b\xbb\x04\x0b\xb3\x64\x5b\xd5\x61\xc9\xb0\xab\x35\xfd\xab\x2f\x91\x96\x25\xf3\x6e\xd8\x58\xec\xb0\xb3\x38\xae\x75\x12\xda\x82\x8f\xed\xbf\xae\x6c\xff\x75\xf5\xca\x22\x01\x1e\x62\x84\x91\x9c\x33\xaa\x33\x3e\xdb\xbe\x55\x9d\xe1\x1d\x17\x81\x74\x9a\x89\x2d\xcd\xf0\x04\x52\x11\x4c\x4c\x50\x96\xed\x4c\x0e\x15\x3d\xc8\xd5\xb0\x7d\xd5\x5d\xf1\x2e\x4a\x77\x65\xae\x7b\xbb\x26\xb8\xd5\x2d\xa2\xca\x17\x8c\xf2\xbc\x4a\x88\x2c\xd6\x5d\x61\x8d\x98\x01\xf1\x9d\xea\x5c\x50\xe8\xdc\x8a\xf5\x1b\xd6\xf5\x91\xd2\x09\xc1\xa6\x65\x58\xb0\xda\x12\xb5\x69\x46\xd2\x46\x14\x99\xe8\x25\x1c\x69\x36\xc9\x0b\x82\x59\x5b\x48\x99\x57\x57\x09\xc3\xd7\x22\x04\x3b\x05\x5d\x67\x62\x84\x29\x9c\xea\xa2\xb6\x89\xd3\xca\x14\xf8\x58\x90\xae\x98\xbc\x15\xe8\xdd\x81\x95\x1b\x8c\xde\x82\x42\xd5\xe3\x0a\x27\xd5\xec\x60\xef\x7e\xe8\xe1\x48\x44\x13\xd3\xc6\x18\xa0\x6d\x8b\x0f\x1b\x43\x0d\xbd\xda\x34\x41\xc7\x4b\x0b\xa3\x2b\x56\x6f\x11\xd1\xf2\x39\x64\x3e\xa7\x5a\x00\xc2\xed\xe3\xbc\x2e\x44\xb1\xf5\xe3\xca\x17\x79\x2f\xbe\xdb\x70\x35\xd8\x34\xea\xfa\xf2\x32\xa9\xeb\xf9\x8e\xfe\x49\x5d\x53\x55\xb9\xe6\xaa\x72\x2d\x73\xba\xeb\x61\x99\xf1\x1a\x9f\xe5\x5a\x3e\xcb\xf5\x35\x3e\x19\x03\xa2\xa9\x57\xa5\xa4\xaa\x19\x74\x0c\xd2\x46\x5d\xf7\xea\xba\xd7\x4d\xbd\xba\x12\x25\x20\x67\x06\x84\xae\xec\x0d\xac\xef\xc4\x04\x6f\xb4\xa8\x83\xab\x51\x9d\x6d\x50\x91\xeb\x74\x2c\x75\x3a\xd6\x61\xa1\xa2\xc1\x1a\x7e\x34\x35\xdc\x35\x44\x1e\x28\xa8\x18\xd1\x2c\x3f\x43\x52\x75\x04\x9a\x0d\xac\xd4\x90\xb0\xe5\x9a\xa9\x3a\xb6\x66\x83\xcd\x1c\x01\x3e\x13\x7b\xac\x02\xe5\xdd\x13\x65\xd7\x38\xdc\x6a\xf8\x81\x85\x11\x1a\x5b\xa2\x8d\xf6\x68\xbe\x5b\xd8\xed\x2d\x06\x69\x21\xc2\x6c\x0c\x55\x7f\x34\x2c\xcb\xb0\x08\x63\xb3\xb0\xc1\x5a\xac\x55\xb1\x25\x39\x34\x86\xf9\x5a\x13\xa8\xd1\xe3\x09\xa4\xa7\x16\xec\x4b\xc2\x16\xd4\x14\x08\xc2\x8d\x2d\x01\xe6\x2f\x69\x68\x8f\x81\x97\xd4\x05\xf2\xb5\x59\xcf\x05\x80\x1e\x8c\xca\xd0\x25\xc7\xa1\x4f\x8e\xb8\x2e\x6b\x24\x8c\x22\x4a\x34\xcc\x5f\x88\x81\x37\xcc\x5f\x38\xf6\x2a\xf6\x9e\xfe\xa8\x60\x71\xbd\xe0\xdb\x32\xa4\xdb\xac\x3b\xe1\x74\xc2\x49\x1a\xf6\x67\x30\xc3\x28\xd6\x67\x6d\x97\xac\x4a\x7a\x3d\x1a\xa2\x35\x6c\xe9\xc3\x11\x97\x03\x02\x24\xab\x54\x6b\x68\x0a\x22\x61\x54\x47\x02\x19\x16\x67\x75\x5b\xa1\xd3\x49\x75\xa0\x3f\xd6\x57\x00\x3b\x00\x8c\x3f\x40\x32\x02\x32\x4f\x63\x33\xa3\x88\x03\x56\x61\x16\x81\xf8\xcc\x9c\xca\x61\xc1\xe4\x70\xc3\x56\x34\x0d\x37\xa9\x64\x46\x03\xb7\x4e\x47\x76\xf4\xdb\x19\x41\x62\x9c\x23\xc6\x1c\x20\xbd\x03\xf6\x70\x29\xff\x6a\xf0\xe0\x96\x16\x51\xfb\xda\x6a\x95\x56\x8a\x46\x91\xb4\x32\x0e\x09\xd2\x0d\xda\x40\x41\x28\x1b\xbb\xf4\x37\xd4\x33\x88\x89\x69\x3d\x21\x5c\x46\xdb\xf6\x8e\x53\x5c\x84\x48\x39\x66\x40\xaa\x2f\x55\xe2\x15\x98\x12\xc3\x06\x15\x82\x41\xd5\x3c\x2e\x58\xa7\x52\x1b\x42\x5e\xb1\x51\x58\xa7\xbd\x4a\x88\x33\x23\xf6\x60\x9d\xd1\x91\xa6\xe4\xa9\xb3\x57\xf2\xc6\x0a\x46\x77\x65\x3c\x2b\x9e\x5c\x55\xdb\xc1\x3a\x8c\x6a\x53\x92\x0e\x23\x21\x86\x33\x9a\x46\xe2\xb6\x91\xa4\x71\x24\xfa\xfe\x59\xa5\x5c\xc1\x6e\xa8\x5a\xd5\x9c\x36\x02\x2a\x7a\x63\xd8\xa7\x47\x0a\x03\x9d\x5c\x41\x82\x49\xb0\x11\x6b\x03\x21\x78\xe3\xb9\x62\x13\xbd\x5c\xa5\xb6\x73\x80\xfd\xdc\xc2\x40\xaa\xbe\x62\x33\x29\xb6\x02\x23\x48\x6f\x27\x63\x81\x33\xe5\xda\x22\xa1\xc7\xc9\x75\xd8\x72\x2a\xdd\x04\xe3\x8b\x38\x20\x34\xe1\x64\xbc\x13\x48\xf5\x8f\x90\xab\xb6\x40\xce\xa9\x5c\xdf\xc7\xb5\x00\x53\x20\x4e\x68\x0c\x3d\x8c\x69\x11\x6e\x67\x63\x51\x14\xac\x0d\x63\x3c\xa3\xbb\x2c\x11\x8b\xc7\xd2\xcd\xc5\xe8\xaa\xa5\xf7\xd3\xd2\x3b\xe9\x56\xfc\x31\x04\x41\x8e\xdd\x4a\x4c\xc0\x18\x61\x1e\x1b\x4f\xe5\x58\x6c\xb8\x32\xdf\x9d\x6f\x24\x3d\x39\x21\xfc\x0e\xa9\x48\x09\x27\x08\xcd\x9f\x13\x13\x87\x94\x73\xa8\x6b\x06\xc4\x83\x03\x83\x94\x37\xc6\xce\x55\xea\x55\xea\x71\x73\x4a\xe9\xed\xc2\x1e\xab\xe5\x44\x73\x1a\x05\xd8\x02\x7f\x19\x10\xa3\x52\xef\x17\xec\xbd\x26\xf5\x28\x5c\x1f\xd7\xfc\xee\xfa\xb8\x46\x6e\xd4\x7b\x26\xf8\xd0\x54\x69\x4d\x5d\x56\x5a\xef\xe8\x0f\x21\x8a\x11\x5c\xb8\xde\x55\x28\x09\x43\x7a\x18\xc6\xf8\x8a\x0a\x61\x16\x09\x96\x49\x04\x11\x70\xb1\x55\x10\x2a\x03\x50\xac\xea\x0f\x67\xf0\x4a\xbe\x90\xc3\x12\x35\x88\xf5\x88\x4f\xd6\xea\x0a\x99\x05\x6f\xa7\xc4\xf4\xee\x61\x7a\x20\x0c\x12\x05\x51\x45\xf6\x11\x6a\x7a\xa8\x0c\x68\x4c\xa5\x7e\x5e\xa0\x3c\xf2\x96\xd3\x42\xf1\x79\xa8\x75\x00\xf4\x91\xd6\x3b\xd4\xb1\xf5\x4e\xf6\xcd\xd2\x7a\x57\xf6\x32\x04\x85\xf4\x93\xd6\x3b\x59\xa2\x1f\x30\xce\x15\x8b\xf1\x40\xd6\x72\xd4\xd5\x48\x83\x3c\x9a\x74\x3a\xeb\x5d\x59\x97\x1b\x51\xbe\x3f\x16\x87\x08\xd2\xb4\x42\x20\x5f\x31\x94\x28\xc8\xa1\x30\x1c\x0a\xa5\x54\x45\xf0\x07\x9a\xf1\x64\xf4\xc2\x0a\xe4\x42\x16\x31\x92\xd0\xe1\x74\xc2\x0a\xd2\x15\xc8\xe7\xb3\x6c\x42\x08\xdf\x7f\xf8\x4e\x45\x92\x48\xeb\x5d\xe2\x4a\x44\xdd\xfc\x17\x05\xc1\xf3\xc0\x26\x14\x80\x0f\x64\xa9\x8a\x39\x64\x1a\xe2\x36\x2a\x6d\xe8\xc4\x0d\x6b\x95\xa5\x0d\x3a\x9c\x0d\xb7\xfb\x8d\x75\x57\x2a\x6d\x50\x83\x04\x66\x95\x36\x61\x40\xba\x48\x65\xde\xf0\x23\x6e\xca\xc3\x6c\xb8\xb3\xdd\x64\x21\xb9\x41\xee\x5a\x31\xd0\xde\xb5\xad\xa5\x94\xfb\x0b\x86\x54\xb6\x9d\xcf\x9a\xcd\x1a\xcb\x22\x26\xe1\x96\xad\x15\xa9\x37\xfa\x25\x06\x43\x43\x30\x62\x45\xcd\xe9\xff\x88\x92\xc7\x94\x54\x81\xf8\xf3\x06\x89\xe5\x34\x09\xe8\x08\xda\xd1\xca\x11\xcb\x76\x02\xab\x2f\x08\x43\x5b\xcc\x7a\xde\x23\x71\x9c\x6a\x01\xb9\x40\xa3\xc4\x04\x12\xe2\x3d\x21\x9e\x03\x45\xb5\x1d\xa2\x05\x98\x62\xfa\x68\x12\x89\xee\x04\xb0\xfe\x47\x48\xca\x8d\xca\x26\x47\x5d\xaf\x42\xb4\x35\x9b\x3a\x5a\xb6\x64\x8c\x2a\x53\x59\xc3\x9c\xfe\x56\xf1\x6b\x2b\x1b\x35\x0c\xd3\x46\xf1\xc6\x3c\x52\x2b\xe0\x97\x02\x8d\xca\x21\x95\xb0\x4e\xf9\x56\x5b\x43\x7a\x3f\x84\x92\x70\x09\x9b\x43\x1a\x38\x80\x50\xff\xc5\x88\x59\x16\x2b\x43\x3f\x87\xff\x26\xc6\xd0\x24\x72\xe4\xf9\x50\xf3\x98\x8d\x10\xf9\xf2\xde\xd7\x7c\x39\x21\x74\x79\x12\xc5\x65\x74\xd7\x39\x61\xe0\xc8\x09\x0b\x9d\x0c\x2a\xb3\x55\x39\x89\xc4\x4a\x08\x5e\x5d\x8a\x72\x0a\x5f\x26\x12\x40\x4e\xbd\xa7\x0f\xcd\x6f\x95\x25\xa0\xdc\x67\xe8\x8c\x10\x84\x05\x5a\xee\x73\x80\xed\x51\xde\xa8\xbc\xd1\xd1\xd0\xb4\x31\x6f\x02\xfd\x69\x6a\xcf\xa0\xaa\x16\x1d\xc9\xae\x22\x12\x0d\x4c\x51\x4c\xaf\xa8\xdb\x29\xcc\x61\x3f\xf3\x80\x6b\xe1\xb4\xe0\x38\x03\xe6\xa6\x63\xf6\xb0\xe5\x35\xcd\xc1\xdd\x52\x00\x9a\xe5\x2c\xcd\xe1\xfd\xbb\xda\x1e\x9f\x76\x7d\xcb\xa5\x22\x91\xde\x38\x77\x18\xb8\xa6\x99\x5e\xa5\x76\x38\x6f\xa7\xf2\xae\x1b\x23\x35\x85\x85\xea\xf7\x0f\x15\x5e\x35\x25\xf3\x68\xd6\x40\xea\x76\xb4\xb5\x4c\xc5\x66\x92\x57\x63\x8a\x51\x62\x7b\x05\xd5\x51\xcf\xc6\x8b\x54\x15\xd6\x3a\xc2\x39\x3e\x21\xac\xfc\xb4\xd6\x34\xdf\xf7\x4b\x83\x4b\x6c\x5c\x08\x80\x27\x1b\x41\x6b\x5e\xe2\x2c\x14\xef\x97\x16\x2a\x0f\xe6\x8c\x71\x31\x6e\x7e\x14\xda\xc6\x01\xa5\x9e\x53\x70\x56\x9c\x13\xe2\x20\xf3\x70\x40\xc9\xc4\x4a\x28\x7a\x3d\x82\x0e\x37\x15\x8b\xcc\xe8\x5a\x7e\x66\xcc\x4e\xd7\x3a\xa6\x80\x67\x4c\xc3\x89\x8c\x55\x9b\x29\xfe\x98\x88\x0d\x1d\xe7\x10\x48\xf4\xaf\x16\x66\x8d\xe0\x44\x55\x7b\xd5\xad\x2c\xb0\x8e\x53\xba\xd8\xd4\xd3\x57\x60\x6a\xbe\x2f\x43\x3e\xce\x25\x60\xc8\x1c\x08\xe2\x6b\x53\x97\xc2\x98\x68\x3d\x42\x0d\xc5\xc4\x96\x9c\x62\xcf\x39\x9a\x77\xb2\x55\xa7\xd9\x88\xaa\xed\xda\x2e\xd4\x7a\xe3\x92\xda\x14\x55\xcf\x8d\x99\x0f\xaf\x7a\x63\xe6\x89\xed\x39\xe7\x54\x0b\xa8\x3f\xc7\xfe\x32\x21\x8f\x0b\x32\x81\x0f\x81\x94\xfd\xca\x09\x4e\xe2\xf3\x84\xd4\x07\xc4\xc1\xc1\x6e\x40\xed\x24\x0b\x7b\x98\x85\x9d\x66\x61\x8f\xb2\xb0\x9c\x45\x4f\x7d\x27\x1b\x73\x55\xdd\x60\xd5\x25\xb0\xaa\x07\x2c\x15\x6c\x65\xb2\xda\xac\xd4\x06\xd3\xaf\xcd\xca\x2a\xde\xfe\xda\xac\xac\x2b\x96\xa8\xd6\xd5\xd4\xc9\x10\x0c\xdb\xe0\xd5\x06\x76\xa8\xbe\x41\x12\x38\xdd\x10\x80\x63\xe3\x0d\xa6\x47\xf3\x02\xab\x2f\x04\xbb\x56\x5b\x5e\x6e\xd8\x96\xe5\x86\x2d\xd6\x4b\x90\x12\x65\xd5\xd6\x56\x7f\x81\x0d\xa9\x5e\x0b\x80\xf1\x28\x7d\x84\x2d\x1c\x7a\x6f\xd3\x8a\xfe\x40\x8c\xe3\xd4\xa9\x6d\xae\xac\x4d\x04\x32\x80\x26\x21\x91\x40\xf0\x8d\xda\xe6\xb9\xf5\xa0\xa1\x11\xcf\xe0\x00\xab\xbe\x9c\xe0\xcd\xe3\x09\xd1\xe9\xe1\x42\xac\x08\x1b\x26\x7d\x66\x88\xd2\xb3\x37\xe5\x2d\xa6\x13\xdb\x0c\x3d\x39\x1c\x1d\x34\xe6\xb6\x79\x69\x0c\xa7\x95\xc1\x92\x8a\xe0\x18\x85\x19\x6f\xf5\x95\xb1\xd4\xc9\x30\xd9\x15\x58\x4e\x77\x89\x53\x1c\x59\xe9\x3e\x09\x20\x41\x71\x9b\x57\x7c\xc7\x55\xd6\x3b\x17\x28\x0f\xfa\x6a\xdb\x6c\xa5\x24\x22\x3a\x16\x04\x8f\x2b\x28\x9e\x76\x10\xa5\xb7\xd9\xae\xbb\xea\x91\x40\x1c\x5a\xf3\x95\x18\xad\x18\xd0\x80\xb6\xcd\x2c\x12\x09\x44\x8e\xc3\xbc\xb6\xa0\x0b\x7e\x0e\x11\xad\xf5\x84\x90\xf7\x88\x7d\xc3\x02\x87\xd7\xc1\x14\x1d\xf7\xf3\x6a\x61\x18\xba\x05\x20\x17\x46\x24\x77\x2d\x27\x11\x11\x8d\xdc\xad\xab\xa9\x96\x00\x30\x1d\x50\x23\x8a\x08\x3a\x60\x5c\xd0\x41\xb8\xd7\xc3\xc9\x44\x8e\xb9\x45\x14\x50\x04\xbd\x82\xe0\x71\x05\xe5\x6c\x18\x97\xc7\xea\x12\x17\xbb\x4b\x5c\xec\x2e\x51\xaf\x3b\x20\xb8\x3a\x62\xec\xd8\xe6\x58\x23\x11\x7c\xb5\x22\x50\x6e\xce\x0f\x4b\x4f\x37\x37\x82\x2c\x0a\xd2\x3a\x41\xa4\xe4\xfc\x0e\x18\x41\x2d\x8a\x46\x4a\x43\x5d\xfc\x36\xa7\x45\x85\x76\x02\x98\x2d\x61\x7d\xab\xb6\x99\xe4\xca\x2d\x24\x8d\x87\x04\xe5\x31\xf3\xf0\x94\x45\x2a\xdb\x66\x76\xf2\xb9\xdb\xaa\xdd\x96\xfa\xd1\xaa\x9a\x6b\xb8\x56\x62\x75\x37\x61\xea\x68\xf4\x01\x63\xae\xe3\x01\xdd\x84\x7c\x48\xc7\xc0\x0b\x17\x53\xe6\x20\xa4\x4c\x99\x56\xc7\x18\x36\x87\x2c\x8c\xcd\xe9\x80\x27\xa6\xa5\x53\x56\x57\xdf\xbc\xb4\xab\xe7\xb7\x31\x6b\xdd\xa5\x5d\x7b\xc4\x14\xb9\xe3\x98\xdb\x1d\x3f\x7c\xbc\xf1\xf4\xf1\xf8\xf1\x89\x71\x98\x7d\xac\x75\x77\x83\x71\x5c\x86\x28\x1e\xf4\x4d\x73\xc8\xbe\xf1\xe6\xe2\x6d\x65\x8d\x37\x8b\x7a\x0b\x67\x10\xa2\xc0\xfd\x45\x77\x55\x52\xbf\x58\x3f\x47\x52\x39\xb7\x60\xa4\x03\xd2\xd1\x5f\x98\x5d\xc5\x14\x8e\xe4\xaa\xce\xd5\x9f\x09\xae\xea\x02\x1b\x46\x6c\x2a\x50\x90\xb4\x0a\x82\xb4\x1d\x90\xb9\x4e\x06\x48\x1d\xb6\x80\x34\x49\x26\x68\x58\x97\xf4\x17\xac\xcb\x00\x21\x11\x92\xa0\x8d\x00\x3f\x07\xcb\x34\x95\x97\xfa\x8b\x4e\xfd\x92\xf1\x07\xc6\xb9\xd0\x14\x11\xb0\x73\x96\x91\xbe\x05\xcc\x41\x0a\xbd\xd6\x71\x07\x04\xe3\xfa\x9f\x67\xa3\xc5\x3e\xec\xd2\x67\x27\xf3\xb3\x07\xff\xf9\x41\x9f\xfe\x72\x7e\xfa\x1f\x0f\x4f\xff\x5a\x7d\xbc\xf7\xc9\x03\x31\x21\x2d\xce\x27\x70\xce\xe5\x1f\x2f\x7f\xfd\xfa\xe0\xbc\xcb\xdf\xbe\x7e\x70\x64\xa5\xbf\x3e\xfb\xf0\xeb\xfc\xec\x93\xff\x9c\x7d\xf8\xc7\x65\xbc\xf4\x1f\x3f\xfd\x7a\x76\xf9\xc9\x27\xbf\xb1\x55\x3f\xf3\x66\x1f\x3f\x9d\x98\xf7\x17\xe7\x07\xe5\xd2\xd9\xe5\xe5\xfc\x8e\x9e\xa7\xbd\xae\x43\xda\xeb\x64\xfd\x5e\x67\xcd\xc9\x63\xa4\xab\x7d\x6d\xac\xdb\xcb\xf0\xb5\xaf\x43\x3b\xdf\xd3\xb9\x8d\x85\x6a\xf5\xde\x6c\xbb\x3d\x7c\xcd\xef\xad\x5f\x97\xb3\xac\x5f\xa3\x97\xde\x3b\xbf\x77\x7e\xa1\xeb\x2c\x00\xb3\x8e\xbd\xf3\x7c\x2d\x9d\x0a\xe7\xca\xfb\x56\x6f\xf7\xad\xf5\xfb\x36\x34\xfb\x68\x5c\x63\x17\x7b\xf8\x52\xdb\x27\xbb\xf4\x7b\x2a\x56\xba\x8e\x79\x9f\xfa\x76\x4f\xc5\x43\xb9\x72\x94\x22\x60\x26\xb5\x9f\x9b\xac\x1b\xe3\xf7\x4f\xc4\x66\x7e\x3f\xb7\xd4\x90\x05\x68\xb7\xaf\x57\xf6\x31\x92\xac\xad\xdb\x37\xb8\xb6\x6b\xf4\x88\x1d\xe0\xdb\x01\x9f\x60\xcd\x76\xff\x62\xff\x82\xee\xf2\x02\x99\x80\x4f\x24\x9f\xb0\xb7\x54\x08\x7a\xfa\x62\xde\x8f\x97\x42\xf7\x15\x88\xab\xac\x5f\xbf\x40\x52\xa8\x72\xb5\x5f\x0f\x19\xf8\x72\x95\x1f\xce\xf2\xe3\x41\x7a\x2e\x81\x7c\x8f\x8c\x93\x7c\x79\x07\x7c\xb5\x47\x49\x25\x03\xb9\x1a\x97\xca\x75\x0c\x70\x4e\x88\x6d\xb3\xf7\x1d\x5f\x97\x89\xc5\x39\x8a\x49\xfe\x9e\x67\x38\x7b\x12\xba\xf6\x70\xea\xc1\xa9\x0f\x79\xcf\x7e\x5f\xf7\x68\xf8\x7b\x38\xaa\xde\xc3\x81\xf4\xbe\x73\x7d\xd4\x6e\xcf\x4e\xc0\xf6\xd1\x68\xb7\x87\xbf\xc3\x96\x41\x64\x90\xf6\xe2\x72\x6f\x0f\x37\x78\x7b\xae\xda\x04\x9c\xf1\x00\xc8\x98\x90\x72\x9c\xb3\x4f\x39\x86\x05\x72\x4d\x34\xcf\x4e\x00\x7c\xaf\x94\x63\xc9\x94\xd0\x72\x5d\x81\x70\x77\xb3\x4f\xfd\x1c\xee\xdd\x19\xd9\x84\xd8\xec\xd9\x61\xd5\x1e\xe7\xf1\x49\x60\x53\x02\x4b\xb7\x3d\x9c\x79\x9b\xb8\xd7\x7d\x0e\x75\x68\xcc\x7e\x08\xea\xb5\xaf\x57\xa1\xdb\xd7\xce\x76\xfb\x3a\xf8\x66\x6f\xf6\xa6\x4b\xec\x82\x81\x30\x78\x09\xd9\x23\x92\xc0\xde\x7a\x9a\x1e\xed\xad\xc7\x04\x6f\x6f\x79\x73\x6c\xcf\x6a\x5f\xd4\x12\x28\x53\x82\x72\x79\xab\xb7\x7c\x39\x5c\x6d\x03\xb8\xe0\x97\xfb\xd6\x52\x93\xe1\x93\x49\x7a\xe6\x93\xad\x97\x93\xad\xc7\xc9\xd6\xcb\xc9\x70\x04\xbb\xa7\xb7\x8e\xdb\xb1\x6a\xf7\x9e\xd7\xf0\xf7\x69\xcf\x5b\x36\xfb\x66\x4f\x93\x99\x7d\xa3\x77\xfb\x26\x6c\xf6\x4d\xd8\xed\x57\xda\xc1\x0f\xcd\xbe\x6d\x76\x7b\x78\x5b\xd9\x8b\x63\xce\xfd\xc6\x98\xab\x3d\x8e\xd1\x75\xd6\xd1\x45\x8b\x86\x92\x15\x25\x2d\x25\xd7\x94\x50\x4e\x8b\xdd\x7e\x35\x64\xe6\x76\xfb\x15\x9d\xda\xee\x5b\x00\xca\xd6\xed\xf6\xd7\xfb\x6b\x22\x25\x7f\xb7\xdb\x67\xeb\xf7\x99\xdd\xd6\xec\x37\xb8\x9f\xdb\xed\x37\x74\xce\x6e\x2f\xf9\xec\x56\xfb\x5d\xbb\xdf\x81\x77\xbd\xdf\x6d\xf6\xc5\x41\xc2\xbe\x0e\xce\xf7\xe8\xaf\x5c\x0a\x8b\x3d\xc9\xda\xfb\xc6\xe4\x7d\x63\xf5\x12\xc9\xc3\xda\xe7\xbd\x71\xfb\xa5\xc9\xfb\x95\x6e\x74\xab\x63\xb3\x7f\x49\x0d\x8a\xfe\x69\xd7\xee\x6d\x1a\x52\x76\x04\xb2\xff\x9f\xf4\xa5\xca\xeb\x6c\x75\x2e\x4d\xa4\x95\x7e\xcb\xf7\xce\xb5\x3a\xef\xd9\xc3\xe3\x9c\x20\xdd\x3c\x6d\x8c\xe9\xf6\x69\xd7\x52\xe6\xf0\x7f\xb5\x5f\x9b\x9a\xfe\x54\x90\xbb\x77\xbe\xfe\xea\xf2\xf2\xce\xfe\x8f\x77\xe1\xb6\x05\x21\x34\xff\xf1\x41\xfd\x1f\x1f\x3f\xbd\xbc\xfc\xf4\xfe\xa7\x7f\x9c\x9d\xfc\xe1\xd1\xdd\x43\x57\x29\xff\xc4\x7f\x80\xe9\x1e\x3f\xba\x2d\x32\xe7\xec\xc3\xf9\xe9\x7f\x54\x1f\x3f\xc8\x90\x72\xff\xe3\xa7\xb3\x93\xf8\xd5\x18\xf2\xf1\xbb\xe7\xe7\xcf\x9e\xff\xa0\x9e\xbf\x79\x76\xf1\xfc\xa9\x7a\x76\xfe\xee\x7c\xf6\xdb\x89\xfe\x0a\x23\x47\x6b\xb2\x86\x77\x83\x97\x17\xdf\x9f\x3e\x7a\xf8\xd9\xc3\xcf\x4e\x1f\x3f\xfa\xdb\xec\x24\x9e\x3d\x7a\xf8\xdb\x89\x3d\x3a\xe9\xf9\x9b\x67\xa7\xb7\x9d\xf8\xb7\x1b\x9e\x0c\x50\x54\x94\x19\xa0\x1b\x3c\x1a\xb8\xb3\x7c\x72\x75\x16\x69\xfc\xd4\x27\xf6\xe4\x38\x64\xe9\xd3\x3b\xb3\x07\x97\x97\xf4\x7a\xfe\x30\x3b\x99\x5d\x5e\x7e\xfa\x60\x76\x77\xf0\x4f\x63\xfd\xca\x44\x9b\xef\x98\xfb\xe7\x17\xaf\x4f\x7e\xb5\x67\xf4\x51\xf0\xba\xc6\x23\xff\x76\x70\xe4\xf7\x9c\xea\x94\x01\x57\x06\x6f\x19\x35\xff\x05\x47\x2f\x2f\x9b\x7b\xb3\x13\x4b\xc8\x4f\xf4\xd9\xfe\xc9\x17\xd9\xb9\x3e\xdd\xf6\x45\x6e\x8c\xfb\xb3\xcb\xcb\x3f\xce\xee\x99\xfb\x2f\x7f\xf8\x8d\x3e\xcd\x91\x67\x89\x7f\xb9\xf3\x81\x9d\x64\x3c\x3c\xfd\xeb\xc7\x5f\xff\xf2\xdb\x7e\x4a\x7e\xf6\xdb\x5d\x7c\xae\x0f\x33\x09\xc0\x37\x3b\x99\xd5\x29\xcd\x4e\x66\x50\x79\x9c\x9d\xcc\x38\x46\xce\xec\x64\xb6\x08\x3e\x9f\x2e\x74\x6d\x80\xc7\xd9\xc9\x8c\x63\x7b\x10\xc2\xb6\x02\xb3\x93\x19\xf4\x3c\x09\xda\x2d\x09\x0c\xb3\x4e\x2f\x89\xbd\xd1\x91\x28\x4c\xeb\x67\x1f\x4f\xec\x57\x1f\x66\x08\xc2\x39\x3b\x99\x71\x5c\x47\xca\xd3\xc6\x94\x4f\xa1\x44\x13\x47\xd2\x7a\x3a\x86\xf8\x9f\xe3\x49\x08\x03\x88\x62\xd4\xf4\x8a\x66\x70\xef\x37\x3b\x99\x39\x4d\x5f\x62\x46\x93\xd7\xd9\xc9\x6c\x6d\x93\xcd\xa6\x99\x7d\x3c\xf1\x74\x3f\xca\x66\x3e\x8f\xf0\x81\xd1\x44\x83\x87\xd4\x31\xdb\xda\xe1\x0e\xc9\xe2\x01\xe0\xec\x9b\x8a\x45\x7f\x17\xea\x2b\x78\x0b\x26\x22\x34\x3b\x02\x7d\xce\x81\x9e\xa5\xd6\x7e\xad\x13\x10\xf8\x13\x27\xcc\xe2\x4c\xea\x2a\xe9\x05\x36\x78\x8b\x0e\x29\x1c\x30\x13\xb6\xa0\x13\x1b\xbb\xa6\x14\x87\xe8\xfd\x99\x16\x8f\x66\x5c\xc3\x5f\x60\x61\x97\x63\xae\x0b\xbb\xec\xf9\x05\x85\x20\xaf\x26\x44\xba\x60\x45\x8d\x60\xf5\x98\x92\xcf\x28\xf9\x9c\x92\x3f\x53\xf2\x17\x4a\xb0\x9f\x45\x08\x46\x10\x42\x72\x4b\x77\xb4\xf4\x5f\x44\xdd\x1a\x7c\xc2\x25\xbe\x5f\xd7\xf3\x77\xa4\x32\x5e\xcd\x1b\xbc\xcb\x39\xca\xee\xcc\x92\xbf\xbf\xa3\x0b\x5b\x1d\xaf\xf0\x99\x7d\x3f\x3b\x99\x79\x4d\xcf\xc1\x0e\xe5\x09\xa1\xf3\xe9\x4e\xd7\xf4\x97\xf7\x96\x74\x8b\x56\xca\xde\x0e\x08\xeb\x34\x40\x8e\x01\x5f\x8b\x77\x02\x77\xc0\xe8\xcc\xcc\xd5\x7a\x96\xe5\x8d\x67\xba\x79\x36\xdb\x4c\xd3\x1c\x42\xe9\x3d\x10\x5c\x21\x31\x1a\xc7\x2d\x1e\x27\xd3\x03\xf7\x54\x8c\xb5\x8e\xa8\x02\x8d\x09\xb3\x8f\x27\xe1\xab\xd9\x07\x78\x58\x49\x97\x97\xfe\xf2\xf2\xc3\xe5\xe5\xd9\xc9\xc7\xd9\x49\xa2\x7a\xe1\xec\xd2\x9f\x4a\xc8\x40\xaa\x00\xa0\x6d\x36\x6d\x1a\xa8\x64\xdc\x82\x08\x2f\x5b\xf3\x53\xfc\x14\x81\x0c\x0e\x39\x25\x9c\xf3\x21\x57\x3c\xea\x1f\x30\x69\x62\x71\xda\x72\x85\x19\xb9\x1c\xa3\x85\x30\x88\x10\x07\xc7\x3c\x7f\xb8\x91\x41\x43\xf2\x29\x9c\x99\x1e\xb0\xb3\x6d\xad\x5f\x9e\x96\xee\x03\x55\x3b\xa3\x66\xeb\xfa\x8a\x9a\xf1\x29\x9c\xcc\xc1\x05\xb8\x70\x97\x10\x9f\x0f\x88\x53\x8d\xc8\xe2\x1c\x01\x78\xca\x27\xa9\xe5\x88\x13\x1c\x7a\x85\x09\x0b\x9e\x6d\x0f\x59\x21\xda\x25\x7a\x86\x09\xaf\x38\xc6\x3d\xe4\xb2\xee\xcd\x21\x2f\xd9\x5f\xb8\x2d\x46\xae\xdb\x8c\x9c\xce\x43\xce\xa1\x3d\xa6\xc7\x12\x1d\x70\x9d\x59\xe4\xd3\x88\x18\xe4\x37\x8e\xc1\x35\xc2\xef\x1d\x84\x63\xd3\x1b\xdc\x8d\x6d\x50\x13\x85\x5b\xb4\xe8\x0e\x38\xd3\x52\x0c\xef\x64\x42\x9e\x86\x3e\x73\xcb\x3f\xe0\x8e\x6f\x60\xca\x4d\xce\xd6\x37\x32\xe0\xe5\xe5\x63\xee\x51\xd9\xe8\xc9\x0f\xa9\xe3\xc2\x81\x77\xf4\x9c\xe0\x1d\xe5\x74\xfc\x86\xf0\xde\x8e\xc8\xe3\xbc\x99\x79\x94\x39\x33\x8f\x72\x4f\x9d\xae\x31\xa6\x0e\x8c\xc3\x8b\x72\xe8\x0e\x88\xe3\x3b\x11\xeb\xd6\xaf\x4c\x07\x6e\xff\xc4\x74\xe4\xe6\x5d\x8e\x0b\x36\x92\x43\x7d\xdb\x9e\x36\xa6\x0e\xd2\x5a\xa1\x85\x2b\xec\xb4\xd2\x4d\xd8\x14\xc2\xfe\x22\x0f\x44\x67\x9c\x0e\x63\x1f\xa8\x61\x04\x64\xd2\x7a\x19\x8a\x64\x08\x38\x2d\xa4\x33\xe8\xd3\xa4\xe5\x11\x38\xed\x34\xca\x53\x9e\x9f\x03\xc8\x0e\x9d\x86\x90\xd4\xc7\x8c\xd4\x52\x77\x23\x11\x7b\x67\x0e\xa9\xd3\xa3\xbc\xc0\x2b\x6f\x66\xca\x2b\xef\x42\x78\xd2\xab\x0b\x75\x78\x10\xa3\xe4\xd0\xc1\x72\x58\xd4\x78\x3a\x44\xff\x9a\xf0\xa0\x8d\x42\x34\x62\x88\x60\xa4\x1c\xbb\x52\x51\x99\xc5\x78\xd9\xe5\xdd\x69\x6d\x1c\xc6\x54\xb6\xae\x27\xc4\x99\xad\x80\xd3\xb9\x4e\x36\x15\x62\x9a\x0b\x18\x0b\x87\x4f\x03\x7c\x19\x47\x9c\xdd\x7c\x17\x6a\x13\xf1\xae\x30\x37\x12\x01\x68\x94\x83\x5a\xeb\x76\x03\xc5\x8e\xbe\x4f\x8b\x83\xf1\xc2\xbf\x32\xf0\xd2\x59\xc8\x12\x34\xeb\x54\xa2\x79\x98\x72\x40\xba\xb5\x01\x3f\x65\x5f\x58\x03\x2b\x47\x93\xeb\xd5\x48\xf2\xd7\x00\x21\x6e\xb3\x8f\xc8\x53\x67\x97\x28\xd2\x50\x14\xf6\x19\x0c\xb9\xa0\x20\xbb\x6e\x65\xf0\x6d\x6c\x8d\x37\x23\x5d\x51\xb4\xc6\xe7\x32\x52\x95\x8e\xc8\x37\x86\xc5\xdc\x81\x83\xf0\xee\xe5\x24\x53\xc6\x30\x11\x95\x81\x21\xb4\xda\xec\x64\x46\x8f\x62\x17\xbb\xc9\x18\x2b\x1d\x11\xcb\x7a\x93\xe6\x4e\xd2\xde\xe9\x50\x40\x9a\xf1\x0e\x0f\x3b\x12\x43\x0f\x3a\x61\x4d\x46\x90\x09\x37\xef\x3a\xc3\x32\x0b\x0f\x39\x8c\x8c\xa3\x85\xd0\x52\x1a\xa1\x4a\x57\x26\x24\xf7\x35\x30\x2a\x03\x4c\x10\x7f\xf4\x76\x2c\x26\x11\xa5\xbe\xb7\xd6\x4f\x0e\xd8\xb1\x21\x78\xbd\x3e\x6d\xc2\xc6\x0b\x8a\x85\x10\xc1\xe5\xf6\x84\x96\x7b\x13\x0e\x59\xc8\x07\x88\xbf\xbc\xe8\x32\x88\x5a\xa7\x0b\xbc\x61\x21\x26\xcf\x1e\x38\xee\x37\x61\x32\x40\x86\xd8\xad\x34\x3e\xb2\xf8\xde\x1d\xb1\xa1\xa5\x17\x9a\xfd\x2c\x4f\x18\xe5\xdd\x17\xba\x3c\x4c\x89\x4a\x33\x41\x4b\x5b\x19\xe8\xed\x94\xd8\x61\x26\xd0\x34\xfc\x95\x05\x1b\xbf\x43\x61\xc8\x9b\x28\x64\x79\x1b\x85\xe6\x4f\x41\x33\x8a\xd3\xc3\x6e\x74\xc2\x1a\xfa\xd2\x09\x6f\xe8\x50\x3b\x13\x61\x82\xcc\x53\x89\x09\x35\x4a\x25\xd0\x32\x30\xf1\x94\x83\x94\x82\x31\xbc\x5d\x48\xb4\xc4\xe3\x40\x13\x84\x48\x09\xb3\x9e\x97\x36\x0c\xe9\xf5\xd4\xe9\x5d\x80\x44\x4d\x72\xeb\x29\x84\xc8\x03\xe2\xd4\xe9\x34\x1c\x1e\x07\x90\x9b\x9c\xe1\x23\x1d\xf3\xe5\x5b\x1e\xb3\xcb\x27\x03\x9f\x6a\x99\x1f\x6e\x33\xf9\x6a\xa0\xa7\x8d\x1a\x8c\x61\xc4\x02\x35\xf8\x74\x2c\x0c\xf8\xac\x46\x3d\x98\xbc\x13\xfe\x26\x07\xe7\x16\x7c\x7c\xa9\x23\x6b\x28\x1e\x71\x86\x3c\x06\x62\x90\xa5\xa7\xac\x51\x6c\x9e\x70\x4b\x94\x80\x43\xee\x4d\x99\x57\xfc\x6a\x9f\xce\x6d\x43\xd3\x97\xb5\x89\x08\xa1\x3d\x7c\x92\x03\x19\x18\x9e\x8f\xd1\x1d\x61\xce\x6a\x9b\xb0\x49\x8c\xa0\xda\x6f\x42\x6c\x86\x21\x1e\xc4\xd8\x73\x81\x94\x36\xf0\x8b\x34\xef\x8f\x27\xee\xab\x0f\xb3\xcb\xcb\xaf\x67\x27\xb3\x3b\x97\x97\xf3\x1f\xb0\x8c\x71\x79\x39\xbf\x2b\x8c\xe7\xbe\x99\x50\xe6\x80\x6a\xcc\x42\xa8\xbf\xcd\x4e\x66\xff\x72\x79\x99\x78\xc5\x82\x91\xaf\xbe\xfa\xaa\x70\xf6\xb3\x93\xd9\x9f\x66\x1f\xff\xf6\xdf\x6e\xac\x92\xd0\xcb\x96\x95\x91\x47\x53\x5f\x8f\xec\x14\x17\x8b\x10\x77\x66\xf7\xdc\xfd\x9f\x83\xf5\x77\x66\xfb\xd9\xdd\x7b\xb3\xbb\xe2\xb8\xf7\xdf\x2e\x5e\x9f\xf0\x3a\xc8\xe1\x12\x4a\x3c\xe1\xf5\xff\xfb\xb2\xc9\xf0\x71\xb2\xd9\x70\xfa\xf1\xd3\xd9\xbd\x70\x12\x9f\x9c\xfd\xe1\xe1\xb8\x81\x51\x5c\x9d\xc2\x71\x5c\xf1\x74\xf9\x3b\x57\x63\xc7\x01\x67\xfc\xcb\xff\xe7\xfc\x6d\x23\x99\xff\xde\xa5\x63\xe6\xf3\x3b\xb3\x7b\xfe\xf0\xa9\x7f\x3f\xdf\xac\x97\x92\xf1\xfc\xbf\xc8\xf8\xbf\x7f\x7d\xf6\xf5\x19\xe7\x6e\x8f\x73\xe7\x33\xbe\xb9\x33\xbb\xa7\x0f\x0e\x5d\x5e\xce\x67\xbf\x9d\x64\x7a\xbd\x17\x17\x58\x85\x7a\xf3\x9a\x17\x8f\xc6\x3a\x3c\x3f\x9b\xfd\xe3\xf6\x5b\x5f\x5e\xde\xb9\xff\xe9\xe5\xe5\x5d\x7c\xca\x0f\x97\x97\xfe\xe3\xec\xe8\x31\xe0\xca\xf5\xbf\x2a\x3f\xdf\x10\x9e\x5d\x13\x6f\x23\xdd\x79\x70\x62\xce\x1e\x5c\xde\x7d\x40\x19\x45\x94\x4f\xea\xc2\x58\x4a\x54\x92\x8f\xc3\x26\xd1\xe0\xf7\xbb\xdc\xed\xce\xec\x5e\xba\x2f\x2b\xea\x77\xee\xde\x78\xea\x13\x84\xe1\x48\x67\xbf\x9a\xb3\x07\x7f\xdb\xff\xf1\xc6\x9d\xfe\xed\xe6\xfd\xb0\x80\x79\x62\xcf\x1e\x5c\xde\x7f\x00\x6f\xa8\xff\x64\xf1\xac\x9f\xf7\x9e\x06\xc9\xdf\x59\x3d\x9b\x2c\xda\x5d\x5e\x7e\xb8\xf4\x77\xe0\xee\xb7\xd3\x31\xdf\xfd\x5a\x96\xe9\x3e\x5e\xfa\xd9\xb0\x9c\x76\x70\x7e\xf3\xeb\xe7\xbf\x9d\x12\x78\x5c\xc0\x9d\xcb\xcb\x74\xef\x2e\xe3\x67\x07\x00\x4e\x5d\xff\x63\x5c\x11\x9d\x64\x74\xe7\xf2\xf2\xde\xfe\xf4\x2e\x16\x04\x87\xc5\x50\x59\x56\xe5\x95\xcf\xb2\x72\xf8\x8f\x3b\xd9\xa4\xbc\x87\x5e\x9e\x5f\xee\x25\xf2\x45\x81\x8b\xde\xed\x25\xc0\xf4\x1e\xde\xc2\xf6\xe9\xca\x76\xfb\xed\x02\x7b\x31\x5b\x39\xeb\xee\x9d\xb3\xaf\xef\x52\x31\x91\xd7\xdd\xaf\x67\x5c\x25\xff\x01\x13\x23\x93\x12\x1d\xe6\xc7\xf8\xfa\x4e\x17\xba\x7d\xd7\xa7\xd5\x78\x56\xd6\xcb\x74\x36\x10\xb6\x35\x67\x54\xed\xcb\xc2\x2c\xaf\xbd\xd6\x67\x1f\xf2\x49\x3c\xd1\x27\xf6\xf7\x3f\xcb\xc6\x2e\x6e\xff\x28\xc3\x72\x72\x55\x3d\xfd\xfe\xd5\xfb\xd7\x6f\xaa\x4a\x55\xd5\x8b\x97\xaf\x9e\x33\xf2\xfe\xcd\xd3\x77\x2f\xbf\x67\xee\xab\x97\x6f\x88\x0b\xc7\xaa\x7f\x50\x3a\x7d\xad\x4a\x04\x78\xbb\xb6\x79\x27\xbe\x42\x11\x87\xa1\x46\x48\x39\x71\x5d\x39\xf1\x88\xb8\x36\xde\x72\xec\xf9\x31\xe8\xbe\x89\xaa\x31\xb0\xd2\x6c\x6c\x73\x61\xd8\x89\xdd\xce\xeb\xd6\xd6\x05\xbe\xdb\x75\x46\x82\xb2\xfb\xbe\xe5\xa8\xe1\x50\xf3\x5a\x68\xe7\x4a\xdc\x0d\xf6\x1b\xbf\xb0\xce\x94\x08\x69\x12\x91\x3b\x44\xc4\xb1\xe6\x88\xd5\x08\x00\x6d\x17\x07\xf1\x91\x79\x9a\x32\xf8\x56\xb1\x84\x86\x9e\x3d\xa6\x20\x2c\xb9\x4d\xca\xe9\x5f\x76\x25\x44\x2e\x62\x81\x6a\x04\xb5\xf1\xb0\xf3\xe4\xb8\x96\x03\x73\x12\x19\x51\xc2\x21\xc2\xe4\xaf\xcc\x3e\xc4\x82\x6e\x5b\x42\xcc\x21\x04\x5e\x34\xcc\x29\xb1\xdd\x42\x0e\x75\x70\xea\x6d\x41\x24\x00\xdb\x60\x18\x35\xc4\xe2\xa2\xc7\xdf\xa4\xc1\xf8\x09\x3e\x26\x11\xd9\xe9\x82\xc3\x3b\x4d\xc2\xec\x70\xb0\x98\x7e\x2e\x2e\x1a\x53\x8f\xe8\x29\x1c\xbb\x05\xd9\x28\xc9\x0c\x7e\xa3\x73\xdc\xd1\xff\x0f\x94\x7c\xad\xde\x15\x6d\x4a\x8c\x73\xaa\xf7\x61\xe3\x0d\xd4\x1b\xd5\x86\x3e\xfb\x41\x24\x03\xeb\xdc\x05\x49\xb4\xb7\x3a\xf6\xb7\x87\x7e\xfd\xe7\x49\xe9\x66\x8d\xe8\x0d\x10\x0e\xc2\xa2\xc0\x1f\xe1\xef\x58\xfb\xdd\xb7\xec\xd6\x2b\x8c\xce\x77\x01\x6c\xf0\x2f\x24\xc0\xfb\x3c\xda\x66\x69\x5e\xc4\xd0\x7e\x0f\xe1\xdc\xae\xcd\xd3\x5b\x99\xef\x7d\x1d\xbc\xc4\x1f\x75\x72\xc6\xbb\x70\xe3\xa2\x29\xeb\xf0\x92\x1a\xf5\x59\x5b\x78\x07\x65\x87\x9a\xbd\xcf\xcf\x39\xb4\x82\xf0\x5e\x71\x78\xe5\xff\x30\x31\x24\x85\xf5\xfc\xb7\x30\x6e\x1c\x51\xe7\xe1\xb3\x11\x4f\xdd\xc4\xd0\xbd\x40\xb8\x78\xc2\x5e\x69\x42\xfa\xb6\x13\x27\x89\x4f\x6c\x4e\xe7\xe9\x27\x44\xba\x33\x08\x4c\x80\xd8\xfe\xd7\x3d\xd5\x6c\x9d\xb5\x7b\x0e\x47\x85\x83\xa7\x3b\x8f\xc8\xec\x4f\xf0\x14\xcd\xf8\x10\xf8\x7c\x4b\x93\x7f\xd4\xaf\x2c\x7c\x0d\x95\x60\xde\xf2\x22\x2f\xd0\x18\x92\x5c\x77\xf0\x46\x06\xee\x8f\x26\xce\x75\xb6\xed\xd1\xd1\xf7\xb0\x87\x77\xbb\x1f\xc6\x48\x7a\xb7\x31\xdf\x04\xff\xfd\xfc\xe7\xa7\x1c\xfa\x5a\x5a\xd3\xd6\xd6\xac\xad\x4a\xb2\xe1\x53\xf1\xd1\xca\xf1\x8f\x11\xc0\x58\x5e\x6b\x89\x0a\x5c\x48\x89\xcf\xf0\x94\x5e\x15\x9b\x7d\x76\x69\x1a\x59\x33\x24\x69\x5c\xe5\xbb\x1d\x10\xa5\xce\x14\x83\x53\xfe\x1c\x08\x58\x78\xc1\x56\x86\xba\x79\x65\xbd\x91\x80\x81\x2a\x9a\x85\xe3\x70\x76\xe8\x0f\xba\x68\xf2\x53\xb6\x97\x94\xe8\x73\xa1\xf7\xcd\xfb\xee\x5d\x38\xa7\x6a\xcb\x81\xad\xec\x2f\x26\x2c\x04\x70\x35\x16\xe3\x2e\x36\xee\xa1\x11\xf8\x27\x9b\x57\x88\xe3\x84\xd0\x55\x04\xe8\x0a\x41\xe4\x9a\x8d\xee\x54\x0e\x17\xa2\xf8\x4e\xf2\xb6\x04\xab\x69\x4c\x2c\x8e\x20\x9f\xa2\x16\x72\x88\x99\x73\xde\x60\xf9\x7e\x21\xf4\x13\xcb\x45\x65\xea\x59\xd8\xf8\x7a\x24\xdf\x7b\x92\x9b\x85\xf8\x41\x1e\x72\x63\xf3\xea\x39\x76\x9e\x4c\xf3\xca\x2e\x38\x7c\x12\x31\xf9\x93\x9f\xe7\xb7\xae\x4f\x54\xb1\xc1\x7c\x8f\x6b\xdf\xf2\xdc\xed\x26\xa7\x54\x94\xc9\x91\xd7\x1c\x75\xea\xe6\x25\x87\x07\xd2\xff\x4e\xf6\x7c\x92\xd4\xe8\x5f\x6c\x37\x8a\x0d\xbc\x00\x21\xd2\x17\x44\xaf\xcb\xcb\xcd\xff\xfd\x7f\x9e\xfe\x3f\xff\xd7\x27\xd8\x46\x3d\x7b\x48\x82\xc1\xb0\x2f\x59\xf6\x24\x4f\x7e\xad\x49\x7c\x37\x6e\x31\xfb\xf8\xdb\xdd\x22\x1a\x40\xd1\x80\xe5\xb3\x4b\x96\xd0\x66\x90\xfd\xae\xce\x32\x8d\xbd\x1f\x7f\x3b\xf1\x37\xf6\xfe\x48\x0e\xfb\x70\x79\xd9\x54\x1f\xef\xdd\x21\xb9\xfb\xf2\xb2\x31\xcf\xab\x8f\xf7\xee\x7e\xbd\x7f\xb8\x1d\xf7\x01\xcb\xe1\x81\xd1\xc9\x39\xf3\x0f\x0f\x1f\x55\x1f\xef\xed\x1f\x86\x0f\x0f\x4f\xbf\x20\xe6\xe8\xc1\x3e\x7c\x75\x63\x67\xb4\x3e\xfb\x60\x4f\x38\xec\xc7\x5d\x11\x0e\x94\xbd\x5f\x7f\xf5\xc1\x7f\x3c\xf9\x55\xca\x19\xca\x94\x42\x9f\xc4\x13\x7f\x43\xd8\xfd\x3b\x53\x10\xc2\x7e\x9d\x9d\x98\xe7\x22\x5a\x4c\xef\xf5\x0e\x21\x3f\x1e\xd0\x1b\xd5\xa7\xbf\xfc\xb1\x42\x20\x80\x81\xf8\xf4\xc1\x6f\x77\x71\xfc\x7f\x40\x8a\xfd\xd7\x07\xff\x54\xc0\x35\xbe\x49\x6f\x11\x21\x97\x3d\xe2\xa3\x8c\xfc\xee\x4f\xfc\x49\x98\x84\x18\x99\x91\xcc\x43\xe2\xe7\x87\xd9\x27\x1f\x1f\x30\x7a\xf9\x61\xff\xa7\x92\x7f\x99\xe1\xfc\x1d\x52\x5e\xcf\xe1\x4b\x79\xd0\x64\x01\x64\x14\x17\xa8\x03\xe5\x0f\x87\x8f\xf8\xbf\xff\x9c\x97\xfd\xc3\x87\x4f\x1f\x9e\x5e\xf6\x0f\x1f\x3f\xf9\xf2\xf8\xa1\x8b\x28\x3e\x6c\xa8\xdf\xf9\x66\xa3\xa3\xaf\x38\x9a\x93\xc4\xda\xdb\x7f\xc3\x8e\xfd\x4c\xb3\xff\x86\x7a\xbf\xfd\x37\x3e\x20\xdc\xb6\xd9\x7f\xf3\xe6\xe2\x69\xe8\x76\x24\x62\x7e\xf3\xe6\xe2\x35\x07\x86\xdf\x7f\x13\xe6\x3f\xd7\xfb\x6f\x58\x68\xa2\x8f\xb4\xff\xa6\x8c\xfa\x74\xad\xe8\x82\x7c\xf3\xf2\xc9\x79\xcd\x47\x5f\x3e\x79\x66\xe0\xd1\x78\xee\x0c\x51\x2f\xd9\xef\x5b\x21\xbf\xef\xb3\x33\x79\xff\x0d\xa4\x9c\xfd\x37\x2c\x70\xec\xbf\x11\x59\x64\xff\x0d\xd4\x64\x5c\x48\x24\xce\x7e\x43\xb2\x2a\x5f\xa8\xd7\xda\x3a\x46\x7d\xf0\x5c\xa6\x37\x17\xe7\xdd\x10\x17\xf4\xb5\xb6\x7e\xff\xcd\xfb\x97\x47\xac\xbb\x90\x5a\xff\x7e\x36\x6c\x56\x9b\xb3\x07\x3c\xdb\x28\xd5\xf0\x9f\xec\xc1\x67\x8d\x90\x60\x10\x53\x7e\x77\x2e\x51\x87\x96\x57\xb1\xa1\x80\xe7\x43\xe8\x0e\xe7\x4f\xbf\xde\x98\x51\x49\xf5\xc2\x81\xcb\xfb\x0f\x7e\xfb\xf8\xdb\x47\x51\x89\x88\x47\xb3\x00\x64\x79\xe7\xeb\x3f\x50\xae\x77\x87\xf9\x5b\x35\x4c\xe0\x3e\x7e\xca\xf9\x52\xf6\x54\x83\x86\x79\xc6\x81\x5a\xe0\x9f\x26\x57\x9c\xf1\x25\xb3\x3f\x8d\x73\x8e\x89\x92\x21\x8a\xf3\xb7\x31\xda\xce\x7f\x25\xd0\xef\x74\xeb\x6e\x55\x1a\x99\x08\x5a\x3b\xf8\x1b\x91\x40\x4a\xf1\xab\xd9\x3f\x3e\xa8\xcb\x4b\x9a\x78\x9e\xe8\xaf\x66\xf2\x4c\x15\x7a\x46\x61\x4b\xb1\x68\x46\x59\x94\x27\xe2\x3d\x7d\x6f\x26\x13\x90\x78\xef\x93\xd9\x27\xf7\xf4\xbd\x4f\x66\x67\x9f\x08\x63\xf6\xc9\x8c\x4e\xf8\x04\xb3\x92\xd2\x0d\x96\x60\x7a\xa7\xe3\xbb\x90\xf9\xd4\xaf\xbf\xa2\x7b\xf9\xed\x37\x99\xd1\xfc\x49\x68\x5c\x1d\x0e\x27\x6a\xf1\xac\x4c\xc3\x1e\x7c\x82\x77\x0d\xcd\xcb\xf9\xd9\x83\x19\xa8\x99\x50\x97\x17\xf7\xa8\x4b\x28\x11\x92\xfc\xf1\xe4\x68\x5c\x95\xd9\x61\x13\xfe\xdf\xcf\x5f\xbf\x9a\x9d\xf0\xfb\xc3\x65\xf6\xa8\xed\xfe\xe3\xf4\xf4\x34\x7d\xfa\xc7\xa2\x2b\x73\x3c\x79\xfc\x70\x79\xb9\xff\xd7\x8f\x0a\x27\x48\xd7\x11\xee\xd7\x27\xe6\xcc\xde\x5f\x7f\x78\xf8\xf1\xfe\x9c\x9f\xec\x7f\xfc\xe9\xc3\x9f\xbe\x3a\xfd\xc8\x73\xda\x0f\x7f\x3a\xfd\xf8\xf5\x9f\xfe\x75\x76\x92\x5e\x9d\xcd\x62\x3f\xdf\xcd\x4e\x0c\x56\x0b\xb8\xf3\x19\xc3\x92\x0c\xc3\xd6\x1f\xfe\x30\xbb\x87\x88\x4a\xc7\x3d\xcb\x7f\x17\xfe\xbd\xd9\x1f\x67\xc7\xc7\x68\x20\xbb\x71\x74\xde\x3b\x67\x32\x3f\x99\xfa\xf4\x54\x86\x10\x0e\xe6\x44\xcd\x33\x9f\x5c\x9d\xfd\x5a\xc4\xf4\xfc\x5b\x89\x1a\x15\xfe\x49\xd3\xec\x6e\x0f\x11\xf5\x72\xe8\x48\x91\xf7\x58\xb4\x32\x99\x7e\x77\xfe\x76\x30\xcf\xb9\x43\xb3\xef\xbb\x7f\x2c\x33\xdb\x47\xd0\x11\xb8\x3f\x70\x65\x65\xe7\x0e\xcd\x8a\x4f\x4f\x4f\xff\x28\x4b\x03\xf7\xf9\xf7\x47\x7e\x93\xdc\x06\x6e\x0d\xea\x22\xf9\xab\xdf\xd1\x08\xfa\x47\xb8\x2a\x77\xf6\x21\x2b\xa2\xfe\x59\x67\x54\xbb\xff\x22\xc0\x4b\xbe\x9a\x7d\x3c\xb9\x3a\x63\xd5\x9a\x21\xb2\x49\xd7\xb9\x9d\xe2\x70\x09\xd4\xb7\x56\x66\x6b\xea\x70\xc5\xb8\xcc\x3e\x81\xbb\xa0\x1b\xc6\xda\x2b\x8e\xc1\x36\x25\xaa\xe0\xe4\x28\x89\xfc\x76\x21\x99\xb1\xf9\xf8\x7c\xc9\x6e\xca\x4b\x3c\x34\x99\x80\x63\xee\xdd\xa8\x7a\xa5\xbd\xaa\x11\x97\x90\x3d\xb7\xd4\xc1\x23\xb4\x45\x99\x90\x37\x8d\x51\x70\xc7\x82\xa9\x06\xc9\x98\x24\x83\x8a\xe7\xf3\x35\x42\x58\x99\x7a\xf0\x26\x1e\xd5\x02\x8a\x39\xa6\x51\x0b\x76\x4c\x47\x52\xf4\x02\x9e\x72\x07\x8f\xdb\x58\xbf\x07\x06\xb3\xb3\x85\xeb\xd3\x10\xd9\xa6\x44\xb9\x69\xe1\xa9\x39\xa7\x89\xdb\x61\xc7\x3e\x56\xe3\x4e\xad\x72\xee\x30\x3f\xf7\x75\x54\xf0\x19\xcb\x52\x77\x99\x37\xe0\xe5\xee\x1f\x3d\x54\x34\xb4\xdb\xa5\x07\xca\x5a\xae\x8c\xd1\x84\x86\x51\x38\xa7\x64\x85\xd7\x47\xec\x08\xf2\xff\x65\xed\xfd\xb7\xdb\xc8\x8d\x06\xd1\xff\xef\x53\x54\x18\xf9\x58\x1e\xcb\x96\x48\xd9\x1e\x47\xc9\xc4\x9f\xc7\xd6\x4c\x94\xd8\x92\x57\x92\x93\x7c\x31\x35\xfc\xba\x1b\x68\x12\x16\x1a\xdd\x04\xba\x41\x52\x43\xef\x2b\xec\x7d\x94\xfb\x28\xbb\x6f\x74\x4f\x55\x01\xdd\xa0\x7f\x4c\x76\xef\xb9\xe7\x90\x40\x01\x8d\x06\xd0\xf8\x51\xa8\x2a\x00\x55\xa0\xf9\x18\x27\x06\x99\x93\x8e\xa0\xce\x8a\x18\xcd\x67\x51\x11\x66\x4d\x14\x01\xe4\xa4\x48\xc6\x23\x50\x65\xed\x82\x84\x0a\x08\xd4\x4d\xaf\x60\xcf\xcd\xb1\x85\x7b\x0b\x7a\x2c\x0a\x68\xb2\xe2\x36\x9b\x93\x72\x22\x1c\x0d\x8d\x12\xd0\xdc\xce\x4f\x4e\xc2\x1d\xb8\xe6\x76\x3e\xab\x6e\xcf\xd8\xee\xbe\xce\x5a\xd2\x31\x1e\x81\x93\x13\xbe\xf2\x44\xfa\x08\x9b\xae\x75\xa4\x6e\x26\x98\x47\x2f\xa9\x8b\xd9\x30\x79\xb0\x49\x4e\xf6\xcf\xe7\xae\xcb\xb1\x96\xbd\x56\x15\xd6\xfd\x40\xe6\xaa\xc9\x0e\xb5\x94\xb7\x2c\x1a\xc0\xfe\x6c\x07\xe3\xc3\xcc\x9e\x44\x33\xb0\xae\xed\xa5\x03\x85\x9e\x49\x23\x2e\x4a\x64\x44\x29\x84\x9c\xe6\x1b\x95\xb3\xad\xd4\x42\xcf\x88\xa9\xb9\x28\xcf\xe5\xba\xed\xd3\x84\xb8\x77\x56\x7a\x55\x77\xae\x8f\xc7\x35\xf6\x47\x1c\xab\x2f\x69\xc2\xec\x44\xfd\x48\x1b\x49\x18\xc7\x97\x62\x0b\xed\x33\xeb\xa0\xc5\x36\x60\x7b\xaa\x55\xb0\x48\x9a\x18\xe1\xc4\xae\xed\x0c\x7e\x50\x6f\x3a\x93\x8d\x8b\x76\x4d\xb8\xc8\x19\x2c\x46\xb2\x49\x47\x3a\x0d\x17\x48\x91\xfd\xd1\x1f\x71\x5d\x6c\x6f\xbe\xfb\xfd\xe8\x60\xb4\xc7\xe7\x12\xf7\x79\xb1\x4c\x22\x89\x94\xc1\x3e\x60\x8c\x3e\x9d\xfe\x7a\x93\x10\x8f\x9f\xc9\x7c\xe9\x5d\x3a\xf0\x64\x6f\x1e\xee\x9f\x9c\x3c\x78\xd1\x2f\xb7\xfb\x69\x90\x38\x80\x07\xdf\x71\x96\xc9\x3b\xa3\x03\xf9\x8f\x7e\x7d\x20\x64\xc8\x25\xf5\xd7\x00\xf6\xf6\xa7\xd3\x5f\x1f\xbc\xf8\xf7\x59\x4f\xa7\xfb\xfb\x9f\xc7\x3c\xe0\xf2\x7e\x19\xa2\xa7\xd3\x4f\xd3\xe9\xde\xcd\x28\x0a\xea\xff\xb7\xb3\xa7\x9c\xf6\xa7\xd3\x07\x0f\x5e\x7c\x35\xbf\xcf\x0d\x15\x46\xe3\x84\xf8\x25\xff\x1f\x0e\x7e\xde\x7c\x86\xe9\x29\x97\x1f\x59\x46\x7d\xfe\xf6\xb7\x50\xb8\x5c\x7f\x93\x8c\x0c\x1b\x0d\x87\xd3\x29\x09\xb7\xfb\xee\xe4\xf3\x5e\x81\x04\x09\x9f\xfd\x3f\xff\x9f\x47\xff\xeb\x7f\xfc\xcf\xff\xfb\xd1\xff\xfa\x1f\x37\x0f\x3f\x7c\x77\xf3\x22\x10\x21\xf1\xdb\x87\xc7\x48\x22\x22\x5d\xd2\x0b\xdb\xff\x11\x56\xfa\x2f\x6c\x42\x86\x02\xa6\x1f\x98\xa0\xbc\x89\x74\xcd\xaf\x1c\xfe\x74\x18\x16\xc3\xc3\xa9\xfb\xee\x87\xa9\x43\x22\xf2\x8b\xbc\x86\x95\xef\xf0\xd1\x8b\xa9\xf8\x6e\xfa\xf8\xc5\x54\x3c\xdc\x6f\xda\x6d\x53\x6c\xab\x6a\x5b\x54\x5b\x65\xb6\x42\x6c\x8b\x62\x2b\xd7\x5b\x59\x3d\x78\x71\xc8\x2b\xde\xcd\x2e\xd1\xc9\x4c\x21\x99\xcd\xc9\xa8\xb7\xda\x9b\x94\x10\x9b\xee\x4d\xf7\xb8\x5a\x08\x84\x6a\xc5\x98\xc3\xfe\x2c\xf4\x3d\x9a\x33\x07\xbf\xe2\xd2\xfc\xe0\xdb\x7d\xb2\xb0\xdf\x10\x4a\x8f\xf2\xba\xd6\x90\x6f\x5a\x09\x6a\xfc\x0c\xd4\xf1\x04\xd4\xb3\x27\xc1\x46\x62\x44\x4f\xbc\xe4\x8d\x62\xf5\x6f\x4f\x06\x51\xf6\x80\x7d\xd9\x7e\x0f\x92\x55\x42\x96\x51\x00\x4a\x22\x64\x27\xad\x57\x85\x04\xb9\x2e\x64\xc3\xa6\x69\x6b\x25\xa2\xb6\x3c\xc4\x24\x41\xf1\x6b\x33\xc8\x5a\xa3\x20\x37\x11\x5f\xb6\xdf\xb0\xb5\x97\x6c\xe6\x9d\x7f\x61\x35\xf3\xeb\x4c\xeb\xd7\xab\x45\x7c\x13\x0e\x06\x64\x7a\xcd\xe1\xd7\x38\xd5\xdd\x31\xc6\x38\x83\xe7\x4a\x10\x44\xe0\xda\x45\xb7\x18\xaa\xac\x79\x30\x9d\xba\xef\xfe\x44\xf3\xf6\xcf\xa3\x5d\xb6\xfb\xb7\xe6\x4f\xf3\xcd\xe9\x93\x50\x5d\x1f\xc6\x8f\xfe\x40\x22\x81\x5e\xc6\x62\x3f\x67\x74\x46\x27\x1f\x7e\x99\x4e\x6f\x6e\x1e\x0e\x3b\x33\xc3\x2d\x27\xa4\xf8\x5e\x5e\x6e\xdf\x6d\xdf\xbd\xfc\xcf\x37\x17\x2f\x5f\x6f\xdf\x5d\x6e\x2f\xb7\x57\x97\xdb\xcb\xab\xcb\xed\x9b\x1f\xdf\x6c\xff\x7e\xb9\x7d\xff\xf2\xcd\xdb\xed\xdb\xd3\xab\xab\x97\x3f\x9f\x6e\xdf\x5f\x5f\x5c\xbc\xd9\xbe\xff\xe9\xf2\xe5\xdb\xd3\xed\xf5\xd9\xdb\xd3\xcb\x2d\x00\x00\x41\xb3\x8b\xbf\x9f\x5e\xfe\xf4\xe6\xe2\x1f\xdb\xbf\x5e\x9c\x9d\x5f\xcf\xde\xbe\xfc\xe7\xec\xea\xdd\xe9\xe9\xeb\xed\xe5\xe9\xd5\xfb\xb7\xa7\xb3\x77\x97\x17\x3f\x6f\x5f\x9f\xbd\xfc\x79\x76\x79\xfa\xea\xc1\x74\xfa\x21\xee\x3f\x8d\x12\x56\xb1\x3d\xb0\x37\x3d\xbf\xf6\x59\x4d\xcf\xb6\x2f\x2f\xb6\xaf\xcf\xb6\xaf\x2f\xb6\x3f\x6d\x2f\xcf\xb6\x97\x17\xdb\xf7\x67\xdb\xf7\x17\xdb\x9f\xcf\xb6\x3f\x5f\x6c\xaf\xce\xb6\x57\x17\xdf\xce\x97\x87\x87\x1d\x26\x60\x3a\x82\x5f\xfe\x78\x71\x79\x0d\x2f\x5f\xbd\x82\x97\xaf\xff\xfa\xfe\xea\x1a\x5e\x9e\xbf\x86\x97\xef\x66\x6f\x5e\xc3\x8f\x97\xa7\x2f\xff\x06\xaf\x5e\xbe\x79\x03\xaf\xce\xaf\xe1\xd5\xc5\x1b\x78\x75\x71\xfe\xfa\xec\xfa\xec\xe2\x1c\xa1\x9f\xce\x7e\x86\xd7\x2f\xe1\xf5\x8f\xf0\xfa\xec\xef\xf0\xfa\xf4\xfa\xf4\xd5\x35\x9c\xbe\xb9\x3a\x85\xd3\xf3\xd7\xf8\xff\xe9\xe2\x12\x4e\x2f\x2f\x67\xe7\xef\xdf\xa2\x7f\x71\x49\x2d\x01\x3f\x9d\x9d\x9f\x02\x3e\xfb\xf9\x1d\xfc\xfc\xfe\xe5\xe5\x6b\x38\x3b\x7f\x05\x67\x3f\xc1\x5f\xdf\xbe\x83\x37\x67\xe7\xa7\x2f\x2f\x87\x36\x84\x37\x17\xaf\xfe\x06\x6f\x2f\x5e\xc3\xdb\x8b\xf3\xb3\xeb\x8b\x4b\xb8\xf8\xe9\xa7\xab\xd3\x6b\xb8\x60\x93\xe1\x18\xf1\xf7\xd3\xcb\xcb\xb3\xd7\xa7\xf0\xee\xe5\xfb\xab\x53\x78\x77\x79\xfa\x33\xbc\xbb\xfe\x0b\x5c\x5e\xe3\x57\x5c\xbe\x3f\x87\xab\xd3\x37\x58\xb7\xab\xbf\x9d\xbd\x83\xab\x5b\xd5\xc0\xf5\x4b\xb8\xfe\x11\xae\x2f\x00\xbb\x75\x16\x72\xbc\xae\x6b\x3d\x0b\xd9\xbe\xff\x09\xde\x5f\x03\xf7\x37\xd5\x9f\x06\x00\x43\xe7\x54\xa5\x7f\xbc\x3c\xbb\x86\x7f\xc2\x7f\xc2\xbf\xe0\x1f\xf0\x0e\x2e\xe1\xea\xfa\xf2\xcd\xe9\x39\x5c\xbd\xff\xf1\xea\xfa\x12\x3f\xf2\x35\xfa\x7f\x0f\x99\xd3\x97\xbf\xbc\xbe\xbe\x84\xb7\xe7\xf0\xee\xe2\x2a\xd9\xb1\xb8\x38\xc7\x6f\x82\x2a\x5b\xcf\x5c\x23\xa5\x80\x37\xef\x2e\xae\xe0\xaf\xe8\x9c\x9e\xbf\xfc\xf1\xcd\x29\xbc\x3e\xbb\x22\xff\xea\xfa\xe5\xe5\x35\x5c\x5d\x5f\xbc\x83\xcb\xd3\xab\xd3\x6b\x9e\xfe\xd9\x41\xe0\x2c\x13\x99\xc2\xe8\x70\x9f\x46\x1d\x96\xb8\x7d\x7b\xbe\x7d\x77\x71\xb5\x3d\x3d\x7f\x1d\x76\xa3\xbf\x48\xbd\x8f\xbd\xbc\xbd\x7c\x8f\x09\x71\x08\xbf\xb9\xf8\xf9\xec\x15\x4e\x84\x6f\xbd\x80\x93\xfd\xe5\xab\x57\xdb\x57\xe7\xd7\x5b\x6c\xd1\x2d\xb7\xdb\xf6\xdd\xd5\xbb\xd7\x5b\x6a\xf8\x2d\x0d\xa2\x6d\xd2\xa8\x0f\x46\xdf\xb0\xa9\xe9\x64\xb1\xad\xc8\xa9\x0e\xd1\x2b\xaa\xc3\x4a\x99\xad\x32\xc5\x82\x00\x21\xe7\x87\xfc\x18\x17\x97\xa2\x7a\x90\x18\xd9\x64\xc9\xea\xe1\xe8\x60\xf4\xe1\x8f\x7b\x37\x91\xa6\xfa\xdd\x67\xe1\x47\x8f\xe4\xfc\xa4\x27\xba\x58\x90\xf9\xad\x8b\x1d\xcc\x96\x7e\xf5\x06\x46\x10\xd3\xb1\x38\x75\xf4\x1b\x48\x6c\xa5\xe6\xdf\x44\x63\x83\x90\x72\x34\x9d\xee\x8f\xa2\x90\x17\x51\xd8\xb0\xc5\x0e\x39\x33\x53\xb8\xaa\x64\xa6\x05\x56\x81\xcc\x56\xa6\xbb\xaa\xe9\x4d\x37\xd0\xee\x85\x32\x64\x3b\xd7\xb4\x60\x33\x23\xea\x2a\xe8\x63\x0c\xa4\x76\x14\x92\xcc\x4a\x5b\x57\xb3\xf8\xc9\xd9\x0f\x48\x67\xda\xde\x8e\xb2\xfd\x14\xd7\xf9\x96\xd1\x10\x2e\xb8\xdb\xf0\xc1\xb3\x9b\x87\x27\x2f\x0e\x89\xef\xcc\x1d\xe4\xcc\xf5\x65\x8d\x6a\xd9\x18\x77\xb0\x99\x3f\xeb\x79\x3b\xae\x27\x96\x59\xd5\x02\x19\xc9\xc1\xe2\x54\x91\x35\xc8\xbe\x59\xb6\x73\x54\x05\x5b\x08\xf0\x91\x74\x80\xb2\xba\xe0\x5b\xb9\x71\xc8\x73\xb5\xc0\x2c\x15\xb0\xea\x30\xd6\x1b\x6e\xf4\x24\xb7\xc1\x7a\xf6\x2c\xe4\x60\xb3\x55\xa2\xbf\x31\xd9\x2a\x01\x3a\x48\xbd\xbb\x21\x62\x55\xd3\x66\x73\x07\x44\x43\xb3\x8e\x9e\x60\xe7\xde\xea\x50\x03\x42\x9f\x19\x89\x99\xe8\x44\x7d\xa8\x35\x77\x89\xa8\x41\x56\xb9\x14\x2c\xeb\x15\xae\xdf\x0f\xeb\xf9\xcf\x74\xcb\x37\xf6\x52\x61\x6b\x70\x99\x11\x79\xbd\x66\x6e\x08\x89\x06\x2d\x1d\xeb\x82\xf1\x61\xbb\x2b\xd2\x18\x60\x7e\x30\x0f\x47\x30\x7a\x68\x1e\x53\xb5\xf7\x47\x30\x7a\xf0\xb8\xca\x9a\xfd\x2f\x85\x03\x23\x69\xc4\xe8\xa1\xfc\x14\xcf\x5f\x00\xb2\x10\x83\xc0\xa0\xb0\x59\xd9\x16\x95\xeb\xef\x4f\xb9\x37\x27\xa3\x75\xa5\x7b\xa6\xe4\x70\xfa\xeb\xef\x0f\x0f\x0e\x7f\xff\xe9\x30\xdc\x76\xea\x85\x6a\x3d\xb9\xfa\xeb\x3d\x22\xbc\xee\x7d\x3a\xfc\x8c\x64\xc5\x87\xab\x87\x38\x30\xcc\x67\xf4\x27\x89\xbb\xb2\x9b\x78\x86\xe3\xe0\x5b\xe2\x3a\xca\x3e\x50\xa0\x9f\x3e\xa5\xa2\x53\xf5\xdb\x62\xdb\x4d\x23\x7f\x43\x68\xdb\x2f\x6f\xca\x60\x77\x60\xaf\xf0\x7e\x31\xed\xd3\xeb\x0d\x6d\x26\x1b\xb9\x82\xf8\x2e\x76\x6b\x68\x7b\xa2\xce\xe8\x04\x40\x2a\xe4\x50\x86\xf7\x50\xeb\x92\x4d\xe6\xf3\x26\x36\x9d\x40\x88\x83\x9b\x76\xb1\x17\xa4\xbe\x8b\x38\xd9\x5e\xf8\x11\x34\x03\x09\xa9\x65\x2b\x69\x53\x7f\xa3\xa4\x16\xd1\xe6\x23\xed\x1c\x84\x4d\xf7\x74\x57\x9e\x6e\xe5\xd3\x51\x02\x1a\x31\xbc\x85\xce\x1b\xec\x64\x3e\x9a\xb7\x81\xc3\x31\x05\x1e\x6f\x3c\xec\x84\x2c\x74\x66\xb9\xdc\x44\x40\x90\x91\xb1\xd3\xa2\x85\xcc\x01\xe2\x82\x7e\x04\x67\x6e\x63\x0a\xc8\x90\x63\xfd\xb6\x85\x7c\xda\x0a\x2c\x95\x91\x02\xce\xb3\x73\x38\x33\xa5\x32\x74\xa6\x6e\xd8\x60\x27\x41\x8e\x72\x3f\xe1\x03\x09\xca\x61\x3a\xd2\x92\xfc\x13\x19\xc3\x27\xf0\x8c\x36\xa7\x71\x96\xbd\xbf\x3c\x1b\xa0\x57\x75\xc5\x96\xc3\xc3\x5e\x34\x3e\xec\xa1\xe4\x21\xcf\xc4\xce\x04\x20\x6c\xfa\xfd\x14\x7b\xf1\xc7\x60\x9d\x9f\x77\xaa\x4f\x7d\xdc\xb3\x3e\x0b\xc7\x2b\x38\x74\x89\xe8\x31\x80\x71\xd3\x98\x83\x57\x6d\xdd\x9c\xc5\x9b\x39\x70\x45\x6a\xd4\xf8\xc9\xf5\xa6\x09\x69\xde\x5f\x9e\x31\x70\xce\x26\xfc\xdf\x66\xed\x02\x5e\x63\xa7\x85\xdd\xd3\x4b\x39\x3f\x5d\x37\xf0\x92\x24\x30\xf4\xe9\xc7\x93\x24\xf0\xec\x09\x07\xce\x4c\x3b\x7e\xd6\x83\x31\xc9\x99\x69\x9f\x33\xf4\x5e\x0d\x09\x10\x8e\x29\x10\x4e\x92\x3c\x7f\xa5\xb3\xaa\x91\x82\x63\xc8\xfd\xb1\x2b\x4b\x69\xb1\x4e\xd9\xdf\x95\x5c\xc1\x5f\xaf\x2e\xb0\xc3\x5a\x0d\x99\x9d\x77\x3c\x6c\x02\xab\x01\x55\x2d\x3a\xcd\xec\x4b\x4d\xe7\x29\x48\xdf\x8e\xa8\x0b\x4a\x07\x99\xd9\x04\x64\x0b\x79\x68\xdb\xc0\x15\xd1\x34\x79\x67\xeb\x4a\x21\x1b\xf2\xe5\x05\xce\xd6\x91\xa4\xb2\xed\x51\x46\x94\x1e\x1f\xfe\x32\x75\xdf\x7d\xb8\x3f\xba\x21\x45\x74\xad\x55\x45\x8b\xa1\xc3\x4f\x3b\x07\xc3\x3e\x5f\x9f\xff\x8b\x16\xca\xff\xea\x19\xfb\x83\xdd\x0d\x53\x5c\x9d\x79\x97\x0d\xc1\x4f\xf1\xb6\xec\xe7\xbc\x50\xc2\xce\x47\x96\xe5\xe8\x43\xfe\xe3\xcd\x87\xa3\xf1\xcd\xc3\x07\xa3\x81\x91\x39\xfa\x50\x5f\x20\x83\xf1\x7d\x1f\x8d\x64\xc1\xe5\xa7\x9b\x20\x05\xce\xe9\x60\xa5\x7c\x7c\x79\x75\xf9\x70\xb4\xc5\x37\x10\x15\xc4\x6b\xd3\x84\x1c\x90\x74\x22\x1e\x08\x99\x9f\x51\x40\x2e\x03\xda\x18\x25\x1b\x54\xfd\xed\xd5\xcb\xaf\x1d\x0d\xdc\xa7\x43\x80\x2f\xa6\xd3\x07\x5b\xbe\x02\x4a\xe7\xeb\xdc\x77\x3f\xfc\x39\x9e\x05\xa4\x8f\xe6\x88\xd8\xda\x91\xca\xe0\x2f\xe5\x8b\xa3\xbc\x4d\x35\x75\xdf\x4d\x1f\x44\x96\x3a\xd9\x25\x4d\xf6\x09\x76\xf7\x48\x3f\xbb\x5e\x7c\x13\x36\xb3\xa2\x30\x7c\xa7\xae\x43\x40\x9e\x1c\x7e\x98\xfe\xfa\xc7\x9b\xc3\x6f\xe4\xf9\x7f\xb6\xd7\xfb\x1b\xbb\xbb\x5f\xd6\x7b\xb7\xc2\x61\x3f\x77\xba\x1f\x77\x74\xef\x1d\xc6\x4e\xfc\xdb\xc9\x88\xed\xe9\x76\x45\x5b\xdb\x9e\x01\xee\x85\x6d\x71\x17\xef\xff\xaf\xd2\xb9\xd1\x79\xca\x4d\x1f\xe3\xba\xf9\xeb\x80\x3d\x39\xba\xb7\x9e\x8f\x95\x0b\x51\x3b\xf5\x8a\x7b\x9d\x88\xd1\xf8\xaa\xee\x4e\xad\x6f\x93\x47\x11\xc1\x8f\xa2\xfc\xe4\xc3\xfe\xe3\x20\xf5\x19\x4d\xa7\x8f\x79\x30\x25\x3d\xd9\xef\xef\xfc\x47\xe8\x8b\xdf\x24\x72\x7d\xa6\xbf\x6e\x8e\x3e\x65\x2f\xc9\x50\x75\xc7\xae\x51\xe4\x2b\xd3\x42\x87\x0e\x19\x9a\xe9\xc8\x75\x0b\x5c\xb7\x3a\xf6\x10\xa9\x01\x21\x3f\x20\xb4\x87\xee\xb3\x27\xf4\xce\x73\x72\xc7\xcf\xc8\x3b\x9e\x90\xf7\xec\x09\xd0\xf5\x9d\x28\xb5\x21\x91\xce\x8e\xa4\x23\x41\x58\x74\x80\x2c\x9e\x28\x63\x97\x57\x3f\xda\xce\xd6\x71\x7d\xed\x97\xca\xa1\x2d\xfb\x23\x75\x5e\xd9\xb6\xcb\x34\x2d\xe7\x73\xb2\x45\x56\x06\xe2\x42\xd4\x3b\xfb\x0f\x44\x43\x44\x5a\x20\xeb\xe9\x89\x48\x2e\x44\x8c\x60\x37\x81\xc4\xf8\x26\x09\xd0\x1f\x0e\xec\x1c\x1d\x04\x94\x2b\xa6\x33\x22\x69\xc0\x74\x84\x6b\x05\x29\x61\x6d\x05\xa9\x13\x16\xd2\x92\x38\x3a\x5d\xa0\x5f\xff\xd8\x39\xf8\xf9\x8d\xca\xe1\xd5\x2b\xa4\xb4\x7f\x96\xfd\x12\xfa\x73\x7b\x0b\xef\x6a\xa7\xd6\x09\x05\xc0\x8b\x3f\xd1\x01\xb4\xb5\xfb\xa9\xc7\x2e\x89\x4c\x89\xc9\x97\xa1\x9d\x7a\x7a\x63\xf7\x7c\x87\x3a\x19\x7d\xf8\xe5\xe0\x84\xc4\xce\x6e\x3a\x7d\x7c\x13\xf0\xdf\xfb\xeb\xb7\x5f\x47\xd5\x03\xee\xbf\x3f\x1a\x8d\xee\x1f\xc8\xe0\xdb\x93\xa7\x9f\x3e\x3f\x42\x7c\xfe\xf6\xf3\xad\x55\xbe\x89\x4f\x7b\xab\x93\xdf\x18\xc2\xb9\x91\xed\xbf\xd9\x71\xf3\x79\x4f\x3a\xa7\x03\x3b\x13\x62\x91\x19\xa1\xa5\x85\x70\xd7\x9c\x4f\x13\x66\x0e\x32\x23\xf0\x9f\x69\x57\x43\x36\x9f\x5b\x1e\x25\x99\x71\x8a\x0f\xb4\x3a\x59\xe5\x9a\x37\xd7\xfa\xed\x34\xfc\x59\x59\x42\xbe\x41\x1a\xaa\xc8\xb4\xfe\xda\xe9\xd6\x68\x59\x3c\x98\x0a\x0f\x67\x5d\x3b\xd7\xd6\x55\x4f\xf4\x0d\xc7\x5d\xc3\xf0\x14\xaa\xda\xb1\xda\xcc\x63\x73\xd9\x65\xda\x85\x83\xae\xda\x49\x55\x82\x34\x22\x10\x93\xc1\x50\x32\xef\xcd\x11\xd9\x15\xf6\xe5\xb4\x2a\xd8\x7c\x2d\xd1\xd0\x34\xd6\xad\xc2\xf7\x88\x9c\xec\x69\x69\x3a\xfd\x1a\x8c\x7b\xd6\x6d\x0d\x74\x3b\x1d\xb8\xb9\x5c\x60\x8f\x22\xf9\xca\x24\xab\xe3\xd3\xb1\xb4\x22\xa4\x83\x49\x99\xb6\x06\xe5\x40\x39\x1e\x8b\xca\x99\xba\x05\xe5\x68\x4c\x12\xe3\x78\x2b\x37\x44\x51\x6b\x95\x83\x56\xb7\x12\x74\x4d\x7b\x64\x50\x29\x81\xc4\x4d\x24\x70\xaa\x0e\x5b\x80\x0a\x20\xb8\x9f\xce\xd5\x86\x54\x85\x57\x1b\x6e\xe4\x81\x5c\x36\xa4\xc5\x2b\x4e\x38\x23\xd7\x2d\x60\xe1\xa6\x8e\xf9\xd0\x3e\x8f\xa9\x63\x56\x14\xac\x4b\xa8\xcb\x92\x8c\xa3\xec\x1e\xca\x4d\xce\xe6\xda\xde\x66\x09\x35\x3f\x1d\x2b\xac\x33\xe1\x60\x27\xa3\x50\x3f\x3a\x6d\x98\x55\x61\x3b\x2f\xb3\xad\xca\x76\x2d\x59\x44\x5c\x41\x77\x65\x12\xa4\x11\x4f\xf1\x66\xca\x85\xad\x52\x2b\x33\x41\xd6\x9d\xad\xc4\x31\x61\x25\xfd\x6b\x2f\xe3\x40\xb6\xd2\x75\xc9\x1e\x1e\xdd\x8f\x60\xde\x83\xae\x0d\x39\xf4\xad\x14\xe0\x6e\x55\x33\x1c\xf5\x95\x08\xd7\x4d\x40\xb9\x9d\x8d\x44\x1d\x90\xd6\xea\x8d\xe1\x6d\xe1\x36\xbb\x95\xd0\x62\x23\xb6\x0b\x19\xe9\xa0\xb6\x26\xf4\x17\x6e\xf0\x40\x67\x5a\x15\x91\xdc\x0a\x53\xed\x9e\xf4\x15\xd2\xd0\x13\x64\xbf\xd0\xe1\x0b\x5c\xb0\xb2\xaa\x95\xf4\x5d\xeb\x7a\x07\xdb\x45\x82\x95\xc4\xfb\x05\x2d\x0b\x05\xc3\xb4\x0e\x15\x24\xa5\x28\x84\x2c\xa0\x10\xb9\x06\x8e\x24\xc3\xfa\xda\xcc\xa1\xa8\xf3\x8f\x50\x38\x7e\x81\x17\xa6\xc2\x61\x3c\x19\xe8\x27\xd6\x8a\xc5\x1c\xb2\x50\x15\x2e\x07\x74\xb2\x9b\x0e\x3d\x86\x85\x68\x2e\xdb\x36\x9c\x85\x5d\x57\x7a\x18\x58\x8a\xf6\x9c\x5b\x39\x97\x96\xd7\x40\xbe\xe5\x07\x5c\x16\x17\x85\x6d\x30\xec\x40\xb4\x76\x43\x19\x47\x15\xb3\xf1\x6d\x5e\x3c\x79\xd9\xfc\x06\xe7\x56\xb7\x0b\x44\xa3\x9f\x10\x01\x1f\x1e\x6e\x7f\xdd\x7e\xda\x4a\x23\x54\xb9\x9d\xd7\xae\xcb\xb7\xe1\x32\xe9\x76\x45\x0a\x20\x3e\x13\xf9\xc7\x03\x7f\xa4\x36\x8c\xb4\x85\x05\x71\xda\xfd\xb8\xed\xb2\x73\x13\x45\xd4\x45\xbc\x4a\x73\xff\xfe\xfd\xed\x9f\x7e\xf7\xe8\xd1\xf6\xd1\xa3\x3f\x87\x6c\xdf\xfd\xe3\x6d\x94\x03\x24\x09\xff\x74\xf8\x22\xee\x10\x0c\xa9\x42\x39\x5f\x60\xf5\x1e\xa9\xdf\x9e\xfc\x4a\xb1\x8f\xa2\x48\x72\xb8\xf9\x94\x20\x33\xc4\xf7\xb5\x21\x22\x08\x57\xcf\x70\x7f\xfe\x37\xae\x94\xf8\xfc\x6b\x22\x85\xaf\xac\x08\xee\xab\x4b\x02\x63\xed\x78\x11\x01\xc7\x01\x62\xde\x9a\x31\x52\x40\xaa\x6b\x59\x74\x6d\xef\x07\x24\x49\xe8\x15\xb1\x29\xa1\x66\xc2\x34\x3d\x2e\x55\x25\x4f\x18\x46\x15\x26\xa0\xe5\x80\x53\x7a\x94\x8c\x18\xea\x0b\x5c\x80\x68\x11\x51\x71\x8f\x09\x8c\xa8\x2b\x75\x27\x93\xf9\x1f\xe6\x38\x2d\x35\x7c\x7e\x1f\x27\x72\x97\xc7\x39\x27\x49\x11\x6b\xbb\xa0\xf6\x6c\xeb\xd8\xba\xca\x21\x16\x5b\xd7\x96\xd6\x3a\xc4\x8a\xf4\xd9\xb3\x70\x21\x98\x64\x82\x14\xd1\x4a\x5b\x29\xc3\xd3\x84\x97\xa5\x1e\x75\x29\x83\x58\x9a\xd7\x3b\x5e\xfb\x08\x95\x04\x1c\x84\xeb\x46\x3a\x97\x35\x55\x91\xd4\x41\x21\x51\x43\xf3\x8a\xd4\x74\x91\x82\x65\x36\x1a\xe8\x64\x4b\xd6\x36\x69\xc6\x85\x39\x65\xe7\x39\x86\x38\x7b\x9a\x4b\x2b\x29\x6f\x45\xb6\xe1\xd3\x0a\x46\xd0\x24\xce\x84\xe0\xbc\x29\xd6\x20\x03\x9c\x6d\xa0\x52\x86\x6c\xe6\x3a\x46\xbe\x8c\x41\x58\xae\xc8\x82\x47\xb6\xd8\x5c\x6c\x82\x00\xd4\xa9\xda\x38\x46\x12\xad\xaa\xa4\xe7\x83\xd5\x74\x1a\x1c\x36\x32\xb3\xd0\x8b\x61\x18\xc3\x60\x22\x27\x49\xdf\x76\xa9\xd6\x0e\xb4\x34\x90\xb9\x02\x94\xa3\xcb\xef\x74\xde\xc3\x51\xfd\x42\xaa\xac\x35\x41\xc9\xb9\x72\xe1\xfb\x82\xf0\x31\x7c\x14\x25\xe6\x72\x8b\xa2\xc3\x64\x84\xa2\xa8\xa1\xe8\x19\xd9\xe1\xe2\xca\x93\x72\x62\x45\xe8\x9e\x25\xa7\xca\x91\x98\x27\x5c\xeb\x98\x9b\x70\xa0\xc8\x85\x23\xf0\x64\xc3\x94\x10\xe5\x42\xae\xa1\x58\x58\xd0\xc1\x56\xa3\x9b\xe7\xf5\x1a\x3a\xea\xa1\x79\xdf\x07\x45\xed\x22\x8a\x25\xbc\x46\x6a\xd6\x78\xcd\x5e\xd4\x9d\x85\xba\x60\x7c\xc6\x7a\xdf\xf1\x99\x6b\x2d\x59\xcf\x21\x14\x4c\xe7\x55\xc2\x47\xe2\xea\xd8\x28\x5e\x5b\xda\xcc\x84\x0f\x08\x62\x09\x5c\xe7\x79\xda\x4a\x33\x57\x46\xe2\x98\x11\xf1\xf0\x57\xfa\x20\x4a\x80\x93\xa8\x4a\x99\xda\xc6\xb4\x84\xfa\x9d\x32\xd4\x50\xb8\xd2\x92\xad\xd3\x0a\xdc\xd2\xee\xbe\x94\x7d\x1c\x5e\xa2\x16\x14\xd2\x2a\x2f\x05\x1f\x64\x62\x0b\xf6\x54\xc3\x46\xda\x82\xc9\x27\xb6\xa1\xdc\x62\x3b\xd1\xc5\x9e\xcc\x15\x2b\x6c\xc3\x55\x3c\x51\x43\x73\x82\x56\xe0\xa6\x36\x4e\x92\x78\x46\x22\x95\x87\x3d\x27\xad\xfd\x0d\xb9\x5c\x40\xf1\x40\x83\x26\x22\xfa\xff\x6d\x5c\x7e\x78\xff\xf0\xe0\x70\xef\x30\x6c\xa1\xc7\xe3\x0d\xff\x06\x41\x3e\x62\x3d\x43\x5f\xc1\x92\xa9\x74\x99\x4f\x27\x12\xce\x8e\x47\x12\x7b\x0c\xfb\x1b\x48\x58\x5a\xa5\xeb\xaf\x6f\x9f\x0c\xd4\x77\x51\xc8\xa6\x9d\xd5\x26\x92\xdc\x7a\x95\x6d\xa2\x37\x2b\xea\x2a\x8f\x70\x59\x46\x48\x13\x29\x4d\xc4\x79\x7f\xb5\x47\xe1\x30\x77\x84\x71\x90\x1c\xaf\x88\xa4\xe1\x0b\xe6\x90\xcb\xb9\x32\x48\xa0\x0b\x74\x1c\x39\x75\x09\xb9\x6a\x03\x23\x97\x77\xe5\xf6\x08\x5d\x55\x06\x6f\x1c\x68\x0d\xa6\xdc\x1d\xce\x93\xcc\xc9\x3b\x28\xa4\x26\xfa\x02\xc9\x2c\x20\x0b\xa4\xd2\xc6\x95\x02\xa9\x23\xec\xbe\xa2\xaa\x69\xdd\x28\xd5\x7c\xa0\xf0\x91\x0b\xa5\xe9\x50\x1b\xa2\x9d\x92\x0b\x6e\x38\x5c\xc8\x65\xea\x9a\x40\xba\xda\x0e\x6c\xda\x4d\xc8\xf0\x7d\xc9\xfd\x37\x22\x25\x41\x48\x8e\x57\x8e\x28\x4d\xe4\x0d\x88\x2f\x10\xf3\xfe\xe6\x1b\x72\x02\x82\x3e\x03\xfd\x50\x61\x04\xf9\x94\x3b\x02\xa1\xda\x08\x73\xa5\xa5\x11\xfd\xaa\x25\x8d\x98\xf3\x4d\x2a\xca\x81\xab\x88\x74\xc7\x20\x9a\x30\x22\x50\xe6\xd2\x88\x78\x6a\x0d\x41\xab\x2a\xd5\x2a\x1f\x02\x6c\x1c\x8d\x41\x5e\xd5\xa4\x11\xae\x91\x85\x2a\x19\xc4\x79\x62\x38\x3f\x26\xc8\x09\x70\xb7\x81\x95\x61\xf6\x05\xdd\x8e\x78\x16\xb9\x26\x03\xe5\x41\x5e\x1e\x05\xe0\x4c\x22\xc4\x4b\x7c\xca\xba\x76\x46\xca\x24\x23\x3b\x5f\xc8\xf4\x50\xa1\xc4\xa6\x2f\x6b\x7b\x4b\x0e\x61\xb4\x84\xf5\xe1\xaf\xde\x3e\x45\x10\x87\x6d\x7f\xe8\x70\xbe\xb8\x3b\x62\x6f\x4c\x3c\x50\x89\x7f\xba\xd0\xa7\xe6\xa6\xb6\x72\x46\xa3\x4c\x69\x64\xdb\x74\x08\xec\xf0\x49\x5a\x49\x97\xec\x2c\x09\x65\x13\x0b\xee\xb4\xf0\xf6\x97\x09\x83\xa9\x76\x25\x64\xbf\x71\x41\x68\x35\x12\x8e\xd4\x0f\x41\xf3\xef\x2e\xbb\x25\xad\xc3\x38\xde\x94\xab\x95\x99\x65\x66\xc3\x00\x55\x55\x67\x76\x2e\x23\xc3\xc5\xe7\x1e\xc3\xc9\xbc\x68\xe8\xba\xc8\x34\x0f\x33\x5d\xcf\x69\xd5\x40\xa4\xd9\xf2\x16\x58\xe4\xc5\xb0\x6d\x25\x5b\x0c\xee\x2a\xe4\xd0\xe8\x9b\xc2\x53\x43\x34\x85\x9c\xd3\x78\x34\x81\x86\x8e\x2c\x18\xe1\x5b\x83\x53\xc5\xd4\x16\x4c\xed\x16\xf5\xaa\xc0\xcf\xd3\x5a\x8a\x9e\x3f\x2b\x8f\xd8\x1b\x13\xb3\xd5\xb5\xd8\x1c\xc3\xc9\x48\x3a\x57\x4a\x75\x24\x13\x28\x0d\xe6\xd6\xd4\x8e\xca\x1b\x86\x5f\x63\x55\x6d\x15\xb3\x54\xbd\x85\xbe\x2f\x78\x2c\xad\x8f\xc8\x1d\x93\x2b\xea\x95\x21\xa0\x6b\xd0\x73\x92\x94\x21\xcc\x6a\x23\x24\xbe\xb1\x1b\xc7\x63\xb3\xc1\x45\x0d\x49\x33\x72\x98\x4a\xa3\x89\x87\x40\x3f\xba\x2d\x61\x07\x2b\x33\x4d\x4e\x58\xb7\xcb\x60\x39\x0f\x17\x49\xc4\x85\x56\x6a\x49\xaf\xc6\x9b\x9b\x81\x11\x8b\x17\x36\xa9\xdd\x2c\x7d\xaf\x6d\x6d\x66\xd8\xc5\xd6\x0a\xc0\x18\xdc\x2c\xa0\x52\x37\x4b\x66\x8d\x9b\xf5\x6d\xef\x66\xcc\xa5\x05\x7f\x46\xd4\x21\x2b\x09\x45\xce\x30\x56\x98\x58\x12\x5a\x50\x11\xa0\x8a\xef\x76\x15\x19\x42\x13\xe0\x2a\x24\x9a\x5d\x5d\xb6\xe0\x6a\xed\x71\x91\xe6\xb9\x8d\x3e\x8f\xa3\xe1\x4e\x29\x9d\x15\x25\x9d\x72\xc1\x3b\x0a\xfe\x78\xb8\x71\x4a\x9b\x64\x5d\xd3\xe8\xcd\x51\xf0\xc7\xc4\x84\xce\x86\x25\x83\x82\x43\xab\x45\x5b\x36\xf3\xb9\x14\x40\xa8\x83\x84\x6b\xe1\xae\x2f\xce\x27\x3e\x0c\xaa\x2a\xd9\x58\x59\xa8\x7e\xdd\xef\x8c\x6a\xe9\xaa\x1c\xc4\x66\x8c\xad\xd8\x5a\x85\x7f\x8c\x50\x14\xc2\xfe\x6d\x71\x40\xa1\x4b\xc6\x5d\xe2\x1d\x57\x21\x4b\xe4\x7f\x6b\x83\xee\xb2\x93\xc1\x3b\x82\xce\x84\x26\x0a\x5c\xf1\xd0\xda\x9d\xa1\x17\xd9\x8a\xc8\x4a\x59\xc9\x26\x44\x48\x99\x81\x14\xbd\x7c\x92\xe5\x9d\x74\x0a\x35\x53\xd1\x30\xfa\x0a\x6b\xc2\xb7\x68\x65\x76\x7b\x44\xee\x78\xb8\x4b\x2b\x8a\xcc\x22\xc5\x6f\xe5\xc0\x66\x2b\x03\x2b\xa4\xf6\x0d\x93\xfc\x09\x25\xc2\xf7\x3d\x06\x22\x7d\xaf\x54\x46\xb9\x05\xec\x11\x1b\xb1\x47\x6c\xcd\x1e\xdd\x1c\x85\x3d\xe6\x5b\xf6\x56\x19\xa9\x4b\x82\x3d\x3a\x6f\xbd\xd7\x0f\xe6\x3d\x76\xe9\x8a\x24\xd1\xc5\x44\x43\xee\xe5\xaa\x25\xfb\x8b\x98\x03\xc3\xc3\x78\xda\x53\x2d\xe6\x18\x5a\x69\x8f\x18\x64\x4a\x84\xe5\xf7\x79\x86\x23\x02\x5c\x52\x1d\x1e\xc7\x3c\x62\x84\x6d\x6b\x05\x7b\x7d\x8b\xef\x31\x81\x50\x9b\x08\xdd\x2a\xad\x23\xdc\x64\xa4\xed\x3f\x84\xca\x4c\xe9\x21\x64\x6a\xe3\xb3\xa2\xab\xbb\x24\x45\x5d\x96\x11\xc4\xd5\xdb\xd6\xbb\x39\x0d\x4f\x29\xab\x21\x18\x33\xc2\x18\xe5\x3a\x36\x8c\x8e\x95\x63\xa3\x81\x02\xf6\x4a\xa4\x2a\xf6\x90\xac\xc0\x91\xbb\xd7\x64\xae\x9d\xcd\x0b\x7d\xcb\x4f\x02\x18\x1e\x87\x90\x55\x4e\x99\x79\x08\x38\x64\x7c\x36\x31\x1d\xd2\x0f\xd9\x5c\xce\xfa\x4a\xf6\x31\xc8\x43\x0e\x21\x32\x9c\xb1\xe7\x64\x3b\xeb\xa3\x44\x3e\x23\x2a\x7e\xcf\xd6\x0e\x9f\xf1\x8c\xa2\x0a\x71\x5c\x5f\x20\x59\x72\x09\x75\xec\x90\x9e\x8f\x81\x8c\x4c\x5b\xa6\x75\x1e\x82\xd1\x8e\xee\x4e\x8d\xc8\x22\xf7\x10\xc3\x87\x43\xf6\xe6\x49\xb5\x60\x0f\xf9\x86\xb4\x96\xb0\xd7\x33\x1c\x7b\x9d\xe1\x85\x60\x26\x54\xc5\x57\xf6\x1c\xec\x11\x6d\xbe\xa7\xeb\x15\x8e\xcf\xa0\xba\x0c\xf6\x0a\x5d\xcf\x27\xb0\xa7\x0d\x3e\x9a\x8f\x8f\x70\x64\x37\xb0\xe7\x96\xb6\x85\xbd\x06\x13\x93\xa2\x73\xd8\x2b\xa4\xd2\x38\x20\x0d\x56\xcc\xc1\x1e\xf2\x2a\x7b\x74\x4d\x9b\x87\x59\x6d\xe4\xa2\x6e\xb9\x3b\xf9\x40\x79\x9c\x1d\xfd\xb4\x48\x6b\xc3\x9c\xd8\x1e\x92\x0c\x98\xeb\x9d\xc4\xa1\x81\x99\x67\x94\x7b\x46\xd9\x93\x46\x76\xd8\x5b\x6c\x1a\xcc\xd9\x29\xb3\xa0\xc2\x17\x54\xfa\x82\xdf\x58\xf0\x2b\x0b\x4e\xbd\x08\x75\xaa\x8d\xec\xeb\x74\xd4\x4f\xd0\x30\x2f\xf9\x1c\x11\x36\x7d\x3b\x2b\x16\x6a\xe6\x96\x5d\x66\x65\x88\x90\x56\x67\x5c\x59\x0c\xac\x79\x6f\x1e\xc9\x0f\x8e\x61\x55\x56\x21\x10\xad\x63\x70\xa8\x0d\x7e\x50\x8e\x0c\x7b\xcb\x54\x66\xb0\xb7\x9c\xb1\x40\x12\x21\xb9\xce\x2a\xac\xfe\xc6\x14\x7b\x99\x11\x7b\xcc\x8d\x86\x08\xf3\x45\x4c\x6d\x3f\x4b\x31\x44\x7c\x9e\xc5\x17\x39\x7c\x96\xc1\xe7\xef\x2f\xc9\xba\xd2\xde\x72\x56\x22\xbb\xb5\xd7\x38\xc2\x52\x65\x5a\xb9\x46\x67\x46\xee\x54\x6e\x27\xa6\xb6\x9f\xa5\x18\x22\x3e\xcf\xe2\x8b\x1c\x3e\xcb\xe0\xcb\xf7\xc9\xce\xe4\x30\x49\x02\x90\xf7\xd0\xa2\x87\x6a\x9c\x82\xb6\xce\x65\xf4\xf3\x08\x2c\x22\x50\xc3\x1e\xc9\x53\x09\x61\x8a\x4a\x56\x79\x0f\x2d\xc2\x23\x06\x49\xca\xb0\xd7\xe8\xce\x65\x76\xee\x60\x4f\x74\x64\xb9\x2d\x40\xd4\xe1\x1d\x92\xac\x15\xae\x01\x08\xb2\x98\x7f\x00\x63\x12\x0a\x84\x74\x94\x7f\x1e\xfc\x58\x5e\x0d\x7b\x55\x6d\x18\xd9\x07\x20\xef\xa1\x45\x0f\xd5\x43\xf5\x72\xce\x99\x6e\x0a\x71\x75\x10\x89\x22\x80\xc4\x06\x3f\xa3\xd3\x5a\x49\x6d\x62\x0a\x0a\xf5\xc9\x28\x14\xd2\x96\x7c\xb3\x69\xaf\xec\x9b\xba\x1c\xda\xba\x1c\x1a\xbb\x1c\x5a\xbb\x8c\xcd\x5d\xf6\xed\x5d\xf6\x0d\x5e\xf6\x2d\xee\x42\x93\xbb\xf8\xfd\x2e\x36\x80\x8b\x2d\x50\xba\x22\x33\x25\xec\x95\x74\x1d\x67\xaf\xa4\x6b\x35\x7b\x65\xac\x9a\xac\xf1\x19\xdd\x00\xda\x2b\x43\x6e\x65\xcc\xad\x8c\xb9\x95\x7d\x6e\x7d\x83\x96\x43\x8b\x96\x43\x93\x96\x43\x9b\xba\xb8\x80\x06\x00\xcb\x99\xcb\xb6\x40\x0c\xca\x7e\x49\x17\xac\xf6\x5c\xa8\xa1\x95\x64\xbe\x69\xaf\xa4\x7b\x34\x7b\x25\xe1\x95\xaf\x1d\x10\xf1\xa3\x83\x91\x63\x67\x91\xe8\xb0\x6a\x0f\xf4\xc9\xe1\x87\xe9\x6a\xba\x77\xf3\x30\x5e\xe2\x0d\xaa\xbd\x79\x8b\xb0\x3f\x1d\x12\x0f\x73\xa4\x57\x3d\xe2\xf9\x0d\xba\x95\x77\x7f\x3f\xdf\x2e\xb6\xf5\x56\x6c\x7f\xdc\xfe\x65\x7b\xb1\x7d\xfd\xe0\xc1\x87\xa3\x47\x7f\x58\xdf\xfd\xf3\x5f\x7c\x0d\x7e\x96\x1e\xfa\xf8\x71\x7f\xff\xff\xec\x85\x7c\xff\x03\x5f\x4e\x79\xc8\x67\x51\x6f\xbe\xb0\xd7\x11\xd5\x82\x4f\xa7\xfb\xfb\x2f\x7e\xd7\xb3\x1c\x0f\x1e\x3f\xe4\x13\x9f\x61\x23\x7e\x3a\x5d\x7d\x96\x45\x2f\xf2\xfe\xaf\xdf\x10\x79\xf3\x71\xac\x54\x87\x4e\x50\x9b\x43\x92\x8b\x59\x48\xe8\x48\xfa\x10\xd2\x06\x59\xc0\x2c\xb2\x55\x21\x3a\x4a\xce\x59\x70\x3e\xbc\x89\xcc\xfd\xf0\x32\xed\x1b\x80\x2a\x91\x64\x55\xa5\x21\x2f\xf0\x9f\x64\xc3\xc9\xd4\x9d\x49\xad\xc9\x30\x17\x95\xcd\xab\x0c\xe8\x0e\x61\x16\xee\x55\x31\x6d\xf7\x65\x5a\x3a\x60\xd6\x1f\x33\xcb\x68\xc3\x99\x1b\xe5\x5b\xa2\xa2\x85\xf8\xfa\xd5\xe4\xe9\x54\xec\xcf\xb6\xd3\xa9\x78\x40\x9a\xec\x47\x1f\xe4\xe9\xcd\x87\x47\x0f\x6f\x5e\x8c\x1e\xb6\x07\xd9\x0f\xed\xc3\xd1\x3e\x9d\x7f\x68\x1f\x8e\x1e\xbc\xd8\x1f\x3d\xb4\xe8\x8f\x0e\x14\xbe\x89\x5d\x61\x30\xc9\xef\x47\x0f\x55\x4c\xa8\x30\xc1\xef\x87\x94\xf5\x0f\x51\xc9\xd8\xc3\xd1\x96\x2e\x26\x3f\x18\xed\xde\x06\xde\xd9\x2b\xce\x1d\x64\xa4\xa2\x09\xc2\xbd\xcd\x20\xb4\xd2\x2c\x8e\xb2\xc5\x42\x21\x7f\x88\xac\x60\xb8\xc5\xd9\x4b\xa8\x48\x34\x45\xde\x6c\xde\x65\x36\x33\xad\x94\x90\x1c\x13\x66\x21\x15\x6d\xb1\xe5\xb5\xd8\x40\xce\x67\xbf\xf2\xce\x05\x31\x54\x7f\x76\x2e\x5e\xa4\xe4\xb3\x6d\xc3\xc9\xe2\x5e\xb4\xe4\xa5\x05\xa1\x5c\x14\x0b\x20\x33\xdb\xd6\xc3\xd1\xdd\x9d\x31\x02\xb8\xe2\xb7\x9b\xb0\x75\x91\x29\x6b\xf0\xeb\xf8\x6e\xe6\x20\x33\xf9\x5c\x2a\xc2\x80\x2a\xc2\x8e\x30\x69\x48\x92\x51\x47\x12\x7e\x3d\xed\x02\x4b\x9b\xca\x32\x1c\xb0\x99\xc4\x5e\xce\xa0\x0c\xf1\xf1\x81\x0b\x09\xfb\xbd\x59\x43\x9b\xbd\x41\x76\xd0\x6f\xd7\x5a\x16\x09\xe0\xba\x5d\xc7\x5d\x59\x43\x42\x81\x76\x21\xad\x83\x3a\x15\x0c\xd4\xb6\x25\x7d\x49\xd8\x5e\x82\x6e\x41\x4a\xc1\xda\x5b\x6a\xea\xbb\xaf\x0a\x00\x98\x7b\x9f\x4b\xb2\x65\x6f\x05\xc4\x51\x1a\xd8\xf2\x84\x27\xaf\x12\xdb\xf2\x91\x2f\x67\x20\xe9\xd9\xc8\xaa\xd3\x4e\x83\x1d\xf6\x60\x23\x5b\x2d\xbd\x24\xa1\x44\xdc\x89\x0d\xa7\x56\x74\x06\x0e\xf9\x68\x9b\x81\xb3\xba\x67\x92\xbb\x9c\xf9\x4c\xda\x71\xad\x59\xad\x0b\x1b\x0b\xdd\xd0\x29\xca\xac\x2c\xf9\x3b\x90\x95\x75\xfd\xfe\xab\x4c\xae\x31\x56\xb5\x90\xe0\xc9\x90\xae\x27\x86\x37\xdc\x6b\xa4\xdd\x59\xe6\x1a\xdb\x45\xc2\x1c\x7e\x65\xfb\x55\xb5\xb4\xb7\x9a\x15\x6d\x90\x3c\xcd\xc9\x4c\x2b\x09\xce\x75\xb6\x99\x85\x53\xde\x26\x6b\x3b\xec\x50\x56\x3d\xe9\x93\xeb\x57\xed\x8c\x99\x5b\x1a\x5f\x33\xec\xc2\xd9\x2d\xae\x31\x43\xd0\xb5\x59\xdb\x39\x70\xad\x98\xb1\xd8\xa9\x87\xe2\xab\x3d\x73\x17\xbc\x50\xbb\xf8\x38\x54\x2b\x06\xf1\xf5\x6e\xc8\xa9\xfb\x2c\x2b\x52\x8d\xeb\xa5\x98\x0d\x4c\x7a\x02\x0e\x8f\xfb\x87\x0c\x20\xcb\x19\x33\x21\xa3\xb5\x0c\x7f\xe3\xc4\x4d\xdd\x4a\x88\x7c\x01\x13\xe7\x41\x05\x1c\x63\x5b\x9a\xb4\x24\xe1\x63\xe5\x95\x24\xf8\xff\x75\xb4\xbb\x62\xee\x8f\x1e\x3d\xfa\xf2\xda\xc3\x70\xff\xa2\x4e\x4e\x7f\xa5\x97\x21\xf6\xdf\x6f\xff\xb9\x3d\xda\x8e\xb7\xff\xda\xfe\x63\xfb\x66\xfb\x97\xed\xa3\x07\xf7\x87\x85\xf6\xb3\xab\xee\xf8\x42\x3c\x30\xb6\x3f\x7b\x31\x5c\x92\xa0\x6b\x9b\xfd\x4b\xdf\x46\xe3\xaa\xfa\xea\x5e\x02\xd2\x01\xbf\xfb\xfd\x7f\x4c\x57\x37\x74\xc4\x7c\xc0\xa7\xe7\xdb\x23\x78\xb7\x3d\x82\x7f\x6e\x8f\x20\xc3\x7f\x0e\x59\x5e\x40\x96\xd7\x90\x69\xc8\x2a\xc8\x0c\xc6\x5a\xc8\xec\x3c\x43\x07\x71\xec\x5c\x12\x54\xa3\x3b\x47\x87\x4f\xc3\xd2\x91\x9c\x0e\xb2\x6e\x0e\x59\x67\x20\xdf\x1e\x41\x7e\x0e\x79\x06\x79\x26\x20\x17\x90\x23\x8e\xd5\x90\x97\x90\x6b\xc8\x2b\xc8\x0d\xe4\x35\xe4\x0d\xe4\x96\xb6\x01\x78\x2f\x20\x78\x82\x3d\x0d\xb9\xad\x11\x13\x8b\x3a\xe0\x63\x07\x39\x66\xbe\x82\x62\x7b\x04\xc5\x39\x14\xe7\x25\x14\x19\x14\x58\xed\x22\x13\x22\x47\x97\x20\x8c\xd7\x40\x77\xe7\x73\x28\x0a\x28\x0a\x4d\x17\xec\x25\x14\x72\x0d\x45\x09\x45\xa9\x2c\x14\x73\xd9\xe6\xe4\x4a\x28\xe6\xc0\x4c\xbe\x83\x62\x21\xa0\x58\x84\x2d\x86\x16\x0a\xda\x8e\x86\x42\xd7\x50\x54\x50\x54\x59\x53\x40\x51\x49\x28\x0c\x14\x88\x24\x0b\x53\x42\x61\x6a\xfc\xe3\x97\x90\x87\x8f\x6b\x20\x8d\x36\x35\xbe\x57\x57\xf8\x2f\x68\x1d\xc1\xb5\x82\x96\x11\x28\xea\x46\x42\xd1\x40\xd1\x94\x50\x2c\xa1\xb0\x50\x38\x28\x5c\x0b\x45\x07\x45\x67\x32\x74\x30\xa7\x15\x4e\xf1\x0a\x84\xcc\x59\x23\xd9\x1c\xc3\x05\x3a\x25\x20\x31\x21\x54\x59\xce\xc9\xad\xc9\x6d\xd8\xed\xc8\x73\xe4\x92\x30\x4e\x28\x4c\x05\x82\x4e\xdd\x69\x10\x1f\x41\x68\x45\xda\xfb\xea\xac\x6b\xeb\x0c\x44\x03\xc2\x82\x70\x20\x5c\x03\x72\x7b\x04\x32\x03\x59\x80\x2c\x16\xb5\x24\x77\x41\x6e\x45\xae\x01\xa9\x79\x2b\x1b\x64\x05\x92\xb6\x2b\xca\x9a\x5c\xda\x3e\x40\x67\x05\x12\x49\x9e\x35\xc8\x35\x7a\x0a\xe4\xba\x83\x72\x7b\x44\x48\x87\x57\x3a\x3a\x09\x45\x9b\x06\xe8\x28\xc0\x8e\x29\xd5\x1a\xca\x1a\xca\x5a\x8b\x82\x5c\xc1\x6e\xcd\xa1\x70\x40\xb0\x83\x79\x0d\x73\x0b\x73\x2b\x9b\x0c\xe6\x1d\xcc\x3d\x2c\x32\x58\x48\xdd\x94\xe4\xce\xc9\x6d\x61\xa1\x60\xa1\x04\x60\x13\xa8\x0c\x14\x0e\x17\x5c\x2c\x3f\x82\xd2\xa0\x2a\x50\xd4\xa5\x88\x49\x95\xa9\xf1\x8f\xdd\xa8\x42\x37\x92\xbc\xdf\x81\x72\x0d\xa8\x0e\x14\xf6\x8a\xa2\x5e\xf9\xb8\x3d\x82\x8f\x1d\xdc\x6e\x8f\xe0\x56\x62\x05\x6e\xa5\x24\xe8\x23\xe8\x73\xd0\xe7\x25\xe8\xed\x11\xe8\x4c\xe0\x5f\xe4\xe4\x96\xa0\x33\xd0\x99\x01\x9d\xb5\xa0\x73\xd0\x05\xe8\x62\x01\xba\xd0\xa0\x0b\x07\x5a\xd2\xbe\x66\xc6\x3b\x03\x72\x0d\xba\x04\x8d\xed\xa1\x69\xa0\x6a\x1a\xa8\x7a\x0e\x7a\x8e\x51\xf4\xd5\x7a\x01\x5a\x83\xc6\x6c\xb5\x02\x5d\x65\xb7\xa0\x2b\xf4\x9b\x02\xb4\x91\xf8\x5f\x81\x36\x25\x68\x43\x1b\xc0\xb7\xa0\x6b\xd0\x75\x81\xff\x5b\x0f\xba\xc6\xa5\xbf\x91\xa0\x1b\xc0\x56\xd3\x16\xb4\x03\xdd\x82\xee\x40\x77\x19\xfe\x05\x3a\x25\x68\x0f\xda\xab\x2a\x94\xba\x82\x6a\x7b\x04\x55\x06\x58\x20\xeb\x7f\x6b\x0a\x20\xe5\xdf\x80\x7c\x4e\x25\xa1\x92\xa6\x43\xc0\x41\x75\x0b\x15\xc6\xdf\xba\x06\xaa\x5b\x8f\x7f\x25\xf9\x54\xd9\x1d\x54\x77\x25\x98\xbc\x00\x93\x83\xc9\x1d\x53\x1d\x15\x18\xca\x0f\x9b\xd9\x18\x30\xa1\x2b\x4c\x9d\x91\x7e\x9c\x7a\x01\xdc\x47\x7d\xbc\xad\xc0\x74\x60\x3a\x83\xff\x4a\x42\xad\xa1\xde\x1e\x41\x5d\x41\x4d\x19\xd5\x15\x9d\xfa\xa8\x4d\x8d\x7f\x7a\xa9\x6e\x5a\xa8\x3b\xa8\x39\xfd\x0a\x9a\xed\x11\x52\x24\x25\x91\x2c\x25\x3a\xf8\xaf\x9a\xd6\x42\x53\x40\x83\x54\x8a\x84\x46\x5a\x2d\xa0\xa9\xa1\xa9\x9b\x0e\x9a\x06\x1a\x22\x6c\xa4\x87\xc6\x41\xd3\x42\xd3\x9e\x43\xd3\x96\xd0\xb4\x1f\xa1\x69\x35\x34\xad\x81\xa6\x6d\x80\x72\x69\x1d\xe0\x4b\x2b\x68\x36\xc7\xd0\x6c\xda\x45\x6d\xd0\x3f\x16\xe8\x94\xd0\x6c\xa0\xd9\x20\x5c\xc2\xb2\x53\x6d\x06\x4b\x24\xf8\x0b\x60\xa5\x91\x42\xa1\x63\xc9\xc9\x48\x7b\xe4\x1c\x09\x1f\xa4\x74\x88\xda\x01\x2b\x57\x60\x15\x9f\x3b\xc8\xc1\x76\xf4\xdf\x08\x72\x4b\xb0\x9d\x41\x10\xac\x07\x77\x0e\x2e\x33\xe0\x32\x70\x48\xf3\x64\x1e\x5c\x0e\x2e\x3f\x07\x97\x67\xe0\xf2\x12\x5c\xae\xc1\xe5\x15\xb8\xdc\x80\xcb\x1b\x70\x79\xd8\xb9\x8f\xfb\xf7\xe0\x0a\x07\x7c\xd4\x85\xf4\x55\xce\xe9\xcc\x08\xb8\x12\x1c\x0e\x56\xb7\x00\xa7\x2a\xa4\x10\xc0\x29\xa4\xaa\x98\xb0\xaa\x80\x2c\xbd\x3b\xea\x10\x57\x49\x70\x06\x9c\x51\xe0\x4c\x8d\x7f\x4b\x0e\xf6\x8c\x43\xb8\x06\xd7\x20\xcf\x84\x1e\x83\x8a\x3d\xcb\x5e\xc7\xde\x0a\x5c\x03\xae\xb1\xe0\xe8\xc0\x1c\xb8\x36\x63\x6d\x7d\x73\xf6\xe8\xaa\x8f\xa5\x03\x39\x0a\x5c\xfb\x11\x5c\xeb\xc0\x75\x06\xff\x15\x39\x12\x1c\x92\x3c\x1e\xdc\x0a\xdc\x86\x6c\xfe\x2b\xda\xdb\x80\xf6\x1c\xda\x2c\x27\xa7\x20\xbb\xfd\x35\xba\x64\xaf\xbf\x24\x47\x91\x81\x7f\xb2\xcd\x5f\xa1\x43\x27\x8a\xb3\x9c\xf6\x42\x28\x2d\x99\xf9\xa7\x44\x64\xdd\x1f\xda\x0c\xf8\xd2\x5c\x01\x6d\xa1\x49\xb5\x46\x49\x16\xfc\x4b\x68\x17\xd0\x7e\x84\x56\x93\xb6\x0c\x26\x33\x1b\x68\x2d\xdf\x86\x72\xd0\x76\xc1\x22\xba\xa8\x3f\x92\xab\x91\xee\x84\xce\x2c\xa0\x33\x9a\x34\x6a\x00\x7e\x11\x7d\x50\x67\x1c\x74\x0d\x78\xbe\x11\x87\x4e\x0b\x5e\x55\xd0\x4f\x5f\xaf\xc0\xab\x0e\x70\x16\xfa\x0a\x3c\xf5\x88\xaf\x24\x78\x83\x7f\xf0\xa1\x23\xbc\x03\xdf\x21\xbd\x5a\xc9\x68\x47\x7a\x7b\x04\xab\x73\x58\x65\x64\x8f\x5a\x61\x6c\x81\x4e\x03\x2b\x03\xab\x06\x56\x4b\x58\x2d\x33\x58\x39\x58\x75\xb0\xf2\xb0\xde\x1e\xc1\x3a\x83\x35\x95\xb0\xae\x60\x5d\x49\x58\x1b\xa2\x73\xb1\x80\x75\x07\x6b\xca\x7d\xb3\x3d\x82\xbb\xed\x11\xfc\x77\x38\x47\x7a\x8c\x95\x6f\x46\xb5\x3c\x64\x1c\x51\xd1\xe1\xdf\x9c\x4c\x7b\x20\x89\xe2\x25\x9f\x00\x41\xe6\x0f\x31\x0d\x64\x9c\x27\x42\x76\xee\x88\x66\x11\x44\xb0\x84\xfb\x62\x48\xba\x08\xd5\x12\xdd\xc2\x7b\xc9\x48\xbc\xd0\x6e\x6b\xbc\xce\x03\x99\x2b\x94\xa2\x23\x0c\x45\x25\x90\x98\x21\xfe\x2a\xc3\x2a\x9a\xae\xe7\x09\xa9\x8a\x39\x96\x9c\x63\x11\x79\x28\x20\x97\x8b\xcc\x13\x99\x53\xaf\x58\xe6\x9c\xf3\x05\xcd\x9c\x2e\x61\xe6\x74\x87\x53\x21\xcd\x4c\x5c\x55\x5e\xb7\x21\x55\x13\xb4\xad\x20\xd5\x43\x42\x9f\x70\x2e\x82\x28\x22\x11\x82\x02\xa9\x27\xa2\x8a\x14\x66\x66\xeb\x95\x93\x48\x0d\x91\x26\x95\x7c\xa5\x1a\x89\x4c\x18\x53\x30\x50\x50\x15\x8b\x73\x62\x24\x8b\x60\x3f\xbf\x88\xad\x47\x94\x12\x7f\x0b\x82\xa4\x28\x88\x68\x26\x4e\x4d\x67\xa6\xe9\xb8\x74\x4c\x14\x74\x11\x49\x52\xea\x58\x70\x7a\x4e\xcc\x1f\x48\x64\x54\x48\x8b\x4b\x14\x25\x98\xcb\x96\xd3\x2c\x04\x52\x5a\x48\x4a\x35\x59\xbb\x08\x44\x15\x2e\xb6\x05\x7d\x4a\x41\xad\x13\x8a\x40\x04\x41\x04\x16\x57\x94\x9a\xbd\xa0\xe6\x42\x4a\x0b\xf3\x37\x9c\x29\x75\x36\x26\xa6\x85\x20\x7c\x60\x3f\x02\x48\xc3\x51\x51\x6b\x41\x87\x3b\x74\x6d\x5d\xb1\x60\x92\xac\xaa\x90\xb5\x45\x52\x8c\x4b\xa8\xab\x46\x69\x4a\x35\xa8\x3c\x2e\x95\xad\x88\x34\x33\x50\xf4\x7d\x53\xb0\x1c\xb4\x40\xd4\x0c\x45\xe8\xa8\xc2\x31\x05\xe7\xda\x6c\x4e\xb4\x1a\xd6\xa8\x33\xb1\x3e\x61\xdc\x14\xf1\x36\x18\x8f\x13\x24\xe5\x68\x9d\x24\x52\x2e\x10\x74\x56\x4a\xb1\x21\xaa\x2e\x54\x11\x69\xbb\xfe\x52\xa5\x2a\xcb\xa0\x26\x87\x28\x3d\xd9\x32\xad\x57\x32\xe5\xd7\x50\x6f\x31\xc5\xc7\x4f\xf8\x88\x96\x50\xa4\xa9\x15\xc9\x3e\x96\xb4\x72\x05\x90\xee\xeb\xaa\x06\x49\x3f\x3a\xb1\x12\x87\x7b\xa0\x01\xb5\x0e\xe9\x1a\xd2\x6e\x0b\x82\x75\x2a\x21\x45\x88\x99\xd2\x1c\x92\x99\xd5\x4a\x5a\xa2\x02\x99\x38\xb4\x1c\x58\x68\xa6\x0f\xdd\x7c\xf7\x18\x29\xb5\x03\x0b\xbe\x88\x48\xb4\x9f\x1f\x73\x41\x34\x87\xe4\x22\xf1\xc2\x12\x11\xe9\x70\xc6\x93\x0e\xcd\x77\x2e\x9b\xcb\x41\x6b\x15\x71\xde\x25\x73\xaf\xe1\x18\x3d\x6f\x9a\x86\x3b\xd1\x6a\x8d\x13\x06\x69\x45\x26\x22\x69\x78\x31\x09\x49\x1d\x1b\xa8\x49\x8c\xe6\x14\x1c\xdb\x4b\x5a\x76\x8e\xdd\xcb\x86\x49\x4c\x21\x60\xde\x29\x98\x23\x2e\x5d\x64\x56\xd0\x30\x23\xa3\xfc\x44\x72\x62\x6d\x88\xea\xc4\x17\x88\xf0\x44\x5c\xbf\x50\xf3\x85\xa6\x89\xbe\x40\x2e\x33\x6a\xcd\x62\xb5\x57\x48\x8b\xd2\x58\x51\x71\x72\x2a\xea\x1b\x45\x7d\x83\x74\x29\x13\xa7\xfc\x88\x5a\x51\xc5\xa1\xaf\x92\xa1\xaf\xfa\xa1\xaf\x4c\x6b\x6b\x50\xa1\xd3\x14\x77\x9a\xe2\xa1\xa9\xfa\xa1\xa9\xc2\xd0\xe4\xf3\x29\x5d\xd5\x38\xa6\x61\x75\x4b\x3e\x8f\x4f\xa2\x65\xe9\x99\x26\x7c\xa2\x19\x9f\xf0\x71\x95\x88\x38\xf4\x80\x4c\x74\xc4\x21\x7c\xe9\x3c\x30\xa6\x48\xea\xe2\xc3\x98\xa8\x10\x48\xf2\x22\x4e\xd0\xdc\x2b\x3a\xcc\xa2\xa0\xaa\xbb\x6c\x09\xbb\x23\xf9\x8b\xd9\x73\x86\xdc\xab\x7a\xc0\x33\x3a\xe2\x19\x1d\xf1\x0c\xd1\xc3\x81\x2a\x16\x02\x74\xdf\x13\x9a\xab\xc3\xd5\xae\xb2\x5b\x49\xd4\x31\x93\xc8\xd4\xae\x9a\x10\x8c\x66\x04\xa3\x19\xc1\xe8\xd8\xca\x44\x33\xcb\x4d\x04\xbd\x42\x72\xba\x2e\x6e\xb9\x89\x88\x88\xc6\x2c\x18\xd7\x68\x1a\x45\xba\x47\x1a\x9a\x91\x86\x0e\xe8\x42\x23\x9a\xd0\xdc\x17\x48\x58\xd7\x44\x59\x53\x8a\xb0\x34\x0f\x24\x36\x7e\x42\xc0\x1b\xb4\x95\x87\xe5\x01\xd5\xbe\xaf\x37\x9f\xad\xa2\x7e\x24\x5a\xdb\x66\xc6\x61\x63\x23\xcd\x8d\xb3\x05\x29\x6e\xb9\xb6\x05\x12\xde\xd2\xd1\x79\x0b\x24\xc1\x91\xf3\x43\x0a\xbc\xa2\x27\xf4\x41\x24\x9d\xaa\xee\x02\x96\xac\xee\xa8\x4e\x26\xe7\xfe\x31\xf9\xad\xdc\x20\x6d\x9e\xd9\x36\x08\x04\xf1\x03\x4c\x5f\x0f\x1e\x4a\x26\xb6\x98\xe9\xc7\xa2\xe9\x71\x4b\xff\xac\x5e\x04\x1d\x6d\x90\x8c\xde\xe4\x05\xda\xf2\x0c\x47\x52\x0d\xb7\x94\x09\x63\xb5\xd6\x82\x99\x46\x16\x3e\xe2\xa3\xba\xaf\x43\xcd\x49\x8c\xde\x04\x02\x1f\x9f\xf6\xf9\xf2\x39\x6f\x47\xc4\x3e\x3e\x88\x39\xae\x8c\xa3\xbb\xc9\xbd\x66\xe9\xba\x44\xb4\x81\x3e\xb6\x00\xd3\xff\x34\xaf\x03\x2b\x20\x1b\x0d\x0d\x37\x4b\x43\x98\x10\x19\x02\xe6\x0a\x88\x27\x20\xbe\xa0\x81\x86\xc0\xe1\x76\x49\x18\x0e\x4d\xf8\x74\x44\x0c\xc8\x2a\x60\x5b\x62\xfe\x38\xb6\x9b\x96\x26\x7e\xd3\xd2\x68\x6d\x5a\xc3\x0f\x87\x77\xdb\x30\x88\x9a\x36\x48\x33\xe9\x34\xd6\x8a\x98\x07\x2c\x7c\x73\xcc\x75\x26\xbe\x02\x39\x09\x8c\xa3\x28\x5a\xb2\x88\xa5\xd0\x1a\x96\xe8\x04\x65\x74\x2c\xab\xb6\x92\x2e\xed\xe3\x8c\x64\xe6\x22\xf2\x18\x2c\x0c\x8c\x82\x26\x84\x1c\x1f\x7b\x47\x52\x36\x4a\x58\xb9\x52\x4c\xc9\x30\xef\x81\xf4\x0f\x71\x1d\xcc\x80\xd4\xcc\x81\x60\x45\x90\x09\xc1\xa0\xe1\x83\x57\x5e\x55\xb4\x4d\x4e\xb7\x8f\x55\x8b\xc8\xde\x51\x9b\xf4\x1a\x1e\x7a\xc2\x8c\xb6\x12\x5d\xe6\x65\xe6\xc0\x05\x14\xe0\x98\x10\x73\x44\x89\xb9\x40\x6b\x39\x26\xb6\xdc\x40\x6d\x39\x26\xb7\xdc\x40\x66\xb9\x48\x67\x31\x3b\x43\x37\x4a\xfa\xa3\xc9\x41\xf5\x86\x0b\x48\xc9\xf1\xdd\xc0\x7e\xc9\x41\x6e\x87\xd7\x86\x78\x4c\x1e\x79\x1e\xcc\x2b\x94\x4f\xaa\xff\x9c\xaa\x10\x95\xd2\xf9\x50\xa7\x34\x7d\x81\x96\xb2\x41\x46\x08\x13\x55\x19\x89\x44\xfb\xd1\xeb\x68\x38\x3a\xae\xa8\x51\x65\x89\xbc\x50\x48\x14\x47\xb3\xeb\x47\x33\xeb\xe1\x88\x0a\x00\x91\x49\xc2\x91\x43\xd0\xbc\xae\x45\xe0\x96\xa8\x65\x89\x61\x92\xb4\x5f\xcb\x5c\x13\x76\x00\x33\x4e\x2c\xda\xe6\xb3\xdc\x43\xd3\xc4\x96\xe1\x9b\x48\x59\xe0\xa2\xe6\xf1\x70\x3b\xf3\x54\x69\x20\xac\x63\xc4\x60\x45\x90\x46\xb2\x8b\xe3\xd4\x75\x86\x56\x3e\xc7\x93\xcf\x85\xc9\xe7\x3a\x47\xe4\xbd\x23\x0c\xe4\x56\x59\x43\x27\x4a\xc2\x84\x24\x36\x8c\x83\x05\x9d\x1d\xe6\xd9\x82\xfc\x58\xf0\x79\x16\x22\x43\x86\xf3\x90\xd8\x31\x4c\x96\x85\x81\x80\x8c\x59\xc6\x3e\xe1\x4f\x62\xcf\xf8\x4d\xc2\x12\xc8\x9d\xc5\xaf\x46\x26\x8d\xbf\x1b\xbf\xb8\x2d\x34\x71\x67\x35\xb1\x67\x38\x6e\x5b\x99\x59\x24\xb6\xc2\x54\x0d\x97\xae\xe8\x2b\x79\xba\xb6\xf4\x45\x3c\x67\xdb\xba\xa1\x95\x6c\x98\xba\x71\xe6\xc6\x06\x69\x43\x0b\x50\x67\x10\x47\x87\xab\x30\x31\x75\x8a\x74\xbd\x27\x1c\x4f\x68\xbb\xce\x68\xd9\x12\x97\xc7\xeb\x0d\x37\x65\x9f\x4f\x18\x63\x81\x46\xf4\x61\x84\xc6\xc3\xf5\xc8\x04\xd6\xac\x1e\x85\x4c\x3a\x45\x3e\x10\x92\xb5\xc6\x2b\x47\x87\xdd\x14\xd3\x59\xd4\x27\xc8\x17\x32\x73\x48\x83\xd4\x53\x61\x1e\x69\x33\x1f\x07\xa5\xef\x07\xa5\xe7\xa1\xe4\xb9\x66\x3e\x54\x8d\xf7\xd0\x57\xd4\x67\x2b\x1c\x85\x71\xb3\xc3\x10\x2a\x41\xf6\xb1\x12\xc4\x40\xd6\x0e\x56\xd4\x7e\xab\xbe\xdd\x56\x84\xab\x56\x8e\x98\xd8\x15\xb5\xd6\x2a\xe2\x0c\x24\x0a\xd7\xf8\x78\xdd\xd7\x0f\x21\x64\x30\x4d\x17\xf8\x4b\x0c\xf6\xf5\x5b\x73\xc5\xd6\xa1\x62\x9b\xcc\xdc\xa6\xbb\x2c\x6e\x63\xce\x5e\xd3\x0a\x0a\x7c\x14\x88\x77\xa3\x68\x89\xcd\xec\x1c\x84\x12\xb3\x1e\x09\xd0\xf1\xa1\x52\x4a\x41\x2a\x76\xe8\xfe\x8e\x9d\x7b\x62\x26\x90\x70\x9e\x11\x8b\x03\x44\x2e\xca\x96\x3f\x6e\x8d\xe0\xb2\xa4\x0e\xee\x23\x37\x88\x75\x24\xe2\x1c\x0d\xf4\x0d\xf1\x44\x2f\x5f\x5c\xe1\x63\xd5\x98\xbc\xa8\x04\x36\x50\x75\x47\x57\x23\xe6\x99\xcd\xb3\xb9\x2c\x6a\x1d\x6f\x16\x5a\x39\x07\x3a\x35\x45\x87\xa9\x90\x03\x98\x2d\xf4\xd9\x6b\x3e\xc1\xec\x64\x61\xc3\x15\x26\xa4\x95\x1a\x69\x2b\x04\x1a\xc5\x3b\x3f\xab\x78\x05\xd3\x2d\x54\xd9\xd2\x26\x08\xa9\x43\xa2\x03\x4f\xca\xf1\x7d\x3c\xa4\x58\xa9\x89\x20\x1c\x94\xa1\x9c\xad\xa4\x53\x85\xd8\x7f\xb4\x97\x42\x47\xa9\x78\x24\x11\x45\x41\x89\xe8\x93\x71\xb6\x65\x73\x9c\x06\xc6\x86\xbb\x3f\x74\x9d\x68\x4e\xed\x89\x5d\x48\x4d\xb6\xc8\x10\x27\xb6\x35\x51\xc9\xb8\x12\x67\x76\xae\xc4\x9a\x08\x7d\x9a\x89\x6e\x91\x4d\x9e\x3e\x43\x9e\xc0\xb5\x0e\xda\x9a\x55\x0e\x71\x0b\xf1\x76\x4e\x36\xa7\x02\xc3\xa6\xd7\x5c\xb6\xb1\x4d\xf3\xae\x34\x16\xe3\xcb\x60\x21\xc1\xf0\xb7\xe6\x5d\x19\xb2\x73\xad\xe5\x11\x10\x6a\x9b\x77\xdc\x5f\xae\xef\x00\x2b\xab\xba\x95\x33\x5a\x3e\xc3\xe9\x25\x5e\x06\x62\xbf\x62\xa2\xb9\x24\x1b\x80\x98\x31\x7f\x2f\x5d\xab\x9f\x18\x4b\x37\x93\xb8\xae\x7c\x6a\x00\xfb\xa9\x54\x1a\xf9\x4f\xdd\x65\xd4\xb7\x61\xcf\x0b\xb4\xca\x91\x13\xc7\xb7\x23\xfb\x22\xf8\x32\x1e\xeb\x65\x0a\x17\x96\x16\x99\x9b\x21\x75\x86\x1f\x47\xd8\xb3\xb5\x13\xbe\xc5\xef\x5a\x8b\xc5\xb9\x50\x19\x2c\x76\x91\xd9\xaa\xa6\xfb\x5d\x79\x57\x7a\x52\x21\x20\xe4\x1a\x98\x28\xc1\x9a\xd3\xe2\xd5\xeb\x86\x11\x75\xd8\x87\x8e\xe5\xe3\x9b\x61\x08\x87\xa6\xc1\xb8\xd6\x92\x3d\x74\x2a\xda\x58\x3a\x36\x8b\xe3\x99\xcf\xf2\x85\x73\x68\x98\x59\x6b\x69\x5f\x96\x1a\x90\x28\xf7\x95\x32\x6c\x00\x92\x6e\x78\x66\xf9\x4a\xd1\x41\x7f\x2b\xf9\x28\x2b\x1b\x36\x8d\x7d\x61\xd8\x52\x0a\xb1\x0d\xb8\xea\x52\x12\x3a\xc0\x37\x1f\x3a\x20\x90\x57\x74\x38\x8f\xbb\x9d\x5a\x8c\x5a\x86\xcb\xf0\x24\x0b\xb4\x81\x21\xe6\x01\xc0\x53\x3a\x77\x48\x42\x51\x1f\x38\x9a\x9f\x74\x06\xa2\x66\x9d\x59\x2b\xa5\x05\xa2\x0d\x1a\xd5\x7c\x78\x91\x16\x51\xd7\xcd\xe7\x32\x34\x47\x9d\x09\x6c\x8e\x8d\x29\x6a\x53\xc8\x8c\xce\xd1\xcb\x75\x6b\x6a\x93\xeb\xcc\xdc\x86\xab\x49\x93\x42\x2b\x3e\x45\xc0\xb8\x02\x0b\x1b\xbe\x9d\x79\x5e\x9a\x8d\x7c\xf9\x00\x11\x64\x4d\x35\x8a\xc3\x18\xa7\x30\xd5\x38\x99\xe0\x99\x97\xb1\x17\x33\x65\xa1\xb6\x01\xaf\xe0\x32\x45\xbd\xef\xfa\x8f\xc7\x89\x85\x88\x49\x48\xd9\x10\xfb\xea\x5a\x4b\x97\xb4\x42\xcf\x34\x52\xde\xd2\x69\x0a\xc6\x34\x58\x18\x2d\xc3\x5d\xce\xdc\x46\x40\x58\x8b\x8c\xfa\x0f\x4b\x66\x06\x82\xf0\xa4\x10\x50\xdd\x62\x17\x71\xaa\xac\x6d\x69\x6e\x96\xb5\x21\x22\x2b\x0e\xea\xd8\xc5\x2e\x3c\x8e\x4b\x81\xc1\x11\x49\xc2\xca\xa6\x5e\x01\xf3\x7a\x3c\x93\x36\xad\x9c\xf0\x87\x20\xea\x20\xae\x3e\x1c\xce\xa7\x61\x1f\x86\x6c\x9b\xcd\x99\x41\x70\xa4\xb9\xc1\x72\xf7\x52\x47\xe5\x99\x58\x91\x2e\x63\xcb\x1a\xa0\x1b\xc4\xe9\xba\x9e\x23\x33\xdb\xb0\xf1\x47\x58\xd4\x8e\xab\xe9\xc2\x3c\xd6\x75\x4e\x12\xab\xd0\x34\x65\x6d\xe5\xbc\xc7\x5d\xd4\x04\x84\x12\xe9\x33\xa9\x1e\x41\x4b\x1b\x93\x8e\xb3\x70\x00\x84\xee\xcf\xb5\x16\xd1\xd8\x4a\x19\xc6\x42\xa1\xc4\x4a\x99\x61\xd1\xc0\xd6\x33\x76\xc2\xf9\xf6\x9d\x89\x15\xa1\x4e\x16\x2a\xc3\xfa\xd2\x79\x41\xc7\xed\xcd\x32\x82\x56\x56\x0e\x16\x7a\xc0\x61\x54\x14\x0b\x08\xb1\x2f\xe4\xba\xc1\xfe\x2c\x71\xea\xe3\xe7\xd0\xa9\x6d\xe4\x52\x95\x91\x13\xba\x67\x95\xd9\x79\x11\x4c\x23\xcd\xfb\xa1\x4f\x9a\xbe\xb1\xa5\x5b\xb9\x6e\xd9\x20\x46\x40\xd9\x90\x34\x04\x1d\x87\xa5\xca\x04\xe1\xd6\x22\x73\x74\x4a\x01\x51\x81\xa3\xcb\x36\xe5\x86\x06\x1d\x2b\xd9\xc0\xcf\x12\xb2\xd0\xa0\x8a\xda\x78\x6c\x10\x5c\x43\x90\x06\x68\xba\x8a\x2c\x4c\x22\x75\x80\x44\x43\x8f\x7b\x58\x2d\xf4\x22\x73\x34\xef\xf1\x8b\x68\xd5\xc5\xa9\x4f\x05\xe3\x28\x8a\xf5\xa4\x92\x8c\xa5\xdb\x8d\xad\xac\x9a\x38\xf3\x8b\x95\xa0\x41\x84\x2d\x33\x1f\xd0\x9f\x0b\xca\xd1\xc3\xf8\xa1\x9b\x1a\xc3\x25\x7e\xea\x74\x47\x24\x2a\x4d\x5d\x6a\xee\x50\x63\xa6\x59\x37\xc6\xb5\x59\x71\x0b\xcd\x86\x66\x0b\x12\x2e\xfd\x74\xc7\x35\x82\x25\x86\xa1\xb3\x31\x80\x91\x34\xbb\x59\x4e\x6b\xa8\x77\xe8\xc8\xc1\xe1\x1f\xc3\x21\xbd\xf3\x68\x4e\xf2\xb3\xc3\x04\x87\xa3\xfd\xe9\x74\xb4\x9d\x9a\xe9\x74\xfb\xe1\x97\xd1\xd4\xdc\x3c\xf8\x6e\x74\xc8\x8a\x1b\xef\x8f\xee\x07\x9d\xd7\x5f\x18\xb4\xf8\x90\xaf\xda\xb9\x76\x99\xbf\x39\xf9\x30\x5d\x4d\x05\x29\x02\xfa\x96\x29\x19\x1a\xab\x11\xf8\xdd\xa0\xfa\x23\x58\x09\xb8\x7e\xfb\xb9\x0a\x9f\x5d\xcd\x8b\x5f\x1e\x68\x38\xfc\xd3\x87\xe9\xea\xd1\xcd\xc3\x3f\x1f\x7e\xfb\xd8\xc2\xfa\xf9\xb3\xcc\x7d\xfd\xe4\x02\x1f\x01\xd3\x27\xa3\x0f\x8f\xef\xdd\xbc\x08\x7a\x76\xd2\x13\x0c\x74\x5e\xcb\xd2\x75\xe7\x86\xee\xeb\xdc\xa1\x43\x86\xa0\x1a\x73\x07\xeb\x8c\x95\x73\xad\xe3\xe9\xa1\x9c\xae\x59\xa3\x9b\x65\x19\x64\x19\xfa\x15\x64\x99\x83\x4c\x14\x44\xb0\xf1\x41\xb2\x46\x43\x9e\x1f\x45\x95\xfd\xf9\x38\x42\x34\xe6\x73\x57\x42\xee\x2c\xe4\x64\xec\x29\x6f\x21\x6f\x0b\xc8\x5b\x0b\x79\xeb\x82\xf8\x3c\x5f\x41\x21\x96\xf8\x97\x50\xe8\x02\x0a\x2d\xa0\xd0\x0a\x0a\x8d\x49\xaa\x02\x8a\xaa\xc1\xbf\xcb\xc9\x15\xe4\x2e\xc9\x5d\xa1\xbb\x2e\x16\xf3\xe8\x3f\x79\xfe\x2c\x82\xcf\xf3\x08\x8d\x9f\xe5\x50\x34\x9d\x12\xe8\x32\x3d\x82\x00\x93\xdb\xc5\xb2\x06\x1c\xf1\xc5\x4a\x48\x10\x59\x06\x22\x73\x20\x64\x01\x42\x79\x10\x95\x32\x2d\xc8\xaa\x72\xc0\xf2\x7c\xb9\xec\xa0\x9c\xac\xab\x31\x94\xb8\x08\x96\xd8\x0a\xe8\x34\x50\xe6\x5a\x40\x99\xbb\xb6\x81\xb2\x58\x38\x28\x0b\x2d\xd7\x50\x16\x55\xed\xf3\xe0\x49\xf6\x83\x67\xf2\xe8\xc7\x88\xe8\x77\xec\xa3\x57\x57\xe4\x28\x76\x1b\xf2\x82\x4b\x9e\x83\x52\xc8\x82\x0a\x15\xca\x29\x74\x3d\x39\x14\xe1\x2d\xbb\x0d\x94\xf4\x0d\xa5\x34\x0a\xca\x12\x69\x0b\x72\x1b\x28\x15\x7d\x81\xa2\x82\x14\x67\xae\x28\x0f\xc5\xaf\x2b\xfc\x2a\x55\x75\x1a\x29\x0a\x2e\x88\x2c\x08\x96\xca\xb1\xd3\x90\xcb\x5e\x97\xb3\x8b\x54\x9c\xc0\xff\x18\x9d\x62\x85\xae\x34\x1e\x3d\x3d\x91\xec\xb5\xe4\xcd\x27\xe4\x19\xf2\x1a\x85\xee\x1d\x94\x54\x5c\xd5\xe9\x06\x4a\xc3\xcd\x68\xf8\xeb\x0c\x7d\x80\xe1\x1a\x98\x1a\x9f\xd3\x7a\x5e\x1a\xd7\x62\x31\xc6\xb5\x54\x8e\x71\xad\x5b\x41\xd9\x10\x8d\x5d\x36\x56\x56\xec\x8e\xa1\x6c\x28\xca\xd2\xb5\x50\x28\xad\xa3\x23\x5e\x9c\x09\x9f\x11\x2d\x71\x45\xab\xa0\x24\xb6\xc5\x21\x49\xe1\xa0\x24\x56\xa1\x74\xf4\xc7\x82\x42\x39\xf4\xf9\x5c\x16\x7d\xbc\xeb\xf2\x86\x5c\xcb\x6e\x03\x65\x8b\x2f\x75\xd4\xbe\x1d\xf7\x64\x17\xba\xb2\xe3\xe6\xee\x42\x67\xae\xb3\x0a\xca\x75\xb1\x80\x72\xcd\x6a\xa0\xca\x8d\x9e\xac\xd9\x6d\xc6\xb0\xd0\x2d\x28\x9c\x34\xaa\x90\x79\x03\xd4\x49\xd4\x2f\x74\x7c\xb1\xc0\x7f\x4e\xa0\xcb\xd1\x11\xe8\xac\xe2\xfd\xcb\xa3\x31\xe9\xb6\x22\xf0\x98\x14\x5c\x05\x2d\x37\xc6\x63\x42\xdf\x14\x8a\x7c\xdd\xcc\x83\x97\x81\x42\x3e\x08\x1d\x41\xee\x92\xdc\x15\x7c\x2c\xd6\x77\xf0\x51\x92\x6b\xc9\xad\x1a\xfc\x4b\xd0\xd9\xa2\x04\x9d\x59\xd0\xc2\x81\x96\x19\xfe\x49\x34\xed\x40\x97\x74\x92\x50\x97\x0e\xf4\x5c\xb4\xa0\xe7\x0e\xb4\x42\x40\xa3\x53\xb9\x15\xc9\x8b\x11\x1d\x04\x7f\xf2\xfc\x19\xe8\x5a\xb8\x9c\x5c\x41\xee\x92\xdc\x15\x9f\xbe\x44\x87\x15\xef\x98\xe0\xdd\x91\x77\x07\xda\x69\xd0\xce\x81\x6e\x2d\x54\x5c\x70\x3c\x7a\x5e\xd5\x1e\xff\x02\x9d\x25\x3a\x2e\x27\x97\x22\x1c\xc7\x90\x08\xd9\xad\xd9\xa5\x07\x77\x6b\xc0\x86\xae\xe8\x14\xa2\x91\x73\xc0\x91\x47\x96\x55\xe8\xa2\x27\xfe\x5d\x4e\xae\x20\x77\x45\xc7\x3b\x9d\x13\x11\x58\xe5\x04\x74\x0c\x08\xc1\xae\x20\xd7\x71\xc0\xa9\x15\xfb\xec\x75\x21\xba\x0b\x61\x74\x8d\x20\xc7\x40\x93\x75\x4e\x42\x93\x79\x89\x89\xfc\x9c\x12\x17\x55\x23\x97\xd1\x17\xc1\x5f\x91\x3f\x6f\xf3\xe0\x8b\xe0\xaf\xa0\x21\xed\x4c\x39\x34\xe5\x44\x09\x68\xca\xac\x28\xd0\xc5\x4a\x95\xf4\x2a\xfb\x73\x19\xfc\x16\x9a\x12\x19\xdd\xa6\x24\xcd\xbf\x34\x45\x9b\xd2\x16\x0d\xbb\xaa\x1d\x47\x60\x82\x80\x5b\x86\x18\x9a\x38\x0d\xcd\x8e\x86\x27\x46\xa3\x26\xa5\x80\xa6\xca\x8a\x85\xc5\x8f\xae\xf0\xf3\x28\x62\x8e\xa1\x4e\x87\x68\x04\x56\x59\x04\x0a\x06\xf8\x81\x46\xcf\xcf\xe5\x5d\x8e\xbe\x66\xcf\xb0\x87\x2e\x8b\x96\x33\x72\x04\xb9\x2b\x74\x4b\x72\x28\xa2\x5c\x92\x8b\xd1\x96\x8c\x93\x4a\x52\x86\x16\x80\x15\x34\x4e\x6b\x41\xee\x92\x5c\x8c\xb1\x98\x97\xb3\x19\xc1\xf4\xd4\xd2\x53\x4b\x4f\xbb\x3c\x27\x57\x90\xeb\x38\x40\xbd\x8a\x3e\x7b\x5d\x88\xee\x42\x78\x05\x4d\x67\x9a\xe2\x76\x31\x40\x62\x19\x21\x6c\x13\x82\x74\xff\x54\xf7\x4f\x35\x3d\x75\x0b\x72\x32\x76\x39\x06\xab\xd7\xb9\x45\xc9\x2e\xc7\xe1\xd7\xa2\xb7\x82\x66\x8d\x7c\x66\xa1\xc1\x16\x16\xac\x70\x0b\x74\x2b\x87\x6e\x53\x15\x60\x45\xeb\x82\xdb\xc4\xc3\x32\x25\x3a\xc3\xe9\x60\x2b\x2a\xb0\x4e\x14\x60\x1d\xce\x5d\xeb\x30\x88\xb4\x34\xce\x7e\x3e\x23\xa3\x0b\x70\x48\x97\xe7\x39\xb8\x22\x73\xec\x0a\x72\x97\xe4\xae\xc0\xf1\xbc\x74\x88\x0c\xdc\x42\xe3\x5f\x00\xd6\xc6\x2d\xac\x00\x87\xa8\x81\xb2\x77\xb7\x84\xeb\x5d\xa5\xf0\x6f\x5a\x76\x91\xa5\x71\x88\x34\x5c\x4b\x67\x65\xc1\xb5\x0a\x5c\x5b\x63\x51\x6d\xed\x48\x22\x8b\x45\xb5\x35\xa5\xb1\xac\x15\xca\x8b\x02\x9c\xa7\x5c\x3d\x56\x78\x95\x35\x73\x07\x6e\xe3\x88\x0c\x71\x1b\xc7\x4b\x3c\x02\x6b\x2c\x73\xe3\xb0\x05\xc8\x4c\x4b\x27\x8e\xa0\x13\x63\xe8\xc4\x24\x47\x07\xff\x19\x74\x88\x4e\x3c\xa9\xbc\x93\x76\x05\x25\x9f\x52\xce\x09\xa9\xae\x2c\x7e\xcd\xca\x62\xdb\xae\x71\x5a\xad\x11\x77\x13\xa9\xb2\xd6\x59\x9b\x93\x4b\xd2\x30\x26\x09\xd0\xbd\x83\x40\x06\x90\xc7\xa1\x0c\x22\x8d\x40\xa1\xf0\x2c\x07\xa6\x2b\x28\x10\x22\x43\x12\xc3\x6f\xcc\x39\xa0\x39\x72\x1e\x9e\x69\xf2\xd8\x35\x21\x32\x24\x61\x6d\x10\xbe\xe0\x00\x7b\x35\x07\xd8\x73\x1c\x60\xaf\x61\x37\xbc\x1a\x42\x35\x7c\x94\xf0\xf1\x0e\x3e\x1a\x09\x1f\xcd\x1d\x7c\xcc\xe0\x23\xd6\xfc\x63\x86\xe1\x1c\x3e\xe6\xf0\x11\x6b\xfb\x11\xe3\x4c\x06\x1f\xe7\xf0\x11\x6b\xf8\x71\x8e\x61\x0d\x1f\x35\x7c\xc4\x5a\x7d\xc4\x38\x33\x87\x8f\x05\x7c\x34\x05\x7c\xac\xe1\xa3\xa9\xe1\xa3\x83\x8f\xc6\xc1\x47\x2c\xc6\x34\xf0\xb1\x91\xf0\xb1\x41\xf6\x93\x78\xd0\x3b\x74\x98\x79\x37\x04\x67\x04\xe6\x14\x93\x71\x7c\x4e\x6e\x41\x32\x1d\x02\x39\xbe\x08\xdb\x16\x9c\xd6\x64\xfd\x21\x2d\xac\x1b\xc2\x1c\xaf\xc3\xb9\x2d\xd9\x1a\x8e\xe1\xa7\x86\x92\x3a\x02\xc9\xad\x09\x24\x97\xb7\x40\x1a\xe6\x91\x29\xba\x41\xba\xb9\x41\x0a\x5a\x38\x52\xda\x67\x1a\xf2\x1a\x6c\xd6\x46\x2e\xa3\xef\xc8\xd7\xb2\x09\x7e\x08\xb7\x21\xdc\x72\xd8\xc4\x17\x4c\x7c\xc3\xc4\x57\x4c\x7c\xc7\xc4\x97\x4c\x7c\xab\xb6\xa1\xb8\xda\x0a\x8e\xe9\x4c\x1f\x47\x20\xc7\x72\x04\xeb\x00\x54\xe8\xf9\xb6\x51\x93\x86\x01\x37\x69\x14\x02\x4e\x4d\xf8\x91\x73\x13\x47\x31\xc3\xb3\x10\x87\xd4\xa8\x43\x17\xd7\x64\x51\xad\x0b\x67\xa1\xca\xd6\x0d\xb2\xa4\x6b\xe7\x90\xb1\x6f\xc8\x45\xb8\xf6\x59\x43\xde\x82\x3d\x1d\xfd\x10\x1b\xfc\xca\xdd\x32\x60\x5a\xf6\xf9\xdd\x0e\x03\x9d\x66\xd7\x39\xa8\x6d\xe3\xc0\x16\x0d\xbb\xce\x01\xad\x47\x4d\xf0\x9d\x03\xb7\xe8\xca\xc6\x41\x88\x8d\x91\x2d\x57\x12\xa9\x39\x47\x1b\x6e\x0e\xba\xd0\x08\x8c\x9b\x9b\x00\x60\x49\x6b\x2a\xa4\x5c\x07\x52\x92\xfd\x67\x4f\xa0\x5c\x33\x55\x49\xde\xb3\x27\xb0\x46\x5e\xda\xc3\xda\x05\x0f\x1f\xf6\xcf\xd0\xaf\x9b\xb6\x07\x30\x8e\x33\xec\xf3\x8b\x8b\x93\x69\xb3\x1e\x6e\x8f\x06\x70\x3c\x80\x13\xa8\x32\x77\x1b\x69\x1c\xd3\x2e\x89\x4c\x60\x62\x61\x05\x8d\x5c\xb7\x76\x05\x8d\x32\xce\xd2\xfa\xbb\x76\xec\xe1\x1a\x5d\x11\xd9\x88\x1e\x85\xa8\xa5\x73\x5e\x7b\x3b\x5c\xb4\x32\x81\xab\x11\x36\xdb\x8a\x68\x07\x74\x0d\x13\x0f\x0d\xfb\x6a\x82\x8f\x10\xbb\x8a\x58\x0d\xb1\xec\xa0\xd0\x7c\x6f\x90\x2a\x24\x42\xc5\x54\xe8\x42\x22\xb3\xc4\x32\x83\x90\x98\xbc\x09\x25\x5a\x4e\x70\xd1\xcb\x04\xba\x55\xa7\x3b\xf4\xb1\x78\xc1\xde\x22\xd4\x46\x87\x35\x9b\x17\x64\xf2\xba\xbc\x5f\x4b\x97\xc3\xc2\x89\x20\xce\x41\x41\x73\x50\xf0\x1c\x24\xaf\x11\x61\x0e\x06\x9f\x39\x4d\x2d\x9b\xe0\x87\x70\x1b\xc2\x2d\x87\x4d\x7c\xc1\xc4\x37\x4c\x7c\xc5\xc4\x77\x4c\x7c\xc9\xc4\xb7\x70\xbe\x45\x80\x63\x78\x0e\x0e\x20\xc7\x36\x82\x67\x9f\xc0\xf9\x24\x96\x93\xa6\x07\x78\x1a\x0a\x6c\x1d\x06\x78\xce\x21\xd0\x4f\x55\x11\xa6\x6a\x48\xe3\x42\x8c\x13\x61\xaa\x22\xe0\xe2\x2c\x16\x71\x16\x0b\x9e\xc5\x31\xc7\xa1\x90\x21\xab\x90\x05\xce\x6c\x41\x33\x5b\xd0\x8c\x26\x17\x61\x85\x4d\x5a\x11\x1b\x42\x33\x5a\xf0\x8c\x16\x3c\x93\x45\x9c\xc1\x82\xe7\xac\xa0\x39\x4b\x2e\x52\xd0\xb6\x11\x3c\x2f\x05\xcf\x4b\xf6\x70\x55\xef\xf2\x86\x5c\x27\xc2\x7c\x14\x71\x3e\x8a\x38\x1f\x05\xcd\x47\xee\x5c\x4a\x1e\x00\x07\x0b\xee\xf5\x05\x23\xe0\x05\x3f\x5d\xf0\x33\x2d\xe2\xa8\x13\x5d\x43\xe8\x64\x11\x01\x8d\x40\xa1\xe7\x48\x60\xcc\x15\xf8\x8a\xa8\x05\x5f\xc5\x6d\x3e\xb2\x92\xe7\x2b\x9d\x75\xa6\x58\x20\x50\x67\x02\x7c\x15\x93\x35\x2d\x92\x8a\xe4\xbb\x16\x7c\x45\xd2\x07\xf4\xe8\x92\x9d\xaf\x6c\x67\xc0\x57\x84\x0c\x7c\xc5\x12\x09\x5f\xad\xeb\xb2\x24\xcf\x90\x8c\xb7\x41\x1e\xce\x7b\xb2\xab\x97\x11\x69\x99\x11\x45\x99\x61\x43\x34\x64\x4d\xde\x42\xb3\x60\x36\x61\xc1\x9c\xc5\x22\xf0\x12\x0b\x26\x33\x17\x4c\x99\x2e\x02\x2d\x8a\x34\x77\x80\x90\xba\x76\x61\x12\x21\x75\xaa\xe6\x26\x78\x2b\xf6\xc8\xe8\x84\x5d\x86\x33\x58\x61\xe2\x72\xc7\x1a\xc4\x96\xfa\xae\x30\x2d\xe4\x5a\xd2\x0c\x62\xdf\xb1\xef\x63\x04\x2d\x00\x38\x98\x05\xa2\x62\xc9\x0c\x6e\x13\xed\xb3\x47\x4c\x4e\x08\x20\xa2\x18\xe2\x97\xf0\x83\x38\x83\x3c\x00\xab\xc0\xd9\x2c\x19\x8d\xe5\xec\x09\xf6\x96\xd0\x2c\x2a\xda\x2c\xec\x02\x7e\xcb\xd9\x13\xec\x2d\x19\xdb\xe5\xec\x09\x46\x7a\xc1\x63\xa4\xe7\x02\x0a\x14\x8c\x02\x83\xb7\x22\x4c\xe8\xd6\x79\x0f\x88\x08\x2c\x03\xb0\x8a\x31\xab\x18\x23\x18\xb8\x8b\x6f\xdd\xc5\xb7\xee\xe2\x5b\x77\xf1\xad\xbb\xf8\xd6\xdd\x3a\xa0\xb9\xe0\x21\x97\x41\x04\x28\x09\x90\x1b\x11\x7c\xc7\xbe\x0b\x61\x9c\xc3\xb6\x38\x9e\x70\xd3\xb8\xd6\xaa\x1e\xaa\x08\x52\x7d\x9c\xea\xe3\xe6\x2d\xb1\x40\x05\xcb\xb1\x9d\x2c\x98\x7f\xf3\x91\x69\x23\x46\x39\x97\x90\x49\x27\x4d\x11\x3c\xda\xbf\xcf\xa4\x13\xb2\x08\x5e\x8c\x51\x15\xc5\xdc\xca\xcd\x5c\x9a\xcc\x39\x85\xa3\x3d\xbc\xeb\x93\x97\x7d\x78\xdb\x27\xaf\xfb\xf0\xbe\xff\x32\x03\x9a\xb2\x9e\xa7\xac\x67\x84\xed\x99\x76\xf2\xfd\x0c\xf7\xfd\x14\xf7\x8c\xc5\x3d\x13\x54\x3e\xa0\x76\x1f\xc8\x2c\x1f\xc7\xa8\x8f\x83\xd4\xf7\xa3\xd4\xf7\xc3\xd4\xe7\xb6\xce\x44\x91\x39\x1c\xdb\x49\x40\x24\x81\x72\x3c\x79\x0e\x9e\xc6\xe1\xac\x76\xf8\x7a\x5c\x38\x3c\xad\x0c\x43\x24\x21\x7e\x02\x64\x12\x29\x03\x40\x88\x7e\xb6\x4c\x43\x01\x36\x72\x39\xeb\x96\x43\x20\x42\xba\x9d\x75\x6e\x08\xf4\x90\x4c\xa3\x63\xf6\x69\xe6\x43\xd6\x3b\x39\xcf\xd3\x17\xe7\xb2\x87\xd2\x62\xe6\xb1\x18\xba\x28\x38\xab\x97\x69\x30\xa9\x6f\xff\x60\x9e\x7c\x6b\x9f\xe7\x3c\x69\x95\x3e\xc7\xd6\x76\x72\xa8\x0d\x86\x92\x36\x5c\x26\x4d\xb7\xd3\x46\x33\x97\xb6\x92\x4b\xdb\x66\x99\xb6\x48\xf2\xed\x33\x97\x7c\xbd\x4b\xbf\x7e\x99\x7e\xf3\x72\xf7\x4b\xd3\x72\x92\x2f\x4a\xbe\x34\xa9\x26\x7f\x4b\x4c\x35\x14\x57\x3b\x1c\x55\x91\xba\x1f\x06\x48\x84\x23\x20\x93\x48\x19\x80\x38\x40\x92\x50\x80\xc3\x00\xe9\x03\x11\xe2\x01\xd2\x07\x7a\x48\xa6\xd1\x31\xfb\x34\xf3\x21\xeb\x9d\x9c\xe7\xe9\x8b\x73\xd9\x43\x69\x31\xf3\x58\x4c\x3f\x40\x92\x60\x52\xdf\xfe\xc1\x3c\xf9\xd6\x3e\xcf\x79\xd2\x2a\x7d\x8e\x71\x80\x0c\xa1\xa4\x0d\x97\x49\xd3\xed\xb4\xd1\xcc\xa5\xad\xe4\xd2\xb6\x59\xa6\x2d\x92\x7c\xfb\xcc\x25\x5f\xef\xd2\xaf\x5f\xa6\xdf\xbc\xdc\xfd\xd2\xb4\x9c\xe4\x8b\x92\x2f\x4d\xaa\x19\x07\x08\x87\x86\xe2\x6a\xe7\xe2\x60\x71\x09\x06\xe9\x61\x97\x60\x90\x1e\x76\x3b\x18\x24\x0d\xb9\x14\x83\x0c\x01\x97\x62\x90\x21\xe0\x52\x0c\x32\x04\x5c\x82\x41\x06\xd8\x25\x18\xc4\xa5\x18\x64\x08\xb8\x14\x83\x0c\x01\xb7\x8b\x41\xd2\xa0\x4b\x31\x88\x4b\x30\x48\x0f\xbb\x04\x83\xf4\xb0\xdb\xc1\x20\x49\x28\x69\xc3\x65\xd2\x74\x3b\x6d\x34\x73\x69\x2b\xb9\xb4\x6d\x96\x69\x8b\x24\xdf\x3e\x73\xc9\xd7\xbb\xf4\xeb\x97\xe9\x37\x2f\x77\xbf\x34\x2d\x27\xf9\xa2\xe4\x4b\x93\x6a\x86\x01\x12\x42\x2e\xc1\x20\x2e\x0e\x16\x97\x60\x90\x1e\x76\x09\x06\xe9\x61\xb7\x83\x41\xd2\x90\x4b\x31\xc8\x10\x70\x29\x06\x19\x02\x2e\xc5\x20\x43\xc0\x25\x18\x64\x80\x5d\x82\x41\x5c\x8a\x41\x86\x80\x4b\x31\xc8\x10\x70\xbb\x18\x24\x0d\xba\x14\x83\xb8\x04\x83\xf4\xb0\x4b\x30\x48\x0f\xbb\x1d\x0c\x92\x84\x92\x36\x5c\x26\x4d\xb7\xd3\x46\x33\x97\xb6\x92\x4b\xdb\x66\x99\xb6\x48\xf2\xed\x33\x97\x7c\xbd\x4b\xbf\x7e\x99\x7e\xf3\x72\xf7\x4b\xd3\x72\x92\x2f\x4a\xbe\x34\xa9\x66\x1c\x20\x1c\x22\x8f\xb9\x20\x1f\xa4\x13\xbe\xe7\x12\x7d\xcf\x26\xfa\x9e\x4f\xf4\x3d\x7f\xe8\x7b\xbe\xd0\xf7\x8c\xa1\xef\x39\x43\xdf\xb3\x86\xbe\xe7\x0d\x7d\x2f\xeb\xf1\x3d\x9b\xe8\x7b\xb1\x8f\x1f\xf8\x44\x3f\x30\x8a\x7e\xe0\x14\xfd\x20\x0e\xf2\xcc\x35\x7a\x16\x0b\x79\xe6\x1e\x3d\x8b\x87\x3c\x31\x09\x9e\xb8\x04\x1f\xd8\x04\x26\xb6\x06\x9e\xc1\x07\x46\xce\x07\x4e\xce\x07\x56\xce\x07\x5e\xce\x33\x53\xc1\xaf\xf5\x0c\x86\x67\x16\xcf\x6b\xb1\x64\x8f\x45\x3c\x3e\x91\x50\x44\x18\x53\x47\x10\x79\x35\x62\x6c\x3d\x4b\xac\x3c\x33\x0e\x9e\x25\x57\x9e\x19\x5d\xcf\x12\x2c\xcf\x8c\x83\x67\x49\x96\x0f\x8c\xaf\x0f\x22\x2d\x4f\xfb\x4e\x9e\x84\x32\x3e\x72\x9a\x3e\x88\x3d\x28\x3a\xf8\x5c\x15\x0c\xb3\x4f\x22\x2f\x1f\xf8\x67\x1f\x24\x63\x3e\x8a\xc6\x7c\xe0\xa8\x7d\x90\x91\xf9\x9e\xb5\xf6\xbd\x98\xcc\x47\x99\x0b\x03\xcb\x65\x1f\x93\x05\x28\x24\x27\x61\x9a\xe7\x0d\x31\xdf\x73\xc1\xbe\x67\x83\x3d\x0b\xda\x7c\xe0\xda\x7d\x90\xb8\xf9\xc8\xb4\x79\xe6\xe3\x3d\x8b\xe0\x3c\xf3\xf3\x9e\x45\x71\x9e\xd8\x72\x4f\xc2\x32\xcf\x6c\xac\x67\x3e\xd6\x33\x23\xeb\xfb\xbd\x32\xdf\x6f\x9f\xf9\x7e\xdb\xcc\xf7\x0c\xa1\xe7\x1d\x34\xcf\xdb\x62\x9e\x77\xd2\x3c\x4b\x88\x7c\xd8\x51\xf3\x61\x2b\xcd\xc7\xbd\x34\x1f\x37\xd3\x7c\xe4\x97\x3d\x6d\xa8\x79\xde\x51\xf3\x2c\x1f\xf3\x2c\x20\xf3\x3d\xcf\xe9\x23\xd3\xe9\x07\xd6\xca\x0f\xbc\x95\x1f\x98\x2b\x3f\x70\x57\x3e\xee\xc5\xf9\xb8\x09\xe7\xe3\xae\x9c\x8f\xcc\xab\x8f\xfb\x72\x3e\x6e\xc8\xf9\xb8\x43\xe7\x23\x77\xe6\x1b\x69\x2b\x45\x0d\x1a\x20\xc7\xd0\x84\xc7\x76\x60\x80\x7d\x90\xe7\xf9\xc0\x09\xfb\xc0\x0a\xfb\x20\x11\xf0\x41\x24\xe0\xa3\x4c\xc0\x27\x4c\xb2\x0f\x02\x02\x1f\x24\x04\x3e\x8a\x08\x7c\x60\x9f\x7d\x10\x13\xfa\xc0\x47\xfb\xc0\x48\xfb\xb8\x6f\xe7\x07\x61\x82\x0f\xdc\xb5\x0f\x32\x45\x1f\xd8\x6c\x1f\x84\x8b\x3e\x30\xda\x3e\xf0\xdd\x3e\x70\xdc\x3e\x48\x1d\x7d\x60\xbd\x7d\x10\x3f\xfa\xc0\x7c\xfb\xc0\x8b\xfb\x5e\x1e\xe9\x7b\x7e\xdc\xf7\x0c\xb9\xef\x39\x72\xdf\xb3\xe4\xbe\xe7\xc9\x7d\xcf\x94\xfb\x9e\x2b\xf7\x3d\x5b\xee\x7b\xbe\xdc\xf7\x8c\xb9\xef\x39\x73\xdf\xb3\xe6\x3e\x0a\x42\x7d\x2f\x38\xf1\x61\x5f\xd2\x87\x8d\x49\x1f\xf8\x76\x1f\xa5\x95\x3e\xf0\xf3\xbe\xa9\x71\xd4\x85\x99\x12\xe4\x2d\x3e\xc8\x32\x7d\x14\x66\xfa\x28\xcd\xf4\x41\x14\xe3\x83\x2c\xc6\x07\x61\x8c\x0f\x62\x4e\x1f\xe4\x9c\x9e\xb7\x27\x39\x9a\x3d\x7e\x98\xad\xd8\x13\x9c\x74\x15\xde\x60\x0f\x93\x90\x60\xc1\xf3\xce\xa5\x6f\xc2\x30\x08\xa3\x80\x84\xa7\x3e\xec\x64\xfa\x26\x8e\x89\xb0\x87\xe9\xe3\x26\xa6\x1f\xf6\x2f\xfd\xb0\x6d\xe9\x87\xbd\x4c\x9f\x08\x60\xfd\xb0\x9d\xe9\x87\x5d\x4c\x3f\x6c\x6d\xfa\x44\x44\xeb\x69\xa7\xd2\xb3\xec\xde\xb3\xf0\xde\x47\xe9\xbd\x8f\xe2\x7b\x1f\xe5\x22\x3e\x0a\x46\x7c\x94\x8c\xf8\x28\x1a\xf1\x41\x96\xe8\x83\xac\xdf\x07\xa1\xa2\x8f\x99\x05\xe9\xa2\x8f\x79\x46\xf1\xbf\x0f\xeb\x4a\x58\x56\x58\xee\xe8\x79\x3b\xc0\x63\xe3\x35\xd1\x17\xe0\xa3\x3c\xd2\xc7\x8d\x02\xdf\x4b\x26\x7d\xbf\x67\xe0\x7b\x21\xa5\xef\xb7\x0f\x3c\xcb\x2b\x3d\x6f\x23\xf8\x3b\x69\xe9\x56\x29\x01\x7c\x60\xbb\x29\x34\x0e\xa9\x25\xb5\x0b\x07\x16\x69\x40\x2f\x17\xe9\x93\x24\xc0\x0d\x99\xbe\xee\x77\xde\xf7\x3b\x19\xf8\x9d\x1c\x7c\x92\x45\x89\x53\x7c\x7c\x4c\x94\x43\x0f\x8b\x00\x1f\x8f\x87\x78\x84\x63\xfc\x64\x7c\xdc\xc7\x23\x1c\xe3\xc7\x93\x21\x1e\xe1\x3e\xfd\xf1\x78\x48\x7f\x3c\x1e\xf2\x9f\x0c\xf1\x08\xc7\x78\xd7\xe5\x69\x95\x42\x30\x79\x9a\x56\x2c\x04\x93\xa7\x69\xf5\x42\x30\xcd\x79\xb2\xf3\x34\xad\x27\x26\x4e\xaa\x1a\x82\x69\xb9\x93\x9d\xa7\x7d\x9d\xd3\x0a\xa7\xb5\x4d\xab\x9a\xd6\x33\xad\x64\x5a\xc3\xb4\x7a\x69\xdd\xd2\x8a\xa5\xb5\x4a\xab\xf4\x59\x7d\xd2\x6e\x1d\x82\xc9\xd3\xb4\x62\x69\xff\x72\x30\xad\x5e\xda\xcb\x21\xab\xc9\xce\xd3\xb4\x9e\x69\x77\x0f\xc1\xb4\xdc\xc9\xce\xd3\x58\x67\x93\x0e\x44\x93\x8e\x44\x93\x0e\x45\x93\x8e\x45\x93\x0e\x46\x93\x8e\x46\x93\x0e\x47\x93\x8e\x47\x93\x0e\x48\x93\x8e\x48\x93\x0e\x49\x53\xed\xd4\x2d\xe9\x60\x93\xf6\xb0\x49\xbb\xd8\xa4\x7d\x6c\xd2\x4e\x36\x69\x2f\x9b\xb4\x9b\x4d\xda\xcf\x26\xed\x68\x93\xf6\xb4\x49\xbb\xda\xec\xf4\x35\xb7\x94\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\xaf\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xe6\x82\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x4b\xc7\x95\x13\x60\x45\xe9\xc8\x8e\x9d\x15\xf3\x08\x90\x55\x81\x95\x0d\x0f\x56\x36\x3c\x20\x36\x73\x91\xb0\x9e\xcd\x02\x32\x51\xac\x21\x13\xf5\x1a\xac\x70\x52\x0a\x28\x74\x56\x80\x6b\xb3\x02\xd6\x7c\x55\x6a\x5d\xd8\x4d\xd3\xca\x22\x0f\x50\x91\x17\x11\x6a\x6d\x84\xca\xf8\xb4\x2e\x73\x32\xa8\x54\x75\x1a\xd6\x6e\x91\x8d\xc9\x9d\x3c\x7d\x06\x5a\xaf\x9a\x22\x07\xc7\x9e\x5e\x35\x3e\xd3\xe8\x29\x13\x07\x66\x3f\x4b\x06\xcc\xdd\x63\xf4\x04\x97\xa7\x68\x3e\xc5\x4f\x29\xea\xea\xe3\xfb\xc8\x3e\xa6\x1f\x83\x43\x2f\x0f\x48\x65\xc0\x28\xc3\x80\x18\x5a\x7c\x98\xe0\xc3\xec\x1e\x3a\x87\xd2\xd9\xe2\x8e\x52\xa1\x1f\xc2\x2e\x84\xf1\x79\x53\xd0\x71\xa5\x86\x0c\xd5\x90\x27\xd8\x5b\xb2\xd7\x85\xd8\x2e\x44\x77\x31\x7e\xc5\x7e\xe4\x24\xf2\xc8\x4a\xe4\x91\xc7\xc8\x7b\x26\x23\xc6\x74\x7d\xa2\x2e\x1f\xe2\x62\xb2\x6e\x48\xb7\xea\xd3\xad\x7a\x8e\x25\x46\x71\x0c\x92\xa7\x3d\x7f\x12\x63\x02\xdf\x51\x38\xd1\x03\xcb\x45\x0f\xe9\x00\x0d\x4f\x93\xc7\xe9\xf3\x3e\x1b\xb7\x5a\x05\xa8\x8f\x0a\x31\x62\x48\x26\xc2\x53\xba\x13\xe8\x1b\x5b\x13\xfb\x66\x6b\xe2\xd9\x6c\x4d\x1c\x9b\xad\x5b\xa6\xe1\x33\xa6\xed\x99\xfa\x5e\x64\x4b\xf6\xf8\x99\xe6\x67\x4c\x8b\x2f\x98\x5c\x5f\x20\x81\xde\x6f\x30\x29\x66\xf0\x88\xf9\xc4\x54\xfd\x83\x3c\x0d\xac\xd2\xc0\x4e\xb2\xc0\x3c\x06\xce\x31\x72\x90\x91\x7f\x0c\x4f\x27\x2a\x95\xac\xa8\x44\x5e\x12\x8a\x8f\xb2\x91\x01\x0c\xec\x86\x0f\x8c\x86\x0f\x9c\x86\x0f\x5c\x45\xef\x2f\xc1\xcf\xb3\x76\x21\x79\xd3\x89\xc1\xe5\x00\xd2\x58\x8f\xb1\x58\xa9\x10\x2d\x7a\x70\x39\x80\xd4\xe9\x21\x76\x09\xeb\x2c\xaf\x6d\x0b\x6b\xd6\x41\xbb\x96\x46\xc0\x9a\x18\x19\xe2\xe3\x73\xfc\x18\xc8\x75\xa1\xc8\x29\x20\xd7\x4e\x91\x83\x60\x51\x2a\xad\x31\x14\xfc\xa2\x72\xb7\x18\x0c\x1e\xbd\xe8\x20\xbf\x5b\x28\xa8\x3a\xbd\x86\x46\xc8\x86\x36\x96\xc1\xd6\x96\x2e\xd8\xaf\xc1\x2d\x34\x3a\x76\x0d\x2d\xed\x7b\xb7\x77\xf8\x76\x3b\xae\xdc\x6d\x01\x9e\x64\x0b\x22\xf8\xcb\xb0\xab\x58\xf5\xfb\x8b\xd5\xce\xfe\x62\x79\x3c\x59\x3f\x49\xc3\xcf\x9e\xec\x84\xd5\x67\xcf\x15\x3f\x2f\xea\xaa\xb1\xd2\xf1\x46\x53\x84\x7b\x31\x5f\x37\xc8\xf4\x22\xe8\xc4\xa4\x8b\x52\x3d\xd7\x83\x69\xe2\x34\x75\x9a\x3c\x4d\xdf\x0d\x72\xc5\x6e\x10\x2c\x76\x83\x74\xb0\x0b\xe2\x41\xbe\x42\x85\x49\x03\x94\x88\xf2\xf8\x8b\x62\x88\xbf\x27\x8e\xbf\x9d\x67\xe1\x5b\x4b\xb5\xee\x1a\x55\x51\x0b\xf6\xb0\x1b\x60\x97\xc4\x63\xd9\xac\xe7\x84\x06\x1a\x43\x7d\x9c\xeb\xe3\x42\xba\x2a\x63\xb1\x57\x04\x87\x58\x37\xc4\xba\x41\x9c\xc8\xd5\x0b\x01\xae\x5d\x98\x2c\xe9\x93\x50\x6f\x16\xeb\x1d\x4f\x22\xf4\x2c\xc6\x75\x7d\x5c\x87\x71\x4d\x96\xbb\x25\x0b\xa1\xa2\x2c\x2a\xfa\x21\x7a\x19\xd1\x00\x4d\x66\x86\x82\xf8\x48\x47\x61\x91\x96\x01\x30\xbd\x8c\xc9\xf4\x0f\x4d\xff\x34\xa6\x6e\xe3\xfb\x72\xd9\xbf\xb6\xec\x5f\xeb\xa1\xf8\x74\x19\xa5\x56\x5d\x9f\x41\x0f\xc9\x6e\x28\xb9\x1b\x8a\x1e\xc0\x3e\x41\xd7\x0b\xbf\xba\xbe\xfa\x3d\x24\xbb\xa1\x26\xdd\x50\x95\x01\xec\x13\xc4\x45\x89\x46\xbd\x48\xe0\x28\x2e\x9b\x44\x69\xd9\xa4\x19\x20\x17\xa1\x90\xaa\x8d\xa9\xda\x3e\x55\xdb\xa7\x6a\x39\xd5\x3a\x76\x0a\x43\xcb\x20\xcd\x0a\x3e\xd5\xa3\x52\xc6\x05\xbf\x0b\x52\x22\x11\x64\x53\x22\x48\x97\x96\x21\xbc\x0c\xf2\xa4\x65\x94\x58\xc5\x84\x2e\xa6\x74\x31\xa9\x8b\x69\x5d\x4c\xdc\xf5\xa9\xbb\x3e\x79\xd7\xa7\xef\xfa\x17\x3a\x7e\xa3\x26\x31\x5d\x6d\x79\x3d\xd2\xbc\x3a\xe9\x10\xf2\x21\xe8\x39\x6c\x39\x18\xd2\xda\xf0\xd4\x32\x62\x2f\xb2\xb6\x0d\x58\x39\xc2\x49\xfc\x32\x89\x5f\x86\x85\x20\xae\x07\x2c\x5e\xb2\x46\xd7\x73\x31\x80\x51\xe8\x54\x89\x08\xc4\x18\xd3\x47\x99\x2a\xc8\x7e\x04\x7b\x4b\x12\xfe\x8c\x9f\x90\x8c\x87\x00\x17\x00\x17\x63\x48\x0e\x64\x04\xdd\xde\xa1\x64\x11\x4e\xe2\x5d\x12\xdf\x4b\x92\x42\xae\x01\x1c\x62\xdd\x10\x4b\xf2\x20\x7c\x89\x25\x48\x0c\xf5\x71\xae\x8f\x0b\xe9\xa8\x8d\x42\x4a\x86\x87\xf8\x65\x12\x4f\xab\x9e\x5b\x74\x65\x40\x2b\x04\x3e\x7b\xb2\x9e\x30\xa8\x86\x58\x45\xb1\xb7\x88\x11\x56\xe4\xad\x00\x17\xe2\x15\xdc\x1a\x24\x35\x6e\x6b\x8b\xad\x46\xc0\x0a\x6e\x49\x2b\x81\x8e\x00\xc6\x90\x9c\x29\x5f\xc1\xed\xda\x50\x8a\x75\x6d\x77\xc8\x86\x2a\xa7\xe1\x3e\x84\x57\x13\x9e\x56\xa6\xd4\xaa\x68\x53\x18\xd3\xd1\x61\x2f\x11\x81\x25\xe1\xf8\x49\xc0\xf5\x93\xd0\x35\x93\xe7\xa1\xb3\x26\xcf\xfb\x18\x17\x63\xfa\xc6\x0f\xa9\x02\x38\xc4\xba\x21\xd6\xf5\x54\x42\x53\x1e\x25\x94\x04\x85\xd2\x67\xcb\x9d\x67\xcb\xf4\xd9\x78\xe7\xbd\xf1\xce\x7b\xe3\x9d\xf7\xc6\xcb\xa4\xb3\x62\x81\x69\x70\xe7\xe9\x72\xf7\xe9\xee\xbb\xe3\xdd\x77\xc7\xbb\xef\x8e\x77\xdf\xa5\x72\xfb\x9b\x27\xed\x18\x72\x23\xaa\x5b\x74\x0b\x4d\x6e\x47\xae\xa1\xf8\xda\xa3\xa7\xc5\x1a\x3d\xd7\x22\x29\x92\x8d\x71\x60\x3f\x21\xc8\xc8\x35\x59\x73\xce\xc6\x95\x9b\x8f\x23\x30\x09\x9a\x20\x30\x5d\x84\xe3\x73\x06\x27\xb0\x50\xa6\x9d\x99\xba\x39\xea\xa1\x71\x0f\x0d\x4f\x8f\x7b\xe8\x49\x0f\x3d\xed\xa1\x67\x3d\xf4\x7d\x0f\x3d\xef\xa1\x3f\x0c\x39\x27\x85\x0c\xa5\x8c\x87\x62\xc6\x43\x39\xe3\xa1\xa0\xf1\x50\xd2\x78\x28\x6a\x3c\x94\x35\x1e\x0a\x1b\x0f\xa5\x4d\x86\xd2\x26\xc9\x37\x0d\xa5\x4d\x86\xd2\x26\x43\x69\x93\xa1\xb4\xc9\x50\xda\x64\x28\x6d\x32\x94\x36\x19\x4a\x3b\x1e\x4a\x3b\x1e\x4a\x3b\x4e\x9a\x70\x28\xed\x78\x28\xed\x78\x28\xed\x78\x28\xed\x78\x28\xed\x78\x28\xed\x78\x28\xed\xc9\x50\xda\x93\xa1\xb4\x27\x43\x69\x4f\x92\x1e\x1b\x4a\x7b\x32\x94\xf6\x64\x28\xed\xc9\x50\xda\x93\xa1\xb4\x27\x43\x69\x4f\x87\xd2\x9e\x0e\xa5\x3d\x1d\x4a\x7b\x3a\x94\xf6\x34\x19\x20\x43\x69\x4f\x87\xd2\x9e\x0e\xa5\x3d\x1d\x4a\x7b\x3a\x94\xf6\x6c\x28\xed\xd9\x50\xda\xb3\xa1\xb4\x67\xc7\xa3\x83\xff\x6b\x50\x4a\xa3\x1a\x90\xaa\x01\xab\x1a\x52\xc8\xbe\x20\xdd\xe9\x0b\xd2\x47\xbe\x20\x25\xde\x0b\x52\xb0\x2b\x94\x86\xbc\xd1\xe0\x1a\x0d\xf6\x79\x0e\xf6\x0f\x39\xd8\xf1\x11\x3a\x63\x74\x26\xe8\x1c\xa3\xf3\x04\x9d\xa7\x39\x64\x6b\xc8\xd7\x50\xac\x41\xac\x81\x0e\x3d\x43\xde\x80\x6b\xc0\x3e\x5f\x81\xfd\xc3\x0a\xdf\x46\x67\x8c\xce\x04\x9d\x63\x74\x9e\xa0\xf3\x74\x05\x32\x5b\x83\xcc\xd7\x20\x8b\x35\x48\xb1\x06\xe9\x14\x48\xa1\x40\xe6\x0d\x48\x17\xea\xfc\x5c\x80\xfd\x83\xc0\x9c\xd0\x19\xa3\x33\x41\xe7\x18\x9d\x27\xe8\x3c\x15\x60\xb3\x35\xd8\x7c\x0d\xb6\x58\x83\x15\x6b\xb0\x4e\x81\x15\x0a\x6c\xde\x80\xa5\xfa\x80\xfd\x03\xe6\x81\x59\x60\x0e\x98\x01\xbe\x8f\xaf\x43\xe1\x40\x38\x90\x0e\x4a\x07\x73\x07\x74\xcd\x01\x5c\x7b\x04\xae\x1d\x83\x6b\x27\xe0\xda\x63\x70\xed\x13\x70\xed\x53\x70\xed\x33\x70\xed\xf7\x50\x55\x47\x50\x55\x63\xa8\xaa\x09\x54\xd5\x31\x54\xd5\x13\xa8\xaa\xa7\x50\x55\xcf\xa0\xaa\xbe\x87\x35\x26\x40\x77\x4c\xee\x84\xdc\x63\x72\x9f\x90\xfb\x94\xdc\x67\xe4\x7e\x4f\xee\x73\x72\xff\x40\x2f\x85\x77\xc7\xe4\x4e\xc8\x3d\x26\xf7\x09\xb9\x4f\xc9\x7d\x46\x2e\x95\x35\x7e\x4e\x2e\xbd\x3c\x39\x22\x97\xde\x9d\xd0\xbb\x13\x7a\x77\x42\xef\x4e\xe8\xdd\x09\xbd\x3b\xa1\x77\x27\xf4\xee\x84\xde\x3d\xa6\x77\x8f\xc7\xb0\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xb0\xfe\x1b\xae\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\xbf\xa1\xfa\x6f\xa8\xfe\x1b\xaa\xff\x86\xea\x7f\x47\xf5\xbf\xa3\xfa\xdf\x51\xfd\xef\xa8\xfe\x77\x54\xff\x3b\xaa\xff\x1d\xd5\xff\x8e\xea\x7f\x47\xf5\xbf\xc3\xfa\xdf\x71\xfd\xef\xa8\xfe\x77\x54\xff\x3b\xaa\xff\x1d\xd5\xff\x8e\xea\x7f\x47\xf5\xbf\xa3\xfa\xdf\x51\xfd\xef\xa8\xfe\x77\x54\xff\x3b\xaa\xff\x1d\xd5\xff\x8e\xea\x7f\x47\xf5\xbf\xa3\xfa\xdf\x51\xfd\xef\xa8\xfe\x77\x54\xff\x3b\xaa\xff\x1d\xd5\xff\x8e\xea\x7f\x7b\x04\xb7\x63\xb8\x9d\xc0\xed\x31\xdc\x3e\x81\xdb\xa7\x70\xfb\x0c\x6e\xbf\xc7\xc5\xed\x08\x1d\x5a\x0c\x27\xe8\x1c\x43\x61\x8f\xa0\xb0\x63\x28\xec\x04\x0a\x8b\xe1\x27\x50\xd8\xe7\x20\xec\x11\x08\x3b\x06\x61\x27\x20\xec\x31\x08\xfb\x1c\x5a\x7b\x0c\xad\x7d\x02\xad\x7d\x0a\xad\x7d\x06\xad\xfd\x1e\x2c\xce\x0b\xb0\x13\xb0\xc7\x60\x9f\x80\x7d\x0a\xf6\x19\x50\x3c\xce\xf3\x1c\x2c\xce\x7c\x9c\xf8\x38\xef\x9f\xe6\x60\x9f\xe5\x60\xbf\xcf\xc1\xd2\xec\x5e\x81\xc5\xb9\x8d\x53\x1b\x67\xf6\xd3\x15\xd8\x67\x2b\xb0\xdf\xaf\xc0\xd2\x9c\x15\x60\x71\xc6\xe2\x84\xc5\xf9\x8a\xd3\xf5\x99\x00\xfb\xbd\x00\x7b\xb4\x00\x3b\x5e\x80\x9d\x2c\xc0\x1e\x2f\xc0\x1e\x69\xb0\x63\x0d\x76\xa2\xc1\x1e\x6b\xb0\x4f\x34\xd8\xa7\x1a\xec\x33\x0d\xf6\x7b\x44\x4a\x1a\xec\x1f\x30\x0d\x25\xc4\x94\x63\x4c\x3a\xc6\xb4\x63\x4c\x3c\x7e\xaa\x41\xe4\x20\x56\x20\x04\x88\x25\x88\x16\x04\x7a\x35\x88\x0d\x88\x3b\xb0\xd2\xe5\xe8\xac\xd0\x11\xe8\x2c\xc9\xec\x0b\x05\x09\xac\xd1\xd9\xa0\x73\x17\x2f\x8e\xcb\x65\xc7\x86\x92\xd8\x9c\x34\x29\x80\x61\x35\x30\x4b\x72\x4d\xcd\x3a\xcb\xac\xd4\xa4\xff\xc7\xc9\x39\xac\x2c\xeb\x8e\x2a\x5a\x30\x32\xb3\x50\x66\x16\x90\xeb\x6d\x5a\x3b\x3a\xa8\x64\x9b\x9d\x8c\xee\x05\x3b\x4e\xf7\xd6\x11\x78\x08\xf7\xd8\xe8\x12\x3e\x72\xad\x25\xbf\xad\x6f\xe1\x5e\xb0\x1d\x7d\xcf\xb5\xb6\xc8\x5a\xf2\xb5\xc4\x70\x97\x53\x3a\x5b\xb7\x59\x2b\xe1\x9e\xd4\xaa\x44\xd7\x21\x4c\x6a\x72\xef\xf1\x9f\xec\xfd\x22\x50\xb4\x6b\xf4\x94\x30\xc1\x53\xec\xa3\x6b\xba\x0a\x3d\xca\x51\x61\xc1\x92\x12\xb1\x16\x31\x04\x8c\x87\x7b\x6c\xfb\xe4\x5e\x34\x85\x72\x8f\x4d\x26\xde\xb3\xb2\xa1\x22\xc9\x8f\x76\xa8\xee\xd1\x45\xdc\x7b\x4d\xdd\x50\x02\x0d\xf7\x48\x73\x0c\xab\x57\xba\x27\x24\x29\xee\xbb\xd7\x61\x6d\x33\x3b\xc7\xcf\xca\x8a\x5b\x52\xe3\x73\x8f\x75\x22\xde\x23\x6d\x3d\xf7\x8a\xba\x22\xf5\x8d\xf7\xc8\xbc\x35\xc3\x8f\x0d\xab\xb9\xfb\xd2\xde\xd6\x6c\x76\x75\xfa\xea\x7a\x36\x03\x98\xcd\x7e\x3c\xbb\xbe\xa2\xa8\x8b\xf7\xd7\xef\xde\x5f\xcf\x7e\xba\xb8\x7c\xfb\xf2\x9a\x62\x5e\xbf\xbc\xe6\xd4\xd7\x67\x6f\x4f\x87\x98\xf3\xf7\x6f\x87\xd8\x18\x78\x7f\xfd\x6a\x48\x8f\x81\xfe\x9d\xfe\x49\x9a\x34\x79\x17\x66\xb3\x77\x2f\xaf\xb0\x0e\x64\x13\x97\x8c\x64\x13\xa0\xd8\xcb\x5a\x50\xa4\x9d\x5c\x93\xf1\x73\x4d\x27\x1b\x9c\x2c\x5a\x8e\x10\xd9\x1d\x98\x1a\xdd\xae\x21\x13\x50\x70\x27\xed\x60\x05\x8a\x15\x92\x46\xc3\x54\x4d\x97\x6b\x55\x00\x59\xb7\xec\x9c\x1c\x3f\x43\xf7\x78\x82\xee\xb3\x27\xfd\x3b\x2e\x2a\x13\x92\x73\x56\x56\x9e\xbb\x5a\xb3\x86\x64\x32\xbb\x1d\xb4\xfe\x61\x3b\xd7\x06\x8a\xa6\x63\xc5\x63\x30\x9b\x75\x6d\x39\x7e\x46\x1f\x49\x90\x96\x03\x9c\xf7\xf0\xf1\x64\x80\x74\x12\x1b\x52\x50\x5e\xcf\x07\x30\x64\x48\x70\x78\x95\xe0\x67\x4f\x92\xe4\x47\x55\x1a\x48\x32\x1a\x4f\x9e\xeb\x9d\xd0\x82\x42\x67\x86\x54\x71\x6c\x28\xf0\xdf\xce\xb3\x73\x1e\x15\x0c\x9c\x99\x12\xce\xb3\x73\xc0\x78\xc0\x38\xfe\xbe\xe7\x10\xea\x03\xa1\x2e\x10\xea\x01\xb1\x0e\x11\x90\xd0\x97\xdd\x43\x0b\x1c\x87\x6f\x2e\x5e\x5e\xcf\x5e\xbf\xfc\x17\x95\xc6\xa1\xcb\x8b\xf7\xe7\xaf\x93\xf0\x6c\xf4\x29\x98\x04\xda\x1f\xfd\x91\x4c\x01\x45\x53\xff\x3b\xa6\x80\x12\xd3\x79\x2f\x4e\xc8\xae\xdd\x0d\x1b\xb7\xfb\xee\xc1\x8b\xe9\xf4\x71\x80\xf7\x5f\x9c\x90\x29\xb5\x87\x8f\x6e\x5e\x70\xd4\xc3\x07\x2f\xb6\xfb\x47\x1f\xfe\xb9\xbe\x79\xf0\x22\x7d\x6b\x3a\x7d\xfc\x22\x79\xa9\x79\x77\x83\xde\xce\x7b\x0f\x5e\x3c\x98\x4e\xf3\x51\xb0\x40\x44\x85\xef\xf5\x59\xbc\x7c\xf4\x53\xf6\xa8\xbc\xf9\x6e\xe7\x31\xd6\x2d\x79\x38\x80\xb3\x9b\xef\x3e\xfc\x65\x71\xb3\x4d\x6b\xf0\xe1\xb5\xb8\x6e\x6f\x5e\x60\xdc\xf7\x18\xf7\x3d\xc6\xfd\xb7\xe5\x45\x4d\xc9\xc6\x18\x35\xc6\xa8\x1f\xf3\xff\xdc\xdc\x50\x4d\xd2\x62\xe8\x93\xd2\x02\x1e\x6e\x8f\x38\xc7\x50\xff\xed\x11\x67\xc6\x39\x63\x90\x32\xe2\x5c\x1f\x72\x7e\x37\x9f\x52\xab\x4b\x51\x25\x52\x68\xea\xfb\xa4\x7f\xe8\xc3\x2f\xd3\xe9\x74\x7a\x73\x3f\x14\xfe\x5f\x69\xe4\x7f\x8d\x3e\xdd\xa4\xf6\x99\x82\x76\xa2\xf0\xfe\x2f\xd3\xa9\xfb\x2e\xd8\x5a\x7a\x3c\x7b\x71\x33\x98\x5d\x9a\xed\xfd\xfe\x3f\xfe\xfb\xe3\x17\x37\xdf\xed\x9f\x6c\xa7\x53\xf7\x90\xec\xa7\x45\x73\x83\xf4\xda\xbd\x7b\x5f\x49\x7d\xf2\x59\x71\x88\xe9\x49\x57\xd2\x3d\x6a\xd6\x87\xa3\xaf\x3f\xfc\xdd\xd5\xce\x93\x68\xb9\xf0\xf0\x97\xa9\xfb\x6e\xfa\xf8\xc3\x74\x35\x7b\x74\xf3\xf0\xb7\xd4\x28\x7d\xdd\x84\xdf\x45\xfe\x51\x16\xed\x9b\x3a\x13\xd2\xc2\x4b\xa3\x2a\x5c\x6e\xde\xd6\x5e\xc9\x57\x56\x0a\x44\x38\x57\x5a\x09\xe9\xe0\x27\x52\x28\xe8\xe0\x6a\x91\x91\x02\xdc\xb7\x59\x2b\xad\xca\xb4\x83\x37\xd2\xb8\x9f\x74\x66\x25\xbc\xcd\x9a\x06\x9f\xfd\xfd\xcd\xab\x97\x9d\x50\xf5\xdf\x95\x90\x35\x5c\xb5\xd2\xca\xfa\xb5\x2c\x6a\x2c\xe3\x5d\xad\x4c\xfb\x4a\xd7\x9d\x80\x73\xd9\xae\x6a\x7b\xfb\x92\x4d\xf6\x5d\x92\x42\xad\x57\xc1\x2c\xf2\xa5\x9c\x9f\xae\x1b\x78\xb5\xb0\x75\x95\xfd\x4d\x6e\xe0\xca\xd4\xab\x32\xd3\x1a\xce\x6b\x21\xff\x7a\x05\x57\x8d\x94\xc5\x02\x5e\x2d\x32\xdb\xba\xd1\x81\xfd\x61\xd0\xfa\xa4\x4a\x36\xc5\x46\xcb\xa5\xa8\x83\x4e\x51\xb6\xba\x56\xd6\x96\x95\xa9\x04\x03\xff\x64\x56\x4d\x39\xc8\x1c\xac\x16\xd2\x4a\x36\xb9\x96\x6f\x40\x64\x6d\x36\x58\xef\x8b\xe6\xd4\xc8\x22\x36\x69\x12\x23\xd5\x61\xd1\xf6\x1a\x8f\x19\x50\xa6\x54\x6b\x12\x6c\xa0\x57\xbb\x16\x7d\x36\x1a\xd8\x5a\x29\x13\x03\x64\x64\x7a\x8c\xad\x90\x19\xb5\x63\xdc\x5b\x19\x32\x7c\x63\x65\x45\xba\xa6\xd8\xde\x1b\xa9\x5e\x21\x12\x04\xd7\x0e\x56\x9d\x38\xe8\xf3\x74\xa4\x26\xce\x91\x56\x33\x52\x3d\x4a\x7a\x0f\x49\x11\x90\x5c\x37\xf8\xaf\xc6\xac\xcc\xae\x9e\x4f\x82\x7a\x42\x74\x1b\x68\x14\xae\x53\xf8\x3d\xd1\x3a\x1c\xc1\xac\xdb\x94\x40\x56\x9a\x4b\x91\x41\xab\x6f\x51\x9b\x36\x53\xc6\x41\x93\xcd\x25\x38\x1c\x1d\x90\x67\x4e\x15\x33\x86\x5b\xd5\x6a\x99\xc2\x64\x1f\x8f\x80\x32\x13\x72\xa6\x0c\xfb\x75\xd7\x32\x90\xb5\xac\xdc\x74\x46\x96\x18\xd8\x1e\x03\x69\x9f\x9b\x25\x60\x50\xc5\x28\xd7\x64\xcd\x7a\x65\xb3\xa6\x0f\x90\x4e\x4e\x32\x73\x1c\x62\xc8\x4e\xbe\x9c\xbd\x58\x47\x60\x13\x81\xbb\x17\xd0\x6b\x41\xc7\xe7\x49\x60\x93\x06\xee\x5e\x00\x53\x5e\x98\x28\x42\x9b\x1e\xc2\xc7\xb8\x92\x9b\x39\xe9\x6f\xb3\x85\x96\x20\xb5\x56\x8d\x93\xe0\x1a\x1a\x46\xa4\x18\x90\x6a\xde\xd6\xa4\xa0\x1d\xfd\x65\x97\x09\xf4\x8b\xce\x72\x44\x4b\xfa\xd4\xf3\xac\xb8\xed\xf5\xe5\x99\x56\x9a\xd6\xb1\x12\x4b\xbd\x61\xfb\x7d\x55\xdd\x39\xaa\x4a\x00\x36\x01\xc8\xbb\xb6\xad\x8d\x83\xd1\xc3\xf6\xd3\x41\xf6\xc3\x67\x1a\xe1\xee\x8f\xee\x1f\x48\x72\x15\xa2\x5b\x33\xfa\x74\xa0\x3e\x4f\x13\xd0\xe3\xfd\xd1\x90\xc6\x7c\x91\xe6\x4f\x7f\xa2\x44\x7f\xfe\xf3\xe8\xd3\x41\xfd\xc3\x67\xa6\xed\x46\x8c\xb5\x7e\xcf\x48\xfc\x5f\xb3\x9b\x87\xfb\x61\x21\x7b\xc4\xc1\x07\x2f\x7e\xff\x62\xff\xc3\xa9\x1c\x96\x33\x8c\x1b\x7d\x3a\x70\x3f\xfc\x9a\xff\xed\x64\xc4\x13\x71\xb0\xbf\x6a\x71\x1d\xcd\x6e\x3e\x1d\xe8\x1f\x3e\x57\x43\x77\x72\xf8\x21\x7b\x74\x77\xf3\xe1\x97\xa9\xb9\xf9\xee\xd1\x9f\x0f\x0f\xec\x8f\x27\xbf\x3b\x3a\x90\x27\x87\x18\xa0\xe5\x57\x99\x85\xb4\xaa\xdd\x67\x6d\x74\xa4\x81\xda\x9d\xfc\x2a\xff\xc1\xb6\x42\xed\xa7\x4f\x0f\x6e\xbe\x62\x23\xb7\xcd\xea\xd1\x0d\x19\xc4\xcd\x1e\xdd\xbd\x7c\xf4\xaf\x9b\xe0\x23\xde\x7e\x71\xf3\xdd\x61\xac\x56\x6f\x1c\x97\x0c\xff\x65\x07\xea\xc0\x1c\xe8\x03\x77\x50\x1f\xc8\xc7\xe7\x6f\xbf\x8d\x7b\x97\x9d\xb4\x9b\xaf\xe2\x5f\xc2\x47\xb2\x05\x55\x06\x34\x35\x60\xae\xa0\x41\x9d\xd1\x12\x5b\x9a\xc9\x37\xc0\x79\x41\xaf\x88\x3c\x28\x71\xae\x6a\xd1\x69\x49\x58\xc9\x35\x38\x4d\x49\x17\x5d\x66\x37\x8f\x38\xd8\x2b\x9b\xc7\x7e\x6d\x7a\x9a\xb1\xa8\xb5\x66\x43\xa5\x79\xe6\xe4\xa3\xce\x2a\xa8\xad\x90\xd8\xf5\x45\xdd\x6c\x1e\xf5\xf9\x39\x8e\x07\xba\xc0\x6a\x65\x44\x9f\x64\x11\x20\x4b\x8a\xed\xd5\x04\x06\x0a\x16\xb1\x91\xd6\xf5\x8a\xac\x2b\x22\xbf\x81\xf8\xa6\xab\x72\x8d\x11\xc1\x7e\x01\xe2\x8a\x34\x88\x9d\xc6\x78\x98\x34\x71\x23\x15\x4d\xa1\x5e\xf1\x33\xeb\x41\x67\x3d\xa8\x99\x2b\x90\x21\x32\x73\x21\x23\x14\xd4\x23\xcf\xad\xcc\x68\x9f\x5c\x4b\x52\xaa\x5e\x57\xa4\xc2\xd1\x6e\xc0\x65\xad\x72\xa5\x92\x0e\xdc\x4a\x91\x25\x9b\xcc\x49\xd2\xf3\x1a\xc2\xad\xdd\x04\x1b\x37\x99\x11\xb5\x85\xb6\x86\xce\xf0\xe7\xe0\x22\x28\x0b\x5c\x11\x70\x65\x28\x24\xd4\x25\x22\xf7\xac\xc5\x25\xa4\xc8\x42\xad\x10\x20\xb3\x67\x6c\x67\x36\x28\xbf\x8c\x36\x3d\x4c\x5b\x47\x6c\x4a\xfa\x3a\xa3\x0e\x5a\x52\xb8\x1a\x94\x84\xb2\xae\x6e\x32\xab\x9b\x58\xab\x5c\xbb\x93\xa0\xb9\x78\xed\x4e\xe2\xa2\x24\x35\x5b\xd0\x57\xad\xac\x30\x9e\x94\x7c\x07\x9f\xf0\xc7\xda\x9d\x30\xa9\x8f\x91\x75\x87\x15\x44\x48\x16\xaa\xca\x34\xd2\xcb\x9c\x26\x33\x9b\xf7\x97\x67\x08\xe9\xba\x2f\x81\x8a\x5c\xd0\x01\x04\x77\xc2\xea\x40\xa3\x55\xdc\x11\xe2\x9c\xfc\xe4\x70\xba\x37\x4c\x97\x29\x51\x21\x11\xd1\x24\x68\x62\x7f\x3a\xcd\x8f\x02\x15\xf7\x60\x4b\xa1\x35\x22\x83\xc1\xe0\x33\x45\x7e\x18\x0f\xa4\xe5\x7e\x4f\x11\x23\x19\xbb\xfd\x70\x34\xbb\x19\x28\xd9\xcf\xd0\x14\x53\x6b\x87\xa3\x43\xc4\x06\x23\x42\x06\x14\x1e\x1d\xf6\x06\x9f\xf3\x93\xc3\xfb\xf4\xf8\x7e\xff\xf8\xfe\xfd\xf8\xf8\xa6\x47\x6d\xbd\x4d\xe8\x7b\x64\xa4\x98\xf0\x14\xc6\x07\xfe\x34\xaa\xb5\x3c\x21\x8c\x75\x32\x9d\x3e\xc0\x0a\x8d\x49\x05\x26\xa5\x13\x75\xd1\x66\x8c\x38\xff\x83\x73\x60\x5c\x96\x93\xd9\x50\x79\x32\xfa\x84\xac\xc2\x0f\x1f\xb2\x03\x73\xa0\x08\x77\xe8\x9b\x80\x91\x40\x3f\x2e\x7e\x28\x0e\x12\xc4\xb4\xc6\x75\x64\x74\x30\x5a\x2f\x7b\xd3\xdd\x03\x96\x9a\xee\xa5\x78\xea\x64\xfa\x08\x31\x95\x3a\x39\xdc\x6f\x6c\x5d\x3c\xd8\xee\x67\xb9\xa3\x93\x05\x0f\xb6\xfb\xac\x2a\xdc\x3d\xd8\xee\x13\x19\xf4\x60\xbb\xff\xfb\x07\x3b\xc6\x3e\xdb\x9e\x36\xb1\xc8\xc8\x14\xdf\x42\x65\x77\xb2\x59\x28\xfb\x35\x54\xb6\xd3\x1d\x9f\xd9\x0a\xbf\x9f\xc7\xb5\x88\xa9\xe3\x3c\x2e\x3b\x48\xb9\x0f\x28\x9b\xd4\x9a\xaa\x13\xd3\x69\x4d\xc5\x0f\x4f\xfe\x5b\xfa\xe4\xe6\x13\x92\x7b\x9e\x8a\x20\x75\xa8\xaf\x10\xf7\x7e\x89\xd5\xef\x64\x13\x5a\x0d\x71\xff\x08\xc9\xa9\x20\xc0\x98\xd5\xa4\x9b\x4f\x39\xa2\xaa\x58\xf7\x60\x60\x8f\x1b\xab\x3c\xce\x1f\x32\x5d\x44\xd3\xbd\xc4\x79\x9a\x39\x44\x38\xad\x62\x46\x9f\xb1\x04\xcf\x6d\xb2\x7a\xcc\x76\x8b\x18\x83\x13\x0d\x63\x5c\x0b\xd1\x8c\x51\x6d\x65\x56\x2c\xc0\x49\x5d\x02\x19\x83\x91\x6d\x40\xf6\xca\x39\xd9\x46\xf6\x7e\x30\x77\xcc\xd6\x9e\xc3\x6b\x6c\x4d\x20\x18\x4a\x8a\x02\x98\x5e\x38\xc2\xa8\xce\x4a\x52\x64\xca\x9f\x25\x6a\x22\x19\xc3\x90\x6a\x32\x4b\xfa\xa4\x75\x6d\x24\xd9\x1c\x9e\xcd\x5e\xbd\x61\xc1\x45\x2f\x58\xa1\xe5\x86\x8d\x8a\xb1\xa1\x13\x52\x57\x4b\xa6\x4b\x09\x09\xce\x66\x6f\x4f\xaf\xff\x72\x81\xfc\x2e\x61\x4a\xb9\x2e\x24\xa3\xf7\xb8\x8e\x08\x25\x63\x25\xb0\x6e\xef\xcf\x5f\x5d\x9f\x5d\x20\x53\x2e\x8d\x88\x4b\x06\x19\x62\x22\xd4\x1a\xb1\x6e\xb4\xa9\x35\x98\x7c\x52\x65\xbf\xc2\x74\x06\xdb\x26\x21\x94\x5b\x9b\xa9\x96\x4d\x2e\x45\xe4\x5b\x97\x04\xca\x4c\xd4\x25\xcc\x66\xaf\xcf\x2e\xe9\xb3\xce\x5f\xbe\x3d\xbd\x7a\xf7\xf2\x15\x7e\xdb\x46\x49\x3d\x18\x81\xc2\x8f\xeb\xd0\x21\x0c\xd7\x91\x4b\x1a\x95\x3b\x72\x03\x72\x64\x64\x89\xc4\xfd\x40\xe1\xd3\xc0\xd6\xea\x56\xea\x0d\x74\x86\x81\xd1\x0e\x4d\xf0\x97\x68\x0b\xf8\x70\x3a\xfd\x6e\x74\x30\x9a\x4e\xbf\x3b\x1c\x1d\xfc\xfa\x35\xbc\x10\xcd\xf8\x12\x72\x78\xc0\x6f\xcd\x66\x8b\x4c\xb7\xb3\x68\x71\xec\xf1\xc3\x17\x7f\x1c\x1d\xfc\x6e\x7c\xd0\x13\x2f\x9f\xa7\x18\x1d\xe8\x13\xf9\xf8\xfd\xd9\x65\x14\x32\xec\x90\x6c\x7f\xfa\x70\x7f\x3a\xba\x79\x81\x18\x88\xa1\x3d\x9a\x72\xbf\x60\xc4\x1f\x77\x6c\x0c\xe7\x27\x87\xfb\x27\x27\xdb\x47\x7f\x7e\xf0\x30\xa0\x93\xd9\x74\xfd\x7d\xf9\x68\xba\x2e\xcb\x14\xc1\x0c\x91\xff\x46\x45\x30\x16\x74\xf8\xe1\x8f\xbf\xde\x1c\x1e\xc8\x53\xac\x3b\xd1\x96\x7b\xdb\xe9\xf4\xc3\xf6\x5e\x28\xfa\xfd\xbf\x51\x16\x8c\xa9\x46\x38\x63\x46\x91\xda\x6a\x0f\xec\x4d\xaf\x42\xb8\xd0\x99\x73\x5c\x2a\x81\xc3\x4c\xa5\x1c\x10\xd1\x86\x92\x0f\x3f\x9c\x4c\xf7\xa7\x7b\xa3\x9b\x80\xf4\xff\x76\x32\x0a\xc8\x10\x69\x18\x5e\x39\x1d\xe1\xa1\xf7\xd7\x6f\xa9\x39\xfe\x76\x32\xea\xe9\x19\xca\xed\x8f\x23\xca\x67\xfa\xf8\xfe\xcd\x61\x5f\xfb\x98\xb4\x73\x7d\xa2\x9d\x47\x27\xa3\x1f\x90\x5e\xa6\x5a\x63\x17\x7f\x7a\xf0\xc7\xff\x37\x00\x00\xff\xff\x17\x0d\xe0\xf6\x85\x09\x08\x00"
-
-func assetsVendorsHighlightjsJsHighlightPackMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsHighlightjsJsHighlightPackMinJs,
-		"assets/vendors/highlightjs/js/highlight.pack.min.js",
-	)
-}
-
-func assetsVendorsHighlightjsJsHighlightPackMinJs() (*asset, error) {
-	bytes, err := assetsVendorsHighlightjsJsHighlightPackMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/highlightjs/js/highlight.pack.min.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesConfigMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc9\x6f\x5b\xd5\x17\xfe\xe2\xb8\xce\xf0\x1b\xda\x5f\x7f\x2d\x48\x0c\xd2\x01\x41\x69\x11\xaf\xc4\x65\x50\xe5\xd6\x4d\xe9\x24\xb5\x34\x08\xa5\x29\x3b\x16\x37\xf6\x8d\xf3\xe0\x0d\xee\x7b\xcf\x69\x23\x75\x91\x41\x6d\x41\xa9\x88\x98\xa4\xaa\xea\x82\x88\x7f\xc0\x75\xe3\xd6\x71\x6a\xe7\x1f\xe8\xe2\xdc\x35\x12\x0b\xc4\x92\x05\x6c\x60\x85\x84\xee\xbb\x8e\x87\x28\x01\x21\x71\x37\x77\x38\xdf\x39\xe7\xfb\xbe\x7b\xdf\xfb\x7e\x6f\xf2\x6b\x00\x78\x0a\xc0\x73\x00\x9e\x00\x78\x1e\xc0\xf5\x3e\xc4\xe3\xe7\x3e\x80\x00\xfc\xd2\x07\x3c\x03\x60\x6f\x02\xd8\x0b\xc0\x4a\x00\xfb\x00\x9c\x6c\xcd\x97\x13\xc0\x41\x00\x1f\x25\x80\x03\x00\xbe\x4c\x00\xaf\x00\xa8\x27\x80\xff\x00\xf8\x21\x01\xbc\xa4\xeb\x25\x80\xff\x03\xd8\xdf\x0f\x1c\x05\x70\xa8\x1f\x38\x09\x60\xb2\x35\x7f\xdb\x0f\xfc\x0f\xc0\x8f\xfd\x86\xc7\xaf\xfd\x86\xd7\xbe\xa4\xa9\x93\x4e\x9a\xfe\xa3\xad\x79\x3c\x69\xea\xc9\x24\x30\xda\x07\xcc\x26\x81\x0b\x00\xd6\x76\x01\x23\x00\x92\x29\xc3\xef\xad\x94\xc1\x5f\x68\xcd\x1f\xa6\x4c\xbc\x98\x02\x5e\x05\xb0\xd2\x9a\x9f\xa4\x80\xff\x02\xf8\x2d\x05\x9c\x06\x90\x1a\x00\x9e\xd6\x79\x03\xc0\x5d\xcd\x73\x00\x98\x06\xf0\xfb\x00\x10\x01\x98\x1a\x04\xf6\x00\xf8\x6e\x10\x78\x11\xc0\x4f\x83\x46\xff\xee\x21\xd3\xe7\xed\x21\xe0\x5f\x00\xce\x0f\x01\xcf\x6a\x9f\x86\x80\xfd\x00\xae\x0c\x01\xda\xe2\xdd\x00\x06\x60\x34\xe8\xa1\xb5\xa7\x5a\x6b\xcd\x5b\x6b\x4e\x00\x18\x42\xef\x18\x04\xd0\x0f\x53\x3b\xd9\xba\xbf\x7f\xc3\xf4\xdc\xd3\xc2\xe8\x3e\xbb\x00\x0c\xc3\x68\x8a\x47\xce\xf7\xa6\xec\x42\xf2\x1d\xc7\xf1\xaf\x52\x29\x94\xe4\x8a\x30\x92\x01\x89\x90\x42\x47\xcc\xc8\x4d\xc0\x29\xe1\x08\x2f\x27\x03\x9a\xf2\x03\x13\x09\x37\x43\xa7\x7d\xcf\x93\xb9\xc8\xf6\xbd\xf6\xd1\x99\x4b\xef\x91\x3f\xd5\xaa\xb5\xe5\xb0\x37\xf9\x8c\x2d\x1c\x99\x8b\x48\x7a\x39\x3f\x6f\x7b\x85\xb8\xbe\x3b\x1b\x5e\x71\x28\x1f\xd8\x33\x5d\xd9\x6d\x60\xc1\xf6\xe4\x5f\xc2\x66\x64\x10\xda\xbe\x67\x70\xe1\x36\xb8\x9e\xdd\x45\x59\x10\xb9\x59\xca\x8b\x48\x44\xb3\x45\x19\xd2\x55\xdb\x71\x68\x52\x6a\x47\xf2\x9b\xa0\xb1\x58\x8d\x75\xa9\xdb\x96\x31\x71\xcd\x76\x4b\x2e\x09\xd7\x2f\x79\x91\x96\x17\xd9\xae\x24\x41\xb9\xb6\x29\xe4\x8a\x59\x5d\x2a\x90\xbd\xc5\x4c\xa2\x57\x72\x27\x65\xa0\x13\x3b\x19\x21\xd9\x1e\x45\xd3\x92\xec\xbc\x23\xbb\x2b\x15\x7d\xdf\xf9\x5b\x05\xfc\xa2\xf4\x76\x2c\x60\x17\x02\xd1\x73\x6b\x9d\x13\x0a\x73\xd3\xd2\x15\xe4\x09\x57\x6e\x13\x8d\xc4\xa4\x23\x7b\x82\xe3\xc2\xcb\xfb\x6e\x7b\xe7\x97\xbc\x3c\x05\xfe\xa4\xed\x6d\x1e\x89\x7c\x9e\x44\xcf\x93\xb2\x22\x31\x99\xd4\x8e\x4f\x8a\x50\x62\x5c\x16\xfd\x20\xb2\xc6\xc2\x82\x9d\xb7\x4e\x95\x0a\xa1\x35\xe1\x67\x28\x2f\x67\x4e\x7e\x6c\x4f\x0b\xd7\x3f\x1c\x94\x86\x2f\x8a\x30\xb2\x26\x02\xe1\x85\x8e\x88\xfc\x20\x43\xef\xc6\x21\x1a\x2b\x05\xc2\xf5\xf3\x3e\x1d\xef\xc1\x9f\x18\xbe\x28\xbc\x42\x49\x14\xa4\x35\x21\x85\x9b\xa1\xf6\x3e\x43\xe3\xa5\x30\xb4\x85\x37\x3c\x76\x7e\xec\xac\xf5\x81\x79\x2b\x19\x4a\x1f\x1e\x19\x3e\xed\x7b\x91\xf4\x22\x6b\x62\xb6\x28\x33\x14\xc9\x6b\xd1\xeb\x45\x47\xd8\xde\x31\xca\x4d\x8b\x20\x94\x51\xf6\xf2\xc4\x39\xeb\x68\x07\xa7\xf9\x4c\xc9\xc0\x3a\xdb\x7a\xc1\x19\x1a\x7e\xdf\x29\x05\xc2\xb1\xce\xf9\x81\x1b\x66\xc8\x2b\xc6\xdb\x30\xfb\xc6\x31\x32\xcb\xac\xf7\x72\x7a\x24\x9b\x4d\xd3\x81\x03\xa4\x97\x23\x2f\x64\xd3\x69\x1a\xa5\x11\xca\xc4\xfb\x13\xd9\x23\x9b\xa1\xe3\xd9\x37\xf5\xf2\x60\x0c\x3b\x9e\x1e\xa1\xeb\xd7\x4d\xca\x89\xec\x91\x91\x43\x34\x4a\x69\xca\xd0\x91\x63\xe0\x6f\xb8\xcc\x8f\xd4\x1c\x57\xd5\x27\x5c\x53\x0b\xea\x36\x71\x4d\xcd\xf3\x06\x37\x79\x5d\xdd\xe6\x47\xdc\xe4\x0a\x97\x4d\xe0\x31\x97\xd5\xbc\x5a\xe0\xaa\x9a\x23\xae\x73\x99\xeb\xa4\xe6\x79\x9d\xab\xbc\xc6\x15\xf0\xe7\x5c\xe6\x75\x2e\x73\x43\xcd\x73\x4d\xcd\xe9\x4c\xf5\x29\xd7\xb8\x4e\xbc\xca\xeb\x6a\xb9\x0b\xac\x63\xe0\x15\x6e\x72\x95\x57\xb9\xc6\x0d\xae\x72\x83\x6b\x6a\x19\xfa\x6b\xef\x6e\xc4\xe5\xf8\x68\x6b\xea\x5d\x6e\xea\x44\xd3\x45\x73\xd9\xec\x61\x3e\x6e\x5e\xd5\x99\x31\xdc\xd4\xe0\xaf\xb8\xc2\x35\x7e\xc8\xcd\x0e\x9d\x9d\xa0\x5f\xe8\x45\xac\x61\xb9\x0d\x0d\x77\xa8\xda\x7d\x00\xbe\xd3\xed\x9c\x5a\x54\x9f\xa9\x05\x35\xaf\x85\x2f\xc6\x72\xca\xda\x67\xae\x68\xa7\xb9\x4a\x6a\x81\x6b\xbc\xa1\x96\x74\x8f\x32\x37\xb8\xa1\x96\xd4\x0d\xf0\xbd\x8e\x76\x8b\x57\x3a\xee\xde\xd3\x7e\x6b\x56\xda\x9d\xb8\x43\x43\xdb\x47\x5c\x89\xab\x3e\x56\xcb\xaf\x11\x57\x28\xce\xbc\x65\xec\xd4\xd1\x3a\x37\xd5\x02\x37\x63\xa3\x1e\x70\x53\x1b\xb9\xc5\x74\x8d\x7a\xcc\x4d\x7e\xc8\x55\xb5\x40\x7c\x5f\x2d\x99\xdb\xde\x88\x2f\xd0\xe4\x36\xb8\xb9\xed\xbb\xd0\x81\x3f\xa1\x56\xd7\x68\xae\x69\x3e\xb1\xa6\xca\xf6\x04\xd6\x34\x71\xde\x50\x8b\x5a\xad\xf9\x7b\x6d\x87\xfa\xa7\x1b\xc5\xce\xd4\xd5\x5c\x2c\x78\x49\xdd\xd8\xb1\x69\x8d\x1f\xe8\x7b\x56\x37\xb9\xc6\x35\xf0\x1d\x6d\x36\xa9\x79\x75\x23\xf6\x7d\x49\xdb\xd7\x0d\x59\x6b\x43\x16\xb8\xcc\xf7\x63\x62\x37\xb7\x85\xad\xf0\xba\x5a\x54\xb7\xe2\x27\xd4\x50\x4b\xbc\x86\xee\x5f\x20\xaf\x72\x93\xef\x73\x59\xbf\xdc\xf8\x4a\xb6\x7e\x6c\x8f\xcc\xb3\xef\x3c\x9e\x3f\x02\x00\x00\xff\xff\xea\xa1\x1e\xa6\x87\x09\x00\x00"
-
-func localesRuLc_messagesConfigMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesConfigMo,
-		"locales/ru/LC_MESSAGES/config.mo",
-	)
-}
-
-func localesRuLc_messagesConfigMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesConfigMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/config.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesDatabaseMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\x3d\x6f\x13\x4d\x10\xc7\xff\xb9\x27\x4f\x0a\xf3\x2a\x24\x3a\x8a\x41\x82\x08\x8a\x0b\x77\x06\x24\x74\xf6\xd9\x08\x48\x24\x14\x5b\x42\xc9\x41\x45\xb3\x89\x97\xf3\x09\x7b\xd7\xda\xdd\xe3\x45\x72\x01\x41\xa4\x09\x0d\x05\x1d\x12\x82\x0a\x1a\x64\x04\x12\x16\x10\x3e\x01\xc5\x5c\x47\x45\xc5\x07\x41\xf6\x39\xa0\x4c\x33\xff\xdf\xce\x6f\x56\xf3\xeb\xd8\xfc\x0b\x00\x58\x00\x70\x02\x40\x0b\xc0\x01\x00\x43\x94\xf5\x16\xc0\x3c\x80\x77\x00\x0e\x01\x78\x0f\xe0\x08\x80\x6f\x33\xef\xc7\xac\xff\x04\xd0\x9c\x03\x7e\x03\x38\x0a\xe0\x94\x07\x1c\x07\x70\xd1\x2b\x79\xd5\x03\x0e\x02\xb8\xed\x01\x87\x01\x64\x1e\x30\x37\xfb\xfb\x3f\x00\xff\x63\x7f\x2d\xcc\xba\xb7\xf7\xb0\xde\xd5\xf7\xd1\x97\x2a\x9f\xbf\x26\x9c\xd8\x10\x56\x96\xd4\xce\x52\x23\x5c\xa6\x95\x2d\x79\xdd\x09\x97\xcf\x72\x22\x36\x7a\xd2\x62\x4d\x0e\xb4\x71\x7e\xdb\xa6\x59\xc7\xbf\x92\xa7\xd6\x4f\x74\x44\x1d\x79\xef\xf2\xdd\xac\x2b\xfa\x7a\xc9\xe4\x95\x96\xb0\xce\x4f\x8c\x50\xb6\x27\x9c\x36\x11\xad\x4e\x47\xd4\xce\x8d\xe8\xeb\x8e\xa6\xfa\x3e\xbf\x51\x69\x09\x95\xe6\x22\x95\x7e\x22\x45\x3f\xa2\xbf\x1c\xd1\x5a\x6e\x6d\x26\x54\xa5\x7d\xbd\xbd\xec\xdf\x92\xc6\x66\x5a\x45\x14\x2e\x05\x95\xab\x5a\x39\xa9\x9c\x9f\x3c\x1c\xc8\x88\x9c\x7c\xe0\xce\x0d\x7a\x22\x53\x35\xda\xec\x0a\x63\xa5\x8b\x6f\x26\x2b\xfe\xa5\x7f\xde\xe4\x9e\x3b\xd2\xf8\xcb\x6a\x53\x77\x32\x95\x46\x54\xb9\xd1\xcb\x8d\xe8\xf9\x2b\xda\xf4\x6d\x44\x6a\x30\x45\x1b\x9f\xaf\x51\x19\x63\x75\x3a\x0c\xe2\x38\xa4\xc5\x45\x9a\xc4\xe0\x64\x1c\x86\xd4\xa4\x80\xa2\x29\x37\xe2\xea\xde\xa8\x1e\x5f\x98\xc4\x33\x53\xad\x1e\x06\x34\x1c\x96\x2b\x8d\xb8\x1a\x9c\xa5\x26\x85\x14\x51\xb5\x06\x7e\xc5\xdf\xf9\x0b\x8f\xf8\x33\x8f\x8a\xad\xe2\x19\xf8\x79\x09\xc4\x9f\x78\xc4\xbb\xbc\x5b\xec\x14\x4f\xc1\x2f\x79\xcc\x1f\x8b\x47\x3c\x2a\xb6\x79\xcc\x63\xf0\xeb\x62\x6b\xba\xf1\xa4\x78\x0c\x7e\xc3\x23\xfe\xc0\x5f\x79\x5c\x6c\x17\x3b\xf8\x13\x00\x00\xff\xff\xc4\xb4\xcb\x62\x78\x02\x00\x00"
-
-func localesRuLc_messagesDatabaseMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesDatabaseMo,
-		"locales/ru/LC_MESSAGES/database.mo",
-	)
-}
-
-func localesRuLc_messagesDatabaseMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesDatabaseMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/database.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesMigrationsMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\xdf\x6b\x5b\x65\x18\xc7\x3f\x39\x4d\xfc\x11\xe7\x8f\x29\xe2\x86\x22\xef\xc0\xcd\x29\x66\x26\x9d\xa0\xa4\x4d\x6b\x6d\x37\x28\x2e\x38\x6a\x15\xbc\xf3\x98\x9c\x66\x87\x9d\x9c\x13\xce\x39\x71\x1b\xec\xa2\xad\xe8\x2a\x16\x44\x50\xe8\xc5\x70\x08\xe2\x75\xa9\x8d\xa6\x5b\x9b\xdd\x7a\xf9\xbc\x37\xe2\x95\xe0\xa5\x7f\x80\x97\xa2\xbc\xe7\x64\xcd\x92\xb9\xfa\x42\x78\x9f\xe7\x7d\xbe\xcf\xf7\xf9\x3e\xdf\x24\xbf\x3f\x99\xfd\x06\xe0\x30\xf0\x1c\xf0\x3d\xf0\x0c\xf0\x4a\x86\xe4\xac\x65\xe0\x41\xe0\xf3\x0c\xe4\x81\xaf\x32\xf0\x30\x70\x3d\x03\xc7\x80\x1f\x32\x70\x04\xf8\x25\x03\xcf\x02\x7f\x64\xe0\x79\xe0\xef\x0c\x3c\x64\x38\x2d\xb0\x80\xe3\x56\xda\x7f\xd2\x82\x47\x80\xd3\x16\x8c\x01\xd3\x16\x1c\x02\x66\x2d\x78\x01\x38\x6f\xa5\x3c\x6d\x0b\x1e\x30\xf3\x2c\x98\xce\xc0\x86\x05\x4f\x00\xbf\x8d\xc1\xd3\xc0\x9f\x63\x70\x14\xf8\x67\x0c\xa6\x81\xa3\x59\x18\x07\x3e\xc8\xc2\x3c\xb0\x9e\x85\x19\xe0\xd7\x2c\x3c\x06\x1c\xc9\xa5\x3a\x5e\xcc\xa5\x3c\x2f\xe7\xe0\x29\x60\xa2\x9f\xbf\x93\x4b\xf7\xfe\x30\x07\x73\xc0\xe5\x1c\xbc\x0e\xec\xf5\xeb\x7f\xe5\xa0\x6f\x49\xa2\xfb\x30\x83\x73\xa8\x7f\x1b\x9f\xcc\x8e\xc6\x1f\xa3\xdd\xec\x9f\x25\x9d\x6f\x38\xcc\xde\x46\xc3\xa3\x77\xf5\x3e\x0e\xe4\x4c\x30\x53\x8b\xdd\xc0\x8f\x98\x69\xb5\x3c\xd7\xa9\x2b\x3b\x4e\xc2\x2b\xca\xf6\xbc\x7e\xd4\x74\x1b\xa1\x6d\x50\xea\x78\xa4\x96\x82\xd0\x5c\x51\xbb\x56\x73\xa2\x68\x14\x31\x28\xcc\x06\xfe\x92\x1b\x36\x95\x7d\x87\x6c\x00\xda\xaf\x85\x81\xe7\x7d\x64\xd7\x2e\x8e\x94\xe7\x82\x4b\xbe\x17\xd8\x75\xe6\xe7\xa8\xee\x33\x53\x0d\xea\xee\x52\x5f\xe2\x82\x7d\x89\x85\xbb\xba\x07\xc9\x41\x62\xef\x05\x0d\x6a\xef\x06\xed\xb0\xe6\xb0\xe0\xb4\x82\x30\x2e\x54\xa3\x86\x5b\x2f\xbc\xd5\x6e\x44\x85\xc5\xa0\xac\xea\xce\xc7\x6f\x5e\x74\x2f\xd8\xcd\xe0\x54\xd8\xce\x9f\xb3\xa3\xb8\xb0\x18\xda\x7e\xe4\xd9\x71\x10\x96\xd5\xdb\x49\x49\x55\xdb\xa1\xdd\x0c\xea\x81\x9a\x1c\xc2\x4f\xe5\xcf\xd9\x7e\xa3\x6d\x37\x9c\xc2\xa2\x63\x37\xcb\x6a\x3f\x2f\xab\x85\x76\x14\xb9\xb6\x9f\xaf\xce\x57\xcf\x14\xde\x77\xc2\xc8\x0d\xfc\xb2\x2a\x9d\x2a\xe6\x67\x03\x3f\x76\xfc\xb8\xb0\x78\xa5\xe5\x94\x55\xec\x5c\x8e\x5f\x6d\x79\xb6\xeb\x4f\xa8\xda\x05\x3b\x8c\x9c\xb8\xf2\xde\xe2\xd9\xc2\x1b\x03\x9c\xd1\xb3\xe4\x84\x85\x33\x7e\x2d\xa8\xbb\x7e\xa3\xac\xf2\xe7\xbd\x76\x68\x7b\x85\xb3\x41\xd8\x8c\xca\xca\x6f\x25\x69\x54\x39\x3d\xa1\xd2\xb0\xe2\x1f\x2f\x15\x2b\x95\x92\x3a\x71\x42\x99\xb0\x78\xac\x52\x2a\xa9\x69\x55\x54\xe5\x24\x9f\xaa\x8c\xdf\x29\x4d\x56\x5e\x33\xe1\xc9\x04\x36\x59\x2a\xaa\xab\x57\xd3\x96\xa9\xca\x78\xf1\x25\x35\xad\x4a\xaa\xac\xc6\x27\x90\xaf\xa5\x23\x3b\x7a\x45\xaf\xca\x96\x74\xf5\x97\xc8\x0d\xbd\x2c\x5d\xd9\x95\x8e\xec\x25\x9f\x4d\x25\x5b\xc3\xaf\x5d\xbd\xaa\xd7\x95\x6c\xe9\x15\xe9\x20\xd7\xa5\x2b\x3f\xea\x65\xd9\xd4\x9f\x19\x02\xf3\xfd\xc9\xb6\xdc\x4a\x23\xfd\x89\x5e\x91\xdb\xd2\xd1\x6b\xb2\x27\x3d\x25\xb7\x47\xc9\x47\xfa\xa5\xfb\x7f\x2d\xfa\x0b\xe4\x86\xf4\x64\x3b\x51\xdc\xd1\xcb\xf2\x93\x6c\xa7\xb2\xa4\x73\xef\x00\xa3\x28\x55\xaa\x3f\x55\xb2\x3b\x34\x6b\xe7\xbe\x4c\xa6\xa9\xa7\x57\xe5\xa6\x6c\xea\xd5\xc4\x81\xfb\x32\x7c\x97\x80\xae\x19\xa0\x5e\x37\xff\x80\xd1\x85\x90\x0d\xf9\xf9\x3f\xfd\xec\xe9\x15\xd9\x35\x73\xf4\x32\xf2\xed\xfe\xb8\x61\x7b\xf7\xdf\x47\x67\x77\x87\x9d\x4e\x36\xef\xe9\x35\xe9\xc8\xad\x11\x0f\x0f\x20\xd9\x39\xb8\x6f\x23\xf9\x61\xf4\xf4\xb5\xc4\xde\x9b\xfc\x1b\x00\x00\xff\xff\x81\x1f\x3b\x9d\x06\x06\x00\x00"
-
-func localesRuLc_messagesMigrationsMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesMigrationsMo,
-		"locales/ru/LC_MESSAGES/migrations.mo",
-	)
-}
-
-func localesRuLc_messagesMigrationsMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesMigrationsMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/migrations.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesStatusMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xcf\x6b\x13\x41\x1c\xc5\x5f\xd7\xda\xca\x2a\x1e\x3c\x78\x52\xf8\x7a\xb0\xa8\xb0\x75\x37\x7a\x90\x4d\x36\xf1\x07\x29\x88\x8d\x48\xb2\xea\x79\x48\xc6\x74\x71\x33\x13\x66\x66\x43\x85\x1e\x9a\x78\xed\x55\xf0\xe0\xc1\xfe\x07\xa5\x50\x68\x09\x56\xf0\xe6\x45\x66\xff\x00\xbd\x78\xf3\xaf\x90\xdd\x44\x43\xdf\x61\x79\x6f\x3f\x6f\xe6\xcd\xaf\x2b\xcb\x1f\x00\x60\x15\xc0\x35\x00\x31\x80\x8b\x00\xf6\x30\xd3\x31\x80\x0b\x00\x4e\x00\xac\x00\xf8\x06\x60\x19\xc0\x77\x00\x97\x01\xfc\x00\x70\x1e\xc0\xcf\x39\xff\x0d\xa0\xb1\x04\xfc\x01\x70\x15\xc0\x1d\x07\xb8\x04\xe0\x91\x33\xbb\xe7\xb9\x03\x5c\x07\xf0\xda\x01\x5c\x00\xd9\x9c\xef\x3a\xc0\x12\x80\x73\xf3\xdd\xd5\xf9\xce\x0a\x16\x72\xe6\x5b\xa5\x9a\xdb\xbc\x9b\x19\xa9\xd0\x62\xda\x70\x85\xb6\x4c\x39\x3a\x5c\x8d\xb8\xd2\xa4\x0d\x33\x99\x46\x27\x65\x23\x8e\xce\x2c\xb4\xf9\x50\x2a\xe3\xb5\x74\x3f\xe9\x79\x8f\xb3\xbe\xf6\x62\x19\x52\x8f\x8f\x1e\xbe\x4d\xb6\xd8\x40\xae\xab\xcc\xdd\x64\xda\x78\xb1\x62\x42\xa7\xcc\x48\x15\xd2\xb3\x12\x51\x2b\x53\x6c\x20\x7b\x92\x6a\x67\xfa\x75\x77\x93\x89\x7e\xc6\xfa\xdc\x8b\x39\x1b\x84\xf4\x3f\x87\xd4\xce\xb4\x4e\x98\x70\x5b\x4f\x5b\x4d\xef\x15\x57\x3a\x91\x22\xa4\x60\xdd\x77\x9f\x48\x61\xb8\x30\x5e\xfc\x6e\xc8\x43\x32\x7c\xdb\xdc\x1d\xa6\x2c\x11\x55\xea\x6e\x31\xa5\xb9\x89\x5e\xc6\x1b\xde\x83\x45\xaf\x78\xcf\x1b\xae\xbc\xa6\xe8\xca\x5e\x22\xfa\x21\xb9\x2f\xd2\x4c\xb1\xd4\xdb\x90\x6a\xa0\x43\x12\xc3\x32\xea\xe8\x5e\x95\x66\x36\x12\x37\x03\x3f\x8a\x02\x5a\x5b\xa3\xc2\xfa\x37\xa2\x20\xa0\x06\xf9\x14\x96\xb9\x1e\x55\xfe\xa1\x5a\x74\xbf\xb0\xb7\xca\x5a\x2d\xf0\x69\x67\x67\x76\xa4\x1e\x55\xfc\xdb\xd4\xa0\x80\x42\xaa\x54\x61\x3f\xe6\x63\xfb\xd5\x9e\xda\xa9\xfd\x62\x8f\xf3\x89\x3d\xb2\xd3\x7c\x0f\xf6\x93\x3d\xc8\xc7\x45\xcc\x77\x61\x3f\x17\xbc\xf8\xbb\x9f\x4f\xec\x41\x3e\xc9\xdf\xe7\x63\xca\xc7\x05\xb4\x87\xe5\xf7\xd4\x1e\xc2\xee\xdb\xa9\x3d\xb2\x27\xa5\x5d\x14\xf1\x37\x00\x00\xff\xff\xcc\x43\x3d\x0d\x8d\x02\x00\x00"
-
-func localesRuLc_messagesStatusMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesStatusMo,
-		"locales/ru/LC_MESSAGES/status.mo",
-	)
-}
-
-func localesRuLc_messagesStatusMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesStatusMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/status.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesTablesMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x3f\x8e\xd4\x30\x18\xc5\x5f\x60\xa1\x48\x41\x41\x4d\xf1\x51\xb0\x82\xc2\x4b\x32\x50\x20\x4f\x3c\x83\x40\xbb\x12\x62\x23\xa1\x25\x50\xd1\x98\x89\xc9\x44\x24\x76\xb0\x1d\xb4\x48\x2b\xba\xa5\xa1\xe6\x00\x70\x82\xa1\x40\x42\x82\x96\xde\x73\x01\xb8\x0a\x9a\x4c\x00\xb1\x2e\xec\xf7\xf3\x7b\xdf\x9f\x9f\x97\x77\x3e\x00\xc0\x0e\x80\x2b\x00\x32\x00\x17\x00\x3c\xc3\xf6\x74\x00\x2e\x02\x78\x35\xfe\x1f\x8f\xfc\x16\xc0\x3c\x02\x4e\x01\xc4\x00\x7e\x44\xc0\x25\x00\x61\x7c\x7f\x45\x40\x34\xf6\x38\x07\xe0\xfc\x38\x03\x8f\x17\x4b\xd5\x2a\x14\xf2\x79\x33\xde\x0e\x47\xaa\x33\xd6\xb3\xdc\x55\x75\xc9\xee\xf5\x95\x63\x85\xe1\x54\xaa\xd7\x77\x5f\xd6\x4b\xd9\x9a\x3d\xdb\xc7\x87\xd2\x79\x56\x58\xa9\x5d\x23\xbd\xb1\x9c\x1e\x0e\x16\xe5\xbd\x95\xad\x29\x0d\x65\xff\xe5\x67\xf1\xa1\xd4\x55\x2f\x2b\xc5\x0a\x25\x5b\x4e\x7f\x99\xd3\x51\xef\x5c\x2d\x75\x9c\x3f\xc8\xf7\xd9\x53\x65\x5d\x6d\x34\xa7\x74\x2f\x89\xef\x1b\xed\x95\xf6\xac\x78\xd3\x29\x4e\x5e\x1d\xfb\x9b\x5d\x23\x6b\x3d\xa5\xc5\x52\x5a\xa7\xbc\x78\x52\x1c\xb0\x3b\xff\x72\x9b\x7d\x5e\x28\xcb\xf6\xf5\xc2\x94\xb5\xae\x38\xc5\x8f\x9a\xde\xca\x86\x1d\x18\xdb\x3a\x4e\xba\x1b\xd0\x89\x5b\x53\xda\x4a\xa1\xaf\xa5\x89\x10\x29\xed\xee\xd2\x46\x26\x57\x45\x9a\xd2\x9c\x12\xe2\x03\xcf\xc4\xe4\x8f\x95\x89\xdb\x1b\x79\x7d\x88\x65\x69\x42\x27\x27\xdb\x92\x99\x98\x24\x37\x68\x4e\x29\x71\x9a\x4c\x11\x3e\xae\x4f\xc3\x97\xf0\x3d\xac\x10\x3e\x85\x55\xf8\x1c\xbe\x85\xaf\xeb\x77\x67\x70\xfd\x1e\xbf\x03\x00\x00\xff\xff\xc9\xdf\xcf\x52\xee\x01\x00\x00"
-
-func localesRuLc_messagesTablesMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesTablesMo,
-		"locales/ru/LC_MESSAGES/tables.mo",
-	)
-}
-
-func localesRuLc_messagesTablesMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesTablesMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/tables.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"templates/views/migrations.html": templatesViewsMigrationsHtml,
-	"templates/views/status.html": templatesViewsStatusHtml,
-	"templates/views/tables.html": templatesViewsTablesHtml,
-	"assets/css/sql.min.css": assetsCssSqlMinCss,
-	"assets/js/sql.min.js": assetsJsSqlMinJs,
-	"assets/vendors/highlightjs/css/tomorrow.min.css": assetsVendorsHighlightjsCssTomorrowMinCss,
-	"assets/vendors/highlightjs/js/highlight.pack.min.js": assetsVendorsHighlightjsJsHighlightPackMinJs,
-	"locales/ru/LC_MESSAGES/config.mo": localesRuLc_messagesConfigMo,
-	"locales/ru/LC_MESSAGES/database.mo": localesRuLc_messagesDatabaseMo,
-	"locales/ru/LC_MESSAGES/migrations.mo": localesRuLc_messagesMigrationsMo,
-	"locales/ru/LC_MESSAGES/status.mo": localesRuLc_messagesStatusMo,
-	"locales/ru/LC_MESSAGES/tables.mo": localesRuLc_messagesTablesMo,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"assets": &bintree{nil, map[string]*bintree{
-		"css": &bintree{nil, map[string]*bintree{
-			"sql.min.css": &bintree{assetsCssSqlMinCss, map[string]*bintree{}},
-		}},
-		"js": &bintree{nil, map[string]*bintree{
-			"sql.min.js": &bintree{assetsJsSqlMinJs, map[string]*bintree{}},
-		}},
-		"vendors": &bintree{nil, map[string]*bintree{
-			"highlightjs": &bintree{nil, map[string]*bintree{
-				"css": &bintree{nil, map[string]*bintree{
-					"tomorrow.min.css": &bintree{assetsVendorsHighlightjsCssTomorrowMinCss, map[string]*bintree{}},
-				}},
-				"js": &bintree{nil, map[string]*bintree{
-					"highlight.pack.min.js": &bintree{assetsVendorsHighlightjsJsHighlightPackMinJs, map[string]*bintree{}},
-				}},
-			}},
-		}},
-	}},
-	"locales": &bintree{nil, map[string]*bintree{
-		"ru": &bintree{nil, map[string]*bintree{
-			"LC_MESSAGES": &bintree{nil, map[string]*bintree{
-				"config.mo": &bintree{localesRuLc_messagesConfigMo, map[string]*bintree{}},
-				"database.mo": &bintree{localesRuLc_messagesDatabaseMo, map[string]*bintree{}},
-				"migrations.mo": &bintree{localesRuLc_messagesMigrationsMo, map[string]*bintree{}},
-				"status.mo": &bintree{localesRuLc_messagesStatusMo, map[string]*bintree{}},
-				"tables.mo": &bintree{localesRuLc_messagesTablesMo, map[string]*bintree{}},
-			}},
-		}},
-	}},
-	"templates": &bintree{nil, map[string]*bintree{
-		"views": &bintree{nil, map[string]*bintree{
-			"migrations.html": &bintree{templatesViewsMigrationsHtml, map[string]*bintree{}},
-			"status.html": &bintree{templatesViewsStatusHtml, map[string]*bintree{}},
-			"tables.html": &bintree{templatesViewsTablesHtml, map[string]*bintree{}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
-
-func assetFS() *assetfs.AssetFS {
-	assetInfo := func(path string) (os.FileInfo, error) {
-		return os.Stat(path)
-	}
-	for k := range _bintree.Children {
-		return &assetfs.AssetFS{Asset: Asset, AssetDir: AssetDir, AssetInfo: assetInfo, Prefix: k}
-	}
-	panic("unreachable")
-}
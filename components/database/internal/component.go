@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"io"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -8,10 +10,12 @@ import (
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/database"
 	"github.com/mrsmtvd/shadow/components/database/balancer"
+	"github.com/mrsmtvd/shadow/components/database/internal/handlers"
 	"github.com/mrsmtvd/shadow/components/database/storage"
 	"github.com/mrsmtvd/shadow/components/i18n"
 	"github.com/mrsmtvd/shadow/components/logging"
 	"github.com/mrsmtvd/shadow/components/metrics"
+	"github.com/mrsmtvd/shadow/components/tracing"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
@@ -23,8 +27,9 @@ type Component struct {
 	logger      logging.Logger
 	storage     database.Storage
 
-	migrationsIsUp  bool
 	migrationsError error
+	migrationsLock  handlers.MigrationsLock
+	migrateExitCode int
 }
 
 func (c *Component) Name() string {
@@ -50,6 +55,9 @@ func (c *Component) Dependencies() []shadow.Dependency {
 		{
 			Name: metrics.ComponentName,
 		},
+		{
+			Name: tracing.ComponentName,
+		},
 	}
 }
 
@@ -99,25 +107,44 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) error {
 	s.SetConnMaxLifetime(c.config.Duration(database.ConfigConnMaxLifetime))
 
 	s.SetTypeConverter(TypeConverter{})
+	s.SetTracingEnabled(a.HasComponent(tracing.ComponentName))
+	s.SetSlowQuery(c.config.Duration(database.ConfigSlowQueryThreshold), c.logger)
+	s.SetTxRetry(c.config.Int(database.ConfigTxMaxRetries), c.config.Duration(database.ConfigTxRetryBackoff))
 
 	c.mutex.Lock()
 	c.storage = s
 	c.mutex.Unlock()
 
 	c.initTrace(s, c.config.Bool(config.ConfigDebug))
-	c.initBalancer(s, c.config.String(database.ConfigBalancer))
+	c.initBalancer(s)
 
 	migrate.SetSchema(c.config.String(database.ConfigMigrationsSchema))
 	migrate.SetTable(c.config.String(database.ConfigMigrationsTable))
 
-	ready <- struct{}{}
+	if command := c.config.String(database.ConfigMigrate); command != "" {
+		ready <- struct{}{}
 
-	_, err = c.UpMigrations()
+		return c.runMigrateCommand(command, c.config.String(database.ConfigMigrateSource), c.config.String(database.ConfigMigrateID))
+	}
 
-	c.mutex.Lock()
-	c.migrationsIsUp = err == nil
-	c.migrationsError = err
-	c.mutex.Unlock()
+	// миграции применяются до сигнала о готовности, чтобы зависимые компоненты стартовали с актуальной схемой
+	if c.config.Bool(database.ConfigMigrationsAuto) {
+		err = c.autoMigrations()
+
+		c.mutex.Lock()
+		c.migrationsError = err
+		c.mutex.Unlock()
+
+		if err != nil {
+			c.logger.Error("Auto migrations failed", "error", err.Error())
+
+			if c.config.Bool(database.ConfigMigrationsAutoRefuseStart) {
+				return err
+			}
+		}
+	}
+
+	ready <- struct{}{}
 
 	return nil
 }
@@ -130,13 +157,65 @@ func (c *Component) initTrace(s database.Storage, d bool) {
 	}
 }
 
-func (c *Component) initBalancer(s database.Storage, b string) {
-	switch b {
+func (c *Component) initBalancer(s database.Storage) {
+	var b database.Balancer
+
+	switch c.config.String(database.ConfigBalancer) {
 	case database.BalancerRandom:
-		s.SetBalancer(balancer.NewRandom())
+		b = balancer.NewRandom()
+	case database.BalancerWeighted:
+		b = balancer.NewWeighted(c.balancerWeights())
+	case database.BalancerLeastConnections:
+		b = balancer.NewLeastConnections()
 	default:
-		s.SetBalancer(balancer.NewRoundRobin())
+		b = balancer.NewRoundRobin()
+	}
+
+	if c.config.Bool(database.ConfigHealthCheckEnabled) {
+		h := balancer.NewHealth(b)
+		c.initHealthCheck(h)
+
+		b = h
 	}
+
+	s.SetBalancer(b)
+}
+
+func (c *Component) initHealthCheck(h *balancer.Health) {
+	h.SetCheckInterval(c.config.Duration(database.ConfigHealthCheckInterval))
+	h.SetCheckTimeout(c.config.Duration(database.ConfigHealthCheckTimeout))
+	h.SetEjectBackoff(c.config.Duration(database.ConfigEjectBackoffMin), c.config.Duration(database.ConfigEjectBackoffMax))
+}
+
+func (c *Component) balancerWeights() map[string]int {
+	weights := make(map[string]int)
+
+	for _, item := range strings.Split(c.config.String(database.ConfigBalancerWeights), ",") {
+		parts := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			c.logger.Warn("Failed parse weight of server", "server", parts[0], "error", err.Error())
+			continue
+		}
+
+		weights[strings.TrimSpace(parts[0])] = weight
+	}
+
+	return weights
+}
+
+func (c *Component) Shutdown() error {
+	if s := c.Storage(); s != nil {
+		if closer, ok := s.(*storage.SQL).Balancer().(io.Closer); ok {
+			return closer.Close()
+		}
+	}
+
+	return nil
 }
 
 func (c *Component) Storage() database.Storage {
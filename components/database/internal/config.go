@@ -6,6 +6,7 @@ import (
 
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/balancer"
 	"github.com/mrsmtvd/shadow/components/database/storage"
 	migrate "github.com/rubenv/sql-migrate"
 )
@@ -71,8 +72,43 @@ func (c *Component) ConfigVariables() []config.Variable {
 				config.ViewOptionEnumOptions: [][]interface{}{
 					{database.BalancerRoundRobin, "Round robin"},
 					{database.BalancerRandom, "Random"},
+					{database.BalancerWeighted, "Weighted"},
+					{database.BalancerLeastConnections, "Least connections"},
 				},
 			}),
+		config.NewVariable(database.ConfigBalancerWeights, config.ValueTypeString).
+			WithUsage("Weights of servers for weighted balancer").
+			WithGroup("Master-Slave").
+			WithEditable(true).
+			WithView([]string{config.ViewTags}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionTagsDefaultText: "add a server address=weight",
+			}),
+		config.NewVariable(database.ConfigHealthCheckEnabled, config.ValueTypeBool).
+			WithUsage("Enabled").
+			WithGroup("Health check of servers").
+			WithEditable(true).
+			WithDefault(true),
+		config.NewVariable(database.ConfigHealthCheckInterval, config.ValueTypeDuration).
+			WithUsage("Interval").
+			WithGroup("Health check of servers").
+			WithEditable(true).
+			WithDefault(balancer.DefaultHealthCheckInterval),
+		config.NewVariable(database.ConfigHealthCheckTimeout, config.ValueTypeDuration).
+			WithUsage("Ping timeout").
+			WithGroup("Health check of servers").
+			WithEditable(true).
+			WithDefault(balancer.DefaultHealthCheckTimeout),
+		config.NewVariable(database.ConfigEjectBackoffMin, config.ValueTypeDuration).
+			WithUsage("Minimum duration of eject failed server").
+			WithGroup("Health check of servers").
+			WithEditable(true).
+			WithDefault(balancer.DefaultEjectBackoffMin),
+		config.NewVariable(database.ConfigEjectBackoffMax, config.ValueTypeDuration).
+			WithUsage("Maximum duration of eject failed server").
+			WithGroup("Health check of servers").
+			WithEditable(true).
+			WithDefault(balancer.DefaultEjectBackoffMax),
 		config.NewVariable(database.ConfigMigrationsSchema, config.ValueTypeString).
 			WithUsage("Migrations schema name").
 			WithGroup("Migrations").
@@ -82,6 +118,41 @@ func (c *Component) ConfigVariables() []config.Variable {
 			WithGroup("Migrations").
 			WithEditable(true).
 			WithDefault("migrations"),
+		config.NewVariable(database.ConfigMigrate, config.ValueTypeString).
+			WithUsage("Run migrations command and exit").
+			WithGroup("Migrations").
+			WithView([]string{config.ViewEnum}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionEnumOptions: [][]interface{}{
+					{"", "Disabled"},
+					{database.MigrateUp, "Apply"},
+					{database.MigrateDown, "Rollback"},
+					{database.MigrateStatus, "Status"},
+					{database.MigrateRedo, "Redo"},
+				},
+			}),
+		config.NewVariable(database.ConfigMigrateSource, config.ValueTypeString).
+			WithUsage("Source of migration for migrations command").
+			WithGroup("Migrations"),
+		config.NewVariable(database.ConfigMigrateID, config.ValueTypeString).
+			WithUsage("ID of migration for migrations command").
+			WithGroup("Migrations"),
+		config.NewVariable(database.ConfigMigrationsAuto, config.ValueTypeBool).
+			WithUsage("Apply pending migrations on startup").
+			WithGroup("Migrations").
+			WithDefault(true),
+		config.NewVariable(database.ConfigMigrationsAutoMaxPending, config.ValueTypeInt).
+			WithUsage("Maximum number of pending migrations applied on startup. Zero is unlimited").
+			WithGroup("Migrations").
+			WithDefault(0),
+		config.NewVariable(database.ConfigMigrationsAutoRefuseStart, config.ValueTypeBool).
+			WithUsage("Refuse to start if migrations on startup failed").
+			WithGroup("Migrations"),
+		config.NewVariable(database.ConfigMigrationsLockTimeout, config.ValueTypeDuration).
+			WithUsage("Wait timeout of migrations lock").
+			WithGroup("Migrations").
+			WithEditable(true).
+			WithDefault(time.Second),
 		config.NewVariable(database.ConfigMaxIdleConns, config.ValueTypeInt).
 			WithUsage("Maximum number of connections in the idle connection pool").
 			WithGroup("Connections").
@@ -97,19 +168,46 @@ func (c *Component) ConfigVariables() []config.Variable {
 			WithGroup("Connections").
 			WithEditable(true).
 			WithDefault(0),
+		config.NewVariable(database.ConfigSlowQueryThreshold, config.ValueTypeDuration).
+			WithUsage("Log queries that run longer than threshold. Zero disables logging").
+			WithGroup("Logging").
+			WithEditable(true).
+			WithDefault(time.Second),
+		config.NewVariable(database.ConfigTxMaxRetries, config.ValueTypeInt).
+			WithUsage("Maximum number of transaction retries after deadlock or serialization errors").
+			WithGroup("Transactions").
+			WithEditable(true).
+			WithDefault(storage.DefaultTxMaxRetries),
+		config.NewVariable(database.ConfigTxRetryBackoff, config.ValueTypeDuration).
+			WithUsage("Initial delay between transaction retries, doubles on each retry").
+			WithGroup("Transactions").
+			WithEditable(true).
+			WithDefault(storage.DefaultTxRetryBackoff),
 	}
 }
 
 func (c *Component) ConfigWatchers() []config.Watcher {
 	return []config.Watcher{
 		config.NewWatcher([]string{database.ConfigAllowUseMasterAsSlave}, c.watchAllowUseMasterAsSlave),
-		config.NewWatcher([]string{database.ConfigBalancer}, c.watchBalancer),
+		config.NewWatcher([]string{
+			database.ConfigBalancer,
+			database.ConfigBalancerWeights,
+			database.ConfigHealthCheckEnabled,
+		}, c.watchBalancer),
+		config.NewWatcher([]string{
+			database.ConfigHealthCheckInterval,
+			database.ConfigHealthCheckTimeout,
+			database.ConfigEjectBackoffMin,
+			database.ConfigEjectBackoffMax,
+		}, c.watchHealthCheck),
 		config.NewWatcher([]string{config.ConfigDebug}, c.watchDebug),
 		config.NewWatcher([]string{database.ConfigMigrationsSchema}, c.watchMigrationsSchema),
 		config.NewWatcher([]string{database.ConfigMigrationsTable}, c.watchMigrationsTable),
 		config.NewWatcher([]string{database.ConfigMaxIdleConns}, c.watchMaxIdleConns),
 		config.NewWatcher([]string{database.ConfigMaxOpenConns}, c.watchMaxOpenConns),
 		config.NewWatcher([]string{database.ConfigConnMaxLifetime}, c.watchConnMaxLifetime),
+		config.NewWatcher([]string{database.ConfigSlowQueryThreshold}, c.watchSlowQueryThreshold),
+		config.NewWatcher([]string{database.ConfigTxMaxRetries, database.ConfigTxRetryBackoff}, c.watchTxRetry),
 	}
 }
 
@@ -123,9 +221,17 @@ func (c *Component) watchAllowUseMasterAsSlave(_ string, newValue interface{}, _
 	}
 }
 
-func (c *Component) watchBalancer(_ string, newValue interface{}, _ interface{}) {
+func (c *Component) watchBalancer(_ string, _ interface{}, _ interface{}) {
+	if s := c.Storage(); s != nil {
+		c.initBalancer(s)
+	}
+}
+
+func (c *Component) watchHealthCheck(_ string, _ interface{}, _ interface{}) {
 	if s := c.Storage(); s != nil {
-		c.initBalancer(s, newValue.(string))
+		if h, ok := s.(*storage.SQL).Balancer().(*balancer.Health); ok {
+			c.initHealthCheck(h)
+		}
 	}
 }
 
@@ -160,3 +266,15 @@ func (c *Component) watchConnMaxLifetime(_ string, newValue interface{}, _ inter
 		s.(*storage.SQL).SetConnMaxLifetime(newValue.(time.Duration))
 	}
 }
+
+func (c *Component) watchSlowQueryThreshold(_ string, newValue interface{}, _ interface{}) {
+	if s := c.Storage(); s != nil {
+		s.(*storage.SQL).SetSlowQuery(newValue.(time.Duration), c.logger)
+	}
+}
+
+func (c *Component) watchTxRetry(_ string, _ interface{}, _ interface{}) {
+	if s := c.Storage(); s != nil {
+		s.(*storage.SQL).SetTxRetry(c.config.Int(database.ConfigTxMaxRetries), c.config.Duration(database.ConfigTxRetryBackoff))
+	}
+}
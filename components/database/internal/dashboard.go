@@ -1,15 +1,15 @@
 package internal
 
 import (
+	"io/fs"
 	"net/http"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/database/internal/handlers"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -18,6 +18,7 @@ func (c *Component) DashboardMenu() dashboard.Menu {
 		WithIcon("database").
 		WithChild(dashboard.NewMenu("Migrations").WithURL("/" + c.Name() + "/migrations/")).
 		WithChild(dashboard.NewMenu("Tables").WithURL("/" + c.Name() + "/tables/")).
+		WithChild(dashboard.NewMenu("Slow queries").WithURL("/" + c.Name() + "/slow-queries/")).
 		WithChild(dashboard.NewMenu("Status").WithURL("/" + c.Name() + "/status/"))
 }
 
@@ -25,7 +26,7 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 	migrationsHandler := handlers.NewMigrationsHandler(c, c)
 
 	return []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
+		dashboard.RouteFromFS(c),
 		dashboard.NewRoute("/"+c.Name()+"/", &handlers.StatusHandler{}).
 			WithMethods([]string{http.MethodGet}).
 			WithAuth(true),
@@ -41,6 +42,9 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 		dashboard.NewRoute("/"+c.Name()+"/tables/", handlers.NewTablesHandler(c)).
 			WithMethods([]string{http.MethodGet}).
 			WithAuth(true),
+		dashboard.NewRoute("/"+c.Name()+"/slow-queries/", handlers.NewSlowQueriesHandler(c)).
+			WithMethods([]string{http.MethodGet, http.MethodPost}).
+			WithAuth(true),
 		dashboard.NewRoute("/"+c.Name()+"/status/", handlers.NewStatusHandler(c)).
 			WithMethods([]string{http.MethodGet}).
 			WithAuth(true),
@@ -1,12 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/database"
 	"github.com/mrsmtvd/shadow/components/i18n"
+	migrate "github.com/rubenv/sql-migrate"
 )
 
 // easyjson:json
@@ -15,11 +17,33 @@ type migrationsHandlerResponse struct {
 	Message string `json:"message"`
 }
 
+type MigrationsLock struct {
+	Method      string
+	Name        string
+	Holder      string
+	WaitTimeout time.Duration
+	Locked      bool
+	AttemptAt   *time.Time
+	AcquiredAt  *time.Time
+	ReleasedAt  *time.Time
+	Error       string
+}
+
+type MigrationsPlanItem struct {
+	ID        string
+	Source    string
+	Direction string
+	Code      bool
+	Queries   []string
+}
+
 type MigrationsManager interface {
 	UpMigration(id, source string) error
 	UpMigrations() (n int, err error)
 	DownMigration(id, source string) error
 	DownMigrations() (n int, err error)
+	MigrationsLock(ctx context.Context) MigrationsLock
+	PlanMigrations(id, source string, dir migrate.MigrationDirection) ([]MigrationsPlanItem, error)
 }
 
 type MigrationsItem interface {
@@ -27,6 +51,8 @@ type MigrationsItem interface {
 
 	Source() string
 	AppliedAt() *time.Time
+	IsCode() bool
+	Drift() string
 }
 
 type MigrationsHandler struct {
@@ -144,6 +170,8 @@ func (h *MigrationsHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Reques
 				"applied_at":  item.AppliedAt(),
 				"up":          strings.Join(item.Up(), "\n"),
 				"down":        strings.Join(item.Down(), "\n"),
+				"code":        item.IsCode(),
+				"drift":       item.Drift(),
 			})
 		}
 
@@ -154,5 +182,26 @@ func (h *MigrationsHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Reques
 		return
 	}
 
-	h.Render(r.Context(), "migrations", nil)
+	if plan := r.URL().Query().Get("plan"); plan != "" {
+		dir := migrate.Up
+		if plan == "down" {
+			dir = migrate.Down
+		}
+
+		items, err := h.manager.PlanMigrations("", "", dir)
+		if err != nil {
+			r.Session().FlashBag().Error(err.Error())
+		}
+
+		h.Render(r.Context(), "plan", map[string]interface{}{
+			"direction": plan,
+			"plan":      items,
+		})
+
+		return
+	}
+
+	h.Render(r.Context(), "migrations", map[string]interface{}{
+		"lock": h.manager.MigrationsLock(r.Context()),
+	})
 }
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/storage"
+)
+
+const slowQueriesDefaultLimit = 50
+
+type SlowQueriesHandler struct {
+	dashboard.Handler
+
+	component database.Component
+}
+
+func NewSlowQueriesHandler(component database.Component) *SlowQueriesHandler {
+	return &SlowQueriesHandler{
+		component: component,
+	}
+}
+
+func (h *SlowQueriesHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
+	s := h.component.Storage().(*storage.SQL)
+
+	if r.IsPost() {
+		s.ResetSlowQueries()
+
+		h.Redirect(r.URL().Path, http.StatusFound, w, r)
+		return
+	}
+
+	limit := slowQueriesDefaultLimit
+	if l, err := strconv.Atoi(r.URL().Query().Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+
+	h.Render(r.Context(), "slow_queries", map[string]interface{}{
+		"queries":   s.SlowQueries(limit),
+		"limit":     limit,
+		"threshold": r.Config().Duration(database.ConfigSlowQueryThreshold),
+	})
+}
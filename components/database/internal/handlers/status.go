@@ -7,6 +7,8 @@ import (
 
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/balancer"
+	"github.com/mrsmtvd/shadow/components/database/storage"
 )
 
 type StatusHandler struct {
@@ -15,6 +17,13 @@ type StatusHandler struct {
 	component database.Component
 }
 
+type statusServer struct {
+	Executor string
+	Role     string
+	Status   string
+	Replica  *balancer.ReplicaState
+}
+
 func NewStatusHandler(component database.Component) *StatusHandler {
 	return &StatusHandler{
 		component: component,
@@ -38,14 +47,41 @@ func (h *StatusHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
 	master := s.Master()
 	slaves := s.Slaves()
 
-	statuses := make([][]string, 0, 1+len(slaves))
-	statuses = append(statuses, []string{master.String(), "Master", h.status(r.Context(), master)})
+	var replicas []balancer.ReplicaState
+
+	if hb, ok := s.(*storage.SQL).Balancer().(*balancer.Health); ok {
+		replicas = hb.Replicas()
+	}
+
+	replica := func(e database.Executor) *balancer.ReplicaState {
+		for i := range replicas {
+			if replicas[i].Executor == e {
+				return &replicas[i]
+			}
+		}
+
+		return nil
+	}
+
+	servers := make([]statusServer, 0, 1+len(slaves))
+	servers = append(servers, statusServer{
+		Executor: master.String(),
+		Role:     "Master",
+		Status:   h.status(r.Context(), master),
+		Replica:  replica(master),
+	})
 
 	for _, slave := range slaves {
-		statuses = append(statuses, []string{slave.String(), "Slave", h.status(r.Context(), slave)})
+		servers = append(servers, statusServer{
+			Executor: slave.String(),
+			Role:     "Slave",
+			Status:   h.status(r.Context(), slave),
+			Replica:  replica(slave),
+		})
 	}
 
 	h.Render(r.Context(), "status", map[string]interface{}{
-		"statuses": statuses,
+		"servers":       servers,
+		"health_checks": replicas != nil,
 	})
 }
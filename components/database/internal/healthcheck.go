@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/mrsmtvd/shadow/components/dashboard"
@@ -47,14 +48,20 @@ func (c *Component) SlavesExecutorCheck() dashboard.HealthCheck {
 
 func (c *Component) MigrationsCheck() dashboard.HealthCheck {
 	return func() error {
+		pending := c.pendingMigrations()
+		if pending == 0 {
+			return nil
+		}
+
 		c.mutex.RLock()
-		defer c.mutex.RUnlock()
+		err := c.migrationsError
+		c.mutex.RUnlock()
 
-		if c.migrationsIsUp {
-			return nil
+		if err != nil {
+			return err
 		}
 
-		return c.migrationsError
+		return errors.New(strconv.Itoa(pending) + " migrations are pending")
 	}
 }
 
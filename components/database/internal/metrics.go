@@ -3,23 +3,38 @@ package internal
 import (
 	"github.com/kihamo/snitch"
 	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/balancer"
 	"github.com/mrsmtvd/shadow/components/database/storage"
 )
 
 const (
 	MetricOpenConnectionsTotal = database.ComponentName + "_open_connections_total"
+	MetricReplicaUp            = database.ComponentName + "_replica_up"
+	MetricReplicaFailures      = database.ComponentName + "_replica_failures"
+	MetricReplicaEjections     = database.ComponentName + "_replica_ejections_total"
 )
 
 var (
 	metricOpenConnectionsTotal = snitch.NewGauge(MetricOpenConnectionsTotal, "Number of open connections to the database")
+
+	metricReplicaUp, metricReplicaFailures, metricReplicaEjections = newReplicaMetrics()
 )
 
+func newReplicaMetrics() (up, failures, ejections snitch.Gauge) {
+	return snitch.NewGauge(MetricReplicaUp, "Replica is available for balancing"),
+		snitch.NewGauge(MetricReplicaFailures, "Number of consecutive failed health checks of replica"),
+		snitch.NewGauge(MetricReplicaEjections, "Number of replica ejections from balancing")
+}
+
 type metricsCollector struct {
 	component *Component
 }
 
 func (c *metricsCollector) Describe(ch chan<- *snitch.Description) {
 	metricOpenConnectionsTotal.Describe(ch)
+	metricReplicaUp.Describe(ch)
+	metricReplicaFailures.Describe(ch)
+	metricReplicaEjections.Describe(ch)
 
 	// describe from storages
 	storage.Describe(ch)
@@ -32,16 +47,51 @@ func (c *metricsCollector) Collect(ch chan<- snitch.Metric) {
 		return
 	}
 
-	stats := s.Master().(*storage.SQLExecutor).DB().Stats()
-
-	metricOpenConnectionsTotal.Set(float64(stats.OpenConnections))
+	if executor, ok := s.Master().(*storage.SQLExecutor); ok {
+		metricOpenConnectionsTotal.Set(float64(executor.DB().Stats().OpenConnections))
+		metricOpenConnectionsTotal.Collect(ch)
+	}
 
-	metricOpenConnectionsTotal.Collect(ch)
+	if sqlStorage, ok := s.(*storage.SQL); ok {
+		collectReplicas(sqlStorage, ch)
+	}
 
 	// collect from storages
 	storage.CollectStorageSQL(ch)
 }
 
+func collectReplicas(s *storage.SQL, ch chan<- snitch.Metric) {
+	h, ok := s.Balancer().(*balancer.Health)
+	if !ok {
+		return
+	}
+
+	// метрики реплик собираются заново, чтобы после смены набора реплик не оставались метки удаленных
+	up, failures, ejections := newReplicaMetrics()
+
+	for _, replica := range h.Replicas() {
+		executor, ok := replica.Executor.(*storage.SQLExecutor)
+		if !ok {
+			continue
+		}
+
+		name := executor.String()
+
+		if replica.Healthy {
+			up.With("executor", name).Set(1)
+		} else {
+			up.With("executor", name).Set(0)
+		}
+
+		failures.With("executor", name).Set(float64(replica.Failures))
+		ejections.With("executor", name).Set(float64(replica.Ejections))
+	}
+
+	up.Collect(ch)
+	failures.Collect(ch)
+	ejections.Collect(ch)
+}
+
 func (c *Component) Metrics() snitch.Collector {
 	<-c.application.ReadyComponent(c.Name())
 
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -8,12 +9,11 @@ import (
 )
 
 type MigrationItem struct {
-	database.Migration
-
 	mutex     sync.RWMutex
 	source    string
 	migration database.Migration
 	appliedAt *time.Time
+	drift     string
 }
 
 func NewMigrationItem(migration database.Migration, source string) *MigrationItem {
@@ -47,6 +47,44 @@ func (m *MigrationItem) ModAt() time.Time {
 	return m.migration.ModAt()
 }
 
+func (m *MigrationItem) Checksum() string {
+	return database.MigrationChecksum(m.migration)
+}
+
+func (m *MigrationItem) IsCode() bool {
+	_, ok := m.migration.(database.MigrationCode)
+	return ok
+}
+
+func (m *MigrationItem) UpCode(ctx context.Context, executor database.Executor) error {
+	if code, ok := m.migration.(database.MigrationCode); ok {
+		return code.UpCode(ctx, executor)
+	}
+
+	return nil
+}
+
+func (m *MigrationItem) DownCode(ctx context.Context, executor database.Executor) error {
+	if code, ok := m.migration.(database.MigrationCode); ok {
+		return code.DownCode(ctx, executor)
+	}
+
+	return nil
+}
+
+func (m *MigrationItem) Drift() string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.drift
+}
+
+func (m *MigrationItem) SetDrift(drift string) {
+	m.mutex.Lock()
+	m.drift = drift
+	m.mutex.Unlock()
+}
+
 func (m *MigrationItem) AppliedAt() *time.Time {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -1,20 +1,20 @@
 package internal
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/internal/handlers"
 	"github.com/mrsmtvd/shadow/components/database/storage"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
-const (
-	lockTimeoutInSeconds = 1
-)
-
 var nameRegexp = regexp.MustCompile(`^([1-9]\d{3}[0-1]\d[0-3]\d[0-2]\d[0-5]\d[0-5]\d)(.*)$`)
 
 func formatID(source, id string) string {
@@ -48,6 +48,11 @@ func (c *Component) Migrations() []database.Migration {
 
 	records, err := migrate.GetMigrationRecords(executor.DB(), s.Dialect())
 	if err == nil {
+		checksums, err := c.migrationsChecksums(executor)
+		if err != nil {
+			c.logger.Warn("Failed get checksums of migrations", "error", err.Error())
+		}
+
 		for i, m := range collection {
 			var appliedAt *time.Time
 
@@ -59,6 +64,7 @@ func (c *Component) Migrations() []database.Migration {
 			}
 
 			m.SetAppliedAt(appliedAt)
+			m.SetDrift(migrationDrift(m, appliedAt, checksums))
 			migrations[i] = m
 		}
 	} else {
@@ -97,9 +103,21 @@ func (c *Component) collection() MigrationsCollection {
 }
 
 func (c *Component) prepareMigrations(id, source string, dir migrate.MigrationDirection) (int, error) {
+	m, limit, err := c.migrationSource(id, source, dir)
+	if err != nil {
+		return -1, err
+	}
+
+	if len(m.Migrations) == 0 {
+		return 0, nil
+	}
+
+	return c.execWithLock(dir, m, limit)
+}
+
+func (c *Component) migrationSource(id, source string, dir migrate.MigrationDirection) (m migrate.MemoryMigrationSource, limit int, err error) {
 	migrations := make([]*migrate.Migration, 0)
 	collection := c.collection()
-	limit := 0
 
 	if id == "" && source == "" {
 		for _, m := range collection {
@@ -118,7 +136,7 @@ func (c *Component) prepareMigrations(id, source string, dir migrate.MigrationDi
 
 		records, err := migrate.GetMigrationRecords(db, dialect)
 		if err != nil {
-			return -1, err
+			return m, -1, err
 		}
 
 		searchID := formatID(source, id)
@@ -189,16 +207,12 @@ func (c *Component) prepareMigrations(id, source string, dir migrate.MigrationDi
 		}
 	}
 
-	if len(migrations) == 0 {
-		return 0, nil
-	}
-
-	return c.execWithLock(dir, migrate.MemoryMigrationSource{
+	return migrate.MemoryMigrationSource{
 		Migrations: migrations,
-	}, limit)
+	}, limit, nil
 }
 
-func (c *Component) execWithLock(dir migrate.MigrationDirection, m migrate.MigrationSource, limit int) (int, error) {
+func (c *Component) execWithLock(dir migrate.MigrationDirection, m migrate.MemoryMigrationSource, limit int) (int, error) {
 	if len(c.Migrations()) == 0 {
 		return 0, nil
 	}
@@ -207,29 +221,166 @@ func (c *Component) execWithLock(dir migrate.MigrationDirection, m migrate.Migra
 		return -1, errors.New("storage isn't initialized")
 	}
 
-	s := c.Storage().(*storage.SQL)
-	executor := s.Master().(*storage.SQLExecutor)
-	dialect := s.Dialect()
+	locker := c.migrationsLocker()
+	timeout := c.config.Duration(database.ConfigMigrationsLockTimeout)
 
-	if dialect == storage.DialectMySQL {
-		lockName := c.config.String(database.ConfigMigrationsTable) + ".lock"
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-		result, err := executor.SelectIntByQuery("SELECT GET_LOCK(?, ?)", lockName, lockTimeoutInSeconds)
-		if err != nil {
-			return 0, err
+	attemptAt := time.Now()
+	locked, err := locker.Lock(ctx)
+
+	c.mutex.Lock()
+	c.migrationsLock.Method = locker.Method()
+	c.migrationsLock.WaitTimeout = timeout
+	c.migrationsLock.AttemptAt = &attemptAt
+	c.migrationsLock.Locked = locked
+	c.migrationsLock.Error = ""
+
+	if err != nil {
+		c.migrationsLock.Error = err.Error()
+	} else if locked {
+		acquiredAt := time.Now()
+		c.migrationsLock.AcquiredAt = &acquiredAt
+		c.migrationsLock.ReleasedAt = nil
+	}
+	c.mutex.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !locked {
+		holder, _ := locker.Holder(context.Background())
+		c.logger.Warn("Migrations are locked", "method", locker.Method(), "holder", holder, "timeout", timeout.String())
+
+		if holder != "" {
+			return -1, fmt.Errorf("%w, holder %s", database.ErrMigrationsLocked, holder)
+		}
+
+		return -1, database.ErrMigrationsLocked
+	}
+
+	// при потере блокировки контекст отменяется, чтобы не применять миграции параллельно
+	// с экземпляром, который перехватил блокировку
+	migrateCtx, cancelMigrate := context.WithCancel(context.Background())
+	renewDone := make(chan struct{})
+	go c.renewMigrationsLock(locker, cancelMigrate, renewDone)
+
+	defer func() {
+		close(renewDone)
+		cancelMigrate()
+
+		if err := locker.Unlock(); err != nil {
+			c.logger.Error("Failed release migrations lock", "method", locker.Method(), "error", err.Error())
+		}
+
+		releasedAt := time.Now()
+
+		c.mutex.Lock()
+		c.migrationsLock.Locked = false
+		c.migrationsLock.ReleasedAt = &releasedAt
+		c.mutex.Unlock()
+	}()
+
+	return c.execMigrations(migrateCtx, dir, m, limit)
+}
+
+// renewMigrationsLock продлевает блокировку, пока выполняются миграции, чтобы долгие миграции
+// не посчитались брошенными и блокировку не перехватил другой экземпляр
+func (c *Component) renewMigrationsLock(locker migrationsLocker, lost context.CancelFunc, done <-chan struct{}) {
+	ticker := time.NewTicker(migrationsLockRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := locker.Renew(context.Background()); err != nil {
+				c.logger.Error("Failed renew migrations lock, migrations are aborted", "method", locker.Method(), "error", err.Error())
+				lost()
+
+				return
+			}
+
+		case <-done:
+			return
 		}
+	}
+}
+
+func (c *Component) migrationsLocker() migrationsLocker {
+	s := c.Storage().(*storage.SQL)
+
+	return newMigrationsLocker(
+		s.Dialect(),
+		s.Master().(*storage.SQLExecutor).DB(),
+		c.config.String(database.ConfigMigrationsTable)+".lock",
+		migrationsLockHolder(c.application.Name()),
+	)
+}
+
+func (c *Component) MigrationsLock(ctx context.Context) handlers.MigrationsLock {
+	c.mutex.RLock()
+	lock := c.migrationsLock
+	c.mutex.RUnlock()
+
+	lock.Name = c.config.String(database.ConfigMigrationsTable) + ".lock"
+	lock.WaitTimeout = c.config.Duration(database.ConfigMigrationsLockTimeout)
+
+	if c.Storage() == nil {
+		return lock
+	}
+
+	locker := c.migrationsLocker()
+	lock.Method = locker.Method()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
 
-		if result != 1 {
-			c.logger.Warn("Migrations are locked")
-			return 0, nil
+	holder, err := locker.Holder(ctx)
+	if err != nil {
+		lock.Holder = "unknown: " + err.Error()
+	} else {
+		lock.Holder = holder
+	}
+
+	return lock
+}
+
+func (c *Component) pendingMigrations() (pending int) {
+	if c.Storage() == nil {
+		return 0
+	}
+
+	for _, m := range c.Migrations() {
+		if m.(*MigrationItem).AppliedAt() == nil {
+			pending++
 		}
+	}
+
+	return pending
+}
 
-		defer func() {
-			_, _ = executor.ExecByQuery("SELECT RELEASE_LOCK(?)", lockName)
-		}()
+func (c *Component) autoMigrations() error {
+	pending := c.pendingMigrations()
+	if pending == 0 {
+		return nil
+	}
+
+	if max := c.config.Int(database.ConfigMigrationsAutoMaxPending); max > 0 && pending > max {
+		return errors.New("too many pending migrations " + strconv.Itoa(pending) + ", allowed " + strconv.Itoa(max) + ", apply them manually")
 	}
 
-	return migrate.ExecMax(executor.DB(), dialect, m, dir, limit)
+	if _, err := c.UpMigrations(); err != nil {
+		return err
+	}
+
+	// миграции могли не примениться, если блокировку держит другой экземпляр
+	if pending = c.pendingMigrations(); pending > 0 {
+		return errors.New(strconv.Itoa(pending) + " migrations are still pending")
+	}
+
+	return nil
 }
 
 func (c *Component) UpMigration(id, source string) error {
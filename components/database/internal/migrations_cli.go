@@ -0,0 +1,154 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+)
+
+func (c *Component) MigrateExitCode() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.migrateExitCode
+}
+
+func (c *Component) setMigrateExitCode(code int) {
+	c.mutex.Lock()
+	c.migrateExitCode = code
+	c.mutex.Unlock()
+}
+
+func (c *Component) runMigrateCommand(command, source, id string) (err error) {
+	code := database.MigrateExitOK
+
+	defer func() {
+		if errors.Is(err, database.ErrMigrationsLocked) {
+			code = database.MigrateExitLocked
+		}
+
+		if err != nil && code == database.MigrateExitOK {
+			code = database.MigrateExitFailed
+		}
+
+		c.setMigrateExitCode(code)
+	}()
+
+	if (source == "") != (id == "") {
+		code = database.MigrateExitUsage
+		return errors.New("both " + database.ConfigMigrateSource + " and " + database.ConfigMigrateID + " must be specified")
+	}
+
+	switch command {
+	case database.MigrateUp, database.MigrateDown, database.MigrateStatus, database.MigrateRedo:
+	default:
+		code = database.MigrateExitUsage
+		return errors.New("unknown migrate command " + command + ", allowed " +
+			database.MigrateUp + ", " + database.MigrateDown + ", " + database.MigrateStatus + ", " + database.MigrateRedo)
+	}
+
+	if err = ExecutorCheck(c.Storage().Master()); err != nil {
+		return err
+	}
+
+	switch command {
+	case database.MigrateUp:
+		if id != "" {
+			err = c.UpMigration(id, source)
+		} else {
+			_, err = c.UpMigrations()
+		}
+
+	case database.MigrateDown:
+		if id != "" {
+			err = c.DownMigration(id, source)
+		} else {
+			_, err = c.DownMigrations()
+		}
+
+	case database.MigrateRedo:
+		if id == "" {
+			if last := c.lastAppliedMigration(); last != nil {
+				id, source = last.ID(), last.Source()
+			}
+		}
+
+		if id == "" {
+			return errors.New("applied migrations not found")
+		}
+
+		if err = c.DownMigration(id, source); err == nil {
+			err = c.UpMigration(id, source)
+		}
+
+	}
+
+	pending, drift := c.printMigrationsStatus(os.Stdout)
+
+	if err == nil && command == database.MigrateStatus {
+		switch {
+		case drift > 0:
+			code = database.MigrateExitDrift
+		case pending > 0:
+			code = database.MigrateExitPending
+		}
+	}
+
+	return err
+}
+
+func (c *Component) lastAppliedMigration() *MigrationItem {
+	var last *MigrationItem
+
+	for _, m := range c.Migrations() {
+		item := m.(*MigrationItem)
+
+		if item.AppliedAt() != nil {
+			last = item
+		}
+	}
+
+	return last
+}
+
+func (c *Component) printMigrationsStatus(w io.Writer) (pending, drift int) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ID\tSOURCE\tTYPE\tAPPLIED AT\tDRIFT")
+
+	for _, m := range c.Migrations() {
+		item := m.(*MigrationItem)
+
+		kind := "sql"
+		if item.IsCode() {
+			kind = "go"
+		}
+
+		appliedAt := "pending"
+		if at := item.AppliedAt(); at != nil {
+			appliedAt = at.Format(time.RFC3339)
+		} else {
+			pending++
+		}
+
+		itemDrift := item.Drift()
+		if itemDrift != "" {
+			drift++
+		} else {
+			itemDrift = "-"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", item.ID(), item.Source(), kind, appliedAt, itemDrift)
+	}
+
+	_ = tw.Flush()
+
+	fmt.Fprintf(w, "\nPending: %d, drift: %d\n", pending, drift)
+
+	return pending, drift
+}
@@ -0,0 +1,266 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/database/internal/handlers"
+	"github.com/mrsmtvd/shadow/components/database/storage"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+var errMigrationsLockLost = fmt.Errorf("%w, lock is lost during migration", database.ErrMigrationsLocked)
+
+const (
+	MigrationDriftModified = "modified after applied"
+	MigrationDriftChecksum = "checksum mismatch"
+)
+
+func migrationDirection(dir migrate.MigrationDirection) string {
+	if dir == migrate.Down {
+		return "down"
+	}
+
+	return "up"
+}
+
+func migrationDrift(m *MigrationItem, appliedAt *time.Time, checksums map[string]string) string {
+	if appliedAt == nil {
+		return ""
+	}
+
+	if checksum, ok := checksums[formatID(m.Source(), m.ID())]; ok {
+		if current := m.Checksum(); current != "" && current != checksum {
+			return MigrationDriftChecksum
+		}
+
+		return ""
+	}
+
+	// для миграций, примененных до появления контрольных сумм, остается только сравнение дат
+	if modAt := m.ModAt(); !modAt.IsZero() && modAt.After(*appliedAt) {
+		return MigrationDriftModified
+	}
+
+	return ""
+}
+
+func (c *Component) migrationsIndex() map[string]*MigrationItem {
+	collection := c.collection()
+	index := make(map[string]*MigrationItem, len(collection))
+
+	for _, m := range collection {
+		index[formatID(m.Source(), m.ID())] = m
+	}
+
+	return index
+}
+
+func (c *Component) PlanMigrations(id, source string, dir migrate.MigrationDirection) ([]handlers.MigrationsPlanItem, error) {
+	if c.Storage() == nil {
+		return nil, errors.New("storage isn't initialized")
+	}
+
+	m, limit, err := c.migrationSource(id, source, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Migrations) == 0 {
+		return nil, nil
+	}
+
+	s := c.Storage().(*storage.SQL)
+
+	planned, _, err := migrate.PlanMigration(s.Master().(*storage.SQLExecutor).DB(), s.Dialect(), m, dir, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	index := c.migrationsIndex()
+	plan := make([]handlers.MigrationsPlanItem, 0, len(planned))
+
+	for _, p := range planned {
+		item := handlers.MigrationsPlanItem{
+			ID:        p.Id,
+			Direction: migrationDirection(dir),
+			Queries:   p.Queries,
+		}
+
+		if mi, ok := index[p.Id]; ok {
+			item.ID = mi.ID()
+			item.Source = mi.Source()
+			item.Code = mi.IsCode()
+		}
+
+		plan = append(plan, item)
+	}
+
+	return plan, nil
+}
+
+// аналог migrate.ExecMax, дополнительно выполняющий Go миграции и сохраняющий контрольные суммы
+func (c *Component) execMigrations(ctx context.Context, dir migrate.MigrationDirection, m migrate.MemoryMigrationSource, limit int) (int, error) {
+	s := c.Storage().(*storage.SQL)
+	executor := s.Master().(*storage.SQLExecutor)
+
+	planned, _, err := migrate.PlanMigration(executor.DB(), s.Dialect(), m, dir, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(planned) == 0 {
+		return 0, nil
+	}
+
+	if err := c.createMigrationsChecksumsTable(executor); err != nil {
+		return 0, err
+	}
+
+	index := c.migrationsIndex()
+	applied := 0
+
+	for _, p := range planned {
+		// контекст отменяется при потере блокировки миграций, транзакция текущей миграции
+		// в этом случае откатывается драйвером
+		if ctx.Err() != nil {
+			return applied, errMigrationsLockLost
+		}
+
+		if err := c.execMigration(ctx, executor, dir, p, index[p.Id]); err != nil {
+			if ctx.Err() != nil {
+				return applied, errMigrationsLockLost
+			}
+
+			return applied, &migrate.TxError{
+				Migration: p.Migration,
+				Err:       err,
+			}
+		}
+
+		applied++
+	}
+
+	return applied, nil
+}
+
+func (c *Component) execMigration(ctx context.Context, executor *storage.SQLExecutor, dir migrate.MigrationDirection, p *migrate.PlannedMigration, item *MigrationItem) (err error) {
+	tx, err := executor.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, stmt := range p.Queries {
+		// remove the semicolon from stmt, fix ORA-00922 issue in database oracle
+		stmt = strings.TrimSuffix(stmt, "\n")
+		stmt = strings.TrimSuffix(stmt, " ")
+		stmt = strings.TrimSuffix(stmt, ";")
+
+		if _, err = tx.ExecByQuery(stmt); err != nil {
+			return err
+		}
+	}
+
+	if item != nil && item.IsCode() {
+		if dir == migrate.Up {
+			err = item.UpCode(ctx, tx)
+		} else {
+			err = item.DownCode(ctx, tx)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	records := executor.QuotedTableForQuery(c.config.String(database.ConfigMigrationsSchema), c.config.String(database.ConfigMigrationsTable))
+	checksums := c.migrationsChecksumsTable(executor)
+
+	if _, err = tx.ExecByQuery("DELETE FROM "+checksums+" WHERE id = "+executor.BindVar(0), p.Id); err != nil {
+		return err
+	}
+
+	if dir == migrate.Up {
+		_, err = tx.ExecByQuery("INSERT INTO "+records+" (id, applied_at) VALUES ("+executor.BindVar(0)+", "+executor.BindVar(1)+")", p.Id, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if item != nil {
+			if checksum := item.Checksum(); checksum != "" {
+				_, err = tx.ExecByQuery("INSERT INTO "+checksums+" (id, checksum) VALUES ("+executor.BindVar(0)+", "+executor.BindVar(1)+")", p.Id, checksum)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		if _, err = tx.ExecByQuery("DELETE FROM "+records+" WHERE id = "+executor.BindVar(0), p.Id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *Component) migrationsChecksumsTable(executor *storage.SQLExecutor) string {
+	return executor.QuotedTableForQuery(c.config.String(database.ConfigMigrationsSchema), c.config.String(database.ConfigMigrationsTable)+"_checksums")
+}
+
+func (c *Component) createMigrationsChecksumsTable(executor *storage.SQLExecutor) error {
+	table := c.migrationsChecksumsTable(executor)
+
+	var err error
+
+	switch executor.Dialect() {
+	case storage.DialectMSSQL:
+		_, err = executor.ExecByQuery("IF OBJECT_ID(N'" + table + "', N'U') IS NULL CREATE TABLE " + table + " (id NVARCHAR(255) NOT NULL PRIMARY KEY, checksum NVARCHAR(255) NOT NULL)")
+
+	case storage.DialectOracle:
+		_, err = executor.ExecByQuery("CREATE TABLE " + table + " (id VARCHAR2(255) NOT NULL PRIMARY KEY, checksum VARCHAR2(255) NOT NULL)")
+
+		// ORA-00955: name is already used by an existing object
+		if err != nil && strings.Contains(err.Error(), "ORA-00955") {
+			err = nil
+		}
+
+	default:
+		_, err = executor.ExecByQuery("CREATE TABLE IF NOT EXISTS " + table + " (id VARCHAR(255) NOT NULL PRIMARY KEY, checksum VARCHAR(255) NOT NULL)")
+	}
+
+	return err
+}
+
+func (c *Component) migrationsChecksums(executor *storage.SQLExecutor) (map[string]string, error) {
+	rows, err := executor.DB().Query("SELECT id, checksum FROM " + c.migrationsChecksumsTable(executor))
+	if err != nil {
+		// таблица появляется только после первого применения миграций
+		return nil, nil
+	}
+	defer rows.Close()
+
+	checksums := make(map[string]string)
+
+	for rows.Next() {
+		var id, checksum sql.NullString
+
+		if err := rows.Scan(&id, &checksum); err != nil {
+			return nil, err
+		}
+
+		checksums[id.String] = checksum.String
+	}
+
+	return checksums, rows.Err()
+}
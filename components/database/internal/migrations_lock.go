@@ -0,0 +1,448 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hash/fnv"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database/storage"
+)
+
+const (
+	MigrationsLockMethodMySQL    = "mysql GET_LOCK"
+	MigrationsLockMethodPostgres = "postgres advisory lock"
+	MigrationsLockMethodMSSQL    = "mssql sp_getapplock"
+	MigrationsLockMethodTable    = "lock table"
+
+	migrationsLockRetryInterval = time.Millisecond * 200
+	// блокировка через таблицу не освобождается при падении процесса, поэтому записи,
+	// которые давно не продлевались, считаются брошенными
+	migrationsLockStaleAfter    = time.Hour
+	migrationsLockRenewInterval = migrationsLockStaleAfter / 4
+)
+
+type migrationsLocker interface {
+	Method() string
+	// ожидает блокировку не дольше дедлайна контекста
+	Lock(ctx context.Context) (bool, error)
+	Unlock() error
+	// продлевает блокировку, пока выполняются миграции
+	Renew(ctx context.Context) error
+	// текущий держатель блокировки, если диалект позволяет его узнать
+	Holder(ctx context.Context) (string, error)
+}
+
+func newMigrationsLocker(dialect string, db *sql.DB, name, holder string) migrationsLocker {
+	switch dialect {
+	case storage.DialectMySQL:
+		return &mysqlMigrationsLocker{db: db, name: name}
+	case storage.DialectPostgres:
+		return &postgresMigrationsLocker{db: db, key: migrationsLockKey(name)}
+	case storage.DialectMSSQL:
+		return &mssqlMigrationsLocker{db: db, name: name}
+	}
+
+	return &tableMigrationsLocker{db: db, dialect: dialect, name: name, holder: holder}
+}
+
+func migrationsLockHolder(application string) string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return application + "@" + hostname + ":" + strconv.Itoa(os.Getpid())
+}
+
+func migrationsLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+
+	return int64(h.Sum64())
+}
+
+func lockWaitTimeout(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := time.Until(deadline); timeout > 0 {
+			return timeout
+		}
+	}
+
+	return 0
+}
+
+// блокировки уровня сессии держатся на отдельном соединении до вызова Unlock
+type mysqlMigrationsLocker struct {
+	db   *sql.DB
+	conn *sql.Conn
+	name string
+}
+
+func (l *mysqlMigrationsLocker) Method() string {
+	return MigrationsLockMethodMySQL
+}
+
+func (l *mysqlMigrationsLocker) Lock(ctx context.Context) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var result sql.NullInt64
+
+	timeout := int64(math.Ceil(lockWaitTimeout(ctx).Seconds()))
+
+	// GET_LOCK сам ожидает блокировку, поэтому запрос выполняется без дедлайна
+	if err = conn.QueryRowContext(context.Background(), "SELECT GET_LOCK(?, ?)", l.name, timeout).Scan(&result); err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	if !result.Valid || result.Int64 != 1 {
+		conn.Close()
+		return false, nil
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
+
+func (l *mysqlMigrationsLocker) Unlock() error {
+	if l.conn == nil {
+		return nil
+	}
+
+	defer func() {
+		l.conn.Close()
+		l.conn = nil
+	}()
+
+	_, err := l.conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", l.name)
+
+	return err
+}
+
+// блокировка уровня сессии живет, пока живо соединение
+func (l *mysqlMigrationsLocker) Renew(ctx context.Context) error {
+	if l.conn == nil {
+		return errors.New("migrations lock isn't acquired")
+	}
+
+	return l.conn.PingContext(ctx)
+}
+
+func (l *mysqlMigrationsLocker) Holder(ctx context.Context) (string, error) {
+	var id sql.NullInt64
+
+	if err := l.db.QueryRowContext(ctx, "SELECT IS_USED_LOCK(?)", l.name).Scan(&id); err != nil {
+		return "", err
+	}
+
+	if !id.Valid {
+		return "", nil
+	}
+
+	return "connection " + strconv.FormatInt(id.Int64, 10), nil
+}
+
+type postgresMigrationsLocker struct {
+	db   *sql.DB
+	conn *sql.Conn
+	key  int64
+}
+
+func (l *postgresMigrationsLocker) Method() string {
+	return MigrationsLockMethodPostgres
+}
+
+func (l *postgresMigrationsLocker) Lock(ctx context.Context) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		var locked bool
+
+		if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+			conn.Close()
+
+			if ctx.Err() != nil {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		if locked {
+			l.conn = conn
+			return true, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return false, nil
+		case <-time.After(migrationsLockRetryInterval):
+		}
+	}
+}
+
+func (l *postgresMigrationsLocker) Unlock() error {
+	if l.conn == nil {
+		return nil
+	}
+
+	defer func() {
+		l.conn.Close()
+		l.conn = nil
+	}()
+
+	_, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key)
+
+	return err
+}
+
+// блокировка уровня сессии живет, пока живо соединение
+func (l *postgresMigrationsLocker) Renew(ctx context.Context) error {
+	if l.conn == nil {
+		return errors.New("migrations lock isn't acquired")
+	}
+
+	return l.conn.PingContext(ctx)
+}
+
+func (l *postgresMigrationsLocker) Holder(ctx context.Context) (string, error) {
+	var (
+		pid     int64
+		address string
+		name    string
+	)
+
+	// ключ bigint хранится в pg_locks двумя половинами: classid и objid
+	err := l.db.QueryRowContext(ctx, `SELECT a.pid, COALESCE(host(a.client_addr), ''), COALESCE(a.application_name, '')
+FROM pg_locks l JOIN pg_stat_activity a ON a.pid = l.pid
+WHERE l.locktype = 'advisory' AND l.granted AND l.objsubid = 1 AND l.classid = $1 AND l.objid = $2`,
+		int64(uint64(l.key)>>32), int64(uint64(l.key)&math.MaxUint32)).Scan(&pid, &address, &name)
+
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	holder := "pid " + strconv.FormatInt(pid, 10)
+	if address != "" {
+		holder += " from " + address
+	}
+
+	if name != "" {
+		holder += " (" + name + ")"
+	}
+
+	return holder, nil
+}
+
+type mssqlMigrationsLocker struct {
+	db   *sql.DB
+	conn *sql.Conn
+	name string
+}
+
+func (l *mssqlMigrationsLocker) Method() string {
+	return MigrationsLockMethodMSSQL
+}
+
+func (l *mssqlMigrationsLocker) Lock(ctx context.Context) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var result int64
+
+	// sp_getapplock сам ожидает блокировку, поэтому запрос выполняется без дедлайна
+	err = conn.QueryRowContext(context.Background(), `DECLARE @result int;
+EXEC @result = sp_getapplock @Resource = ?, @LockMode = 'Exclusive', @LockOwner = 'Session', @LockTimeout = ?;
+SELECT @result`, l.name, lockWaitTimeout(ctx).Milliseconds()).Scan(&result)
+
+	if err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	// 0 и 1 успешное получение блокировки, -1 таймаут, остальное ошибки
+	switch {
+	case result >= 0:
+		l.conn = conn
+		return true, nil
+	case result == -1:
+		conn.Close()
+		return false, nil
+	}
+
+	conn.Close()
+
+	return false, errors.New("sp_getapplock returned " + strconv.FormatInt(result, 10))
+}
+
+func (l *mssqlMigrationsLocker) Unlock() error {
+	if l.conn == nil {
+		return nil
+	}
+
+	defer func() {
+		l.conn.Close()
+		l.conn = nil
+	}()
+
+	_, err := l.conn.ExecContext(context.Background(), "EXEC sp_releaseapplock @Resource = ?, @LockOwner = 'Session'", l.name)
+
+	return err
+}
+
+// блокировка уровня сессии живет, пока живо соединение
+func (l *mssqlMigrationsLocker) Renew(ctx context.Context) error {
+	if l.conn == nil {
+		return errors.New("migrations lock isn't acquired")
+	}
+
+	return l.conn.PingContext(ctx)
+}
+
+func (l *mssqlMigrationsLocker) Holder(_ context.Context) (string, error) {
+	return "", nil
+}
+
+type tableMigrationsLocker struct {
+	db      *sql.DB
+	dialect string
+	name    string
+	holder  string
+	locked  bool
+}
+
+func (l *tableMigrationsLocker) Method() string {
+	return MigrationsLockMethodTable
+}
+
+func (l *tableMigrationsLocker) table() string {
+	return strings.ReplaceAll(l.name, ".", "_")
+}
+
+func (l *tableMigrationsLocker) query(query string) string {
+	if l.dialect != storage.DialectOracle {
+		return query
+	}
+
+	for i := 1; strings.Contains(query, "?"); i++ {
+		query = strings.Replace(query, "?", ":"+strconv.Itoa(i), 1)
+	}
+
+	return query
+}
+
+func (l *tableMigrationsLocker) createTable(ctx context.Context) error {
+	if l.dialect == storage.DialectOracle {
+		_, err := l.db.ExecContext(ctx, "CREATE TABLE "+l.table()+" (name VARCHAR2(255) NOT NULL PRIMARY KEY, holder VARCHAR2(255) NOT NULL, acquired_at NUMBER(19) NOT NULL)")
+
+		// ORA-00955: name is already used by an existing object
+		if err != nil && strings.Contains(err.Error(), "ORA-00955") {
+			return nil
+		}
+
+		return err
+	}
+
+	_, err := l.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+l.table()+" (name VARCHAR(255) NOT NULL PRIMARY KEY, holder VARCHAR(255) NOT NULL, acquired_at BIGINT NOT NULL)")
+
+	return err
+}
+
+func (l *tableMigrationsLocker) Lock(ctx context.Context) (bool, error) {
+	if err := l.createTable(ctx); err != nil {
+		return false, err
+	}
+
+	for {
+		stale := time.Now().Add(-migrationsLockStaleAfter).Unix()
+
+		if _, err := l.db.ExecContext(ctx, l.query("DELETE FROM "+l.table()+" WHERE name = ? AND acquired_at < ?"), l.name, stale); err != nil && ctx.Err() == nil {
+			return false, err
+		}
+
+		_, err := l.db.ExecContext(ctx, l.query("INSERT INTO "+l.table()+" (name, holder, acquired_at) VALUES (?, ?, ?)"), l.name, l.holder, time.Now().Unix())
+		if err == nil {
+			l.locked = true
+			return true, nil
+		}
+
+		// ошибка вставки при существующей записи означает, что блокировка занята
+		if holder, e := l.Holder(ctx); e == nil && holder == "" && ctx.Err() == nil {
+			return false, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-time.After(migrationsLockRetryInterval):
+		}
+	}
+}
+
+func (l *tableMigrationsLocker) Unlock() error {
+	if !l.locked {
+		return nil
+	}
+
+	l.locked = false
+
+	_, err := l.db.Exec(l.query("DELETE FROM "+l.table()+" WHERE name = ? AND holder = ?"), l.name, l.holder)
+
+	return err
+}
+
+func (l *tableMigrationsLocker) Renew(ctx context.Context) error {
+	if !l.locked {
+		return errors.New("migrations lock isn't acquired")
+	}
+
+	result, err := l.db.ExecContext(ctx, l.query("UPDATE "+l.table()+" SET acquired_at = ? WHERE name = ? AND holder = ?"), time.Now().Unix(), l.name, l.holder)
+	if err != nil {
+		return err
+	}
+
+	// запись удалили как брошенную, значит блокировку мог получить другой экземпляр
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return errors.New("migrations lock is lost")
+	}
+
+	return nil
+}
+
+func (l *tableMigrationsLocker) Holder(ctx context.Context) (string, error) {
+	var (
+		holder     string
+		acquiredAt int64
+	)
+
+	err := l.db.QueryRowContext(ctx, l.query("SELECT holder, acquired_at FROM "+l.table()+" WHERE name = ?"), l.name).Scan(&holder, &acquiredAt)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return holder + " since " + time.Unix(acquiredAt, 0).Format(time.RFC3339), nil
+}
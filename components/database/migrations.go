@@ -1,9 +1,17 @@
 package database
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
 	"time"
 )
 
+// ErrMigrationsLocked миграции не выполнены, потому что блокировку миграций держит другой экземпляр
+var ErrMigrationsLocked = errors.New("migrations are locked by another instance")
+
 type HasMigrations interface {
 	DatabaseMigrations() []Migration
 }
@@ -46,3 +54,102 @@ func (m *MigrationSimple) Down() []string {
 func (m *MigrationSimple) ModAt() time.Time {
 	return m.modAt
 }
+
+type MigrationFunc func(ctx context.Context, executor Executor) error
+
+// Миграция, шаги которой выполняются Go кодом внутри транзакции
+type MigrationCode interface {
+	Migration
+	UpCode(ctx context.Context, executor Executor) error
+	DownCode(ctx context.Context, executor Executor) error
+}
+
+type HasMigrationChecksum interface {
+	Checksum() string
+}
+
+type MigrationCodeSimple struct {
+	id       string
+	up       MigrationFunc
+	down     MigrationFunc
+	modAt    time.Time
+	checksum string
+}
+
+func NewMigrationCode(id string, up, down MigrationFunc, modAt time.Time) *MigrationCodeSimple {
+	return &MigrationCodeSimple{
+		id:    id,
+		up:    up,
+		down:  down,
+		modAt: modAt,
+	}
+}
+
+// Код функций невозможно сравнить, поэтому для обнаружения изменений
+// миграции нужно явно указать ее версию, например хеш коммита
+func (m *MigrationCodeSimple) WithChecksum(checksum string) *MigrationCodeSimple {
+	m.checksum = checksum
+	return m
+}
+
+func (m *MigrationCodeSimple) ID() string {
+	return m.id
+}
+
+func (m *MigrationCodeSimple) Up() []string {
+	return nil
+}
+
+func (m *MigrationCodeSimple) Down() []string {
+	return nil
+}
+
+func (m *MigrationCodeSimple) ModAt() time.Time {
+	return m.modAt
+}
+
+func (m *MigrationCodeSimple) Checksum() string {
+	return m.checksum
+}
+
+func (m *MigrationCodeSimple) UpCode(ctx context.Context, executor Executor) error {
+	if m.up == nil {
+		return nil
+	}
+
+	return m.up(ctx, executor)
+}
+
+func (m *MigrationCodeSimple) DownCode(ctx context.Context, executor Executor) error {
+	if m.down == nil {
+		return nil
+	}
+
+	return m.down(ctx, executor)
+}
+
+func MigrationChecksum(m Migration) string {
+	if c, ok := m.(HasMigrationChecksum); ok {
+		return c.Checksum()
+	}
+
+	if _, ok := m.(MigrationCode); ok {
+		return ""
+	}
+
+	h := sha256.New()
+
+	for _, query := range m.Up() {
+		_, _ = io.WriteString(h, query)
+		_, _ = h.Write([]byte{0})
+	}
+
+	_, _ = h.Write([]byte{1})
+
+	for _, query := range m.Down() {
+		_, _ = io.WriteString(h, query)
+		_, _ = h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
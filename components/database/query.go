@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// Типизированные обертки над Executor. Маршрутизация запросов определяется переданным исполнителем,
+// поэтому чтение обычно выполняется через Storage.Slave(), а запись и транзакции через Storage.Master().
+// Для nil исполнителя, который Storage.Slave() возвращает при недоступности реплик, возвращается ErrExecutorUnavailable
+
+// Select возвращает коллекцию записей, маппинг колонок на поля выполняет gorp
+func Select[T any](ctx context.Context, executor Executor, builder *sq.SelectBuilder) ([]T, error) {
+	if executor == nil {
+		return nil, ErrExecutorUnavailable
+	}
+
+	var list []T
+
+	if _, err := executor.SelectContext(ctx, &list, builder); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// SelectOne возвращает единственную запись, если записи нет, то возвращается sql.ErrNoRows
+func SelectOne[T any](ctx context.Context, executor Executor, builder *sq.SelectBuilder) (T, error) {
+	var holder T
+
+	if executor == nil {
+		return holder, ErrExecutorUnavailable
+	}
+
+	err := executor.SelectOneContext(ctx, &holder, builder)
+
+	return holder, err
+}
+
+// Get возвращает запись по первичному ключу зарегистрированной в хранилище таблицы,
+// если запись не найдена, то возвращается nil без ошибки
+func Get[T any](ctx context.Context, executor Executor, keys ...interface{}) (*T, error) {
+	if executor == nil {
+		return nil, ErrExecutorUnavailable
+	}
+
+	entity, err := executor.GetContext(ctx, new(T), keys...)
+	if err != nil || entity == nil {
+		return nil, err
+	}
+
+	holder, ok := entity.(*T)
+	if !ok {
+		return nil, errors.New("unexpected entity type " + reflect.TypeOf(entity).String())
+	}
+
+	return holder, nil
+}
+
+// ScanConverter реализуют исполнители, которые преобразуют значения колонок при чтении.
+// Iterate применяет его к каждому полю так же, как gorp применяет TypeConverter в Select:
+// сканирует в holder, а затем вызывает bind. Если преобразование не нужно, возвращается target и nil
+type ScanConverter interface {
+	ScanTarget(target interface{}) (holder interface{}, bind func() error)
+}
+
+// Iterate читает результат построчно без загрузки всей выборки в память.
+// Обработка прекращается на первой ошибке fn, которая и возвращается
+func Iterate[T any](ctx context.Context, executor Executor, builder *sq.SelectBuilder, fn func(T) error) (err error) {
+	if executor == nil {
+		return ErrExecutorUnavailable
+	}
+
+	rows, err := executor.QueryContext(ctx, builder)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if e := rows.Close(); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	indexes, err := columnsFieldIndexes(reflect.TypeOf((*T)(nil)).Elem(), columns)
+	if err != nil {
+		return err
+	}
+
+	converter, _ := executor.(ScanConverter)
+	scan := make([]interface{}, len(columns))
+	binds := make([]func() error, 0, len(columns))
+
+	for rows.Next() {
+		var holder T
+
+		// каждая строка сканируется в новое значение, чтобы fn мог сохранить его у себя
+		target := reflect.ValueOf(&holder).Elem()
+		if target.Kind() == reflect.Ptr {
+			target.Set(reflect.New(target.Type().Elem()))
+			target = target.Elem()
+		}
+
+		binds = binds[:0]
+
+		for i, index := range indexes {
+			field := target
+			if index != nil {
+				if field, err = fieldByIndex(target, index); err != nil {
+					return err
+				}
+			}
+
+			scan[i] = field.Addr().Interface()
+
+			if converter != nil {
+				var bind func() error
+
+				if scan[i], bind = converter.ScanTarget(scan[i]); bind != nil {
+					binds = append(binds, bind)
+				}
+			}
+		}
+
+		if err = rows.Scan(scan...); err != nil {
+			return err
+		}
+
+		for _, bind := range binds {
+			if err = bind(); err != nil {
+				return err
+			}
+		}
+
+		if err = fn(holder); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// fieldByIndex в отличие от reflect.Value.FieldByIndex создает встроенные по указателю структуры,
+// поля которых сопоставлены колонкам, вместо паники на nil указателе
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return v, errors.New("cannot set embedded pointer to unexported struct " + v.Type().Elem().String())
+				}
+
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, nil
+}
+
+// columnsFieldIndexes сопоставляет колонки с полями так же как gorp: по имени из тега db,
+// а при его отсутствии по имени поля без учета регистра. Для скалярных типов индекс nil
+func columnsFieldIndexes(t reflect.Type, columns []string) ([][]int, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || reflect.PtrTo(t).Implements(scannerType) {
+		if len(columns) != 1 {
+			return nil, errors.New("expected one column for type " + t.String())
+		}
+
+		return [][]int{nil}, nil
+	}
+
+	indexes := make([][]int, len(columns))
+	missing := make([]string, 0)
+
+	for i, column := range columns {
+		column = strings.ToLower(column)
+
+		field, found := t.FieldByNameFunc(func(name string) bool {
+			f, _ := t.FieldByName(name)
+			tag := strings.Split(f.Tag.Get("db"), ",")[0]
+
+			switch tag {
+			case "-":
+				return false
+			case "":
+				tag = f.Name
+			}
+
+			return column == strings.ToLower(tag)
+		})
+
+		if !found {
+			missing = append(missing, column)
+			continue
+		}
+
+		indexes[i] = field.Index
+	}
+
+	if len(missing) > 0 {
+		return nil, errors.New("no fields " + strings.Join(missing, ", ") + " in type " + t.String())
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,374 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+type queryTestBase struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type queryTestUser struct {
+	queryTestBase
+
+	Name     string `db:"name,size:255"`
+	Email    string
+	Password string         `db:"-"`
+	Note     sql.NullString `db:"note"`
+}
+
+type queryTestProfile struct {
+	*queryTestBase
+
+	Name string `db:"name"`
+}
+
+// QueryTestAudit экспортируется, так как reflect не может создать встроенную по указателю неэкспортируемую структуру
+type QueryTestAudit struct {
+	UpdatedBy string `db:"updated_by"`
+}
+
+type queryTestArticle struct {
+	*QueryTestAudit
+
+	Title string `db:"title"`
+}
+
+func TestColumnsFieldIndexes(t *testing.T) {
+	t.Parallel()
+
+	userType := reflect.TypeOf(queryTestUser{})
+
+	cases := []struct {
+		name    string
+		t       reflect.Type
+		columns []string
+		want    [][]int
+		err     bool
+	}{
+		{
+			name:    "tags",
+			t:       userType,
+			columns: []string{"name", "note"},
+			want:    [][]int{{1}, {4}},
+		},
+		{
+			name:    "field name without tag",
+			t:       userType,
+			columns: []string{"email"},
+			want:    [][]int{{2}},
+		},
+		{
+			name:    "case insensitive",
+			t:       userType,
+			columns: []string{"NAME", "Email"},
+			want:    [][]int{{1}, {2}},
+		},
+		{
+			name:    "embedded fields",
+			t:       userType,
+			columns: []string{"id", "created_at", "name"},
+			want:    [][]int{{0, 0}, {0, 1}, {1}},
+		},
+		{
+			name:    "embedded pointer",
+			t:       reflect.TypeOf(queryTestProfile{}),
+			columns: []string{"id", "name"},
+			want:    [][]int{{0, 0}, {1}},
+		},
+		{
+			name:    "pointer to struct",
+			t:       reflect.TypeOf(&queryTestUser{}),
+			columns: []string{"email"},
+			want:    [][]int{{2}},
+		},
+		{
+			name:    "ignored field",
+			t:       userType,
+			columns: []string{"name", "password"},
+			err:     true,
+		},
+		{
+			name:    "field name is ignored if tag is set",
+			t:       userType,
+			columns: []string{"createdat"},
+			err:     true,
+		},
+		{
+			name:    "unknown column",
+			t:       userType,
+			columns: []string{"name", "unknown"},
+			err:     true,
+		},
+		{
+			name:    "scalar",
+			t:       reflect.TypeOf(int64(0)),
+			columns: []string{"count"},
+			want:    [][]int{nil},
+		},
+		{
+			name:    "scanner",
+			t:       reflect.TypeOf(sql.NullString{}),
+			columns: []string{"note"},
+			want:    [][]int{nil},
+		},
+		{
+			name:    "pointer to scalar",
+			t:       reflect.TypeOf(new(string)),
+			columns: []string{"name"},
+			want:    [][]int{nil},
+		},
+		{
+			name:    "scalar with many columns",
+			t:       reflect.TypeOf(""),
+			columns: []string{"id", "name"},
+			err:     true,
+		},
+		{
+			name:    "scanner without columns",
+			t:       reflect.TypeOf(sql.NullInt64{}),
+			columns: []string{},
+			err:     true,
+		},
+	}
+
+	for _, c := range cases {
+		indexes, err := columnsFieldIndexes(c.t, c.columns)
+
+		if c.err {
+			assert.Error(t, err, c.name)
+			continue
+		}
+
+		if assert.NoError(t, err, c.name) {
+			assert.Equal(t, c.want, indexes, c.name)
+		}
+	}
+}
+
+const queryTestDriverName = "shadow-database-fake-rows"
+
+// queryTestDriver возвращает на любой запрос колонки и строки из DSN пула
+type queryTestDriver struct {
+	mutex   sync.Mutex
+	results map[string]queryTestRows
+}
+
+type queryTestRows struct {
+	columns []string
+	values  [][]driver.Value
+	index   int
+}
+
+func (r *queryTestRows) Columns() []string {
+	return r.columns
+}
+
+func (r *queryTestRows) Close() error {
+	return nil
+}
+
+func (r *queryTestRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.values) {
+		return io.EOF
+	}
+
+	copy(dest, r.values[r.index])
+	r.index++
+
+	return nil
+}
+
+func (d *queryTestDriver) Open(dsn string) (driver.Conn, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return &queryTestConn{rows: d.results[dsn]}, nil
+}
+
+type queryTestConn struct {
+	rows queryTestRows
+}
+
+func (c *queryTestConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *queryTestConn) Close() error {
+	return nil
+}
+
+func (c *queryTestConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *queryTestConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	rows := c.rows
+	return &rows, nil
+}
+
+var queryTestDrivers = &queryTestDriver{
+	results: make(map[string]queryTestRows),
+}
+
+func init() {
+	sql.Register(queryTestDriverName, queryTestDrivers)
+}
+
+type queryTestExecutor struct {
+	Executor
+
+	db        *sql.DB
+	converter bool
+}
+
+func newQueryTestExecutor(t *testing.T, columns []string, values ...[]driver.Value) *queryTestExecutor {
+	queryTestDrivers.mutex.Lock()
+	dsn := t.Name()
+	queryTestDrivers.results[dsn] = queryTestRows{columns: columns, values: values}
+	queryTestDrivers.mutex.Unlock()
+
+	db, err := sql.Open(queryTestDriverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &queryTestExecutor{db: db}
+}
+
+func (e *queryTestExecutor) QueryContext(ctx context.Context, builder *sq.SelectBuilder) (*sql.Rows, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.db.QueryContext(ctx, query, args...)
+}
+
+// ScanTarget читает строковые поля в верхнем регистре, имитируя TypeConverter
+func (e *queryTestExecutor) ScanTarget(target interface{}) (interface{}, func() error) {
+	s, ok := target.(*string)
+	if !ok || !e.converter {
+		return target, nil
+	}
+
+	holder := new(string)
+
+	return holder, func() error {
+		*s = strings.ToUpper(*holder)
+		return nil
+	}
+}
+
+func queryTestSelect(table string) *sq.SelectBuilder {
+	builder := sq.Select("*").From(table)
+	return &builder
+}
+
+func TestIterate_EmbeddedPointer_Allocated(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := newQueryTestExecutor(t, []string{"title", "updated_by"},
+		[]driver.Value{"first", "john"},
+		[]driver.Value{"second", "jane"},
+	)
+
+	list := make([]queryTestArticle, 0)
+
+	err := Iterate[queryTestArticle](context.Background(), executor, queryTestSelect("articles"), func(article queryTestArticle) error {
+		list = append(list, article)
+		return nil
+	})
+
+	a.NoError(err)
+
+	if a.Len(list, 2) {
+		a.Equal("first", list[0].Title)
+		a.Equal("second", list[1].Title)
+
+		if a.NotNil(list[0].QueryTestAudit) && a.NotNil(list[1].QueryTestAudit) {
+			a.Equal("john", list[0].UpdatedBy)
+			a.Equal("jane", list[1].UpdatedBy)
+			a.NotSame(list[0].QueryTestAudit, list[1].QueryTestAudit)
+		}
+	}
+}
+
+func TestIterate_EmbeddedPointerToUnexported_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	executor := newQueryTestExecutor(t, []string{"id", "name"}, []driver.Value{int64(1), "first"})
+
+	err := Iterate[queryTestProfile](context.Background(), executor, queryTestSelect("profiles"), func(queryTestProfile) error {
+		return nil
+	})
+
+	assert.Error(t, err)
+}
+
+func TestIterate_ScanConverter_Applied(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := newQueryTestExecutor(t, []string{"name", "email"}, []driver.Value{"john", "john@example.com"})
+	executor.converter = true
+
+	var user *queryTestUser
+
+	err := Iterate[*queryTestUser](context.Background(), executor, queryTestSelect("users"), func(u *queryTestUser) error {
+		user = u
+		return nil
+	})
+
+	a.NoError(err)
+
+	if a.NotNil(user) {
+		a.Equal("JOHN", user.Name)
+		a.Equal("JOHN@EXAMPLE.COM", user.Email)
+	}
+}
+
+func TestIterate_Scalar(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := newQueryTestExecutor(t, []string{"count"}, []driver.Value{int64(3)}, []driver.Value{int64(5)})
+
+	var sum int64
+
+	err := Iterate[int64](context.Background(), executor, queryTestSelect("stats"), func(v int64) error {
+		sum += v
+		return nil
+	})
+
+	a.NoError(err)
+	a.Equal(int64(8), sum)
+}
+
+func TestIterate_NilExecutor_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	err := Iterate[int64](context.Background(), nil, queryTestSelect("stats"), func(int64) error {
+		return nil
+	})
+
+	assert.Equal(t, ErrExecutorUnavailable, err)
+}
@@ -1,12 +1,24 @@
 package database
 
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// ErrExecutorUnavailable все реплики недоступны, а использование мастера вместо реплик запрещено
+var ErrExecutorUnavailable = errors.New("no available database executor")
+
 type Storage interface {
 	Executor() Executor
 	Executors() []Executor
 	Master() Executor
+	// возвращает nil, если доступных реплик нет, а использование мастера вместо реплик запрещено
 	Slave() Executor
 	Slaves() []Executor
 	AllowUseMasterAsSlave()
 	DisallowUseMasterAsSlave()
 	SetBalancer(Balancer)
+	// выполняет fn в транзакции на мастере с автоматической фиксацией или откатом
+	WithTx(ctx context.Context, opts *sql.TxOptions, fn func(Executor) error) error
 }
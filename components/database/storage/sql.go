@@ -2,12 +2,14 @@ package storage
 
 import (
 	"errors"
+	"io"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/go-gorp/gorp"
 	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/logging"
 )
 
 type SQL struct {
@@ -17,6 +19,9 @@ type SQL struct {
 	slaveExecutors   []*SQLExecutor
 	balancer         database.Balancer
 	tables           []*gorp.TableMap
+	observer         *sqlObserver
+	txMaxRetries     int64
+	txRetryBackoff   int64
 }
 
 func NewSQL(driver string, masterDSN string, slavesDSN []string, options map[string]string, allowUseMasterAsSlave bool) (s *SQL, err error) {
@@ -27,12 +32,17 @@ func NewSQL(driver string, masterDSN string, slavesDSN []string, options map[str
 	s = &SQL{
 		slaveExecutors: make([]*SQLExecutor, 0, len(slavesDSN)),
 		tables:         make([]*gorp.TableMap, 0),
+		observer:       newSQLObserver(),
+		txMaxRetries:   DefaultTxMaxRetries,
+		txRetryBackoff: int64(DefaultTxRetryBackoff),
 	}
 
 	if s.masterExecutor, err = NewSQLExecutor(driver, masterDSN, options); err != nil {
 		return nil, err
 	}
 
+	s.masterExecutor.observer = s.observer
+
 	if len(slavesDSN) > 0 {
 		for _, dsn := range slavesDSN {
 			executor, err := NewSQLExecutor(driver, dsn, options)
@@ -40,6 +50,7 @@ func NewSQL(driver string, masterDSN string, slavesDSN []string, options map[str
 				return nil, err
 			}
 
+			executor.observer = s.observer
 			s.slaveExecutors = append(s.slaveExecutors, executor)
 		}
 	} else {
@@ -55,6 +66,22 @@ func NewSQL(driver string, masterDSN string, slavesDSN []string, options map[str
 	return s, nil
 }
 
+func (s *SQL) SetTracingEnabled(enabled bool) {
+	s.observer.SetTracingEnabled(enabled)
+}
+
+func (s *SQL) SetSlowQuery(threshold time.Duration, logger logging.Logger) {
+	s.observer.SetSlowQuery(threshold, logger)
+}
+
+func (s *SQL) SlowQueries(limit int) []SlowQuery {
+	return s.observer.SlowQueries(limit)
+}
+
+func (s *SQL) ResetSlowQueries() {
+	s.observer.ResetSlowQueries()
+}
+
 func (s *SQL) Executor() database.Executor {
 	return s.Slave()
 }
@@ -80,7 +107,17 @@ func (s *SQL) Slave() database.Executor {
 		return s.Master()
 	}
 
-	return balancer.Get()
+	if executor := balancer.Get(); executor != nil {
+		return executor
+	}
+
+	// балансировщик не вернул ни одного доступного исполнителя, все реплики недоступны.
+	// Мастер используется вместо реплик только если это разрешено allow-use-master-as-slave
+	if atomic.LoadInt64(&s.useMasterAsSlave) == 1 {
+		return s.Master()
+	}
+
+	return nil
 }
 
 func (s *SQL) Slaves() []database.Executor {
@@ -138,8 +175,20 @@ func (s *SQL) SetBalancer(balancer database.Balancer) {
 	balancer.Set(executors)
 
 	s.mutex.Lock()
+	previous := s.balancer
 	s.balancer = balancer
 	s.mutex.Unlock()
+
+	if closer, ok := previous.(io.Closer); ok && previous != balancer {
+		_ = closer.Close()
+	}
+}
+
+func (s *SQL) Balancer() database.Balancer {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.balancer
 }
 
 func (s *SQL) SetMaxIdleConns(n int) {
@@ -215,10 +264,25 @@ func (s *SQL) AddTableWithName(i interface{}, name string) {
 	}
 }
 
+// регистрирует таблицу с первичным ключом, без которого gorp не умеет Get, Update и Delete
+func (s *SQL) AddTableWithKeys(i interface{}, name string, keys ...string) {
+	table := s.masterExecutor.executor.(*gorp.DbMap).AddTableWithName(i, name).SetKeys(false, keys...)
+
+	s.mutex.Lock()
+	s.tables = append(s.tables, table)
+	s.mutex.Unlock()
+
+	for _, executor := range s.slaveExecutors {
+		executor.executor.(*gorp.DbMap).AddTableWithName(i, name).SetKeys(false, keys...)
+	}
+}
+
 func (s *SQL) CreateTablesIfNotExists() error {
-	defer UpdateStorageSQLMetric(OperationCreate, s.masterExecutor.ServerAddress(), time.Now())
+	done := s.masterExecutor.observe(OperationCreate, "CREATE TABLE IF NOT EXISTS")
+	err := s.masterExecutor.executor.(*gorp.DbMap).CreateTablesIfNotExists()
+	done(err)
 
-	return s.masterExecutor.executor.(*gorp.DbMap).CreateTablesIfNotExists()
+	return err
 }
 
 func (s *SQL) Dialect() string {
@@ -37,9 +37,13 @@ var dsnPattern = regexp.MustCompile(
 
 type SQLExecutor struct {
 	executor      gorp.SqlExecutor
+	dbMap         *gorp.DbMap
 	dialect       string
 	name          string
 	serverAddress string
+	ctx           context.Context
+	observer      *sqlObserver
+	gorpDialect   gorp.Dialect
 }
 
 func NewSQLExecutor(driver string, dataSourceName string, options map[string]string) (*SQLExecutor, error) {
@@ -105,9 +109,12 @@ func NewSQLExecutor(driver string, dataSourceName string, options map[string]str
 	}
 
 	e := &SQLExecutor{
-		executor: dbMap,
-		dialect:  dialectName,
-		name:     dialectName + ">",
+		executor:    dbMap,
+		dbMap:       dbMap,
+		dialect:     dialectName,
+		name:        dialectName + ">",
+		observer:    newSQLObserver(),
+		gorpDialect: dialect,
 	}
 
 	matches := dsnPattern.FindStringSubmatch(dataSourceName)
@@ -140,23 +147,115 @@ func (e *SQLExecutor) ServerAddress() string {
 	return e.serverAddress
 }
 
+func (e *SQLExecutor) Dialect() string {
+	return e.dialect
+}
+
+func (e *SQLExecutor) BindVar(i int) string {
+	return e.gorpDialect.BindVar(i)
+}
+
+func (e *SQLExecutor) QuotedTableForQuery(schema, table string) string {
+	return e.gorpDialect.QuotedTableForQuery(schema, table)
+}
+
 func (e *SQLExecutor) DB() *sql.DB {
 	return e.executor.(*gorp.DbMap).Db
 }
 
+// ScanTarget применяет TypeConverter хранилища, чтобы database.Iterate читал значения так же, как Select
+func (e *SQLExecutor) ScanTarget(target interface{}) (interface{}, func() error) {
+	if e.dbMap == nil || e.dbMap.TypeConverter == nil {
+		return target, nil
+	}
+
+	scanner, ok := e.dbMap.TypeConverter.FromDb(target)
+	if !ok {
+		return target, nil
+	}
+
+	return scanner.Holder, scanner.Bind
+}
+
 func (e *SQLExecutor) Ping(ctx context.Context) error {
 	return e.DB().PingContext(ctx)
 }
 
+func (e *SQLExecutor) WithContext(ctx context.Context) database.Executor {
+	return e.withContext(ctx)
+}
+
+func (e *SQLExecutor) withContext(ctx context.Context) *SQLExecutor {
+	executor := *e
+	executor.ctx = ctx
+
+	return &executor
+}
+
+func (e *SQLExecutor) Context() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+
+	return e.ctx
+}
+
+func (e *SQLExecutor) sqlExecutor() gorp.SqlExecutor {
+	if e.ctx != nil {
+		return e.executor.WithContext(e.ctx)
+	}
+
+	return e.executor
+}
+
+func (e *SQLExecutor) observe(operation, query string, args ...interface{}) func(error) {
+	if e.observer == nil {
+		startAt := time.Now()
+
+		return func(err error) {
+			UpdateStorageSQLQueryMetric(operation, e.String(), NormalizeStatement(query), startAt, err)
+		}
+	}
+
+	return e.observer.start(e.ctx, e, operation, query, args)
+}
+
 func (e *SQLExecutor) Begin() (database.Executor, error) {
-	transaction, err := e.executor.(*gorp.DbMap).Begin()
+	return e.BeginTx(e.ctx, nil)
+}
+
+func (e *SQLExecutor) BeginTx(ctx context.Context, opts *sql.TxOptions) (database.Executor, error) {
+	dbMap, ok := e.executor.(*gorp.DbMap)
+	if !ok {
+		return nil, errors.New("executor is not grop.DbMap")
+	}
+
+	done := e.observe(OperationBegin, "BEGIN")
+	sqlTx, statements, err := beginTx(ctx, dbMap.Db, e.dialect, opts)
+	done(err)
+
 	if err != nil {
 		return nil, err
 	}
 
-	return &SQLExecutor{
-		executor: transaction,
-	}, nil
+	transaction, err := gorpTransaction(dbMap, sqlTx)
+	if err != nil {
+		_ = sqlTx.Rollback()
+		return nil, err
+	}
+
+	tx := *e
+	tx.executor = transaction
+	tx.ctx = ctx
+
+	for _, statement := range statements {
+		if _, err := tx.ExecByQuery(statement); err != nil {
+			_ = transaction.Rollback()
+			return nil, err
+		}
+	}
+
+	return &tx, nil
 }
 
 func (e *SQLExecutor) Commit() error {
@@ -178,9 +277,10 @@ func (e *SQLExecutor) Rollback() error {
 }
 
 func (e *SQLExecutor) SelectByQuery(i interface{}, query string, args ...interface{}) ([]interface{}, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
+	done := e.observe(OperationSelect, query, args...)
+	data, err := e.sqlExecutor().Select(i, query, args...)
+	done(err)
 
-	data, err := e.executor.Select(i, query, args...)
 	if err != nil {
 		return data, errors.New("error getting collection from DB, query: '" + query + "', error: '" + err.Error() + "'")
 	}
@@ -197,10 +297,19 @@ func (e *SQLExecutor) Select(i interface{}, builder *sq.SelectBuilder) ([]interf
 	return e.SelectByQuery(i, query, args...)
 }
 
+func (e *SQLExecutor) SelectByQueryContext(ctx context.Context, i interface{}, query string, args ...interface{}) ([]interface{}, error) {
+	return e.withContext(ctx).SelectByQuery(i, query, args...)
+}
+
+func (e *SQLExecutor) SelectContext(ctx context.Context, i interface{}, builder *sq.SelectBuilder) ([]interface{}, error) {
+	return e.withContext(ctx).Select(i, builder)
+}
+
 func (e *SQLExecutor) SelectOneByQuery(holder interface{}, query string, args ...interface{}) error {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
+	done := e.observe(OperationSelect, query, args...)
+	err := e.sqlExecutor().SelectOne(holder, query, args...)
+	done(err)
 
-	err := e.executor.SelectOne(holder, query, args...)
 	if err != nil && err != sql.ErrNoRows {
 		return errors.New("error getting value from DB, query: '" + query + "', error: '" + err.Error() + "'")
 	}
@@ -217,10 +326,47 @@ func (e *SQLExecutor) SelectOne(holder interface{}, builder *sq.SelectBuilder) e
 	return e.SelectOneByQuery(holder, query, args...)
 }
 
-func (e *SQLExecutor) SelectIntByQuery(query string, args ...interface{}) (int64, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
+func (e *SQLExecutor) SelectOneByQueryContext(ctx context.Context, holder interface{}, query string, args ...interface{}) error {
+	return e.withContext(ctx).SelectOneByQuery(holder, query, args...)
+}
+
+func (e *SQLExecutor) SelectOneContext(ctx context.Context, holder interface{}, builder *sq.SelectBuilder) error {
+	return e.withContext(ctx).SelectOne(holder, builder)
+}
+
+func (e *SQLExecutor) QueryByQuery(query string, args ...interface{}) (*sql.Rows, error) {
+	done := e.observe(OperationSelect, query, args...)
+	rows, err := e.sqlExecutor().Query(query, args...)
+	done(err)
 
-	result, err := e.executor.SelectInt(query, args...)
+	if err != nil {
+		return nil, errors.New("error getting rows from DB, query: '" + query + "', error: '" + err.Error() + "'")
+	}
+
+	return rows, nil
+}
+
+func (e *SQLExecutor) Query(builder *sq.SelectBuilder) (*sql.Rows, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New("could not prepare SQL query, error: '" + err.Error() + "'")
+	}
+
+	return e.QueryByQuery(query, args...)
+}
+
+func (e *SQLExecutor) QueryByQueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return e.withContext(ctx).QueryByQuery(query, args...)
+}
+
+func (e *SQLExecutor) QueryContext(ctx context.Context, builder *sq.SelectBuilder) (*sql.Rows, error) {
+	return e.withContext(ctx).Query(builder)
+}
+
+func (e *SQLExecutor) SelectIntByQuery(query string, args ...interface{}) (int64, error) {
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectInt(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -239,9 +385,9 @@ func (e *SQLExecutor) SelectInt(builder *sq.SelectBuilder) (int64, error) {
 }
 
 func (e *SQLExecutor) SelectNullIntByQuery(query string, args ...interface{}) (sql.NullInt64, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	result, err := e.executor.SelectNullInt(query, args...)
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectNullInt(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -262,9 +408,9 @@ func (e *SQLExecutor) SelectNullInt(builder *sq.SelectBuilder) (sql.NullInt64, e
 }
 
 func (e *SQLExecutor) SelectFloatByQuery(query string, args ...interface{}) (float64, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	result, err := e.executor.SelectFloat(query, args...)
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectFloat(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -283,9 +429,9 @@ func (e *SQLExecutor) SelectFloat(builder *sq.SelectBuilder) (float64, error) {
 }
 
 func (e *SQLExecutor) SelectNullFloatByQuery(query string, args ...interface{}) (sql.NullFloat64, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	result, err := e.executor.SelectNullFloat(query, args...)
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectNullFloat(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -306,9 +452,9 @@ func (e *SQLExecutor) SelectNullFloat(builder *sq.SelectBuilder) (sql.NullFloat6
 }
 
 func (e *SQLExecutor) SelectStrByQuery(query string, args ...interface{}) (string, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	result, err := e.executor.SelectStr(query, args...)
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectStr(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -327,9 +473,9 @@ func (e *SQLExecutor) SelectStr(builder *sq.SelectBuilder) (string, error) {
 }
 
 func (e *SQLExecutor) SelectNullStrByQuery(query string, args ...interface{}) (sql.NullString, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	result, err := e.executor.SelectNullStr(query, args...)
+	done := e.observe(OperationSelect, query, args...)
+	result, err := e.sqlExecutor().SelectNullStr(query, args...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error selecting data in DB, error: '" + err.Error() + "'")
@@ -350,9 +496,9 @@ func (e *SQLExecutor) SelectNullStr(builder *sq.SelectBuilder) (sql.NullString,
 }
 
 func (e *SQLExecutor) Get(i interface{}, keys ...interface{}) (interface{}, error) {
-	defer UpdateStorageSQLMetric(OperationSelect, e.serverAddress, time.Now())
-
-	entity, err := e.executor.Get(i, keys...)
+	done := e.observe(OperationSelect, entitiesStatement("GET", i), keys...)
+	entity, err := e.sqlExecutor().Get(i, keys...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error get data in DB, error: '" + err.Error() + "'")
@@ -361,10 +507,16 @@ func (e *SQLExecutor) Get(i interface{}, keys ...interface{}) (interface{}, erro
 	return entity, err
 }
 
+func (e *SQLExecutor) GetContext(ctx context.Context, i interface{}, keys ...interface{}) (interface{}, error) {
+	return e.withContext(ctx).Get(i, keys...)
+}
+
 func (e *SQLExecutor) Insert(list ...interface{}) error {
-	defer UpdateStorageSQLMetric(OperationInsert, e.serverAddress, time.Now())
+	done := e.observe(OperationInsert, entitiesStatement("INSERT", list...))
+	err := e.sqlExecutor().Insert(list...)
+	done(err)
 
-	if err := e.executor.Insert(list...); err != nil {
+	if err != nil {
 		return errors.New("error inserting data into DB, error: '" + err.Error() + "'")
 	}
 
@@ -372,9 +524,9 @@ func (e *SQLExecutor) Insert(list ...interface{}) error {
 }
 
 func (e *SQLExecutor) Update(list ...interface{}) (int64, error) {
-	defer UpdateStorageSQLMetric(OperationUpdate, e.serverAddress, time.Now())
-
-	count, err := e.executor.Update(list...)
+	done := e.observe(OperationUpdate, entitiesStatement("UPDATE", list...))
+	count, err := e.sqlExecutor().Update(list...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error updating data in DB, error: '" + err.Error() + "'")
@@ -384,9 +536,9 @@ func (e *SQLExecutor) Update(list ...interface{}) (int64, error) {
 }
 
 func (e *SQLExecutor) Delete(list ...interface{}) (int64, error) {
-	defer UpdateStorageSQLMetric(OperationDelete, e.serverAddress, time.Now())
-
-	count, err := e.executor.Delete(list...)
+	done := e.observe(OperationDelete, entitiesStatement("DELETE", list...))
+	count, err := e.sqlExecutor().Delete(list...)
+	done(err)
 
 	if err != nil {
 		err = errors.New("error deleting data in DB, error: '" + err.Error() + "'")
@@ -396,9 +548,9 @@ func (e *SQLExecutor) Delete(list ...interface{}) (int64, error) {
 }
 
 func (e *SQLExecutor) Prepare(query string) (*sql.Stmt, error) {
-	if transaction, ok := e.executor.(*gorp.Transaction); ok {
+	if transaction, ok := e.sqlExecutor().(*gorp.Transaction); ok {
 		return transaction.Prepare(query)
-	} else if dbMap, ok := e.executor.(*gorp.DbMap); ok {
+	} else if dbMap, ok := e.sqlExecutor().(*gorp.DbMap); ok {
 		return dbMap.Prepare(query)
 	}
 
@@ -406,9 +558,10 @@ func (e *SQLExecutor) Prepare(query string) (*sql.Stmt, error) {
 }
 
 func (e *SQLExecutor) ExecByQuery(query string, args ...interface{}) (sql.Result, error) {
-	defer UpdateStorageSQLMetric(OperationExec, e.serverAddress, time.Now())
+	done := e.observe(OperationExec, query, args...)
+	result, err := e.sqlExecutor().Exec(query, args...)
+	done(err)
 
-	result, err := e.executor.Exec(query, args...)
 	if err != nil {
 		return result, errors.New("error executing DB query, query: '" + query + "', error: '" + err.Error() + "'")
 	}
@@ -440,6 +593,14 @@ func (e *SQLExecutor) Exec(query interface{}, args ...interface{}) (sql.Result,
 	return nil, errors.New("could not prepare SQL query")
 }
 
+func (e *SQLExecutor) ExecByQueryContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return e.withContext(ctx).ExecByQuery(query, args...)
+}
+
+func (e *SQLExecutor) ExecContext(ctx context.Context, query interface{}, args ...interface{}) (sql.Result, error) {
+	return e.withContext(ctx).Exec(query, args...)
+}
+
 func (e *SQLExecutor) ExecSelect(builder *sq.SelectBuilder) (sql.Result, error) {
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -8,29 +8,70 @@ import (
 )
 
 const (
-	MetricQueryDuration = database.ComponentName + "_query_duration_seconds"
+	MetricQueryDuration       = database.ComponentName + "_query_duration_seconds"
+	MetricQueryErrors         = database.ComponentName + "_query_errors_total"
+	MetricTransactionDuration = database.ComponentName + "_transaction_duration_seconds"
+	MetricTransactionRetries  = database.ComponentName + "_transaction_retries_total"
 
+	OperationBegin  = "begin"
 	OperationExec   = "exec"
 	OperationCreate = "create"
 	OperationSelect = "select"
 	OperationInsert = "insert"
 	OperationUpdate = "update"
 	OperationDelete = "delete"
+
+	TxOutcomeCommit   = "commit"
+	TxOutcomeRollback = "rollback"
+	TxOutcomeFailed   = "failed"
 )
 
-var metricStorageSQLQueryDuration = snitch.NewTimer(MetricQueryDuration, "Response time of queries to the database")
+var (
+	metricStorageSQLQueryDuration = snitch.NewTimer(MetricQueryDuration, "Response time of queries to the database")
+	metricStorageSQLQueryErrors   = snitch.NewCounter(MetricQueryErrors, "Number of failed queries to the database")
+	metricStorageSQLTxDuration    = snitch.NewTimer(MetricTransactionDuration, "Duration of transactions by outcome")
+	metricStorageSQLTxRetries     = snitch.NewCounter(MetricTransactionRetries, "Number of transaction retries after deadlock or serialization errors")
+)
 
 func Describe(ch chan<- *snitch.Description) {
 	metricStorageSQLQueryDuration.Describe(ch)
+	metricStorageSQLQueryErrors.Describe(ch)
+	metricStorageSQLTxDuration.Describe(ch)
+	metricStorageSQLTxRetries.Describe(ch)
 }
 
 func CollectStorageSQL(ch chan<- snitch.Metric) {
 	metricStorageSQLQueryDuration.Collect(ch)
+	metricStorageSQLQueryErrors.Collect(ch)
+	metricStorageSQLTxDuration.Collect(ch)
+	metricStorageSQLTxRetries.Collect(ch)
 }
 
-func UpdateStorageSQLMetric(operation, server string, startAt time.Time) {
-	metricStorageSQLQueryDuration.With(
+func UpdateStorageSQLMetric(operation, executor string, startAt time.Time) {
+	UpdateStorageSQLQueryMetric(operation, executor, "", startAt, nil)
+}
+
+func UpdateStorageSQLQueryMetric(operation, executor, statement string, startAt time.Time, err error) {
+	labels := []string{
 		"operation", operation,
-		"server", server,
+		"executor", executor,
+		"statement", statement,
+	}
+
+	metricStorageSQLQueryDuration.With(labels...).UpdateSince(startAt)
+
+	if err != nil {
+		metricStorageSQLQueryErrors.With(labels...).Inc()
+	}
+}
+
+func UpdateStorageSQLTxMetric(outcome, executor string, startAt time.Time) {
+	metricStorageSQLTxDuration.With(
+		"outcome", outcome,
+		"executor", executor,
 	).UpdateSince(startAt)
 }
+
+func IncStorageSQLTxRetries(executor string) {
+	metricStorageSQLTxRetries.With("executor", executor).Inc()
+}
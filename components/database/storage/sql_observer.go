@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/components/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// не даем журналу медленных запросов бесконечно расти при генерируемых запросах
+const slowQueriesMaxStatements = 200
+
+type SlowQuery struct {
+	Statement string
+	Operation string
+	Executor  string
+	Count     uint64
+	Total     time.Duration
+	Max       time.Duration
+	Last      time.Duration
+	LastAt    time.Time
+}
+
+func (q SlowQuery) Average() time.Duration {
+	if q.Count == 0 {
+		return 0
+	}
+
+	return q.Total / time.Duration(q.Count)
+}
+
+// общие для мастера, слейвов и транзакций настройки наблюдения за запросами
+type sqlObserver struct {
+	tracingEnabled     int64
+	slowQueryThreshold int64
+	logger             atomic.Value
+
+	slowMutex   sync.Mutex
+	slowQueries map[string]*SlowQuery
+}
+
+func newSQLObserver() *sqlObserver {
+	return &sqlObserver{
+		slowQueries: make(map[string]*SlowQuery),
+	}
+}
+
+func (o *sqlObserver) SetTracingEnabled(enabled bool) {
+	if enabled {
+		atomic.StoreInt64(&o.tracingEnabled, 1)
+	} else {
+		atomic.StoreInt64(&o.tracingEnabled, 0)
+	}
+}
+
+func (o *sqlObserver) SetSlowQuery(threshold time.Duration, logger logging.Logger) {
+	if logger != nil {
+		o.logger.Store(logger)
+	}
+
+	atomic.StoreInt64(&o.slowQueryThreshold, int64(threshold))
+}
+
+// начинает наблюдение за запросом, возвращаемая функция должна быть вызвана по завершению запроса
+func (o *sqlObserver) start(ctx context.Context, e *SQLExecutor, operation, query string, args []interface{}) func(error) {
+	startAt := time.Now()
+
+	var span opentracing.Span
+
+	if ctx != nil && atomic.LoadInt64(&o.tracingEnabled) == 1 && opentracing.SpanFromContext(ctx) != nil {
+		span, _ = tracing.StartSpanFromContext(ctx, database.ComponentName, "SQL "+operation)
+
+		ext.DBType.Set(span, "sql")
+		ext.DBInstance.Set(span, e.dialect)
+		ext.DBStatement.Set(span, query)
+		ext.PeerAddress.Set(span, e.serverAddress)
+		ext.SpanKindRPCClient.Set(span)
+	}
+
+	return func(err error) {
+		statement := NormalizeStatement(query)
+
+		if err == sql.ErrNoRows {
+			err = nil
+		}
+
+		UpdateStorageSQLQueryMetric(operation, e.String(), statement, startAt, err)
+
+		if span != nil {
+			if err != nil {
+				tracing.SpanError(span, err)
+			}
+
+			span.Finish()
+		}
+
+		threshold := time.Duration(atomic.LoadInt64(&o.slowQueryThreshold))
+		if threshold <= 0 {
+			return
+		}
+
+		duration := time.Since(startAt)
+		if duration < threshold {
+			return
+		}
+
+		o.addSlowQuery(operation, e.String(), statement, duration)
+
+		if logger, ok := o.logger.Load().(logging.Logger); ok {
+			logger.Warn("Slow query",
+				"operation", operation,
+				"executor", e.String(),
+				"duration", duration.String(),
+				"query", query,
+				"args", redactArgs(args),
+			)
+		}
+	}
+}
+
+func (o *sqlObserver) addSlowQuery(operation, executor, statement string, duration time.Duration) {
+	key := operation + "\x00" + executor + "\x00" + statement
+
+	o.slowMutex.Lock()
+	defer o.slowMutex.Unlock()
+
+	item, ok := o.slowQueries[key]
+	if !ok {
+		if len(o.slowQueries) >= slowQueriesMaxStatements && !o.evictSlowQuery(duration) {
+			return
+		}
+
+		item = &SlowQuery{
+			Statement: statement,
+			Operation: operation,
+			Executor:  executor,
+		}
+		o.slowQueries[key] = item
+	}
+
+	item.Count++
+	item.Total += duration
+	item.Last = duration
+	item.LastAt = time.Now()
+
+	if duration > item.Max {
+		item.Max = duration
+	}
+}
+
+// вытесняет запрос с наименьшим максимальным временем, если он быстрее нового
+func (o *sqlObserver) evictSlowQuery(duration time.Duration) bool {
+	var (
+		minKey string
+		min    *SlowQuery
+	)
+
+	for key, item := range o.slowQueries {
+		if min == nil || item.Max < min.Max {
+			minKey = key
+			min = item
+		}
+	}
+
+	if min == nil || min.Max >= duration {
+		return false
+	}
+
+	delete(o.slowQueries, minKey)
+
+	return true
+}
+
+// SlowQueries возвращает медленные запросы, отсортированные по убыванию максимального времени
+func (o *sqlObserver) SlowQueries(limit int) []SlowQuery {
+	o.slowMutex.Lock()
+	list := make([]SlowQuery, 0, len(o.slowQueries))
+
+	for _, item := range o.slowQueries {
+		list = append(list, *item)
+	}
+	o.slowMutex.Unlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Max > list[j].Max
+	})
+
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+
+	return list
+}
+
+func (o *sqlObserver) ResetSlowQueries() {
+	o.slowMutex.Lock()
+	o.slowQueries = make(map[string]*SlowQuery)
+	o.slowMutex.Unlock()
+}
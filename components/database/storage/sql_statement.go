@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const statementMaxLength = 512
+
+var (
+	statementStringPattern = regexp.MustCompile(`'(?:[^']|'')*'`)
+	statementBindPattern   = regexp.MustCompile(`\$\d+|:\d+|@p\d+`)
+	statementNumberPattern = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	statementListPattern   = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	statementRowsPattern   = regexp.MustCompile(`\(\.\.\.\)(?:\s*,\s*\(\.\.\.\))+`)
+	statementSpacePattern  = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeStatement приводит запрос к виду без литералов и значений, чтобы однотипные запросы
+// группировались в метриках и журнале медленных запросов под одним ключом
+func NormalizeStatement(query string) string {
+	query = statementStringPattern.ReplaceAllString(query, "?")
+	query = statementBindPattern.ReplaceAllString(query, "?")
+	query = statementNumberPattern.ReplaceAllString(query, "?")
+	query = statementListPattern.ReplaceAllString(query, "(...)")
+	query = statementRowsPattern.ReplaceAllString(query, "(...)")
+	query = strings.TrimSpace(statementSpacePattern.ReplaceAllString(query, " "))
+
+	if len(query) > statementMaxLength {
+		query = query[:statementMaxLength] + "..."
+	}
+
+	return query
+}
+
+// для операций gorp без SQL в качестве запроса используются типы сущностей
+func entitiesStatement(operation string, list ...interface{}) string {
+	types := make([]string, 0, len(list))
+
+	for _, item := range list {
+		if item == nil {
+			continue
+		}
+
+		t := reflect.TypeOf(item)
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		types = append(types, t.String())
+	}
+
+	return operation + " " + strings.Join(types, ", ")
+}
+
+// значения аргументов могут содержать персональные данные, поэтому в журнал попадают только их типы
+func redactArgs(args []interface{}) []string {
+	redacted := make([]string, len(args))
+
+	for i, arg := range args {
+		if arg == nil {
+			redacted[i] = "nil"
+		} else {
+			redacted[i] = reflect.TypeOf(arg).String()
+		}
+	}
+
+	return redacted
+}
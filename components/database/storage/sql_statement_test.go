@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeStatement(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "placeholders",
+			query: "SELECT * FROM users WHERE id = ?",
+			want:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			name:  "numbers",
+			query: "SELECT * FROM users WHERE id = 10 AND rate > 0.5 LIMIT 20",
+			want:  "SELECT * FROM users WHERE id = ? AND rate > ? LIMIT ?",
+		},
+		{
+			name:  "numbers in identifiers",
+			query: "SELECT t1.col2 FROM table1 t1",
+			want:  "SELECT t1.col2 FROM table1 t1",
+		},
+		{
+			name:  "strings",
+			query: "SELECT * FROM users WHERE name = 'John' AND note = 'it''s 42'",
+			want:  "SELECT * FROM users WHERE name = ? AND note = ?",
+		},
+		{
+			name:  "postgres binds",
+			query: "UPDATE users SET name = $1 WHERE id = $12",
+			want:  "UPDATE users SET name = ? WHERE id = ?",
+		},
+		{
+			name:  "oracle binds",
+			query: "SELECT * FROM users WHERE id = :1",
+			want:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			name:  "mssql binds",
+			query: "SELECT * FROM users WHERE id = @p1",
+			want:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			name:  "IN lists",
+			query: "SELECT * FROM users WHERE id IN (?, ?, ?)",
+			want:  "SELECT * FROM users WHERE id IN (...)",
+		},
+		{
+			name:  "IN lists of different length are equal",
+			query: "SELECT * FROM users WHERE id IN (1,2)",
+			want:  "SELECT * FROM users WHERE id IN (...)",
+		},
+		{
+			name:  "multi row insert",
+			query: "INSERT INTO users (name, age) VALUES (?, ?), (?, ?), ('a', 3)",
+			want:  "INSERT INTO users (name, age) VALUES (...)",
+		},
+		{
+			name:  "whitespace",
+			query: "\n  SELECT *\n\tFROM users\n  WHERE id = ?  \n",
+			want:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			name:  "empty",
+			query: "",
+			want:  "",
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, NormalizeStatement(c.query), c.name)
+	}
+}
+
+func TestNormalizeStatement_LongQuery_Truncated(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT " + strings.Repeat("column_name, ", 100) + "id FROM users"
+	normalized := NormalizeStatement(query)
+
+	assert.Len(t, normalized, statementMaxLength+len("..."))
+	assert.True(t, strings.HasPrefix(query, strings.TrimSuffix(normalized, "...")))
+}
+
+func TestEntitiesStatement(t *testing.T) {
+	t.Parallel()
+
+	type user struct{}
+	type group struct{}
+
+	u := &user{}
+
+	assert.Equal(t, "insert storage.user, storage.group", entitiesStatement("insert", &u, group{}, nil))
+}
+
+func TestRedactArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"int", "string", "nil", "*int"}, redactArgs([]interface{}{1, "secret", nil, new(int)}))
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-gorp/gorp"
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// unavailableBalancer балансировщик, у которого все реплики исключены
+type unavailableBalancer struct{}
+
+func (unavailableBalancer) Get() database.Executor {
+	return nil
+}
+
+func (unavailableBalancer) Set([]database.Executor) {}
+
+func newTestSQL() *SQL {
+	return &SQL{
+		masterExecutor: &SQLExecutor{name: "master"},
+		slaveExecutors: []*SQLExecutor{{name: "slave"}},
+	}
+}
+
+func TestSQL_SlaveWithoutAvailableReplicas_MasterIfAllowed(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	s := newTestSQL()
+	s.SetBalancer(unavailableBalancer{})
+
+	a.Nil(s.Slave())
+	a.Nil(s.Executor())
+
+	s.AllowUseMasterAsSlave()
+	a.Equal(s.Master(), s.Slave())
+
+	s.DisallowUseMasterAsSlave()
+	a.Nil(s.Slave())
+}
+
+func TestSQL_SlaveWithoutBalancer_Master(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSQL()
+
+	assert.Equal(t, s.Master(), s.Slave())
+}
+
+func TestGet_WithoutAvailableReplicas_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSQL()
+	s.SetBalancer(unavailableBalancer{})
+
+	_, err := database.Get[struct{}](context.Background(), s.Slave())
+	assert.Equal(t, database.ErrExecutorUnavailable, err)
+}
+
+type upperTypeConverter struct{}
+
+func (upperTypeConverter) ToDb(val interface{}) (interface{}, error) {
+	return val, nil
+}
+
+func (upperTypeConverter) FromDb(target interface{}) (gorp.CustomScanner, bool) {
+	if _, ok := target.(*string); !ok {
+		return gorp.CustomScanner{}, false
+	}
+
+	return gorp.CustomScanner{
+		Holder: new(string),
+		Target: target,
+		Binder: func(holder, target interface{}) error {
+			*target.(*string) = strings.ToUpper(*holder.(*string))
+			return nil
+		},
+	}, true
+}
+
+func TestSQLExecutor_ScanTarget_UsesTypeConverter(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor := &SQLExecutor{dbMap: &gorp.DbMap{}}
+
+	var value string
+
+	holder, bind := executor.ScanTarget(&value)
+	a.Equal(&value, holder)
+	a.Nil(bind)
+
+	executor.dbMap.TypeConverter = upperTypeConverter{}
+
+	number := new(int)
+	holder, bind = executor.ScanTarget(number)
+	a.Equal(number, holder)
+	a.Nil(bind)
+
+	holder, bind = executor.ScanTarget(&value)
+	if a.NotNil(bind) {
+		*holder.(*string) = "value"
+		a.NoError(bind())
+		a.Equal("VALUE", value)
+	}
+}
@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+)
+
+const (
+	DefaultTxMaxRetries   = 3
+	DefaultTxRetryBackoff = time.Millisecond * 50
+
+	txRetryBackoffMax = time.Second * 5
+)
+
+type txContextKey struct{}
+
+// состояние открытой транзакции, передается через контекст во вложенные вызовы WithTx
+type txState struct {
+	executor   *SQLExecutor
+	savepoints int
+}
+
+// сообщения об ошибках, после которых транзакцию безопасно повторить целиком.
+// исполнитель оборачивает ошибки драйверов в строки, поэтому проверка идет по тексту
+var txRetryableErrors = map[string][]string{
+	DialectMySQL: {
+		"Error 1213", // deadlock found when trying to get lock
+		"Error 1205", // lock wait timeout exceeded
+	},
+	DialectPostgres: {
+		"40001", "could not serialize access",
+		"40P01", "deadlock detected",
+	},
+	DialectMSSQL: {
+		"was deadlocked on",
+	},
+	DialectOracle: {
+		"ORA-00060", // deadlock detected while waiting for resource
+		"ORA-08177", // can't serialize access for this transaction
+	},
+	DialectSQLite3: {
+		"database is locked",
+		"database table is locked",
+	},
+}
+
+func isRetryableTxError(dialect string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := err.Error()
+
+	for _, pattern := range txRetryableErrors[dialect] {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *SQL) SetTxRetry(maxRetries int, backoff time.Duration) {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	if backoff <= 0 {
+		backoff = DefaultTxRetryBackoff
+	}
+
+	atomic.StoreInt64(&s.txMaxRetries, int64(maxRetries))
+	atomic.StoreInt64(&s.txRetryBackoff, int64(backoff))
+}
+
+// WithTx выполняет fn в транзакции на мастере. Если fn возвращает ошибку или паникует, то транзакция
+// откатывается, иначе фиксируется. Транзакция целиком повторяется при дедлоках и ошибках сериализации.
+// Вызов WithTx с контекстом исполнителя транзакции (executor.Context()) открывает точку сохранения
+// вместо новой транзакции, повторы для вложенных вызовов не выполняются
+func (s *SQL) WithTx(ctx context.Context, opts *sql.TxOptions, fn func(database.Executor) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if state, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return state.savepoint(ctx, fn)
+	}
+
+	maxRetries := int(atomic.LoadInt64(&s.txMaxRetries))
+	backoff := time.Duration(atomic.LoadInt64(&s.txRetryBackoff))
+
+	for attempt := 0; ; attempt++ {
+		err := s.tx(ctx, opts, fn)
+
+		if attempt >= maxRetries || !isRetryableTxError(s.masterExecutor.dialect, err) {
+			return err
+		}
+
+		IncStorageSQLTxRetries(s.masterExecutor.String())
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > txRetryBackoffMax {
+			backoff = txRetryBackoffMax
+		}
+	}
+}
+
+func (s *SQL) tx(ctx context.Context, opts *sql.TxOptions, fn func(database.Executor) error) (err error) {
+	startAt := time.Now()
+	name := s.masterExecutor.String()
+	state := &txState{}
+
+	executor, err := s.masterExecutor.BeginTx(context.WithValue(ctx, txContextKey{}, state), opts)
+	if err != nil {
+		UpdateStorageSQLTxMetric(TxOutcomeFailed, name, startAt)
+		return err
+	}
+
+	state.executor = executor.(*SQLExecutor)
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = executor.Rollback()
+			UpdateStorageSQLTxMetric(TxOutcomeRollback, name, startAt)
+
+			panic(r)
+		}
+	}()
+
+	if err = fn(executor); err != nil {
+		if e := executor.Rollback(); e != nil {
+			err = errors.New(err.Error() + ", rollback failed: " + e.Error())
+		}
+
+		UpdateStorageSQLTxMetric(TxOutcomeRollback, name, startAt)
+
+		return err
+	}
+
+	if err = executor.Commit(); err != nil {
+		UpdateStorageSQLTxMetric(TxOutcomeFailed, name, startAt)
+		return err
+	}
+
+	UpdateStorageSQLTxMetric(TxOutcomeCommit, name, startAt)
+
+	return nil
+}
+
+func (t *txState) savepoint(ctx context.Context, fn func(database.Executor) error) (err error) {
+	t.savepoints++
+	name := "sp_" + strconv.Itoa(t.savepoints)
+
+	executor := t.executor.withContext(ctx)
+	create, rollback, release := savepointStatements(executor.dialect, name)
+
+	if _, err = executor.ExecByQuery(create); err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_, _ = executor.ExecByQuery(rollback)
+
+			panic(r)
+		}
+	}()
+
+	if err = fn(executor); err != nil {
+		if _, e := executor.ExecByQuery(rollback); e != nil {
+			err = errors.New(err.Error() + ", rollback to savepoint failed: " + e.Error())
+		}
+
+		return err
+	}
+
+	if release != "" {
+		_, err = executor.ExecByQuery(release)
+	}
+
+	return err
+}
+
+func savepointStatements(dialect, name string) (create, rollback, release string) {
+	switch dialect {
+	case DialectMSSQL:
+		return "SAVE TRANSACTION " + name, "ROLLBACK TRANSACTION " + name, ""
+	case DialectOracle:
+		return "SAVEPOINT " + name, "ROLLBACK TO SAVEPOINT " + name, ""
+	}
+
+	return "SAVEPOINT " + name, "ROLLBACK TO SAVEPOINT " + name, "RELEASE SAVEPOINT " + name
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/go-gorp/gorp"
+)
+
+// beginTx начинает транзакцию в пуле sql.DB с опциями. Драйверы MySQL, PostgreSQL и SQLite
+// применяют опции сами при BEGIN, для остальных диалектов транзакция начинается без опций,
+// а параметры выставляются первыми запросами внутри нее
+func beginTx(ctx context.Context, db *sql.DB, dialect string, opts *sql.TxOptions) (*sql.Tx, []string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if opts == nil || (opts.Isolation == sql.LevelDefault && !opts.ReadOnly) {
+		tx, err := db.BeginTx(ctx, nil)
+		return tx, nil, err
+	}
+
+	switch dialect {
+	case DialectMySQL, DialectPostgres, DialectSQLite3:
+		tx, err := db.BeginTx(ctx, opts)
+		return tx, nil, err
+	}
+
+	statements, err := txOptionsStatements(dialect, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+
+	return tx, statements, err
+}
+
+func txOptionsStatements(dialect string, opts *sql.TxOptions) ([]string, error) {
+	statements := make([]string, 0, 1)
+
+	var level string
+
+	switch opts.Isolation {
+	case sql.LevelDefault:
+	case sql.LevelReadUncommitted, sql.LevelReadCommitted, sql.LevelRepeatableRead, sql.LevelSerializable, sql.LevelSnapshot:
+		level = strings.ToUpper(opts.Isolation.String())
+	default:
+		return nil, errors.New("isolation level " + opts.Isolation.String() + " isn't supported")
+	}
+
+	switch dialect {
+	case DialectMSSQL:
+		if opts.ReadOnly {
+			return nil, errors.New("read only transaction isn't supported by " + dialect)
+		}
+
+		statements = append(statements, "SET TRANSACTION ISOLATION LEVEL "+level)
+
+	case DialectOracle:
+		switch {
+		case opts.ReadOnly && level != "":
+			return nil, errors.New("read only transaction with isolation level isn't supported by " + dialect)
+		case opts.ReadOnly:
+			statements = append(statements, "SET TRANSACTION READ ONLY")
+		case opts.Isolation == sql.LevelReadCommitted || opts.Isolation == sql.LevelSerializable:
+			statements = append(statements, "SET TRANSACTION ISOLATION LEVEL "+level)
+		default:
+			return nil, errors.New("isolation level " + opts.Isolation.String() + " isn't supported by " + dialect)
+		}
+
+	default:
+		return nil, errors.New("transaction options aren't supported by " + dialect)
+	}
+
+	return statements, nil
+}
+
+// gorpTransaction оборачивает начатую транзакцию в gorp.Transaction. В gorp нет конструктора,
+// принимающего sql.Tx, поэтому поля заполняются по имени, а при несовпадении структуры
+// возвращается ошибка
+func gorpTransaction(dbMap *gorp.DbMap, tx *sql.Tx) (*gorp.Transaction, error) {
+	transaction := &gorp.Transaction{}
+	value := reflect.ValueOf(transaction).Elem()
+
+	fields := map[string]interface{}{
+		"dbmap": dbMap,
+		"tx":    tx,
+	}
+
+	for name, v := range fields {
+		field := value.FieldByName(name)
+		if !field.IsValid() || field.Type() != reflect.TypeOf(v) {
+			return nil, errors.New("unexpected structure of gorp.Transaction, field " + name + " not found")
+		}
+
+		reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(v))
+	}
+
+	return transaction, nil
+}
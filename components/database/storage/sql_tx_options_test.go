@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-gorp/gorp"
+	"github.com/stretchr/testify/assert"
+)
+
+const fakeTxDriverName = "shadow-storage-fake-tx"
+
+// fakeTxRecorder запоминает опции BEGIN и запросы одного тестового пула, пул выбирается по DSN
+type fakeTxRecorder struct {
+	mutex      sync.Mutex
+	opts       []driver.TxOptions
+	statements []string
+}
+
+func (r *fakeTxRecorder) last() driver.TxOptions {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.opts[len(r.opts)-1]
+}
+
+func (r *fakeTxRecorder) executed() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]string(nil), r.statements...)
+}
+
+type fakeTxDriver struct {
+	recorders sync.Map
+}
+
+func (d *fakeTxDriver) Open(dsn string) (driver.Conn, error) {
+	r, _ := d.recorders.LoadOrStore(dsn, &fakeTxRecorder{})
+	return &fakeTxConn{recorder: r.(*fakeTxRecorder)}, nil
+}
+
+type fakeTxConn struct {
+	recorder *fakeTxRecorder
+}
+
+func (c *fakeTxConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeTxConn) Close() error {
+	return nil
+}
+
+func (c *fakeTxConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeTxConn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.recorder.mutex.Lock()
+	c.recorder.opts = append(c.recorder.opts, opts)
+	c.recorder.mutex.Unlock()
+
+	return fakeTx{}, nil
+}
+
+func (c *fakeTxConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.recorder.mutex.Lock()
+	c.recorder.statements = append(c.recorder.statements, query)
+	c.recorder.mutex.Unlock()
+
+	return driver.RowsAffected(0), nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+var (
+	fakeTxDrivers = &fakeTxDriver{}
+	fakeTxDSN     uint64
+)
+
+func init() {
+	sql.Register(fakeTxDriverName, fakeTxDrivers)
+}
+
+func newFakeTxExecutor(t *testing.T, dialect string) (*SQLExecutor, *fakeTxRecorder) {
+	dsn := strconv.FormatUint(atomic.AddUint64(&fakeTxDSN, 1), 10)
+
+	db, err := sql.Open(fakeTxDriverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	// пул открывает соединение, а вместе с ним и recorder, только при первом запросе
+	if err = db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, _ := fakeTxDrivers.recorders.Load(dsn)
+
+	return &SQLExecutor{
+		executor: &gorp.DbMap{Db: db, Dialect: gorp.SqliteDialect{}},
+		dialect:  dialect,
+		name:     dialect + ">",
+	}, r.(*fakeTxRecorder)
+}
+
+func TestSQLExecutor_BeginTxWithOptions_DriverReceivesOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, dialect := range []string{DialectMySQL, DialectPostgres, DialectSQLite3} {
+		dialect := dialect
+
+		t.Run(dialect, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+
+			executor, recorder := newFakeTxExecutor(t, dialect)
+
+			tx, err := executor.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true})
+			a.NoError(err)
+			a.NoError(tx.Commit())
+			a.Equal(driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSerializable), ReadOnly: true}, recorder.last())
+
+			tx, err = executor.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+			a.NoError(err)
+			a.NoError(tx.Rollback())
+			a.Equal(driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelReadCommitted)}, recorder.last())
+
+			a.Empty(recorder.executed())
+		})
+	}
+}
+
+func TestSQLExecutor_BeginTxWithOptions_StatementsInTransaction(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		dialect   string
+		opts      *sql.TxOptions
+		statement string
+	}{
+		{DialectMSSQL, &sql.TxOptions{Isolation: sql.LevelSnapshot}, "SET TRANSACTION ISOLATION LEVEL SNAPSHOT"},
+		{DialectOracle, &sql.TxOptions{Isolation: sql.LevelSerializable}, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE"},
+		{DialectOracle, &sql.TxOptions{ReadOnly: true}, "SET TRANSACTION READ ONLY"},
+	}
+
+	for _, c := range cases {
+		executor, recorder := newFakeTxExecutor(t, c.dialect)
+
+		tx, err := executor.BeginTx(context.Background(), c.opts)
+		if assert.NoError(t, err, c.dialect) {
+			assert.NoError(t, tx.Commit(), c.dialect)
+		}
+
+		assert.Equal(t, driver.TxOptions{}, recorder.last(), c.dialect)
+		assert.Equal(t, []string{c.statement}, recorder.executed(), c.dialect)
+	}
+}
+
+func TestSQLExecutor_BeginTxWithUnsupportedOptions_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		dialect string
+		opts    *sql.TxOptions
+	}{
+		{DialectMSSQL, &sql.TxOptions{ReadOnly: true}},
+		{DialectOracle, &sql.TxOptions{Isolation: sql.LevelRepeatableRead}},
+		{DialectOracle, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}},
+		{DialectMSSQL, &sql.TxOptions{Isolation: sql.LevelLinearizable}},
+	}
+
+	for _, c := range cases {
+		executor, recorder := newFakeTxExecutor(t, c.dialect)
+
+		_, err := executor.BeginTx(context.Background(), c.opts)
+		assert.Error(t, err, c.dialect)
+		assert.Empty(t, recorder.opts, c.dialect)
+	}
+}
+
+func TestSQLExecutor_BeginWithoutOptions_DriverReceivesDefaults(t *testing.T) {
+	t.Parallel()
+
+	for _, dialect := range []string{DialectPostgres, DialectMSSQL} {
+		executor, recorder := newFakeTxExecutor(t, dialect)
+
+		tx, err := executor.Begin()
+		if assert.NoError(t, err, dialect) {
+			assert.NoError(t, tx.Commit(), dialect)
+		}
+
+		assert.Equal(t, driver.TxOptions{}, recorder.last(), dialect)
+		assert.Empty(t, recorder.executed(), dialect)
+	}
+}
+
+func TestSQLExecutor_BeginTx_TransactionExecutesInTx(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	executor, recorder := newFakeTxExecutor(t, DialectMySQL)
+
+	tx, err := executor.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true})
+	a.NoError(err)
+
+	_, err = tx.ExecByQuery("DELETE FROM users")
+	a.NoError(err)
+	a.NoError(tx.Commit())
+	a.Equal(sql.ErrTxDone, tx.Rollback())
+
+	a.Equal([]string{"DELETE FROM users"}, recorder.executed())
+}
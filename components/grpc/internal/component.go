@@ -12,6 +12,7 @@ import (
 	"github.com/mrsmtvd/shadow/components/grpc/stats"
 	"github.com/mrsmtvd/shadow/components/i18n"
 	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/misc/listener"
 	g "google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/health"
@@ -114,7 +115,7 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) error {
 	}
 
 	addr := net.JoinHostPort(c.config.String(grpc.ConfigHost), c.config.String(grpc.ConfigPort))
-	lis, err := net.Listen("tcp", addr)
+	lis, err := listener.Listen(c.Name(), "tcp", addr)
 
 	if err != nil {
 		c.logger.Errorf("Failed to listen [%d]: %s\n", os.Getpid(), err.Error())
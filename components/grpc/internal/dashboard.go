@@ -1,15 +1,15 @@
 package internal
 
 import (
+	"io/fs"
 	"net/http"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/grpc/internal/handlers"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -20,7 +20,7 @@ func (c *Component) DashboardMenu() dashboard.Menu {
 
 func (c *Component) DashboardRoutes() []dashboard.Route {
 	return []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
+		dashboard.RouteFromFS(c),
 		dashboard.NewRoute("/"+c.Name()+"/", handlers.NewManagerHandler(c)).
 			WithMethods([]string{http.MethodGet, http.MethodPost}).
 			WithAuth(true),
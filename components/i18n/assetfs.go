@@ -3,9 +3,11 @@ package i18n
 import (
 	"bytes"
 	"io"
-	"path/filepath"
+	"io/fs"
+	"path"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
+	"github.com/mrsmtvd/shadow/misc/bindata"
 )
 
 const (
@@ -13,13 +15,13 @@ const (
 	MessagesDirName  = "LC_MESSAGES"
 )
 
+// Deprecated: use LocalesFromFS
 func FromAssetFS(fs *assetfs.AssetFS) map[string][]io.ReadSeeker {
-	root, err := fs.Open("")
-	if err != nil {
-		return nil
-	}
+	return LocalesFromFS(bindata.FS(fs), ".")
+}
 
-	dirs, err := root.Readdir(0)
+func LocalesFromFS(f fs.FS, dir string) map[string][]io.ReadSeeker {
+	dirs, err := fs.ReadDir(f, dir)
 	if err != nil {
 		return nil
 	}
@@ -27,22 +29,23 @@ func FromAssetFS(fs *assetfs.AssetFS) map[string][]io.ReadSeeker {
 	locales := make(map[string][]io.ReadSeeker, len(dirs))
 
 	for _, d := range dirs {
-		localeDir, err := fs.Open(filepath.Join(d.Name(), MessagesDirName))
-		if err != nil {
+		if !d.IsDir() {
 			continue
 		}
 
-		localeFiles, err := localeDir.Readdir(0)
+		localeDir := path.Join(dir, d.Name(), MessagesDirName)
+
+		localeFiles, err := fs.ReadDir(f, localeDir)
 		if err != nil {
 			continue
 		}
 
-		for _, f := range localeFiles {
-			if f.IsDir() || filepath.Ext(f.Name()) != TranslateFileExt {
+		for _, file := range localeFiles {
+			if file.IsDir() || path.Ext(file.Name()) != TranslateFileExt {
 				continue
 			}
 
-			content, err := fs.Asset(filepath.Join(fs.Prefix, d.Name(), MessagesDirName, f.Name()))
+			content, err := fs.ReadFile(f, path.Join(localeDir, file.Name()))
 			if err != nil {
 				continue
 			}
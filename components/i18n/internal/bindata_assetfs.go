@@ -1,331 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// templates/views/toolbar.html
-// assets/vendors/bootstrap-languages/languages.min.css
-// assets/vendors/bootstrap-languages/languages.png
-// locales/ru/LC_MESSAGES/config.mo
-// DO NOT EDIT!
-
-package internal
-
-import (
-	"github.com/elazarl/go-bindata-assetfs"
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _templatesViewsToolbarHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\xcb\x8e\xd4\x30\x10\xbc\xf3\x15\x2d\x6b\x0e\xbb\x12\x49\xe0\x80\x84\x86\xc9\x72\xe5\xb0\x5c\x10\x7c\x40\x4f\xd2\xc9\x18\x39\xed\xc8\xed\x2c\x20\x2b\xff\x8e\x9c\xec\x38\xde\x79\xdc\xca\xed\x52\x55\x77\x55\x08\xd0\x52\xa7\x99\x40\x35\x96\x3d\xb1\x57\x30\xcf\xef\x00\x00\x42\x00\xdd\x41\xef\xe1\xc1\x10\x43\x69\x6c\x83\x86\xe4\x11\x3e\x44\xc2\xc2\x38\x18\xfd\xb4\x80\x57\xba\x78\xf4\xba\xf9\xf6\xf3\xfb\x33\x3c\xac\xf8\xd7\x8f\x67\x50\x95\xfe\xf8\x99\x2b\x14\x21\x2f\xd5\x0b\x71\x6b\x9d\x54\x47\x6b\xbd\x78\x87\x63\x61\x90\xfb\x09\x7b\x92\x2a\xa1\x72\xd0\x5c\x36\x22\x0a\x3a\x34\x42\x8f\xe7\x95\x16\x53\x84\x93\xa3\xae\x56\xbf\xf1\x05\xa5\x71\x7a\xf4\xfb\x2f\x0a\x1a\x83\x22\xb5\x6a\x9d\x1d\x5b\xfb\x87\x0b\x6f\xfb\xde\x10\x68\xee\x6c\xc1\xd3\x70\x24\xa7\xa0\x45\x8f\xaf\x1f\x1b\x53\x01\x3a\x8d\x05\xfd\x1d\x91\x5b\x6a\x6b\xb5\x58\xaa\xed\xb0\xc5\x54\x9f\x0d\xe2\x8e\x85\x0c\x0a\x22\xa8\x55\x08\x50\x36\x93\x73\xc4\x1e\xe6\x59\x81\xf8\x7f\x51\xdc\xdb\x71\x5f\x7e\xa2\x41\x3d\x1d\xaa\x2c\xa3\x43\x85\xd9\x63\x32\x57\x5b\x0f\xc4\x13\xa4\xd7\x24\xe4\x96\xc9\x38\x19\x53\x38\xdd\x9f\xfc\xc5\x5e\x21\x80\x43\xee\x09\x76\xfa\x3d\xec\xd6\x8a\x60\x5f\xa7\xb6\xf2\xe0\x92\x6f\xde\xda\x9b\x8f\x73\xb2\x6b\xf3\x4c\x49\x71\x97\xdd\xb8\xb6\xd9\x9c\xa2\x6d\xf5\x75\x25\xd4\x21\x24\xee\x3c\x87\x00\x64\x24\xa2\xbc\xa2\x38\xe5\x36\x86\x74\xdb\xfd\x66\xcc\xf9\xe5\x5b\xe2\x9b\xd5\x45\xbe\x57\x7a\x32\x22\xbf\x91\x34\x47\x73\x57\x28\x92\xef\x24\x93\xf7\xb6\x0d\x2f\x83\x4c\x27\x66\x85\x4f\x66\xe5\x6c\xec\x8d\x95\xd0\xff\x00\x00\x00\xff\xff\xc3\xe6\x1a\x59\x86\x03\x00\x00"
-
-func templatesViewsToolbarHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesViewsToolbarHtml,
-		"templates/views/toolbar.html",
-	)
-}
-
-func templatesViewsToolbarHtml() (*asset, error) {
-	bytes, err := templatesViewsToolbarHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/views/toolbar.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsBootstrapLanguagesLanguagesMinCss = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5b\xdf\x93\xdb\xb6\x11\xfe\x57\x32\x79\x49\x3b\x13\xa5\x04\xf8\xfb\x3c\xcd\xcc\x9d\x4e\xfe\xd1\xd6\x99\x4c\xec\xf4\xa5\xee\x03\xee\x84\x93\x18\x51\x94\x4c\x52\x77\x67\x7b\xfc\xbf\x77\x44\x4a\x22\x88\x6f\x77\x4f\x4e\xed\x87\x0c\x8e\xc0\xe2\xc3\x2e\x16\xd8\xfd\xb0\x91\x7f\x2a\x4d\xb5\x98\x3c\x36\x9f\x6e\xcc\xed\x6a\x51\x6f\x76\xd5\x7c\xb2\xdd\x34\x45\x5b\x6c\xaa\x8b\xe0\xbb\x49\x94\x86\xdb\xc7\x67\xeb\xa2\x9a\x3c\x14\xf3\x76\x79\xa1\xa2\xed\xe3\xb3\xa5\x2d\x16\xcb\xf6\x42\xa9\xc3\xd0\xe8\xdb\x3c\x8e\xbe\x1d\xdc\xda\x6e\xad\x69\x2f\xaa\xcd\xe1\xaf\x67\xf3\xa2\xd9\x96\xe6\xc3\x45\x51\x95\x45\x65\x27\x37\xe5\xe6\x76\xe5\x4e\x28\xd6\x66\x61\x2f\x76\x75\xf9\x97\xbd\x96\x3b\xb3\xb0\xcd\x4f\xdb\x6a\xf1\xd7\xcf\xbd\xd6\xcd\x9a\xd3\x5a\xa9\x54\x8f\xd4\xd6\xda\x51\x3b\xf1\xd4\x4e\x3c\xb5\x93\x6f\xab\x76\xb9\xe0\xd4\xd6\x2a\x8c\x46\x6a\x87\xc1\xa0\xb6\xd6\x63\xb5\xfb\xef\x41\xed\xee\xfb\x1b\xaa\xfd\xd8\xfc\x67\xdf\xfe\xdd\xd4\xff\x65\xf4\x0f\x3c\xc9\x1b\xcb\x49\x4e\xf6\x47\xc3\x97\x5e\xb0\xd2\x7b\xd3\x3c\xe9\xdb\x86\x95\x0e\x43\x90\x9e\x1b\x56\x3a\x8a\x50\x9a\xd7\x3b\x8e\x41\xda\x96\xac\x74\x92\xa0\x74\xc5\x4a\xa7\x29\x4a\xf3\x56\x66\x19\x4a\xb7\xac\x74\x9e\x83\xf4\x5d\x21\x78\x27\x40\x71\xd6\xed\x13\xa5\xd1\x9b\x0b\x7e\xc7\x55\x88\xee\x5c\x0a\xca\x44\xe8\xcf\xa5\xa0\x4c\x8c\x0e\x5d\xee\x78\xf1\x04\x3d\x5a\xf0\x3e\x52\x29\xba\xb4\xe0\x9d\xa4\x32\xf4\x69\xc1\x7b\x49\xe5\xe8\xd4\xe2\x81\xbf\x16\x01\x7a\xf5\x0f\x7e\xdf\xb5\x46\xaf\xae\x36\xbc\x78\x88\x5e\x2d\x79\xdd\x75\x84\x5e\x2d\xef\x79\xf1\x18\xbd\xba\x5e\xf1\xe2\x09\x7a\x75\xcd\xef\xbb\x4e\xd1\xab\x6b\x41\xf7\x0c\xbd\x5a\xf1\xd7\x5a\xe7\xe8\xd5\x8a\xdf\xc8\x30\x40\xaf\x6e\x79\xf4\x50\xa1\x57\xb7\xbc\xee\x61\x88\x5e\xad\x05\x65\x22\xf4\x6a\xcd\x5f\x8f\x30\x46\xaf\x36\xbc\x9b\xc2\x04\xbd\xda\x08\xa6\xa6\xe8\xd5\xe6\x3d\x2f\x9e\xa1\x57\x1b\x3e\x12\x84\x39\x7a\xb5\xe1\x4f\x64\x14\xa0\x57\xdb\x25\x2f\xae\xd0\xab\x2d\xaf\x4c\xa4\xd1\xab\x3b\x7e\x23\xa3\x08\xbd\x7a\xcf\xc7\xc8\x28\x46\xaf\x7e\x14\x74\x4f\x06\xaf\x36\xeb\xa7\xd2\xfa\x24\xca\x22\x10\x17\x72\x7b\x1c\x04\x28\xce\x27\xf7\x58\x25\x20\x2e\x64\xf7\x38\x44\xdd\x85\xf4\x1e\x47\x19\x8a\x0b\xba\x27\x68\xaa\x90\xe0\xe3\x0c\x4d\x15\x32\x7c\x9c\xa3\xa9\x42\x8a\x4f\x14\x9a\x2a\xe4\xf8\x44\xa3\xa9\x42\x92\x4f\x22\x34\x55\x48\xf2\x49\x82\xa6\x0a\x49\x3e\x49\xd1\x54\x21\xc9\x27\x39\x9a\x2a\x24\xf9\x34\x40\x53\x85\x24\x9f\x6a\x34\x55\x48\xf2\x69\x84\xa6\x0a\x49\x3e\x8d\xd1\x54\x21\xc9\xa7\x29\x9a\x2a\x24\xf9\x34\x43\x53\x85\x24\x9f\x05\x68\xaa\x90\xe4\x33\x8d\xa6\x0a\x49\x3e\x0b\xd1\x54\x21\xc9\x67\x31\x9a\x2a\x24\xf9\x2c\x41\x53\x85\x24\x9f\x11\x61\x49\x48\xf2\x39\x11\x96\x84\x24\x9f\x13\x61\x49\x48\xf2\x39\x11\x96\x84\x24\x9f\x13\x61\x49\x48\xf2\x39\x11\x96\x84\x24\x9f\x13\x61\x49\x48\xf2\x39\x11\x96\x84\x24\xaf\x02\x22\x2e\x09\x59\x5e\x05\x44\x60\x12\xd2\xbc\x0a\x88\xc8\x24\xe4\x79\x15\x10\xa1\x49\x48\xf4\x2a\x20\x62\x93\x90\xe9\x55\x40\x04\x27\x21\xd5\x2b\x45\x44\x27\x21\xd7\x2b\x45\x84\x27\x21\xd9\x2b\x45\xc4\x27\x21\xdb\x2b\xe5\x04\xa8\x72\xf1\x64\xba\x57\xca\x09\x39\x47\x79\xe9\x2d\xaf\x9d\xe7\xe2\x49\x9e\x4f\xf8\x4a\x3b\x77\xe5\x28\x2f\x64\x7c\xa5\x9d\x27\xdd\x51\x5e\x48\xf9\x4a\xa7\x68\xaf\x90\xf3\x95\xce\xd1\x5e\x21\xe9\xab\x50\xa3\xbd\x42\xd6\x57\x61\x84\xf6\x0a\x69\x5f\x85\x09\xda\x2b\xe4\x7d\x15\x66\x68\xaf\xf4\xba\x8f\x02\xb4\x57\x7a\xde\x47\x21\xda\x2b\xbd\xef\xa3\x18\xed\x15\x1f\xf8\x29\xda\x2b\xbd\xf0\xa3\x1c\xed\x95\x9e\xf8\xb1\x42\x7b\xa5\x37\x7e\x1c\xa1\xbd\xd2\x23\x3f\x4e\xd0\x5e\xe9\x95\x1f\x67\x68\xaf\xc0\x00\x54\x12\xa0\xbd\x02\x05\x50\x2e\x11\x3c\xca\x0b\x1c\x40\x25\x31\xda\x2b\x90\x00\x95\xa4\x68\xaf\xc0\x02\x54\x92\xa3\xbd\x02\x0d\x50\xa9\x42\x7b\x05\x1e\xa0\xd2\x10\xed\x15\x88\x80\x4a\x13\xb4\x57\x60\x02\x2a\xcd\xd0\x5e\x81\x0a\x28\x97\x83\x1d\xe5\x05\x2e\xa0\x32\x8d\xf6\x0a\x64\x40\x65\x11\xda\x2b\xb0\x01\x95\xa5\x68\xaf\x40\x07\x54\x96\xa3\xbd\x12\x1f\xc8\x15\xda\x2b\xf1\x81\x3c\x44\x7b\x25\x3e\x90\xc7\x68\xaf\xc4\x07\x5c\xfa\x73\x92\x17\x4a\x51\x41\x80\xf6\x0a\x7c\x40\x07\x1a\xed\x15\xf8\x80\x0e\x22\xb4\x57\xe0\x03\x3a\x48\xd0\x5e\x81\x0f\xe8\x20\x47\x7b\x05\x3e\xa0\x95\xc3\xdf\xca\x9b\xf2\xc2\xdc\xb5\xb6\xfe\x74\xbb\xa9\x5a\x5b\xb5\x17\xdf\xff\x5e\xad\xaa\xcd\x43\xf5\x5d\x79\xf8\xbf\x00\xdf\x0f\xa2\x13\x5b\x7d\x89\xf4\xdd\xae\xfc\x12\xf4\x13\x33\xf1\xe7\xbc\x0b\x82\x20\xd1\x69\xd7\x44\x51\xd7\x84\x79\xdf\xa8\x7e\x2c\xeb\xc7\x2e\xfb\xaf\x1c\x40\x6f\x2c\x09\x1a\xa9\x6e\x7e\x14\xc6\x7d\x73\xd5\x37\x41\xd7\x44\x87\x26\xec\x9b\x83\x64\xb7\x44\x14\x27\xb8\xc4\x42\x5c\xa2\xd7\xed\xb4\x44\x48\xad\x34\x5a\x22\xcc\x60\x89\xdb\x86\x5c\x42\x05\x53\xdb\xb5\x89\x6a\x8b\xca\xc0\xb4\xb9\x81\x69\xd7\xa6\x6a\x56\x28\x88\xbb\x74\x6d\x77\x6d\x73\xbb\x04\x51\x5b\x92\xaa\x84\x79\xb7\x93\xe1\xd5\xd5\xa8\x49\xfb\xe6\xba\x6f\xf2\xbe\xe9\xcc\x0c\x2f\xa7\x88\x5d\x01\xf6\xac\x5a\x94\x45\x43\xa8\x81\x3b\x32\x6b\xb6\x66\x8f\x1c\x3c\x57\x9b\x12\x27\xb4\x38\xc1\x36\x6d\x01\x82\x77\x05\x08\xbe\xd9\x6d\xd6\x84\x20\x9e\xd7\xe7\xb5\xa9\x3a\x15\x66\xa9\x29\x1a\x98\xb1\x40\x7f\xbc\x30\xb6\x28\x89\xcb\xb0\x44\x2d\xf6\xc0\x79\x7f\xfc\xf3\xf0\x79\xd7\x04\xba\x6b\x74\xd2\x35\x51\x80\x30\xa8\xe3\xcb\xfa\xde\xb4\xcd\x0a\xed\x59\xee\x40\xf6\xb5\x59\x7c\x30\x35\x48\x16\xe8\xa7\x2b\xb3\x34\x4d\xbf\xfd\x3a\x28\xaa\xf9\xa6\xb2\x4d\x81\x27\xb2\xa0\x0f\x72\x30\xbd\x6e\x4a\x5b\x35\x2b\x62\x06\xfa\xed\x55\x6b\xca\xc2\x54\x1b\x94\x7d\x20\xd1\xe3\x59\xb7\x4d\xf1\xb5\xea\xbf\xb2\xfe\x2b\xef\xbf\x2e\x01\xe6\x0f\x74\xd3\xbb\x20\x48\xe2\xd9\xfe\x88\x27\xa9\x9e\xbe\x0b\x82\xec\x32\x9f\xc1\xc4\xd5\x86\x9a\x78\x1d\xc7\xfb\x19\x97\xd7\xc9\xfe\x1a\x4c\xe3\xab\x08\x26\x96\x68\xe4\xbf\x0a\xdb\xee\xee\x8b\xee\x7a\xa7\x21\xce\xb8\xc7\x19\xa6\xbd\x2f\x8e\xf1\x60\x07\x33\xd6\x2b\x26\x4c\x4d\xdd\x88\x74\x8c\x41\x87\xb8\x18\xf5\xcd\xac\x6f\xae\xcf\x8b\x56\x6b\x74\xf2\xe8\x78\xac\x6d\x69\x3e\x10\x0a\xe2\x26\xbc\x36\x25\x71\x43\x2b\x0c\x41\xbf\xd8\xb9\xad\x4b\x53\xcd\xf1\xd2\x55\xe8\x94\x5f\x36\x35\x11\x04\xb7\x08\xfb\xeb\xa6\xa4\x6e\xca\x16\x35\xfd\x75\x53\xb7\xbb\xc5\xae\xbf\xfc\x97\xa8\x45\x8d\x5a\xfc\xb6\x59\xf7\xe2\xba\xea\x83\x39\x7a\xb9\xc6\x2b\xd9\x6d\xba\xa6\x32\x94\xef\x97\xbe\xc1\x94\xd8\xe0\x41\x78\x53\x6e\xee\xed\x41\x8b\x6b\x2a\x93\x34\xb8\x37\xce\x9c\x44\x49\x53\xdf\xe3\xd4\xe5\xfb\x62\x8b\x31\xaf\xa1\x09\x40\xa4\x95\x9b\x2c\xfb\xd0\xf7\xf4\x29\x6c\xf0\x86\xbc\xb9\xa7\x63\x4c\xbb\x24\x17\x9e\xf5\x94\x63\xa6\xba\x2c\x36\xd3\x1a\x27\xa2\xc6\x6f\xfb\x14\x34\xad\x57\x87\x44\x80\x76\xee\x98\x9b\xa8\x43\xd7\xa4\xa3\xb9\x7d\x13\xa7\xc4\x15\x8c\xa6\xa3\x3d\xc0\xf0\x7f\x8f\x59\xe4\xed\x3e\xa4\xa8\xd9\xd5\xf3\x6a\x71\xb8\x8e\xf7\x7d\xcf\x34\x6d\x61\xfe\x47\x72\x63\xa2\x99\xbe\xee\x02\x62\x96\x8e\x29\x22\xcb\xe3\x2e\x6b\x73\x53\xdc\x52\xc2\x04\x3f\xbb\xb2\xa5\xa9\x77\x4d\x61\x2a\x72\x02\xb2\xad\xab\x5d\xb9\x30\x35\x23\x4f\x50\xa7\xe9\x47\x3b\x22\x36\x27\x59\x9a\x2f\x8d\xe9\xc7\x20\x8c\x9a\xbf\xb0\xf5\x9a\xd6\x82\x60\x4d\x2f\x6a\x6b\x57\xa4\xec\x39\x2c\x68\x90\x46\xfb\xde\x6c\x59\xa5\x29\x16\xd4\xb4\x9b\x8a\xd9\x3c\x82\x0b\x3d\x2f\x2a\x0e\x9c\x24\x44\xb6\xa2\xf7\x9a\xe0\x42\xaf\x6a\x06\x98\x20\x43\x2f\x8b\x6a\x5e\x90\xb2\xa8\xc4\xb4\xde\x98\x96\xb1\x90\x20\x3d\x2f\x77\x15\x7f\x9c\x08\xea\xf3\xea\xc8\x76\xe8\x09\xe8\x9f\x57\xb7\x76\x9f\xaa\xe8\x0b\xc1\xf3\x1d\x52\x1a\x19\xcf\x4b\x7b\x53\xdb\x07\x4a\x98\xe0\x35\xff\x30\x5b\x53\xd9\xc6\x52\xe2\x04\x9b\xf9\xe7\xa6\xb6\xb4\x22\x24\x83\x69\x97\x3b\xc3\x1d\x2d\x8e\xc0\xd0\xd2\x04\x79\x79\x6d\x6e\xed\x9c\x3d\xb9\x04\x07\x79\x6d\x4a\xf3\x81\x94\xa5\x89\x07\xb3\x2d\x04\xfb\xb8\xde\xb5\xf4\x21\xa7\xb9\xc7\x83\x5d\x30\x5a\xd3\x14\x84\xb9\x15\x3c\x0b\x61\x34\xa7\x39\x08\xeb\x21\x82\x7c\xfc\xb6\x6b\xb8\x73\xce\xb0\x0a\x43\x86\x38\x96\x4e\x70\xe0\xc8\x21\x2e\xcb\x1b\x56\x73\x82\x48\xbc\xb1\xf5\x0d\x27\x4d\x10\x85\x07\x3b\x67\x76\x9d\xe0\x0a\x6f\x97\x86\x0c\x45\x14\x3b\xd8\xd5\x2b\x06\x98\x60\x05\xbf\xaf\x6a\x53\x70\x46\x12\xb9\xfd\xdf\x85\x6d\x2b\xb3\x66\xdc\x4f\x24\xf3\xe9\xb2\xf0\x6f\xff\xdd\xae\xfc\x8a\xf5\x98\x8e\x62\x5c\x06\x7f\x3b\xb4\xc8\x03\x86\xe5\xbe\x7e\xa5\xc6\x5b\x9c\xe6\x15\x8e\x02\x5f\xbd\x8e\xe3\x2b\x40\xf1\x94\x61\xfd\xf3\x8a\x3c\x1e\x26\x70\x99\x01\x8f\xab\xfe\x78\x08\xc8\x70\x1c\x08\xb6\x2e\xe4\x81\x20\xf3\x19\x40\xbe\x62\xc5\xc8\x5f\xd5\xa7\x50\xce\xa2\x2c\x89\xf2\x30\x08\x6a\xe5\xa0\x3c\x51\x65\xf2\xb0\x08\xe2\xe5\x60\x31\x05\x28\x5f\x1f\x82\x8e\x0d\x20\x5c\x71\xca\x03\x21\x48\x9a\x83\xf1\x54\xdd\xca\x07\x03\x0e\x37\x60\xf1\x15\x2d\x0f\x04\xb8\xdd\x80\xf1\xe7\x4a\x5d\x1e\x3e\xf0\x41\x07\x9f\xaf\x81\xf9\x97\x89\x20\x8a\x0e\x0e\x57\x1f\xf3\x55\xa1\xf8\xe3\x00\x73\x76\xf1\xcc\xdf\x41\x92\x66\x3a\xb8\x4f\x95\xd6\x7c\x3c\x8a\x85\x3a\x70\x7c\xdd\xcd\x07\x42\x7a\xea\xc0\xfc\xa9\x92\x9c\xbf\xa3\x40\x69\x87\x05\xce\x2d\xd6\x79\x90\x14\xf1\x1d\x40\xcf\x2d\xe4\x79\xa0\x48\x8f\x07\xc8\x27\x4b\x7c\x1e\x16\xcd\x9e\x1d\xbc\xa7\x0a\x80\x3e\x1e\x92\xeb\x01\xec\x9b\xd5\x06\x3d\x25\x68\xce\xee\xe8\x71\x56\xe5\x10\x40\x3d\x5e\xef\xe0\x31\x25\x45\x44\xf0\xd9\xfe\x80\x21\x56\x1b\xfd\x5c\xea\x3f\x04\x1c\x18\xa6\x0c\xe9\x21\x90\xcf\x83\x01\x85\xad\x51\x7a\x30\xf8\x6a\x70\x30\x9e\xac\x5e\x02\x18\xf5\xaa\x18\x00\xcf\xaa\x6d\x7a\x98\xd4\xc3\xc3\x41\xfc\x0a\x75\x4f\x7f\x41\x7c\xba\x0c\xeb\x9d\x51\x12\xf5\x53\x3d\xbc\x6d\x1c\xb4\xb3\x8b\xa5\x04\x28\xbc\x81\x1c\x5c\xae\x92\xea\xc1\x50\x4f\x23\x07\xe5\xff\xa9\xb2\xfa\x0a\xe3\xab\xca\x59\x88\x2d\xc0\xfa\x28\xf8\xda\x1a\x50\xce\xad\xcd\x7a\x98\xfe\x9b\xcc\x01\x3c\xa7\x66\xeb\xa3\xe1\xb3\x6d\x00\xfc\x66\xe5\x5c\x4f\x09\xf2\x35\x38\xa8\xf1\x85\xb5\x5e\x0f\x9c\x7e\x3a\x0e\xe8\x4f\x56\x82\x7d\x2a\xe5\xbd\x2c\x17\x17\x37\xf6\x6e\x53\xdb\x1f\xfb\xef\x66\x3d\xfe\x7e\x6c\x0e\xdf\x27\xf8\x1f\x0e\x40\x3f\x7c\x3e\x4a\x9c\xd4\xfa\x11\x7a\x26\xb6\x22\x3a\xf7\xda\x7f\xda\x9a\xf9\xbc\xd8\x77\xd8\xbb\xfe\x5f\x78\x7e\x3e\x6a\xe0\xe3\x39\x3d\x03\x9e\xdb\x89\x78\xa3\xdf\x83\xf8\x78\x4e\xcf\x80\xe7\x76\x22\xde\xe8\xf7\x91\xee\xec\xf1\x6e\xf9\x20\xec\xa8\xbf\xe9\x3c\xa4\x6f\x27\x3b\xea\xfb\x8d\x87\xf4\x5d\xc1\x8e\x82\xeb\x7f\x38\xed\xc1\xc9\xa4\xc3\xe1\x6b\x37\xdb\x8b\xe0\xd9\xe9\x47\x2d\xb5\x2d\x4d\x5b\xdc\xdb\x93\x4f\x3b\x01\xb5\x7d\x14\x44\x1c\xa4\x89\x24\xf9\xd8\x74\x32\x91\x28\xe2\x82\x71\x92\xd6\xcc\x7f\x3e\x9a\xd1\x49\xf6\x4e\x76\xbb\x5d\x18\x7f\xf4\x60\x54\x02\xdd\xee\x24\x7f\xf4\xa0\x7c\x06\xdd\xee\xa4\xfd\x68\xb3\x36\x65\x39\x5e\x69\xbf\x2b\x5e\xbf\x33\xcb\x1f\x3c\xac\xa4\xa1\xdb\xb7\x69\xa9\xc6\xbb\x90\x8f\xfb\x5c\xf1\xd1\xd0\xd1\xa9\x7a\xdc\x39\x72\xe3\x68\xec\xa0\x51\xf7\xdb\x34\xa7\x73\x34\xa1\x1b\xd3\x63\x85\xe2\x71\x9f\x2b\x3f\x1a\x3a\x28\x94\x8d\xfb\xfc\x8d\x3d\x0d\x1d\xd5\x09\xc6\x9d\x23\x75\xba\xb1\x70\xac\x8e\x1a\xf7\x79\xc7\x76\x18\x3a\xa8\x13\x8f\xfb\x40\xfb\x10\x4f\x86\xd3\x07\xda\x47\x63\x65\xfa\x25\x23\x4a\x9b\xd1\xc8\x70\x03\xdd\x3e\xd0\x3d\xfa\xd9\xbf\x63\x6e\x9f\x77\xa9\x3e\x2f\xe3\x13\xd2\x8f\xce\xdf\x6e\x48\x18\x64\x9c\xf3\xe8\xf4\xc1\x61\x4c\x1c\xc8\x84\x81\x4c\x3c\x0f\xba\xf3\x3c\x07\xee\xef\xd9\x4d\x5b\x8d\x77\x41\xfb\xbd\x70\xcd\x87\x41\x67\x27\x46\xbd\xfe\x5e\xec\x07\xf7\xff\x4d\x1e\x9b\xc1\x02\xaa\x73\x64\x0a\x0a\x1c\xd6\x0b\x49\xc8\xf1\xb2\x23\x99\x66\x4d\x2c\xeb\x74\xd2\xcb\x9e\x04\xa8\x65\x9d\x41\x76\xd9\x72\x81\x97\x83\x1a\xa4\x9c\x32\x96\x39\xf8\x86\x46\x1f\xbb\x88\x96\x39\x98\x90\x30\x83\x7e\x54\xfe\x5f\x00\x00\x00\xff\xff\xce\x90\x8b\x4c\x68\x43\x00\x00"
-
-func assetsVendorsBootstrapLanguagesLanguagesMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsBootstrapLanguagesLanguagesMinCss,
-		"assets/vendors/bootstrap-languages/languages.min.css",
-	)
-}
-
-func assetsVendorsBootstrapLanguagesLanguagesMinCss() (*asset, error) {
-	bytes, err := assetsVendorsBootstrapLanguagesLanguagesMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/bootstrap-languages/languages.min.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsBootstrapLanguagesLanguagesPng = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xba\x65\x5c\x54\xdf\xf7\xfe\x7d\x86\xee\x6e\x86\x12\x10\x90\xee\x0e\x41\xe9\x46\xa5\xbb\xa5\x6b\x68\x18\x41\x45\x3a\xa5\x1b\x04\x91\xee\x94\x4e\x29\xe9\x86\xa1\x63\x18\xba\xf3\x7e\xf9\xf9\xfe\x7f\xf7\x3c\xda\x4f\xf6\x7a\xed\x39\xe7\xac\x6b\x5d\xef\xb5\x76\xb8\xa6\xba\x02\x2e\x16\x15\x16\x00\x00\xb8\x4a\x8a\x6f\xb4\x01\x00\xa0\x05\x00\x0c\x47\x02\xb4\x7f\xab\x1f\x71\xaa\x00\x00\xa0\xd8\xbe\x56\x7b\x0d\x00\xd5\xb1\xd8\x0f\xe6\xa8\x00\x00\xd0\x5b\x2a\x6a\xab\x01\x80\xdf\x4b\x00\x80\x7e\x06\x80\x3b\x00\x00\xa0\x7b\x00\xe0\xc5\x03\x00\x07\x66\x00\x20\x96\x0a\x00\xe4\xae\x99\x2d\x5a\x12\x00\x00\xa0\x59\xa8\x28\xbc\x01\xfe\xfb\xdd\xca\xb5\x06\x03\x00\x02\xa6\xf4\xe6\xf5\x3b\x9f\xc5\xc3\x2c\x54\x23\x52\x35\xf2\xb0\xd7\x1c\x2d\xe2\x28\x98\x6c\xa8\x28\xef\x50\x48\x50\x1c\xab\xb0\x51\x90\xdf\xbe\x23\xc1\xd6\xc4\xd4\xc2\x71\x64\x7e\xaf\x2a\xab\x3a\x89\xa7\xda\x4b\x9f\x94\x7c\xa4\xc9\xab\x95\x7e\x9e\x65\x2c\xe3\x13\x8d\x4f\xfa\xc5\xdf\xcb\x7b\xed\xfa\xba\xc9\xc3\x76\x6c\xec\x79\x2c\x8d\xdf\x96\x4b\x98\x82\x82\xc2\xd1\x51\x40\x10\x24\x16\x6b\x1c\x14\x30\xc1\x60\x93\xf1\x3d\x5d\xac\xf7\x72\xd2\x20\x3a\x94\x49\x74\xa6\x38\xd1\xf3\x43\x25\x7d\xc9\xcf\xf6\x6c\x58\x02\xd8\xcb\xe4\xcc\x2f\xf3\x8e\xed\xac\xc5\x20\x90\xc0\x97\xa6\x92\x97\x7a\xe8\x63\xad\xb2\x4e\x0a\x2d\x0a\xff\x7c\x95\xfd\x67\x90\xeb\xaf\xc5\xcb\xb9\x01\x3c\xf6\xec\xb5\x49\x0f\x34\x62\xe8\xe7\x64\x8f\x96\x6a\xb0\xcc\x73\xac\xa1\xe9\x88\x18\x52\x2e\x61\x2b\xa3\x87\xba\x0b\xb4\xfd\xd8\xf8\x5a\x83\xa3\xfb\xcc\xdf\x70\xd3\x66\x01\x74\x92\xe9\xa6\xc9\x6b\xc7\xf4\x25\xb4\x9f\xb7\x85\xa0\x2d\x49\x77\x05\xc7\x2c\xf5\xa9\x1d\xf3\x83\x21\x08\x4f\xe9\x0d\xf5\xd7\x18\x54\xbd\xb6\xfb\xba\x78\xff\x17\x9d\x45\xd9\x29\xbb\xb4\xb3\xc0\x7c\xf3\xa1\xbc\xa3\x0c\x4f\xe7\xc1\x37\xcd\xd3\xc0\x34\x60\x09\x51\x91\x73\x45\x8e\x44\x51\xf1\x9b\xdb\x7b\x42\x64\x72\x8c\xc7\xcb\xf4\x8e\x40\xc2\x40\x92\x40\xc6\xba\xaf\xc1\x6e\x4a\x01\xc8\x2e\x3e\x3a\x94\x28\xc0\x81\xd5\xdd\x87\x24\xbb\xe0\x41\x15\xba\x68\xd6\xa7\xfc\x41\xcb\x3d\x95\x0e\x59\xae\x48\x7a\xfc\x9c\xd3\xf6\xd2\x2d\x27\x45\x3f\x52\x1e\xf1\x5c\xd0\x28\x48\xf7\x03\x6d\x45\x2d\x0d\x75\x8b\x03\xbb\x5c\x04\x21\x48\x8a\xc6\xcb\x71\xbc\x79\x38\x66\x46\x33\x9f\xba\xd8\x77\x91\x29\x7e\xfa\xbf\xe2\xd0\xc7\xcc\x1d\xad\xb7\x2d\x67\x6e\x29\x8b\xb8\x68\x40\x2e\xd2\x73\xc1\x18\xae\x70\x38\xc7\x03\xe1\x3c\x55\xb1\x5b\xad\x0a\x01\x43\x08\x6a\xb4\xd4\xe9\x78\x88\x85\x40\xd1\xdf\x50\x98\x89\x10\xbb\x40\x58\x02\x4d\xc5\xec\xc9\xcb\xd0\xf8\xe3\x4e\x9e\x90\x48\xfe\xe9\xb1\xf8\xb6\xdc\x86\x18\x8e\x1c\x5e\xd7\x97\xbe\x8f\x4a\x9c\x69\x6d\x26\x92\xae\xc8\xf6\x61\xba\x9b\x54\x92\x6f\xf7\x3f\xd4\xd8\x7d\x06\x4e\x8c\x0d\xc4\x97\x30\x42\xdf\x05\xf7\x94\x67\xd8\xba\x99\x13\x94\x2e\xe4\x4b\xc9\xe1\xcb\x3c\x35\x1b\x5e\x0a\x78\x22\x3e\x09\x2e\x11\x8f\x9c\x86\xef\x7b\xac\x32\x4f\xf7\xa6\x3a\xb2\x14\xc7\xcb\xe6\x84\x90\x0a\x64\x23\x57\x0d\x95\x37\x50\x7e\x65\x64\xcd\xde\x05\x0b\x48\x91\xb3\x1d\x94\xf1\xae\x12\xc8\x5c\x7c\x9b\x8a\x72\x0b\xb8\x41\x19\x59\xce\xe4\x7b\x14\x82\x1e\x5e\xa9\x19\x25\xae\xc5\x23\x2e\x04\x3f\x2a\x71\xcd\x4c\xe7\xb2\x74\xfd\x9e\xa8\xaf\xcf\x30\x5e\x0a\x2b\x0c\x17\xdb\x9f\x54\x29\xfe\x65\xca\x24\xaa\x2b\x99\xce\x14\xd9\x82\xa9\xc4\x7f\xc9\x6a\x85\x4f\x6d\xa8\xc6\x79\x07\x5e\xef\xe0\x9f\x7a\x55\x23\xe5\xba\x93\xfa\x4a\x26\x3d\xab\x7e\x43\xd9\x73\x34\xcc\xff\xfd\xa8\x27\x22\x1e\x7f\xe6\x5d\xd8\x7e\xc2\x3b\x6a\xa1\xc3\xeb\xea\x61\xd4\x14\x09\xd1\x75\xd2\x36\x6c\x7a\x49\xdf\x05\x3b\x7f\x23\x1b\x5a\x67\x5d\xc1\xc9\xac\x6b\x72\x6c\x2e\x1b\xb1\x77\x10\x24\xfe\x92\xba\xb2\xbe\x62\x5d\xcb\x83\x9b\xbf\x2a\x33\x1f\xe6\x1e\xb9\xc9\x32\xdd\xfc\x47\x5f\xda\xe2\x88\x85\x0b\xf2\x8f\xec\x5a\x7e\xbd\x38\x29\x3b\x40\x5e\x89\xb2\xe9\xea\x79\x92\xed\x7a\xeb\xd8\xbe\x19\x6b\x26\xff\x7d\xac\xb1\xd7\xfe\x52\xe5\x70\x1e\x6f\x46\xab\x25\xb9\xc3\x94\x15\x31\xb2\xbe\x3f\x60\x82\x66\xfe\x2a\xf5\xc8\x9e\x7f\x04\x2f\x25\x27\xac\xf8\xfd\xdc\x3e\xad\x7c\xd1\x2f\x25\x7c\x6a\x65\x6d\x9b\xd4\xab\x63\xcb\xb9\x58\x9e\xb0\xe3\x79\xc6\x1f\x22\x61\x3a\xc9\x46\x26\xc6\xb8\x04\x3a\xa5\x54\xa5\xf0\x08\x1a\xf0\x2f\x88\x79\x2a\xdb\x50\x64\x47\xa2\x31\x97\x88\x43\xef\x47\x2d\xdb\xcc\x56\x06\x3c\x38\x0e\x19\x3e\x7a\xd5\x1c\x24\xdb\x22\x04\xfe\x43\x4e\xba\xd8\x2f\x91\x54\xd1\xb7\xf8\xeb\xda\x10\x17\xb9\x51\x0b\x4c\x66\xcb\x6d\x79\x97\x56\x50\xea\xe5\xc1\x06\x88\xe7\xec\xaf\x7d\x09\x65\x22\x48\xfe\x3c\x27\x22\xf5\x14\x60\x83\x46\xdd\x06\x1e\xba\x77\x6d\x07\xc6\xea\xa3\x9f\x23\x83\xe7\xa4\xe3\xab\x08\x75\xfa\x26\x62\xd5\x93\x55\x07\x56\xc6\xe6\x79\x59\x34\xc6\x52\x63\xc0\x91\x99\xa7\xf7\xf3\x97\xaf\xe1\xc9\xb1\xc3\x5a\x24\x3e\x75\x27\xae\x9f\x75\x70\xb1\xd4\x45\xf4\xa5\x25\xf3\xf4\x4f\xa2\x3a\x34\x79\x3c\xcf\x5e\x63\x16\xa1\x3c\xaf\x33\x2a\x72\xf6\x70\xcf\x82\x73\x6b\xe7\xb7\x43\x28\x99\x03\x17\x9c\x7c\x01\xb1\x48\x81\x13\x24\x68\xec\xb1\xbb\x43\x3e\x63\x56\x5f\x85\xd4\xea\x3d\x06\xe7\x71\x38\xcd\x3e\x07\xe4\xb2\xba\x67\xad\xf0\xba\x93\x04\x3a\x66\x31\x77\x1c\xf6\xdb\x6a\xba\xe9\x10\x24\x16\x69\xcc\x25\x22\xf8\x5e\x3d\x06\x3d\x54\xc9\x36\x9e\x68\x79\x21\x8c\xf3\x39\x3b\x63\xbd\x14\x50\x9e\x34\x55\x0b\x76\x79\x8a\x56\xcd\x8b\x97\x78\xce\xee\x0d\x15\x9f\xfb\xd9\xfb\x03\xc9\xec\x0c\x5a\xff\xa8\x2e\x81\xa2\x28\xf4\x47\x9d\x83\xfa\x3a\x3a\x7b\xf6\xb5\xa4\xc0\x03\x6a\x48\xb3\x76\x14\x8a\x11\x07\x20\xa2\x27\x9f\x24\x66\x0d\xd8\x61\x8c\xe3\x2e\x76\xd0\xc2\x90\x8e\x1f\x75\x4a\xec\xe7\x7b\x44\xab\x30\xd5\xeb\xf7\x4f\x15\x7e\x86\x50\xf9\xd1\x9a\x3b\x33\x32\x92\x93\x03\xce\x41\x05\x13\x5c\x15\xac\xcb\x0b\x32\x6b\xfa\x18\xb9\x1a\xf3\xbd\xdf\xca\x55\x40\x57\x4c\x28\x2e\x1d\xd4\x28\x27\x4a\xb9\x06\x95\x57\xf3\xe4\xdd\x0e\xfb\xfa\xae\xd8\x7d\xd1\xaa\x46\x42\xc8\xae\xb4\xfc\x49\x6e\xed\x18\x9d\xf7\x41\x49\x19\xec\xd4\xd0\xcb\x66\x44\x35\x03\x07\x80\x2a\x6e\x3a\xdd\xb1\xfb\x48\x2d\x7f\xa3\x12\xb1\xfe\xcd\x05\x80\x6d\xf0\x94\x79\xea\xa3\x75\x1e\x59\xb1\x22\xf2\xd1\xcd\xfc\x2d\x9e\x32\x46\x85\x81\x3f\x6f\x56\x12\xbd\x25\x91\x7c\x04\x2d\xc2\x0e\x9d\xa3\xda\xb9\x4d\x8f\xcc\x56\x47\x67\xa5\xec\x35\xaa\x7e\xc6\x7f\x1a\x16\x03\xaf\x73\x14\x3c\x52\xbf\x71\xfd\x9c\x85\x3b\x2e\x1e\x8b\xc4\x63\xfd\xfe\x58\xdc\x01\x5f\xe6\x5d\xaa\x59\xfc\x08\x07\xb4\x4e\xdc\xc8\x1f\x15\xf2\x42\xad\xc9\x02\x55\x39\xae\xa0\x79\x03\xe5\xcf\x22\x0b\x02\x63\x87\x9f\xcf\xd3\xad\x5b\xf4\x61\x92\xd5\x39\x94\x1c\x7a\xb3\x47\xd8\x33\xa7\x0e\xd2\x23\x2f\xb6\x12\xdb\xa1\x97\x91\x85\x42\x53\x7b\x5d\xa1\xbb\x79\x6d\x03\x9f\xbf\x6b\xc9\x20\xe5\x40\xbf\xa1\xf0\x45\xc7\x6a\xbc\xd9\x1a\x58\xb8\xd4\x12\x9b\xb3\x4b\x88\xe4\xc6\x5a\x71\x7a\x57\xb7\x4e\xdb\x09\xad\xee\xd1\x73\x9c\xb0\xc6\x97\x48\xc6\x9b\xf0\x8b\xa5\xc9\xea\x2a\x66\xb0\x40\x8b\x0b\x4a\x11\x96\x13\x01\x2a\x37\x83\xd5\x58\x47\x31\x76\xda\x9d\x98\x36\x5e\xa0\xf3\x7c\xec\x87\x09\xa8\x04\x41\x07\x57\x36\x32\xff\x0c\xf3\x5e\x7f\xdf\xf2\x22\x96\xed\xda\x0f\xbc\xa5\xf8\xc2\x5e\xdd\x33\x14\xf0\xf0\xc2\xa2\x83\x2b\xc9\x06\x65\x5c\xbf\x28\x59\xfa\x91\xb9\x73\xc5\xcf\xdc\x98\x12\xdc\x15\xf5\xd8\x16\xf9\xb9\xc2\x1c\xdd\xe3\xc6\xe7\x0d\x4a\x7c\x09\x0d\xb1\xa2\x47\x08\x9d\x84\x99\xa5\xce\x7b\xd4\x9b\x84\x89\xe6\xdf\x33\xdf\x87\x41\x67\xe3\x2d\x46\xf3\x39\x5f\xc2\x67\x6f\x52\xde\x57\xae\xc1\x8c\x1a\xb4\x02\x79\xd7\x81\x59\xea\x91\x00\x67\xe8\xc6\x1c\x62\x91\x91\x39\x95\xe8\x21\x2c\x76\xef\xb3\x89\x4b\x92\x9c\x16\x8c\xbc\xcd\x74\xfa\x77\x71\x08\x1b\x56\xb2\xf7\x62\x4b\x2c\x08\x15\xdf\xd7\x77\x43\xb0\x28\xf5\x34\xec\x8e\x9b\x3a\x96\xcd\xe6\xa2\x8a\xd6\x90\x44\x23\x60\xa5\x42\x12\xd2\x0c\xbb\x68\xd3\x0f\xfd\x60\xf1\xf7\x2e\x82\xb1\xc8\x7f\x21\x7b\x00\x67\x88\xfe\xe6\xef\x17\x0c\xe8\x9d\xc2\x71\x34\x7b\x31\xd2\x96\x84\x41\xbd\xe7\x12\x52\xc0\xb2\xf5\xd7\xf1\x08\xc4\x9a\xdd\x1c\x5e\xab\x91\xeb\x66\x4d\xba\x5b\xbd\xa5\xeb\xd2\x3d\x73\xf9\xc7\x77\x0e\xe8\x16\x67\xa5\x7a\x1d\xbf\xda\xa9\xd2\x90\x4b\xcd\xa8\x93\x73\x2a\xb2\x34\xc3\x86\xf5\x36\x37\xd2\xf3\xea\x17\x9d\x0e\x96\x02\x06\xc6\xc8\x0b\xe5\x53\xd3\x7b\xde\x25\x15\xa1\xe8\x84\x04\x6f\xc7\x16\x65\xa9\x28\xf3\x30\xdf\xc4\xd2\xbc\x24\x54\xfb\xf7\xd9\x37\xc3\xca\xf2\x8b\x62\x3c\xc9\x5a\x8d\x5c\xaf\xee\xff\xdf\x02\x8f\x58\x4e\x74\x22\x7e\xcc\x22\x05\xfb\x49\xb3\xce\x5a\x70\x22\x7e\xec\x05\x6e\xef\xee\x1b\x78\x91\x30\xb7\x17\x9e\xbd\x8c\x6b\x40\x80\x4e\x98\x58\x58\x12\x6c\x4c\x4a\xef\x3a\x75\x1c\xf7\x3d\xf2\x9e\x83\x7d\xed\xee\x24\x2e\x34\xf5\x67\x54\x20\xe9\x07\x40\xac\x9e\xeb\xae\x71\x19\xa4\xd7\x30\x82\x87\xeb\x8b\xd7\x29\xee\xf0\xb4\x7a\x72\x0f\x3c\x34\x24\x1f\x33\x1e\xc6\x25\x72\x78\xbc\x48\x7e\x92\x68\x35\xe2\xf1\x90\xae\x45\x67\xc0\x06\x31\x09\xa6\x44\x98\x58\xc8\x76\x7d\xa4\x44\x66\x47\x43\xc6\x91\x3c\x22\x21\xf3\x42\x87\x7a\xdc\x64\x6e\xf1\x38\x00\x2e\xf5\xec\xc7\xb5\xdd\xc0\xd1\xe9\x8f\x72\xff\x34\x64\x57\xff\x40\xe5\xe9\x61\x67\xe9\x71\x2e\xf2\x43\x46\xf7\x19\xc9\x52\x5a\x98\xde\xa6\x53\xb5\x44\x20\x74\x72\xd3\xc5\xe2\xd7\x2a\x52\xa6\x24\x41\xa2\x36\x13\x06\x4c\x10\x11\xa1\x4f\x16\xd3\x04\x2f\xad\x85\xb0\xed\xa5\xc2\xc7\x72\x70\x94\xc5\x70\xaa\x4c\x4e\xb6\x22\x0b\x7f\xe6\xd8\xa6\x60\x4b\xb9\xdc\x9c\x58\x6a\xf1\x74\x7e\xe9\xf7\x59\xd7\xc6\x85\xea\xd2\x49\xab\x96\x37\x03\x04\x3f\xd2\x25\xb6\xfa\x5c\xa1\x53\xeb\xf8\x6d\xd8\x73\x48\x65\xfb\xc7\xa6\xcf\x87\x84\x9d\x12\xa0\x9c\x09\x8d\x59\xe4\x11\xdd\x33\x6b\x9f\x84\xb3\xf3\xc3\x3b\xd1\x80\xbf\x01\x5a\xac\x58\x08\x07\xcb\x4d\xaf\x7d\xb2\x96\x8b\x2c\x9c\xd8\x88\xb7\x22\x61\xde\x22\xc1\x2d\xd6\x98\x9d\x96\x3c\xdc\x82\x60\xe3\xbb\xde\x62\xf1\xd2\x33\x65\x20\x41\x9e\x6c\xef\xf7\x14\x8a\xcf\x41\x6a\x23\x0c\xb1\xe7\xe2\xfa\xf8\x6c\x9b\x82\x4d\xc6\x5f\x07\x69\x76\x25\x94\x71\x2a\x0e\x84\x5d\x66\x00\x1a\x63\xd6\xd7\xc1\x34\x20\x9e\x59\xab\x8c\x2d\xc9\xe5\x93\xc8\xec\x5a\xb2\x76\x18\x5a\xee\x5e\xd5\xd9\xca\x7b\x4f\x04\x28\x65\x76\xb9\x83\x2c\xcc\xa8\xb5\xff\x78\xf1\xfb\xbf\xc3\x07\x15\xa5\xe8\xde\x41\xc4\x65\xfe\xec\x74\x67\x1c\xaf\x22\xbf\x33\xd0\x8d\x2d\xd1\x45\x19\x74\xe5\xb2\xbe\x77\x05\x14\x76\xe4\x56\xbf\x34\xb2\xc7\xcd\x9d\x5d\xb5\x09\x2a\x88\x6d\x8d\xfa\x8b\x1f\xb0\xc8\xdf\x44\x0e\xb4\x1a\x9d\x04\x90\x9a\x16\xb1\xd8\x8b\x08\x9b\xde\x15\x4a\x34\xe3\xf2\x14\xff\xf1\xbf\x27\xd6\x6a\x93\xda\xf2\x78\x50\x22\x18\x93\xc4\x5d\xcb\xd6\x98\x0c\x0e\x1c\x9b\x5c\x06\x3f\xcd\xd8\xa9\x34\x6a\xdd\xe9\xce\xe9\xb4\x18\x87\x05\x0b\x5a\xe6\x2f\xa9\x96\x06\x44\x39\xcc\x73\xa9\xf2\x28\xa3\x33\x67\x58\xe5\xa0\xa7\xfa\xfb\x1b\xdb\x8b\xd8\x98\x44\x6d\x5a\x29\xb4\x5e\xb0\x88\xb1\x0e\x6d\xb3\x82\x72\xfb\x84\xbf\xec\x76\x4a\x19\x25\xef\xa8\xe9\xd7\x0e\xd6\x7d\xd7\x1b\xf3\x32\xe3\xd6\xd7\xe8\xd7\x22\x5d\xfa\x9d\xbc\xd6\x5e\x8d\xdf\x59\x66\xcb\x3f\xee\xbf\xe1\xf7\x28\xe0\x1f\x9f\xbd\x49\x02\x6d\xbc\x64\xb1\xfd\xea\x3a\x09\x35\x3b\x56\xc1\xae\xf3\xe6\x02\x0d\xae\x1c\x9d\x40\xe0\xc6\x50\xbe\xa3\x3d\x4e\x4c\x59\x20\x66\xf7\x7a\x34\xdc\x1d\x6d\x8f\xb3\x60\xab\x39\x9c\xda\x2c\xee\xba\xd7\xdd\x34\x6a\x4c\x49\xa5\x58\x84\x3b\x3d\xd4\x88\xcf\x1a\xf1\x82\x9f\x03\x3f\x4d\x60\x4d\x98\x08\x5e\xbe\x27\xfd\x07\x41\xd1\x19\x21\x6e\x83\x7e\xd5\x86\xa6\x38\x63\x72\x04\x3b\xc0\xd5\xcc\xf3\xe7\xbc\xcd\x06\x64\xfe\x9e\xba\xb6\xda\xfe\xb6\x93\x71\xbd\x0e\x8b\x88\x15\x1a\x1d\x88\xf7\xca\xbc\xb3\x10\xae\xe8\x70\x3b\x9a\xc5\x19\x13\x91\xad\xcc\x79\x5b\x68\x3a\x0c\x88\x38\x7b\xd8\xf4\x27\xa2\x40\xd3\xd2\x86\x35\xb2\x6d\xba\x8c\x11\x87\x43\x52\x1f\x28\x66\x88\x9d\x58\x74\xb9\xb4\xd1\x52\xab\x57\xee\xcc\x3a\xb5\xa0\x81\xb1\x9c\x99\x0d\x9c\xe8\xac\x38\x90\xf4\xba\x47\xb0\x8c\xc9\x24\xf2\x84\x2d\x2e\x10\x29\x27\x44\x65\xd2\xab\x5a\xd1\x9a\x17\x30\x8e\xeb\x2b\x40\x3f\x9f\x64\x3f\xf3\xd5\x03\x1b\x50\x55\x39\x9c\xfd\x62\x86\x1e\x2b\x71\x3a\x1c\xd5\xbd\xfd\xd3\xf3\xf1\x53\x92\xcc\x07\xe8\xc1\xcd\x05\x94\x63\x59\x95\x64\x29\x29\x36\xa8\x03\x7d\xce\x16\xae\x1f\xae\xa2\x40\xaf\x91\xa1\xb4\x08\xbb\xcb\xa4\x0c\x2a\x37\xc4\x79\xc9\x4a\x2a\x19\xcb\x9b\x35\xd9\x75\xe7\x5c\xe9\xb3\x0a\x23\x39\xb9\x8e\xb5\x53\xec\x4e\x9a\x9e\xb6\x98\x29\xf0\xd8\x3b\x2b\x5a\xf9\x20\x9a\x95\xa1\x81\x1c\x82\x0d\x29\xe3\x9d\xff\x4c\xc3\x49\xd1\xf8\xd5\x9e\x18\x3f\x26\x6a\xea\x63\xa3\x5a\xaa\x0f\xc9\x74\xe5\xd0\xaa\x27\x99\xc2\x88\xd5\xfb\x6c\x76\x29\x50\x9f\xe4\x21\xc4\x05\xeb\xad\xee\x7b\x3a\x3b\xc1\xc9\x98\xad\x90\x78\x07\x20\x02\xac\x4b\xda\x14\xd3\xeb\x47\x0a\x21\x75\x16\xb9\xc6\x26\x45\x54\xb4\x94\xcb\x8a\x1b\x94\x7a\x0a\x41\x14\xc4\xfb\x1d\x44\x84\xc2\x19\x32\x8e\xa7\xb6\x43\x36\x77\x6b\x86\x94\x74\x37\xae\xda\xbe\x14\x39\x26\x4c\x1e\xc7\x7d\x74\xfc\x5d\xc8\xeb\x1d\xa3\x6b\x61\x99\xd2\x2f\x4a\x29\x0d\x55\x7b\xd7\x42\x46\x6f\x0e\xbe\x36\xb9\xdd\xf8\xd0\x90\x14\x5c\x31\x37\x59\xd1\x37\x46\x7e\x65\x6f\xfb\x39\x7a\xce\x95\x88\x71\x82\x8f\xef\x0b\x16\x44\x77\xf9\x43\x02\xe3\xf5\x22\xae\xc7\x5e\xbe\x97\xc8\xa4\xfc\x28\x63\x6b\x8b\x57\x61\xa4\xff\x22\x82\x41\xbe\xbd\x8f\x18\x8b\x6a\xd6\x8a\x30\xfb\xb5\x5f\x04\x91\x49\xac\xd3\x19\x38\x9d\xd2\x3c\xb3\x67\x6c\xd7\x55\xd7\x4a\xcc\x63\xe6\xd1\xa3\xd5\xf5\x48\x54\xc1\x8a\x39\xaf\xf3\xe3\x80\x6e\x88\x8b\x95\x9b\x73\x0d\xad\xa5\xe8\x17\x0f\xa1\xf3\xa3\x99\x03\x0a\x1c\x31\xca\xdd\x91\xbb\x45\x18\x71\x7c\xb5\xeb\x87\x6e\x6d\x49\x12\x89\x7a\xa3\xcd\xc7\x97\x65\x39\x71\x41\x0c\xd8\x05\x3e\xfb\x45\xc8\x1a\xc8\x69\x3c\x64\x6d\x17\xec\x0c\x16\x9f\xf6\x55\xc4\x53\x34\xe5\xdd\xf1\x5a\x09\xaa\xf3\xe1\x05\x75\x36\x1d\xe9\xb8\xa9\xec\xdc\xab\x4d\x5a\x0f\x1b\x41\xe0\x34\x71\xfc\x37\x44\xbc\x8c\xaf\x70\xbc\xf9\x56\x62\xb4\xfc\xd7\xa8\xb1\xe7\x9c\x6e\x50\x46\x44\xcc\x03\x5f\xd1\x13\x14\x9c\x47\x0c\xd1\x91\x5f\xb2\x14\xd4\x0b\x13\x96\xdb\xae\x9a\xb4\xef\x92\xa0\xb5\x6e\x32\x8e\xd9\x49\x75\x4d\x9f\x7a\x19\xbd\x19\xaa\x77\x8f\xba\x0a\x2b\x6f\x15\x8d\x34\xbb\xb8\xce\xb9\xb3\xf7\xa6\x90\xdf\x17\xe8\x4b\x52\x8b\xb4\x5f\xef\x49\x86\xac\x27\xe6\x04\xf5\xc6\x4f\xb7\x25\x7b\x68\x50\x19\x7b\x2d\xbb\x2b\xe8\x6a\x18\x69\x0c\x8f\xa8\x1f\x76\xc5\x5c\x43\x10\xd2\x7d\x08\xa6\x8a\xc6\x5d\x96\x51\xc6\xd9\xa5\xe4\xcb\x46\x6b\x49\xda\x2d\xfe\xd3\x2f\xdc\x47\x21\x7e\xe7\x6c\x4e\xab\xbd\x51\xf0\xeb\xfe\x40\x03\xad\xa6\x60\x2e\x38\xed\x6f\xc6\x3c\xee\x8a\x63\x29\xbc\xf7\x86\x05\xa0\x36\x7e\xf2\xbd\xa3\x4c\x39\xe4\x90\x35\xde\x6c\x23\xe2\x17\xb1\x94\x13\x4b\x6f\xc5\x62\x2c\x79\xe4\x7e\x65\x50\x6e\xef\x7f\xa6\x9c\x62\xb9\x7d\xa4\xb6\x1e\xc4\x51\x4e\x1f\xcc\x35\xe0\xfb\xe5\x62\x65\x60\x85\xf6\xf5\xb2\x89\xc6\xed\xd1\x46\x20\x6e\xc6\x43\xab\x33\x7e\xa5\xe3\x48\xbe\x89\xb7\xc5\x67\x6d\xab\x7a\x41\xa4\x7b\x9b\xd8\xf8\xf8\x38\xa1\x38\xdc\x1b\xa8\xaa\x51\x56\x24\x08\xce\x3b\x06\x43\x9a\x61\x7b\x65\xab\x6d\x44\xe8\xff\x73\x41\x6a\xc6\xe3\x49\xc1\x34\x9d\xb6\xbf\xf6\x46\x61\x03\x85\xd2\xa3\x4a\xc7\x14\xd2\x63\xcd\x17\x4f\x77\xc9\x0f\x8f\x27\x77\x15\x26\x94\xcf\x76\x35\xab\x65\xcf\xd3\xcf\x4a\x90\x66\x58\x7f\x69\xa3\x06\x8f\xd9\xd1\x92\xcf\xfe\x15\x6f\x08\xbd\x57\xeb\x27\xd0\xae\x15\x22\x9f\x5a\xa1\xc5\xfc\x95\x5e\xc3\x53\xa6\x49\xa5\x2f\xf0\x9d\x28\xe5\x2b\x6f\x33\x6c\x93\xca\xfb\x86\x12\xcf\xf2\x31\xb2\x31\x36\x01\x61\xb0\xf8\x4c\x6f\x0f\xd3\xe0\x9c\x58\xc9\x78\x22\x21\xe2\x54\xa9\xaa\x7e\x0e\x24\x44\x53\x25\x38\xc3\x79\xe4\xf7\x37\x43\x1f\xaf\x30\x8c\x63\xba\x01\xf8\x7f\x68\x98\x78\x85\x88\xa0\x3b\xe8\x39\xe3\x5b\x27\xd1\xc3\xc7\x1d\x9d\xe7\x1e\x6d\x15\x91\x43\x8e\xd7\x5c\x8e\xe2\xb8\xba\x0e\x42\x7e\xca\xfd\x76\x35\xf4\xb9\x4e\x3b\x67\xc2\x8c\xaa\x41\x1f\xb5\xdd\xa1\x00\x77\x1a\x1c\xb6\xe3\xcd\xb2\x5e\xf4\xc1\x4e\x68\xda\xc4\x07\xef\x06\x32\x16\xeb\xeb\x9e\x79\x27\x27\x31\xd0\x09\xcb\x88\xda\x79\xe3\xac\x59\x3a\x7f\x9f\x1b\xfe\xda\x52\x06\x05\xd4\x14\xa7\x0f\x6d\x5c\x5e\xca\x84\xb5\x30\x18\x34\x8d\xcc\x67\xe2\x13\x20\x5e\xcf\x72\x17\x0d\x13\x3a\xa7\x19\xe6\x06\x89\x67\x98\xbf\xe8\x2c\x3e\xcc\x78\xf4\xfb\x00\x92\x30\x0d\x3a\x28\x07\x5e\x52\x8d\x87\x5c\x0d\x60\x9b\xf4\xc2\xbc\xb6\xe3\xb0\x9f\x5d\xc1\xeb\x9c\x29\xb7\x4d\x18\x5d\x79\xde\x3e\x43\x6c\x32\x2e\x1e\x37\xa5\x76\xbf\x0a\x85\xc3\xe9\x84\xd1\xed\xc3\x2e\x89\x6e\x46\xe3\x71\xef\x9a\x1f\x5c\xe2\x27\xee\x54\x6e\x11\xf1\x54\x7e\xfc\xb7\xbc\x2c\xea\xd4\xdf\x2e\x69\xf6\x79\x94\x9f\x3d\xe0\x27\xd2\x29\xf6\xd1\xb4\xbf\x83\x9f\xcf\x77\x81\xac\xb7\xca\xae\xb6\x74\x96\x10\x9f\x70\xab\x4d\xa9\xc6\x7f\x36\x16\x1d\x25\xad\x63\xe1\xcb\xfd\xda\xbf\xe2\x6c\x93\xda\x4b\x30\x78\x42\x28\x3e\xa9\x5f\x18\xbd\x49\xe7\x77\xcf\x53\xc0\xe4\x46\x64\xfa\x3c\x2d\x7a\x78\x37\x57\x37\xfd\x2d\xf1\x26\xa4\x72\x8c\x01\x57\x84\xff\x32\xcb\x27\x09\x16\x2c\x7b\xa2\xf3\x05\x67\x82\xcf\x1a\x70\x2e\x22\xcc\x3b\x12\x04\x24\x1b\x71\xb3\x76\x21\xd4\xd7\x7d\xd1\xb1\x3f\xdc\x0b\x9f\x98\xbb\x4e\x52\xb0\x82\xae\x44\x3c\x5e\xeb\x4b\x62\x25\xd3\x9c\xfa\x88\x63\x63\x5d\x51\xb5\x4c\x73\xa2\xc1\x8a\x1e\x13\xdd\x44\xf5\x3d\x39\x44\x4c\xcb\x3c\x97\x96\x18\x01\x4e\x8e\x36\xd3\xa3\x67\x3c\xe1\x87\x94\x05\x5a\x3b\x9b\x6c\x10\xec\xa6\x70\xbc\x19\x81\x2c\x13\x9b\x61\xe0\xbd\x6c\xb3\x79\xc6\x77\x1a\x21\x6b\x81\x25\x13\x3f\x17\xa9\x75\x6c\x5b\xd7\x70\xaf\x05\x69\x36\x0b\x97\xdd\x3c\xf7\xc7\x06\x0c\xe6\x15\xc6\x9b\x3e\xd2\x09\x43\xab\x7a\x29\xe2\x57\x56\x80\x96\x6a\x7c\xe5\x85\x26\x52\xf1\x3b\x3d\xfe\xbe\x7a\x0c\x19\x7a\xcf\x77\xcf\x59\xd5\x7a\xe3\xcb\x9f\x03\x4d\x73\x66\x21\xd2\xca\x54\x8a\xec\xe8\x35\x4d\x8e\x2f\xc4\xce\xd2\x70\x7a\x75\xa7\xe9\x35\x9a\xb6\x88\xa7\x01\x5d\xec\x90\xf4\x84\x8a\xd2\xa1\xb3\x07\x5c\x0e\x94\x19\x2a\xa3\xd9\xe7\xe2\x15\xfc\x04\x2a\xf3\x8f\x78\x3a\x5a\xe9\x06\x61\x25\xaa\x70\x5b\x3c\x7b\x7b\x97\xb3\xe2\xee\x76\xab\x9c\xea\xec\x39\x90\xea\xa6\x6e\x45\x87\x14\x69\x2e\x2f\xb9\x1f\xe1\xda\xe6\xe9\xcc\x29\xd1\x3c\x28\x54\x77\xdb\x53\xfc\x1c\x57\xaf\x63\xa3\xea\x50\x7b\xd4\x7f\x10\x0b\x15\x95\xb3\xbc\x52\x49\x23\xc4\x5f\x3b\xe4\xd9\xbd\x2b\x49\x45\x27\x84\xad\x2b\xc9\x4c\x99\x87\xf4\x47\x09\xe1\x13\x22\x2c\x67\xcd\xee\x8c\xff\x93\xe9\x99\x59\x11\xa1\x34\xc3\xcd\x2c\x0c\x51\xaa\xd8\xe5\x2f\x73\x99\xb1\x5f\x2d\x28\xf5\x75\xc0\x5e\x70\xca\x27\x04\x4f\x5b\x8d\xf2\xae\xc5\x6b\x6d\x63\x8d\x49\xef\x3b\x91\x2a\xe6\x3f\xcb\xf5\x2f\xc6\x47\x9a\x22\x14\x1d\xae\xc7\xb5\x14\x7b\x7c\xda\x77\xba\x2c\x62\xd3\xa2\x85\x28\xde\xed\x66\x6b\xc5\xaf\x9e\xde\x84\x2d\x9a\x17\xb8\x84\xfc\xc1\xdc\xb8\x83\x96\x2a\x5b\x53\x07\x71\x3d\xf3\x8a\x0b\xd0\xdf\xec\xa8\x0c\x78\xe6\xcb\xad\x99\xf7\x8c\xba\x2b\x6c\xd8\x7e\xa3\x57\x5e\xaa\x06\xc1\x36\xbf\x0f\xca\xa0\x0b\x6d\xfd\x50\x0c\x46\x9f\x9d\x3d\x9b\xf5\x7b\x5e\xa3\xe5\x7c\x28\xb1\x26\xc7\x88\xef\xda\xf5\x47\x99\xc7\x3c\x99\x3d\x72\x6a\x7c\x75\x78\xd2\xe7\x4f\xce\x62\x55\xfe\x52\x94\xd7\x01\x37\xb9\xd2\x77\x58\xef\xfb\xaa\x41\xa5\xa4\xbf\xc0\x7d\xe3\x0f\xee\xd8\xb6\x2e\xdf\xd0\x55\x35\xa4\xf9\x94\x7a\x6e\x46\xb2\x82\x9b\xf6\xa5\xfb\x7c\x8b\xfa\x6a\x23\x06\x8d\xa7\x15\x3d\xb1\xbb\x25\xed\x4d\x74\xbd\x25\xbd\x08\x9d\xea\xef\xf0\x45\x13\x39\xca\x27\x69\x6c\xd8\x68\x82\xb0\x4b\xe9\xb7\xe3\x95\x16\x3f\x37\xbd\x64\xd0\xd9\x62\xdf\xba\xfe\xd7\xf2\x6d\xce\xcb\xc5\xda\xb9\xed\x90\x72\xf0\x8b\x3f\x3b\x23\xc0\x0d\x17\xe2\x85\xf2\x8e\xaa\x42\xff\x1b\xbc\xb4\x4d\x14\x1e\x7f\x58\x09\x30\x65\xac\xf8\x5d\x67\x40\x6d\xe2\x4b\x39\xad\xc0\xf7\xe0\x8a\xb9\x98\xdf\x5c\xe4\x23\x26\xd5\x5e\x5e\x8d\xae\x50\xc8\xed\x47\xf8\x2f\x67\x73\x00\xac\xd0\xa0\xc4\xcf\x5b\x5f\xf3\xe3\x41\x01\xe1\xd9\x6c\x46\x4b\xeb\x7d\x6d\xf2\x1b\x10\x8b\x4c\x82\x8d\xc5\x4e\x72\x56\xdf\x89\x97\x99\x9b\x59\xec\x9d\x06\xd3\xba\x98\x0b\xce\xc2\x17\x82\x24\x17\xc9\x97\x47\xfe\x0c\x8d\x1c\x1c\x3e\x82\xb2\x0d\xc3\x45\x86\xd5\x90\x7d\x84\x64\xa2\xd4\xec\x79\x3b\xfd\xfd\x77\x90\xf0\xb6\xe9\x7c\x64\xf7\xd4\xaa\x3a\xf2\x2a\x8e\x3c\xa3\xba\x23\xc1\x2f\xc1\x86\xd7\x84\x66\x5f\x7b\x33\xdf\x53\xc7\x82\x32\x83\x7f\xbc\x37\x3b\x94\xd5\x0d\x51\xdb\xcc\x68\xf0\x32\xe5\x1d\x13\x88\xc9\xa5\x8c\x2c\x11\x8e\xb1\x7b\x92\xd5\xa3\x41\x76\x6d\xf4\x51\x96\x86\x0b\x03\xd7\xa6\x1a\x25\xb5\x06\xd8\x71\xad\xe6\x15\x6b\x16\xb6\x35\xd9\xe9\xfb\xe7\x0d\x88\x76\xa7\x14\x34\x14\xe1\x1d\x21\x84\x6d\x47\xcf\x9f\x64\xf0\xbe\xd6\x55\x41\x1f\x8a\xf1\xab\xcc\x61\xc5\x20\x77\x73\x96\x1b\x9f\x55\x4b\x35\x0b\xbc\xe0\x27\xaf\xb9\x67\x87\x58\x67\x6d\x15\xe8\xd6\x8f\x21\x0f\x4c\xe7\xc3\x86\xf8\x78\xa6\x7b\x79\xa1\x9a\x00\x97\x33\x1b\x3f\x65\x4e\x07\x2a\x07\x33\x71\xa9\xe7\xb5\xcd\xd2\x64\x61\x67\xe6\x40\xd5\x1d\xfa\x2f\x78\x5e\xbf\x15\x27\x07\x2f\xc9\xb4\xb2\x39\x32\x25\xaa\xea\x57\x83\x17\x5b\x83\x9d\x2a\x87\x51\xb9\xdb\xab\x6e\xba\x2f\x0d\xa0\xc7\x13\x77\x3b\x16\xc5\xd4\x96\xc0\xec\x57\xce\xc4\x56\x09\x8a\xdc\x6f\x4b\xdb\x57\xb4\x64\x85\xf9\x2a\x33\xb4\xcb\x7f\x2b\x7f\xff\x56\x7d\xe6\x73\xa6\x2d\x73\xe1\x30\x09\xb6\xbc\xb8\x46\xd4\x55\x0d\xa3\x9e\x5f\xed\x20\x10\x5e\xd4\x30\xff\xad\xa1\x5d\x5f\xb8\x17\x31\xb4\x12\x6a\xda\x25\x4e\x6d\xe6\x59\x9d\x9e\xde\x88\x5b\xa3\xee\x14\x22\x2c\x6f\xdb\xae\xfd\xf4\xb4\xda\x5c\xfe\xbf\x97\x88\x7a\x7a\x72\x0a\xba\xe1\xfa\x82\xfa\x05\x03\x4b\x00\x6b\xfa\x3e\x37\xf2\x8c\x13\x26\xbd\xbd\x19\x5b\xb7\x85\x7f\x25\x3a\x61\xb0\xaa\x5d\x29\xe9\x5f\xc7\x7d\x23\x5c\x3b\x8c\x3a\xd0\xc7\xf6\x0a\x8f\x1a\xed\xaf\x7e\x21\x8a\xf7\x15\xa1\x21\x43\xa3\x85\x1d\x1e\xe0\x6e\xcd\xaf\xfb\xf0\x4f\xf8\x8d\xa4\xfd\x8b\xd4\x52\x99\xbd\xc8\x5a\xa5\xa6\x22\x40\xea\x71\x7d\x51\x79\x89\x8a\x0a\x25\xdb\x6b\x67\xe6\x2a\xcc\xa9\xb8\xa1\x55\x2c\x59\xbd\x5c\xe4\x6d\xef\xa7\x9e\x27\x2b\x34\xa1\xff\x44\x8c\xd6\xc4\xf2\xdc\xa2\xec\x95\x8c\x6d\xf5\x30\x2a\x35\xc5\xfc\x22\x15\x35\xb2\x27\x19\x5b\x57\x56\x60\xef\x80\x87\xc7\xf0\x10\xa1\x34\xde\xbf\x6c\xf9\x97\x47\x1d\xfa\xae\x74\x57\x86\x93\x81\x55\x72\xff\x62\x18\x7d\xdc\x3e\x3a\x1a\x42\x3e\x21\x25\x0f\x2c\x6e\x93\x0c\x2a\xa5\x71\x81\x0b\x7e\xff\xbf\x9c\x3d\x19\xa7\x91\xfb\x7a\x73\x47\x44\x63\xbf\x4e\x0f\xca\xb9\x6c\x0a\x9c\xc9\x91\x3c\x3d\x34\x86\xbe\x33\x2c\x1e\x19\x9d\x02\xd5\x94\xcd\xb6\xe9\x17\xe3\xf0\x58\x8d\x77\xa7\xe5\xcd\x0d\x1e\x5f\xce\xef\x17\x4c\x34\xac\xc4\x26\x10\x2d\xef\x87\xf8\xdf\x89\x25\xd2\x7c\x2c\xb2\xc9\x1b\x07\x93\x8c\x08\x19\x1e\x1d\xfa\x92\xb4\xf5\xb2\x39\x9d\xbf\x23\x71\x6c\xde\x99\x11\x63\x26\x91\xf0\xcf\x33\xb0\x23\xd0\x77\x29\x8a\x75\x5d\x82\x48\xff\xe1\x92\x6a\x59\x82\xef\xdc\xaf\x20\xdb\x31\x58\xd6\x41\x46\xf2\xf8\x81\x57\x99\xe7\x99\xb2\x3d\x5a\xae\xdf\x87\xf7\x38\x59\xb4\x4e\x24\xfd\x9f\x2e\xef\x00\x31\x3e\x95\xa2\xb7\x47\x5d\x7e\x46\x8b\x3d\xf8\xfa\xf5\xff\x89\x22\x6c\xea\x00\x9d\x8a\x4b\x14\xa8\x3b\x49\xda\xff\x54\x92\xc2\x83\xda\x96\x44\xb1\x45\xd6\x87\x1b\xe7\xed\x11\x1d\x19\x28\x21\xf3\x94\xcb\xbc\x2e\x14\x05\xcc\xd1\xa2\xba\xc4\x2c\x7e\xbb\xbc\xa3\xd5\x6c\xef\x9d\x5d\x3c\x52\x58\xd8\x0e\x89\xf4\x18\x88\xa5\x66\x44\x83\x82\x35\x6a\x3e\xb6\xf5\x51\xa8\x9e\x45\x0a\xc5\x2e\xf1\xb7\x90\xd0\x27\x7a\xfc\x7d\x78\x1d\x18\xbe\x66\x33\x11\x37\x23\xd3\xf5\x56\xb2\x7f\x36\x16\xd2\xe7\x87\xbb\xc9\x39\xc0\x1a\xf7\xaf\xbe\x12\x94\x10\x16\xed\xb8\xea\x2a\xec\xf7\x05\xeb\x27\x15\x11\xc5\x76\x98\x56\x85\x0d\x0c\xc6\xbf\x51\x68\xdf\x6a\xe7\x47\xe3\xe8\x5d\x1a\xaa\x18\xf3\x3e\x56\x08\x72\xb6\xe7\xc7\xb9\x91\x77\x7f\x20\x10\x79\x0d\x0e\xcd\x68\x7f\xb4\x27\x37\x84\x36\xe1\x3f\x1e\xce\x54\x12\x70\xef\xb3\xb2\xe1\xd2\x3e\x08\xfe\x4f\x1d\x99\x53\xff\x13\xba\xe7\x24\x33\x95\x8f\x9f\xd9\xba\x3c\x99\x44\xd6\x5a\x47\x72\x3f\x52\x1f\x79\x8b\x87\x1b\x73\xe9\x9d\x6d\x17\xd6\x88\xa3\x02\x88\xb3\xbe\x42\xed\x04\xaf\x44\xa9\x55\x36\xa7\x9e\xe4\x33\xba\xba\xa9\xed\x10\x85\x92\x25\xa9\xa8\x15\xcc\x13\xe3\x8f\x8b\x52\x31\x2b\x5f\xea\x6a\x02\xa9\xfd\x09\x44\x17\xaf\x7c\x0f\x9f\x84\x1c\xd9\xe8\xa6\x8d\x2a\x69\xfc\x8d\xb9\x9a\x20\x46\x2b\x15\xfd\x7d\xc0\x73\x9e\xa0\xbb\xf6\x4a\x7b\x3f\x33\x20\x68\xed\xd5\x43\x15\x1d\x1f\x50\x8f\x4e\xe5\x0b\x38\x5b\xf7\xff\x0e\xe4\x8c\x56\x8d\x2e\xf5\x59\x21\x1f\xf6\xcd\xf8\x63\x78\x50\x5f\x77\x5f\xb4\xd2\xe7\x79\x52\x9c\x7c\x37\xf2\xd5\xb8\x57\x38\x9f\xc6\x84\xc2\x5c\xcf\x0a\xa3\x6a\xeb\x6c\x0c\xb7\xa4\xa1\x5c\x42\x42\x58\xb6\x72\x10\x80\x5b\x0b\x1c\x32\x1d\x3c\x0a\x06\x1b\x9e\x45\x49\xb6\x0a\x4b\x93\xc3\x32\x5f\xbf\x73\xd5\xc7\x70\x37\x9a\xbe\xad\xe1\xe1\x88\x95\x6a\x95\x94\xbe\xe7\xbe\xb3\xa4\x8a\xe1\x11\x75\x0f\x2b\x7f\x11\x15\x11\xe5\x6a\xc5\x7d\xe4\xa9\x6e\xac\x3e\xf9\x05\xb6\xee\x4b\x78\xd7\x3b\x38\x27\xed\x32\xff\x9a\x9c\xf1\xe1\x77\xf3\xd5\x0c\xd8\x39\xbb\xb4\xd1\x7d\xf3\x95\xe9\x70\xdd\x98\x75\x4b\x46\x0f\x42\x7e\xff\xb5\xe9\x9b\x7d\xde\xd1\x09\x5f\x9c\x2c\xf9\xfd\x60\x67\x9a\xa7\x5d\x3e\xce\x03\x4e\xfc\x1c\x69\x52\x6c\x2f\xcd\xe7\xe8\x8d\xc2\x16\x9a\x4b\x9d\xb8\x70\x2f\x43\x38\xcb\x93\x8f\xf8\x9d\x7e\xd8\x23\x35\xbe\xb0\x0d\xd0\x46\x82\x61\x48\x65\x8d\x7f\xc2\x61\x93\x9f\xcb\x69\xa3\x37\x7e\xa4\x7e\x68\x3e\x1c\xb9\xbd\x6c\xce\x9f\x5f\x56\xb5\x1a\xde\x2f\x17\x3d\xbe\xef\xf1\x99\xfa\xf4\x21\xe0\x5d\x92\x11\x79\x6c\xd7\xe5\xf5\x98\x93\x09\x43\x16\x11\x14\x7e\x4e\x41\xb1\xc5\x6e\xe0\xbb\x4b\xc6\x5d\xa9\x0e\xd2\xd7\xe0\xe8\x3f\xb9\x87\xd8\x05\x7f\xc6\x17\x6e\x11\x96\x42\x53\x6c\xe6\x29\x6e\x64\x2c\x8a\x9b\xd3\xe5\xe3\x61\x35\xb3\x70\xdb\x24\x43\x76\x78\x7e\x5d\xee\xc8\xa6\xd1\x2c\x11\x36\xe0\xc6\xd9\x20\x3e\xd9\x54\xc0\x9b\x8c\x67\xdc\x92\x57\x98\x5e\x8d\x46\xfc\xcd\xe7\x0b\x61\x22\x87\xd6\xcd\xa5\x2b\xc9\x87\xaf\xb2\x2e\x3c\x3c\x17\xc7\x6b\xca\x35\xe0\x94\x62\x9f\x1f\x84\x45\xc9\xe1\x56\xad\xb4\x82\xc3\x7b\x6e\x78\x74\xf6\xcb\xab\x37\x28\x0a\x47\x93\x60\x3c\x85\x56\x3a\x8a\x75\x76\x8f\xcf\xe0\xac\x21\x88\x38\xf5\xe6\x9e\x2f\x5e\x8a\x71\x4a\x34\x65\x98\xc7\x25\x57\xe5\xe7\xb1\xe1\x9d\xe6\xfb\x5f\xdf\xbd\x8f\x4e\xb0\xe0\x49\x09\x97\x77\x7d\x14\x53\xe7\x60\xe7\xe8\x49\x05\xaa\x5a\x1e\xaa\xc8\xcf\xe5\xcc\xab\x07\x27\x87\xa7\xbd\xed\x0d\xca\x83\x7b\xe1\x99\x0d\xf9\x7a\x63\x74\x0d\xf4\x1f\xdf\x3a\x08\x3c\xdf\xcf\xae\x6f\xb5\xac\x5c\x4e\x0d\x5d\xfa\xf8\x3f\x6e\x75\xb8\xd1\x3c\x39\xf0\x97\x6b\x6c\xdd\xde\xfe\x14\x57\xce\x94\xe6\xfe\xe9\x4c\xed\xd6\xc0\xf6\xf3\xa5\x06\x15\xd1\xf2\xcd\x1a\xec\xcc\x8f\xd7\x0d\xc9\x67\x8e\x88\x45\x86\xca\x85\xca\x9f\x4a\xe1\x5e\x56\xa7\x40\xbb\xb2\xd0\x04\xf5\x44\x52\x35\x13\x38\x16\x09\x3f\x8f\x24\x98\x55\x0c\xe5\x0a\xf4\x43\x75\x43\x52\xd5\xcc\xe1\x31\x0b\xc1\x92\x91\xf1\xcc\x35\xa6\xe7\xd5\x0d\x75\xb0\x9d\x19\x05\xdd\xb8\x9e\x60\x7a\x58\x30\x4c\xc2\xdd\xde\x5b\x10\x25\x8f\x97\x6c\x97\x10\xf7\x87\x05\x6a\x44\x8b\xce\xe0\x77\x5a\x54\xf6\xe1\x61\x2f\x5f\xcb\xef\x10\xb1\xe0\xb6\x7a\xf6\x60\x6f\xbc\xf9\x14\x16\x4e\x6c\x96\xb6\x5d\x63\x38\x3c\x86\x4c\x92\xfd\x87\xf4\xcf\xdb\x4f\x5f\x76\x2a\x12\x25\xe7\x40\x12\xca\xa4\x3b\x7f\xfb\x49\xce\xc2\xa3\xfe\xae\xe4\x79\x83\x22\x0a\x85\x3e\xfb\xa0\x20\x25\xcd\x91\x98\xb2\xba\xa1\x57\x95\x56\xd1\x59\x57\x86\xbf\x6e\x4d\x17\x99\x70\xa6\x74\x9b\xac\x52\xd1\x3d\x66\x56\x27\x19\x34\x78\xa7\x67\x85\xa6\x59\x74\x59\xc8\xb1\x4d\xdf\x19\x4a\x10\x5f\xdc\xa2\x02\xa2\xbd\xb2\x92\x9a\x93\x26\xa5\x6f\xfb\x2a\x27\xcb\x4f\x03\x3b\x63\xc2\x64\x43\xf6\x02\xbb\x85\x2b\xe0\xe7\xdc\x82\x48\x30\xc8\x17\x48\x79\xf3\xb3\x24\x7b\x95\x86\xb9\x8c\x95\xb2\x4d\x69\xe6\x68\xb8\x98\xa5\x08\x91\x4e\xe2\xc9\x92\x84\xf7\x3e\x85\xbf\xe3\x58\x8b\x33\x9f\x77\xbb\x15\xf9\xb1\x1b\xe9\xf6\x06\x5d\x5d\xbb\xc6\xb4\x0e\x8b\xbe\x87\xcb\xe8\xec\xaa\xce\x45\xf5\x88\xfb\x70\x72\x15\xe3\x98\x5e\xdd\x73\x26\xff\xc5\x4a\x77\xb3\x48\xcd\x65\xf8\xea\xed\x6b\x40\x9b\x50\xac\x0c\xee\x4a\x37\x52\x20\xd2\x39\xdb\x90\xfd\xf0\xe3\x8f\x51\x98\x58\x5b\xb6\x74\xc0\x18\x9a\xaa\x4c\xb6\xb5\xb4\x71\x5c\x20\x86\xe4\xa6\xdc\x7a\x6d\x77\xb6\x9d\xad\xb8\xdb\xe4\x09\x46\xe3\x48\x7d\xe7\x6a\x2f\x66\x55\x84\x97\xf5\xb6\xf2\x32\xce\xd6\xd9\xce\xd0\xee\x1a\x8a\x9e\xff\xe4\xd2\xde\x81\x17\xd0\x10\xdf\xd3\x2c\xd4\x86\x6a\x76\x4d\x7b\xe5\x83\xbc\x33\x0f\xdf\xf1\xb9\x35\xee\x12\x35\x71\xd4\x21\x32\xfd\x9a\x11\x7d\x89\x3e\xdb\x5a\x26\x3c\xa0\x67\xf6\x36\xd3\xae\x31\x55\xc4\x5e\xe4\x54\x44\x3c\x43\x84\xf7\xc6\x7d\x64\x3d\xbd\x07\x99\x60\x9a\xbb\xea\xd5\x09\x91\x8c\x5a\xe0\xca\xc5\xd7\x6a\x91\xb0\x01\x96\x68\x56\x4d\x19\x75\x35\x2a\xfd\xdc\xa8\x6d\x06\x2f\x0e\x5c\x11\x5b\x8d\xf7\x59\x7e\x01\x58\x0a\x9e\xb7\x1b\xdb\x2a\xc9\x4b\x80\x51\x14\xa3\xba\x41\xd4\xa4\x69\x45\xcf\x6c\x6f\x38\xfc\x84\x78\xa3\xd7\x2b\x55\x34\x9a\x39\xfa\x11\x7d\x76\x1d\xbb\x84\x5c\x87\x7b\x53\xac\x2d\x4a\xc4\x8d\x66\x87\x5e\xdd\x00\xcf\xa0\x83\x7d\xca\x9d\x45\x9d\x6a\xac\x8e\x8a\xaa\xc2\x64\x7b\xbb\xaf\x79\x56\xe9\x9f\x3d\x53\x31\x25\xdf\xa6\x94\xad\x68\xca\xea\xe6\x22\x07\x6b\xba\xf7\xea\xda\xb0\x73\x31\xfe\xab\x3c\x23\xac\xd5\x5b\xd9\x0c\xf2\xeb\x63\x2f\xe4\xe6\xe8\x54\xc8\xa8\x63\x58\xc4\x26\xf5\x0f\x0f\x18\x2d\xc3\x3f\xe7\xbd\x80\x14\xd6\xce\xcf\x84\x79\x54\xae\xab\xa6\x93\x01\x59\x7d\x5c\xe4\x60\x4e\x66\xc3\xe2\x07\xe0\xbf\x1e\xf4\xf1\xf2\xfe\x5c\xec\x0d\xb6\xec\x7f\xad\xe4\x81\x89\x82\x5f\xbf\x7a\xb9\xd5\x21\xff\xaa\x1d\xd7\x4d\xd5\xaf\x84\x64\x34\xc8\x9e\xc6\xc4\x71\xc7\xa1\x5f\x97\xec\x2b\x09\xb9\xad\xea\xff\x0a\x5d\xe6\x07\xbf\x7e\xa1\x42\x3a\xe7\x0b\xf1\xf0\xe9\xb5\xe4\x9f\x84\x45\x3b\x27\x74\x11\x93\x41\x88\x37\x0b\x90\x7c\x97\xd6\xd8\x29\xd5\xff\x64\xdc\xc8\x55\x2b\x8e\x92\x8e\xb0\x14\xbe\xc3\x1c\xc5\x72\x23\xe6\x1e\xcb\xdf\x65\x95\x68\x62\x82\x42\x2c\x99\x61\xe1\x6b\x61\x45\x6c\x6a\x3a\x5f\x5b\x92\x46\xbd\x94\xdc\x38\xad\x42\xbb\xe6\xc0\x72\xeb\x33\x98\x7d\x5b\x68\x3c\xfc\x79\x1f\x25\x72\x2c\xb5\xe4\x6f\xaf\x4b\x3d\xd3\x2c\x8f\x04\x93\x58\x82\x83\xc0\x03\x46\x97\xc6\x6a\x4a\x3e\x66\xb2\x86\xde\xe5\x27\xb3\x49\xbc\xa2\xda\xc2\xdc\x6c\xb5\x90\x3f\x7d\xba\xed\x4e\x3b\x19\x0d\xdb\x4a\x4b\x69\x21\x83\x67\x6e\xa5\x7c\x4a\xe2\xed\x29\xd9\x09\x10\x05\x7c\x7c\x46\xf8\x0c\x9f\x44\x1e\x6e\x17\xef\x28\xa3\x40\x59\xf8\x78\x4f\xe1\x17\x8c\xf6\xef\xa7\x2f\xc5\x4d\x6a\x02\x3e\x09\xdd\x36\xe9\x4c\x6d\xa2\xfe\x7a\xd9\xba\xc8\x37\x51\x5d\xd2\x66\x35\x84\x24\x5a\x63\x2c\x7d\xec\x3e\xa9\x5e\x58\x17\x60\xd8\x1a\x8e\x9f\x3e\x7f\x19\x12\x26\xec\xfd\xad\x20\x7c\x5c\x5d\x90\xb7\x2f\x26\x90\xb3\x8b\xcf\x24\xc3\x12\xe5\x5c\xae\xff\x3d\xd1\x08\x07\x4d\x55\x59\x9a\x90\xd5\x6d\xb3\xdb\x5b\x2b\x49\x50\xc6\xb9\x95\x38\x3a\xa4\xb1\x01\x62\x70\xd8\xb0\x5c\x3c\x8c\x9a\x22\x5d\x53\x4b\xbf\x9b\x3a\x39\x92\x88\xe2\x01\xae\xc7\x8f\x63\xf4\xac\x3b\x56\x0b\xb1\xbf\xff\xec\x89\xb1\x33\x2a\x90\x40\x7f\x73\xf4\xc8\xfb\xa9\x61\x24\xc3\x7a\x3a\xa5\xac\x51\xd7\x8e\x60\x61\xac\x92\x5b\x14\xd5\x1d\x5c\xa7\xb4\xfe\xd6\x9d\xff\x3a\xae\x22\x68\x6d\x0c\x2a\x1f\xe4\x52\x62\x41\x94\x66\x16\xf4\xaa\xf9\x4d\x95\xd4\x4b\x2f\xd1\xae\x57\xa1\x0e\x3d\x83\x30\x9d\xe6\x6b\xda\xae\x0d\x36\xb2\x42\xec\x39\xde\x3e\x4e\xe6\x05\x4e\x67\x42\x7d\xa9\xd5\x10\xf0\xfe\x5c\xe5\x15\xed\x8b\xa5\x7d\x13\xc6\xe0\x98\xc6\x27\x93\x03\xc7\x49\x67\xeb\xa1\xdb\x22\x94\x86\xee\xcf\x1f\xe4\xe7\xdb\x7c\x3f\x87\x0e\xc5\xbb\x4d\x4a\xdd\x8c\x12\x66\xcf\x48\x74\x1f\xc7\xb8\x09\x26\x9e\xa3\x2b\xf8\x78\x9f\x63\x50\x4f\xc2\xc1\x0d\xf6\x3b\x44\x2d\x99\x3d\x1d\x04\x3f\xae\x13\xde\xf1\x2f\xc8\xe5\x68\xdd\xed\xce\xad\x5f\x8f\x06\x28\xa4\xc0\x4f\x84\xf9\x38\x8b\x21\x7c\xe5\xa3\xa3\x9e\x05\xc3\xaa\x91\x20\xe3\xc5\x3f\x94\xd0\xa1\xcf\xa7\xb7\xdd\x97\xc0\xe3\x6d\x61\x79\x91\x3c\xd2\x89\xe9\x3a\x56\x15\xcc\xe5\x00\x96\x9f\xa6\x56\xd7\x82\x44\xdf\x92\x71\x9b\xb0\xcd\x0f\x6d\xe5\x97\xcf\x4e\x73\x07\x87\x26\x8e\xf7\x7e\x0d\x47\x05\xf4\x45\x89\x6d\x35\x2c\x1c\x72\x70\xea\x5c\x39\xba\x63\xf0\xcb\xa9\xab\x5c\x42\x50\x3a\xbb\xbf\x85\x37\xb7\xbc\x07\x12\x36\x64\x71\x6b\xff\x9c\x60\x52\x30\xff\xf6\x3e\xe0\x85\x66\xdf\xad\x3c\x72\x44\x83\x7a\xc1\x4d\x3e\x29\x99\xa0\x6f\xa4\xde\xf3\x2d\xbe\xe0\x96\x13\xc6\x6b\xb4\xeb\x29\xe0\x87\xd7\xd2\xab\x65\xf2\x86\xf1\x0a\x5d\x78\x08\xe3\x2e\x7a\x55\x94\xfd\x1d\x27\x22\x1b\xe0\x6b\xb0\x80\xf0\x7f\x07\xd9\x9c\x50\x29\x51\x16\x22\x49\xf0\x49\x9a\x8c\x3d\xd3\xca\x58\x67\x1c\x7e\x1f\x62\x00\x5e\xcc\x89\x7a\x39\xfa\x80\x36\x6e\x32\x6d\x9c\xc8\x41\x3e\x5a\x75\xb6\xe7\xb4\xcc\x24\x63\x8d\xfe\x43\x47\x87\x2e\xee\x6e\x86\x90\x66\x93\xfe\x37\x5f\x75\x3e\x48\x00\xec\x56\xb6\x94\x76\x2b\x03\x84\x23\x04\x3c\xc2\xd4\x38\x55\x7a\x8e\x7f\xcc\xb3\x90\x53\xf5\x2e\x9a\x25\xbe\x6d\x63\xfa\x75\xe6\x83\xe2\x5f\x3e\x01\xdd\xe7\xfa\x2f\x39\xb0\x90\x7d\xaa\x06\xeb\x20\x5a\x64\x32\xeb\x3c\x0e\x72\x0e\x8e\x39\xc7\x6d\x83\xab\xe9\xb5\x81\x93\x5f\x55\xa4\x91\x52\x5d\x9d\x6e\x32\xe3\x4f\x81\xc4\xf7\x17\xb6\x94\xeb\xe0\xef\x2b\x2c\xed\xf6\xfd\xb8\xd0\xfd\x21\xf9\x36\x34\x3a\x40\x84\xb6\xca\x39\x2b\x34\x36\x52\xc9\xed\xba\xa6\x2b\xd0\x09\xec\x68\xb5\xf4\x48\x01\xb3\xae\xb7\x0d\x2f\x16\x8f\xa9\xcb\x1c\xa0\x19\x07\x21\x6a\x87\xfb\x20\x91\x16\x66\x55\x65\x32\xaf\xd3\x7f\x27\xed\xaa\xa2\x51\x10\x4b\xec\xf5\xfd\x9c\x01\x36\x54\xdb\x8c\x6a\x77\x2c\x78\x88\xbb\x06\xe8\x90\x78\x36\x8e\xe6\x26\x31\x65\x36\x98\x07\xe2\xde\x86\x00\x1a\x7e\x15\x55\x9e\x36\xdd\x9f\xe5\xec\x2d\x85\xe7\x1c\x9a\xf0\xb8\x97\x81\x8a\x8a\xb5\xdc\x99\xe0\xca\x56\xa3\x93\x20\x17\xf3\xfa\xbc\xdf\xa0\x2b\x6b\xac\x5b\xdc\x2c\xa4\xaa\xb9\x0f\x7f\xcd\xed\x78\x3b\x9f\xdc\x64\x73\xbe\xfe\x61\xd8\xc5\xb8\x06\x47\x74\x39\x9a\xe6\xc5\xd5\x7e\xe0\x81\xae\x91\xd8\x13\x4b\xe2\x7c\xd8\xd7\x4f\xee\x8e\x94\x98\xf8\xa1\xc0\xcd\xae\x1f\xc3\x68\x42\x50\xe7\x38\x5f\xd3\x7b\xb5\xb0\x1d\xb2\x06\x96\x78\x58\xc6\x37\xae\x31\xbe\xa3\xe3\x3a\x59\x8a\xde\x92\x96\x98\x71\xc1\x68\x71\x9f\x7c\x3d\x16\x7a\xa7\x58\x80\x4c\x8d\xdf\x26\x9b\x0e\x9e\x0f\x82\x19\xdf\x1f\xba\x77\x7d\x49\xd1\xbd\x2d\x4b\xee\x8f\xff\xf8\xe3\x9a\xee\x85\x7d\x07\x9c\xbf\xbc\xd8\xc1\x77\x76\xa3\x66\x5a\xff\xd0\x2f\xa5\x02\x03\x17\xa3\xfc\x8d\x2e\x82\x09\xf2\x88\x0d\xec\x52\xfb\x0b\x1e\x6c\x5c\x19\xb1\x97\xf2\xa0\xa7\xe4\xb4\xd4\x69\x14\xff\x52\x7d\x3a\xe7\x9a\x47\x93\x90\xa6\xab\x7f\xf5\xe5\x88\x99\x62\x54\x27\xa6\xe4\x48\x40\x15\x5d\xb1\x6a\x7b\x42\x6b\xfe\x9b\x2a\x6c\x53\x47\xfb\x63\x8e\x31\xd7\xcd\x76\x7f\x99\xae\x28\x45\xd4\x91\xbf\xa9\x89\x3f\x66\xa8\xc6\x53\x42\x6f\x86\x12\x9d\xd6\x6e\xbe\x58\x62\x4b\x86\xe9\x33\x6f\xd4\x11\x6a\x07\x2f\x57\x93\x4e\x21\xc6\x89\x1e\x42\x3e\x87\x5a\x3b\x55\x7f\x45\x35\xe7\x24\x00\xf5\x34\xbe\xf5\xee\xf6\x72\xee\x59\xb6\xeb\x67\x9e\x74\x14\xfa\x0d\x4f\xe3\x96\xe5\x82\x14\x14\x2d\x2c\x7d\x79\x71\xff\xd9\x6d\xff\xae\x21\x87\xf9\x81\x1a\x25\x78\x97\x78\x8a\xb5\x76\xfd\x18\xbc\x9e\x23\xc6\xfa\x7f\x36\x73\x39\x4d\x41\xf1\x5b\x6a\x6a\x9b\x84\xcc\xff\xf4\xd3\x17\x99\x81\x92\x92\xfd\x3f\x88\x08\xaa\x99\xd9\x59\x49\x1f\x06\xb2\xfa\x2a\xf0\x0d\x3e\x45\x03\x8e\x14\xd1\x62\xe1\x9e\xe4\x3f\xf6\x9f\xfd\x25\x5a\x7a\x00\xd7\xee\x6c\x4f\xa2\x56\xa3\x93\x08\xd0\x91\x3b\x79\x36\xb2\x27\x59\xab\x51\xd5\x8d\x2b\x72\x32\x22\xe3\xbf\xde\x96\x9e\xf2\xc7\xad\xc1\x2c\x71\xd0\x3f\xee\x54\x0f\x56\x83\x47\x27\x10\x9d\xfa\xe6\x8f\xb7\xb1\x72\x97\xfe\x44\x3a\x19\xb2\x57\x3f\x31\x22\xcf\xf4\x6f\x34\xe2\x7b\xac\x44\x8a\xda\x36\x12\x18\x64\xa7\x83\xad\xac\xd4\x3b\xc3\x13\xab\x57\x4b\x83\x76\x49\xd4\x1b\xec\xaf\x43\x0b\x51\xbc\x5b\x60\x46\x7d\xf6\x7f\x6a\xab\xe4\x38\x71\x0e\x84\x4d\xcc\xd1\xb0\x4c\x78\xc3\xec\xd5\xc9\xb0\x62\xdc\x95\x2e\xf0\x19\x51\xf1\xaf\x5e\xc9\x74\xe9\x82\xa9\xe6\x34\xba\xfd\xd2\xdc\xc3\xcf\x4e\x9d\x16\x1f\xe9\x5b\x8d\x5c\xb7\xdd\x10\x05\x62\xd9\x40\x4e\xb3\xcf\x20\xe3\x06\xfe\x66\x7d\xbd\x50\xb4\x90\x1b\x50\x9a\xdc\x22\x85\x07\x46\xe3\x51\xef\x4e\x5f\xf2\xe7\x94\x22\x8c\x72\x66\x33\x24\xa9\xce\x7e\x26\xe0\x2f\xd7\xfa\x7f\x1b\xdf\x1d\x84\xdf\x9d\x5c\x03\x83\x68\x09\x1a\xea\x1c\x38\x39\xb8\xae\xbb\xcb\xbe\x39\xd4\xa4\xc0\x54\x36\xd7\xb8\x68\x13\x9d\xd9\x37\x58\xd4\xe3\x52\x01\xe0\x2c\x81\x85\xf0\xc0\xa6\x7c\xef\x6d\xa1\x7f\x13\x25\x84\x08\x8a\x33\xe6\x4a\xcd\xb1\xd4\xeb\x6e\x61\x82\x8e\x6f\x51\x7d\xa8\xaa\x40\x29\xf9\xe5\x1f\x8c\xc0\x05\x6c\xac\xdf\xe7\x6d\x97\x01\x92\x1f\xef\xae\x4d\x23\x34\x80\x35\x93\x2c\x27\x3c\x11\xef\x5b\xd4\xaf\xbf\x8d\x74\x27\x8a\x56\xf0\x13\xc8\xe4\x9b\xd6\x73\xa8\xd6\x2b\x77\x16\x13\x3a\x02\xf0\x9e\x3d\xb4\xd2\x95\x25\x9e\x20\x5d\xd3\xba\x45\xff\xe3\xae\x16\xa2\x07\xbf\x5b\xbc\xe4\x82\x0d\x40\x71\xc7\xa6\x26\xe0\xf5\xce\xb1\x51\xb0\x5a\xf8\xd5\x92\x57\x33\xac\x4c\xd4\xc8\xcd\xe0\xbf\xc7\xcd\x2d\x12\x19\xab\xa1\x80\x2e\x3e\xad\xf4\xff\xb0\xee\xbe\xaa\xe6\xe3\x9d\xc4\xc4\xb5\x5d\xb9\x8c\xeb\xe9\x0b\x9a\x95\xa2\xb7\x65\xd6\xbc\xf7\x82\x93\x15\x66\x2f\xd9\xe1\x45\xf0\xb7\xe4\x98\x06\x1a\x34\x07\xf7\x34\x0c\x51\x17\x9c\x4d\x8d\x33\x44\x68\xf2\x1b\x7e\xeb\xfc\x1b\xe9\xfa\xa3\x0d\x11\x30\xb1\xfb\x34\xc1\xad\x01\x2b\x6b\x9f\xd2\x76\xa2\xc9\xe8\x0a\x05\x9f\x3c\xe9\xd7\xe1\x24\xad\x5d\x51\x9b\xc0\xec\xab\x26\x09\x5b\x71\x14\x82\x6e\x5e\xee\x34\x39\xc2\x5c\xb3\xd5\xcf\x9a\x57\x2c\x4d\x6a\x49\x1f\x5e\x59\xba\xdf\xdc\xc6\x17\x31\x77\x25\x8f\x86\x3c\x62\x70\x85\x4b\x13\x0c\xf1\x50\xd2\x1f\xff\xd8\x24\x9d\x11\x83\x4e\x77\xad\x6e\xb6\x9d\x9f\x88\xb9\x9f\x1e\xf2\x96\x82\x22\x3c\x8b\x7f\xf2\x92\x82\x04\xf0\x0e\x16\x42\x4d\x8c\xfd\xd0\x5e\xbf\x24\x77\x38\xb3\xac\xfa\xc0\x2d\xb9\x4a\x14\x45\x64\x22\xae\x36\x4e\x48\x7f\xb8\x52\xff\xc0\xc1\x0d\x2d\x06\xc3\xaf\xb8\xe7\x91\xe3\x82\x3d\x5e\x9b\x09\x4b\x42\x5b\x16\xc0\x8d\x7b\xef\x5d\xa7\x30\x54\x0c\xe2\xd7\x36\x79\x6d\xca\xfd\x69\xbb\x5c\xd5\x5b\x87\x03\x77\x91\x24\x76\x97\xc1\xd5\xc6\x98\x29\x29\x0e\xd4\xf8\xb8\x6c\x00\x6b\xa2\x01\x7b\x8f\x08\xb2\x82\x8f\xcc\xf5\xae\x27\xed\x0f\x72\x5a\x16\x56\xa1\x65\xf5\x78\xd8\x24\xae\xff\x41\x0b\x3f\xc8\xe8\x67\x8e\x53\x0f\x04\x29\x57\xaf\x2b\xa1\x76\x97\x17\x8a\x3f\x2e\xd0\xc2\xef\x3a\x79\x0d\x76\xb6\xd0\x28\xed\x1b\xed\xae\xfd\x66\x37\xca\xb7\x9a\x1c\x80\x34\xdb\x9c\x71\xc5\x22\x8d\xff\x90\xd0\x78\xe7\x62\x1a\xd6\x33\xe4\x35\x6f\xb5\x77\xd4\xe1\x41\xf3\x74\xcf\xcf\xc9\xfc\x03\x97\xfa\x5b\x4c\x80\x92\x6f\x4f\xf4\x14\x60\xb3\x52\x45\x65\xfd\x2a\xa2\x22\xf3\x5a\x55\xc6\x8c\x5c\x26\xf4\x6f\xed\x0b\x10\x19\xd3\x66\xad\x36\x48\x4a\xf5\x0d\xad\x54\x39\x61\x1c\xfa\xe0\xfb\x34\x1b\xf6\xf0\xe1\xf7\x12\xc8\xf7\x6f\x9b\xb7\x7b\x63\x0b\xff\xa1\x78\xa0\xda\xff\x4f\x7b\xff\x87\xd9\xa6\xec\xa9\x77\x70\x06\xe6\x1b\x6e\x62\x70\x21\x64\x46\x74\x1c\xe5\xff\x8d\xda\xc3\xb0\x50\x2d\x09\x0e\x38\x26\x65\x92\x66\x13\x44\x7d\x93\xf7\x14\x6d\xab\x4b\x63\x1b\x0d\xb0\x58\xc2\xf1\x35\x03\x23\x0f\xd7\x9a\x6d\x9e\xd5\x1f\xaf\x16\x27\xba\xd1\xeb\x1b\xe6\x4a\xaf\xf8\xbb\x2e\xeb\xff\x6d\xcc\x39\x7e\x1f\xc0\xec\x47\x0c\xe3\xbf\x0e\xe8\xfa\x2d\x3d\x04\x59\xf4\x6a\x86\x25\x91\x30\x33\x63\x62\x42\xc7\x5e\x30\xa7\x2a\x4b\x88\x69\xb8\xd2\x64\x77\xb8\x3f\xec\x36\x6a\xec\xf3\xd4\x3b\x5a\x39\xec\x7d\x6a\xcd\x5d\x8f\xa5\xb4\x74\xa3\x2c\x39\x8f\xc9\x26\xf2\xc0\x12\x3d\xaf\x40\x3c\xbe\x58\x66\xc6\x55\x05\x27\x5f\x64\xc6\x28\xdc\xd4\xcd\x23\x0e\x32\x03\xa0\x3a\xa1\x47\x23\xa3\x97\x68\x4d\x12\x97\x54\xde\x44\xce\xd8\xa6\xb8\x34\x92\x76\x09\xec\x9d\x03\x99\x33\x9f\xe0\x3e\xf1\xf9\xc9\xd9\x91\xe1\x2e\xdc\x38\x99\xa2\x0b\xda\xe4\xf4\x23\x88\x31\x58\x99\x99\xe3\x6e\xf0\xfd\x7b\xbc\x18\xd7\xc8\xac\xab\xf9\xed\x90\x05\xa6\x97\x18\x28\x55\x48\x3c\xe3\xfc\x69\xa4\xb9\xb8\x3c\x37\x8b\x65\xd0\x02\x91\x04\x54\x67\xcb\xe3\xd1\xbf\xe8\x3f\x7d\x7d\xe8\xaf\x4e\x24\xb6\x12\x69\x3c\xae\x58\x91\x98\x24\xe8\x5a\xbb\x02\x30\x64\x4c\x63\x29\xeb\x3f\x25\x02\x7a\x63\xe9\x1c\xd7\x0a\x0c\x9c\x90\x45\xcb\x14\x6c\x1b\x25\xc1\x89\x73\x69\xcc\xbf\xfe\x9f\xab\x23\x9f\x25\x81\xcb\x38\xfd\xf3\x18\x41\x60\x8b\x3d\x7a\x8f\xff\x35\x2e\x2c\xfa\x69\xb2\x77\xc6\x04\x38\x56\xde\x89\xe0\x38\x40\x27\x20\x17\xfa\x95\xb1\x2f\x0f\x95\xfa\xc8\x7f\x83\x7c\xbd\x41\xbc\xed\x1c\xd0\x1d\x4c\x83\xe5\xd2\x30\x45\xdb\x1a\xf4\x59\x5f\x0d\xe5\xe1\xb6\x5a\xce\x25\x3a\x50\x23\x40\x96\x81\xb1\x08\x1f\x0b\x03\x34\x9f\xc0\x5f\x32\xe1\x0b\xee\xf4\x34\xfe\xfd\x91\xc5\x01\xda\xac\xf0\x75\x66\xde\x52\xd3\xc3\x84\x67\xf3\x36\x59\x16\x58\x85\x27\x5a\x22\x93\x02\x7a\xa1\xd1\xfc\xea\xd3\x9b\x18\x42\x4b\xf6\xb1\xc6\x5c\x37\x3f\x5d\x9f\xc9\x13\x79\x58\x69\xb6\x11\xa6\x22\x6c\x00\x6c\xbe\xf4\x89\x92\xd8\xed\xf3\xa3\x53\xb4\xf4\xa2\x14\x0a\x05\x09\x5f\x31\x2f\x86\x82\xe7\xbb\x9e\xe5\xf4\x4d\x42\x11\xdb\x72\x05\xf5\x3a\xaa\x4f\x97\xbb\x57\x29\x19\xa9\x50\x12\xc4\x73\x82\xe3\x9a\x45\x31\x0d\xa4\x19\x76\xcd\x9c\x2f\x16\x28\x8d\xd4\xc6\x4d\x3a\x83\x88\x64\x7c\x90\xd2\xd9\x0a\x5c\xe3\xd9\xb0\xc5\x65\x12\xd8\x12\x00\xb4\xe1\xf2\x65\x59\x0f\x54\xf4\xf0\x1f\x31\x83\x7a\xe0\xdb\xeb\xd7\x16\x92\x85\x39\x0c\x91\x6f\xfb\xee\x8b\x18\x0c\x17\x9f\xe9\x5b\x8d\x4e\xe0\x73\x16\x16\x35\x35\x80\xd3\xbc\xc2\x07\xda\x5b\xd9\xba\x77\x3b\x92\x9d\x86\x0a\x84\xd2\xf6\x1c\xc1\xec\x6c\x67\x32\x7e\xa2\xc9\xe4\x11\x97\x49\xdb\xcc\xf0\xf0\xe1\x3f\x8f\xc5\x2b\xf8\xf1\x64\xa8\xcf\x5a\x93\xea\x1b\xa7\xa0\x5f\xb9\x57\xcf\x56\x38\x08\x49\xf5\xca\xb4\x59\xf4\xe6\x57\x47\x06\x46\xde\xc4\x2e\x64\xcb\x3f\xe7\xc3\xe6\x02\x7f\xcd\x7f\xe4\x7c\xda\xd0\xe5\x64\xfc\x55\xe0\xc4\xb7\x83\xf4\xed\x80\x63\x02\xb7\x77\x84\x7d\xc3\xb8\xee\x7d\xff\x61\xc7\xc1\xfb\x61\x74\x62\x77\x7c\x20\x13\x27\x56\x3f\x41\x98\xa8\xd3\x78\x8b\x04\xce\x48\xf2\xb1\x43\xcc\x6c\xb0\x40\xcb\x87\xd3\x2d\xe2\x31\x9e\x5b\x2a\x88\xa1\xff\xa5\x8b\xc0\xe9\x01\xe3\xec\xb5\xd3\xce\xa7\xe3\x93\x67\x09\x75\xe4\x4a\x03\x83\x13\xd4\x78\xb6\x1c\x51\x5b\x98\x3d\xc0\xdc\xb8\x6d\x53\x3c\x8e\x94\x49\xed\xb7\xa9\xc3\xb7\x33\xa6\xbc\x94\x6c\x98\x29\xa5\x20\x35\x46\xe6\x13\xe1\x73\x59\x5d\xf2\x54\x99\x80\x66\x52\x2f\x90\x03\x56\x7c\x89\xb2\xb3\x89\x54\x89\x7c\x11\xe0\xc8\xcb\x09\x93\x82\x52\x0d\x4c\x0e\xbc\xfe\xe4\x43\xe3\xc0\x9b\xd5\xe4\xec\xdb\xa6\xf6\x68\x6a\xa5\x1b\xcb\x53\x2a\x6a\x4a\x4f\x17\x03\x34\x8a\x06\xd3\x57\x54\xe2\x14\xdb\xcc\xa1\x25\xb0\xe5\x2e\xb4\x02\xc7\xb3\xf0\xe4\x01\xe9\xb9\xe7\xb7\x90\x66\x58\xe8\xd1\xc1\x5d\x62\x05\xe0\x49\xd6\x2a\x55\xbf\x45\x58\x12\xff\xaa\x6f\xba\x32\x71\x7e\x3b\x84\xfd\x55\x6f\xaf\x95\xd5\xff\x9a\xa7\x52\x11\x11\x4c\x4c\x4c\xcc\xa0\x76\x0b\x25\x7e\x69\xfa\x3b\x24\x92\x52\xa5\x41\x95\x57\x0c\xf7\x8c\xa4\x4b\x55\xcd\x6d\x3f\x56\x77\x21\x6b\xd5\x2a\xfe\x21\x91\x19\x94\x5c\x0c\xac\x6c\x51\x99\x8c\x5d\x2f\x87\xf0\xe2\xa4\x4d\x24\x7e\x8e\xf3\x6e\x05\x9c\xea\x04\x3e\x2c\x55\x35\x83\xe1\xd5\xc4\x97\x52\x8d\xec\x45\xe4\x5d\x2e\x19\xf2\xbf\xfe\xca\xb7\xe5\x8f\xab\x40\x5d\xc5\xbb\xf4\x65\xad\x01\xc3\xfa\x26\x8d\x73\xd8\xd3\x9c\x13\x7b\x23\x66\x2c\x8d\xbb\x07\x71\xe3\x81\x6b\x0e\xc3\x9f\x1e\x04\xaa\x5a\xb8\xdf\x97\xe1\xf4\xc5\xfa\xf9\x3c\xea\xae\xb7\xe2\xcc\x3e\x4c\x6e\x2b\x97\x4d\xbd\x0e\xdd\x61\x25\xf9\x07\x71\xd9\xfc\xfe\x16\x48\xf9\xbd\x8b\x9b\xaa\x1a\xc9\x1f\x7e\xe1\x7d\x7c\x75\xdd\x14\x73\xc9\xe3\xc5\xb1\xf8\x05\x71\xb3\xa7\x74\xe2\x10\x2e\x95\xa0\x3d\xa8\x88\xc9\xd0\x2d\x88\x6f\x75\x1a\x7e\x5e\x09\x8a\x6b\x9a\xce\xaa\xbf\xff\x9c\x8c\xd7\xa8\xfd\xa2\x46\x1c\x5b\x2b\x88\xbe\xb1\xc4\x64\xa2\xaf\x08\x92\x83\xf8\xdb\x06\x8b\xd8\x89\xc9\x4e\x22\x88\x23\x41\x11\x19\xfb\x33\xce\x66\x86\xcf\xa3\x13\x79\xc9\x43\xed\x4c\xfd\x65\x21\xb5\x9f\xdf\x49\xfe\x8c\xbe\xd1\xac\x59\x2a\xd9\xd0\x42\xd2\x39\x88\x0b\x84\xee\x48\xbe\x3c\x7d\x54\x4b\x93\x4e\xbe\x0c\x76\xc2\xe6\xef\x83\xdf\x4d\x83\x32\xff\x90\x51\xbc\xf0\x01\x99\xed\xe2\xd2\xaa\xac\xf6\x00\x96\xa6\x7c\xb6\xd5\x4d\x0c\xec\x71\xac\x01\xbb\x9c\x1c\xd2\x21\x97\x58\xa8\x75\x28\xdc\x16\xad\x65\x45\x90\x9c\x02\xa1\x51\x6c\x2e\x07\xda\xb4\x40\xa2\xb6\x5e\x23\x55\x29\x46\x03\x96\x27\x30\x4c\xe9\xa7\x62\x66\x2a\x3e\xd4\x97\x60\xc9\x0a\x62\xa4\x31\xaa\x51\xab\xc6\x70\xc3\x84\x33\xec\x16\xc3\x92\xe3\xf4\x4b\xb7\xae\xa3\x64\xc5\x56\x5c\x80\xfb\x06\x7b\xbd\xcf\x85\xf9\x82\xd9\x2d\xc7\xe8\xe3\xcb\x6a\x6d\x77\x1a\x99\x2d\xa6\x2e\xe4\xee\xa6\x23\x79\x75\x99\x57\x0d\xc9\x8d\xd3\xf7\xd9\x9a\x8b\xe4\x0f\xcc\x0e\xcc\x85\x4c\x3f\x9e\xf1\x83\xff\xe2\x3d\x62\x70\x3d\x1c\x83\x9f\x47\xba\xe9\x1c\x35\xd8\x43\xe8\x24\x28\x04\xbe\xdd\xfc\xf4\xa3\xfe\x08\xc7\x51\xd8\xb1\x37\xf8\x28\x8d\xf4\xad\x82\x36\xa9\x94\x66\xe2\x0f\x7a\xb3\xda\x76\x0e\x3c\x70\xe5\xe9\x39\xe1\x0a\x65\xe0\xe3\xcb\x8f\xc8\x93\x36\x28\x8d\xeb\x1f\xa0\x86\x8e\xfc\x01\x6a\x45\xf1\x17\xbc\x69\x9f\xb6\x87\xe5\x5d\x09\xb7\x73\xe0\x13\x19\xb0\x81\xdf\x68\xbc\x0e\xf8\x20\x9f\xe5\xd6\x34\xac\xf4\xaf\x8b\x54\x80\xb7\xb0\x7a\x84\xb0\x1f\xae\xab\x8e\xc7\x8c\x41\x68\xb3\x62\xe2\x7b\x67\xb4\x1d\xbd\x76\x6b\xed\xfc\x89\xa5\x1d\xbf\x74\xa7\xc5\xcb\x3b\x5e\x48\x33\x6c\x54\xbc\x8e\x56\x95\x03\x65\xbe\x4d\x1b\x7e\xd5\xfb\x62\x24\xba\xd8\x7e\xf4\xe5\x93\x22\xd9\xc2\xf8\x56\x5d\x35\x6f\xcb\x59\x13\x27\xe9\x26\x0f\xeb\x5b\x97\x95\x3f\xd2\xea\x78\xc3\xe8\xe9\x6f\x28\x34\xba\xfd\x74\xea\x47\xc2\xfd\x38\x11\x52\x4b\xf6\x61\xc6\xcf\xbb\x93\x60\x49\x35\x7f\xb0\x56\x43\xa8\x5f\xe0\xe1\x7b\x50\x53\xf9\xe5\xf8\x52\x0e\x9a\xc8\x69\x6f\x60\x73\x25\x97\x8c\xcd\x5a\xc1\xc1\x2a\xca\x8f\x18\xbc\x8b\x7e\x51\xff\x17\xb3\x2b\x5d\xde\x24\xfa\xdc\xd3\x4f\x1c\x0d\xe2\x7f\xa7\x8f\x6a\xb3\x20\xf3\xdb\x21\xfa\xb5\x17\x49\x88\x14\x50\x95\x1f\xbc\x7a\xa9\x00\xee\x3e\x3a\x37\xde\x58\x09\xc2\x38\x93\x20\x0e\x76\x22\xc6\x5a\xa1\xb4\x27\x0c\xaf\xc8\xda\x27\xb6\xa7\xbc\x25\x1c\x5e\x19\x7f\x63\x6c\x18\x79\x39\x72\xc1\x7f\xc8\x5e\x9d\x45\xde\xd0\x93\x74\xfe\xbf\x18\x0b\xf7\x7b\xd8\xa2\xae\x9b\x18\x56\x07\xd7\x9e\xb0\xee\x78\xfe\x49\xe1\x1a\x5d\x00\xa3\x0d\xf1\x4c\x9f\x40\x47\xaf\x70\xa5\x3c\x31\x53\xad\x18\xc3\xce\xa6\xcb\xbb\x87\x42\x76\x27\xc7\xc4\xdb\x9d\x78\x61\xbb\xe8\x60\xde\x8a\xf3\x48\x8b\x97\x09\xfc\x4f\xc0\xa5\x10\xae\xbf\xb9\xcc\x09\x3a\xed\x87\xa9\x71\x30\xfd\x00\xdb\x56\xe3\x58\x0d\x99\xb9\x40\x56\x30\xcf\x33\x06\x96\xdf\xa2\xcf\x4f\x33\xba\x23\xef\x9c\x20\x91\xa4\xba\x63\x86\xe4\x48\x54\x3d\xda\x2e\x98\x47\xc3\xfd\x65\x31\xe6\xdd\xbc\xf3\x93\x17\xfd\x62\x60\xc3\x14\x9e\xfd\x91\x8b\x0a\xe7\x40\x46\xcb\x9b\x50\x0a\x41\xa8\xe3\x6a\x95\x2d\x68\x7d\x50\x90\xf0\xec\x75\xaf\xcb\x67\x07\x66\x25\xa9\xc3\x47\xe1\x20\x14\x7a\xcc\x37\x66\x82\x32\xe5\x9d\x19\xbe\x2e\xab\x8c\x9c\x6b\x8b\xed\xe5\xfd\x67\x16\xa9\xa8\xeb\xc1\x89\x30\xee\xa0\xdd\x93\xe7\xb6\xe7\xa3\x81\x83\x89\x74\x33\xb4\xaf\x43\x89\x92\x43\xc7\x51\x8f\x23\xa7\xd9\x61\x8a\xee\xbe\x1b\x77\x7e\x3e\xc3\xeb\x21\x0c\xd1\x00\xdd\xac\x04\xc5\xda\xb9\x04\xd4\x60\x93\xc8\xfe\x5e\x66\xe3\x34\x7c\x68\x35\xf9\xf9\xff\x8c\x2a\x66\x76\xbb\x55\x98\x81\x48\xff\x39\xab\x92\xd0\x38\x1f\x49\xdb\xbe\xeb\x13\x81\x1d\x19\x38\x24\xc4\x21\x59\x71\x11\x3d\x31\x24\xc7\x97\xfb\x4e\xa3\x95\xce\x2a\x85\x2e\x71\xcc\x4f\x9f\x80\xe6\x76\x84\x9e\xfb\x6c\x6e\x99\x45\xdf\xff\x0b\xd2\x8d\x20\xad\x54\xde\xdb\x07\x82\xad\x10\xf4\xaf\x92\xdc\xc8\x3f\xc9\x38\xa5\x0e\x79\x22\x48\xca\xb6\x0a\xae\x72\x20\xd2\x83\x5c\x52\x01\x14\x78\xfd\x7b\xcb\xc8\x76\x79\xde\x2b\x5d\xf7\x62\xf8\x71\xbc\xe8\xe3\xfb\x24\xc8\x85\x15\x2d\x35\x26\x7f\x41\x05\x17\x55\x0a\x47\x2b\xce\xc8\x99\xa7\x78\x27\x1f\x46\x81\x93\x16\x4f\x4d\xad\xb9\xf2\xfa\x95\xd8\x04\xb4\x7c\x4f\x59\x27\x9d\xb2\xa7\x88\x4b\xb5\x28\xc6\x8e\x4e\x14\x32\x89\x12\x07\xae\x14\x97\x8d\x29\x93\x35\xfb\xeb\x60\xa5\x68\x1e\xc1\xb0\x12\x56\xb5\xf2\x9e\x24\x58\x8e\xe8\xab\x90\xcf\x57\x8a\x26\x48\x7b\x1c\x90\x66\x98\x37\x9d\xda\xaa\x3a\x1c\xff\xaf\x7e\xe1\x27\xaf\x26\xf9\x92\x3b\x70\xb5\x3a\x04\xaf\xd5\xc8\x15\xc5\x3b\xf5\x4f\xd6\xab\xff\xf8\x20\x99\xf4\x3b\x41\x06\x2b\xf0\x57\xff\xd0\xbd\x6b\x92\xae\xd7\x44\x75\x0f\xfd\xaf\xfe\xff\xdd\x8b\x9a\x35\x7f\x35\x75\x97\x56\x50\x15\xf7\xe5\xad\x26\x41\x6e\xad\xa2\x7f\x48\xa4\x9e\x0f\xca\x3d\x7d\x0e\x2f\x37\x79\x27\x6e\xd0\x7a\x72\x76\x78\x40\xd9\xe0\xca\x6f\x94\x5b\x57\x53\xa3\xd4\x3f\x01\x9a\x30\xb0\x7e\xac\x8a\x66\x66\x36\x2a\xb1\x2c\x73\xdc\xb5\x7f\x28\x14\x43\x46\x4b\x28\x56\x83\x8e\x14\x4a\xce\x8e\x63\x43\x99\x01\x4a\x5a\x9b\x9e\xa1\xdc\xfe\xb4\xad\xd1\xc4\xce\x1c\x50\x65\x82\x55\x5b\x2c\xc3\xc3\xf6\x59\x5f\x13\x4a\x8b\xca\xc2\xb7\x84\x7d\x28\xf3\x9a\x77\xd9\x9e\x08\xee\x26\x6e\x57\x2f\xdd\x7f\x2d\xd2\x39\xf0\x03\xd4\x50\x6c\x03\x6a\x4b\xe7\x63\x9e\xcb\x24\xcc\x15\xd0\x0b\x37\x8f\xe3\x3d\xed\x17\xdc\xb1\xfb\xf4\xce\xca\xd5\x05\x2d\xc8\xd7\x8a\xf6\xdc\xff\x35\x9a\x36\x45\x69\x2e\x81\x24\xc6\xea\xfc\xf9\x8b\x63\x6a\x68\xca\x99\xa5\xba\x46\xda\xd7\xc8\x6f\x6b\x72\x9f\x58\x0e\x01\xe6\xf1\xc2\x9e\x77\x3b\xeb\x9b\xb5\xfe\x1c\xdb\xcd\x51\x65\xb6\x6c\x04\xbd\x81\xee\xa2\x4b\x66\x5e\x8f\x1c\x50\x3e\x3a\xaf\x66\x73\xc9\x93\xf0\x0d\x2b\x2f\xf2\x45\x45\xac\x60\x66\x4d\x05\xfc\x10\xf2\xab\xa6\xb1\x25\x5c\x98\xd7\x44\xc2\x7e\xa9\x3c\x52\x80\x32\xce\x6f\x22\xa5\xb6\x2f\x71\x91\x33\x72\x76\x2c\x52\xd9\x9d\x8e\x0c\x32\x6d\xbe\x17\x18\x73\x12\x6e\xe0\x2e\xfc\xd8\xdf\xdb\xf8\xcc\xc8\x6d\xf8\x2b\xcd\xf6\xc1\x14\x52\x4f\x9f\xfa\x14\x4e\x78\xf8\x52\x9a\xdf\xc0\x18\x25\x31\x56\x2f\x7c\x3f\x23\x17\xab\xbe\x50\xd0\x3b\xc1\x44\x8f\x71\xcd\xf7\x2c\xfc\x13\xf2\x1c\xe5\x9a\x67\x76\xba\xc6\xd3\xdd\x0b\x5e\x55\x1a\x5b\x27\x09\xaf\x17\x09\xc1\xbe\x5e\xbb\xb8\x64\xde\x3d\xf9\xa3\x53\x24\x12\x56\xac\xce\xc6\x73\xfc\x24\x3c\xba\x9e\xcc\x4a\xf3\x28\xaa\xec\x7f\xa5\xd5\xcf\xea\xe4\xc0\xd3\xe7\x23\x16\xb3\x87\xbb\xf2\xba\x00\x62\x7e\x6b\x79\x74\x4d\x64\x12\x2c\x97\xf4\xf4\x24\x96\x79\xe1\x5d\xd1\xf3\xac\x5c\xd4\xd7\x69\x66\x03\xc9\x7a\x20\xb0\xb6\x8d\xff\xdf\xd4\x61\xd5\xf2\x83\xeb\x34\x4e\x3e\x63\x56\x1f\x97\x94\x50\xe7\x27\xe4\x9d\x05\x80\xd3\xd3\xfa\xa6\xdf\x4b\xfd\xdc\x6b\x8c\x3c\x76\xb7\x6e\xf6\xbe\x28\x2b\xb3\x12\x3e\xd1\xe9\x60\x60\xbf\x94\xf1\x24\xbb\x26\x5a\x08\xea\x62\x63\x53\xa3\x39\x00\x6b\xff\x33\xb1\xb0\x35\x7f\x2b\xab\xc1\x3f\x11\x9b\x2f\x61\x61\xb7\x46\x1f\x0e\xa4\x1f\x86\xae\x2e\x18\x45\x74\x3e\x68\x19\xe1\xdc\x6a\xee\xa4\x37\x69\x53\x37\x24\x3d\x7b\x85\xc4\x2a\x49\x93\xd0\xa1\xc7\x79\xbc\xf3\x2f\xfa\x26\xcb\x6e\xf8\x67\xdf\xd4\x35\xf9\x10\x70\xdf\x9a\xb4\xf2\xed\x65\x06\x65\x93\x5f\x55\x93\x8f\xa3\xb2\x9e\xd7\x1e\x8f\xf3\x97\x7f\xe7\xdc\xe6\xbc\x94\x6a\x2c\x60\xec\xe2\x63\x0a\x2d\x5c\xfb\x16\x8b\x32\x98\x42\x23\xae\xaa\x88\x9a\x2b\xeb\xb8\xe6\x15\x83\x02\x3d\x08\x1a\xcf\xbe\x48\x62\xbc\x4d\x35\xfb\x72\xd3\xde\x0a\x3e\xd5\xd3\x08\x3a\x44\x61\xb0\x76\xf4\xd6\xbe\xe3\x9c\x38\xaa\xa5\x69\xa1\x5c\x5f\xe2\x13\xef\x45\x47\xb2\xba\x2d\x11\x21\x5c\xc3\x7e\x6c\x69\xd4\x30\xc5\x0b\xc2\x69\xf0\xa6\xba\xe6\xff\xc9\x70\x2c\x74\xc7\xf3\xd3\x42\x34\x63\x9c\x07\xcf\xb9\xcc\xfb\x8d\x75\xe9\x1e\x6e\xcd\x0a\x24\x9f\xe6\xb1\xa5\xae\x25\xa9\xfa\xc6\x0d\x9a\x2f\x65\x33\xf1\x30\x0e\x3a\x38\x4a\xbe\x32\x4b\x8f\x6a\xd3\x41\x52\xd5\xa9\x32\x9a\x3c\x64\x08\x16\xd3\x77\x91\xd0\x8a\xa5\x35\xbe\xeb\xa8\xf8\xad\xe1\x57\x2f\x72\xe4\xae\x1c\xc3\xa5\xdf\x63\x88\xa3\x19\x60\x99\x07\xca\xc1\x3f\xfb\x23\xfb\xb6\x3d\x54\x2f\xc8\x6e\x28\x60\x75\x72\xd6\x6f\xcd\xc3\xfa\x8e\xf0\x41\x16\x92\x28\xb2\x5e\x43\xf5\xf8\xb6\x4f\xe4\x44\xcc\x48\x5e\xc7\x28\xa5\x50\xac\xab\xec\xd3\xa3\x6f\xaf\x88\x21\x7c\xa2\x16\x96\xfc\x46\x4e\xdb\x08\x89\x92\xd3\x43\x3b\x00\xa0\x8c\xbe\xed\xbd\xaf\x33\xaf\x53\xe5\xd1\x5f\xd5\x3e\xae\xd4\x0f\x0b\x26\x6e\x35\x72\x55\x6f\x08\x11\x20\x6f\xb1\x18\xe3\xbf\x95\xe5\x02\x59\xe5\x07\xb0\x0d\x21\x7d\x31\x37\xda\xeb\x46\xf5\xf7\x22\xf7\x80\xf1\x25\xfa\x17\x76\xd0\xda\x32\x25\x4b\x27\x77\x8c\x31\x87\x8c\x68\x69\x6e\x6c\xbc\x28\x39\xe1\x3f\xf4\xeb\xfa\x81\x55\xdb\x34\xef\x4a\xf3\x7d\x42\xa2\xff\x14\x33\x54\xab\x1b\x85\xa6\x01\x83\xc4\x27\xff\xf9\x9d\xba\x95\xf9\xb7\xae\xa0\x2b\x07\xd6\x65\x05\x6f\xfc\x01\x0c\x8e\x39\x5d\xaa\xbe\xb4\x30\xa4\x49\x52\xbd\x26\x9f\x7d\x4d\x57\x67\x7c\x54\x98\x23\xe0\xf3\xa3\xe3\x4f\xe8\x02\x71\xae\xfc\xe3\x2f\xe1\x29\x1f\x12\xa9\xc0\x00\xc0\x10\x15\x72\xd1\xd6\x86\x92\x22\xc1\x25\x7d\xc7\xc8\x5d\x55\x29\xdf\xa5\x84\x4c\xe6\x41\xcb\x24\x99\x97\x79\x81\xd2\x42\xbd\x5e\xef\xee\x65\x74\xd7\xd4\x26\xa0\xde\xda\xff\xf5\xf5\x1e\xa2\x0d\x11\xea\xef\x0b\xfb\x76\x45\x7b\xd0\x5f\x8d\x2b\x4e\xf5\xb2\x72\x84\x59\xe1\x61\xe6\xaf\x1d\x46\xab\x30\x56\x0c\xe5\xdf\xd8\x73\x8a\x64\x96\x21\x5d\xd7\xd3\xa9\x02\x98\x23\x2a\x9b\x05\xde\xee\x91\xa6\xfa\x6e\x6f\xd4\xab\x39\xe5\xb4\x30\x62\x9d\xbe\xe5\x66\xe5\xef\x99\xe3\xdf\xa4\x13\x0f\xa4\xcd\xb9\x7b\x59\x60\x7d\xae\x3a\x78\x99\xad\xcd\x3a\xdf\x5f\x88\x4c\x69\xca\xa4\xcb\xe2\xb7\x78\xda\x1f\x7e\x7b\xb1\x90\xc7\x74\xab\xbb\xf5\x8d\xf9\x4f\x4a\x7c\x85\x41\x21\x4f\x72\x24\xcd\x97\x96\x03\x2c\x37\x8a\x82\xbf\xa1\x8f\xdf\x45\xb9\x13\x5e\xc3\xd1\x02\xd1\xcb\x8f\xbd\x78\x0c\x5f\x27\x6f\xf9\xfa\xf2\x5a\xe0\x6d\x12\x6b\xc9\x4a\x11\x36\x63\x3d\x06\xbf\x65\x45\x60\x3f\xc9\xfd\x3f\x92\xbe\xa6\x10\x1e\xeb\x61\xe4\x27\xce\xad\x55\x6c\x0d\x19\x10\xf6\x68\x1a\x18\x53\x5d\xbe\x89\x85\x23\xaa\x45\x88\xe7\x76\xab\xc3\x69\x2d\xf9\x8e\x19\x8e\x7d\x71\xc6\xdb\xa2\xb7\x25\x25\xcb\xf8\x37\xe3\xfc\x46\x2f\xfb\xa7\x4a\xcd\x8c\x63\x69\xd2\xf6\x07\x69\x68\x75\xf9\x86\x81\x97\x65\xa7\x64\x23\x58\xc8\xa9\x62\xfb\x80\xc7\x95\x3d\xd4\xde\x1d\x1d\x62\xc5\x62\x7e\xcb\x53\xa9\xa1\xff\x5a\xcf\xa5\x71\x40\x30\x7f\x75\x17\x72\xf1\xd2\xe7\xb0\xe9\xf1\x7d\x5a\x31\x40\xb6\x82\xab\x38\xb8\x86\x5a\x25\x76\xe1\x58\xd9\x2d\x0c\x60\x5b\x3e\x3c\x9e\xf1\xce\x18\x15\x13\xd7\xe4\xa6\x92\x9a\x15\xb8\x6f\x75\x26\x10\xeb\xc5\x8a\x77\xf2\x05\xbb\x39\x88\xdf\x11\x4a\x94\xd9\x7f\xee\xa7\xf6\x7d\x65\x65\x5e\x54\xef\x35\xce\xdc\xaa\x3d\x52\x05\x1e\xcb\x31\x7d\xef\x49\x63\x56\x39\x75\x79\xe8\x4e\x0e\xed\x64\xc1\xa7\x7b\xb7\x0c\x9a\x17\x7a\xd2\xd6\xb7\x7f\x79\xba\xc4\x95\xa2\xa7\xcc\x8b\x4e\x27\x3d\xa9\x13\x6f\xa7\xb0\xe8\xab\x8e\x1f\x22\x28\x21\x64\x5b\x84\x44\xe1\x72\x1e\xf0\xfd\x0b\xdb\x36\x04\xce\x8e\xfc\x47\x1e\x04\x26\x6c\xe6\xbe\xfa\xc8\xd3\xb9\xd0\x22\x32\x24\x8c\x0c\x3a\xa2\xb6\x95\xaa\xdc\xea\x8d\x1f\xc3\x69\x36\x2d\x43\x81\x65\x07\x8b\xb6\xaa\x52\x2e\xf9\xfa\x07\x8d\xa4\xcf\x7f\x0c\xbb\x42\x8d\x3d\x9b\x4f\x49\xfb\x54\xd1\x3c\x12\x2a\xc8\x4f\xd2\xe9\xab\x40\x41\xb2\xb9\x2c\x51\xf5\x30\xb6\x97\x52\x00\x32\xca\x79\x3b\x9d\x72\x88\x7a\x22\x1c\x35\x51\xfe\x17\x79\xde\xe3\xe2\x2e\xea\x6c\x48\x6f\x9c\xb3\xac\xa2\x09\x65\xa9\xfc\x04\x46\xff\xcf\xdc\xe6\x88\x1e\x22\x18\x12\x7d\x29\xcd\xfc\x16\xba\xe4\x44\x91\x81\x2b\xfc\x16\x2d\x93\x98\xd6\xc2\x51\x4c\x72\xf2\xef\x63\xf2\xba\xc7\x41\x25\x2e\xfb\x0c\x00\x7e\x76\xc6\xb0\x29\x8f\xf9\x6f\x72\xf4\xc7\x6f\x7e\x9c\x88\x3d\x6e\x65\x8d\xb3\x93\xa1\x11\x59\x10\xba\xe4\x16\x06\x28\x31\x31\xe0\xde\x26\x03\x79\x84\xef\x02\x9e\xe5\x41\x24\x3a\x18\xcb\xc0\x42\x30\xac\x93\xfe\xdf\x10\xb3\x40\xba\x8b\x53\xe0\xf4\xe4\xc5\x17\xe9\x4b\x8a\xef\xbe\xe4\xfb\x07\x0c\xa9\x94\x13\x5f\x47\xb7\x39\x2f\xf7\x84\x4f\x6f\x0c\xf9\x39\xd1\xd0\xa8\xa9\xd5\xd4\xf2\x19\x2f\x64\x95\x4f\x5c\xc8\x96\x7b\xfa\x3f\xe8\x44\xb6\xcd\x4e\xfa\xa8\xb8\xda\xd7\xf5\xbf\x49\x08\xa7\x3a\x1f\x74\x97\xc7\x0d\x3e\xbb\x2b\x41\xbb\x95\xa4\x51\xe1\x9c\xa1\x8b\x9f\x7e\x7f\xfd\xf2\x56\xcf\x52\x6c\x88\x0a\xf7\xcc\x81\x33\xf9\xab\xc3\xcf\xc4\xb6\x1f\xaf\x83\xe8\xbe\x8d\x9a\x45\x47\x3c\x2f\x17\x0f\x2b\xcc\xcb\x14\xd0\xe6\xde\xe3\x19\x26\x33\x34\xf9\xc2\x3e\xd4\xe7\x08\xbd\x20\x84\xc2\x93\x02\xc7\x09\x23\x01\xbd\xb4\x0f\x6f\xe1\xed\xfd\x70\x96\xab\x7c\xc6\x65\xa0\x57\x20\x4b\x76\x00\x81\x16\xd7\xd6\x61\xde\x8d\x82\x5a\x26\xdd\xb5\xfa\xb1\x57\x65\x43\x05\x4d\xb7\x18\xc7\x14\xe8\xf4\x76\x41\x20\x15\x98\x01\xab\x05\x78\x73\xb8\x2e\xa0\x8b\x9f\xd5\xa3\xb3\x2f\x72\x14\x33\xf2\xd4\x34\xec\x52\xb0\x4d\xfc\x34\x43\xc9\x65\xcc\x6f\x6d\x12\xf7\x25\x41\x90\x62\xf9\x12\x34\x44\xcf\xe5\xfc\x8d\xf2\xbc\x96\x51\xb0\x1a\x05\x9e\x41\x05\x12\x74\x7b\x66\x97\xec\x90\x6c\x00\xa5\x70\xe7\xc5\xf0\x27\x79\x60\x2d\xef\x1a\x2f\xdb\x16\xd0\x13\xe7\x78\x28\x4a\x91\x84\x7e\xf7\x3e\xc9\x2e\x77\xe0\xe5\xf7\xa7\x76\x5b\xa0\xf6\xd6\x15\x3f\x77\x71\x47\x83\x20\xff\xc5\x58\x24\x74\xd2\x52\x5f\xb6\x1d\xf2\xbf\x7c\xaa\x63\xf0\xf9\x75\x87\x0a\xed\x8a\x6a\x2d\xdc\x91\x42\xc1\x68\xf8\xc6\xd9\x7c\x47\x01\x8b\x7b\x7b\xb1\xa1\x69\x0b\xbd\x6d\x0d\xf5\x5c\xf9\x2b\x98\x45\xcc\xd5\x26\x2e\xd3\x3e\x70\x36\x99\x2b\x97\x1a\xe7\xd8\xec\xe6\x75\xce\x4b\xb9\x6c\xa4\x67\x7a\xb4\x1f\x06\x3f\x3f\x38\x62\xd0\xbd\x56\xeb\x66\x31\x0b\xbe\x61\xe7\x6e\xbb\x92\xf0\xb7\x9d\xa3\x24\x85\xe6\x2d\xf8\xab\x72\xea\x0c\xdd\x47\xbd\x97\x56\xa2\x0e\x61\x14\xe4\x09\x23\x33\xdc\x4e\x12\x39\x7a\xd9\x79\x17\x19\x8f\x25\xd5\xc4\x2e\x56\xbd\x39\x51\x11\x99\x2a\x57\xb9\x25\x79\xdc\xc8\x82\xd6\x59\xf2\xd0\x7d\x86\x7c\x06\x14\xef\xf4\x89\x9a\x9e\x83\x44\x84\x76\xf2\x4c\x98\xc6\xdc\x8a\x23\xc9\x5f\xde\x6c\x7c\xab\x5c\xc7\xc9\x6a\xa1\xcb\x81\x7e\xef\xdf\x75\x39\xba\xc5\x80\x05\x1e\x39\x6f\x2d\xd8\x19\x55\xf4\xdf\xbd\xac\xf0\x51\x5f\x55\x30\x0a\xe4\x75\x1a\x31\x37\x8f\xbe\x07\x7e\xa9\xac\x14\xa7\xe5\xb1\xbb\x5c\x37\x05\xad\xd0\x29\xf4\xb3\xed\x75\x2b\xae\xe1\x03\x90\xe1\xee\x5e\xc9\xaf\x1b\x18\x5c\x78\x25\xcb\x61\x4b\x6f\xc4\x6b\x79\x4c\xf0\xdf\x2e\x0b\x2f\xd1\x12\x1a\xb0\x43\xc1\x1e\x85\x19\x72\xb8\x9d\x16\xf0\xa9\x57\x47\x4d\xf0\x9a\x28\xec\xbb\xa6\x05\x82\xc6\x34\x85\xd0\x56\xa7\xd7\xe3\x94\x77\x36\xe3\xb2\xc3\x2f\x16\x89\x1c\x7f\x84\xb7\xe6\xae\x6a\x7c\x68\xd9\x83\x81\x89\x5d\xf5\x84\xbd\x49\x18\x81\x5d\x40\xdc\x3a\xd0\x09\xe7\x43\x10\x24\x9a\x91\xa7\xd1\x66\xa9\x4d\xcc\xcc\x87\x0e\x9a\x7d\xc7\xee\x87\xdb\x67\x36\x93\x38\x75\xd5\xa7\x99\xbf\x8a\xde\x5a\x70\xd7\xb4\x62\x45\xd7\x54\x74\x7d\x32\x45\x51\x28\xbf\x20\x66\xa7\x6f\x71\x12\x61\xe4\x60\xb3\x85\xfe\xd7\x61\x20\xc2\x0e\x01\xda\x0a\x4c\x12\xac\xb1\x7f\xcd\xc3\x98\xf0\xd0\xaf\x45\xcd\x16\xed\xe3\xce\x74\x65\x7f\xc1\xe4\xab\x26\x97\xb3\xa5\x86\xcb\x98\x90\x46\xb5\xe5\x3d\xbe\xf8\xcd\xbe\x37\x8e\x64\xb2\x49\x2e\x79\x6d\x86\x42\xe1\x2c\x04\xed\xd6\x6b\x58\x28\x9f\x6a\x62\xeb\x92\x10\x97\xe1\xac\xa5\x52\x1e\xa9\x26\x65\x14\x4b\xcd\x69\x51\x59\x82\xcc\x6e\x63\x90\x37\x69\xa1\x6d\xae\xd7\xab\x76\xae\x8c\xb4\xb8\xce\x9f\x41\xa3\x4c\x71\xba\xdf\xc9\x82\x7b\x87\x14\x1e\x66\xa1\x09\xf7\xf5\xfb\xd2\xa5\xa9\xb0\xd5\x44\x7a\x28\x37\x33\x68\xcd\x12\xa6\x07\x49\xb5\xad\x50\xfd\xdb\x45\x68\x4d\x55\x17\xb0\x05\x5b\x2a\x94\xf4\x50\x63\x5b\x1c\xab\xf3\x14\xe4\x99\xf7\xec\xb0\x61\x17\x2e\x24\x32\x7b\x93\xa4\x70\x4a\x24\x05\xe8\x3c\x6e\x96\x66\x71\x11\x2c\x0d\x16\x1c\xc1\x17\xdc\xc6\x6c\xe8\xd2\xea\x67\xc9\xbc\xb5\x6e\xc3\x66\x10\x6e\xd2\x12\x6e\x63\x79\x4b\x0e\xee\x20\x47\xc7\xf1\xb6\xd5\x70\xc6\x16\x0b\x84\x6f\xa8\x64\x7f\x13\xde\x92\x18\x58\x58\x62\x3a\xf3\xdb\x5f\xc7\x8f\x4a\x41\xd2\x75\xa3\x44\x6a\x23\x49\xa9\x15\x5c\x6b\xd2\x28\x05\xee\xe3\xbf\x48\xd9\xbf\x9a\xc8\xd9\x94\xa7\x0a\x27\xdd\x68\x35\xa7\x92\x4e\x7d\xf5\x63\x60\xd4\x8d\xa2\x8c\x08\x57\xd4\x50\x5a\xc2\x97\x13\x05\xf9\xc8\xd2\x4c\xe3\xb8\xad\x7c\x6f\x6f\xae\xde\x2d\x1c\xdd\x23\x14\x65\x97\xfa\x58\xe9\x28\xfe\xde\x4e\x30\xc5\xe6\x21\x40\x52\x37\xf1\x81\x53\x3b\x43\x29\x7d\x6b\x8e\x75\x49\xd5\x79\x87\x44\x8d\x36\x41\x47\xd0\x57\x69\x6c\x92\x84\x59\x81\x4f\xd7\x12\x77\x68\xfd\x60\xeb\x98\x6e\x91\xd5\xce\x8c\xc7\x72\xef\xb1\xc0\x23\x65\x51\x42\x33\x24\x78\x8f\x15\x8c\xb0\x3e\x0e\x1b\x56\x39\xad\x65\xa4\x43\xde\x06\x12\x7a\x46\x72\xf4\xf8\x91\x25\x8c\x7e\x9c\x19\xa4\x60\xdf\x6a\xaa\x39\xcd\xf3\x2c\x9a\x87\xa1\x92\xa7\x1e\xf9\x1f\x47\x4e\x34\xda\x21\x3e\xb5\x59\x9e\x83\x12\x22\xd4\x88\xc9\x0e\x36\x70\x1d\xdf\x47\x78\xf9\x3f\x7e\x09\x51\xb9\xa2\xeb\x08\x3a\xe3\x92\x59\x38\x80\x29\xec\x8e\x82\xb4\xd1\xf5\x2b\x4c\xbf\x06\x88\xe8\x6e\x47\x8d\x4d\x75\x68\x55\x5d\xba\x95\x24\xa6\x52\xa7\xa8\xe1\xd0\x7a\x55\x00\xa8\x2a\xfa\xaf\xb8\x7b\x58\xef\x5d\xb3\x95\xbf\xa9\x4f\x9a\xb2\x8a\x18\x7b\x97\xc0\xa8\x58\xa0\x23\xbc\x57\xce\xd4\x9a\x3e\xdd\xa3\x6e\x77\x53\x1a\xeb\xb8\xea\xa5\xab\x93\x8f\xd4\xa8\xbb\x87\x2b\x9e\x2e\xe8\x2f\x2f\x29\xcd\x1d\xe4\xc5\xce\x2d\xff\x6e\xf0\x7a\x18\xa5\x86\x0a\xeb\x95\x11\x95\x9e\x5f\x71\x93\x18\x49\x20\xaa\x7e\x4a\x3b\x0f\x63\x15\xfb\xd2\x9c\xd7\xca\xa6\xe0\x19\x8b\x2c\x27\x63\xba\xe4\x8a\xe5\xca\x2b\xac\x73\x93\x18\xf9\x8c\x2a\xec\xf0\xb4\x38\x2b\x1d\x86\x43\xcd\x36\x8a\x3b\xb9\x32\xc8\x0d\x2d\xb5\x9f\xff\x1a\x7b\x7d\xae\x40\x65\xbe\xe1\x76\xdf\xc7\x34\xf9\x14\x14\x09\x4c\x46\x90\x5f\xe8\x3f\x01\xb0\x53\xf7\xed\x17\x08\xf6\x41\x3f\x52\xfd\x15\x9f\xc5\xf5\x63\x0c\x03\xef\xf0\xdc\xef\x0b\xde\x5e\x42\x68\x0e\x4d\xed\x01\xdf\x6f\x89\x96\x9b\xe8\xfc\x6c\x5e\x39\xbd\xc3\x87\x5d\x4f\x0a\x58\x26\x71\x20\xaa\x36\x87\x27\xab\x8a\xac\xd9\x86\x57\xc6\x46\x50\x0b\xce\x62\x02\xc5\xb3\xc8\xcd\xfa\x05\xef\x8c\x4e\x3e\x51\xca\x01\xfd\x45\x59\x49\xd9\x79\x6a\xe2\x87\x3e\x1b\xd5\x37\x4d\x4c\xf1\x3e\x49\xc7\xd2\x6c\x41\x63\x74\x66\x6b\xd5\xd0\x61\x78\x2d\x48\x5e\x12\x83\x2c\x5b\x0a\xd9\xa5\xd0\x83\xcf\xfd\x2b\x1b\xa2\x6a\x33\x44\x32\x5d\x7b\xf8\x6c\x30\x71\x60\x4c\x25\x17\x06\x07\x45\xc7\x34\x85\x53\x7c\x27\x59\xf6\xbf\x63\x6a\x9b\x98\x4f\x64\xb1\x1d\xc9\xa5\x79\x6a\x18\x95\x3b\x25\x5d\x1b\x1e\x28\x04\x5d\x71\x9c\x66\xb4\xb4\xb0\x60\x71\xe1\x08\xa5\x1e\x3d\x91\x63\x71\x81\xa7\xea\x24\x39\xb6\xa5\xc0\xd4\x9a\x58\x55\xba\xe7\x7b\x28\xad\x6f\x48\x8c\xc0\x12\x41\x77\x4f\x1d\x9a\x3c\x7f\x17\x5e\xff\xd7\x8d\x7d\xf6\x17\xd8\xe9\x82\xa4\xe2\xca\x70\x1f\x56\x99\x4d\x35\xc1\xeb\x9f\x8c\x80\xc1\x74\xe9\xde\xb5\x8f\xcf\xc2\x6d\xaa\x70\x2f\x7f\x7b\x5e\xf3\x6f\x26\xf3\xeb\x3d\x03\xb6\xb7\xee\xdd\x04\xbf\xe3\x88\x1e\x6a\x07\x3e\x19\xb3\xeb\x61\xc8\xa1\x43\x9e\x98\xbc\x1f\xd7\xef\xd6\xcc\xd1\x63\x4f\xa9\x86\xb9\x29\x72\x90\x73\xc9\x21\x7a\xae\xca\xd5\x46\xf4\xe3\x7e\x80\x9f\x79\x18\x6a\x71\x7b\x32\xf1\xce\xfe\xbf\xe0\x4d\xb0\x77\xa2\xa2\xb4\xb4\x02\x8a\x2c\xff\x1c\xc7\x92\x28\x59\xb9\x7b\x47\xf0\x2a\x0a\xfd\xae\x53\xf8\x83\xc3\xaa\x8b\x15\xdc\x4b\xf3\x99\xbd\x43\x56\xa5\x36\x03\xbd\x02\x5d\x9e\x83\x6b\x0a\xc0\x31\xa3\xbc\x66\x60\x80\x2e\x0d\x6c\xd2\xd4\x84\xb6\xbb\x15\xc7\x6a\xa2\x0a\x06\x87\xc2\x5e\x31\x0a\xa6\x90\x02\xe0\x6e\x46\x29\x11\x52\x40\x31\x4c\xd5\xf7\xe0\x08\xb5\x8a\xfb\x50\xa0\xe7\xf7\x14\xf0\x3d\x54\x5a\xf2\x97\xe8\xc3\xe8\x1a\x9d\x73\xa1\x4a\xd7\xf2\xb2\xbc\x3c\x17\x17\xb0\x6c\x21\xff\x92\x2b\x03\x24\x42\x83\xd3\x2a\x4e\x87\x0e\x13\xa4\xeb\xab\x5f\x7f\xd9\x09\x2e\x56\x6b\x55\xcf\xab\xdb\xa5\xc3\x92\x6f\xcb\x3c\x8f\xa6\x9d\x8e\x53\xd7\x08\x0b\x31\x64\x35\x13\xe8\xb7\x66\x04\xe6\xdc\x7a\xb0\xc8\x03\xc1\xbe\xa4\xa1\x47\xea\x95\x20\x34\xc9\x8c\xe0\xb5\x4d\x64\x99\xa6\x33\xe3\x61\x12\x39\xe8\x57\xc6\xe8\x40\x6a\xee\xba\x7d\x25\xe6\x80\x03\x9c\x94\xe7\xc7\x10\xc3\x41\x4b\x81\x04\xa6\xe2\xf8\x4f\x91\xfc\x58\x8d\xfc\x7f\x21\x3b\xc8\x04\xd8\xfc\x69\xf5\xb7\xbc\x9d\x5f\x1b\x83\xb6\xe7\xda\x40\xaf\xee\x3a\xa8\xcf\x4f\x87\x84\xfd\x66\x4f\x6c\x33\x9f\xc6\x31\x9f\x12\xf2\xe5\xfb\x0b\x87\x3b\x78\x33\x8f\x33\x63\x69\x09\x2c\xf4\xb6\xf9\xa8\xcc\x36\x68\xef\x43\x36\x99\x01\xb7\xf1\xc9\x63\x12\xc9\xdc\x5d\xc2\x27\xda\xdb\x28\xf9\xd6\xa6\x7c\x98\x00\xe3\x6b\x72\x6c\xe0\x2a\x96\x31\x5b\xac\x0f\x60\xed\xcf\xc0\x44\x68\x81\x30\x02\xa8\x7c\xaf\xe3\xa9\xd5\x9d\x72\x64\x4b\xf3\x39\x9b\x7e\x8d\xbe\x92\x30\x10\xa0\x5d\xa8\x74\x55\x88\x37\x1f\x58\x20\x0d\xc1\xc5\x24\xdf\xfd\xc3\x9f\xbe\xb5\x11\x59\x71\xe4\x78\xe1\x2c\xc7\x07\x75\x44\xf0\xcc\x7e\xdf\x07\x5a\x23\xbf\xb3\x50\x88\x82\xac\x04\x7e\xdd\x38\x8c\xa1\x0c\xfe\xd1\xb9\x9c\xec\x1d\xa8\x95\x7d\xdf\xe8\x50\xe4\x99\x99\xc5\xa2\x87\xb2\xcb\x77\xf4\xa1\x89\x29\x4a\xa8\xc5\xcc\x75\x23\x42\x28\x7d\x3f\x04\x7e\xa9\x38\xcb\x80\x95\x4c\x31\x2a\xf7\x55\x49\xaf\x44\xd1\x52\xe0\xb8\x47\x5c\x7b\x8f\x51\xc6\x80\x2a\x36\xfb\x2f\xcf\x96\x0d\xad\x13\x83\xaa\xe3\x64\xc3\xe9\xde\x03\xde\xd3\x50\xae\xf5\x34\xb8\x2e\xca\x48\x08\xa6\x48\x46\xc9\xec\xd8\x49\x48\x1a\x4e\x1d\x5f\x3f\x46\x6c\x96\x36\x05\x94\x62\x8e\x03\xb4\x54\x98\xed\xf1\xbf\xba\x09\xb3\xfa\x1d\xb8\x3f\x33\x66\x5e\x85\xc4\xea\x7c\xc6\x4e\xfb\x33\xc0\xf2\x42\x19\x43\x21\xe2\x41\xea\x29\xdc\x7a\xe8\x2f\xaa\x71\x02\x7b\xf7\xe0\x32\x33\xca\x97\x6c\xfe\x3f\xab\x04\x98\xb9\x7e\x32\xde\x08\x77\x7c\x80\xce\x90\x52\xce\xc9\xde\x22\xf8\x4f\xa3\xee\x3b\x40\xc1\x13\x38\xe6\x5c\xb7\x9e\xcf\xf3\x91\x02\xc5\x4b\x75\x98\xc2\x72\x88\x55\x9b\x0e\xc5\xa2\xba\xe7\xa2\xf0\x9c\x34\xbb\x7b\x43\x87\x4e\xaa\xcf\x5f\x42\x87\xe0\x02\xb7\xa3\x08\xa0\x42\x95\x64\x54\xf7\x30\x9c\xb1\xa4\x07\xce\xf4\x0e\x27\x4e\xea\x5b\xe3\xeb\x1b\x54\x08\x53\x7c\xac\xe9\x9b\xc3\x71\xec\x47\xa7\x90\xe6\x83\xa8\xfe\x16\xc7\x81\x78\x09\xb3\x77\x55\x0c\x58\x32\x7c\x32\xc1\xe1\x8f\x98\x80\x02\x75\x1f\x7e\x01\xef\x5d\x7f\x24\x96\xc8\xf5\xfe\x27\xd9\xd6\xf3\x23\x02\x9b\x5e\x6a\xe9\x01\x6a\x6c\xe1\xd6\x32\xc5\x5d\xcc\xc7\xc0\x10\x9d\xe8\xa2\x16\xb3\x8a\x32\x84\xe8\xc5\x95\x05\xb8\x0b\x1a\xaf\x8d\x44\xc5\xe2\x86\xf2\xe3\x96\xe2\x0b\x7b\x3c\xbe\xef\xd4\x97\x58\xe1\xd6\xb2\x37\xbb\xd8\x0f\x88\x10\xdb\xa1\x9f\x4b\xe9\x58\x48\xcb\x55\xc4\x43\x87\x61\x6c\x56\xf2\x48\xc7\xaa\x79\x72\xac\x49\x89\x95\xc5\x3c\x36\xca\xf6\x43\x59\xf0\x9a\x60\xa3\x93\x50\x74\x47\xc7\x5f\xbf\x00\x84\x3b\x9d\x83\x34\x29\x8e\x7a\xec\x8f\x37\x36\xfb\x66\xdd\x5a\xcd\x3c\x41\x0a\xca\x10\x07\xb4\x77\xbd\x70\x13\x5f\x8c\x82\x9b\x50\xda\xdf\x3a\xf7\x95\x4f\x20\xff\x0e\xc5\xff\x26\x2b\x0f\x95\xd8\x36\x47\xc6\xcb\x74\xc2\x0f\x08\x5e\xb1\x6a\xdd\xd0\x33\x2f\xa8\xc2\x30\xea\xae\xfd\x8f\x3c\xd8\xba\x1b\x33\x5b\xa2\x21\x2a\xb9\xc9\x62\xc3\x16\x8c\xb5\xcc\x76\x9a\x51\xf3\xd4\xef\xfe\x2a\xe8\x3b\x0d\x59\xa6\x4f\x90\x5e\xd9\x82\x36\x14\xb2\x2f\xa4\xfa\x97\xc4\x63\x37\xc9\xde\xf2\x9e\x63\x0b\xed\x26\x29\x2e\x3b\xd3\x23\xff\xbd\xab\x6f\x57\x38\x7b\x5e\x9e\x5e\xac\xa9\x42\x70\xf2\x2a\xfe\xfc\xfd\x5b\x88\x9e\xab\x5c\x23\xbe\x1f\x1d\xaf\xb0\x73\xec\xe5\xc9\xeb\x4d\x46\x1b\x3d\x5c\x64\xa9\x4e\x05\x8b\x82\x8e\x5c\x73\x37\xb9\x0c\x5d\x31\xaa\xc6\xb9\xde\x69\x8b\x31\x63\xd3\xcb\x4f\x1d\x44\x8b\x8d\xd7\xa5\x85\x28\x3a\x5c\x6a\xa8\x9c\xff\xdd\x63\x0e\x61\xeb\xf2\xe4\x68\xda\xe6\x2c\xba\x78\xfb\xbf\x53\xdf\xfe\xb4\x17\x7a\xc3\x7d\xb8\x57\xad\x5b\x32\x44\xf0\xbb\xaa\x27\xe4\xbc\x52\x98\x32\xff\x9f\x86\x40\x20\x89\x0c\x7e\x0c\xcc\x37\xc5\xa8\xd4\x82\xd4\x85\xc4\x10\x3d\x57\xdf\x4b\x0a\x9d\x6e\x82\xdf\x55\x9b\xec\x27\xe7\x4d\x44\x67\x53\x2a\x9e\xdd\x6b\x71\xee\xbd\xe4\xc2\x81\x46\x91\x52\x37\xe2\x28\x03\xc2\xd4\x85\xd8\xff\xc0\x1c\x01\x3e\x3c\x24\x23\xeb\x1d\x2e\x75\xf4\xe6\x48\x92\x0b\x04\x9a\x4c\xc7\xc4\x29\xa4\xc8\x60\x2d\xd6\x1b\x75\x67\x98\x40\x49\x6b\x44\xf2\x6f\x61\x34\x02\xe9\xda\xf9\x9d\x0d\xa0\x6c\x6e\x45\x82\xc9\x13\x8d\x6f\xc2\xc2\x75\xcf\x1c\xd8\x19\x5c\x98\x64\xc8\x06\x7e\x17\x8c\x18\xc9\xc9\xd3\x5b\x8e\x42\x3e\x47\xdd\x79\x1c\x7a\x76\x95\xc5\x8e\x34\x7e\xa1\x42\x72\x6d\x1e\x95\x24\x4f\xc6\xe1\x09\x38\xfe\xba\xb8\x94\x0c\xf4\xcf\x2c\x5d\xbf\x1d\x66\xfc\xd1\xc0\xbf\x3a\xfe\xec\x71\x58\x65\x66\xa3\x55\xfb\x33\xce\xf7\x06\xa7\x3f\x03\x81\x43\x89\xdb\xd9\x25\x55\x6c\x4b\x41\xdc\x29\x58\x60\xdb\x6e\xed\x02\xd2\x3e\xc3\x72\x58\x3b\x07\x61\x08\x1b\xcc\xc8\x7f\x00\x5d\x4c\xf2\x79\x49\x05\x93\xcb\x74\xba\x7e\x72\xe8\x7d\x0f\x55\x08\x49\x83\xb3\x6b\x4f\x6c\xd6\xe4\x82\xa5\x90\xa6\x73\x1c\x7c\x96\xf5\xee\x76\xe7\xcd\xbb\xe0\xdc\x28\xb1\x2a\x7e\xa8\x55\x41\x0a\x55\x1f\xb5\xf1\xa0\xe7\x99\x53\xcb\x43\xa7\x00\x65\x11\xb3\x8b\xf4\x61\x37\xaa\xe9\xb3\x13\xa4\x9e\x11\xba\x54\x7b\xbe\x53\x72\x83\x52\x66\x2b\x99\x96\xe6\x49\xd8\x19\xbe\x9f\xe4\x5e\x43\xd9\x79\xb4\x44\x4e\xc1\xe7\x09\x92\xf8\xce\x4d\x37\x66\x81\x7a\x32\xd8\xe6\xf8\xfd\xf6\x6a\xfc\x54\x18\xa5\xdc\x54\xfc\x55\x73\xa9\x28\x5c\xf4\xef\x0f\xba\x26\xe5\xe5\x06\x05\xfc\x05\x0b\x1b\xb2\xfc\xaf\x5c\xc1\x53\x01\x72\x3f\x9f\x72\x2c\xc3\x50\x0d\x63\x4e\x7e\x53\x95\x62\x99\x0d\x21\xfc\x79\x3e\x10\x75\x7e\x5c\xef\xfa\xdc\x94\x07\x28\xcd\x61\x37\x74\x64\xe0\x6a\x96\x3d\x1b\xd4\x2a\x83\xcc\xf4\x11\x13\x23\x9f\xa9\x81\x73\xdb\x9b\x08\x69\x36\x14\x3d\x26\xaf\x41\x91\x31\x50\xe7\xf1\xe4\x68\xb5\x28\x25\x60\x4b\x6c\x41\x15\xe9\x1a\x93\x33\x14\x9d\xa6\x93\x7a\xff\xef\xaf\xfc\x4e\x1c\xbd\xfa\x6a\x16\x77\xf0\x1b\x57\x01\xe3\x4b\xed\x80\x5e\x61\x58\x7e\xa4\xa9\x8e\x7f\x43\xc1\x21\xea\xd8\xfa\x56\xf7\xf5\x71\xb3\x2b\x9e\x69\x63\x44\x6c\x6f\xee\x44\xed\xa2\xe7\xd9\xad\x07\xf0\xe0\x8c\x4d\xf1\x62\x16\xb4\xcc\xa6\x14\xed\xd3\x4c\x05\x9b\xfa\x92\x6e\x7b\xc9\x0d\x92\xca\xfc\xe9\xa7\xa9\x7b\xf6\x2f\x24\xc7\xab\x5a\x84\x9c\xf3\xc6\x99\x36\xcf\x12\x3d\x13\x00\xd5\xf1\x1c\xab\xe7\x01\x2f\x38\x64\x8f\x67\x27\xd5\x9a\x25\xed\x70\x10\x05\x71\xe3\xd2\x23\x45\x86\x70\x45\x0d\xb0\x14\x2d\x72\x88\x58\x89\xc9\x5f\x02\xd8\x79\x9a\x99\x9b\x8f\xe6\xa3\xf3\x0e\xf7\xc0\x57\x1c\x08\x49\xdb\x2d\x9e\xd9\xc9\xc4\xe5\xba\x70\xc1\x13\x58\x9c\xc3\xe7\x67\xe2\x54\xde\x4f\x57\x68\x5e\x4f\xd6\x07\x79\x1a\x10\x45\x83\x7b\xcf\x5f\x43\x11\x05\x3a\xbc\xb1\xc1\x72\x99\xd7\xa2\xfd\xdb\xab\xdf\xf5\x81\x02\xaa\xc6\x9b\x55\xb9\xb9\x59\x2c\x4f\x8b\x70\x83\xa8\xcb\x62\xd1\x66\x79\xd4\xfc\xbf\x79\x31\xdd\xc2\x6b\x96\x61\x6d\x73\x39\xbf\x7d\xb3\xf0\x50\x82\x21\x69\x3d\xef\xbd\xe1\x11\xf1\x39\xbf\xa8\xe0\xad\x79\x1b\x08\x02\x34\x7b\xa7\x90\xe9\xc7\x03\xa7\xcb\xa6\x93\xe0\x79\x43\xaf\x72\xae\xe3\xf1\xd6\x23\x3a\x8f\xfd\xca\x10\x39\xf9\x28\x7d\x73\xa1\x9a\xf1\xd0\xe5\x6e\x4b\xc5\x7e\x74\x0d\xac\xdf\xf1\x6e\xbc\x3a\x58\x39\x8c\xe7\x16\x3b\x4f\x43\xb9\x17\x03\xa1\xb3\x39\x26\xf2\x76\xce\xfa\x77\x53\xe1\x48\xa3\xcc\xb4\xc1\x62\xa4\x85\xcd\xd3\x67\x1a\x2c\xf7\x02\x8d\xbe\x37\x53\x4b\xa2\xe9\x4b\xb4\x64\x17\x75\x26\xff\x1f\x1b\x6f\x1d\x14\xd7\xd3\x75\x8d\x0e\x2e\x83\x06\x0d\x2e\x01\x42\x70\x1d\x9c\x10\x9c\x41\x42\x20\xb8\x3b\xc1\x6d\x70\x09\x04\x77\x09\x6e\x41\x03\x24\xb8\x0c\xee\xee\x12\x6c\x70\x77\x77\x06\xb8\xf5\xcb\xef\x79\xbe\xf7\x7e\xef\xbd\xff\x4d\x57\xed\xe9\x73\xba\xea\x74\xef\xb5\xd6\x5e\xbd\x1d\x56\xd1\x3f\x1e\x26\x35\x17\x1b\xe1\xbc\x0f\x5f\x29\xdb\x8a\xd5\x04\x28\xea\x6b\x9c\x67\x29\x21\x18\x89\x30\x34\xf7\x9b\x63\x3a\xe5\xb7\xc9\x66\x39\x3e\x0e\x74\xd0\xe6\x1f\xb4\xa4\x41\x93\x21\x6e\xc4\xdd\xc8\x5b\xb5\xe9\x37\x1a\x12\x01\x19\x55\x5f\x0f\x40\xc9\xa6\x79\xce\xd0\x9c\xf4\x7a\x53\x16\xdd\xbf\x4c\x52\x52\xf8\x9d\x12\xe4\x81\xca\x71\x11\x38\xa1\xf4\xcb\x30\xeb\x90\x3f\x20\xad\x99\x38\x07\xa3\xe7\xb1\x17\xf6\xfb\xf7\x8a\x28\x05\x88\x4b\x4d\xe6\x4c\x25\x0b\xfd\xac\x6f\x7b\xe4\x02\x3d\x65\x95\x46\x80\xf9\x4f\x84\x65\x16\xff\xfb\xf2\xab\x99\x4c\x94\xd4\xf7\xea\x3a\xab\x10\x8f\x80\xe6\x1c\x10\xcb\xaa\x8b\xc6\xba\x9f\xfb\xb4\x85\x43\x30\x8c\x08\xaa\x92\xfd\x63\x0c\xfa\x27\x15\xfa\x23\xa9\x6c\x13\x5c\xbd\x59\x96\xe9\xa9\x3a\xf0\x9a\x2f\x8b\xfa\xd5\x92\x1e\x2e\x6c\x41\x28\x71\x95\x81\xc3\x0d\x26\x4f\x7c\xbc\x83\x63\xb2\x3a\x16\xdb\xcc\xa1\xfa\x98\x4a\x63\x18\x60\x79\xba\xeb\x32\xff\xd9\xc4\xff\x24\x35\x8d\xed\x18\xe3\xe3\x28\x21\x75\x66\x2a\xe0\xec\xe6\x21\xb4\xed\x8f\x8d\x5f\xd0\x5a\xbc\x20\x71\x9e\x6c\x22\x80\xee\x56\xa0\xfa\x31\x79\xb3\xfe\x66\xc0\x83\xd9\x85\xf0\xc6\xf9\xea\xf9\xdc\x4f\x17\x81\xac\x47\x8c\xa2\x02\x0e\x70\xf4\x7c\x6e\x95\x5d\xc8\x04\x7c\x21\x50\xe2\x7f\x40\x4e\xa0\xcc\xde\xba\x23\x4c\x89\x24\xfb\xd3\xc1\x96\x9a\xbe\xa2\x88\x43\xe1\x65\xfa\x07\x84\x0c\x4a\xa9\xd1\x28\xa3\xa2\xf3\x2b\x45\x96\x9d\x19\xc4\x68\xf8\xf1\xc5\x57\x29\xf9\x3e\x13\x20\x70\xdd\xd7\x78\xf0\x16\x73\x7c\x89\xd3\x78\x4d\xaf\x85\x7d\x73\x11\x6a\x07\x2a\xaa\xc4\x9e\x33\x2c\x6b\x77\x42\x09\xb3\x90\xf7\xd0\xe1\x45\xe0\x69\xbd\xf2\x74\x80\xff\xf1\x1f\x5e\x0d\x2d\x2c\x12\x9a\x35\x2d\xb7\x2d\x67\x81\xea\x62\x18\xcd\x89\xf5\x5f\x4a\x40\x2b\x94\xd4\x78\x7c\x9b\x6d\xa9\xde\x77\x6e\x07\xdf\xdb\x38\x3e\x95\x65\x24\xdd\x73\xa2\xe5\xac\x2f\x15\x24\xf0\x9c\x66\x23\x8c\xec\x5b\x8a\x73\x9a\x71\x03\x70\x33\x2c\x9a\xbc\xe9\x3a\xa2\x3d\x9f\xdd\xe6\x9c\x72\x32\xbd\x8a\x72\xca\x6f\x18\x13\x84\x16\xc9\xab\x7c\xda\xfc\x9f\xae\xc1\x5a\x0e\x9b\x71\x0b\xc2\x93\xdf\x2d\x71\xeb\x95\xaf\x73\x50\xf0\x5c\x6c\x0a\xbf\x0b\x53\x17\x95\x4f\x47\x83\xd9\x1c\xbd\xef\x0d\xf8\x44\xf6\x22\xa8\xed\x3f\x1c\x88\x45\xff\x72\x42\x3c\xab\x47\x6f\xba\x26\x05\x71\x74\x11\x49\xee\x9e\xd0\xb4\x57\x4a\x71\xcf\x9e\xbb\xb7\x70\x45\xab\x6a\x6b\x74\x06\xc3\xcf\xbe\x5d\xd9\x5a\x5a\x2c\x61\x8b\x6a\x52\x1d\x30\xe2\x18\xd1\x36\xf4\xd0\x1b\xe1\xef\xcc\xd0\xb1\x8c\x37\xd9\xac\x0f\x0c\x93\x5d\x6a\x6b\x19\xf5\x23\xb2\x67\x36\xda\x87\x53\xaa\x95\xe9\xa9\x5e\x63\xac\xa5\x6a\x5a\xf5\xf0\x48\x66\xba\x90\xe1\x33\xde\x4e\x5e\x7f\x5a\xda\xba\x0a\xd9\xb0\x67\x31\x2f\x5b\x82\xfe\xa0\xae\xf2\xf1\xe8\xc9\xb7\x42\x02\xac\x9a\x9f\x4c\xd2\x42\x8f\x15\xc9\x4d\xd8\x6d\x6d\x42\x55\xb6\x76\x82\xe3\x6d\xb6\x26\xcc\x2f\xb3\x0d\xc7\x67\x91\x06\xc8\xd5\xdf\xc1\xa9\x4e\x85\x55\x3a\x7e\x88\x10\x82\x38\x44\xd9\x08\x63\xa0\x2b\x6f\x1e\xf9\xba\x1b\xd1\xfa\x5e\x99\x6b\x4b\x93\x4c\xae\xd2\x08\x58\x29\x6d\xa5\x4a\x2d\x8d\xa3\x0a\x4a\x2b\xcb\x49\x69\x02\x5b\x48\x9c\xde\xe1\xdf\x36\x22\xc0\xe8\x70\x5e\xb9\xec\xa6\xb9\x3d\x84\x70\x2b\x2e\xb2\x50\x8b\x79\x65\x05\xa8\x5e\xe8\x77\xfc\x91\x6c\xc8\x42\x20\x3b\xf5\x73\x2e\xff\x64\x33\x5a\x27\xae\x24\x27\x18\xf7\xd5\x45\x48\xfe\xb3\x45\x84\x86\x88\x73\xdc\xc8\x17\xb6\xcc\xc3\x9c\x26\x86\xb2\x82\x4d\xb7\xcf\xe6\x1b\x91\xea\x99\x19\xa7\xd9\x06\xc4\xd0\x70\x89\x6a\xe3\xf1\xd0\xa1\xd8\x4f\xbf\x8f\x73\x82\x45\xa8\x3f\xdb\x47\xdf\xf2\x61\x18\x9e\x1f\xe1\xdc\x6c\xfa\x49\x79\xe4\xb1\x2c\x54\x84\x4b\xb5\xeb\x3c\x2c\x18\x77\x9a\x9b\x1c\x6a\x35\x9c\xad\x2d\x59\xff\xb8\x13\xba\x61\x9b\x3f\xe7\x1e\xab\x8b\xd6\x75\xec\x89\xec\xa6\x7c\x68\xc2\x77\x00\x93\xd5\x26\x7b\x0f\xeb\x6d\x6d\xd5\xd9\xeb\x52\x01\x08\x0d\x6a\x84\x0f\x8a\xc2\x7c\x6c\x1c\x76\x42\x54\x14\xb3\xab\xcd\x17\xbf\x5c\x25\x59\x5e\xd0\x91\xcc\x98\xc9\xcb\x94\x0e\x1a\x79\xfa\x0f\x8f\xfc\x79\x35\x6f\xdf\xa9\x33\xce\xa6\x55\x61\x35\xb9\x98\xa2\x5b\xd5\xc2\x39\x98\x3d\x3e\x0a\xf4\x9c\x29\x5f\x2d\x82\x79\xe4\xd0\x3c\xce\xd9\x8f\x87\x09\xb6\xc4\x38\x01\x75\x33\xfc\x7c\x26\xdb\x71\x80\x31\x15\x9b\x07\x2b\xb8\xbb\x7d\x1c\x35\xe9\x35\x4d\xd4\x63\x9d\xc8\xcc\xe5\xba\x17\x50\x5f\x45\x68\x37\x79\xf1\x5d\x35\x76\xc6\xb0\xee\x81\xdb\x95\x5f\xd2\xda\xea\xce\xd4\x68\xf9\x67\x0a\xdb\x97\x3e\xb6\xaa\x3f\xd7\x72\x9a\x57\xb6\x10\x25\xeb\xad\xae\x3a\x03\xdd\xec\xfa\x26\xfb\x48\x96\x06\x28\x24\xb2\x8c\xa4\xcd\xc6\xd9\xd7\xf9\xdd\xdb\x38\x35\xd3\xe9\x64\x2b\xe7\xd2\x33\xdb\xce\x3f\xb9\x02\x23\x12\x11\xa9\x42\x02\xdf\xc9\xc2\x4a\xae\xfa\xf5\x1e\xca\x72\x89\x28\xf1\xd3\xc2\xc6\xcd\x3a\x52\x1d\x33\x2c\x1b\x2a\x37\x85\x73\x6e\x59\x72\x56\x76\x9a\xb1\x44\xde\x79\xfb\xa9\xf7\xae\x5d\x1e\x55\x9b\xa9\x3b\x60\xc9\x9c\x12\x6c\xaa\x55\x3d\x15\x53\x0d\x0e\xed\xc7\xea\x06\x7d\x06\x9f\x3d\xf0\xf2\xf7\xb2\x04\x95\x8d\x0a\xef\x9a\x8a\x1d\x97\x92\x85\x1c\xab\x18\x88\xfa\x7a\xa3\xa1\x76\xa7\xca\x86\x63\x20\x9d\x89\xc1\xa6\x5b\x87\x4c\xce\xda\x9a\xe9\x62\x98\x4c\x0c\xf0\x3c\x40\x74\xa9\xe8\xd9\xed\xa2\x56\x75\x21\xde\xfb\xda\x63\xa9\x0b\x57\xbc\x1a\xf3\x9b\x11\x18\x5d\x4b\x66\xa1\x71\x1f\xf5\x64\x2e\x04\xc0\xa5\x2b\x35\x6b\x17\xaa\x0f\x22\xf4\xe4\x29\x91\x81\xc7\x40\x82\x59\xa3\xa1\x14\x7e\x13\x48\xf4\xb1\xba\x9e\x3d\xe2\x72\xe2\x07\xde\xc9\x6d\x46\xa9\x02\xa9\xd8\xce\x6d\xab\xc8\x25\x2d\x13\x41\x85\x05\xd6\x62\x15\x56\x95\xf3\x29\x7c\xaf\x5b\xd5\x81\xaa\x92\x1f\x87\xd9\x3f\x64\x7d\x12\x7e\xd6\x96\x76\x45\x9d\xf8\xee\x05\xcf\x1c\xc2\x9c\x1a\x13\x41\xc4\xd5\x1d\xf8\x7e\x3f\x1b\x9d\x73\x84\x2d\x4c\x88\x93\xc1\x74\x48\x9c\xaa\xfb\xf7\x1f\x3c\x33\x75\xc5\xab\x0d\x2d\x7a\x9c\x07\x7f\xce\x54\xfb\x40\xee\xac\x74\x26\x82\xa9\x71\x28\xdb\x36\xf1\xd8\xef\xe8\x24\xc8\xa9\x4d\xd1\xc4\xbf\xfb\x4e\x95\x68\x87\x52\x4e\xe3\xf8\x43\xb6\xd9\x19\xd1\x79\xa2\x87\xd9\x66\x4d\xba\xe2\x1a\xa7\x3c\xc8\x2d\x0c\x6a\xe6\xb4\x34\x1f\x39\x3a\xbe\x75\xb9\x0c\x54\x67\x3d\x3d\x27\x7a\xc4\x61\xc8\x82\x11\x3f\x30\x52\x69\x8d\xe9\x17\xdf\x0f\x3c\xe1\x63\xbe\xab\x2f\xa3\x33\x68\x1f\x13\x55\xab\x3e\xa6\x64\x77\x77\xe3\xe1\x06\xbb\xdc\xac\x13\x78\xba\x18\x77\x93\xf1\xf8\xd0\xb9\x29\x12\xa4\xac\x37\xb5\x1c\x56\x6d\x46\xd3\x50\x00\xe2\xa3\x9b\xd2\x9b\x07\x39\xa6\x92\xd3\x77\xe6\xcf\xc8\x91\x63\x85\x78\x4f\x23\xc4\x2d\x44\x6e\x13\x5f\xc5\x10\x95\x24\x32\x67\xad\x67\x3c\x09\x58\x4a\xf1\x87\x1c\x1b\x7c\x65\xce\x81\x21\x4f\x36\xee\x79\x15\x3f\x0a\x68\x52\xf5\x0a\x16\xf0\xff\xe9\xe9\x66\x4a\x50\xc4\x07\xbd\x32\x34\xef\xd6\x29\xf4\x72\x04\x6f\xe1\x16\x24\xd7\x95\x4a\xe8\x0e\x48\x7d\x18\xea\xc1\xd7\xd7\x57\x5a\x32\x2b\xc5\x5d\x6c\x65\x75\x1f\xe7\x01\x82\x6e\xb0\x03\xa9\x95\x35\x37\x1e\xe0\xce\x43\xcf\x75\x7e\xa9\xda\xe6\x94\xe4\xe3\xa6\x31\xe5\xd4\x9e\xbd\x55\x19\x1c\x7d\x61\xaa\x5d\xc4\xa8\xb2\x91\x7e\x41\x6b\x0d\x4c\x62\x8e\xea\x1c\x12\x29\xe6\xd1\xfe\x49\x61\x19\xdf\x96\x48\x6b\xbe\x83\x27\xdd\x5e\x35\x8f\x7a\x5b\x86\xba\x0c\x5a\x47\xf8\xca\xe0\x9d\xcb\xd6\x3f\x48\x38\xc3\x06\x55\xbe\xcb\x2d\x3b\x27\xd8\xad\x20\x3b\x3e\x4d\xed\x18\xf3\x9d\xa3\xbc\xb5\xb2\xcc\xa7\x6f\x12\x69\xb8\xe9\x98\xfa\xfd\xd6\x36\xf1\xeb\x5c\x06\xac\x0a\x6a\x9d\x76\x5e\xfe\x03\x53\xbb\xf1\xe2\xa6\x0a\x0d\x7a\xb2\x3b\xa4\xc4\xa6\xfb\x31\x94\xeb\x87\xd5\x7a\x86\x1b\xbc\x5d\xe2\x2c\x43\xdf\x32\x90\xee\xc3\x80\x5d\xea\x9a\xfa\x48\xf4\xe6\xed\x6f\x25\x18\xdb\x37\x58\xed\xb7\xde\xc1\xaf\x40\x27\x58\xd1\xdb\xf1\xa3\xa6\x58\x0a\x55\x6a\x13\xf2\xb9\xa6\xdf\x87\x67\x37\x95\x9e\x19\x0b\xb7\x50\x82\x93\x04\xd2\x27\x33\x50\xe3\x5a\x51\xa7\xeb\xd9\x50\x52\xcd\x3a\x54\x89\xc8\x87\xe8\x06\x1f\xc4\x5c\x67\xdf\xb8\x8b\x39\x9b\x3f\xec\xa2\x9d\xe3\xbc\x77\xab\xbb\x42\x33\x36\xf4\x8e\x56\xa2\x05\x21\x43\x4c\x0e\xe3\xd9\xba\x53\x2d\x0d\x57\x2e\x35\x4f\x42\xc8\x38\x14\xa5\x3f\x2b\x45\x87\x39\xf0\x71\x1c\xe2\x59\x9e\x46\x52\x70\x24\xe5\x68\xdf\xd7\x0b\xb6\x23\xae\x7a\xf9\x0a\x69\x4f\xa3\xf4\x6f\xae\xfd\x18\x86\x92\x28\xde\xe1\x3e\x48\xc2\x1d\xc9\x0c\x68\xe4\xde\xc2\x9a\x26\xde\x0a\x9c\xb0\x56\x24\x55\x2c\x55\xfc\xf0\xab\x69\xd3\x4b\x47\x0d\xef\x4b\xdf\xb7\xd7\xaa\xa3\x1d\x71\xdb\x8a\xa4\x3e\x5f\x90\x3f\x58\x24\x5e\xc3\x6a\x1e\x23\xe1\xd8\xfe\x78\xfe\x9c\xd0\xfd\xf1\x0d\x43\x27\x23\x9e\x48\xf4\x59\xfb\x16\x9e\x1f\x19\xa8\x16\x06\x8e\xd5\xd9\xe6\xd8\xec\xba\x9f\x43\xd3\x04\xbe\x21\x0c\x19\x06\x5b\xdc\x3a\xd3\x3c\x13\x13\x65\x75\x55\x26\xfd\x12\x6f\x6f\x5c\xce\x3d\x9d\x3f\xc6\xf3\x0f\x5a\x7b\xbc\xcd\xc0\xf2\x1b\xb4\xc2\x47\x65\x24\xf4\x7a\xd4\xe0\x73\xd2\x3f\xf0\xb9\x11\x54\xc3\x0d\xa8\xac\xd3\x28\x5b\xe0\xe2\x13\x29\xe5\xcf\x17\x3e\x15\xfd\x65\xbe\xc6\x1f\x82\xc4\x93\xdb\x00\xe6\xe0\x30\x3f\xeb\xd6\xbb\xdf\x6a\xfd\x3d\x0a\x49\x68\xb7\x6b\x30\x85\x27\x06\x6a\x12\xf8\xb4\x5e\x2c\x4a\x69\x2c\x9d\xf5\xbb\x5f\x0a\x30\x7f\x0b\x0f\x7f\x91\xdc\x0c\xa2\x80\xb2\xe7\x78\xb5\xb9\x9e\xda\xfa\x88\x40\x4e\x06\x27\x35\x52\xf1\xde\xa4\x65\xed\x7c\xef\x8a\x2a\xab\x3f\x8b\x91\x00\x69\x4e\x8d\xe9\x7a\x11\x64\xca\x56\x8e\xbc\x8c\x70\x1a\x2f\x9d\x48\xb7\x6a\x55\x49\x60\x37\xb8\x09\x5b\x90\x46\x11\x4f\x23\xcc\x17\x43\x8d\xbe\x2a\xbd\xee\x68\xfd\x6d\xf0\xab\x9c\x54\x94\xda\xfa\x54\x61\xaa\xfb\xdb\xfa\x74\x6f\x99\xa8\x97\x42\xc2\x71\xc3\x44\xc6\x5a\xff\x16\x0c\x72\x9b\x19\x49\x18\x2e\x29\xb1\xfd\xb8\xf3\x13\x75\xfd\xc6\x6e\xf1\x17\x33\x85\x36\xc0\xdb\x45\x91\x71\xc8\x01\x05\xca\xd0\x78\x94\x6e\xc6\x67\x7f\x55\xe1\x9d\x82\x23\x1c\x10\x48\x6d\xa0\x3f\x7d\x29\xb6\xb0\x92\x26\x83\xe2\x0f\x92\x6b\xa1\xd3\xda\xf3\xeb\x55\x02\xa8\x8d\x37\xbb\xc3\xc9\xb7\x9c\xcc\xf2\x13\xf4\x40\x4e\xb1\xbd\x05\x2b\x9e\xd2\xd0\x04\x76\x76\x12\x7a\xbd\x5e\x68\x39\x60\xc9\x5e\x40\x50\xc5\x8e\x17\x33\xb9\xed\xa9\x54\x13\xb7\xfa\x09\x27\x1d\x4b\x67\x55\x1f\x35\x39\x14\xca\xa3\xbd\x79\xf3\x71\x5f\x51\xda\x61\xc4\x89\x25\x39\xdb\xd9\x22\x2b\x7c\x0e\x36\x86\x65\xdc\xa7\x3f\x53\xf6\xb5\xd3\xc5\x23\x8f\x6b\xf0\xd4\x4d\xa8\x9c\x84\xf1\x85\x83\x2f\x28\xb6\x1a\xbf\xc2\xa0\x29\x87\x64\xef\xab\xbc\xff\xe8\x59\xdc\x77\x45\x98\x95\xa3\xf6\xc3\xc9\x07\x7f\x85\x21\x0d\xe4\x20\x95\x96\x00\xf5\xb8\x30\x4c\xe7\x6c\xf5\x5f\x9c\x32\x9b\x1e\xc6\x25\xc3\x3d\x0d\xec\xbd\x0e\x09\xef\x88\xfb\x8e\xf5\x29\xe4\xf4\x49\x26\xe9\x2b\x72\x80\xbb\xeb\x6e\xd5\x33\xc4\xf8\x84\x14\xee\x1a\x5f\x82\xc3\x80\xd8\x8c\x54\x2f\x7a\x5f\xe4\x8f\x93\x9f\x21\x9d\xf9\xb2\xa7\xab\x2f\x70\x41\x40\x6b\xdc\x2b\x45\x0c\x7b\x95\xb3\x9e\x27\xa6\xec\x76\xc9\x20\xd7\xae\x98\x76\x45\xdd\xba\x53\x37\x2f\x2e\xc0\x3f\x8c\x28\x18\x69\xe6\x29\x3a\x1a\xb0\x17\x8c\xd5\xbe\x9b\xdb\x86\x14\xf0\x4a\x11\xa3\x48\xb3\xb3\xc8\xa9\x47\x1e\x8e\x4e\xee\x5b\xd9\x1d\x48\x7e\x19\x96\x78\x97\x26\xfa\x60\x94\xe2\x6b\x1f\xfe\x60\x0e\xfe\x87\x59\xb5\x21\xb7\xaf\x3c\x18\xb4\x7d\xa1\x08\x50\x88\x48\x3b\x43\xb8\x78\x9a\x46\xca\x69\x8a\xa3\x24\x1d\x50\xf1\xd9\x6e\xa2\xed\xfe\x64\xe0\x0f\x0c\xf8\x12\x99\x3e\xf4\xf0\xf5\x5f\x83\xec\x2c\x72\xbb\xb7\x6a\x9a\x86\x3e\x5a\xee\x81\x05\xb1\xe3\x1a\x2f\xf3\x15\xed\x39\x01\xa3\x1c\x71\x8a\xd2\x5b\xa8\x97\x75\xfa\x8b\x4b\x40\xd3\xc6\x83\x2e\xf6\x77\x5e\xd6\xbb\x95\xc6\xe2\xf4\xfb\xf9\x4e\x7e\x8a\x99\xe4\x5b\x90\xf8\x28\x93\x18\xdc\xc9\xfa\xd3\x55\xb8\xa2\x29\x20\xcd\x37\xf1\xbb\x08\x3b\x3e\x48\x23\xa7\xe1\xab\x4f\x96\x87\xd1\x06\xaa\xcf\xe2\x8c\x0d\xdb\x0e\x65\x35\x32\x34\xed\xa6\x07\x94\x82\x0d\xbb\xf6\x39\xd0\xa3\xb9\xae\x6b\x7d\x91\x1c\xa5\xf6\x6c\xe9\x0e\xd4\xde\x70\x3b\x75\x18\x44\xa3\xd1\x3e\xc3\xb6\xd8\xeb\xb3\x63\x96\x76\x3e\xc4\x99\xaf\x7e\x9d\xab\x1b\x2b\x71\x4e\x3e\xe8\x5e\xf7\x25\xa2\xa9\x9c\x66\xf0\xb0\x89\x70\xd8\x05\x4d\xd8\xde\x27\xfa\x9a\xc2\x6d\xb9\x5e\x4f\x82\x71\x5f\x6c\xe9\xd3\x95\xff\xd1\x33\x9d\x7f\xb7\xb2\x3c\xfd\xcc\xe7\x34\xce\x2b\xfd\x10\xe8\xbb\xf8\x96\x8d\x52\x19\x4c\x42\xfa\x60\xc8\x34\xc5\x91\x02\xba\xe5\x17\xc6\x20\xc6\x86\xc3\x57\xd5\x06\x05\x87\x01\x2f\x36\x15\x41\xff\x70\xd1\x65\x83\x89\x87\xf6\xce\x6f\x21\x81\xb3\xb9\xab\xaf\x6a\x9f\x1b\xcd\xc3\x15\x44\xde\x76\x26\x19\x3d\x10\x8f\x74\xd0\xb0\x1d\x1f\x2b\xb5\x39\x35\x8b\x56\x7b\xe4\x73\xf6\x89\x71\xe7\x89\x91\x06\x07\xa1\x86\xe4\x0c\x4d\x3c\xde\xe3\xcc\xdd\x49\x89\x35\x46\x8c\xcf\xd8\xc8\x48\xc7\xff\xf8\x00\x20\xe2\x20\x82\xd6\x15\xc4\x13\x61\x62\xea\xe8\x1c\x27\xfe\xbf\xad\x8d\xff\x97\xd3\x71\x6d\x95\x7e\x7e\xdc\xe1\xca\xaa\xeb\x63\xf3\x12\x48\x87\x12\x05\x4c\x65\x41\x4e\x1f\x16\xf8\xa7\x9a\x35\xff\x93\x9f\x39\xf0\xc5\xd5\x15\xef\xfe\x3b\xff\x61\x23\xe9\xe0\x38\xe8\xb7\xee\xd4\x15\x77\x4f\x93\x9b\xf8\x87\xff\xed\x96\xfc\x9f\xa1\xff\x78\x83\x08\x85\xc8\xcf\x72\x65\xd2\x02\xdc\xbf\x64\x17\xb8\x92\xcf\x2e\x43\x56\xf0\xf9\xc4\xde\xfb\x89\x1c\x99\xda\x64\x1a\x8f\x11\xeb\x99\xee\x10\x97\xcb\x06\x3d\x7e\xee\x81\x6c\x2b\x43\x1b\xfe\x8e\xf7\x8b\x7c\x9b\xbd\x89\x8b\xa7\x4d\x2b\xce\xf2\x29\x08\x7d\x4c\xc4\x7f\x21\x89\x73\x3e\xc4\xc2\x50\xcf\x3f\x32\x3a\xcd\x32\xa3\xbc\xc6\x83\xfd\xf4\xbd\xa8\xf4\x3e\x15\xc9\x30\x00\xb3\x2b\x8f\x55\x39\x47\x36\x3b\x3c\xdd\xf3\x01\x2b\x99\xa4\xe0\xb4\x55\x6b\x4a\xa7\xa2\x4e\x5f\xf8\x06\x76\x93\xaf\xe4\xdf\xf4\x6c\x2a\x12\xa5\xe4\x61\x74\xf3\xb1\x09\x0b\x25\x9b\x03\x47\x34\x47\x94\xe2\x9e\xfb\xea\x43\x3d\x42\x72\x86\x78\x12\x9b\x30\x4c\x27\xf6\x44\xbc\xe1\x6a\x67\x22\x43\xf2\x0f\x0f\x42\x97\x77\xc8\xed\x48\x12\xc2\x3c\xbf\x11\x2c\x20\x95\x5e\xee\xde\xee\x82\x2b\x14\xd0\x95\x1d\x2a\x3a\xbe\xd5\xe3\x15\xba\x4a\x51\x9e\x7d\x0d\x42\xb7\x27\x7e\xb5\x22\xda\xe2\x5d\xc4\x8b\xf9\x79\xb9\x88\xcc\x85\xb0\xa4\x56\x89\x79\x87\x13\x53\x19\x17\xcc\x84\x42\xed\xe9\x52\xb0\x78\xc5\xf1\x60\xb6\xb8\xc9\x86\x7b\x3c\xa4\x89\x28\x55\xf9\x6d\x76\xc7\x55\xd6\x39\xf8\x83\xb2\x03\xeb\xea\xb3\xa3\xfe\x3d\x19\x99\x17\xd3\xca\x82\x24\x73\x7f\x60\x37\xc0\x2f\x1d\x4d\x2d\x06\x35\x94\x8e\xb6\xce\xdb\x91\xbd\xa3\x44\x29\x5c\x57\x05\x07\x69\x9e\xe7\x7c\x68\x46\xf2\xad\xc7\xf8\x3c\x0b\x63\xa8\xe3\x4a\xf6\x62\x1c\xf4\xbe\x40\xd9\xa6\x75\xfc\x78\x71\x11\xf8\x74\xfe\x08\xd1\xe2\xac\xe8\x81\xc4\xac\xf8\x02\xfc\xd2\xe8\x60\xda\xde\x91\xf4\x4c\x0e\xa0\x3d\x51\xd2\x80\x0a\xa7\x7e\x72\x0a\xad\xb5\x20\x4a\x87\xb4\xd7\xe9\xc3\xa1\xb5\x25\x73\x9d\x8f\x6e\x5a\x9c\xee\xdf\x9b\x4d\xc7\x53\x00\x57\x5d\x38\xc0\x9d\x75\x27\xf7\xd3\x3f\xc0\x00\x03\x39\x8c\x22\xe4\x86\x66\xd6\x3a\x56\xe5\xab\xce\x72\x02\xbd\xc1\x8e\x91\x8e\x1b\xe0\xb1\x98\x89\xb0\x86\x17\xe1\xd2\x88\x82\x60\x3d\xde\x98\x1d\x60\xbf\x31\x50\x8d\xd0\x1e\x51\x2f\x2b\xdf\xcf\x75\x0b\x5b\x0f\xb4\xc0\x2b\xc8\xa8\x07\x08\x1b\x25\xbc\xd0\x68\x8d\x16\xd2\xac\x2e\xac\x9c\x9b\xbe\x44\x07\xf1\x78\xac\x0d\x74\xa4\x89\x22\xec\xc8\xa9\x58\x2c\x16\x01\x8d\x44\xbd\x87\xca\x3f\xa7\xd2\x44\x51\xd5\x77\xad\x1c\x8b\x76\xf4\xe8\xba\x44\xdc\xe4\x4e\x38\x62\x4b\xf6\x0e\x9a\x5a\x7f\xe0\x34\xa5\xee\x13\xdb\xaa\xdf\x65\x5a\x98\x79\x38\x18\xf9\x61\xbb\x19\x84\x60\x71\xc8\x81\x77\x06\x44\x9b\x6f\x75\xda\xfb\x71\x3f\x8d\x90\x09\x89\x12\x33\x36\xc5\x5a\x13\x31\x4e\xce\x18\x96\x09\xe0\x1d\x7b\x56\x43\x13\xb5\x62\x59\x9b\x3b\x79\x24\x47\x46\xc3\xc6\xa2\x03\x9d\x88\x0f\x27\xc2\x25\xa0\x2f\x4f\xae\x4b\xba\x2b\x20\xb4\x5c\x3d\x4b\xfe\xe6\x42\xda\xf7\xdd\x0f\x9e\x3a\xc9\x4b\xd0\xe3\x2c\x7e\xf7\x47\x38\x20\x80\xa4\xd4\xe2\x94\x75\x17\xa0\xce\xe7\x85\x7b\xe5\x46\x03\x3b\x47\xf9\x0c\x1f\x7a\x4a\x9f\x77\xcb\x08\x8d\x65\x7b\x19\x58\x4d\x13\xcf\xc8\xd5\x0f\x5c\x16\x09\x84\xf9\xd4\x1f\xd3\xec\xb7\x8f\x86\xb2\x72\xd4\xbb\xbc\x39\x5c\x1e\xee\x3f\xa6\xf3\xc9\x7b\x5c\xfe\x54\xe7\x57\x27\xd6\x59\xb3\x62\xd9\x8a\xb1\x48\x5d\x2b\x2e\x37\x35\x99\x4d\xe8\xc5\xbf\xcd\x7a\xff\xe0\x76\xbd\x77\x30\xe9\xdf\x7b\xc3\x49\xcf\x16\x04\x5f\x58\x31\xb7\x1f\x0e\x5b\xc5\x0d\xfc\x8d\xa1\x4b\x20\xb7\xe3\x30\xca\x34\xdc\x9a\xec\x19\xcf\x31\x89\x6e\x89\xf9\x42\x14\xb8\x7a\xed\xaf\x2f\x3d\x71\x99\x8b\x86\x9f\x64\x20\xac\xef\xdc\x24\x7d\x91\x39\x2d\x5d\x0d\x6f\xeb\xf7\xae\x5e\x81\x82\x10\x79\x69\xee\x5a\xb6\x3d\x87\xa3\xba\x01\xab\x05\xab\xee\x72\x22\x62\xeb\xf0\xe4\x28\x59\x00\xad\xd7\xae\x76\x8e\x38\xc2\x5b\x21\xb7\x81\x93\x5a\xec\x49\x86\x5d\xd1\x98\x71\x79\x7b\x77\xc5\xbe\x0b\x58\x28\x55\x56\xdf\xb5\x80\xd9\x96\x27\x4e\x9e\x01\xb8\xd9\x61\x65\xd7\xf1\xf1\xa8\xd2\x88\x96\x8b\x96\xcb\x70\x24\xd5\x2f\xdd\xe7\x43\x57\xdb\x11\x00\x31\x1b\x31\x7d\x05\x3a\x36\xd6\x40\xea\x3e\x47\xcd\xf3\x13\xde\x1d\xa3\x28\x1c\x14\xd7\x40\xc9\xe6\xbe\x31\x30\x26\xce\x95\x1d\x74\xf7\x55\x06\x81\x1a\x81\xed\x54\xa6\x3a\x0b\x6f\xf2\x44\xf3\x14\x66\xdc\x89\x88\x8d\x88\xdd\x33\x0e\x78\xe2\xa6\xc6\x20\xbc\x3b\x42\xc4\x5b\x7a\xd7\xb3\x2b\x8c\xf4\xfe\x32\xe6\x48\x91\x83\xb0\x20\x8d\xae\xb9\x0f\x38\xa7\xd4\xb9\x7c\xd7\x63\xd4\xd8\x21\xf9\xd5\x27\x27\x8b\x12\xaa\xc2\x23\xcc\xaa\x85\x87\x4b\xad\x27\xe6\x97\xd6\x15\xf8\x01\x19\xa5\x14\xa5\xc3\x92\x03\xd0\x5e\x1b\x50\x8d\x5b\x94\x0a\xf7\x45\x21\xf4\xb5\xaf\x4d\x6e\x98\x39\xdc\x7b\x6a\x32\x70\x64\x4a\x7c\x05\xaf\x91\xd5\xcb\xcc\xdb\xa8\x34\x06\x5c\xfd\x73\x6a\x40\x45\x3b\xb9\x22\x44\xba\xb7\x26\x69\xb2\x2a\xbb\x03\x37\xbd\xdf\x86\x63\x91\x20\xe7\x92\x40\xb4\xce\x6c\xea\x80\x4f\xe5\xb1\x3d\x33\x29\xca\x72\x3c\xfe\x8a\x87\xbf\x82\xfe\x75\xc1\xdc\x42\x07\xa9\xbc\xb7\x6f\xf0\xff\xaa\xf5\xe4\x98\xe5\x6b\xfc\xeb\x68\xff\xc4\x57\x76\x02\x60\x82\x7d\x3a\x80\xab\xae\x18\x20\x7f\x65\x1d\x1f\xbf\x4c\x90\xeb\xdf\x07\xb4\xea\x09\x65\x67\xe8\x2a\xe3\xb8\x7c\x16\xa2\xfd\xef\x05\x5e\xd3\x40\x3b\x81\x3f\xe6\x1a\x3f\xfe\x02\x27\xa6\xd5\x23\xc3\x82\x06\x8a\x00\x83\x79\xe3\xce\x3a\xbd\xe0\xa1\xde\x14\x84\xbd\xe0\x19\xbf\xff\x39\xcf\xfc\x13\x8d\x50\x43\x68\x25\x7d\x7c\x31\x24\x57\x37\x8d\xc4\x0d\xe9\x01\xf5\x5f\x90\x6e\xf3\x15\x00\xb7\xf0\xfc\x5f\x89\xbf\x00\x17\x1a\x31\x4b\xb6\x05\xd8\x1f\xf5\xb8\x2d\xeb\xef\xa5\x03\x12\x0d\xe2\xc9\x13\xb0\x11\x48\xd5\x42\x9d\xa9\xc2\x68\x61\x91\xf7\x4d\x2f\xe2\xcd\xed\x43\x63\x24\x2d\x77\x86\x04\xb6\x4a\x18\x45\x1c\x9d\x41\x35\x3e\x24\xf1\x5d\x6d\xfd\xeb\x6d\xf0\xb7\xf3\xcb\xef\x8f\xe5\x90\x30\x21\xb1\xe9\xe9\x66\x21\x81\x9a\xb1\x4b\x0a\x98\xec\x19\xa6\xc6\x7e\x18\x28\x30\xa9\x31\x25\xc6\x36\x42\xe3\x72\x8d\xad\xaf\x48\x66\xcd\xb0\x36\x9e\x97\x8a\x65\x43\x33\xb4\x58\x83\xf3\x6e\xe9\x27\x38\x42\x1b\x78\xd6\xd8\xde\x2f\x11\xe1\x8e\x38\xe6\xfb\xc5\x2e\x62\x98\x81\xb7\xb7\xca\x05\x98\x50\xc4\x7b\x8b\x2a\x1f\xe3\xad\x80\x71\x4c\xbe\xa7\x58\x87\x75\x24\x98\x45\xed\x1c\xc3\xe8\x66\x92\x45\x98\x22\xf4\x78\x0c\xc7\xf2\xec\xf2\x41\x1c\xe2\x1f\x4f\x49\xec\xf3\xf9\xa5\xaf\x83\xd0\xb5\xf5\x34\xe1\x5a\xe6\x94\x70\x29\x74\x65\xa0\x95\xed\x7b\x39\xc6\x99\xc9\x6e\x0d\x91\xf5\x32\x42\xf5\x6d\x94\x80\x60\x17\x32\x4d\xbb\xf1\xec\x7c\xda\x38\x42\x44\x7d\xa1\xfe\x88\x6e\xd4\xf5\xa6\x3d\x77\xd1\x3b\x3a\xb4\xd5\x8f\x0a\x0b\xf0\x7f\x32\x9a\x30\x2b\x74\x6d\xbf\x80\x8a\x00\x82\xe4\x93\x22\x9f\xe8\xa5\x34\x2f\xe9\xb3\xe1\x9d\x30\x8f\xbc\x94\xbd\xcd\x1d\xab\xf4\xba\x19\x83\x46\x54\xf1\x3e\xdf\x99\xad\xc3\x45\x98\x0f\x85\xef\x33\x6b\x05\xbf\x98\x05\xeb\xd7\xeb\x0d\xc6\x73\x15\x81\x10\x9f\xd9\x98\x65\xc3\x37\x0c\x50\xb0\xbe\x17\x06\x25\xf4\x8a\x1a\x43\xe7\x9c\x97\xe2\x6f\x92\x1b\xaa\xfd\x27\xc9\x79\x91\x1c\xad\x73\x25\xf8\x80\x0d\x9b\x97\x84\x3e\x45\xdf\x21\x72\xa9\xdc\xa8\x6e\x91\x84\x89\x6a\x86\x27\xdc\xdd\x63\xc3\x0f\xf5\x49\xf8\x0d\x3a\x99\xde\xfc\x08\xad\xde\xc3\x33\x0e\xb8\x0b\x55\x68\x09\x62\x08\x17\x2f\x7c\xcb\x8c\x4d\xb5\x24\xf6\xff\x4d\x6e\xff\x1d\x52\x29\x3e\x49\xc0\x77\x71\xe7\x42\xba\x51\xb4\x9f\x01\x57\x5d\xab\x6b\x53\x07\xf7\x29\xdf\x31\x24\xaf\xba\x70\xa8\x5e\x79\x12\x30\xad\xa4\x21\x4f\x18\x8b\x65\x59\x79\x58\x8a\x04\x14\xac\xbd\x09\x12\xee\xfc\x6d\xcb\xfc\xa2\x7f\xbb\x5f\xb9\x06\x17\x2b\x2c\xfc\xac\x01\x10\xef\x7c\x95\xe0\x5c\x48\xf4\x79\x76\x40\xdb\xdc\xe7\xf3\x0d\x00\x85\x63\x58\xa9\x54\x01\x40\xc5\xa1\xf8\xd9\xa7\x3f\x28\x33\xe5\x9d\x4a\x65\xe1\x5f\xf7\x31\x1b\xc4\xef\xe2\x59\x9f\xaa\xf6\xc0\x85\xec\x06\x20\xb3\x90\x0e\x74\x83\x47\x77\x3b\x95\xdb\x89\x1c\xa5\x6f\x47\xd4\x8a\xb7\xf0\x0f\xbf\x1e\xee\x3e\xf4\x20\x0f\x8d\xbf\x86\x04\xbd\xa1\x6c\xb7\x2b\xe9\xab\xa9\x6f\x53\x25\xe8\xd0\xef\xf3\x57\x1a\xc3\x00\x5c\x6a\x92\x83\x18\x76\x10\x53\xe7\x17\xd9\x22\x57\x23\xf5\xac\x1b\x32\x73\xef\x23\xa3\xc9\xc3\x9d\xdd\x9d\xfc\x61\x99\xa6\xf3\xf6\x86\x76\xd8\x93\x31\xf6\xab\xc5\x6e\x8b\x17\x21\x6e\xd6\x08\x1d\xa4\x7f\xbe\x4f\x6f\x7c\x03\x54\x13\x13\x63\x3f\xf7\xc4\xea\x69\x14\x3c\x62\xcc\x1d\x72\xa5\xb3\x28\x6d\x3a\xb6\x8a\x8a\x3e\xf5\x65\x4e\x65\xb6\xaf\x45\x5b\x17\xac\xf9\x10\x16\xb5\x39\x33\x32\xcf\x57\xcb\x5d\x12\x50\x3b\xcb\xc7\xf3\x33\x7b\x9c\x29\xd4\x08\xe0\x7f\xaa\xf4\xb4\x66\x17\x6d\xae\x95\xb5\xd3\xaf\x01\xc3\xe2\xd9\x9f\x20\x9b\xb3\x3d\x22\xae\xe6\x36\xc2\x87\x77\xcb\x03\x0c\x90\xc8\xcd\x5b\xa3\xfb\x8c\x68\xdd\x30\x67\x77\xae\x97\x6d\xc1\xfe\xaa\xe5\xec\xc5\x03\x19\x6c\x21\xaa\xf5\x64\xa3\x6f\x12\xe0\x00\x2c\x1d\x5d\x1c\x87\xb1\xc5\x30\x57\x91\xa3\xd4\xed\xc0\x6d\x7c\x37\x1f\x5e\xa2\x5a\xbf\xc7\xea\x5a\xde\xcf\xdb\x94\x4d\x57\xa6\x87\x33\xcf\xa7\x1e\xd6\x8e\xda\x0f\xbb\xaf\x62\xfd\xd9\xeb\xe0\xbb\xfc\xb8\x33\xe4\x3d\xcc\x9d\xc7\x54\xd1\xe2\x7a\x15\xf1\xd9\x28\xa0\xf7\x7c\x33\x3e\x92\x7f\xba\xd2\x13\x74\x58\x1d\x6a\x7f\x0d\xfe\xfe\x0d\x3f\x87\xd4\xb2\x40\xf1\x0f\x72\xdb\xae\xab\x0f\x6c\xb3\xe5\xb9\xca\x6b\x04\x55\x40\x21\x8f\xb3\x08\x6f\xcc\x62\x62\xb4\x59\x43\x14\x29\x29\xab\x52\x89\x86\xf3\x41\x27\x67\xa3\x3d\x5e\x4c\x97\xb5\x91\x6a\xed\x0e\xa1\x37\xc8\xfe\x5c\xdc\xdf\xbb\x13\x65\x2c\x37\xb8\xcf\xa1\x8a\x97\xd7\xac\x86\x01\x87\x9f\xb1\xb0\x22\x6e\xe3\xfb\x5f\x93\x12\xb2\xd3\xa8\xd0\x70\xd0\x89\x1b\xdf\xdb\xfd\xd2\xfc\x99\x26\x6a\x23\x5c\x4f\x9e\x5c\x90\xc2\x7c\x7b\x48\xbf\xb2\x4f\xbf\xc4\xf9\xb8\xd9\x5f\x7a\x08\x18\x37\x50\x11\xb1\xf3\x46\x47\xb1\x93\x32\x56\x03\xd8\xeb\x99\x19\xed\x71\x04\xb4\x74\x4e\xfc\x7e\x09\xe0\xb7\x65\x77\x28\x09\x3d\x1e\x5b\xa4\x62\x3c\x56\xd7\x5c\x2e\x40\x4a\xf1\x6b\x16\x5f\xe4\x44\x00\x01\x1b\xdc\x16\x2c\x71\x54\xa5\x24\x0e\x5f\x93\x26\xb1\xc9\x7d\xc5\x7a\x7a\x7c\xa1\xe6\x9f\x6b\x16\xcd\x5c\x36\x09\xc3\x5c\x3c\x8f\xc8\xce\x74\xcc\xc6\x41\x4e\x49\xa5\xd2\xc7\xf3\x42\x46\xc9\x94\x44\x10\xb0\x74\xd0\x93\x90\xf8\x24\x4e\x03\x2d\x5c\xf1\xb4\x0d\x9d\x6c\xea\xd9\xf8\xa9\xbf\xa7\xed\x85\x67\x0b\x99\x11\xba\x92\xaa\x59\x7b\xc0\x41\xcf\x76\x71\xb5\xb3\x42\x17\x85\xd6\x5a\x5e\xdd\x7d\x33\x9a\xe2\xb3\xc4\xdd\x7f\x01\xb2\xff\xee\x17\xf3\x0f\x13\x5a\x3c\x8f\xe6\x57\x0a\x2b\x40\xea\xc4\xde\xa1\xb6\x29\xcd\xe3\xbc\x6b\xc9\xf0\x18\xe4\xdf\x23\x09\x9c\x0d\x0d\x57\xad\xdf\x1b\xc2\x26\xa6\x26\x2f\x3d\x99\x98\xae\xe6\xa5\xb3\x56\x28\x62\x64\x07\x55\xf0\x92\x5b\xe2\x77\x95\x39\xdd\x7b\x9e\xf5\x56\xbb\xfe\xf0\x6c\x6c\x8a\xbc\x56\xe0\x33\x1c\xbd\xe8\x83\x3c\xc8\xf7\xa5\x95\xba\xd6\xb2\xfa\x7e\xf7\xf8\xb6\x95\x46\xd4\xde\x8b\x4c\xef\xb6\xb9\x89\xa4\x97\xea\xb8\x15\xa3\xac\xb3\x84\x7b\xef\x73\x35\xda\xeb\xa3\xf5\xec\xb1\xa9\xba\xaa\xa9\x75\xaa\xb8\xf2\x73\x43\xc4\x4b\x41\xc4\x8f\xb7\xae\xb6\x94\xb9\x42\x9a\x36\x1a\xf8\x05\xd3\xa6\xec\x39\x64\xe6\x22\x67\x59\x04\xee\x06\xeb\x26\xbb\x80\x3f\xab\x82\xb3\xc5\xfd\x6b\xe7\xca\xb3\x1e\x9f\xcf\xf1\xcb\xff\xd0\x2b\x07\x08\x30\x6d\x20\xff\x6c\x93\xcb\xb4\x7b\xd7\x42\x3b\x7e\x13\xcb\x71\xf0\x79\x95\x56\xb9\xb6\x39\x5a\x40\x75\x97\x65\xe3\x28\x02\xff\x59\x2e\x3b\x27\xf6\x27\x51\x0f\x06\xe7\xa5\xa0\x13\xa2\xc7\x05\x01\xd3\xfb\x46\x42\xa7\x67\x38\x20\xc5\xe6\x15\xd3\x22\x3e\xad\x72\x80\xf6\x7e\x0f\x06\xde\x6f\xf9\x4c\xbb\x77\x1a\x09\x96\xbb\xd1\x8e\xc1\x5c\x4e\x88\xac\xf1\xb4\xca\x01\xcc\xe9\x3d\x18\x9c\xa3\x49\x02\x08\x26\xd3\xa6\x39\x7d\x62\x36\xd3\x46\x7e\xdd\x99\x21\xde\x7b\xf8\x9d\xdb\x41\x06\xeb\x90\xaa\xd9\xf6\x96\xd3\x32\x36\x2d\xb1\x62\xe3\x2f\xdd\x7f\x66\xbf\x54\xe1\x9a\x3a\xf4\x6f\x0c\x8c\x9d\xa5\x56\xf9\xf7\xf9\x05\x2c\xb8\xf5\x60\x54\xc2\x52\x05\xce\xdb\x38\xc2\x6b\x80\x7a\x0f\x7a\xa4\x4e\x88\x8a\xdc\x8c\xca\x5b\xc0\x0d\xe4\xf9\xf5\xea\x6e\xe3\x7e\x1d\xac\x46\xef\xf0\x75\xd9\x39\xbd\xe4\xcb\x22\x4e\x72\x1a\x61\xa3\x05\x8b\x86\x4e\xe8\xb3\x50\x49\x89\xa2\xdc\x87\xc5\x12\x27\x25\x68\xe0\x76\x7f\xf2\x6f\xa7\x6e\xfd\xcf\xd7\x17\x99\x7d\x1f\x6c\x57\x2b\xd1\xa0\xe4\x5c\x12\x82\xad\x47\x57\x5d\xd3\x1a\x80\xcb\xa1\x70\x82\x63\x17\x7c\xa6\x15\x77\x90\x13\xe4\xeb\xda\x94\x6c\x0f\xc6\xda\x79\xa0\x00\x02\x71\x1f\x3e\xd3\xfb\xaf\x6a\x3f\xc0\xeb\x56\x47\x02\x36\xc1\x17\xb0\x78\x00\xf3\x6d\xff\x23\x5b\x57\x7c\x6c\xcc\x81\x9b\x94\x27\x57\x07\x92\xf9\xce\x6f\x24\xad\x38\xb5\x54\xd0\x95\xc3\x7b\x9d\x1b\xe7\xcf\x1a\x48\x72\xdb\x54\xed\x76\xf8\xca\xc1\xa8\x27\x98\xf7\x0d\x4d\x80\xde\x08\x06\x49\x85\x26\x24\xad\x2c\x46\xe5\x96\x71\xa2\x8d\x9e\x47\x03\x9a\xb1\x31\x42\xb8\x74\xd2\xaf\x8b\x37\xca\x01\x4b\x9f\x7b\x16\x74\x9e\x45\x1e\xbf\x39\x7f\x43\xee\x78\xf2\xaa\x67\x2b\x4d\xb5\x16\x85\x0e\x79\xc9\xa2\x04\x7d\x1a\xd1\x9b\x9d\x7b\x37\x2f\x26\x14\xaa\x24\x4b\xa4\xff\xd0\x27\x75\x83\x75\x3d\xec\xd1\x9a\x69\xd7\xf0\x05\x61\x6c\x9d\x44\x16\x3c\x76\x6b\x53\x97\x28\x1c\x2a\x70\x2e\x06\x0b\xab\x79\xb2\x7a\xda\xcd\x73\xe5\xf3\xf4\xcb\x7a\xdb\xc8\x37\xe9\xd0\xd0\x3d\x8a\xd4\x53\xac\x1c\x99\xaa\x59\xb2\x66\xb1\x56\xc5\x72\xf8\x0d\x81\xd4\xe7\x44\xbd\xfa\xf6\xa3\x77\x28\x55\x3c\x6c\x77\x3e\xc9\x1c\x70\x91\xa6\x90\x43\x4c\xdd\x5f\xd2\xa2\x64\x7b\xf1\x66\x49\xa3\x88\x93\x5d\x6d\xa1\x65\xfd\x45\xaa\xee\x74\xa9\x61\x18\x59\x52\x9b\xc0\x56\x0a\xb4\x98\xd3\xd7\x28\x34\xae\x56\xeb\x6a\x22\xd6\x11\x2f\xd2\xc6\xdc\xed\x09\xd8\x61\x04\xa3\x2a\x93\x23\x4c\x88\x1a\xe8\xf7\x3f\xbf\x9b\x94\x88\x2e\x75\x1b\xf4\xb0\x13\x58\x3b\x79\x87\xe5\x2c\xc1\x72\x2c\xd9\x4a\x2f\x47\x20\xcd\x19\x49\x8c\xec\xbf\x3f\x45\xd5\x13\x5e\xde\x10\x28\xa0\x3b\x8c\xab\x8c\xa1\xea\xfd\xad\x7c\x6c\xef\xc7\x73\x1f\x82\x7e\x7a\xb0\x24\x9b\xff\x28\xfb\xd1\xc7\x70\x99\xf1\xba\xea\x82\x89\x12\x9b\xc1\x9c\xf1\xcf\x15\x06\xc2\x18\xb9\xe4\x0d\x5e\xf9\x92\xcc\xa8\x65\x0b\x96\x39\xcb\xab\x53\x7d\x85\x6c\x61\x89\xa8\x7a\x4d\x57\x5c\x4e\x26\x9c\x8d\xca\x99\x00\xa0\x48\x38\x41\xee\x44\xdd\xc7\x32\x1a\xa2\xb5\xef\x6b\xba\x7f\x54\x04\xf2\x50\x81\xc4\x2a\x57\xc2\x4a\xae\x52\x7f\xc6\xfd\xd0\x3b\xbf\x62\xe2\x3c\xc9\x29\x08\xa2\xc7\x6f\xdb\x1c\x39\xf6\x60\xd9\xb3\x67\x1f\xed\x86\x1e\x87\xb4\x65\x5d\xc9\xe3\xe4\x4a\x15\xde\x49\x72\x89\xc5\x03\x35\xf1\x98\x56\x2c\x85\x9d\x36\x7c\x39\x5e\x59\x6a\xb2\x82\x1f\xc3\x09\x8c\x4a\xdf\x38\x99\xf4\x7f\x09\x23\x30\xda\x2d\xcf\xf2\x86\x9c\x30\x95\x7d\x93\x30\xd4\x83\xf4\x11\x6a\xa8\x47\x75\x5b\xc1\x1c\x2e\xa3\x2f\xad\x01\xce\xb1\x8c\xde\xa7\x5d\xe6\xd2\xec\xde\xd5\x2f\xec\xd4\xac\xbb\x30\x9f\x13\x1f\xd6\xd9\x44\x36\xc5\x52\xd2\x88\xdf\x52\xe4\x15\xbc\xef\x90\x32\xb5\xbd\x2d\x9e\x5e\x5b\x02\xbc\x51\x37\x7a\xdc\x9e\x19\x9c\x36\x7c\x53\x08\x2c\x49\xd0\xcb\xd7\x68\x95\x03\xb6\x3f\xf7\x60\x54\x2a\xc6\x08\x20\x14\xe1\xd2\x29\x2b\xd2\x98\x98\x50\xf7\xf7\xd5\x61\x58\x94\x62\x87\x92\x4d\xf2\x81\xc5\xdb\x74\x5e\xa8\xd4\x7a\xaa\xed\x2b\x96\x56\xfc\x3b\x9c\xa3\x43\xba\xa3\x5d\x16\xdf\x50\x69\x9a\x54\xf4\x6f\x3c\x88\x4e\xa7\x0b\xa8\x3a\x22\x38\x6d\xa0\x0f\xe3\x31\xad\x80\xa2\x04\x9a\xb9\xeb\x66\x18\x95\x47\xa2\x20\xf8\x4c\x45\xdf\x58\x7b\x16\xbe\xce\x38\xe3\x39\xe5\x51\xe2\x6c\x48\xad\x37\x87\x13\xa4\x80\x2e\x8e\xba\x66\x2e\xca\x46\x83\x80\x4e\xcf\x7d\xa3\xdd\xfa\x1b\x52\xcd\xb0\x70\x02\x53\x75\x14\xa7\x4f\xb0\xee\x55\x0e\xe8\x8f\xf5\x83\xcf\x06\x06\x99\x36\xff\x22\xb2\xac\x7c\xd5\xbb\x03\x7d\x03\xc8\xd2\xc9\x24\x86\x30\x2b\x54\xec\xf4\x40\x6f\xa5\xea\x2f\x3b\xe1\x27\x5a\x6a\x72\xa1\x61\xbc\xbe\xea\x5a\x3d\xeb\xab\xed\x6d\x8d\x7e\xc0\x0b\xd9\x0b\xa6\x14\x4b\x66\xe5\xe2\x7d\x83\x35\x09\x64\xee\x14\xf7\x66\xbd\xe6\x12\x01\x93\xe6\x91\x40\xce\x38\x5b\x84\x3c\x63\x71\xca\x1d\xfe\xf9\x67\x9b\xfe\xac\xc3\xe7\x17\xd5\xbf\xb3\xb4\x25\xce\x56\xa8\xf8\x4b\x03\xbc\x8c\xfb\x51\x56\xf7\xe7\x62\x3d\x70\x51\xff\xd5\x2c\x76\x05\xbc\xd4\x3f\x7f\x06\xff\x87\xfd\xa8\x05\xb6\xe3\xf9\x68\x7d\x96\x07\x23\x61\x4f\x61\x08\xb3\x6b\xc9\x05\x7b\xcb\x89\xf3\xca\x31\x1d\x55\xae\xd5\x4e\xf1\xd4\xd3\x5a\x85\x19\xc8\xb5\xaa\x44\xbe\x52\x6c\xbc\x12\xa7\xaa\x7d\x6e\xfd\x2f\x26\x69\x22\xd6\xfb\xc9\xbf\xfd\xa6\x8a\x6c\x4e\xaa\xdf\x91\xff\xf7\x50\x45\xd1\x2b\xf8\x4f\xea\xc5\xf7\x9c\xfa\x6a\xae\xc2\x9d\x3d\x93\x4a\x65\x10\x13\x4c\x24\xb4\xc1\x9e\x9d\x43\x3e\x25\xc5\xd5\x37\x63\x4a\x95\x31\x5c\xf6\x08\x83\xf6\x4e\xc9\xa3\xdb\x7a\x76\x87\xbe\xf6\x73\x26\xc5\x0b\xf6\x4d\xe9\x7b\x0a\x17\x6d\x92\x92\x3c\xb0\x9b\xfc\x1d\xd5\x28\xd6\xce\x0f\xbf\x99\xcf\x91\x85\x59\x05\xce\xfd\xa6\xb1\x6b\x66\x4b\xe2\x41\x3f\xb7\x04\x9f\x9a\xf6\x43\x0a\x1b\x30\xda\xe6\xc4\xda\x22\x8d\x16\x4d\x24\x84\xab\x83\x8a\x46\x29\x95\x2d\x8c\xe8\x5f\x2f\xb9\x66\x55\xc7\x3f\x71\x77\x96\xd2\xce\x69\xc9\x7f\xd1\x37\xb5\x66\x6f\x9f\xa9\x5f\xd9\x85\x8c\x42\xa6\x88\x31\xc7\xe8\x4f\xf3\x3d\x7d\x9c\x2e\x7d\x4f\xe3\x9e\xf0\x84\x24\x17\x07\x9d\x55\x75\x95\x67\x63\xed\x4b\x57\x9d\x3a\x9d\x46\x29\x5f\x4f\xf6\xa8\xab\x51\x41\x02\x39\xbc\x85\x0d\x98\xe6\xe7\x39\xbc\xd9\x16\x7e\x44\x3e\xf3\x99\xa1\xa5\x60\xd8\xd3\x1f\x8e\x7f\xb1\xbf\x2d\x08\x97\xaf\x69\x5e\x66\xb8\x2b\xbf\xb3\xfa\xc4\x43\x90\x31\x7c\x5a\xfb\x7b\x6e\x0a\x27\xa7\xe3\x4f\xc1\x32\xde\x0b\xe1\x85\x18\xe6\x42\xc6\xc0\xa3\xb3\x90\x45\xe2\x53\xe5\x1a\xbe\x03\xac\x22\xd9\x0f\xa2\xe7\xb8\xb1\x6b\x99\xed\x23\x43\x00\x02\x01\x49\x27\x95\x52\x8c\x9e\xeb\xce\x87\x0a\x6c\x4a\x96\x99\x84\xcf\x9e\x13\xfc\x45\x17\x8c\x65\x3b\x91\xab\x9f\x47\x76\x6e\x2c\x94\x7c\x3c\xfb\x0a\xcb\xd3\xf8\xec\x86\x75\x0f\x0a\x05\xd1\x0d\xe8\x8f\xbf\x3d\x58\xc6\x41\xf8\x4e\xcb\xe2\x6f\x1b\x45\xfd\x44\x3c\x0d\xbb\xed\x43\xec\x1e\xcf\x1b\x0d\xc3\xdb\x75\x1d\x81\x89\xa4\x5e\x71\x2d\x93\x3e\xa2\xd5\xe1\xaf\x5b\xcd\x06\xe2\x3d\x76\x7d\xee\xde\x0d\xd6\xc8\x8e\xc5\x21\x8e\x61\x8a\xd2\xdd\x1d\xc9\xa9\xb5\x76\x6d\xab\x01\xd3\xd3\x56\xeb\x45\xd8\xb4\xf6\x10\x17\x4e\xb6\xe2\x45\xdf\x80\x28\x95\x19\x8e\xab\x07\xd5\xe3\x6e\x5f\xff\xf1\x69\x27\x37\xbc\xda\x8c\x7c\x76\xd3\xab\x94\xa8\x91\x88\xb1\x88\x00\xb9\x38\xf3\xb8\xf3\xbb\xc8\x5d\xea\x6d\x9b\xe9\x4b\x54\xfe\x7a\xe1\x66\x0e\xac\x38\x86\xe0\x32\xa1\x14\x02\x73\xe8\x53\x6b\xa4\x33\xb0\xd8\x05\x61\xb5\x89\x83\x17\x84\xeb\xdd\x66\xad\xdf\x9b\xe5\x55\x98\xd5\x0c\x8b\x64\x17\xd7\x8d\xdd\xec\x6d\x6e\x2f\x7d\x19\xfd\x27\x63\x93\xfd\x5e\xc3\x19\x3b\x39\xa0\x47\xa6\xe8\x92\xd2\x83\x35\xcd\xf8\x23\x59\xf9\xb2\x98\x83\x61\x09\xee\x74\xc2\xac\x5d\x39\xb2\x9c\x9e\xb2\x0e\xd5\x06\x1b\xad\x3b\x38\x7b\xbc\x5c\x8f\x1b\x6b\x80\x40\x86\x32\x62\x52\x82\x5f\xa2\x67\x66\x58\x83\x41\x2a\x51\x19\xc3\x4c\x71\xd7\xe5\xd8\x6d\x12\xf5\xd3\x76\xf6\xfe\xc5\xe1\x83\x30\xaf\x44\xf6\x6c\xc6\x0e\x6a\xf0\xca\x6d\xa9\xc8\xeb\x65\x17\xcb\x7d\xae\x84\xa7\x08\xbe\x61\xba\xbb\xc0\x85\xe7\x3b\x07\xfc\xa8\x22\xde\xf4\xfc\x4d\x2f\x6e\x0f\xb3\xad\xc4\xc9\x3a\x31\xee\xef\x99\xc2\x7c\xcd\x79\xf2\x29\x8f\x02\x1c\x51\x14\x25\x0d\xf9\x3e\x90\xb8\xdf\x47\xb3\x09\x2a\xef\x9f\x83\xcf\x95\x24\xca\xbb\xdc\x9c\x13\xb3\x48\x80\xb0\x8b\x6d\xf0\x9c\xaa\x59\xeb\xe5\xe6\xd0\x00\x84\xdf\x26\x36\x0e\x72\xe5\x33\xd0\x2f\x9e\x1e\x1c\x4f\xaf\x5f\xc3\x96\x7e\x15\xf6\x50\x49\xc8\x8d\xca\x5b\xb4\x0b\x72\x30\x00\xcf\xf1\xc2\xc8\xef\x8a\x91\x13\x3f\x1c\x72\x14\x54\x3f\x15\xac\x61\xac\xe2\xdb\xde\xd9\x04\x5e\xae\xc5\xa2\xcb\xa4\xc4\x3c\x77\x15\x3e\x26\x94\xbb\x81\x57\xee\x43\xf5\x1e\xa6\xd6\x3a\x2c\xe6\x28\x65\x9b\xaa\x4f\x09\x82\x2c\xc8\xf8\x53\x62\x5f\xe4\x53\xb2\x55\x6e\x4c\x8b\xa8\xba\x49\xd8\x8f\x55\x6c\x24\x0e\x59\x5f\xff\xe2\xb7\x11\xb7\xc5\x31\x50\x49\x0f\x4a\xa6\x56\x42\xf9\x2d\x5e\xc9\xfa\x9d\xbf\x26\x95\x3f\xa5\x70\x35\xc7\x83\x97\xbf\x26\x9b\x9f\x20\x76\x35\x47\xa7\x88\x9f\xa7\xd0\x4e\xf0\xb8\x20\xb9\xdd\xe1\xd2\xb1\xce\xf3\xb2\x63\xb2\xc5\xd3\xc8\x86\xbd\xfd\x83\x5a\x46\xe8\x2a\x54\x74\xcb\x6e\x85\x4a\x87\xcf\x01\x97\x84\x3f\xe5\x72\x62\xe6\x26\x0e\xff\xf2\x35\x0b\x0b\x40\x86\x1f\xd5\xa2\xec\xde\x0a\xaf\xd9\x98\x28\x68\x2e\x1e\xcb\xfb\xf7\x03\xc0\xf1\x7b\xb5\xf4\xf8\x31\xce\x44\x62\xb1\xf0\x38\xa2\x8b\x0f\xc5\x25\x07\x8b\xf8\x1b\xf6\x4f\x1a\x63\x72\x99\x55\x1a\xa3\xe2\x2b\xf5\x61\xc0\xf7\xd0\xf8\x2a\xa7\x7a\x8a\x0e\x73\xa7\x18\xbb\xbc\x14\x4e\x2b\xfd\x75\xcc\x9d\x08\x14\x8f\x77\x5d\x75\xdf\x61\x6d\x9b\x70\xbb\xc0\xd7\x9c\xf8\xb5\x98\x08\x46\x5c\xfb\x75\xf6\xcf\xaf\x03\xbe\xff\x29\x9a\xab\x4d\x45\xd0\xc8\xc9\x1c\x15\x36\xe3\x7f\xbf\xaa\x4a\x37\x67\xb1\xce\x37\x5d\x65\x4f\x07\xb4\xa4\x6d\xe6\xa2\x32\xf0\xc8\x8d\x76\x14\x21\x7e\x65\xf8\x60\xc9\x9e\xda\x76\x81\x32\xd6\x59\x7a\xc9\x35\x57\x84\xe4\x33\x15\xa1\xea\xe5\x88\xa7\xef\xea\x7c\x75\xbe\x17\x15\x73\x79\x8b\xc1\x28\xb3\xc7\xdb\x41\xd1\xe0\x64\xee\x55\x06\xf0\x31\xa3\xa0\x7a\x67\x8f\x68\x04\x32\xf5\xe3\x73\x40\x01\xc4\x62\x3d\x3e\x09\x66\x20\xf4\x35\x59\xdd\x52\xe8\xa3\xe7\x92\x97\x7a\x75\x17\x80\xde\x3b\x64\x51\x61\x24\x93\xa7\x2f\xea\x4a\x23\x86\x79\x71\x92\xb0\xee\xa1\x3a\xde\x9e\x59\x15\x95\xfc\x81\x52\x91\xa8\xac\x88\x2d\xa0\x12\xc4\x3e\x27\x46\x30\x79\xbb\x65\x52\xfa\xd3\x1b\xa0\x43\x5c\xc9\xdf\xc7\xf2\x28\xaa\x02\xe3\x98\x78\xf5\xc6\x07\x9c\x90\xf6\x2a\x34\xda\x85\xfd\xc0\xd3\xd8\xbf\x9c\x4e\xa2\xb4\x79\xd5\xed\x47\x08\xb1\x3e\xd4\x5b\xc6\x38\x02\x11\xf5\xdc\x6f\x95\xf1\xa4\x7f\x60\xc6\x24\x64\x14\x0d\xf3\x03\x57\x80\x34\x63\x63\xa2\xee\xcc\xc9\xfd\xc7\x6e\x8d\xcd\xd3\x44\xf9\x27\xa5\x4f\xf9\x37\xad\xc8\x6f\xc5\xab\x2a\x56\xc5\x0c\xb7\xce\x39\x3c\x36\x84\xe7\x56\x8d\xfb\x51\x70\xbb\x62\x0c\x10\xd6\x8c\x8c\xb4\xb9\x1c\x00\x1d\x82\x7d\xc7\x3c\x05\xe5\x48\x57\x8f\x33\x96\x47\x57\xc2\x1e\x48\x51\x45\x3d\xfd\xfa\x9a\x14\xd9\x44\xb9\xdb\xd1\xc3\xa1\x4f\x89\xbe\x58\xb2\xaa\x03\x95\xe4\x9f\x5a\x27\xcd\xb1\x29\x51\xb1\x49\x41\x3f\xb4\xcf\xa4\x09\x47\x46\xec\xda\x5c\x4d\xd1\x90\xa9\x5f\xa6\x03\x71\x8d\x64\x6f\x11\xae\x74\x16\xf3\x73\xcc\xe9\xde\xaf\x61\x82\x90\xd2\xac\x00\xbf\x79\x9e\x40\x4a\xe8\x5f\x20\x6e\x2d\x93\x5b\x12\x0f\x3a\x32\xb1\x08\x98\x31\x7a\x14\x03\x64\x68\x67\x61\xbb\x1e\x3f\x01\x68\x01\x11\xc1\xbf\x4e\x92\x42\x47\xaf\xe7\x27\x0a\xdd\xb9\x2d\xd9\xf6\x94\x87\xd9\x97\xf9\x87\x4e\x00\x5a\x45\x22\xa2\x0e\x7b\x4c\x01\xbf\xbf\x83\x5b\x3f\x73\x91\xf1\x5c\x5b\x88\x54\x4d\xa0\xbd\x37\x62\xb8\xc5\x73\x21\xb4\xcd\x16\x9e\x95\x35\x24\xec\x30\x5b\xe1\x5c\x50\xdd\xdb\xf8\x55\xe6\xa5\x3b\x36\x67\x8b\xa3\xe3\x49\x5a\xab\x88\x42\x5d\x31\xd2\x95\xfb\xd9\xd1\x6f\xe5\x4f\x03\x69\x6d\x01\x95\x9e\x0f\x4d\x90\x84\xac\xb0\x0e\xf3\x62\xe9\xe6\x11\xd4\x48\x71\xef\x97\x3d\x08\xa8\x81\xb6\x66\x61\xd9\x90\x01\x23\x30\x02\x6f\x7d\xd2\xe6\x73\x3d\x2f\x64\x9c\x5e\xb1\xd9\xe4\x66\xe0\x97\xe9\x98\x1c\xc1\x4e\x29\x96\x5e\x1e\x26\x26\xdd\xcc\x3c\xed\x4e\x6a\x58\x63\xae\xef\xfe\x33\xf7\xee\x77\x63\x2b\x66\x40\xd8\x7b\xdc\x9c\x46\x56\x00\x08\x6b\xbb\xc2\xca\xc4\x73\xb1\x0e\xc1\x12\x4d\x6a\x8d\xa0\x43\xb0\x43\x4a\xba\x74\xf3\xa8\xd2\x80\x7f\xc4\x69\xd7\x84\xd7\xa2\x9b\xd7\x98\x9d\x9d\xe3\x57\x2a\xe3\xc8\xe1\x57\xd5\x7b\xb3\x5b\xf5\x4b\x1d\x80\x27\x1f\xc2\xbe\xb5\x40\x02\xbc\xd5\x6b\x85\xbb\xdf\x59\x83\xe3\xd7\x2d\x56\x78\xf8\xce\x92\xda\x3c\x8e\x3b\xc8\x85\xcb\xab\x06\xca\xc5\x39\x22\x18\x72\x1d\x0b\x8c\x64\xd5\xec\x55\xea\x4c\x67\xd9\xb9\x45\x43\x54\x3f\x55\x49\x4f\xf7\x20\x91\x7d\xe8\xab\x39\xb3\x6c\xa4\xb4\x0c\x45\x41\x43\xe7\x3c\x26\xc4\x01\xa0\xaf\x39\x14\xbd\x9d\x36\x58\x27\x00\x3c\x77\x2a\x22\x05\x3b\x21\xab\x08\xb8\x93\xd3\x72\x63\x56\xe0\x72\x5d\x13\x28\x5b\xfb\xc2\x5d\x9e\x2c\x1e\x72\xcc\x5f\x11\x64\x23\x39\x7a\x09\x0f\xd3\x9c\x93\xa7\x4a\x84\x5b\x0f\xe0\xf6\xad\x7d\x37\x28\xb6\xcb\xcd\x0a\x5a\x02\xf1\x6a\x3f\x7e\xec\x5f\x30\xee\x3c\x1d\xee\xfe\xdd\xfd\x8b\x2b\xe5\xf8\xed\x6a\x08\x2c\xc4\xd8\xd1\xeb\x94\x78\x85\x4d\x11\x63\xcd\xee\x16\x6f\x0e\x2f\xea\xc4\xc1\x47\xd2\x43\xf2\xec\x1c\x24\x2b\x29\xf5\xc1\x56\x68\x2c\x66\x77\x2a\xa2\x7e\x9f\x85\xd8\xb4\x5c\x81\xe9\x48\xa8\x53\xc1\xf0\xcc\x1d\xa8\xfe\x20\x19\xe8\x9f\x52\xe6\x46\x2c\xcc\x2a\xe0\xbb\x3c\x21\xb8\x08\x6d\xfe\x41\x02\x81\xe7\x7b\xac\x3b\xcc\xb6\x76\x7e\x0b\x32\xb8\x83\xad\x14\xff\xc1\x53\xcb\xd7\x4c\x8c\x85\x9d\x64\xf2\x56\x44\xa5\x41\xbf\x36\xc0\xe3\xd4\xd2\x6e\x81\x39\xa1\x1a\xcc\x4b\x06\x1f\x6c\xb9\x63\x4a\x4e\x38\x7b\x7a\xe8\x3b\x93\x65\x65\x11\x52\x8a\xbf\x21\x8b\x89\x31\x14\xc6\xd4\x6c\x06\x52\xf3\x7d\x03\xbd\xaa\xe0\x45\xfe\xbe\xdb\x4a\xd8\x15\x5d\x3f\x38\x7a\xa0\x0b\xe5\xf0\x73\xa1\x5d\xad\x0b\xb8\x0b\xf3\xd2\x0b\x72\xea\x2e\xc9\x21\x14\x49\x0b\x41\x69\x77\x56\xa2\xce\x50\x45\xc5\x73\xf0\xbf\xd4\x20\x84\x9c\x1d\x0d\x77\xa4\x31\xa5\x02\x4c\xc8\x2a\xd8\x9b\x22\xc2\x65\x1a\xcd\x23\xb4\x74\xa6\x2d\x9f\x3d\x8d\xf6\x0c\x39\xbb\xbe\x17\x32\x8c\x0f\x8d\x6d\x94\xcb\xd0\xde\x98\x88\xd8\x63\x96\xaf\x60\xba\x01\xe3\x3b\x96\xae\x25\x5c\xf7\x91\x46\x7e\x88\xcb\x11\x09\x26\x6b\x25\xe8\xf0\x77\x7e\x6d\x37\xfa\xa6\x2b\xa4\x53\x7c\xd3\x99\xf7\x6a\xd7\x3e\xa4\xab\x35\x0d\xf7\xc1\xe4\x44\xe8\x49\x7a\x99\x25\x32\xe7\xc5\x5e\x72\x31\xa7\x9b\xad\x7f\x3e\xc4\x17\xbb\x6a\xde\x38\xd2\xbd\x75\xf5\x30\x4f\x6c\xb5\xc9\xf4\x7a\xd9\x9f\xcb\x6a\xf2\x0a\x1d\xf4\xe1\xe9\xe5\x09\x55\x75\x83\x5c\xe6\xf0\xb9\xe8\xed\x53\x06\x6e\x53\x5a\x77\xfb\x67\x4e\xbe\xae\x19\x74\x35\x7a\xc5\xe8\xff\xfc\x80\x9b\xfa\x83\x81\xf8\x04\x89\x29\x68\xb9\x24\x7f\x35\x75\x0c\x9c\x57\x87\x8c\xe3\xc1\xa8\xfb\xdf\x28\x89\x29\x86\x58\xd9\x4b\x69\x10\xdf\x73\x1c\xdd\x01\xcf\x9f\x3e\x10\xd1\x6b\x02\x26\x80\xd6\xf6\xda\x79\x62\xca\x60\x8c\x22\x64\xf7\xf2\xb5\xca\x5b\x55\xdf\x4f\xdd\x08\x7b\xc1\x94\xc4\x4b\x9a\xae\x49\x1d\xec\x38\x80\x84\xa3\xd1\xad\x5c\x7d\x88\x56\xa5\xfa\x69\xfc\x17\x61\x7d\xc0\x5e\x30\x25\x09\x2b\x9f\xf3\xd6\x58\x96\x55\x9c\xf2\x5f\xa9\xf8\x36\x39\x14\xa4\xa0\xd0\x43\xe2\xe2\x9b\x82\x81\x49\xfe\xbd\x1e\xc1\x67\x80\x72\x5f\xec\x00\xe3\xbd\xfd\xcb\xfc\xe4\x20\x3d\x42\x4f\x73\xf4\x47\x48\x92\x83\x39\xd8\x13\xdc\x9a\x0c\x39\x5e\x7c\x91\xff\xf7\xce\x19\x07\x72\x83\xeb\x91\x37\xd6\x71\xca\xaf\xb8\xa0\x0f\x27\xaf\x9d\x62\x33\xc3\xbb\x08\x4d\xee\x13\x3d\x55\xdf\x4e\xa3\x13\xc9\x16\x6a\xd4\x27\x35\xcc\xd8\x58\xcf\x95\x3f\xe5\x98\x85\xa2\x34\x44\x0d\xb8\x2f\xb0\xe6\x0e\xcd\xd1\x19\xc7\x30\xbf\x6f\x9b\x9a\x3e\xba\xba\x7f\xeb\x3a\x80\x8e\x22\xfb\xe3\x7f\x05\x42\x37\xdc\x17\x44\x9c\xd9\x02\xf3\x2a\xc4\xee\x93\xaa\x48\xde\x7b\x84\x9f\x20\x85\xe6\xfe\xdf\x41\xfe\x82\x24\xed\x26\x91\x87\xfc\xb1\x66\x91\xef\x8b\xf0\x23\xa4\xc1\x45\xb5\xd5\x85\xf0\xcb\x81\x86\x5a\x9b\xf1\x67\x95\x84\x57\x8a\x8d\xbc\x44\xb5\xcf\xe7\xff\x05\x76\x0f\xc4\x8a\xad\xec\x4a\xff\x47\x2b\x22\x7b\xa5\xe7\xde\xe0\xe8\xbd\x20\xf2\x57\x6a\xdc\x05\x96\x9d\xa4\x26\x7c\x6d\xa6\x14\x32\x0e\x45\xb1\xbc\x3f\xdb\xc3\x6a\x45\xff\xeb\x12\x66\x15\x13\xa3\xa4\xfc\x8f\x4b\xf8\x9f\x05\xfb\x83\x39\xcf\xf5\xc1\x4a\x86\x23\xd7\x35\x7a\xea\xca\x8e\x77\x7f\xce\xdf\x88\x07\xf3\xe8\xdb\x0d\xc1\x74\x2e\x1a\xc4\x25\x15\xce\xa8\x49\x46\xcc\xea\x7e\x6a\x9f\xa9\x7d\xe2\x84\x79\x5c\x64\xa8\x88\x13\xb9\x95\xdd\xe7\x05\x45\x5d\xb1\x7b\xd0\x22\xd1\x77\x06\xeb\x86\x1d\xda\x1f\x80\x77\xf0\x28\x56\x2d\x89\xef\xaf\xa3\xa8\xdf\x78\x58\xf0\xba\xff\x5a\xf5\x17\xd2\x19\xef\xf8\x60\x18\xd8\xac\xcb\x66\xdd\x64\x20\xe7\xf7\xf8\xc3\x35\x39\x88\x3e\x5f\xbc\xe3\x5b\x96\x37\x0e\x24\x05\x30\x6f\x05\x1d\x4f\x2c\x21\xcc\x2d\xc8\xd9\x37\x2d\x12\x09\xc0\x24\xee\x7e\x55\xc4\xf5\x73\x3a\xa9\x2e\xd3\xb8\xd3\xff\xdb\x6d\xae\x5d\x67\x14\xdb\x1c\x70\xb0\xc2\x30\xa9\x5c\xb7\x58\xe5\x2c\xf5\x5a\x95\x7c\x2c\x1a\xc0\xa4\xa2\x68\xbc\xd9\x8e\x85\xe7\xe4\x32\xc4\xe2\x47\x4e\x08\x87\xbe\xd8\x8a\x6d\x4c\x33\x2d\x00\xf9\xd1\x75\x90\xb6\x92\xd8\x2e\x8e\xdd\x28\x72\x25\xf2\xbd\xb9\x5a\xa3\xce\x5a\xea\xe9\x9b\xab\x45\xe2\x32\x6b\x82\x3f\xc9\x6e\x6b\x13\xe7\x01\xfb\xc7\xf0\xd3\x7f\x02\xe2\xf4\xa8\xd5\x5d\xbb\x88\x72\xd5\x87\x41\x73\x9b\x78\x98\xfe\x9c\x53\x1a\x8c\x98\x34\xde\x85\xd1\x1f\x1d\xb8\x02\x86\xaa\x9e\x9a\xa6\x23\xd7\x24\x90\xbd\x57\xb5\x3f\xf8\x2d\x94\x5f\x36\x25\x6e\x69\x56\x86\x60\xbb\x8b\x0f\x2e\x51\xd2\x4c\x7a\x45\x6e\x60\x82\x03\x5c\xaa\xdc\xdd\xbf\x23\x23\x2e\x45\xb5\xdc\xd0\xd1\x12\xe7\x04\x68\x5a\xdc\xbf\x43\x42\x70\x1e\xe7\x68\x9c\xb5\x11\xe9\x88\xc4\xcd\x7f\xbd\x2f\x5d\xc9\x28\xb1\x73\xc4\x30\x8e\xc1\x9e\x28\x26\xe3\x57\xb5\x63\xbf\xd0\x4b\x34\xc2\x2d\x10\xe8\x9d\x5c\xa0\x5d\x02\xc8\xfc\xd2\x42\xa9\x11\xf6\x38\x43\xfe\xc3\x38\x91\x5a\x19\xe5\xad\x02\x85\x65\x59\x58\x27\x1b\xac\xdf\x49\x7a\x9c\x97\x10\xee\x51\xcb\xde\x8a\x9e\x0d\xbe\x48\xf8\x63\x1d\x98\xa8\x79\x92\x42\xb3\x07\x31\x86\x45\x18\x94\x00\x54\x04\x7f\xf1\xe3\x9c\xf5\x60\xed\xed\x3f\x33\xfb\xa5\x22\x6c\xff\x74\xc8\xb2\x08\x0d\xcc\x39\x75\x51\x26\xe9\xa0\xd1\x10\xb9\xd9\xf8\xc8\xc8\x12\x10\xbb\x71\xd1\x79\x45\x0b\xe5\xb3\xfa\x4d\x17\x94\x9c\x84\x74\xff\x7d\x0f\x96\x30\x86\x63\xcc\x8b\x32\x0f\x9a\xdb\xa7\x27\x97\x39\x94\xe5\x0f\x32\xbd\xb7\x47\x4c\x2a\xb6\xa6\xd8\xee\x8c\x57\x7e\xd9\xea\xec\x14\x21\x96\x6d\x72\x5e\x96\xc9\xf3\x93\xd4\x89\x05\xf6\x38\xcb\xa8\x1c\x3a\xba\xe4\x55\x9d\x11\x4f\x3a\xa5\x31\xd9\x48\x86\x1d\x86\x17\x3d\x2e\xbc\xb6\x40\xb0\xd5\xa9\xf2\xe7\xaf\x62\x25\xe2\xa8\xf1\x58\x1d\x25\x4f\x29\x9c\x8f\xdf\xd2\xac\x98\x03\xd7\x94\x3f\xa9\x84\x92\xbf\xf6\x9a\x0a\x5d\x1b\xa7\xb7\xab\xcb\xd5\xbe\xb3\xe0\x3e\x17\xa8\x31\xe3\x48\xf3\xf0\x18\xa5\x6b\x87\x3d\x21\x09\x73\xa8\xb9\xf4\x17\xbd\x58\x4a\x91\xef\xb1\x9d\xdf\xf9\x7d\x91\xf7\x23\x3f\x3b\x3b\x51\x32\x84\x3e\x95\x14\xc0\x0e\x39\x64\x92\xe2\x17\x45\x3e\x06\x36\x4b\x55\x26\x4c\xa1\x5e\xc0\x82\x60\x85\x13\x21\x59\x80\x28\x3a\x85\x5e\x72\x2d\x00\xe2\x95\x2f\xbb\x75\x05\xbd\x50\x82\xcf\x77\x15\xdc\x20\xf5\xa7\x1a\x4b\x44\xc4\x55\xcb\xee\xcd\xa4\x67\xf8\x39\xa0\x02\x0e\xa9\xc0\xf7\x0d\x63\x7e\x4b\xef\x9e\x46\x41\xfd\x1e\x4e\x5f\xee\x25\xe8\x69\x26\x02\x6a\xc8\xd4\x7b\x2c\xf8\xff\x2d\x18\xfe\x33\xd4\x8a\xf1\x62\xa2\x62\x48\x7d\x05\x1f\x07\xda\x8f\x20\xc9\x9f\xfd\xb5\xd5\x57\x5a\x2f\x85\xd8\xe0\x5a\x00\x26\x80\xfe\xdd\xd5\x05\x9e\x20\xb9\x38\x4e\x29\x8e\xa3\xb3\x1f\x85\x6e\x17\x6f\x8c\xc3\x0c\xfe\xfa\x17\x6c\x54\x6e\xe9\xfe\x61\x5f\x1e\x51\xe0\xb9\x89\xba\x39\xc4\xbf\x76\x7c\xe7\xaa\x84\xfa\x1e\xf3\x91\xb0\x7f\x4e\xb3\xcc\x4f\x3f\x0a\x68\x3a\x31\xfe\xee\xb4\xbf\xdd\x51\xfe\xb3\xd3\x4a\x46\x91\x18\xee\x9a\x57\xfd\x62\x2c\x65\x63\x72\x1e\xa2\x49\x16\x5f\xaf\x57\x36\x89\xb9\x6d\x3a\x7a\x33\x7e\xdc\xd0\x68\x22\xca\x5d\x57\x9a\x8c\xd8\xb0\xf3\x18\x55\xe3\x6e\x73\x7d\x65\x00\x22\x3e\x4c\x5a\xdb\xc4\x66\x90\x8e\xea\xdd\xe8\xe1\x3f\xb1\x3b\x6a\x0e\xae\x6d\xa1\xf6\x43\x91\xec\x40\x13\x7e\x40\x91\xa8\xdc\x47\xbd\xae\x99\x8e\x11\x37\xc4\x39\x3b\x09\x96\x58\x87\xa6\x01\x1c\x22\x51\xa3\xc9\xf8\x31\xd7\xd4\x96\x88\xa3\x29\x45\x00\xa7\x65\x4c\x2e\xe3\xf0\x6f\xdb\xb2\xa8\xec\x94\x4d\xb8\xb9\x11\x36\xbb\xfb\xbe\x5a\xb8\x1e\xe9\x8b\xf9\xa2\x94\x02\x57\x75\x4f\x86\xe6\xa1\x28\xe3\x65\xf3\xa2\xc7\x2b\x76\x01\x1c\x82\xfd\xee\xe3\x55\x7b\x02\x01\x34\xc9\xfa\x69\xbb\x11\x1f\xcf\xd4\xef\x79\x4a\x53\xf7\xd5\x4f\x74\xde\x54\x2b\x52\x3d\x2d\x6f\x4f\x3d\x94\x25\xbf\xe5\x34\x5b\xb5\x39\xb5\x7e\xbb\xf4\xe9\xa9\xe4\x86\x35\x60\x77\x73\xc4\x7b\x41\x11\x45\x0b\xb2\x6c\x79\xd6\xfd\x64\xa5\x62\xae\xb9\x28\xda\x50\x71\xfc\xf2\x4e\x98\x15\xba\x1b\xe4\x94\xce\xd4\x84\xe0\x23\xcf\x98\xed\xc4\xf4\x34\x8e\x34\x2f\xa8\x43\x79\xb1\x8d\x7a\xd7\x51\xa2\xc3\x85\x2c\x5b\x7f\x31\x99\x80\xc1\x12\x82\x30\xf3\x4b\xd0\xed\x8e\x22\x66\xa3\x58\x05\x56\x74\x7c\x20\x09\xff\x79\x8f\xae\xb4\xd1\xd0\x9b\xc3\xa9\x84\x5e\xcd\x8a\x84\xd7\x08\xb2\xe9\x29\x41\x00\x88\xf2\xc7\x64\xf9\xc2\x3b\xe6\x6e\xfc\xbf\x53\x12\x8f\x0c\x7d\x31\xd9\x38\xc5\x3d\x63\xf0\x5e\x48\x37\x43\x1e\x43\xd1\xf2\x36\x9b\xf4\xc9\x91\x7d\x0b\xd5\xf0\x5e\x46\x77\x21\x4c\x6d\x65\xf6\x87\x89\xba\xba\x8d\xa3\x43\xad\xbe\x75\xfd\xf1\x91\xc6\x6d\xed\x71\xc6\x9f\x16\x73\xe1\x46\x30\x49\x51\x14\x30\x6f\x24\xcb\x8d\x3d\x3b\x5a\x2f\x96\x44\x48\x3d\x25\x87\xdd\xdb\xf8\xda\xbd\x68\xde\xef\x22\x47\xbe\xf6\x47\xef\x88\xd7\xde\x6a\xd4\xda\xfb\x89\x38\x36\x29\x3c\xf4\x3a\xbd\x15\xc1\x80\x0d\x9f\x2f\xb1\x17\x3f\x46\xd3\x70\xab\xca\x0f\xe3\xfd\x8a\xa8\xd2\x23\xfe\xdb\x2b\xd1\xd8\x81\xdc\x36\x49\x04\xfb\xed\xd1\x19\x43\xda\x08\x5a\xa3\xe7\x91\x11\xb5\x6d\x00\x6b\x7e\x14\xf5\x9d\xee\x44\xdc\x9a\x41\x0b\x01\x2a\x32\x63\x5e\x07\xc7\xbc\x6f\x0d\x86\xcb\x1a\x3b\x00\xdb\x70\x38\x77\xba\x0a\x60\x60\xc0\xf3\x47\x9f\x1f\xa0\xb5\x65\xf3\x5b\x97\x01\x29\x97\xd3\xd4\x36\xbe\x81\xce\x1e\xe6\x73\x8d\xc5\x4f\x0a\x10\x58\x9a\x89\x34\x0c\x67\x6f\x8b\x19\x66\x9c\xc5\x4d\xa9\x31\x78\x62\xb2\xc3\x26\x96\xd1\xb4\xcd\x9c\x60\x3a\x3b\xff\x77\xdd\xb6\xc9\xc5\x9b\x5f\x62\x5d\xce\x58\x1e\x0e\x15\x6b\x4f\x63\x3a\x3f\x78\x41\xd3\x90\xc6\xa4\x1b\x42\xf9\x25\x7d\x12\x01\x05\x8d\xbb\x99\xe2\xde\x48\xbb\x45\x03\x1e\xc0\x97\xc8\xbd\xea\x56\xc6\xc2\x6c\xa9\x94\x9c\xb2\xf6\xbc\xad\x19\x2d\xc2\x36\x7b\x93\x6d\x99\xcc\xcb\x22\x70\xad\xdd\xd1\x19\x06\xcf\xf0\xdc\x82\x15\xc7\x3d\xf6\x63\xfb\x00\x0e\xb0\xdc\x83\x9e\xff\x33\x3f\xe2\x1a\x8b\x1f\x5e\x06\x2d\x79\x38\x16\x81\x3e\x5b\x50\xf9\xfd\x43\x55\x4b\x35\x19\x47\x47\xe1\x33\x50\xb3\x71\x0c\x40\x6a\xd3\xd2\xb2\xe7\x43\x13\xa0\x76\x35\xd4\xd8\x7f\x05\xc0\x4c\xc3\x3e\x62\x24\x34\x12\x35\x9d\x9c\xe6\xe6\xa8\x5c\xd6\xe5\x32\x5d\xe0\x59\xad\x84\x49\x40\xb4\xce\x32\x0c\x4c\x10\xab\xa1\x3b\xcf\xd4\x0a\x43\x0a\xb4\x82\x52\x82\xe6\x8e\x5b\x2b\x4a\x89\xef\xd4\xcd\x10\xde\x1e\xf2\x5d\x2c\xbc\x71\x27\x84\xba\xad\xf9\xda\x89\x67\x6e\x1e\xd1\xa9\x4e\x0d\x9f\x35\xe9\x44\xc9\x27\xbf\x3e\xbc\x25\x26\x80\xb7\x43\xbc\xfd\x77\x9b\x6e\xdd\xa9\x1c\x2c\x42\x51\xda\xfd\xd8\x18\x19\x59\x22\x29\xed\x72\x3e\x55\x0b\x58\x1a\xb6\xe3\xb5\x32\x07\xb1\xc3\xfb\x34\xee\x8c\x0d\xa3\xba\x1c\x90\xa5\x3e\x15\xaa\x55\xc1\x19\xc4\xb8\x22\xc4\xcd\x8a\x25\x8f\x14\x3b\x79\x25\x14\x38\xeb\x44\xe1\xda\x9d\xd1\x13\x67\xf9\xe2\x23\x0f\xe0\x6b\x6b\x88\xf7\xcf\x99\x54\x84\x21\x00\x73\x72\x70\xb1\x61\x05\x00\xfd\x98\xbc\x2c\xbb\xec\xeb\xdc\x26\x26\x6e\x4b\x7d\x57\x3f\x11\xd9\xb4\xc4\x39\x87\x17\x42\xee\x97\xb1\xd0\x6a\x15\xe8\x0b\x25\xa8\xe7\x33\x2d\xd6\x5c\x3b\xd5\xf4\x8e\x10\x55\x9f\xb9\x55\x66\x4b\x99\xc7\xbc\xb6\x8f\xcf\x4d\xe8\x86\x4a\xc6\xfe\xe5\x69\xb7\xe9\x1a\xcd\xfd\xad\x66\x02\xe7\x0f\xed\xb3\x0b\xdc\x1d\xea\xe9\x8f\x6b\xf2\xc5\x43\x1f\x97\x4e\xe4\x57\xeb\x0e\x09\x42\x62\xa3\x85\x8f\xaf\xb8\x5c\x75\x0e\x37\xd9\xae\xdb\x6b\x2f\x8b\xef\xc8\x9f\xc5\x8e\x86\x89\x47\x15\xe0\x4f\x75\x69\xeb\x6d\x89\xf9\x4e\x48\xe2\x2c\xe6\xab\x31\x6f\x97\x4e\x04\x7f\x5f\x6e\xb6\x5d\xe3\xd0\xfc\xa9\xde\xda\x79\x74\xf3\x68\xfd\xa3\xb2\x37\xdb\x3f\xdb\xaf\x7b\xe0\x6f\xbe\x8b\x7b\x0e\x82\x73\x8d\xce\x88\x06\x64\x71\x5e\xa5\x79\x23\xf6\xa0\x8f\x6d\x89\x9d\xc8\x1d\x25\xd7\xaf\xb6\x04\xf8\x74\x24\xe3\x6c\x0b\x58\x92\x50\x2e\xf9\xdd\x06\xba\x1c\x6e\xfe\xbe\x6e\xaf\xbe\x0c\x9d\x5f\xef\x5e\x12\xe1\x8c\xe3\x9c\x51\xec\x4b\x3e\x5c\xa8\xfa\x2a\x10\x6a\x1e\x41\xbc\x97\xd9\x11\xbf\xcc\x3d\x0d\xbe\x9a\x12\x6c\x29\xd7\xe2\xdc\xf8\x46\x70\x7f\x47\xb0\x71\x3e\xfb\x90\xd5\xbb\xca\x06\xfd\x0e\x46\xe4\xa0\xa9\xb3\x40\x43\x5b\x2b\xca\x4d\x19\xfa\x75\x65\xba\xe8\xf1\x3b\x7a\xee\xde\x8e\xca\x32\x57\xb8\x93\xb8\x9c\x49\x1e\x61\x75\x7b\x27\x91\x43\xee\x01\x6d\xf8\x65\xb7\xa9\x15\xe2\x1f\x46\x29\x52\xc4\x4e\x2a\xd8\xf3\x15\xf8\xb7\xda\x58\x81\xd9\x43\x3c\x94\x6b\x33\x05\x14\x6e\xf3\xdc\x63\xeb\xe3\xa0\x0f\x18\x14\xcc\xaa\xdc\xac\xf0\x50\x0f\x0b\x1f\x1c\x00\x4c\x00\xff\xc1\x13\x90\x98\x3c\x3a\x0c\x0c\x79\x79\x80\x97\xf1\x2e\x06\xbb\xed\x0e\x79\xb8\x58\x82\x6f\x7c\x45\xdc\x27\x40\xaf\xed\xab\xa9\x04\x67\x39\x6f\x0c\x3e\x79\x25\xd6\x8a\xc4\xb8\x84\x24\x7b\x06\xed\xf2\x49\x9d\x8a\xc2\x65\x4b\xe1\xe0\xda\x77\x77\x48\x88\xc2\x5a\x5a\x74\x06\xab\xc5\x54\x28\x6f\xe4\xf7\x14\x62\x00\x6f\x49\xd9\xa9\x96\x77\x71\xde\x17\x25\x6a\xb5\x49\x89\xcc\x66\xd0\x77\x32\x86\x0b\x3a\xc4\x4b\x13\xf0\x64\x65\x0c\x01\xaf\xd2\xff\x1a\xa8\xb1\xbf\x65\x11\x37\xf3\x32\x41\xb7\xe2\x7a\x14\xe5\x81\x01\xa4\x68\xf1\xeb\x79\x50\x4a\x11\x14\x44\xa1\x40\x4d\x00\x62\xc0\xaa\x6e\x25\xd3\x49\xb6\xba\xb6\x43\xe5\xfa\xf3\xbc\x78\x84\x7f\x15\xc0\x9e\xfc\x89\xe3\x5c\x17\x58\x60\xd2\x88\x7a\xa8\x54\xcf\x06\xf8\x2a\x7a\xef\x1b\xe6\x55\x7c\x90\xbe\xd8\x2b\x10\xb9\x17\xce\xc5\x0b\xe3\x2b\x96\xee\x8c\x7f\x65\x91\x70\x59\x99\xef\x2b\xcd\x2b\x25\x0e\x60\xd5\xef\xa0\x1d\x1c\x44\xca\xe5\xcb\x9d\x9b\x5d\x69\x05\x8c\x14\x68\x93\x2f\x50\x21\x91\x2e\x1d\x3e\x05\x7a\x12\x5c\xf8\x9d\xa6\x8c\xd8\x6b\xad\x14\x97\xe8\x81\x7f\x97\xac\x4c\x05\x53\x7c\xca\x6c\xb1\x92\xa5\x3a\x56\xcf\x5b\x0e\x63\x6c\xae\x65\xba\xfb\xf5\xad\x64\x4b\xef\xc8\x2a\x7d\x40\x80\x8b\x3f\x80\xe8\x0f\xc5\x42\xe6\x6b\xc0\x06\x79\x1a\x66\xd3\xa6\x1e\x91\x78\xa7\xfa\x14\xa5\x8b\x1d\x8e\x71\x9f\x62\x9f\x50\x01\xa9\x29\xb1\xc4\xb9\xa9\xd2\xf6\x86\xb2\xa2\xa7\x54\x2b\x11\xdf\x3c\x3b\xc1\xf1\x56\x78\x14\xc9\xa6\x55\x1d\xd8\x3b\xb0\x41\x5e\x31\x91\x17\xc9\xa3\x45\xab\xc7\xd0\x96\xab\xe3\xe0\x24\xd3\xb7\xd1\x31\x2d\x8e\xd3\x34\xf0\x84\x5e\x47\xe8\xc2\x57\x41\xd1\xf4\xcb\x9b\x83\xdf\x88\xca\xcc\x79\xcb\x9b\x9f\xe9\x10\x4c\xc0\x13\x27\xb3\xff\xbc\xc0\xbc\xb6\x49\xe9\x16\xeb\x91\x55\xd8\x80\x76\xd5\x67\x40\x4c\x73\xa3\x8c\xe3\x38\xf2\xc4\xeb\xd5\x43\x25\x07\x6f\x80\x7d\x90\xbd\xcc\xb2\x6d\xa5\xa4\x05\x96\x2d\x56\x8f\xcc\x72\x4b\xf8\x92\x69\x53\xf4\x51\x3d\xd6\xa1\xd2\xf1\x75\x7d\x03\x52\x3d\x6c\x7a\xe6\xe4\x5c\x3a\xde\xc5\xa4\x6c\xb2\x5c\x2d\x51\x7f\x91\x40\x04\x6c\x12\xb8\xa5\x1e\x30\xff\x26\x1e\xd7\xa4\x01\xa0\xc7\x07\x75\x86\x92\x23\x2c\xa5\x38\xf9\xaf\xf5\xe2\x67\x88\x05\xba\x11\x67\xa0\x07\x68\x21\x4e\x6f\x9e\xfc\x1a\x30\x2b\x62\x5a\x9e\xbc\x2e\xce\xa8\x4c\xaf\x0a\x37\x15\x15\x3e\x51\x30\xd0\x4b\xd6\x73\x83\x5e\x78\x38\xf6\x98\x4e\x4b\xbf\xeb\xb5\xcf\x5f\x4e\x53\x82\x58\xab\x54\x06\xf7\x48\x0d\x5a\x03\x70\xf5\xa5\x77\x22\x7f\x74\x64\x8d\x7b\xfe\xf2\x78\x42\x68\xf1\x3e\x7b\x38\xff\x8e\xc4\xda\xd6\xfb\x1c\x88\x94\x7f\x69\xe5\xbb\xa3\xc4\x7a\x77\x67\x25\x69\x01\x0a\x8c\x74\xa8\x4e\x71\x31\xaa\xbd\x39\x89\xbe\x36\x30\x69\x16\xa1\xb2\x31\x58\x1a\x10\x5f\xa2\x33\x61\x66\x84\x7d\x49\xd9\x41\x26\xc9\x89\x27\xa5\x2b\x41\x8a\x3b\x60\x2b\x92\x6c\x41\x39\x9b\xfa\x44\x8b\xba\x2e\x09\x70\x9f\x09\x4e\x89\xd8\x40\x7f\xd0\xdb\x54\x5a\xef\x5f\x7b\x93\x35\xf7\xa4\x10\xbb\x32\x90\x70\xa2\xe9\xcd\x69\x7a\x6f\xb7\xa2\x9e\x07\x8f\x41\x9d\xa5\x5f\x64\xeb\x84\xf8\x59\xf5\x37\x0c\x2a\x47\xea\x2f\x82\x45\x9c\x19\x7a\x18\x1e\x8d\xd0\xa2\x19\x92\x18\x3e\x3b\x9a\xd9\x83\x53\xc1\x3a\x88\x49\x2b\x89\xf3\x34\x8d\xd8\x6b\xaa\xd0\x01\x48\x15\x06\x80\x87\xbf\xa6\xa9\x2a\x5a\xa6\x89\xef\x7c\x92\x21\x9e\x52\xea\x2f\xa1\x60\x83\xe8\x1c\x4d\x65\xaf\x9d\x9e\x4b\xab\x95\x6a\x35\x49\x74\x19\x4c\xa1\xd0\x68\xa5\x51\x23\x73\x09\xa0\x0c\xd3\x1e\xeb\x0c\xeb\x1e\x4c\xaa\xb0\x2f\x53\xd9\x64\xb8\xec\x66\xa7\xf2\x0c\xd7\x57\x17\x66\x3e\xf3\xe3\x8a\x37\x35\xde\x7d\x8b\xb3\xef\xfe\xe0\xb5\xcc\xf6\xc4\x06\xeb\x46\xc3\x45\xc5\xa6\x6b\xfb\xf9\x80\x26\xa0\x25\x4b\xe2\xe9\xff\x51\x9c\x12\x8b\x9f\x2b\x88\xcf\xa6\x8e\xcb\x2d\x6a\x9f\x2f\x4c\x1e\x2d\xff\x22\xc8\x3b\x68\xc6\x35\x04\x1e\x97\x85\x53\xc5\x83\x8c\x4b\x9b\x56\x20\xa8\x29\x8c\x88\xc2\x8d\x1b\x31\xb5\x8c\x8e\xc7\x37\x46\xe3\x48\x6a\x8f\x79\x42\x88\x05\xa2\x18\x0b\xdd\xfe\x90\x9e\x2d\xd4\x93\x63\x66\xc2\x57\x61\x4d\x12\x77\xec\xea\x7a\xb5\xa7\x7a\xa6\x36\xfa\x86\x52\x4f\x25\xbf\x46\x56\x3b\x86\x0a\xd7\xd1\xbf\x94\x61\x9c\x95\xd0\x4b\x7a\xaa\xe8\xf4\x4a\x2a\x80\xfc\x1c\x28\xae\x94\xf3\x6d\x5b\xdb\x65\xdb\x12\xa7\xff\xe1\x32\x54\x89\x8c\x8b\xf0\x5e\xea\xb2\x08\x82\xc1\x86\x39\x46\x51\x50\x92\x1f\x8e\xda\xde\x4d\x0a\x19\xa5\xa3\xd4\x33\x38\xf9\x32\xae\xc5\x29\x86\xb1\xf6\x80\xa0\xbb\xe0\x04\x1d\x6d\x36\x6f\x37\xab\x47\x28\x2f\x56\x2d\x8f\x7c\x83\x55\x64\x7a\x5f\x4c\x84\xa4\x38\xf4\xd1\x63\x8a\x34\x48\xd5\xe3\x29\x57\x43\x4d\x5d\xf8\xee\x11\xf2\x4f\x76\xa3\xd0\x17\x94\x33\xec\x7f\x2d\x7b\x06\xf6\x23\x2d\x28\xde\xfc\x06\x4a\x2f\xbd\x97\xd9\x05\x34\x9d\xbc\x5e\x9e\x13\x9a\x05\xcc\x7d\x99\x23\xc2\x2a\x7d\xff\x03\xdc\x00\xfc\x69\xaa\xec\xc3\x62\x1e\x5a\xdf\x44\x9b\x1c\x5b\x99\x5d\x50\x18\x11\x38\x8d\x96\x71\x90\xd9\x09\xf9\x15\x94\xe4\x5a\xef\xd3\x6d\x8f\xb6\x60\xdc\xc9\x98\xef\xd6\xb5\xc3\x00\xf0\x91\xdf\x04\x0a\x3d\xee\xae\x87\x8d\x4d\xcc\x45\xa7\x7b\x13\xab\x7d\xa8\xb2\x0e\x74\x81\x18\xe1\xf8\x18\xfb\x5e\xf1\xb6\xf8\xe5\x35\xc0\x1c\xf1\xed\x51\xa9\x98\x4e\x05\xf9\x57\x91\x04\x6d\x3c\xbb\xa2\x6e\x01\xa1\xf5\x51\xc2\xb6\x5f\x1b\xeb\xce\x89\xb2\x94\x2e\xf3\x26\xb3\xbf\x2a\x0f\xb4\x40\xff\x83\x6a\x6e\x09\x5a\xcd\x71\x0e\x11\xda\xcc\x2a\xe8\x5a\x11\x70\xf6\x86\x10\x3d\x51\x9f\x71\x52\x1f\x96\x53\xf7\x5f\x78\xb7\x04\xde\x5f\x94\x62\x47\x95\x9d\xf7\xa1\xc4\xcc\x79\xb0\x9b\xa0\x5e\xc3\xaf\xe2\x87\xb3\xf7\x01\xda\x29\x2d\x23\x90\xfc\x09\x4a\x4d\x90\x83\xa0\x08\x35\xac\x82\xca\xc2\xda\x1d\xfb\x78\x79\xae\x06\xe2\x78\xb7\xf8\x5c\x51\xdd\x1d\xb8\x30\x7f\x75\x75\xe4\x24\xfe\xc8\xa0\x5d\x18\x5a\x3c\x1f\x8b\x8c\xa7\x2d\x54\x6d\xb4\x8c\xe9\x6a\x1c\xac\x7b\xf6\xf3\x9b\x87\x2a\xfb\xd4\x29\x62\x8c\x63\x75\x1f\xf3\xe3\x74\x1a\x40\xc5\x1c\x57\xec\x92\xa5\xcf\xed\xc2\xa7\xe5\xe3\x92\x70\x80\x3e\x7b\x5b\xec\x38\x09\x62\x65\x01\x6e\x46\x33\x23\xc9\xf1\xf5\xc4\x74\x95\xe1\x53\xdd\x93\xe1\x76\x0e\x01\xab\x70\xcb\x04\xd5\x6d\x09\xdd\x81\x8c\xca\x1c\x7b\x17\xbe\x98\xe3\x93\xdc\x15\x7e\xc0\x12\xb0\x8a\xb6\x0a\x09\xa1\xfa\x9e\x2e\x89\xde\x1d\xa9\x80\x5a\x40\x9c\xe9\x0d\x21\x08\x45\xb8\xc6\xc1\xe2\xb8\x07\xdf\xef\xb4\xea\x62\x86\x88\x10\xee\x93\xda\x99\xfe\xc8\xfb\x16\xaa\xf9\x4b\xf9\x9e\xcc\xde\xb5\x93\x63\xbe\x46\x71\x17\x4e\xa6\x0c\x78\xce\xea\x0e\xd2\x24\x37\x26\x34\xa0\x1c\xf3\x46\x0a\x52\xcf\x7e\xce\x0f\x8a\xcd\xde\x90\xab\xc0\x8a\xc7\x62\xa8\xf7\x04\xa9\xc4\x0a\x04\x91\xdf\x4f\x0d\x45\xb5\xbe\xc6\x84\x4e\x52\x37\xba\xdd\xc6\x67\xcc\xfb\x3d\x4e\xf0\x94\x39\xbe\xbb\x8a\x98\x65\xd6\xe9\x3e\xff\x0f\xf0\xbc\x5d\xb4\xc5\x8b\x3a\xdc\xd5\xdc\x82\x89\xf0\x93\xca\x42\xc2\x77\x8a\x06\x02\x42\x49\x7d\x57\x51\xf5\xc4\x18\xb0\xe2\x52\x7d\xaa\xaf\x48\xc9\xdf\x7f\x58\xc6\x87\xe5\x7f\x07\x38\x35\xe3\xfe\x12\x0b\x08\x97\x7b\xe8\xa8\x5c\xee\x7c\x04\x28\x7b\x7b\x17\x22\x63\x01\x52\x6f\x97\x94\x4e\xb6\x9c\x6b\x6b\x1c\x6a\xfc\x2a\x4f\x40\x47\x2f\x74\xc2\xcf\xf5\x85\x98\x7b\x68\x40\x40\xdb\x06\x1a\x29\x6a\xaa\xa0\x44\x6c\x5f\xe2\x19\xba\xfe\xfc\x63\x54\x7c\x05\x0a\x3b\x2e\x3b\xff\xed\x75\x29\x97\xdc\xc2\x72\x13\x85\x39\x52\x5c\x35\x5c\x2a\xef\x54\x83\x75\xb5\x1e\xde\x7b\x26\x12\x90\xe2\x6e\xa2\x22\x63\x2a\x28\x62\xed\x27\x3c\x7d\xfb\xcf\x54\xc7\xad\xc9\x39\x43\x91\x31\xbb\x86\x00\xb9\xc3\x1d\xbb\x5d\x93\x60\x89\x2f\xde\xf0\x43\x01\x8c\xcc\x69\xd1\x8a\x8a\x68\x8f\xaf\x2a\x03\x19\xef\xb7\x7d\x37\x00\xd8\x97\x65\xc9\x9f\x95\xf2\x77\xc7\xa9\xf8\xdf\xb3\xa9\xd7\xdd\xfb\x2c\x67\xba\x23\xad\x1c\x22\xb5\x38\x0c\xe8\x65\xd4\xbd\x5a\xcb\x97\x7f\xce\x0a\x14\x16\x40\x4c\xe7\x6b\xb0\xb9\xad\xb7\xd6\xc4\xfd\x63\x07\x9b\x5c\x0d\x65\x23\x57\xfd\xd0\x8d\x3b\x63\x13\x40\x26\x52\xa2\x92\x32\x15\xf5\x6f\xa3\x28\x51\x0c\xc9\x63\x27\x93\x6a\x90\x3d\xf1\x0c\x59\xca\x55\x1d\x2e\xa2\xc7\xf4\xf1\x33\xc0\x42\x34\x0f\x78\xbe\xe5\xde\xff\x68\x27\x66\xfb\x7b\x5b\x62\x76\xf7\x8a\x95\x4e\x70\xe5\x72\x22\x76\xfe\x49\x1b\x92\xb5\xf6\xfc\x92\xbe\x86\x90\x64\x24\x84\x2f\xd2\x39\x9d\xc2\x3b\x8f\xc3\x24\x76\xd9\x37\x02\xfe\xed\x15\x5e\xf0\xc4\x4c\x38\x12\xc6\xfe\x7b\x55\xe6\xac\xaf\x5b\x43\xc7\xc0\xcc\x97\x7f\x9c\x3b\xc1\x54\x24\xf6\x39\x6c\x39\xc7\x3c\x34\x56\xde\xb2\x95\x15\x5d\xf2\xd8\x29\x2f\x21\x96\x2c\xe0\xcb\xe4\x46\x37\x4e\x3e\x82\x41\x7b\x13\xc7\xa8\xad\x22\x66\x3d\x23\x32\x75\xa9\x30\x6b\xb1\x74\x1b\x89\x73\x42\x9d\xe6\xdb\x41\x9a\x8a\x60\x71\x93\x5b\xcb\x85\xce\xee\xd0\x75\xa3\xa8\x31\xd1\x80\x9b\x3d\xb7\xfd\xdc\xbc\x72\xf2\x8e\xd7\xa7\x00\x87\x3f\x58\x01\x07\xbd\xb8\x2a\x5c\x0c\x78\x81\xa6\x35\x18\x9d\x25\x23\x9a\x32\x40\x13\xc6\x1d\x4a\x97\xb3\x4c\x1c\x7b\x92\xd6\x43\x9c\x95\x39\xcf\x89\xb6\xe5\x9c\x6d\x07\xad\xd0\x4d\xcc\x74\x8d\x4d\x88\xf6\xca\x88\xdb\x1b\xec\x1c\x75\x3c\x5b\x56\x4f\xe7\x8c\xaf\x41\x84\x7e\xd0\xfb\xf3\x3c\xea\xda\x5b\x70\x81\x79\x67\x30\x2f\xf3\x1d\x6e\x93\x2b\xaf\x52\xd3\x95\x38\x49\xed\x73\xe7\xff\xb1\xd6\x10\xc3\x4f\x5b\xe9\x9f\xc3\xe2\x12\xb6\xb0\xfe\xad\x7a\x0d\xed\xf3\x9f\x86\x91\x8c\xec\x07\xb7\x6f\xf2\xb1\x19\xe3\xd1\x1b\xb2\xe7\x01\x21\x7a\x9c\x2c\x61\x1d\x66\xbc\xb2\xff\x04\x7c\xf6\x4d\xbe\x3f\x2a\xff\x97\xdc\x6d\x21\x1b\x07\x9f\x2f\xfe\xad\xb7\x25\xc7\xe3\x93\xe4\x70\x22\xfc\xad\xaa\xe9\xa5\x63\xea\xbc\xdc\x55\x5f\x8d\x33\x50\xbd\xe8\x7d\xb9\x1f\xb7\xa3\x7a\x6e\x3c\x36\x36\xa6\x05\x46\xe6\xd2\x00\x5a\xe3\x5e\xc1\xd1\x50\x6a\x80\x11\x34\x6a\xa9\xa2\xc8\x81\x71\xf1\xad\x6c\xf7\x0d\xb4\x0e\xd7\xca\x6b\x15\xef\x77\xb8\xdb\xf1\x7e\xd2\x1e\x56\x61\x60\x4e\x17\x1b\x69\x48\xd0\x9a\x89\xb9\xb4\xd2\x00\xa7\x2a\xe6\x10\xda\xd0\x00\xd2\x4c\xd0\xfa\x30\x1b\x84\xa4\x99\xa1\x8b\xd2\x10\xfa\x39\x05\xd4\x77\x04\xd4\xd3\xf1\xa6\x19\x8c\xb6\x01\xd7\x54\x9c\xb7\xc4\x71\x32\xc4\x55\x67\x18\x0a\x53\x89\x6b\x88\xc9\xf6\x81\x99\x03\x2c\xad\xad\x77\x1c\x88\x2d\x71\x13\x30\xf1\x9b\x77\xef\xff\xe4\xca\x95\x0c\x93\x01\xf8\x6e\xa9\x04\x7b\xd2\xd7\x9d\x9d\x0e\x1c\x6b\xfd\x1d\xb0\xde\xa5\xec\xf0\x6a\x09\x23\x12\x7a\x03\x89\x06\x86\x8c\x9b\x05\x76\xce\x11\x93\xbf\x51\xee\xc8\x48\xcc\xf2\xab\xd0\x60\x63\x5a\xd0\x0b\x43\xa0\x73\x5c\x6d\x02\x01\xd5\x7d\x12\x9a\x57\xbf\x01\x07\x9e\x35\x2a\xbb\x42\x3f\x26\x42\x63\x63\xfa\xec\x7b\x98\x02\x7c\x3d\xae\xb5\x50\x08\xad\x98\x3c\x0c\x51\x61\xdf\xdf\x28\xc8\xfe\xcc\xed\x48\xf0\xd8\x7f\x7a\x17\x22\xb0\x11\xf5\xab\xe5\x83\x88\x15\x53\xb2\xb6\xa6\x11\xd9\x85\xc5\xa9\x30\xdc\x73\x80\x62\x07\xeb\x2b\x89\x5d\x91\x5c\x0a\x70\xcc\xd4\x8f\xf9\x23\xb8\xe9\x4d\x05\x4d\xd5\x1a\xae\x88\xe3\xe5\x9e\x51\x80\x4c\xc6\xad\xe4\x8a\x66\xde\x44\xfc\x83\xf9\x26\xd9\xb9\xd3\xe6\x09\x83\xc8\xde\x62\xf0\xf9\x08\x23\x22\x30\x7e\xce\x3b\x67\xb7\x9f\xe9\x27\x67\xee\x9c\xcb\xf3\xc9\x5b\x3d\xd9\x2e\x35\x27\xb4\xf7\xf7\x8f\xeb\x99\xa6\x51\xe7\xe9\xba\x2d\xb1\x88\x62\x12\xd2\x4d\x47\x1b\x74\x58\x05\x67\x14\x5f\xe7\x6a\x69\x04\xb2\x3d\x13\x02\xf8\x62\x96\xf0\x9e\x23\x7d\x9d\xd8\x67\xab\x45\x4b\xa2\xda\xa3\xe8\x75\xaf\xa5\x98\xb2\x5f\x0f\x6f\xdf\xdd\x17\x42\xe2\xd9\x85\x95\x8d\xfc\x32\x20\xcc\x23\x8f\xe1\x46\x1a\xb5\xa1\x3c\x2a\x47\xe8\x1d\x87\x69\xc0\x06\xd6\x66\xc4\x8f\xee\x7c\x9b\xef\x93\x41\x1d\xb0\x1f\x37\x8a\x63\x3d\xc5\x72\xcd\x35\xa5\xe8\xd7\x4c\x28\x96\x98\x2d\x05\x6c\x9d\x24\x0f\x37\x0c\x28\xed\x91\xed\x01\x81\x65\x39\x58\xb1\x96\xf4\x28\x40\xb9\xe3\xe7\x0c\xe4\x86\x26\xde\xd8\x5c\xe4\xa6\xda\xc5\xaf\x03\xf9\x48\x72\x29\xb3\x9e\x86\xd2\x4d\x57\x19\xe7\xa2\x72\x29\xec\x3b\xaf\x2f\x82\xc1\xfd\x53\xf9\x60\xff\xd7\xb5\x64\xa8\xc1\x87\xe3\xbd\xb9\xbd\x59\xbe\x22\xee\x7c\x31\x57\xf9\xb2\x12\x55\xd0\xe7\xb6\xb5\x09\x53\xd2\x32\x05\xd4\xdf\xb2\xdc\x79\x66\x13\x4d\x79\xd5\xa9\x58\x3a\xd9\xef\x64\xae\x08\x6b\xdb\x0c\x92\x45\xee\x27\x0b\x0d\xc3\x0e\xed\xc8\xc0\x3e\x39\x85\x32\x6f\xe6\xcc\xb5\x09\xfd\xa8\x53\x0a\x17\xb6\xaa\x5d\xe7\xda\x99\xd8\xc1\x24\xbb\x3e\xb5\x06\xd2\xec\xe0\xc6\x6a\xdf\x7d\x1b\xed\x87\x97\xf0\x7e\x27\x76\x4d\x27\x25\xee\xf3\x35\x75\xd1\x58\xd7\x38\x91\xbc\xa3\xf7\x8c\x54\x89\xc1\xd7\x03\x3d\x41\x69\x62\x04\xb1\xfe\x9c\x75\x05\x10\xb0\x7f\xb0\x11\x2a\x2a\x4f\xcd\xe1\x1c\x86\xe4\xd5\x7f\x6d\xcb\xcf\x0d\xc8\x19\x3a\xcb\x14\x72\x7e\xfe\x3c\x59\x95\x41\xa1\x52\x9d\x09\x6d\x12\xf0\xbf\x9f\xf6\x18\x49\xec\x3a\x9e\x00\xc6\x7b\x0e\x38\xb6\xbf\x22\xd8\x77\xe9\x0a\xd5\xf6\x61\xcd\x01\x2f\x33\x61\xca\x2c\x4e\x4c\xee\x3f\x12\xc8\x4b\xf2\x5a\x14\x96\xb6\xb6\x93\x13\xc0\x8b\xf6\x18\xc3\x8f\x9d\xe5\x9a\xfa\xb9\x2e\x90\xc7\x9c\xa8\xb7\x37\x8e\x8b\x9b\xa4\xe7\x3b\xac\xb2\x10\x5e\x41\xe0\x23\x27\x24\xe5\x83\xf8\x7e\xcc\x46\x9f\x68\x9d\x64\xd4\x67\x41\xe7\x19\x84\x71\x22\xeb\x5c\x3b\x63\x7a\xde\x0a\xd8\xbe\xcd\xf2\x76\x84\xd5\x56\x17\xc2\x5d\x44\x85\x5e\xb1\x4a\x72\xcb\x6a\xc8\xea\x21\xef\x33\xb7\xca\xfb\x90\x7d\x32\x3c\x48\x85\x82\xaa\x3f\x42\x92\xa1\x80\x8e\xd0\x2b\x96\xc8\xb4\x06\xba\x1c\x50\x55\x21\xdc\xe5\xbf\xc0\x28\x73\x0d\x8d\x7b\xb7\xe7\x11\xc9\xac\xea\xed\x70\x78\x09\x20\xc9\x40\xef\x3c\x3d\x05\x25\xf7\x83\xf7\x8b\x4e\xcc\x30\xa0\xc2\xcd\x87\x6d\x9e\x05\x70\x30\xde\xae\x33\x3b\x5a\xf1\x5d\xcb\x5e\x39\xa9\x61\x86\xa5\xb5\xbe\x9a\xc4\x81\xa3\xb6\xbb\xe8\x17\x63\xc8\x0d\x77\x5e\xc6\x3f\xf0\x4a\x5d\x1b\xc7\x49\xde\xac\xed\x48\xca\x49\x22\x20\x30\x8e\x39\x3f\xac\x9d\x5b\xe3\xda\xd7\xb9\x79\x37\x34\xf9\x74\xfe\x4e\xac\x6c\x2b\x27\xe9\xc6\x27\xfa\x98\x5d\x59\x9b\xf5\x0c\xff\x98\x70\x40\x99\xf1\xc8\xa7\x1e\x1c\xc4\xce\x26\xcb\xad\xaf\x21\xa5\x87\x28\xd8\x7e\xaa\xe7\x24\x8b\xa5\xec\x24\xa4\x41\x27\xfa\x31\x4e\x72\x69\x3b\xdb\x78\x15\xaf\x49\x8c\xbd\xdc\x12\x7d\xd6\xbb\xb4\x46\xa2\x80\x7c\xc1\x1e\x67\x32\xa6\x76\xba\x38\x5a\x3f\x1d\x57\x67\x9d\x9b\x6c\xa3\x09\xb0\x80\x84\xd6\x46\x42\x89\xf2\xd1\x57\xc0\xd3\x2e\xb6\x1f\x10\x7d\x29\xf6\xdb\x94\x57\x6a\xec\xc9\x6c\xd8\x4a\x2a\xb9\x82\xfe\xdf\x0e\x41\xed\x6f\xed\x16\x35\x25\x7d\xe8\xdf\x1b\xe7\xbf\xa6\x17\x6f\x72\xbd\x5e\x7a\xb3\x4e\xc5\x07\x60\x98\x8d\xac\xbb\x0b\xe3\x68\xce\x69\x4f\x8d\x8d\x25\x19\x1e\x7d\x65\xe3\xc5\x1b\x77\x4d\xfa\xb7\xff\x90\xca\x99\xd3\x7e\xf1\x86\xa9\xea\xc0\xd1\xeb\x10\xc4\x32\xc0\x05\x35\x69\x3b\x21\x56\xff\x85\xa7\xaf\x52\x6f\x56\x9c\xcb\xe3\xd8\x9b\x54\x7f\xd8\x5d\xfc\x9a\xf4\x2f\x90\x69\x48\x60\x80\xaf\x8d\xf0\x03\x41\x26\x93\xb0\x82\x1e\x29\x4d\x4d\xb3\x1e\x75\x95\x57\xcb\x4d\xfc\x0d\x71\xce\x52\xe6\x27\x85\x5a\x63\x17\xc1\x59\x02\xda\x43\xbe\x86\xfc\xfe\x1e\xc9\x22\x41\x2f\x17\x7e\x59\xed\xfd\xd3\x99\xc4\xe7\xbc\xec\x91\xd5\x3a\xcf\xbb\x5d\x08\xb8\x96\x5d\x80\x49\x56\xe2\x6b\x24\x70\x6e\x90\xe3\x35\x43\x7b\x0c\x50\x9f\x93\x49\xe9\x07\x39\x89\x0c\x2c\x73\x17\xf3\x48\x4f\x04\xd0\x18\xa2\xc0\x23\xe0\x82\x70\x51\xab\x8e\xeb\xa4\x63\x7a\x0f\x0c\x36\xd7\x63\x35\x13\xbe\x77\x58\xbc\x1f\x85\x53\x06\x12\xc9\x07\xbd\xc6\x84\x7f\xcb\xcd\x44\xbe\x77\x65\x1f\x09\xb8\x18\x2b\x71\x71\x2a\xcd\xeb\x90\x50\x60\xcd\xec\xd5\xf2\xfb\x65\x48\x41\x52\x94\x84\xe2\xed\x4a\xe2\x1c\x85\xec\x51\x62\xa7\xd6\xdb\xa5\x24\x86\x50\x13\xc6\x44\xb3\x9b\xd0\x82\x91\xc2\xcb\xad\x62\x4c\x43\xc2\xc4\x06\x54\xd6\x34\xa2\x95\xe1\xd4\x54\x91\x8f\xe5\xa1\x0e\xc6\x2c\x4b\x72\x2e\xef\x5d\x6a\xc8\x78\x22\xb2\x50\xd2\x53\x9e\xb9\x36\xa8\xd6\x5c\xdf\x9c\xfe\x6e\xef\x58\x44\x77\x8a\xbd\xb6\x2d\xf2\x75\x58\xa9\x44\x83\x22\x94\xe3\x3d\x7e\xe4\x24\xf1\x8d\xa8\x87\xa8\xe9\x04\x51\x8c\xed\xd1\xbc\x92\xa7\xf0\x14\x8f\x95\x86\xe3\xcf\x16\x44\x4d\xf1\xa3\xb3\x41\x5a\x70\xd8\xd6\xb3\xdd\x5e\x0c\xdc\x60\x98\xeb\xfe\x05\x8f\xa3\x3f\x8c\x79\xad\x7f\xca\xa8\xe1\x44\xa0\xab\x57\x85\xf1\xca\xb4\xfb\x9a\x77\x57\xaa\x1d\xab\x1c\x61\xc0\xfb\x62\x45\x4f\xa0\x78\x88\x7d\x04\xdf\x84\x32\x54\x65\x84\x32\x28\xf6\x08\x8a\xb3\x4b\x71\x7c\xfb\xed\x0c\x79\x91\x28\xb3\x84\xa6\x2b\xb8\x3c\x6d\x73\xc5\x81\x7b\xee\x63\x00\x09\x47\xf2\x41\xa5\x95\xf1\x17\x01\x76\x73\x1e\x49\x6e\xfb\xcb\x0f\x4f\x45\x37\x3f\xfb\xe2\x45\x67\x0c\x83\x13\xf1\xe0\xea\x62\x19\x8e\x9e\x99\x88\x7a\x3d\x84\x03\x9b\x6a\xe1\x9b\x7a\xb1\xb1\x1c\xaa\x1a\x6b\x51\x0c\xbb\x3e\xee\xce\x63\xd3\x67\x14\xf6\xdc\xa4\x74\xab\x29\xa4\xfb\x9f\xda\xf6\xbf\xd8\xc2\x07\x1a\x8a\xcc\x7f\x59\x3a\xd2\xb7\x4f\x5f\xbc\x02\xd3\x62\x7a\xf1\xad\x51\xee\xda\xff\x72\xd7\xf6\x23\x13\xd4\x54\x19\xbe\x5d\xfb\xd4\xc3\x14\x36\xfe\x2e\x0e\x45\xbf\xc5\xaa\xf7\x27\xf0\x68\x47\xeb\x64\xf9\x94\x26\x45\x48\x14\x9d\x5d\x23\x75\xa4\x37\xa4\xeb\x81\xd9\x60\xa7\xe7\xc9\x26\x06\x2c\x8e\x5f\xbe\x3e\xa0\x82\x69\xd9\xe8\x08\x4a\x52\xfb\xb1\x36\xd2\x1b\x45\xdf\x64\xbe\xf6\x51\x19\x89\xd0\x0a\xf4\x2e\x7c\x99\xb0\xf8\x43\xf2\xac\x5f\x0c\xbd\x56\x79\xf9\x41\x47\x23\xe1\x61\xef\xd0\xea\xee\xab\x22\xb4\x4e\x41\xcc\x27\x39\x31\x4d\x23\x1b\xc8\xa0\xdf\xe8\x64\x46\x68\xd4\xca\x28\xd5\xc7\x09\x15\x6f\x00\x1a\x6a\xdd\x45\x54\xb0\x06\x2b\xe8\x1c\x83\xac\xb0\x36\x95\x27\x23\x73\xda\xa0\x27\x73\x6c\xf0\xf3\xc7\x1f\xc7\x6f\x9f\x6a\x40\xec\x1a\x9c\xeb\x26\xb2\x3f\x5a\x89\x04\x05\xb9\x40\x63\x83\x1a\x7b\xe0\xbe\x80\xe4\xed\x68\x9c\xd9\xf5\x9c\x66\xa7\xfa\x40\xbd\xcb\x81\x44\x89\xb2\x9a\x57\x31\xe5\x04\x4b\xbd\xff\x53\x60\x38\x83\x3a\x51\x51\xa5\xa5\x01\xae\x3e\x28\x38\xa6\x60\x50\x6d\x54\xf1\xf1\x60\x1b\xd9\xd6\xf7\xf7\x8c\x58\x99\x85\x84\x3a\xed\xf8\xb2\x52\x2f\xa9\x2b\xb7\x07\x32\x79\xde\x99\x59\xfc\xa0\x21\xc1\xfd\x3a\x82\xbe\xa5\x8d\x5b\x37\x72\x08\xd8\x04\x79\xa6\x9b\xad\x17\x19\x27\x24\x8e\x6f\xd9\xd2\x9a\x45\x8c\xd7\xdb\x2e\x29\x45\x5b\x5e\x99\x75\x58\x70\xde\x24\x85\x2a\xec\xd4\xcd\x42\xd8\x20\x56\x9a\x67\x52\xb8\x0e\x9f\x7e\xd6\xa0\x89\x4f\x1a\x4f\x1f\xfa\x71\xb8\xac\x20\xb0\xb2\xe3\xbc\x1a\xab\x40\x49\x6d\xdd\x44\x57\x2b\xc7\xe8\xc8\x9b\x46\x68\xb8\x68\x40\x9c\x7f\x97\x45\xa6\x39\x0f\xec\xf8\x62\x0e\x50\x7b\x26\xfd\x95\x82\xd7\x93\xfd\x82\x0f\x13\x3d\xf3\xce\x51\x5b\xf8\x52\xdc\xcb\x3e\x8a\x41\xf5\xd8\x32\x28\xcd\x84\x7b\x19\x3e\xd8\x67\x97\xef\x3e\xf8\x1b\xc6\x61\x02\xa0\xa0\xff\x70\x19\xd9\x90\x9f\x1b\xd6\xb1\x08\x07\xbd\xbd\x93\xe9\x48\x84\x67\x01\xca\xb0\xc6\xde\x12\xa1\x22\x50\xb8\xc4\xac\x5c\xbe\xa3\x30\x72\x39\xe8\x2d\x2c\x84\x1a\xde\xd4\x37\x6f\xdf\xd1\x61\x1d\x47\x9d\x79\x66\xfe\x9e\xfb\x87\x8c\xc6\x36\xb6\xbf\x98\xed\xd8\x07\x04\xff\x78\x6e\xbe\x7c\x44\x44\x6e\xf1\x15\x68\xe9\x42\x43\xc1\xc1\x76\x1c\x32\xc4\xa8\x4c\xde\x86\x2f\xe4\x79\x10\xf2\x9e\xcc\xc2\x49\x8a\x01\x31\xba\x62\x78\xbe\xdf\xd1\x1c\xa1\xb0\xd6\x3f\x1c\x93\x85\x9a\x21\x39\xca\xef\x7a\x98\x18\x8d\xcc\x47\x42\x31\x99\x6a\x5d\x46\x57\x59\x0c\x93\x6b\xc9\xa5\xe6\x0b\x45\x3b\x87\x6f\x2a\x46\x5c\xa5\x34\xf9\x04\xf9\x2a\xf0\x6e\x9b\x43\x65\x97\x85\xe1\x11\x48\x1d\xfb\xaa\xf3\x37\x1b\xc2\x00\x26\x6a\xf1\x52\xdf\xe8\xc7\xed\xb3\x84\xe2\x94\x95\x31\x24\xb9\xcf\x6c\x82\x86\xdb\xb8\x8e\x5e\x5d\x89\xd1\x15\x2a\x48\xac\xeb\xe8\x64\x5a\x13\xd8\x30\xec\xc6\xdd\xfb\x08\xa2\xe3\x11\xd1\xba\xda\xb8\xd0\xda\xe6\xc0\x4a\xb0\x77\xa0\xef\xed\xe9\xc0\x52\x54\x39\xf9\x2b\x2d\x49\xa8\x3d\x40\x52\x51\x2e\x98\xa8\xe9\x68\x7a\x0f\x56\x71\x82\xa8\xa7\x9b\xf8\xe9\xce\x00\xcf\xc8\xf0\x3a\x1a\x6d\x5b\x05\x70\x71\xcc\xa2\x67\x83\x89\x20\xf2\x94\x67\xd1\x9e\xfa\x2d\x7b\x82\xa7\x43\xc4\x1f\x3f\xe0\xa5\x86\x34\x36\x62\xc1\x6a\xd7\x09\x0f\xec\x67\x5e\xa6\xbf\x98\xd1\x4d\x22\x8a\x6a\xb9\x82\x9c\xda\x74\x12\xa0\xce\x3f\x26\xef\xff\x44\x7a\x8c\x99\x39\xfc\x78\x90\x81\xbc\x28\x2a\x64\xa9\x67\x16\xd1\x02\x19\xfb\x69\x85\xda\x81\x52\xde\x43\xe5\x40\x00\x80\x94\xea\x51\xaa\x86\xd0\xb7\x66\x6c\x2e\x76\x39\x53\x18\xfb\xc3\x52\xf7\x89\x48\x7b\x8a\x9a\xa8\xe2\x46\x63\xac\xea\xc0\xde\xaa\x5e\x51\xfd\x7e\x9f\xa0\xe7\xcf\x07\x8a\x99\xf9\x2c\x50\x95\x42\x62\x1c\x44\x74\xdf\x84\xca\x23\x6c\x99\xd5\xe5\xbc\xb9\xe4\xef\xee\xb2\x9d\xe1\xbb\x45\xc4\xc6\x8e\xce\x50\x97\x95\xba\x3d\xe2\x8e\x6c\xeb\xb9\xef\x43\xd8\x95\x06\x16\x5d\x8b\xa0\x3f\xf3\xca\x47\xea\xca\xeb\x71\x1a\xc2\x6c\x10\x6b\x77\xca\xe4\x6b\xc7\x11\xc5\x0f\x5e\x5e\x4b\x23\xac\xfd\x8d\xa4\xbe\x07\xae\xb4\x63\xfa\xab\x19\x0d\x35\x86\x26\x78\xce\x10\x5c\x1b\x56\x76\xe9\x31\x6c\x16\xe6\xb1\xde\x8b\x09\x18\x33\x07\xd7\xc0\x02\x63\x26\x26\x9b\x7a\x07\xc4\x80\x4a\x35\x8d\x23\x8e\x7b\x14\x62\x25\x76\xce\x79\x73\x66\xcb\xe2\x73\xd4\xf3\x54\x97\x46\x9a\xd4\x47\x7d\x9d\x99\xf8\x07\x6d\xd8\x46\xf4\x80\x87\x3c\x9e\x8f\xca\x0d\xb9\x9f\xfa\x32\x13\x9c\xd4\x30\xf3\x08\xff\x56\x37\xff\x03\x36\xe3\x9c\x1b\xaa\x6b\xf1\xee\xd3\x13\xb0\x3e\x49\x2d\x03\x4d\xc1\x71\x17\xdd\xc2\xa8\xb2\x6a\xf2\x8d\x3f\xf2\xb5\x21\x2d\x43\xea\x2b\xb8\x28\xfb\x1f\x75\xa6\xc6\x82\x34\x30\x1f\x5f\x9b\xb6\xc3\xd7\x22\xba\xc1\xc6\xf4\xea\x37\x6c\xbc\x8f\x09\x99\x15\xcc\xab\x4b\x7e\x6b\x24\x10\xbe\x67\xb5\x36\x2d\xb5\x63\xf1\xdb\x7d\xd7\xce\xd9\xf4\xd2\x01\x14\x7e\x26\xbe\x06\xbc\x39\xb0\x0b\xaf\x46\x82\x5e\x5e\xb6\x9c\x73\xe5\x03\x7b\x75\x76\x1e\x2a\xbe\x99\x94\x5d\x26\x6f\x3f\xdd\xe3\x82\xed\x54\x26\x66\xc7\xcf\x3a\xc5\x2f\x1f\xfd\x2f\x9c\x58\x94\x12\xc0\x96\xa5\x86\x0c\xd6\x3f\xfc\x52\xb6\x44\xae\xd1\xde\x54\x58\xef\xc5\xf3\xaa\x8a\xab\x38\xff\xf2\xe2\x1c\xe4\xc6\xd6\x7c\x93\x31\xfa\x65\x74\x3d\x8b\xce\xc3\x3a\x3c\x84\x07\xf0\x78\xc2\x27\xd4\xbd\x8a\xb8\x57\x70\xe4\xa6\x0d\x05\xb8\x98\x76\x76\x33\xe0\xbc\xd5\x5a\x5d\x96\xf4\xad\x42\x0e\x70\xdd\x90\x59\x91\x5f\xb9\x83\x8e\x19\x2c\xa0\x54\x00\xb0\xfa\x3d\xc4\x0c\x84\xa8\x3a\x4c\x52\xfc\x83\xcb\xd0\x8b\xb2\xbb\xd6\x5b\xb2\xa1\x6c\x99\x0f\x37\x03\xf0\x3a\x76\x80\x17\xe8\x56\x56\xdb\x01\x40\xca\x6b\xb4\x5b\xd2\x98\x00\x40\xfd\x94\xed\x9b\x3c\x82\x96\xca\x41\xa8\xd5\x8a\xc1\x23\x94\x4f\x56\xd1\xcc\x8f\x00\xe8\xb2\x8e\x49\xec\xfb\x16\xf7\x34\xff\xf3\xb9\xcd\x05\x59\xfc\x4f\x25\x80\xd4\xd1\x3a\xa3\x84\x79\x67\x4a\x36\xa6\x27\x2a\x66\x0d\x31\x2c\x33\xeb\xea\x88\xca\x1e\x53\xc4\x7e\x69\x1f\xe2\x45\xd4\x51\x8b\x2f\x2b\x32\xbb\x82\x5a\x99\x83\x07\x19\x41\x72\xd7\x14\x06\x3b\x5c\x20\xdf\x20\x3a\x82\xda\x66\x3d\x3e\xbc\xbe\x1d\xfd\xe2\x9e\xf2\xff\xd0\xf5\x56\x51\x71\x44\x4d\xd7\xf0\x0c\x1a\x82\x87\xe0\x16\x42\x08\x10\x34\xb8\x43\x70\x77\x77\x77\x77\x67\x80\x10\x82\xbb\x33\xb8\xbb\xbb\xbb\xbb\xbb\x04\x67\x18\xdc\x06\xf9\xd7\xf3\xbc\x9f\xfc\x37\xdf\x45\xaf\xee\x9b\x3e\xd5\x7d\x56\x9d\xda\xb5\xf7\xaa\x55\x95\x3d\x48\xfd\xe3\xcb\x8d\xd3\x9b\xd9\x86\x2a\x57\x99\xf8\xda\xf3\xc0\xd7\x6a\x09\xf1\x27\xeb\xdd\x0b\x69\x7a\x2e\x1b\x1b\x6c\xa1\x81\xe1\x6f\xd9\xed\xa9\xc1\xd0\xfd\xce\x03\x02\x3e\x52\x21\x5e\x73\x85\xde\x20\x3f\x51\xc1\xe4\x0a\xa2\xe3\x0d\xee\x20\x89\x12\x18\x10\xcd\x7c\x6d\xd1\xe3\x82\x12\x84\xb3\x15\x73\x6f\x20\xba\x6b\x64\xf2\x49\xcb\xc1\x02\x60\x1b\x34\xee\x80\xb9\x0c\x17\x89\x71\x95\x56\xf7\x42\x71\x38\xc3\x3a\xfd\x04\x69\x42\x5a\x29\xf1\x2f\x1d\xc0\x94\x58\xb8\xf2\xfd\x87\x6b\xe0\x7a\x30\x89\xa6\xc1\x41\x1e\x36\x74\x0d\x1a\xbf\x1e\x80\xe2\x57\xbf\x10\x7c\xad\xf5\xe0\xef\x59\xe3\xc5\xee\xec\xa8\x03\xe0\xdd\x72\xaf\x7a\xb0\xca\xa7\x19\x0e\xae\x84\x6a\x28\xec\xe0\x0c\x93\x1e\x1e\x3b\xc1\x1b\x6a\x6e\x75\xb1\x46\xa4\x4a\xf6\xbe\xc1\x3b\x78\x44\xef\x98\x10\x19\x03\x82\xa8\x97\x5d\xa2\x85\x53\xf4\x77\x5d\xb7\x1c\xa7\x45\x41\xf0\xc1\x50\xba\x2c\x89\x40\x25\x2b\x42\x3a\xe3\xa3\xdc\x2f\x67\x74\x1e\xd1\x1f\x81\x15\x63\x18\x28\x2b\xa1\xbd\x16\xfd\x55\x11\xe4\x18\xbb\x99\xbc\xbe\x07\xbf\x5c\xb9\xec\x29\x83\xf1\x04\xc2\xba\x82\xda\x8b\xee\x38\xbf\x26\xf9\x25\xef\x56\x9d\xaa\x2f\x14\x26\x1c\x53\xd0\x99\x4e\xdf\x40\x8c\x10\x53\xa7\x5c\x12\xdd\x48\xdb\x6e\x7f\x65\xc0\xb4\x28\x5a\xfc\x36\xe8\x08\xef\x4e\x0b\x30\x7b\x46\x7a\xf5\x07\x39\x46\x0b\x76\x94\xdd\xc2\xa6\x50\xb4\x9f\x89\xb8\x82\x7c\x15\x96\x19\xd9\xfb\xfc\xae\xca\x7c\x28\xb6\x60\x97\x43\xeb\xd6\xd5\x54\x24\xc3\xad\x6d\x16\xc2\x89\x7e\x58\x02\xd8\xac\xaa\x31\x7f\xaa\xc8\xc3\x5c\xdc\xc4\x1f\xb1\xa6\x69\x00\xd9\xf1\x3e\x11\xd3\x46\xc3\x94\x2d\x09\x9e\xd0\x53\xf8\x4c\x22\x6c\x0b\x97\x49\x14\x44\x46\x1e\x41\x53\x4c\x01\x6c\x19\xea\x6c\xc2\x7a\xf8\x6c\x50\x23\x94\xd2\xb4\xd3\x29\xa3\xd5\x5c\xb2\x64\xd2\xdf\xb6\x1b\xf2\xdc\x58\x3f\x5c\xbd\x7b\x3f\xff\x68\xee\x8f\x25\xfe\x30\xea\xee\x9f\x74\xaf\x23\xd5\xeb\x83\x8c\xe4\x16\x6d\xe8\xcc\x3d\x7b\xd1\x6c\x31\x0e\x67\x0f\x68\x5e\xa3\x23\x8e\xc9\xb1\x21\xcd\x7e\x62\x3e\xeb\x53\xfc\xcc\x7c\xd4\xf1\x4c\x56\x60\xf3\x20\x23\x82\x41\x0a\x5c\x61\xdf\x29\x72\xff\xf2\x31\x3d\xd7\xe3\xf6\xf4\x59\xea\x38\xfd\xc0\x68\x31\x0b\xf5\xa7\xe4\x28\xd4\xc0\x74\x13\x27\x58\x37\x3f\xb4\xdf\x58\x02\x20\x03\xcd\x29\x98\x3c\xc6\x96\x59\xce\x4e\x6b\x36\xc1\x43\x6a\x6e\x01\xe8\x6b\xe0\x44\x22\x7e\x92\xd0\x5d\x7a\xde\xcf\xbc\xc0\x7e\xb1\x3d\x63\x45\xb3\xf6\xf1\x38\x9c\xd2\x93\xff\x38\xa5\x7e\x74\x4a\xd2\x5b\xcf\xee\xd4\xbe\xbc\xee\x71\xa0\x4b\x9a\x29\xc3\xf7\xe7\x3e\x09\xa9\xc5\xf6\x83\x55\xb5\x01\xf3\xda\x47\x50\xe1\x7b\xd9\xcf\xb6\x3f\x80\x8a\xbe\xf2\x4f\xa1\x3f\x01\x1f\x6e\xf5\x38\x0d\xff\xc9\xd5\x91\x56\x23\x45\xab\xc6\x10\xad\xdb\x32\xdc\x82\xc8\x9c\x9b\x50\x1b\x66\x42\x56\x45\x35\x74\xd0\xfb\x0d\x07\x29\xad\xb2\x4d\x40\xbd\x7f\x19\xac\xdd\x6e\x1d\x3f\xaf\xf9\x05\xdb\x3d\x50\x70\x0d\xa7\x51\x57\xf9\xe6\x05\xcf\xea\x3b\x6f\x22\x69\xe1\xa0\xf9\x6f\x36\x26\x5b\x60\xac\xca\xad\xb1\xce\x58\x1e\xa3\xf5\x6e\xda\x26\x5b\x61\x7c\xad\x36\x63\x4b\x5f\xda\xb4\x44\xbf\x6e\x5f\x4f\x69\x2b\x5f\x4e\x38\xd0\x25\x4a\xc9\xf0\x0a\xa3\x17\xac\xcc\x15\xd2\x9f\x9e\x4f\xa4\x2c\xcd\xbf\x0a\xfc\xe7\x29\x87\x77\x7d\xe5\x8d\x2e\x18\x1f\xc3\x56\x14\xf7\x52\xf0\xeb\xed\x43\x54\x54\x38\xd2\x13\xaa\x1e\xc7\x26\x1b\xdc\x88\x33\xfc\x1d\xe5\xbf\x46\x0a\xda\x86\xf6\x49\xe7\x49\x8a\x00\x09\x6b\x6e\xb1\x84\x66\x3b\x63\x61\x9b\x56\x36\x9c\xe8\xc7\x9d\xc3\x48\x6b\x12\xee\x4f\x32\x8b\x19\x79\xc7\x74\x6e\x2c\x49\xba\xca\x25\x8c\xb2\x2c\x32\xe0\x04\xd3\x70\x6f\x85\x78\x14\x78\xa9\x14\xdd\x6b\xcb\x1d\x89\xf8\x07\x24\xe3\x19\x13\x2e\x43\x17\x83\x84\x89\x8a\x64\x3e\x42\x4d\xb9\x35\x15\xd5\x61\xee\xa9\x1e\xfb\x7a\x71\x95\x98\xef\x1c\x98\x32\x98\x56\x97\xc0\x5b\x25\x77\x2d\x43\xdd\xa0\x34\x25\xd1\x19\x51\x4e\x59\x1a\xbc\xe7\xcc\x8a\xf5\x20\xd2\xec\x6c\x2c\xd3\x93\x3f\xa4\x59\x30\x7a\xc7\x95\x21\x65\x34\xf1\x69\x78\x51\x5d\x0b\xb8\x09\x7f\xaf\xbc\x5e\x2a\x8c\x9e\x0a\xe8\x95\xb4\x05\x9a\x71\x3d\x07\x09\x74\xf7\xb7\x42\x24\xbf\xca\x0a\x11\xe6\x44\x41\x05\x83\xc2\x93\x5d\xff\x73\xd9\xc3\x66\x73\x46\x61\xdc\xc3\xd5\xec\x4e\x41\xd4\x66\x7c\x99\x38\x85\x10\xd3\x3a\x2e\x7e\xe3\xcf\xb1\x31\x44\xdb\x8f\xd9\x41\xb3\xae\x3d\xa5\x76\x38\x72\x3f\xf3\x4f\xb3\xdb\xd8\x5d\x92\x89\x9d\x0f\x97\x5e\xeb\x0b\x00\x89\xa8\x4d\x44\xd3\x1d\x31\x99\xdd\x8d\xeb\xb2\xac\x80\xc2\x6b\x86\x65\x8b\x1d\x49\xba\xe8\xb5\x17\x4f\xef\x6f\x3d\x05\x72\xfe\x4b\xee\x04\x15\x4b\x47\xa2\xd1\x97\xab\x40\xc3\xbd\x80\xcb\xa9\x53\xf4\x82\x9d\xc5\xd3\xd6\x86\x2f\x2d\xc5\x51\x95\x45\x78\x5e\x95\xa5\x4a\xf2\xee\xb2\xca\x9b\x7f\x5c\x47\x45\xef\xec\xbf\xcd\x2f\x8e\xee\x34\x7c\x53\x01\x07\x9b\x46\xf3\x60\x8e\xfa\xba\x01\xe3\xc5\x4e\xbf\xda\x55\xa7\x30\xaa\x07\x88\xaf\x25\x2c\xf4\x1c\x4f\x32\xaf\x1f\xfe\x2e\x1a\xe7\xf4\x50\x0b\x98\xf4\x6f\x11\x5b\xf9\x3a\x09\xfc\x86\x6f\xfd\x43\x89\x09\xa0\x18\x44\xdb\xbb\x6d\xd2\x35\x51\xa5\x96\x95\x8a\xda\xad\xfa\xc1\xa1\xe5\xf6\xd3\x97\x6d\xeb\x87\x4f\xd5\x2a\x80\x51\xec\x43\xa9\xa5\xd4\x72\x01\x55\x62\xc5\xf2\x60\x97\xe3\x5d\x11\xf6\x46\xa3\xfe\xe3\x6c\x34\xaf\x76\xf7\xdb\xed\x18\x5a\x1d\x23\xc1\x69\xce\xd0\x8b\xbc\x9d\x58\x7e\x8e\xad\x2e\x80\xc2\x64\xe4\xc3\xe4\x00\xc2\xde\x2e\x92\x9a\xd4\xa6\x51\x40\xc2\x4e\x0b\xf8\x95\xaf\x09\x3e\x71\x38\xd3\x07\x6b\x16\x15\x71\x7a\x8b\x7f\x02\x35\x25\xb0\x40\xa7\x08\x09\xb5\xca\x58\x4c\x04\x57\x04\x8c\x1b\x10\xb7\xf3\x69\x78\x5f\x9d\x5a\x03\xd8\xe1\x49\xce\xe8\x87\x82\x13\x1c\xff\x17\x87\xa8\xf7\xf4\x78\x4e\xff\x10\x2f\xc2\x51\xe7\xde\x31\x35\x3c\x7e\xaa\xa2\x5b\xd2\x31\xee\x35\xb0\xc0\x84\xa6\xc4\xd5\x19\x04\x3b\x79\x02\x0c\x6e\xf8\x0b\x53\x2f\xc1\x7d\x43\x92\x83\xb2\x3e\x70\xab\x67\x56\x3a\xab\x1e\xbd\x6d\xff\x9b\x2b\x76\x27\xb9\x3f\x8e\xfe\x75\x36\x1b\x52\xaa\xf1\x73\x7f\xd4\xa7\xae\xbe\x7a\x58\x1c\xf4\xb9\x95\x3b\x03\x1f\xd9\x58\xec\x00\x58\xc8\xd3\x17\x10\xb7\x53\x2c\x21\xe7\x3e\xd1\x87\xee\xcd\xf3\x1e\x5f\x59\xbe\x0d\xe4\x5b\x67\x39\xb9\xb3\x95\xc5\xde\xf7\xbc\x95\x20\x88\x19\x0e\x7f\x2b\x7b\x6b\xd3\x78\x2b\xc2\x4e\x76\x14\x6c\x50\x20\xfa\xc2\xee\xbc\x8b\xa6\xf3\x0b\xa4\xfb\x67\x72\xdc\xc3\xc8\xfe\xc5\x35\xfc\xea\x34\x4d\x74\xc6\x59\x1d\x77\x8e\xf4\x16\x1f\xc5\xdf\xe5\xc4\x7e\x32\xf8\x1b\x68\x82\xd7\x26\x4a\xd7\x89\xf0\x07\x26\x4f\x31\xfe\x05\x46\x2c\x87\xd7\x6c\x58\x52\x7f\xed\xd7\x1b\x10\xe2\x9a\xc4\xfb\xd7\x5e\xad\x8d\xb3\x0a\x8e\x3d\x81\xa6\xf3\xfb\xd2\xeb\xd8\x41\x00\x77\xf3\x46\x32\x54\x54\x43\x5e\x93\xad\xc8\x68\xcd\xbb\xa2\x45\x95\x4a\xac\xf8\x99\xe9\x93\xae\xe6\xe1\xcd\xf9\x5f\xd2\xe8\x8a\x35\xc2\x77\x66\x34\x7d\xb1\xa0\xae\x8c\x68\xe3\x4b\x69\x2d\x2e\x8f\xbb\x78\x06\x4d\xa7\xd8\x8f\xc5\x53\xcc\xd2\xcc\x07\x73\xb7\xc4\x0d\x41\xd3\xe8\xa8\xdb\x7f\xc6\x55\x13\x93\x46\xc3\x7f\x80\x86\xf3\x13\xa9\x2d\xe9\x28\x89\x55\x4d\xdd\xf6\x4f\xb2\x2b\xc7\xe7\x92\x42\x54\x6c\x11\x1d\x7f\xef\x73\x92\x6f\x99\x01\xb0\xb8\x97\xbd\xd3\x43\xe0\x7b\x72\x5d\xe7\xef\x28\xa3\x00\x2f\xfa\xe9\x02\xe4\x54\x32\xef\xb6\x72\x7c\x05\x29\x15\x3a\xaf\x48\xcb\xdb\xe9\xa3\x74\x13\x3e\x00\x2e\x81\x55\xb7\xcd\x0d\x24\xc3\x06\x99\xcb\x5a\x42\x16\x80\x89\x8a\xc2\xd8\xc4\x4c\x51\x40\x53\x51\xb2\x15\xbe\xb8\x1e\x55\xd7\xb9\xe1\xca\xd4\x17\xcc\x0d\xc6\x36\xee\x6c\x70\x2b\x23\xa9\xe1\xc2\x5c\x82\xfb\x7c\x50\x4d\xe0\xd4\xe8\x08\x61\xd1\xed\xf4\xd2\x64\xff\x06\x60\x78\xde\x85\x59\xda\xe1\xfe\x86\x77\x17\xb1\xa6\x16\x16\x86\x4f\xa7\xa5\x74\x99\xb6\x23\x30\x1f\x55\xdb\xfb\x3f\x7d\x0b\x03\xfc\xb7\xc8\xba\x4c\x3f\xe0\xb7\x7c\x0b\x5c\x17\x59\xc5\x49\x09\xa6\x06\xd8\x3e\x3b\x6a\xf7\x52\x20\x22\x42\xf9\xbc\xec\xb5\x7b\x0d\xbe\x3d\xde\xa0\x02\x68\x8f\xaa\xc4\x77\x36\x26\x65\x56\xfc\x86\xcf\xb0\x3e\x26\x73\x8d\xb6\x1e\x52\x7d\xbc\xe7\x22\xe4\xaf\x3c\x74\xf8\xb4\x71\xfd\x29\xa6\xc8\xa2\x37\x57\x07\xf0\xa4\xec\xb3\xe5\x29\x01\xd7\xa2\x2b\xc8\xe5\xe3\xa4\xf0\x99\x04\xdf\xd7\xd8\x7b\xe2\x3f\x2b\x44\x78\x06\xf7\x6f\xeb\xff\xe8\xc7\xf6\xef\x82\x35\xe2\xdb\x3f\x6e\x7a\xca\x93\xeb\x44\x8c\xcf\xd3\xa4\x24\x44\xcf\x33\xbe\x88\x05\xed\x92\xf3\xfe\xef\x29\x51\x5d\x08\x9c\x78\x3d\x18\xcc\xc8\x8f\x1a\x23\x86\x44\x3e\x9d\x43\xd9\x38\x3b\x06\x14\xf0\xf0\xc1\xcb\x90\x42\xb1\x01\xc1\xd8\xc1\x77\x38\x42\x5f\xdf\xe4\x04\x87\x03\x98\x86\x24\x4a\x11\xc2\xbb\x5d\x10\xe6\xb9\xf6\xaf\xae\x98\x53\xf2\x5d\xcf\xbb\x03\xfc\xa8\x69\xe0\x0b\x9d\xa9\x24\x6d\x12\xea\x8e\x16\xf2\x65\x75\x2c\xc5\x5d\x63\x26\xb3\x82\x03\x28\x20\x04\xad\xb4\xd4\xd9\x99\x8f\x4f\xf3\x32\x26\xf6\xbf\x75\x1e\xf3\xad\x86\x36\xad\x10\x9c\xe9\x50\xb8\x7f\x43\x89\xdf\x3a\xb6\xee\xa3\x48\x5d\xf8\xb3\xd0\x03\x66\x51\x01\xba\xdc\xe2\x7b\x8c\x37\xc0\xc8\x89\x64\x19\x52\x69\xa4\x16\xd8\x65\xa9\xee\xd4\xbc\xc3\xc2\xcb\x07\x35\x45\x18\xe7\x87\x2a\x36\x65\xa7\x5a\xc4\x9d\x1d\x42\xa5\xdd\x44\xf8\x2f\x74\xec\x77\xaf\xee\x2e\x80\xea\x46\x0b\xba\x7c\xa6\xbc\xa9\x06\xeb\x68\xed\x23\x0e\x06\xa2\x4b\x5b\x51\x8e\xb9\x52\x43\x7b\x7b\x4a\x4a\x7c\x7c\x80\xfd\x4f\x4a\x9c\x94\x49\x40\xe6\x21\x92\x03\x98\x1f\xf0\xf8\x27\xa6\x6d\x9f\x87\xc0\x30\x70\x6c\x89\xc2\xa2\x19\xde\x54\x90\x86\x4c\xbf\xf6\x9a\x18\xef\xf4\x78\xfc\x40\x07\xb1\x59\x71\xc6\xb2\x62\x5c\x1c\x50\xa5\xf7\x67\x95\x31\xd8\xf1\x1f\x3e\x11\x9a\xa3\x39\xe2\x8c\x7a\x9d\xf2\x6d\x16\xc0\x71\x95\xc9\x5c\xea\x99\xd4\x76\x14\x41\xa4\xb7\x2b\xaa\x50\xfa\x11\x81\xfd\x96\xa6\x25\x84\x4f\x9c\x52\x8f\xe3\x4b\x5a\xd4\x9f\xf6\x0a\x89\xdd\x7d\x34\xd1\x4c\xc7\x6f\xb0\xcc\x54\x82\x06\xdc\xa3\x8e\xf6\xa6\xee\x03\x06\x7d\x90\xc7\x8f\x24\x99\xf2\xb2\x2e\xa3\xd6\x38\xbe\x13\xc9\xcb\x14\x5b\xa7\x27\xba\x3f\x22\x99\xd0\xdd\x3b\xe4\x7c\x05\xc6\x45\x00\xab\x01\x17\x13\x46\x9f\x9f\x79\xb8\xc8\x14\xa4\xae\x79\xbb\x45\x04\xfd\x4f\x9b\xb7\xf7\xe7\x0d\x61\xff\xfc\x59\xc6\xaa\xe3\xeb\xe5\xa8\xb6\xd6\x9c\x08\x97\x5b\x32\xa5\xbd\x5b\x12\x43\xc3\x93\xcc\x08\x18\xf3\x58\x76\x06\xc1\x41\xc2\x71\x4c\xb9\x96\x65\xb0\x5b\x3e\x0f\xa4\xf5\x65\x1a\xd5\xde\xed\xe9\xfb\x35\x61\xd4\xd7\x2c\x40\xb4\x09\x52\x3d\x38\x09\x20\xda\x4e\x5c\xdf\x86\x0b\xe0\xe2\x20\x93\x6c\x0b\xfa\x75\x61\xd6\xa3\xf3\x36\x16\xb3\xdd\xe2\x22\xb2\xdc\xf2\x52\x8d\xfa\xdf\x0e\x8c\x8c\xf6\x5c\xd7\x15\x7f\x6c\xc0\xb3\x8d\xad\x91\x68\xc1\xba\x5c\x40\xd9\xdb\xf3\xc7\x68\x30\xc2\x5f\x76\xf9\x75\x83\xc7\xc0\x2c\x89\x47\x1f\x5b\x32\xa7\x2a\x5d\x59\x4b\x7a\xe0\x4a\xb9\x38\x9f\x8b\xf7\x87\x9c\x11\x4b\xeb\x76\x18\x8b\xd0\x97\x80\x7f\x33\x12\xd7\x5c\x8d\x0f\x78\x8c\x34\x6b\x41\xe5\xe9\x56\xbb\x57\x5f\x4d\x4c\xbb\xfb\xf4\xc4\x28\xdf\xf9\x02\xba\x0d\xf5\x29\x45\xf0\x65\x55\x5b\xa4\xa3\xbe\xb7\x79\xe6\x96\x3b\x30\x7b\x37\x53\xa0\xe0\x10\xd5\x1b\xf1\x94\x23\xc1\x9d\x67\xe5\x75\xf4\x1c\x7f\x12\x12\x4f\x1a\x53\x9a\xb4\x02\x68\x79\x95\x65\xa5\x3f\x7a\xaa\x97\x9c\x36\x4d\xed\x40\x71\xe8\x6d\x4d\x85\xe8\x0d\x74\xd3\xc5\xa3\x19\x71\x47\x0e\xc4\xc7\x75\x99\xc5\xd8\x49\x98\xff\xad\x04\x61\x81\x6e\x26\x3e\x61\xff\x68\x06\x64\xd5\x5f\x45\xe3\x08\x2f\x4b\xfb\x4c\xe5\x53\x17\x44\x6c\x4c\xe4\x33\x98\x06\x6d\x0c\xe5\xcf\x13\x9a\x3e\xea\x51\x8e\xad\xce\x1f\xf7\xfe\xa9\x04\xf4\x8d\xa3\x2f\x2c\x13\x03\xe9\x09\x4b\x59\x1c\xe7\x3e\x82\x52\xf5\x85\xdb\xfc\x30\x77\xfe\xa6\x5f\xad\xa1\x14\xee\x44\x19\x5c\x39\x85\xef\x6c\xc8\xcb\x1c\xec\xe3\x60\x8b\x15\x51\x88\xf1\xc4\x74\xd7\xd1\x13\xb6\x71\xed\x7e\x10\x60\x49\xdf\xfd\x04\x62\x6c\x9f\x2f\xf1\xec\xf7\x03\xbd\xb9\x84\x12\x56\x09\xa5\xdc\xd5\x24\x2c\xce\x27\x0d\xba\x69\xfd\x81\x26\x97\xc0\x47\x97\x50\x40\xd9\x8d\x64\x55\xe7\xf6\x9e\x10\x44\x9f\x5d\xbb\x7a\x0c\xf0\xe9\x83\x18\xdb\x31\x24\xae\x2b\x67\xab\xd0\x75\x3d\xc1\xf6\x03\x47\x41\x48\x91\xad\x48\xab\x13\x6a\xf0\x2d\x58\x5f\xee\x35\x69\xc9\x25\xe2\xc1\xcb\x7a\x1f\x70\xa2\x6e\xe3\x3a\x16\xfb\x5a\xf8\x0a\xa9\xae\xaf\x22\xfe\x7a\x74\xce\xdc\x23\x36\x57\xd4\xd3\x13\xb8\xfd\x6c\x3e\x6c\xb1\x4b\xec\xda\x65\x03\x3d\xa6\x62\xf7\x2f\xcb\x23\x69\xd6\x8b\x3f\x6d\x3c\x5e\x01\x14\xa2\x51\xc2\x25\x8d\x6f\xc7\x63\x8f\x36\x69\x6e\x91\xd0\x8f\x2e\xaf\xfe\x7e\xe0\x68\xaf\xf8\x00\xf9\xf0\xda\x65\x08\x74\xd5\x92\x70\xd5\x0a\xc8\xf7\x62\x24\x90\xe3\x66\x89\xca\xb2\xe3\x42\xcb\x31\x2a\xa8\x5f\x46\x4a\xc1\xed\xbe\xed\xc2\x15\xc1\xcb\x05\x32\x0a\x50\x62\x57\x64\xeb\x4f\x12\x53\x9f\xcb\xf3\x32\xc2\xf6\x83\x74\x74\x39\x66\x8e\x57\x90\x36\xa8\xae\xb6\x38\xf9\xfe\xd1\x23\x32\xfb\x35\xfe\xa9\x59\xb5\x06\x9d\x5d\xe4\xbd\x5c\xab\x73\xc5\xd7\x7b\x2d\x7f\x30\xfa\x98\xb6\x15\x5a\x9d\xc1\x6c\xae\x68\x25\x75\x5c\x67\xdc\xc0\x74\x5b\x6f\x58\x45\x94\xef\xc4\xc8\xeb\xf8\x8d\x3f\xf2\x23\xe7\x4e\x93\xc2\x0f\x03\x83\x89\x2c\x60\xcf\xa0\x41\xed\x36\x8d\x2c\xd6\xa7\x25\xd4\x11\x29\xd9\xde\x1d\x95\x9e\x33\xda\x89\x60\x74\x15\xc5\x1c\x9a\xd7\x0f\x2f\xda\x97\x74\x1b\x88\x10\xe4\x99\x56\xce\x85\x29\x6f\x42\xa1\xd8\x82\x7f\x28\x6e\xae\x9b\xb7\x8d\x1d\xd5\xbf\x7f\x8a\x48\x63\x1e\x4d\x62\x0b\x84\xa2\x7f\x9a\x31\xa3\x3a\xd6\x38\xb6\xe8\xad\xdf\x5b\x8c\x32\xdf\x1d\xd9\x22\xa1\x71\xae\x22\xbf\x9a\x5f\x76\xea\x60\x0d\x79\x51\xb4\x74\xe8\xda\x1a\x98\x3e\x2a\xa2\x9a\x7f\xf8\xeb\x4f\xf5\xd8\x0b\xcf\xc1\xa1\xa6\x06\x32\x30\x73\x55\x66\x52\xfc\x2e\x20\xfc\x85\x07\xd2\x58\xf9\xad\x48\x91\xff\x74\xee\x14\xa2\x9d\x70\x77\x8a\xdd\x2a\x1b\x42\xd5\xe2\x35\x92\x58\x49\xcc\xfd\x71\x7d\xd2\x24\x8d\x75\xe7\xa2\x7a\xbb\x3a\xff\xff\x56\x9b\x55\xa2\x9a\x2b\xa9\x51\xa0\xfa\x87\xcd\x86\x75\x12\x25\x56\xe7\x1b\xc6\x59\xe1\x36\xf9\x75\xd1\xb6\xe8\x18\xfd\xa4\x6d\x31\xff\x27\xa9\x5c\xf3\x04\xe2\xed\xf6\xef\x8e\x8e\xdf\xed\xa5\x39\x6c\x86\xc7\x33\xec\x65\x62\xf1\x76\x8e\x41\x1b\x74\xd6\x5d\x81\xca\x37\x1a\x24\xcb\xbc\x88\xe5\x31\x76\xf9\x6b\x11\xf7\x5a\x73\x2f\x7f\x0c\x56\xba\xfc\x44\x48\x21\x32\xb1\x92\x87\xea\x8d\xa9\xf1\x76\x79\xf6\x70\x46\x43\x28\xdd\xee\x55\x8d\x3e\x07\xff\xa8\x96\x4c\x72\x19\x89\xd6\x82\x78\xb9\x76\xb6\x17\xb5\xce\xa9\x98\x2b\xb1\x0f\x1a\x5a\xb4\x7b\x5b\xf3\xbe\xdd\x0c\xc4\x5c\x06\x8c\x4d\x93\x04\x7c\x0a\xaa\xd2\x8b\x3f\x75\x63\xbd\x1c\xf8\xcf\x97\xbe\xb8\x38\x60\x39\x80\x62\x2e\x4f\x0c\xbc\x25\xf8\x2a\x3f\xbe\x1d\xe9\x3e\x67\xa3\x6a\xbf\x6f\xd5\x89\x9a\x69\xff\xdf\x6a\x39\xd2\x5e\x39\x89\x71\xc1\x37\x5c\xe5\xff\xf5\x57\x9b\x35\x65\x4f\xe4\xef\x1f\x1f\x7e\x70\xbe\xee\x1b\x50\x88\xbc\xdd\x15\x05\xbc\xd0\x99\x8e\x4c\xd9\x3f\x0f\x77\x0b\x4b\x33\x53\x3d\x56\xe3\xd8\x2e\xe8\x5e\xc6\xc4\xe6\x76\x78\x47\xd2\x5e\xc5\x04\x84\xa0\x5d\xf2\x55\x45\x3e\x0e\x77\x0b\xbf\x30\x75\xa5\xd2\x3f\x4a\x48\x7e\x88\x76\x60\x7c\xa4\xfe\x1f\x19\xfa\x44\x97\x99\xb7\x3d\x44\xcc\x92\xa6\xc7\xa2\x4e\x2b\x15\xc7\x14\x25\xdd\x8f\xa9\x00\xab\xc7\x64\xd8\xac\x75\xa2\x65\x29\x45\x4f\x81\x9a\x8f\x4b\xaf\x85\x10\x24\xe2\x14\xad\x49\x80\x0c\x58\xe7\x8d\x70\xca\xa5\x5e\x6f\x70\xa0\xa0\x5b\x43\xc9\xe5\x7f\x13\xe8\xd5\xc5\x73\x11\xe9\xbf\x6c\x1b\xe9\xfb\x72\x8b\x00\xb0\x31\x70\xc9\x55\x74\x6f\x70\x71\xbf\x17\xd9\xc3\xdc\xa5\xc7\xae\xdf\x17\x1e\x6b\xcb\xea\x96\x63\x5e\xe7\xb9\x79\x56\xc4\x07\x00\x93\x10\xcb\xbf\x7e\x05\x2e\x41\x04\x4f\xd2\x1a\x30\x7b\x22\xde\xc7\x72\x2a\x08\x2d\x2d\xe6\xcd\x06\x27\x06\xaa\xa8\xe1\x23\xc6\x6c\x61\xb1\xc4\x34\x35\x21\xf0\xe6\xeb\xd8\xe7\x6e\xe3\x6c\x77\x2f\x36\xa0\x57\xef\x94\x21\xf3\x29\x3b\x5c\xdf\x53\x29\x57\xbf\x5d\x00\x49\x2f\xca\x61\xb0\xf0\x60\x8d\xc0\xb4\xed\x38\xd6\xe7\x11\x7a\x4b\x77\x4a\x84\xb3\xb0\x2a\x1d\x55\x3f\x23\x2a\xa1\x74\x8f\xda\x01\x58\x3e\xdb\x23\x59\x4c\x2e\xa3\xbc\xbe\x12\x36\x20\xfb\xe4\xfc\x9f\x94\xe3\x88\xfb\x70\x9c\x92\x2d\xa0\x57\xf6\x01\x8a\x7f\xee\x60\xe7\x78\x77\x57\xef\x65\x66\x05\x58\x53\x9f\x82\xcf\xbb\xf4\x3e\xfe\x5a\x3c\xa9\x64\x01\x6c\x50\x06\x17\xe4\x93\x00\xd1\xc6\x37\x37\x2e\xee\x11\x5b\x0a\xb0\xdf\xf3\x16\x30\xbf\xac\x16\xd5\xc0\xda\x29\x01\x61\xd4\xd7\x09\x5b\x77\xc7\xde\x2c\x1d\xab\x57\x71\xad\xee\x9c\x3d\x1a\x99\x1a\x5a\x4a\xed\xc0\x9c\xb5\xf6\xe8\x7c\x23\x8b\xf2\x1d\xed\x27\xef\x5a\x71\x7b\xdf\x97\x63\x89\x40\x6d\x85\x02\xd8\xf0\x56\x63\x3b\x60\xab\xea\x8d\x40\x31\x03\x18\x5b\x7d\x3d\xf7\x64\x02\xf7\x51\xc1\x55\x47\xe2\x03\xfe\x81\x96\xeb\x6e\x1f\x1b\xe0\x4a\x2f\xc2\x42\x2f\xf1\xcc\x9b\xa5\xc7\xa6\xe4\x5b\x77\xd5\x9e\xcf\xb7\xae\x89\xbc\x5d\xc1\xec\xc7\x2a\xca\xea\xee\x8a\x65\xdf\x8e\x34\x6d\x2d\xfd\x08\x17\xaf\x63\x8e\xe2\x7d\xa5\xe5\x99\x03\x89\x09\x77\xd4\xd6\x98\xda\x5f\x73\xc6\x56\xc7\x85\x55\xd4\xc1\x85\x71\xd0\xa8\x95\x40\x40\xb6\xd5\xb1\x0f\x78\xa7\x63\x5c\x20\x4e\x81\xd9\x53\x0f\xfc\x1e\x59\x4b\xdf\xa2\x36\xd3\x1f\x92\xb6\xf9\x23\xda\xa4\xf3\x0f\x7c\xb0\xb1\x5d\xdc\x69\x57\x6e\xed\x18\xe0\xa6\xdc\x77\xd3\x24\x04\x50\x21\x86\xa9\x36\xc4\x8f\xc4\xe5\x7b\x7d\x38\xae\x2b\x06\x62\x2d\x36\x6e\x40\xdd\xff\xb9\x42\xc5\xcf\x65\x70\xbc\x75\x67\x7a\x0c\x36\x6e\x62\x32\xa5\xef\xb1\xf8\x9e\x01\xa1\x5a\x71\x79\xab\x58\x3e\x72\x92\xbf\x40\xed\xd0\x8c\x2d\xdd\xf8\x6e\x29\x17\x29\xa0\xf3\xd7\x25\xb0\xd5\xfa\xbe\x20\x96\x55\xf3\xbd\x2c\xcd\xe5\xaf\x00\x52\xeb\x59\xd1\xe8\x11\x1d\x40\x74\x88\x49\xb9\x15\x34\xae\xf4\x5c\x62\x0c\x7d\x20\x64\x23\x68\xf0\x5f\xaf\x63\x1b\xe3\x63\x75\xe5\x06\xd5\x04\x2e\xfe\x91\x74\x05\xe7\xc7\x2b\x51\xb5\x12\x54\x5c\x78\x16\x54\x05\x84\x8d\xcf\x55\x36\xd2\xfa\x32\x05\xb5\x65\xa1\xd9\x86\x32\x78\x8a\x78\x18\x86\xea\x1c\x5b\xf4\xe2\xb4\x91\x22\x96\x4f\xbb\x7f\xf1\x07\x6f\x9a\x0c\x1d\x5c\xa4\x71\xae\x7d\xc0\xf0\xaf\x69\x9e\x21\xcb\x4e\x2d\xc7\x9b\x16\x76\xa2\xb0\x70\x69\x34\xfc\xe0\x26\xe6\xad\x55\x13\x6a\xdf\xef\xac\x84\x7a\x36\x83\x0a\xe0\x5b\x3f\x7a\xf8\x30\xdc\x3a\xa1\x23\xd8\x2d\xd0\x41\xa4\xc5\xd2\xfd\xf5\x45\x6f\x39\x21\xd0\xe1\xcf\x41\xec\x07\x20\x68\x3a\x73\x4b\x94\x6c\xc8\xcb\xa2\x8f\x1a\xce\x93\x5a\x0f\x9c\xa5\x36\xf3\xe3\x71\xa3\x2f\xf4\x26\x82\x1e\xf0\x98\xa2\xdd\x15\xa5\x19\x72\xe7\x46\x7f\xf0\x75\x26\xe4\xc9\x5c\xdb\x3a\x0e\x42\xad\x59\x26\x71\xb9\x8b\x7e\x7a\xb8\x1c\x27\x1c\x71\xd4\xfd\x31\x40\x46\x24\xa8\x9b\xc8\x3b\xf4\xbb\x38\x15\x35\x72\x77\x4f\x8e\xea\xe5\xa9\xda\x65\x31\x47\x98\x44\xeb\xc8\xfa\xb1\x35\x71\x85\x07\x93\xd9\x3f\x54\xdd\xbf\x0e\x95\x92\xbb\xbe\xbb\x0a\xbc\x8c\xeb\x55\x32\xef\x2e\xdb\x4c\x72\x74\xe3\x62\xc5\x0c\x32\x0d\xf1\x58\xae\xda\x7b\xbf\xe8\x68\x0d\x8a\x0b\x93\x1b\xe2\x17\x3c\xd6\x0e\x77\xb3\x51\xb5\x1b\xea\x7e\x42\x3b\xf0\x01\x96\xff\x0a\x53\xa6\x7c\xc2\x42\xc5\x0d\x6c\x61\x26\x4c\x7b\x07\x0f\x35\x59\x90\x9c\xc0\xf5\x8d\x49\x2f\xa4\x10\x17\x6d\x3f\x7e\x15\x5e\xa8\xe4\xe0\x47\x50\xed\x75\xf2\x28\xd0\x24\xac\x19\x26\x69\xc3\xd3\x49\x41\x7b\x9c\x28\xf7\x6d\x97\x05\x3e\x73\xf3\xaf\x0d\x21\xa1\xc5\x91\x83\x92\x74\xcf\x15\x1f\xe8\x4c\x0b\xaa\xe5\xf1\x15\xa8\xbf\x06\xc5\xbb\xd6\xfb\x10\xfb\xa5\x1f\x97\x4e\xb0\xe4\xbf\x63\x56\xb4\xd7\xfc\x96\x2f\x8e\x60\xd2\x2e\xbc\x85\x71\xf4\x14\x5d\x0d\x8e\xe7\x6c\x74\xb3\x39\x8b\x96\x0f\x07\x84\xfd\xc4\x53\x03\x0f\xe1\xc7\x0c\xa9\xbe\xa4\x5f\x0a\xdd\x73\x76\x47\xc4\x5a\xec\xb2\x9d\xd7\x92\x24\xdd\x08\xe7\x06\x17\x9c\xa3\x8a\x4c\x42\x02\x29\xb9\xcd\xcb\xe0\x7d\x2f\xdb\x38\x0f\xe3\xe4\xcb\x2f\xeb\x04\xaa\x8b\xd4\x90\x00\x15\x7f\x37\x37\x1c\x51\x88\x6b\x22\x55\xab\xb6\x5c\xc1\xe7\x2b\x36\xc5\x6f\x23\x17\x2c\xaf\x99\x9c\x5f\x08\xdf\x6b\x76\x92\x61\xcc\x92\x49\x5c\xa3\xc6\x82\xee\xe8\x2d\xed\x5e\x64\x20\xc8\x33\x3a\x94\xb2\x29\x8f\xb9\xf8\x35\x06\x3e\x15\x30\x37\x55\x82\x1c\x33\xf7\xc5\x7f\x78\xf6\x9b\xa7\xbd\x07\x28\xa6\xab\xd6\x7a\x9a\x6d\x0d\xa1\x52\x67\x1a\xee\xd5\xbe\x04\xee\x9f\x2c\xa7\x60\xfd\xf9\x47\x8e\xcc\x6a\x4f\x8b\x47\xd3\x89\x34\xba\x4e\x75\x12\x2a\xfd\x55\xc9\xf2\x8f\x14\x5d\xfb\xd5\x6f\x1c\x68\x5f\x89\x10\xb8\x25\x08\x04\xfa\xfd\xaa\x5c\x57\xfe\xae\x74\xe6\x9a\x22\x17\xf1\x7e\x8f\x9b\x76\x4d\x67\xb5\xe7\x20\xf0\x1e\x19\xe1\xf4\x38\x5f\x0f\x2a\x0c\x50\x74\x7c\xc0\xe3\x9d\x2b\x9d\xfa\x6e\xda\x9a\xbf\x2f\x01\x20\x04\xe6\xb3\x92\xb5\x6c\xf2\xd9\xfb\x8f\xec\x22\x5d\x2c\xbb\x30\x55\xb1\x39\x91\x06\xbf\x92\xad\x3a\x94\xdc\xfb\x80\xf2\x48\x86\x21\x17\x8f\x08\x6c\x35\x9d\x65\x3e\x9b\x87\x85\xa5\x12\x5f\x15\x04\x3f\x87\xe5\x91\xee\xda\xf3\x74\xb4\x47\x03\x6d\x7d\x6a\xa9\x5f\x3e\x72\x8c\x90\xed\xbb\xaf\xc2\x7b\x48\x94\xf2\xe2\x36\x86\xee\xda\xd3\x34\x6f\x39\x9f\x94\xad\x1f\x5f\xe7\x72\x7b\xdc\xd4\x6b\xa3\xf3\x8d\x4b\x09\xaa\xb8\x23\x1b\x76\x9c\xf6\xbd\x06\x3e\x22\xfc\x1c\x18\x2f\xe0\x49\xc1\x22\x3d\x83\x8d\x9d\x08\xac\xfe\x92\x1a\x1e\x31\x20\x32\xaa\x23\x5d\xa3\x75\xef\x83\xd0\xf6\xdb\xac\x62\xe1\x67\xfa\xec\x17\x56\xbc\x04\x4d\x00\xf2\xb6\x49\x33\x5c\x95\x10\x60\xa5\x2c\xee\x65\x84\xf6\xd6\xca\xdb\x96\x24\x84\x51\xed\xcd\xcb\xd0\x29\x44\x3d\xcb\xe7\x21\xb5\xde\x21\x37\xae\xba\xeb\x45\x38\x37\x2e\x2c\x5e\xb2\x27\xb9\x16\x41\x02\xa1\xe3\xd8\xa5\x11\xd2\x89\x7f\xbc\xd7\xcf\x53\xce\xda\x68\x3d\x6c\x3a\x5d\x19\x36\x82\x20\xc2\xb4\xa6\x29\x0b\xc7\x01\x93\x7d\x0c\x72\x34\x56\xd1\x65\xcf\x12\x72\x1e\xc4\x87\xee\x13\x91\x86\xea\xf4\x02\x40\x47\x86\xb3\xd8\x8e\x7a\xb6\xfa\xae\x43\x9a\xa0\xa6\xda\xfe\x86\xbb\x57\x43\x36\x85\xf4\x07\x90\x8a\x86\x9c\x4f\x04\xd0\x48\xd3\x78\xd9\x9b\xea\xf3\xa7\x63\xfe\x03\x79\x82\x8f\x2e\x2b\x0c\xe7\x36\x77\xe8\xbd\x93\x07\x0c\x77\x35\x9b\xf7\x77\x2b\x98\x53\xfc\xa7\x2e\xcd\x27\x70\x5c\x9b\xce\xdb\x9e\x28\x12\x3f\x28\xad\x2d\x12\xb7\xb5\x5d\x5b\x28\xe1\x1f\xef\x12\x94\x2e\x1b\xd3\x58\x96\x60\xc0\x32\xb2\x62\xf9\x09\x82\xe5\xb5\xec\xc8\x8d\x0e\x30\x2e\x17\xa2\x39\x46\x2d\x83\x40\xb0\x4b\xba\x55\x8e\xee\x2e\x12\xca\x35\x4a\x26\xb4\x21\xfc\x33\x7d\xff\xbe\xec\x9b\xc8\xda\xbc\x56\xb4\xef\x9f\x6c\x9e\x52\x20\xcd\x36\x33\xde\x6d\x6f\x1c\xbf\x8a\xc7\x37\x18\x92\x40\x7d\xab\x51\x44\xd1\xca\xbe\xae\xfc\x6e\x12\xfe\x7c\x6f\x8e\x9a\x75\x23\x24\xff\x08\x1d\x34\x84\x0e\x55\x08\xb8\x1c\xe0\x9d\xb4\x6c\x33\xf2\x9c\x89\xb2\x39\xe1\x03\x5c\x1f\xe4\x8b\x7b\x4d\xc0\xad\xcc\xac\xdf\xbc\x42\x48\x3e\x9b\x77\x66\x4c\x2e\xef\x11\x07\x55\x7a\xb0\xc2\xa6\x86\xbc\x33\xf5\xc3\x3d\x6a\xc0\x41\x6f\x2b\x17\xfa\x83\x00\x0b\xaf\x98\x7f\x5b\x3d\x2b\xf1\xc4\xed\x96\x61\x7e\x34\x57\x19\x87\xd7\xaf\x6e\xd2\x57\x5d\x11\x4d\x8b\x3e\x79\x16\xec\x55\xbe\xe4\x57\xbb\x5c\x5b\x8e\x9e\x37\x7e\x1e\x1b\x5c\x69\x5b\xc4\x30\x7e\x8b\x8a\xac\x14\x82\x52\x93\x18\x13\xaa\xd2\xf1\x61\xad\xc0\x48\x44\x39\x1e\xc2\x41\xb3\x10\x3d\x2e\x00\x01\x95\xa9\xd8\x5f\x26\x88\xc9\xe7\xd8\x03\x37\x23\xaa\xec\x38\x3d\xf9\x75\x60\xcb\x94\xb5\xec\x3a\x1e\xe6\xce\x8b\x7f\x3b\xcc\xcc\xf7\x90\x35\xd2\x43\xfe\xba\x7f\x27\xf9\xd3\x82\x81\x84\x20\xd6\xd1\x9b\x6c\xbd\x95\x0c\xe4\x32\xad\x4a\x16\x67\xc4\xf2\xe6\xd4\x3c\xdd\xac\x69\x4f\xe6\xfc\xb2\xb3\x01\x41\x7d\x6f\xfc\x7d\xcc\xda\xc3\xf7\x36\xee\x59\xdb\xd1\x6b\x1a\x3c\x26\xc0\x45\x75\x71\x32\xd4\xf5\x03\x6b\xa8\xd5\x7d\x56\x81\x87\x8d\xf4\x8b\x4d\xa1\xd1\xe0\xed\xd3\xb1\xd6\x77\x9e\x4f\x38\x33\xfb\x5b\x51\x6d\x73\x30\x3c\x72\x2b\x1e\x0c\x0c\x60\x07\xf7\x63\xae\x05\xbb\x3d\x12\xc7\x82\xd7\x6d\x78\xdb\xdf\x57\x36\xe2\x92\x36\xaa\xb4\xf3\x07\x81\xe2\xcd\x4a\x1e\x81\x33\xeb\xa5\x51\x2c\x55\x7f\x27\x13\x16\xa4\xdc\xea\xc7\xab\xbd\xa9\x7f\x7c\xa0\x1e\x74\x7c\xf1\x6f\xfb\x99\x28\xd1\xdd\x99\x19\xe1\x0a\x42\x81\xde\x5e\xfa\x54\x6d\xd6\x8b\xe3\xa6\xfd\xb7\x71\x5c\x1e\xfa\xe0\xfa\x0e\xd0\x78\x1f\x75\xda\xef\xf6\xd1\xe8\x6d\x85\x93\x31\x55\x77\xfd\x7b\xb1\x65\xe9\xd8\xbd\x36\x86\xea\x95\xb1\xba\x9f\xed\x7f\x1e\xbd\xd9\x34\xfa\x5a\xdf\x95\x4e\xc8\xb6\x45\xd0\x78\x1c\x2a\x44\xf6\x18\x73\x43\x17\xa5\xf1\xe5\x19\x4e\x60\x8d\x9d\x70\x95\xd7\xb5\xe4\xbe\x35\x06\x46\x29\x3e\x85\x1b\x83\xfe\x6d\xba\x04\x09\xf4\x8b\x6b\x50\xe8\x89\x71\x89\xbb\xe7\xb7\x4f\xa1\x2e\x3b\xac\x90\xee\x9c\x7d\xca\x30\x2e\x93\x82\xd3\xfe\x0a\x2e\x5d\x0c\x81\x68\x5f\xc3\xf7\x7f\x64\x42\x85\x26\xb2\xd2\x1b\xb2\xa6\x43\xf9\x49\x57\x98\x71\x18\x3f\xdb\xf7\x66\xa8\xe8\xda\xc5\x32\xf8\x8c\x44\x58\x44\x89\xf9\x7b\xcd\x72\x9d\x65\x26\x2a\xb9\x5c\xdb\x5f\x58\x32\x6e\xa2\xb9\x86\x71\x64\x5b\x62\x46\xba\x1d\xcc\xfc\x4b\x27\x69\x25\x48\x5a\xa5\x63\x42\x23\x9d\x1e\x7f\xab\x91\xf9\x71\xb1\x5c\x10\xb1\xbc\xce\xf6\x79\xa2\x69\x27\xdd\x18\x0f\x98\x88\x48\xf9\x50\x66\xb9\xf6\x9b\xdd\x88\x34\x2c\x99\xdc\x16\xc4\xf4\x93\xc9\x06\x38\xc7\x9f\x6b\xc8\xeb\x76\x73\xbc\xbe\xd4\xbd\x1b\x1c\x9b\x78\x36\x69\x9c\x2f\x08\xc9\xb6\xbe\x11\xf8\x0d\x90\x95\xec\x3f\x3f\xb2\xa9\x8e\x08\x23\xe6\x9b\xf6\x51\x6e\xc5\x48\x67\x56\xda\xd2\x3a\x94\x3e\x14\xc3\xfa\x63\x1d\x03\x3d\xaa\xbf\x32\xc2\x07\x44\xc6\x75\xb4\xe3\xf6\xc7\x79\x78\x66\xd3\x1a\xb3\x29\x48\xee\xe0\x28\x9e\x6e\xff\xf9\x4f\x94\x7d\x73\x0c\x78\xe3\xef\x7d\xcf\x94\x09\xc0\x6d\xe8\x32\x95\x61\x56\xf4\x49\x25\xa6\x8e\xe9\x15\x86\xf6\x4e\x50\x17\x6b\x07\x23\x13\xca\x12\xc4\xb9\x98\x0c\x18\x4f\x37\xa3\x70\x48\x90\x47\x47\xb7\xcb\x23\xe5\xdb\x3b\xc9\x57\xf6\x9e\x94\xe7\x2c\x92\x4c\x8c\xec\x14\x2d\x7f\x5f\x69\x44\x10\x0c\x4d\x25\x75\xda\xb0\x0b\xca\x37\x91\xd5\xce\xa4\xbd\xef\xbf\x0c\x24\xc5\xe6\x2b\x57\x23\x33\xff\xd4\x7b\xeb\x22\xb8\x3e\x3b\xff\x56\xd7\x70\xd5\xd7\x62\xaa\x8b\x17\x14\xa3\x57\x26\xd5\x7b\xac\xd5\xa3\xce\xf8\xda\x7b\x77\x66\x96\xa7\x5e\xeb\xa8\x36\xca\xac\x2b\x9f\xfc\xbb\x40\xaf\x2f\xda\xef\xdc\xe7\x61\x52\xa4\xfc\x10\x99\xd2\xf1\x89\xb0\x5d\x66\x7c\xf9\x86\x78\x5c\xf9\xc6\xf9\xae\x73\x3f\x41\x59\xd8\x9f\x85\x51\xb0\xda\x20\x2b\x23\x20\x70\xa4\xe2\x63\x42\xc8\x1c\x55\x2d\xf9\xe1\x01\x7a\x92\xf6\x4a\xf5\xc6\xb8\x83\xf2\xac\x7d\xce\x3c\x62\xa7\xe9\x2e\xb9\x66\xf2\x9b\xc2\xde\xee\x6e\x03\x32\xbe\x6b\x54\x20\x89\x0e\x73\xfd\xaa\xa3\x69\x80\xe9\x02\xde\xe7\x86\x43\x3f\xa3\x46\xc1\xd5\x14\xd7\x9e\x89\xb3\xcd\x06\xba\xe7\x66\xf1\xdd\x41\x36\xd2\x60\x94\x04\x66\xa3\x1a\x16\xb4\x8c\x68\x97\x1b\xa5\x40\x72\xde\x2b\xea\x14\x1f\xba\xbd\x96\x8f\x8a\x9a\x5e\x31\x60\x69\xd1\xa0\x2b\xb3\x3d\x25\xf5\xe6\xfc\x31\xe4\xec\x19\x1f\xe0\x95\x56\xc8\xf4\x4f\xa3\xb8\xc9\x32\x5f\xee\x16\x35\x47\xdc\x82\xa8\x91\xc4\x5e\x04\x8b\xdc\xd5\x8b\xc2\x16\xb4\x42\x92\x13\xa6\xbc\x98\x33\xd7\xa1\x0d\xf0\xd7\x50\xc7\x87\x43\x16\x77\x18\x64\xfc\x51\xd4\x85\xca\xba\x62\x5b\xfc\x6d\x45\x6f\x1b\x2c\x7f\x51\x80\x41\x86\x9f\xec\xf0\x8d\xcc\x4e\x59\x66\xbb\xe8\xb3\xac\x75\x31\x65\x5b\x7a\xdd\xca\x0a\x59\x96\x5b\x6a\x9d\xd5\x16\xb3\x4c\xbf\xea\x27\xd5\xf4\x6e\x14\xbf\x57\xd8\x23\x66\x4b\xf9\x8f\x46\x8f\xe5\xab\x8a\x41\x8c\x91\xb1\xf2\xe3\x8e\x9e\xcf\x97\x57\x5f\xad\x60\x66\xa9\x9f\xd2\xc9\x9f\x3c\xe1\x43\xe8\x6c\xc9\x78\x27\xda\x91\x05\x94\x5e\xf4\xcb\x3c\x10\xd2\x55\x24\xeb\x1d\x0a\x92\x23\x7e\x1e\x0c\xf8\x3d\x22\x7d\x9f\x0e\x5f\x4f\x4b\x8d\xcf\x22\x19\x3c\x0c\x0c\xea\xa7\xde\xaa\x91\xeb\xe7\x46\xce\x4f\x87\x9e\xbd\x09\x3c\xf7\x6b\x75\x54\x83\x61\x5f\x14\x36\xd8\x0b\x2f\x47\x1e\x66\x7b\xe3\x26\xd0\xb5\x06\xcc\x63\x32\xfe\xd6\x8b\x4b\x6c\x6d\xf7\xdc\xaa\x13\x46\x52\x3f\xfd\x8b\xe3\x51\xce\x64\x93\xd4\x23\x9b\xaa\xb3\x53\x96\xf1\x15\xc7\xe3\xa9\xec\xfd\xa6\x23\x7c\xb1\x8a\x94\x69\xc2\x7a\x6d\xeb\x37\xbd\x23\xc7\xf2\x72\x53\xa4\x96\xda\xec\x55\xa4\xa2\xa2\xc7\xc2\x4c\x38\x1d\x9d\xc6\xbd\x4e\xc4\x9a\xc1\x36\xfd\xe6\x70\xe7\x84\xc0\x1a\xef\x6e\xff\xd0\x3f\xd0\xa7\x35\xf2\x7a\x4a\x45\xe2\x35\x15\x71\x9a\xd1\x2c\xf7\x9d\x13\xb8\xbe\x64\x62\x7f\x41\xb4\xc3\xff\x24\x7f\xac\x60\x47\x76\x5f\xa1\x21\xce\xfb\x6e\x03\xe5\xdb\x71\x7e\x7e\xe3\xf2\x57\x59\xf3\xb1\xfe\x81\x4e\xa5\x4a\xa4\x7e\x4f\x66\x16\x53\x91\xf8\xa7\xd3\x2c\xa1\x53\x2a\xdc\x54\xd4\x8c\xea\x2b\x65\x5c\x5a\xd1\x5f\x4b\x28\x83\xf6\xb7\xb5\xed\xf7\x87\xac\xac\xcf\x82\x6f\xea\x34\xd3\x6e\x1d\xfa\xd9\x59\x37\x99\x77\x5d\x47\x3e\x5d\x47\x3e\xf6\xfc\xd3\x49\xb3\x09\x9b\x41\xf8\x5e\x9b\xb6\xc8\xd0\x75\x2f\x4b\xad\x13\x2a\xbf\xb1\xbd\xb3\xe1\x1b\xf3\xe7\x28\x6c\x8f\xab\xbe\xa1\xbb\x8b\x2c\xeb\xf0\xa3\xe5\x81\x75\xbf\xe4\xc8\x93\xe0\xd0\xac\xf0\x7c\x62\xa6\xdd\x4f\x69\x25\xcd\x3b\x55\xf8\x5d\xd3\xad\x92\x2a\x6b\xfa\xd9\x13\x2f\x8f\xec\x4d\x25\x95\xb6\x6d\x4d\x6b\x81\xeb\x7d\x53\xad\x55\x6a\x98\xb3\x3a\xc9\xd7\xbe\x8f\x8a\x5e\xd9\x0d\xa5\x4a\xf5\x49\x6f\x88\xdd\x1e\xcf\xec\x99\xa5\xa9\xca\xc1\x3a\xc1\x57\x2b\x49\x15\x02\xaa\xc6\x2c\x41\x08\xe5\xed\xe8\x2d\xda\x21\xc3\x4d\x85\x1c\xa3\xc5\x4b\xb3\x31\x23\xaa\xdd\xf5\x17\x48\x56\x5c\x2e\x72\x42\xf9\x24\x98\xa8\xcb\x08\xb3\xc8\xee\x8d\x33\x8d\xa5\x80\xfe\x4f\x95\xc8\xde\x79\xf3\xbe\x87\x44\x9c\x50\xb3\x60\xc1\x64\xda\xee\xb4\x6b\x9e\x60\x38\xc9\xfd\x6c\x27\xef\x9f\x53\xbe\x13\x45\xee\x63\xfc\xb3\xf1\xf1\xd4\xf6\xda\x90\xbf\xb5\x6f\xf7\x5b\x3e\xac\x2f\xb3\xb0\x71\x22\xc2\x87\x75\xb9\xed\x96\x0f\x7e\xf7\xb7\xd2\x87\x3e\xd7\x7f\xe3\xd7\x98\x34\xe3\x18\x63\x99\x06\x1a\xe3\xd5\x6a\x78\x6c\x61\xa4\x6c\x60\x7f\x3b\xf4\x36\xfc\x9c\x13\x5d\x83\x01\x93\xe9\x28\x64\xe8\x7c\x55\x34\xf0\xd8\x6d\x51\x1c\x5d\xd2\x49\x57\x1c\xa9\xc5\xfd\xce\xc7\x98\x67\xd6\x46\xae\x6b\x1f\x09\xb4\x86\xb9\x0d\xf8\x4b\xcd\x89\x47\x93\x54\x5b\x9e\x0f\x50\x85\x3c\xb7\x9d\xf7\xaf\xc0\x54\xdb\xc7\x71\xa7\x1d\xb7\xab\xae\x91\xd4\x58\x2e\x17\x30\x7f\x99\x7d\x02\x54\x73\x81\x19\x5b\x09\xf7\x5a\x2a\xd7\xc4\x6e\x85\x51\xe5\x08\xd9\x17\xad\xc9\xc8\xe8\xa0\x28\x9e\x6d\x08\x23\xec\x2d\x4c\x7a\xa7\xd8\x46\x6f\x68\xa8\xdf\xc4\x53\x87\x64\x25\x8a\x71\x7c\x94\x03\x26\xbf\xe8\xfe\x9b\xad\x82\xf1\x6f\x5e\x91\x74\xec\x94\xfa\x21\x6f\x27\x00\x5e\xf4\x49\x55\x38\x12\x1a\x73\xe2\x1b\xc5\x21\xd5\x1b\x7b\xb4\xfa\xf3\xd8\xc3\xb9\x8b\x02\x66\xf9\x9c\x3d\x7d\x62\x19\x4c\xba\x98\xc3\x8d\xaf\xdf\x0d\x60\x7a\xc1\xbc\x24\x54\xd4\x70\x1a\xa1\x93\x03\x54\xcb\x7e\x86\x29\x61\x2d\x4d\xfb\x03\xb7\x8c\x98\xc7\x92\xdb\x8a\x35\x3c\xf8\x7b\x11\xf2\x5f\xff\xd4\xcf\x9f\x07\x7a\x12\x49\xc7\x42\x18\x04\x89\x82\x1f\x8b\x2b\x3b\xd1\xe0\x2e\xe1\xd0\xee\x6a\x6b\x05\xdc\x12\x75\x4f\x9a\x6d\xf4\xc2\xc2\x2b\xc2\xac\x3e\x71\x5e\x99\x9e\x48\x54\xe6\x58\x57\xf7\xb2\x0b\xfc\x1b\x8d\x9c\x24\x0b\xe7\x7a\xab\x63\x8b\x80\x9b\x47\xfa\xde\x4d\x6d\x27\x7f\x88\xb4\x01\x35\x33\xcf\xd2\xb2\x97\x10\xf8\xc4\xf4\x25\xa7\x40\x34\xc0\x34\x93\x3f\x11\x68\x0e\x7a\x67\xdf\xc9\xf9\xb8\x65\x26\x7d\x5c\xb1\x22\x56\x3a\x41\xd8\xc4\x24\xc6\xbc\xf4\x62\x05\xb6\xcf\x10\x71\xad\x66\xf8\x7b\xc9\x31\xd3\xf6\x62\x60\xd7\x1d\x87\xfb\xb0\x1b\x2b\xd5\x18\xf0\xf5\x7d\x93\x27\xae\xd0\xbd\x91\x68\x4c\xc1\x26\x4a\xf9\x12\xab\x8c\xa9\x4c\xd5\xd1\xa0\x8c\x7c\xf4\x08\xd6\xb0\x31\xec\x9d\xf0\x56\xb4\xf7\x1e\x8a\x69\xc3\xda\xa5\xe3\x62\x55\x99\xec\x73\x99\xdc\x8f\x96\xb3\x03\xde\xe6\x27\xe3\x2b\x1c\x48\xcf\xae\xc2\xdb\x8a\xe4\x39\x88\xfc\x54\x19\xc2\xf0\xaa\xec\x39\x57\x9b\x79\x1e\xec\x41\x92\x20\xd5\x79\x4e\x7e\x7e\xd4\x7c\x1c\x03\x08\x5d\xb0\x9e\x11\xd2\xde\xfe\xfe\x2c\xb8\x4f\x04\xe7\x68\xb3\xd3\x61\x95\x57\x79\xd2\x1d\x9f\xe6\x68\x10\xf9\x26\xc3\x7f\x74\x75\x24\x86\x09\xbf\xf1\xbb\x55\xba\xcf\xd3\xdf\x2f\xe3\x90\x7c\x74\x50\xe6\xd4\x8e\xea\xce\x26\x1d\xdd\x11\x0b\x38\xb5\x06\x85\x08\x73\x1b\x45\xf2\xec\xa9\x62\x62\xf0\x1d\x20\xa0\xeb\x69\x8c\x46\x3c\x21\x37\x88\x33\x60\xb9\x01\xb9\xea\x66\xdd\x43\x90\xbc\xfb\x67\x28\x91\x27\xb5\x4d\x06\x92\xbf\x1f\x35\x86\x9f\x71\x2f\xad\xfb\xf4\x26\x7e\x3d\xda\xc8\xd1\x99\x44\xf4\xc4\xa9\xb4\xf2\xa9\xee\x54\x1d\x58\xe1\x99\x1b\x5f\xcc\x9d\xfc\x9a\x39\x8b\x2d\xc2\x87\xf0\xd3\xd5\x45\xea\xf4\x64\xe3\x10\xb1\x27\x8a\x5d\x47\xee\x21\x52\x62\x67\x95\xec\x89\xf7\x0f\x90\x0f\xff\x4a\x6b\xd6\x8b\x3c\x4b\x5f\xff\x71\x4b\xdd\xf3\xd7\xda\xea\xa0\x35\x67\x4d\x29\xdc\x30\x56\x27\x8e\xa5\xb4\x4d\x45\x6f\x2e\x21\xe9\xe5\xad\x93\xa0\xef\x2c\xc3\xab\x0a\x57\x3d\x0e\xac\x31\xa9\xb6\xc7\xe5\xac\xea\xd7\x54\x9b\x3d\xc0\x82\x72\xf9\x76\x35\xd8\x86\x43\x13\x96\x3a\x78\xa5\x74\xdb\x4c\x07\xb1\xe2\xa7\x87\xdf\x09\xa9\xac\xb7\x81\x6d\xad\x4c\x75\x09\xfd\x52\x6f\x0f\x93\xc3\xe5\x0a\xb7\x54\xcd\x41\xad\x9c\x66\x7d\xb8\x9b\xf9\xc5\x44\xc2\xb6\xd4\x55\x9e\x85\x3f\x55\xb3\xad\x89\x11\x48\x02\xa2\x92\x80\x45\x2d\x43\x81\x35\xfa\x7e\x2f\xa3\xd8\xea\x9e\x7e\xb6\x1a\x1b\x05\xdd\x07\xcc\x0d\x9e\x32\x12\xfb\x67\x9e\x04\x61\x1b\xe0\xea\xfc\x68\x08\x09\xea\xf9\xb0\x35\x67\x53\x29\x9c\x60\xd9\x62\x8f\xa4\x76\x02\xdc\x54\xe3\xe9\xe7\x50\x56\x75\xff\xb7\xf0\x5d\x7e\x10\x1d\xfc\xe1\xe7\xe6\x0e\xf4\x1d\x27\x6b\x5f\xce\xdd\x14\x80\x7e\xb3\x44\x35\xd6\x30\x61\xb7\x16\x47\x61\x38\xd3\xef\xb7\xbb\xa2\x50\xaf\x14\x17\xc0\x55\xad\x45\xf4\x31\x94\x02\x42\x62\xd8\x08\x2b\x28\x85\x43\xae\x5d\x72\xb4\xd0\x86\x1f\x06\xab\x2d\x68\xc3\xed\x70\x5e\x78\xba\x86\xe6\xa6\xea\xb4\x59\x61\x4f\xdb\x67\x9e\xdc\xcb\xe0\x1d\x6b\xc2\x3d\x9e\xd7\x57\xfe\xec\x79\xe5\xea\xb3\x0e\xab\xfc\x62\xaf\xf0\x66\x11\x33\x65\x1d\x80\x8f\xb9\xb3\xae\x60\xd1\x20\x43\x01\x91\xac\x59\x43\x7f\x93\xe9\xd9\xde\xa8\xa1\xae\x5f\x08\x5a\x0a\x9a\xe3\x8d\x17\xb6\x4c\x7f\xb2\xb0\x27\xff\x34\xc4\xae\xed\x45\x19\xbb\xd7\xe3\xe2\xec\xbf\x06\x29\x9e\xe3\xaa\x7f\xca\xb7\xdf\x2c\x5c\x76\x35\x1b\xf0\x2b\xc5\x6b\xd2\xf4\x3f\x25\xc5\xce\x9d\x2f\xe1\xb7\xdf\xc6\xfe\x1a\x60\x49\xc6\x72\x5a\xef\xfe\x6e\xbb\x33\xfb\xe5\x76\xec\x35\x4f\xbb\x6e\x01\x0e\x97\x73\xad\xa2\x92\x15\xf2\xbb\x3d\x8f\x0c\x6c\xc5\xb4\x81\x77\xe3\x30\x71\xfe\x2d\x33\xa6\x7a\xd3\xc2\xfe\x33\x4f\xc4\x29\xfb\x4d\x01\x96\x3e\x68\xe2\x8c\x55\xe2\x43\xf1\xdc\xfa\xca\x84\x5a\x9f\xb7\xa8\x06\xea\x59\x9d\x30\xdf\x2c\x12\x64\xea\xb1\x7e\xcc\x3f\x14\xf9\x90\x6f\x02\xc0\xda\x33\x49\xec\x79\xb9\xf3\x0a\xdb\xdf\x07\xae\x88\x11\x1a\x22\xa5\x02\xed\x2f\x7a\x28\x1f\xb2\x23\x03\x82\x09\x6d\xf3\x16\x72\x30\x31\xc4\x9c\x3c\xb3\xcc\xf0\x0b\x4d\xf9\x6f\x15\xd2\x6f\x91\x0d\xf1\xcd\xba\x32\x92\x81\xe6\x20\x42\x43\xa4\x16\xa9\xb3\x82\x0f\x3c\xc0\x9a\x08\x65\xf6\x13\x57\x38\x43\x7c\xb3\xee\x69\x4c\xd6\x1e\x34\x66\xf8\x09\x40\x3d\xa2\xa3\xdc\x8f\x91\x7e\xe1\x8d\xed\xac\x0a\xb5\xce\x1d\x05\x87\x73\x6f\xfc\x25\xf4\xa2\xc5\x99\x99\xad\xac\x92\xf8\x57\x63\x00\x86\x19\xe5\x2a\xfa\x57\x40\xf1\xad\xfd\x5f\x31\xc4\x77\x9b\x9e\x60\x82\xff\xd8\x36\x07\x65\x3c\x36\xb1\x79\xcd\xaf\xd1\xe1\x53\xa1\x5c\x9e\xb8\xbd\x76\x01\x36\x86\x24\xfd\x83\x09\x7a\x4e\xd8\xdf\xe6\xa6\x2d\x38\x81\x3b\xae\x83\x1b\x90\xc1\x61\x40\x97\x4e\x94\x73\x0c\x9e\x0f\xb0\x80\xf6\x68\x83\xc1\x1e\x9d\xf9\xab\x7f\xe9\xe6\x2a\x90\xcd\x90\xba\xd5\xad\x5d\x10\xa8\x5e\x6b\x10\xa4\x6d\xa7\x69\x62\x00\x5f\xd3\xbd\xbd\xfa\x2b\xf7\x0a\xb0\xea\x5b\xcd\xb7\x75\xf1\x1e\xf8\x46\xa4\x45\x5b\x88\x86\x03\xf5\x23\x04\x6c\x57\x72\x7f\x17\x80\xb1\x28\x41\xd3\xb9\xce\x7d\x3e\xf6\xbc\x57\x49\x1c\x26\x2b\x13\xcd\x7c\x59\xe8\x82\x51\xf7\x80\xcf\x4f\xa6\x59\x4b\x89\xa2\xeb\x24\x84\xc2\xfa\xde\xd8\x79\x8f\x80\x0d\x59\x36\x3f\xce\x72\xae\xe0\x5f\xd9\xeb\x94\x3f\x17\x42\x9b\xfc\xe1\x5f\xfb\x31\xcf\x46\xef\x88\x51\x8e\x69\x7d\x9e\xd7\x29\x1e\xd1\xa2\x20\x9c\x01\x70\xba\xc5\xcf\xf7\xff\xf6\x39\x91\x78\xce\x70\xa4\xf8\x53\xee\x6c\x02\xca\xba\xc5\xe7\xde\x31\x2e\xc9\x35\xee\xe1\xcd\x59\x13\xcc\x79\x8b\xef\x50\xd7\xe7\x3a\xcd\x5b\x19\x6a\xf5\x76\x7a\x7f\x27\xff\x77\xbb\x58\x7b\xe4\x08\x53\xf3\x54\xd5\xc0\xe0\x51\x89\xf1\x00\x65\x35\x73\xee\xcd\xfe\x28\xfe\x4a\x89\x1a\x18\xc9\x06\x54\xdb\x42\x81\x76\xf6\xf9\x2e\x50\x4d\x70\x3c\xce\x7f\x14\x5d\x91\x7e\x5b\xc1\x57\x95\x1a\x14\x54\xd3\x54\xce\xeb\x93\x8f\x67\x69\xe5\x06\x79\xbe\x19\x03\xfc\xaf\x9d\xff\x7f\xdd\x1c\xe7\x91\x05\x9c\x4d\x99\x9f\x88\x47\xb3\xd5\x96\x4b\x02\xaf\x6e\xab\x29\xa7\x1f\xe5\x74\x7e\xf7\x48\x53\xa5\x79\xfd\x8c\x72\x12\x69\xa4\xa1\x01\xfb\x42\x6f\xa3\xe9\xa4\xff\xfd\x4a\xa0\x3b\x5c\xf2\x07\x8e\x8e\x25\x75\xe7\xa1\x31\xc3\xbf\x74\xf6\x9c\xd0\x1b\x0e\x8a\x23\xa8\x9b\x9a\xa7\x02\xcd\x65\xd3\xff\x7b\xd9\x7b\x7f\x07\x4f\x55\x18\x21\xa5\x6a\xf5\xff\x8f\x0d\xf9\x88\x34\xa3\xa1\x82\xb4\x9e\xfc\x13\x20\x3c\x89\x40\x45\xb5\x82\x45\x4d\x28\xcb\x7f\xec\x6f\xbc\x04\x3e\x9c\xd2\xc5\xa2\x35\x29\x22\xb8\xe3\xb2\xc5\x16\xb2\xfa\x27\x2c\xb5\x7c\x24\x68\xf8\x5a\x8d\x8b\xae\xfa\x57\x9d\xf0\xfc\x51\xd4\xe6\xe6\x6c\xfc\x75\xe9\xea\x8b\xe3\x1e\xa2\xe0\x75\x43\xa6\xf8\x52\xdd\xc0\xbc\x3f\xce\xc9\xb2\x94\xc8\x0b\x9f\x12\x07\xb5\xfb\xb5\x48\x8d\x70\x6e\x8d\x0e\x3e\x9d\x92\x57\x7f\x95\x01\xfd\xf0\x0b\x7a\x18\xe9\x6b\xa2\x54\x6b\x6e\xc1\xc6\xb0\x77\xb7\x85\x6e\xf7\x6b\xb9\xd5\xd8\x26\xdd\xd1\x8c\x77\xe3\xce\xf3\x5d\x03\x04\x40\x2d\xde\x3e\x3e\xd8\x21\xcc\xee\x94\x12\xee\x15\xf6\x44\xbb\x3c\x5d\x60\xd7\xe3\x4c\xfd\xc3\xe2\x35\x0e\xd3\xeb\xe5\x02\x03\x8e\x13\xc9\x7e\x02\x0d\xee\xb2\xe9\xb2\xe9\x6f\x3f\xe1\x1d\xfe\x2d\xbe\x6c\xef\xc8\xa8\xc1\xa0\x01\x55\x21\xe0\x46\xc3\xab\x3a\xdc\x21\xec\xa0\x09\xd1\x7e\x1c\xcd\x6a\xa8\x67\xd1\xaf\x0c\xb6\xf0\x08\xf4\xd4\xbb\x6f\x23\x29\x0e\x06\x31\xbc\xea\x14\x15\xc0\x69\xaa\xea\xa8\x1a\x40\x51\x0d\xa5\xb4\x94\x97\x61\xc0\x8d\x16\xff\x7d\xef\x31\x39\xfa\xe6\x75\x1b\x0f\x12\x32\xbc\x32\x64\x55\xcc\x92\x2b\x87\xaa\x1e\x4e\xac\x81\xe5\x71\x4e\x79\xfc\x40\xee\x17\x6e\xe5\x78\xec\xc4\xfe\xf8\x7d\xef\x00\x55\x7b\x99\x8b\x33\x78\xec\x95\x87\x3f\x0e\x1f\xf5\xec\xab\x97\xb7\x5a\x96\x34\x1d\x0c\xef\x7a\xd2\x33\x26\x57\x0b\x9a\x91\xed\xa7\xd1\xc4\xe6\xc1\x87\xfc\x0c\x17\xf0\x41\xa8\x4f\xa8\x85\xa6\x79\x65\xcf\xe5\x45\x9f\x8d\xdf\x3e\x42\x71\x07\x0a\x1e\x90\x2e\x26\x7d\x6f\x31\x2b\x76\x4f\x2f\xa5\x59\x0c\xde\x2a\x5f\x7f\xcf\x0f\x03\x3c\xd7\x5a\x75\x86\xf5\x45\xc1\x50\x01\x86\x7b\xbe\xc3\x88\x27\xa5\x93\xef\x9a\x8c\x15\xd7\x43\xe4\x4e\xda\x47\x31\xa2\xed\xe0\x56\x84\x99\xe3\xd3\xcf\x10\xe7\x43\xb8\x29\xc3\xa3\xd4\x0b\x8b\x19\x2d\xb0\x46\xd8\x12\x57\x3d\x49\xf3\x3b\x6a\x60\x97\x7d\xbe\xd2\x34\xa2\xbe\x2b\x71\xd8\x93\xf4\x6c\x99\xc5\xb9\x24\xb7\x89\x72\xb4\x1a\xd4\x23\x2a\x22\x5e\x71\x83\x62\x22\x62\x6a\xaf\xb9\xb4\x0f\x83\x22\x8f\xd4\xa3\xe1\x70\x11\x10\xfb\x79\x4d\xa9\xbf\xbd\x59\xff\xec\x5a\x30\x19\x36\xb9\x9f\x3b\x20\x60\x6b\x30\xf9\x4a\x04\xd2\x4b\xfc\x84\xbf\xdd\x05\x0e\x45\x06\xe9\xfd\x07\x68\x09\x77\xcc\x31\x74\x61\x6a\xf3\xa6\x32\xe6\xa8\x66\xa0\x00\xad\x71\x5a\xb2\x70\x68\x04\x34\xbb\x4a\x2d\x7a\x9f\x84\x37\xec\xe2\xe8\x0b\xa6\x43\x21\x49\x40\x0d\xb8\x88\xbb\x1f\x57\xa3\x75\xdf\xab\x19\xd8\x71\x9f\x98\x5e\xc4\x85\xbd\xb3\x8b\xdd\xfa\x73\x33\xd4\xe8\x2f\x35\xab\x90\xe5\x1c\x2b\x28\xe1\x08\x39\x33\x5b\xff\xa3\xa3\x5d\xa5\xee\x1c\x08\x4f\xb3\x4a\xda\x3a\xac\xcf\x18\x24\xcd\x7f\xf1\x4d\xff\xc2\xdc\x72\x2e\xd0\x18\x69\x1d\x76\x0e\x9f\x6a\x6b\xc3\xb9\xcf\xfc\xc7\xb5\x5d\x67\xd4\x27\x72\x1a\xa9\x06\xcc\xcf\x69\xb6\x6a\x0d\xc0\xd9\x62\xaf\xca\xb8\x12\xbe\x45\x85\x38\x7b\xf4\x63\x81\xe2\x06\xcb\xab\xc0\x9e\x1f\xb0\xe6\x96\x14\x5b\x43\xd5\x58\x11\xba\xac\x1d\x36\x06\x3f\x07\xaa\x4d\x3e\x20\x22\x6a\xc1\xd1\xd7\xcd\x1e\x0b\xd8\xb0\xf6\xb4\xe0\x24\xc3\x9a\x0a\xe0\x5c\x27\xf5\x1b\x87\x9f\x48\x40\x51\x4f\x8f\xb7\x5a\x9e\xf4\x99\x4f\xde\xbd\x1c\x12\x7c\xfc\x88\x4d\xa7\x7b\x5c\x96\x4f\x0e\x88\x87\x8a\xff\xbe\x74\x37\x58\xd3\x57\xda\x71\x51\x2a\xfd\x32\x40\x0f\xfc\x1e\xe6\xb0\xb1\x97\x11\xc1\x5a\x61\x58\xc0\x51\x0e\xdf\x13\x72\x04\x26\x8b\xf4\x05\x3a\x2e\xe9\xbf\x9f\xa4\xd2\x83\x18\xd2\xc0\xc3\x53\xc4\xe1\x50\x89\xc9\xac\x86\x4e\x62\xa1\x13\x42\x5a\xa5\x34\x79\x74\xe4\xb5\x96\xfd\xfe\xef\x0a\x27\xd9\x5f\x39\xcb\x88\xe5\xf0\xb3\xea\x86\x6e\x2a\x67\x46\x2d\x1f\xc6\x5b\x38\x9a\x15\x98\x33\x78\xc8\x72\xbe\xdb\xfa\x2f\x3f\x0d\xdd\xc2\xf6\xff\x0d\x21\x7c\x5a\x24\xbf\x21\x10\x73\xd5\x5f\xed\xf8\x0c\xd2\x60\x9d\x28\x98\xdc\x04\x96\xd9\xb7\xc6\xdc\x12\x88\xad\xa5\xb9\x59\x3b\xe7\x47\x47\x58\xee\x6f\x03\x4a\xca\x60\xb9\xb6\x9e\x5a\x98\xda\x30\xd1\x07\x8a\xef\xa7\xab\xe3\xf3\xb1\x5d\x94\x35\x1d\xa6\x4f\xbc\xda\x1a\x91\xda\x3f\xd3\xa7\x1e\x24\xaf\x3c\xe5\x6d\xb6\x69\xfd\xac\xcf\x2a\x19\x33\xd6\x6b\xc0\xe6\x2e\xc3\xf9\xb2\xb9\x91\x1b\x57\xdd\x3c\xd0\x53\x3e\xed\x94\x3f\x8c\xe8\x85\x6e\x09\x73\xdf\xaf\x33\x5e\xe5\x48\x32\xb8\x24\xfd\x4f\xe7\x54\x95\x2f\xae\x17\xc7\x0b\xb2\xd4\xd3\xd4\xeb\x3b\x06\x55\x7a\xb5\x7a\xa5\x6c\x7a\x97\x82\x2a\x1b\xd2\x9b\x39\x1c\xe4\xce\xe4\x5f\xc1\x03\x03\xee\x03\xd0\xbf\xd4\x4f\x11\x93\xf8\x09\xce\xfa\x88\xfa\x05\x64\x6a\xbf\xd8\x65\x76\xe6\x94\x0a\x4d\x19\xb4\x1b\x1a\x2c\xa5\xca\x13\x32\x12\x88\x9f\xe4\xde\x82\x71\x31\x47\x1c\xfe\x71\xab\xc7\x13\xba\xa7\x9f\x8b\x2e\x95\x0d\x3a\xa3\x1a\x3f\x17\xba\x17\xba\x4f\xe2\x3c\x1a\x9e\xfc\xd2\x2e\x76\x91\xa7\x66\x85\xd8\xee\xd8\x0a\x81\x1e\xaa\x57\x14\x6e\x9f\x3e\xd6\xb9\x83\x3f\x82\x3f\x4a\xb5\xb2\xcf\x2f\xc7\x7a\x03\x0b\x81\x93\x37\x55\x07\x8d\xd6\xc6\x65\xd5\x22\x06\x51\x14\x45\xe3\x76\xf2\x0f\xea\xf5\x2a\xce\xc5\x9f\x2b\x79\x2f\x20\x2d\x0a\xd7\x12\x19\x98\xd4\x07\xd7\xfa\x0c\x94\x77\x55\xaa\x4c\xcf\x51\x3d\xf8\xe6\xba\xa9\x71\x8e\x9f\x1f\x86\x87\xe6\x5b\x51\x8a\x01\x1d\xb4\x0e\x8b\x08\xba\xf5\xd8\x4d\x5b\x30\x61\x72\x1c\x1c\xff\x96\xb9\xdf\x5c\x74\x93\x72\xff\x9a\xff\x66\x88\x72\xc4\x69\x7a\x51\x55\x6c\x04\x1d\x34\x79\x53\x87\x5c\x25\x78\x4b\xa3\xd4\xdd\x41\xd8\xee\x1f\x4b\xd4\xaf\x2b\xff\x61\xa2\x00\xd8\xf2\xc6\x66\x7f\x6c\xea\x9b\x9f\x51\xb8\xaa\x6f\xb2\xac\xcb\x6e\xac\xe3\x7c\x39\x7c\x1e\xcc\xc2\x34\x09\xd3\xb8\x37\x90\x5d\xba\xa8\x73\x8f\xc0\x87\x1b\x70\xd6\xe3\x23\x19\x40\x9d\xd1\x6b\x8d\x9a\xde\xa2\xd2\x54\x90\x31\xdf\xcf\x95\xfb\xef\x3a\x34\x27\xd6\x27\xa7\xc1\x9e\xde\x4f\xe8\xda\xfe\x58\x98\x98\xca\x19\x05\xdc\x18\xf2\xfd\xa9\xab\xc5\x30\xd6\x07\x12\x90\x89\x18\xe5\x41\xf3\x6f\x78\x92\x14\xd2\x56\x37\x3a\x7b\x85\xd7\x1f\x91\x83\x1b\x36\x35\x1b\xea\xf4\x9c\x85\x5d\x5b\x50\x22\x7c\xaa\xe0\x77\x8c\x3c\x17\x2b\x57\xbb\x41\x65\xda\xb4\x25\xe4\x59\x5e\x99\x64\x49\x37\xd7\x21\x3b\x49\xef\x3a\xb4\xe1\x5f\x68\xec\x85\xc5\x63\x0d\x39\x6a\xd7\x95\xaa\x3e\x47\x5c\xf4\xd4\x5a\x3a\x17\x26\x9d\xdb\x6c\x55\xdb\xc8\x2f\xec\x07\x71\x71\x3c\x9e\x0d\x81\x6f\xbb\x6f\xef\x7c\x97\x06\xea\x48\x8f\xd9\xc8\xfe\x2f\x2d\x7a\xed\x59\x2d\x76\x35\x11\xff\x13\xa4\x37\x02\x45\xd6\xc2\x1e\x16\x06\xd0\x38\x94\x4e\xa5\x64\x20\xea\x9d\x05\xc0\x15\xb1\x52\xe1\xce\x72\x3b\x07\x74\x04\x43\x7c\x33\x32\xf8\x91\xff\x81\x93\x8d\x82\x40\x07\x54\x89\xc7\x6d\x5e\x8c\x1e\x46\xe9\xbb\x14\x6d\x1a\xeb\x1f\x28\xff\x79\x3a\x6b\xe9\xd9\x28\xa0\x03\xb4\xd3\x2c\x56\x6f\x07\x21\x7e\xff\x9e\xda\xfb\xfd\xbf\xa8\xe4\xc8\x8a\xec\x7f\x2d\x2a\x2b\x2b\x02\x3f\x1d\x61\x9b\xf7\xff\x87\xa5\x77\xb9\x9d\xd6\xaf\xcf\xd6\x11\x3f\x83\xe5\x12\xbd\x9d\xda\x45\xcc\xa1\x5e\x80\xf3\x3c\xd5\x10\x4b\xf3\xd0\x73\x62\xc0\x79\xde\x84\x9e\xcc\x7f\x93\x02\xc2\xff\xc9\x0d\x08\x77\xb6\x91\x64\x95\xf4\xda\x48\x78\x44\xdf\x2f\x37\xcb\x02\x46\xc2\x96\xa6\x88\x42\x3d\x81\x18\xe8\x5d\x1d\x6c\x53\xe4\x39\xdf\x06\xc7\x77\x6d\x05\x84\xce\x25\xdd\x64\x01\xa3\x9d\xcf\x64\x50\x5e\x9c\x1d\x39\xd7\xb1\xc1\xc8\x26\x40\x27\xe2\x91\xa7\x3e\xa1\x10\x59\x91\xc0\x4e\xce\x0e\xe6\xdd\xf2\xc8\xf3\x11\x48\xa1\x96\x98\x99\xb5\xff\x1d\xe9\xf2\xc3\x50\x01\xe1\x4e\x37\x9a\xad\xe2\x45\xa6\xdc\xfb\xe5\xe6\xf3\x14\x77\xb0\xfa\x2b\xda\xd1\x4f\xc0\x54\x74\x4a\xe2\x5e\x0b\xf6\x63\x54\xca\xfa\xaa\x07\x3a\x08\x76\xcf\x3f\xeb\x81\x07\x67\x20\xeb\x34\x12\x0b\x64\x9e\xca\x36\x73\x4c\x3b\x0f\xf6\x3e\x90\x37\xf1\x2f\xe9\x1e\x5a\x2c\x24\x99\x0a\xed\x1b\x03\x76\x69\xa4\xa8\xf2\xf2\x86\x24\xe5\xde\xbb\x17\xf5\x4d\xc1\xff\x9e\x88\x0a\x50\x6b\x20\xda\xb1\x63\xaa\x66\x61\xfa\x89\xc0\xa1\x44\x9e\x13\xcd\x9a\x46\xbf\xc4\x59\x06\x55\x03\x3d\x20\xd7\xb1\x38\xf7\xcd\x7e\x1a\x7a\x6e\x1b\x0c\x00\x68\xaa\x3a\xe6\xd0\xcb\x3e\xc1\x60\x36\xb6\x85\x02\x05\x21\x59\x18\xad\x70\x97\x4a\xf3\xdd\x2a\xf5\xc2\xd3\x21\xba\x22\x39\x13\x8e\xf9\x35\x16\x00\xaf\x53\x9d\x22\xfb\x5a\xa1\x9d\x42\x22\x63\xa2\x0f\x59\x96\x8b\x9d\x06\x0f\xfe\x5c\xfd\xa3\x1a\xd1\xfa\xdc\xa0\x03\x8d\x3f\x5a\xa1\xfa\xa2\x7e\xb8\xde\x3f\x07\xa0\xc8\xcf\x4f\x6a\xaf\x91\x11\xe1\x74\x67\x96\x33\xb3\x80\x9d\xe2\xc6\xb2\x7c\x2b\x09\x15\x55\x1f\xc8\xd9\xda\x32\xf6\x63\x6b\x51\x41\xd1\x37\xeb\x10\xf3\x22\xf3\xef\x87\xdd\xc7\x35\x75\xfd\xd2\x0b\xe1\xd1\x9d\xbc\xf7\x6f\x21\xfe\x44\x52\xab\x98\x8f\x82\xdf\xc1\xf7\x7e\x45\xb3\x3d\x05\xe8\xc4\x83\x05\xdd\xcd\xdb\xb7\x50\xdd\x20\x83\xdd\x67\x5e\x79\x99\x03\x26\x1e\x86\xa6\xf5\xe4\x54\xc9\x08\xa0\x39\x28\xed\x86\x99\xb5\x7f\x02\x8d\xb3\x94\xd0\xdd\x95\x44\x32\x40\x14\x52\xec\xd6\x6d\xf0\x7e\x74\x0b\x5f\xb4\x11\x54\xac\x5b\x40\x2e\x78\x7b\xaf\xb4\x95\x6b\xd0\x0d\xd6\xa6\x48\x67\xe5\xe1\x4c\x7d\x9b\xf4\x7b\x6f\xc1\x46\x3d\x11\xd4\x9a\x59\x33\xf4\x0c\x7f\xd3\x41\xb7\x83\x4c\x81\x03\xf4\x03\x90\x1d\x11\xfe\xeb\x16\x93\x44\xa9\x4e\xda\xff\xf1\x8d\xff\x24\x16\x3f\xad\xcc\xff\xa4\x11\x9a\x83\x08\x13\xfe\xe3\x02\xe6\xe8\xac\x3d\x13\x44\xf6\xd6\x3d\xb6\x0f\xc3\x85\x5e\xdf\x43\x44\xc6\x98\x00\x35\x11\xca\x76\x74\xdf\x27\x30\x22\x91\x0d\xf1\x07\xd9\x6d\x4d\x5d\x83\x83\xdf\x02\xf5\x12\x59\x74\x77\x3c\x12\x5e\x3e\xfe\x53\x38\xf9\xd4\xad\x93\x7d\x9c\x0d\xd9\xce\xe2\xf9\x91\xcd\x86\x08\x50\xdb\xf5\x38\xf6\xee\xb9\xa7\xce\x57\x27\x4d\x92\x4b\x47\xa8\xf9\xf5\x40\x60\x30\x57\xe8\x5d\x39\x4e\x7d\xe0\x3f\xcd\x25\x60\xf5\x10\xe3\x73\x62\x31\x1b\x76\x40\x75\x5a\x82\x1d\xe6\x20\xa3\x9a\x71\x63\x41\xf6\x23\xe7\x93\x75\xd7\x9b\x7b\x9a\xfe\xd3\xb8\xf5\x46\x35\x75\xb7\xf6\x3b\x87\xf5\x37\xeb\xbd\xe7\x8d\x92\xdd\xd4\x6a\xbd\x2c\xad\xd7\xf3\xa3\xf5\xd7\x33\xf2\x6b\xcf\x80\xbc\x89\x62\x6f\x24\x90\xc6\x3d\x04\x9e\x49\x02\x40\x42\x7c\xa6\x93\xd4\x08\xdf\x63\xdf\x32\x7f\xff\xe8\x02\x40\x32\x74\xfa\x62\xca\x0e\x32\x42\x45\x2d\x8e\x55\x4b\xdd\x5e\x1e\x15\x78\x43\xa3\xd5\x90\x4b\xb9\x91\x6e\xef\x7c\x28\xa4\x6d\x72\x69\x38\xf7\x6d\x34\x63\x7d\x54\x95\x51\x73\xbf\x03\x5a\xc2\x33\x07\xcc\x6c\xfd\xaa\xbd\x01\x44\x9a\xd8\x55\xff\x06\x8b\xf5\x14\x27\x83\x4f\x04\xd1\x5c\x6c\xa1\xec\x7c\x7e\x1c\x65\x5d\xcb\x23\xb7\xad\xa9\xef\x7d\x75\x3a\x23\x6a\xf4\x29\x96\x3e\x60\x46\xce\xee\x9b\x2d\xfb\xda\xfa\x1a\xf0\xad\x35\xb2\xa2\xd5\x58\x4f\x92\xe2\xe2\xcc\xed\x5e\x56\xc1\xf4\x8f\x24\xa4\xe3\x6f\xa6\x02\x34\x04\xbf\x48\xee\xb3\xf7\x65\xe8\x60\x0e\xec\xa1\x2a\x47\xa6\xf3\x35\xfa\xd4\x8c\x37\x5b\xbd\x04\x3c\x43\x9b\x32\x9c\x4c\xb8\xef\x05\x9b\xdf\xad\xe7\xb2\xd1\xe9\x19\xf9\x36\x8b\x54\x3f\xfd\xfa\x3d\x89\x8c\x9c\x3f\xca\x73\xce\x3d\x0c\xbe\xdf\x45\xed\xb1\x3f\x2a\x98\x2b\xab\x21\x69\xf6\x22\x87\x23\x89\x15\x68\x55\xad\xe0\x06\x2c\xa7\xb9\xaa\x37\x52\xda\x85\xdf\xc0\xa0\xa4\x58\x11\xdf\x6d\x32\x6d\x7c\x98\x26\x88\xe5\x38\xe7\xe6\x68\x06\xf0\xe5\x5a\xc1\xcb\x58\xc6\x73\x6d\xfa\x45\x27\x2e\xcc\xef\x69\x66\xf8\xc6\x73\xd5\xb6\xe4\xbf\x77\x77\x4d\xfc\x8b\x3b\xc5\x07\x0a\xe6\xcb\x1e\xd8\xed\x12\xaa\x5c\xb4\xed\xcd\xf0\xc3\xe6\xc7\x3a\x20\x8f\x61\xf3\x44\xbb\x0d\xac\x5b\xa8\xe0\x25\xe2\x08\x08\xc5\xb8\x92\x0c\xbd\xfb\x8c\x35\xe7\x8c\x95\x0a\x5f\xfa\x4b\xad\x13\x7d\x71\x92\xb3\x0f\xa6\x93\xb6\xc4\x58\x47\x7a\xd3\xfc\xf3\x52\x93\xa9\xe2\x5b\xb8\xad\x8c\xc3\x72\x8a\x44\x94\xf8\xa4\xb6\x01\x56\x88\xa1\x19\xe9\x58\x7d\xc6\x5d\xd3\xed\x64\x0f\x25\x65\x58\xbd\x28\x0e\x3b\x45\x6d\x99\xe8\x3e\x13\x02\x19\xba\x30\x93\x97\x3a\x4d\xc9\xcc\xc8\xb3\x95\x65\xe9\xcd\xbb\xc8\xf8\xd0\xc5\xbb\x60\x2e\xd5\x3a\x29\xd9\xca\x85\xe7\x88\x3b\x27\x60\xe5\x02\x0f\xf3\x26\xce\xf3\xbf\x31\x46\x10\xa6\x31\x2f\x99\x6b\x28\x67\xf2\x27\x31\x0a\x53\x17\xfd\x80\xe0\xe1\xed\x68\x1b\x39\x38\x91\x54\x0b\x15\x58\x97\x42\xbe\x7b\x1f\x8d\x28\xa9\xd9\x55\xb2\x39\x65\x55\x82\x8a\x8f\x02\x21\xf9\xfd\x54\xaf\x67\xe0\x09\x33\xe4\x86\x7c\xce\xa8\xa8\xdd\xde\xbe\xd8\x86\xaf\xea\xa5\x50\xe3\xf4\xdc\x6c\xfa\x51\x41\x24\x32\x48\xd2\xbe\x1f\x27\x00\x05\x06\x4d\x97\x4b\x60\x90\xa3\x60\x39\x46\xe4\xf1\x34\xc0\xb7\xb8\xfd\xce\x6b\xe0\x3b\x63\xdf\x41\x3a\x6c\xee\x75\xa6\x5e\x9c\xc6\x84\xfe\xbe\x3b\x24\xed\xde\x49\x00\x41\x2c\x55\x11\xd5\x14\x1e\x05\x5f\xd9\x70\x13\xa6\xc6\x3c\x68\xb9\x63\x00\x1e\x90\xa7\x66\xc2\x21\x0a\x4d\x14\xb1\xc6\xf6\x68\x43\x0d\x6c\x59\xb8\x3b\x8e\xc5\xbc\x60\x79\x78\xb3\x83\x04\x67\xce\x9a\x2d\x9e\xb7\xbb\x3f\x4a\x2b\xf2\x59\x32\xf7\x5e\x27\x45\x66\x73\xd9\x15\xb5\xf1\x26\x0a\x09\xfb\xbc\x70\x7c\x9f\xe3\x14\x20\x31\xc8\x0f\x00\xa7\xf0\xb9\xe4\x5f\x9d\x32\x28\xae\xcc\xc2\xe8\x42\x35\x18\xef\x12\x67\x63\xb5\xa7\x71\xee\x97\x7d\x49\x2d\xfc\x93\xe1\x9c\x96\x1d\x8d\x9b\x96\xac\x99\x8f\x4b\x8c\xc6\x06\xa8\x99\xa5\xd4\x5c\x2a\xaf\x28\x1f\x24\x42\xa3\xb7\x81\xcf\x06\x54\x77\x92\xbc\xf0\x35\x9b\x98\xee\xa3\x30\xd1\x8c\x19\xa2\x2a\x1e\xcd\x3e\x2d\x6b\xb8\xcb\x63\xbb\xe5\x73\x5a\x94\x8a\xc0\x7b\x9b\x6f\x3b\x5d\x2b\xf6\x5a\xcb\x65\xb4\x21\x2b\xd3\xbb\x9f\xe2\xe6\x50\xe4\x00\x2d\xf4\xdf\x6c\x64\xc8\xbc\x37\x73\xa8\xed\x38\x30\x9c\x7a\x81\xe5\xf9\x52\xd1\x30\xda\x19\x85\x24\x7b\xe4\x4e\xf7\x99\x7a\x0e\x0c\x11\xbe\x9a\xec\x4b\xfa\xd0\x8e\x34\x39\x20\x17\x9b\x8d\xce\x96\x2b\x5c\x87\xd9\xdf\x33\x07\xa0\x3b\x89\x73\x38\x80\x7c\x24\x63\xf1\xb3\xec\xb3\x22\x0a\xd3\xab\xe3\xad\x44\xa1\x5e\x44\x91\xe9\x1b\x52\x42\xd5\xf9\x10\x7f\xe1\x67\x98\x13\x43\x1c\x0d\x78\xe1\x77\x53\xe1\xc0\x83\x3b\x0a\xec\xb7\x53\x4e\x5c\xe3\xd4\x61\x4a\xe0\x89\x3b\x92\x71\x7b\x92\xfb\x13\xec\x73\xfa\x5e\x93\xeb\xb5\x95\xbf\x2f\xe7\x86\xfc\xc0\x81\xc1\xdd\x30\x80\x52\x08\x4d\x4e\xf9\x99\xba\x89\x4a\xbd\x33\x34\xfe\xda\xe1\xf9\xcc\xb8\x3d\x4b\x41\x70\xe0\xc1\x21\x68\x7d\x6e\xb8\x67\x7f\xd2\x68\xeb\xa6\xc5\x61\x6b\x73\xa0\x7b\x9b\x99\xd4\xe2\x94\x61\x6a\x81\x5d\x1d\x44\xf1\x16\xd5\xd3\x5f\x2d\x60\x38\xfa\xc3\xb9\x54\xa0\x7a\xf1\xb3\xc9\xc9\xb4\x88\xc0\x64\x19\xf5\xe2\x21\xc1\xd7\x2a\xfe\xb7\xd3\x78\xac\x3f\x91\x29\x8b\x2e\x7f\xa6\x4b\x90\x3b\xdc\x3e\x8b\x8c\xa9\xa0\x0b\x8f\x67\x7c\xa8\x08\x3c\x98\x2a\xd9\x26\x8d\x66\x4d\xab\xbf\xf5\xfc\xab\xa0\xe4\xf7\x77\xcd\x55\x64\xbc\x9a\x94\xac\xad\xb0\xc5\xcc\xae\x4f\xa4\xef\x99\xf2\xc7\xd6\x87\xf8\x1a\x73\xa5\x66\xb0\x8b\x52\x0a\xa3\xf2\x46\x98\x3e\x97\x4f\xae\x4d\xd7\x03\x3c\x7d\x71\x46\x1d\xcb\x78\x19\x37\x09\xd9\xca\xbd\xe7\xc8\xc6\x95\x21\x88\x70\xa7\x08\xfd\x25\xb0\xc0\xfb\x88\x3b\x42\xde\xc0\x89\x3d\x6a\xd7\xb6\x6b\x4e\xfe\xee\x2b\x8f\x9f\x9f\x6e\x37\x86\xee\xeb\xc9\x5b\x8a\x4b\xb0\x3c\x40\x47\xf4\x04\x21\x96\x51\xaa\x98\x09\xfc\x43\xf2\x39\x6c\x7e\x24\xe5\xac\xd0\x23\x17\xe7\xde\x46\x88\x9a\x24\x94\x18\xdc\x25\x30\x25\xce\x7a\x52\xa9\x72\x8c\xc0\x6c\x8e\x05\x06\x98\xdb\x16\xe3\x08\xe5\x90\x82\x1d\x3b\x14\x8e\x6f\xcf\x70\x74\xf1\x14\xa8\x6d\x1f\xd0\xc0\x35\xd9\x2e\xae\x7d\xfe\xeb\x33\x28\x86\xeb\x0c\x3c\xec\x45\x52\x01\xe6\x3f\xb0\x21\xc7\x08\xcc\xaa\x89\xf2\x00\x9c\xb5\x19\x14\xc3\x4f\xf8\xe0\xcb\x46\x3a\x30\xe0\x1a\xfd\xf8\xf6\xac\xe7\x09\xfe\xe4\x37\xa4\x6d\x1e\xc5\x30\x8f\x1b\xac\x18\x3a\x83\xc2\xf4\xf2\x95\x07\xf8\x8d\x3c\x6f\xfe\x21\xe3\x91\xd3\xe2\x91\x9f\x09\x0c\xa8\x60\x3b\x41\x60\x56\x8b\x90\x07\x14\x9d\x1d\x23\x30\xf7\x81\xe5\x01\xd4\x52\xcf\x7d\xe1\xa9\x2f\x17\x4b\x3e\xc4\x20\xe3\x45\x10\x61\x6f\x13\x81\x56\xe5\x06\x6c\x0e\xc2\x77\xda\xd1\xc9\xb9\xc9\x61\xdc\xe5\x09\xd2\x65\x6e\xd2\xe0\x3f\x01\xbf\xc3\x98\x44\xb5\xc9\x3e\x83\x01\xe6\x82\x79\x38\x3d\x8f\x81\xae\x70\xa9\x2e\x29\xf2\x00\x0e\xe2\x13\x04\xc5\x74\xb1\x62\x71\xe6\x93\x99\x2b\xd7\x3e\x3a\x3d\x11\x6a\x40\x42\xa9\xab\xf0\xa5\x89\x2b\x5c\x4d\x4d\x21\x8e\x3e\xc1\xe2\xc3\x35\x14\x97\x58\xd7\x12\x36\xb0\xe3\xca\x0e\x6a\x41\x60\x5d\x15\x63\xaa\x21\x35\x18\xcd\x02\x37\xfe\x34\x57\xff\x77\xb6\x45\x41\xa2\x96\x35\x9d\xd3\x2f\x32\xd1\xfd\x65\xb5\xc9\x85\x8e\x3e\xc5\xba\xa8\x9b\x06\xf3\xcc\x4e\x4a\x56\x92\xb3\xc2\xa1\xd8\xd8\x65\xf0\x34\xbc\x11\x10\xca\x38\x8b\x02\xd4\x98\x47\x31\x6c\xa1\x01\x3b\xea\x4e\x9f\xfc\x86\x10\xcc\x95\x62\x83\xc6\x7d\x4f\x10\x1c\x03\x04\xc1\x80\x43\x94\x19\x14\xc3\x4b\x5d\x1e\xe0\x6f\xd3\xf9\xd2\x2e\xdf\xc2\xb5\xf2\x76\xc4\x51\xc0\xec\xc3\x1f\x97\x75\xc3\xce\x1c\xe6\x2c\x7c\x88\x1e\xd6\x65\x49\x8f\x28\x38\xb8\x84\x22\xe8\x6b\x1b\x98\xe8\x58\x09\xc8\x4a\xca\x36\x9a\xe3\x98\xf5\x78\x2f\x51\xbb\x15\x6c\xf4\xc4\xd1\xc2\xf6\xe3\x87\xbe\x1e\xad\x64\x89\x03\xe7\xa3\x6a\x8d\x05\x33\x19\x07\xd2\xe2\x0c\x8a\xa1\x3d\x21\xd8\x51\x77\xfe\xe4\xf7\x3e\x9f\x58\xb1\xb8\x6e\x3f\x39\xe6\x73\xcc\x31\x82\xe3\xc5\x4f\xb0\xe3\xc3\xcc\x80\xe8\xbb\x93\xb4\x17\x04\x46\x9b\x8e\x7c\x9f\x9d\x67\x31\xa7\x68\x18\x8f\x91\x6e\x7f\x89\x1e\xf8\x81\xd4\x97\x89\x4b\x53\x51\x52\x03\xf3\x4e\xe0\x47\xd0\x1f\xcb\x0f\x89\x67\x7b\x56\x3f\x64\xd5\x76\x40\x53\x5c\x0b\xb5\x70\xf0\xae\x2d\x3f\xbb\xeb\x85\x90\x49\xf9\x54\xff\xa5\xf2\x16\xf9\x7c\xe0\x5c\x29\x64\xb3\x69\xb0\xa9\xd9\x74\xe1\x3c\xfe\xbd\x7f\x59\x30\xff\x10\x03\x83\x75\xde\x7a\x5c\x94\x53\x8d\x0b\xb6\xa8\xe5\x94\xea\xf3\xfd\x74\x7e\xc9\x2b\xff\xd0\xf4\xc7\x43\x08\xf6\x6d\x21\x34\x28\xaf\xed\x78\x20\x08\x5a\x27\xf4\x80\x62\x7a\xfa\x0f\x8e\x4f\x11\xaf\x62\xae\xff\xa6\x6e\x9d\x35\x6c\x99\xff\x8a\xc0\xb3\xa0\xd8\x54\xd8\xe1\x16\xb7\x39\xea\xd1\xec\xc4\xf9\x67\x93\x88\x6f\xe5\x4c\x62\x39\xff\x96\x8d\x9f\x23\xec\xc9\x0f\xd6\x2c\xa6\x4d\x18\x54\xed\xde\x31\x78\x73\xde\x37\xdf\x49\x36\x35\xdc\x78\xeb\xc8\xff\x2e\x44\x7a\xbb\x45\xd1\x8c\xf0\xc9\xcd\xd8\x29\xea\x0e\xaf\xa5\x38\x78\x7d\xfc\x15\x5f\x38\xc8\xbb\xc5\x40\x9e\xb0\xb2\xce\xc2\x17\x03\xa4\x88\xdf\x09\x91\x71\x6c\xdf\xbd\x39\x5d\xf0\xb1\xa2\x65\xc4\x35\x59\x54\x52\x59\x83\xdb\x58\x2f\x6f\xb7\xfb\x3c\xa5\x2a\x26\x35\x05\xea\xd8\xf8\x4e\xa0\x6d\x8e\xd2\xc9\xe2\x1b\x6c\xa3\xa8\xe0\xf2\x78\xc0\x70\x6c\xa7\x61\x60\xab\xf6\x13\xc1\xe2\xb8\xe9\xb7\x23\xaa\x9f\x20\x20\xc0\x67\x37\xe3\x4f\xe6\xb1\x1d\x35\xdd\x26\x41\x88\x4c\x50\xb9\xd0\xf6\xcc\x05\xdc\x93\xb3\x00\x2c\xe9\x5f\x8c\x3c\x5a\x85\x06\x5f\x7e\xa3\x8a\x4c\x3b\x25\xe0\x9e\x9d\x1f\x69\xd6\xc3\xac\xfc\x53\x7d\xf6\x61\xe9\xab\xd4\x0e\x94\x2d\x69\x0e\x9e\xfc\x8f\x5a\x4e\xca\x45\xe3\x6c\xf2\xbc\xdb\x67\xb8\x90\xb3\x15\x7a\x42\xa4\x9c\x4e\x6d\x9f\x5e\x59\x26\x40\x54\xc3\x7d\x82\xf9\xbe\xf6\x01\x4f\xae\x60\x0f\x63\x24\x40\x5e\xcf\x89\x3c\x69\x58\x28\x00\x11\x89\xfe\x73\x73\xe0\x6c\x38\xee\x3f\x1d\xec\x28\x88\x3c\x60\xa5\x4c\xf6\x68\x4b\xee\x3d\x24\xcb\xa2\xf4\x01\x56\x22\x68\x34\x67\xe0\xa2\xbd\xeb\x2a\x7c\x84\x2d\x4e\xbd\x27\xbb\xb6\xc4\xf6\x18\xd1\xc6\xcf\x43\xd6\x4e\x16\x0b\x9d\x9c\xdb\x6e\x11\x0e\x04\x41\x74\xb9\x23\x8f\x07\x93\x4c\xc2\x30\xb4\x03\x59\x25\x1b\x4d\x03\x9d\x09\x08\x96\x82\x88\x1a\x34\x7f\xc6\x2d\x64\x74\xf2\x11\xf5\xac\xae\x06\xb7\x2d\x3f\xb0\x16\x87\x04\x4e\x73\x0b\xf2\xbc\x8a\x17\xab\xb9\xa7\xc9\xb7\xdc\xdf\xcf\xa1\x34\x53\x91\xf1\xec\x6a\x53\xbe\x3b\x46\x7d\x0f\x2d\xf8\x5d\xb0\x90\x32\xbd\xae\xed\x58\x83\x60\x51\xe2\x30\x31\x51\x58\xe5\x8a\x9c\x58\x95\x42\x0a\x5c\x16\x6c\x14\x26\x4b\x9a\xcf\xb9\x09\x57\x13\x8d\xfa\xbb\x74\xa0\xd2\x56\x0c\x31\xad\xd5\xbf\x9a\x90\x93\x78\xac\x01\x43\x10\x73\xa9\x62\x88\x6d\x65\xa8\x83\x36\x83\x66\xbd\xd0\xa5\x73\x70\xec\x65\xa0\xc7\xbf\xf7\x59\x8a\x71\xa2\xe7\x13\xe4\xa3\xb0\x3d\x65\x0b\x70\xec\x2f\x71\x5d\xfb\x7a\x4c\xb0\xc1\xcc\x3b\xc7\xe2\xd4\x0b\x9a\x3c\x46\x3b\x14\xaf\x5d\x8b\x3e\x4f\xcf\xde\x57\xd0\x79\x6e\x83\x7d\x18\x65\x42\xf2\xb9\x7b\x36\x5a\x4f\x7b\xdb\x7a\x2f\x3b\x75\xd8\xe8\x56\x9d\x8f\xec\x6d\xb4\xde\xb7\x43\x87\xf3\xc3\x36\x24\x64\x9e\x39\xaf\x51\x2e\x0f\xca\x8a\x07\x1a\x86\x57\xb7\x60\x76\x9d\xda\x0e\x63\xb3\x41\x22\x00\x3e\x2e\x4f\x2e\xa6\x62\x2e\x0e\x12\x19\xe4\xf8\xf7\xbe\xf7\x2f\x6a\x12\x0b\x0b\x1e\x76\xb8\x4b\x1f\x01\x1e\xa3\x4b\xf9\x37\x23\xc9\xe5\x10\x79\x80\x4f\xe3\x31\x02\x33\x4f\xa8\x3c\x81\xf6\xd0\xdc\x49\xf7\xdd\x76\xa3\xd7\x76\x17\x09\x7c\x30\xb1\x40\x6f\xf0\xde\x6c\x9a\x5d\x28\x4e\xff\x45\x23\xd2\x4a\xae\x7b\xdf\x73\x16\xe3\xa1\x04\x42\xc6\xe0\x3e\xa2\x2f\xf5\x9d\x7e\xe1\xc6\x79\xf7\xdd\x26\xce\x31\x02\x73\x76\xb0\xbc\xe5\x69\x88\x2b\x9c\x66\x9a\x18\x35\xe0\x8c\xd7\x55\x98\x3c\x5e\xe4\x3f\x27\x2d\x46\xfa\xf8\x37\x6b\xa6\x34\x35\x40\x46\x80\xc7\x6f\xf7\x72\x26\x4c\x3e\xca\xd3\x93\xeb\x17\x35\x1f\x9b\x6c\x11\x8e\xbe\x27\x2e\x0f\xf0\xfc\x5c\x84\x9a\x8f\x2f\x75\xbe\xf4\x27\x7c\xcc\x74\x4c\x28\xe4\xf8\x37\xab\x4f\xb6\x3c\x17\x40\x82\x67\xf7\xa7\x3d\x37\x98\xe8\xca\x33\x55\xa8\x18\x69\x58\xc4\x80\xc7\xa8\x9b\xfa\xf8\xf7\x70\x3f\xa9\xeb\x33\xe9\xfa\xdc\x11\x27\xc0\x7e\x0e\x05\x73\x6f\xcf\xb5\x2f\xb8\x95\x0d\xfc\xd6\x83\x0e\xb2\x77\xed\xf3\x6f\x9f\x41\x19\x76\x8a\x97\xef\xbe\x41\xea\x39\x6a\xf6\x34\x9f\x3f\x61\xda\xd8\xd3\x92\x2d\xde\xf6\x8a\x38\x32\x68\x0f\xd3\xd0\x83\x40\x99\x28\x02\x35\xa2\xe5\xfe\x8f\x92\x42\x79\xf6\x2e\x24\x4c\xe0\x01\xe4\x50\x2a\xfb\xd1\xbf\x2d\x6c\x4c\x43\xd6\xb0\x2a\x5a\x2a\x35\xa7\xe3\xe1\xd6\x17\xcc\x31\x5b\x12\x60\x40\x1b\x9e\x3e\xc8\x9b\xc3\xfe\x0a\xdf\x4e\xc3\xee\xe3\xfe\xfe\x76\x8b\x79\xfe\x5f\xe9\xae\x1a\x38\xcd\xbd\xf2\xce\xf7\x0a\x9f\x61\x19\xf0\x3e\x0b\x8b\xfc\x09\x67\x84\x5f\x78\x71\xd0\xa8\xb7\x61\x84\x05\x68\xa7\x71\x13\x6c\xaa\x64\x79\xbd\x22\x72\x57\x3a\x7d\x7b\x6d\xb2\x3b\x11\xdc\x36\xc3\x37\xfb\xaf\xb6\x9b\x06\x4f\x2e\x58\x55\xbe\xde\xf8\x1f\x42\xf7\xbf\x19\xdb\x46\x41\x60\x8c\xdb\x79\x8e\x29\x1b\x02\x48\x2f\xb1\x2d\x8b\x6b\x7f\xf1\xbc\xfc\x3f\x84\x6d\xb1\xae\x4e\xcc\xec\xd7\x7f\x08\x5b\xb1\xf6\x9b\x97\x61\x13\xab\x55\x93\x8b\xb6\x6d\x33\xc3\xcf\x38\xbc\xff\xf3\xea\x6f\x10\xeb\x70\x4c\x63\x11\x73\x0f\x3d\xd3\xc7\xd1\x42\xc9\x9c\x27\x6b\xe1\xca\x2e\x5f\xd1\xeb\x04\x91\xfb\x99\xf4\x06\x05\xa6\xd8\xa5\x12\x8c\xcf\x62\x6c\xa7\xbc\x6a\x15\xea\xf5\x50\x05\x4c\xe3\xc6\xf5\xd2\x5c\x63\x7f\xc3\x7e\xe7\xed\x4d\x46\x1b\x12\x29\x9d\x3b\x8c\x38\xa7\xa1\xdf\x13\x46\x8d\xc4\xb3\xb1\xf9\x1d\x6c\x89\x11\x4b\xb2\x11\xb5\x12\x96\x85\x79\x7d\x9a\xb8\x50\xd7\x36\xd1\x33\xde\xf6\x9b\x6a\x1b\xba\x7f\x6d\xcb\xd6\x5b\x84\xda\xdb\x12\xcf\x9d\x85\xec\xb9\xe2\x74\xa9\xae\x2b\x0f\xbc\xc8\x4b\x65\xea\xf3\xe2\x0f\xd5\x14\x90\xc6\x29\x4d\x49\x55\x6e\x13\x20\x5d\x77\x99\x51\xab\x43\xd3\xf0\x29\xe7\x6e\xf0\x92\x14\x4b\x95\x62\xb7\x44\x85\x53\x96\x71\x54\x46\xe4\xc3\x26\x8f\x83\x53\x1f\xee\xc2\x09\x77\x29\xbe\xbe\x4e\x4c\xb8\xf7\x84\x75\x89\x42\xe5\x50\x8b\x4c\x45\xee\xdd\xdd\xdd\x9d\x77\xc3\xd2\xc8\xf7\xb3\x05\x8e\xc4\xed\x68\xd7\x58\xdf\x7c\xba\x13\x66\xed\x1b\xb9\x7e\x6f\xae\x2f\xd1\x4c\x18\x33\x0d\xea\x38\x83\xeb\x56\xb3\xda\x49\xd8\x22\x3d\xe5\x2c\x26\x65\x5a\x4f\xe9\xe8\xe3\x7e\xc0\xc4\x7c\x23\x41\x14\x05\xb6\x51\xb4\xae\x98\x56\x8a\x29\x22\xfc\x52\x83\xfc\xd8\x8d\x88\x48\xd8\x85\xb7\x4b\x9f\x93\xf8\x5e\x0f\xf5\x7e\x44\x09\x7c\x95\xcd\x23\x22\x95\x90\xab\x1f\xe4\xec\xe8\x86\x1b\xd7\x6a\xd2\x91\xa8\x35\x2c\xf5\x8f\x08\x56\x0d\x4f\x8b\x38\x4f\xc7\x39\x67\x16\xda\x8e\xe4\xba\x44\xf6\x24\xd7\x11\x35\xfc\x96\x5e\x79\x6d\x9f\xb2\x29\xd2\x58\x0a\xb2\x9d\xd1\x89\xb9\x7a\x97\x45\xbf\x33\xf9\x19\xbf\x45\xa3\x0a\x67\xbe\xbc\x9d\x42\x82\xff\xf4\x93\x9b\x25\x57\xf4\x75\x70\x6f\xe3\x3e\x1f\xcf\x5c\x68\xa4\x0f\x85\xc5\xfa\x1f\x12\xf2\xd6\x3c\x01\xe4\x2a\xe8\xdf\x8c\x12\xc1\xb3\x3b\x1a\xf5\x50\x05\xf6\x30\xab\x0e\xb0\x73\x1d\xbf\x4d\xe5\x40\x02\x30\xf3\x3a\x81\x1a\xaa\x63\xa5\xfb\x86\xf0\xc2\x2d\xb6\x24\xbf\x30\xb9\xb6\xcc\x24\x7e\x93\x49\x59\x84\xe7\x42\x11\x57\x78\x55\xf1\x52\x8f\x3e\xee\x57\xb1\x1c\xb3\x08\x55\x8d\xda\x2c\xc8\x36\x59\xde\xae\x79\x96\xa3\xdc\xaf\xe1\xd1\xf2\xe9\x35\x61\xdb\x66\x27\x39\x1a\x76\x5a\x76\xf2\x1d\x92\x78\xcd\x66\x57\xb1\x91\x56\xd0\xaa\x0e\x5d\xb4\xbe\xa5\x30\x42\xd7\xbe\x31\x67\x4c\x13\xb3\x71\x73\xdc\x53\x67\xc9\x41\xf5\xf7\x1b\x01\xb8\x2e\x4e\x73\x90\x5c\x0e\x3f\xf7\x36\x6a\x2f\x58\x09\x32\x29\xbd\xbe\x6e\xa0\xdf\x08\x26\x97\xc3\x2f\x2a\x6f\x4f\xb4\xe5\xcc\x15\x64\xd7\x88\xe2\xcf\x41\xf3\xa8\x44\xf3\x63\x3e\x78\xd8\x70\x55\xc5\xdc\x75\x37\x35\x74\xc1\x3f\xaa\x54\xb2\xa2\x23\x2f\x9d\x11\xfd\x08\xe6\x02\x9b\xc1\x4f\xcd\x6b\x37\xdd\x90\x39\x99\x43\xed\xaa\xe3\x08\x65\xb1\x54\x8e\x60\x08\x1d\x2a\x95\x87\x1b\xb5\xfe\x53\x73\x16\xe8\x37\xf9\xcf\xf5\x42\x4f\x8b\xce\x92\xbc\x87\xcb\x55\xf1\xee\x0f\xdb\x94\xd5\xf3\x4b\x18\x7f\x29\xbe\xcb\x28\x79\x65\x47\xee\xb3\x26\xbc\x26\x2b\x2b\x05\x6b\x11\x9e\x69\x9e\xf6\xcf\x5d\x56\xab\x56\xb0\xb6\x22\x57\x2c\x77\x5a\xb3\x77\x55\x3b\xe3\x55\x0e\x06\x86\x8e\xc2\x0a\x19\x98\xe2\x1b\xc1\xcc\x9a\x22\x21\x89\xeb\xfd\x6b\xe8\x8f\x6e\x89\x7f\x3f\x1e\x97\x70\x4e\x28\x1c\xe7\xcb\x77\xdc\xf1\xb6\x48\x32\x1a\x3a\x0d\x18\xed\xd1\xef\x23\x97\x37\x58\xae\x4c\x0f\xfb\x9f\x0d\x5d\x3d\x3d\xf6\x09\xee\x97\xec\x1b\x3b\x29\x87\xc9\xac\x8d\x63\x1d\x29\x7d\x2b\x16\x92\x0d\xd1\x30\x24\x68\x8d\xef\xd4\xc6\xaa\x26\x31\x1b\x2a\xfb\xd2\xa7\xfc\xa4\xd0\xe9\xb0\x80\x91\x7c\x6f\x5a\xb9\x68\xe3\x3b\x80\x72\x60\x3e\x49\x61\xc3\x61\xb0\xf2\x06\xef\xf4\xa3\x8a\x12\x45\xd2\x4b\x8c\xa6\x15\x70\xa0\x96\xbb\xf6\xed\x7d\x64\x24\xa2\x20\x33\xc7\x8f\xa2\x34\x45\x30\x74\xe2\x1f\x6a\x40\x97\x0d\xfa\xfa\xeb\xca\xf4\xdf\xe9\x21\x25\x4e\xc6\x25\xae\x6c\xe2\xe6\x2f\xf8\x6c\xe9\x32\x3d\x7f\x1d\x3b\x13\x2b\x37\xd1\x0f\x2d\xf1\xb1\x77\xca\xe2\x57\x94\x6e\x31\x71\xe1\x72\x65\x46\x26\x44\x9f\x05\xa8\x34\x1e\xdb\xc6\xe1\xf7\x0e\xbd\xd4\x0d\x28\x80\x41\xb3\x0b\xca\xda\x2a\x0e\x0f\x54\x1a\x7c\x71\x32\x74\x61\x6c\x5c\x92\xe9\xa9\xd7\xa8\x98\x5d\xbd\xf4\x38\x94\x36\x6c\xd6\xf4\x36\x87\xaa\x0b\x93\x7f\xc3\x90\x1e\x21\xe5\x6e\x3c\xd5\x12\x6c\x0b\x0f\xcd\x2f\xee\xf1\xce\xd6\xa7\x03\xd4\xa2\x51\x26\x40\x3e\x13\xbd\x1e\x1c\xdf\x3f\x05\xd9\x5d\x73\xff\xe0\xbe\xab\x5a\xc5\xa1\xc6\x79\x37\x2a\x7c\x26\x4c\xb9\x5f\xf0\x69\xe8\x2a\xd3\x92\xf3\x95\xba\x42\xc4\xa7\xee\xc3\xde\x93\x71\x0f\xf9\x58\x35\x33\x6b\x4a\x4c\x1f\x09\x11\x06\x5d\xa5\x9b\xd7\x75\x18\x7c\xa2\x13\xc6\x36\x3c\x51\x49\x9e\x32\xb6\x3b\x36\x32\xed\xa6\x93\x79\xf8\x82\x5e\x30\x10\xeb\x7d\xe0\x11\x1c\xf6\x40\xef\xd6\xea\x0b\x76\xb5\x0c\xf8\x8b\x46\xcf\x64\x47\xb8\xca\x34\x0f\xc5\xa4\xc6\x6f\x18\xbb\x1f\x94\x57\xe7\xc5\x45\x6f\x1c\x3b\x44\x74\x92\x3f\x01\xf6\x87\xe1\x8c\x45\xe8\xbb\xc7\x36\x98\x78\x8c\x44\x37\x9c\x39\xa6\x79\x9e\x38\xbf\x1b\xb2\x64\x95\x3d\x12\xd4\xa9\x5a\x4b\xcf\x83\x35\x21\x7c\x86\x51\xf3\xc9\x85\xd3\x9a\x3f\x06\xf6\xa4\x8d\x92\xc6\x9f\xf5\x77\x56\xbb\x11\x7c\x1d\x5a\x68\x51\x3d\x61\x1a\x20\xce\x3a\x50\xfb\x0b\xb6\x21\xdb\x09\xb6\x01\x9b\x95\x60\x4a\xe8\x40\x81\x97\x06\xec\x35\x4f\x4a\xc1\x34\xed\x02\x23\x99\x36\xc6\xb6\x04\xfa\xfa\xca\x51\x27\xbb\x76\x4f\x2d\xf7\x2c\xf0\xf3\xe4\x74\x91\x58\xeb\x3b\xe4\x51\x2c\x81\x31\x1c\xa2\x7e\xd6\x80\xd7\x9c\xf2\x58\x29\x2f\x33\xd0\x15\xcf\x45\x9d\x12\x47\x3d\x22\x9b\x14\x32\x56\xa3\x1a\x9d\x14\x10\x7f\xb0\x21\xad\x29\x4f\xf7\x5c\x9c\xb9\x34\xe0\xc0\x55\xd9\xba\xe6\xf0\x7e\xde\x34\xf0\x91\x34\xe1\xd1\xd5\x54\xeb\xc7\x32\x33\x79\x73\xe1\x29\x74\x2d\xf9\xe2\x7d\xaf\xe9\x76\x18\x3e\xa6\x7d\x09\x85\x3e\x0a\xc6\x1b\x38\x9c\x8d\xc9\x80\xa8\x95\x4b\x15\x30\xff\x81\x21\x60\x7b\xb7\x37\xc2\xcd\x52\xd2\xcc\xca\xb9\xff\x45\x30\xc0\xf3\xf1\x75\xb8\x9f\xb5\xc2\x84\x62\x35\x6b\x4c\x03\xc1\xce\xec\x20\xdb\x3e\x71\x7f\xb7\x16\xca\x4f\xf6\xd6\x4a\x0c\x80\xd8\x38\x00\x06\x94\x2b\x88\x01\x3d\x63\xd4\x14\xe6\x89\xa3\xd9\x6d\xc0\xcb\x61\xfb\x2b\x34\xcb\xde\xb6\xc1\xfa\xbf\x6e\x11\x16\x14\x74\xd9\xcf\xf9\xcd\x9e\x6f\x3b\x72\x1d\xcc\xea\xed\xcf\xe8\xb8\x37\x22\xfe\x57\xdf\xb3\xc6\x31\x37\x07\xf6\x85\x82\x6b\x6b\x81\x8f\x31\x1f\x55\xe0\x38\xf0\xfe\xa9\x7c\x49\x94\x53\xae\x9c\x5a\xb0\x28\xf5\xad\x5c\x6b\xea\x73\xce\x54\x45\x25\x3e\x63\x5a\x78\x97\xad\xe5\xea\xb6\xe3\x04\xac\xaa\x7d\x36\x3b\x44\x73\x34\x63\x11\xbb\x52\x07\xf2\x65\xa9\xf1\x5e\x4a\x4c\xbf\x47\x2a\x1b\x00\x0e\xaa\xe1\x1b\x0f\x90\xb1\x50\xf0\x24\xb3\xba\xe0\x0c\x33\x45\xba\x35\x5e\xf1\x81\xb2\xda\x3c\x34\x55\xd0\x1f\x80\x27\x88\x72\x51\x02\xd4\x98\x3b\xd2\xd1\xb6\x43\xf1\xe7\x5e\x93\x93\xc4\x60\x7d\xd2\x24\xde\xb3\x49\x79\x49\x53\x36\x40\x85\x23\x1f\xf8\xf9\xcf\xc7\xca\x7a\x37\xc4\x0f\x52\xf5\x24\xed\x39\x94\x42\xea\xbb\xc2\xdb\x21\xe3\x4e\x48\x0d\x78\xa2\x0c\x99\x57\xb0\xa4\xe7\x3e\x59\x45\x8e\x52\x80\xf1\x66\x88\xe7\x24\x2b\x80\xef\x07\x87\x79\x69\x27\xfc\xa5\xfc\xea\x68\xb9\x31\xca\xcc\xf6\x6c\xc9\x7b\xcd\x1b\xd7\xe5\xd3\x1c\x5f\xc2\x15\x7b\xb5\xf5\xb8\x0e\xab\x3b\xe0\x03\xea\x55\x75\xdd\x0c\x9c\x65\x6b\xe0\x4d\xea\x22\x85\x2d\x32\x27\x69\x86\xd9\x1d\x9e\x50\xb3\x0d\x3f\x5a\xca\xe5\x8f\xc1\xd6\x71\x0d\x63\x7a\x7a\xe2\x7a\x9a\xe0\x37\xf3\x14\xbd\xc2\xde\x42\x06\xa7\x6c\x37\x7a\x29\x5a\xaf\x3b\xd8\x1d\x25\x73\x48\x20\x78\x52\x76\x69\xc2\x1e\xa0\xc9\xb5\x6d\xab\xb7\xcc\x0a\xc8\xc5\x54\xa0\x4f\x1c\x4a\x8b\x95\x49\x46\x4b\xef\x1b\x64\x39\x21\x03\xbc\xe0\x7b\x05\xd1\x73\x02\x6f\xd3\x37\xa5\x56\x7f\xbf\xc5\xf7\xbc\x00\xe3\x5e\x97\xe0\x8f\x4b\x2d\x33\xc9\x28\x81\x3d\x90\xfe\x03\xc3\xc2\xdf\x07\x3f\xb3\xea\xb4\xa6\xda\x62\xe3\x56\xe4\x1d\xc7\x3b\x4e\x84\x01\xc0\xe9\x46\xaa\xb6\x19\x83\x4f\x2b\x0a\x0e\xe9\x64\xfa\x4f\x04\xf8\x9b\x3f\x3a\xff\x6e\x57\xdf\xf5\x10\x24\xae\xeb\x22\x60\xd1\xec\xa1\xd7\xda\x02\x1d\x1d\x16\x77\x15\x57\xa3\x00\x11\x55\xf2\x6b\x1b\x6a\x51\x88\x65\xc1\xe1\xc5\x84\x19\x37\x00\xa4\xe5\x86\xa6\x28\x61\x1c\x81\x1b\x57\x99\xd6\x53\x5b\x12\xcf\xd9\x10\xaf\xaf\x29\x00\x77\x19\xc6\x4b\x48\x0b\xb2\xd0\x4f\x19\xf1\x00\x65\x04\xd4\x5b\x76\x19\xa7\xc3\x83\x94\x9b\xb4\xe9\x1a\xe4\x96\xec\xb5\x6d\xd7\x05\x21\xd0\xe7\x5f\xf3\x14\x45\x6d\xc7\xbe\x43\x69\xba\x6c\x8d\x8f\xaf\xd2\xec\x6c\x66\x3a\xfc\x1b\x0c\xd1\xa3\x53\xcc\xed\xe1\x1a\xa6\x4b\x5e\xc4\xb3\x74\x5c\xc1\x1e\x3c\x93\xcc\x3f\x0d\x81\xcd\x48\x3b\x19\x43\x10\x4e\xf8\xe5\xfb\xc8\xae\xa2\x6a\xef\xae\x5e\x3d\x45\xda\x2f\xe9\xc5\xe1\xf7\xe1\x0a\x9c\xcb\x5b\xf9\x3c\x36\x5f\xb7\x88\x73\x14\xa2\x7e\x15\xef\x7e\x07\x30\x6c\x39\x73\x69\x92\x5b\xa4\xdd\x72\xb1\x9d\xf4\x4b\xfb\xd2\x15\xcc\x14\x94\xe3\x38\xbb\x20\x27\x40\x55\xa4\xab\x15\x7b\xfc\x16\x5a\x2b\x5a\xce\xfb\xa1\xcb\x0a\x81\xd4\x70\x96\x99\xea\x2c\x59\x20\xf8\x30\xb9\x3b\xf7\xcf\x6a\x08\xcc\x2c\xd6\xf8\xcc\xe1\x29\x77\x02\x55\x90\x64\xb3\xcc\x45\x75\xcb\x67\x97\xc2\x21\x95\xcd\x5a\x7d\xab\xe5\xd0\x53\xdd\x7f\xdc\x52\x71\xe0\xd1\x2e\x9e\xbb\xf0\xa4\x1d\xb5\xe7\x04\x43\x71\xf5\xfe\x4b\x61\x27\xc7\xc5\x6a\xa3\xb5\x52\xf6\x52\xc2\xa6\x52\x24\xcb\x46\x11\x3e\x0b\x69\x94\x9e\x7a\x2d\x0b\xfa\xc1\x6a\x60\xea\xc2\xed\x5f\xb2\x86\x74\xf6\x7e\x3f\x2c\xae\x30\xd6\x61\x6b\x94\x43\xba\x7b\x45\xc2\xcf\xc1\xc3\xcd\x3a\xf8\x62\x62\x45\x14\x0e\xd0\xbf\xf2\x9f\xa7\x2c\x85\x42\x83\x4d\x0e\xa2\x80\xae\x2c\x8b\xd3\xd1\x4e\x03\x84\xea\x79\x75\x95\x32\x9a\x90\x62\xb6\x42\x76\x5f\x64\x5c\x30\xae\x85\x77\x04\xf6\x12\x3f\x50\x3e\x66\x93\x24\xef\x8f\xcd\xaa\x54\x7f\x55\xfe\xfd\x5f\x24\x1b\x27\x9a\x32\x4c\xd0\x9a\x29\x62\x79\x18\x57\xf0\x38\x4c\xa1\x9c\xcd\x3b\x6c\x4b\xd7\x3e\xbf\xaa\x8d\xb6\xe7\xb6\xb0\x7f\xfa\x0e\x0f\x3e\xf6\x10\x67\x32\xfd\x39\x5a\x8d\x29\xc2\x6c\x4e\x24\x23\x36\xc5\xde\xd2\xbb\x69\xd8\x11\xbd\x6a\x16\x93\x38\x1a\x7f\x47\x2c\x27\xa5\x65\x45\x15\xa0\x52\x45\x86\x12\xec\x98\xc3\x80\xe4\xe4\xa8\x63\xb1\x27\x2b\xe3\x8c\xd1\xf0\x71\x80\x3d\x40\x74\xa9\x0f\x8d\x21\x37\x3f\x7f\x6d\xb7\xd6\x99\x40\x93\x46\x46\x36\xdb\x99\x74\xdd\x2b\x04\xc2\xd8\xe3\x1e\x42\xd3\x15\x03\x44\x4b\xef\x12\x16\xcc\x1d\x6d\x6a\xa9\x0e\xd5\xad\x49\x65\xee\xc6\xb9\xe8\xd7\xc0\x3b\xd7\x01\xd9\xf0\xe6\x7b\x76\x41\x83\xde\xba\x0d\xde\xdb\x31\x4d\x0c\xc5\x03\x1e\xe8\x86\x09\xad\xcb\xa3\x7e\x20\x11\x05\x05\x73\x3c\xdc\x21\xb4\x64\xb6\x6a\x34\xb0\xa7\x5f\x28\xbf\xd1\xaa\xeb\x39\x9a\xaf\x37\x69\xf0\xb0\xfe\x22\x19\x1f\x55\x8a\xe7\x50\x99\x61\x29\x72\x34\xd5\x61\x1a\x7c\xf6\xd7\x0c\x60\x3d\x44\xfd\x5a\x72\x60\x01\xe3\xe5\xe1\xe4\x36\x91\xcf\x2f\xf5\x04\x3a\x98\x8e\xae\x94\xe5\x72\x06\xf8\x1b\x30\x4a\x57\x61\x81\xf5\x6c\x5a\x4d\xae\x33\x02\xba\x95\x4d\xed\xc6\x6a\x96\x2c\x2b\x9d\xa7\x12\xcd\x3b\x2f\xfa\xe6\x90\x01\x79\x95\x21\x2a\xc5\xd9\x83\x84\x1d\x74\x10\x1e\x2a\x12\xa0\x86\xa3\x5e\x33\x86\x61\xa7\xc3\xc0\xdc\x3d\x7d\xd2\x6b\x41\x67\x8c\x75\xc4\x96\xe6\x2f\xb5\xb7\x3e\x67\x5b\x4e\x29\x86\x73\xed\x09\x53\x3e\x76\xf0\x14\x24\x36\x18\xe6\x1a\xe2\xd1\x64\x76\xbf\x96\xbd\xc0\xbe\x3d\xe2\x90\x93\xa8\xc5\x26\xe6\x36\x48\xf0\x50\x1e\x2e\xf1\xb7\xaa\xa5\x88\x7a\x0f\xd8\x59\xce\xf9\xfa\x3a\x55\x72\x95\x1a\xf0\xc1\x0d\xed\x07\x12\xde\x6d\xf6\x27\xdf\x72\x70\x98\xa7\x37\x24\x08\x7b\x95\x44\x64\xb6\xfd\xd5\x71\x72\xb8\x52\x1f\xda\x28\x87\x20\xf2\xf2\x63\x78\x30\xfa\xe6\xb2\x22\xa1\x65\xdb\x34\xa0\x07\xaa\x71\x9a\x56\x58\x13\xc6\xec\x3d\xba\x5d\xd5\xd4\x97\xff\x7b\xb6\x21\x9b\x16\xf5\x06\xd5\x63\x58\xce\x36\x2a\x27\x09\x4b\x7f\x44\x52\xe4\x97\x18\x45\xe4\xc4\xd7\xf6\x8f\x65\x1f\xa4\xde\xfd\x0b\xb7\x88\xc5\x9d\x25\xf2\xa4\xde\xdc\xd9\x9f\x1f\xdb\xa8\x94\x5e\x25\xa7\x24\x83\x05\xab\x55\x6b\xc1\x50\x1a\x03\xce\x67\x79\x41\x1f\x9a\xbb\x81\x58\x89\xdf\x29\x98\x3b\xe5\xd6\x0b\x13\x37\x82\x2a\xf7\x19\xbb\xdd\x8d\xc9\x6f\x6b\x44\x6f\x2a\x5b\xb5\xa5\x34\x49\x47\x17\x33\xbd\x40\x11\x29\x77\xa5\x43\x13\x3e\xb5\xfa\xad\xb0\xa4\x53\x60\x47\xa5\x24\xa3\x74\x3b\xa8\x5a\xa7\xa3\xd2\xb3\x78\xde\x93\x54\xfd\xd8\xf4\x62\xac\x57\x44\xb4\x21\xe3\xe2\xfe\xcd\xb3\x23\x52\xf7\x8b\x7d\x45\x48\x68\xe2\x88\xa4\x94\x94\x1d\x64\xbb\xfa\x1d\xc8\xf8\x53\xae\x7a\x84\x28\x12\x3b\xee\x74\x72\x62\x5d\x02\xb0\x35\x50\x40\x26\x57\xfd\xb8\xa7\x51\xaa\x2e\x64\xc6\x8a\x50\xa5\xa5\x95\xa5\x6e\x84\xa9\xe8\x95\xe3\x79\x91\xc1\x06\x6a\x50\xdb\xb5\xa0\x54\x05\xc0\x49\x3c\x0b\xde\x4e\xf7\xb8\x94\x3b\xae\x80\x2e\x7d\x1e\xdf\x76\xf2\x24\x1c\x9e\x29\x8e\x92\x3f\x26\x85\x67\x4c\x7a\xfe\xd9\xbe\x95\xaf\x93\x4a\x1a\x70\xa9\x7e\xfc\xff\xf8\x78\xab\xa0\xb8\xda\x2d\x6a\x77\x35\x4e\x90\x26\x90\x84\xe0\x04\x77\x08\x12\xac\x91\x04\x87\xe0\xee\x04\x77\x09\xee\x0d\x24\x10\x3c\xc1\x5d\x83\xbb\x3b\x34\x1e\xdc\x25\x68\xe3\xee\xd2\x38\xa7\xbe\x6f\xef\xfd\x57\x9d\x53\xa7\xfe\xab\xb5\x2e\x56\xcd\xab\xb1\xc6\xac\x77\x3e\x63\xbe\xe9\x8f\x88\x2b\x90\xfd\x6a\xd3\xfa\x7e\xf8\xc7\xeb\x01\xed\xf2\x26\x74\xd4\x33\x9e\x9d\xd7\xec\xab\x0d\x1e\x11\xaa\xed\xdd\x9e\x63\x20\x8b\x07\xe3\xc1\x1f\xb5\xa7\xe6\xd8\x0f\xc7\x96\x7d\xcd\xa5\x5a\xd0\x4d\xd5\x8f\x1f\xb2\x3d\xf4\xb0\x8e\xf7\x78\x47\xc1\x16\x93\x36\x99\x16\x23\x03\xdd\x00\x38\x5f\xdf\xa9\x35\xb0\xbf\xd9\xf4\x38\xe4\xf6\xdd\x00\x70\x12\x56\xc3\xf7\xd5\x25\xf5\x82\x78\xb4\xa8\x49\x5d\xac\xdd\xba\x75\xdf\x88\x27\x43\xe7\xbc\x5c\x0a\xbd\x2f\x03\x05\xc3\xb5\x6d\xc4\x37\x84\x10\x9e\x37\x7e\x31\x34\xe1\x3d\x70\xef\x5b\x8c\xb4\xb7\xaa\x3e\x83\x32\xf7\xea\x51\x4c\xe1\x40\x5f\x69\x3b\x84\xf4\x72\xf2\xfd\x17\x10\xbe\x7b\x7f\x36\x81\x6e\xac\x1f\x6d\x49\xaf\x66\x07\xa8\xf9\x16\x79\x2e\x4e\x23\xa3\x3c\xa6\xfb\x76\xda\xa7\xa5\x7a\x93\xad\x3d\xaa\xe8\x50\x63\x0f\xc1\x6e\x2f\xc7\xd3\x38\xa0\xf6\xb4\xea\x70\x59\xfe\x90\x6c\x57\x38\x30\x76\x7a\xf2\xd4\xff\xff\x13\x6c\xe0\x42\x6d\xf7\x50\xfe\xf8\x51\x95\xe0\x7f\xe8\x79\x1b\xd7\x47\x36\x40\x55\xf5\x15\xd7\xbc\x38\x23\x85\xb0\x04\x3f\xab\x36\xe0\xa6\xcc\xa4\x3a\x25\x75\x3f\x48\x05\xb8\x29\x6f\xcf\xe5\xfd\x2f\x74\x41\x7c\xa3\x32\x40\xb6\x95\x34\xf4\xcf\x21\x86\xe4\xef\x47\x95\x96\xef\xc0\xbc\x04\xe3\xeb\x95\x00\xaf\x96\x59\x50\x65\xb8\x0a\x13\x13\x32\xfa\xf1\xc2\x77\xa2\xff\x62\x2b\x5e\x67\x78\x0e\x95\x9d\xdd\x5d\x15\x64\xb5\x4c\xfb\x28\xaa\xc1\x1d\x9d\x41\xd8\x3a\xcb\x9e\x46\xd2\x61\x6b\x2c\x58\xb8\x0d\xa7\xd2\xb9\x42\x77\xf3\xe8\xd7\x65\xbf\xeb\xb4\xbe\x6d\x14\x92\x23\xce\x24\x6e\x9a\x3e\x36\x7c\x71\xcf\xcf\xfc\x12\x1f\x4a\xac\xbe\x1a\xdb\x6e\x52\xe9\x17\x50\xef\x88\x5e\xaa\xa9\x56\x58\x36\xfd\xf1\x16\xfd\xce\x8e\xc6\xec\x3f\x7c\xcb\xde\x1c\xe6\x79\x34\xb9\x68\xf1\xa5\x0f\x61\x6d\xdd\xe2\x74\x34\x82\x77\xf3\xd3\xaf\x78\x8d\x1a\x69\x69\x6b\xcf\x13\xe9\xe1\xf2\xba\x90\x1d\x38\x58\x1d\x0b\x33\x08\x83\x31\xb5\xeb\xfa\xb4\x18\x3d\x2e\xb9\x7d\x3c\x12\x53\x36\xac\xf7\x9e\x10\xbe\xfe\xa7\x50\x5e\x58\xc8\x5e\xeb\xb4\x9c\x04\xf4\x64\x66\x45\x60\xdb\x8b\x40\x69\xd5\xf5\x6c\x6f\x5b\x14\x59\xea\xaf\x70\xb8\x18\x5c\xc8\xde\xdd\x1e\x71\x87\x54\xb9\xf2\x48\x3f\x31\x85\xe7\x01\x9f\x41\xd5\x7c\x5d\xb9\x62\x94\x8e\xd1\x26\x3a\x9d\x2d\x10\xea\xa5\x2c\x32\xee\x40\xcd\x88\x1a\xda\x49\x18\xa8\xee\xb8\xb4\x5a\xe2\xd4\xa9\x1b\x38\x68\x35\x5e\x29\x9c\x2d\x5c\x9b\x90\xd7\x2e\x05\x5f\xfe\x65\x9f\x88\x14\x85\x07\x17\xfa\xa0\x80\x01\x91\xb9\xbf\x3d\x94\x85\xda\x7c\x45\x10\x18\xa4\xe5\x0e\xbd\x76\x67\xf8\xfd\x3c\x2a\xf0\xee\x46\xad\x92\x65\xc2\xb9\x53\xf6\xdf\x52\xae\xd3\x83\x05\xba\x96\x86\x50\x0a\xa5\x8d\xf1\xa7\xc2\x37\xc2\x2a\xd9\x61\x40\x3f\xbb\x41\x2b\xf1\x24\x9a\xd1\x61\x1c\xaa\x90\x5e\xa8\x8c\x4d\xe3\x7c\x14\x60\xe9\x54\xbb\xbc\xa9\x4d\x0b\x38\xf3\xda\x0a\x78\xcc\xbe\x1e\x92\xb8\xc5\xc6\x6c\xea\xb1\x2e\x58\x05\x5f\xcd\x15\xca\x2a\xab\x2d\x4e\x71\xed\x05\x9d\x8d\x95\xec\x7c\x06\xe5\x10\x71\x09\xe6\xd6\x8f\xdb\xf2\x3a\xbf\x79\xd4\x51\x35\xa1\xbb\x35\xf5\x58\xa4\xe7\xaa\x13\x66\x44\x94\x3c\x3e\xe6\x54\x93\x9c\x52\x70\xed\xed\x08\x8b\xa5\x7e\x93\xa9\x49\x9f\x32\x6e\xb1\x0b\x3d\x42\x02\xd3\x25\xfc\x17\x20\xda\x0f\xc2\x86\x4f\x42\xfe\xc7\x12\xff\xf3\xf0\x27\x74\xdc\x0a\x1a\x4e\x67\x67\x05\xcd\x4b\x14\xb9\x68\x2f\x93\x99\xac\xff\xf3\xf6\x3b\x68\xbd\x6f\xb9\xfc\x1f\x05\xc8\xc8\xc4\xc4\xf4\xf5\xfd\x0f\x65\xfe\x57\x72\xa7\x86\x28\xf9\x1d\x9d\xe9\x90\xbc\xfc\x89\xe9\x04\x88\x86\x34\xa3\x55\x0c\x87\x27\x34\xb7\x18\xde\xb3\xd0\x0f\x9a\x11\xc6\xd9\x71\x60\xfe\xf7\xc3\x4c\xc6\x86\x11\x96\x59\x9b\x20\x9d\x3a\xba\xe8\x75\x35\x78\x92\x34\x79\x51\xf2\x4a\x86\x03\x9c\x10\x5d\x49\xbe\xce\xd6\x00\x16\xf8\xe1\x42\x0b\xba\x99\x6c\x0d\xa1\xab\xf9\x13\x14\xeb\xfa\xa6\x9c\x7a\x8c\xad\x6e\xb7\x2d\x12\x02\xe7\x33\x70\x41\xf2\x4c\x31\x90\xb1\x97\x72\x38\x87\xf5\xb5\x2f\xf1\x8a\x10\x49\xe3\xd0\x4e\xd7\x06\xa0\xda\x6e\x57\x8a\x0e\x6c\x20\xe9\x6e\xb1\x66\x48\x1e\xa1\x27\x8d\xac\x09\xb4\xe6\x61\xc3\xbe\xd9\x47\x19\xb3\xd6\x80\xcc\xfb\xc8\x05\x56\xce\xd1\x46\x2b\xeb\x22\x75\x87\x86\x80\x26\xab\x0d\xa9\xda\x25\xd0\x4f\xd4\x0f\x7d\x7a\xf7\xbb\x52\xfe\x83\x2c\xb9\xb3\xbc\x6f\x95\x5e\xf7\xae\x8a\x5b\xcc\xa7\x40\x4e\x94\xc4\x2d\xc8\x3d\xf7\x97\x32\x2c\x0a\x16\x2e\x70\x91\xf8\x6a\x66\x9f\xf2\x26\x31\x01\xf7\x06\xfb\xd8\xbc\x97\x87\x4b\xd9\xbf\x20\x31\x7b\x7c\xdd\x1a\x7d\x6d\xb4\x67\xf0\xc7\x49\x83\x89\x4f\xb2\x18\x94\xd2\xee\x7b\xcd\xb3\x05\x1c\xcf\x67\x92\x5c\xed\xb1\xc0\x16\xe9\x27\x61\x79\xd7\x60\x3b\x9b\x19\x6b\xc2\xb2\x6d\x5d\xf0\xaf\xfe\x3b\x33\x9a\x1e\x96\xb5\x54\xb4\x53\xe3\x45\x6e\xc2\xb5\x09\xcb\x66\xf6\x60\xec\xa3\x15\x1c\x4a\x1e\x8b\xde\x85\xd0\x8c\xc6\x35\xc1\xd2\x43\xc1\x2a\x6a\xd1\x5f\x53\xe3\xf3\xe5\x26\xa0\x9c\x1b\x3a\x3e\x69\x06\x24\x88\xe0\xc6\x3a\xf3\x3c\xc8\x02\xd2\x7f\xe9\xab\x8a\x4a\xa7\x18\xb5\x19\x95\x2e\x31\x7b\xdc\xb7\x65\x31\x34\x40\x50\x0b\xe9\x56\xbc\x48\x40\x7e\x45\xe7\xbe\xcf\x16\xc8\xa5\xed\x3a\xa9\x7b\xec\x03\x86\x86\xf0\x8f\x05\x19\x18\xa2\x60\x1c\x52\xd4\x6d\xf3\x10\x6e\x2f\xa6\xcb\x0b\xb6\x11\x65\x19\x69\xda\x10\x86\x22\x80\x0a\x72\x57\x0a\x86\x53\x7c\x4a\x8b\xe6\xd7\x9e\x34\x16\x57\x5a\xe4\xfd\x74\x68\xb6\xf2\xe4\x41\xcc\x2f\xab\x44\xc3\x34\x42\x7c\x09\x07\x54\x4d\xdc\xd3\x5d\x4a\x36\xe5\x51\x4e\x45\x2c\xa7\x64\x5f\x59\x7c\x38\xfd\x99\x5a\x67\xc3\x0c\x9d\x7a\xe2\xc5\x9a\x5c\x00\xd2\xce\x9b\x18\x7f\xea\x20\x3b\x2a\x22\xa4\x53\x2e\x20\x50\x3c\xed\x0b\xd9\x01\x66\x4b\x50\x14\x96\x1b\xe6\xab\x6c\xab\xf0\x43\x65\xea\xe8\x65\xf5\x97\x73\x56\xd6\xb0\xcd\x80\xc1\x98\x16\xc2\xd2\x02\xd7\x93\x75\xac\x36\x2b\xfd\x95\xd9\xbd\x0f\x0e\xb8\xa2\xb6\x07\xa3\xed\x39\x37\x77\x67\xcb\xe9\xbf\x42\x2e\x1d\x59\xc1\xd7\x84\x72\xea\x00\x5f\xb2\xc7\x86\x5f\xf1\x5b\x58\xee\x17\xdd\x0a\x72\xcb\x50\x1b\xed\x96\x0b\xea\x50\x6b\xe8\xa6\x45\xf8\x3d\x66\xd6\x54\xd8\xce\xb2\x7c\xc6\xd9\xe3\x5a\x9b\x87\xa9\x2a\xd7\x1e\x79\xed\x79\xf3\x88\xc1\xe7\x92\x23\x63\xcd\xf3\xb7\xcb\x06\x54\x07\x23\x4d\x33\xa0\x40\x7d\x1c\x93\x6f\x16\xb9\x03\x0a\x8b\x45\x55\x5a\x44\xf0\x8f\xcf\xd2\x9d\x69\xf5\x00\x42\x51\x58\x3b\xde\x97\x00\x7e\x75\x68\x87\x20\x44\xcb\x6a\x10\xfe\xb2\xce\xc0\x16\x92\xcf\x72\x32\x19\x28\x45\x38\x57\x79\x92\xc6\x69\x3c\xe9\xec\x11\xfc\x41\x9d\x5d\x9e\xfc\xbb\x90\xf9\xae\xc1\x39\xde\x74\xae\x6e\x39\xad\x6a\xcb\x44\xed\xe2\x7c\x03\x61\xb8\xb8\x45\x0f\x2f\xbf\x92\x35\xad\x40\x45\x12\x66\x8a\xb9\x03\x68\xe5\x87\x02\x06\x57\x2a\x0a\xde\x1e\x8f\x6b\x33\x77\x3b\x85\xba\x87\x86\xfc\x20\xfe\x47\x56\xe7\x6d\xe5\x72\xe0\x96\x95\x2e\xb5\xaa\x3e\x2f\x9f\xd8\x43\x2b\xb6\x60\x2e\xec\x66\x84\x2b\xdb\xd5\xdb\xa2\x22\xd1\x94\x35\xfa\x6c\x70\x07\x82\xe5\x68\x74\xd2\xaa\x8b\x3f\x34\x61\x15\xba\x4b\x1c\x96\x5b\x33\xe8\xff\x9b\x94\xde\x3e\x64\xa5\xb5\x7b\x97\x51\xa5\x7f\xe2\x1b\x2f\x59\x6a\x1f\xcf\x5b\xca\x2a\xeb\x55\xac\x73\x8e\x99\xb3\x1c\x82\x14\x37\x78\xe7\xf3\xfb\xd3\x79\xd1\x92\xc3\xba\xde\x97\xaf\xe8\xd1\x88\x47\xa5\xc4\xf7\xe6\x08\x70\x05\xf6\x94\xa4\x38\xf0\x88\x3c\xa5\x33\x49\x2c\x38\x5d\xc7\x4a\x8b\x96\x3e\x58\x0e\xa2\xc6\xae\x17\x89\x59\x0e\x9a\xd8\x2d\xcc\x09\x3b\xeb\x6d\x83\x7a\xaf\x64\x7a\x68\xc7\xf5\x3e\xc9\x9d\xff\xb6\x34\x08\xfd\x0b\xa9\xdd\x67\x35\x50\xfc\x24\x35\x74\x43\xd6\x4c\x53\xfb\x8a\x8b\xda\xa6\xad\x47\x31\x71\xd8\x92\x87\x7d\x6c\x78\xec\x93\x5c\xe7\xb6\x97\xc7\xf8\x17\x7d\xa8\xd2\x57\x0f\x4b\x7e\xe5\x64\x52\xf2\xe9\xdb\x3b\x9f\xad\x53\xd8\x08\x29\xf8\x5f\x37\x78\xc2\x34\xb2\x3b\xba\x3a\xd8\xa3\xf0\xd3\xa8\x53\x39\x3c\x8b\xfb\x5d\xdb\xae\xb9\xda\xbb\x06\x30\xda\x38\xac\xda\x5e\x60\xa4\x9f\xdc\xef\xc8\xaa\x3c\x72\x64\x14\x7a\x5d\x7f\xd6\xb9\xbb\xaf\xf3\x5a\xbf\x98\x50\xf1\x68\xbc\xe1\xd8\xc9\x77\x66\xb6\x87\x7c\x4f\xb6\x49\x29\x24\x02\x41\xb4\x59\xd6\xb3\xde\xe3\x5d\x85\x26\x25\x05\xf7\x8d\x31\xff\x5f\x12\x79\xff\xed\x41\x64\x49\xb7\xa6\xc4\xff\x6d\x65\xaa\x68\xe5\x03\xc0\x6b\xbe\xc0\x45\x8c\x54\x06\x1e\x46\xe3\x36\xff\x53\x14\xc0\x4d\x19\x1b\x82\x4d\x91\x16\x93\xed\x6f\x44\x98\xd7\xec\x26\xe4\x9b\xf2\x14\xf0\x6f\xa3\x7b\xe6\x87\xbf\xde\xf1\x90\x46\xf3\xde\xad\xe4\x0b\x22\x77\xd3\xff\xc7\xb8\x08\x9b\x16\x96\xe3\xcb\x37\x9a\x62\xe5\xe3\xc2\xd8\xc6\x67\x55\xf0\x41\x46\x84\x79\x39\xb9\x75\x75\xf1\x71\x1c\x41\xff\x67\x9e\x38\xfc\x2f\x61\x32\xfa\x00\x2a\x0f\xe0\x4d\x22\x3e\x31\xc4\x69\xed\x9d\xbe\xb4\x68\x6c\x36\xb3\x35\x7f\xf8\x0a\x05\x93\x5c\x67\x40\xb2\x22\x9a\x8d\xc6\x50\xa5\xc4\xa6\x93\x90\x97\x42\xe8\x90\xe2\x56\x11\xc2\x7b\xaa\x3f\x2a\x98\xd3\x8b\xba\x85\x94\x77\x4e\xf8\xd7\x0b\x83\xad\x5b\x2c\xe0\x4f\x0c\x50\xa6\xc5\x3f\x82\x63\x0a\x8f\x23\x2d\x09\xdf\xc7\x5f\xe3\xae\xa1\x3e\x07\x7f\x1a\x5b\x78\xb1\x95\x56\x80\xd5\x4a\x0a\xbc\xa8\xa8\x7d\xa1\xcc\x28\xb9\xcc\xda\x9d\xb7\x44\x92\xc5\xa0\xca\xa5\x3c\x86\xbf\x8a\x40\x1c\xaf\xd7\x20\x67\xdd\x88\xd2\x9f\xd9\xf7\xdd\x3c\x09\xec\x81\xca\xce\x5c\xd7\xdc\xf6\xa8\xcb\x08\x5e\x42\xf6\x8d\x2c\xe6\x5c\xaf\x8e\x66\x5e\xf9\x18\x3e\x47\xe6\xc5\x49\x3c\x65\x54\x05\xb8\x83\xda\x8b\xe9\xaf\x19\xd0\x9a\x91\xe7\x8b\x05\xbe\x7f\x4b\x06\x89\x47\x2d\x2b\xad\xd1\xbc\x38\x95\xd4\xb1\xd7\x48\xf5\x06\x68\xb7\x09\x12\x3e\xab\x7e\xfc\xc9\x85\x7b\x9f\xc6\xe4\x88\xe6\x4a\x2e\x27\x59\xdf\x35\xc7\x8f\x1e\xa3\xcf\x7d\xea\xcb\xe1\x6f\x9c\x1b\x4a\x08\x53\xaf\xaf\x91\x05\x44\x0f\xa3\x5e\x57\x2f\x56\x6e\xf0\x1f\x80\x23\xb1\x32\x43\x8f\x14\xe7\x1f\x39\x1d\x0f\x9e\xf7\x3a\x7e\xc5\xaf\xe3\x92\x9b\x7b\xc4\x20\xc3\x4b\xac\x21\x97\x7f\x43\xfb\x01\xaa\xbc\x8d\xed\xb4\xde\xb9\xa7\x8a\x9d\x26\x87\x29\x24\x0f\x41\x05\xb6\x14\xc0\xfd\x2d\x89\xe0\x2d\x0a\x92\xf5\x71\xe1\xca\x01\xaa\x52\xd3\xf3\x17\x69\x69\x1b\x1f\xbb\x3a\x29\xdb\x67\x19\x1f\x11\x53\xd9\x3b\xec\x56\x25\x07\xdc\x21\x03\x65\x19\x74\x8c\x3d\x0b\x9b\x3a\x73\x30\xf4\xb3\x66\xcd\xb4\x33\x05\xa0\xb3\x42\xd2\xfc\xd0\x73\xf6\x3c\xe5\x4f\xcd\x4c\x4b\x69\x64\xbd\x1f\xc5\x75\xc3\xb3\x65\x68\x1c\xb3\x1f\xc5\xf5\x8e\xd6\xbe\xcf\x9d\x0d\x13\x5e\x3f\xbd\xe4\xac\xbb\x9b\x4c\x30\x5d\x79\x7c\x8a\x0f\x7f\x84\xdc\x97\xeb\x06\xe7\xb4\xff\xa5\xeb\x60\x46\x0f\xa8\x40\x15\xc1\xde\xa0\x26\x04\xae\x0d\x7a\x1e\x04\x53\xe7\x4e\x2a\xfb\xb0\x5c\x34\x4a\x54\x1c\x44\x44\xb5\xda\xaf\xf2\xe2\x67\x80\x17\x36\x05\xe5\x42\x14\xd8\x30\xc4\xa8\x69\xac\x77\x62\x05\x62\x75\xfe\xce\x0e\x6e\x46\xb7\x77\x5c\x03\xd0\x60\xd1\xb5\xf3\x23\xb0\x29\x29\xdd\x8e\xcb\x59\x5f\x60\x0c\x97\x65\x4c\x82\x3e\xae\x0c\x55\x94\x2f\x40\x91\xfd\x32\xeb\x6f\xff\xe2\xb2\xdf\xe5\x9e\x74\xfa\x8a\x85\x8c\xc3\x16\xcf\xdd\x5a\xbe\x3c\xd9\x7a\xdf\xb2\x92\xbd\xbc\x49\x4a\x28\x67\xd3\x91\x9d\x97\x3f\xe8\x66\x1e\xc2\x9b\x77\xd9\xaf\xbd\xf0\xc3\x78\xe8\x34\x2d\xf1\x9e\xcb\x47\xf1\x99\x53\xeb\xf3\xc5\x4a\xb9\x5c\x74\x12\x1c\xcd\x29\xba\xbb\xd4\x62\xa9\x01\x9b\x4c\xba\xeb\xc9\xb6\x4c\x6f\x8c\xab\x11\xe8\xf0\x33\xdf\xe7\x4c\x7d\x55\x9d\x99\xeb\x23\xf2\x4b\x3f\xd1\x73\x4c\x7b\x8a\x09\x0a\x38\x72\x3b\x40\xf4\x22\x32\xff\xc4\x31\xc8\x6d\xa0\x2e\x30\x67\x66\x5b\x07\xbc\xa9\xf6\xb5\x9f\x7c\xa3\x5f\x9b\x34\x65\x4e\x3d\xb6\xf6\xa1\xdf\xc1\x06\xff\xc0\x7e\xe6\x1b\x14\xb5\x51\x3e\xa4\x79\x83\x78\xcb\x97\xc3\x38\x8b\xbe\xbc\x77\x44\x50\xf3\x95\xf7\x20\xf2\x6f\xc4\x44\xcd\x71\x28\x1d\xc9\xcf\x52\x83\x71\xac\xf4\xa8\x9d\xa8\x28\x03\xea\x09\x5d\x44\xbb\xb9\xdb\x33\x03\x3d\xec\x65\xbb\xc2\xe0\x46\xee\xcb\x4a\xc9\xa0\x12\x4d\x30\x74\x93\xe7\xa8\xac\xd9\xa4\xdd\xb9\xe5\xf5\x46\xd4\xda\xc2\x96\x3f\x64\x70\x37\x8e\x60\x5f\x70\x2a\xe3\x38\xe4\x99\x92\x23\xfa\xe6\x47\x54\x46\xa3\x88\x9f\x3a\xad\x75\xbc\x4d\xce\x00\xff\xd8\x31\xb0\x9c\x3f\x7a\xeb\x28\x43\x6a\xf4\xb7\x5b\xe2\xfd\x31\xd2\xae\x8f\x00\xae\xb6\xd3\xdb\x55\x4d\xad\x0f\xd3\x3d\xb4\xc8\xe9\xdd\x72\xb1\x8b\xe4\x72\xa0\xcd\x7c\xf0\x95\x48\x84\x8d\xba\xa4\xb5\x63\xef\x9a\x15\xe0\x7c\x32\xd2\xe6\x8f\x34\x04\xe6\xd6\xb9\x39\xe6\x14\xa9\xef\xff\xf2\x75\xc5\x99\xd3\xca\x70\xe7\xf9\x7a\xf5\x8f\xac\xbc\x02\x4f\x7d\x43\x37\xb0\x9e\xea\xd5\xe7\x51\xab\x2f\x07\x5c\x44\x64\x54\x0d\x99\x01\x69\xc5\x9a\x87\x82\x51\xde\x45\xbf\x6e\x0e\x57\x84\x15\x5f\xaa\xf9\xde\xf0\x20\x86\xf8\x4c\xd6\xf2\x5b\x8f\xe5\x4e\xeb\x96\x1d\xe9\xdd\x05\xa1\xd7\xd7\xb3\x2d\xec\x29\x26\x9e\xe1\x3c\x21\x4b\x3b\x80\xd6\xec\xa5\xb2\x56\x80\x82\xfd\xf9\x8b\x8d\xa8\xa1\x15\x1f\xa9\x8e\xc8\x52\xb5\x8f\x55\x5d\x5a\xae\xa4\xbb\xbc\x57\xe9\x5d\x28\xae\x7a\x25\xa8\xe8\x9b\xac\xd3\x93\x8e\x86\x33\x93\x87\x45\xde\x98\x94\x5a\x4e\x7f\x7f\xa7\x17\x22\x2f\xb0\xd6\x23\x46\x90\xf1\x5a\x3f\x34\x1f\x5e\xa2\x02\x26\x45\xb7\xe8\x3e\x13\xf8\x48\x19\xa5\xeb\x6e\xb5\xbd\xe7\xcb\x0b\x22\x9c\xb6\xe1\x57\xbc\x7f\x1a\x34\xba\xa5\x4b\xe0\x6d\x5d\xe2\x57\xcc\xab\x13\x0e\xd4\xcc\xea\x3d\xb9\x94\x65\x51\x5c\xd7\xcb\x83\x25\x59\xa5\x51\x01\x92\x5f\xda\xf5\x62\xf6\x99\x2f\xdb\x5b\xad\xb9\x16\xc1\x68\xd2\xbf\xea\x75\xaa\xdf\xca\x5f\xbb\xf4\x8e\xe2\xec\x84\x01\xeb\xc5\xe6\x60\xbd\xa6\x37\xab\x14\xd3\xd7\xbf\x46\x15\x58\x9e\x3f\x1e\xff\xd8\x27\x9a\x54\x20\xb9\xfa\x20\x32\x6e\x48\xcd\xac\xae\x55\x98\x95\x55\x1e\x85\x7c\xe7\xa4\xae\x7a\x02\x25\xa6\x17\x51\xb5\xe3\x19\x35\x62\xbf\x05\x5b\xbf\xfd\x9a\xc0\x67\xb5\x3c\xbb\xd1\x37\x17\xf5\xe2\xfb\x7e\xa3\x3f\x61\xdf\xe0\x41\xf1\x15\xfd\x16\xcf\xb4\xe0\x93\x9a\x48\x64\xb1\x1a\x35\xf7\xef\xc2\xa8\x00\xe9\x2f\x01\x48\x99\xcd\x28\xd6\x29\xad\x91\x29\x64\x76\xa0\x73\x9e\xfd\xd6\x4d\x5f\xda\xf3\x27\x43\x34\xbb\xcf\x70\xf3\x3c\xec\x53\x11\xf0\xf0\xd2\xfa\x17\x6f\x38\x67\xe1\xb7\x7d\x0a\xcb\x5f\x6c\x86\x57\xe0\x61\xc7\xbf\x75\x61\xd3\xd6\xd8\xac\xdd\xc8\x31\xd4\x86\x5f\x86\x7d\x3e\x0a\x3d\x98\xbd\xd8\x0e\xa1\x90\x6f\xbf\x65\x42\x5b\x15\x51\x3d\x6b\x2d\xbe\xa3\xe0\xdc\x4b\xdd\x38\x46\x17\x47\x87\x67\x67\x1d\xb1\xb0\x47\xcb\x02\x6b\x5a\x07\xe4\x52\x67\x71\x34\xf4\x90\x83\x4a\xf4\xbe\x1e\xde\xfb\x25\x11\x12\xdf\x33\x0a\xa4\xf6\xaf\xbb\x3a\x7f\xa9\x09\x21\x5b\x96\x29\xea\xcd\xe9\xbd\x41\xde\xd5\x66\x7f\x9c\x20\x74\x5f\xad\x92\xd5\x27\x2a\x0e\x56\x1c\x70\xfd\x6d\xf8\x5f\xe1\xc6\x76\x48\x3c\xe4\xcc\x3e\xb6\xa0\x82\x08\xa9\xe5\x80\xcd\x5e\x3b\xfa\xb4\x4e\x3c\xa7\x1e\xdc\x90\x21\x44\xe6\x86\x0d\xd1\xa2\x8c\xc5\xe5\xfe\xea\xb3\xc7\xe2\x2d\xc9\xd1\x13\x6b\x1e\x9d\x04\x29\x3e\x36\x7e\xd6\xf3\x9b\xb3\x21\x01\xed\xc5\x3c\x75\x25\x54\x58\x8f\xcd\x81\x0f\x1b\x1f\x32\x29\x6e\x93\xf0\xfb\xe2\x9f\xed\x36\xb7\x6b\x4a\x5b\xac\x3e\xe9\x1e\xcb\x4c\xc5\xaf\x56\x3b\x9a\x46\x11\x5d\x9d\x77\xe7\xab\xd6\x08\x07\x46\x09\xe5\xd8\x7a\x4f\x8c\x49\x11\x1f\x75\x79\xc8\x29\xa4\x84\xe5\xbd\xeb\x99\x72\x44\xfc\xa3\x6a\x50\xd8\xc6\xd9\x34\xc1\xce\x23\x67\x07\x0d\xb9\xe1\x0e\x5a\xfc\x78\x45\x7c\x5e\x01\x9b\x10\x93\xb6\xe1\x93\xe0\xc2\xa3\x9c\xac\x7c\xfa\xfe\x9e\xcc\xf3\xa9\xfa\xf2\x7a\xe8\x4a\x22\x7b\xff\xf0\x7e\x23\x07\x6c\x52\x58\x70\xc3\xf7\x4c\xcf\xdb\x5b\xe6\xb2\x67\xc9\xc5\x47\xc8\xc5\x22\xc1\x41\xea\xbc\xfd\xca\xe9\xd7\xdd\xf9\xea\xc4\xb1\xe1\xa4\x03\x61\xda\xb6\xcc\x53\xa0\x5d\x58\x0b\x7d\x86\xf7\x95\x80\x56\x30\x85\x84\x43\xdb\xc1\x44\x86\x33\x61\xfa\xa9\xab\xc3\xd0\x8e\xd4\x49\x0c\x42\xd1\x0b\xd7\x5a\xab\xfd\x16\x69\x6e\x8f\x4a\x91\xf8\xb3\x5d\x58\x5e\x08\x3a\xa0\x78\x8d\xc2\x37\x8b\x6a\x68\xf3\xb9\xea\xd4\xd1\xe1\xd4\x2f\x16\xf5\x51\xd9\x08\x57\x0d\x7c\xee\x15\xbc\x43\x9b\xd3\x02\xb1\x21\x6e\x2d\xf7\xef\x28\x42\x56\xac\x11\x49\x57\x78\x86\xd1\x09\xb8\xf5\xc1\xd8\x86\x24\xfe\xe9\x61\x0f\x2c\xa8\x36\x75\xcc\xff\x1c\xc9\xde\x45\x07\xc6\xa5\x70\x93\xff\xfc\x2b\x5e\x14\x88\x84\x8a\xca\xc3\x03\xb8\x29\xff\x67\x4b\x80\xdf\x51\x23\x21\x26\xa6\x9a\x13\x68\x61\x40\x5e\xda\xd5\x29\xfa\x9d\x53\x1a\xa6\x13\xc1\xbe\x98\x65\x5e\x7d\x7e\x50\xcb\xd6\x51\xae\x17\x8b\x5a\x4e\xe2\x28\x71\x24\xea\xfc\x29\x2e\x9f\x51\x17\x64\x7a\xa2\xc4\xa9\x74\x1c\x51\x71\x1b\x8f\xc6\x00\x06\xfd\x69\x2d\xb9\xc9\xbe\x42\x1f\xee\x28\x99\xd6\x6f\x22\x2c\x6e\x51\xb1\xd8\x3a\xc6\x89\x8b\xcf\xf4\xac\xa8\xd7\xd3\x6f\x8b\x3e\xaa\x5c\x28\x3b\xd8\x31\xe9\xf0\x27\xe6\x6b\xc9\x53\x21\x31\x73\x85\x5e\x46\x86\xad\x94\x8d\x01\x1e\xf4\xb2\xab\x56\x33\x60\xca\x0f\xfa\x9d\x1a\x56\x7c\xb0\x36\xbf\x6a\xba\xaf\x33\x80\x1c\xab\xb8\x64\x68\x94\x58\xe4\xab\x64\x3a\x6b\x9c\xd3\x25\x31\x61\x83\x33\xa6\x7d\xe5\x5b\x2d\x09\xec\xab\x04\x49\x23\x61\x24\xd6\x74\x6a\x4e\x1d\x17\xa4\x59\x12\x36\x1b\xa6\x90\x82\xca\xf9\x9e\x05\xb7\x56\xd5\xdf\x27\x93\x47\xf9\x0e\xea\xad\xa2\x88\xd8\x11\xf5\xc5\x93\xcc\xcc\x35\xeb\xd6\xf5\xdd\x2b\xce\xd8\x57\xcc\xba\x9a\x48\xb6\x82\xb4\x6f\xd8\x3b\xc1\x95\xa5\x05\x7f\x20\x13\x66\x18\x4a\x34\xc6\xb4\x02\x84\x46\x83\x6f\x3a\x71\x03\x31\xca\xf9\xeb\xb6\x7e\x24\x18\x00\x24\x83\xbd\x9b\x3d\xde\x59\xdb\xe1\x75\xf8\xfd\x44\xb3\xdc\x15\x23\x99\xd5\xea\x95\xb9\x46\xaf\x67\x99\xcb\xca\x1a\x86\x45\x4d\xa3\x76\x55\x6c\xe7\xa4\x58\x94\x3f\x01\xf7\x32\xea\xa5\x39\xf7\x45\x07\x88\x31\xe4\x9a\xcf\x2c\x53\x72\x55\xb5\x57\x82\x50\xdf\xfe\xde\x1a\xda\x45\x24\x2d\x6f\x0c\x79\x82\x63\x70\xd6\x78\xfc\x85\xc0\x8e\x37\xf0\x18\xbe\x14\xed\xe9\x85\x6c\x3b\x86\xbf\x13\xc7\xd1\xf5\x50\x94\xd6\xc0\xe7\x04\x7f\xb1\x9d\x06\x4a\xc3\x68\x14\xd2\x6c\xcd\x43\x49\x29\x7f\xc2\x3c\x96\x82\x58\x2e\xbc\xf0\xe5\x73\x70\xf4\xa3\x3c\x4a\x9a\x3a\x3a\x14\xa4\xce\x60\xfe\x8b\x7b\x7f\x56\x7e\xb4\x93\x6b\xf5\xec\x64\x94\x10\x3b\x00\x29\xd6\x9a\xbc\x63\x85\x8a\xd5\x7f\x4b\xa2\x49\x39\x86\xb0\xad\x85\x52\x59\x86\x0d\x2e\x36\xa2\x39\xf1\x03\x8e\x16\xca\xec\x8c\x28\xbb\x7c\x81\xba\xc1\x8b\xe4\x59\xdf\x19\x78\xa0\xc4\x61\x4c\x1f\x71\x7e\xd7\x6d\x58\xda\x6b\x0b\x64\x0a\xb6\xd5\xa7\x9d\xd3\x95\x07\x5c\x6e\x84\x6e\xcf\x62\x28\xe2\x11\x05\x1f\xf9\xfd\xfc\xf9\x93\xbb\xdb\x2f\x6a\xee\xfa\xa2\x64\xf4\x68\x27\x60\x35\x77\x1c\xc6\x23\x1b\xc7\x89\x0a\xa7\xa2\xfd\xfb\xc9\x9a\x0a\x97\x5b\xd5\x23\xe2\x8f\x51\x5a\x11\x56\x5a\xa7\xde\x52\x9d\xa1\xc9\xc8\x1d\xd5\xc1\x89\x0a\x2a\x78\x94\xb2\x3c\x61\x7e\xd4\x79\x4d\x99\x0a\xb9\x4a\x5f\x5d\x86\xad\x48\x66\x9e\x42\xca\x4e\xc4\xf5\xf1\xc9\x13\xae\x32\xeb\x96\x53\xd6\x53\x16\x61\x34\x7a\x40\x3b\x2a\x97\x53\x34\xba\x45\x78\x4c\x95\x83\x9a\x95\x00\x7b\xbd\x5a\xfd\x18\x8d\x5c\x42\xfb\xa5\x0d\x17\x70\xb6\xfe\x16\x29\xce\x5d\xb5\xdc\x86\x4f\x48\x33\x81\x6e\xea\x5a\x7a\xf4\x8f\x32\x48\x1b\xd3\xcc\x26\x09\xad\xe7\x66\x7f\xf5\xc6\xc8\xe5\xe5\x00\x1a\x14\x2f\x8f\xd8\x83\xe9\xf8\xa0\x99\x8a\x61\x27\xf8\xa3\x5a\xef\x76\x08\xd8\x5e\x02\x01\x6e\xa4\xcc\x7c\xd7\xcf\x84\x4a\x59\x04\xdb\x82\xc6\x4d\xee\x69\xbf\xf4\x72\xb4\x14\x83\x15\x83\x4f\x38\xeb\x37\xa8\x16\xa2\xed\x77\xfe\x10\x0f\x98\xa9\xb0\x57\x66\xca\xcd\x2b\x5a\xf9\x76\xf8\x93\xdf\xb0\x1e\xc8\x3a\x68\xe2\x6b\x26\x00\x7c\x02\x12\xe7\xd0\x57\xe5\x55\x9e\x01\x5d\xe1\xe2\xb6\xa0\x59\x52\x82\xba\x37\xba\x38\x46\x16\xec\x5f\xa5\x2f\x51\xa0\x13\xa4\xe4\xac\xe4\x60\x68\xc2\x72\x91\x9c\x1a\x50\xec\x54\x46\xfc\xf7\xa2\x76\xa7\xf3\xa8\xcf\xbe\xe5\x47\x78\xa1\xe0\xac\x20\xbd\x35\x75\x3d\xee\x22\x26\xa1\x01\x15\x27\xd6\x62\xdd\x2f\x97\x84\x9c\xf4\x99\xa8\x05\x3f\xe1\xeb\xfb\xfe\x80\xd5\x69\xb7\x34\xbb\xd2\x69\x05\x22\x79\x44\x52\xf2\xa6\xfa\xdd\x41\xb6\xc2\x61\xac\x4a\x3e\xc5\x3b\x37\x6f\x9d\x92\xb9\xaf\xe8\x40\x5f\x08\xc6\xf0\xcb\x1e\x44\xc1\xdf\x17\xa3\xaf\x3f\x39\x08\x19\x09\x64\xbe\x58\xb5\x53\xd3\x6d\xff\x50\xab\x90\xca\xc6\x27\x85\xd1\x76\x71\xd3\xaf\x55\x4e\x3d\x5b\x63\x7b\x25\x3b\x8a\xb9\xe8\x04\x73\x73\x33\x6e\x99\x8d\x99\xcb\xd3\xfd\x22\x22\x64\xfd\xaf\xd0\xd0\xc9\xdb\x9c\x80\xc6\x59\x95\x0a\x06\x36\xcb\x48\xd0\xf7\x0d\xf3\xd0\xce\x18\xcb\x14\xe6\x36\x09\xfe\x1e\x1a\xd0\x07\x92\x1f\xda\xe1\x27\x9d\x44\xde\xec\xcd\x5a\xd8\xe8\xed\x66\x91\xf3\x7e\x67\xba\x80\xec\x2b\x0b\x4c\x42\x7b\x45\xd9\xa5\xb4\x13\xcb\xb2\x21\xc3\x0b\x05\x97\x5f\x6f\x0d\x0a\x47\x24\x8d\x35\xd6\xbd\xab\xd2\x6d\x72\xd2\x8c\x39\xe7\x8f\xdd\x42\xdf\xc5\x78\xac\xa9\x54\x30\xb3\xd9\xda\xa9\xae\xfe\xf3\xdb\x40\xf2\x66\x07\x99\xf8\xa0\xda\xb2\x02\x83\x77\x00\x38\xd0\x4c\x71\x30\x1a\x1d\x83\x88\x6d\x4e\xbd\x1d\x1b\xae\xdc\xae\x57\x1b\x48\x02\x2b\x6b\x2a\x1b\x1d\x48\x46\xfa\x8c\x20\x6c\xa5\xfd\x86\x4c\x78\x3c\xf2\xab\x0f\x54\x88\xcc\x3f\xa9\x0b\x27\xce\xf0\x6b\xf8\xf0\x3c\x9b\xa3\x61\xf0\x95\xba\xd2\x20\x60\x09\xab\xd9\xe0\x0f\x65\x13\x9b\x8b\x20\xb8\xcb\x54\xf1\x8f\x09\x4a\x82\x90\xae\x4c\xe2\x71\x41\xf9\x6a\x87\xbb\xc7\x0f\x65\x15\x25\x8d\xae\xa4\x44\xa9\x22\x11\x32\xe4\x9d\x08\x55\xe2\x6c\xc8\x1b\x4a\x4d\xe9\x01\x0d\x4f\x61\xd1\xc9\x2f\x12\xf6\xb4\xd8\xa2\xa4\x7d\xe1\x9a\x72\xd7\xa8\xbb\x42\xc1\x73\x51\xe0\x36\xbb\x13\x99\x56\xe6\x1b\x8f\xf7\xfe\x29\x1e\x91\x34\xc1\x51\x32\xfd\xaf\x3d\xe3\x1d\x29\x46\x5f\x97\x96\x69\xce\x29\xe0\x3a\xd8\xb5\xb8\x3e\x72\x2f\x4f\xa9\x54\xc7\xe4\x7c\xb5\x49\x4b\x56\xad\x0b\xf9\xc6\x27\xf5\xfd\x4a\xd6\x32\x86\x7a\xf5\x3c\xf3\x4d\x52\x58\x4a\xef\x09\x23\xf4\xde\x66\x49\xf8\xbb\xab\x6c\x23\x4d\xb5\xe2\x5c\x42\x49\xc9\x9c\x4f\x74\x48\x53\x5d\x4a\x49\x05\xb7\xa6\x27\x26\x34\x53\xbd\xe4\x43\xb5\xbb\xd2\x88\x32\xf2\xfe\xc5\xc1\xe0\xa3\x20\x2f\x30\x69\x6d\xc1\xd6\x2f\x6d\x95\x74\xfe\xc3\xab\x6c\xe8\xd9\xfd\xc4\x63\xd3\x27\x7f\x98\x95\x1c\xad\xd1\x29\x20\x28\x28\x32\x62\x37\x24\x94\x37\xa5\xef\x6d\x3e\x49\x6a\x96\xe4\x5b\xc6\xb7\x88\x77\xb7\xe9\x24\x75\x8f\x86\xd3\x86\xa0\x63\xdb\x25\x64\x9e\xe9\xc2\xb6\xf7\x68\x28\x9d\xd1\xd8\xbe\xcd\xe8\xb9\x06\xbb\xf5\x15\x8b\x4e\xd3\xb0\x51\xce\x65\x27\x0a\x1c\x5c\x2e\x16\xce\x04\xca\xad\x96\xb7\x08\xdb\x0c\x58\x5f\xdf\xdb\x7b\x34\x11\x9e\xb4\xe9\x47\xca\x64\x19\xd7\x8d\xb7\xd0\xac\x1b\x03\xcd\x3b\x5d\x90\xde\x3c\xbf\x42\x6e\x26\x69\x5f\xcf\x4a\x90\x30\x36\x5e\xdf\x0f\x99\xa6\x83\x43\x83\xde\xcb\x61\x92\x1e\xb7\x06\x51\x9c\xf7\xf8\xbc\x09\xf7\xde\x84\xf0\xfe\x00\x6a\x6c\x0a\x17\x76\x71\x58\xe2\x63\x3a\x5a\x24\xc8\x83\x41\xc0\xbf\x13\x1b\xd2\xb0\x92\x69\x6f\xc7\x87\x49\x9d\xcd\xec\x3d\x77\xf0\xb9\x9f\xf2\x59\xa3\xf9\x68\x19\xd3\x19\x40\x22\x20\x3c\xd0\x56\xff\xd2\x94\x74\x76\xd4\x0f\xdf\xa3\xe7\xcb\x0a\xb7\x8c\xe6\x65\x71\xec\xcd\xc7\x26\x35\x36\xb5\x8c\xf8\xa5\xe4\xd8\xfa\xa5\xa9\x0a\x8c\x24\xad\x65\x1d\xd1\x4f\xf9\x6c\xbf\xba\x75\xd4\x17\xfd\xc9\x68\xe2\xcc\xed\xf1\x7d\x9f\x6f\xa1\xa5\x26\x47\x2b\x60\x62\x74\xff\x7e\xec\x6e\xa9\x07\xe1\xce\xae\xcd\x4f\x2c\xf6\xe7\x5e\xff\x4d\x69\xea\x8f\x6c\x93\xd3\x28\xbf\x07\x64\xda\x1f\x45\xec\xc7\x7e\x9b\x0e\x00\x3d\xd5\x8f\x77\xe6\x3e\xd1\xeb\x7b\x74\xe2\x73\xa7\x94\x76\x61\x88\x63\x1c\xd2\x09\x29\x10\xbc\x78\xaf\xf5\xbe\xad\x05\xe9\x26\x91\x3d\x73\x0e\x87\xba\x30\xf5\xe2\xd0\x9e\x78\xad\x88\x1e\x7e\x39\xa9\xbf\xec\x00\xe8\xa9\xea\xbf\x9b\x22\x13\x93\x79\x3e\x22\x50\xbb\x0b\x36\x11\x4c\x6c\x76\xd9\x0f\xb8\xed\x2c\x92\xc4\xef\x7b\xb1\xfa\xd7\x81\x9a\xe9\xb5\x9a\xf5\x74\xbf\x07\x6b\xca\x6e\xc3\x65\x99\xb2\xe2\x86\xba\xd6\xc4\xb8\x65\x5e\x95\x48\x7b\x3d\x87\x0a\xcf\x0a\xb6\x23\xb5\xbd\x9b\x15\xa1\xbe\x0f\xeb\x2e\x9f\x34\x61\x74\x40\x83\x1a\xd9\x77\x1c\x8e\x40\xaf\xf9\xb9\xaa\x54\xb6\xf2\xe5\x45\xbf\xc5\x18\x1c\xbc\x4b\x36\x87\xcc\xf5\x63\xcb\xbb\x4e\x23\x9a\xe5\x20\xac\x21\x42\xa2\x2b\x0e\x83\x5b\xb2\xc7\x0f\xd5\x2a\xf9\x41\x11\xec\xda\xe2\x48\x3e\x72\xf2\xa6\x91\x84\xda\x34\x70\x27\x7b\xfb\x8a\xf9\x3d\x66\x3c\xd8\x50\xed\xf2\x37\x1a\x94\x79\x3e\x9b\xd5\xc6\x5c\xe0\xcb\x01\x42\x2b\x85\x5a\x49\xba\x87\x29\x5b\xc6\x1c\x58\x53\x6f\xe1\x2e\xa3\x37\x91\xd2\x2b\x59\x88\xe8\x31\x31\xf8\x06\x25\x1a\xdb\x34\x85\x92\x81\xef\xc2\x15\xaa\x51\xde\x0a\x2f\xf2\x75\x4d\x5e\x2f\x3e\x7e\xa8\x57\x69\x8d\xf6\x1a\xd3\x8d\xe2\x42\xb0\x6e\x3d\x8d\xeb\xda\xf3\x07\x16\x35\x5d\x86\xeb\xe2\x87\x88\x12\xe2\xb2\x43\xb4\x71\x4e\x8d\xe7\x17\x84\xc3\x29\x90\xc4\x47\xff\xf6\x0b\x82\xd9\xa5\xb7\x3f\x89\xac\xa6\x62\xc4\x5d\xde\x84\x85\xde\x93\xb7\x02\xe5\xb3\x6c\x62\x7a\x4e\x1b\x8a\xa7\xb3\x1d\x50\x1b\x8f\x04\xf8\xa6\x44\x89\xa3\xb9\x6e\xc6\x81\xab\x4b\xd3\x72\xc3\xbd\x9d\x6d\x1e\x0a\xaa\x37\xa2\xbe\x3b\xaa\x23\xef\x21\xb8\xed\x7a\x61\x2d\x67\xae\x15\x62\x7b\xcd\x74\xa7\x49\xd7\xd2\xdd\x0f\x91\x70\x48\x7b\x7a\xc8\x69\x8f\xa6\x80\x11\xfc\xf5\x7c\x7f\x21\x01\xbc\x10\x30\x1d\x32\x4d\x47\x93\xf6\x81\xef\xed\xbd\xc4\x38\xb5\xed\x8b\xf1\x10\xbc\xcb\x75\xa7\x22\x52\xe5\xba\x01\xde\x6c\xb2\x8d\x39\x4b\x9e\x8c\x78\xd7\x4a\x4d\x4f\xd6\x3a\x20\x16\xe7\xef\x1e\x61\x64\x5e\xf2\xd5\x1b\xe8\xe3\x31\x1f\x96\x1b\xdd\x48\x61\x26\x73\x1c\xe1\xf4\xca\x1e\x51\x23\x0a\xb1\x32\xe9\xe1\x5c\x7b\x55\xaa\xa6\x0d\x68\xa2\x2e\x4f\xf1\x24\x9a\xbc\xd9\xa1\x25\x27\xaa\xab\x63\x41\x3b\xcb\xa1\xbc\x75\x0e\xeb\x1f\xe2\x1a\x76\xb7\x92\x96\xea\x81\x8b\x93\x52\x6d\xcf\x79\xac\x00\x76\x44\x63\xf3\xe5\x17\x55\xb4\xd9\x83\xba\x2e\x5c\xf4\x52\x22\xfe\x25\x77\x1e\x34\x52\xc5\x7c\x74\x42\x89\xc3\x3e\x93\x95\xe0\xe1\x53\x6c\x94\x30\x0f\x47\xc7\xc8\x70\x14\xa1\x0d\x5e\x09\xfb\xe4\x8d\xe1\x15\x37\xa2\x1d\x6b\x00\xef\x84\xa8\x91\x06\xaf\xa1\x40\x93\xc2\x40\x61\x84\x47\x02\xde\xce\xac\xc0\x3f\x47\x79\x1a\x49\xae\x98\x90\xcf\x6d\x06\x3f\x6e\x1b\x5d\x94\x17\x37\xba\x7d\x77\xa0\x61\x2b\x21\x1a\x25\xf8\x11\xac\xf8\xee\x87\xd8\xca\x70\x22\x3a\x80\xef\x76\xc7\xb8\xfa\x13\xfd\x8f\x03\x11\x2d\xb1\x3a\xca\x4f\x1d\xbf\x9a\x2b\x56\x17\x83\x3d\xc9\xb1\x39\xa1\xd5\xc0\xad\xb0\x1a\x0a\x53\x09\xe4\xe1\xa3\x96\xd3\x87\x95\x00\x0c\xd2\x19\x55\xbf\xe4\x59\x43\x60\x3b\x94\x63\x54\x97\x09\xed\x94\xd5\x0e\xb3\xef\x3e\xc1\x1a\xb9\x30\xea\x60\xa3\x85\xe1\x0d\x7c\x62\x74\x28\x9e\xd7\xf1\x68\x7b\x90\xae\x5d\xc6\x3a\x80\xae\xcd\x57\xb1\xb7\x9d\xc3\x45\xc3\xa1\x35\x9d\xdd\xad\x0f\xc1\x7e\x1c\x3a\x8f\x00\x28\xb7\x4f\x71\x24\x9e\xd9\x3c\xde\x5d\xe7\x8d\xc8\x77\xf3\x40\xb8\x3a\xcf\xcf\xd1\x22\xaa\x9a\xf4\x9a\x15\x75\x38\x9f\xfe\x55\x43\x02\xfa\x07\x84\x60\x50\xd5\x59\xc3\x74\x97\x67\xcd\x11\x51\xda\x84\x04\xfa\x21\xf3\x12\x47\x8c\x57\xf3\x93\x6d\x0b\xd6\x6e\x84\xf1\x07\x43\x7e\x1f\xf2\x00\x72\x55\xa9\x56\xfd\xf7\x1a\x7e\x1a\xce\x5e\xcf\x44\x4b\x7e\xe4\xfc\x82\xc7\x4e\xc4\x51\x15\x57\x6c\xc3\xfc\xdf\x15\x98\xd4\x07\x9f\xbf\x67\xe2\x40\xb1\xea\x5c\x0f\x5e\x82\x96\x56\xba\x3e\x20\x84\x2e\x4e\xff\x2d\xcf\x83\x9a\x85\x39\xbb\xc9\x6c\xfc\x54\x81\xc4\x5b\x0a\x4a\x9b\xd8\xa7\xde\x2a\x8a\xe7\xde\xdd\xed\xbe\xaf\x2f\xeb\xc4\xfb\x3a\xe5\x73\x56\x7e\xe4\x98\x1a\x93\xbc\xc3\x11\xe4\x37\x1a\xe4\x7b\x79\xf7\x56\xf9\x72\xfd\x0b\x9b\x09\x38\xba\x4f\xeb\x0e\x7a\x35\x9b\xb1\x64\x09\x4a\x28\x79\x13\x35\x0a\xf2\x20\x48\x83\x91\x44\x73\x61\xad\x50\x74\xa4\x6c\xb7\xdc\x39\xb0\x3c\xee\x45\xaf\xb7\x09\x13\xf9\x9c\x91\x07\xa2\x49\x8d\x9c\x4c\xa3\x22\x16\x0b\xa9\xd9\xfa\x4a\x38\x7e\x7a\xf0\x08\x77\xb8\x83\x04\x6a\xa6\xeb\xc0\xf4\xcb\x0a\xb9\xd1\xa1\xe1\x9a\xc4\x42\x79\x1d\x31\xdb\xb0\x4a\x9e\xfe\xca\x8d\x37\x5b\xce\x43\x42\x29\x1b\x16\xe0\x16\x8d\x3d\xcd\xa9\x8e\x73\xbe\x63\x02\x77\xb4\x74\x95\x2e\x0d\x70\xf4\x46\x63\x82\x73\xaf\xa1\x74\x1b\xec\x0f\x93\x49\x7f\x50\x3c\x58\xc0\xf8\xa9\xa7\xfb\x08\x01\x77\xaa\x1c\x66\x9d\x63\x77\xff\xd4\x5d\x61\x17\xf8\x82\xc1\x01\x17\xc6\xc3\x37\x1e\x16\xac\xaa\xaf\x8a\xb2\xb8\x5b\xf1\x6b\x70\x7b\xa8\xd3\xae\xc4\xe3\xb0\x26\x4f\x99\x2f\xe8\x04\x8d\xe6\x22\xdf\x91\x52\x53\xf6\xef\xe9\x79\x00\xce\xa0\x66\x97\xe0\xe7\x11\xc7\x60\x1b\xc7\x28\xbf\xcd\x9a\xe0\x99\x4c\x7d\x86\xed\x8d\x5a\xec\xd3\x13\x50\x7e\xe1\x44\x2e\x98\x34\xcb\xff\xe6\x0b\x99\x1f\x2c\xbe\xf0\x4f\x00\x0b\x94\x3a\x35\x7a\xf4\x70\x5f\x9a\xf7\xf6\x46\xfd\x48\x6c\x90\x1a\x6d\x5c\x67\x7a\xfd\x8b\x54\xfb\x0a\xe0\x35\x53\xb3\x38\x9f\x9e\x33\x14\xed\xb3\x06\xd9\x28\xb8\xb3\xf9\x1f\x5c\x30\x87\xa6\x72\x9f\xfe\xb1\x1b\x45\x0c\x88\xfa\xa9\xf1\xf2\x85\xe0\x1f\xb9\x2b\x21\xc1\xd4\x4f\xff\xcc\xd8\x3b\x63\x9e\xea\xbe\x39\xa5\xd4\xc1\x0a\xa2\x08\x3b\x7b\x67\xd4\x8f\xe0\x62\xaf\xc8\x52\x64\x9e\xc6\x18\x15\x63\x13\x99\x31\xdc\xb0\xac\x98\xc1\x22\xdb\xee\x07\x1e\x2a\xd9\x0c\x1a\x6d\xfc\xa8\x8a\x6f\xba\xac\xb0\xf0\x6e\xf6\xe1\x35\x68\x0b\x42\x18\xa7\xd8\x48\x3e\xbc\x39\xac\x65\x0e\x25\x41\xb4\x8d\x72\x40\x69\x13\x2f\xdb\x87\x58\xf4\xdd\xec\x56\xa3\x89\x4f\x8f\x99\xbb\x15\xbf\xe2\x98\xda\xc8\x8d\x57\x26\x49\xe1\x05\x57\x5f\xff\x4c\x27\x24\x78\xd0\x71\x80\x09\x1d\x26\x5e\x7c\x5c\x14\x96\xa5\x85\x41\xa2\xa9\xac\xca\x92\xaf\x2c\xb9\xbe\xef\xac\x82\x17\xc5\xbc\x1d\x23\xbb\xce\x43\xc4\x24\xeb\xf4\x6e\xb0\x1e\x1f\x73\x54\x2a\x79\x3f\xc5\x61\x9f\x5a\xb8\x52\xc8\x45\x92\x6f\xe1\xc1\x06\xa5\xd6\x5e\x65\xc4\x58\x17\x53\x08\x59\x63\xe0\x86\x53\xeb\xe0\xb9\xfe\xc0\xbb\xcf\x7b\xf2\x53\xfe\x0b\x4e\x3a\xfa\x9c\x72\x94\xf6\x66\xd1\x4e\xee\xf3\xef\xde\xff\x4d\xd7\x78\xdf\x19\x6d\x0b\x02\x4f\x9e\xf0\xdc\x32\xb5\xbd\x8e\xcf\x16\x84\x79\x2b\x91\x99\xba\xad\x8d\x3c\x40\x0b\x03\x6a\x45\x00\xf2\xcd\xde\x2a\x52\x75\x48\xf5\x1a\xfb\xd4\x4d\x36\xaa\x4b\x77\xe6\xbc\x78\x51\x6f\xd9\x0e\xd9\x92\x2a\x08\xc6\x26\xcb\x18\xfd\x73\x86\x78\x85\x0c\xaa\x1f\xa7\xa2\xaa\xaa\x46\x1a\x70\x9c\xf2\x9f\xa9\x1b\x8f\xd1\x7f\xc2\x6d\x55\xfa\xf0\x4e\xbf\x6c\xda\x16\x32\xf2\x3f\x55\x32\xa1\xae\x3e\xc6\xc8\x66\x1d\x24\xc0\xb0\x50\x99\x3f\x19\x1f\x57\x6c\xa6\xa1\x24\xda\x7f\x79\x94\x91\x1f\x28\x59\xa3\x90\xe8\x21\x8d\x33\xe7\x92\x37\xff\x6c\x5f\x6e\xc2\x70\x92\x37\x33\x55\xb1\x0b\xc5\x15\xb5\x21\xfe\x9b\xe0\x3e\xe4\x38\x01\x3f\x48\x19\x7c\x36\x9c\x08\x89\x4a\x19\x26\x64\x4d\x97\xdf\xc7\x1a\xfa\xc3\x03\x36\x7a\xc7\x39\x8e\x22\xec\xbf\xe7\x38\x1d\xde\x2a\xa9\xa2\x0a\x7c\xf5\xae\xd3\x5f\xd8\xc3\x11\xfd\x42\x5f\x22\xec\xcc\x8b\xdc\x3a\x30\xdf\x6a\x95\xf8\x56\xf4\x7d\xbf\x4a\xd5\x8c\x0d\xe8\xfc\xb0\x59\x02\x27\xab\x83\xb5\x28\xe4\x67\x18\x29\xe1\x2c\xd6\xc6\x54\xdc\x71\x50\x4a\x2f\x3b\xdb\x54\x33\x23\x32\xfd\x99\x50\x82\x82\x24\x2b\xbc\x21\x21\x39\xc4\xde\xef\x34\x2e\x43\xe3\x3b\x10\xbf\xb8\x6e\x40\x37\x4e\xb1\xe2\x58\x9c\xa2\x56\x47\x92\x45\xc5\xb6\xaf\x5e\xcb\x28\xba\x67\x1c\x8d\x65\x01\x06\xe2\xe6\xf9\xa3\xbe\x2a\xf7\x9e\xa7\xe6\x5f\x55\x87\x4a\x9e\x32\x85\x72\x0d\x7a\xcd\x8f\x72\xb5\x7f\x9f\xd4\x4d\xa1\xab\xac\x8c\x80\xec\x83\x32\x5e\xc6\x90\x70\xd0\xb0\x92\xf2\xf7\xb3\x00\x59\xcd\xbf\x95\xea\xa5\xf1\x3e\x96\x31\x95\xab\xa9\xad\x0f\xf7\xd9\xcd\xae\x78\x92\x50\x4a\x19\x38\x65\xcf\x72\xb2\x50\x8c\xed\x30\x3f\xe2\x81\xee\x31\xae\x7e\x89\xc1\x97\xb6\x74\xcc\xd1\x6e\xfc\xca\xf3\x83\x2d\x0a\x70\x6f\xfc\x04\x0c\x8b\xb0\xe9\xad\x81\x13\x9e\x73\x5a\x7f\x46\x1f\xa2\x49\x07\x12\x96\xe4\x0e\x34\xd2\xc1\x02\x4a\x3b\x94\x9f\x76\xf4\x04\x45\xf9\x48\x4a\xd7\x77\x01\x1e\xe3\xbf\xef\xa6\xfa\x5b\xf6\xca\x73\x97\x1f\xf0\x6c\x7f\x8c\x4b\x36\xed\x99\xca\x1f\xc8\xc8\x67\xcd\xf1\x19\x46\x46\x00\x20\x99\x5f\x6c\xa2\x57\x1b\xcb\xd4\xae\x2f\x87\xce\xb0\x3e\x3b\xf0\x10\xb9\x42\x7f\xe6\x91\xa6\xb8\x1d\xbf\x60\xd5\x0d\x92\x32\x2d\x42\x91\x16\x91\x14\xea\xda\xa0\xf4\x24\xbc\xa2\xed\xc8\x88\x78\x4f\x84\x5e\x11\x31\x9d\x89\xb2\x02\xf3\x55\xcf\xc1\x80\xc7\xeb\x8b\x48\x11\x48\x41\x53\xe0\x93\xbf\xf2\xf6\x0a\x75\x24\x3c\x7b\x03\x1a\x25\xc7\x48\x5a\xc0\x5d\x62\xe6\xdb\x5e\xc7\xd4\xf8\x55\xa6\x76\x61\x03\x0c\xbe\xd9\xe9\x2c\x7e\x32\x44\x66\xd3\x46\xc2\x2f\xad\x58\xa7\x11\x0f\xc2\x9c\x9a\x7b\x16\xe1\x27\xc4\x95\xfe\xb4\x8f\xa4\xc1\xa1\x8a\x2c\x6c\x93\x14\x67\x5c\x98\x99\xe3\x04\xec\xd2\x69\x37\xa5\xb3\x97\x29\xfe\x9d\x0d\x62\x77\xf3\x99\x28\xf5\x56\xb7\xd0\xa7\x98\xe5\x4f\x79\x44\xb8\xce\x31\x24\x3e\xb0\xcf\xbc\x02\x22\x0a\x04\xf2\x5a\x3a\x4e\x49\xb3\x6f\x59\xdc\x43\x78\xf5\xf6\xb7\x9e\xad\x76\x18\x57\xb1\xad\x50\x86\x34\x7e\xf9\x94\x19\x7f\xb7\xf1\x42\xd4\x3e\xd6\x5b\xbe\x8f\xcf\x0e\xe5\x1c\xf8\xe4\x01\x5a\x96\x95\x5d\x21\x43\x61\x98\x4c\xf3\xb6\x2e\x22\x4b\xf0\x87\xa5\x52\x2f\x23\xf1\x44\x9e\x44\x72\x3f\xe0\x18\xf5\x12\x79\x6f\xf7\xf8\x23\xe9\xbd\x2a\x88\x84\x71\x86\xe5\xeb\x6c\x3c\xd1\xc2\x23\x97\xe6\x7e\xc8\xb0\x1f\x34\x4c\x99\xd2\xbf\xdf\x5b\xcb\x1b\xe8\xf9\x8d\x15\x26\x36\xfe\xbb\x2d\xb2\x0d\xed\xec\x41\x8e\x8e\x6c\xb0\x40\x3a\x6d\x96\x33\xd9\x91\x07\xd5\x45\xa7\x82\xdc\x11\x3d\xd2\x16\x5e\xcb\xa2\x5f\xd0\x2c\x44\xa9\x60\x13\x9e\x46\xeb\xa4\xd3\xbc\xea\xa8\xf3\x86\xa5\xc0\xec\x63\xda\xec\x60\xfe\x76\xad\xf0\x6a\xc2\x0b\x49\x05\xde\x96\x88\xc5\xc9\xc5\x4f\xbf\x72\xde\x98\xea\x9e\xe7\x36\xac\xf1\x62\xa6\x0a\x9e\x60\x53\x2f\xc6\xa4\xcf\x5a\x57\xb3\xea\xc7\xe2\x4a\x56\xb6\x7f\x42\xde\xe2\x0e\xe5\xad\xe7\xc6\xa0\x5a\x9c\xdc\x53\x20\x4b\x3d\xaf\xbf\x5c\x85\x75\xab\x98\x7f\x28\xef\x04\xa9\x72\x97\x8e\x7f\x63\x6e\xe8\x69\x93\xfe\x43\x1d\xd0\xdf\xc3\xc4\x7d\x1b\x76\x53\xe8\x3e\xc1\xd7\x9d\x27\x07\xb1\x4f\x93\x50\x65\xd1\x3e\xe6\x9b\x95\x40\x1e\x72\x48\x33\x37\xbd\xf8\x81\x42\x9e\x31\x48\x96\xea\x06\x3f\xdb\x1a\x3f\x47\xaa\xc8\x7e\x95\x62\x6e\x3f\x56\xaf\x91\xce\x39\x5e\xb4\xa7\xbd\x37\xa6\x1f\xc5\x95\xde\xee\x39\xc2\xe6\xf2\x55\xa2\xf2\xb7\x17\x8f\x41\x52\x6b\xde\xf2\x31\x78\xf7\xf3\x27\x39\x54\x6d\x4b\x85\xec\xad\x39\x30\x18\xed\x92\xd0\x60\x63\x2d\xe0\xb9\xa6\x1b\x99\xf0\xe0\xb4\x52\x74\x32\x92\x94\xdc\x38\x65\x71\x2d\x80\x1f\xcc\x05\xc3\x76\x24\x40\xc9\x2f\x1c\x88\x75\x58\xce\xf1\x37\x22\xfc\x3f\x4e\x49\x64\x84\x76\xc3\x8e\xa4\x58\xdd\xb4\x1c\x0a\x51\x4b\x13\x52\x63\xf4\xad\x1f\x64\xb4\x02\xdc\x94\x99\xf4\x19\xc5\xc5\xef\x5e\xfd\x63\x1e\x78\x04\x06\xf7\x9d\x4c\xd7\x4a\x0f\x14\x64\xfa\xa9\x9d\xe4\xff\x1f\x38\xa8\x20\xad\xa4\xa9\xa9\x86\xf2\x6f\x4d\x78\xdf\x24\xcd\x55\x54\xd8\x8b\x42\xf7\x99\x0f\x8c\x6e\xd6\x59\xae\xb7\xcf\xa4\xa7\x66\x3c\x62\xbd\xe4\xc0\x40\xec\x95\xf3\xe6\x62\x8e\xc2\xe4\x58\x5e\x1d\xdb\xb3\x21\xd2\x2c\xa5\x80\xf8\x56\xf2\xba\x01\x74\xa9\x09\xeb\x92\xe6\xae\xaa\xd6\xdf\xf7\xa9\xff\xeb\x08\x39\x9f\x4e\xba\xa1\x32\x2a\x8f\xae\x6f\x42\x0e\x0d\x2c\xf5\xd5\x09\x3a\x12\xfe\x55\xca\x4f\xd5\xd4\xe7\x2a\xbd\x58\x74\x05\xb5\x39\x2a\x9b\xd3\xc0\x94\x56\x63\x87\xe9\xc8\x62\xd9\xb0\x84\xc2\x54\xb5\x45\x06\x9d\xac\x94\x42\x53\xc6\x63\x49\x02\x3f\x7b\x17\x0d\xb6\x57\x59\x10\x7a\x99\x18\x66\x14\x1e\x5e\x99\x40\x8d\x18\x0a\x0d\xc3\x63\x93\xb6\x66\xb7\xfd\x85\xb2\x83\x1d\x76\xa4\x92\x97\xbe\x39\x09\xbf\xa5\x2b\x06\x98\x83\x7b\xbf\x26\x03\x56\x07\xe8\x6c\xd6\xae\x44\xea\x65\x1d\xda\x25\x5f\xa1\xd3\x43\xbe\x3e\xa6\xfd\x59\x98\x52\x2c\x29\xf4\x51\x15\x36\x90\x21\x3e\x3e\xf1\x83\xf8\xcd\xc4\x99\xc4\x6e\x2f\x82\x3d\x2f\xd7\x23\x1a\x43\xae\xd2\x04\x39\xfa\x47\x9a\xa9\x47\x9f\x43\x44\x05\xc8\xbb\x78\x9b\x09\xb7\x0b\x24\xfc\x56\x6d\xc5\x1a\x07\x26\xd8\x02\x30\x0a\xfb\x41\x99\x10\x0f\x0a\xac\x10\x65\xef\x6d\xb3\x06\x06\x50\xe1\xca\x73\x5f\xe2\x86\xd9\x72\x83\xc7\x9c\x8d\xbe\x9f\x7a\x74\x2b\x4b\x33\xe9\x2c\xd7\xef\x83\xb6\x1a\xf0\x5b\x58\xff\xf7\xa3\x60\xb0\xfd\xd4\x60\x79\x7b\x66\x07\xa6\x5e\xc9\xd9\x3e\x53\x98\x6c\x21\x6e\x71\xf8\x2b\x7a\xf8\xe7\xb6\x43\x5d\x86\x12\x90\x12\x3e\xdc\x3d\xe8\x84\xe1\x11\x5d\xcf\x31\x03\xff\x86\x7d\xd7\xbf\x16\xe5\x81\xa1\x55\x89\xf6\xa3\x1f\xad\xcf\xe8\xbc\x82\xb2\x08\xa3\xf6\xeb\xc9\x63\xe3\xe6\xe5\xe8\x47\x6e\xa2\x82\xf8\xc2\x95\x8c\xd2\x2a\x75\xbb\x17\xaf\x2d\x90\x68\xbe\xef\xd9\xc7\x8a\x60\x2b\xc5\x39\x52\x49\x9c\x62\x23\x99\x05\xd0\xd2\x5e\xa1\x8e\xdb\x56\x66\x8f\x5b\xbd\x36\xcb\x88\xc3\x83\xc8\x88\x24\xc9\x22\x7e\x3f\x7c\xb4\xb5\x30\x4b\xf5\xe9\xd5\xc6\x59\xb0\xab\x36\x10\x90\xd1\x57\x54\x26\x57\x57\xe6\xd7\x7b\x39\x67\xb4\x72\xc5\xa3\xd9\x9b\xe0\xef\x1d\x7c\xf1\x30\x7b\x33\xb1\x85\xff\x2a\x4e\xec\xb5\x2f\x49\xed\xd4\x66\x43\x5e\x81\x75\xc5\xab\x19\x10\x7e\x7f\x43\x99\x32\x86\x28\x41\xba\xf7\x11\xad\xa1\xb1\x90\x96\xb6\x8a\x8b\xd5\x90\x58\xa1\xd3\xc4\x7b\xd6\xe8\xe9\x1d\x93\x26\x48\xb3\x9e\x7e\x5b\xb3\x5b\x8a\x70\x65\xd0\xbe\x4a\x75\x82\x9d\x77\x5b\x62\x9e\x08\xf2\xcb\xb8\x95\xef\x25\xaf\x91\x96\xcf\x5b\x86\xdc\xb1\x2f\x1a\x10\xed\xef\x5a\xde\xba\x9a\x38\x48\x69\xbb\x48\xd2\xb6\xfb\xa5\xde\x8c\xcb\xa8\x43\x3e\x9f\xc0\x68\xa5\xf6\x7d\xb3\x0e\x66\xea\xa6\x2e\x03\x2c\x6a\x93\x8b\x1a\x5f\x24\xda\x26\xdd\x7a\x16\x55\x01\xa8\xcb\x88\xb1\xd7\xd7\xdd\x75\xc9\x05\xbc\x6a\x2b\xe4\xab\x86\x53\xf9\x9f\x27\x12\x2c\x51\xcb\x64\xf8\xbd\xbc\xce\x53\x2b\xd3\x8e\x9f\xa2\xba\xdc\x2b\x0d\x2a\x9b\xb0\x1e\xe7\x1a\x5d\x8f\xb8\x7d\xe1\xd2\x75\xd8\x12\x97\x93\x03\x07\x38\xf6\x03\xb9\x73\xf2\x90\x62\xd7\xcf\x5f\xe4\x82\xec\x68\x65\xf4\x43\xf8\xb2\x05\xae\xd4\x7c\x65\x76\xe8\x9b\xad\xf9\x19\x6c\xbb\xbd\x4a\x70\x20\x9b\xdf\xf7\x17\xad\xee\x60\xa1\xfb\x47\x18\x7f\xd4\xeb\x02\xc2\x96\x95\xbb\x67\x02\x5f\x2d\xf2\xfc\x79\xd6\xb9\x6d\xfe\xaa\x39\x83\xc6\xe8\x76\x2f\xb2\x95\x7c\xf1\xef\xbd\x1f\xbc\x8e\xb2\x61\x09\x09\x0c\x19\x3a\xed\xc5\x03\x3a\xc4\x99\xbb\x3b\x27\x05\x56\x44\x3f\xc2\xb2\x9a\xeb\xdb\xa8\x8a\x43\xf6\x0b\xf8\x0d\xd4\xae\x62\xbf\x1e\x3d\x39\xdc\xfb\x6b\x75\xe0\xa6\x15\xcf\xfc\xb2\xfc\x66\xfe\xa3\xd0\x16\xb9\x6c\x48\x71\x56\x85\xfa\x4e\x8f\xcb\xf0\x95\xc9\x26\xe9\x95\xf2\x7a\xa0\xa0\x92\x99\x94\x24\xd6\x71\xef\x03\xe5\x48\x6c\xce\xae\x99\x6a\x4c\x6d\x9c\x44\x6f\x0a\xf2\xe9\x5e\xfd\x89\x44\x1d\x88\x33\x81\xa8\x1f\x2b\xba\x7c\xae\xf9\x21\x4e\x9c\xd5\x13\xe9\x5c\xfa\x42\xd8\xf7\x9d\xc1\x49\x26\x45\x6f\x62\x78\xb3\x6b\x3b\x81\x21\x28\x79\x4f\xd9\x34\x3f\xba\xbe\x8d\xba\x58\x8e\x4d\xed\x3e\x1f\xa9\x54\x86\x73\xb2\xae\x39\xfc\x45\xae\xa4\xd5\x76\x98\xac\x09\x00\x58\x4f\x49\x9c\xf9\xdc\x37\x7b\x29\xdb\xb4\x97\x88\xc7\x5b\x96\x0d\x29\x7a\xe6\x27\x4b\x24\xca\x0d\x4c\x13\x8b\xce\xc9\x09\x4d\x7d\xc9\xfb\x23\x31\x5d\x42\x76\xfc\x23\x0d\xf5\x4f\xda\xe4\x4e\x95\x12\xda\x7e\x5a\xbb\xec\xa7\xb7\xd4\x05\x3d\x36\x98\xa1\x61\xb1\x51\x8b\x4f\xfa\x70\x1a\x56\x09\x4e\xd5\xf7\x3a\x71\x0c\xe4\x53\x5f\xf5\xfa\x6c\x09\x48\x83\xfa\xeb\xb2\x58\xcf\x20\x56\xc0\x96\xae\x83\xfc\xba\x9a\xd8\xb2\x4a\x49\x52\xe6\x60\x6c\xd8\x56\x54\x04\x3f\x13\x1f\xfe\x41\xaf\x75\x1d\x5e\x3c\x25\x97\x6f\x62\x92\x4f\xad\x39\xd1\xb2\x82\x5f\x46\x3a\xae\x21\xc5\x34\xaf\x1b\xc1\x8d\x17\x37\xc7\x99\x38\x6b\x53\x87\xd5\xfa\x15\xd7\x83\xe8\xd4\xc7\x57\x6d\x5a\x77\xd7\xa3\x01\x5d\x9d\x80\x83\x37\x69\xe5\x36\x9d\x62\xe6\x67\x7d\x5d\xef\x08\xb9\x3a\xfa\xd5\xfd\x55\x04\x0c\xed\xd8\x59\xcb\xde\x62\xc7\xa2\x0b\x2c\xd3\xe6\x9b\x23\x8b\x93\xa6\xf3\xcd\x9c\x01\x27\x63\x65\xc1\x31\xdc\x91\xa8\xb8\x32\x3b\x01\x03\xbd\x2a\xab\xbc\xa7\x89\xd6\xa6\xe8\xc9\x0e\x2e\xe0\xb0\x18\x8a\x2d\x2d\xb5\xac\x31\x4d\x3c\x56\x10\xcb\xca\x7e\xaa\x09\xa8\x2d\xfb\xb2\xc0\x9c\x80\xab\x51\x13\x36\x5c\xfa\x18\xff\xe4\x51\xc5\xbd\x42\x7b\xb1\xc4\x7b\xad\x8a\x51\x35\x43\xcb\x37\x53\x0a\x2c\x65\x21\xc7\x9e\x2f\x49\xd4\x7c\xf5\x05\x7f\xf1\xd7\xa2\x5a\xcd\x8e\x73\x3d\xab\x3b\x3c\xf7\x07\xc4\xd6\xa9\xfd\x89\x8f\x9e\x59\xff\x2d\x7f\x59\x77\xb7\xe4\xfa\x60\x19\xd8\x14\x1f\xcb\x83\x7a\x7a\x93\x70\xff\xa5\xfa\x07\x8c\x68\x58\x33\x59\x30\xdc\xb0\xa8\x7d\xa9\xdf\x6b\x8c\x07\xcb\x4a\x09\x85\x2d\x8f\xc7\xbf\x2f\xe6\xa5\x0f\x89\xb2\x5d\xa2\xd6\xc5\xdf\x58\x1e\x96\x4f\xb3\x8e\x81\x09\x6f\x6d\x58\x9f\x5d\x8d\x8c\xad\x8b\xd2\x74\x68\xa9\x78\xf8\xb8\x8a\x9b\xed\xc4\x4f\x97\x52\xd6\xff\xd6\x8b\xd6\x0a\x4d\xf8\xe8\x4c\x2d\xec\xd6\xe3\x6b\x07\x6e\xe9\x58\xb6\x65\x0f\x65\x17\x11\x13\x14\xe4\xad\x96\xeb\xa1\xb4\xb9\x58\x8b\x1c\x29\x53\x35\xf6\xd5\x3c\x04\xbb\x10\x74\x89\x89\xce\x3e\xbe\x0b\xff\x16\x51\xa9\xd7\x98\xdf\x51\x48\xba\xa7\x55\x63\x65\x27\xe7\xaa\xc0\xf2\xf9\x02\x8d\xc8\x68\x19\x7b\xd6\xea\xad\x0d\x1c\x53\x47\xc5\x34\x3f\x90\x5b\x71\x7c\x74\x77\xa6\x2b\xf9\xf8\x41\x4e\xd9\x62\x98\x7b\xa3\xdf\xd9\x76\x70\xa0\xde\x29\x87\x4c\x79\x79\x41\x98\x3a\x25\xdf\xac\x87\xf3\x91\xae\x19\xec\x2f\x77\xc0\xf6\x39\xed\x8d\x88\xdd\x42\x13\xe1\xb0\xc6\x0b\xc8\x27\xa1\x2f\xe6\x94\xa7\x71\x49\x1e\x2f\xdf\x9f\x89\xb3\x26\x77\x58\x75\x45\xa2\x3f\x78\xee\x7a\x88\x39\x57\x5b\x92\x7c\x05\x79\x0c\x2c\x44\xcd\xe1\xd3\x2c\xd8\x7e\x1a\xa2\x98\x11\x88\x55\x9c\x8e\x31\x1f\xb1\x8c\x45\xb2\xf3\x81\x9b\xff\xbf\xf7\xdd\x25\xe0\x5f\xba\x49\xc9\x08\x08\xfe\x77\xd7\xce\x53\x30\xee\x57\x17\xe9\xcf\xa2\x5b\x8e\xb5\x0a\x11\xa2\xaf\xdd\xf5\x7e\xfe\xd8\xd9\xc8\xfe\x18\xe5\x8b\x7f\xb2\x66\xc3\x25\x35\xd7\xf3\x4a\xcd\xaa\xbf\x97\x66\x75\xb5\xca\x66\x9d\xcc\x87\x35\x78\x8b\x4a\x99\x51\xe3\x1f\x03\x21\x3d\x75\xec\x47\x16\x77\x25\xe5\xa5\x91\xa4\x86\x46\x52\x3b\xac\xa3\x42\x5f\x6e\x8d\x72\xc5\x92\x83\x92\xca\x5d\x17\x7e\xd6\xe2\x1a\x75\xd9\x69\xde\xc6\x85\x02\x6f\x04\x15\x4e\xf3\x92\x01\x2d\x11\x47\x77\x19\xcd\xfe\x07\xb9\xf2\x18\xc6\x8d\xaa\xb4\x38\xd5\xd5\xdf\x7f\x01\xea\xa6\xb4\x1d\x7a\xa1\x3e\x37\x5b\x9b\xc5\x22\x1b\x84\x89\x88\x3f\xd9\xfc\x1f\x27\x1b\x63\x96\x7d\x9d\x7e\xb9\xd0\xbf\x00\x96\xf5\xa8\xf0\xe0\x21\x4a\x65\xf9\xdc\x96\x27\x7f\x20\x90\xe0\xab\x58\xfa\x59\x17\x9b\xa3\x2d\x57\x37\x91\x5b\x18\xda\x24\xa7\x98\x39\xaa\x85\x5a\x59\x7b\xe2\x79\xc1\xe2\x07\xa5\x0d\x33\x10\x4e\x1e\x03\x6d\x9f\x66\x86\xfd\xe1\x00\xb4\xbc\x4f\xaa\xbf\x5e\xeb\x42\xbf\x53\x59\xe4\x72\x45\x56\x2e\x5f\x45\x25\xee\xb9\x55\x65\xb2\xfe\xce\xec\xc0\x81\xd4\xce\x3c\x35\xf6\x6c\xf0\x99\x30\x2f\xef\xe4\x0d\x01\x69\x88\x70\x0f\xc3\xdf\x68\x95\x3a\x7f\x2d\xa4\xae\xf5\xa0\x9e\x52\x63\x49\x66\x37\xa0\xe1\xcd\x4b\x8f\x4c\xbf\xb7\x46\x09\x1d\xf4\xbf\x09\x91\x87\x0d\xfc\xac\x9d\x82\xb9\xa1\xa9\x92\x3f\xb5\x9f\x30\xa1\x04\x51\x9d\x92\xb7\x9e\x5a\x27\x29\x8a\xe6\xd7\xb8\xb6\x3b\xb6\x3d\x07\x54\xaf\x6d\xdd\x4b\xee\x27\x5d\x9a\xee\xfc\x9e\xc8\x4f\x95\xde\x70\x0c\x5d\x92\xa2\xa1\x27\x6d\xa5\x83\xf8\x6c\x9e\x7f\xd4\x8c\x93\xc3\xf6\xd3\x5b\x3e\xe9\xf0\xc0\xb0\x1f\x63\x03\xad\xc8\x81\x70\xf9\xb6\x4b\xce\xb3\x47\xda\xaa\x9d\x78\x2e\x11\xa0\x94\xa5\xfd\x6d\x7c\x19\x56\xe5\xd4\x69\xdf\xf7\x44\x2c\x00\x97\x7f\xd9\xfb\x46\x07\x64\x21\xb0\xe9\x32\x16\x21\x33\x1f\x25\x79\xb3\xd1\xe5\x39\x5f\xae\x0d\x8b\x68\xbd\x98\x1f\x63\x91\x6a\xef\x09\xbf\x7a\x7c\xf2\x34\x32\xe8\xff\x1b\xaa\xe8\x78\x94\xee\x2a\x90\x52\x8b\x03\x9f\x2f\x0d\xe9\xbe\x40\xdf\x80\x15\x79\xd7\x18\x80\xa0\x47\x1e\xd1\x42\x7b\x6e\xc0\xc5\x58\x66\xd1\x00\x03\x4a\xa3\x15\xc5\xe3\x7e\x19\x0b\xd4\x10\x1e\xde\xee\xe0\x86\x8c\x62\x20\xab\xa7\xae\x82\x82\x57\x92\x2e\xb8\x8b\x5c\x3e\x31\xb2\x30\xc7\xe7\xdc\x62\xd2\x56\x43\xee\x3e\x55\xd5\x16\xb4\x85\xb0\x35\x98\x31\x6a\x29\xa1\x88\x1a\xa3\x08\x7e\xbc\xe1\x6b\xa2\x3d\xa2\x7c\x1c\xcd\xb9\x7f\xdc\x80\x89\x95\x90\x59\xd0\xe4\xa8\x12\x27\xef\xe8\x65\xa8\x61\xc2\xf9\x59\xa6\xcb\xe1\x2c\xb0\xf6\x8d\x86\xb0\x8b\x7a\x80\xc5\x7b\xd3\x2e\x85\x87\x08\x26\xf4\xa1\xa7\xc9\x88\x09\xf6\x5d\x3b\xda\x26\x4c\x03\x39\xed\x25\xb6\x26\x33\x37\x8a\x47\x2b\x9b\xaf\x39\x63\xf9\xc8\x26\x79\x85\xec\xa0\x53\x59\xc5\x8b\x0b\xa2\x54\x21\x54\xf9\x67\x68\x81\xcc\x9d\xab\xad\xd4\x4e\x82\x22\xf9\x68\xeb\xce\x6f\xa5\x7b\xa4\xb4\xb5\x5f\xbd\xa3\x33\x32\xea\xf3\x0e\xf1\xb9\xb4\xcb\x27\x99\x99\x5f\x30\xfb\xbf\x5f\xd6\x1b\x7b\xcd\x0c\x50\x5b\xd1\x97\xf7\xee\x66\xc1\x1e\x42\x00\x36\xae\x13\xb0\x87\x10\x86\x21\x3b\xce\x42\x86\x3f\x26\x25\x62\x3a\x75\x25\x7e\xd0\x32\xe5\x05\xe8\xf1\xdc\xb1\xec\x58\xb5\xc9\x95\x0d\x2c\xcf\x53\xa7\xd8\xae\xc1\x2f\x50\x03\xf5\xae\x42\x5e\x59\x8f\x58\x0c\xf3\xd0\xca\x1f\x13\x67\x27\xf7\xcd\x91\x5b\xe0\xae\x8d\x4b\x46\xb4\x1a\xc8\x19\x0b\x9a\x7d\xc4\xbc\x5d\x9b\x1f\x93\x2f\x98\x92\x69\xd9\xbf\x7f\xbc\x80\xf9\x92\x21\x68\xfa\x36\x5e\x47\xbb\x90\xc6\xdd\x00\x46\xa9\xb3\x57\x85\x4b\x84\x80\x26\x9a\xc8\xa2\x7a\x84\x8d\xab\xeb\x35\x2d\xd3\x3d\x00\xb5\xd9\x41\x57\x35\xdc\x20\x5e\x61\xf2\xd7\xa7\x27\x4c\x1f\x0d\xf8\xe8\xbe\xd0\xb6\x8f\xf3\xf3\x48\x65\x58\x3b\x60\x94\xc5\xfe\x40\x2e\x99\xd3\x45\xd6\x2d\x79\x65\x4b\x5b\xcb\x2f\xb9\xc1\x1d\x51\xea\x34\x3f\x7a\x67\xb1\x13\xee\x5b\xb5\x9c\x31\xa7\xea\xbc\x51\x99\xc9\xd0\xdf\x19\x1a\x70\xf5\xf8\x24\xc4\x3e\xd2\x20\xc2\x79\x86\x61\x2f\x38\xb7\xe4\x96\x5f\x84\xa4\xb7\x80\x9f\xac\x13\x01\x64\xb4\x89\x11\xbe\x4e\xeb\xe3\x49\xf3\xd3\xe0\xcf\x25\x35\x4a\x19\x70\x2a\x52\xe3\xf6\xd4\xb5\x88\x3e\xd8\x4b\x3d\x43\x6a\xd4\x5f\xfd\x79\x00\xae\x4c\x3f\x27\x49\x0c\xb7\xf1\xfb\xb8\xf5\x97\xc3\x4b\xf0\x02\x82\xef\x55\x0e\x47\xab\xaf\x11\xe2\x8c\xb4\xb7\xb8\xf3\xe5\x0d\xe1\x8e\xbc\xf3\x7b\x7a\x12\x5e\x5a\x76\xf2\xa6\x12\x42\xe6\x69\xcc\x7f\xff\x49\xc7\x17\xb6\xe3\x74\x4d\xe7\xa2\x22\xf1\x9e\x6c\x65\x1f\x9e\xa2\x8e\xe1\x60\x38\x4f\x84\x58\x6e\xd8\xa1\x30\x5b\x3b\x30\xcb\x5b\xa9\xa9\x2e\x3d\xbd\x9a\xba\xe0\xad\xae\x1d\xb7\x39\xb9\xf2\x4a\xbc\xc5\x1f\x3a\x0b\x29\x19\xb7\x21\x39\x70\xf5\x09\x5f\xf1\xbe\x22\xca\xec\x39\xb4\xa3\x39\xaf\xa6\xfa\x45\x58\x92\x49\x47\x60\x85\x53\xe6\x58\x9b\x95\x1e\x3a\x30\x8a\xfc\x5d\x26\x1f\xb0\xd9\xd6\x23\x4e\x8f\x5c\xfc\x11\xed\x45\x63\xf8\x12\xed\x8d\xa6\x5a\x06\x2b\xa3\x5b\x37\x51\xdb\xa6\xfb\x33\xad\x4e\x97\x1b\x58\xa4\x23\xb1\xec\xbd\xe1\xf6\xdf\xd6\x75\x13\x2d\x05\x9e\x98\x44\xb6\x06\xf3\xad\x3c\x99\xbf\x89\x55\xe9\xcb\xe8\x61\xb1\x92\xd3\x0d\x8b\x2e\x54\x65\x02\x64\x6b\xbb\x69\x49\xdf\x90\x3f\x3b\x93\xc6\xbf\xc5\xe6\x68\xbd\x00\xc5\xd3\x44\xfc\x1e\xf6\x89\xc2\x96\xc1\x84\x3d\x7a\x4c\x0c\x28\x58\xed\xf6\x48\x1e\xf1\xf3\xd1\x12\x21\x16\x95\x75\xc1\x0a\x9f\x25\x63\xd3\xab\x0e\x7f\x83\xd2\x1d\xb6\xbd\x4d\xdf\xea\xdb\x7b\x34\x20\x17\xcb\x20\xcd\x2b\x30\xd5\x13\xa8\xad\xda\x4f\x09\x41\x92\x2a\xcd\x3f\x75\x3b\xc9\x53\x09\x54\x65\xc7\x92\x16\x89\x0b\x38\xc2\x57\x9e\xf9\x26\x5e\x5f\xe5\x6d\xdc\xf4\xe0\x4d\x43\x12\x66\xb7\x6a\x06\x67\x66\xee\xe7\x71\x9d\x21\xa6\xff\xee\x9e\x05\x3c\x81\xa9\x57\x89\x8f\x6c\xc7\xf2\x7f\x00\x00\x00\xc8\x48\x28\x88\x97\x7f\x32\x0a\xfc\x7f\x02\x00\x00\xff\xff\x8e\xd9\x00\x07\x67\xee\x00\x00"
-
-func assetsVendorsBootstrapLanguagesLanguagesPngBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsBootstrapLanguagesLanguagesPng,
-		"assets/vendors/bootstrap-languages/languages.png",
-	)
-}
-
-func assetsVendorsBootstrapLanguagesLanguagesPng() (*asset, error) {
-	bytes, err := assetsVendorsBootstrapLanguagesLanguagesPngBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/bootstrap-languages/languages.png", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesConfigMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\x4d\x6b\x13\x41\x18\xc7\xff\x8d\x11\x21\x17\xa1\x50\xf0\xe0\xe1\xe9\xa1\x45\x0f\x5b\x77\xab\x87\xb2\xcd\x26\x62\x6d\x41\x9a\x80\x94\xe8\xd9\x31\x99\x6e\x97\x6e\x66\xc2\xce\x6e\xb1\xd0\x43\xa3\xf8\x76\xb1\x27\xa1\x88\x17\xbf\x41\x28\x04\x43\x62\x72\xf3\xfe\xcc\xc1\xab\x9f\x45\x36\x2f\x8a\x73\x99\xff\xcb\x6f\xe6\x79\x7e\x2f\x17\x3f\x03\xc0\x75\x00\xb7\x01\x3c\x06\x70\x03\x40\x8c\xd9\xf9\x00\x60\x19\xc0\x47\x00\x2b\x00\xbe\xce\xfd\x15\x80\x9b\x00\x7e\x02\xa8\x2e\x01\xbf\xe6\x7e\xa5\x00\xdc\x02\xb0\x56\x00\x56\x01\x54\x0a\xc0\x3a\x80\x17\x05\x60\x09\xc0\x35\x00\xc5\xf9\xdf\x85\xf9\x9d\xcf\x46\x53\xab\xc3\x28\x2c\xee\x68\x7d\x1c\x49\x52\xa2\x2d\x17\xd1\xbe\x3c\xa5\x48\x91\x91\xc6\x44\x5a\x2d\xd2\x9a\x6e\x8a\x58\x92\x11\x27\x0b\xd0\x49\xc5\xcb\x62\xe4\x6d\x29\x1c\xc8\x8e\x4e\x52\xa7\x6e\xc2\xa8\xe5\x3c\xca\x42\xe3\x34\xb4\x4f\x2d\x79\xf2\xf0\x38\x3a\x12\x6d\xbd\x91\x64\xa5\x9a\x30\xa9\xd3\x48\x84\x32\xb1\x48\x75\xe2\xd3\xfe\xb4\xa2\x7a\x96\x88\xb6\x6e\x69\x2a\xff\xc7\x57\x4a\x35\xa1\xc2\x4c\x84\xd2\x69\x48\xd1\xf6\xe9\xaf\xf7\xe9\x20\x33\x26\x12\xaa\x54\x7f\x52\xdf\x75\x9e\xcb\x24\x5f\xd3\x27\x6f\xc3\x2d\xed\x68\x95\x4a\x95\x3a\x8d\xd3\x8e\xf4\x29\x95\xaf\xd2\x7b\x9d\x58\x44\x6a\x9b\x9a\x47\x22\x31\x32\x0d\x9e\x35\xf6\x9c\xad\x7f\x5c\xbe\xcf\xa1\x4c\x9c\x5d\xd5\xd4\xad\x48\x85\x3e\x95\x9e\xc6\x59\x22\x62\x67\x4f\x27\x6d\xe3\x93\xea\x4c\xad\x09\xee\x6f\xd3\x4c\x06\x6a\xcd\x73\x83\xc0\xa3\xf5\x75\xca\xa5\xbb\x1a\x78\x1e\x55\xc9\x25\x7f\xea\x2b\xc1\xe6\xa2\x2a\x07\x0f\x72\x79\x67\x8a\x95\x3d\x97\xce\xce\x66\x4f\x2a\xc1\xa6\x7b\x97\xaa\xe4\x91\x4f\x9b\xdb\xe0\x4b\xfe\x61\x2f\x88\x87\xf6\x0d\x0f\x79\x00\xfe\xc2\x23\xfb\xc9\xbe\x27\xbe\x22\xdb\xe5\xbe\xed\xda\x2e\x0f\xf2\xe2\x1b\x4f\xec\x5b\x7b\xce\x3d\x1e\x73\x9f\xc7\x3c\xe0\x3e\xf1\x88\x27\x3c\xe4\x1e\x8f\x72\xe2\x92\xc7\xf6\x35\xf7\xed\x39\x8f\xb9\x67\xdf\xf1\x80\x27\xb9\xca\x4b\xfe\x3e\x4b\xec\x05\xfe\x04\x00\x00\xff\xff\xac\x69\x05\x98\x87\x02\x00\x00"
-
-func localesRuLc_messagesConfigMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesConfigMo,
-		"locales/ru/LC_MESSAGES/config.mo",
-	)
-}
-
-func localesRuLc_messagesConfigMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesConfigMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/config.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"templates/views/toolbar.html": templatesViewsToolbarHtml,
-	"assets/vendors/bootstrap-languages/languages.min.css": assetsVendorsBootstrapLanguagesLanguagesMinCss,
-	"assets/vendors/bootstrap-languages/languages.png": assetsVendorsBootstrapLanguagesLanguagesPng,
-	"locales/ru/LC_MESSAGES/config.mo": localesRuLc_messagesConfigMo,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"assets": &bintree{nil, map[string]*bintree{
-		"vendors": &bintree{nil, map[string]*bintree{
-			"bootstrap-languages": &bintree{nil, map[string]*bintree{
-				"languages.min.css": &bintree{assetsVendorsBootstrapLanguagesLanguagesMinCss, map[string]*bintree{}},
-				"languages.png": &bintree{assetsVendorsBootstrapLanguagesLanguagesPng, map[string]*bintree{}},
-			}},
-		}},
-	}},
-	"locales": &bintree{nil, map[string]*bintree{
-		"ru": &bintree{nil, map[string]*bintree{
-			"LC_MESSAGES": &bintree{nil, map[string]*bintree{
-				"config.mo": &bintree{localesRuLc_messagesConfigMo, map[string]*bintree{}},
-			}},
-		}},
-	}},
-	"templates": &bintree{nil, map[string]*bintree{
-		"views": &bintree{nil, map[string]*bintree{
-			"toolbar.html": &bintree{templatesViewsToolbarHtml, map[string]*bintree{}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
-
-func assetFS() *assetfs.AssetFS {
-	assetInfo := func(path string) (os.FileInfo, error) {
-		return os.Stat(path)
-	}
-	for k := range _bintree.Children {
-		return &assetfs.AssetFS{Asset: Asset, AssetDir: AssetDir, AssetInfo: assetInfo, Prefix: k}
-	}
-	panic("unreachable")
-}
@@ -2,24 +2,24 @@ package internal
 
 import (
 	"context"
+	"io/fs"
 	"net/http"
 	"reflect"
 	"strconv"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/i18n"
 	"github.com/mrsmtvd/shadow/components/i18n/internal/handlers"
 	"github.com/mrsmtvd/shadow/components/logging"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardRoutes() []dashboard.Route {
 	return []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
+		dashboard.RouteFromFS(c),
 		dashboard.NewRoute("/"+c.Name()+"/change/", handlers.NewChangeHandler(c)).
 			WithMethods([]string{http.MethodGet}).
 			WithAuth(true),
@@ -1,9 +1,9 @@
 package internal
 
 import (
+	"io/fs"
 	"net/http"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/logging"
@@ -11,8 +11,8 @@ import (
 	"github.com/mrsmtvd/shadow/components/logging/internal/handlers"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -38,7 +38,7 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 	}
 
 	return []dashboard.Route{
-		dashboard.RouteFromAssetFS(c),
+		dashboard.RouteFromFS(c),
 		dashboard.NewRoute("/"+c.Name()+"/", handlers.NewManagerHandler(c.global, levels)).
 			WithMethods([]string{http.MethodGet, http.MethodPost}).
 			WithAuth(true),
@@ -0,0 +1,115 @@
+package ota
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+const DefaultAutoUpdateInterval = time.Hour
+
+// AutoUpdater хранит политику автоматического обновления и выбирает релиз для установки.
+// Периодический запуск и сама установка выполняются компонентом
+type AutoUpdater struct {
+	mutex sync.RWMutex
+
+	repository Repository
+	version    string
+	channel    string
+	enabled    bool
+	interval   time.Duration
+	window     *MaintenanceWindow
+	reset      chan struct{}
+}
+
+func NewAutoUpdater(repository Repository, version string) *AutoUpdater {
+	return &AutoUpdater{
+		repository: repository,
+		version:    version,
+		channel:    ChannelStable,
+		interval:   DefaultAutoUpdateInterval,
+		window:     &MaintenanceWindow{any: true},
+		reset:      make(chan struct{}, 1),
+	}
+}
+
+func (u *AutoUpdater) SetChannel(channel string) {
+	if channel != ChannelBeta {
+		channel = ChannelStable
+	}
+
+	u.mutex.Lock()
+	u.channel = channel
+	u.mutex.Unlock()
+}
+
+func (u *AutoUpdater) Channel() string {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return u.channel
+}
+
+func (u *AutoUpdater) SetEnabled(enabled bool) {
+	u.mutex.Lock()
+	u.enabled = enabled
+	u.mutex.Unlock()
+}
+
+func (u *AutoUpdater) Enabled() bool {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return u.enabled
+}
+
+func (u *AutoUpdater) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultAutoUpdateInterval
+	}
+
+	u.mutex.Lock()
+	u.interval = interval
+	u.mutex.Unlock()
+
+	select {
+	case u.reset <- struct{}{}:
+	default:
+	}
+}
+
+func (u *AutoUpdater) Interval() time.Duration {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return u.interval
+}
+
+// Reset сигналит об изменении интервала проверки
+func (u *AutoUpdater) Reset() <-chan struct{} {
+	return u.reset
+}
+
+func (u *AutoUpdater) SetWindow(window *MaintenanceWindow) {
+	u.mutex.Lock()
+	u.window = window
+	u.mutex.Unlock()
+}
+
+func (u *AutoUpdater) Window() *MaintenanceWindow {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return u.window
+}
+
+// Candidate возвращает самый новый релиз канала для текущей архитектуры из уже загруженного
+// списка релизов, кроме версий skip. Если обновляться не на что, то возвращается nil
+func (u *AutoUpdater) Candidate(skip ...string) (Release, error) {
+	releases, err := u.repository.Releases(runtime.GOARCH)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewestRelease(releases, u.version, runtime.GOARCH, u.Channel(), skip...), nil
+}
@@ -0,0 +1,116 @@
+package ota
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	ChannelStable = "stable"
+	ChannelBeta   = "beta"
+)
+
+// Channeled реализуют релизы, канал которых задан явно, например в индексе репозитория
+type Channeled interface {
+	Channel() string
+}
+
+// ReleaseChannel возвращает канал релиза. Если канал не задан явно, то релизы с pre-release
+// версией (1.2.0-rc.1) относятся к beta, остальные к stable
+func ReleaseChannel(rl Release) string {
+	if c, ok := rl.(Channeled); ok {
+		if channel := strings.ToLower(strings.TrimSpace(c.Channel())); channel != "" {
+			return channel
+		}
+	}
+
+	if v, err := ParseVersion(rl.Version()); err == nil && v.Prerelease() != "" {
+		return ChannelBeta
+	}
+
+	return ChannelStable
+}
+
+// ChannelAllows сообщает, можно ли подписчику канала channel устанавливать релизы канала releaseChannel.
+// Канал beta получает и стабильные релизы
+func ChannelAllows(channel, releaseChannel string) bool {
+	if channel == releaseChannel {
+		return true
+	}
+
+	return channel == ChannelBeta && releaseChannel == ChannelStable
+}
+
+// ParseVersion разбирает версию релиза. У текущего релиза к версии через пробел дописана сборка,
+// она в сравнении не участвует
+func ParseVersion(version string) (*semver.Version, error) {
+	if fields := strings.Fields(version); len(fields) > 0 {
+		version = fields[0]
+	}
+
+	return semver.NewVersion(version)
+}
+
+// CompareVersions сравнивает версии, результат как у strings.Compare
+func CompareVersions(a, b string) (int, error) {
+	va, err := ParseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, err := ParseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return va.Compare(vb), nil
+}
+
+// NewestRelease выбирает самый новый релиз для архитектуры arch из канала channel, который новее
+// версии current. Релизы с версией не в формате semver, а также версии из skip пропускаются
+func NewestRelease(releases []Release, current, arch, channel string, skip ...string) Release {
+	currentVersion, err := ParseVersion(current)
+	if err != nil {
+		return nil
+	}
+
+	skipped := make([]*semver.Version, 0, len(skip))
+	for _, version := range skip {
+		if v, err := ParseVersion(version); err == nil {
+			skipped = append(skipped, v)
+		}
+	}
+
+	var (
+		newest        Release
+		newestVersion *semver.Version
+	)
+
+	for _, rl := range releases {
+		if rl.Architecture() != arch || !ChannelAllows(channel, ReleaseChannel(rl)) {
+			continue
+		}
+
+		v, err := ParseVersion(rl.Version())
+		if err != nil || !v.GreaterThan(currentVersion) || containsVersion(skipped, v) {
+			continue
+		}
+
+		if newestVersion == nil || v.GreaterThan(newestVersion) {
+			newest, newestVersion = rl, v
+		}
+	}
+
+	return newest
+}
+
+func containsVersion(versions []*semver.Version, v *semver.Version) bool {
+	for _, item := range versions {
+		if item.Equal(v) {
+			return true
+		}
+	}
+
+	return false
+}
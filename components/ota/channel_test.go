@@ -0,0 +1,258 @@
+package ota
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRelease struct {
+	version      string
+	architecture string
+	channel      string
+	content      []byte
+	signature    []byte
+}
+
+func (r *testRelease) Version() string {
+	return r.version
+}
+
+func (r *testRelease) File() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(r.content)), nil
+}
+
+func (r *testRelease) FileBinary() (io.ReadCloser, error) {
+	return r.File()
+}
+
+func (r *testRelease) Path() string {
+	return "/releases/" + r.version
+}
+
+func (r *testRelease) Type() FileType {
+	return FileTypeBinary
+}
+
+func (r *testRelease) Checksum() []byte {
+	return nil
+}
+
+func (r *testRelease) Size() int64 {
+	return int64(len(r.content))
+}
+
+func (r *testRelease) Architecture() string {
+	return r.architecture
+}
+
+func (r *testRelease) CreatedAt() *time.Time {
+	return nil
+}
+
+func (r *testRelease) Validate() error {
+	return nil
+}
+
+func (r *testRelease) Channel() string {
+	return r.channel
+}
+
+func (r *testRelease) Signature() []byte {
+	return r.signature
+}
+
+func TestReleaseChannel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		version string
+		channel string
+		want    string
+	}{
+		{"1.2.0", "", ChannelStable},
+		{"1.2.0-rc.1", "", ChannelBeta},
+		{"1.2.0-beta", "", ChannelBeta},
+		{"1.2.0+build.5", "", ChannelStable},
+		{"1.2.0 2021-01-01", "", ChannelStable},
+		{"not a version", "", ChannelStable},
+		{"1.2.0-rc.1", ChannelStable, ChannelStable},
+		{"1.2.0", " Beta ", ChannelBeta},
+	}
+
+	for _, c := range cases {
+		rl := &testRelease{version: c.version, channel: c.channel}
+
+		assert.Equal(t, c.want, ReleaseChannel(rl), "version %q channel %q", c.version, c.channel)
+	}
+}
+
+func TestChannelAllows(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		channel        string
+		releaseChannel string
+		want           bool
+	}{
+		{ChannelStable, ChannelStable, true},
+		{ChannelStable, ChannelBeta, false},
+		{ChannelBeta, ChannelBeta, true},
+		{ChannelBeta, ChannelStable, true},
+		{ChannelStable, "nightly", false},
+		{ChannelBeta, "nightly", false},
+		{"nightly", "nightly", true},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, ChannelAllows(c.channel, c.releaseChannel), "%s allows %s", c.channel, c.releaseChannel)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.0", "1.2.0", 0},
+		{"v1.2.0", "1.2.0", 0},
+		{"1.10.0", "1.9.0", 1},
+		{"1.2.0", "1.2.1", -1},
+		{"1.2.0-rc.1", "1.2.0", -1},
+		{"1.2.0-alpha", "1.2.0-beta", -1},
+		{"1.2.0-rc.2", "1.2.0-rc.10", -1},
+		{"1.2.0-rc.1", "1.1.9", 1},
+		{"1.2.0 2021-01-01", "1.2.0", 0},
+	}
+
+	for _, c := range cases {
+		result, err := CompareVersions(c.a, c.b)
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.want, result, "%s vs %s", c.a, c.b)
+	}
+
+	_, err := CompareVersions("1.2.0", "latest")
+	assert.Error(t, err)
+}
+
+func TestNewestRelease(t *testing.T) {
+	t.Parallel()
+
+	releases := []Release{
+		&testRelease{version: "1.0.0", architecture: "amd64"},
+		&testRelease{version: "1.1.0", architecture: "amd64"},
+		&testRelease{version: "1.2.0-rc.1", architecture: "amd64"},
+		&testRelease{version: "1.2.0-rc.2", architecture: "amd64"},
+		&testRelease{version: "1.3.0", architecture: "arm64"},
+		&testRelease{version: "2.0.0", architecture: "amd64", channel: "nightly"},
+		&testRelease{version: "broken", architecture: "amd64"},
+	}
+
+	cases := []struct {
+		name    string
+		current string
+		arch    string
+		channel string
+		skip    []string
+		want    string
+	}{
+		{"stable", "1.0.0", "amd64", ChannelStable, nil, "1.1.0"},
+		{"beta gets pre-release", "1.0.0", "amd64", ChannelBeta, nil, "1.2.0-rc.2"},
+		{"pre-release is older than release", "1.2.0-rc.2", "amd64", ChannelBeta, nil, ""},
+		{"current is newest", "1.1.0", "amd64", ChannelStable, nil, ""},
+		{"current with build", "1.0.0 2021-01-01", "amd64", ChannelStable, nil, "1.1.0"},
+		{"other architecture", "1.0.0", "arm64", ChannelStable, nil, "1.3.0"},
+		{"unknown architecture", "1.0.0", "386", ChannelStable, nil, ""},
+		{"skip rolled back", "1.0.0", "amd64", ChannelBeta, []string{"1.2.0-rc.2"}, "1.2.0-rc.1"},
+		{"skip with prefix", "1.0.0", "amd64", ChannelStable, []string{"v1.1.0"}, ""},
+		{"wrong current", "dev", "amd64", ChannelStable, nil, ""},
+	}
+
+	for _, c := range cases {
+		rl := NewestRelease(releases, c.current, c.arch, c.channel, c.skip...)
+
+		if c.want == "" {
+			assert.Nil(t, rl, c.name)
+			continue
+		}
+
+		if assert.NotNil(t, rl, c.name) {
+			assert.Equal(t, c.want, rl.Version(), c.name)
+		}
+	}
+}
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value string
+		want  string
+		err   bool
+	}{
+		{"", "", false},
+		{"  ", "", false},
+		{"02:00-05:00", "02:00-05:00", false},
+		{" 23:30 - 01:15 ", "23:30-01:15", false},
+		{"03:00-03:00", "", false},
+		{"02:00", "", true},
+		{"02:00-05:00-06:00", "", true},
+		{"25:00-05:00", "", true},
+		{"02:00-5", "", true},
+	}
+
+	for _, c := range cases {
+		w, err := ParseMaintenanceWindow(c.value)
+
+		if c.err {
+			assert.Error(t, err, c.value)
+			continue
+		}
+
+		if assert.NoError(t, err, c.value) {
+			assert.Equal(t, c.want, w.String(), c.value)
+		}
+	}
+}
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2021, 1, 1, hour, minute, 30, 0, time.UTC)
+	}
+
+	cases := []struct {
+		window string
+		t      time.Time
+		want   bool
+	}{
+		{"", at(12, 0), true},
+		{"03:00-03:00", at(12, 0), true},
+		{"02:00-05:00", at(2, 0), true},
+		{"02:00-05:00", at(4, 59), true},
+		{"02:00-05:00", at(5, 0), false},
+		{"02:00-05:00", at(1, 59), false},
+		{"23:00-02:00", at(23, 0), true},
+		{"23:00-02:00", at(0, 0), true},
+		{"23:00-02:00", at(1, 59), true},
+		{"23:00-02:00", at(2, 0), false},
+		{"23:00-02:00", at(22, 59), false},
+		{"23:00-02:00", at(12, 0), false},
+	}
+
+	for _, c := range cases {
+		w, err := ParseMaintenanceWindow(c.window)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.want, w.Contains(c.t), "%q contains %s", c.window, c.t.Format("15:04"))
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Команда ota-index формирует статический индекс релизов для клиента Shadow:
+//
+//	ota-index -dir ./releases -base-url https://cdn.example.com/releases -keep 5
+//
+// Версия и канал релиза берутся из файла .meta рядом с релизом, иначе версия ищется в имени файла,
+// подпись из файла .sig
+package main // import "github.com/mrsmtvd/shadow/components/ota/cmd/ota-index"
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/mrsmtvd/shadow/components/ota/repository"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "Directory with release files")
+	baseURL := flag.String("base-url", "", "Base URL of release files, empty for links relative to index")
+	output := flag.String("output", "", "Path to index file, - for stdout. Default is index.json in directory")
+	keep := flag.Int("keep", 0, "Number of last releases for each architecture, 0 keeps all")
+	flag.Parse()
+
+	if *output == "" {
+		*output = filepath.Join(*dir, "index.json")
+	}
+
+	records, err := repository.GenerateIndex(*dir, *baseURL, *keep, *output)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	content, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(append(content, '\n'))
+	} else {
+		err = ioutil.WriteFile(*output, content, 0644)
+	}
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -2,6 +2,20 @@ package ota
 
 const (
 	ConfigReleasesDirectory       = ComponentName + ".releases_directory"
+	ConfigDownloadsDirectory      = ComponentName + ".downloads_directory"
 	ConfigRepositoryServerEnabled = ComponentName + ".repository_server.enabled"
+	ConfigRepositoryServerToken   = ComponentName + ".repository_server.publish_token"
+	ConfigRepositoryServerKeep    = ComponentName + ".repository_server.keep_releases"
 	ConfigRepositoryClientShadow  = ComponentName + ".repository_client.shadow"
+	ConfigSignatureTrustedKeys    = ComponentName + ".signature.trusted_keys"
+	ConfigSignatureEnforce        = ComponentName + ".signature.enforce"
+	ConfigUpdateChannel           = ComponentName + ".update.channel"
+	ConfigAutoUpdateEnabled       = ComponentName + ".auto_update.enabled"
+	ConfigAutoUpdateInterval      = ComponentName + ".auto_update.interval"
+	ConfigAutoUpdateWindow        = ComponentName + ".auto_update.maintenance_window"
+	ConfigRollbackEnabled         = ComponentName + ".rollback.enabled"
+	ConfigRollbackDeadline        = ComponentName + ".rollback.deadline"
+	ConfigRollbackMaxBoots        = ComponentName + ".rollback.max_boots"
+	ConfigRestartReadyTimeout     = ComponentName + ".restart.ready_timeout"
+	ConfigExtractSideFiles        = ComponentName + ".extract_side_files"
 )
@@ -0,0 +1,20 @@
+package ota
+
+// ChecksumSHA256 реализуют релизы, для которых известна чексумма SHA-256. MD5 из Checksum
+// остается идентификатором релиза для совместимости, а при проверке предпочитается SHA-256
+type ChecksumSHA256 interface {
+	ChecksumSHA256() []byte
+}
+
+type DownloadProgress struct {
+	Downloaded int64  `json:"downloaded"`
+	Total      int64  `json:"total"`
+	Active     bool   `json:"active"`
+	Completed  bool   `json:"completed"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Downloadable реализуют удаленные релизы, загрузку которых можно отслеживать
+type Downloadable interface {
+	DownloadProgress() DownloadProgress
+}
@@ -10,13 +10,21 @@ const (
 	FileTypeUnknown FileType = "unknown"
 	FileTypeBinary  FileType = "binary"
 	FileTypeZip     FileType = "zip"
+	FileTypeTarGz   FileType = "tar.gz"
+	FileTypeTarXz   FileType = "tar.xz"
 )
 
 type FileType string
 
+// Extractable реализуют релизы в архивах, из которых помимо бинарника можно распаковать
+// сопутствующие файлы (конфиги, ассеты)
+type Extractable interface {
+	Extract(dir string) ([]string, error)
+}
+
 func (t FileType) String() string {
 	switch t {
-	case FileTypeBinary, FileTypeZip, FileTypeUnknown:
+	case FileTypeBinary, FileTypeZip, FileTypeTarGz, FileTypeTarXz, FileTypeUnknown:
 		return string(t)
 	}
 
@@ -29,17 +37,34 @@ func (t FileType) Ext() string {
 		return ".bin"
 	case FileTypeZip:
 		return ".zip"
+	case FileTypeTarGz:
+		return ".tar.gz"
+	case FileTypeTarXz:
+		return ".tar.xz"
 	}
 
 	return ""
 }
 
+func (t FileType) IsArchive() bool {
+	switch t {
+	case FileTypeZip, FileTypeTarGz, FileTypeTarXz:
+		return true
+	}
+
+	return false
+}
+
 func (t FileType) MIME() string {
 	switch t {
 	case FileTypeBinary:
 		return "application/x-binary"
 	case FileTypeZip:
 		return "application/zip"
+	case FileTypeTarGz:
+		return "application/gzip"
+	case FileTypeTarXz:
+		return "application/x-xz"
 	}
 
 	return ""
@@ -59,13 +84,22 @@ var (
 		{FileTypeBinary, []byte("\xFE\xED\xFA\xCF")},
 		{FileTypeBinary, []byte("\xCE\xFA\xED\xFE")},
 		{FileTypeZip, []byte("PK\x03\x04")},
+		// сжатые архивы определяются по сжатию, tar внутри проверяется при распаковке
+		{FileTypeTarGz, []byte("\x1F\x8B")},
+		{FileTypeTarXz, []byte("\xFD7zXZ\x00")},
 	}
 	fileTypeMIME = []struct {
 		fileType FileType
 		mime     string
 	}{
 		{FileTypeBinary, "application/x-binary"},
-		{FileTypeBinary, "application/zip"},
+		{FileTypeBinary, "application/macbinary"},
+		{FileTypeZip, "application/zip"},
+		{FileTypeTarGz, "application/gzip"},
+		{FileTypeTarGz, "application/x-gzip"},
+		{FileTypeTarGz, "application/x-compressed-tar"},
+		{FileTypeTarXz, "application/x-xz"},
+		{FileTypeTarXz, "application/x-xz-compressed-tar"},
 	}
 )
 
@@ -85,11 +119,32 @@ func FileTypeFromData(data io.Reader) FileType {
 	return FileTypeUnknown
 }
 
+// FileTypeFromFileName определяет тип по расширению файла, например app-1.2.0-linux-amd64.tar.gz
+func FileTypeFromFileName(name string) FileType {
+	name = strings.ToLower(name)
+
+	switch {
+	case strings.HasSuffix(name, FileTypeTarGz.Ext()), strings.HasSuffix(name, ".tgz"):
+		return FileTypeTarGz
+	case strings.HasSuffix(name, FileTypeTarXz.Ext()), strings.HasSuffix(name, ".txz"):
+		return FileTypeTarXz
+	case strings.HasSuffix(name, FileTypeZip.Ext()):
+		return FileTypeZip
+	}
+
+	return FileTypeUnknown
+}
+
 func FileTypeFromMIME(contentType string) FileType {
-	contentType = strings.ToLower(contentType)
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+
+	// параметры вроде charset в сравнении не участвуют
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = strings.TrimSpace(contentType[:i])
+	}
 
 	for _, m := range fileTypeMIME {
-		if strings.HasPrefix(m.mime, contentType) {
+		if m.mime == contentType {
 			return m.fileType
 		}
 	}
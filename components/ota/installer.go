@@ -6,21 +6,40 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/kardianos/osext"
+	"github.com/mrsmtvd/shadow/misc/listener"
 )
 
+const DefaultRestartReadyTimeout = time.Minute
+
 type Installer struct {
-	shutdown func() error
+	mutex sync.RWMutex
+
+	shutdown     func() error
+	verifier     *Verifier
+	readyTimeout time.Duration
+	extract      bool
 }
 
 func NewInstaller(shutdown func() error) *Installer {
 	return &Installer{
-		shutdown: shutdown,
+		shutdown:     shutdown,
+		readyTimeout: DefaultRestartReadyTimeout,
 	}
 }
 
+// SetVerifier включает проверку подписи релиза перед установкой
+func (i *Installer) SetVerifier(verifier *Verifier) {
+	i.mutex.Lock()
+	i.verifier = verifier
+	i.mutex.Unlock()
+}
+
 // очистка старых не используемых релизов при запуске
 func (i *Installer) AutoClean() error {
 	return nil
@@ -59,6 +78,17 @@ func (i *Installer) InstallTo(release Release, path string) error {
 		return err
 	}
 
+	// 1.1. Проверяем подпись до того, как бинарник попадет на диск рядом с текущим
+	i.mutex.RLock()
+	verifier := i.verifier
+	i.mutex.RUnlock()
+
+	if verifier != nil {
+		if err := verifier.Verify(release); err != nil {
+			return err
+		}
+	}
+
 	// 2. создаем файл path.new в него копируем новый релиз
 	newPath := path + ".new"
 	_ = os.Remove(newPath)
@@ -77,12 +107,25 @@ func (i *Installer) InstallTo(release Release, path string) error {
 	// windows
 	// newFile.Close()
 
-	// 3. переименовываем текущий файл в path.old
-	oldPath := path + ".old"
+	// 3. переименовываем текущий файл в path.old, он хранится до следующего обновления для отката
+	oldPath := path + PreviousReleaseExt
 	_ = os.Remove(oldPath)
 
+	// 3.1. маркер пишется до подмены бинарника, чтобы новый релиз гарантированно прошел проверку после запуска
+	marker := &UpgradeMarker{
+		Version:      release.Version(),
+		Path:         path,
+		PreviousPath: oldPath,
+		InstalledAt:  time.Now(),
+	}
+
+	if err = marker.Save(); err != nil {
+		return err
+	}
+
 	err = os.Rename(path, oldPath)
 	if err != nil {
+		_ = marker.Remove()
 		return err
 	}
 
@@ -90,16 +133,50 @@ func (i *Installer) InstallTo(release Release, path string) error {
 	err = os.Rename(newPath, path)
 	if err != nil {
 		// rollback
+		_ = marker.Remove()
 		return os.Rename(oldPath, path)
 	}
 
-	// 5. удалить старые релизы
+	// 5. удалить временный файл
 	_ = os.Remove(newPath)
-	_ = os.Remove(oldPath)
+
+	// 6. распаковываем сопутствующие файлы архива рядом с бинарником
+	if extractable, ok := release.(Extractable); ok && i.ExtractSideFiles() {
+		if _, err = extractable.Extract(filepath.Dir(path)); err != nil {
+			return errors.New("release installed, but side files not extracted: " + err.Error())
+		}
+	}
 
 	return err
 }
 
+// SetExtractSideFiles включает распаковку сопутствующих файлов из архива релиза при установке
+func (i *Installer) SetExtractSideFiles(extract bool) {
+	i.mutex.Lock()
+	i.extract = extract
+	i.mutex.Unlock()
+}
+
+func (i *Installer) ExtractSideFiles() bool {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.extract
+}
+
+// Rollback возвращает предыдущий бинарник, сохраненный при установке релиза
+func (i *Installer) Rollback(marker *UpgradeMarker) error {
+	if _, err := os.Stat(marker.PreviousPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(marker.PreviousPath, marker.Path); err != nil {
+		return err
+	}
+
+	return marker.Remove()
+}
+
 func (i *Installer) Install(release Release) error {
 	execName, err := osext.Executable()
 	if err != nil {
@@ -109,30 +186,121 @@ func (i *Installer) Install(release Release) error {
 	return i.InstallTo(release, execName)
 }
 
+// Restart перезапускает приложение без закрытия портов. Новый процесс запускается с теми же аргументами
+// и наследует слушающие сокеты, а текущий процесс завершается только после того, как новый сообщит
+// о готовности. Если новый процесс не стал готов, то он останавливается, а текущий продолжает работу
 func (i *Installer) Restart() error {
 	execName, err := osext.Executable()
 	if err != nil {
 		return err
 	}
 
-	err = i.shutdown()
+	dir, err := os.Getwd()
+	if err != nil {
+		dir = filepath.Dir(execName)
+	}
+
+	listeners, err := listener.Files()
 	if err != nil {
 		return err
 	}
 
-	execDir := filepath.Dir(execName)
+	names := make([]string, 0, len(listeners))
+	for name := range listeners {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	readyReader, readyWriter, err := os.Pipe()
+	if err != nil {
+		for _, f := range listeners {
+			_ = f.Close()
+		}
+
+		return err
+	}
+	defer readyReader.Close()
 
-	files := make([]*os.File, 3)
+	files := make([]*os.File, 3, 3+len(names)+1)
 	files[syscall.Stdin] = os.Stdin
 	files[syscall.Stdout] = os.Stdout
 	files[syscall.Stderr] = os.Stderr
 
-	_, err = os.StartProcess(execName, []string{execName}, &os.ProcAttr{
-		Dir:   execDir,
-		Env:   os.Environ(),
+	for _, name := range names {
+		files = append(files, listeners[name])
+	}
+
+	files = append(files, readyWriter)
+
+	process, err := os.StartProcess(execName, os.Args, &os.ProcAttr{
+		Dir:   dir,
+		Env:   listener.Environ(names),
 		Files: files,
 		Sys:   &syscall.SysProcAttr{},
 	})
 
-	return err
+	// дескрипторы уже унаследованы новым процессом, копии в текущем не нужны
+	for _, f := range listeners {
+		_ = f.Close()
+	}
+
+	_ = readyWriter.Close()
+
+	if err != nil {
+		return err
+	}
+
+	i.mutex.RLock()
+	timeout := i.readyTimeout
+	i.mutex.RUnlock()
+
+	if err = waitProcessReady(readyReader, timeout); err != nil {
+		_ = process.Kill()
+		_, _ = process.Wait()
+
+		// непроверенный релиз, который не смог запуститься, сразу откатывается
+		if marker, e := ReadUpgradeMarker(execName); e == nil && marker != nil {
+			_ = i.Rollback(marker)
+		}
+
+		return err
+	}
+
+	_ = process.Release()
+
+	return i.shutdown()
+}
+
+// SetReadyTimeout задает время ожидания готовности нового процесса при перезапуске
+func (i *Installer) SetReadyTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultRestartReadyTimeout
+	}
+
+	i.mutex.Lock()
+	i.readyTimeout = timeout
+	i.mutex.Unlock()
+}
+
+func waitProcessReady(ready *os.File, timeout time.Duration) error {
+	done := make(chan error, 1)
+
+	go func() {
+		// новый процесс пишет байт о готовности, при его завершении чтение вернет EOF
+		_, err := ready.Read(make([]byte, 1))
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return errors.New("new process exited before ready")
+		}
+
+		return nil
+
+	case <-time.After(timeout):
+		return errors.New("new process isn't ready before timeout")
+	}
 }
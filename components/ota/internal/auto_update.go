@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/ota"
+)
+
+func (c *Component) autoUpdateLoop() {
+	ticker := time.NewTicker(c.autoUpdater.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.autoUpdate()
+
+		case <-c.autoUpdater.Reset():
+			ticker.Reset(c.autoUpdater.Interval())
+
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *Component) autoUpdate() {
+	if !c.autoUpdater.Enabled() {
+		return
+	}
+
+	if window := c.autoUpdater.Window(); !window.Contains(time.Now()) {
+		c.logger.Debug("Skip auto update outside maintenance window", "window", window.String())
+		return
+	}
+
+	// пока предыдущее обновление не проверено, новое может помешать откату
+	marker, err := ota.ReadUpgradeMarker(c.currentRelease.Path())
+	if err != nil {
+		c.logger.Error("Failed read upgrade marker", "error", err.Error())
+		return
+	}
+
+	if marker != nil {
+		c.logger.Debug("Skip auto update while upgrade isn't verified", "version", marker.Version)
+		return
+	}
+
+	// ошибка одного из репозиториев не мешает обновиться из остальных
+	_ = c.Update()
+
+	// откаченный релиз не ставится повторно, иначе узел будет бесконечно обновляться и откатываться
+	skip := make([]string, 0, 1)
+	if last := c.LastUpgrade(); last != nil && last.Status == ota.UpgradeStatusRolledBack {
+		skip = append(skip, last.Version)
+	}
+
+	rl, err := c.autoUpdater.Candidate(skip...)
+	if err != nil {
+		c.logger.Error("Failed get releases for auto update", "error", err.Error())
+		return
+	}
+
+	if rl == nil {
+		return
+	}
+
+	c.logger.Info("Auto update to release",
+		"version", rl.Version(),
+		"channel", ota.ReleaseChannel(rl),
+		"path", rl.Path(),
+	)
+
+	if err = c.installer.Install(rl); err != nil {
+		c.logger.Error("Failed install release", "version", rl.Version(), "error", err.Error())
+		return
+	}
+
+	if err = c.installer.Restart(); err != nil {
+		c.logger.Error("Failed restart after auto update", "version", rl.Version(), "error", err.Error())
+	}
+}
+
+// при ошибке разбора остается прежнее окно, как и для доверенных ключей
+func (c *Component) setMaintenanceWindow(value string) {
+	window, err := ota.ParseMaintenanceWindow(value)
+	if err != nil {
+		c.logger.Error("Failed parse maintenance window", "error", err.Error())
+		return
+	}
+
+	c.autoUpdater.SetWindow(window)
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/kardianos/osext"
 	"github.com/mrsmtvd/shadow"
@@ -13,16 +14,25 @@ import (
 	"github.com/mrsmtvd/shadow/components/ota"
 	"github.com/mrsmtvd/shadow/components/ota/release"
 	"github.com/mrsmtvd/shadow/components/ota/repository"
+	"github.com/mrsmtvd/shadow/misc/listener"
 )
 
 type Component struct {
+	mutex sync.RWMutex
+
 	logger logging.Logger
 	routes []dashboard.Route
 
 	installer        *ota.Installer
+	verifier         *ota.Verifier
 	uploadRepository *repository.Directory
 	allRepository    *repository.Merge
 	currentRelease   ota.Release
+	autoUpdater      *ota.AutoUpdater
+	lastUpgrade      *ota.UpgradeResult
+
+	done     chan struct{}
+	doneOnce sync.Once
 }
 
 func (c *Component) Name() string {
@@ -62,11 +72,16 @@ func (c *Component) Init(a shadow.Application) error {
 		return err
 	}
 
+	c.verifier = ota.NewVerifier(nil, false)
 	c.installer = ota.NewInstaller(a.Shutdown)
+	c.installer.SetVerifier(c.verifier)
 
 	c.uploadRepository = repository.NewDirectory()
 	c.allRepository = repository.NewMerge(c.uploadRepository, repository.NewMemory(c.currentRelease))
 
+	c.autoUpdater = ota.NewAutoUpdater(c.allRepository, a.Version())
+	c.done = make(chan struct{})
+
 	return nil
 }
 
@@ -78,6 +93,35 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) (err error)
 
 	c.uploadRepository.SetPath(cfg.String(ota.ConfigReleasesDirectory))
 
+	lastUpgrade, e := ota.ReadUpgradeResult(c.currentRelease.Path())
+	if e != nil {
+		c.logger.Error("Failed read result of last upgrade", "error", e.Error())
+	}
+
+	c.mutex.Lock()
+	c.lastUpgrade = lastUpgrade
+	c.mutex.Unlock()
+
+	c.installer.SetReadyTimeout(cfg.Duration(ota.ConfigRestartReadyTimeout))
+	c.installer.SetExtractSideFiles(cfg.Bool(ota.ConfigExtractSideFiles))
+
+	// процесс запущен перезапуском, родитель ждет готовности, чтобы завершиться
+	if listener.IsRestarted() {
+		go c.notifyRestarted(a, cfg.Duration(ota.ConfigRestartReadyTimeout))
+	}
+
+	if cfg.Bool(ota.ConfigRollbackEnabled) {
+		c.verifyUpgrade(a, cfg.Duration(ota.ConfigRollbackDeadline), cfg.Int(ota.ConfigRollbackMaxBoots))
+	}
+
+	c.verifier.SetEnforce(cfg.Bool(ota.ConfigSignatureEnforce))
+	c.setTrustedKeys(cfg.String(ota.ConfigSignatureTrustedKeys))
+
+	c.autoUpdater.SetChannel(cfg.String(ota.ConfigUpdateChannel))
+	c.autoUpdater.SetEnabled(cfg.Bool(ota.ConfigAutoUpdateEnabled))
+	c.autoUpdater.SetInterval(cfg.Duration(ota.ConfigAutoUpdateInterval))
+	c.setMaintenanceWindow(cfg.String(ota.ConfigAutoUpdateWindow))
+
 	go c.Update()
 
 	shadowURLs := cfg.String(ota.ConfigRepositoryClientShadow)
@@ -88,13 +132,26 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) (err error)
 				return err
 			}
 
-			c.allRepository.Merge(repository.NewShadow(shadowURL))
+			shadow := repository.NewShadow(shadowURL)
+			shadow.SetCacheDirectory(cfg.String(ota.ConfigDownloadsDirectory))
+
+			c.allRepository.Merge(shadow)
 		}
 	}
 
+	go c.autoUpdateLoop()
+
 	return err
 }
 
+func (c *Component) Shutdown() error {
+	c.doneOnce.Do(func() {
+		close(c.done)
+	})
+
+	return nil
+}
+
 func (c *Component) Update() (err error) {
 	err = c.allRepository.Update()
 	if err != nil {
@@ -103,3 +160,25 @@ func (c *Component) Update() (err error) {
 
 	return err
 }
+
+func (c *Component) retainReleases(keep int) {
+	removed, err := c.uploadRepository.Retain(keep)
+	if err != nil {
+		c.logger.Error("Failed remove old releases", "error", err.Error())
+	}
+
+	for _, rl := range removed {
+		c.logger.Info("Old release removed", "version", rl.Version(), "path", rl.Path())
+	}
+}
+
+// при ошибке разбора остается прежний набор ключей, чтобы опечатка в одном ключе не отключила проверку
+func (c *Component) setTrustedKeys(value string) {
+	keys, err := ota.ParsePublicKeys(value)
+	if err != nil {
+		c.logger.Error("Failed parse trusted keys", "error", err.Error())
+		return
+	}
+
+	c.verifier.SetKeys(keys)
+}
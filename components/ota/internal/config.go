@@ -2,6 +2,7 @@ package internal
 
 import (
 	"os"
+	"time"
 
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/ota"
@@ -13,15 +14,140 @@ func (c *Component) ConfigVariables() []config.Variable {
 			WithUsage("Path to saved releases directory").
 			WithEditable(true).
 			WithDefault(os.TempDir()),
+		config.NewVariable(ota.ConfigDownloadsDirectory, config.ValueTypeString).
+			WithUsage("Path to cache of releases downloaded from remote repositories").
+			WithGroup("Clients").
+			WithDefault(os.TempDir()),
+		config.NewVariable(ota.ConfigExtractSideFiles, config.ValueTypeBool).
+			WithUsage("Extract side files (configs, assets) from release archive to directory of binary on upgrade").
+			WithEditable(true).
+			WithDefault(false),
 		config.NewVariable(ota.ConfigRepositoryServerEnabled, config.ValueTypeBool).
 			WithUsage("Enable serve repository").
 			WithGroup("Server").
 			WithEditable(true).
 			WithDefault(true),
+		config.NewVariable(ota.ConfigRepositoryServerToken, config.ValueTypeString).
+			WithUsage("Bearer token for publish releases from CI. Empty disables publishing").
+			WithGroup("Server").
+			WithEditable(true).
+			WithView([]string{config.ViewPassword}),
+		config.NewVariable(ota.ConfigRepositoryServerKeep, config.ValueTypeInt).
+			WithUsage("Number of last uploaded releases kept for each architecture, 0 keeps all").
+			WithGroup("Server").
+			WithEditable(true).
+			WithDefault(0),
 		config.NewVariable(ota.ConfigRepositoryClientShadow, config.ValueTypeString).
 			WithUsage("Shadow").
 			WithGroup("Clients").
 			WithView([]string{config.ViewTags}).
 			WithViewOptions(map[string]interface{}{config.ViewOptionTagsDefaultText: "add a url"}),
+		config.NewVariable(ota.ConfigSignatureTrustedKeys, config.ValueTypeString).
+			WithUsage("Trusted Ed25519 public keys in base64 or hex for verify signature of releases").
+			WithGroup("Signature").
+			WithEditable(true).
+			WithView([]string{config.ViewTags}).
+			WithViewOptions(map[string]interface{}{config.ViewOptionTagsDefaultText: "add a key"}),
+		config.NewVariable(ota.ConfigSignatureEnforce, config.ValueTypeBool).
+			WithUsage("Reject unsigned releases").
+			WithGroup("Signature").
+			WithEditable(true).
+			WithDefault(false),
+		config.NewVariable(ota.ConfigUpdateChannel, config.ValueTypeString).
+			WithUsage("Channel of releases").
+			WithGroup("Auto update").
+			WithEditable(true).
+			WithDefault(ota.ChannelStable).
+			WithView([]string{config.ViewEnum}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionEnumOptions: [][]interface{}{
+					{ota.ChannelStable, "Stable"},
+					{ota.ChannelBeta, "Beta"},
+				},
+			}),
+		config.NewVariable(ota.ConfigAutoUpdateEnabled, config.ValueTypeBool).
+			WithUsage("Install newest release automatically and restart").
+			WithGroup("Auto update").
+			WithEditable(true).
+			WithDefault(false),
+		config.NewVariable(ota.ConfigAutoUpdateInterval, config.ValueTypeDuration).
+			WithUsage("Interval of check updates").
+			WithGroup("Auto update").
+			WithEditable(true).
+			WithDefault(time.Hour),
+		config.NewVariable(ota.ConfigAutoUpdateWindow, config.ValueTypeString).
+			WithUsage("Maintenance window in local time, format HH:MM-HH:MM. Empty is any time").
+			WithGroup("Auto update").
+			WithEditable(true),
+		config.NewVariable(ota.ConfigRollbackEnabled, config.ValueTypeBool).
+			WithUsage("Rollback to previous release if new release isn't ready after upgrade").
+			WithGroup("Rollback").
+			WithEditable(true).
+			WithDefault(true),
+		config.NewVariable(ota.ConfigRollbackDeadline, config.ValueTypeDuration).
+			WithUsage("Deadline of readiness checks after upgrade").
+			WithGroup("Rollback").
+			WithEditable(true).
+			WithDefault(time.Minute * 5),
+		config.NewVariable(ota.ConfigRollbackMaxBoots, config.ValueTypeInt).
+			WithUsage("Number of boots of new release without passed readiness checks before rollback").
+			WithGroup("Rollback").
+			WithEditable(true).
+			WithDefault(3),
+		config.NewVariable(ota.ConfigRestartReadyTimeout, config.ValueTypeDuration).
+			WithUsage("Timeout of waiting for readiness of new process on restart").
+			WithGroup("Restart").
+			WithEditable(true).
+			WithDefault(ota.DefaultRestartReadyTimeout),
 	}
 }
+
+func (c *Component) ConfigWatchers() []config.Watcher {
+	return []config.Watcher{
+		config.NewWatcher([]string{ota.ConfigSignatureTrustedKeys}, c.watchSignatureTrustedKeys),
+		config.NewWatcher([]string{ota.ConfigSignatureEnforce}, c.watchSignatureEnforce),
+		config.NewWatcher([]string{ota.ConfigUpdateChannel}, c.watchUpdateChannel),
+		config.NewWatcher([]string{ota.ConfigAutoUpdateEnabled}, c.watchAutoUpdateEnabled),
+		config.NewWatcher([]string{ota.ConfigAutoUpdateInterval}, c.watchAutoUpdateInterval),
+		config.NewWatcher([]string{ota.ConfigAutoUpdateWindow}, c.watchAutoUpdateWindow),
+		config.NewWatcher([]string{ota.ConfigRestartReadyTimeout}, c.watchRestartReadyTimeout),
+		config.NewWatcher([]string{ota.ConfigExtractSideFiles}, c.watchExtractSideFiles),
+		config.NewWatcher([]string{ota.ConfigRepositoryServerKeep}, c.watchRepositoryServerKeep),
+	}
+}
+
+func (c *Component) watchSignatureTrustedKeys(_ string, newValue interface{}, _ interface{}) {
+	c.setTrustedKeys(newValue.(string))
+}
+
+func (c *Component) watchSignatureEnforce(_ string, newValue interface{}, _ interface{}) {
+	c.verifier.SetEnforce(newValue.(bool))
+}
+
+func (c *Component) watchUpdateChannel(_ string, newValue interface{}, _ interface{}) {
+	c.autoUpdater.SetChannel(newValue.(string))
+}
+
+func (c *Component) watchAutoUpdateEnabled(_ string, newValue interface{}, _ interface{}) {
+	c.autoUpdater.SetEnabled(newValue.(bool))
+}
+
+func (c *Component) watchAutoUpdateInterval(_ string, newValue interface{}, _ interface{}) {
+	c.autoUpdater.SetInterval(newValue.(time.Duration))
+}
+
+func (c *Component) watchAutoUpdateWindow(_ string, newValue interface{}, _ interface{}) {
+	c.setMaintenanceWindow(newValue.(string))
+}
+
+func (c *Component) watchRestartReadyTimeout(_ string, newValue interface{}, _ interface{}) {
+	c.installer.SetReadyTimeout(newValue.(time.Duration))
+}
+
+func (c *Component) watchExtractSideFiles(_ string, newValue interface{}, _ interface{}) {
+	c.installer.SetExtractSideFiles(newValue.(bool))
+}
+
+func (c *Component) watchRepositoryServerKeep(_ string, newValue interface{}, _ interface{}) {
+	c.retainReleases(newValue.(int))
+}
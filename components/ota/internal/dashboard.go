@@ -1,16 +1,16 @@
 package internal
 
 import (
+	"io/fs"
 	"net/http"
 
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/ota"
 	"github.com/mrsmtvd/shadow/components/ota/internal/handlers"
 )
 
-func (c *Component) DashboardTemplates() *assetfs.AssetFS {
-	return dashboard.TemplatesFromAssetFS(c)
+func (c *Component) DashboardTemplates() fs.FS {
+	return dashboard.TemplatesFromFS(c)
 }
 
 func (c *Component) DashboardMenu() dashboard.Menu {
@@ -31,6 +31,7 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 			Installer:      c.installer,
 			AllRepository:  c.allRepository,
 			CurrentRelease: c.currentRelease,
+			LastUpgrade:    c.LastUpgrade,
 		}
 		upgradeHandler := &handlers.UpgradeHandler{
 			Installer:        c.installer,
@@ -39,6 +40,9 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 		repositoryHandler := &handlers.RepositoryHandler{
 			Repository: c.allRepository,
 		}
+		publishHandler := &handlers.PublishHandler{
+			UploadRepository: c.uploadRepository,
+		}
 
 		c.routes = []dashboard.Route{
 			dashboard.NewRoute("/"+c.Name()+"/upgrade/", upgradeHandler).
@@ -57,6 +61,10 @@ func (c *Component) DashboardRoutes() []dashboard.Route {
 				WithMethods([]string{http.MethodGet}),
 			dashboard.NewRoute("/"+c.Name()+"/repository/:id/:file", repositoryHandler).
 				WithMethods([]string{http.MethodGet, http.MethodHead}),
+			dashboard.NewRoute("/"+c.Name()+"/publish/", publishHandler).
+				WithMethods([]string{http.MethodPost}),
+			dashboard.NewRoute("/"+c.Name()+"/publish/:id", publishHandler).
+				WithMethods([]string{http.MethodDelete}),
 		}
 	}
 
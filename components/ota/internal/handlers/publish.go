@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/components/ota"
+	"github.com/mrsmtvd/shadow/components/ota/release"
+	"github.com/mrsmtvd/shadow/components/ota/repository"
+)
+
+type publishResponse struct {
+	response
+
+	Release *repository.ShadowRecord `json:"release,omitempty"`
+	Removed []string                 `json:"removed,omitempty"`
+}
+
+// PublishHandler принимает релизы от CI. Авторизация не через сессию дашборда, а по токену
+// в заголовке Authorization: Bearer
+type PublishHandler struct {
+	dashboard.Handler
+
+	UploadRepository *repository.Directory
+}
+
+func (h *PublishHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
+	token := r.Config().String(ota.ConfigRepositoryServerToken)
+
+	if token == "" || !r.Config().Bool(ota.ConfigRepositoryServerEnabled) {
+		h.NotFound(w, r)
+		return
+	}
+
+	if !h.authorized(r, token) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="ota"`)
+		h.sendError(w, http.StatusUnauthorized, errors.New("wrong publish token"))
+
+		return
+	}
+
+	switch r.Original().Method {
+	case http.MethodPost:
+		h.publish(w, r)
+
+	case http.MethodDelete:
+		h.remove(w, r)
+
+	default:
+		h.MethodNotAllowed(w, r)
+	}
+}
+
+func (h *PublishHandler) authorized(r *dashboard.Request, token string) bool {
+	header := r.Original().Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, "Bearer ")), []byte(token)) == 1
+}
+
+func (h *PublishHandler) publish(w *dashboard.Response, r *dashboard.Request) {
+	file, _, err := r.Original().FormFile("release")
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	signature, err := ota.ParseSignature(r.Original().FormValue("signature"))
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	channel := strings.ToLower(strings.TrimSpace(r.Original().FormValue("channel")))
+	if channel != "" && channel != ota.ChannelStable && channel != ota.ChannelBeta {
+		h.sendError(w, http.StatusBadRequest, errors.New("unknown channel "+channel))
+		return
+	}
+
+	rl, err := release.NewLocalFileFromStream(file, "", r.Config().String(ota.ConfigReleasesDirectory))
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if rl.Type() == ota.FileTypeUnknown {
+		h.removeFile(rl)
+		h.sendError(w, http.StatusBadRequest, errors.New("unknown type of release file"))
+
+		return
+	}
+
+	if len(signature) > 0 {
+		err = rl.SaveSignature(signature)
+	}
+
+	if err == nil {
+		err = rl.SaveMetadata(strings.TrimSpace(r.Original().FormValue("version")), channel)
+	}
+
+	if err != nil {
+		h.removeFile(rl)
+		h.sendError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	compress := release.NewCompress(rl)
+	h.UploadRepository.Add(compress)
+
+	logging.Log(r.Context()).Info("Release published",
+		"version", rl.Version(),
+		"architecture", compress.Architecture(),
+		"path", rl.Path(),
+	)
+
+	removed, err := h.UploadRepository.Retain(r.Config().Int(ota.ConfigRepositoryServerKeep))
+	if err != nil {
+		logging.Log(r.Context()).Error("Failed remove old releases", "error", err.Error())
+	}
+
+	record := repository.NewShadowRecord(compress, "/ota/repository/"+ota.GenerateReleaseID(compress)+"/"+ota.GenerateFileName(compress))
+
+	_ = w.SendJSON(publishResponse{
+		response: response{Result: "success"},
+		Release:  &record,
+		Removed:  releaseVersions(removed),
+	})
+}
+
+func (h *PublishHandler) remove(w *dashboard.Response, r *dashboard.Request) {
+	id := strings.TrimSpace(r.URL().Query().Get(":id"))
+
+	releases, err := h.UploadRepository.Releases("")
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	for _, rl := range releases {
+		if ota.GenerateReleaseID(rl) != id {
+			continue
+		}
+
+		if err = h.UploadRepository.Remove(rl); err != nil {
+			h.sendError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		_ = w.SendJSON(publishResponse{
+			response: response{Result: "success"},
+			Removed:  releaseVersions([]ota.Release{rl}),
+		})
+
+		return
+	}
+
+	h.sendError(w, http.StatusNotFound, errors.New("release "+id+" not found"))
+}
+
+// файл, который не попал в репозиторий, удаляется вместе с сопутствующими файлами
+func (h *PublishHandler) removeFile(rl *release.LocalFile) {
+	for _, path := range []string{rl.Path(), rl.Path() + ota.SignatureExt, rl.Path() + ota.MetadataExt} {
+		_ = os.Remove(path)
+	}
+}
+
+func (h *PublishHandler) sendError(w *dashboard.Response, code int, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+
+	_ = w.SendJSON(response{
+		Result:  "failed",
+		Message: err.Error(),
+	})
+}
+
+func releaseVersions(releases []ota.Release) []string {
+	versions := make([]string, 0, len(releases))
+	for _, rl := range releases {
+		versions = append(versions, rl.Version())
+	}
+
+	return versions
+}
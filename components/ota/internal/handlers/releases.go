@@ -18,9 +18,13 @@ type releaseView struct {
 	Version       string
 	Size          int64
 	Checksum      string
+	SHA256        string
 	IsCurrent     bool
 	IsRemovable   bool
 	IsUpgradeable bool
+	IsSigned      bool
+	IsNewest      bool
+	Channel       string
 	Path          string
 	Architecture  string
 	UploadedAt    *time.Time
@@ -38,6 +42,7 @@ type ReleasesHandler struct {
 	Installer      *ota.Installer
 	AllRepository  *repository.Merge
 	CurrentRelease ota.Release
+	LastUpgrade    func() *ota.UpgradeResult
 }
 
 func (h *ReleasesHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
@@ -61,10 +66,15 @@ func (h *ReleasesHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request)
 		case "upgrade":
 			h.actionUpgrade(w, r, releases)
 			return
+
+		case "progress":
+			h.actionProgress(w, r, releases)
+			return
 		}
 	}
 
 	releasesView := make([]releaseView, 0, len(releases))
+	newest := ota.NewestRelease(releases, h.CurrentRelease.Version(), runtime.GOARCH, r.Config().String(ota.ConfigUpdateChannel))
 
 	for _, rl := range releases {
 		rView := releaseView{
@@ -78,7 +88,18 @@ func (h *ReleasesHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request)
 			Architecture:  rl.Architecture(),
 			Path:          rl.Path(),
 			UploadedAt:    rl.CreatedAt(),
+			Channel:       ota.ReleaseChannel(rl),
+			IsNewest:      newest != nil && rl == newest,
+		}
+
+		if cs, ok := rl.(ota.ChecksumSHA256); ok {
+			rView.SHA256 = hex.EncodeToString(cs.ChecksumSHA256())
+		}
+
+		if signed, ok := rl.(ota.Signed); ok {
+			rView.IsSigned = len(signed.Signature()) > 0
 		}
+
 		rView.DownloadURL = "/ota/repository/" + rView.ID + "/" + ota.GenerateFileName(rl)
 
 		releasesView = append(releasesView, rView)
@@ -86,6 +107,7 @@ func (h *ReleasesHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request)
 
 	h.Render(r.Context(), "releases", map[string]interface{}{
 		"releases": releasesView,
+		"upgrade":  h.LastUpgrade(),
 	})
 }
 
@@ -138,6 +160,33 @@ func (h *ReleasesHandler) actionRemove(w *dashboard.Response, r *dashboard.Reque
 	h.NotFound(w, r)
 }
 
+// actionProgress отдает прогресс загрузки релиза, который опрашивается страницей во время обновления
+func (h *ReleasesHandler) actionProgress(w *dashboard.Response, r *dashboard.Request, releases []ota.Release) {
+	id := strings.TrimSpace(r.URL().Query().Get(":id"))
+
+	for _, rl := range releases {
+		if ota.GenerateReleaseID(rl) != id {
+			continue
+		}
+
+		progress := ota.DownloadProgress{
+			Downloaded: rl.Size(),
+			Total:      rl.Size(),
+			Completed:  true,
+		}
+
+		if d, ok := rl.(ota.Downloadable); ok {
+			progress = d.DownloadProgress()
+		}
+
+		_ = w.SendJSON(progress)
+
+		return
+	}
+
+	h.NotFound(w, r)
+}
+
 func (h *ReleasesHandler) actionUpgrade(w *dashboard.Response, r *dashboard.Request, releases []ota.Release) {
 	if !r.IsPost() {
 		h.MethodNotAllowed(w, r)
@@ -160,7 +209,7 @@ func (h *ReleasesHandler) actionUpgrade(w *dashboard.Response, r *dashboard.Requ
 					)
 				}
 
-				if r.URL().Query().Get("restart") != "" {
+				if err == nil && r.URL().Query().Get("restart") != "" {
 					err = h.Installer.Restart()
 				}
 
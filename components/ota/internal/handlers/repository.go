@@ -1,11 +1,12 @@
 package handlers
 
 import (
-	"encoding/hex"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mrsmtvd/shadow/components/dashboard"
 	"github.com/mrsmtvd/shadow/components/logging"
@@ -49,12 +50,23 @@ func (h *RepositoryHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Reques
 					fileName = ota.GenerateFileName(rl)
 				}
 
-				w.Header().Set("Content-Length", strconv.FormatInt(rl.Size(), 10))
 				w.Header().Set("Content-Type", rl.Type().MIME())
 				w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
 
-				if !r.IsHead() {
-					io.Copy(w, releaseBinFile)
+				// локальные файлы отдаются с поддержкой Range, чтобы клиенты могли продолжить прерванную загрузку
+				if seeker, ok := releaseBinFile.(io.ReadSeeker); ok {
+					var modTime time.Time
+					if createdAt := rl.CreatedAt(); createdAt != nil {
+						modTime = *createdAt
+					}
+
+					http.ServeContent(w, r.Original(), fileName, modTime, seeker)
+				} else {
+					w.Header().Set("Content-Length", strconv.FormatInt(rl.Size(), 10))
+
+					if !r.IsHead() {
+						io.Copy(w, releaseBinFile)
+					}
 				}
 
 				releaseBinFile.Close()
@@ -86,14 +98,7 @@ func (h *RepositoryHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Reques
 			fileURL.Scheme = "https"
 		}
 
-		records = append(records, repository.ShadowRecord{
-			Architecture: rl.Architecture(),
-			Checksum:     hex.EncodeToString(rl.Checksum()),
-			Size:         rl.Size(),
-			Version:      rl.Version(),
-			File:         fileURL.String(),
-			CreatedAt:    rl.CreatedAt(),
-		})
+		records = append(records, repository.NewShadowRecord(rl, fileURL.String()))
 	}
 
 	_ = w.SendJSON(records)
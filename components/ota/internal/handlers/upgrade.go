@@ -7,6 +7,7 @@ import (
 	"runtime"
 
 	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/logging"
 	"github.com/mrsmtvd/shadow/components/ota"
 	"github.com/mrsmtvd/shadow/components/ota/release"
 	"github.com/mrsmtvd/shadow/components/ota/repository"
@@ -28,26 +29,46 @@ func (h *UpgradeHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request)
 
 			t := header.Header.Get("Content-Type")
 
-			switch t {
-			case "application/macbinary", "application/x-binary", "application/zip":
+			switch ota.FileTypeFromMIME(t) {
+			case ota.FileTypeBinary, ota.FileTypeZip, ota.FileTypeTarGz, ota.FileTypeTarXz:
 				var rl *release.LocalFile
 
+				var signature []byte
+
+				// подпись проверяется при установке, здесь только сохраняется рядом с файлом
+				signature, err = ota.ParseSignature(r.Original().FormValue("signature"))
+				if err != nil {
+					break
+				}
+
 				rl, err = release.NewLocalFileFromStream(file, "", r.Config().String(ota.ConfigReleasesDirectory))
+				if err == nil && len(signature) > 0 {
+					err = rl.SaveSignature(signature)
+				}
+
 				if err == nil {
 					h.UploadRepository.Add(release.NewCompress(rl))
 
+					if _, e := h.UploadRepository.Retain(r.Config().Int(ota.ConfigRepositoryServerKeep)); e != nil {
+						logging.Log(r.Context()).Error("Failed remove old releases", "error", e.Error())
+					}
+
 					_ = w.SendJSON(struct {
 						ID           string `json:"id"`
 						Version      string `json:"version"`
 						Checksum     string `json:"checksum"`
+						SHA256       string `json:"checksum_sha256"`
 						Architecture string `json:"architecture"`
 						Size         int64  `json:"size"`
+						Signed       bool   `json:"signed"`
 					}{
 						ID:           ota.GenerateReleaseID(rl),
 						Version:      rl.Version(),
 						Checksum:     hex.EncodeToString(rl.Checksum()),
+						SHA256:       hex.EncodeToString(rl.ChecksumSHA256()),
 						Architecture: rl.Architecture(),
 						Size:         rl.Size(),
+						Signed:       len(rl.Signature()) > 0,
 					})
 
 					return
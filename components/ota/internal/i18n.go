@@ -7,8 +7,5 @@ import (
 )
 
 func (c *Component) I18n() map[string][]io.ReadSeeker {
-	fs := c.AssetFS()
-	fs.Prefix = "locales"
-
-	return i18n.FromAssetFS(fs)
+	return i18n.LocalesFromFS(c.FS(), "locales")
 }
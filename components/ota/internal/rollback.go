@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mrsmtvd/shadow"
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/ota"
+	"github.com/mrsmtvd/shadow/misc/listener"
+)
+
+const readinessCheckInterval = time.Second
+
+var errShutdown = errors.New("component is shutdown")
+
+// verifyUpgrade проверяет релиз, запущенный после обновления. Релиз считается рабочим, когда все
+// компоненты запустились и прошли проверки готовности. Иначе, а также если релиз падает при старте
+// больше maxBoots раз, возвращается предыдущий бинарник и приложение перезапускается
+func (c *Component) verifyUpgrade(a shadow.Application, deadline time.Duration, maxBoots int) {
+	path := c.currentRelease.Path()
+
+	marker, err := ota.ReadUpgradeMarker(path)
+	if err != nil {
+		c.logger.Error("Failed read upgrade marker", "path", path, "error", err.Error())
+		return
+	}
+
+	if marker == nil {
+		return
+	}
+
+	marker.Boots++
+
+	if marker.Boots > maxBoots {
+		go c.rollback(marker, errors.New("release crashed on start too many times"))
+		return
+	}
+
+	if err = marker.Save(); err != nil {
+		c.logger.Error("Failed save upgrade marker", "path", path, "error", err.Error())
+	}
+
+	c.logger.Info("Verify upgraded release", "version", marker.Version, "boot", marker.Boots, "deadline", deadline)
+
+	go c.waitReadiness(a, marker, deadline)
+}
+
+func (c *Component) waitReadiness(a shadow.Application, marker *ota.UpgradeMarker, deadline time.Duration) {
+	switch err := c.awaitReadiness(a, deadline); err {
+	case nil:
+		c.upgradeVerified(marker)
+
+	case errShutdown:
+
+	default:
+		c.rollback(marker, err)
+	}
+}
+
+// notifyRestarted сообщает родительскому процессу о готовности после перезапуска
+func (c *Component) notifyRestarted(a shadow.Application, timeout time.Duration) {
+	if err := c.awaitReadiness(a, timeout); err != nil {
+		if err != errShutdown {
+			c.logger.Error("Restarted process isn't ready", "error", err.Error())
+		}
+
+		return
+	}
+
+	if err := listener.NotifyReady(); err != nil {
+		c.logger.Error("Failed notify parent process about readiness", "error", err.Error())
+		return
+	}
+
+	c.logger.Info("Restarted process is ready, parent process will be stopped")
+}
+
+// awaitReadiness ждет запуска всех компонентов и прохождения их проверок готовности
+func (c *Component) awaitReadiness(a shadow.Application, deadline time.Duration) error {
+	timer := time.NewTimer(deadline)
+	defer timer.Stop()
+
+	components, err := a.GetComponents()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(components))
+	for _, cmp := range components {
+		names = append(names, cmp.Name())
+	}
+
+	select {
+	case <-a.ReadyComponent(names[0], names[1:]...):
+	case <-timer.C:
+		return errors.New("components aren't ready before deadline")
+	case <-c.done:
+		return errShutdown
+	}
+
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err = readinessCheck(components); err == nil {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return err
+		case <-c.done:
+			return errShutdown
+		}
+	}
+}
+
+func readinessCheck(components []shadow.Component) error {
+	for _, cmp := range components {
+		checker, ok := cmp.(dashboard.HasReadinessCheck)
+		if !ok {
+			continue
+		}
+
+		for name, check := range checker.ReadinessCheck() {
+			if err := check(); err != nil {
+				if name == "" {
+					name = cmp.Name()
+				} else {
+					name = cmp.Name() + "_" + name
+				}
+
+				return errors.New("readiness check " + name + " failed: " + err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Component) upgradeVerified(marker *ota.UpgradeMarker) {
+	if err := marker.Remove(); err != nil {
+		c.logger.Error("Failed remove upgrade marker", "error", err.Error())
+	}
+
+	c.saveUpgradeResult(&ota.UpgradeResult{
+		Version:    marker.Version,
+		Status:     ota.UpgradeStatusVerified,
+		FinishedAt: time.Now(),
+	})
+
+	c.logger.Info("Upgraded release verified", "version", marker.Version)
+}
+
+func (c *Component) rollback(marker *ota.UpgradeMarker, reason error) {
+	c.logger.Error("Upgraded release isn't ready, rollback to previous release",
+		"version", marker.Version,
+		"previous", marker.PreviousPath,
+		"error", reason.Error(),
+	)
+
+	if err := c.installer.Rollback(marker); err != nil {
+		c.logger.Error("Failed rollback to previous release", "error", err.Error())
+		return
+	}
+
+	c.saveUpgradeResult(&ota.UpgradeResult{
+		Version:    marker.Version,
+		Status:     ota.UpgradeStatusRolledBack,
+		Message:    reason.Error(),
+		FinishedAt: time.Now(),
+	})
+
+	if err := c.installer.Restart(); err != nil {
+		c.logger.Error("Failed restart after rollback", "error", err.Error())
+	}
+}
+
+func (c *Component) saveUpgradeResult(result *ota.UpgradeResult) {
+	if err := ota.SaveUpgradeResult(c.currentRelease.Path(), result); err != nil {
+		c.logger.Error("Failed save upgrade result", "error", err.Error())
+	}
+
+	c.mutex.Lock()
+	c.lastUpgrade = result
+	c.mutex.Unlock()
+}
+
+// LastUpgrade возвращает итог последнего обновления, если оно было
+func (c *Component) LastUpgrade() *ota.UpgradeResult {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.lastUpgrade
+}
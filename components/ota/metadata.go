@@ -0,0 +1,51 @@
+package ota
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MetadataExt расширение файла с метаданными релиза, который хранится рядом с файлом релиза
+const MetadataExt = ".meta"
+
+// pre-release ограничен известными метками, иначе в версию попадут архитектура и расширение файла
+var versionInFileName = regexp.MustCompile(`v?\d+\.\d+\.\d+(?:-(?:alpha|beta|rc|dev|pre)(?:\.?\d+)*)?`)
+
+// ReleaseMetadata то, что нельзя извлечь из самого файла релиза
+type ReleaseMetadata struct {
+	Version string `json:"version"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// ReadReleaseMetadata читает метаданные файла релиза path, если их нет, то возвращается nil
+func ReadReleaseMetadata(path string) (*ReleaseMetadata, error) {
+	md := &ReleaseMetadata{}
+
+	if err := readJSONFile(path+MetadataExt, md); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return md, nil
+}
+
+func SaveReleaseMetadata(path string, md *ReleaseMetadata) error {
+	return writeJSONFile(path+MetadataExt, md)
+}
+
+// IsSidecarFile сообщает, что файл не релиз, а подпись или метаданные, лежащие рядом с релизом
+func IsSidecarFile(name string) bool {
+	return strings.HasSuffix(name, SignatureExt) ||
+		strings.HasSuffix(name, MetadataExt) ||
+		strings.HasSuffix(name, MetadataExt+tmpExt)
+}
+
+// VersionFromFileName ищет версию в имени файла, например app-1.2.0-linux-amd64.tar.gz
+func VersionFromFileName(path string) string {
+	return versionInFileName.FindString(filepath.Base(path))
+}
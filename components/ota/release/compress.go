@@ -1,14 +1,19 @@
 package release
 
 import (
+	"archive/tar"
 	"archive/zip"
+	"compress/gzip"
 	"errors"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/mrsmtvd/shadow/components/ota"
+	"github.com/ulikunitz/xz"
 )
 
 // подходит первый попавшийся файл
@@ -58,50 +63,233 @@ func (f *Compress) File() (io.ReadCloser, error) {
 }
 
 func (f *Compress) FileBinary() (io.ReadCloser, error) {
-	reader, err := f.original.FileBinary()
+	if !f.Type().IsArchive() {
+		return f.original.FileBinary()
+	}
+
+	var binary io.ReadCloser
+
+	err := f.walkArchive(func(entry archiveEntry) (bool, error) {
+		if !f.isBinary(entry) {
+			return true, nil
+		}
+
+		readerBinary, err := entry.open()
+		if err != nil {
+			return false, err
+		}
+
+		// TODO: непонятно как удалять этот файл
+
+		fdBinary, _, err := createTempFileFromReader(readerBinary)
+		readerBinary.Close()
+
+		if err != nil {
+			return false, err
+		}
+
+		binary = fdBinary
+
+		return false, nil
+	})
+
 	if err != nil {
 		return nil, err
 	}
 
-	if f.Type() != ota.FileTypeZip {
-		return reader, nil
+	if binary == nil {
+		return nil, errors.New("binary file not found in archive")
+	}
+
+	return binary, nil
+}
+
+// Extract распаковывает в dir все файлы архива, кроме бинарника, и возвращает пути распакованных файлов
+func (f *Compress) Extract(dir string) ([]string, error) {
+	if !f.Type().IsArchive() {
+		return nil, nil
+	}
+
+	var (
+		extracted   = make([]string, 0)
+		binaryFound bool
+	)
+
+	err := f.walkArchive(func(entry archiveEntry) (bool, error) {
+		if !binaryFound && f.isBinary(entry) {
+			binaryFound = true
+			return true, nil
+		}
+
+		path, err := extractPath(dir, entry.name)
+		if err != nil {
+			return false, err
+		}
+
+		if err = extractFile(path, entry); err != nil {
+			return false, err
+		}
+
+		extracted = append(extracted, path)
+
+		return true, nil
+	})
+
+	return extracted, err
+}
+
+type archiveEntry struct {
+	name string
+	mode os.FileMode
+	// у zip права могут не сохраняться, поэтому признак исполняемого файла проверяется только в tar
+	checkMode bool
+	open      func() (io.ReadCloser, error)
+}
+
+func (f *Compress) isBinary(entry archiveEntry) bool {
+	if entry.checkMode && entry.mode&0111 == 0 {
+		return false
 	}
 
+	return f.fnSearchFile(entry.name)
+}
+
+// walkArchive обходит обычные файлы архива, пока fn возвращает true
+func (f *Compress) walkArchive(fn func(archiveEntry) (bool, error)) error {
+	reader, err := f.original.FileBinary()
+	if err != nil {
+		return err
+	}
 	defer reader.Close()
 
-	// в остальных случая нам надо:
-	// 1. создать временный файл, записать туда содержимое
+	switch f.Type() {
+	case ota.FileTypeZip:
+		return walkZip(reader, fn)
+
+	case ota.FileTypeTarGz:
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+
+		return walkTar(gz, fn)
+
+	case ota.FileTypeTarXz:
+		xzReader, err := xz.NewReader(reader)
+		if err != nil {
+			return err
+		}
+
+		return walkTar(xzReader, fn)
+	}
+
+	return errors.New("unsupported archive type " + f.Type().String())
+}
+
+func walkZip(reader io.Reader, fn func(archiveEntry) (bool, error)) error {
+	// zip читается с произвольного места, поэтому сначала сохраняется во временный файл
 	fd, size, err := createTempFileFromReader(reader)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer fd.Close()
 
-	// 3. открыть его через архиватор
 	readerArchive, err := zip.NewReader(fd, size)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// 4. найти в архиве нужный файл
 	for _, fileInArchive := range readerArchive.File {
-		if f.fnSearchFile(fileInArchive.Name) {
-			// копируем бинарник во временный файл
-			readerBinary, err := fileInArchive.Open()
-			if err != nil {
-				return nil, err
-			}
+		if !fileInArchive.Mode().IsRegular() {
+			continue
+		}
 
-			// TODO: непонятно как удалять этот файл
+		next, err := fn(archiveEntry{
+			name: fileInArchive.Name,
+			mode: fileInArchive.Mode(),
+			open: fileInArchive.Open,
+		})
+
+		if err != nil || !next {
+			return err
+		}
+	}
+
+	return nil
+}
 
-			fdBinary, _, err := createTempFileFromReader(readerBinary)
-			readerBinary.Close()
+func walkTar(reader io.Reader, fn func(archiveEntry) (bool, error)) error {
+	readerArchive := tar.NewReader(reader)
+
+	for {
+		header, err := readerArchive.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
 
-			return fdBinary, err
+		next, err := fn(archiveEntry{
+			name:      header.Name,
+			mode:      header.FileInfo().Mode(),
+			checkMode: true,
+			open: func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(readerArchive), nil
+			},
+		})
+
+		if err != nil || !next {
+			return err
 		}
 	}
+}
+
+// extractPath не дает файлам архива выйти за пределы dir через абсолютные пути и ..
+func extractPath(dir, name string) (string, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.New("file " + name + " in archive is outside of directory")
+	}
 
-	return nil, errors.New("binary file not found in archive")
+	return path, nil
+}
+
+func extractFile(path string, entry archiveEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	reader, err := entry.open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	perm := entry.mode.Perm()
+	if perm == 0 {
+		perm = 0644
+	}
+
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm|0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(fd, reader); err != nil {
+		fd.Close()
+		return err
+	}
+
+	return fd.Close()
 }
 
 func (f *Compress) Path() string {
@@ -112,6 +300,26 @@ func (f *Compress) Checksum() []byte {
 	return f.original.Checksum()
 }
 
+func (f *Compress) ChecksumSHA256() []byte {
+	if cs, ok := f.original.(ota.ChecksumSHA256); ok {
+		return cs.ChecksumSHA256()
+	}
+
+	return nil
+}
+
+func (f *Compress) DownloadProgress() ota.DownloadProgress {
+	if d, ok := f.original.(ota.Downloadable); ok {
+		return d.DownloadProgress()
+	}
+
+	return ota.DownloadProgress{
+		Downloaded: f.original.Size(),
+		Total:      f.original.Size(),
+		Completed:  true,
+	}
+}
+
 func (f *Compress) Size() int64 {
 	return f.original.Size()
 }
@@ -128,6 +336,22 @@ func (f *Compress) CreatedAt() *time.Time {
 	return f.original.CreatedAt()
 }
 
+func (f *Compress) Signature() []byte {
+	if signed, ok := f.original.(ota.Signed); ok {
+		return signed.Signature()
+	}
+
+	return nil
+}
+
+func (f *Compress) Channel() string {
+	if c, ok := f.original.(ota.Channeled); ok {
+		return c.Channel()
+	}
+
+	return ""
+}
+
 func (f *Compress) Validate() error {
 	return f.original.Validate()
 }
@@ -0,0 +1,331 @@
+package release
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mrsmtvd/shadow/components/ota"
+	"github.com/stretchr/testify/assert"
+	"github.com/ulikunitz/xz"
+)
+
+type archiveFixtureFile struct {
+	name    string
+	content string
+	mode    int64
+	dir     bool
+}
+
+func tarFixture(t *testing.T, w io.Writer, files []archiveFixtureFile) {
+	tw := tar.NewWriter(w)
+
+	for _, f := range files {
+		header := &tar.Header{
+			Name:     f.name,
+			Mode:     f.mode,
+			Size:     int64(len(f.content)),
+			Typeflag: tar.TypeReg,
+		}
+
+		if f.dir {
+			header.Typeflag = tar.TypeDir
+			header.Size = 0
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func archiveFixture(t *testing.T, fileType ota.FileType, files []archiveFixtureFile) []byte {
+	buf := &bytes.Buffer{}
+
+	switch fileType {
+	case ota.FileTypeTarGz:
+		gz := gzip.NewWriter(buf)
+		tarFixture(t, gz, files)
+
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+	case ota.FileTypeTarXz:
+		xzWriter, err := xz.NewWriter(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tarFixture(t, xzWriter, files)
+
+		if err = xzWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+	case ota.FileTypeZip:
+		zw := zip.NewWriter(buf)
+
+		for _, f := range files {
+			if f.dir {
+				continue
+			}
+
+			header := &zip.FileHeader{Name: f.name, Method: zip.Deflate}
+			header.SetMode(os.FileMode(f.mode))
+
+			w, err := zw.CreateHeader(header)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err = w.Write([]byte(f.content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func compressFixture(t *testing.T, fileType ota.FileType, files []archiveFixtureFile, fn func(string) bool) *Compress {
+	path := filepath.Join(t.TempDir(), "app-1.0.0"+fileType.Ext())
+
+	if err := ioutil.WriteFile(path, archiveFixture(t, fileType, files), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rl, err := NewLocalFile(path, "1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fn == nil {
+		return NewCompress(rl)
+	}
+
+	return NewCompressWithFn(rl, fn)
+}
+
+func readBinary(t *testing.T, rl ota.Release) string {
+	reader, err := rl.FileBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(content)
+}
+
+var archiveTypes = []ota.FileType{ota.FileTypeTarGz, ota.FileTypeTarXz, ota.FileTypeZip}
+
+func TestExtractPath(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(string(filepath.Separator)+"opt", "app")
+
+	cases := []struct {
+		name string
+		want string
+		err  bool
+	}{
+		{"config.yml", filepath.Join(dir, "config.yml"), false},
+		{"assets/css/main.css", filepath.Join(dir, "assets", "css", "main.css"), false},
+		{"./assets/../config.yml", filepath.Join(dir, "config.yml"), false},
+		{"/etc/app.conf", filepath.Join(dir, "etc", "app.conf"), false},
+		{"..", "", true},
+		{"../config.yml", "", true},
+		{"assets/../../config.yml", "", true},
+		{"../app-evil/config.yml", "", true},
+		{"/../../etc/passwd", "", true},
+	}
+
+	for _, c := range cases {
+		path, err := extractPath(dir, c.name)
+
+		if c.err {
+			assert.Error(t, err, c.name)
+			continue
+		}
+
+		if assert.NoError(t, err, c.name) {
+			assert.Equal(t, c.want, path, c.name)
+		}
+	}
+}
+
+func TestCompress_FileBinary(t *testing.T) {
+	t.Parallel()
+
+	files := []archiveFixtureFile{
+		{name: "app", dir: true, mode: 0755},
+		{name: "app/README.md", content: "readme", mode: 0644},
+		{name: "app/bin/app", content: "binary", mode: 0755},
+		{name: "app/bin/helper", content: "helper", mode: 0755},
+	}
+
+	for _, fileType := range archiveTypes {
+		fileType := fileType
+
+		t.Run(fileType.String(), func(t *testing.T) {
+			t.Parallel()
+
+			rl := compressFixture(t, fileType, files, func(name string) bool {
+				return strings.HasPrefix(name, "app/bin/")
+			})
+
+			assert.Equal(t, fileType, rl.Type())
+			assert.Equal(t, "binary", readBinary(t, rl))
+		})
+	}
+}
+
+func TestCompress_FileBinaryInTar_SkipsNotExecutable(t *testing.T) {
+	t.Parallel()
+
+	for _, fileType := range []ota.FileType{ota.FileTypeTarGz, ota.FileTypeTarXz} {
+		fileType := fileType
+
+		t.Run(fileType.String(), func(t *testing.T) {
+			t.Parallel()
+
+			rl := compressFixture(t, fileType, []archiveFixtureFile{
+				{name: "README.md", content: "readme", mode: 0644},
+				{name: "app", content: "binary", mode: 0750},
+			}, nil)
+
+			assert.Equal(t, "binary", readBinary(t, rl))
+
+			rl = compressFixture(t, fileType, []archiveFixtureFile{
+				{name: "README.md", content: "readme", mode: 0644},
+				{name: "app", content: "binary", mode: 0600},
+			}, nil)
+
+			_, err := rl.FileBinary()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompress_FileBinaryInZip_IgnoresMode(t *testing.T) {
+	t.Parallel()
+
+	rl := compressFixture(t, ota.FileTypeZip, []archiveFixtureFile{
+		{name: "app", content: "binary", mode: 0644},
+		{name: "README.md", content: "readme", mode: 0644},
+	}, nil)
+
+	assert.Equal(t, "binary", readBinary(t, rl))
+}
+
+func TestCompress_Extract(t *testing.T) {
+	t.Parallel()
+
+	files := []archiveFixtureFile{
+		{name: "README.md", content: "readme", mode: 0644},
+		{name: "app", content: "binary", mode: 0755},
+		{name: "assets", dir: true, mode: 0755},
+		{name: "assets/css/main.css", content: "body {}", mode: 0640},
+		{name: "tools/helper", content: "helper", mode: 0755},
+	}
+
+	for _, fileType := range archiveTypes {
+		fileType := fileType
+
+		t.Run(fileType.String(), func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+
+			rl := compressFixture(t, fileType, files, func(name string) bool {
+				return name == "app"
+			})
+
+			dir := t.TempDir()
+
+			extracted, err := rl.Extract(dir)
+			a.NoError(err)
+			a.Equal([]string{
+				filepath.Join(dir, "README.md"),
+				filepath.Join(dir, "assets", "css", "main.css"),
+				filepath.Join(dir, "tools", "helper"),
+			}, extracted)
+
+			a.NoFileExists(filepath.Join(dir, "app"))
+
+			content, err := ioutil.ReadFile(filepath.Join(dir, "assets", "css", "main.css"))
+			a.NoError(err)
+			a.Equal("body {}", string(content))
+
+			info, err := os.Stat(filepath.Join(dir, "tools", "helper"))
+			a.NoError(err)
+			a.NotZero(info.Mode().Perm() & 0100)
+		})
+	}
+}
+
+func TestCompress_ExtractOutsideDirectory_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	for _, fileType := range archiveTypes {
+		fileType := fileType
+
+		t.Run(fileType.String(), func(t *testing.T) {
+			t.Parallel()
+
+			rl := compressFixture(t, fileType, []archiveFixtureFile{
+				{name: "app", content: "binary", mode: 0755},
+				{name: "../evil.sh", content: "evil", mode: 0755},
+			}, nil)
+
+			root := t.TempDir()
+			dir := filepath.Join(root, "app")
+
+			_, err := rl.Extract(dir)
+			assert.Error(t, err)
+			assert.NoFileExists(t, filepath.Join(root, "evil.sh"))
+		})
+	}
+}
+
+func TestCompress_NotArchive(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "app")
+	a.NoError(ioutil.WriteFile(path, []byte("\x7FELF binary"), 0600))
+
+	rl, err := NewLocalFile(path, "1.0.0")
+	a.NoError(err)
+
+	compress := NewCompress(rl)
+	a.Equal(ota.FileTypeBinary, compress.Type())
+	a.Equal("\x7FELF binary", readBinary(t, compress))
+
+	extracted, err := compress.Extract(t.TempDir())
+	a.NoError(err)
+	a.Empty(extracted)
+}
@@ -3,30 +3,43 @@ package release
 import (
 	"bytes"
 	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/mrsmtvd/shadow/components/ota"
 )
 
+const httpFileCachePrefix = "release-download-"
+
 type HTTPFile struct {
 	mutex sync.RWMutex
-
-	u            *url.URL
-	version      string
-	checksum     []byte
-	size         int64
-	architecture string
-	fileType     *ota.FileType
-	fileCache    string
-	createdAt    *time.Time
+	// загрузка выполняется одним вызовом File, остальные ждут ее завершения
+	downloadMutex sync.Mutex
+
+	u              *url.URL
+	version        string
+	checksum       []byte
+	checksumSHA256 []byte
+	size           int64
+	architecture   string
+	fileType       *ota.FileType
+	cacheDir       string
+	createdAt      *time.Time
+	signature      []byte
+	channel        string
+	progress       ota.DownloadProgress
 }
 
 func NewHTTPFile(path, version string, checksum []byte, size int64, architecture string, createdAt *time.Time) (*HTTPFile, error) {
@@ -41,6 +54,7 @@ func NewHTTPFile(path, version string, checksum []byte, size int64, architecture
 		checksum:     checksum,
 		size:         size,
 		architecture: architecture,
+		cacheDir:     os.TempDir(),
 		createdAt:    createdAt,
 	}, nil
 }
@@ -49,42 +63,205 @@ func (f *HTTPFile) Version() string {
 	return f.version
 }
 
+// File возвращает файл из локального кэша. Кэш именуется по чексумме, поэтому переживает
+// обновление индекса репозитория, а прерванная загрузка продолжается с места остановки
 func (f *HTTPFile) File() (io.ReadCloser, error) {
-	// проверяем локальный кэш
-	f.mutex.RLock()
-	fileCache := f.fileCache
-	f.mutex.RUnlock()
+	f.downloadMutex.Lock()
+	defer f.downloadMutex.Unlock()
+
+	path := f.cachePath()
 
-	if fileCache != "" {
-		if fd, err := os.Open(fileCache); err == nil {
-			return fd, nil
+	if fd, err := os.Open(path); err == nil {
+		f.mutex.Lock()
+		f.progress = ota.DownloadProgress{
+			Downloaded: f.size,
+			Total:      f.size,
+			Completed:  true,
 		}
+		f.mutex.Unlock()
+
+		return fd, nil
 	}
 
-	response, err := http.Get(f.u.String())
-	if err != nil {
+	if err := f.download(path); err != nil {
+		f.mutex.Lock()
+		f.progress.Active = false
+		f.progress.Error = err.Error()
+		f.mutex.Unlock()
+
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return nil, errors.New("remote server response not 200 OK")
+	f.mutex.Lock()
+	f.progress.Active = false
+	f.progress.Completed = true
+	f.mutex.Unlock()
+
+	return os.Open(path)
+}
+
+func (f *HTTPFile) download(path string) error {
+	partPath := path + ".part"
+
+	fd, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
 	}
+	defer fd.Close()
 
-	// сохраняем в локальный кэш
-	fd, err := ioutil.TempFile(os.TempDir(), "release-download-")
-	if err == nil {
-		_, err = io.Copy(fd, response.Body)
-		if err == nil {
-			f.mutex.Lock()
-			f.fileCache = fd.Name()
-			f.mutex.Unlock()
+	offset, err := fd.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	response, err := f.request(offset)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		response.Body.Close()
+	}()
+
+	// сервер может проигнорировать или сдвинуть диапазон, тогда дописывать ответ к файлу нельзя
+	if offset > 0 && (response.StatusCode == http.StatusOK ||
+		(response.StatusCode == http.StatusPartialContent && !contentRangeStartsAt(response.Header.Get("Content-Range"), offset))) {
+		if err = fd.Truncate(0); err != nil {
+			return err
+		}
+
+		if offset, err = fd.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
 
-			return os.Open(fd.Name())
+		if response.StatusCode != http.StatusOK {
+			response.Body.Close()
+
+			retry, err := f.request(offset)
+			if err != nil {
+				return err
+			}
+
+			response = retry
 		}
 	}
 
-	return nil, err
+	switch response.StatusCode {
+	case http.StatusOK:
+
+	case http.StatusPartialContent:
+		if !contentRangeStartsAt(response.Header.Get("Content-Range"), offset) {
+			return errors.New("remote server response wrong content range " + response.Header.Get("Content-Range"))
+		}
+
+	// недокачанный файл уже полный, если размер совпадает, иначе он испорчен
+	case http.StatusRequestedRangeNotSatisfiable:
+		if f.size <= 0 || offset != f.size {
+			_ = os.Remove(partPath)
+			return errors.New("remote server rejected resume of download, partial file removed")
+		}
+
+	default:
+		return errors.New("remote server response " + response.Status)
+	}
+
+	total := f.size
+	if total <= 0 && response.ContentLength >= 0 {
+		total = offset + response.ContentLength
+	}
+
+	f.mutex.Lock()
+	f.progress = ota.DownloadProgress{
+		Downloaded: offset,
+		Total:      total,
+		Active:     true,
+	}
+	f.mutex.Unlock()
+
+	if response.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		// недокачанная часть остается на диске для продолжения загрузки
+		if _, err = io.Copy(fd, io.TeeReader(response.Body, progressWriter{f})); err != nil {
+			return err
+		}
+	}
+
+	if err = fd.Close(); err != nil {
+		return err
+	}
+
+	if err = f.validateFile(partPath); err != nil {
+		_ = os.Remove(partPath)
+		return err
+	}
+
+	return os.Rename(partPath, path)
+}
+
+func (f *HTTPFile) request(offset int64) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, f.u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 {
+		request.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	return http.DefaultClient.Do(request)
+}
+
+// contentRangeStartsAt проверяет, что ответ на Range начинается с offset, заголовок в формате bytes start-end/size
+func contentRangeStartsAt(contentRange string, offset int64) bool {
+	if !strings.HasPrefix(contentRange, "bytes ") {
+		return false
+	}
+
+	contentRange = strings.TrimPrefix(contentRange, "bytes ")
+
+	i := strings.Index(contentRange, "-")
+	if i < 0 {
+		return false
+	}
+
+	start, err := strconv.ParseInt(strings.TrimSpace(contentRange[:i]), 10, 64)
+
+	return err == nil && start == offset
+}
+
+func (f *HTTPFile) cachePath() string {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	var key string
+
+	switch {
+	case len(f.checksumSHA256) > 0:
+		key = hex.EncodeToString(f.checksumSHA256)
+	case len(f.checksum) > 0:
+		key = hex.EncodeToString(f.checksum)
+	default:
+		h := sha256.Sum256([]byte(f.u.String()))
+		key = hex.EncodeToString(h[:])
+	}
+
+	return filepath.Join(f.cacheDir, httpFileCachePrefix+key)
+}
+
+// SetCacheDirectory задает директорию локального кэша загруженных файлов
+func (f *HTTPFile) SetCacheDirectory(dir string) {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+
+	f.mutex.Lock()
+	f.cacheDir = dir
+	f.mutex.Unlock()
+}
+
+func (f *HTTPFile) DownloadProgress() ota.DownloadProgress {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.progress
 }
 
 func (f *HTTPFile) FileBinary() (io.ReadCloser, error) {
@@ -99,6 +276,19 @@ func (f *HTTPFile) Checksum() []byte {
 	return f.checksum
 }
 
+func (f *HTTPFile) SetChecksumSHA256(checksum []byte) {
+	f.mutex.Lock()
+	f.checksumSHA256 = checksum
+	f.mutex.Unlock()
+}
+
+func (f *HTTPFile) ChecksumSHA256() []byte {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.checksumSHA256
+}
+
 func (f *HTTPFile) Size() int64 {
 	return f.size
 }
@@ -124,6 +314,13 @@ func (f *HTTPFile) Type() ota.FileType {
 	return *ft
 }
 
+// SetType задает тип файла из индекса репозитория, тогда запрос к серверу не нужен
+func (f *HTTPFile) SetType(fileType ota.FileType) {
+	f.mutex.Lock()
+	f.fileType = &fileType
+	f.mutex.Unlock()
+}
+
 func (f *HTTPFile) CreatedAt() *time.Time {
 	return f.createdAt
 }
@@ -135,20 +332,94 @@ func (f *HTTPFile) Validate() error {
 	}
 	defer file.Close()
 
-	hasher := md5.New()
+	return f.validate(file)
+}
+
+func (f *HTTPFile) validateFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return f.validate(file)
+}
+
+func (f *HTTPFile) validate(file io.Reader) error {
+	var (
+		hasher hash.Hash
+		want   []byte
+	)
+
+	if want = f.ChecksumSHA256(); len(want) > 0 {
+		hasher = sha256.New()
+	} else {
+		hasher, want = md5.New(), f.Checksum()
+	}
+
+	if len(want) == 0 {
+		return nil
+	}
+
 	if _, err := io.Copy(hasher, file); err != nil {
 		return err
 	}
 
 	cs := hasher.Sum(nil)
-	if bytes.Equal(f.Checksum(), cs) {
-		return fmt.Errorf("wrong checksum have %x want %x", cs, f.Checksum())
+	if !bytes.Equal(want, cs) {
+		return fmt.Errorf("wrong checksum have %x want %x", cs, want)
 	}
 
 	return nil
 }
 
+func (f *HTTPFile) SetSignature(signature []byte) {
+	f.mutex.Lock()
+	f.signature = signature
+	f.mutex.Unlock()
+}
+
+func (f *HTTPFile) Signature() []byte {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.signature
+}
+
+func (f *HTTPFile) SetChannel(channel string) {
+	f.mutex.Lock()
+	f.channel = channel
+	f.mutex.Unlock()
+}
+
+func (f *HTTPFile) Channel() string {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.channel
+}
+
 func (f *HTTPFile) getFileType() ota.FileType {
+	if ft := f.getFileTypeFromMIME(); ft != ota.FileTypeUnknown {
+		return ft
+	}
+
+	// статические серверы часто отдают архивы как application/octet-stream
+	if ft := ota.FileTypeFromFileName(f.u.Path); ft != ota.FileTypeUnknown {
+		return ft
+	}
+
+	// остается определить тип по содержимому, файл все равно понадобится для установки
+	file, err := f.File()
+	if err != nil {
+		return ota.FileTypeUnknown
+	}
+	defer file.Close()
+
+	return ota.FileTypeFromData(file)
+}
+
+func (f *HTTPFile) getFileTypeFromMIME() ota.FileType {
 	// попытка вычитать HEAD
 	response, err := http.Head(f.u.String())
 	if err == nil {
@@ -171,3 +442,15 @@ func (f *HTTPFile) getFileType() ota.FileType {
 
 	return ota.FileTypeUnknown
 }
+
+type progressWriter struct {
+	file *HTTPFile
+}
+
+func (w progressWriter) Write(p []byte) (int, error) {
+	w.file.mutex.Lock()
+	w.file.progress.Downloaded += int64(len(p))
+	w.file.mutex.Unlock()
+
+	return len(p), nil
+}
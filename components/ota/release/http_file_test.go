@@ -0,0 +1,261 @@
+package release
+
+import (
+	"crypto/md5"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var httpFileContent = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+// httpFileServer запоминает заголовки Range всех запросов к файлу
+type httpFileServer struct {
+	*httptest.Server
+
+	mutex  sync.Mutex
+	ranges []string
+}
+
+func newHTTPFileServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *httpFileServer {
+	s := &httpFileServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.ranges = append(s.ranges, r.Header.Get("Range"))
+		s.mutex.Unlock()
+
+		handler(w, r)
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *httpFileServer) requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]string(nil), s.ranges...)
+}
+
+// partialContent отвечает на Range так, как будто файл начинается со start
+func partialContent(w http.ResponseWriter, start int) {
+	w.Header().Set("Content-Range", "bytes "+strconv.Itoa(start)+"-"+strconv.Itoa(len(httpFileContent)-1)+"/"+strconv.Itoa(len(httpFileContent)))
+	w.WriteHeader(http.StatusPartialContent)
+	_, _ = w.Write(httpFileContent[start:])
+}
+
+func newTestHTTPFile(t *testing.T, server *httpFileServer, content []byte) *HTTPFile {
+	checksum := md5.Sum(content)
+
+	f, err := NewHTTPFile(server.URL+"/app-1.0.0", "1.0.0", checksum[:], int64(len(content)), "amd64", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.SetCacheDirectory(t.TempDir())
+
+	return f
+}
+
+func writePartFile(t *testing.T, f *HTTPFile, content []byte) {
+	if err := ioutil.WriteFile(f.cachePath()+".part", content, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readHTTPFile(t *testing.T, f *HTTPFile) ([]byte, error) {
+	file, err := f.File()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
+
+func TestHTTPFile_File_Download(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(httpFileContent)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+
+	content, err := readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Equal([]string{""}, server.requests())
+	a.NoFileExists(f.cachePath() + ".part")
+
+	progress := f.DownloadProgress()
+	a.True(progress.Completed)
+	a.False(progress.Active)
+	a.Equal(int64(len(httpFileContent)), progress.Downloaded)
+
+	// повторное чтение из кэша без запросов к серверу
+	content, err = readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Len(server.requests(), 1)
+}
+
+func TestHTTPFile_File_ResumePartialContent(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		partialContent(w, 10)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	content, err := readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Equal([]string{"bytes=10-"}, server.requests())
+}
+
+func TestHTTPFile_File_ResumeIgnoredRange_Restarted(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	// сервер без поддержки Range отдает весь файл
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(httpFileContent)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	content, err := readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Equal([]string{"bytes=10-"}, server.requests())
+}
+
+func TestHTTPFile_File_ResumeWrongContentRange_Restarted(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			partialContent(w, 5)
+			return
+		}
+
+		_, _ = w.Write(httpFileContent)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	content, err := readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Equal([]string{"bytes=10-", ""}, server.requests())
+}
+
+func TestHTTPFile_File_WrongContentRangeAfterRestart_ReturnsError(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	// сервер всегда отвечает диапазоном с чужим началом
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		partialContent(w, 5)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	_, err := readHTTPFile(t, f)
+	a.Error(err)
+	a.Equal([]string{"bytes=10-", ""}, server.requests())
+	a.NoFileExists(f.cachePath())
+	a.NotEmpty(f.DownloadProgress().Error)
+}
+
+func TestHTTPFile_File_RangeNotSatisfiableWithCompletePart(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent)
+
+	content, err := readHTTPFile(t, f)
+	a.NoError(err)
+	a.Equal(httpFileContent, content)
+	a.Equal([]string{"bytes=" + strconv.Itoa(len(httpFileContent)) + "-"}, server.requests())
+	a.NoFileExists(f.cachePath() + ".part")
+}
+
+func TestHTTPFile_File_RangeNotSatisfiableWithIncompletePart_PartRemoved(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	_, err := readHTTPFile(t, f)
+	a.Error(err)
+	a.NoFileExists(f.cachePath() + ".part")
+	a.NoFileExists(f.cachePath())
+}
+
+func TestHTTPFile_File_WrongChecksum_PartRemoved(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(httpFileContent)
+	})
+
+	f := newTestHTTPFile(t, server, []byte("other content"))
+
+	_, err := readHTTPFile(t, f)
+	a.Error(err)
+	a.NoFileExists(f.cachePath() + ".part")
+	a.NoFileExists(f.cachePath())
+
+	// следующая попытка загружает файл с начала
+	_, err = readHTTPFile(t, f)
+	a.Error(err)
+	a.Equal([]string{"", ""}, server.requests())
+}
+
+func TestHTTPFile_File_ServerError_PartKept(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	server := newHTTPFileServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	f := newTestHTTPFile(t, server, httpFileContent)
+	writePartFile(t, f, httpFileContent[:10])
+
+	_, err := readHTTPFile(t, f)
+	a.Error(err)
+	a.NoFileExists(f.cachePath())
+
+	part, err := ioutil.ReadFile(f.cachePath() + ".part")
+	a.NoError(err)
+	a.Equal(httpFileContent[:10], part)
+}
@@ -2,6 +2,8 @@ package release
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
 	"io"
 	"io/ioutil"
 	"os"
@@ -11,12 +13,15 @@ import (
 )
 
 type LocalFile struct {
-	path         string
-	version      string
-	checksum     []byte
-	architecture string
-	fileInfo     os.FileInfo
-	fileType     ota.FileType
+	path           string
+	version        string
+	checksum       []byte
+	checksumSHA256 []byte
+	architecture   string
+	fileInfo       os.FileInfo
+	fileType       ota.FileType
+	signature      []byte
+	channel        string
 }
 
 func NewLocalFile(path, version string) (*LocalFile, error) {
@@ -57,7 +62,9 @@ func NewLocalFileFromFD(fd *os.File, version string) (*LocalFile, error) {
 	}
 
 	h := md5.New()
-	if _, err := io.Copy(h, fd); err != nil {
+	hSHA256 := sha256.New()
+
+	if _, err := io.Copy(io.MultiWriter(h, hSHA256), fd); err != nil {
 		return nil, err
 	}
 
@@ -67,17 +74,44 @@ func NewLocalFileFromFD(fd *os.File, version string) (*LocalFile, error) {
 
 	fd.Seek(0, io.SeekStart)
 
+	md, err := ota.ReadReleaseMetadata(fd.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	var channel string
+
+	if md != nil {
+		if version == "" {
+			version = md.Version
+		}
+
+		channel = md.Channel
+	}
+
 	if version == "" {
 		version = stat.Name()
 	}
 
+	// отделенная подпись лежит рядом с файлом релиза
+	var signature []byte
+
+	if content, err := ioutil.ReadFile(fd.Name() + ota.SignatureExt); err == nil {
+		if signature, err = ota.ParseSignature(string(content)); err != nil {
+			return nil, err
+		}
+	}
+
 	return &LocalFile{
-		path:         fd.Name(),
-		version:      version,
-		checksum:     h.Sum(nil),
-		architecture: ota.ArchitectureFromReader(fd),
-		fileInfo:     stat,
-		fileType:     fileType,
+		path:           fd.Name(),
+		version:        version,
+		checksum:       h.Sum(nil),
+		checksumSHA256: hSHA256.Sum(nil),
+		architecture:   ota.ArchitectureFromReader(fd),
+		fileInfo:       stat,
+		fileType:       fileType,
+		signature:      signature,
+		channel:        channel,
 	}, nil
 }
 
@@ -101,6 +135,10 @@ func (f *LocalFile) Checksum() []byte {
 	return f.checksum
 }
 
+func (f *LocalFile) ChecksumSHA256() []byte {
+	return f.checksumSHA256
+}
+
 func (f *LocalFile) Size() int64 {
 	return f.fileInfo.Size()
 }
@@ -121,6 +159,43 @@ func (f *LocalFile) CreatedAt() *time.Time {
 	return &[]time.Time{f.fileInfo.ModTime()}[0]
 }
 
+func (f *LocalFile) Signature() []byte {
+	return f.signature
+}
+
+// SaveSignature сохраняет отделенную подпись рядом с файлом релиза
+func (f *LocalFile) SaveSignature(signature []byte) error {
+	err := ioutil.WriteFile(f.path+ota.SignatureExt, []byte(base64.StdEncoding.EncodeToString(signature)), 0600)
+	if err == nil {
+		f.signature = signature
+	}
+
+	return err
+}
+
+func (f *LocalFile) Channel() string {
+	return f.channel
+}
+
+// SaveMetadata сохраняет версию и канал рядом с файлом релиза, чтобы они пережили перезапуск
+func (f *LocalFile) SaveMetadata(version, channel string) error {
+	if version == "" {
+		version = f.version
+	}
+
+	err := ota.SaveReleaseMetadata(f.path, &ota.ReleaseMetadata{
+		Version: version,
+		Channel: channel,
+	})
+
+	if err == nil {
+		f.version = version
+		f.channel = channel
+	}
+
+	return err
+}
+
 func (f *LocalFile) Validate() error {
 	return nil
 }
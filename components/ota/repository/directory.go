@@ -3,7 +3,9 @@ package repository
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/mrsmtvd/shadow/components/ota"
 	"github.com/mrsmtvd/shadow/components/ota/release"
@@ -31,6 +33,9 @@ func (r *Directory) Remove(release ota.Release) (err error) {
 	err = os.Remove(release.Path())
 
 	if err == nil {
+		_ = os.Remove(release.Path() + ota.SignatureExt)
+		_ = os.Remove(release.Path() + ota.MetadataExt)
+
 		err = r.Memory.Remove(release)
 	}
 
@@ -41,6 +46,53 @@ func (r *Directory) CanRemove(release ota.Release) bool {
 	return r.Memory.CanRemove(release)
 }
 
+// Retain оставляет для каждой архитектуры keep последних загруженных релизов, остальные удаляются
+func (r *Directory) Retain(keep int) ([]ota.Release, error) {
+	if keep <= 0 {
+		return nil, nil
+	}
+
+	releases, err := r.Releases("")
+	if err != nil {
+		return nil, err
+	}
+
+	byArch := make(map[string][]ota.Release)
+	for _, rl := range releases {
+		byArch[rl.Architecture()] = append(byArch[rl.Architecture()], rl)
+	}
+
+	removed := make([]ota.Release, 0)
+
+	for _, items := range byArch {
+		if len(items) <= keep {
+			continue
+		}
+
+		sort.SliceStable(items, func(i, j int) bool {
+			return createdAt(items[i]).After(createdAt(items[j]))
+		})
+
+		for _, rl := range items[keep:] {
+			if err := r.Remove(rl); err != nil {
+				return removed, err
+			}
+
+			removed = append(removed, rl)
+		}
+	}
+
+	return removed, nil
+}
+
+func createdAt(rl ota.Release) time.Time {
+	if t := rl.CreatedAt(); t != nil {
+		return *t
+	}
+
+	return time.Time{}
+}
+
 func (r *Directory) Update() error {
 	r.Memory.Clean()
 
@@ -49,7 +101,7 @@ func (r *Directory) Update() error {
 			return nil
 		}
 
-		if !strings.HasPrefix(info.Name(), "release-file-") {
+		if !strings.HasPrefix(info.Name(), "release-file-") || ota.IsSidecarFile(info.Name()) {
 			return nil
 		}
 
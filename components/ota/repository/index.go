@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mrsmtvd/shadow/components/ota"
+	"github.com/mrsmtvd/shadow/components/ota/release"
+)
+
+// GenerateIndex формирует индекс для клиента Shadow по файлам релизов из dir, чтобы раздавать релизы
+// любым статическим сервером. Ссылки на файлы строятся от baseURL, а если он пустой, то относительно
+// индекса. Версия берется из метаданных рядом с файлом, иначе из имени файла. Если keep больше нуля,
+// то для каждой архитектуры в индекс попадают только keep последних релизов
+func GenerateIndex(dir, baseURL string, keep int, skip ...string) ([]ShadowRecord, error) {
+	skipped := make(map[string]struct{}, len(skip))
+	for _, path := range skip {
+		if abs, err := filepath.Abs(path); err == nil {
+			skipped[abs] = struct{}{}
+		}
+	}
+
+	releases := make([]ota.Release, 0)
+	files := make(map[ota.Release]string)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(info.Name(), ".") && path != dir {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if info.IsDir() || ota.IsSidecarFile(info.Name()) {
+			return nil
+		}
+
+		if abs, err := filepath.Abs(path); err == nil {
+			if _, ok := skipped[abs]; ok {
+				return nil
+			}
+		}
+
+		var version string
+
+		if md, err := ota.ReadReleaseMetadata(path); err != nil {
+			return err
+		} else if md == nil {
+			version = ota.VersionFromFileName(path)
+		}
+
+		rl, err := release.NewLocalFile(path, version)
+		if err != nil {
+			return err
+		}
+
+		if rl.Type() == ota.FileTypeUnknown {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		compress := release.NewCompress(rl)
+		releases = append(releases, compress)
+		files[compress] = filepath.ToSlash(rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		if releases[i].Architecture() != releases[j].Architecture() {
+			return releases[i].Architecture() < releases[j].Architecture()
+		}
+
+		return createdAt(releases[i]).After(createdAt(releases[j]))
+	})
+
+	records := make([]ShadowRecord, 0, len(releases))
+	perArch := make(map[string]int)
+
+	for _, rl := range releases {
+		if keep > 0 && perArch[rl.Architecture()] >= keep {
+			continue
+		}
+
+		perArch[rl.Architecture()]++
+
+		records = append(records, NewShadowRecord(rl, indexFileURL(baseURL, files[rl])))
+	}
+
+	return records, nil
+}
+
+func indexFileURL(baseURL, rel string) string {
+	u := (&url.URL{Path: rel}).String()
+
+	if baseURL == "" {
+		return u
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + u
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
@@ -8,22 +9,61 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/mrsmtvd/shadow/components/ota"
 	"github.com/mrsmtvd/shadow/components/ota/release"
 )
 
+// ShadowRecord запись индекса репозитория. Checksum содержит MD5 для совместимости со старыми клиентами,
+// при наличии ChecksumSHA256 файл проверяется по нему. Без Channel канал определяется по версии.
+// Type нужен статическим серверам, которые отдают архивы как application/octet-stream
 type ShadowRecord struct {
-	Architecture string     `json:"architecture"`
-	Checksum     string     `json:"checksum"`
-	Size         int64      `json:"size"`
-	Version      string     `json:"version"`
-	File         string     `json:"file"`
-	CreatedAt    *time.Time `json:"created_at"`
+	Architecture   string     `json:"architecture"`
+	Checksum       string     `json:"checksum"`
+	ChecksumSHA256 string     `json:"checksum_sha256,omitempty"`
+	Size           int64      `json:"size"`
+	Version        string     `json:"version"`
+	File           string     `json:"file"`
+	CreatedAt      *time.Time `json:"created_at"`
+	Signature      string     `json:"signature,omitempty"`
+	Channel        string     `json:"channel,omitempty"`
+	Type           string     `json:"type,omitempty"`
+}
+
+// NewShadowRecord формирует запись индекса для релиза, файл которого доступен по адресу file
+func NewShadowRecord(rl ota.Release, file string) ShadowRecord {
+	record := ShadowRecord{
+		Architecture: rl.Architecture(),
+		Checksum:     hex.EncodeToString(rl.Checksum()),
+		Size:         rl.Size(),
+		Version:      rl.Version(),
+		File:         file,
+		CreatedAt:    rl.CreatedAt(),
+	}
+
+	if ft := rl.Type(); ft != ota.FileTypeUnknown {
+		record.Type = ft.String()
+	}
+
+	if cs, ok := rl.(ota.ChecksumSHA256); ok && len(cs.ChecksumSHA256()) > 0 {
+		record.ChecksumSHA256 = hex.EncodeToString(cs.ChecksumSHA256())
+	}
+
+	if signed, ok := rl.(ota.Signed); ok && len(signed.Signature()) > 0 {
+		record.Signature = base64.StdEncoding.EncodeToString(signed.Signature())
+	}
+
+	if c, ok := rl.(ota.Channeled); ok {
+		record.Channel = c.Channel()
+	}
+
+	return record
 }
 
 type Shadow struct {
 	*Memory
 
-	u *url.URL
+	u        *url.URL
+	cacheDir string
 }
 
 func NewShadow(u *url.URL) *Shadow {
@@ -33,6 +73,13 @@ func NewShadow(u *url.URL) *Shadow {
 	}
 }
 
+// SetCacheDirectory задает директорию, в которую загружаются файлы релизов
+func (r *Shadow) SetCacheDirectory(dir string) {
+	r.lock.Lock()
+	r.cacheDir = dir
+	r.lock.Unlock()
+}
+
 func (r *Shadow) Update() error {
 	response, err := http.Get(r.u.String())
 	if err != nil {
@@ -59,11 +106,42 @@ func (r *Shadow) Update() error {
 			return err
 		}
 
-		rl, err := release.NewHTTPFile(record.File, record.Version, cs, record.Size, record.Architecture, record.CreatedAt)
+		// статический индекс может ссылаться на файлы относительно себя
+		file, err := r.u.Parse(record.File)
 		if err != nil {
 			return err
 		}
 
+		rl, err := release.NewHTTPFile(file.String(), record.Version, cs, record.Size, record.Architecture, record.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		signature, err := ota.ParseSignature(record.Signature)
+		if err != nil {
+			return err
+		}
+
+		rl.SetSignature(signature)
+		rl.SetChannel(record.Channel)
+
+		if ft := ota.FileType(record.Type); ft.String() != ota.FileTypeUnknown.String() {
+			rl.SetType(ft)
+		}
+
+		if record.ChecksumSHA256 != "" {
+			csSHA256, err := hex.DecodeString(record.ChecksumSHA256)
+			if err != nil {
+				return err
+			}
+
+			rl.SetChecksumSHA256(csSHA256)
+		}
+
+		r.lock.RLock()
+		rl.SetCacheDirectory(r.cacheDir)
+		r.lock.RUnlock()
+
 		r.Memory.Add(release.NewCompress(rl))
 	}
 
@@ -0,0 +1,184 @@
+package ota
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// SignatureExt расширение файла с отделенной подписью, который хранится рядом с файлом релиза
+const SignatureExt = ".sig"
+
+var (
+	ErrReleaseUnsigned     = errors.New("release isn't signed")
+	ErrSignatureInvalid    = errors.New("release signature isn't valid")
+	ErrTrustedKeysNotFound = errors.New("trusted keys for verify release signature not found")
+)
+
+// Signed реализуют релизы, у которых есть подпись Ed25519
+type Signed interface {
+	Signature() []byte
+}
+
+// Sign подписывает файл релиза. Подписывается SHA-512 от содержимого файла, как в minisign,
+// чтобы не держать большой файл в памяти
+func Sign(key ed25519.PrivateKey, file io.Reader) ([]byte, error) {
+	digest, err := signatureDigest(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.Sign(key, digest), nil
+}
+
+func signatureDigest(file io.Reader) ([]byte, error) {
+	h := sha512.New()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// ParseSignature разбирает подпись в base64 или hex
+func ParseSignature(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	signature, err := decodeBase64OrHex(value, ed25519.SignatureSize)
+	if err != nil {
+		return nil, errors.New("signature " + err.Error())
+	}
+
+	return signature, nil
+}
+
+// строка в hex почти всегда является и корректным base64, поэтому формат выбирается по размеру результата
+func decodeBase64OrHex(value string, size int) ([]byte, error) {
+	decoded, errBase64 := base64.StdEncoding.DecodeString(value)
+	if errBase64 == nil && len(decoded) == size {
+		return decoded, nil
+	}
+
+	decoded, errHex := hex.DecodeString(value)
+	if errHex == nil && len(decoded) == size {
+		return decoded, nil
+	}
+
+	if errBase64 != nil && errHex != nil {
+		return nil, errors.New("must be encoded in base64 or hex")
+	}
+
+	return nil, errors.New("has wrong size")
+}
+
+// ParsePublicKeys разбирает публичные ключи Ed25519 в base64 или hex через запятую
+func ParsePublicKeys(value string) ([]ed25519.PublicKey, error) {
+	keys := make([]ed25519.PublicKey, 0)
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		key, err := decodeBase64OrHex(item, ed25519.PublicKeySize)
+		if err != nil {
+			return nil, errors.New("public key " + item + " " + err.Error())
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Verifier проверяет подписи релизов доверенными ключами. Без принуждения неподписанные
+// релизы пропускаются, но релиз с неверной подписью отклоняется всегда
+type Verifier struct {
+	mutex   sync.RWMutex
+	keys    []ed25519.PublicKey
+	enforce bool
+}
+
+func NewVerifier(keys []ed25519.PublicKey, enforce bool) *Verifier {
+	return &Verifier{
+		keys:    keys,
+		enforce: enforce,
+	}
+}
+
+func (v *Verifier) SetKeys(keys []ed25519.PublicKey) {
+	v.mutex.Lock()
+	v.keys = keys
+	v.mutex.Unlock()
+}
+
+func (v *Verifier) SetEnforce(enforce bool) {
+	v.mutex.Lock()
+	v.enforce = enforce
+	v.mutex.Unlock()
+}
+
+func (v *Verifier) Enforce() bool {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	return v.enforce
+}
+
+func (v *Verifier) Verify(release Release) error {
+	v.mutex.RLock()
+	keys := v.keys
+	enforce := v.enforce
+	v.mutex.RUnlock()
+
+	var signature []byte
+
+	if signed, ok := release.(Signed); ok {
+		signature = signed.Signature()
+	}
+
+	if len(signature) == 0 {
+		if enforce {
+			return ErrReleaseUnsigned
+		}
+
+		return nil
+	}
+
+	if len(keys) == 0 {
+		if enforce {
+			return ErrTrustedKeysNotFound
+		}
+
+		return nil
+	}
+
+	file, err := release.File()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	digest, err := signatureDigest(file)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if ed25519.Verify(key, digest, signature) {
+			return nil
+		}
+	}
+
+	return ErrSignatureInvalid
+}
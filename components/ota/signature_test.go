@@ -0,0 +1,131 @@
+package ota
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(t *testing.T, seed byte) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+}
+
+func testSignedRelease(t *testing.T, key ed25519.PrivateKey, content []byte) *testRelease {
+	signature, err := Sign(key, bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testRelease{version: "1.0.0", content: content, signature: signature}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	key := testKey(t, 1)
+	otherKey := testKey(t, 2)
+	content := []byte("release binary")
+
+	valid := testSignedRelease(t, key, content)
+
+	tampered := testSignedRelease(t, key, content)
+	tampered.content = []byte("release binarY")
+
+	unsigned := &testRelease{version: "1.0.0", content: content}
+
+	garbage := testSignedRelease(t, key, content)
+	garbage.signature = bytes.Repeat([]byte{0xFF}, ed25519.SignatureSize)
+
+	publicKey := key.Public().(ed25519.PublicKey)
+	otherPublicKey := otherKey.Public().(ed25519.PublicKey)
+
+	cases := []struct {
+		name    string
+		keys    []ed25519.PublicKey
+		enforce bool
+		release Release
+		err     error
+	}{
+		{"valid signature", []ed25519.PublicKey{publicKey}, true, valid, nil},
+		{"valid signature with one of keys", []ed25519.PublicKey{otherPublicKey, publicKey}, true, valid, nil},
+		{"tampered payload", []ed25519.PublicKey{publicKey}, true, tampered, ErrSignatureInvalid},
+		{"tampered payload without enforce", []ed25519.PublicKey{publicKey}, false, tampered, ErrSignatureInvalid},
+		{"wrong key", []ed25519.PublicKey{otherPublicKey}, true, valid, ErrSignatureInvalid},
+		{"wrong key without enforce", []ed25519.PublicKey{otherPublicKey}, false, valid, ErrSignatureInvalid},
+		{"broken signature", []ed25519.PublicKey{publicKey}, true, garbage, ErrSignatureInvalid},
+		{"missing signature", []ed25519.PublicKey{publicKey}, true, unsigned, ErrReleaseUnsigned},
+		{"missing signature without enforce", []ed25519.PublicKey{publicKey}, false, unsigned, nil},
+		{"no trusted keys", nil, true, valid, ErrTrustedKeysNotFound},
+		{"no trusted keys without enforce", nil, false, valid, nil},
+	}
+
+	for _, c := range cases {
+		err := NewVerifier(c.keys, c.enforce).Verify(c.release)
+
+		assert.Equal(t, c.err, err, c.name)
+	}
+}
+
+func TestVerifier_SetKeys_AppliesToNextVerify(t *testing.T) {
+	t.Parallel()
+
+	key := testKey(t, 1)
+	rl := testSignedRelease(t, key, []byte("release binary"))
+
+	v := NewVerifier([]ed25519.PublicKey{testKey(t, 2).Public().(ed25519.PublicKey)}, true)
+	assert.Equal(t, ErrSignatureInvalid, v.Verify(rl))
+
+	v.SetKeys([]ed25519.PublicKey{key.Public().(ed25519.PublicKey)})
+	assert.NoError(t, v.Verify(rl))
+
+	v.SetKeys(nil)
+	v.SetEnforce(false)
+	assert.False(t, v.Enforce())
+	assert.NoError(t, v.Verify(rl))
+}
+
+func TestParseSignature(t *testing.T) {
+	t.Parallel()
+
+	signature := ed25519.Sign(testKey(t, 1), []byte("digest"))
+
+	parsed, err := ParseSignature(base64.StdEncoding.EncodeToString(signature))
+	assert.NoError(t, err)
+	assert.Equal(t, signature, parsed)
+
+	parsed, err = ParseSignature(" " + hex.EncodeToString(signature) + "\n")
+	assert.NoError(t, err)
+	assert.Equal(t, signature, parsed)
+
+	parsed, err = ParseSignature("")
+	assert.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	_, err = ParseSignature(base64.StdEncoding.EncodeToString(signature[:10]))
+	assert.Error(t, err)
+
+	_, err = ParseSignature("not a signature!")
+	assert.Error(t, err)
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	first := testKey(t, 1).Public().(ed25519.PublicKey)
+	second := testKey(t, 2).Public().(ed25519.PublicKey)
+
+	keys, err := ParsePublicKeys(base64.StdEncoding.EncodeToString(first) + ", " + hex.EncodeToString(second) + ",")
+	assert.NoError(t, err)
+	assert.Equal(t, []ed25519.PublicKey{first, second}, keys)
+
+	keys, err = ParsePublicKeys("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = ParsePublicKeys(base64.StdEncoding.EncodeToString(first[:16]))
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package ota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+const (
+	// UpgradeMarkerExt расширение маркера непроверенного обновления, который лежит рядом с бинарником
+	UpgradeMarkerExt = ".upgrade"
+	// UpgradeResultExt расширение файла с итогом последнего обновления
+	UpgradeResultExt = ".upgrade-result"
+	// PreviousReleaseExt расширение предыдущего бинарника, который хранится для отката
+	PreviousReleaseExt = ".old"
+
+	tmpExt = ".tmp"
+
+	UpgradeStatusVerified   = "verified"
+	UpgradeStatusRolledBack = "rolled_back"
+)
+
+// UpgradeMarker создается при установке релиза и удаляется после того, как новый релиз
+// прошел проверки готовности. Boots считает запуски, чтобы отловить падение при старте
+type UpgradeMarker struct {
+	Version      string    `json:"version"`
+	Path         string    `json:"path"`
+	PreviousPath string    `json:"previous_path"`
+	InstalledAt  time.Time `json:"installed_at"`
+	Boots        int       `json:"boots"`
+}
+
+// ReadUpgradeMarker читает маркер для бинарника path, если маркера нет, то возвращается nil
+func ReadUpgradeMarker(path string) (*UpgradeMarker, error) {
+	marker := &UpgradeMarker{}
+
+	if err := readJSONFile(path+UpgradeMarkerExt, marker); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return marker, nil
+}
+
+func (m *UpgradeMarker) Save() error {
+	return writeJSONFile(m.Path+UpgradeMarkerExt, m)
+}
+
+func (m *UpgradeMarker) Remove() error {
+	err := os.Remove(m.Path + UpgradeMarkerExt)
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+type UpgradeResult struct {
+	Version    string    `json:"version"`
+	Status     string    `json:"status"`
+	Message    string    `json:"message,omitempty"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// ReadUpgradeResult читает итог последнего обновления бинарника path, если обновлений не было, то возвращается nil
+func ReadUpgradeResult(path string) (*UpgradeResult, error) {
+	result := &UpgradeResult{}
+
+	if err := readJSONFile(path+UpgradeResultExt, result); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func SaveUpgradeResult(path string, result *UpgradeResult) error {
+	return writeJSONFile(path+UpgradeResultExt, result)
+}
+
+func readJSONFile(path string, v interface{}) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, v)
+}
+
+// запись через временный файл, чтобы падение посреди записи не оставило битый файл
+func writeJSONFile(path string, v interface{}) error {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + tmpExt
+
+	if err = ioutil.WriteFile(tmpPath, content, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,78 @@
+package ota
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaintenanceWindow ежедневный интервал времени, в который допускается автоматическое обновление.
+// Интервал может переходить через полночь, например 23:00-02:00
+type MaintenanceWindow struct {
+	start time.Duration
+	end   time.Duration
+	any   bool
+}
+
+// ParseMaintenanceWindow разбирает окно в формате HH:MM-HH:MM, пустое значение разрешает любое время
+func ParseMaintenanceWindow(value string) (*MaintenanceWindow, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return &MaintenanceWindow{any: true}, nil
+	}
+
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return nil, errors.New("maintenance window must be in format HH:MM-HH:MM")
+	}
+
+	start, err := parseClock(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseClock(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaintenanceWindow{
+		start: start,
+		end:   end,
+		any:   start == end,
+	}, nil
+}
+
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, errors.New("wrong time " + value + " of maintenance window")
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains проверяет попадание времени в окно по локальному времени t
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	if w.any {
+		return true
+	}
+
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+
+	if w.start < w.end {
+		return clock >= w.start && clock < w.end
+	}
+
+	return clock >= w.start || clock < w.end
+}
+
+func (w *MaintenanceWindow) String() string {
+	if w.any {
+		return ""
+	}
+
+	day := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return day.Add(w.start).Format("15:04") + "-" + day.Add(w.end).Format("15:04")
+}
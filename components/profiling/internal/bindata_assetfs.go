@@ -1,406 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// templates/views/trace.html
-// assets/js/trace.min.js
-// assets/vendors/flipclock/css/flipclock.min.css
-// assets/vendors/flipclock/js/flipclock.min.js
-// locales/ru/LC_MESSAGES/config.mo
-// locales/ru/LC_MESSAGES/profiling.mo
-// locales/ru/LC_MESSAGES/trace.mo
-// DO NOT EDIT!
-
-package internal
-
-import (
-	"github.com/elazarl/go-bindata-assetfs"
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _templatesViewsTraceHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x6b\x6f\xe3\xb8\xd5\xfe\x3e\xbf\xe2\xbc\x9a\xbc\x95\x0d\x44\x96\x93\xe9\xee\x16\x8e\xed\xc1\x20\x83\x99\x16\x98\x05\x16\x73\x41\x81\x2e\x16\x01\x2d\x52\x16\x13\x8a\x54\x49\xca\xb1\x6b\xf8\xbf\x17\xa4\x2e\xd6\x85\x52\x32\xdd\xd9\x45\x0b\x6c\xbe\x84\x26\x79\x0e\x9f\x73\x3f\xa4\x8e\x47\xc0\x24\xa6\x9c\x80\x97\x10\x84\x3d\x38\x9d\x5e\x00\x00\x1c\x8f\x40\x63\x98\x29\x8d\xa4\x26\xb8\x31\xab\x34\xd2\x34\xfa\xeb\xe7\x1f\x3f\xc0\xa4\x18\x7f\xf9\xf8\x01\xbc\x30\x93\x22\xa6\x8c\xf2\x6d\x88\x94\x22\x5a\x85\x3b\xc2\xb1\x90\x2a\x8c\x19\xcd\x22\x26\xa2\x87\x30\x52\x8d\x5f\xb3\x94\xf2\x59\xa4\x94\x07\x31\x62\x8a\x4c\x1b\x47\x10\x6e\x0f\x3c\x8f\x5e\x34\x50\x46\x82\x6b\xc2\xb5\x05\xba\xc4\x74\x07\x11\x43\x4a\xad\xbc\x0c\x6d\x49\xa0\xa9\x66\xc4\x5b\x5b\x46\xcd\x45\x3b\x7f\xc7\x48\xac\xcb\x45\xbb\x21\x79\xb5\x36\x62\x5e\xfd\x85\x83\xf7\x5e\x80\x96\x28\x22\x1e\xcc\xe0\x74\x5a\x86\xc9\xab\x92\x4b\x88\xe9\x6e\xfd\xa2\xfc\xd7\x3a\x31\x62\x04\xc9\x98\xee\xbd\xb5\x6b\x75\x7f\x97\x21\x4e\x98\x03\xcc\xfe\xae\x09\xb3\x40\x72\xbd\x5e\xd2\x6a\x3d\x46\x10\xa3\x20\xa6\x66\xcb\x32\xa4\x6b\xa8\x41\x7e\xcc\x39\xd4\x7a\xae\x91\x5e\x37\x18\xe5\xac\xe2\xc2\xd1\x0e\x38\xda\x6d\x90\x0c\x24\xdd\x26\x1a\x2c\x9c\x3b\x2d\x04\xdb\x88\x7d\xe3\x70\x4b\xc7\xe8\x7a\x89\x6a\xc1\x04\x63\x28\x53\x24\x60\x94\x3f\x78\x3d\x64\x51\x42\x76\x52\xf0\x20\xcf\x0a\x7c\xcb\x10\xad\x97\x21\xa3\x3d\x96\x15\x19\x96\x22\xc3\xe2\x91\x77\x0e\xb5\xbb\x10\x24\x92\xc4\x2b\xef\xa5\xd7\xdd\x1d\x68\xb1\xdd\x32\xe2\x01\x46\x1a\x95\x3f\x1a\xbc\x40\x0a\xf3\x7b\x93\x6b\x2d\xb8\x07\x48\x52\x14\x90\x7d\x86\x38\x26\xd8\x20\x65\x8a\xf4\xa1\x3f\x4a\xc2\xa3\xe4\x0c\xbb\x8f\xe7\xac\xc0\x1a\x47\x4a\x78\x5e\x1d\x67\xc7\x7d\xb2\x4a\x87\xce\x85\x96\x9c\xf7\x68\x87\x54\x24\x69\xa6\x17\x3b\x41\xf1\x64\x3e\xf5\x80\xe2\x95\xa7\x08\x23\x91\x0e\x10\x63\x03\xec\x6b\x4e\xb5\x48\x5b\x76\xc8\x12\x1a\x09\x0e\xf5\xc8\xd8\x26\x7a\xe8\xba\xcd\x27\xcb\x1b\x0c\x6f\xeb\x33\xc3\x28\x5d\x2a\x29\x16\x5c\xc2\x2d\xc3\x9c\x75\x8c\xde\xda\xd7\x5e\x1f\x0d\x1d\x38\x87\x5a\x77\xef\xfe\xae\x0a\xf9\x06\xaf\x58\xc8\xb4\x34\x89\x19\x7a\x80\x22\x4d\x05\x5f\x79\xaf\x7b\x89\xab\x5c\x51\x5a\x64\x26\xa3\x30\x45\x9a\x93\x48\xea\x3a\xcf\x78\x90\x12\x9d\x08\xbc\xf2\x32\xa1\x74\x61\x97\x22\xdc\x88\xea\x58\xc5\x9d\x1e\x5d\xa2\x4a\xf1\xe8\xf2\xfb\x96\x32\x44\xf4\xe0\x81\xd2\x07\x23\xce\x23\xc5\x3a\x59\xbc\x9a\xcf\xb3\xfd\x4d\x8a\xe4\x96\xf2\xc5\x15\x49\x01\xe5\x5a\xdc\xb4\xd4\x75\xd6\x72\x6f\xea\x2c\xe7\x20\x2c\xaa\x49\x0a\x46\x75\xc1\x56\x0a\x13\xc9\x3d\x88\xc7\x23\x48\xc4\xb7\x04\x2e\x28\xbe\x84\x8b\x52\x11\xb0\x58\xc1\xac\x52\x8a\xcb\x97\x5a\x92\x19\x6f\xec\x67\x9b\x7a\x2b\x43\x1b\xc2\x46\x82\x86\xf2\x2c\xd7\xa0\x0f\x19\x69\xf0\xaa\x23\x9a\xa1\xd2\x46\xc7\x63\x0d\x6f\xf6\x9e\xe8\xbf\xbd\xb5\xb6\xe4\x28\x25\xb5\xfd\xee\xdc\x7b\x76\x88\xe5\x64\x88\x41\x61\xe3\xe6\xc2\xa7\xda\xdc\x16\x0d\xc1\xb5\xeb\x9c\xa3\xad\xb9\xff\x2d\x29\x42\x9d\x0a\x0e\x17\x43\x81\xb7\x0c\x07\xd4\xe0\x30\x2c\xb4\x0a\xe4\x13\x9b\x1b\xf5\x73\xc8\x40\x8c\xdf\x29\xc1\x28\x76\x3b\x56\x63\xe3\x53\x9e\x32\x12\x0c\x35\xbb\x22\x55\x97\xe6\x54\xf9\x26\xa5\xba\x36\xe6\x46\x73\xd8\x68\x1e\x60\xe3\x71\xb2\x36\x8c\x09\x5a\xef\x5c\xa4\xdf\x51\x4e\x55\xd2\x2f\x81\x05\x67\xb7\xaa\x1c\x71\xf0\x55\x88\x54\x1e\x45\xc4\xb4\x29\x35\x24\x24\xb5\x07\x98\x2a\xb4\x61\x04\xaf\xc7\x8b\xf3\x18\xb2\x27\x8d\xb8\x0c\x8d\xda\x9f\xec\x43\xbe\x45\xa7\xa1\x02\xd1\xad\x1a\x6f\xf3\x34\x53\xff\xe5\x4d\x46\xe9\x79\x92\xa4\x62\x47\xee\x10\x63\x3d\x8d\xfe\xd1\x85\xb8\xe4\x74\x75\x21\x2d\x01\x53\x81\x11\xab\xe6\x90\xdc\x12\xbd\xf2\x5e\x36\x27\xed\xb8\xe8\xb7\x6d\xfe\x2c\x7c\xe6\x56\xf0\x98\x9a\xca\x6c\x0d\x62\x3a\x0e\xc0\x79\x9a\x15\x5e\xd4\xa2\xdc\x08\x7c\x68\x10\xfe\x9d\x32\x06\x1b\xd2\x25\x54\x33\x78\x23\x09\x1c\x44\x0e\x2a\x97\xe4\xb5\x83\x51\x84\x18\xdb\xa0\xe8\x61\xe5\x59\x82\x8f\x96\xc1\x64\x7a\xf3\xec\x36\xaa\xb0\x89\x96\x48\x25\x01\x62\xba\xd7\x73\xd7\x88\x7e\xdf\xe6\x09\x9c\x59\xe2\x37\x6c\xa9\x9a\xd7\x25\x93\xda\x02\x49\x54\x26\xb8\xa2\x3b\xd2\x0d\x64\xbb\xde\xda\x0c\x05\x49\x22\x76\x44\x96\x63\xa5\x25\xcd\x08\x2e\x4a\xb4\xb5\x8d\x2b\xf2\xb4\xb9\x75\xba\xe6\xe5\x80\x32\x75\x72\x4e\xb9\x3f\x55\xcd\x59\x99\xa5\x74\xf2\x0c\xa2\xaa\x88\x9b\xee\xe1\xab\xc8\x44\x96\xfd\x07\x64\xf4\x5f\x04\x28\x87\xcd\x41\x3f\x0b\x67\xad\x55\xb2\xd7\x45\x4e\x6d\xd4\xbf\x37\xb6\x6b\x1d\x65\xb3\x0c\x5d\x9a\x33\x7b\x07\xf4\x6c\x02\x71\xac\xf9\x33\xbd\x9f\xb1\x9e\x6d\xfc\xac\x19\x9d\x5d\x9f\x96\x45\x2e\x26\xff\x2c\xb6\x97\xed\x92\xce\x15\xbc\x82\xd3\xa9\x4e\x67\x45\x7d\x3f\xf7\x4d\x0e\x4e\x0e\x98\x50\xe7\x7a\x07\xff\xab\xb1\xa8\x3c\x0b\x92\xb5\x9b\xd8\x9a\xcb\x4f\x23\xcd\x6c\x87\x53\xaf\x49\x1c\x3b\xd6\x51\xdf\x9b\xab\x63\x7d\x49\xf3\x59\x43\x8a\xad\xb4\xfd\x47\xeb\x7e\x70\x35\x9f\xff\x7f\x79\x3d\x08\x36\x42\x6b\x91\x2e\xe6\x37\x31\x13\x48\x2f\x4a\xa7\x19\xce\x53\x0e\xee\xc1\x06\x49\x68\xfe\x08\x28\x8f\x45\x7b\xa6\x8c\x67\x7b\xd3\xda\x91\xaa\x14\x55\x5b\x36\x48\x96\xe5\xcf\xf6\x49\x5c\x3c\xae\xbc\xab\xf9\xbc\x39\x97\x52\xbe\xf2\xda\x33\x68\x5f\xee\x6a\x09\x07\x46\xba\xa7\xf2\xb7\xca\x10\xaf\xc4\x50\x32\x10\x9c\x1d\x1a\x91\xf2\x9e\x70\x22\x91\x89\x16\xcf\x76\xde\xcb\xd0\xec\x1f\x51\x8a\xbb\xd7\x7e\x62\x69\xd8\xca\xcb\xd0\xe5\xc6\xc6\xb7\x97\x45\xdd\x2d\x9b\x4e\x94\x65\x8c\x46\x16\x68\x78\x2e\xca\xde\x1a\x8b\x28\x4f\x09\xd7\xb3\x47\x49\x35\x99\x60\xa4\xc9\x67\xf1\x49\x4b\xca\xb7\x13\xdf\x78\x62\x23\x06\x4c\x36\x7b\xa3\x67\xef\x84\x4c\x91\x06\xef\x7a\x3e\xff\x3e\x98\x5f\x05\xf3\xeb\xcf\x57\xdf\x2d\xe6\x7f\x5e\xcc\xbf\xfb\xc7\xfc\x87\x85\xd1\xf2\xe9\xe4\x4f\xa7\xcb\xb0\x38\x64\xfd\x3b\x62\xb4\xa9\xf3\xdb\x62\x6c\x1d\x60\x92\xac\xcd\x88\xee\xcd\xae\xa4\x3a\x92\x63\x10\xc7\x30\xe1\xa4\x97\x68\xe6\x53\xe7\xf4\xf5\x74\x30\x90\xed\x33\x45\xfb\x51\xa1\x7e\xa8\x28\x07\x98\x30\xa2\xc9\x9f\x28\x5e\x35\x25\x2a\xaf\xa0\xe7\xbb\x48\x71\xf9\x82\x7a\x14\xec\x5d\xe5\xb4\x3e\xb8\x6a\xf4\xea\x53\xc4\x23\x67\x02\xe1\x27\xcf\xb1\x27\xd8\xe0\xb7\x83\x48\xf0\x66\xff\xea\x7a\x72\xaa\x58\x7b\xd0\xed\x06\xdf\xd6\x2b\x26\x04\x47\xfa\xdd\x1a\xf6\xf3\xaf\x88\xcf\x86\x67\x1b\x3b\x07\x36\xab\xf6\x36\xb2\xa1\xcb\x1a\x74\xee\x62\x0e\xaf\xf9\x95\xe9\xfc\xfb\x1f\xea\xd7\x9e\x3f\xd2\xf9\xff\x4e\x3a\x77\xf6\x5a\xa3\x5c\xfa\xdd\xd6\x32\xb4\xed\x72\xf3\xd6\xdf\x6d\xdf\xcb\x7f\xee\xef\x21\xf7\xea\x37\xfd\x66\x73\xdf\xfd\x64\x73\x3f\xf2\xc5\xe6\xc5\xd7\x9c\x75\xaf\x42\xfb\xc5\xc5\x72\xd4\x32\x2f\x18\xbe\x78\x5e\xed\xb1\x07\x5f\x4c\xaa\x12\x34\x9d\x49\x82\xf0\x61\x12\xe7\xdc\xe6\x3b\x98\x4c\xe1\x78\x7e\xf6\x1a\x7f\xa0\x7a\xc7\x68\x76\x6b\xe5\xfb\x80\xf8\xf6\x67\x9f\x89\x08\x31\xe2\xff\x02\x2b\x38\xf6\x6c\xe8\x1f\x08\x92\xca\x07\x80\x05\xf8\xb5\x7f\xda\xc9\xa2\x2d\xf7\x2f\xfb\x34\xa9\xe0\x3a\x31\x44\x4d\x9a\x62\x72\x98\x08\xa3\x83\x3d\xa7\x45\x64\x26\x87\x49\x12\x91\xf7\xb1\xd9\xc9\x11\x6c\x94\xe7\x9a\x28\xbf\x8d\xad\x98\x1c\xa6\x52\x24\x12\x1c\x77\xa8\xca\xc9\x92\xaa\x45\x74\xba\x69\x3f\x42\x5e\x4c\xfc\x99\xf5\x29\x7f\x3a\xab\xf5\x3f\x39\x1e\xcd\x15\xa3\x08\xf4\xd9\xa7\x82\x1b\x9c\x4e\x97\x0e\x3b\x58\xe2\x77\x28\x22\x0b\xf0\x7f\xb4\x68\x6f\x45\xce\x35\x91\x0e\xb4\x0c\xf1\x6d\x8e\xb6\x66\x6b\x69\xdb\x36\xb6\xe9\x4d\xd3\x53\x5c\xa9\x7c\x67\xf2\x29\xac\x0c\xec\x97\xd5\xe3\xb7\x3f\x9d\xc5\x94\xe3\x89\xbf\xa8\x9e\xa6\xfd\x06\xa3\x8a\x6a\xd3\xa1\x82\xa2\xc8\xfc\x6c\xdd\xbb\xa8\x70\xbf\xb8\xe8\x54\x49\x77\xfe\x2e\x64\x76\xb5\xb6\xa9\x59\xc4\x68\xf4\x50\x3b\xbd\xf5\xf9\xae\xec\x86\x17\x27\x7b\x0d\x2b\xf8\xbf\x8b\x89\x4e\xa8\x9a\xce\x30\xd2\x68\xe2\x17\x9c\x09\xee\x9e\x6e\xfe\xb2\x19\xbd\x35\x42\x4d\x2c\xe9\x6b\xf0\xad\x88\xd6\xda\x39\x2f\xc6\x0e\xaa\x8a\x7f\xa2\x53\x56\x53\x8e\x57\xe7\x92\x1b\xc1\xdd\x67\x97\x2f\x5c\x35\xbe\x5a\x99\x9c\x6f\x4f\xff\x95\x5f\xbf\x2c\x9f\xe9\x60\xd9\xe8\x6a\xe6\xd2\xaa\xae\x23\xe9\xa9\x6b\x87\x6c\x26\xf8\xc4\xa7\xf1\x6d\x21\x89\x7f\x09\x67\x8b\xf4\x0d\xf2\xb4\x33\x94\xcf\x99\x6f\xb4\x96\x13\xbf\x7a\x67\xee\xea\x7b\x10\xc5\x97\x4a\xa3\x4f\xe0\xa0\xf1\xa4\x05\x65\x51\x91\x4d\x67\x8c\xf0\xad\x4e\x60\x6d\x1a\xde\x3e\xa1\xf9\xdb\x3c\x0b\xa3\xc5\x59\xc4\xd3\x10\x1b\xd4\x66\x70\x09\xbe\x8b\x09\x58\x6f\xb7\x6e\xf5\x6d\x3c\xa0\x6f\xe8\xa2\xa4\xf5\xe1\x8f\x66\x8a\x73\x99\x37\x2b\xf5\xa5\xe5\x5c\xa9\xff\x1d\x00\x00\xff\xff\x77\xac\x4b\x4a\x5e\x21\x00\x00"
-
-func templatesViewsTraceHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesViewsTraceHtml,
-		"templates/views/trace.html",
-	)
-}
-
-func templatesViewsTraceHtml() (*asset, error) {
-	bytes, err := templatesViewsTraceHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/views/trace.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsJsTraceMinJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4a\x2b\xcd\x4b\x2e\xc9\xcc\xcf\x53\x48\x29\xcd\x2d\x28\x0e\x4a\xcd\xcd\x2f\x4b\xd5\xd0\xac\x56\xd1\x2b\xc8\x2f\x2e\xd1\x50\xd2\x2f\x28\xca\x4f\xcb\xcc\xc9\xcc\x4b\xd7\x2f\x29\x4a\x4c\x4e\xd5\xb7\x4f\x04\x2b\xb7\x4d\x49\xcd\x49\x2d\x49\x55\xcb\x4c\xb1\x4d\xcc\xc9\x51\xd2\x81\x19\xa3\xa1\x59\x9d\x93\x9f\x9c\x08\x62\xea\x15\xa5\xe6\xe4\x27\xa6\x68\x68\xd6\x6a\xd6\x02\x02\x00\x00\xff\xff\x3c\x9b\x35\x94\x66\x00\x00\x00"
-
-func assetsJsTraceMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsJsTraceMinJs,
-		"assets/js/trace.min.js",
-	)
-}
-
-func assetsJsTraceMinJs() (*asset, error) {
-	bytes, err := assetsJsTraceMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/js/trace.min.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsFlipclockCssFlipclockMinCss = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xcb\x6e\xe3\xb8\x12\xfd\x15\xdd\x34\x02\xc4\x17\x96\x40\x5b\x71\x27\x4d\x6d\x7a\x39\xab\x59\xcf\x96\x96\x28\x9b\x08\xc5\x12\x48\xca\x76\x5a\xe8\x7f\x1f\x90\x7a\x58\xb2\xa8\x87\x93\x19\x60\x1a\x68\x04\x01\x24\x55\xb1\x58\x55\xe7\xd4\xc3\x41\xca\x59\xee\xc7\x1c\xe2\x37\xff\x2c\x49\x9e\x53\xe9\xfd\xbf\xf4\xcf\x74\xff\xc6\xb4\xbf\x87\x8b\xaf\xd8\x0f\x26\x0e\x78\x0f\x32\xa1\xd2\x7c\x89\xfc\x0c\x7e\x8c\x8a\xd4\x98\x04\x46\x04\x23\xea\x8d\x07\x24\x7e\x4b\x49\x4c\xfd\x13\x53\x6c\xcf\x38\xd3\xef\xf8\xc8\x92\x84\x8a\xda\x8d\x29\xb9\x9a\x14\xc3\x94\x74\x5c\xf4\xd3\x95\x32\x52\xc6\x85\x54\x20\x71\x0e\x4c\x68\x2a\x23\x4d\x2f\xda\x4f\x68\x0c\x92\x68\x06\x02\x0b\x10\x34\x8a\x81\x83\xc4\x5f\xe2\x38\x76\x1b\xc1\x47\x38\x51\x59\xd6\x6a\x69\x9a\x3a\xd5\x0a\x5e\x72\xa6\xb4\xaf\xf4\x3b\xa7\xd6\xb0\x4b\x2d\x88\x39\x25\x32\x65\x17\x4c\x52\x4d\xe5\x7a\x52\x65\x4f\x53\x90\xb4\x8c\x41\x68\x2a\x34\x7e\xf0\x1e\xa2\x84\xa9\x9c\x93\x77\xac\xc9\x9e\x2f\xb9\xa0\xb4\xaf\x78\x0f\xfa\xe8\xd2\x2e\x53\x10\x1a\x0b\x90\x19\xe1\xde\x66\x93\x5f\xbc\x87\x3f\x28\x3f\x51\xcd\x62\xe2\xfd\x49\x0b\xfa\xb0\x6e\xdf\xd7\x8a\x08\xe5\x2b\x2a\x59\xda\xf2\xa0\x50\x54\xfa\x8a\x72\x1a\xeb\x61\xc8\x19\x95\x2c\x61\x45\x56\x1a\xd4\x0e\x12\x0a\x91\x60\xe4\xa1\xff\xb1\x2c\x07\xa9\x89\xd0\x15\xc9\x8e\x24\x81\xb3\x11\xf4\x44\xc6\x31\x43\x40\x8a\xc3\xaf\xf9\xe5\x2a\x70\xde\x60\x80\xae\xd0\x09\x37\x61\x18\x86\xce\x50\x2d\xf6\x84\xb3\x83\xc0\x31\xb5\x6c\xc8\x41\x31\x4b\x03\x49\x39\xd1\xec\x44\xa3\x33\x4b\xf4\x11\x6f\x10\x7a\x8c\x32\x22\x0f\x4c\xe0\x0d\xcd\x5c\xd6\xc6\xe1\xfb\x10\x6a\x8b\xc0\x32\x14\x1b\xba\x9c\x72\x20\x1a\x73\x9a\xea\xc6\xe5\x5d\x7e\xa9\x03\xf9\x8a\xf2\x4b\x74\xa4\xec\x70\xd4\xf8\x9b\x79\xbe\x66\xf5\xb5\x7d\x3d\x57\xf2\x17\x84\x22\xce\x04\xf5\x6b\xfd\xd7\x97\xdc\x74\x01\x5b\xfa\x92\x24\xac\x50\xf8\xab\xf9\x72\xc5\xf2\x0b\x42\x68\xc4\x4f\x8f\xb3\xf2\x87\xcf\x44\x42\x2f\x78\x73\xcd\x33\xd9\x2b\xe0\x85\xa6\x91\x71\x17\xa3\x48\x43\x8e\x51\x37\xe9\xf5\xdd\xf6\x79\xe0\x8c\xab\x78\x47\x88\xd1\xf3\x04\xa7\x4c\x2a\xed\xc7\x47\xc6\x93\xd6\xab\xed\xb8\xbe\x47\xca\x06\xb0\xbd\x91\xf6\xbc\x6a\x98\x9f\x53\xa9\x72\x1a\x1b\x08\xf0\x16\x99\x64\xda\xc6\x37\xfc\x3c\xfc\x52\xc3\xd4\x65\xbb\x69\x30\x29\x87\x33\xb6\x7d\x8d\x77\xc2\x8a\xea\x0e\x96\xd0\x94\x14\x5c\x2f\x89\xd7\x23\x5e\xc2\x4e\x4b\xf2\x3f\xcc\xfc\x0e\x3d\xde\x92\xa4\xf5\xad\xee\xc1\x50\x68\x03\x0d\x36\xdd\x42\x01\x67\x89\xa7\x25\x11\x2a\x27\x92\xce\x7b\xe5\x05\x55\xbd\x97\x43\x9f\x46\x68\xb0\x08\x30\x63\x3a\x28\xf2\x76\x42\x5a\x8f\x52\x90\x99\x0f\x92\xd9\x9a\x40\x8f\x5e\x85\x9f\x41\x69\x4a\xac\xa6\xa4\x30\x21\x9c\x90\x18\x9a\x2f\x70\xbf\xe9\x02\x4d\xe7\x78\x70\x20\x67\x6c\x3d\x3f\xe7\x97\x06\xc2\x26\x3d\x3b\x47\xfe\xc2\x5e\xbd\xfa\x75\x8b\x44\x08\x0d\xbf\xca\xc3\x9e\x3c\xa1\xb5\xf9\x0b\x9e\x57\xb3\xbe\x26\x70\x16\xd3\xc9\x46\x13\x99\x46\xe3\x69\x46\xa3\x39\x46\xee\x04\xa3\x68\x0f\x5a\x43\x86\x51\xd4\xee\x29\xe6\xdd\x37\xf9\xe9\x35\xae\x9e\x54\x9a\x04\x75\xc4\xb3\xbc\x35\x51\x33\x21\x1c\xbc\xbd\x01\x62\xe3\x00\x62\x6b\x9e\xaf\x6b\x46\xd5\xc9\xda\xc1\x67\x0a\x69\x9b\x5f\x3c\x8b\xcc\x70\x4a\x0d\x11\x0c\xc3\xd0\xd1\x99\xaf\x65\xfb\x82\xe6\x03\x0a\x8a\xbc\x8d\x69\x19\x3f\x0d\xe6\xed\x91\x26\xe9\x23\xa7\x02\xd3\x3d\x3d\xce\xba\xd2\x7a\x2e\x36\x79\x72\x0e\x69\xcf\x7e\x2b\x7b\x7b\x81\x49\xcd\x2e\xbf\x78\x5d\x8a\xbe\x8c\x51\xd4\x75\x31\xb1\xcd\xb7\xa5\x2b\x11\x2c\xab\x06\x08\x51\x89\x17\xec\x94\xfd\x37\x2d\x8d\x48\xcf\xcc\xde\x8a\xb9\xf3\x6a\xf3\x1a\x6d\x71\xf6\xbc\x4d\xd8\x89\x25\x66\xf7\xba\x4e\xee\x66\xde\x30\x61\xa7\x5e\x35\x76\xc6\xd6\x93\x6d\x67\xaa\x6f\xd0\x2d\xd6\xb5\xf5\xde\xd0\xab\xce\xf5\xc1\x4a\x40\xdf\x8c\xb9\xee\x68\x0f\xb7\xe1\x73\xf8\xdc\x52\xb9\x7b\xa1\x99\x69\x83\x22\xe8\xd3\xd1\x4c\x91\x9b\xdd\xee\x16\xc1\xdd\xaa\x2a\x9c\x9d\xdb\x7d\xaf\xfb\x8d\x93\x3d\xe5\x8e\xca\x33\xbc\xf5\x37\xc1\x8e\x66\x91\xad\x67\xec\xbf\x9a\x42\xe8\xf4\xb9\x6e\xa1\x0d\xf6\xd3\xfa\xaa\x20\x63\xa2\xd0\x54\x39\xae\x6c\xac\xbe\xba\x9d\x0c\x14\x8d\x41\x24\x13\x27\xbf\x6d\x6e\x4f\x82\x0e\x34\xe4\xb6\xe4\xc2\x01\x74\xa0\x83\xaa\xb0\x9a\xfa\xb2\x2a\xdf\x1b\xe6\xbe\xd1\xf7\x54\x92\x8c\x2a\x8f\xa8\xa4\x44\x8f\x9d\x65\x66\xdb\x79\x7b\xfe\x69\x7a\x4f\xe7\xf5\xe7\x77\xcb\xe8\x4f\x1c\x87\x4f\x1c\xfe\xf0\xc9\x7b\x2b\xdc\xab\xc6\x52\x6b\x3e\x1a\x56\xbc\x2e\xa4\x58\x52\xf2\xa3\x7a\xf3\x2a\x2e\xb8\x8c\xae\x89\x7c\x30\x31\xb1\x04\x4d\x34\xfd\xeb\xe9\x1b\x4a\xe8\x61\x55\xa5\x60\x5c\x0b\xad\x86\x58\xb6\xb6\x7b\xe3\xd6\x6d\xd8\xad\x52\x59\x05\x97\x4d\x98\xb3\xe8\x52\xb0\xf6\x86\xc6\xa6\x2d\xb9\xcd\xdc\x3b\x5d\x3c\xb3\x04\xce\x11\x60\xbb\x04\xfd\xa1\xd2\x8c\x7c\x0c\xf7\xed\x34\xf0\x68\x16\x74\xbf\x4e\x93\x13\xf9\xed\x04\xf4\x68\x1a\xf6\x8e\xdd\x5b\xec\xb7\xa3\xe0\xa3\x29\xe0\xaf\x16\x1d\xe6\xc6\x6d\x4d\x18\x1a\xdd\x49\x1c\xf3\x7d\x7a\xb1\x98\x63\x4d\xfb\xc3\xa4\x33\x05\x6d\xe2\x2a\x88\xfd\x83\x19\x6d\x54\xe8\x27\x0d\xf9\xba\x3b\xc8\x36\x2b\x0f\xad\xcd\xb0\xb1\xbb\xfe\xaa\x3b\x45\x1b\x4c\xdb\xb3\x95\xad\xb5\x19\x7c\x9e\xb1\x63\x1f\xaa\x56\xbf\xb6\x53\xcb\x57\x1a\xf2\x27\x74\x73\xc1\xaa\x2b\x34\xb7\xd8\xfb\x56\xbd\xbb\x6a\xd3\xd3\xde\xf5\x9c\x83\x4f\x85\x96\xa9\xcf\x1c\x6f\xbd\x6d\xa2\x9f\xf0\x79\x58\xc4\xea\x08\xe7\xb9\x1a\x76\xea\x4c\x8b\x3f\x30\x70\x7e\xf3\xe6\x57\xe2\xcd\x91\x25\xb4\x1a\xd9\xe1\x24\x77\x46\xf5\xe6\x55\x3e\xd0\x7b\xec\xef\xaa\x7b\x58\x64\x43\xbc\xc5\xfa\x9f\x64\x91\x65\xc9\x80\x3b\x37\xdc\x1a\x63\xd1\xb8\x77\x0b\x58\xb4\x34\xb4\x11\x16\x2d\x3c\x3e\x60\xd1\x84\xcf\xff\xe5\xee\xf3\x9b\x39\xbf\x16\x73\xfe\x95\xfe\xb3\x9d\xdd\x42\x0d\xdb\xcc\x06\x06\x39\x89\x99\x7e\xc7\xa8\x5a\xbb\x9a\xd7\xcd\x70\xb3\x5c\x72\x02\xee\xd3\xbf\xcf\xf8\x20\x06\x13\x6f\xd9\xd3\xea\x1d\x42\xc3\x18\x96\x9c\x80\xfb\xf4\xef\x51\xfe\x3b\x00\x00\xff\xff\x23\xb6\xe4\xce\xc2\x1d\x00\x00"
-
-func assetsVendorsFlipclockCssFlipclockMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsFlipclockCssFlipclockMinCss,
-		"assets/vendors/flipclock/css/flipclock.min.css",
-	)
-}
-
-func assetsVendorsFlipclockCssFlipclockMinCss() (*asset, error) {
-	bytes, err := assetsVendorsFlipclockCssFlipclockMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/flipclock/css/flipclock.min.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsVendorsFlipclockJsFlipclockMinJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x7b\xdb\x92\x1b\xb9\x79\xff\xfd\xff\x29\xc8\xb6\xfe\x54\xf7\x12\xec\x69\x8e\xe5\x75\x99\x1c\x0c\x4b\x96\x76\xed\x75\x24\xaf\x62\xc9\x4e\x25\x34\xb3\x85\xee\x46\x93\x58\xf5\x74\xb3\xfa\x30\xa3\xc9\x90\x17\xb6\xcb\x87\xf2\x4d\x2e\xf2\x04\xa9\x6c\xb2\x55\xf1\x45\x0e\xde\xad\x8a\x13\x6f\x6e\x36\xbe\x1f\xdd\x8d\xde\x26\x85\x53\x37\xd0\x00\x39\x9c\x3d\xa4\x4a\xa5\x21\x81\x0f\xc0\x87\x1f\xbe\x33\xc0\xa3\xb7\xfa\xbd\x24\x25\xeb\x28\xcd\xa3\x97\xbd\xe3\x60\xfc\x60\x34\x0e\x46\xc7\xe3\xde\x5b\x47\xff\xef\x1c\x15\xbd\xef\xa2\x12\xc3\xa4\xce\xa2\x8a\xe4\x99\xeb\x5d\x6d\xa7\xb4\xc5\xc7\xaf\x2a\x9c\xc5\x6d\x07\x02\xa1\x77\xe5\xd4\x25\xee\x95\x55\x41\xa2\xca\x99\xd2\xc1\x11\x64\xc4\xeb\x22\xaf\xf2\xea\x72\x2d\x87\xf1\x29\x3e\x90\xcd\x24\x5b\xc2\x7e\xc0\x06\xc4\x30\xc3\x17\xbd\x6a\x45\xca\x69\xe4\x47\x28\x4d\xdd\x18\x20\x0f\xc4\x7e\xd8\x65\x03\xc4\x38\xc5\x15\xee\x19\x53\xb1\x79\x30\x8c\xfd\x28\xcf\xca\xaa\xa8\xa3\x2a\x2f\x40\xa2\x7f\x57\x67\x22\x89\xdb\x37\x26\xf1\x48\xe2\x52\x2e\xfc\x0f\x9a\x51\x24\x5b\x6e\x36\xac\x4d\x9d\x08\x26\x9e\x49\x07\xfb\x01\xc0\x3e\x5a\xaf\xd3\x4b\x36\x0b\x40\xc5\xb2\x3e\xc3\x59\x55\x7a\x92\x6d\x73\xd0\x14\xa7\x25\xee\x91\xc4\xcd\xea\x34\xed\x43\xd8\x8c\x99\x07\x0b\xaf\xc0\x55\x5d\x64\xae\xda\x26\xb0\xdc\x6c\x22\x8f\x23\xa5\x76\x82\xd8\xdb\x4e\xf9\xa0\x5e\xe2\xa3\x2c\xc2\x25\xe5\x96\x31\x93\xc8\xc3\x63\x3c\xf0\xcf\x20\xf1\x93\xbc\x78\x07\x45\x2b\xde\x2a\xbe\x80\xc4\x27\x67\xeb\x14\xd3\x69\x79\x47\xf3\x15\x24\xed\xb1\x42\x3a\xbe\xca\x9f\x57\x05\xdd\x3c\xa3\x93\xdf\x40\xe2\x9f\xa3\xb4\xc6\xef\x27\x8a\xac\x78\x57\x9c\x35\x27\x0f\x3f\xc4\x51\xe5\x40\x88\x66\xc9\x04\x4b\x4a\xd7\xdb\x02\x71\xfa\x09\x08\x3d\xe0\xc8\x91\x0e\x84\x74\xb9\x3c\xe9\x25\x3e\xc9\x48\x35\x18\xf0\xbf\xae\x07\x92\x2d\xd0\x45\x0d\x5e\xf1\x9d\x4d\x74\x11\x25\x49\x8b\x93\x9f\xe2\x6c\x59\xad\x4e\xc7\xde\x15\x17\x56\xca\xf9\x1c\x2d\xa6\x24\x71\xa3\xc1\xc0\xb2\x6c\x38\x18\xb8\xfd\x48\xf2\xb9\xd9\x44\x2d\xcb\x7d\x18\xb6\x5f\xbc\xc1\xe0\xe8\xa7\x21\x95\xd9\x9f\x86\x47\x7e\x85\xcb\xca\x0d\x3d\xbe\x4a\xac\xd2\x4d\x43\x55\x12\x69\x37\xe2\xf0\xd1\xa1\x9b\x4d\x47\x79\x68\xe3\xb4\xe9\x86\x11\x93\xf4\x10\xc6\x76\x49\x93\xc7\xdf\x0e\x40\x20\xdc\x82\xf0\xc0\xf3\x08\x27\x31\x25\x6e\x4e\x95\xf1\x22\xbf\x6d\x05\x52\x30\xdc\x4a\xa9\x45\x9c\x7f\x7c\xa8\xc6\x6f\x36\x16\x7c\xe9\xb4\x9b\x8d\x8b\x55\xd1\xdc\x6c\xb0\x37\x4d\xf2\xc2\xa5\xd3\x27\xf0\xaa\xca\x9f\xe7\x75\x11\xe1\x09\xd5\x93\x2d\x58\xc2\xb9\xa3\x28\xa4\x03\x1c\xc9\xa4\x03\x1c\xb1\x55\x67\x01\x56\xd0\xe0\x60\x16\x4c\xc6\x53\x02\x97\xf3\xd5\x70\xb8\x98\x7a\x68\x4e\x16\x7d\x98\xcc\xc9\x62\x30\xc0\x5c\xfa\x18\xa2\x04\xd0\x9e\x96\x05\xd2\x23\x59\x0f\x79\x94\x70\xb3\xb1\x10\x6e\x15\xdc\xb7\x5c\x2a\xa1\x62\x32\xdd\x2b\x85\xdb\x89\x72\xfa\xca\x96\x35\x55\xf6\xb6\x5b\x70\x25\x35\x78\xf2\x3e\x3b\x21\x70\x8e\x8b\x92\xe4\xd9\xc4\x19\xfb\x63\x07\x08\x6d\xd5\x44\x1d\x44\xde\x95\x64\x39\xe6\x2c\x9f\xe7\x24\xee\x05\x10\x72\x74\x9b\x23\x9a\xc7\x8b\xc1\x20\xe4\x1b\x89\x00\x9a\xc7\x0b\x40\x8d\xee\x16\x34\xba\xae\xb2\x29\xe7\x44\x30\x98\xa2\x93\xae\x2e\x4d\xd1\x70\xe8\x59\x0e\xb6\xdd\x10\x5a\xcc\xd4\x2f\xae\xce\x8b\x37\xd1\xbf\x9b\x80\xa0\x85\x26\xd9\x5b\x20\x8f\x5b\x65\x52\x10\xf0\x0e\xbe\x44\xab\x9b\xdb\xad\xc7\x34\xe7\xdd\x94\xac\x1f\x51\xaf\x37\xed\xab\xaa\xa0\x39\xb1\x86\x06\x76\xb1\x15\x4b\x84\x3d\x92\x95\x15\x3d\x9e\x3c\xe9\xf1\xc3\x19\x0c\xb4\xc6\xc7\xa8\xc2\x10\xf6\xc7\x83\x81\x1b\xc1\x10\x84\x30\xf0\x00\x75\x72\xcd\xd4\xfe\xbb\x88\xca\xc2\xa5\x98\x79\x0b\xda\x9e\x27\x28\x5b\xc2\x2b\xb5\xc5\x14\xa7\xb0\x26\x69\x4c\x17\x99\x38\xd2\x7b\x07\x6f\x3b\xad\x84\x04\xfe\xb7\xfd\x6f\x39\xc0\x2a\x75\x6c\x27\x52\xeb\xfb\xaa\x95\x0b\xe1\xd5\xd6\x03\x46\x57\xc4\x76\x41\xbb\x18\xa8\x25\xae\xde\x5f\xd3\x99\x4a\x17\x49\x86\xfa\x01\xb8\xda\xb2\x9d\x50\x33\x8e\xd2\x34\x44\xd1\xcb\x89\x8a\x30\x49\x5c\x9b\x88\xb4\xb2\x15\xc2\xf9\x02\x44\x70\x3c\x8d\x4e\xa0\x21\x61\xd1\x70\xe8\xb5\xe2\x10\x31\xd9\x5d\xd7\xe5\xca\x55\x1b\xbd\x29\x52\xad\x62\x48\x95\x28\xcd\x97\x1a\x1b\x17\x24\x8b\xf3\x0b\xe6\xcd\xf3\x14\x0f\x06\xe2\x83\x9f\xe6\x4b\xed\x8b\x4b\x75\x61\x29\x77\x3a\x31\xcd\x66\x4f\x58\xc3\x99\xf8\x3b\xe9\x8f\x95\x01\xa5\x45\x30\xb9\xe4\x96\x96\x39\xa9\x8f\x6a\x8d\x6b\x4b\x52\x6a\xeb\x36\x40\x71\xc5\x76\xea\x2c\xc6\x09\xc9\x70\xdc\x9e\x14\x9a\x87\x8b\xc1\x40\x3f\x25\x37\x04\xb4\x99\x6a\xc0\xd6\xfd\xf0\xcf\x6b\x5c\x5c\x7a\xe0\x76\xd9\xa7\x02\x8a\xa1\x2e\x83\x8d\xf8\xa1\x9a\x2a\x20\x2a\xaa\x49\x3f\x00\x31\x39\x27\x31\x2e\xca\xc9\x7c\x01\x12\x2e\xd5\x93\xfe\x18\xa4\xa4\xac\x58\x9b\x55\x08\x9b\x3d\xfb\x72\x38\x3d\x7e\xd6\xc0\x06\x36\xdf\xa8\x13\x73\x43\x21\x7a\x62\x7a\x88\xb6\x80\x69\x80\x61\x4a\x1b\xc6\x24\x0a\xf4\x33\x0b\x2d\x0a\x8c\x2a\xfc\x98\xaf\xa5\xe9\x02\x88\xbd\x2b\x27\xcc\xf3\x14\xa3\x4c\x93\xf9\x68\xb3\x71\x63\x18\x81\x08\x86\x9e\x88\x2f\xe7\xf7\x4f\xca\x35\xca\x7a\x51\x8a\xca\x12\x3a\xf7\x87\x2a\x57\x3e\x6b\xc5\xa5\x1f\xe7\xd5\xf0\x7e\xaf\xca\xd7\xce\xe9\xc9\x11\xa5\x3f\xbd\x0f\xee\x30\x30\xcc\xab\x2a\x3f\x6b\xc7\x2e\xfc\x0f\x73\x92\xb9\x8e\xe3\x4d\xe3\xc1\xc0\xc5\xd0\x71\x3c\x10\x42\x6d\x86\x34\x8f\x50\x4a\xfe\x86\x22\x35\xe5\x2e\xf3\x40\x56\x39\x20\x43\xa7\xe7\x0c\xdd\x68\x16\x4d\x1c\xc7\xf3\xab\xfc\x49\x7e\x81\x8b\x47\x14\x79\x6f\x78\xdf\x39\x94\xfd\x14\x85\x38\x65\xf4\x43\x37\x9c\x85\x74\xae\xa1\x23\x76\xe1\x00\x0c\x9a\xcf\x0b\xb0\x84\xc8\x4d\x9a\x7d\xe9\x61\x8b\x14\x08\xae\xe0\x4b\x0f\x2c\xe5\xf1\x3d\x21\x65\xd5\x11\xa1\x36\x7a\x91\x2a\xc0\xec\x18\x02\x08\x06\x9e\x48\x47\x74\xc3\x4b\x27\x71\x55\xfe\x01\x9d\x48\xe3\x80\x49\x20\x5f\x3e\xf2\x40\xb4\x05\x05\x2e\x71\x65\x08\x9b\xdc\x7f\x45\xce\x70\x67\x91\x17\xe4\x0c\xab\x8b\x68\xe2\xeb\xe7\x05\x59\x92\x0c\xa5\xb3\xa7\xa8\x5a\xf9\x45\x5e\x67\xb1\x6b\x25\xf0\x26\x01\xb8\x3a\x23\x19\x39\xab\xcf\x1e\x93\x25\xa9\xca\x89\x46\xa7\x75\x49\xfb\x4c\x13\x3b\xfb\x74\xa0\x3f\xf6\xb6\x00\xad\xd7\x38\x8b\xd9\x98\x17\x39\x63\x57\x33\x33\xc8\xbf\x87\x53\x9f\x13\xb9\x7c\x40\xcc\xa9\x35\x32\x6e\xb2\x79\x6a\xd4\x1c\x8e\x8b\xc0\x95\x90\x86\xc9\x15\x8a\x2a\x72\x8e\x27\x56\x51\xe1\x7d\x20\xc4\x49\x5e\xec\x20\xe1\x7d\x80\xee\xc6\x4e\x40\x7b\xa8\x5b\xe7\x8a\x6f\x6c\xca\x0d\xbd\x2d\x60\xfa\x3f\xd1\x52\xc8\xb2\xca\xd7\x7a\x0b\x35\x1a\xef\x65\x51\x61\x84\x3d\xfa\xb2\x79\x9d\x55\x71\x7e\x91\xcd\xb8\x94\x62\x31\xc0\x15\xe1\x0b\xc9\x9a\x86\x2d\x20\xb7\x4e\x47\x85\xc6\x47\x71\xfc\x1c\x47\x79\x16\xd3\x31\xcd\x8c\xea\x98\x00\x42\x73\xd8\x12\x57\x54\xc0\xf8\xd0\xd2\xf5\x66\x1a\x09\xdd\xa0\x64\x4a\x1b\x56\xd6\x61\xbb\x1a\xc3\x55\x8f\x09\x78\xa6\xc2\xf2\x70\xe4\x63\x14\xad\xdc\x10\xe8\xda\x26\xb3\x6c\xa6\x20\x34\x69\xc2\x33\x37\xda\x6c\x42\x08\xb1\x1f\x53\xec\x69\x70\xbc\x4e\xd1\xa5\xeb\x01\xec\x97\x38\xc5\x11\x4b\x82\x26\xb1\x2f\x85\x88\x1e\xcb\x17\xf1\x45\xcc\xee\xef\x72\x47\x19\x39\x43\x74\x8a\x1f\xd1\x88\x68\x8c\xbf\x09\x34\x07\x25\xe3\x91\x72\x72\x15\xe3\xb2\x2a\x72\xe6\x9f\xb8\xd4\xd2\x4f\x34\x9b\xe4\x7f\x2b\x5c\x9c\xa3\x94\x7e\xe6\x82\xc3\x3e\xe4\x6b\xfa\x97\x9b\x00\xea\xe4\xbb\x02\xee\x50\x28\x47\xac\x94\x32\xe2\x4d\x8e\x14\x6c\xb5\x8b\x37\x39\xd2\x5d\x6a\x7d\xa2\xcd\x01\x71\x5e\xe9\x1d\x79\xe5\x00\x66\x57\xb5\x66\xd6\xe2\xf0\x33\x64\xed\x0e\xa0\xa8\x4f\x1c\xfa\xbf\x03\x2e\x0a\xaa\x0e\xfa\x12\xa2\xcd\xa1\xfc\xe7\xd1\x4b\xea\xdd\x27\xce\xf7\xf3\xba\x48\x2f\x1f\x51\xc9\xa6\xab\x37\x22\x4e\x37\x1c\xe3\x04\xd5\x69\xf5\x68\x27\xb5\x20\xa0\xe1\x6a\x8d\x96\x78\xe2\xe0\x6c\x99\x92\x72\xe5\x80\x7b\x98\x61\x98\xd0\x51\xfd\x00\xa4\x28\x5b\xb2\x88\xc0\xa4\xd4\x6d\x5b\x00\xa4\xa5\x62\x88\xd7\x59\x46\xf8\x48\x2a\xbe\xf2\x6f\x41\x3f\xdc\x93\x5b\xa4\x07\xb9\x23\xc8\xa5\x8e\x3d\x66\x3e\xbc\x09\x5d\xf5\xd0\x42\x2c\x00\xe9\xc4\x4d\xa8\x11\x0b\xd2\x7b\x38\x85\xc8\x0d\x3d\x2a\xb8\x8f\xe8\x89\x73\x93\x2a\x8d\x8f\x58\x5f\x12\x8b\xaf\x50\x0e\xe5\xcd\x72\x37\x30\xea\xe6\x06\xb3\x68\x12\xa9\xd6\x3f\xa2\x96\x9e\xd7\x50\xf6\x38\x13\x7d\x56\xab\x67\xd0\x9a\x80\xae\x18\xdc\x50\xaa\x4d\x12\x17\x86\xab\x65\xd5\x82\x2f\x2b\x31\x49\x73\x14\xcb\xe3\xe6\x70\xc8\x23\x55\x08\x1a\x81\x91\x80\x89\xaf\xcd\x2c\x3b\x63\x34\x6d\xf4\xc4\xb4\x3c\x11\x88\xa1\x43\xfb\x1c\x80\x61\x7f\x2c\x5d\x36\x82\xc8\xaf\xa3\x84\x14\x65\xe5\x7a\xc3\xb8\x71\xb5\x98\x59\xc2\xc1\xc0\x15\xab\x50\xab\x48\x07\x06\xed\x09\xfb\xab\xea\x2c\xa5\x11\x48\x0b\x83\x8e\x20\xb4\x80\x1a\xb5\x66\x88\xc6\xff\x1a\x6a\x32\xb9\x05\xa1\x37\xd1\x3b\x84\xd3\xd0\x75\x6a\x18\x37\xe4\x20\xf2\x59\x48\xeb\xb6\xa1\x2e\x86\x11\xc0\x3a\x48\x6d\x1f\x87\xab\x51\x3e\xc3\x35\x4b\x74\x42\xa8\x67\x96\x73\x05\xac\xc5\x6c\x4f\xdf\xa4\xdb\x67\x52\x1b\x34\xea\x26\x25\x6b\x32\xaa\xa7\x59\x6d\x48\xb9\xe6\x91\xaa\xed\x7c\x61\x43\x39\x25\x89\xdb\x47\xa2\x02\xda\xcb\xea\x34\x15\x95\x62\xa4\x47\xa7\xd3\x6e\x0d\x4b\xc9\x66\x69\xdc\x0e\x68\x1c\x3f\x8f\x17\x33\xfa\xdf\x04\x19\x01\x81\x16\xc8\x4c\x43\x69\x12\x36\x9b\xb0\xf5\xf7\x83\x41\xdf\xd5\xbe\x86\x5c\x50\xe8\x7f\xa7\x81\x37\x0b\x59\xbe\xe8\xbc\x28\x2e\x49\xb6\xec\x55\x79\x8f\xad\xd1\x63\x3a\xd5\xbb\x58\xe1\xac\xd7\x8c\xed\xa1\xb4\xc0\x28\xbe\xec\xa1\xaa\x17\x38\xde\xc4\x0d\xa5\xa0\xd2\xd3\xe5\xf3\x7a\x80\xff\x2d\x44\xab\x12\x0c\x84\x3c\xb6\xb3\x16\x47\xd1\x60\x80\x5c\x6f\xeb\x79\xdd\x08\x07\xb5\x81\x07\x96\x5a\xd0\x2a\x3d\x6f\x41\x6d\xa5\x8b\x65\x97\xad\xb1\xf4\x94\x80\x78\x85\xca\xf7\x2f\xb2\x67\x45\xbe\xc6\x45\x75\xe9\x86\x9e\x90\x4d\x1e\x0d\x84\x0b\x31\xbb\x11\x2e\x4b\x0e\xf8\x82\xac\xd3\x45\x8a\x28\x8b\x26\x8f\x65\xbe\xd4\xec\xd8\x87\x72\xd3\x88\x94\x20\xb7\x1f\xf0\x24\x5d\x1f\xa3\x25\xdc\x6c\x10\x9b\xf8\x51\xe3\xdc\x76\x84\xb1\xe2\xec\xa7\xa2\xdc\x2f\xa8\x21\x9a\xf5\x03\xea\x66\xc2\x8e\x25\x51\xd5\xd2\x88\xa7\x34\xd0\x39\xaf\x63\x80\xee\x1c\xf7\xd0\x98\x7a\x57\xd0\xc3\xe2\xad\x49\xf0\x65\xc3\x11\x25\x86\xd8\xaa\xe9\xbb\xf0\xe1\xf7\xf2\xf0\x43\x16\x1b\x55\xf8\x8c\x65\xf3\x29\x2a\xab\xc7\x72\xe9\xfd\x99\x7d\x93\xad\x03\x91\xd7\x2d\x49\x05\x43\x69\x0e\xc4\x34\xb2\x81\xfa\xdb\x6e\x36\x21\x0d\x75\x1e\x7e\xd8\x7a\xd6\xf0\x34\x68\x4a\x1c\x22\xbe\xd4\xb3\x2b\x25\x7d\x91\x83\x98\x68\x51\x62\xa3\x24\xd5\x16\x51\x5a\x4d\x9a\x89\x7a\x3c\x47\x58\xe1\x5d\xdd\x48\x1f\xea\xfb\xd0\x44\x89\xb2\x90\x90\x2c\x76\x1d\xdf\x19\x6a\x71\x03\x87\xdd\xf3\x0b\x7c\x96\x9f\x63\x4b\x60\x21\x08\xa6\xb7\xcc\xc3\x4f\x76\xcf\x3c\x92\xc0\x1e\xbe\x88\x55\x80\x92\x3b\x51\xc4\xdf\xab\xf0\x99\x6d\x1a\x65\xdf\xd4\x3c\xf1\x45\xe5\xf1\xb4\x47\xd9\x52\x6d\xb7\x3c\x20\xed\x66\x3f\xec\x6c\x34\x8e\xba\x59\x1d\x1d\x66\xd8\x30\xf5\x9a\x64\x2a\x8c\x44\x5e\x6b\xb6\x91\xa7\xad\x2a\x1c\x68\xd7\xdc\x46\x66\x54\xf8\x32\xf4\xf7\xd4\x52\x03\x45\xa3\x23\xd7\xdc\xb2\xcc\xef\x9f\xa4\xa4\x2d\x86\xb8\x68\x86\x58\xb1\x43\x94\x4a\x50\x6f\x55\xe0\x04\x3a\xdf\xe0\x5f\x63\x72\x2e\x69\xeb\xb5\xd1\x54\xae\x50\x9c\x5f\x38\xa7\x27\x47\x31\x39\xef\x76\x92\x2c\xeb\x56\x53\x28\x95\x03\x9a\x0f\x1a\x39\xb5\x57\x5f\xf5\x02\xce\xc9\x11\xe2\x7f\x52\x72\xea\xb4\xb5\x28\x59\x47\xd8\x01\x94\xe2\xcf\x75\x40\x59\x77\x2b\xdd\x42\x51\x23\xcf\x5e\xe3\xd1\xb4\x6a\x89\xab\x67\x05\x3e\xe7\x69\xa4\x48\x7a\xf5\x36\x45\x59\x41\x04\x91\x3b\xbf\x7f\x52\xa7\x9d\xaa\x95\x5a\x3e\xe0\x75\x2f\x4d\x1e\x84\x17\x98\xed\x14\xce\xf6\xa4\x6d\xbb\xb3\x68\x19\x90\x06\x6a\x1f\xa9\x45\xcf\x9c\x93\xa3\x3a\x55\x21\x6f\x8a\x54\x11\xf3\x7a\x3f\xc4\xaf\xaa\x4e\x5d\xa6\x71\x7d\xdf\x81\x8a\x32\xce\x02\x05\x97\x21\x2f\x53\x37\xa0\x59\x06\x07\xda\xe0\xef\x28\x83\x47\xe3\xc3\x3c\x18\xbf\x83\x51\x6e\x74\x44\x58\x09\x77\x38\xe9\xb2\x0e\xcb\xaa\x70\x03\x30\xf6\xfc\x2a\xff\x31\x4d\xa5\x44\xf5\x51\xed\x66\xb5\x28\x3f\xc9\x7c\xcb\x05\x8d\x7a\x3d\xa3\x05\xde\x2e\x62\x40\x7b\xe2\x9a\x85\x8d\x4f\xf6\x8f\xd7\xd7\x60\x5d\xdb\x3b\xb9\x6d\x6a\x9e\x76\xb9\x6d\x96\xca\x06\xaa\xa7\xed\xe6\xc0\xbb\xbc\xaa\xf5\xda\xa6\xbd\x9b\x89\xf4\x34\x65\xb3\x71\x3b\x2d\x10\xe9\xdf\x3d\x25\xeb\x8d\xba\x05\xf6\x36\xdc\x61\x51\x57\x48\xf5\x33\xcf\xce\x71\xc1\x45\xae\x7c\x91\x3f\x2c\x0a\xd5\xc0\x37\xb1\xd4\x7c\x31\x45\x2c\x4c\x17\x17\x71\x6d\x68\x19\xc1\x60\x1a\x9d\x20\xed\x4e\x67\x1e\x2d\xfc\x33\x54\x45\x2b\xf7\xe8\xaf\x7f\x1a\xbf\x75\xef\x68\xe9\xb5\x77\x3b\xec\x4e\x47\xe6\x31\x5b\x10\xef\x2b\x42\xb6\xeb\x81\x08\x86\x72\x0d\x39\x78\x1e\x8d\xd0\x62\xc6\xff\xb0\x22\x0e\x9b\x4b\x4b\x43\x1a\xa3\x35\x67\x6f\x01\x76\x54\xc0\x42\x18\xaa\x4b\x8d\x61\xb3\x16\xab\x3b\x3b\x81\x33\x0c\xdb\x1d\xc7\x30\x98\xc6\x27\x0d\x37\xf1\x70\xe8\x45\x7c\x6f\xa1\x1f\xad\x50\xf1\xb0\x72\x63\xcf\x63\x87\x27\x9e\x25\x98\xf9\xa6\x3c\x07\xfd\x2c\xe5\x00\x71\x6e\x5a\xe7\x69\xd3\xd9\xf2\x11\x35\x3c\x9c\x98\x03\x04\x63\x75\x56\xae\x48\x52\xb9\x4e\xe0\x74\x4c\xcd\x63\x54\x61\x7e\xcf\xb9\x2f\xcc\x36\x8a\x1c\x4d\x0f\xcb\x85\x69\x93\xeb\xca\x4f\x9e\xac\x62\xba\xde\x70\x8c\xbf\xf9\x96\xb4\xe6\x5a\x61\xd3\x13\xab\xcb\xa2\x93\x4d\xdc\xe4\xc0\xc7\xe8\xb2\x94\x21\xc9\x12\x57\xdf\xcf\xeb\xa2\x74\x9b\x64\x7f\x89\xab\xa7\x24\xab\x2b\xcc\xda\x16\x4d\xed\xa0\x11\x36\x49\x25\xd7\xee\x07\x9e\xa7\x98\x63\x7e\xb7\x22\xd9\x29\x77\x49\x61\x77\x03\x47\x6f\x07\xf4\xdf\xf1\x03\x65\x41\x37\xfc\xff\xf0\xdb\x1e\x60\xd5\x9f\x24\xcd\xf3\x42\x4e\x4c\x59\x36\x36\xaa\xbd\x10\x69\x58\x99\xeb\xdb\xb4\x6e\x12\x58\xb6\xd4\xea\x13\x6a\x96\x4c\x2f\x77\x1d\xaa\xce\x93\xdb\x72\x79\xc7\xfd\x77\x36\x7f\xfc\xc0\xb6\xfb\xa7\x24\x25\x15\x2a\x2e\x3b\x09\x20\x7f\x62\x66\x46\xe9\xfc\xbe\x9a\x6e\x13\x6d\x36\x2e\x82\xad\x18\x48\x51\x75\x3d\x79\x01\x34\x47\x2a\x54\x48\xc5\xa9\x15\x85\x10\x42\xd8\x0f\x06\x03\xa1\xa0\x48\x45\xce\x10\x85\x48\xf2\xcc\x66\xb9\x03\x1a\x1d\x28\xde\x0e\xec\x50\xd0\x69\xef\x2a\x0a\xcd\x96\x0e\x39\x78\x85\x2f\xdb\xe5\x36\xc7\xb4\xd1\x55\x70\x8b\x8e\x9b\x77\x24\x6c\x53\x67\xe8\x55\xeb\x43\x5a\x7d\x3f\x1a\xe3\x6f\x8e\x90\xfe\x1d\x04\xde\xa4\xd3\x34\xda\x31\x74\xa2\xe4\xf5\x46\xf2\xff\xe5\xe4\x05\xe8\x2f\x00\x84\xf8\x68\xd2\x13\xc3\x79\x74\x3a\x3e\x9e\x45\xa3\xf1\xf1\x24\x80\x10\x46\xb3\xf1\xf1\x24\xba\x4d\xaa\xe2\x43\xa4\x2a\xe6\xc7\x6f\x3b\x97\x3d\x52\xa5\x8a\xd4\xdb\x01\x84\xe1\x2c\x84\xc1\x44\x15\xae\x08\x93\x54\xca\xd6\x5f\x60\xfc\xf2\xee\xe6\xeb\xe8\xdb\x1d\xc1\xfd\xd6\xb1\x21\xb8\x3c\xf7\x7a\x82\x51\x4c\xb2\xe5\x5f\xe1\x22\x2f\xad\xf7\x4b\x01\xc0\xd4\xc5\x36\xb1\x16\x73\xb3\x91\x16\x56\x85\xa7\x68\x16\x0f\xe1\x1a\x15\x25\x7e\x2f\xab\xdc\x68\x8e\x16\x60\x1c\x78\x13\x2c\x2e\x65\xe7\x68\x41\xbd\x26\x85\x3f\x9e\xe1\x49\xc4\xee\x28\x6d\xb0\x1d\xe0\x9b\xfc\xb2\x3d\x11\x4d\xe2\x1a\x14\x86\xc8\x6b\x65\x6e\x08\x91\xb2\x9a\xf9\x00\xa1\xe1\x83\x85\xab\xcd\x95\xdb\xd7\xc0\xd6\x48\x65\x6b\x44\xd9\x6a\x56\x33\xd8\x6a\xf9\x60\x6c\xed\x79\x3e\x65\x95\x03\x25\xe4\xb1\x27\x00\x7b\x43\xe1\x62\x57\x2c\xfc\x55\x5f\xcc\x51\xeb\xa3\xc7\xd6\xcd\x48\x76\x29\x68\x5c\x13\xde\x52\xc0\xb2\xbf\x3d\x11\xc9\x9c\xe0\xdd\xd5\xbe\x95\xec\x69\xaa\xb7\x9f\x84\x6f\x90\xab\xe3\x3b\x29\x5a\x97\x38\xde\x75\x10\x6c\x4b\x3c\x36\x92\x5b\x51\x87\xed\x28\x7c\x36\xd1\x56\x2b\xb5\xf2\x58\xc5\x40\x16\x5a\x99\x65\xda\x28\xc5\xa8\x78\x4f\x2c\xd4\x8e\x96\xd7\x5c\x8c\x1b\x28\x2e\xaa\x3e\x28\x71\xd5\x90\xa2\x5b\xb6\xcc\x96\x32\xef\xe3\x51\xe7\x52\xbc\xb9\x91\x93\x6b\x70\xa8\xf8\x55\xd4\x6d\x6b\xb0\xb9\xf7\x95\xbf\xf5\x25\xc6\x72\x09\x6d\xcf\xb7\x2f\x92\xaf\xbb\x24\xc8\xdb\x02\x7d\x1a\xf5\x4c\x76\x62\x4a\x07\xb5\xdb\x33\x39\xee\x00\xbc\x05\x1f\x08\x25\xd9\x45\xaf\xaf\xa4\x5d\xed\xc9\xdd\x2a\x3c\xef\xdf\xa7\x58\x8a\xae\x4a\x8c\x4d\xc9\x15\x77\xeb\x80\xa8\xa6\xed\x5a\x94\xca\xd1\x70\xb8\x05\xea\x16\x77\x5f\xca\x34\x1c\xd0\x09\xc4\xe5\x08\x54\xc1\xd1\xae\x48\x54\x6a\x51\xeb\x6b\xab\x7b\xbb\xaa\x17\x8a\xc1\xba\xc0\x59\x75\xf9\x6e\x5e\x17\x2c\xf4\x95\x37\x75\x50\x7f\x18\xb7\xff\x5d\x6f\xc7\x82\xd0\xaf\xc6\x83\x35\xad\x46\x06\x62\xfd\x95\x47\x5b\xf7\xad\x53\x47\x54\xcb\xb4\xa7\x1c\xf4\xbf\xcd\xc6\xfe\xca\xa7\x09\xdb\xcc\x42\xe7\x2d\xaf\x94\x2e\xed\xcf\x94\x44\x1c\x1d\xd2\xc8\xc2\xf2\xac\xa4\x13\xbb\xbb\x52\xd6\xca\x55\x7e\x21\x3c\x88\x37\x95\x39\xf0\x69\xdc\xa4\xcb\x3b\x32\xec\x48\xbd\x01\x08\xbd\xad\x56\x3c\x13\x0f\xf8\xdc\x1d\x8d\xbc\xd6\xd3\xbc\x20\x9b\x07\x0b\xcf\x27\x59\x89\x8b\xea\xbb\xac\x14\x27\x2e\xad\xd9\x7d\x55\xfb\x51\x70\x34\x3a\x5e\xb0\xbb\x02\x63\x96\xf1\x9d\x66\x79\x20\x66\x69\xcf\xdf\xbc\x1d\x6a\xe4\x43\x7b\x7a\x44\x37\x00\xd1\x0c\x7d\x41\x8c\x41\x47\xe2\x6e\x97\x75\x91\x61\xec\x93\xef\x72\x95\xd7\x69\xfc\x50\x7b\x21\xb5\xeb\xcd\x85\xf6\x20\xcf\xf2\xb0\x18\xb5\x57\xff\x30\x6c\x3f\xcb\xfb\x35\xf5\x61\x80\x2b\x77\xd7\x5d\x9c\x47\xf0\xed\x63\x2b\xa8\x5d\x01\xb4\xb7\x76\xfd\x31\x40\xbe\xb8\x29\x70\x95\xc4\xc2\x08\x6d\x86\xbc\xaa\xd8\x3c\xbe\xda\x39\x61\x20\x94\x60\xdf\x64\x53\x76\x39\xd5\x2e\x1c\x8e\xf8\xec\x25\xae\x7e\x82\xd2\x5a\xf9\x31\x53\x48\x67\x6f\xc8\x24\x91\x38\x90\x3d\x64\xb7\x58\x15\xd5\x72\x82\x68\x9f\x51\xe9\x9a\x9c\x76\x53\x9d\xcc\xd2\x24\xa0\x12\xb9\xf0\xa6\x52\x93\x9b\x72\x53\xa3\xd2\xb1\xaa\xd2\x51\xfb\x13\x18\x45\xb1\x23\x3a\x5e\x5c\xe0\x45\x1e\x13\x0e\x36\xb4\xd5\xa7\x6e\xe1\x4d\xbc\x2b\xdb\x1e\xa8\x59\x16\xd9\x11\xd7\x86\x86\xce\xb5\xf9\xe0\x17\x06\xc3\x50\xbe\x2f\xff\x64\x74\x87\x31\x3e\xe4\xcd\xa8\xfa\x10\xf4\x10\x3b\xf0\x18\x91\xe6\x5d\xd7\x2d\xc6\x40\x5a\x1b\xf6\xae\xee\xff\xc0\xf7\x01\x0c\x83\xe9\x1e\xcf\xd3\xd6\x06\x5d\xd3\x20\x7e\x69\xa7\xa3\xcc\x36\x13\x5e\x41\x77\x39\x8e\xe8\x75\xbc\x2f\xe0\x68\x26\x18\x1e\x03\xfb\xb4\xa2\x90\x70\xb7\x69\x1f\x0c\xb1\xee\xc1\x3a\x93\xb2\x12\xc6\xdd\xa6\x7c\xfb\x96\x29\x1f\xa3\xcb\xd2\x01\xfd\x60\xcf\xa4\xc1\x81\xfe\xd0\xa2\x86\x87\x1c\xb2\xf2\xa8\x4c\x55\xea\x3b\xfb\x42\xed\x71\xe3\xd7\x13\xf1\xe9\x6f\x6c\x01\xde\x1b\xf3\x45\x30\x9a\x45\x76\x99\xd7\x6a\xb2\xed\xd5\x01\xee\x0a\x79\xd4\x11\xf2\xd8\x10\xf2\xaf\x5e\xa6\xbf\x52\x79\x16\x53\x86\x9b\xcd\x3e\x81\xfe\xda\xc5\x4f\xc3\xfb\x30\x79\xbb\xed\x91\xbd\x42\xde\x14\x01\x9b\x98\x55\xec\xe5\x61\xd2\x08\xbc\xda\x99\xe1\x57\x15\xff\x41\x9b\xb5\x0e\xd3\x4a\xad\x56\x45\xee\x48\xb4\x21\xed\xad\x78\xd3\x24\xf2\x9d\x57\x11\x2e\x4b\x71\x23\xb9\x37\xdc\xbb\x35\x1e\x69\x09\x64\x52\x6f\x06\xb6\x0a\x9f\xb6\xb7\x54\xb7\x1c\x4f\x77\xfc\xdd\x75\xff\xc5\x05\x4e\xcf\xf1\xae\x7c\x6f\x47\x46\xd8\x20\x76\x86\x0b\x12\x93\xfa\x8c\xdd\xe5\x8a\xcf\x2f\xf0\xab\x6a\xe2\x3c\x7c\xea\xec\x0b\xd0\xac\x8f\xfa\xed\xe9\x93\x59\x8e\x52\x57\x6a\x8a\xb7\x4f\x45\xa3\xdb\x21\xea\x3e\x85\x50\xde\x81\x49\x12\xe7\xf4\x3e\x70\x4e\x52\xc2\xde\x94\xa8\x2f\x58\x86\xc6\x72\x43\xfd\x4d\x88\xe5\xa1\x82\xbe\xba\x29\xcf\xbb\x34\x7e\xbc\x10\xaf\xb5\x76\x45\x75\x2a\x1b\x7d\xcb\xb6\x9b\xcb\xd2\xbb\xa0\x23\xcc\x2e\x72\x3c\xfe\x1e\xd8\x98\x41\x93\xa9\xbb\x9d\x1c\x90\x57\x3a\x52\x48\x8c\x6a\x9d\x7e\x23\x2a\xae\x19\x4e\xe1\xf8\x78\xe6\x3c\x7b\xea\x30\x21\xda\x02\x52\x3e\x7b\x6a\x0e\xa5\x04\xd0\xb2\x3d\x9e\x51\xd1\x51\x0f\x6d\xa3\x1e\xee\x1d\x75\xab\x5d\x79\x82\xb2\xa5\xff\xb0\x40\x21\x89\xe0\xd5\x25\x46\x45\x39\x71\x6e\x7e\xff\xe6\x57\x6f\x7e\x73\xf3\xf1\xcd\x3f\x3b\xe0\x2c\xcf\xaa\x15\x6d\xfb\xe4\xcd\xaf\xdf\xfc\xe6\xe6\xdf\x1c\x10\xa3\x4b\xfa\xfd\x1f\xde\xfc\xf6\xe6\xe3\x37\xbf\x74\xc0\x8a\x5d\x21\x3a\x37\xbf\xbf\xf9\xf8\xe6\x0f\x62\x90\xb8\x49\x73\x6e\xfe\xe5\xcd\xcf\x6f\x3e\xbe\xf9\xa7\x37\x3f\x77\x40\x29\x62\x4d\xe7\xe6\x77\x74\xf2\x37\xbf\x7a\xf3\x5b\xa7\xfb\x4b\x59\x1f\x15\xd0\xca\x5b\x87\x6e\xee\xa0\x62\x84\x0a\x67\x71\x10\xb5\x8f\xf8\xfe\xac\xb4\x87\xe0\xf3\x18\x65\xa4\x5c\x35\xf8\x7c\xfe\xcb\xa2\x05\xe6\xe9\xe7\xff\x98\x61\xfe\x73\x0f\x06\xcc\x63\xb4\xc4\x0d\x28\xac\xb8\xa7\xe0\xc1\xac\x1b\xfb\xbd\x45\x83\xc6\x73\xfc\xb2\xce\x62\xf6\x5b\x8e\xce\xaa\x31\xea\x72\xcc\xf9\x30\xb0\x88\xd1\x28\x7e\x69\x62\x61\xa5\xf6\x63\xbe\x17\x2b\xed\x21\x58\x7c\x0f\x17\x67\x28\x6b\xb0\xf8\x01\x5a\x15\x58\x41\x23\xcf\x50\x85\x25\x16\x2f\x54\x2c\x9e\x57\x74\x9f\x59\x17\x0d\xda\xd2\x05\x23\xb3\x80\x81\xbb\x2c\x73\x46\x4c\x30\xf0\x28\xc6\x26\x18\x56\x6a\x7f\xc9\x37\x63\xa5\x3d\x04\x8c\x77\xf8\x4f\x5f\x1a\x34\xfe\x92\xfe\xd1\xd0\xa8\x56\x65\x2b\x19\x34\xbc\x16\x68\x88\x68\xa7\x83\x45\xa9\x61\xc1\x03\x37\x03\x0a\x6c\x30\x2c\xd8\x30\xb0\xc0\xd9\xa8\x2e\x4d\x2c\xec\xe4\xbe\xf8\x1d\xcf\x0e\xea\x43\xe0\x78\xbe\xd6\x15\xe5\xe1\xe0\x1b\xc7\x0f\xc6\xd3\x5c\x85\x04\x97\xb8\x45\x64\xf0\x8d\xe3\xe0\x5b\x53\xa4\xc2\x52\x20\x03\x96\x5c\x87\x65\x59\x67\x71\x6e\x81\xa5\xec\x72\x2e\xd8\x31\x61\x29\x47\xd8\x02\x8b\x9d\xdc\x2f\xd7\x56\x8d\x11\xd4\x87\xc0\xf2\x2e\xc9\x34\x58\x7e\x52\xe7\x55\x85\x5a\x4c\xfe\xac\xae\x5f\xa2\x9a\x35\x71\x5c\x9e\x7d\xfe\x11\x39\xff\xfc\xa3\xcf\x3f\x6a\x0d\x49\x9d\x55\x04\x75\x80\xa9\x2b\xd6\xa6\x6b\x0f\x6d\x32\xb0\x49\x48\x97\x7d\xc1\x93\x81\x4d\x42\x46\x09\x31\xb1\xb1\x93\x53\x5f\x6b\xc3\x46\x50\x1f\x84\x4d\x81\xb3\x48\x91\x98\x4c\x53\x1f\xd2\x88\xca\x0f\xb8\xbe\x48\x31\xc1\x75\x81\x0f\x51\x1f\x6c\xd1\x9f\xc4\xf0\x31\x9c\x09\x13\x8b\x62\x14\x21\x0b\x16\x36\x6a\x3f\xe1\x1b\xb1\xd2\x1e\x02\xc4\x7b\x15\x4a\x89\x62\x58\x1f\x66\x19\xd1\xd4\x86\x48\x28\xbe\x47\xf2\x82\xf6\x09\x2c\xde\x67\xf6\x57\x03\x82\x18\x38\x10\x13\x06\x52\x75\x99\x15\x2c\x18\x38\x90\x6a\x44\x2a\x13\x07\x3b\xb9\x4f\xc4\x46\xec\xd4\x87\x40\xf1\x04\x55\xe7\x2a\x14\xdf\x43\xb1\x0a\xc5\xff\xfc\x5d\x86\xff\xf4\xf7\x0d\x1c\x8f\x09\xce\x14\x0b\xc2\xdc\x4c\xd7\x86\xfc\xe9\x77\x5d\xe1\x60\x7e\xc6\x22\x1c\xe9\x79\x97\x71\xc1\x8d\x81\x4a\x7a\x3e\x4a\xcf\x4d\x54\xec\xe4\x7e\x2a\xf6\x64\xa7\x3e\x28\x08\xa9\x2b\x45\x51\x7e\x80\x0a\xe6\x4b\x25\x28\x08\x71\xe7\xda\x06\x21\x59\x03\xc9\x8f\x8b\x5b\xdd\x2e\xd3\x15\x8b\xdb\xcd\x52\x23\x52\xa0\x6c\x18\x60\x64\xe9\x28\xb4\x78\x5d\x1b\xb1\x1f\xb3\x8d\xd8\x28\x0f\x81\xe1\x87\x79\x71\x81\x97\xaa\x78\xdc\x1a\x8e\x15\x5f\x4d\x3c\x96\xe5\x5d\xa6\x1b\x5e\x0c\xd2\xee\x0f\xf5\x76\x92\xce\x9d\x2c\x1f\x65\xa1\x09\xdd\xee\xb9\x1b\x00\x76\x8d\x38\x04\xc6\x67\x79\x51\xd5\xcb\x1a\x97\x58\xb1\x38\xbb\x1d\x35\xf9\x22\x3e\xda\xa2\x5e\x6b\xc3\xe8\xb4\x8c\x18\xc8\xac\xab\x51\x68\x89\xf1\x77\x8e\xf0\xd7\xed\xa6\x76\x8e\x39\x04\x9c\x1f\xd5\x65\xa9\x4a\xd8\xf5\x7f\x5d\x7f\xfa\xfa\xe7\x2d\x36\xd7\x7f\xbc\xfe\xf4\xf5\xcf\x5e\xff\xed\xeb\x5f\x5d\x7f\x7a\xfd\xef\x12\xa4\xeb\x4f\xae\x3f\xbb\xfe\xf4\xfa\x0f\x0d\x50\xaf\x7f\x7d\xfd\xaf\xaf\x7f\x76\xfd\xdf\x94\xa4\x41\xeb\xfa\x8f\xd7\xff\x71\xfd\xd9\xeb\x5f\xbc\x56\x53\xa2\xd7\x3f\xbb\xfe\xf4\xfa\x3f\x5f\xff\xe2\xfa\xb3\xeb\x4f\x4c\xd0\x8a\xba\xbb\x1d\xc1\xa0\x81\x58\x51\x8f\x8a\xda\x44\xcc\x4e\xee\x17\x62\x9b\x76\xea\x83\x02\xbe\x0b\x1c\xef\xcd\x8c\x90\xae\x8a\x48\x53\xc5\x33\x64\xe8\xe2\x81\xaa\x58\x1a\x56\x5a\x70\x62\x20\x52\x9e\x8f\x4a\x8b\x61\xb2\x93\xfb\xa5\xd8\x8f\x9d\xba\x41\x64\xfa\xbf\x01\x00\x00\xff\xff\x41\x8a\xb8\xca\x73\x51\x00\x00"
-
-func assetsVendorsFlipclockJsFlipclockMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsVendorsFlipclockJsFlipclockMinJs,
-		"assets/vendors/flipclock/js/flipclock.min.js",
-	)
-}
-
-func assetsVendorsFlipclockJsFlipclockMinJs() (*asset, error) {
-	bytes, err := assetsVendorsFlipclockJsFlipclockMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/vendors/flipclock/js/flipclock.min.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesConfigMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xcf\x6b\x24\x45\x14\xfe\x26\x89\x1e\xc6\x1f\xa0\x78\xf4\xf0\x04\x5d\x14\xec\x75\x26\xee\x41\x3a\xe9\x44\x76\xd9\x05\xd9\x04\xc3\x3a\xab\x27\x0f\x95\x9e\x4a\x4f\x63\x77\xd5\x50\x5d\x2d\x59\xc8\x21\x33\x01\x45\x5c\xd8\x8b\x5e\xdd\x83\x17\xbd\x8d\x93\x0c\xdb\x66\x26\x9d\x7f\xe1\xd5\x5d\xfc\x37\xbc\x4a\xf5\x18\x97\x20\xf8\x0e\x8f\xf7\xe6\xfb\x5e\x7d\xdf\x37\xf4\x9f\xaf\xaf\xfd\x08\x00\x2f\x02\x78\x13\xc0\x0e\x80\x97\x00\x1c\x61\x59\xbf\x00\x78\x05\xc0\xaf\x00\xde\x06\xf0\x0c\xc0\x2d\x00\x7f\x00\xb8\x0f\xe0\xb5\x16\xf0\x06\x80\x2f\x5b\xc0\x76\x0b\x30\x2d\xe0\x65\x00\xd3\x15\xe0\x36\x80\xcb\x15\xa0\x07\x60\x65\x15\x78\x0a\xe0\x8b\x55\xe0\x55\x00\x7f\xad\x02\xad\x7f\x74\xd7\x3c\x8e\xe7\xb5\x8a\xeb\xf5\x82\x6f\xb1\x56\x07\x69\xb2\xf6\xa9\x1d\x48\x53\x5c\x6d\x7b\xc2\x0e\xc8\x6a\xb2\x46\xc4\x92\xfa\x65\x3e\x2c\xa8\x9f\x1a\x19\x5b\x6d\x1e\x5d\x91\x3e\x93\xb6\x20\x3b\x90\x94\x08\xb3\x2f\x12\x49\xb1\xce\x32\x19\xdb\x54\x2b\xb2\xc2\x24\xd2\xd2\x50\x9a\x58\x2a\x2b\x12\xf9\x9f\xa3\x5c\x1c\xa6\x79\x99\x93\x2a\xf3\x7d\x69\x48\x1f\xd0\x9d\xbd\x87\x1e\x12\x96\x62\xa1\x68\x5f\x92\x3c\x94\x71\x69\x53\x95\x50\x91\xe6\x65\x66\x85\x92\xba\x2c\xb2\x2b\x03\x81\x15\xfb\x6b\x43\xa3\x0f\xd2\x2c\x55\x09\x1e\xc8\xa1\x36\x36\xd8\x2d\x92\xb4\x1f\xdc\x2e\x93\x22\xe8\xe9\x90\xfa\xf2\xeb\x8f\xbf\x4a\x07\x22\xd7\x37\x4d\xd9\xde\x11\x85\x0d\x7a\x46\xa8\x22\x13\x56\x9b\x90\xee\x37\x10\xed\x96\x46\xe4\xba\xaf\x69\xf3\x1a\x7f\xab\xbd\x23\x54\x52\x8a\x44\x06\x3d\x29\xf2\x90\xfe\xdd\x43\x7a\x50\x16\x45\x2a\x54\x7b\xf7\x93\xdd\xbb\xc1\xe7\xd2\x14\xa9\x56\x21\x75\x6f\x76\xda\x77\xb4\xb2\x52\xd9\xa0\xf7\x68\x28\x43\xb2\xf2\xd0\x7e\x30\xcc\x44\xaa\x36\x28\x1e\x08\x53\x48\x1b\x3d\xec\xdd\x0b\x3e\x7a\xce\xf3\x7e\x0e\xa4\x09\xee\xaa\x58\xf7\x53\x95\x84\xd4\xde\xcb\x4a\x23\xb2\xe0\x9e\x36\x79\x11\x92\x1a\x36\x6b\x11\x7d\xb8\x41\xcb\x31\x52\xef\x74\x3b\x51\xd4\xa5\x1b\x37\xc8\x8f\x9d\xb7\xa2\x6e\x97\xb6\xa9\x43\x61\xb3\x6f\x45\xeb\x57\xd0\x66\x74\xcb\x8f\xef\x36\xb4\xcd\x6e\x87\x8e\x8e\x96\x27\x5b\xd1\x7a\xe7\x3d\xda\xa6\x2e\x85\xb4\xbe\x01\xfe\xc1\x1d\xbb\x13\x3e\xe5\x9a\x67\xe0\xa7\xee\xc4\x8d\xdd\x63\xe2\x33\xae\x7d\xab\xdc\x31\xcf\xf8\xdc\x8d\xb9\x76\xc7\x5c\x71\xe5\x7f\x9c\xf0\x82\x2f\xb9\xe6\x29\xb9\x71\x83\xff\xee\x46\x5c\xf1\x05\x9f\xf2\x04\xfc\xb3\x1b\xb9\x31\x4f\xf8\x82\x27\x3c\xe5\x39\x57\x3c\xe5\x09\xcf\xdc\x98\xf8\x92\x67\xee\xd8\x7d\xc3\x33\xbe\x70\x63\xae\x78\xbe\x54\x9a\xbb\x27\xe4\x46\xfc\x9b\xd7\x70\xdf\x71\xc5\xe7\x3c\x21\x5e\xb8\x13\x37\x6a\x64\xff\xff\xd1\x05\x4f\xf8\xbc\x31\xe0\xa7\xb9\x7b\xcc\x17\x3e\x0b\xf1\x39\xd7\x9e\xe9\xbe\xe5\x59\x73\x3d\xe5\xda\x7f\x6c\xef\x37\xc8\x32\x91\xfb\xde\x13\x17\x5c\xf3\xa9\x0f\x4e\xbe\x79\x5f\x5c\xf1\xb3\x26\x7b\xed\x75\xfc\x3f\xe2\x46\xee\x09\x71\xcd\x67\xcd\xeb\xd3\x26\xf6\xc2\x07\xf1\x3b\xf8\x27\x37\xe6\x39\x4f\xf8\xcc\x7b\xc7\xdf\x01\x00\x00\xff\xff\xac\x65\xc5\xa7\x06\x04\x00\x00"
-
-func localesRuLc_messagesConfigMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesConfigMo,
-		"locales/ru/LC_MESSAGES/config.mo",
-	)
-}
-
-func localesRuLc_messagesConfigMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesConfigMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/config.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesProfilingMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x4e\x3d\x6e\xd4\x40\x14\xfe\x96\x9f\x02\x17\x14\x14\x54\x14\x8f\x82\x08\x8a\x09\xf6\x92\x02\xcd\xee\xec\x22\x50\x22\x21\x76\xa5\x28\x32\xd4\x8c\xd6\x13\x67\x14\x7b\xc6\xcc\x8c\x11\x48\x29\x12\xee\x40\x4b\xc1\x09\x16\x44\x81\x40\x42\x1c\x61\x7c\x01\xce\x82\xec\x4d\x40\xf9\x8a\xa7\xef\xef\x3d\xbd\x3f\xb7\xae\x7d\x04\x80\xab\x00\xee\x00\xd8\x01\x70\x1d\xc0\x02\x1b\xbc\x06\x70\x03\x80\x3c\xf7\x8f\x01\xcc\x47\xc0\x1b\x00\x37\x01\x7c\x19\x01\xb7\x01\xfc\x1e\x01\xa3\xf3\x3b\x17\xb8\xd2\x8f\x7d\x67\x0f\x75\xa5\x4d\x89\xdc\xc9\x95\xc2\x81\x6a\xac\x0b\x6c\xe9\x4b\x5d\xb0\xa7\x6d\xe9\x59\x6e\x39\x15\xea\xed\x93\x63\x7d\x24\x6b\xbb\xed\xda\x64\x21\x7d\x60\xb9\x93\xc6\x57\x32\x58\xc7\xe9\xc5\x10\xd1\xb2\x75\xb2\xb6\x85\xa5\xe9\xa5\xfe\x2c\x59\x48\x53\xb6\xb2\x54\x2c\x57\xb2\xe6\xf4\x4f\x73\x3a\x68\xbd\xd7\xd2\x24\xcb\xe7\xcb\x5d\xf6\x4a\x39\xaf\xad\xe1\x94\x6d\xa7\xc9\x33\x6b\x82\x32\x81\xe5\xef\x1b\xc5\x29\xa8\x77\xe1\x61\x53\x49\x6d\x26\xb4\x3a\x92\xce\xab\x20\x5e\xe6\x7b\xec\xf1\xff\x5e\xff\xcf\xa1\x72\x6c\xd7\xac\x6c\xa1\x4d\xc9\x29\xd9\xaf\x5a\x27\x2b\xb6\x67\x5d\xed\x39\x99\x66\x90\x5e\x3c\x9a\xd0\x86\x0a\x73\x2f\x4b\x85\xc8\x68\x6b\x8b\x7a\x9a\xde\x15\x59\x46\x73\x4a\x89\x0f\x7a\x26\xc6\x17\xd1\x54\xec\xf4\xf4\xfe\x50\x9b\x66\x29\x9d\x9c\x6c\x56\x66\x62\x9c\x3e\xa0\x39\x65\xc4\x69\x3c\x41\xfc\xd4\x7d\x88\x3f\xe3\x3a\x7e\x8b\x3f\xe2\x1a\xf1\x73\x77\x1a\xd7\xdd\x59\x77\x16\xbf\x77\xa7\xf1\x57\xfc\x3a\xd8\x7f\x03\x00\x00\xff\xff\x49\x5e\xfe\x21\xd7\x01\x00\x00"
-
-func localesRuLc_messagesProfilingMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesProfilingMo,
-		"locales/ru/LC_MESSAGES/profiling.mo",
-	)
-}
-
-func localesRuLc_messagesProfilingMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesProfilingMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/profiling.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _localesRuLc_messagesTraceMo = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4d\x8c\x5b\x57\x15\xfe\x32\x9e\x8e\x1d\x93\x42\x29\x2d\xe5\xb7\x1c\x10\x0d\x04\xd5\xe9\x38\x20\x81\x9c\x38\xd3\x34\x69\x22\xa0\x03\x21\x33\x81\x25\x7a\x63\xdf\xb1\x9f\x62\xbf\x6b\xde\x4f\x9a\xa9\xba\x18\x7b\x48\xaa\x6a\x4a\x87\x40\x10\xbb\x86\x88\x45\x77\xc8\x33\x63\x13\xcf\x8f\x9d\x0d\x1b\x76\xe7\x0a\x89\x0d\x12\x20\x16\x48\x2c\x80\x15\x62\x05\x42\xe7\xde\x37\x3f\x2e\xa1\xb0\xe0\x2d\x7c\xef\xb9\xf7\x9c\xf3\x7d\xe7\x3b\xe7\xfa\xb7\x8f\x4f\xfe\x08\x00\x3e\x01\xe0\x63\x00\x9e\x38\x02\x9c\x00\x70\x6c\x02\xf6\xeb\x4e\x00\x59\x00\xeb\x13\xc0\x33\x00\xb6\x26\x80\xa7\x00\xfc\x6e\x02\x98\x02\xf0\xf7\x09\x20\x07\xe0\x9f\x13\xc0\x23\x00\xb2\x19\xe0\x31\x00\x8f\x66\x80\x3c\x80\xa7\x33\xee\xfe\x44\xba\x9e\x4a\xcf\x4f\x67\x80\x47\x01\x5c\x4c\xed\xf9\xd4\xfe\x76\x6a\x37\xd3\xf5\x46\x06\x38\x06\x60\x25\x03\x7c\x06\xc0\xf7\x33\xc0\xa7\x00\x0c\x32\xc0\x24\x80\xdf\x64\x1c\xee\xef\x33\x8e\xe7\x1f\x33\x8e\xd7\x5f\x52\xfb\x6f\x19\xe0\x38\x80\x7f\x64\x80\x67\x01\x7c\x7a\x12\x98\x01\xf0\x8d\x49\xe0\x8b\x00\x6e\x4f\xba\xda\x7f\x39\xe9\xf2\xfc\x49\xee\x8f\x00\x7f\x9d\x74\x75\x3c\x37\x05\x9c\x03\xf0\x7c\xba\x76\xa6\x80\xf7\x02\xf8\x45\xba\xfe\x6a\xca\xf1\xfc\xc3\x94\xd3\xed\xcf\x53\xc0\xe3\x00\x9e\xca\x3a\x9d\x3e\x97\x75\x79\x5e\xc8\x02\x4f\x02\x98\xcb\xba\x3a\xfc\xd4\xbe\x9d\x75\xba\xbf\x9d\x05\xde\x2f\x79\xd3\xb8\x5f\x67\x81\x8f\x4b\x1d\x59\x87\x7b\x2c\x07\x14\x01\x7c\x2d\xe7\x74\x5c\xce\x39\xdc\xd7\xd3\xf5\x4e\xce\xf1\xb9\x9b\x73\x7a\xfd\x2c\x07\x7c\x05\xc0\x4e\x0e\x58\x00\x30\x75\x14\xf8\x9e\xe0\x1e\x05\xbe\x23\x7d\x3b\x0a\x94\xc5\xce\x3b\xbd\xde\xce\x03\x47\x30\xfe\x7d\xe0\xd0\xfe\x28\x9c\xde\x82\xf1\xe1\x43\xe7\xef\x01\xf0\x21\x38\xee\xc2\x3b\x03\xc7\xef\x09\xb8\x7e\xbe\x0f\xae\x0f\x52\x8b\xf0\x14\xac\xa7\x01\x7c\x10\x4e\x27\xe1\x2a\xa3\xf6\x58\x9a\x4f\xb4\x78\x24\x9d\xc7\x8f\xa6\x67\x4f\xa6\xeb\x47\xe4\xe7\x5c\x25\xf6\x75\x10\xe1\xfc\xe5\xab\xd4\x0a\xf5\xa2\xdf\xf0\x83\x1a\x2d\xea\x90\xe2\xba\xa2\x4a\x12\x86\x2a\x88\xe5\xa6\xa2\xa2\x08\xe7\x75\xb0\xe8\x87\x4d\x0a\x55\x53\x5f\x57\xe4\x35\x1a\x54\x4d\x9a\x2d\x5c\x50\x0d\x15\x2b\x5c\xd0\x2f\x07\x0d\xed\x55\x71\x21\x69\xb6\x22\x5c\xf4\x03\x3f\xaa\x1f\xa4\xc5\x25\x15\xa8\xd0\x13\x40\x5c\xd2\x14\x87\x5e\x45\xe1\xb2\xbd\x55\x11\xae\xec\xe7\xc4\x95\x24\x38\x14\x35\xa7\x1a\xaa\x12\xdb\x8b\x39\xff\x15\x45\x7e\x40\x0b\x4b\xb1\x8a\x30\x17\x7b\x61\xac\xaa\xe4\xc5\x98\x8b\x75\xab\xe5\xb6\x57\x83\xe8\x20\xe0\x5b\x7e\xa3\x41\x0b\xea\x9d\x84\xa3\x93\x74\x2e\x54\xb4\xa4\x13\x8a\x92\x50\xcd\xc0\xa3\xc8\x6b\xb6\x6c\xed\x7a\xd1\xba\xd5\x95\xd7\x92\x8d\xae\x78\x4e\xa1\xaa\xb7\x14\xa1\xae\x93\x30\x42\xd3\x0f\x12\x21\xd0\xd4\x41\x5c\x8f\x10\xa9\x8a\x0e\xaa\x11\xa2\xd8\xab\x5c\x73\x65\x45\x7b\x69\xf6\x14\xac\xe9\x50\x27\xb1\x1f\xa8\x7f\x77\xab\xeb\x46\x55\x85\x76\x5b\xd1\x41\xac\x82\xaa\xaa\x52\x33\x89\xd5\x8d\x77\x3a\xc7\x75\x2f\xa6\x86\xaa\x52\xac\x69\xa1\xa1\x2b\xd7\x2c\xdf\x80\xa2\xa5\xa0\x52\x0f\x75\xe0\xbf\x62\xb9\x52\x2b\xf4\x9b\x7e\xec\x5f\x7f\xb7\x78\xdb\xde\x50\xb9\x00\xbd\x48\x81\x7a\x99\xbe\x3e\x47\x71\x3d\x54\x5e\x35\x82\x44\xfc\x87\x49\xa8\x85\x5e\x13\x4b\xca\x0b\xa5\x67\x2d\x1d\xc6\x85\xd9\xa8\xe6\x57\x0b\x2f\x24\xb5\xa8\x30\xaf\x4b\x54\x55\xd7\x9f\xbf\xe6\xd7\xbd\xa6\x3e\x19\x26\xf9\x97\xbc\x28\x2e\xcc\x87\x5e\x10\x35\xbc\x58\x87\x25\xfa\xaa\xbd\xa2\xd9\x24\xf4\x9a\xba\xaa\xe9\xcc\x98\xff\xd9\xfc\x4b\x5e\x50\x4b\xbc\x9a\x2a\xcc\x2b\xaf\x59\xa2\x7d\xbb\x44\x57\x92\x28\xf2\xbd\x20\x3f\xfb\xe5\xd9\x17\x0b\xdf\x54\x61\xe4\xeb\xa0\x44\xc5\x93\xd3\xf9\xf3\x56\xb8\xb8\x30\xbf\xd4\x52\x25\x8a\xd5\x8d\xf8\xb9\x56\xc3\xf3\x83\xd3\x54\xa9\x7b\x61\xa4\xe2\xf2\xd5\xf9\x8b\x85\x2f\x1d\xf8\x09\x9f\x45\x15\x16\x5e\x0c\x2a\xba\xea\x07\xb5\x12\xe5\x2f\x37\x92\xd0\x6b\x14\x2e\xea\xb0\x19\x95\x28\x68\x59\x33\x2a\x7f\xfe\x34\xb9\x6d\x39\x78\xa6\x38\x5d\x2e\x17\xe9\xf8\x71\x92\xed\xf4\x27\xcb\xc5\x22\xcd\xd0\x34\x95\xac\x7d\xb6\x7c\x6a\xef\xea\x4c\xf9\x0b\xb2\xfd\xac\x75\x3b\x53\x9c\xa6\x57\x5f\x75\x21\x67\xcb\xa7\xa6\x4f\xd0\x0c\x15\xa9\x44\xa7\x4e\x83\xef\x70\x9f\xb7\x4c\xdb\x74\x78\x83\x07\x66\xcd\x3e\x43\x7e\x60\x96\x79\x64\xbe\xcb\x5d\xde\xe2\x1d\x1e\xf0\x90\x37\x89\x7b\xbc\x63\xd6\xc8\x74\xb8\xcf\xdb\x66\xc5\xbc\xce\x7d\xde\xe4\xd1\x9e\xf3\x2d\xee\x9b\xb6\x69\x73\x17\x7c\x97\x47\xdc\xb3\x09\xfb\x66\x99\x7f\xce\x3d\xee\xf3\x90\x07\xdc\x27\xb3\xc2\x3d\xee\xf2\x8e\x3b\x30\x6b\xc4\x1b\xa6\xcd\x7d\x73\x93\xec\xf9\x2e\x3f\xe0\x11\x6f\x80\x7f\x9a\xba\x0d\x4c\xc7\xbc\x01\xbe\xc7\xdb\xdc\x35\xaf\x71\xd7\x99\x77\x9c\xab\x59\x05\xbf\x65\x99\x77\x79\x28\x71\xce\xfd\xa1\xec\xc1\x3f\xb4\x98\x42\xa8\x6b\x6e\xd9\x4a\x2f\x69\x32\x1d\x6b\xb7\x79\x20\x21\xbc\x21\x38\xe0\xbb\x87\xe3\x05\x65\x8c\x4e\xca\x19\xfc\x63\xee\xf2\x03\xb3\x62\x19\xbc\x1b\xf2\x0f\xcc\x2a\xaf\x5b\x9c\xc3\xd1\x3f\xe1\x2e\xdf\xe7\x5d\x61\x44\xbc\x41\xbc\x2e\x41\x52\x8b\xb9\x79\x90\x5a\x34\x1e\x92\x28\x32\x5e\xe8\xce\xfe\xf9\x3d\x1e\x9a\xb5\xbd\xc4\xab\x56\xea\x9d\x3d\xb9\xc1\xb7\x45\x70\xb3\x62\x3a\x63\xca\x9b\xd5\x94\xc5\xbe\xea\x66\xf5\x24\x09\x4f\x71\x73\x5d\xb3\x6e\x33\xb0\x49\xd7\x79\x64\x96\x45\x9a\x43\xed\x92\x54\x23\xdb\x96\x5b\x3c\xe0\x2d\xb2\x23\xf1\x1a\x0f\xc0\x3d\x91\x99\xb7\x60\xfb\xd5\x76\xfd\xdc\x15\x2d\x84\x07\x6c\xc5\x6d\xb3\x26\xf3\xc2\x1b\x90\x74\x12\xca\x43\xee\x61\xaf\x1b\x63\xfd\x18\x90\xad\xbc\x3f\x8e\x7f\x68\x0a\x07\x42\x67\x93\x47\x66\xc5\x2c\x0b\x84\x40\xfd\x2f\xa9\x7a\xe9\x74\x76\xed\x91\x08\xb3\x45\x36\x7e\x7c\x6c\x87\x66\x55\x00\x76\xcd\x1b\xe6\xcd\x14\xd5\x15\xf5\x5f\x21\x9e\x25\xde\xe6\x91\xe9\x88\x7a\x66\x55\xd4\x96\xf1\x18\x48\x7e\x19\x0e\xe2\x6d\xe9\xba\x93\xf1\x20\x43\x9f\x78\x28\xf4\x9c\xef\xae\x4c\x96\x8d\xe9\x4a\xd5\x6d\xab\xe3\x4d\xeb\x2c\x2d\xbe\x9f\xea\x3f\xf8\xbf\xb0\xb1\x85\xdd\xb7\x33\x21\xaf\xf3\x4d\x72\xd3\x66\x05\xb0\xf9\xfb\xdc\x93\x03\xe2\xb7\xf8\x1e\x1e\xf6\x76\x1e\xfa\x27\xb1\x95\xbe\x0b\xde\x94\x00\xde\xe5\x5d\x79\x53\x3b\xdc\x37\x1d\xfc\x2b\x00\x00\xff\xff\xcc\x7e\x62\xa0\xae\x0a\x00\x00"
-
-func localesRuLc_messagesTraceMoBytes() ([]byte, error) {
-	return bindataRead(
-		_localesRuLc_messagesTraceMo,
-		"locales/ru/LC_MESSAGES/trace.mo",
-	)
-}
-
-func localesRuLc_messagesTraceMo() (*asset, error) {
-	bytes, err := localesRuLc_messagesTraceMoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "locales/ru/LC_MESSAGES/trace.mo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"templates/views/trace.html": templatesViewsTraceHtml,
-	"assets/js/trace.min.js": assetsJsTraceMinJs,
-	"assets/vendors/flipclock/css/flipclock.min.css": assetsVendorsFlipclockCssFlipclockMinCss,
-	"assets/vendors/flipclock/js/flipclock.min.js": assetsVendorsFlipclockJsFlipclockMinJs,
-	"locales/ru/LC_MESSAGES/config.mo": localesRuLc_messagesConfigMo,
-	"locales/ru/LC_MESSAGES/profiling.mo": localesRuLc_messagesProfilingMo,
-	"locales/ru/LC_MESSAGES/trace.mo": localesRuLc_messagesTraceMo,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"assets": &bintree{nil, map[string]*bintree{
-		"js": &bintree{nil, map[string]*bintree{
-			"trace.min.js": &bintree{assetsJsTraceMinJs, map[string]*bintree{}},
-		}},
-		"vendors": &bintree{nil, map[string]*bintree{
-			"flipclock": &bintree{nil, map[string]*bintree{
-				"css": &bintree{nil, map[string]*bintree{
-					"flipclock.min.css": &bintree{assetsVendorsFlipclockCssFlipclockMinCss, map[string]*bintree{}},
-				}},
-				"js": &bintree{nil, map[string]*bintree{
-					"flipclock.min.js": &bintree{assetsVendorsFlipclockJsFlipclockMinJs, map[string]*bintree{}},
-				}},
-			}},
-		}},
-	}},
-	"locales": &bintree{nil, map[string]*bintree{
-		"ru": &bintree{nil, map[string]*bintree{
-			"LC_MESSAGES": &bintree{nil, map[string]*bintree{
-				"config.mo": &bintree{localesRuLc_messagesConfigMo, map[string]*bintree{}},
-				"profiling.mo": &bintree{localesRuLc_messagesProfilingMo, map[string]*bintree{}},
-				"trace.mo": &bintree{localesRuLc_messagesTraceMo, map[string]*bintree{}},
-			}},
-		}},
-	}},
-	"templates": &bintree{nil, map[string]*bintree{
-		"views": &bintree{nil, map[string]*bintree{
-			"trace.html": &bintree{templatesViewsTraceHtml, map[string]*bintree{}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
-
-func assetFS() *assetfs.AssetFS {
-	assetInfo := func(path string) (os.FileInfo, error) {
-		return os.Stat(path)
-	}
-	for k := range _bintree.Children {
-		return &assetfs.AssetFS{Asset: Asset, AssetDir: AssetDir, AssetInfo: assetInfo, Prefix: k}
-	}
-	panic("unreachable")
-}
@@ -0,0 +1,13 @@
+package internal
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed locales/*/LC_MESSAGES/*.mo
+var embedFS embed.FS
+
+func (c *Component) FS() fs.FS {
+	return embedFS
+}
@@ -1,6 +1,9 @@
 package workers
 
 import (
+	"context"
+	"time"
+
 	ws "github.com/mrsmtvd/go-workers"
 	"github.com/mrsmtvd/shadow"
 )
@@ -17,10 +20,30 @@ type Component interface {
 	GetWorkers() []ws.Worker
 
 	AddTask(ws.Task)
+	AddTaskToQueue(queue string, task ws.Task) error
+	AddCronTask(task ws.Task, expression string, jitter time.Duration) (ws.Task, error)
 	RemoveTask(ws.Task)
 	GetTaskMetadata(string) ws.Metadata
 	GetTasks() []ws.Task
 
+	Queues() []Queue
+	GetTaskQueue(id string) string
+	GetWorkerQueue(id string) string
+
+	DeadLetters() []DeadLetter
+	RequeueDeadLetter(id string) error
+	DiscardDeadLetter(id string) error
+
+	RegisterTaskHandler(name string, handler TaskHandler)
+	AddStoredTask(record *TaskRecord) (ws.Task, error)
+	TaskStore() TaskStore
+
+	Locker() Locker
+	LockOwner() string
+
+	HistoryStore() HistoryStore
+	TaskHistory(ctx context.Context, taskID, status string) ([]*TaskRun, error)
+
 	AddListener(ListenerWithEvents)
 	AddListenerByEvent(ws.Event, ws.Listener)
 	AddListenerByEvents([]ws.Event, ws.Listener)
@@ -4,4 +4,11 @@ const (
 	ConfigWorkersCount               = ComponentName + ".workers.count"
 	ConfigTickerExecuteTasksDuration = ComponentName + ".ticker-execute-tasks-duration"
 	ConfigListenersLoggingEnabled    = ComponentName + ".listeners.logging-enabled"
+	ConfigStorage                    = ComponentName + ".storage"
+	ConfigLocksStorage               = ComponentName + ".locks.storage"
+	ConfigLocksTTL                   = ComponentName + ".locks.ttl"
+	ConfigDeadLettersLimit           = ComponentName + ".dead-letters.limit"
+	ConfigQueues                     = ComponentName + ".queues"
+	ConfigHistoryStorage             = ComponentName + ".history.storage"
+	ConfigHistoryLimit               = ComponentName + ".history.limit"
 )
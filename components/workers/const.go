@@ -1,6 +1,23 @@
 package workers
 
+import (
+	"time"
+)
+
 const (
 	ComponentName    = "workers"
 	ComponentVersion = "3.1.0"
 )
+
+const DefaultLockTTL = time.Second * 30
+
+const (
+	StorageMemory   = "memory"
+	StorageDatabase = "database"
+)
+
+const (
+	EventsTopicListeners = ComponentName + ".listeners"
+	EventsTopicWorkers   = ComponentName + ".workers"
+	EventsTopicTasks     = ComponentName + ".tasks"
+)
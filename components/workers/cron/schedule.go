@@ -0,0 +1,362 @@
+package cron
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ограничение поиска следующего запуска для расписаний, которые никогда не срабатывают, например 30 февраля
+const searchYearsLimit = 5
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	boundsSeconds = bounds{0, 59, nil}
+	boundsMinutes = bounds{0, 59, nil}
+	boundsHours   = bounds{0, 23, nil}
+	boundsDom     = bounds{1, 31, nil}
+	boundsMonths  = bounds{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 допускается как синоним воскресенья
+	boundsDow = bounds{0, 7, map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Schedule расписание в формате cron. Поддерживаются выражения из 5 полей (минуты, часы, день месяца,
+// месяц, день недели) и из 6 полей с секундами в начале, дескрипторы @daily, @hourly и т.д., а так же @every <duration>
+type Schedule struct {
+	expression string
+	location   *time.Location
+	every      time.Duration
+
+	second, minute, hour, dom, month, dow uint64
+	domStar, dowStar                      bool
+}
+
+// Parse разбирает выражение в локальной временной зоне, зона может быть переопределена префиксом CRON_TZ=<zone>
+func Parse(expression string) (*Schedule, error) {
+	return ParseInLocation(expression, time.Local)
+}
+
+func ParseInLocation(expression string, location *time.Location) (*Schedule, error) {
+	s := &Schedule{
+		expression: strings.TrimSpace(expression),
+		location:   location,
+	}
+
+	if s.location == nil {
+		s.location = time.Local
+	}
+
+	spec := s.expression
+
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexAny(spec, " \t")
+		if i == -1 {
+			return nil, errors.New("cron expression is empty")
+		}
+
+		zone := spec[strings.Index(spec, "=")+1 : i]
+
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return nil, errors.New("cron time zone " + zone + " is wrong: " + err.Error())
+		}
+
+		s.location = loc
+		spec = strings.TrimSpace(spec[i:])
+	}
+
+	if strings.HasPrefix(spec, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(spec[len("@every "):]))
+		if err != nil {
+			return nil, errors.New("cron interval is wrong: " + err.Error())
+		}
+
+		if every < time.Second {
+			return nil, errors.New("cron interval must be at least one second")
+		}
+
+		s.every = every.Truncate(time.Second)
+
+		return s, nil
+	}
+
+	if descriptor, ok := descriptors[spec]; ok {
+		spec = descriptor
+	} else if strings.HasPrefix(spec, "@") {
+		return nil, errors.New("unknown cron descriptor " + spec)
+	}
+
+	fields := strings.Fields(spec)
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, errors.New("cron expression must contain 5 or 6 fields, got " + strconv.Itoa(len(fields)))
+	}
+
+	var err error
+
+	if s.second, err = parseField(fields[0], boundsSeconds); err != nil {
+		return nil, err
+	}
+
+	if s.minute, err = parseField(fields[1], boundsMinutes); err != nil {
+		return nil, err
+	}
+
+	if s.hour, err = parseField(fields[2], boundsHours); err != nil {
+		return nil, err
+	}
+
+	if s.dom, err = parseField(fields[3], boundsDom); err != nil {
+		return nil, err
+	}
+
+	if s.month, err = parseField(fields[4], boundsMonths); err != nil {
+		return nil, err
+	}
+
+	if s.dow, err = parseField(fields[5], boundsDow); err != nil {
+		return nil, err
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+
+	s.domStar = fields[3] == "*" || fields[3] == "?"
+	s.dowStar = fields[5] == "*" || fields[5] == "?"
+
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+
+	for _, expression := range strings.Split(field, ",") {
+		bit, err := parseRange(expression, b)
+		if err != nil {
+			return 0, errors.New("cron field " + field + " is wrong: " + err.Error())
+		}
+
+		bits |= bit
+	}
+
+	return bits, nil
+}
+
+func parseRange(expression string, b bounds) (bits uint64, err error) {
+	rangeAndStep := strings.Split(expression, "/")
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	single := false
+
+	var start, end, step uint
+
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		if len(lowAndHigh) > 1 {
+			return 0, errors.New("range of " + expression + " is wrong")
+		}
+
+		start, end = b.min, b.max
+	} else {
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+
+		switch len(lowAndHigh) {
+		case 1:
+			end = start
+			single = true
+		case 2:
+			if end, err = parseValue(lowAndHigh[1], b); err != nil {
+				return 0, err
+			}
+		default:
+			return 0, errors.New("range of " + expression + " is wrong")
+		}
+	}
+
+	switch len(rangeAndStep) {
+	case 1:
+		step = 1
+	case 2:
+		if step, err = parseValue(rangeAndStep[1], bounds{}); err != nil {
+			return 0, err
+		}
+
+		if step == 0 {
+			return 0, errors.New("step of " + expression + " must be positive")
+		}
+
+		// N/step означает N-max/step
+		if single {
+			end = b.max
+		}
+	default:
+		return 0, errors.New("step of " + expression + " is wrong")
+	}
+
+	if start < b.min || end > b.max || start > end {
+		return 0, errors.New(expression + " is out of range " + strconv.Itoa(int(b.min)) + "-" + strconv.Itoa(int(b.max)))
+	}
+
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (uint, error) {
+	if n, ok := b.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, errors.New("value " + value + " is wrong")
+	}
+
+	return uint(n), nil
+}
+
+func (s *Schedule) String() string {
+	return s.expression
+}
+
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
+
+// Next возвращает ближайшее время срабатывания строго после t во временной зоне t.
+// Если расписание не срабатывает в обозримом будущем, то возвращается нулевое время
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Add(s.every - time.Duration(t.Nanosecond()))
+	}
+
+	origin := t.Location()
+	loc := s.location
+
+	t = t.In(loc)
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+
+	// после сдвига на следующую единицу младшие разряды обнуляются только один раз
+	added := false
+	yearLimit := t.Year() + searchYearsLimit
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.month == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+
+		t = t.AddDate(0, 1, 0)
+
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+
+		t = t.AddDate(0, 0, 1)
+
+		// при переходе на летнее время полночь может не существовать
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+
+		t = t.Add(time.Hour)
+
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+
+		t = t.Add(time.Minute)
+
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.second == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+
+		t = t.Add(time.Second)
+
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t.In(origin)
+}
+
+// день совпадает по правилам cron: если ограничены и день месяца и день недели, то достаточно совпадения любого из них
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.dom != 0
+	dowMatch := 1<<uint(t.Weekday())&s.dow != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
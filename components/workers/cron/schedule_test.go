@@ -0,0 +1,255 @@
+package cron
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loc
+}
+
+func TestParse_WrongExpression_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"1-2-3 * * * *",
+		"*-5 * * * *",
+		"foo * * * *",
+		"@fortnightly",
+		"@every 500ms",
+		"@every forever",
+		"CRON_TZ=Mars/Olympus 0 * * * *",
+		"CRON_TZ=UTC",
+	} {
+		_, err := Parse(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	t.Parallel()
+
+	utc := time.UTC
+	moscow := mustLocation(t, "Europe/Moscow")
+
+	cases := []struct {
+		name       string
+		expression string
+		from       time.Time
+		want       time.Time
+	}{
+		{
+			name:       "every minute",
+			expression: "* * * * *",
+			from:       time.Date(2021, 1, 1, 10, 15, 30, 0, utc),
+			want:       time.Date(2021, 1, 1, 10, 16, 0, 0, utc),
+		},
+		{
+			name:       "strictly after from",
+			expression: "15 10 * * *",
+			from:       time.Date(2021, 1, 1, 10, 15, 0, 0, utc),
+			want:       time.Date(2021, 1, 2, 10, 15, 0, 0, utc),
+		},
+		{
+			name:       "with seconds",
+			expression: "*/20 * * * * *",
+			from:       time.Date(2021, 1, 1, 10, 15, 21, 500, utc),
+			want:       time.Date(2021, 1, 1, 10, 15, 40, 0, utc),
+		},
+		{
+			name:       "ranges and lists",
+			expression: "0 9-17/4 * * mon,fri",
+			from:       time.Date(2021, 1, 4, 17, 0, 0, 0, utc), // понедельник
+			want:       time.Date(2021, 1, 8, 9, 0, 0, 0, utc),
+		},
+		{
+			name:       "month names",
+			expression: "0 0 1 feb *",
+			from:       time.Date(2021, 3, 1, 0, 0, 0, 0, utc),
+			want:       time.Date(2022, 2, 1, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "dom and dow are OR",
+			expression: "0 0 13 * 5",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc), // пятница
+			want:       time.Date(2021, 1, 8, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "dom and dow are OR, dom first",
+			expression: "0 0 13 * 5",
+			from:       time.Date(2021, 1, 9, 0, 0, 0, 0, utc),
+			want:       time.Date(2021, 1, 13, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "dom with star dow is AND",
+			expression: "0 0 13 * *",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc),
+			want:       time.Date(2021, 1, 13, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "7 is sunday",
+			expression: "0 0 * * 7",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc),
+			want:       time.Date(2021, 1, 3, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "0 is sunday",
+			expression: "0 0 * * 0",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc),
+			want:       time.Date(2021, 1, 3, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "descriptor",
+			expression: "@monthly",
+			from:       time.Date(2021, 1, 15, 0, 0, 0, 0, utc),
+			want:       time.Date(2021, 2, 1, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc),
+			want:       time.Date(2024, 2, 29, 0, 0, 0, 0, utc),
+		},
+		{
+			name:       "never fires",
+			expression: "0 0 30 2 *",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, utc),
+			want:       time.Time{},
+		},
+		{
+			name:       "every",
+			expression: "@every 90s",
+			from:       time.Date(2021, 1, 1, 10, 0, 0, 250, utc),
+			want:       time.Date(2021, 1, 1, 10, 1, 30, 0, utc),
+		},
+		{
+			name:       "CRON_TZ",
+			expression: "CRON_TZ=Europe/Moscow 0 9 * * *",
+			from:       time.Date(2021, 1, 1, 7, 0, 0, 0, utc),
+			want:       time.Date(2021, 1, 2, 6, 0, 0, 0, utc),
+		},
+		{
+			name:       "result in zone of from",
+			expression: "CRON_TZ=UTC 0 9 * * *",
+			from:       time.Date(2021, 1, 1, 0, 0, 0, 0, moscow),
+			want:       time.Date(2021, 1, 1, 12, 0, 0, 0, moscow),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+
+			s, err := ParseInLocation(c.expression, utc)
+			a.NoError(err)
+
+			next := s.Next(c.from)
+			a.True(c.want.Equal(next), "want %s, got %s", c.want, next)
+
+			if !next.IsZero() {
+				a.Equal(c.from.Location(), next.Location())
+			}
+		})
+	}
+}
+
+func TestSchedule_NextAcrossDST(t *testing.T) {
+	t.Parallel()
+
+	ny := mustLocation(t, "America/New_York")
+
+	cases := []struct {
+		name       string
+		expression string
+		from       time.Time
+		want       time.Time
+	}{
+		{
+			// 14 марта 2021 после 01:59:59 EST наступает 03:00:00 EDT
+			name:       "hourly skips missing hour",
+			expression: "0 * * * *",
+			from:       time.Date(2021, 3, 14, 1, 0, 0, 0, ny),
+			want:       time.Date(2021, 3, 14, 3, 0, 0, 0, ny),
+		},
+		{
+			name:       "missing time is skipped",
+			expression: "30 2 * * *",
+			from:       time.Date(2021, 3, 14, 0, 0, 0, 0, ny),
+			want:       time.Date(2021, 3, 15, 2, 30, 0, 0, ny),
+		},
+		{
+			name:       "daily after spring forward",
+			expression: "0 9 * * *",
+			from:       time.Date(2021, 3, 13, 10, 0, 0, 0, ny),
+			want:       time.Date(2021, 3, 14, 9, 0, 0, 0, ny),
+		},
+		{
+			// 7 ноября 2021 01:00-01:59 повторяется, первое срабатывание по EDT
+			name:       "repeated time fires first occurrence",
+			expression: "30 1 * * *",
+			from:       time.Date(2021, 11, 7, 0, 0, 0, 0, ny),
+			want:       time.Date(2021, 11, 7, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "daily after fall back",
+			expression: "0 9 * * *",
+			from:       time.Date(2021, 11, 6, 10, 0, 0, 0, ny),
+			want:       time.Date(2021, 11, 7, 9, 0, 0, 0, ny),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := ParseInLocation(c.expression, ny)
+			assert.NoError(t, err)
+
+			next := s.Next(c.from)
+			assert.True(t, c.want.Equal(next), "want %s, got %s", c.want, next)
+		})
+	}
+}
+
+func TestParse_WithoutZone_UsesLocal(t *testing.T) {
+	t.Parallel()
+
+	s, err := Parse("0 * * * *")
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Local, s.Location())
+	assert.Equal(t, "0 * * * *", s.String())
+}
+
+func TestParse_CronTZ_OverridesLocation(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParseInLocation("CRON_TZ=Europe/Moscow 0 * * * *", time.UTC)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Moscow", s.Location().String())
+}
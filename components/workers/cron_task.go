@@ -0,0 +1,111 @@
+package workers
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/workers/cron"
+)
+
+// HasSchedule реализуют задачи, которые запускаются по расписанию cron
+type HasSchedule interface {
+	Schedule() *cron.Schedule
+	NextRunAt() *time.Time
+}
+
+// CronTask запускает задачу по расписанию cron вместо интервала повторения. Диспетчер после
+// каждого запуска откладывает задачу на RepeatInterval, поэтому интервал вычисляется до следующего
+// срабатывания расписания со случайной задержкой в пределах jitter
+type CronTask struct {
+	ws.Task
+
+	mutex     sync.RWMutex
+	schedule  *cron.Schedule
+	jitter    time.Duration
+	nextRunAt time.Time
+}
+
+func NewCronTask(task ws.Task, schedule *cron.Schedule, jitter time.Duration) *CronTask {
+	t := &CronTask{
+		Task:     task,
+		schedule: schedule,
+		jitter:   jitter,
+	}
+
+	t.nextRunAt = NextScheduleTime(schedule, time.Now(), jitter)
+
+	return t
+}
+
+// NextScheduleTime возвращает время срабатывания расписания после t со случайной задержкой не больше jitter
+func NextScheduleTime(schedule *cron.Schedule, t time.Time, jitter time.Duration) time.Time {
+	next := schedule.Next(t)
+
+	if !next.IsZero() && jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(jitter))))
+	}
+
+	return next
+}
+
+func (t *CronTask) Run(ctx context.Context) (interface{}, error) {
+	defer func() {
+		next := NextScheduleTime(t.schedule, time.Now(), t.jitter)
+
+		t.mutex.Lock()
+		t.nextRunAt = next
+		t.mutex.Unlock()
+	}()
+
+	return t.Task.Run(ctx)
+}
+
+// расписание без будущих срабатываний завершает задачу
+func (t *CronTask) Repeats() int64 {
+	if t.NextRunAt() == nil {
+		return 0
+	}
+
+	return -1
+}
+
+func (t *CronTask) RepeatInterval() time.Duration {
+	next := t.NextRunAt()
+	if next == nil {
+		return 0
+	}
+
+	if interval := time.Until(*next); interval > 0 {
+		return interval
+	}
+
+	return 0
+}
+
+func (t *CronTask) StartedAt() *time.Time {
+	return t.NextRunAt()
+}
+
+func (t *CronTask) Unwrap() ws.Task {
+	return t.Task
+}
+
+func (t *CronTask) Schedule() *cron.Schedule {
+	return t.schedule
+}
+
+func (t *CronTask) NextRunAt() *time.Time {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.nextRunAt.IsZero() {
+		return nil
+	}
+
+	next := t.nextRunAt
+
+	return &next
+}
@@ -0,0 +1,58 @@
+package workers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	RunStatusSuccess = "success"
+	RunStatusFail    = "fail"
+	RunStatusTimeout = "timeout"
+	RunStatusCancel  = "cancel"
+)
+
+// максимальная длина результата запуска в истории, остаток отбрасывается
+const RunResultMaxLength = 1024
+
+// TaskRun запись истории об одном запуске задачи
+type TaskRun struct {
+	ID        string        `db:"id"`
+	TaskID    string        `db:"task_id"`
+	TaskName  string        `db:"task_name"`
+	WorkerID  string        `db:"worker_id"`
+	Status    string        `db:"status"`
+	StartedAt time.Time     `db:"started_at"`
+	Duration  time.Duration `db:"duration"`
+	Error     string        `db:"error"`
+	Result    string        `db:"result"`
+}
+
+func NewTaskRun(taskID, taskName string) *TaskRun {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return &TaskRun{
+		ID:        hex.EncodeToString(id),
+		TaskID:    taskID,
+		TaskName:  taskName,
+		StartedAt: time.Now(),
+	}
+}
+
+// FinishedAt возвращает время завершения запуска
+func (r *TaskRun) FinishedAt() time.Time {
+	return r.StartedAt.Add(r.Duration)
+}
+
+// HistoryStore хранит историю запусков задач. Записи возвращаются от новых к старым
+type HistoryStore interface {
+	Add(ctx context.Context, run *TaskRun) error
+	// List возвращает историю задачи, пустой статус не ограничивает выборку
+	List(ctx context.Context, taskID, status string) ([]*TaskRun, error)
+	// Truncate оставляет не больше limit последних записей задачи
+	Truncate(ctx context.Context, taskID string, limit int) error
+	Delete(ctx context.Context, taskID string) error
+}
@@ -1,4 +1,16 @@
 $(document).ready(function () {
+    var leaseToString = function (task) {
+        if (!task.singleton) {
+            return '';
+        }
+
+        if (!task.lock_owner) {
+            return 'Free';
+        }
+
+        return task.lock_owner + ' (' + dateToString(task.lock_expires_at) + ')';
+    };
+
     $('#workers-show').click(function () {
         $('#workers .task-show:has(i.fa-eye)').click();
     });
@@ -85,6 +97,31 @@ $(document).ready(function () {
             order: [[ 1, 'asc' ], [ 2, 'asc' ]]
         });
 
+    var tableQueues = $('#queues table')
+        .DataTable({
+            stateSave: true,
+            stateDuration: 0,
+            language: {
+                url: '/dashboard/datatables/i18n.json?locale=' + window.shadowLocale
+            },
+            ajax: {
+                url: '/workers/?action=stats&entity=queues',
+                dataSrc: 'data'
+            },
+            columns: [
+                {
+                    data: 'name',
+                    render: function (name, type, row) {
+                        return row.default ? name + ' <span class="label label-default">default</span>' : name;
+                    }
+                },
+                { data: 'priority' },
+                { data: 'workers' },
+                { data: 'tasks' }
+            ],
+            order: [[ 1, 'asc' ], [ 0, 'asc' ]]
+        });
+
     var tableWorkers = $('#workers table')
         .on('draw.dt', function (e, settings) {
             if (settings.json) {
@@ -110,6 +147,7 @@ $(document).ready(function () {
                     }
                 },
                 { data: 'status' },
+                { data: 'queue' },
                 {
                     data: 'locked',
                     render: function (flag) {
@@ -157,16 +195,20 @@ $(document).ready(function () {
                             '<td>' +
                                 '<ul class="list-group">' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.id + '</em></span><strong>ID</strong><br /></li>' +
-                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.name + '</em></span><strong>Name</strong><br /></li>' +
+                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em><a href="/workers/task/' + task.id + '">' + task.name + '</a></em></span><strong>Name</strong><br /></li>' +
+                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.queue + '</em></span><strong>Queue</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.priority + '</em></span><strong>Priority</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.repeats + '</em></span><strong>Repeats</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + durationToReadableString(task.repeat_interval) + '</em></span><strong>RepeatInterval</strong><br /></li>' +
+                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.schedule + '</em></span><strong>Schedule</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + durationToReadableString(task.timeout) + '</em></span><strong>Timeout</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + dateToString(task.created_at) + '</em></span><strong>Created</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + (task.started_at ? dateToString(task.started_at) : '') + '</em></span><strong>Started</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.status + '</em></span><strong>Status</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + task.attempts + '</em></span><strong>Attempts</strong><br /></li>' +
+                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + leaseToString(task) + '</em></span><strong>Lease</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + dateToString(task.allow_start_at) + '</em></span><strong>Allow start</strong><br /></li>' +
+                                    '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + (task.next_run_at ? dateToString(task.next_run_at) : '') + '</em></span><strong>Next run</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + (task.first_started_at ? dateToString(task.first_started_at) : '') + '</em></span><strong>First started</strong><br /></li>' +
                                     '<li class="list-group-item"><span class="pull-right text-muted small"><em>' + (task.last_started_at ? dateToString(task.last_started_at) : '') + '</em></span><strong>Last started</strong><br /></li>' +
                                 '</ul>' +
@@ -198,7 +240,13 @@ $(document).ready(function () {
             },
             columns: [
                 { data: 'id' },
-                { data: 'name' },
+                {
+                    data: 'name',
+                    render: function (name, type, row) {
+                        return '<a href="/workers/task/' + row.id + '">' + $('<div>').text(name).html() + '</a>';
+                    }
+                },
+                { data: 'queue' },
                 { data: 'priority' },
                 { data: 'repeats' },
                 {
@@ -207,6 +255,7 @@ $(document).ready(function () {
                         return durationToReadableString(ns);
                     }
                 },
+                { data: 'schedule' },
                 {
                     data: 'timeout',
                     render: function (ns) {
@@ -236,6 +285,12 @@ $(document).ready(function () {
                         return flag ? 'Locked' : 'Free';
                     }
                 },
+                {
+                    data: null,
+                    render: function (data) {
+                        return leaseToString(data);
+                    }
+                },
                 { data: 'attempts' },
                 {
                     data: 'allow_start_at',
@@ -243,6 +298,16 @@ $(document).ready(function () {
                         return dateToString(date);
                     }
                 },
+                {
+                    data: 'next_run_at',
+                    render: function (date) {
+                        if (!date) {
+                            return '';
+                        }
+
+                        return dateToString(date);
+                    }
+                },
                 {
                     data: 'first_started_at',
                     render: function (date) {
@@ -275,24 +340,138 @@ $(document).ready(function () {
                     }
                 }
             ],
-            order: [[ 2, 'asc' ], [ 3, 'asc' ]]
+            order: [[ 3, 'asc' ], [ 4, 'asc' ]]
         });
 
+    var tableDeadLetters = $('#dead-letters table')
+        .DataTable({
+            stateSave: true,
+            stateDuration: 0,
+            language: {
+                url: '/dashboard/datatables/i18n.json?locale=' + window.shadowLocale
+            },
+            ajax: {
+                url: '/workers/?action=stats&entity=dead-letters',
+                dataSrc: 'data'
+            },
+            columns: [
+                { data: 'id' },
+                {
+                    data: null,
+                    render: function (data) {
+                        return '<a href="/workers/task/' + data.task_id + '">' + $('<div>').text(data.task_name).html() + '</a><br /><small>' + data.task_id + '</small>';
+                    }
+                },
+                { data: 'attempts' },
+                {
+                    data: 'error',
+                    render: $.fn.dataTable.render.text()
+                },
+                {
+                    data: 'failed_at',
+                    render: function (date) {
+                        return dateToString(date);
+                    }
+                },
+                {
+                    orderable: false,
+                    data: null,
+                    render: function (data) {
+                        var content = '<div class="btn-group btn-group-xs">'
+                            + '<button type="button" class="btn btn-primary btn-icon dead-letter-show">'
+                            + '<i class="fa fa-eye" title="Inspect"></i>'
+                            + '</button>';
+
+                        if (!data.queued) {
+                            content += '<button type="button" class="btn btn-success btn-icon" onclick="deadLettersAction(\'requeue\', \'' + data.id + '\');">'
+                                + '<i class="fa fa-redo" title="Requeue"></i>'
+                                + '</button>';
+                        }
+
+                        return content
+                            + '<button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm discard dead letter #' + data.id + '" data-modal-callback="deadLettersAction(\'discard\', \'' + data.id + '\');">'
+                            + '<i class="fa fa-trash-alt" title="Discard"></i>'
+                            + '</button>'
+                            + '</div>';
+                    }
+                }
+            ],
+            order: [[ 4, 'desc' ]]
+        });
+
+    $('#dead-letters table tbody').on('click', 'button.dead-letter-show', function (e) {
+        e.preventDefault();
+        var b = $(this).find('i');
+        var row = tableDeadLetters.row($(this).closest('tr'));
+
+        if (b.hasClass('fa-eye')) {
+            var letter = row.data();
+
+            b.removeClass('fa-eye').addClass('fa-eye-slash');
+            row.child(
+                '<ul class="list-group">' +
+                    '<li class="list-group-item"><strong>Error</strong><pre>' + $('<div>').text(letter.error).html() + '</pre></li>' +
+                    '<li class="list-group-item"><strong>Result</strong><pre>' + $('<div>').text(letter.result).html() + '</pre></li>' +
+                '</ul>'
+            ).show();
+        } else {
+            b.removeClass('fa-eye-slash').addClass('fa-eye');
+            row.child.hide();
+        }
+    });
+
     var update = function() {
         tableListeners.ajax.reload();
+        tableQueues.ajax.reload();
         tableWorkers.ajax.reload();
         tableTasks.ajax.reload();
+        tableDeadLetters.ajax.reload();
+    };
+
+    var autorefresh = null,
+        pending = {},
+        timer = null;
+
+    var schedule = function(entity) {
+        pending[entity] = true;
+
+        if (timer !== null) {
+            return;
+        }
+
+        timer = window.setTimeout(function() {
+            if (pending.listeners) {
+                tableListeners.ajax.reload(null, false);
+            }
+
+            if (pending.workers || pending.tasks) {
+                tableQueues.ajax.reload(null, false);
+            }
+
+            if (pending.workers) {
+                tableWorkers.ajax.reload(null, false);
+            }
+
+            if (pending.tasks) {
+                tableTasks.ajax.reload(null, false);
+                tableDeadLetters.ajax.reload(null, false);
+            }
+
+            pending = {};
+            timer = null;
+        }, 1000);
     };
 
-    var autorefresh = null;
     $('#autorefresh').click(function() {
         if (this.checked) {
             if (autorefresh === null) {
                 update();
-                autorefresh = window.setInterval(update, 1000 * 10);
+                autorefresh = shadowEvents.subscribe('workers.*', function(event) {
+                    schedule(event.topic.substr('workers.'.length));
+                });
             }
         } else if (autorefresh !== null) {
-            window.clearInterval(autorefresh);
+            autorefresh.close();
             autorefresh = null;
         }
     });
@@ -339,5 +518,29 @@ $(document).ready(function () {
                 tableTasks.ajax.reload();
             }
         });
-    }
+    };
+
+    window.deadLettersAction = function(action, id) {
+        $.ajax({
+            type: 'POST',
+            url: '/workers/?action=dead-letters-' + action,
+            data: {
+                id: id
+            },
+            success: function(r) {
+                if (r.result === 'failed') {
+                    new PNotify({
+                        title: 'Error',
+                        text: r.message,
+                        type: 'error',
+                        hide: false,
+                        styling: 'bootstrap3'
+                    });
+                }
+
+                tableTasks.ajax.reload();
+                tableDeadLetters.ajax.reload();
+            }
+        });
+    };
 });
\ No newline at end of file
@@ -1 +1 @@
-$(document).ready(function(){$("#workers-show").click(function(){$("#workers .task-show:has(i.fa-eye)").click()}),$("#workers-hide").click(function(){$("#workers .task-show:has(i.fa-eye-slash)").click()}),$("#workers-add button[type=submit]").click(function(){$.ajax({type:"POST",url:"/workers/?action=workers-add",data:{count:$("#workers-add-count").val()},success:update})});var tableListeners=$("#listeners table").on("draw.dt",function(e,settings){settings.json&&$("#listeners-count").text(settings.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=listeners",dataSrc:"data"},columns:[{data:"id"},{data:"name"},{data:"events",render:function(data,type,row){var content="";for(var eventId in data)row.locked?content+='<span class="label label-info">'+data[eventId]+"</span> ":content+='<a href="#" title="Removing listener" class="label label-info" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove listener #'+row.id+" for event "+data[eventId]+'" data-modal-callback="listenersRemove(\''+row.id+"', '"+eventId+"');\">"+data[eventId]+" x</a> ";return content}},{data:"fires"},{data:"first_fired_at",render:function(date){return date?dateToString(date):""}},{data:"last_fired_at",render:function(date){return date?dateToString(date):""}},{orderable:!1,data:null,render:function(data,type,row){return row.locked?"":'<div class="btn-group btn-group-xs"><button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove listener #'+row.id+' for all events" data-modal-callback="listenersRemove(\''+row.id+'\');"><i class="fa fa-trash-alt" title="Remove listeners for all events"></i></button></div>'}}],order:[[1,"asc"],[2,"asc"]]}),tableWorkers=$("#workers table").on("draw.dt",function(e,settings){settings.json&&$("#workers-count").text(settings.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=workers",dataSrc:"data"},columns:[{data:"id"},{data:"created",render:function(date){return dateToString(date)}},{data:"status"},{data:"locked",render:function(flag){return flag?"Locked":"Free"}},{data:null,defaultContent:""},{orderable:!1,data:null,render:function(data){var content='<div class="btn-group btn-group-xs">';return data.task&&(content+='<button type="button" class="btn btn-success btn-circle task-show" data-task="\' + i + \'"><i class="fa fa-eye" title="Show task\'s details"></i></button>'),content+='<button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm kill worker #'+data.id+'" data-modal-callback="workersRemove(\''+data.id+'\');"><i class="fa fa-trash-alt" title="Remove worker"></i></button></div>'}}],order:[[2,"asc"],[0,"asc"]]});$("#workers table tbody").on("click","button.task-show",function(e){e.preventDefault();var b=$(this).find("i"),row=tableWorkers.row($(this).closest("tr"));if(b.hasClass("fa-eye")){var task=row.data().task;b.removeClass("fa-eye").addClass("fa-eye-slash"),row.child('<table width="100%"><tr><td><ul class="list-group"><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.id+'</em></span><strong>ID</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.name+'</em></span><strong>Name</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.priority+'</em></span><strong>Priority</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.repeats+'</em></span><strong>Repeats</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+durationToReadableString(task.repeat_interval)+'</em></span><strong>RepeatInterval</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+durationToReadableString(task.timeout)+'</em></span><strong>Timeout</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+dateToString(task.created_at)+'</em></span><strong>Created</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(task.started_at?dateToString(task.started_at):"")+'</em></span><strong>Started</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.status+'</em></span><strong>Status</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+task.attempts+'</em></span><strong>Attempts</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+dateToString(task.allow_start_at)+'</em></span><strong>Allow start</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(task.first_started_at?dateToString(task.first_started_at):"")+'</em></span><strong>First started</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(task.last_started_at?dateToString(task.last_started_at):"")+"</em></span><strong>Last started</strong><br /></li></ul></td></tr></table>").show()}else b.removeClass("fa-eye-slash").addClass("fa-eye"),row.child.hide()});var tableTasks=$("#tasks table").on("draw.dt",function(e,settings){settings.json&&$("#tasks-count").text(settings.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=tasks",dataSrc:"data"},columns:[{data:"id"},{data:"name"},{data:"priority"},{data:"repeats"},{data:"repeat_interval",render:function(ns){return durationToReadableString(ns)}},{data:"timeout",render:function(ns){return durationToReadableString(ns)}},{data:"created_at",render:function(date){return dateToString(date)}},{data:"started_at",render:function(date){return date?dateToString(date):""}},{data:"status"},{data:"locked",render:function(flag){return flag?"Locked":"Free"}},{data:"attempts"},{data:"allow_start_at",render:function(date){return dateToString(date)}},{data:"first_started_at",render:function(date){return date?dateToString(date):""}},{data:"last_started_at",render:function(date){return date?dateToString(date):""}},{orderable:!1,data:null,render:function(data){return'<div class="btn-group btn-group-xs"><button type="button" class="btn btn-danger btn-icon task-remove" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove task #'+data.id+'" data-modal-callback="tasksRemove(\''+data.id+'\');"><i class="fa fa-trash-alt" title="Remove task"></i></button></div>'}}],order:[[2,"asc"],[3,"asc"]]}),update=function(){tableListeners.ajax.reload(),tableWorkers.ajax.reload(),tableTasks.ajax.reload()},autorefresh=null;$("#autorefresh").click(function(){this.checked?null===autorefresh&&(update(),autorefresh=window.setInterval(update,1e4)):null!==autorefresh&&(window.clearInterval(autorefresh),autorefresh=null)}),window.listenersRemove=function(listenerId){console.log(arguments),$.ajax({type:"POST",url:"/workers/?action=listeners-remove",data:{id:listenerId,events:Array.apply(null,arguments).slice(1)},success:function(){tableListeners.ajax.reload()}})},window.workersRemove=function(id){$.ajax({type:"POST",url:"/workers/?action=workers-remove",data:{id:id},success:function(){tableWorkers.ajax.reload(),tableTasks.ajax.reload()}})},window.tasksRemove=function(id){$.ajax({type:"POST",url:"/workers/?action=tasks-remove",data:{id:id},success:function(){tableWorkers.ajax.reload(),tableTasks.ajax.reload()}})}});
\ No newline at end of file
+$(document).ready(function(){a=function(e){return e.singleton?e.lock_owner?e.lock_owner+" ("+dateToString(e.lock_expires_at)+")":"Free":""},$("#workers-show").click(function(){$("#workers .task-show:has(i.fa-eye)").click()}),$("#workers-hide").click(function(){$("#workers .task-show:has(i.fa-eye-slash)").click()}),$("#workers-add button[type=submit]").click(function(){$.ajax({type:"POST",url:"/workers/?action=workers-add",data:{count:$("#workers-add-count").val()},success:l})}),i=$("#listeners table").on("draw.dt",function(e,t){t.json&&$("#listeners-count").text(t.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=listeners",dataSrc:"data"},columns:[{data:"id"},{data:"name"},{data:"events",render:function(e,t,n){var s,o="";for(s in e)n.locked?o+='<span class="label label-info">'+e[s]+"</span> ":o+='<a href="#" title="Removing listener" class="label label-info" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove listener #'+n.id+" for event "+e[s]+`" data-modal-callback="listenersRemove('`+n.id+"', '"+s+`');">`+e[s]+" x</a> ";return o}},{data:"fires"},{data:"first_fired_at",render:function(e){return e?dateToString(e):""}},{data:"last_fired_at",render:function(e){return e?dateToString(e):""}},{orderable:!1,data:null,render:function(e,t,n){return n.locked?"":'<div class="btn-group btn-group-xs"><button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove listener #'+n.id+` for all events" data-modal-callback="listenersRemove('`+n.id+`');"><i class="fa fa-trash-alt" title="Remove listeners for all events"></i></button></div>`}}],order:[[1,"asc"],[2,"asc"]]}),r=$("#queues table").DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=queues",dataSrc:"data"},columns:[{data:"name",render:function(e,t,n){return n.default?e+' <span class="label label-default">default</span>':e}},{data:"priority"},{data:"workers"},{data:"tasks"}],order:[[1,"asc"],[0,"asc"]]}),t=$("#workers table").on("draw.dt",function(e,t){t.json&&$("#workers-count").text(t.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=workers",dataSrc:"data"},columns:[{data:"id"},{data:"created",render:function(e){return dateToString(e)}},{data:"status"},{data:"queue"},{data:"locked",render:function(e){return e?"Locked":"Free"}},{data:null,defaultContent:""},{orderable:!1,data:null,render:function(e){var t='<div class="btn-group btn-group-xs">';return e.task&&(t+=`<button type="button" class="btn btn-success btn-circle task-show" data-task="' + i + '"><i class="fa fa-eye" title="Show task's details"></i></button>`),t+='<button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm kill worker #'+e.id+`" data-modal-callback="workersRemove('`+e.id+`');"><i class="fa fa-trash-alt" title="Remove worker"></i></button></div>`,t}}],order:[[2,"asc"],[0,"asc"]]}),$("#workers table tbody").on("click","button.task-show",function(e){e.preventDefault();var n,s=$(this).find("i"),o=t.row($(this).closest("tr"));s.hasClass("fa-eye")?(n=o.data().task,s.removeClass("fa-eye").addClass("fa-eye-slash"),o.child('<table width="100%"><tr><td><ul class="list-group"><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.id+'</em></span><strong>ID</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em><a href="/workers/task/'+n.id+'">'+n.name+'</a></em></span><strong>Name</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.queue+'</em></span><strong>Queue</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.priority+'</em></span><strong>Priority</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.repeats+'</em></span><strong>Repeats</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+durationToReadableString(n.repeat_interval)+'</em></span><strong>RepeatInterval</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.schedule+'</em></span><strong>Schedule</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+durationToReadableString(n.timeout)+'</em></span><strong>Timeout</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+dateToString(n.created_at)+'</em></span><strong>Created</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(n.started_at?dateToString(n.started_at):"")+'</em></span><strong>Started</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.status+'</em></span><strong>Status</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+n.attempts+'</em></span><strong>Attempts</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+a(n)+'</em></span><strong>Lease</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+dateToString(n.allow_start_at)+'</em></span><strong>Allow start</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(n.next_run_at?dateToString(n.next_run_at):"")+'</em></span><strong>Next run</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(n.first_started_at?dateToString(n.first_started_at):"")+'</em></span><strong>First started</strong><br /></li><li class="list-group-item"><span class="pull-right text-muted small"><em>'+(n.last_started_at?dateToString(n.last_started_at):"")+"</em></span><strong>Last started</strong><br /></li></ul></td></tr></table>").show()):(s.removeClass("fa-eye-slash").addClass("fa-eye"),o.child.hide())}),n=$("#tasks table").on("draw.dt",function(e,t){t.json&&$("#tasks-count").text(t.json.recordsTotal)}).DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=tasks",dataSrc:"data"},columns:[{data:"id"},{data:"name",render:function(e,t,n){return'<a href="/workers/task/'+n.id+'">'+$("<div>").text(e).html()+"</a>"}},{data:"queue"},{data:"priority"},{data:"repeats"},{data:"repeat_interval",render:function(e){return durationToReadableString(e)}},{data:"schedule"},{data:"timeout",render:function(e){return durationToReadableString(e)}},{data:"created_at",render:function(e){return dateToString(e)}},{data:"started_at",render:function(e){return e?dateToString(e):""}},{data:"status"},{data:"locked",render:function(e){return e?"Locked":"Free"}},{data:null,render:function(e){return a(e)}},{data:"attempts"},{data:"allow_start_at",render:function(e){return dateToString(e)}},{data:"next_run_at",render:function(e){return e?dateToString(e):""}},{data:"first_started_at",render:function(e){return e?dateToString(e):""}},{data:"last_started_at",render:function(e){return e?dateToString(e):""}},{orderable:!1,data:null,render:function(e){return'<div class="btn-group btn-group-xs"><button type="button" class="btn btn-danger btn-icon task-remove" data-toggle="modal" data-target="#modal" data-modal-title="Confirm remove task #'+e.id+`" data-modal-callback="tasksRemove('`+e.id+`');"><i class="fa fa-trash-alt" title="Remove task"></i></button></div>`}}],order:[[3,"asc"],[4,"asc"]]}),s=$("#dead-letters table").DataTable({stateSave:!0,stateDuration:0,language:{url:"/dashboard/datatables/i18n.json?locale="+window.shadowLocale},ajax:{url:"/workers/?action=stats&entity=dead-letters",dataSrc:"data"},columns:[{data:"id"},{data:null,render:function(e){return'<a href="/workers/task/'+e.task_id+'">'+$("<div>").text(e.task_name).html()+"</a><br /><small>"+e.task_id+"</small>"}},{data:"attempts"},{data:"error",render:$.fn.dataTable.render.text()},{data:"failed_at",render:function(e){return dateToString(e)}},{orderable:!1,data:null,render:function(e){var t='<div class="btn-group btn-group-xs"><button type="button" class="btn btn-primary btn-icon dead-letter-show"><i class="fa fa-eye" title="Inspect"></i></button>';return e.queued||(t+=`<button type="button" class="btn btn-success btn-icon" onclick="deadLettersAction('requeue', '`+e.id+`');"><i class="fa fa-redo" title="Requeue"></i></button>`),t+'<button type="button" class="btn btn-danger btn-icon" data-toggle="modal" data-target="#modal" data-modal-title="Confirm discard dead letter #'+e.id+`" data-modal-callback="deadLettersAction('discard', '`+e.id+`');"><i class="fa fa-trash-alt" title="Discard"></i></button></div>`}}],order:[[4,"desc"]]}),$("#dead-letters table tbody").on("click","button.dead-letter-show",function(e){e.preventDefault();var o,t=$(this).find("i"),n=s.row($(this).closest("tr"));t.hasClass("fa-eye")?(o=n.data(),t.removeClass("fa-eye").addClass("fa-eye-slash"),n.child('<ul class="list-group"><li class="list-group-item"><strong>Error</strong><pre>'+$("<div>").text(o.error).html()+'</pre></li><li class="list-group-item"><strong>Result</strong><pre>'+$("<div>").text(o.result).html()+"</pre></li></ul>").show()):(t.removeClass("fa-eye-slash").addClass("fa-eye"),n.child.hide())});var t,n,s,i,a,r,l=function(){i.ajax.reload(),r.ajax.reload(),t.ajax.reload(),n.ajax.reload(),s.ajax.reload()},o=null,e={},c=null,d=function(o){if(e[o]=!0,c!==null)return;c=window.setTimeout(function(){e.listeners&&i.ajax.reload(null,!1),(e.workers||e.tasks)&&r.ajax.reload(null,!1),e.workers&&t.ajax.reload(null,!1),e.tasks&&(n.ajax.reload(null,!1),s.ajax.reload(null,!1)),e={},c=null},1e3)};$("#autorefresh").click(function(){this.checked?o===null&&(l(),o=shadowEvents.subscribe("workers.*",function(e){d(e.topic.substr("workers.".length))})):o!==null&&(o.close(),o=null)}),window.listenersRemove=function(e){console.log(arguments),$.ajax({type:"POST",url:"/workers/?action=listeners-remove",data:{id:e,events:Array.apply(null,arguments).slice(1)},success:function(){i.ajax.reload()}})},window.workersRemove=function(e){$.ajax({type:"POST",url:"/workers/?action=workers-remove",data:{id:e},success:function(){t.ajax.reload(),n.ajax.reload()}})},window.tasksRemove=function(e){$.ajax({type:"POST",url:"/workers/?action=tasks-remove",data:{id:e},success:function(){t.ajax.reload(),n.ajax.reload()}})},window.deadLettersAction=function(e,t){$.ajax({type:"POST",url:"/workers/?action=dead-letters-"+e,data:{id:t},success:function(e){e.result==="failed"&&new PNotify({title:"Error",text:e.message,type:"error",hide:!1,styling:"bootstrap3"}),n.ajax.reload(),s.ajax.reload()}})}})
\ No newline at end of file
@@ -9,6 +9,7 @@ import (
 	"github.com/mrsmtvd/shadow"
 	"github.com/mrsmtvd/shadow/components/config"
 	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/database"
 	"github.com/mrsmtvd/shadow/components/i18n"
 	"github.com/mrsmtvd/shadow/components/logging"
 	"github.com/mrsmtvd/shadow/components/metrics"
@@ -22,6 +23,25 @@ type Component struct {
 	mutex           sync.RWMutex
 	dispatcher      *dispatcher.SimpleDispatcher
 	lockedListeners []ws.ListenerWithEvents
+
+	queuesMutex sync.RWMutex
+	queues      []*queue
+
+	taskStore      workers.TaskStore
+	taskHandlers   map[string]workers.TaskHandler
+	pendingRecords map[string]workers.TaskRecord
+
+	locker    workers.Locker
+	lockOwner string
+	lockTTL   int64
+	heldLocks map[string]struct{}
+	lockDone  chan struct{}
+
+	deadLetters      []workers.DeadLetter
+	deadLettersLimit int
+
+	historyStore workers.HistoryStore
+	historyLimit int
 }
 
 func (c *Component) Name() string {
@@ -41,6 +61,9 @@ func (c *Component) Dependencies() []shadow.Dependency {
 		{
 			Name: dashboard.ComponentName,
 		},
+		{
+			Name: database.ComponentName,
+		},
 		{
 			Name: i18n.ComponentName,
 		},
@@ -58,6 +81,14 @@ func (c *Component) Init(a shadow.Application) error {
 
 	c.dispatcher = dispatcher.NewSimpleDispatcher()
 	c.lockedListeners = make([]ws.ListenerWithEvents, 0)
+	c.taskHandlers = make(map[string]workers.TaskHandler)
+	c.pendingRecords = make(map[string]workers.TaskRecord)
+	c.lockOwner = newLockOwner()
+	c.lockTTL = int64(workers.DefaultLockTTL)
+	c.heldLocks = make(map[string]struct{})
+	c.lockDone = make(chan struct{})
+	c.deadLetters = make([]workers.DeadLetter, 0)
+	c.queues = make([]*queue, 0)
 
 	return nil
 }
@@ -76,23 +107,63 @@ func (c *Component) Run(a shadow.Application, ready chan<- struct{}) error {
 		}
 	}
 
+	if l := c.newEventsListener(); l != nil {
+		c.addLockedListener(l)
+	}
+
+	c.mutex.Lock()
+	c.deadLettersLimit = cfg.Int(workers.ConfigDeadLettersLimit)
+	c.historyLimit = cfg.Int(workers.ConfigHistoryLimit)
+	c.mutex.Unlock()
+
+	c.addLockedListener(c.newDeadLettersListener())
+	c.addLockedListener(c.newHistoryListener())
+
 	for i := 1; i <= cfg.Int(workers.ConfigWorkersCount); i++ {
 		c.AddSimpleWorker()
 	}
 
+	c.initQueues(cfg.String(workers.ConfigQueues), cfg.Duration(workers.ConfigTickerExecuteTasksDuration))
+
+	c.setLockTTL(cfg.Duration(workers.ConfigLocksTTL))
+
+	store := c.initTaskStore(cfg.String(workers.ConfigStorage))
+	locker := c.initLocker(cfg.String(workers.ConfigLocksStorage))
+	history := c.initHistoryStore(cfg.String(workers.ConfigHistoryStorage))
+
+	c.mutex.Lock()
+	c.taskStore = store
+	c.locker = locker
+	c.historyStore = history
+	c.mutex.Unlock()
+
+	go c.renewLocks(c.lockDone)
+
+	c.restoreTasks()
+
 	ready <- struct{}{}
 
 	return c.dispatcher.Run()
 }
 
-func (c *Component) Shutdown() error {
+func (c *Component) Shutdown() (err error) {
+	for _, q := range c.queueList() {
+		if q.dispatcher.Status() == ws.DispatcherStatusProcess {
+			_ = q.dispatcher.Cancel()
+		}
+	}
+
 	if c.dispatcher.Status() == ws.DispatcherStatusProcess {
-		if err := c.dispatcher.Cancel(); err != context.Canceled {
-			return err
+		if err = c.dispatcher.Cancel(); err == context.Canceled {
+			err = nil
 		}
 	}
 
-	return nil
+	// блокировки освобождаются после остановки диспетчера, чтобы другие реплики не запустили задачи раньше
+	close(c.lockDone)
+	c.releaseLocks()
+
+	return err
 }
 
 func (c *Component) LockedListeners() []ws.ListenerWithEvents {
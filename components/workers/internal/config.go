@@ -23,6 +23,57 @@ func (c *Component) ConfigVariables() []config.Variable {
 			WithEditable(true).
 			WithDefault(true).
 			WithGroup("listeners"),
+		config.NewVariable(workers.ConfigStorage, config.ValueTypeString).
+			WithUsage("Storage of tasks").
+			WithDefault(workers.StorageMemory).
+			WithView([]string{config.ViewEnum}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionEnumOptions: [][]interface{}{
+					{workers.StorageMemory, "Memory"},
+					{workers.StorageDatabase, "Database"},
+				},
+			}),
+		config.NewVariable(workers.ConfigLocksStorage, config.ValueTypeString).
+			WithUsage("Storage of locks for singleton tasks").
+			WithGroup("Locks").
+			WithDefault(workers.StorageMemory).
+			WithView([]string{config.ViewEnum}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionEnumOptions: [][]interface{}{
+					{workers.StorageMemory, "Memory"},
+					{workers.StorageDatabase, "Database"},
+				},
+			}),
+		config.NewVariable(workers.ConfigLocksTTL, config.ValueTypeDuration).
+			WithUsage("Lease duration of lock").
+			WithGroup("Locks").
+			WithEditable(true).
+			WithDefault(workers.DefaultLockTTL.String()),
+		config.NewVariable(workers.ConfigDeadLettersLimit, config.ValueTypeInt).
+			WithUsage("Maximum count of dead letters").
+			WithGroup("Dead letters").
+			WithEditable(true).
+			WithDefault(100),
+		config.NewVariable(workers.ConfigQueues, config.ValueTypeString).
+			WithUsage("Named queues in format name:workers[:priority] separated by comma, lower priority value is more important").
+			WithGroup("Queues").
+			WithEditable(true),
+		config.NewVariable(workers.ConfigHistoryStorage, config.ValueTypeString).
+			WithUsage("Storage of tasks runs history").
+			WithGroup("History").
+			WithDefault(workers.StorageMemory).
+			WithView([]string{config.ViewEnum}).
+			WithViewOptions(map[string]interface{}{
+				config.ViewOptionEnumOptions: [][]interface{}{
+					{workers.StorageMemory, "Memory"},
+					{workers.StorageDatabase, "Database"},
+				},
+			}),
+		config.NewVariable(workers.ConfigHistoryLimit, config.ValueTypeInt).
+			WithUsage("Maximum count of runs in history of each task, zero disables history").
+			WithGroup("History").
+			WithEditable(true).
+			WithDefault(50),
 	}
 }
 
@@ -31,17 +82,23 @@ func (c *Component) ConfigWatchers() []config.Watcher {
 		config.NewWatcher([]string{workers.ConfigWorkersCount}, c.watchCount),
 		config.NewWatcher([]string{workers.ConfigTickerExecuteTasksDuration}, c.watchTickerExecuteTasksDuration),
 		config.NewWatcher([]string{workers.ConfigListenersLoggingEnabled}, c.watchListenersLoggingEnabled),
+		config.NewWatcher([]string{workers.ConfigLocksTTL}, c.watchLocksTTL),
+		config.NewWatcher([]string{workers.ConfigDeadLettersLimit}, c.watchDeadLettersLimit),
+		config.NewWatcher([]string{workers.ConfigQueues}, c.watchQueues),
+		config.NewWatcher([]string{workers.ConfigHistoryLimit}, c.watchHistoryLimit),
 	}
 }
 
 func (c *Component) watchCount(_ string, newValue interface{}, _ interface{}) {
-	for i := len(c.GetWorkers()); i < newValue.(int); i++ {
+	for i := len(c.dispatcher.GetWorkers()); i < newValue.(int); i++ {
 		c.AddSimpleWorker()
 	}
 }
 
 func (c *Component) watchTickerExecuteTasksDuration(_ string, newValue interface{}, _ interface{}) {
-	c.dispatcher.SetTickerExecuteTasksDuration(newValue.(time.Duration))
+	for _, d := range c.dispatchers() {
+		d.SetTickerExecuteTasksDuration(newValue.(time.Duration))
+	}
 }
 
 func (c *Component) watchListenersLoggingEnabled(_ string, newValue interface{}, _ interface{}) {
@@ -55,3 +112,25 @@ func (c *Component) watchListenersLoggingEnabled(_ string, newValue interface{},
 
 	c.removeLockedListener(c.Name() + "." + logging.ComponentName)
 }
+
+func (c *Component) watchLocksTTL(_ string, newValue interface{}, _ interface{}) {
+	c.setLockTTL(newValue.(time.Duration))
+}
+
+func (c *Component) watchDeadLettersLimit(_ string, newValue interface{}, _ interface{}) {
+	c.mutex.Lock()
+	c.deadLettersLimit = newValue.(int)
+	c.mutex.Unlock()
+}
+
+func (c *Component) watchQueues(_ string, newValue interface{}, _ interface{}) {
+	cfg := c.application.GetComponent(config.ComponentName).(config.Component)
+
+	c.initQueues(newValue.(string), cfg.Duration(workers.ConfigTickerExecuteTasksDuration))
+}
+
+func (c *Component) watchHistoryLimit(_ string, newValue interface{}, _ interface{}) {
+	c.mutex.Lock()
+	c.historyLimit = newValue.(int)
+	c.mutex.Unlock()
+}
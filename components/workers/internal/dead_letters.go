@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+var (
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+	ErrDeadLetterQueued   = errors.New("task of dead letter is still queued")
+)
+
+func (c *Component) newDeadLettersListener() *Listener {
+	l := NewListener(func(_ context.Context, _ ws.Event, t time.Time, args ...interface{}) {
+		err, ok := args[5].(error)
+		if !ok {
+			return
+		}
+
+		var exhausted *workers.RetriesExhaustedError
+		if !errors.As(err, &exhausted) {
+			return
+		}
+
+		c.addDeadLetter(workers.DeadLetter{
+			Task:     args[0].(ws.Task),
+			Attempts: exhausted.Attempts,
+			Error:    exhausted.Err.Error(),
+			Result:   args[4],
+			FailedAt: t,
+		})
+	}, ws.EventTaskExecuteStop)
+
+	l.SetName(c.Name() + ".dead-letters")
+
+	return l
+}
+
+func (c *Component) addDeadLetter(letter workers.DeadLetter) {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	letter.ID = hex.EncodeToString(id)
+
+	c.mutex.Lock()
+	c.deadLetters = append(c.deadLetters, letter)
+
+	// самые старые записи вытесняются при переполнении списка
+	if limit := c.deadLettersLimit; limit > 0 && len(c.deadLetters) > limit {
+		c.deadLetters = append(c.deadLetters[:0:0], c.deadLetters[len(c.deadLetters)-limit:]...)
+	}
+	c.mutex.Unlock()
+
+	c.logger.Warn("Task moved to dead letters",
+		"task.id", letter.Task.Id(),
+		"task.name", letter.Task.Name(),
+		"task.attempts", letter.Attempts,
+		"task.error", letter.Error,
+	)
+}
+
+func (c *Component) DeadLetters() []workers.DeadLetter {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	tmp := make([]workers.DeadLetter, len(c.deadLetters))
+	copy(tmp, c.deadLetters)
+
+	return tmp
+}
+
+// RequeueDeadLetter возвращает задачу в очередь диспетчера. Периодические задачи остаются в очереди
+// после неудачного запуска, поэтому их повторная постановка невозможна
+func (c *Component) RequeueDeadLetter(id string) error {
+	c.mutex.RLock()
+	index := c.deadLetterIndex(id)
+	var letter workers.DeadLetter
+	if index >= 0 {
+		letter = c.deadLetters[index]
+	}
+	c.mutex.RUnlock()
+
+	if index < 0 {
+		return ErrDeadLetterNotFound
+	}
+
+	if c.GetTaskMetadata(letter.Task.Id()) != nil {
+		return ErrDeadLetterQueued
+	}
+
+	if err := c.DiscardDeadLetter(id); err != nil {
+		return err
+	}
+
+	c.AddTask(letter.Task)
+
+	return nil
+}
+
+func (c *Component) DiscardDeadLetter(id string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	index := c.deadLetterIndex(id)
+	if index < 0 {
+		return ErrDeadLetterNotFound
+	}
+
+	c.deadLetters = append(c.deadLetters[:index], c.deadLetters[index+1:]...)
+
+	return nil
+}
+
+func (c *Component) deadLetterIndex(id string) int {
+	for i, letter := range c.deadLetters {
+		if letter.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+func (c *Component) newEventsListener() *Listener {
+	if !c.application.HasComponent(dashboard.ComponentName) {
+		return nil
+	}
+
+	events := c.application.GetComponent(dashboard.ComponentName).(dashboard.Component).Events()
+
+	l := NewListener(func(_ context.Context, event ws.Event, _ time.Time, args ...interface{}) {
+		switch event {
+		case ws.EventWorkerAdd, ws.EventWorkerRemove, ws.EventWorkerStatusChanged:
+			events.Publish(workers.EventsTopicWorkers, map[string]interface{}{
+				"event": event.Name(),
+				"id":    args[0].(ws.Worker).Id(),
+			})
+
+		case ws.EventTaskAdd, ws.EventTaskRemove, ws.EventTaskStatusChanged, ws.EventTaskExecuteStart, ws.EventTaskExecuteStop:
+			t := args[0].(ws.Task)
+
+			events.Publish(workers.EventsTopicTasks, map[string]interface{}{
+				"event": event.Name(),
+				"id":    t.Id(),
+				"name":  t.Name(),
+			})
+
+		case ws.EventListenerAdd, ws.EventListenerRemove:
+			l := args[1].(ws.Listener)
+
+			events.Publish(workers.EventsTopicListeners, map[string]interface{}{
+				"event": event.Name(),
+				"id":    l.Id(),
+				"name":  l.Name(),
+			})
+		}
+	}, ws.EventAll)
+
+	l.SetName(c.Name() + "." + dashboard.ComponentName)
+
+	return l
+}
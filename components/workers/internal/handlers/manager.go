@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -15,12 +17,19 @@ type managerHandlerResponseSuccess struct {
 	Result string `json:"result"`
 }
 
+// easyjson:json
+type managerHandlerResponseFailed struct {
+	Result  string `json:"result"`
+	Message string `json:"message"`
+}
+
 // easyjson:json
 type managerHandlerItemWorker struct {
 	ID      string                  `json:"id"`
 	Created time.Time               `json:"created"`
 	Status  string                  `json:"status"`
 	Locked  bool                    `json:"locked"`
+	Queue   string                  `json:"queue"`
 	Task    *managerHandlerItemTask `json:"task"`
 }
 
@@ -40,6 +49,12 @@ type managerHandlerItemTask struct {
 	AllowStartAt   *time.Time    `json:"allow_start_at"`
 	FirstStartedAt *time.Time    `json:"first_started_at"`
 	LastStartedAt  *time.Time    `json:"last_started_at"`
+	Schedule       string        `json:"schedule"`
+	NextRunAt      *time.Time    `json:"next_run_at"`
+	Singleton      bool          `json:"singleton"`
+	LockOwner      string        `json:"lock_owner"`
+	LockExpiresAt  *time.Time    `json:"lock_expires_at"`
+	Queue          string        `json:"queue"`
 }
 
 // easyjson:json
@@ -53,6 +68,27 @@ type managerHandlerItemListener struct {
 	LastFiredAt  *time.Time        `json:"last_fired_at"`
 }
 
+// easyjson:json
+type managerHandlerItemQueue struct {
+	Name     string `json:"name"`
+	Priority int64  `json:"priority"`
+	Workers  int    `json:"workers"`
+	Tasks    int    `json:"tasks"`
+	Default  bool   `json:"default"`
+}
+
+// easyjson:json
+type managerHandlerItemDeadLetter struct {
+	ID       string    `json:"id"`
+	TaskID   string    `json:"task_id"`
+	TaskName string    `json:"task_name"`
+	Attempts int64     `json:"attempts"`
+	Error    string    `json:"error"`
+	Result   string    `json:"result"`
+	FailedAt time.Time `json:"failed_at"`
+	Queued   bool      `json:"queued"`
+}
+
 type ManagerHandler struct {
 	dashboard.Handler
 
@@ -75,6 +111,28 @@ func (h *ManagerHandler) isLocked(id string) bool {
 	return false
 }
 
+// расписание и аренда блокировки есть только у задач, реализующих соответствующие интерфейсы
+func (h *ManagerHandler) taskOptions(ctx context.Context, task ws.Task, data *managerHandlerItemTask) {
+	if scheduled, ok := workers.TaskAs[workers.HasSchedule](task); ok && scheduled.Schedule() != nil {
+		data.Schedule = scheduled.Schedule().String()
+		data.NextRunAt = scheduled.NextRunAt()
+	}
+
+	singleton, ok := workers.TaskAs[workers.HasSingleton](task)
+	if !ok || singleton.LockName() == "" {
+		return
+	}
+
+	data.Singleton = true
+
+	if locker := h.component.Locker(); locker != nil {
+		if lease, err := locker.Get(ctx, singleton.LockName()); err == nil && lease != nil && !lease.Expired() {
+			data.LockOwner = lease.Owner
+			data.LockExpiresAt = &lease.ExpiresAt
+		}
+	}
+}
+
 func (h *ManagerHandler) actionStats(w *dashboard.Response, r *dashboard.Request) {
 	stats := struct {
 		Draw     int         `json:"draw"`
@@ -129,6 +187,7 @@ func (h *ManagerHandler) actionStats(w *dashboard.Response, r *dashboard.Request
 			data := managerHandlerItemWorker{
 				ID:      item.Id(),
 				Created: item.CreatedAt(),
+				Queue:   h.component.GetWorkerQueue(item.Id()),
 			}
 
 			if md := h.component.GetWorkerMetadata(item.Id()); md != nil {
@@ -149,6 +208,9 @@ func (h *ManagerHandler) actionStats(w *dashboard.Response, r *dashboard.Request
 						StartedAt:      item.StartedAt(),
 					}
 
+					h.taskOptions(r.Context(), item, data.Task)
+					data.Task.Queue = data.Queue
+
 					if taskMD := h.component.GetTaskMetadata(item.Id()); taskMD != nil {
 						data.Task.Status = taskMD[ws.TaskMetadataStatus].(ws.Status).String()
 						data.Task.Locked = taskMD[ws.TaskMetadataLocked].(bool)
@@ -183,6 +245,9 @@ func (h *ManagerHandler) actionStats(w *dashboard.Response, r *dashboard.Request
 				StartedAt:      item.StartedAt(),
 			}
 
+			h.taskOptions(r.Context(), item, &data)
+			data.Queue = h.component.GetTaskQueue(item.Id())
+
 			if md := h.component.GetTaskMetadata(item.Id()); md != nil {
 				data.Status = locale.Translate(workers.ComponentName, md[ws.TaskMetadataStatus].(ws.Status).String(), "task")
 				data.Locked = md[ws.TaskMetadataLocked].(bool)
@@ -198,6 +263,56 @@ func (h *ManagerHandler) actionStats(w *dashboard.Response, r *dashboard.Request
 		stats.Data = list
 		stats.Total = len(list)
 
+	case "queues":
+		queues := h.component.Queues()
+		list := make([]managerHandlerItemQueue, 0, len(queues))
+		tasks := make(map[string]int, len(queues))
+
+		for _, item := range h.component.GetTasks() {
+			tasks[h.component.GetTaskQueue(item.Id())]++
+		}
+
+		for _, q := range queues {
+			list = append(list, managerHandlerItemQueue{
+				Name:     q.Name,
+				Priority: q.Priority,
+				Workers:  q.Workers,
+				Tasks:    tasks[q.Name],
+				Default:  q.Name == workers.DefaultQueue,
+			})
+		}
+
+		stats.Data = list
+		stats.Total = len(list)
+
+	case "dead-letters":
+		letters := h.component.DeadLetters()
+		list := make([]managerHandlerItemDeadLetter, 0, len(letters))
+
+		// новые записи выводятся первыми
+		for i := len(letters) - 1; i >= 0; i-- {
+			letter := letters[i]
+
+			data := managerHandlerItemDeadLetter{
+				ID:       letter.ID,
+				TaskID:   letter.Task.Id(),
+				TaskName: letter.Task.Name(),
+				Attempts: letter.Attempts,
+				Error:    letter.Error,
+				FailedAt: letter.FailedAt,
+				Queued:   h.component.GetTaskMetadata(letter.Task.Id()) != nil,
+			}
+
+			if letter.Result != nil {
+				data.Result = fmt.Sprint(letter.Result)
+			}
+
+			list = append(list, data)
+		}
+
+		stats.Data = list
+		stats.Total = len(list)
+
 	default:
 		h.NotFound(w, r)
 		return
@@ -279,6 +394,33 @@ func (h *ManagerHandler) actionTasksRemove(w *dashboard.Response, r *dashboard.R
 	}
 }
 
+func (h *ManagerHandler) actionDeadLetters(w *dashboard.Response, r *dashboard.Request, action string) {
+	id := r.Original().FormValue("id")
+
+	var err error
+
+	if action == "requeue" {
+		err = h.component.RequeueDeadLetter(id)
+	} else {
+		err = h.component.DiscardDeadLetter(id)
+	}
+
+	if err != nil {
+		err = w.SendJSON(managerHandlerResponseFailed{
+			Result:  "failed",
+			Message: err.Error(),
+		})
+	} else {
+		err = w.SendJSON(managerHandlerResponseSuccess{
+			Result: "success",
+		})
+	}
+
+	if err != nil {
+		h.InternalError(w, r, err)
+	}
+}
+
 func (h *ManagerHandler) actionWorkerRemove(w *dashboard.Response, r *dashboard.Request) {
 	checkID := r.Original().FormValue("id")
 
@@ -344,6 +486,20 @@ func (h *ManagerHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request)
 			h.MethodNotAllowed(w, r)
 		}
 
+	case "dead-letters-requeue":
+		if r.IsPost() {
+			h.actionDeadLetters(w, r, "requeue")
+		} else {
+			h.MethodNotAllowed(w, r)
+		}
+
+	case "dead-letters-discard":
+		if r.IsPost() {
+			h.actionDeadLetters(w, r, "discard")
+		} else {
+			h.MethodNotAllowed(w, r)
+		}
+
 	case "workers-remove":
 		if r.IsPost() {
 			h.actionWorkerRemove(w, r)
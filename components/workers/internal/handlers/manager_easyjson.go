@@ -114,6 +114,8 @@ func easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandler
 			out.Status = string(in.String())
 		case "locked":
 			out.Locked = bool(in.Bool())
+		case "queue":
+			out.Queue = string(in.String())
 		case "task":
 			if in.IsNull() {
 				in.Skip()
@@ -158,6 +160,11 @@ func easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandler
 		out.RawString(prefix)
 		out.Bool(bool(in.Locked))
 	}
+	{
+		const prefix string = ",\"queue\":"
+		out.RawString(prefix)
+		out.String(string(in.Queue))
+	}
 	{
 		const prefix string = ",\"task\":"
 		out.RawString(prefix)
@@ -282,6 +289,38 @@ func easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandler
 					in.AddError((*out.LastStartedAt).UnmarshalJSON(data))
 				}
 			}
+		case "schedule":
+			out.Schedule = string(in.String())
+		case "next_run_at":
+			if in.IsNull() {
+				in.Skip()
+				out.NextRunAt = nil
+			} else {
+				if out.NextRunAt == nil {
+					out.NextRunAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.NextRunAt).UnmarshalJSON(data))
+				}
+			}
+		case "singleton":
+			out.Singleton = bool(in.Bool())
+		case "lock_owner":
+			out.LockOwner = string(in.String())
+		case "lock_expires_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LockExpiresAt = nil
+			} else {
+				if out.LockExpiresAt == nil {
+					out.LockExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LockExpiresAt).UnmarshalJSON(data))
+				}
+			}
+		case "queue":
+			out.Queue = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -382,6 +421,44 @@ func easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandler
 			out.Raw((*in.LastStartedAt).MarshalJSON())
 		}
 	}
+	{
+		const prefix string = ",\"schedule\":"
+		out.RawString(prefix)
+		out.String(string(in.Schedule))
+	}
+	{
+		const prefix string = ",\"next_run_at\":"
+		out.RawString(prefix)
+		if in.NextRunAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.NextRunAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"singleton\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Singleton))
+	}
+	{
+		const prefix string = ",\"lock_owner\":"
+		out.RawString(prefix)
+		out.String(string(in.LockOwner))
+	}
+	{
+		const prefix string = ",\"lock_expires_at\":"
+		out.RawString(prefix)
+		if in.LockExpiresAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.LockExpiresAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"queue\":"
+		out.RawString(prefix)
+		out.String(string(in.Queue))
+	}
 	out.RawByte('}')
 }
 
@@ -574,3 +651,287 @@ func (v *managerHandlerItemListener) UnmarshalJSON(data []byte) error {
 func (v *managerHandlerItemListener) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers3(l, v)
 }
+func easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(in *jlexer.Lexer, out *managerHandlerResponseFailed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			out.Result = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(out *jwriter.Writer, in managerHandlerResponseFailed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Result))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v managerHandlerResponseFailed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v managerHandlerResponseFailed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *managerHandlerResponseFailed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *managerHandlerResponseFailed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers4(l, v)
+}
+func easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(in *jlexer.Lexer, out *managerHandlerItemDeadLetter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "task_id":
+			out.TaskID = string(in.String())
+		case "task_name":
+			out.TaskName = string(in.String())
+		case "attempts":
+			out.Attempts = int64(in.Int64())
+		case "error":
+			out.Error = string(in.String())
+		case "result":
+			out.Result = string(in.String())
+		case "failed_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.FailedAt).UnmarshalJSON(data))
+			}
+		case "queued":
+			out.Queued = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(out *jwriter.Writer, in managerHandlerItemDeadLetter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TaskID))
+	}
+	{
+		const prefix string = ",\"task_name\":"
+		out.RawString(prefix)
+		out.String(string(in.TaskName))
+	}
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Attempts))
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		out.String(string(in.Result))
+	}
+	{
+		const prefix string = ",\"failed_at\":"
+		out.RawString(prefix)
+		out.Raw((in.FailedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"queued\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Queued))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v managerHandlerItemDeadLetter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v managerHandlerItemDeadLetter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *managerHandlerItemDeadLetter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *managerHandlerItemDeadLetter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers5(l, v)
+}
+func easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(in *jlexer.Lexer, out *managerHandlerItemQueue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "priority":
+			out.Priority = int64(in.Int64())
+		case "workers":
+			out.Workers = int(in.Int())
+		case "tasks":
+			out.Tasks = int(in.Int())
+		case "default":
+			out.Default = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(out *jwriter.Writer, in managerHandlerItemQueue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Priority))
+	}
+	{
+		const prefix string = ",\"workers\":"
+		out.RawString(prefix)
+		out.Int(int(in.Workers))
+	}
+	{
+		const prefix string = ",\"tasks\":"
+		out.RawString(prefix)
+		out.Int(int(in.Tasks))
+	}
+	{
+		const prefix string = ",\"default\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Default))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v managerHandlerItemQueue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v managerHandlerItemQueue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEd74d837EncodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *managerHandlerItemQueue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *managerHandlerItemQueue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEd74d837DecodeGithubComKihamoShadowComponentsWorkersInternalHandlers6(l, v)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/dashboard"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+var taskRunStatuses = []string{
+	workers.RunStatusSuccess,
+	workers.RunStatusFail,
+	workers.RunStatusTimeout,
+	workers.RunStatusCancel,
+}
+
+type taskView struct {
+	ID        string
+	Name      string
+	Queue     string
+	Status    string
+	Attempts  int64
+	Schedule  string
+	NextRunAt *time.Time
+	Queued    bool
+}
+
+type TaskHandler struct {
+	dashboard.Handler
+
+	component workers.Component
+}
+
+func NewTaskHandler(component workers.Component) *TaskHandler {
+	return &TaskHandler{
+		component: component,
+	}
+}
+
+func (h *TaskHandler) ServeHTTP(w *dashboard.Response, r *dashboard.Request) {
+	q := r.URL().Query()
+	id := q.Get(":id")
+
+	status := q.Get("status")
+	if status != "" && !h.isStatus(status) {
+		status = ""
+	}
+
+	runs, err := h.component.TaskHistory(r.Context(), id, status)
+	if err != nil {
+		h.InternalError(w, r, err)
+		return
+	}
+
+	view := taskView{
+		ID: id,
+	}
+
+	// задача могла уже завершиться и покинуть диспетчер, тогда о ней известно только из истории
+	for _, task := range h.component.GetTasks() {
+		if task.Id() != id {
+			continue
+		}
+
+		view.Name = task.Name()
+		view.Queue = h.component.GetTaskQueue(id)
+		view.Queued = true
+
+		if md := h.component.GetTaskMetadata(id); md != nil {
+			view.Status = md[ws.TaskMetadataStatus].(ws.Status).String()
+			view.Attempts = md[ws.TaskMetadataAttempts].(int64)
+		}
+
+		if scheduled, ok := workers.TaskAs[workers.HasSchedule](task); ok && scheduled.Schedule() != nil {
+			view.Schedule = scheduled.Schedule().String()
+			view.NextRunAt = scheduled.NextRunAt()
+		}
+
+		break
+	}
+
+	if !view.Queued {
+		if len(runs) == 0 && status == "" {
+			h.NotFound(w, r)
+			return
+		}
+
+		if len(runs) > 0 {
+			view.Name = runs[0].TaskName
+		}
+	}
+
+	h.Render(r.Context(), "task", map[string]interface{}{
+		"task":     view,
+		"runs":     runs,
+		"status":   status,
+		"statuses": taskRunStatuses,
+	})
+}
+
+func (h *TaskHandler) isStatus(status string) bool {
+	for _, s := range taskRunStatuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -18,10 +18,16 @@ func (c *Component) DispatcherCheck() dashboard.HealthCheck {
 			return errors.New("Dispatcher isn't initialized")
 		}
 
-		if c.dispatcher.Status() == workers.DispatcherStatusProcess {
-			return nil
+		if c.dispatcher.Status() != workers.DispatcherStatusProcess {
+			return errors.New("Dispatcher status isn't process")
 		}
 
-		return errors.New("Dispatcher status isn't process")
+		for _, q := range c.queueList() {
+			if q.dispatcher.Status() != workers.DispatcherStatusProcess {
+				return errors.New("Dispatcher status of queue " + q.Name + " isn't process")
+			}
+		}
+
+		return nil
 	}
 }
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/storage"
+)
+
+func (c *Component) initHistoryStore(driver string) workers.HistoryStore {
+	if driver == workers.StorageDatabase {
+		if c.application.HasComponent(database.ComponentName) {
+			<-c.application.ReadyComponent(database.ComponentName)
+
+			if s := c.application.GetComponent(database.ComponentName).(database.Component).Storage(); s != nil {
+				return storage.NewDatabaseHistory(s)
+			}
+		}
+
+		c.logger.Error("Database storage of history isn't available, memory storage is used")
+	}
+
+	return storage.NewMemoryHistory()
+}
+
+// newHistoryListener сохраняет запуски задач. Время старта берется из метаданных задачи,
+// так как событие начала выполнения доставляется асинхронно и может прийти после завершения
+func (c *Component) newHistoryListener() *Listener {
+	l := NewListener(func(_ context.Context, _ ws.Event, t time.Time, args ...interface{}) {
+		task := args[0].(ws.Task)
+		run := workers.NewTaskRun(task.Id(), task.Name())
+
+		if md, ok := args[1].(ws.Metadata); ok {
+			if startedAt, ok := md[ws.TaskMetadataLastStartedAt].(*time.Time); ok && startedAt != nil {
+				run.StartedAt = *startedAt
+				run.Duration = t.Sub(*startedAt)
+			}
+		}
+
+		if worker, ok := args[2].(ws.Worker); ok {
+			run.WorkerID = worker.Id()
+		}
+
+		if args[4] != nil {
+			run.Result = truncateRunResult(fmt.Sprint(args[4]))
+		}
+
+		err, _ := args[5].(error)
+		run.Status = runStatus(err)
+
+		if err != nil {
+			run.Error = err.Error()
+		}
+
+		c.addTaskRun(run)
+	}, ws.EventTaskExecuteStop)
+
+	l.SetName(c.Name() + ".history")
+
+	return l
+}
+
+func runStatus(err error) string {
+	switch {
+	case err == nil:
+		return workers.RunStatusSuccess
+	case errors.Is(err, context.DeadlineExceeded):
+		return workers.RunStatusTimeout
+	case errors.Is(err, context.Canceled):
+		return workers.RunStatusCancel
+	}
+
+	return workers.RunStatusFail
+}
+
+func truncateRunResult(result string) string {
+	if len(result) <= workers.RunResultMaxLength {
+		return result
+	}
+
+	// обрезка не должна разрывать многобайтовый символ
+	n := workers.RunResultMaxLength
+	for n > 0 && !utf8.RuneStart(result[n]) {
+		n--
+	}
+
+	return result[:n] + "…"
+}
+
+func (c *Component) addTaskRun(run *workers.TaskRun) {
+	c.mutex.RLock()
+	store := c.historyStore
+	limit := c.historyLimit
+	c.mutex.RUnlock()
+
+	if store == nil || limit <= 0 {
+		return
+	}
+
+	ctx := context.Background()
+
+	if err := store.Add(ctx, run); err != nil {
+		c.logger.Error("Failed save run of task", "task.id", run.TaskID, "error", err.Error())
+		return
+	}
+
+	if err := store.Truncate(ctx, run.TaskID, limit); err != nil {
+		c.logger.Error("Failed truncate history of task", "task.id", run.TaskID, "error", err.Error())
+	}
+}
+
+func (c *Component) HistoryStore() workers.HistoryStore {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.historyStore
+}
+
+func (c *Component) TaskHistory(ctx context.Context, taskID, status string) ([]*workers.TaskRun, error) {
+	store := c.HistoryStore()
+	if store == nil {
+		return nil, nil
+	}
+
+	return store.List(ctx, taskID, status)
+}
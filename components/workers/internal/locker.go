@@ -0,0 +1,209 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/storage"
+)
+
+// lockedTask выполняет задачу только если реплика владеет ее блокировкой. Захваченная блокировка
+// удерживается и продлевается в фоне, а не освобождается после запуска, иначе другая реплика
+// с небольшим смещением таймера повторно выполнит тот же запуск
+type lockedTask struct {
+	ws.Task
+
+	mutex     sync.RWMutex
+	skipped   bool
+	component *Component
+}
+
+func newLockedTask(task ws.Task, component *Component) *lockedTask {
+	return &lockedTask{
+		Task:      task,
+		component: component,
+	}
+}
+
+func (t *lockedTask) Run(ctx context.Context) (interface{}, error) {
+	name := t.LockName()
+
+	acquired, err := t.component.acquireLock(ctx, name)
+	if err != nil {
+		t.component.logger.Error("Failed acquire lock of task", "task.id", t.Id(), "lock", name, "error", err.Error())
+	}
+
+	t.mutex.Lock()
+	t.skipped = !acquired
+	t.mutex.Unlock()
+
+	if !acquired {
+		return nil, err
+	}
+
+	return t.Task.Run(ctx)
+}
+
+// пропущенный запуск по расписанию не обновляет время следующего срабатывания у самой задачи
+func (t *lockedTask) RepeatInterval() time.Duration {
+	t.mutex.RLock()
+	skipped := t.skipped
+	t.mutex.RUnlock()
+
+	if skipped {
+		if s, ok := workers.TaskAs[workers.HasSchedule](t.Task); ok && s.Schedule() != nil {
+			if next := s.Schedule().Next(time.Now()); !next.IsZero() {
+				return time.Until(next)
+			}
+		}
+	}
+
+	return t.Task.RepeatInterval()
+}
+
+func (t *lockedTask) LockName() string {
+	if s, ok := workers.TaskAs[workers.HasSingleton](t.Task); ok {
+		return s.LockName()
+	}
+
+	return ""
+}
+
+func (t *lockedTask) Unwrap() ws.Task {
+	return t.Task
+}
+
+func (c *Component) initLocker(driver string) workers.Locker {
+	if driver == workers.StorageDatabase {
+		if c.application.HasComponent(database.ComponentName) {
+			<-c.application.ReadyComponent(database.ComponentName)
+
+			if s := c.application.GetComponent(database.ComponentName).(database.Component).Storage(); s != nil {
+				return storage.NewDatabaseLocker(s)
+			}
+		}
+
+		c.logger.Error("Database storage of locks isn't available, memory storage is used")
+	}
+
+	return storage.NewMemoryLocker()
+}
+
+// владелец блокировок уникален для процесса, чтобы перезапущенная реплика не продолжила чужую аренду
+func newLockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	id := make([]byte, 4)
+	_, _ = rand.Read(id)
+
+	return hostname + "/" + strconv.Itoa(os.Getpid()) + "/" + hex.EncodeToString(id)
+}
+
+func (c *Component) Locker() workers.Locker {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.locker
+}
+
+func (c *Component) LockOwner() string {
+	return c.lockOwner
+}
+
+func (c *Component) setLockTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = workers.DefaultLockTTL
+	}
+
+	atomic.StoreInt64(&c.lockTTL, int64(ttl))
+}
+
+func (c *Component) acquireLock(ctx context.Context, name string) (bool, error) {
+	locker := c.Locker()
+	if locker == nil {
+		return false, nil
+	}
+
+	acquired, err := locker.Acquire(ctx, name, c.lockOwner, time.Duration(atomic.LoadInt64(&c.lockTTL)))
+
+	c.mutex.Lock()
+	if acquired {
+		c.heldLocks[name] = struct{}{}
+	} else {
+		delete(c.heldLocks, name)
+	}
+	c.mutex.Unlock()
+
+	return acquired, err
+}
+
+func (c *Component) releaseLock(name string) {
+	c.mutex.Lock()
+	_, ok := c.heldLocks[name]
+	delete(c.heldLocks, name)
+	locker := c.locker
+	c.mutex.Unlock()
+
+	if !ok || locker == nil {
+		return
+	}
+
+	if err := locker.Release(context.Background(), name, c.lockOwner); err != nil {
+		c.logger.Error("Failed release lock", "lock", name, "error", err.Error())
+	}
+}
+
+// renewLocks продлевает аренду удерживаемых блокировок, пока компонент не остановлен
+func (c *Component) renewLocks(done <-chan struct{}) {
+	for {
+		ttl := time.Duration(atomic.LoadInt64(&c.lockTTL))
+
+		select {
+		case <-done:
+			return
+		case <-time.After(ttl / 3):
+		}
+
+		c.mutex.RLock()
+		names := make([]string, 0, len(c.heldLocks))
+		for name := range c.heldLocks {
+			names = append(names, name)
+		}
+		c.mutex.RUnlock()
+
+		for _, name := range names {
+			acquired, err := c.acquireLock(context.Background(), name)
+
+			if err != nil {
+				c.logger.Error("Failed renew lock", "lock", name, "error", err.Error())
+			} else if !acquired {
+				c.logger.Warn("Lock was lost", "lock", name, "owner", c.lockOwner)
+			}
+		}
+	}
+}
+
+func (c *Component) releaseLocks() {
+	c.mutex.RLock()
+	names := make([]string, 0, len(c.heldLocks))
+	for name := range c.heldLocks {
+		names = append(names, name)
+	}
+	c.mutex.RUnlock()
+
+	for _, name := range names {
+		c.releaseLock(name)
+	}
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mrsmtvd/go-workers/task"
+	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLockerTestComponent(locker workers.Locker, owner string) *Component {
+	return &Component{
+		logger:    logging.DefaultLogger(),
+		locker:    locker,
+		lockOwner: owner,
+		lockTTL:   int64(time.Minute),
+		heldLocks: make(map[string]struct{}),
+	}
+}
+
+func newLockerTestTask(runs *int64) *workers.SingletonTask {
+	t := task.NewFunctionTask(func(context.Context) (interface{}, error) {
+		return atomic.AddInt64(runs, 1), nil
+	})
+	t.SetName("singleton")
+	t.SetRepeatInterval(time.Second * 5)
+
+	return workers.NewSingletonTask(t)
+}
+
+func TestLockedTask_Run_OnlyOwnerRuns(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	var runs int64
+
+	locker := storage.NewMemoryLocker()
+	first := newLockerTestComponent(locker, "first")
+	second := newLockerTestComponent(locker, "second")
+
+	firstTask := newLockedTask(newLockerTestTask(&runs), first)
+	secondTask := newLockedTask(newLockerTestTask(&runs), second)
+
+	a.Equal(workers.ComponentName+".task.singleton", firstTask.LockName())
+
+	result, err := firstTask.Run(context.Background())
+	a.NoError(err)
+	a.Equal(int64(1), result)
+	a.Contains(first.heldLocks, firstTask.LockName())
+
+	result, err = secondTask.Run(context.Background())
+	a.NoError(err)
+	a.Nil(result)
+	a.NotContains(second.heldLocks, secondTask.LockName())
+
+	// повторный запуск владельцем продлевает аренду
+	_, err = firstTask.Run(context.Background())
+	a.NoError(err)
+	a.Equal(int64(2), atomic.LoadInt64(&runs))
+
+	lease, err := locker.Get(context.Background(), firstTask.LockName())
+	a.NoError(err)
+
+	if a.NotNil(lease) {
+		a.Equal("first", lease.Owner)
+	}
+}
+
+func TestLockedTask_RepeatIntervalWithoutSchedule_TaskInterval(t *testing.T) {
+	t.Parallel()
+
+	var runs int64
+
+	locker := storage.NewMemoryLocker()
+	owner := newLockedTask(newLockerTestTask(&runs), newLockerTestComponent(locker, "first"))
+	skipped := newLockedTask(newLockerTestTask(&runs), newLockerTestComponent(locker, "second"))
+
+	_, _ = owner.Run(context.Background())
+	_, _ = skipped.Run(context.Background())
+
+	assert.Equal(t, time.Second*5, owner.RepeatInterval())
+	assert.Equal(t, time.Second*5, skipped.RepeatInterval())
+}
+
+func TestComponent_ReleaseLock_OtherOwnerAcquires(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	locker := storage.NewMemoryLocker()
+	first := newLockerTestComponent(locker, "first")
+	second := newLockerTestComponent(locker, "second")
+
+	acquired, err := first.acquireLock(context.Background(), "lock")
+	a.NoError(err)
+	a.True(acquired)
+
+	// освобождение чужой блокировки не снимает ее
+	second.heldLocks["lock"] = struct{}{}
+	second.releaseLock("lock")
+
+	acquired, err = second.acquireLock(context.Background(), "lock")
+	a.NoError(err)
+	a.False(acquired)
+
+	first.releaseLocks()
+	a.Empty(first.heldLocks)
+
+	acquired, err = second.acquireLock(context.Background(), "lock")
+	a.NoError(err)
+	a.True(acquired)
+}
+
+func TestComponent_AcquireLockWithoutLocker_NotAcquired(t *testing.T) {
+	t.Parallel()
+
+	c := newLockerTestComponent(nil, "first")
+
+	acquired, err := c.acquireLock(context.Background(), "lock")
+
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+}
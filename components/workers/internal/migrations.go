@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	sql "github.com/mrsmtvd/shadow/components/database/storage"
+	"github.com/mrsmtvd/shadow/components/workers/storage"
+)
+
+func (c *Component) DatabaseMigrations() []database.Migration {
+	return []database.Migration{
+		database.NewMigrationCode("20261019120000_tasks", migrationTasksUp, migrationTasksDown,
+			time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)).
+			WithChecksum("workers-tasks-v1"),
+		database.NewMigrationCode("20261019130000_tasks_schedule", migrationTasksScheduleUp, migrationTasksScheduleDown,
+			time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC)).
+			WithChecksum("workers-tasks-schedule-v1"),
+		database.NewMigrationCode("20261019140000_locks", migrationLocksUp, migrationLocksDown,
+			time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC)).
+			WithChecksum("workers-locks-v1"),
+		database.NewMigrationCode("20261019150000_history", migrationHistoryUp, migrationHistoryDown,
+			time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)).
+			WithChecksum("workers-history-v1"),
+	}
+}
+
+// типы колонок отличаются между диалектами, поэтому таблица создается кодом
+func migrationTasksUp(ctx context.Context, executor database.Executor) error {
+	text, timestamp, bigint := "TEXT", "TIMESTAMP", "BIGINT"
+
+	if e, ok := executor.(*sql.SQLExecutor); ok {
+		switch e.Dialect() {
+		case sql.DialectMySQL:
+			timestamp = "DATETIME"
+		case sql.DialectMSSQL:
+			text, timestamp = "NVARCHAR(MAX)", "DATETIME2"
+		case sql.DialectOracle:
+			text, bigint = "CLOB", "NUMBER(19)"
+		}
+	}
+
+	_, err := executor.ExecByQueryContext(ctx, "CREATE TABLE "+storage.TableTasks+` (
+	id VARCHAR(64) NOT NULL PRIMARY KEY,
+	handler VARCHAR(255) NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	payload `+text+`,
+	priority `+bigint+` NOT NULL,
+	repeats `+bigint+` NOT NULL,
+	repeat_interval `+bigint+` NOT NULL,
+	timeout `+bigint+` NOT NULL,
+	status VARCHAR(32) NOT NULL,
+	attempts `+bigint+` NOT NULL,
+	next_run_at `+timestamp+` NULL,
+	last_run_at `+timestamp+` NULL,
+	last_result `+text+`,
+	last_error `+text+`,
+	created_at `+timestamp+` NOT NULL,
+	updated_at `+timestamp+` NOT NULL
+)`)
+
+	return err
+}
+
+func migrationTasksDown(ctx context.Context, executor database.Executor) error {
+	_, err := executor.ExecByQueryContext(ctx, "DROP TABLE "+storage.TableTasks)
+
+	return err
+}
+
+var migrationTasksScheduleColumns = []string{"schedule", "timezone", "jitter", "missed_run_policy"}
+
+func migrationTasksScheduleUp(ctx context.Context, executor database.Executor) error {
+	add, bigint := "ADD COLUMN", "BIGINT"
+
+	if e, ok := executor.(*sql.SQLExecutor); ok {
+		switch e.Dialect() {
+		case sql.DialectMSSQL:
+			add = "ADD"
+		case sql.DialectOracle:
+			add, bigint = "ADD", "NUMBER(19)"
+		}
+	}
+
+	types := []string{
+		"VARCHAR(255) DEFAULT '' NOT NULL",
+		"VARCHAR(64) DEFAULT '' NOT NULL",
+		bigint + " DEFAULT 0 NOT NULL",
+		"VARCHAR(32) DEFAULT '' NOT NULL",
+	}
+
+	// sqlite не поддерживает добавление нескольких колонок одним запросом
+	for i, column := range migrationTasksScheduleColumns {
+		if _, err := executor.ExecByQueryContext(ctx, "ALTER TABLE "+storage.TableTasks+" "+add+" "+column+" "+types[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func migrationTasksScheduleDown(ctx context.Context, executor database.Executor) error {
+	for _, column := range migrationTasksScheduleColumns {
+		if _, err := executor.ExecByQueryContext(ctx, "ALTER TABLE "+storage.TableTasks+" DROP COLUMN "+column); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// таблица блокировок и признак singleton у задач
+func migrationLocksUp(ctx context.Context, executor database.Executor) error {
+	add, timestamp, boolean, falseValue := "ADD COLUMN", "TIMESTAMP", "BOOLEAN", "FALSE"
+
+	if e, ok := executor.(*sql.SQLExecutor); ok {
+		switch e.Dialect() {
+		case sql.DialectMySQL:
+			timestamp = "DATETIME"
+		case sql.DialectMSSQL:
+			add, timestamp, boolean, falseValue = "ADD", "DATETIME2", "BIT", "0"
+		case sql.DialectOracle:
+			add, boolean, falseValue = "ADD", "NUMBER(1)", "0"
+		}
+	}
+
+	_, err := executor.ExecByQueryContext(ctx, "CREATE TABLE "+storage.TableLocks+` (
+	name VARCHAR(255) NOT NULL PRIMARY KEY,
+	owner VARCHAR(255) NOT NULL,
+	acquired_at `+timestamp+` NOT NULL,
+	expires_at `+timestamp+` NOT NULL
+)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = executor.ExecByQueryContext(ctx, "ALTER TABLE "+storage.TableTasks+" "+add+" singleton "+boolean+" DEFAULT "+falseValue+" NOT NULL")
+
+	return err
+}
+
+func migrationLocksDown(ctx context.Context, executor database.Executor) error {
+	if _, err := executor.ExecByQueryContext(ctx, "ALTER TABLE "+storage.TableTasks+" DROP COLUMN singleton"); err != nil {
+		return err
+	}
+
+	_, err := executor.ExecByQueryContext(ctx, "DROP TABLE "+storage.TableLocks)
+
+	return err
+}
+
+func migrationHistoryUp(ctx context.Context, executor database.Executor) error {
+	text, timestamp, bigint := "TEXT", "TIMESTAMP", "BIGINT"
+
+	if e, ok := executor.(*sql.SQLExecutor); ok {
+		switch e.Dialect() {
+		case sql.DialectMySQL:
+			timestamp = "DATETIME"
+		case sql.DialectMSSQL:
+			text, timestamp = "NVARCHAR(MAX)", "DATETIME2"
+		case sql.DialectOracle:
+			text, bigint = "CLOB", "NUMBER(19)"
+		}
+	}
+
+	_, err := executor.ExecByQueryContext(ctx, "CREATE TABLE "+storage.TableHistory+` (
+	id VARCHAR(64) NOT NULL PRIMARY KEY,
+	task_id VARCHAR(64) NOT NULL,
+	task_name VARCHAR(255) NOT NULL,
+	worker_id VARCHAR(64) NOT NULL,
+	status VARCHAR(32) NOT NULL,
+	started_at `+timestamp+` NOT NULL,
+	duration `+bigint+` NOT NULL,
+	error `+text+`,
+	result `+text+`
+)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = executor.ExecByQueryContext(ctx, "CREATE INDEX "+storage.TableHistory+"_task_id ON "+storage.TableHistory+" (task_id, started_at)")
+
+	return err
+}
+
+func migrationHistoryDown(ctx context.Context, executor database.Executor) error {
+	_, err := executor.ExecByQueryContext(ctx, "DROP TABLE "+storage.TableHistory)
+
+	return err
+}
@@ -0,0 +1,236 @@
+package internal
+
+import (
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/go-workers/dispatcher"
+	"github.com/mrsmtvd/go-workers/worker"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+// queue именованная очередь со своим диспетчером, очередь по-умолчанию обслуживается c.dispatcher
+type queue struct {
+	workers.Queue
+
+	dispatcher *dispatcher.SimpleDispatcher
+}
+
+// dispatchers возвращает диспетчер очереди по-умолчанию и диспетчеры именованных очередей в порядке приоритета
+func (c *Component) dispatchers() []*dispatcher.SimpleDispatcher {
+	c.queuesMutex.RLock()
+	defer c.queuesMutex.RUnlock()
+
+	list := make([]*dispatcher.SimpleDispatcher, 0, len(c.queues)+1)
+	list = append(list, c.dispatcher)
+
+	for _, q := range c.queues {
+		list = append(list, q.dispatcher)
+	}
+
+	return list
+}
+
+func (c *Component) queueDispatcher(name string) *dispatcher.SimpleDispatcher {
+	if name == "" || name == workers.DefaultQueue {
+		return c.dispatcher
+	}
+
+	c.queuesMutex.RLock()
+	defer c.queuesMutex.RUnlock()
+
+	for _, q := range c.queues {
+		if q.Name == name {
+			return q.dispatcher
+		}
+	}
+
+	return nil
+}
+
+func (c *Component) queueList() []*queue {
+	c.queuesMutex.RLock()
+	defer c.queuesMutex.RUnlock()
+
+	tmp := make([]*queue, len(c.queues))
+	copy(tmp, c.queues)
+
+	return tmp
+}
+
+func (c *Component) initQueues(value string, tickerDuration time.Duration) {
+	list, err := workers.ParseQueues(value)
+	if err != nil {
+		c.logger.Error("Failed parse queues", "value", value, "error", err.Error())
+		return
+	}
+
+	c.applyQueues(list, tickerDuration)
+}
+
+func (c *Component) taskDispatcher(id string) *dispatcher.SimpleDispatcher {
+	for _, d := range c.dispatchers() {
+		if d.GetTaskMetadata(id) != nil {
+			return d
+		}
+	}
+
+	return nil
+}
+
+func (c *Component) workerDispatcher(id string) *dispatcher.SimpleDispatcher {
+	for _, d := range c.dispatchers() {
+		if d.GetWorkerMetadata(id) != nil {
+			return d
+		}
+	}
+
+	return nil
+}
+
+func (c *Component) Queues() []workers.Queue {
+	list := []workers.Queue{{
+		Name:    workers.DefaultQueue,
+		Workers: len(c.dispatcher.GetWorkers()),
+	}}
+
+	c.queuesMutex.RLock()
+	for _, q := range c.queues {
+		item := q.Queue
+		item.Workers = len(q.dispatcher.GetWorkers())
+
+		list = append(list, item)
+	}
+	c.queuesMutex.RUnlock()
+
+	return list
+}
+
+func (c *Component) GetTaskQueue(id string) string {
+	c.queuesMutex.RLock()
+	defer c.queuesMutex.RUnlock()
+
+	for _, q := range c.queues {
+		if q.dispatcher.GetTaskMetadata(id) != nil {
+			return q.Name
+		}
+	}
+
+	return workers.DefaultQueue
+}
+
+func (c *Component) GetWorkerQueue(id string) string {
+	c.queuesMutex.RLock()
+	defer c.queuesMutex.RUnlock()
+
+	for _, q := range c.queues {
+		if q.dispatcher.GetWorkerMetadata(id) != nil {
+			return q.Name
+		}
+	}
+
+	return workers.DefaultQueue
+}
+
+// applyQueues приводит набор очередей к конфигурации. Пулы обработчиков только расширяются,
+// как и у очереди по-умолчанию, а задачи удаленных очередей переносятся в очередь по-умолчанию
+func (c *Component) applyQueues(list []workers.Queue, tickerDuration time.Duration) {
+	actual := make(map[string]workers.Queue, len(list))
+	for _, q := range list {
+		actual[q.Name] = q
+	}
+
+	c.queuesMutex.Lock()
+	queues := make([]*queue, 0, len(list))
+	created := make([]*queue, 0)
+	removed := make([]*queue, 0)
+
+	for _, q := range c.queues {
+		if cfg, ok := actual[q.Name]; ok {
+			q.Queue = cfg
+			queues = append(queues, q)
+			delete(actual, q.Name)
+		} else {
+			removed = append(removed, q)
+		}
+	}
+
+	for _, cfg := range list {
+		if _, ok := actual[cfg.Name]; !ok {
+			continue
+		}
+
+		q := &queue{
+			Queue:      cfg,
+			dispatcher: dispatcher.NewSimpleDispatcher(),
+		}
+		q.dispatcher.SetTickerExecuteTasksDuration(tickerDuration)
+
+		queues = append(queues, q)
+		created = append(created, q)
+	}
+
+	sortQueues(queues)
+	c.queues = queues
+	c.queuesMutex.Unlock()
+
+	for _, q := range created {
+		// слушатели общие для всех очередей, поэтому новая очередь получает уже добавленных
+		for _, l := range c.dispatcher.GetListeners() {
+			if md := c.dispatcher.GetListenerMetadata(l.Id()); md != nil {
+				if events, ok := md[ws.ListenerMetadataEvents].([]ws.Event); ok {
+					for _, event := range events {
+						_ = q.dispatcher.AddListener(event, l)
+					}
+				}
+			}
+		}
+
+		go func(q *queue) {
+			if err := q.dispatcher.Run(); err != nil {
+				c.logger.Error("Dispatcher of queue stopped with error", "queue", q.Name, "error", err.Error())
+			}
+		}(q)
+
+		c.logger.Debug("Queue added", "queue", q.Name, "workers", q.Workers, "priority", q.Priority)
+	}
+
+	c.queuesMutex.RLock()
+	for _, q := range c.queues {
+		for i := len(q.dispatcher.GetWorkers()); i < q.Workers; i++ {
+			_ = q.dispatcher.AddWorker(worker.NewSimpleWorker())
+		}
+	}
+	c.queuesMutex.RUnlock()
+
+	for _, q := range removed {
+		tasks := q.dispatcher.GetTasks()
+
+		for _, t := range tasks {
+			q.dispatcher.RemoveTask(t)
+			_ = c.dispatcher.AddTask(t)
+		}
+
+		if q.dispatcher.Status() == ws.DispatcherStatusProcess {
+			_ = q.dispatcher.Cancel()
+		}
+
+		c.logger.Warn("Queue removed, tasks moved to default queue", "queue", q.Name, "tasks", len(tasks))
+	}
+}
+
+func sortQueues(queues []*queue) {
+	list := make([]workers.Queue, len(queues))
+	index := make(map[string]*queue, len(queues))
+
+	for i, q := range queues {
+		list[i] = q.Queue
+		index[q.Name] = q
+	}
+
+	workers.SortQueues(list)
+
+	for i, q := range list {
+		queues[i] = index[q.Name]
+	}
+}
@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/storage"
+)
+
+func (c *Component) initTaskStore(driver string) workers.TaskStore {
+	if driver == workers.StorageDatabase {
+		if c.application.HasComponent(database.ComponentName) {
+			<-c.application.ReadyComponent(database.ComponentName)
+
+			if s := c.application.GetComponent(database.ComponentName).(database.Component).Storage(); s != nil {
+				return storage.NewDatabase(s)
+			}
+		}
+
+		c.logger.Error("Database storage of tasks isn't available, memory storage is used")
+	}
+
+	return storage.NewMemory()
+}
+
+// восстанавливает сохраненные задачи, для которых уже зарегистрированы обработчики,
+// остальные ждут регистрации обработчика в RegisterTaskHandler
+func (c *Component) restoreTasks() {
+	records, err := c.TaskStore().List(context.Background())
+	if err != nil {
+		c.logger.Error("Failed load stored tasks", "error", err.Error())
+		return
+	}
+
+	c.mutex.Lock()
+	for _, record := range records {
+		c.pendingRecords[record.ID] = *record
+	}
+	c.mutex.Unlock()
+
+	c.mutex.RLock()
+	handlers := make([]string, 0, len(c.taskHandlers))
+	for name := range c.taskHandlers {
+		handlers = append(handlers, name)
+	}
+	c.mutex.RUnlock()
+
+	for _, name := range handlers {
+		c.restoreTasksByHandler(name)
+	}
+}
+
+func (c *Component) restoreTasksByHandler(name string) {
+	c.mutex.Lock()
+	handler, ok := c.taskHandlers[name]
+	if !ok || c.taskStore == nil {
+		c.mutex.Unlock()
+		return
+	}
+
+	records := make([]workers.TaskRecord, 0)
+
+	for id, record := range c.pendingRecords {
+		if record.Handler == name {
+			records = append(records, record)
+			delete(c.pendingRecords, id)
+		}
+	}
+
+	store := c.taskStore
+	c.mutex.Unlock()
+
+	for _, record := range records {
+		t, err := newStoredTask(record, handler, store, c.logger)
+		if err != nil {
+			c.logger.Error("Failed restore stored task", "task.id", record.ID, "task.handler", record.Handler, "error", err.Error())
+			continue
+		}
+
+		if t.Repeats() == 0 {
+			t.remove()
+			continue
+		}
+
+		t.applyMissedRunPolicy(time.Now())
+
+		if t.Repeats() == 0 {
+			continue
+		}
+
+		c.AddTask(t)
+
+		c.logger.Debug("Stored task restored", "task.id", record.ID, "task.handler", record.Handler, "task.attempts", record.Attempts)
+	}
+}
+
+func (c *Component) TaskStore() workers.TaskStore {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.taskStore
+}
+
+func (c *Component) RegisterTaskHandler(name string, handler workers.TaskHandler) {
+	c.mutex.Lock()
+	c.taskHandlers[name] = handler
+	c.mutex.Unlock()
+
+	c.restoreTasksByHandler(name)
+}
+
+func (c *Component) AddStoredTask(record *workers.TaskRecord) (ws.Task, error) {
+	c.mutex.RLock()
+	handler, ok := c.taskHandlers[record.Handler]
+	store := c.taskStore
+	c.mutex.RUnlock()
+
+	if !ok {
+		return nil, errors.New("handler " + record.Handler + " of task isn't registered")
+	}
+
+	if store == nil {
+		return nil, errors.New("storage of tasks isn't initialized")
+	}
+
+	t, err := newStoredTask(*record, handler, store, c.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.schedule != nil && record.NextRunAt == nil {
+		next := workers.NextScheduleTime(t.schedule, time.Now(), record.Jitter)
+		if next.IsZero() {
+			return nil, errors.New("schedule " + record.Schedule + " of task has no next run")
+		}
+
+		record.NextRunAt = &next
+		t.record.NextRunAt = &next
+	}
+
+	if err := store.Save(context.Background(), record); err != nil {
+		return nil, err
+	}
+
+	c.AddTask(t)
+
+	return t, nil
+}
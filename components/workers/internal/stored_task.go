@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/logging"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/cron"
+)
+
+// максимальное количество пропущенных запусков, которые выполняются при политике catch up
+const missedRunsLimit = 100
+
+// storedTask задача диспетчера, состояние которой сохраняется в хранилище.
+// Состояние обновляется в самом Run, так как события диспетчера доставляются асинхронно
+// и для быстрых задач могут прийти не по порядку
+type storedTask struct {
+	mutex   sync.RWMutex
+	record  workers.TaskRecord
+	repeats int64
+	runs    int64
+	removed bool
+	// оставшееся количество пропущенных запусков, которые выполняются подряд
+	catchUp int64
+
+	schedule *cron.Schedule
+	handler  workers.TaskHandler
+	store    workers.TaskStore
+	logger   logging.Logger
+}
+
+func newStoredTask(record workers.TaskRecord, handler workers.TaskHandler, store workers.TaskStore, logger logging.Logger) (*storedTask, error) {
+	schedule, err := record.CronSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	return &storedTask{
+		record:   record,
+		repeats:  record.RemainingRepeats(),
+		schedule: schedule,
+		handler:  handler,
+		store:    store,
+		logger:   logger,
+	}, nil
+}
+
+func (t *storedTask) Run(ctx context.Context) (result interface{}, err error) {
+	now := time.Now()
+
+	t.update(func(r *workers.TaskRecord) {
+		r.Status = workers.TaskRecordStatusProcess
+		r.LastRunAt = &now
+	})
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.finish(fmt.Sprint(r), fmt.Errorf("panic: %v", r))
+			panic(r)
+		}
+	}()
+
+	result, err = t.handler(ctx, t.Record().Payload)
+
+	// прерванный запуск не засчитывается, чтобы после перезапуска задача выполнилась повторно
+	if ctx.Err() == context.Canceled {
+		t.update(func(r *workers.TaskRecord) {
+			r.Status = workers.TaskRecordStatusWait
+		})
+
+		return result, err
+	}
+
+	if result != nil {
+		t.finish(fmt.Sprint(result), err)
+	} else {
+		t.finish("", err)
+	}
+
+	return result, err
+}
+
+func (t *storedTask) finish(result string, err error) {
+	now := time.Now()
+
+	t.mutex.Lock()
+	t.runs++
+	completed := t.repeats >= 0 && t.runs >= t.repeats
+
+	var next time.Time
+
+	if t.schedule != nil {
+		if t.catchUp > 0 {
+			t.catchUp--
+			next = now
+		} else {
+			next = workers.NextScheduleTime(t.schedule, now, t.record.Jitter)
+			completed = completed || next.IsZero()
+		}
+	}
+	t.mutex.Unlock()
+
+	if completed {
+		t.remove()
+		return
+	}
+
+	t.update(func(r *workers.TaskRecord) {
+		r.Status = workers.TaskRecordStatusRepeatWait
+		r.Attempts++
+		r.LastResult = result
+		r.LastError = ""
+
+		if err != nil {
+			r.LastError = err.Error()
+		}
+
+		if !next.IsZero() {
+			r.NextRunAt = &next
+		} else if r.RepeatInterval > 0 {
+			next := now.Add(r.RepeatInterval)
+			r.NextRunAt = &next
+		} else {
+			r.NextRunAt = nil
+		}
+	})
+}
+
+// applyMissedRunPolicy переносит время запуска задачи по расписанию, если оно прошло пока приложение не работало
+func (t *storedTask) applyMissedRunPolicy(now time.Time) {
+	if t.schedule == nil {
+		return
+	}
+
+	record := t.Record()
+	if record.NextRunAt != nil && record.NextRunAt.After(now) {
+		return
+	}
+
+	var (
+		next   time.Time
+		missed int64
+	)
+
+	if record.NextRunAt == nil {
+		next = workers.NextScheduleTime(t.schedule, now, record.Jitter)
+	} else {
+		missed = 1
+
+		for at := t.schedule.Next(*record.NextRunAt); !at.IsZero() && !at.After(now) && missed < missedRunsLimit; at = t.schedule.Next(at) {
+			missed++
+		}
+
+		switch record.MissedRunPolicy {
+		case workers.MissedRunPolicyOnce:
+			next = now
+
+		case workers.MissedRunPolicyCatchUp:
+			next = now
+
+			t.mutex.Lock()
+			t.catchUp = missed - 1
+			t.mutex.Unlock()
+
+		default:
+			next = workers.NextScheduleTime(t.schedule, now, record.Jitter)
+		}
+
+		t.logger.Info("Task missed scheduled runs",
+			"task.id", record.ID,
+			"task.handler", record.Handler,
+			"task.missed", missed,
+			"task.policy", record.MissedRunPolicy,
+		)
+	}
+
+	if next.IsZero() {
+		t.remove()
+		return
+	}
+
+	t.update(func(r *workers.TaskRecord) {
+		r.NextRunAt = &next
+	})
+}
+
+func (t *storedTask) update(fn func(*workers.TaskRecord)) {
+	t.mutex.Lock()
+	if t.removed {
+		t.mutex.Unlock()
+		return
+	}
+
+	fn(&t.record)
+
+	t.record.UpdatedAt = time.Now()
+	record := t.record
+	t.mutex.Unlock()
+
+	if err := t.store.Save(context.Background(), &record); err != nil {
+		t.logger.Error("Failed save task record", "task.id", record.ID, "task.handler", record.Handler, "error", err.Error())
+	}
+}
+
+func (t *storedTask) remove() {
+	t.mutex.Lock()
+	if t.removed {
+		t.mutex.Unlock()
+		return
+	}
+
+	t.removed = true
+	id := t.record.ID
+	t.mutex.Unlock()
+
+	if err := t.store.Delete(context.Background(), id); err != nil {
+		t.logger.Error("Failed delete task record", "task.id", id, "error", err.Error())
+	}
+}
+
+func (t *storedTask) Record() workers.TaskRecord {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.record
+}
+
+func (t *storedTask) Id() string {
+	return t.Record().ID
+}
+
+func (t *storedTask) Name() string {
+	record := t.Record()
+
+	if record.Name == "" {
+		return record.Handler
+	}
+
+	return record.Name
+}
+
+func (t *storedTask) Priority() int64 {
+	return t.Record().Priority
+}
+
+func (t *storedTask) Repeats() int64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.removed {
+		return 0
+	}
+
+	return t.repeats
+}
+
+func (t *storedTask) RepeatInterval() time.Duration {
+	record := t.Record()
+
+	if t.schedule == nil {
+		return record.RepeatInterval
+	}
+
+	if record.NextRunAt != nil {
+		if interval := time.Until(*record.NextRunAt); interval > 0 {
+			return interval
+		}
+	}
+
+	return 0
+}
+
+func (t *storedTask) Timeout() time.Duration {
+	return t.Record().Timeout
+}
+
+func (t *storedTask) CreatedAt() time.Time {
+	return t.Record().CreatedAt
+}
+
+func (t *storedTask) StartedAt() *time.Time {
+	return t.Record().NextRunAt
+}
+
+func (t *storedTask) Schedule() *cron.Schedule {
+	return t.schedule
+}
+
+func (t *storedTask) NextRunAt() *time.Time {
+	return t.Record().NextRunAt
+}
+
+func (t *storedTask) LockName() string {
+	record := t.Record()
+
+	if !record.Singleton {
+		return ""
+	}
+
+	// запись общая для всех реплик, поэтому блокировка именуется по идентификатору записи
+	return workers.ComponentName + ".task." + record.ID
+}
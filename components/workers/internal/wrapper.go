@@ -1,9 +1,13 @@
 package internal
 
 import (
+	"errors"
+	"time"
+
 	ws "github.com/mrsmtvd/go-workers"
 	"github.com/mrsmtvd/go-workers/worker"
 	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/mrsmtvd/shadow/components/workers/cron"
 )
 
 func (c *Component) AddSimpleWorker() {
@@ -15,31 +19,108 @@ func (c *Component) AddWorker(worker ws.Worker) {
 }
 
 func (c *Component) RemoveWorker(worker ws.Worker) {
-	c.dispatcher.RemoveWorker(worker)
+	if d := c.workerDispatcher(worker.Id()); d != nil {
+		d.RemoveWorker(worker)
+	}
 }
 
 func (c *Component) GetWorkerMetadata(id string) ws.Metadata {
-	return c.dispatcher.GetWorkerMetadata(id)
+	if d := c.workerDispatcher(id); d != nil {
+		return d.GetWorkerMetadata(id)
+	}
+
+	return nil
 }
 
 func (c *Component) GetWorkers() []ws.Worker {
-	return c.dispatcher.GetWorkers()
+	list := make([]ws.Worker, 0)
+
+	for _, d := range c.dispatchers() {
+		list = append(list, d.GetWorkers()...)
+	}
+
+	return list
 }
 
 func (c *Component) AddTask(task ws.Task) {
-	_ = c.dispatcher.AddTask(task)
+	if _, ok := task.(*lockedTask); !ok {
+		if s, ok := workers.TaskAs[workers.HasSingleton](task); ok && s.LockName() != "" {
+			task = newLockedTask(task, c)
+		}
+	}
+
+	d := c.dispatcher
+
+	// задача неизвестной очереди выполняется в очереди по-умолчанию, чтобы не потеряться
+	if q, ok := workers.TaskAs[workers.HasQueue](task); ok {
+		if d = c.queueDispatcher(q.Queue()); d == nil {
+			c.logger.Warn("Queue of task not found, default queue is used", "task.id", task.Id(), "queue", q.Queue())
+			d = c.dispatcher
+		}
+	}
+
+	_ = d.AddTask(task)
+}
+
+// AddTaskToQueue добавляет задачу в именованную очередь
+func (c *Component) AddTaskToQueue(queue string, task ws.Task) error {
+	if c.queueDispatcher(queue) == nil {
+		return errors.New("queue " + queue + " not found")
+	}
+
+	c.AddTask(workers.NewQueueTask(task, queue))
+
+	return nil
+}
+
+// AddCronTask добавляет задачу, запускаемую по расписанию cron
+func (c *Component) AddCronTask(task ws.Task, expression string, jitter time.Duration) (ws.Task, error) {
+	schedule, err := cron.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	t := workers.NewCronTask(task, schedule, jitter)
+	if t.NextRunAt() == nil {
+		return nil, errors.New("schedule " + expression + " of task has no next run")
+	}
+
+	c.AddTask(t)
+
+	return t, nil
 }
 
 func (c *Component) RemoveTask(task ws.Task) {
-	c.dispatcher.RemoveTask(task)
+	if s, ok := workers.TaskAs[workers.HasSingleton](task); ok && s.LockName() != "" {
+		c.releaseLock(s.LockName())
+	}
+
+	// явно удаленная задача не должна восстановиться после перезапуска
+	if t, ok := workers.TaskAs[*storedTask](task); ok {
+		t.remove()
+	}
+
+	if d := c.taskDispatcher(task.Id()); d != nil {
+		d.RemoveTask(task)
+	}
 }
 
 func (c *Component) GetTaskMetadata(id string) ws.Metadata {
-	return c.dispatcher.GetTaskMetadata(id)
+	if d := c.taskDispatcher(id); d != nil {
+		return d.GetTaskMetadata(id)
+	}
+
+	return nil
 }
 
 func (c *Component) GetTasks() []ws.Task {
-	return c.dispatcher.GetTasks()
+	list := make([]ws.Task, 0)
+
+	for _, d := range c.dispatchers() {
+		list = append(list, d.GetTasks()...)
+	}
+
+	return list
 }
 
 func (c *Component) AddListener(listener workers.ListenerWithEvents) {
@@ -47,7 +128,9 @@ func (c *Component) AddListener(listener workers.ListenerWithEvents) {
 }
 
 func (c *Component) AddListenerByEvent(event ws.Event, listener ws.Listener) {
-	_ = c.dispatcher.AddListener(event, listener)
+	for _, d := range c.dispatchers() {
+		_ = d.AddListener(event, listener)
+	}
 }
 
 func (c *Component) AddListenerByEvents(events []ws.Event, listener ws.Listener) {
@@ -57,7 +140,9 @@ func (c *Component) AddListenerByEvents(events []ws.Event, listener ws.Listener)
 }
 
 func (c *Component) RemoveListenerByEvent(event ws.Event, listener ws.Listener) {
-	c.dispatcher.RemoveListener(event, listener)
+	for _, d := range c.dispatchers() {
+		d.RemoveListener(event, listener)
+	}
 }
 
 func (c *Component) RemoveListenerByEvents(events []ws.Event, listener ws.Listener) {
@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+)
+
+// Lease аренда блокировки. Владелец должен продлевать аренду до истечения ExpiresAt,
+// иначе блокировку сможет захватить другая реплика
+type Lease struct {
+	Name       string    `db:"name"`
+	Owner      string    `db:"owner"`
+	AcquiredAt time.Time `db:"acquired_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+}
+
+func (l *Lease) Expired() bool {
+	return !l.ExpiresAt.After(time.Now())
+}
+
+type Locker interface {
+	// Acquire захватывает свободную или просроченную блокировку, либо продлевает аренду текущего владельца
+	Acquire(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, name, owner string) error
+	// Get возвращает текущую аренду блокировки, если блокировка никогда не захватывалась, то nil
+	Get(ctx context.Context, name string) (*Lease, error)
+}
+
+// HasSingleton реализуют задачи, которые должны выполняться только на одной реплике.
+// Имя блокировки должно совпадать на всех репликах, пустое имя отключает блокировку
+type HasSingleton interface {
+	LockName() string
+}
+
+// SingletonTask помечает задачу как выполняемую только на одной реплике, блокировка
+// именуется по имени задачи, так как идентификаторы задач в памяти различаются между репликами
+type SingletonTask struct {
+	ws.Task
+}
+
+func NewSingletonTask(task ws.Task) *SingletonTask {
+	return &SingletonTask{
+		Task: task,
+	}
+}
+
+func (t *SingletonTask) LockName() string {
+	return ComponentName + ".task." + t.Name()
+}
+
+func (t *SingletonTask) Unwrap() ws.Task {
+	return t.Task
+}
@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	ws "github.com/mrsmtvd/go-workers"
+)
+
+const DefaultQueue = "default"
+
+// Queue именованная очередь задач со своим диспетчером и пулом обработчиков, поэтому задачи
+// одной очереди не занимают обработчики другой. Очереди с меньшим значением приоритета важнее
+type Queue struct {
+	Name     string
+	Workers  int
+	Priority int64
+}
+
+// ParseQueues разбирает описание очередей вида name:workers[:priority] через запятую, например mail:4:10,reports:1:100.
+// Очереди возвращаются в порядке приоритета
+func ParseQueues(value string) ([]Queue, error) {
+	queues := make([]Queue, 0)
+	exists := make(map[string]struct{})
+
+	for _, spec := range strings.Split(value, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		parts := strings.Split(spec, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, errors.New("queue " + spec + " must be in format name:workers[:priority]")
+		}
+
+		q := Queue{
+			Name: strings.TrimSpace(parts[0]),
+		}
+
+		if q.Name == "" || q.Name == DefaultQueue {
+			return nil, errors.New("queue name " + q.Name + " is wrong")
+		}
+
+		if _, ok := exists[q.Name]; ok {
+			return nil, errors.New("queue " + q.Name + " is duplicated")
+		}
+
+		var err error
+
+		if q.Workers, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil || q.Workers < 1 {
+			return nil, errors.New("workers count of queue " + q.Name + " must be positive")
+		}
+
+		if len(parts) == 3 {
+			if q.Priority, err = strconv.ParseInt(strings.TrimSpace(parts[2]), 10, 64); err != nil {
+				return nil, errors.New("priority of queue " + q.Name + " is wrong")
+			}
+		}
+
+		exists[q.Name] = struct{}{}
+		queues = append(queues, q)
+	}
+
+	SortQueues(queues)
+
+	return queues, nil
+}
+
+func SortQueues(queues []Queue) {
+	sort.SliceStable(queues, func(i, j int) bool {
+		if queues[i].Priority == queues[j].Priority {
+			return queues[i].Name < queues[j].Name
+		}
+
+		return queues[i].Priority < queues[j].Priority
+	})
+}
+
+// HasQueue реализуют задачи, которые должны выполняться в именованной очереди
+type HasQueue interface {
+	Queue() string
+}
+
+type QueueTask struct {
+	ws.Task
+
+	queue string
+}
+
+func NewQueueTask(task ws.Task, queue string) *QueueTask {
+	return &QueueTask{
+		Task:  task,
+		queue: queue,
+	}
+}
+
+func (t *QueueTask) Unwrap() ws.Task {
+	return t.Task
+}
+
+func (t *QueueTask) Queue() string {
+	return t.queue
+}
@@ -0,0 +1,257 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	ws "github.com/mrsmtvd/go-workers"
+)
+
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBackoff     = time.Second
+	DefaultRetryMaxBackoff  = time.Minute * 10
+
+	// диспетчер прерывает задачу по своему таймауту не дожидаясь ее завершения, поэтому ему передается
+	// таймаут с запасом, чтобы результат попытки всегда успевал зафиксироваться в самой задаче
+	retryTimeoutGrace = time.Second
+)
+
+type RetryPolicy struct {
+	// общее количество попыток выполнения с учетом первой
+	MaxAttempts int64
+	// задержка перед первым повтором, каждый следующий повтор увеличивает ее в Multiplier раз
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	Multiplier float64
+	// жесткий таймаут одной попытки, по истечении которого попытка считается неудачной
+	Timeout time.Duration
+}
+
+func NewRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		Backoff:     DefaultRetryBackoff,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+		Multiplier:  2,
+	}
+}
+
+func (p RetryPolicy) Delay(attempt int64) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.Backoff) * math.Pow(multiplier, float64(attempt-1))
+
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+
+	return time.Duration(delay)
+}
+
+// RetriesExhaustedError возвращается последней неудачной попыткой, такие запуски попадают в список dead letters
+type RetriesExhaustedError struct {
+	Attempts int64
+	Err      error
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return "retries exhausted after " + strconv.FormatInt(e.Attempts, 10) + " attempts: " + e.Err.Error()
+}
+
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// DeadLetter запуск задачи, неудачный после всех повторов
+type DeadLetter struct {
+	ID       string
+	Task     ws.Task
+	Attempts int64
+	Error    string
+	Result   interface{}
+	FailedAt time.Time
+}
+
+type retryState struct {
+	attempt   int64
+	completed int64
+	retrying  bool
+}
+
+// RetryTask повторяет неудачные запуски задачи с экспоненциальной задержкой. Запуск, исчерпавший
+// попытки, завершается ошибкой RetriesExhaustedError и считается выполненным для подсчета повторений
+// исходной задачи
+type RetryTask struct {
+	ws.Task
+
+	mutex  sync.RWMutex
+	policy RetryPolicy
+	state  retryState
+}
+
+func NewRetryTask(task ws.Task, policy RetryPolicy) *RetryTask {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	return &RetryTask{
+		Task:   task,
+		policy: policy,
+	}
+}
+
+func (t *RetryTask) Run(ctx context.Context) (result interface{}, err error) {
+	// до завершения попытки выставляется состояние неудачи, так как диспетчер может запросить
+	// интервал повтора раньше, чем вернется результат
+	t.mutex.Lock()
+	prev := t.state
+	t.state = prev.failed(t.policy.MaxAttempts)
+	attempt := prev.attempt + 1
+	t.mutex.Unlock()
+
+	result, err = t.run(ctx)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	switch {
+	case err == nil:
+		t.state = prev.succeeded()
+
+	case errors.Is(err, context.Canceled):
+		t.state = prev
+
+	case !t.state.retrying:
+		err = &RetriesExhaustedError{
+			Attempts: attempt,
+			Err:      err,
+		}
+	}
+
+	return result, err
+}
+
+func (t *RetryTask) run(ctx context.Context) (result interface{}, err error) {
+	if t.policy.Timeout <= 0 {
+		return t.Task.Run(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.policy.Timeout)
+	defer cancel()
+
+	type response struct {
+		result interface{}
+		err    error
+	}
+
+	done := make(chan response, 1)
+
+	go func() {
+		var r response
+
+		defer func() {
+			if e := recover(); e != nil {
+				r.result, r.err = e, errors.New("panic recovered")
+			}
+
+			done <- r
+		}()
+
+		r.result, r.err = t.Task.Run(ctx)
+	}()
+
+	// задача, не реагирующая на отмену контекста, продолжит выполняться в фоне, но попытка завершится по таймауту
+	select {
+	case r := <-done:
+		return r.result, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *RetryTask) Repeats() int64 {
+	t.mutex.RLock()
+	state := t.state
+	t.mutex.RUnlock()
+
+	if state.retrying {
+		return -1
+	}
+
+	repeats := t.Task.Repeats()
+	if repeats < 0 || state.completed < repeats {
+		return -1
+	}
+
+	return 0
+}
+
+func (t *RetryTask) RepeatInterval() time.Duration {
+	t.mutex.RLock()
+	state := t.state
+	t.mutex.RUnlock()
+
+	if state.retrying {
+		return t.policy.Delay(state.attempt)
+	}
+
+	return t.Task.RepeatInterval()
+}
+
+func (t *RetryTask) Timeout() time.Duration {
+	if t.policy.Timeout > 0 {
+		return t.policy.Timeout + retryTimeoutGrace
+	}
+
+	return t.Task.Timeout()
+}
+
+func (t *RetryTask) Unwrap() ws.Task {
+	return t.Task
+}
+
+func (t *RetryTask) RetryPolicy() RetryPolicy {
+	return t.policy
+}
+
+// Attempt возвращает количество неудачных попыток текущего запуска
+func (t *RetryTask) Attempt() int64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.state.attempt
+}
+
+func (s retryState) failed(maxAttempts int64) retryState {
+	s.attempt++
+
+	if s.attempt < maxAttempts {
+		s.retrying = true
+	} else {
+		s.attempt = 0
+		s.retrying = false
+		s.completed++
+	}
+
+	return s
+}
+
+func (s retryState) succeeded() retryState {
+	s.attempt = 0
+	s.retrying = false
+	s.completed++
+
+	return s
+}
@@ -0,0 +1,170 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mrsmtvd/go-workers/task"
+	"github.com/stretchr/testify/assert"
+)
+
+var errRetryTest = errors.New("attempt failed")
+
+func retryTestTask(results ...error) *task.FunctionTask {
+	var call int
+
+	return task.NewFunctionTask(func(context.Context) (interface{}, error) {
+		err := results[call]
+		call++
+
+		return call, err
+	})
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt int64
+		want    time.Duration
+	}{
+		{"first retry", NewRetryPolicy(), 1, time.Second},
+		{"exponential", NewRetryPolicy(), 4, time.Second * 8},
+		{"max backoff", NewRetryPolicy(), 20, DefaultRetryMaxBackoff},
+		{"zero attempt as first", NewRetryPolicy(), 0, time.Second},
+		{"without max backoff", RetryPolicy{Backoff: time.Second, Multiplier: 10}, 4, time.Second * 1000},
+		{"multiplier less than one is constant", RetryPolicy{Backoff: time.Second, Multiplier: 0.5}, 3, time.Second},
+		{"without multiplier is constant", RetryPolicy{Backoff: time.Second}, 5, time.Second},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, c.policy.Delay(c.attempt), c.name)
+	}
+}
+
+func TestRetryState(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	var s retryState
+
+	s = s.failed(3)
+	a.Equal(retryState{attempt: 1, retrying: true}, s)
+
+	s = s.failed(3)
+	a.Equal(retryState{attempt: 2, retrying: true}, s)
+
+	s = s.failed(3)
+	a.Equal(retryState{completed: 1}, s)
+
+	s = s.failed(1)
+	a.Equal(retryState{completed: 2}, s)
+
+	s = s.failed(3).succeeded()
+	a.Equal(retryState{completed: 3}, s)
+}
+
+func TestRetryTask_Run_ExhaustsAttempts(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	policy := NewRetryPolicy()
+	rt := NewRetryTask(retryTestTask(errRetryTest, errRetryTest, errRetryTest), policy)
+
+	for attempt := int64(1); attempt < policy.MaxAttempts; attempt++ {
+		_, err := rt.Run(context.Background())
+		a.Equal(errRetryTest, err)
+
+		a.Equal(attempt, rt.Attempt())
+		a.Equal(int64(-1), rt.Repeats())
+		a.Equal(policy.Delay(attempt), rt.RepeatInterval())
+	}
+
+	result, err := rt.Run(context.Background())
+	a.Equal(3, result)
+
+	var exhausted *RetriesExhaustedError
+	if a.True(errors.As(err, &exhausted)) {
+		a.Equal(policy.MaxAttempts, exhausted.Attempts)
+	}
+
+	a.True(errors.Is(err, errRetryTest))
+	a.Equal(int64(0), rt.Attempt())
+	a.Equal(int64(0), rt.Repeats())
+	a.Equal(time.Duration(0), rt.RepeatInterval())
+}
+
+func TestRetryTask_Run_SucceedsAfterRetry(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	rt := NewRetryTask(retryTestTask(errRetryTest, nil), NewRetryPolicy())
+
+	_, err := rt.Run(context.Background())
+	a.Equal(errRetryTest, err)
+	a.Equal(int64(-1), rt.Repeats())
+
+	result, err := rt.Run(context.Background())
+	a.NoError(err)
+	a.Equal(2, result)
+	a.Equal(int64(0), rt.Attempt())
+	a.Equal(int64(0), rt.Repeats())
+}
+
+func TestRetryTask_Run_OneAttempt(t *testing.T) {
+	t.Parallel()
+
+	rt := NewRetryTask(retryTestTask(errRetryTest), RetryPolicy{})
+
+	_, err := rt.Run(context.Background())
+
+	var exhausted *RetriesExhaustedError
+	if assert.True(t, errors.As(err, &exhausted)) {
+		assert.Equal(t, int64(1), exhausted.Attempts)
+	}
+
+	assert.Equal(t, int64(0), rt.Repeats())
+}
+
+func TestRetryTask_Run_CanceledIsNotAttempt(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	rt := NewRetryTask(retryTestTask(errRetryTest, context.Canceled), NewRetryPolicy())
+
+	_, err := rt.Run(context.Background())
+	a.Equal(errRetryTest, err)
+
+	_, err = rt.Run(context.Background())
+	a.Equal(context.Canceled, err)
+	a.Equal(int64(1), rt.Attempt())
+	a.Equal(time.Second, rt.RepeatInterval())
+}
+
+func TestRetryTask_Run_TimeoutIsFailedAttempt(t *testing.T) {
+	t.Parallel()
+	a := assert.New(t)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	policy := NewRetryPolicy()
+	policy.Timeout = time.Millisecond * 10
+
+	rt := NewRetryTask(task.NewFunctionTask(func(context.Context) (interface{}, error) {
+		// задача не реагирует на отмену контекста
+		<-release
+		return nil, nil
+	}), policy)
+
+	a.Equal(policy.Timeout+retryTimeoutGrace, rt.Timeout())
+
+	_, err := rt.Run(context.Background())
+	a.True(errors.Is(err, context.DeadlineExceeded))
+	a.Equal(int64(1), rt.Attempt())
+	a.Equal(int64(-1), rt.Repeats())
+}
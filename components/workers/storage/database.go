@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	sql "github.com/mrsmtvd/shadow/components/database/storage"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+const TableTasks = workers.ComponentName + "_tasks"
+
+// Database хранит задачи в таблице, созданной миграцией компонента workers
+type Database struct {
+	storage database.Storage
+}
+
+func NewDatabase(s database.Storage) *Database {
+	if sqlStorage, ok := s.(*sql.SQL); ok {
+		sqlStorage.AddTableWithKeys(workers.TaskRecord{}, TableTasks, "ID")
+	}
+
+	return &Database{
+		storage: s,
+	}
+}
+
+func (s *Database) Save(ctx context.Context, record *workers.TaskRecord) error {
+	executor := s.storage.Master().WithContext(ctx)
+
+	count, err := executor.Update(record)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	// MySQL возвращает количество измененных, а не найденных строк, поэтому при повторном
+	// сохранении без изменений count равен 0 и наличие записи нужно проверить отдельно
+	exists, err := database.Get[workers.TaskRecord](ctx, executor, record.ID)
+	if err != nil {
+		return err
+	}
+
+	if exists != nil {
+		return nil
+	}
+
+	return executor.Insert(record)
+}
+
+func (s *Database) Get(ctx context.Context, id string) (*workers.TaskRecord, error) {
+	record, err := database.Get[workers.TaskRecord](ctx, s.storage.Master(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	if record == nil {
+		return nil, workers.ErrTaskRecordNotFound
+	}
+
+	return record, nil
+}
+
+func (s *Database) Delete(ctx context.Context, id string) error {
+	_, err := s.storage.Master().WithContext(ctx).Delete(&workers.TaskRecord{ID: id})
+
+	return err
+}
+
+func (s *Database) List(ctx context.Context) ([]*workers.TaskRecord, error) {
+	var list []*workers.TaskRecord
+
+	_, err := s.storage.Master().SelectByQueryContext(ctx, &list, "SELECT * FROM "+TableTasks+" ORDER BY created_at")
+
+	return list, err
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	sql "github.com/mrsmtvd/shadow/components/database/storage"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+const TableHistory = workers.ComponentName + "_history"
+
+// DatabaseHistory хранит историю запусков в таблице, созданной миграцией компонента workers
+type DatabaseHistory struct {
+	storage database.Storage
+}
+
+func NewDatabaseHistory(s database.Storage) *DatabaseHistory {
+	if sqlStorage, ok := s.(*sql.SQL); ok {
+		sqlStorage.AddTableWithKeys(workers.TaskRun{}, TableHistory, "ID")
+	}
+
+	return &DatabaseHistory{
+		storage: s,
+	}
+}
+
+func (s *DatabaseHistory) Add(ctx context.Context, run *workers.TaskRun) error {
+	return s.storage.Master().WithContext(ctx).Insert(run)
+}
+
+func (s *DatabaseHistory) List(ctx context.Context, taskID, status string) ([]*workers.TaskRun, error) {
+	executor := s.storage.Master()
+	bind := bindVar(executor)
+
+	query := "SELECT * FROM " + TableHistory + " WHERE task_id = " + bind(0)
+	args := []interface{}{taskID}
+
+	if status != "" {
+		query += " AND status = " + bind(1)
+		args = append(args, status)
+	}
+
+	var list []*workers.TaskRun
+
+	_, err := executor.SelectByQueryContext(ctx, &list, query+" ORDER BY started_at DESC", args...)
+
+	return list, err
+}
+
+// Truncate удаляет записи старше последних limit. Выборка с OFFSET отличается между диалектами,
+// поэтому лишние идентификаторы отбираются на стороне приложения
+func (s *DatabaseHistory) Truncate(ctx context.Context, taskID string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	executor := s.storage.Master()
+	bind := bindVar(executor)
+
+	var ids []string
+
+	_, err := executor.SelectByQueryContext(ctx, &ids,
+		"SELECT id FROM "+TableHistory+" WHERE task_id = "+bind(0)+" ORDER BY started_at DESC", taskID)
+	if err != nil || len(ids) <= limit {
+		return err
+	}
+
+	ids = ids[limit:]
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+
+	for i, id := range ids {
+		placeholders[i] = bind(i)
+		args[i] = id
+	}
+
+	_, err = executor.ExecByQueryContext(ctx, "DELETE FROM "+TableHistory+" WHERE id IN ("+strings.Join(placeholders, ", ")+")", args...)
+
+	return err
+}
+
+func (s *DatabaseHistory) Delete(ctx context.Context, taskID string) error {
+	executor := s.storage.Master()
+
+	_, err := executor.ExecByQueryContext(ctx, "DELETE FROM "+TableHistory+" WHERE task_id = "+bindVar(executor)(0), taskID)
+
+	return err
+}
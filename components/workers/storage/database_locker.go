@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	sql "github.com/mrsmtvd/shadow/components/database/storage"
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+const TableLocks = workers.ComponentName + "_locks"
+
+// DatabaseLocker хранит аренды блокировок в таблице, созданной миграцией компонента workers.
+// Истечение аренды сравнивается по часам реплик, поэтому время на серверах должно быть синхронизировано
+type DatabaseLocker struct {
+	storage database.Storage
+}
+
+func NewDatabaseLocker(s database.Storage) *DatabaseLocker {
+	if sqlStorage, ok := s.(*sql.SQL); ok {
+		sqlStorage.AddTableWithKeys(workers.Lease{}, TableLocks, "Name")
+	}
+
+	return &DatabaseLocker{
+		storage: s,
+	}
+}
+
+func (l *DatabaseLocker) Acquire(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	executor := l.storage.Master()
+	bind := bindVar(executor)
+	now := time.Now()
+
+	// продление своей аренды или перехват просроченной одним запросом, чтобы две реплики не захватили блокировку одновременно
+	result, err := executor.ExecByQueryContext(ctx,
+		"UPDATE "+TableLocks+" SET owner = "+bind(0)+
+			", acquired_at = CASE WHEN owner = "+bind(1)+" THEN acquired_at ELSE "+bind(2)+" END"+
+			", expires_at = "+bind(3)+
+			" WHERE name = "+bind(4)+" AND (owner = "+bind(5)+" OR expires_at < "+bind(6)+")",
+		owner, owner, now, now.Add(ttl), name, owner, now)
+	if err != nil {
+		return false, err
+	}
+
+	if count, err := result.RowsAffected(); err != nil {
+		return false, err
+	} else if count > 0 {
+		return true, nil
+	}
+
+	err = executor.WithContext(ctx).Insert(&workers.Lease{
+		Name:       name,
+		Owner:      owner,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(ttl),
+	})
+	if err == nil {
+		return true, nil
+	}
+
+	// вставка не удалась, так как запись уже есть. MySQL не считает строку затронутой, если продление
+	// не изменило значений, поэтому блокировка может принадлежать самому владельцу
+	if lease, e := l.Get(ctx, name); e == nil && lease != nil {
+		return lease.Owner == owner, nil
+	}
+
+	return false, err
+}
+
+func (l *DatabaseLocker) Release(ctx context.Context, name, owner string) error {
+	executor := l.storage.Master()
+	bind := bindVar(executor)
+
+	_, err := executor.ExecByQueryContext(ctx, "DELETE FROM "+TableLocks+" WHERE name = "+bind(0)+" AND owner = "+bind(1), name, owner)
+
+	return err
+}
+
+func (l *DatabaseLocker) Get(ctx context.Context, name string) (*workers.Lease, error) {
+	return database.Get[workers.Lease](ctx, l.storage.Master(), name)
+}
+
+func bindVar(executor database.Executor) func(int) string {
+	if e, ok := executor.(*sql.SQLExecutor); ok {
+		return e.BindVar
+	}
+
+	return func(int) string {
+		return "?"
+	}
+}
@@ -0,0 +1,279 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/database"
+	"github.com/mrsmtvd/shadow/components/workers"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLocksExecutor таблица блокировок в памяти с поведением MySQL: время хранится с точностью
+// до секунды как в DATETIME, а RowsAffected считает только измененные строки
+type fakeLocksExecutor struct {
+	database.Executor
+
+	mutex  sync.Mutex
+	leases map[string]workers.Lease
+}
+
+type fakeLocksStorage struct {
+	database.Storage
+
+	executor *fakeLocksExecutor
+}
+
+func newFakeLocksStorage() *fakeLocksStorage {
+	return &fakeLocksStorage{
+		executor: &fakeLocksExecutor{
+			leases: make(map[string]workers.Lease),
+		},
+	}
+}
+
+func (s *fakeLocksStorage) Master() database.Executor {
+	return s.executor
+}
+
+func (s *fakeLocksExecutor) WithContext(context.Context) database.Executor {
+	return s
+}
+
+func (s *fakeLocksExecutor) ExecByQueryContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch {
+	case strings.HasPrefix(query, "UPDATE "+TableLocks):
+		owner, now, expiresAt, name := args[0].(string), args[2].(time.Time), args[3].(time.Time), args[4].(string)
+
+		lease, ok := s.leases[name]
+		if !ok || (lease.Owner != owner && !lease.ExpiresAt.Before(now.Truncate(time.Second))) {
+			return driver.RowsAffected(0), nil
+		}
+
+		updated := lease
+		if updated.Owner != owner {
+			updated.AcquiredAt = now.Truncate(time.Second)
+		}
+
+		updated.Owner = owner
+		updated.ExpiresAt = expiresAt.Truncate(time.Second)
+		s.leases[name] = updated
+
+		if updated == lease {
+			return driver.RowsAffected(0), nil
+		}
+
+		return driver.RowsAffected(1), nil
+
+	case strings.HasPrefix(query, "DELETE FROM "+TableLocks):
+		if lease, ok := s.leases[args[0].(string)]; ok && lease.Owner == args[1].(string) {
+			delete(s.leases, lease.Name)
+			return driver.RowsAffected(1), nil
+		}
+
+		return driver.RowsAffected(0), nil
+	}
+
+	return nil, errors.New("unexpected query " + query)
+}
+
+func (s *fakeLocksExecutor) Insert(list ...interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	lease := *list[0].(*workers.Lease)
+
+	if _, ok := s.leases[lease.Name]; ok {
+		return errors.New("duplicate entry " + lease.Name + " for key PRIMARY")
+	}
+
+	lease.AcquiredAt = lease.AcquiredAt.Truncate(time.Second)
+	lease.ExpiresAt = lease.ExpiresAt.Truncate(time.Second)
+	s.leases[lease.Name] = lease
+
+	return nil
+}
+
+func (s *fakeLocksExecutor) GetContext(_ context.Context, _ interface{}, keys ...interface{}) (interface{}, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	lease, ok := s.leases[keys[0].(string)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &lease, nil
+}
+
+func testLockers() map[string]func() workers.Locker {
+	return map[string]func() workers.Locker{
+		"memory": func() workers.Locker {
+			return NewMemoryLocker()
+		},
+		"database": func() workers.Locker {
+			return NewDatabaseLocker(newFakeLocksStorage())
+		},
+	}
+}
+
+func TestLocker_Acquire(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range testLockers() {
+		factory := factory
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+			ctx := context.Background()
+			l := factory()
+
+			lease, err := l.Get(ctx, "lock")
+			a.NoError(err)
+			a.Nil(lease)
+
+			acquired, err := l.Acquire(ctx, "lock", "first", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			acquired, err = l.Acquire(ctx, "lock", "second", time.Minute)
+			a.NoError(err)
+			a.False(acquired)
+
+			lease, err = l.Get(ctx, "lock")
+			a.NoError(err)
+
+			if a.NotNil(lease) {
+				a.Equal("first", lease.Owner)
+				a.False(lease.Expired())
+			}
+
+			acquired, err = l.Acquire(ctx, "other", "second", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+		})
+	}
+}
+
+func TestLocker_Renew(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range testLockers() {
+		factory := factory
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+			ctx := context.Background()
+			l := factory()
+
+			acquired, err := l.Acquire(ctx, "lock", "first", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			first, err := l.Get(ctx, "lock")
+			a.NoError(err)
+
+			// продление без изменения значений, MySQL вернет 0 затронутых строк
+			acquired, err = l.Acquire(ctx, "lock", "first", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			acquired, err = l.Acquire(ctx, "lock", "first", time.Hour)
+			a.NoError(err)
+			a.True(acquired)
+
+			renewed, err := l.Get(ctx, "lock")
+			a.NoError(err)
+
+			if a.NotNil(first) && a.NotNil(renewed) {
+				a.Equal("first", renewed.Owner)
+				a.True(renewed.AcquiredAt.Equal(first.AcquiredAt))
+				a.True(renewed.ExpiresAt.After(first.ExpiresAt))
+			}
+		})
+	}
+}
+
+func TestLocker_AcquireExpired_Stolen(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range testLockers() {
+		factory := factory
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+			ctx := context.Background()
+			l := factory()
+
+			acquired, err := l.Acquire(ctx, "lock", "first", -time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			acquired, err = l.Acquire(ctx, "lock", "second", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			lease, err := l.Get(ctx, "lock")
+			a.NoError(err)
+
+			if a.NotNil(lease) {
+				a.Equal("second", lease.Owner)
+				a.False(lease.Expired())
+			}
+
+			acquired, err = l.Acquire(ctx, "lock", "first", time.Minute)
+			a.NoError(err)
+			a.False(acquired)
+		})
+	}
+}
+
+func TestLocker_ReleaseWithWrongOwner_KeepsLease(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range testLockers() {
+		factory := factory
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a := assert.New(t)
+			ctx := context.Background()
+			l := factory()
+
+			acquired, err := l.Acquire(ctx, "lock", "first", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+
+			a.NoError(l.Release(ctx, "lock", "second"))
+
+			lease, err := l.Get(ctx, "lock")
+			a.NoError(err)
+
+			if a.NotNil(lease) {
+				a.Equal("first", lease.Owner)
+			}
+
+			a.NoError(l.Release(ctx, "lock", "first"))
+
+			lease, err = l.Get(ctx, "lock")
+			a.NoError(err)
+			a.Nil(lease)
+
+			acquired, err = l.Acquire(ctx, "lock", "second", time.Minute)
+			a.NoError(err)
+			a.True(acquired)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+// Memory хранит задачи до перезапуска приложения и используется, когда надежное хранилище не настроено
+type Memory struct {
+	mutex   sync.RWMutex
+	records map[string]workers.TaskRecord
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		records: make(map[string]workers.TaskRecord),
+	}
+}
+
+func (s *Memory) Save(_ context.Context, record *workers.TaskRecord) error {
+	s.mutex.Lock()
+	s.records[record.ID] = *record
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *Memory) Get(_ context.Context, id string) (*workers.TaskRecord, error) {
+	s.mutex.RLock()
+	record, ok := s.records[id]
+	s.mutex.RUnlock()
+
+	if !ok {
+		return nil, workers.ErrTaskRecordNotFound
+	}
+
+	return &record, nil
+}
+
+func (s *Memory) Delete(_ context.Context, id string) error {
+	s.mutex.Lock()
+	delete(s.records, id)
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *Memory) List(_ context.Context) ([]*workers.TaskRecord, error) {
+	s.mutex.RLock()
+	list := make([]*workers.TaskRecord, 0, len(s.records))
+
+	for _, record := range s.records {
+		r := record
+		list = append(list, &r)
+	}
+	s.mutex.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+
+	return list, nil
+}
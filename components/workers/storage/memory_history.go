@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+// максимальное количество задач, история которых хранится в памяти. Одноразовые задачи
+// не удаляются из истории явно, поэтому вытесняется история задачи, запускавшейся раньше всех
+const memoryHistoryTasksLimit = 1000
+
+type MemoryHistory struct {
+	mutex sync.RWMutex
+	// записи каждой задачи хранятся от старых к новым
+	runs  map[string][]workers.TaskRun
+	order []string
+}
+
+func NewMemoryHistory() *MemoryHistory {
+	return &MemoryHistory{
+		runs:  make(map[string][]workers.TaskRun),
+		order: make([]string, 0),
+	}
+}
+
+func (s *MemoryHistory) Add(_ context.Context, run *workers.TaskRun) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.touch(run.TaskID)
+	s.runs[run.TaskID] = append(s.runs[run.TaskID], *run)
+
+	if len(s.order) > memoryHistoryTasksLimit {
+		delete(s.runs, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	return nil
+}
+
+func (s *MemoryHistory) List(_ context.Context, taskID, status string) ([]*workers.TaskRun, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	runs := s.runs[taskID]
+	list := make([]*workers.TaskRun, 0, len(runs))
+
+	for i := len(runs) - 1; i >= 0; i-- {
+		if status == "" || runs[i].Status == status {
+			run := runs[i]
+			list = append(list, &run)
+		}
+	}
+
+	return list, nil
+}
+
+func (s *MemoryHistory) Truncate(_ context.Context, taskID string, limit int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if runs := s.runs[taskID]; limit > 0 && len(runs) > limit {
+		s.runs[taskID] = append(runs[:0:0], runs[len(runs)-limit:]...)
+	}
+
+	return nil
+}
+
+func (s *MemoryHistory) Delete(_ context.Context, taskID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.runs, taskID)
+	s.remove(taskID)
+
+	return nil
+}
+
+// touch переносит задачу в конец очереди вытеснения
+func (s *MemoryHistory) touch(taskID string) {
+	s.remove(taskID)
+	s.order = append(s.order, taskID)
+}
+
+func (s *MemoryHistory) remove(taskID string) {
+	for i, id := range s.order {
+		if id == taskID {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/workers"
+)
+
+// MemoryLocker блокировки в пределах одного процесса, подходит только для запуска в одном экземпляре
+type MemoryLocker struct {
+	mutex  sync.RWMutex
+	leases map[string]workers.Lease
+}
+
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{
+		leases: make(map[string]workers.Lease),
+	}
+}
+
+func (l *MemoryLocker) Acquire(_ context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	lease, ok := l.leases[name]
+
+	if ok && lease.Owner != owner && lease.ExpiresAt.After(now) {
+		return false, nil
+	}
+
+	if !ok || lease.Owner != owner {
+		lease = workers.Lease{
+			Name:       name,
+			Owner:      owner,
+			AcquiredAt: now,
+		}
+	}
+
+	lease.ExpiresAt = now.Add(ttl)
+	l.leases[name] = lease
+
+	return true, nil
+}
+
+func (l *MemoryLocker) Release(_ context.Context, name, owner string) error {
+	l.mutex.Lock()
+	if lease, ok := l.leases[name]; ok && lease.Owner == owner {
+		delete(l.leases, name)
+	}
+	l.mutex.Unlock()
+
+	return nil
+}
+
+func (l *MemoryLocker) Get(_ context.Context, name string) (*workers.Lease, error) {
+	l.mutex.RLock()
+	lease, ok := l.leases[name]
+	l.mutex.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &lease, nil
+}
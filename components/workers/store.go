@@ -0,0 +1,120 @@
+package workers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/mrsmtvd/shadow/components/workers/cron"
+)
+
+const (
+	TaskRecordStatusWait       = "wait"
+	TaskRecordStatusProcess    = "process"
+	TaskRecordStatusRepeatWait = "repeat_wait"
+)
+
+// поведение задачи по расписанию, если время запуска пропущено пока приложение не работало
+const (
+	MissedRunPolicySkip    = "skip"
+	MissedRunPolicyOnce    = "once"
+	MissedRunPolicyCatchUp = "catch_up"
+)
+
+var ErrTaskRecordNotFound = errors.New("task record not found")
+
+// TaskHandler исполняет сохраненную задачу. Задачи хранятся в виде данных, поэтому код
+// задачи регистрируется в компоненте по имени, а в хранилище попадают только имя и payload
+type TaskHandler func(ctx context.Context, payload string) (interface{}, error)
+
+type TaskRecord struct {
+	ID              string        `db:"id"`
+	Handler         string        `db:"handler"`
+	Name            string        `db:"name"`
+	Payload         string        `db:"payload"`
+	Priority        int64         `db:"priority"`
+	Repeats         int64         `db:"repeats"`
+	RepeatInterval  time.Duration `db:"repeat_interval"`
+	Timeout         time.Duration `db:"timeout"`
+	Schedule        string        `db:"schedule"`
+	Timezone        string        `db:"timezone"`
+	Jitter          time.Duration `db:"jitter"`
+	MissedRunPolicy string        `db:"missed_run_policy"`
+	Singleton       bool          `db:"singleton"`
+	Status          string        `db:"status"`
+	Attempts        int64         `db:"attempts"`
+	NextRunAt       *time.Time    `db:"next_run_at"`
+	LastRunAt       *time.Time    `db:"last_run_at"`
+	LastResult      string        `db:"last_result"`
+	LastError       string        `db:"last_error"`
+	CreatedAt       time.Time     `db:"created_at"`
+	UpdatedAt       time.Time     `db:"updated_at"`
+}
+
+func NewTaskRecord(handler, payload string) *TaskRecord {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	now := time.Now()
+
+	return &TaskRecord{
+		ID:        hex.EncodeToString(id),
+		Handler:   handler,
+		Payload:   payload,
+		Repeats:   1,
+		Status:    TaskRecordStatusWait,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// NewScheduledTaskRecord создает запись задачи, запускаемой по расписанию cron без ограничения количества запусков
+func NewScheduledTaskRecord(handler, payload, schedule string) *TaskRecord {
+	r := NewTaskRecord(handler, payload)
+	r.Repeats = -1
+	r.Schedule = schedule
+	r.MissedRunPolicy = MissedRunPolicySkip
+
+	return r
+}
+
+// CronSchedule разбирает расписание записи, для записей без расписания возвращается nil
+func (r *TaskRecord) CronSchedule() (*cron.Schedule, error) {
+	if r.Schedule == "" {
+		return nil, nil
+	}
+
+	location := time.Local
+
+	if r.Timezone != "" {
+		var err error
+
+		if location, err = time.LoadLocation(r.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	return cron.ParseInLocation(r.Schedule, location)
+}
+
+// оставшееся количество запусков с учетом уже завершенных попыток, отрицательное значение без ограничений
+func (r *TaskRecord) RemainingRepeats() int64 {
+	if r.Repeats < 0 {
+		return r.Repeats
+	}
+
+	if remaining := r.Repeats - r.Attempts; remaining > 0 {
+		return remaining
+	}
+
+	return 0
+}
+
+type TaskStore interface {
+	Save(ctx context.Context, record *TaskRecord) error
+	Get(ctx context.Context, id string) (*TaskRecord, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*TaskRecord, error)
+}
@@ -0,0 +1,31 @@
+package workers
+
+import (
+	ws "github.com/mrsmtvd/go-workers"
+)
+
+// обертки задач (CronTask, RetryTask и т.д.) возвращают исходную задачу, поэтому
+// возможности задачи проверяются по всей цепочке оберток
+type wrapper interface {
+	Unwrap() ws.Task
+}
+
+// TaskAs ищет в цепочке оберток первую задачу, реализующую интерфейс T
+func TaskAs[T any](task ws.Task) (T, bool) {
+	for task != nil {
+		if t, ok := task.(T); ok {
+			return t, true
+		}
+
+		w, ok := task.(wrapper)
+		if !ok {
+			break
+		}
+
+		task = w.Unwrap()
+	}
+
+	var zero T
+
+	return zero, false
+}
@@ -0,0 +1,13 @@
+package internal
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations
+var embedFS embed.FS
+
+func (c *Component) FS() fs.FS {
+	return embedFS
+}
@@ -5,8 +5,5 @@ import (
 )
 
 func (c *Component) DatabaseMigrations() []database.Migration {
-	fs := c.AssetFS()
-	fs.Prefix = "migrations"
-
-	return database.MigrationsFromAsset(fs)
+	return database.MigrationsFromFS(c.FS(), "migrations")
 }
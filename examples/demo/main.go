@@ -2,6 +2,7 @@ package main // import "github.com/mrsmtvd/shadow/examples/demo"
 
 import (
 	"log"
+	"os"
 	"strconv"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	_ "github.com/mrsmtvd/shadow/components/annotations/instance"
 	_ "github.com/mrsmtvd/shadow/components/config/instance"
 	_ "github.com/mrsmtvd/shadow/components/dashboard/instance"
+	"github.com/mrsmtvd/shadow/components/database"
 	_ "github.com/mrsmtvd/shadow/components/database/instance"
 	_ "github.com/mrsmtvd/shadow/components/grpc/instance"
 	_ "github.com/mrsmtvd/shadow/components/i18n/instance"
@@ -34,6 +36,10 @@ func main() {
 	shadow.SetVersion("1.0")
 	shadow.SetBuild(build)
 
+	if database.IsMigrateMode() {
+		os.Exit(database.RunMigrate())
+	}
+
 	if err := shadow.Run(); err != nil {
 		log.Fatal(err.Error())
 	}
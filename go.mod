@@ -1,8 +1,9 @@
 module github.com/mrsmtvd/shadow
 
-go 1.17
+go 1.18
 
 require (
+	github.com/Masterminds/semver/v3 v3.1.0
 	github.com/Masterminds/sprig/v3 v3.1.0
 	github.com/Masterminds/squirrel v1.2.0
 	github.com/TheZeroSlave/zapsentry v1.8.0
@@ -35,6 +36,7 @@ require (
 	github.com/stretchr/testify v1.5.1
 	github.com/uber/jaeger-client-go v2.22.1+incompatible
 	github.com/uber/jaeger-lib v2.2.0+incompatible
+	github.com/ulikunitz/xz v0.5.11
 	go.uber.org/multierr v1.5.0
 	go.uber.org/zap v1.16.0
 	golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e
@@ -49,7 +51,6 @@ require (
 require (
 	code.cloudfoundry.org/clock v1.0.0 // indirect
 	github.com/Masterminds/goutils v1.1.0 // indirect
-	github.com/Masterminds/semver/v3 v3.1.0 // indirect
 	github.com/OneOfOne/xxhash v1.2.7 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/bsm/histogram v2.0.0+incompatible // indirect
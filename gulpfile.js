@@ -7,7 +7,6 @@ var child          = require('child_process'),
     env            = require('gulp-env'),
     exec           = require('gulp-exec'),
     filterBy       = require('gulp-filter-by'),
-    groupAggregate = require('gulp-group-aggregate'),
     reload         = require('gulp-livereload'),
     rename         = require('gulp-rename'),
     uglify         = require('gulp-uglify'),
@@ -269,7 +268,7 @@ gulp.task('frontend', gulp.series('compress-components', function(done) {
  * Backend
  */
 gulp.task('golang', function() {
-    return gulp.src([__dirname + '/**/**/*.go', '!' + __dirname + '/vendor/**', '!/**/bindata_assetfs.go'])
+    return gulp.src([__dirname + '/**/**/*.go', '!' + __dirname + '/vendor/**'])
         .pipe(exec('goimports -w <%= file.path %>'))
         .pipe(exec.reporter(execOptions));
 });
@@ -290,54 +289,6 @@ gulp.task('i18n', function() {
         .pipe(exec.reporter(execOptions));
 });
 
-gulp.task('bindata', function() {
-    var ignores = [
-        '[.]DS_Store',
-        '[.]gitignore',
-        '.*?[.]go$',
-    ];
-
-    if (process.env.NODE_ENV !== DEV_ENV) {
-        ignores.push('.*?(([^n]|^)|([^i]|^)n|([^m]|^)in|([^.]|^|^[.])min)[.][jJ][sS]');
-        ignores.push('.*?(([^n]|^)|([^i]|^)n|([^m]|^)in|([^.]|^|^[.])min)[.][cC][sS][sS]');
-        ignores.push('.*?[.]po$');
-    }
-    
-    return gulp.src([
-        'examples/**/templates',
-        'examples/**/assets',
-        'examples/**/migrations',
-        'examples/**/locales',
-
-        COMPONENTS + '/**/templates',
-        COMPONENTS + '/**/assets',
-        COMPONENTS + '/**/migrations',
-        COMPONENTS + '/**/locales'
-    ])
-        .pipe(groupAggregate({
-            group: function (file){
-                return path.dirname(file.path);
-            },
-            aggregate: function (group, files){
-                folders = [];
-                
-                for (var i in files) {
-                    folders.push(files[i].path.replace(group + '/', ''))
-                }
-                
-                return {
-                    path: group,
-                    contents: Buffer.from(folders.join('/... ') + '/...')
-                };
-            }
-        }))
-        .pipe(exec('cd <%= file.path %> && go-bindata-assetfs <%= options.debug %> -ignore="('+ ignores.join('|') +')" -o ./bindata_assetfs.go -pkg=<%= options.path.basename(file.path) %> -nometadata -nomemcopy <%= file.contents %>', {
-            path: path,
-            debug: process.env.NODE_ENV === DEV_ENV ? '-debug' : ''
-        }))
-        .pipe(exec.reporter(execOptions));
-});
-
 gulp.task('protobuf', function() {
     return gulp.src(COMPONENTS + '/**/*.proto')
         .pipe(exec('protoc --proto_path=<%= options.path.dirname(file.path) %> --go_out=plugins=grpc,Mgoogle/protobuf/timestamp.proto=github.com/golang/protobuf/ptypes/timestamp,Mgoogle/protobuf/duration.proto=github.com/golang/protobuf/ptypes/duration:<%= options.path.normalize(options.root + "/../../..") %> <%= file.path %>', {
@@ -369,7 +320,7 @@ gulp.task('enumer', function() {
 
 gulp.task('backend', gulp.series(
     gulp.parallel('i18n', 'protobuf', 'easyjson', 'enumer'),
-    'bindata', 'golang', 'lint'
+    'golang', 'lint'
 ));
 
 /**
@@ -432,36 +383,21 @@ gulp.task('server:watch', function() {
     ],  gulp.series('compress-components'));
 
     gulp.watch([
-        COMPONENTS + '/**/*.html'
-    ], function() {
-        if (process.env.NODE_ENV !== DEV_ENV) {
-            gulp.parallel('bindata', 'server:build', 'server:spawn');
-        } else {
-            gulp.parallel('server:spawn');
-        }
-    });
-
-    gulp.watch([
+        COMPONENTS + '/**/*.html',
         COMPONENTS + '/**/assets/css/*.css',
         COMPONENTS + '/**/assets/js/*.js'
-    ], function() {
-        if (process.env.NODE_ENV !== DEV_ENV) {
-            gulp.parallel('bindata', 'server:build', 'server:spawn');
-        } else {
-            gulp.parallel('server:build', 'server:spawn');
-        }
-    });
+    ], gulp.parallel('server:build', 'server:spawn'));
 
     // proto
     gulp.watch(['*/**/*.proto'],  gulp.series('protobuf'));
 
     // go source
-    gulp.watch(['*.go', '*/**/*.go', '!**/bindata_assetfs.go', '!**/bindata.go'], gulp.parallel(
+    gulp.watch(['*.go', '*/**/*.go'], gulp.parallel(
         'server:build', 'server:spawn'
     ), SERVER_BIN_NAME);
 });
 
-gulp.task('watch', gulp.parallel('bindata', 'server:build',
+gulp.task('watch', gulp.parallel('server:build',
     function() {
         reload.listen();
         return gulp.parallel('server:watch', 'server:spawn')
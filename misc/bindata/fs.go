@@ -0,0 +1,191 @@
+package bindata
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"time"
+
+	assetfs "github.com/elazarl/go-bindata-assetfs"
+)
+
+// адаптер для использования go-bindata-assetfs там, где ожидается fs.FS,
+// префикс AssetFS учитывается как корень файловой системы
+type assetFS struct {
+	fs *assetfs.AssetFS
+}
+
+type fileInfo struct {
+	name    string
+	size    int64
+	isDir   bool
+	modTime time.Time
+}
+
+type file struct {
+	*bytes.Reader
+
+	info *fileInfo
+}
+
+type directory struct {
+	info    *fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func FS(f *assetfs.AssetFS) fs.FS {
+	return &assetFS{
+		fs: f,
+	}
+}
+
+func (f *assetFS) fullName(name string) string {
+	name = path.Join(f.fs.Prefix, name)
+	if name == "." {
+		return ""
+	}
+
+	return name
+}
+
+func (f *assetFS) stat(name string) (*fileInfo, error) {
+	full := f.fullName(name)
+
+	if content, err := f.fs.Asset(full); err == nil {
+		info := &fileInfo{
+			name: path.Base(name),
+			size: int64(len(content)),
+		}
+
+		if f.fs.AssetInfo != nil {
+			if i, err := f.fs.AssetInfo(full); err == nil {
+				info.modTime = i.ModTime()
+			}
+		}
+
+		return info, nil
+	}
+
+	if _, err := f.fs.AssetDir(full); err != nil {
+		return nil, fs.ErrNotExist
+	}
+
+	return &fileInfo{
+		name:  path.Base(name),
+		isDir: true,
+	}, nil
+}
+
+func (f *assetFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	info, err := f.stat(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	if !info.isDir {
+		content, err := f.fs.Asset(f.fullName(name))
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+
+		return &file{
+			Reader: bytes.NewReader(content),
+			info:   info,
+		}, nil
+	}
+
+	children, err := f.fs.AssetDir(f.fullName(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	entries := make([]fs.DirEntry, 0, len(children))
+
+	for _, child := range children {
+		childInfo, err := f.stat(path.Join(name, child))
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, fs.FileInfoToDirEntry(childInfo))
+	}
+
+	return &directory{
+		info:    info,
+		entries: entries,
+	}, nil
+}
+
+func (i *fileInfo) Name() string {
+	return i.name
+}
+
+func (i *fileInfo) Size() int64 {
+	return i.size
+}
+
+func (i *fileInfo) Mode() fs.FileMode {
+	if i.isDir {
+		return fs.ModeDir | 0555
+	}
+
+	return 0444
+}
+
+func (i *fileInfo) ModTime() time.Time {
+	return i.modTime
+}
+
+func (i *fileInfo) IsDir() bool {
+	return i.isDir
+}
+
+func (i *fileInfo) Sys() interface{} {
+	return nil
+}
+
+func (f *file) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *file) Close() error {
+	return nil
+}
+
+func (d *directory) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *directory) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *directory) Close() error {
+	return nil
+}
+
+func (d *directory) ReadDir(count int) ([]fs.DirEntry, error) {
+	n := len(d.entries) - d.offset
+
+	if count > 0 {
+		if n == 0 {
+			return nil, io.EOF
+		}
+
+		if count < n {
+			n = count
+		}
+	}
+
+	entries := make([]fs.DirEntry, n)
+	copy(entries, d.entries[d.offset:d.offset+n])
+	d.offset += n
+
+	return entries, nil
+}
@@ -0,0 +1,195 @@
+package listener
+
+import (
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvListeners список унаследованных от родительского процесса сокетов в формате name=fd,name=fd
+	EnvListeners = "SHADOW_LISTENERS"
+	// EnvReadyFD дескриптор, в который дочерний процесс сообщает о готовности
+	EnvReadyFD = "SHADOW_READY_FD"
+
+	// первые три дескриптора stdin, stdout и stderr
+	firstExtraFD = 3
+)
+
+type fileListener interface {
+	net.Listener
+	File() (*os.File, error)
+}
+
+var (
+	mutex     sync.Mutex
+	inherited map[string]*os.File
+	readyFile *os.File
+	active    = make(map[string]net.Listener)
+
+	inheritOnce sync.Once
+)
+
+// разбор переменных окружения выполняется один раз, после чего они удаляются, чтобы не попасть
+// к процессам, которые запустит само приложение
+func inherit() {
+	inheritOnce.Do(func() {
+		inherited = make(map[string]*os.File)
+
+		for _, item := range strings.Split(os.Getenv(EnvListeners), ",") {
+			parts := strings.SplitN(item, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+
+			fd, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				continue
+			}
+
+			inherited[parts[0]] = os.NewFile(uintptr(fd), parts[0])
+		}
+
+		if fd, err := strconv.ParseUint(os.Getenv(EnvReadyFD), 10, 64); err == nil {
+			readyFile = os.NewFile(uintptr(fd), "ready")
+		}
+
+		_ = os.Unsetenv(EnvListeners)
+		_ = os.Unsetenv(EnvReadyFD)
+	})
+}
+
+// Listen возвращает сокет, унаследованный от родительского процесса при перезапуске, либо открывает новый.
+// Унаследованный сокет используется, только если он слушает тот же адрес
+func Listen(name, network, addr string) (net.Listener, error) {
+	inherit()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if f, ok := inherited[name]; ok {
+		delete(inherited, name)
+
+		lis, err := net.FileListener(f)
+		_ = f.Close()
+
+		if err == nil {
+			if sameAddr(lis.Addr(), network, addr) {
+				active[name] = lis
+				return lis, nil
+			}
+
+			_ = lis.Close()
+		}
+	}
+
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	active[name] = lis
+
+	return lis, nil
+}
+
+func sameAddr(have net.Addr, network, addr string) bool {
+	if have.Network() != network {
+		return false
+	}
+
+	want, err := net.ResolveTCPAddr(network, addr)
+	if err != nil {
+		return false
+	}
+
+	tcp, ok := have.(*net.TCPAddr)
+	if !ok || tcp.Port != want.Port {
+		return false
+	}
+
+	return want.IP == nil || want.IP.IsUnspecified() || want.IP.Equal(tcp.IP)
+}
+
+// Files возвращает копии дескрипторов открытых сокетов для передачи дочернему процессу
+func Files() (map[string]*os.File, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	files := make(map[string]*os.File, len(active))
+
+	for name, lis := range active {
+		fl, ok := lis.(fileListener)
+		if !ok {
+			continue
+		}
+
+		f, err := fl.File()
+		if err != nil {
+			for _, opened := range files {
+				_ = opened.Close()
+			}
+
+			return nil, err
+		}
+
+		files[name] = f
+	}
+
+	return files, nil
+}
+
+// Environ формирует окружение дочернего процесса. Сокеты names передаются дескрипторами
+// начиная с 3 в том же порядке, следом за ними идет дескриптор для сообщения о готовности
+func Environ(names []string) []string {
+	env := make([]string, 0, len(os.Environ())+2)
+
+	for _, item := range os.Environ() {
+		if strings.HasPrefix(item, EnvListeners+"=") || strings.HasPrefix(item, EnvReadyFD+"=") {
+			continue
+		}
+
+		env = append(env, item)
+	}
+
+	listeners := make([]string, 0, len(names))
+	for i, name := range names {
+		listeners = append(listeners, name+"="+strconv.Itoa(firstExtraFD+i))
+	}
+
+	return append(env,
+		EnvListeners+"="+strings.Join(listeners, ","),
+		EnvReadyFD+"="+strconv.Itoa(firstExtraFD+len(names)),
+	)
+}
+
+// IsRestarted сообщает, что процесс запущен перезапуском и родитель ждет его готовности
+func IsRestarted() bool {
+	inherit()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return readyFile != nil
+}
+
+// NotifyReady сообщает родительскому процессу о готовности, после чего родитель завершается
+func NotifyReady() error {
+	inherit()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if readyFile == nil {
+		return errors.New("process isn't started by restart")
+	}
+
+	_, err := readyFile.Write([]byte{1})
+	_ = readyFile.Close()
+	readyFile = nil
+
+	return err
+}